@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"cmp"
+	"debug/elf"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/multithreaded"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+var (
+	AuditELFPathFlag = &cli.PathFlag{
+		Name:      "path",
+		Usage:     "Path to 32/64-bit big-endian MIPS ELF file of the guest program",
+		TakesFile: true,
+		Required:  true,
+	}
+	AuditELFStepsFlag = &cli.Uint64Flag{
+		Name:  "steps",
+		Usage: "maximum number of steps to run the program for. The program is not run if 0.",
+		Value: 10_000_000,
+	}
+	AuditELFOutputFlag = &cli.PathFlag{
+		Name:      "output",
+		Usage:     "path to write the audit report to. The report is written to stdout if set to '-'.",
+		TakesFile: true,
+		Value:     "-",
+	}
+)
+
+// SyscallAudit is the report of the syscalls of a guest program that the multithreaded VM does not support.
+type SyscallAudit struct {
+	// Steps is the number of steps the program ran for.
+	Steps  uint64 `json:"steps"`
+	Exited bool   `json:"exited"`
+	// Unsupported are the unsupported syscalls, found statically in the ELF or hit while running the program.
+	Unsupported []*UnsupportedSyscall `json:"unsupported"`
+	// UnknownSites are the functions containing syscall instructions whose syscall number is not statically known,
+	// e.g. syscall.Syscall. Their syscalls are only audited if they are hit while running the program.
+	UnknownSites []string `json:"unknownSites"`
+}
+
+type UnsupportedSyscall struct {
+	Num arch.Word `json:"num"`
+	// Callers are the functions of the syscall instructions of the syscall.
+	Callers []string `json:"callers"`
+	// Hits is the number of times the syscall was executed while running the program.
+	Hits uint64 `json:"hits"`
+	// Stub is a suggested case of the VM syscall handler, treating the syscall as a noop that succeeds.
+	// It must be reviewed, as the program may depend on the effects or results of the syscall.
+	Stub string `json:"stub"`
+}
+
+func AuditELF(ctx *cli.Context) error {
+	elfPath := ctx.Path(AuditELFPathFlag.Name)
+	elfProgram, err := elf.Open(elfPath)
+	if err != nil {
+		return fmt.Errorf("failed to open ELF file %q: %w", elfPath, err)
+	}
+	defer elfProgram.Close()
+	if elfProgram.Machine != elf.EM_MIPS {
+		return fmt.Errorf("ELF is not big-endian MIPS R3000, but got %q", elfProgram.Machine.String())
+	}
+	meta, err := program.MakeMetadata(elfProgram)
+	if err != nil {
+		return fmt.Errorf("failed to compute program metadata: %w", err)
+	}
+
+	audit := newSyscallAudit(meta)
+	sites, err := program.FindSyscalls(elfProgram)
+	if err != nil {
+		return fmt.Errorf("failed to find syscalls: %w", err)
+	}
+	for _, site := range sites {
+		audit.addSite(site)
+	}
+
+	if steps := ctx.Uint64(AuditELFStepsFlag.Name); steps > 0 {
+		if err := audit.run(ctx, elfProgram, steps); err != nil {
+			return err
+		}
+	}
+
+	if err := jsonutil.WriteJSON(audit.report(), ioutil.ToStdOutOrFileOrNoop(ctx.Path(AuditELFOutputFlag.Name), OutFilePerm)); err != nil {
+		return fmt.Errorf("failed to write audit report: %w", err)
+	}
+	return nil
+}
+
+type syscallAudit struct {
+	meta         *program.Metadata
+	steps        uint64
+	exited       bool
+	unsupported  map[arch.Word]*UnsupportedSyscall
+	unknownSites map[string]struct{}
+}
+
+func newSyscallAudit(meta *program.Metadata) *syscallAudit {
+	return &syscallAudit{
+		meta:         meta,
+		unsupported:  make(map[arch.Word]*UnsupportedSyscall),
+		unknownSites: make(map[string]struct{}),
+	}
+}
+
+func (a *syscallAudit) addSite(site program.SyscallSite) {
+	caller := a.meta.LookupSymbol(site.Addr)
+	if !site.Known {
+		a.unknownSites[caller] = struct{}{}
+		return
+	}
+	if !multithreaded.IsSupportedSyscall(site.Num) {
+		a.addCaller(site.Num, caller)
+	}
+}
+
+func (a *syscallAudit) addCaller(num arch.Word, caller string) *UnsupportedSyscall {
+	s, ok := a.unsupported[num]
+	if !ok {
+		s = &UnsupportedSyscall{Num: num}
+		a.unsupported[num] = s
+	}
+	if !slices.Contains(s.Callers, caller) {
+		s.Callers = append(s.Callers, caller)
+	}
+	return s
+}
+
+// run executes the program, failing the unsupported syscalls it hits with ENOSYS, so it proceeds as far as possible.
+func (a *syscallAudit) run(ctx *cli.Context, elfProgram *elf.File, steps uint64) error {
+	state, err := program.LoadELF(elfProgram, multithreaded.CreateInitialState)
+	if err != nil {
+		return fmt.Errorf("failed to load ELF data into VM state: %w", err)
+	}
+	if err := program.PatchStack(state); err != nil {
+		return fmt.Errorf("failed to patch state: %w", err)
+	}
+
+	l := Logger(os.Stderr, log.LevelInfo).With("module", "vm")
+	guestLog := &mipsevm.LoggingWriter{Log: Logger(os.Stderr, log.LevelInfo).With("module", "guest")}
+	hostLog := Logger(os.Stderr, log.LevelInfo).With("module", "host")
+
+	// split CLI args after first '--'
+	args := ctx.Args().Slice()
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	if len(args) == 0 {
+		args = []string{""}
+	}
+	po, err := NewProcessPreimageOracle(args[0], args[1:], hostLog, hostLog)
+	if err != nil {
+		return fmt.Errorf("failed to create pre-image oracle process: %w", err)
+	}
+	if err := po.Start(); err != nil {
+		return fmt.Errorf("failed to start pre-image oracle server: %w", err)
+	}
+	defer func() {
+		if err := po.Close(); err != nil {
+			l.Error("failed to close pre-image server", "err", err)
+		}
+	}()
+
+	vm := multithreaded.NewInstrumentedState(state, po, guestLog, guestLog, l, a.meta)
+	vm.EnableSyscallAudit(func(syscallNum, pc arch.Word) {
+		caller := a.meta.LookupSymbol(pc)
+		s := a.addCaller(syscallNum, caller)
+		if s.Hits == 0 {
+			l.Warn("Unsupported syscall", "num", syscallNum, "pc", fmt.Sprintf("0x%x", pc), "caller", caller, "step", state.GetStep())
+		}
+		s.Hits++
+	})
+	stepFn := vm.Step
+	if po.cmd != nil {
+		stepFn = Guard(po.cmd.ProcessState, stepFn)
+	}
+	for state.GetStep() < steps && !state.GetExited() {
+		if state.GetStep()%100 == 0 {
+			if err := ctx.Context.Err(); err != nil {
+				return err
+			}
+		}
+		if _, err := stepFn(false); err != nil {
+			return fmt.Errorf("failed at step %d: %w", state.GetStep(), err)
+		}
+	}
+	a.steps = state.GetStep()
+	a.exited = state.GetExited()
+	l.Info("Audit run finished", "steps", a.steps, "exited", a.exited, "exit_code", state.GetExitCode())
+	return nil
+}
+
+func (a *syscallAudit) report() *SyscallAudit {
+	report := &SyscallAudit{
+		Steps:        a.steps,
+		Exited:       a.exited,
+		Unsupported:  make([]*UnsupportedSyscall, 0, len(a.unsupported)),
+		UnknownSites: make([]string, 0, len(a.unknownSites)),
+	}
+	for _, s := range a.unsupported {
+		slices.Sort(s.Callers)
+		s.Stub = fmt.Sprintf("case %d: // noop, called by %s", s.Num, strings.Join(s.Callers, ", "))
+		report.Unsupported = append(report.Unsupported, s)
+	}
+	slices.SortFunc(report.Unsupported, func(x, y *UnsupportedSyscall) int {
+		return cmp.Compare(x.Num, y.Num)
+	})
+	for site := range a.unknownSites {
+		report.UnknownSites = append(report.UnknownSites, site)
+	}
+	slices.Sort(report.UnknownSites)
+	return report
+}
+
+func CreateAuditELFCommand(action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:  "audit-elf",
+		Usage: "List the syscalls of a guest program that the VM does not support",
+		Description: "Scan the ELF file of a guest program for syscall instructions, and run the program for a limited number of steps, " +
+			"to find the syscalls the multithreaded VM does not support, with suggested stubs of the VM syscall handler. " +
+			"Unsupported syscalls hit while running fail with ENOSYS, so that the run finds as many as possible. " +
+			"A pre-image server can be specified after '--', like for 'cannon run'.",
+		Action: action,
+		Flags: []cli.Flag{
+			AuditELFPathFlag,
+			AuditELFStepsFlag,
+			AuditELFOutputFlag,
+		},
+	}
+}
+
+var AuditELFCommand = CreateAuditELFCommand(AuditELF)
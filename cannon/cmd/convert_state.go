@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
+	"github.com/ethereum-optimism/optimism/op-service/serialize"
+)
+
+var (
+	ConvertStateInputFlag = &cli.PathFlag{
+		Name:      "input",
+		Usage:     "path of input state. Use file extension '.bin', '.bin.gz', or '.json' for binary, compressed binary, or JSON formats.",
+		TakesFile: true,
+		Required:  true,
+	}
+	ConvertStateOutputFlag = &cli.PathFlag{
+		Name:      "output",
+		Usage:     "path to write the converted state to. State is dumped to stdout if set to '-'. Use file extension '.bin', '.bin.gz', or '.json' for binary, compressed binary, or JSON formats.",
+		TakesFile: true,
+		Required:  true,
+	}
+	ConvertStateVersionFlag = &cli.StringFlag{
+		Name: "version",
+		Usage: "state version to convert to. Defaults to the latest version the input state can be upgraded to, " +
+			"or the input state version when writing JSON. JSON is only supported for the single threaded versions, " +
+			"and binary is not supported for " + versions.VersionSingleThreaded.String() + ". Valid options: " + openum.EnumString(stateVersions()),
+	}
+)
+
+func ConvertState(ctx *cli.Context) error {
+	input := ctx.Path(ConvertStateInputFlag.Name)
+	output := ctx.Path(ConvertStateOutputFlag.Name)
+	var target versions.StateVersion
+	if ctx.IsSet(ConvertStateVersionFlag.Name) {
+		var err error
+		target, err = versions.ParseStateVersion(ctx.String(ConvertStateVersionFlag.Name))
+		if err != nil {
+			return err
+		}
+		if err := versions.CheckFormat(target, output); err != nil {
+			return err
+		}
+	}
+	state, err := versions.LoadStateForConversion(input)
+	if err != nil {
+		return fmt.Errorf("invalid input state (%v): %w", input, err)
+	}
+
+	if !ctx.IsSet(ConvertStateVersionFlag.Name) {
+		target = versions.LatestUpgrade(state.Version)
+		if !serialize.IsBinaryFile(output) {
+			target = state.Version
+		}
+		if err := versions.CheckFormat(target, output); err != nil {
+			return err
+		}
+	}
+	converted, err := versions.ConvertState(state, target)
+	if err != nil {
+		return err
+	}
+	if err := versions.WriteConvertedState(output, converted, OutFilePerm); err != nil {
+		return fmt.Errorf("failed to write state output: %w", err)
+	}
+	return nil
+}
+
+func CreateConvertStateCommand(action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:  "convert-state",
+		Usage: "Convert a Cannon state between serialization formats and state versions",
+		Description: "Convert a Cannon state between the JSON and (compressed) binary serialization formats, " +
+			"and upgrade the state to a newer state version where the newer version executes the state the same way.",
+		Action: action,
+		Flags: []cli.Flag{
+			ConvertStateInputFlag,
+			ConvertStateOutputFlag,
+			ConvertStateVersionFlag,
+		},
+	}
+}
+
+var ConvertStateCommand = CreateConvertStateCommand(ConvertState)
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"debug/elf"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+var (
+	CoverageELFFlag = &cli.PathFlag{
+		Name:      "elf",
+		Usage:     "Path to the 32/64-bit big-endian MIPS ELF file of the guest program, including DWARF debug info",
+		TakesFile: true,
+		Required:  true,
+	}
+	CoverageInputFlag = &cli.StringSliceFlag{
+		Name:     "input",
+		Usage:    "Path(s) of the coverage data written by 'cannon run --coverage'. The coverage of multiple runs is merged.",
+		Required: true,
+	}
+	CoverageOutputFlag = &cli.PathFlag{
+		Name:      "output",
+		Usage:     "Path to write the LCOV report to. The report is written to stdout if set to '-'.",
+		TakesFile: true,
+		Value:     "lcov.info",
+	}
+)
+
+func Coverage(ctx *cli.Context) error {
+	elfPath := ctx.Path(CoverageELFFlag.Name)
+	elfProgram, err := elf.Open(elfPath)
+	if err != nil {
+		return fmt.Errorf("failed to open ELF file %q: %w", elfPath, err)
+	}
+	defer elfProgram.Close()
+	if elfProgram.Machine != elf.EM_MIPS {
+		return fmt.Errorf("ELF is not big-endian MIPS R3000, but got %q", elfProgram.Machine.String())
+	}
+
+	cov := program.NewCoverage()
+	for _, input := range ctx.StringSlice(CoverageInputFlag.Name) {
+		runCov, err := jsonutil.LoadJSON[program.Coverage](input)
+		if err != nil {
+			return fmt.Errorf("failed to load coverage data %q: %w", input, err)
+		}
+		cov.Merge(runCov)
+	}
+
+	files, err := program.MakeSourceCoverage(elfProgram, cov)
+	if err != nil {
+		return fmt.Errorf("failed to map coverage to source: %w", err)
+	}
+	out, closer, abort, err := ioutil.ToStdOutOrFileOrNoop(ctx.Path(CoverageOutputFlag.Name), OutFilePerm)()
+	if err != nil {
+		return fmt.Errorf("failed to open output: %w", err)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := program.WriteLCOV(out, files); err != nil {
+		abort()
+		return fmt.Errorf("failed to write LCOV report: %w", err)
+	}
+	return closer.Close()
+}
+
+func CreateCoverageCommand(action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:  "coverage",
+		Usage: "Create an LCOV report of the guest program code executed by 'cannon run'",
+		Description: "Map the instructions executed by one or more 'cannon run --coverage' runs to the source lines and functions " +
+			"of the guest program, using the DWARF debug info of its ELF file, and write an LCOV report.",
+		Action: action,
+		Flags: []cli.Flag{
+			CoverageELFFlag,
+			CoverageInputFlag,
+			CoverageOutputFlag,
+		},
+	}
+}
+
+var CoverageCommand = CreateCoverageCommand(Coverage)
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+var (
+	DebugInputFlag = &cli.PathFlag{
+		Name:      "input",
+		Usage:     "path of input binary state.",
+		TakesFile: true,
+		Required:  true,
+	}
+	DebugMetaFlag = &cli.PathFlag{
+		Name:      "meta",
+		Usage:     "path to metadata file, to resolve breakpoints from symbols and print symbol names.",
+		TakesFile: true,
+		Required:  false,
+	}
+	DebugScriptFlag = &cli.PathFlag{
+		Name:      "script",
+		Usage:     "path of a file with debugger commands to execute, one per line. Commands are read from stdin if left empty.",
+		TakesFile: true,
+		Required:  false,
+	}
+)
+
+func Debug(ctx *cli.Context) error {
+	guestLogger := Logger(os.Stderr, log.LevelInfo)
+	outLog := &mipsevm.LoggingWriter{Log: guestLogger.With("module", "guest", "stream", "stdout")}
+	errLog := &mipsevm.LoggingWriter{Log: guestLogger.With("module", "guest", "stream", "stderr")}
+
+	l := Logger(os.Stderr, log.LevelInfo).With("module", "vm")
+
+	// split CLI args after first '--'
+	args := ctx.Args().Slice()
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	if len(args) == 0 {
+		args = []string{""}
+	}
+
+	poOut := Logger(os.Stdout, log.LevelInfo).With("module", "host")
+	poErr := Logger(os.Stderr, log.LevelInfo).With("module", "host")
+	po, err := NewProcessPreimageOracle(args[0], args[1:], poOut, poErr)
+	if err != nil {
+		return fmt.Errorf("failed to create pre-image oracle process: %w", err)
+	}
+	if err := po.Start(); err != nil {
+		return fmt.Errorf("failed to start pre-image oracle server: %w", err)
+	}
+	defer func() {
+		if err := po.Close(); err != nil {
+			l.Error("failed to close pre-image server", "err", err)
+		}
+	}()
+
+	meta := &program.Metadata{Symbols: nil}
+	if metaPath := ctx.Path(DebugMetaFlag.Name); metaPath != "" {
+		if meta, err = jsonutil.LoadJSON[program.Metadata](metaPath); err != nil {
+			return fmt.Errorf("failed to load metadata: %w", err)
+		}
+	}
+
+	state, err := versions.LoadStateFromFile(ctx.Path(DebugInputFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	l.Info("Loaded input state", "version", state.Version)
+	vm := state.CreateVM(l, po, outLog, errLog, meta)
+
+	var in io.Reader = os.Stdin
+	prompt := true
+	if scriptPath := ctx.Path(DebugScriptFlag.Name); scriptPath != "" {
+		script, err := os.Open(scriptPath)
+		if err != nil {
+			return fmt.Errorf("failed to open script: %w", err)
+		}
+		defer script.Close()
+		in = script
+		prompt = false
+	}
+
+	debugger := NewDebugger(ctx.Context, vm, meta, os.Stdout)
+	return debugger.Run(in, prompt)
+}
+
+func CreateDebugCommand(action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:  "debug",
+		Usage: "Debug a VM state interactively, or with a script of debugger commands.",
+		Description: "Debug a VM state interactively, or with a script of debugger commands. " +
+			"Supports breakpoints on addresses and symbols, memory watchpoints, single stepping and state inspection. " +
+			"Arguments after -- start the pre-image server, as with the run command.\n\n" + debuggerHelp,
+		Action: action,
+		Flags: []cli.Flag{
+			DebugInputFlag,
+			DebugMetaFlag,
+			DebugScriptFlag,
+		},
+	}
+}
+
+var DebugCommand = CreateDebugCommand(Debug)
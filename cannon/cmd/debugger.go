@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/op-service/serialize"
+)
+
+const debuggerHelp = `Commands:
+  break <pc|symbol>    set a breakpoint at an address, or at the start of a symbol (alias: b)
+  watch <addr>         set a watchpoint on the memory word containing the address (alias: w)
+  delete <id>          delete a breakpoint or watchpoint (alias: d)
+  list                 list breakpoints and watchpoints (alias: l)
+  step [n]             execute n instructions, 1 by default (alias: s)
+  continue             execute until a breakpoint or watchpoint is hit, or the program exits (alias: c)
+  info                 print the current step, pc, symbol and exit status (alias: i)
+  regs                 print the cpu registers (alias: r)
+  mem <addr> [n]       print n memory words starting at the address, 1 by default (alias: x)
+  save <path>          write the current state to a file
+  help                 print this help (alias: h)
+  quit                 stop debugging (alias: q)
+`
+
+var errQuit = errors.New("quit")
+
+type breakpoint struct {
+	id   int
+	pc   arch.Word
+	desc string
+}
+
+type watchpoint struct {
+	id   int
+	addr arch.Word
+	last arch.Word
+}
+
+// Debugger is an interactive debugger of a Cannon VM.
+// Commands are read line by line, either from a terminal or a script.
+type Debugger struct {
+	ctx  context.Context
+	vm   mipsevm.FPVM
+	meta *program.Metadata
+	out  io.Writer
+
+	nextID      int
+	breakpoints []breakpoint
+	watchpoints []watchpoint
+}
+
+func NewDebugger(ctx context.Context, vm mipsevm.FPVM, meta *program.Metadata, out io.Writer) *Debugger {
+	return &Debugger{
+		ctx:    ctx,
+		vm:     vm,
+		meta:   meta,
+		out:    out,
+		nextID: 1,
+	}
+}
+
+// Run executes the commands read from in, until the input ends or the quit command is read.
+// If prompt is true, a prompt is printed before reading each command.
+// Errors of individual commands are printed, and do not stop the debugger.
+func (d *Debugger) Run(in io.Reader, prompt bool) error {
+	scanner := bufio.NewScanner(in)
+	for {
+		if prompt {
+			_, _ = fmt.Fprint(d.out, "(cannon) ")
+		}
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := d.Exec(line); errors.Is(err, errQuit) {
+			return nil
+		} else if err != nil {
+			if ctxErr := d.ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			d.printf("error: %v\n", err)
+		}
+	}
+}
+
+// Exec executes a single debugger command.
+func (d *Debugger) Exec(line string) error {
+	fields := strings.Fields(line)
+	cmd, args := fields[0], fields[1:]
+	switch cmd {
+	case "break", "b":
+		if len(args) != 1 {
+			return errors.New("usage: break <pc|symbol>")
+		}
+		return d.addBreakpoint(args[0])
+	case "watch", "w":
+		if len(args) != 1 {
+			return errors.New("usage: watch <addr>")
+		}
+		return d.addWatchpoint(args[0])
+	case "delete", "d":
+		if len(args) != 1 {
+			return errors.New("usage: delete <id>")
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid id: %w", err)
+		}
+		return d.delete(id)
+	case "list", "l":
+		d.list()
+		return nil
+	case "step", "s":
+		n := uint64(1)
+		if len(args) == 1 {
+			v, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return fmt.Errorf("invalid step count: %w", err)
+			}
+			n = v
+		}
+		return d.step(n)
+	case "continue", "c":
+		return d.cont()
+	case "info", "i":
+		d.info()
+		return nil
+	case "regs", "r":
+		d.regs()
+		return nil
+	case "mem", "x":
+		if len(args) < 1 || len(args) > 2 {
+			return errors.New("usage: mem <addr> [n]")
+		}
+		return d.mem(args)
+	case "save":
+		if len(args) != 1 {
+			return errors.New("usage: save <path>")
+		}
+		if err := serialize.Write(args[0], d.vm.GetState(), OutFilePerm); err != nil {
+			return fmt.Errorf("failed to write state: %w", err)
+		}
+		d.printf("saved state at step %d to %s\n", d.vm.GetState().GetStep(), args[0])
+		return nil
+	case "help", "h":
+		d.printf("%s", debuggerHelp)
+		return nil
+	case "quit", "q":
+		return errQuit
+	default:
+		return fmt.Errorf("unknown command %q, see help", cmd)
+	}
+}
+
+func (d *Debugger) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(d.out, format, args...)
+}
+
+// resolveAddr parses a numeric address, or resolves the start address of a symbol.
+func (d *Debugger) resolveAddr(v string) (arch.Word, string, error) {
+	if addr, err := strconv.ParseUint(v, 0, arch.WordSize); err == nil {
+		return arch.Word(addr), d.vm.LookupSymbol(arch.Word(addr)), nil
+	}
+	for _, sym := range d.meta.Symbols {
+		if sym.Name == v {
+			return sym.Start, sym.Name, nil
+		}
+	}
+	return 0, "", fmt.Errorf("%q is neither an address nor a known symbol", v)
+}
+
+func (d *Debugger) addBreakpoint(v string) error {
+	pc, desc, err := d.resolveAddr(v)
+	if err != nil {
+		return err
+	}
+	bp := breakpoint{id: d.nextID, pc: pc, desc: desc}
+	d.nextID++
+	d.breakpoints = append(d.breakpoints, bp)
+	d.printf("breakpoint %d at %#x (%s)\n", bp.id, bp.pc, bp.desc)
+	return nil
+}
+
+func (d *Debugger) addWatchpoint(v string) error {
+	addr, _, err := d.resolveAddr(v)
+	if err != nil {
+		return err
+	}
+	addr &^= arch.ExtMask
+	wp := watchpoint{id: d.nextID, addr: addr, last: d.vm.GetState().GetMemory().GetWord(addr)}
+	d.nextID++
+	d.watchpoints = append(d.watchpoints, wp)
+	d.printf("watchpoint %d at %#x, value %#x\n", wp.id, wp.addr, wp.last)
+	return nil
+}
+
+func (d *Debugger) delete(id int) error {
+	if i := slices.IndexFunc(d.breakpoints, func(bp breakpoint) bool { return bp.id == id }); i >= 0 {
+		d.breakpoints = slices.Delete(d.breakpoints, i, i+1)
+		return nil
+	}
+	if i := slices.IndexFunc(d.watchpoints, func(wp watchpoint) bool { return wp.id == id }); i >= 0 {
+		d.watchpoints = slices.Delete(d.watchpoints, i, i+1)
+		return nil
+	}
+	return fmt.Errorf("no breakpoint or watchpoint with id %d", id)
+}
+
+func (d *Debugger) list() {
+	for _, bp := range d.breakpoints {
+		d.printf("%d: breakpoint at %#x (%s)\n", bp.id, bp.pc, bp.desc)
+	}
+	for _, wp := range d.watchpoints {
+		d.printf("%d: watchpoint at %#x, value %#x\n", wp.id, wp.addr, wp.last)
+	}
+}
+
+// stepOnce executes a single instruction, and reports whether a watchpoint was triggered.
+func (d *Debugger) stepOnce() (bool, error) {
+	state := d.vm.GetState()
+	if state.GetExited() {
+		return false, errors.New("program has exited")
+	}
+	if d.vm.CheckInfiniteLoop() {
+		return false, fmt.Errorf("detected an infinite loop at step %d", state.GetStep())
+	}
+	if _, err := d.vm.Step(false); err != nil {
+		return false, fmt.Errorf("failed at step %d (PC: %#x): %w", state.GetStep(), state.GetPC(), err)
+	}
+	triggered := false
+	for i := range d.watchpoints {
+		wp := &d.watchpoints[i]
+		if v := state.GetMemory().GetWord(wp.addr); v != wp.last {
+			d.printf("watchpoint %d at %#x: %#x -> %#x\n", wp.id, wp.addr, wp.last, v)
+			wp.last = v
+			triggered = true
+		}
+	}
+	return triggered, nil
+}
+
+func (d *Debugger) step(n uint64) error {
+	for i := uint64(0); i < n; i++ {
+		if triggered, err := d.stepOnce(); err != nil {
+			return err
+		} else if triggered {
+			break
+		}
+		if d.vm.GetState().GetExited() {
+			break
+		}
+	}
+	d.info()
+	return nil
+}
+
+func (d *Debugger) cont() error {
+	state := d.vm.GetState()
+	for {
+		if state.GetStep()%100 == 0 { // don't do the ctx err check (includes lock) too often
+			if err := d.ctx.Err(); err != nil {
+				return err
+			}
+		}
+		triggered, err := d.stepOnce()
+		if err != nil {
+			return err
+		}
+		if triggered || state.GetExited() {
+			break
+		}
+		pc := state.GetPC()
+		if i := slices.IndexFunc(d.breakpoints, func(bp breakpoint) bool { return bp.pc == pc }); i >= 0 {
+			d.printf("breakpoint %d hit\n", d.breakpoints[i].id)
+			break
+		}
+	}
+	d.info()
+	return nil
+}
+
+func (d *Debugger) info() {
+	state := d.vm.GetState()
+	pc := state.GetPC()
+	d.printf("step: %d, pc: %#x (%s)\n", state.GetStep(), pc, d.vm.LookupSymbol(pc))
+	if state.GetExited() {
+		d.printf("exited with code %d\n", state.GetExitCode())
+	}
+}
+
+func (d *Debugger) regs() {
+	state := d.vm.GetState()
+	cpu := state.GetCpu()
+	d.printf("pc: %#x, next_pc: %#x, lo: %#x, hi: %#x, heap: %#x\n", cpu.PC, cpu.NextPC, cpu.LO, cpu.HI, state.GetHeap())
+	for i, v := range state.GetRegistersRef() {
+		d.printf("r%-2d: %#x\n", i, v)
+	}
+}
+
+func (d *Debugger) mem(args []string) error {
+	addr, _, err := d.resolveAddr(args[0])
+	if err != nil {
+		return err
+	}
+	n := uint64(1)
+	if len(args) == 2 {
+		if n, err = strconv.ParseUint(args[1], 0, 64); err != nil {
+			return fmt.Errorf("invalid word count: %w", err)
+		}
+	}
+	addr &^= arch.ExtMask
+	memory := d.vm.GetState().GetMemory()
+	for i := uint64(0); i < n; i++ {
+		d.printf("%#x: %#x\n", addr, memory.GetWord(addr))
+		addr += arch.WordSizeBytes
+	}
+	return nil
+}
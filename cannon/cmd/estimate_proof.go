@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+var (
+	EstimateProofInputFlag = &cli.PathFlag{
+		Name:      "input",
+		Usage:     "path of the input binary state to run from",
+		TakesFile: true,
+		Value:     "state.bin.gz",
+		Required:  true,
+	}
+	EstimateProofAtFlag = &cli.Uint64Flag{
+		Name:     "at",
+		Usage:    "step to estimate the proof of. Must not be before the step of the input state.",
+		Required: true,
+	}
+	EstimateProofOutputFlag = &cli.PathFlag{
+		Name:      "output",
+		Usage:     "path to write the estimate to. The estimate is written to stdout if set to '-'.",
+		TakesFile: true,
+		Value:     "-",
+	}
+	EstimateProofLargePreimageThresholdFlag = &cli.Uint64Flag{
+		Name:  "large-preimage-threshold",
+		Usage: "minimum size of pre-images that are uploaded with the large pre-image proposal process, see MIN_LPP_SIZE_BYTES of the PreimageOracle",
+		Value: 126000,
+	}
+)
+
+// Gas bounds of the onchain execution, excluding the intrinsic gas of the calldata.
+// They are deliberately generous, so that the estimate is an upper bound for budgeting.
+const (
+	// stepExecutionGas bounds the execution of FaultDisputeGame.step, including the MIPS step and the game state updates.
+	stepExecutionGas = 500_000
+	// preimageLoadExecutionGas bounds the storage writes of loading a pre-image part into the PreimageOracle.
+	preimageLoadExecutionGas = 100_000
+	// preimageHashGasPerWord is the hashing cost per 32-byte word of a loaded pre-image.
+	preimageHashGasPerWord = 6
+)
+
+// stepABI is the step function of the FaultDisputeGame, which the challenger calls to execute the step onchain.
+var stepABI = mustParseABI(`[{"type":"function","name":"step","inputs":[{"name":"_claimIndex","type":"uint256"},{"name":"_isAttack","type":"bool"},{"name":"_stateData","type":"bytes"},{"name":"_proof","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"}]`)
+
+// preimageLoadABI covers the PreimageOracle and FaultDisputeGame functions that load the pre-image read by a step.
+var preimageLoadABI = mustParseABI(`[
+{"type":"function","name":"addLocalData","inputs":[{"name":"_ident","type":"uint256"},{"name":"_execLeafIdx","type":"uint256"},{"name":"_partOffset","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+{"type":"function","name":"loadKeccak256PreimagePart","inputs":[{"name":"_partOffset","type":"uint256"},{"name":"_preimage","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},
+{"type":"function","name":"loadSha256PreimagePart","inputs":[{"name":"_partOffset","type":"uint256"},{"name":"_preimage","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},
+{"type":"function","name":"loadBlobPreimagePart","inputs":[{"name":"_z","type":"uint256"},{"name":"_y","type":"uint256"},{"name":"_commitment","type":"bytes"},{"name":"_proof","type":"bytes"},{"name":"_partOffset","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+{"type":"function","name":"loadPrecompilePreimagePart","inputs":[{"name":"_partOffset","type":"uint256"},{"name":"_precompile","type":"address"},{"name":"_requiredGas","type":"uint64"},{"name":"_input","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"}
+]`)
+
+func mustParseABI(data string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(data))
+	if err != nil {
+		panic(fmt.Errorf("invalid ABI: %w", err))
+	}
+	return parsed
+}
+
+// ProofEstimate is the size and gas estimate of executing a single step onchain.
+type ProofEstimate struct {
+	Step uint64 `json:"step"`
+
+	StateDataSize uint64 `json:"stateDataSize"`
+	ProofDataSize uint64 `json:"proofDataSize"`
+	// StepCalldataSize is the exact size of the calldata of the step call.
+	StepCalldataSize uint64 `json:"stepCalldataSize"`
+	StepGas          uint64 `json:"stepGas"`
+
+	// PreimageKey is set if the step reads a pre-image, which must be loaded into the PreimageOracle before the step.
+	PreimageKey          hexutil.Bytes `json:"preimageKey,omitempty"`
+	PreimageSize         uint64        `json:"preimageSize,omitempty"`
+	PreimageCalldataSize uint64        `json:"preimageCalldataSize,omitempty"`
+	PreimageGas          uint64        `json:"preimageGas,omitempty"`
+	// LargePreimage is true if the pre-image is uploaded with the large pre-image proposal process instead,
+	// which takes multiple transactions and is not included in the estimate.
+	LargePreimage bool `json:"largePreimage,omitempty"`
+
+	// TotalGas is the upper bound of the gas of all transactions of the step.
+	TotalGas uint64 `json:"totalGas"`
+}
+
+func EstimateProof(ctx *cli.Context) error {
+	at := ctx.Uint64(EstimateProofAtFlag.Name)
+	state, err := versions.LoadStateFromFile(ctx.Path(EstimateProofInputFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if state.GetStep() > at {
+		return fmt.Errorf("state is at step %d, after step %d", state.GetStep(), at)
+	}
+
+	l := Logger(os.Stderr, log.LevelInfo).With("module", "vm")
+	guestLog := &mipsevm.LoggingWriter{Log: Logger(os.Stderr, log.LevelInfo).With("module", "guest")}
+	hostLog := Logger(os.Stderr, log.LevelInfo).With("module", "host")
+
+	// split CLI args after first '--'
+	args := ctx.Args().Slice()
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	if len(args) == 0 {
+		args = []string{""}
+	}
+	po, err := NewProcessPreimageOracle(args[0], args[1:], hostLog, hostLog)
+	if err != nil {
+		return fmt.Errorf("failed to create pre-image oracle process: %w", err)
+	}
+	if err := po.Start(); err != nil {
+		return fmt.Errorf("failed to start pre-image oracle server: %w", err)
+	}
+	defer func() {
+		if err := po.Close(); err != nil {
+			l.Error("failed to close pre-image server", "err", err)
+		}
+	}()
+
+	vm := state.CreateVM(l, po, guestLog, guestLog, &program.Metadata{})
+	stepFn := vm.Step
+	if po.cmd != nil {
+		stepFn = Guard(po.cmd.ProcessState, stepFn)
+	}
+	for state.GetStep() < at && !state.GetExited() {
+		if state.GetStep()%100 == 0 {
+			if err := ctx.Context.Err(); err != nil {
+				return err
+			}
+		}
+		if _, err := stepFn(false); err != nil {
+			return fmt.Errorf("failed at step %d: %w", state.GetStep(), err)
+		}
+	}
+	if state.GetExited() {
+		return fmt.Errorf("program exited at step %d, there is no step %d to prove", state.GetStep(), at)
+	}
+	witness, err := stepFn(true)
+	if err != nil {
+		return fmt.Errorf("failed at proof-gen step %d: %w", at, err)
+	}
+
+	estimate, err := estimateProof(at, witness, ctx.Uint64(EstimateProofLargePreimageThresholdFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := jsonutil.WriteJSON(estimate, ioutil.ToStdOutOrFileOrNoop(ctx.Path(EstimateProofOutputFlag.Name), OutFilePerm)); err != nil {
+		return fmt.Errorf("failed to write estimate: %w", err)
+	}
+	return nil
+}
+
+func estimateProof(step uint64, witness *mipsevm.StepWitness, largePreimageThreshold uint64) (*ProofEstimate, error) {
+	// The claim index is a placeholder that is as large as any realistic index.
+	calldata, err := stepABI.Pack("step", new(big.Int).SetUint64(1<<32), true, witness.State, witness.ProofData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode step call: %w", err)
+	}
+	estimate := &ProofEstimate{
+		Step:             step,
+		StateDataSize:    uint64(len(witness.State)),
+		ProofDataSize:    uint64(len(witness.ProofData)),
+		StepCalldataSize: uint64(len(calldata)),
+		StepGas:          params.TxGas + calldataGas(calldata) + stepExecutionGas,
+	}
+	estimate.TotalGas = estimate.StepGas
+	if !witness.HasPreimage() {
+		return estimate, nil
+	}
+
+	if len(witness.PreimageValue) < 8 {
+		return nil, errors.New("pre-image value is missing its length prefix")
+	}
+	value := witness.PreimageValue[8:]
+	estimate.PreimageKey = witness.PreimageKey[:]
+	estimate.PreimageSize = uint64(len(value))
+	keyType := preimage.KeyType(witness.PreimageKey[0])
+	if keyType != preimage.LocalKeyType && estimate.PreimageSize >= largePreimageThreshold {
+		estimate.LargePreimage = true
+		return estimate, nil
+	}
+	calldata, err = preimageLoadCalldata(keyType, value, uint64(witness.PreimageOffset))
+	if err != nil {
+		return nil, err
+	}
+	words := (uint64(len(value)) + 31) / 32
+	estimate.PreimageCalldataSize = uint64(len(calldata))
+	estimate.PreimageGas = params.TxGas + calldataGas(calldata) + preimageLoadExecutionGas + words*preimageHashGasPerWord
+	estimate.TotalGas += estimate.PreimageGas
+	return estimate, nil
+}
+
+// preimageLoadCalldata encodes the call that loads the pre-image into the PreimageOracle. Where the call takes
+// more than the pre-image, e.g. the KZG proof of blob pre-images, the additional inputs are zero-filled.
+func preimageLoadCalldata(keyType preimage.KeyType, value []byte, offset uint64) ([]byte, error) {
+	partOffset := new(big.Int).SetUint64(offset)
+	switch keyType {
+	case preimage.LocalKeyType:
+		return preimageLoadABI.Pack("addLocalData", big.NewInt(0), big.NewInt(0), partOffset)
+	case preimage.Keccak256KeyType:
+		return preimageLoadABI.Pack("loadKeccak256PreimagePart", partOffset, value)
+	case preimage.Sha256KeyType:
+		return preimageLoadABI.Pack("loadSha256PreimagePart", partOffset, value)
+	case preimage.BlobKeyType:
+		return preimageLoadABI.Pack("loadBlobPreimagePart", big.NewInt(0), big.NewInt(0), make([]byte, 48), make([]byte, 48), partOffset)
+	case preimage.PrecompileKeyType:
+		return preimageLoadABI.Pack("loadPrecompilePreimagePart", partOffset, common.Address{}, uint64(0), value)
+	default:
+		return nil, fmt.Errorf("unknown pre-image key type %d", keyType)
+	}
+}
+
+// calldataGas returns the intrinsic gas of the calldata.
+func calldataGas(data []byte) uint64 {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	return gas
+}
+
+func CreateEstimateProofCommand(action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:  "estimate-proof",
+		Usage: "Estimate the calldata size and gas of the onchain step at the given step",
+		Description: "Run the VM from the input state to the given step, and report the exact calldata size of the step call, " +
+			"and an upper bound of the gas of the step and of loading the pre-image it reads into the PreimageOracle. " +
+			"The pre-image server command is passed after '--', as with 'cannon run'.",
+		Action: action,
+		Flags: []cli.Flag{
+			EstimateProofInputFlag,
+			EstimateProofAtFlag,
+			EstimateProofOutputFlag,
+			EstimateProofLargePreimageThresholdFlag,
+		},
+	}
+}
+
+var EstimateProofCommand = CreateEstimateProofCommand(EstimateProof)
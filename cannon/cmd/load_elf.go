@@ -41,6 +41,11 @@ var (
 		Value:    "meta.json",
 		Required: false,
 	}
+	LoadELFBundleFlag = &cli.PathFlag{
+		Name:     "bundle",
+		Usage:    "Write prestate bundle of the state, metadata and absolute prestate hash, for 'cannon run' to load and verify at once. None if empty. Use file extension '.bundle' or '.bundle.gz'.",
+		Required: false,
+	}
 )
 
 func stateVersions() []string {
@@ -109,6 +114,15 @@ func LoadELF(ctx *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to create versioned state: %w", err)
 	}
+	if bundlePath := ctx.Path(LoadELFBundleFlag.Name); bundlePath != "" {
+		if !versions.IsBundleFile(bundlePath) {
+			return fmt.Errorf("invalid prestate bundle path %q, must end in '.bundle' or '.bundle.gz'", bundlePath)
+		}
+		bundle := versions.NewBundle(versionedState, meta)
+		if err := serialize.WriteSerializedBinary(bundle, ioutil.ToStdOutOrFileOrNoop(bundlePath, OutFilePerm)); err != nil {
+			return fmt.Errorf("failed to output prestate bundle: %w", err)
+		}
+	}
 	return serialize.Write(ctx.Path(LoadELFOutFlag.Name), versionedState, OutFilePerm)
 }
 
@@ -123,6 +137,7 @@ func CreateLoadELFCommand(action cli.ActionFunc) *cli.Command {
 			LoadELFPathFlag,
 			LoadELFOutFlag,
 			LoadELFMetaFlag,
+			LoadELFBundleFlag,
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
@@ -31,7 +32,7 @@ import (
 var (
 	RunInputFlag = &cli.PathFlag{
 		Name:      "input",
-		Usage:     "path of input binary state. Stdin if left empty.",
+		Usage:     "path of input binary state. Stdin if left empty. A prestate bundle ('.bundle' or '.bundle.gz') includes the metadata, and is verified against its prestate hash before running.",
 		TakesFile: true,
 		Value:     "state.bin.gz",
 		Required:  true,
@@ -68,6 +69,13 @@ var (
 		Value:    "state-%d.bin.gz",
 		Required: false,
 	}
+	RunSnapshotAtStepFlag = &cli.StringFlag{
+		Name: "snapshot-at-step",
+		Usage: "format for file names of snapshots output when the program marks a checkpoint, such as the interop program once it parsed the agreed state of the step, " +
+			"e.g. 'checkpoints/%s/%d.bin.gz' with the checkpoint commitment and the step. " +
+			"Executions of the same step, e.g. for other claims, can be restored from the snapshot. Disabled if empty.",
+		Required: false,
+	}
 	RunStopAtFlag = &cli.GenericFlag{
 		Name:     "stop-at",
 		Usage:    "step pattern to stop at: " + patternHelp,
@@ -115,6 +123,30 @@ var (
 		TakesFile: true,
 		Required:  false,
 	}
+	RunScheduleFlag = &cli.PathFlag{
+		Name:      "schedule",
+		Usage:     "path to write the thread switches of multi-threaded VMs to",
+		TakesFile: true,
+		Required:  false,
+	}
+	RunCoverageFlag = &cli.PathFlag{
+		Name:      "coverage",
+		Usage:     "path to write the execution counts of the guest program instructions to, for reports created with 'cannon coverage'",
+		TakesFile: true,
+		Required:  false,
+	}
+	RunFuzzPreemptionSeedFlag = &cli.Int64Flag{
+		Name: "fuzz-preemption-seed",
+		Usage: "enable preemption fuzzing of multi-threaded VMs, injecting additional thread preemptions at steps derived from this seed. " +
+			"The resulting states diverge from the onchain VM and can't be used for proofs.",
+		Required: false,
+	}
+	RunFuzzPreemptionIntervalFlag = &cli.Uint64Flag{
+		Name:     "fuzz-preemption-interval",
+		Usage:    "average number of steps between injected preemptions when preemption fuzzing is enabled",
+		Value:    1000,
+		Required: false,
+	}
 
 	OutFilePerm = os.FileMode(0o755)
 )
@@ -145,6 +177,33 @@ func (rk rawKey) PreimageKey() [32]byte {
 	return rk
 }
 
+// checkpointOracle records the commitment of the last checkpoint the program marked with a checkpoint hint.
+type checkpointOracle struct {
+	mipsevm.PreimageOracle
+	checkpoint *common.Hash
+}
+
+func (o *checkpointOracle) Hint(v []byte) {
+	if hintType, value, _ := strings.Cut(string(v), " "); hintType == preimage.HintCheckpoint {
+		if checkpoint, err := hexutil.Decode(value); err == nil && len(checkpoint) == common.HashLength {
+			o.checkpoint = (*common.Hash)(checkpoint)
+		}
+	}
+	o.PreimageOracle.Hint(v)
+}
+
+// writeCheckpointSnapshot writes the snapshot at a checkpoint, unless an earlier execution already wrote it.
+// The state at a checkpoint is the same for all executions that reach the same checkpoint commitment.
+func writeCheckpointSnapshot(path string, state *versions.VersionedState) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+	return serialize.Write(path, state, OutFilePerm)
+}
+
 type ProcessPreimageOracle struct {
 	pCl      *preimage.OracleClient
 	hCl      *preimage.HintWriter
@@ -363,28 +422,50 @@ func Run(ctx *cli.Context) error {
 	infoAt := ctx.Generic(RunInfoAtFlag.Name).(*StepMatcherFlag).Matcher()
 
 	var meta *program.Metadata
-	if metaPath := ctx.Path(RunMetaFlag.Name); metaPath == "" {
-		l.Info("no metadata file specified, defaulting to empty metadata")
-		meta = &program.Metadata{Symbols: nil} // provide empty metadata by default
+	var state *versions.VersionedState
+	hasMeta := true
+	if inputPath := ctx.Path(RunInputFlag.Name); versions.IsBundleFile(inputPath) {
+		if ctx.IsSet(RunMetaFlag.Name) {
+			return errors.New("cannot specify a metadata file with a prestate bundle, the bundle includes the metadata")
+		}
+		bundle, err := versions.LoadBundleFromFile(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to load prestate bundle: %w", err)
+		}
+		l.Info("Loaded and verified prestate bundle", "version", bundle.State.Version, "prestate", bundle.PrestateHash)
+		state, meta = bundle.State, bundle.Meta
 	} else {
-		if m, err := jsonutil.LoadJSON[program.Metadata](metaPath); err != nil {
-			return fmt.Errorf("failed to load metadata: %w", err)
+		if metaPath := ctx.Path(RunMetaFlag.Name); metaPath == "" {
+			l.Info("no metadata file specified, defaulting to empty metadata")
+			meta = &program.Metadata{Symbols: nil} // provide empty metadata by default
+			hasMeta = false
 		} else {
-			meta = m
+			if m, err := jsonutil.LoadJSON[program.Metadata](metaPath); err != nil {
+				return fmt.Errorf("failed to load metadata: %w", err)
+			} else {
+				meta = m
+			}
 		}
-	}
 
-	state, err := versions.LoadStateFromFile(ctx.Path(RunInputFlag.Name))
-	if err != nil {
-		return fmt.Errorf("failed to load state: %w", err)
+		state, err = versions.LoadStateFromFile(inputPath)
+		if err != nil {
+			return fmt.Errorf("failed to load state: %w", err)
+		}
+		l.Info("Loaded input state", "version", state.Version)
+	}
+	var oracle mipsevm.PreimageOracle = po
+	var checkpoints *checkpointOracle
+	checkpointFmt := ctx.String(RunSnapshotAtStepFlag.Name)
+	if checkpointFmt != "" {
+		checkpoints = &checkpointOracle{PreimageOracle: po}
+		oracle = checkpoints
 	}
-	l.Info("Loaded input state", "version", state.Version)
-	vm := state.CreateVM(l, po, outLog, errLog, meta)
+	vm := state.CreateVM(l, oracle, outLog, errLog, meta)
 
 	// Enable debug/stats tracking as requested
 	debugProgram := ctx.Bool(RunDebugFlag.Name)
 	if debugProgram {
-		if metaPath := ctx.Path(RunMetaFlag.Name); metaPath == "" {
+		if !hasMeta {
 			return errors.New("cannot enable debug mode without a metadata file")
 		}
 		if err := vm.InitDebug(); err != nil {
@@ -394,6 +475,22 @@ func Run(ctx *cli.Context) error {
 	if debugInfoFile := ctx.Path(RunDebugInfoFlag.Name); debugInfoFile != "" {
 		vm.EnableStats()
 	}
+	if scheduleFile := ctx.Path(RunScheduleFlag.Name); scheduleFile != "" {
+		vm.EnableScheduleRecording()
+	}
+	if ctx.IsSet(RunFuzzPreemptionSeedFlag.Name) {
+		seed := ctx.Int64(RunFuzzPreemptionSeedFlag.Name)
+		interval := ctx.Uint64(RunFuzzPreemptionIntervalFlag.Name)
+		if err := vm.EnablePreemptionFuzzing(seed, interval); err != nil {
+			return fmt.Errorf("failed to enable preemption fuzzing: %w", err)
+		}
+		l.Warn("Preemption fuzzing enabled, states diverge from the onchain VM", "seed", seed, "interval", interval)
+	}
+
+	var coverage *program.Coverage
+	if coverageFile := ctx.Path(RunCoverageFlag.Name); coverageFile != "" {
+		coverage = program.NewCoverage()
+	}
 
 	proofFmt := ctx.String(RunProofFmtFlag.Name)
 	snapshotFmt := ctx.String(RunSnapshotFmtFlag.Name)
@@ -446,6 +543,10 @@ func Run(ctx *cli.Context) error {
 			}
 		}
 
+		if coverage != nil {
+			coverage.Record(state.GetPC())
+		}
+
 		if proofAt(state) {
 			witness, err := stepFn(true)
 			if err != nil {
@@ -474,6 +575,15 @@ func Run(ctx *cli.Context) error {
 			}
 		}
 
+		if checkpoints != nil && checkpoints.checkpoint != nil {
+			checkpoint := *checkpoints.checkpoint
+			checkpoints.checkpoint = nil
+			l.Info("Writing snapshot at checkpoint", "checkpoint", checkpoint, "step", state.GetStep())
+			if err := writeCheckpointSnapshot(fmt.Sprintf(checkpointFmt, checkpoint.Hex(), state.GetStep()), state); err != nil {
+				return fmt.Errorf("failed to write checkpoint snapshot: %w", err)
+			}
+		}
+
 		lastPreimageKey, lastPreimageValue, lastPreimageOffset := vm.LastPreimage()
 		if lastPreimageOffset != ^arch.Word(0) {
 			if stopAtAnyPreimage {
@@ -506,6 +616,16 @@ func Run(ctx *cli.Context) error {
 			return fmt.Errorf("failed to write benchmark data: %w", err)
 		}
 	}
+	if scheduleFile := ctx.Path(RunScheduleFlag.Name); scheduleFile != "" {
+		if err := jsonutil.WriteJSON(vm.GetSchedule(), ioutil.ToStdOutOrFileOrNoop(scheduleFile, OutFilePerm)); err != nil {
+			return fmt.Errorf("failed to write schedule: %w", err)
+		}
+	}
+	if coverage != nil {
+		if err := jsonutil.WriteJSON(coverage, ioutil.ToStdOutOrFileOrNoop(ctx.Path(RunCoverageFlag.Name), OutFilePerm)); err != nil {
+			return fmt.Errorf("failed to write coverage: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -522,6 +642,7 @@ func CreateRunCommand(action cli.ActionFunc) *cli.Command {
 			RunProofFmtFlag,
 			RunSnapshotAtFlag,
 			RunSnapshotFmtFlag,
+			RunSnapshotAtStepFlag,
 			RunStopAtFlag,
 			RunStopAtPreimageFlag,
 			RunStopAtPreimageTypeFlag,
@@ -531,6 +652,10 @@ func CreateRunCommand(action cli.ActionFunc) *cli.Command {
 			RunPProfCPU,
 			RunDebugFlag,
 			RunDebugInfoFlag,
+			RunScheduleFlag,
+			RunCoverageFlag,
+			RunFuzzPreemptionSeedFlag,
+			RunFuzzPreemptionIntervalFlag,
 		},
 	}
 }
@@ -548,5 +673,8 @@ func checkFlags(ctx *cli.Context) error {
 			return errors.New("invalid --snapshot-fmt file format. Only binary file formats (ending in .bin or bin.gz) are supported")
 		}
 	}
+	if ctx.IsSet(RunFuzzPreemptionSeedFlag.Name) && ctx.IsSet(RunProofAtFlag.Name) {
+		return errors.New("proofs can't be generated with preemption fuzzing, as the states diverge from the onchain VM")
+	}
 	return nil
 }
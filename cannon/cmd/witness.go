@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	factory "github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
@@ -43,7 +42,7 @@ func Witness(ctx *cli.Context) error {
 	}
 	witness, h := state.EncodeWitness()
 	if witnessOutput != "" {
-		if err := os.WriteFile(witnessOutput, witness, 0755); err != nil {
+		if err := ioutil.WriteAtomic(witnessOutput, witness, 0755); err != nil {
 			return fmt.Errorf("writing output to %v: %w", witnessOutput, err)
 		}
 	}
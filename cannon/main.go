@@ -21,6 +21,11 @@ func main() {
 		cmd.LoadELFCommand,
 		cmd.WitnessCommand,
 		cmd.RunCommand,
+		cmd.DebugCommand,
+		cmd.ConvertStateCommand,
+		cmd.CoverageCommand,
+		cmd.EstimateProofCommand,
+		cmd.AuditELFCommand,
 	}
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
 	err := app.RunContext(ctx, os.Args)
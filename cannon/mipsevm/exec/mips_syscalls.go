@@ -38,6 +38,7 @@ const (
 	MipsEINVAL     = 0x16
 	MipsEAGAIN     = 0xb
 	MipsETIMEDOUT  = 0x91
+	MipsENOSYS     = 0x59
 )
 
 // SysFutex-related constants
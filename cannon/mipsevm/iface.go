@@ -57,6 +57,10 @@ type FPVMState interface {
 	// EncodeWitness returns the witness for the current state and the state hash
 	EncodeWitness() (witness []byte, hash common.Hash)
 
+	// Fork returns an independent copy of the state, sharing the memory pages copy-on-write with this state.
+	// Both states can be stepped separately, to speculatively execute along different paths.
+	Fork() FPVMState
+
 	// CreateVM creates a FPVM that can operate on this state.
 	CreateVM(logger log.Logger, po PreimageOracle, stdOut, stdErr io.Writer, meta Metadata) FPVM
 }
@@ -93,6 +97,18 @@ type FPVM interface {
 	// EnableStats if supported by the VM, enables some additional statistics that can be retrieved via GetDebugInfo()
 	EnableStats()
 
+	// EnableScheduleRecording if supported by the VM, records the thread switches that can be retrieved via GetSchedule()
+	EnableScheduleRecording()
+
+	// GetSchedule returns the thread switches recorded since schedule recording was enabled
+	GetSchedule() []ScheduleEvent
+
+	// EnablePreemptionFuzzing if supported by the VM, preempts the active thread at additional steps, on average
+	// every meanInterval steps. The steps are derived from seed, so a run can be replayed with the same seed.
+	// The resulting states diverge from the onchain VM, and must only be used to detect guest programs whose
+	// behavior depends on the thread schedule.
+	EnablePreemptionFuzzing(seed int64, meanInterval uint64) error
+
 	// LookupSymbol returns the symbol located at the specified address.
 	// May return an empty string if there's no symbol table available.
 	LookupSymbol(addr arch.Word) string
@@ -58,6 +58,9 @@ type Memory struct {
 	// this prevents map lookups each instruction
 	lastPageKeys [2]Word
 	lastPage     [2]*CachedPage
+
+	// pageIndex of pages that may be referenced by a forked memory, and are copied before they are written to
+	shared map[Word]struct{}
 }
 
 func NewMemory() *Memory {
@@ -207,6 +210,7 @@ func (m *Memory) SetWord(addr Word, v Word) {
 		// Go may mmap relatively large ranges, but we only allocate the pages just in time.
 		p = m.AllocPage(pageIndex)
 	} else {
+		p = m.unshare(pageIndex, p)
 		m.invalidate(addr) // invalidate this branch of memory, now that the value changed
 	}
 	arch.ByteOrderWord.PutWord(p.Data[pageAddr:pageAddr+arch.WordSizeBytes], v)
@@ -230,6 +234,7 @@ func (m *Memory) GetWord(addr Word) Word {
 func (m *Memory) AllocPage(pageIndex Word) *CachedPage {
 	p := &CachedPage{Data: new(Page)}
 	m.pages[pageIndex] = p
+	delete(m.shared, pageIndex)
 	// make nodes to root
 	k := (1 << PageKeySize) | uint64(pageIndex)
 	for k > 0 {
@@ -267,6 +272,7 @@ func (m *Memory) UnmarshalJSON(data []byte) error {
 	m.pages = make(map[Word]*CachedPage)
 	m.lastPageKeys = [2]Word{^Word(0), ^Word(0)}
 	m.lastPage = [2]*CachedPage{nil, nil}
+	m.shared = nil
 	for i, p := range pages {
 		if _, ok := m.pages[p.Index]; ok {
 			return fmt.Errorf("cannot load duplicate page, entry %d, page index %d", i, p.Index)
@@ -293,6 +299,10 @@ func (m *Memory) SetMemoryRange(addr Word, r io.Reader) error {
 		p, ok := m.pageLookup(pageIndex)
 		if !ok {
 			p = m.AllocPage(pageIndex)
+		} else {
+			p = m.unshare(pageIndex, p)
+			// invalidate the branch of the page, its nodes may have been cached, e.g. when the memory was forked
+			m.invalidate(pageIndex << PageAddrSize)
 		}
 		p.InvalidateFull()
 		copy(p.Data[pageAddr:], chunk[:n])
@@ -360,6 +370,59 @@ func (m *Memory) Copy() *Memory {
 	return out
 }
 
+// Fork returns a child memory with the same contents, for speculative execution along a different path.
+// Pages are shared copy-on-write: a page is only copied by the parent or the child when either writes to it,
+// so forking does not duplicate the memory image.
+// The parent and child may be used concurrently, but each of them must only be used by a single goroutine.
+func (m *Memory) Fork() *Memory {
+	// Fill the merkle caches of all pages, so that reads never update the caches of shared pages.
+	// Pages may have been invalidated with still valid nodes above them, so merkleize every page directly.
+	for _, p := range m.pages {
+		p.MerkleRoot()
+	}
+	m.MerkleRoot()
+
+	if m.shared == nil {
+		m.shared = make(map[Word]struct{}, len(m.pages))
+	}
+	out := &Memory{
+		nodes:        maps.Clone(m.nodes), // nodes are replaced, never modified in place, so they can be shared
+		pages:        maps.Clone(m.pages),
+		lastPageKeys: [2]Word{^Word(0), ^Word(0)},
+		shared:       make(map[Word]struct{}, len(m.pages)),
+	}
+	for pageIndex := range m.pages {
+		m.shared[pageIndex] = struct{}{}
+		out.shared[pageIndex] = struct{}{}
+	}
+	return out
+}
+
+// SharedPageCount returns the number of pages that are still shared with a forked memory.
+func (m *Memory) SharedPageCount() int {
+	return len(m.shared)
+}
+
+// unshare returns a private copy of the page at pageIndex if it is shared, or p itself otherwise.
+func (m *Memory) unshare(pageIndex Word, p *CachedPage) *CachedPage {
+	if len(m.shared) == 0 {
+		return p
+	}
+	if _, ok := m.shared[pageIndex]; !ok {
+		return p
+	}
+	delete(m.shared, pageIndex)
+	cp := &CachedPage{Data: new(Page), Cache: p.Cache, Ok: p.Ok}
+	*cp.Data = *p.Data
+	m.pages[pageIndex] = cp
+	for i := range m.lastPageKeys {
+		if m.lastPageKeys[i] == pageIndex {
+			m.lastPage[i] = cp
+		}
+	}
+	return cp
+}
+
 type memReader struct {
 	m     *Memory
 	addr  Word
@@ -254,3 +254,24 @@ func TestMemory64Copy(t *testing.T) {
 	require.Equal(t, Word(0xAABB), mcpy.GetWord(0xAABBCCDD_8000))
 	require.Equal(t, m.MerkleRoot(), mcpy.MerkleRoot())
 }
+
+func TestMemory64Fork(t *testing.T) {
+	m := NewMemory()
+	m.SetWord(0xAABBCCDD_8000, 123)
+	root := m.MerkleRoot()
+
+	child := m.Fork()
+	require.Equal(t, 1, child.SharedPageCount())
+	require.Equal(t, root, child.MerkleRoot())
+
+	child.SetWord(0xAABBCCDD_8000, 0x11223344_55667788)
+	require.Equal(t, Word(0x11223344_55667788), child.GetWord(0xAABBCCDD_8000))
+	require.Equal(t, Word(123), m.GetWord(0xAABBCCDD_8000))
+	require.Equal(t, 0, child.SharedPageCount())
+	require.Equal(t, root, m.MerkleRoot())
+	require.Equal(t, child.Copy().MerkleRoot(), child.MerkleRoot())
+
+	m.SetWord(0xAABBCCDD_8000, 456)
+	require.Equal(t, Word(0x11223344_55667788), child.GetWord(0xAABBCCDD_8000))
+	require.Equal(t, m.Copy().MerkleRoot(), m.MerkleRoot())
+}
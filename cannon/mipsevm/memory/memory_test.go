@@ -243,3 +243,47 @@ func TestMemoryCopy(t *testing.T) {
 	require.Equal(t, Word(123), mcpy.GetWord(0x8000))
 	require.Equal(t, m.MerkleRoot(), mcpy.MerkleRoot())
 }
+
+func TestMemoryFork(t *testing.T) {
+	m := NewMemory()
+	m.SetWord(0x8000, 123)
+	m.SetWord(0x10000, 456)
+	root := m.MerkleRoot()
+
+	child := m.Fork()
+	require.Equal(t, 2, m.SharedPageCount())
+	require.Equal(t, 2, child.SharedPageCount())
+	require.Equal(t, root, child.MerkleRoot())
+
+	// writes to the child are not visible in the parent
+	child.SetWord(0x8000, 789)
+	require.Equal(t, Word(789), child.GetWord(0x8000))
+	require.Equal(t, Word(123), m.GetWord(0x8000))
+	require.Equal(t, 1, child.SharedPageCount())
+	require.Equal(t, root, m.MerkleRoot())
+
+	// writes to the parent are not visible in the child
+	m.SetWord(0x10000, 1)
+	require.Equal(t, Word(1), m.GetWord(0x10000))
+	require.Equal(t, Word(456), child.GetWord(0x10000))
+	require.Equal(t, 1, m.SharedPageCount())
+
+	// new pages are private
+	child.SetWord(0x20000, 2)
+	require.Equal(t, Word(0), m.GetWord(0x20000))
+
+	// merkle roots match a full copy of the same contents
+	require.Equal(t, m.Copy().MerkleRoot(), m.MerkleRoot())
+	require.Equal(t, child.Copy().MerkleRoot(), child.MerkleRoot())
+	require.NotEqual(t, m.MerkleRoot(), child.MerkleRoot())
+
+	t.Run("SetMemoryRange", func(t *testing.T) {
+		m := NewMemory()
+		m.SetWord(0x8000, 123)
+		child := m.Fork()
+		require.NoError(t, child.SetMemoryRange(0x8000, bytes.NewReader([]byte{0xaa, 0xbb, 0xcc, 0xdd})))
+		require.Equal(t, Word(0xaabbccdd), child.GetWord(0x8000))
+		require.Equal(t, Word(123), m.GetWord(0x8000))
+		require.Equal(t, child.Copy().MerkleRoot(), child.MerkleRoot())
+	})
+}
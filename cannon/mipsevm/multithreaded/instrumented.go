@@ -1,6 +1,7 @@
 package multithreaded
 
 import (
+	"errors"
 	"io"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -22,6 +23,15 @@ type InstrumentedState struct {
 	stackTracker  ThreadedStackTracker
 	statsTracker  StatsTracker
 
+	// schedule is the recorded thread switches, only recorded if recordSchedule is set
+	recordSchedule   bool
+	schedule         []mipsevm.ScheduleEvent
+	preemptionFuzzer *preemptionFuzzer
+	// injectedPreemption is set if the current step preempted the active thread due to preemption fuzzing
+	injectedPreemption bool
+	// onUnsupportedSyscall is called instead of panicking on unsupported syscalls, if syscall auditing is enabled
+	onUnsupportedSyscall func(syscallNum, pc Word)
+
 	preimageOracle *exec.TrackingPreimageOracleReader
 	meta           mipsevm.Metadata
 }
@@ -55,6 +65,28 @@ func (m *InstrumentedState) EnableStats() {
 	m.statsTracker = NewStatsTracker()
 }
 
+func (m *InstrumentedState) EnableScheduleRecording() {
+	m.recordSchedule = true
+}
+
+func (m *InstrumentedState) GetSchedule() []mipsevm.ScheduleEvent {
+	return m.schedule
+}
+
+// EnableSyscallAudit makes unsupported syscalls fail with ENOSYS, after reporting them to onUnsupported,
+// instead of panicking. The execution then diverges from the onchain VM, so it must only be used to audit programs.
+func (m *InstrumentedState) EnableSyscallAudit(onUnsupported func(syscallNum, pc Word)) {
+	m.onUnsupportedSyscall = onUnsupported
+}
+
+func (m *InstrumentedState) EnablePreemptionFuzzing(seed int64, meanInterval uint64) error {
+	if meanInterval == 0 {
+		return errors.New("preemption fuzzing interval must be greater than 0")
+	}
+	m.preemptionFuzzer = newPreemptionFuzzer(seed, meanInterval, m.state.GetStep())
+	return nil
+}
+
 func (m *InstrumentedState) Step(proof bool) (wit *mipsevm.StepWitness, err error) {
 	m.preimageOracle.Reset()
 	m.memoryTracker.Reset(proof)
@@ -215,6 +215,11 @@ func (m *InstrumentedState) handleSyscall() error {
 		// These syscalls have the same values on 64-bit. So we use if-stmts here to avoid "duplicate case" compiler error for the cannon64 build
 		if arch.IsMips32 && (syscallNum == arch.SysFstat64 || syscallNum == arch.SysStat64 || syscallNum == arch.SysLlseek) {
 			// noop
+		} else if m.onUnsupportedSyscall != nil {
+			// Not part of the onchain VM: the audit fails the syscall instead, to find all unsupported syscalls
+			m.onUnsupportedSyscall(syscallNum, thread.Cpu.PC)
+			v0 = exec.SysErrorSignal
+			v1 = exec.MipsENOSYS
 		} else {
 			m.Traceback()
 			panic(fmt.Sprintf("unrecognized syscall: %d", syscallNum))
@@ -226,12 +231,24 @@ func (m *InstrumentedState) handleSyscall() error {
 }
 
 func (m *InstrumentedState) mipsStep() error {
+	fromThreadId := m.state.GetCurrentThread().ThreadId
+	m.injectedPreemption = false
 	err := m.doMipsStep()
 	if err != nil {
 		return err
 	}
 
 	m.assertPostStateChecks()
+	if m.recordSchedule {
+		if toThreadId := m.state.GetCurrentThread().ThreadId; toThreadId != fromThreadId {
+			m.schedule = append(m.schedule, mipsevm.ScheduleEvent{
+				Step:     m.state.Step,
+				From:     fromThreadId,
+				To:       toThreadId,
+				Injected: m.injectedPreemption,
+			})
+		}
+	}
 	return err
 }
 
@@ -299,6 +316,13 @@ func (m *InstrumentedState) doMipsStep() error {
 		}
 	}
 
+	if m.preemptionFuzzer != nil && m.state.ThreadCount() > 1 && m.preemptionFuzzer.shouldPreempt(m.state.Step) {
+		// Not part of the onchain VM: perturb the schedule to detect guest behavior that depends on it
+		m.preemptThread(thread)
+		m.injectedPreemption = true
+		return nil
+	}
+
 	if m.state.StepsSinceLastContextSwitch >= exec.SchedQuantum {
 		// Force a context switch as this thread has been active too long
 		if m.state.ThreadCount() > 1 {
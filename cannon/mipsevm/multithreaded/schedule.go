@@ -0,0 +1,36 @@
+package multithreaded
+
+import (
+	"math/rand"
+)
+
+// preemptionFuzzer selects the steps at which a preemption of the active thread is injected.
+// The steps are drawn from a seeded source, so the same seed always perturbs the schedule the same way.
+type preemptionFuzzer struct {
+	rng          *rand.Rand
+	meanInterval uint64
+	nextStep     uint64
+}
+
+func newPreemptionFuzzer(seed int64, meanInterval uint64, step uint64) *preemptionFuzzer {
+	f := &preemptionFuzzer{
+		rng:          rand.New(rand.NewSource(seed)),
+		meanInterval: meanInterval,
+	}
+	f.scheduleNext(step)
+	return f
+}
+
+// shouldPreempt returns true if a preemption must be injected at step.
+func (f *preemptionFuzzer) shouldPreempt(step uint64) bool {
+	if step < f.nextStep {
+		return false
+	}
+	f.scheduleNext(step)
+	return true
+}
+
+func (f *preemptionFuzzer) scheduleNext(step uint64) {
+	// Uniformly distributed in [1, 2*meanInterval], so preemptions are injected every meanInterval steps on average
+	f.nextStep = step + 1 + uint64(f.rng.Int63n(int64(2*f.meanInterval)))
+}
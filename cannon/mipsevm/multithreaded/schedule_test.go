@@ -0,0 +1,93 @@
+package multithreaded
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/testutil"
+)
+
+func TestPreemptionFuzzer(t *testing.T) {
+	t.Run("Deterministic", func(t *testing.T) {
+		steps := func(seed int64) []uint64 {
+			f := newPreemptionFuzzer(seed, 10, 0)
+			var preempted []uint64
+			for step := uint64(1); step <= 1000; step++ {
+				if f.shouldPreempt(step) {
+					preempted = append(preempted, step)
+				}
+			}
+			return preempted
+		}
+		require.Equal(t, steps(1), steps(1))
+		require.NotEqual(t, steps(1), steps(2))
+	})
+
+	t.Run("WithinInterval", func(t *testing.T) {
+		f := newPreemptionFuzzer(1, 10, 100)
+		last := uint64(100)
+		for step := uint64(101); step <= 10_000; step++ {
+			if f.shouldPreempt(step) {
+				require.LessOrEqual(t, step-last, uint64(20))
+				last = step
+			}
+		}
+		require.Greater(t, last, uint64(9_980))
+	})
+}
+
+func TestInstrumentedState_PreemptionFuzzing(t *testing.T) {
+	if os.Getenv("SKIP_SLOW_TESTS") == "true" {
+		t.Skip("Skipping slow test because SKIP_SLOW_TESTS is enabled")
+	}
+	t.Parallel()
+
+	run := func(t *testing.T, seed int64) []mipsevm.ScheduleEvent {
+		state, meta := testutil.LoadELFProgram(t, testutil.ProgramPath("mt-general"), CreateInitialState, false)
+		oracle := testutil.StaticOracle(t, []byte{})
+
+		var stdOutBuf, stdErrBuf bytes.Buffer
+		us := NewInstrumentedState(state, oracle, io.MultiWriter(&stdOutBuf, os.Stdout), io.MultiWriter(&stdErrBuf, os.Stderr), testutil.CreateLogger(), meta)
+		us.EnableScheduleRecording()
+		require.NoError(t, us.EnablePreemptionFuzzing(seed, 1000))
+
+		for i := 0; i < 10_000_000; i++ {
+			if us.GetState().GetExited() {
+				break
+			}
+			_, err := us.Step(false)
+			require.NoError(t, err)
+		}
+		require.True(t, state.Exited, "must complete program")
+		require.Equal(t, uint8(0), state.ExitCode, "exit with 0")
+		require.Contains(t, stdOutBuf.String(), "waitgroup result: 42")
+		require.Contains(t, stdOutBuf.String(), "channels result: 1234")
+		require.Equal(t, "", stdErrBuf.String(), "should not print any errors")
+		return us.GetSchedule()
+	}
+
+	schedule := run(t, 1)
+	require.True(t, containsInjectedPreemption(schedule), "should inject preemptions")
+	require.Equal(t, schedule, run(t, 1), "should replay the same schedule")
+	require.NotEqual(t, schedule, run(t, 2), "should perturb the schedule differently")
+}
+
+func TestInstrumentedState_InvalidPreemptionFuzzingInterval(t *testing.T) {
+	state := CreateEmptyState()
+	us := NewInstrumentedState(state, nil, os.Stdout, os.Stderr, testutil.CreateLogger(), nil)
+	require.Error(t, us.EnablePreemptionFuzzing(1, 0))
+}
+
+func containsInjectedPreemption(schedule []mipsevm.ScheduleEvent) bool {
+	for _, event := range schedule {
+		if event.Injected {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -188,6 +189,27 @@ func (s *State) GetMemory() *memory.Memory {
 	return s.Memory
 }
 
+func (s *State) Fork() mipsevm.FPVMState {
+	out := *s
+	out.Memory = s.Memory.Fork()
+	out.LeftThreadStack = forkThreadStack(s.LeftThreadStack)
+	out.RightThreadStack = forkThreadStack(s.RightThreadStack)
+	out.LastHint = slices.Clone(s.LastHint)
+	return &out
+}
+
+func forkThreadStack(stack []*ThreadState) []*ThreadState {
+	if stack == nil {
+		return nil
+	}
+	out := make([]*ThreadState, len(stack))
+	for i, thread := range stack {
+		t := *thread
+		out[i] = &t
+	}
+	return out
+}
+
 func (s *State) GetHeap() Word {
 	return s.Heap
 }
@@ -403,3 +403,26 @@ func TestThreadStateWitnessSize(t *testing.T) {
 	}
 	require.Equal(t, expectedWitnessSize, SERIALIZED_THREAD_SIZE)
 }
+
+func TestState_Fork(t *testing.T) {
+	state := CreateEmptyState()
+	state.Memory.SetWord(0x1000, 1)
+	state.LeftThreadStack = append(state.LeftThreadStack, &ThreadState{ThreadId: 1})
+	state.LastHint = hexutil.Bytes{1, 2, 3}
+	_, preHash := state.EncodeWitness()
+
+	child := state.Fork().(*State)
+	_, childHash := child.EncodeWitness()
+	require.Equal(t, preHash, childHash)
+
+	child.Memory.SetWord(0x1000, 2)
+	child.GetCurrentThread().Registers[1] = 3
+	child.LastHint[0] = 4
+	child.Step++
+
+	_, postHash := state.EncodeWitness()
+	require.Equal(t, preHash, postHash, "parent must not be modified by the child")
+	require.Equal(t, arch.Word(1), state.Memory.GetWord(0x1000))
+	require.Equal(t, arch.Word(2), child.Memory.GetWord(0x1000))
+	require.Equal(t, hexutil.Bytes{1, 2, 3}, state.LastHint)
+}
@@ -0,0 +1,35 @@
+package multithreaded
+
+import (
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+)
+
+// supportedSyscalls are the syscalls handled by handleSyscall, including the ones that are noops.
+var supportedSyscalls = func() map[Word]struct{} {
+	nums := []Word{
+		arch.SysMmap, arch.SysBrk, arch.SysClone, arch.SysExitGroup, arch.SysRead, arch.SysWrite, arch.SysFcntl,
+		arch.SysGetTID, arch.SysExit, arch.SysFutex, arch.SysSchedYield, arch.SysNanosleep, arch.SysOpen,
+		arch.SysClockGetTime, arch.SysGetpid,
+		// noops
+		arch.SysMunmap, arch.SysGetAffinity, arch.SysMadvise, arch.SysRtSigprocmask, arch.SysSigaltstack,
+		arch.SysRtSigaction, arch.SysPrlimit64, arch.SysClose, arch.SysPread64, arch.SysStat, arch.SysFstat,
+		arch.SysOpenAt, arch.SysReadlink, arch.SysReadlinkAt, arch.SysIoctl, arch.SysEpollCreate1, arch.SysPipe2,
+		arch.SysEpollCtl, arch.SysEpollPwait, arch.SysGetRandom, arch.SysUname, arch.SysGetuid, arch.SysGetgid,
+		arch.SysMinCore, arch.SysTgkill, arch.SysSetITimer, arch.SysTimerCreate, arch.SysTimerSetTime,
+		arch.SysTimerDelete, arch.SysGetRLimit, arch.SysLseek,
+	}
+	if arch.IsMips32 {
+		nums = append(nums, arch.SysFstat64, arch.SysStat64, arch.SysLlseek)
+	}
+	set := make(map[Word]struct{}, len(nums))
+	for _, num := range nums {
+		set[num] = struct{}{}
+	}
+	return set
+}()
+
+// IsSupportedSyscall returns whether the VM handles the syscall, rather than panicking on it.
+func IsSupportedSyscall(syscallNum Word) bool {
+	_, ok := supportedSyscalls[syscallNum]
+	return ok
+}
@@ -0,0 +1,41 @@
+package multithreaded
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/exec"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/register"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/testutil"
+)
+
+func TestSyscallAudit(t *testing.T) {
+	// Syscall numbers start at 4000 on MIPS32 and at 5000 on MIPS64
+	base := Word(arch.SysRead) / 1000 * 1000
+	for num := base; num < base+500; num++ {
+		state := CreateEmptyState()
+		testutil.StoreInstruction(state.Memory, 0, 0x0000000C) // syscall
+		state.GetRegistersRef()[register.RegSyscallNum] = num
+		vm := NewInstrumentedState(state, testutil.StaticOracle(t, nil), io.Discard, io.Discard, testutil.CreateLogger(), nil)
+		var unsupported []Word
+		vm.EnableSyscallAudit(func(syscallNum, pc Word) {
+			require.Zero(t, pc)
+			unsupported = append(unsupported, syscallNum)
+		})
+
+		_, err := vm.Step(false)
+		require.NoError(t, err)
+		if IsSupportedSyscall(num) {
+			require.Empty(t, unsupported, "syscall %d", num)
+			continue
+		}
+		require.Equal(t, []Word{num}, unsupported)
+		thread := state.GetCurrentThread()
+		require.Equal(t, exec.SysErrorSignal, thread.Registers[register.RegSyscallRet1])
+		require.Equal(t, Word(exec.MipsENOSYS), thread.Registers[register.RegSyscallErrno])
+		require.Equal(t, Word(4), thread.Cpu.PC)
+	}
+}
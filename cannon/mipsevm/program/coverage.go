@@ -0,0 +1,201 @@
+package program
+
+import (
+	"bufio"
+	"debug/dwarf"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"golang.org/x/exp/maps"
+)
+
+// instructionSize is the size in bytes of a MIPS instruction, for both the 32 and 64 bit architectures.
+const instructionSize = 4
+
+// Coverage records how often each instruction of a guest program was executed.
+type Coverage struct {
+	Hits map[Word]uint64 `json:"hits"`
+}
+
+func NewCoverage() *Coverage {
+	return &Coverage{Hits: make(map[Word]uint64)}
+}
+
+// Record registers an execution of the instruction at pc.
+func (c *Coverage) Record(pc Word) {
+	c.Hits[pc]++
+}
+
+// Merge adds the hits of other to the coverage, e.g. to combine the coverage of multiple runs.
+func (c *Coverage) Merge(other *Coverage) {
+	for pc, hits := range other.Hits {
+		c.Hits[pc] += hits
+	}
+}
+
+// FunctionCoverage is the number of times a function was entered.
+type FunctionCoverage struct {
+	Name string
+	Line int
+	Hits uint64
+}
+
+// FileCoverage is the coverage of a single source file of the guest program.
+type FileCoverage struct {
+	// Lines maps the line numbers with instructions to the number of times the line was executed.
+	Lines     map[int]uint64
+	Functions []FunctionCoverage
+}
+
+// lineRow is the source location of the instructions in [start, end).
+type lineRow struct {
+	start, end Word
+	file       string
+	line       int
+}
+
+// MakeSourceCoverage maps the executed instructions to source lines and functions,
+// using the DWARF line tables and the symbols of the guest program.
+// Returns the coverage per source file.
+func MakeSourceCoverage(elfProgram *elf.File, cov *Coverage) (map[string]*FileCoverage, error) {
+	rows, err := loadLineRows(elfProgram)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]*FileCoverage)
+	fileCoverage := func(name string) *FileCoverage {
+		f, ok := files[name]
+		if !ok {
+			f = &FileCoverage{Lines: make(map[int]uint64)}
+			files[name] = f
+		}
+		return f
+	}
+	for _, row := range rows {
+		var hits uint64
+		for addr := row.start; addr < row.end; addr += instructionSize {
+			hits = max(hits, cov.Hits[addr])
+		}
+		lines := fileCoverage(row.file).Lines
+		// A line may be split over multiple rows, e.g. a loop header. Count the most executed part of the line.
+		lines[row.line] = max(lines[row.line], hits)
+	}
+
+	syms, err := elfProgram.Symbols()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load symbols table: %w", err)
+	}
+	for _, sym := range syms {
+		if elf.ST_TYPE(sym.Info) != elf.STT_FUNC || sym.Size == 0 {
+			continue
+		}
+		start := Word(sym.Value)
+		i := sort.Search(len(rows), func(i int) bool {
+			return rows[i].end > start
+		})
+		if i == len(rows) || rows[i].start > start {
+			continue // no source information for this function
+		}
+		f := fileCoverage(rows[i].file)
+		f.Functions = append(f.Functions, FunctionCoverage{Name: sym.Name, Line: rows[i].line, Hits: cov.Hits[start]})
+	}
+	return files, nil
+}
+
+// loadLineRows reads the line tables of all compile units, sorted by address.
+func loadLineRows(elfProgram *elf.File) ([]lineRow, error) {
+	data, err := elfProgram.DWARF()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DWARF data: %w", err)
+	}
+	var rows []lineRow
+	r := data.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DWARF entry: %w", err)
+		}
+		if entry == nil {
+			break
+		}
+		if entry.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		lr, err := data.LineReader(entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line table: %w", err)
+		}
+		r.SkipChildren()
+		if lr == nil {
+			continue
+		}
+		var prev *dwarf.LineEntry
+		for {
+			var le dwarf.LineEntry
+			if err := lr.Next(&le); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to read line table entry: %w", err)
+			}
+			if prev != nil && !prev.EndSequence && prev.File != nil && le.Address > prev.Address {
+				rows = append(rows, lineRow{
+					start: Word(prev.Address),
+					end:   Word(le.Address),
+					file:  prev.File.Name,
+					line:  prev.Line,
+				})
+			}
+			prev = &le
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].start < rows[j].start
+	})
+	return rows, nil
+}
+
+// WriteLCOV writes the source coverage as an LCOV tracefile.
+func WriteLCOV(w io.Writer, files map[string]*FileCoverage) error {
+	// Write errors are sticky in the buffered writer, and returned when flushing.
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "TN:")
+	names := maps.Keys(files)
+	slices.Sort(names)
+	for _, name := range names {
+		f := files[name]
+		fmt.Fprintf(out, "SF:%s\n", name)
+		functions := slices.Clone(f.Functions)
+		sort.Slice(functions, func(i, j int) bool {
+			return functions[i].Line < functions[j].Line
+		})
+		functionsHit := 0
+		for _, fn := range functions {
+			fmt.Fprintf(out, "FN:%d,%s\n", fn.Line, fn.Name)
+		}
+		for _, fn := range functions {
+			fmt.Fprintf(out, "FNDA:%d,%s\n", fn.Hits, fn.Name)
+			if fn.Hits > 0 {
+				functionsHit++
+			}
+		}
+		fmt.Fprintf(out, "FNF:%d\nFNH:%d\n", len(functions), functionsHit)
+		linesHit := 0
+		lines := maps.Keys(f.Lines)
+		slices.Sort(lines)
+		for _, line := range lines {
+			hits := f.Lines[line]
+			fmt.Fprintf(out, "DA:%d,%d\n", line, hits)
+			if hits > 0 {
+				linesHit++
+			}
+		}
+		fmt.Fprintf(out, "LF:%d\nLH:%d\n", len(f.Lines), linesHit)
+		fmt.Fprintln(out, "end_of_record")
+	}
+	return out.Flush()
+}
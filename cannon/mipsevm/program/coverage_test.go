@@ -0,0 +1,73 @@
+package program
+
+import (
+	"bytes"
+	"debug/elf"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+)
+
+func TestCoverageMerge(t *testing.T) {
+	cov := NewCoverage()
+	cov.Record(0x1000)
+	cov.Record(0x1000)
+	cov.Record(0x1004)
+
+	other := NewCoverage()
+	other.Record(0x1004)
+	other.Record(0x2000)
+
+	cov.Merge(other)
+	require.Equal(t, map[Word]uint64{0x1000: 2, 0x1004: 2, 0x2000: 1}, cov.Hits)
+}
+
+func TestSourceCoverage(t *testing.T) {
+	path := "../../testdata/example/bin/hello.elf"
+	if !arch.IsMips32 {
+		path = "../../testdata/example/bin/hello.64.elf"
+	}
+	elfProgram, err := elf.Open(path)
+	require.NoError(t, err)
+	defer elfProgram.Close()
+
+	meta, err := MakeMetadata(elfProgram)
+	require.NoError(t, err)
+	var mainFn Symbol
+	for _, s := range meta.Symbols {
+		if s.Name == "main.main" {
+			mainFn = s
+		}
+	}
+	require.NotZero(t, mainFn.Size, "main.main not found")
+
+	// Execute every instruction of main.main once
+	cov := NewCoverage()
+	for pc := mainFn.Start; pc < mainFn.Start+mainFn.Size; pc += instructionSize {
+		cov.Record(pc)
+	}
+	files, err := MakeSourceCoverage(elfProgram, cov)
+	require.NoError(t, err)
+
+	var mainFile string
+	for name := range files {
+		if strings.HasSuffix(name, "hello/main.go") {
+			mainFile = name
+		}
+	}
+	require.NotEmpty(t, mainFile, "source file of main.main not found")
+	f := files[mainFile]
+	require.Contains(t, f.Functions, FunctionCoverage{Name: "main.main", Line: 5, Hits: 1})
+	require.Equal(t, uint64(1), f.Lines[6])
+
+	var out bytes.Buffer
+	require.NoError(t, WriteLCOV(&out, files))
+	report := out.String()
+	require.True(t, strings.HasPrefix(report, "TN:\n"))
+	require.Contains(t, report, "SF:"+mainFile+"\nFN:5,main.main\nFNDA:1,main.main\nFNF:1\nFNH:1\n")
+	require.Contains(t, report, "DA:6,1\n")
+	require.Equal(t, len(files), strings.Count(report, "end_of_record\n"))
+}
@@ -0,0 +1,101 @@
+package program
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+)
+
+// syscallLookback is the number of instructions before a syscall instruction that are searched
+// for the instruction setting the syscall number.
+const syscallLookback = 8
+
+// regSyscallNum is the register holding the syscall number, v0.
+const regSyscallNum = 2
+
+// SyscallSite is a syscall instruction of a program.
+type SyscallSite struct {
+	Addr Word
+	// Num is the syscall number, if Known is set.
+	// The number is known if it is set by a constant load right before the syscall instruction,
+	// as done by the raw syscall wrappers of the Go runtime. It is unknown for generic wrappers,
+	// like syscall.Syscall, that take the number as argument.
+	Num   Word
+	Known bool
+}
+
+// FindSyscalls statically scans the executable sections of the ELF program for syscall instructions.
+func FindSyscalls(elfProgram *elf.File) ([]SyscallSite, error) {
+	var sites []SyscallSite
+	for _, sec := range elfProgram.Sections {
+		if sec.Type != elf.SHT_PROGBITS || sec.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+		data, err := sec.Data()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read section %q: %w", sec.Name, err)
+		}
+		sites = append(sites, findSyscalls(data, Word(sec.Addr))...)
+	}
+	return sites, nil
+}
+
+func findSyscalls(code []byte, base Word) []SyscallSite {
+	var sites []SyscallSite
+	n := len(code) / instructionSize
+	insnAt := func(i int) uint32 {
+		return binary.BigEndian.Uint32(code[i*instructionSize:])
+	}
+	for i := 0; i < n; i++ {
+		if !isSyscall(insnAt(i)) {
+			continue
+		}
+		site := SyscallSite{Addr: base + Word(i*instructionSize)}
+		for j := i - 1; j >= 0 && j >= i-syscallLookback; j-- {
+			insn := insnAt(j)
+			if isSyscall(insn) {
+				break
+			}
+			if num, ok, writes := syscallNumLoad(insn); writes {
+				if ok {
+					site.Num, site.Known = num, true
+				}
+				break
+			}
+		}
+		sites = append(sites, site)
+	}
+	return sites
+}
+
+// isSyscall returns whether the instruction is a syscall instruction, ignoring its code field.
+func isSyscall(insn uint32) bool {
+	return insn&0xFC00003F == 0x0C
+}
+
+// syscallNumLoad returns whether the instruction writes the syscall number register,
+// and the number if the instruction loads a constant into it.
+func syscallNumLoad(insn uint32) (num Word, ok bool, writes bool) {
+	opcode := insn >> 26
+	rs := (insn >> 21) & 0x1F
+	rt := (insn >> 16) & 0x1F
+	rd := (insn >> 11) & 0x1F
+	switch {
+	case opcode == 0:
+		return 0, false, rd == regSyscallNum
+	case opcode == 0x09 || opcode == 0x19: // addiu, daddiu
+		if rt != regSyscallNum {
+			return 0, false, false
+		}
+		return Word(int16(insn)), rs == 0, true
+	case opcode == 0x0D: // ori
+		if rt != regSyscallNum {
+			return 0, false, false
+		}
+		return Word(uint16(insn)), rs == 0, true
+	case opcode >= 0x20 && opcode <= 0x27, opcode == 0x37: // loads
+		return 0, false, rt == regSyscallNum
+	default:
+		return 0, false, false
+	}
+}
@@ -0,0 +1,35 @@
+package program
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSyscalls(t *testing.T) {
+	insns := []uint32{
+		0x24021389, // addiu v0, zero, 5001
+		0x0000000C, // syscall
+		0x3402138A, // ori v0, zero, 5002
+		0x24040001, // addiu a0, zero, 1
+		0x000000CC, // syscall with code field
+		0xDFA20008, // ld v0, 8(sp)
+		0x0000000C, // syscall
+		0x24421389, // addiu v0, v0, 5001
+		0x0000000C, // syscall
+		0x0000000C, // syscall without number load
+	}
+	code := make([]byte, len(insns)*instructionSize)
+	for i, insn := range insns {
+		binary.BigEndian.PutUint32(code[i*instructionSize:], insn)
+	}
+	sites := findSyscalls(code, 0x1000)
+	require.Equal(t, []SyscallSite{
+		{Addr: 0x1004, Num: 5001, Known: true},
+		{Addr: 0x1010, Num: 5002, Known: true},
+		{Addr: 0x1018},
+		{Addr: 0x1020},
+		{Addr: 0x1024},
+	}, sites)
+}
@@ -125,6 +125,10 @@ func (m MockFPVMState) EncodeWitness() (witness []byte, hash common.Hash) {
 	panic("not implemented")
 }
 
+func (m MockFPVMState) Fork() mipsevm.FPVMState {
+	panic("not implemented")
+}
+
 func (m MockFPVMState) CreateVM(logger log.Logger, po mipsevm.PreimageOracle, stdOut, stdErr io.Writer, meta mipsevm.Metadata) mipsevm.FPVM {
 	panic("not implemented")
 }
@@ -0,0 +1,12 @@
+package mipsevm
+
+import "github.com/ethereum-optimism/optimism/cannon/mipsevm/arch"
+
+// ScheduleEvent is a switch of the active thread of the VM.
+type ScheduleEvent struct {
+	Step uint64    `json:"step"`
+	From arch.Word `json:"from"`
+	To   arch.Word `json:"to"`
+	// Injected is set if the switch is a preemption injected by preemption fuzzing
+	Injected bool `json:"injected,omitempty"`
+}
@@ -1,6 +1,7 @@
 package singlethreaded
 
 import (
+	"errors"
 	"io"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -58,6 +59,18 @@ func (m *InstrumentedState) EnableStats() {
 	//noop
 }
 
+func (m *InstrumentedState) EnableScheduleRecording() {
+	//noop
+}
+
+func (m *InstrumentedState) GetSchedule() []mipsevm.ScheduleEvent {
+	return nil
+}
+
+func (m *InstrumentedState) EnablePreemptionFuzzing(seed int64, meanInterval uint64) error {
+	return errors.New("preemption fuzzing is not supported by single-threaded VMs")
+}
+
 func (m *InstrumentedState) Step(proof bool) (wit *mipsevm.StepWitness, err error) {
 	m.preimageOracle.Reset()
 	m.memoryTracker.Reset(proof)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -154,6 +155,13 @@ func (s *State) GetMemory() *memory.Memory {
 	return s.Memory
 }
 
+func (s *State) Fork() mipsevm.FPVMState {
+	out := *s
+	out.Memory = s.Memory.Fork()
+	out.LastHint = slices.Clone(s.LastHint)
+	return &out
+}
+
 func (s *State) GetHeap() Word {
 	return s.Heap
 }
@@ -0,0 +1,151 @@
+package versions
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/serialize"
+)
+
+// bundleMagic prefixes every prestate bundle. Its first byte is not a valid StateVersion,
+// so bundles can't be mistaken for plain binary states.
+var bundleMagic = []byte("cannon-bundle-v1")
+
+var (
+	ErrNotBundle               = errors.New("not a prestate bundle")
+	ErrBundleVersionMismatch   = errors.New("bundle VM type does not match state")
+	ErrPrestateHashMismatch    = errors.New("prestate hash does not match state")
+	ErrBundleMetadataMalformed = errors.New("malformed bundle metadata")
+)
+
+// Bundle is a prestate proof bundle: the state snapshot, the metadata of the program the state was loaded from,
+// and the expected absolute prestate hash. The bundle is loaded and verified at once,
+// so a state is never run with the metadata of another program, or by a VM of another type.
+type Bundle struct {
+	State        *VersionedState
+	Meta         *program.Metadata
+	PrestateHash common.Hash
+}
+
+// NewBundle creates a bundle of the state and metadata, with the hash of the state as the expected prestate hash.
+func NewBundle(state *VersionedState, meta *program.Metadata) *Bundle {
+	_, hash := state.EncodeWitness()
+	return &Bundle{
+		State:        state,
+		Meta:         meta,
+		PrestateHash: hash,
+	}
+}
+
+// IsBundleFile returns true if the path has the file extension of a prestate bundle, ".bundle" or ".bundle.gz".
+func IsBundleFile(path string) bool {
+	return strings.HasSuffix(path, ".bundle") || strings.HasSuffix(path, ".bundle.gz")
+}
+
+// LoadBundleFromFile loads the prestate bundle at the path, and verifies that the hash of its state
+// matches the expected prestate hash.
+func LoadBundleFromFile(path string) (*Bundle, error) {
+	bundle, err := serialize.LoadSerializedBinary[Bundle](path)
+	if err != nil {
+		return nil, err
+	}
+	if err := bundle.Verify(); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// Verify checks that the hash of the state matches the expected prestate hash of the bundle.
+func (b *Bundle) Verify() error {
+	if _, hash := b.State.EncodeWitness(); hash != b.PrestateHash {
+		return fmt.Errorf("%w: expected %s but got %s", ErrPrestateHashMismatch, b.PrestateHash, hash)
+	}
+	return nil
+}
+
+// Serialize writes the bundle in the following format:
+//
+//	magic, VM type (state version), prestate hash, JSON metadata, binary state
+//
+// The VM type is repeated ahead of the metadata, so it can be detected without reading the whole bundle.
+func (b *Bundle) Serialize(w io.Writer) error {
+	if _, err := w.Write(bundleMagic); err != nil {
+		return err
+	}
+	bout := serialize.NewBinaryWriter(w)
+	if err := bout.WriteUInt(b.State.Version); err != nil {
+		return err
+	}
+	if err := bout.WriteHash(b.PrestateHash); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(b.Meta)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+	if err := bout.WriteBytes(meta); err != nil {
+		return err
+	}
+	return b.State.Serialize(w)
+}
+
+func (b *Bundle) Deserialize(in io.Reader) error {
+	version, err := readBundleHeader(in)
+	if err != nil {
+		return err
+	}
+	bin := serialize.NewBinaryReader(in)
+	if err := bin.ReadHash(&b.PrestateHash); err != nil {
+		return err
+	}
+	var meta []byte
+	if err := bin.ReadBytes(&meta); err != nil {
+		return err
+	}
+	b.Meta = new(program.Metadata)
+	if err := json.Unmarshal(meta, b.Meta); err != nil {
+		return fmt.Errorf("%w: %w", ErrBundleMetadataMalformed, err)
+	}
+	b.State = new(VersionedState)
+	if err := b.State.Deserialize(in); err != nil {
+		return err
+	}
+	if b.State.Version != version {
+		return fmt.Errorf("%w: bundle is for %s but state is %s", ErrBundleVersionMismatch, version, b.State.Version)
+	}
+	return nil
+}
+
+// readBundleHeader reads the magic and the VM type of a bundle.
+func readBundleHeader(in io.Reader) (StateVersion, error) {
+	magic := make([]byte, len(bundleMagic))
+	if _, err := io.ReadFull(in, magic); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrNotBundle, err)
+	}
+	if !bytes.Equal(magic, bundleMagic) {
+		return 0, ErrNotBundle
+	}
+	var version StateVersion
+	if err := serialize.NewBinaryReader(in).ReadUInt(&version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// detectBundleVersion returns the VM type of the bundle at the path.
+func detectBundleVersion(path string) (StateVersion, error) {
+	f, err := ioutil.OpenDecompressed(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open file %q: %w", path, err)
+	}
+	defer f.Close()
+	return readBundleHeader(f)
+}
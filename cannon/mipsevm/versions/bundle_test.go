@@ -0,0 +1,76 @@
+package versions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/multithreaded"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/program"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/serialize"
+)
+
+func TestBundle(t *testing.T) {
+	state, err := NewFromState(multithreaded.CreateEmptyState())
+	require.NoError(t, err)
+	meta := &program.Metadata{Symbols: []program.Symbol{{Name: "main", Start: 0x1000, Size: 0x20}}}
+	bundle := NewBundle(state, meta)
+	_, expectedHash := state.EncodeWitness()
+	require.Equal(t, expectedHash, bundle.PrestateHash)
+
+	for _, filename := range []string{"prestate.bundle", "prestate.bundle.gz"} {
+		t.Run(filename, func(t *testing.T) {
+			path := writeBundleToFile(t, filename, bundle)
+
+			version, err := DetectVersion(path)
+			require.NoError(t, err)
+			require.Equal(t, state.Version, version)
+
+			loaded, err := LoadBundleFromFile(path)
+			require.NoError(t, err)
+			require.Equal(t, bundle.PrestateHash, loaded.PrestateHash)
+			require.Equal(t, meta, loaded.Meta)
+			require.Equal(t, state.Version, loaded.State.Version)
+			_, loadedHash := loaded.State.EncodeWitness()
+			require.Equal(t, expectedHash, loadedHash)
+		})
+	}
+}
+
+func TestBundle_PrestateHashMismatch(t *testing.T) {
+	state, err := NewFromState(multithreaded.CreateEmptyState())
+	require.NoError(t, err)
+	bundle := NewBundle(state, &program.Metadata{})
+	bundle.PrestateHash[0] ^= 0xff
+	path := writeBundleToFile(t, "prestate.bundle", bundle)
+
+	_, err = LoadBundleFromFile(path)
+	require.ErrorIs(t, err, ErrPrestateHashMismatch)
+}
+
+func TestBundle_NotBundle(t *testing.T) {
+	state, err := NewFromState(multithreaded.CreateEmptyState())
+	require.NoError(t, err)
+	// A plain binary state with a bundle file extension
+	path := filepath.Join(t.TempDir(), "prestate.bundle")
+	require.NoError(t, serialize.WriteSerializedBinary(state, ioutil.ToAtomicFile(path, 0o644)))
+
+	_, err = LoadBundleFromFile(path)
+	require.ErrorIs(t, err, ErrNotBundle)
+	_, err = DetectVersion(path)
+	require.ErrorIs(t, err, ErrNotBundle)
+
+	empty := filepath.Join(t.TempDir(), "empty.bundle")
+	require.NoError(t, os.WriteFile(empty, nil, 0o644))
+	_, err = LoadBundleFromFile(empty)
+	require.ErrorIs(t, err, ErrNotBundle)
+}
+
+func writeBundleToFile(t *testing.T, filename string, bundle *Bundle) string {
+	path := filepath.Join(t.TempDir(), filename)
+	require.NoError(t, serialize.WriteSerializedBinary(bundle, ioutil.ToStdOutOrFileOrNoop(path, 0o644)))
+	return path
+}
@@ -0,0 +1,89 @@
+package versions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/singlethreaded"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum-optimism/optimism/op-service/serialize"
+)
+
+var (
+	ErrUnsupportedConversion = errors.New("unsupported state conversion")
+	ErrUnsupportedFormat     = errors.New("unsupported state format")
+)
+
+// upgrades lists, for each state version, the newer versions that a state can be upgraded to.
+// An upgrade is only permitted if the state encoding is unchanged and the newer version executes
+// every instruction supported by the older version the same way.
+var upgrades = map[StateVersion][]StateVersion{
+	// VersionSingleThreaded2 only adds support for the fcntl(F_GETFD) syscall
+	VersionSingleThreaded: {VersionSingleThreaded2},
+}
+
+// LoadStateForConversion loads the state from the file, retaining its version.
+// Unlike LoadStateFromFile, JSON states are loaded as VersionSingleThreaded, the only version that supports JSON.
+func LoadStateForConversion(path string) (*VersionedState, error) {
+	if serialize.IsBinaryFile(path) {
+		return serialize.LoadSerializedBinary[VersionedState](path)
+	}
+	state, err := jsonutil.LoadJSON[singlethreaded.State](path)
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedState{Version: VersionSingleThreaded, FPVMState: state}, nil
+}
+
+// LatestUpgrade returns the newest version that a state of the given version can be upgraded to,
+// or the version itself if it cannot be upgraded.
+func LatestUpgrade(ver StateVersion) StateVersion {
+	if targets := upgrades[ver]; len(targets) > 0 {
+		return targets[len(targets)-1]
+	}
+	return ver
+}
+
+// ConvertState converts the state to the target version.
+// The state is returned as is if it already has the target version.
+func ConvertState(state *VersionedState, target StateVersion) (*VersionedState, error) {
+	if state.Version == target {
+		return state, nil
+	}
+	if !slices.Contains(upgrades[state.Version], target) {
+		return nil, fmt.Errorf("%w: from %v to %v", ErrUnsupportedConversion, state.Version, target)
+	}
+	return &VersionedState{Version: target, FPVMState: state.FPVMState}, nil
+}
+
+// CheckFormat returns ErrUnsupportedFormat if a state of the given version can not be written to the path,
+// in the format selected by its file extension.
+// The binary format is supported by every version that can be deserialized, i.e. all but VersionSingleThreaded.
+// The JSON format does not record the state version and is only supported by the single threaded versions,
+// which share the same state encoding. JSON states are loaded for conversion as VersionSingleThreaded.
+func CheckFormat(ver StateVersion, path string) error {
+	if serialize.IsBinaryFile(path) {
+		if ver == VersionSingleThreaded {
+			return fmt.Errorf("%w: %v states can only be written as JSON", ErrUnsupportedFormat, ver)
+		}
+		return nil
+	}
+	if ver != VersionSingleThreaded && ver != VersionSingleThreaded2 {
+		return fmt.Errorf("%w: %v states can not be written as JSON", ErrUnsupportedFormat, ver)
+	}
+	return nil
+}
+
+// WriteConvertedState writes the state to the path, in the format selected by its file extension.
+func WriteConvertedState(path string, state *VersionedState, perm os.FileMode) error {
+	if err := CheckFormat(state.Version, path); err != nil {
+		return err
+	}
+	if serialize.IsBinaryFile(path) {
+		return serialize.Write(path, state, perm)
+	}
+	return jsonutil.WriteJSON(state.FPVMState, ioutil.ToStdOutOrFileOrNoop(path, perm))
+}
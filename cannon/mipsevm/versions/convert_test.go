@@ -0,0 +1,120 @@
+//go:build !cannon64
+// +build !cannon64
+
+package versions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/multithreaded"
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/singlethreaded"
+)
+
+func TestLoadStateForConversion(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		expected := &VersionedState{Version: VersionSingleThreaded, FPVMState: singlethreaded.CreateEmptyState()}
+		path := writeToFile(t, "state.json", expected)
+		actual, err := LoadStateForConversion(path)
+		require.NoError(t, err)
+		require.Equal(t, VersionSingleThreaded, actual.Version)
+		_, expectedHash := expected.EncodeWitness()
+		_, actualHash := actual.EncodeWitness()
+		require.Equal(t, expectedHash, actualHash)
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		expected, err := NewFromState(multithreaded.CreateEmptyState())
+		require.NoError(t, err)
+		path := writeToFile(t, "state.bin.gz", expected)
+		actual, err := LoadStateForConversion(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestConvertState(t *testing.T) {
+	t.Run("Upgrade", func(t *testing.T) {
+		state := &VersionedState{Version: VersionSingleThreaded, FPVMState: singlethreaded.CreateEmptyState()}
+		require.Equal(t, VersionSingleThreaded2, LatestUpgrade(state.Version))
+		converted, err := ConvertState(state, VersionSingleThreaded2)
+		require.NoError(t, err)
+		require.Equal(t, VersionSingleThreaded2, converted.Version)
+		require.Same(t, state.FPVMState, converted.FPVMState)
+
+		// the upgraded state round trips through the binary format
+		path := writeToFile(t, "state.bin.gz", converted)
+		actual, err := LoadStateForConversion(path)
+		require.NoError(t, err)
+		require.Equal(t, converted, actual)
+	})
+
+	t.Run("SameVersion", func(t *testing.T) {
+		state, err := NewFromState(multithreaded.CreateEmptyState())
+		require.NoError(t, err)
+		require.Equal(t, VersionMultiThreaded, LatestUpgrade(state.Version))
+		converted, err := ConvertState(state, VersionMultiThreaded)
+		require.NoError(t, err)
+		require.Same(t, state, converted)
+	})
+
+	t.Run("RejectDowngrade", func(t *testing.T) {
+		state, err := NewFromState(singlethreaded.CreateEmptyState())
+		require.NoError(t, err)
+		_, err = ConvertState(state, VersionSingleThreaded)
+		require.ErrorIs(t, err, ErrUnsupportedConversion)
+	})
+
+	t.Run("RejectChangingVM", func(t *testing.T) {
+		state, err := NewFromState(singlethreaded.CreateEmptyState())
+		require.NoError(t, err)
+		_, err = ConvertState(state, VersionMultiThreaded)
+		require.ErrorIs(t, err, ErrUnsupportedConversion)
+	})
+}
+
+func TestCheckFormat(t *testing.T) {
+	require.NoError(t, CheckFormat(VersionSingleThreaded, "state.json"))
+	require.NoError(t, CheckFormat(VersionSingleThreaded2, "state.json"))
+	require.NoError(t, CheckFormat(VersionSingleThreaded2, "state.bin.gz"))
+	require.NoError(t, CheckFormat(VersionMultiThreaded, "state.bin"))
+	require.ErrorIs(t, CheckFormat(VersionSingleThreaded, "state.bin"), ErrUnsupportedFormat)
+	require.ErrorIs(t, CheckFormat(VersionMultiThreaded, "state.json"), ErrUnsupportedFormat)
+}
+
+func TestWriteConvertedState(t *testing.T) {
+	t.Run("BinaryJSONRoundTrip", func(t *testing.T) {
+		state, err := NewFromState(singlethreaded.CreateEmptyState())
+		require.NoError(t, err)
+		binPath := writeToFile(t, "state.bin.gz", state)
+		loaded, err := LoadStateForConversion(binPath)
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		jsonPath := filepath.Join(dir, "state.json")
+		require.NoError(t, WriteConvertedState(jsonPath, loaded, 0o644))
+		fromJSON, err := LoadStateForConversion(jsonPath)
+		require.NoError(t, err)
+		require.Equal(t, VersionSingleThreaded, fromJSON.Version)
+
+		converted, err := ConvertState(fromJSON, LatestUpgrade(fromJSON.Version))
+		require.NoError(t, err)
+		roundTripPath := filepath.Join(dir, "state.bin.gz")
+		require.NoError(t, WriteConvertedState(roundTripPath, converted, 0o644))
+		actual, err := LoadStateForConversion(roundTripPath)
+		require.NoError(t, err)
+		require.Equal(t, state, actual)
+	})
+
+	t.Run("RejectUnsupportedFormat", func(t *testing.T) {
+		state, err := NewFromState(multithreaded.CreateEmptyState())
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "state.json")
+		require.ErrorIs(t, WriteConvertedState(path, state, 0o644), ErrUnsupportedFormat)
+		_, err = os.Stat(path)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
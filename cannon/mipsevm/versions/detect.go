@@ -9,21 +9,27 @@ import (
 )
 
 func DetectVersion(path string) (StateVersion, error) {
-	if !serialize.IsBinaryFile(path) {
+	var ver StateVersion
+	if IsBundleFile(path) {
+		var err error
+		ver, err = detectBundleVersion(path)
+		if err != nil {
+			return 0, err
+		}
+	} else if !serialize.IsBinaryFile(path) {
 		return VersionSingleThreaded, nil
-	}
+	} else {
+		var f io.ReadCloser
+		f, err := ioutil.OpenDecompressed(path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open file %q: %w", path, err)
+		}
+		defer f.Close()
 
-	var f io.ReadCloser
-	f, err := ioutil.OpenDecompressed(path)
-	if err != nil {
-		return 0, fmt.Errorf("failed to open file %q: %w", path, err)
-	}
-	defer f.Close()
-
-	var ver StateVersion
-	bin := serialize.NewBinaryReader(f)
-	if err := bin.ReadUInt(&ver); err != nil {
-		return 0, err
+		bin := serialize.NewBinaryReader(f)
+		if err := bin.ReadUInt(&ver); err != nil {
+			return 0, err
+		}
 	}
 
 	switch ver {
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm/versions"
+)
+
+func Debug(ctx *cli.Context) error {
+	if len(os.Args) == 3 && os.Args[2] == "--help" {
+		if err := list(); err != nil {
+			return err
+		}
+		fmt.Println("use `--input <valid input file> --help` to get more detailed help")
+		return nil
+	}
+
+	inputPath, err := parsePathFlag(os.Args[1:], "--input")
+	if err != nil {
+		return err
+	}
+	version, err := versions.DetectVersion(inputPath)
+	if err != nil {
+		return err
+	}
+	return ExecuteCannon(ctx.Context, os.Args[1:], version)
+}
+
+var DebugCommand = &cli.Command{
+	Name:            "debug",
+	Usage:           "Debug a VM state interactively, or with a script of debugger commands.",
+	Description:     "Debug a VM state interactively, or with a script of debugger commands. Supports breakpoints on addresses and symbols, memory watchpoints, single stepping and state inspection.",
+	Action:          Debug,
+	SkipFlagParsing: true,
+}
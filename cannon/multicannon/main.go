@@ -30,6 +30,7 @@ func main() {
 		LoadELFCommand,
 		WitnessCommand,
 		RunCommand,
+		DebugCommand,
 		ListCommand,
 	}
 	ctx := ctxinterrupt.WithCancelOnInterrupt(context.Background())
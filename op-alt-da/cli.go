@@ -16,6 +16,8 @@ var (
 	PutTimeoutFlagName            = altDAFlags("put-timeout")
 	GetTimeoutFlagName            = altDAFlags("get-timeout")
 	MaxConcurrentRequestsFlagName = altDAFlags("max-concurrent-da-requests")
+	ExtraDAServersFlagName        = altDAFlags("extra-da-servers")
+	ShardThresholdFlagName        = altDAFlags("shard-threshold")
 )
 
 // altDAFlags returns the flag names for altDA
@@ -77,6 +79,19 @@ func CLIFlags(envPrefix string, category string) []cli.Flag {
 			EnvVars:  altDAEnvs(envPrefix, "MAX_CONCURRENT_DA_REQUESTS"),
 			Category: category,
 		},
+		&cli.StringSliceFlag{
+			Name:     ExtraDAServersFlagName,
+			Usage:    "HTTP addresses of additional DA servers. If set, input is stored with all DA servers, and must be configured in the same order for the batcher and all nodes. Requires the MultiCommitment commitment type.",
+			EnvVars:  altDAEnvs(envPrefix, "EXTRA_DA_SERVERS"),
+			Category: category,
+		},
+		&cli.Uint64Flag{
+			Name:     ShardThresholdFlagName,
+			Usage:    "Number of DA servers required to retrieve input, when using multiple DA servers. Input is erasure coded into a shard per DA server if above 1, and fully replicated to every DA server if 1.",
+			Value:    1,
+			EnvVars:  altDAEnvs(envPrefix, "SHARD_THRESHOLD"),
+			Category: category,
+		},
 	}
 }
 
@@ -88,6 +103,8 @@ type CLIConfig struct {
 	PutTimeout            time.Duration
 	GetTimeout            time.Duration
 	MaxConcurrentRequests uint64
+	ExtraDAServerURLs     []string
+	ShardThreshold        uint64
 }
 
 func (c CLIConfig) Check() error {
@@ -98,12 +115,48 @@ func (c CLIConfig) Check() error {
 		if _, err := url.Parse(c.DAServerURL); err != nil {
 			return fmt.Errorf("DA server URL is invalid: %w", err)
 		}
+		for _, extra := range c.ExtraDAServerURLs {
+			if _, err := url.Parse(extra); err != nil {
+				return fmt.Errorf("extra DA server URL %q is invalid: %w", extra, err)
+			}
+		}
+		if c.UseMultipleDAServers() {
+			servers := uint64(1 + len(c.ExtraDAServerURLs))
+			if servers > maxShards {
+				return fmt.Errorf("too many DA servers: %d, max %d", servers, maxShards)
+			}
+			if c.ShardThreshold == 0 || c.ShardThreshold > servers {
+				return fmt.Errorf("shard threshold %d must be between 1 and the number of DA servers %d", c.ShardThreshold, servers)
+			}
+		}
 	}
 	return nil
 }
 
+// UseMultipleDAServers returns true if input is stored with multiple DA servers, using MultiCommitments.
+func (c CLIConfig) UseMultipleDAServers() bool {
+	return len(c.ExtraDAServerURLs) > 0
+}
+
 func (c CLIConfig) NewDAClient() *DAClient {
-	return &DAClient{url: c.DAServerURL, verify: c.VerifyOnRead, precompute: !c.GenericDA, getTimeout: c.GetTimeout, putTimeout: c.PutTimeout}
+	return c.newDAClient(c.DAServerURL)
+}
+
+func (c CLIConfig) newDAClient(url string) *DAClient {
+	return &DAClient{url: url, verify: c.VerifyOnRead, precompute: !c.GenericDA, getTimeout: c.GetTimeout, putTimeout: c.PutTimeout}
+}
+
+// NewDAStorage returns the client to store and retrieve input with the configured DA servers.
+// If multiple DA servers are configured, input is stored with all of them.
+func (c CLIConfig) NewDAStorage() (DAStorage, error) {
+	if !c.UseMultipleDAServers() {
+		return c.NewDAClient(), nil
+	}
+	clients := []DAStorage{c.NewDAClient()}
+	for _, extra := range c.ExtraDAServerURLs {
+		clients = append(clients, c.newDAClient(extra))
+	}
+	return NewMultiDAClient(clients, int(c.ShardThreshold), c.VerifyOnRead)
 }
 
 func ReadCLIConfig(c *cli.Context) CLIConfig {
@@ -115,5 +168,7 @@ func ReadCLIConfig(c *cli.Context) CLIConfig {
 		PutTimeout:            c.Duration(PutTimeoutFlagName),
 		GetTimeout:            c.Duration(GetTimeoutFlagName),
 		MaxConcurrentRequests: c.Uint64(MaxConcurrentRequestsFlagName),
+		ExtraDAServerURLs:     c.StringSlice(ExtraDAServersFlagName),
+		ShardThreshold:        c.Uint64(ShardThresholdFlagName),
 	}
 }
@@ -2,11 +2,13 @@ package altda
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive/params"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -25,6 +27,8 @@ func CommitmentTypeFromString(s string) (CommitmentType, error) {
 		return Keccak256CommitmentType, nil
 	case GenericCommitmentString:
 		return GenericCommitmentType, nil
+	case MultiCommitmentString:
+		return MultiCommitmentType, nil
 	default:
 		return 0, fmt.Errorf("invalid commitment type: %s", s)
 	}
@@ -33,11 +37,14 @@ func CommitmentTypeFromString(s string) (CommitmentType, error) {
 // CommitmentType describes the binary format of the commitment.
 // KeccakCommitmentType is the default commitment type for the centralized DA storage.
 // GenericCommitmentType indicates an opaque bytestring that the op-node never opens.
+// MultiCommitmentType bundles the commitments of the erasure coded shards stored with multiple DA servers.
 const (
 	Keccak256CommitmentType CommitmentType = 0
 	GenericCommitmentType   CommitmentType = 1
+	MultiCommitmentType     CommitmentType = 2
 	KeccakCommitmentString  string         = "KeccakCommitment"
 	GenericCommitmentString string         = "GenericCommitment"
+	MultiCommitmentString   string         = "MultiCommitment"
 )
 
 // CommitmentData is the binary representation of a commitment.
@@ -81,6 +88,12 @@ func DecodeCommitmentData(input []byte) (CommitmentData, error) {
 		return DecodeKeccak256(data)
 	case GenericCommitmentType:
 		return DecodeGenericCommitment(data)
+	case MultiCommitmentType:
+		comm, err := DecodeMultiCommitment(data)
+		if err != nil {
+			return nil, err
+		}
+		return comm, nil
 	default:
 		return nil, ErrInvalidCommitment
 	}
@@ -167,3 +180,96 @@ func (c GenericCommitment) Verify(input []byte) error {
 func (c GenericCommitment) String() string {
 	return hex.EncodeToString(c.Encode())
 }
+
+// MultiCommitment is an implementation of CommitmentData for input that is erasure coded into shards,
+// each stored with a different DA server. Any Threshold of the shards suffice to reconstruct the input.
+// With a threshold of 1, every DA server stores a full copy of the input.
+type MultiCommitment struct {
+	// Threshold is the number of shards required to reconstruct the input.
+	Threshold uint8
+	// Size is the size of the input in bytes.
+	Size uint32
+	// Hash is the keccak256 hash of the input, to verify the reconstructed input.
+	Hash common.Hash
+	// Shards are the commitments of the shards, in the order of the DA servers storing them.
+	Shards []CommitmentData
+}
+
+// multiCommitmentHeaderSize is the size of the threshold, size, hash and shard count fields.
+const multiCommitmentHeaderSize = 1 + 4 + common.HashLength + 1
+
+// DecodeMultiCommitment validates and decodes the commitment into a MultiCommitment.
+func DecodeMultiCommitment(commitment []byte) (*MultiCommitment, error) {
+	if len(commitment) < multiCommitmentHeaderSize {
+		return nil, ErrInvalidCommitment
+	}
+	c := &MultiCommitment{
+		Threshold: commitment[0],
+		Size:      binary.BigEndian.Uint32(commitment[1:5]),
+		Hash:      common.BytesToHash(commitment[5 : 5+common.HashLength]),
+	}
+	count := int(commitment[multiCommitmentHeaderSize-1])
+	if c.Threshold == 0 || int(c.Threshold) > count {
+		return nil, ErrInvalidCommitment
+	}
+	rest := commitment[multiCommitmentHeaderSize:]
+	for i := 0; i < count; i++ {
+		if len(rest) < 2 {
+			return nil, ErrInvalidCommitment
+		}
+		size := int(binary.BigEndian.Uint16(rest[:2]))
+		if len(rest) < 2+size {
+			return nil, ErrInvalidCommitment
+		}
+		shard, err := DecodeCommitmentData(rest[2 : 2+size])
+		if err != nil {
+			return nil, err
+		}
+		// Shards are stored with regular DA servers, and cannot be multi commitments themselves.
+		if shard.CommitmentType() == MultiCommitmentType {
+			return nil, ErrInvalidCommitment
+		}
+		c.Shards = append(c.Shards, shard)
+		rest = rest[2+size:]
+	}
+	if len(rest) != 0 {
+		return nil, ErrInvalidCommitment
+	}
+	return c, nil
+}
+
+// CommitmentType returns the commitment type of MultiCommitment.
+func (c *MultiCommitment) CommitmentType() CommitmentType {
+	return MultiCommitmentType
+}
+
+// Encode adds a commitment type prefix self describing the commitment.
+func (c *MultiCommitment) Encode() []byte {
+	out := []byte{byte(MultiCommitmentType), c.Threshold}
+	out = binary.BigEndian.AppendUint32(out, c.Size)
+	out = append(out, c.Hash.Bytes()...)
+	out = append(out, byte(len(c.Shards)))
+	for _, shard := range c.Shards {
+		enc := shard.Encode()
+		out = binary.BigEndian.AppendUint16(out, uint16(len(enc)))
+		out = append(out, enc...)
+	}
+	return out
+}
+
+// TxData adds an extra version byte to signal it's a commitment.
+func (c *MultiCommitment) TxData() []byte {
+	return append([]byte{params.DerivationVersion1}, c.Encode()...)
+}
+
+// Verify checks if the commitment matches the given reconstructed input.
+func (c *MultiCommitment) Verify(input []byte) error {
+	if uint32(len(input)) != c.Size || crypto.Keccak256Hash(input) != c.Hash {
+		return ErrCommitmentMismatch
+	}
+	return nil
+}
+
+func (c *MultiCommitment) String() string {
+	return hex.EncodeToString(c.Encode())
+}
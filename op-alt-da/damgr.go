@@ -38,6 +38,11 @@ type L1Fetcher interface {
 	L1BlockRefByNumber(context.Context, uint64) (eth.L1BlockRef, error)
 }
 
+// L1BlockRefFetcher fetches L1 block references by number.
+type L1BlockRefFetcher interface {
+	L1BlockRefByNumber(context.Context, uint64) (eth.L1BlockRef, error)
+}
+
 // DAStorage interface for calling the DA storage server.
 type DAStorage interface {
 	GetInput(ctx context.Context, key CommitmentData) ([]byte, error)
@@ -78,8 +83,12 @@ type DA struct {
 }
 
 // NewAltDA creates a new AltDA instance with the given log and CLIConfig.
-func NewAltDA(log log.Logger, cli CLIConfig, cfg Config, metrics Metricer) *DA {
-	return NewAltDAWithStorage(log, cfg, cli.NewDAClient(), metrics)
+func NewAltDA(log log.Logger, cli CLIConfig, cfg Config, metrics Metricer) (*DA, error) {
+	storage, err := cli.NewDAStorage()
+	if err != nil {
+		return nil, err
+	}
+	return NewAltDAWithStorage(log, cfg, storage, metrics), nil
 }
 
 // NewAltDAWithStorage creates a new AltDA instance with the given log and DAStorage interface.
@@ -111,35 +120,28 @@ func (d *DA) OnFinalizedHeadSignal(f HeadSignalFn) {
 	d.finalizedHeadSignalHandler = f
 }
 
-// updateFinalizedHead sets the finalized head and prunes the state to the L1 Finalized head.
-// the finalized head is set to the latest reference pruned in this way.
-// It is called by the Finalize function, as it has an L1 finalized head to use.
-func (d *DA) updateFinalizedHead(l1Finalized eth.L1BlockRef) {
+// Finalize computes the altDA finalized head from the L1 finalized head, and calls the handler function if set.
+// An L1 block is final in altDA mode once it is final on L1, and all commitments included up to it are final:
+// the challenge window of unchallenged commitments, and the resolve window of challenged commitments, must have
+// ended by the L1 finalized head. Commitments that were challenged successfully, i.e. of which the challenge
+// expired without resolution, are final as well, as the derived L2 blocks are reorged when the challenge expires.
+// Challenges and commitments are only known up to the challenge and commitment origins, so the finalized head
+// never advances beyond these origins.
+func (d *DA) Finalize(ctx context.Context, l1 L1BlockRefFetcher, l1Finalized eth.L1BlockRef) error {
 	d.l1FinalizedHead = l1Finalized
-	// Prune the state to the finalized head
+	final := min(l1Finalized.Number, d.challengeOrigin.Number, d.commitmentOrigin.Number)
+	// The finalized number must be computed before pruning, which drops challenges that end before the L1 finalized head.
+	num, ok := d.state.FinalizedNumber(final)
 	d.state.Prune(l1Finalized.ID())
-	d.finalizedHead = d.state.lastPrunedCommitment
-}
-
-// updateFinalizedFromL1 updates the finalized head based on the challenge window.
-// it uses the L1 fetcher to get the block reference at the finalized head - challenge window.
-// It is called in AdvanceL1Origin if there are no commitments to finalize, as it has an L1 fetcher to use.
-func (d *DA) updateFinalizedFromL1(ctx context.Context, l1 L1Fetcher) error {
-	// don't update if the finalized head is smaller than the challenge window
-	if d.l1FinalizedHead.Number < d.cfg.ChallengeWindow {
+	if !ok || (d.finalizedHead != (eth.L1BlockRef{}) && num <= d.finalizedHead.Number) {
+		d.log.Debug("altDA finalized head unchanged", "l1", l1Finalized, "altDA", d.finalizedHead)
 		return nil
 	}
-	ref, err := l1.L1BlockRefByNumber(ctx, d.l1FinalizedHead.Number-d.cfg.ChallengeWindow)
+	ref, err := l1.L1BlockRefByNumber(ctx, num)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to fetch altDA finalized block %d: %w", num, err)
 	}
 	d.finalizedHead = ref
-	return nil
-}
-
-// Finalize sets the L1 finalized head signal and calls the handler function if set.
-func (d *DA) Finalize(l1Finalized eth.L1BlockRef) {
-	d.updateFinalizedHead(l1Finalized)
 	d.metrics.RecordChallengesHead("finalized", d.finalizedHead.Number)
 
 	// Record and Log the latest L1 finalized head
@@ -151,9 +153,10 @@ func (d *DA) Finalize(l1Finalized eth.L1BlockRef) {
 	// the handler function is called with the altDA finalized head
 	if d.finalizedHeadSignalHandler == nil {
 		d.log.Warn("finalized head signal handler not set")
-		return
+		return nil
 	}
 	d.finalizedHeadSignalHandler(d.finalizedHead)
+	return nil
 }
 
 // LookAhead increments the challenges origin and process the new block if it exists.
@@ -209,7 +212,7 @@ func (d *DA) GetInput(ctx context.Context, l1 L1Fetcher, comm CommitmentData, bl
 
 	// Fetch the input from the DA storage.
 	data, err := d.storage.GetInput(ctx, comm)
-	notFound := errors.Is(ErrNotFound, err)
+	notFound := errors.Is(err, ErrNotFound)
 	if err != nil && !notFound {
 		d.log.Error("failed to get preimage", "err", err)
 		// the storage client request failed for some other reason
@@ -240,8 +243,8 @@ func (d *DA) GetInput(ctx context.Context, l1 L1Fetcher, comm CommitmentData, bl
 			}
 			return nil, ErrPendingChallenge
 		case ChallengeResolved:
-			// Generic Commitments don't resolve from L1 so if we still can't find the data we're out of luck
-			if comm.CommitmentType() == GenericCommitmentType {
+			// Generic and multi commitments don't resolve from L1 so if we still can't find the data we're out of luck
+			if comm.CommitmentType() == GenericCommitmentType || comm.CommitmentType() == MultiCommitmentType {
 				return nil, ErrMissingPastWindow
 			}
 			// Keccak commitments resolve from L1, so we should have the data in the challenge resolved input
@@ -305,8 +308,7 @@ func (d *DA) AdvanceCommitmentOrigin(ctx context.Context, l1 L1Fetcher, block et
 }
 
 // AdvanceL1Origin syncs any challenge events included in the l1 block, expires any active challenges
-// after the new resolveWindow, computes and signals the new finalized head and sets the l1 block
-// as the new head for tracking challenges and commitments. If forwards an error if any new challenge have expired to
+// after the new resolveWindow and sets the l1 block as the new head for tracking challenges and commitments. If forwards an error if any new challenge have expired to
 // trigger a derivation reset.
 func (d *DA) AdvanceL1Origin(ctx context.Context, l1 L1Fetcher, block eth.BlockID) error {
 	if err := d.AdvanceChallengeOrigin(ctx, l1, block); err != nil {
@@ -315,14 +317,6 @@ func (d *DA) AdvanceL1Origin(ctx context.Context, l1 L1Fetcher, block eth.BlockI
 	if err := d.AdvanceCommitmentOrigin(ctx, l1, block); err != nil {
 		return fmt.Errorf("failed to advance commitment origin: %w", err)
 	}
-	// if there are no commitments, we can calculate the finalized head based on the challenge window
-	// otherwise, the finalization signal is used to set the finalized head
-	if d.state.NoCommitments() {
-		if err := d.updateFinalizedFromL1(ctx, l1); err != nil {
-			return err
-		}
-		d.metrics.RecordChallengesHead("finalized", d.finalizedHead.Number)
-	}
 	return nil
 }
 
@@ -397,7 +391,7 @@ func (d *DA) loadChallengeEvents(ctx context.Context, l1 L1Fetcher, block eth.Bl
 func (d *DA) fetchChallengeLogs(ctx context.Context, l1 L1Fetcher, block eth.BlockID) ([]*types.Log, error) {
 	var logs []*types.Log
 	// Don't look at the challenge contract if there is no challenge contract.
-	if d.cfg.CommitmentType == GenericCommitmentType {
+	if d.cfg.CommitmentType != Keccak256CommitmentType {
 		return logs, nil
 	}
 	//cached with deposits events call so not expensive
@@ -53,12 +53,12 @@ func TestFinalization(t *testing.T) {
 	require.NoError(t, state.ExpireCommitments(bID(8)))
 	require.Empty(t, state.commitments)
 
-	state.Prune(bID(bn1))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
-	state.Prune(bID(7))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
+	// The commitment is final once its challenge window ended
+	requireFinalizedNumber(t, state, 5, 0, false)
+	requireFinalizedNumber(t, state, 7, 1, true)
+	requireFinalizedNumber(t, state, 8, bn1, true)
 	state.Prune(bID(8))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
+	require.Empty(t, state.expiredCommitments)
 
 	// Track a commitment, challenge it, & then resolve it
 	c2 := RandomCommitment(rng)
@@ -82,13 +82,24 @@ func TestFinalization(t *testing.T) {
 	state.ExpireChallenges(bID(30))
 	require.Empty(t, state.challenges)
 
+	// The challenged commitment is final once its resolve window ended, even though it was resolved earlier
+	requireFinalizedNumber(t, state, 28, bn2-1, true)
+	requireFinalizedNumber(t, state, 29, bn2-1, true)
+	requireFinalizedNumber(t, state, 30, 24, true)
+
 	// Now finalize everything
-	state.Prune(bID(20))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
 	state.Prune(bID(28))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
+	require.Len(t, state.expiredCommitments, 1)
 	state.Prune(bID(32))
-	require.Equal(t, eth.L1BlockRef{Number: bn2}, state.lastPrunedCommitment)
+	require.Empty(t, state.expiredCommitments)
+	require.Empty(t, state.expiredChallenges)
+	requireFinalizedNumber(t, state, 32, 26, true)
+}
+
+func requireFinalizedNumber(t *testing.T, state *State, final uint64, expected uint64, expectedOk bool) {
+	num, ok := state.FinalizedNumber(final)
+	require.Equal(t, expectedOk, ok)
+	require.Equal(t, expected, num)
 }
 
 // TestExpireChallenges expires challenges and prunes the state for longer windows
@@ -176,7 +187,7 @@ func TestDAChallengeDetached(t *testing.T) {
 
 	// pruning finalized block is safe. It should not prune any commitments yet.
 	state.Prune(bID(1))
-	require.Equal(t, eth.L1BlockRef{}, state.lastPrunedCommitment)
+	require.Len(t, state.expiredCommitments, 1)
 
 	// Perform reorg back to bn2
 	state.ClearCommitments()
@@ -302,3 +313,56 @@ func TestAdvanceChallengeOrigin(t *testing.T) {
 	_, has = state.GetChallenge(comm, 14)
 	require.False(t, has)
 }
+
+func TestFinalize(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelWarn)
+	ctx := context.Background()
+	cfg := Config{
+		ChallengeWindow: 6,
+		ResolveWindow:   6,
+	}
+	rng := rand.New(rand.NewSource(1234))
+
+	l1F := &mockL1Fetcher{}
+	defer l1F.AssertExpectations(t)
+
+	state := NewState(logger, &NoopMetrics{}, cfg)
+	da := NewAltDAWithState(logger, cfg, NewMockDAClient(logger), &NoopMetrics{}, state)
+	var signaled []eth.L1BlockRef
+	da.OnFinalizedHeadSignal(func(ref eth.L1BlockRef) {
+		signaled = append(signaled, ref)
+	})
+	refAt := func(n uint64) eth.L1BlockRef {
+		return eth.L1BlockRef{Number: n, Hash: common.Hash{byte(n)}}
+	}
+
+	// No commitments: finality trails the L1 finalized head by the challenge window
+	da.challengeOrigin = bID(20)
+	da.commitmentOrigin = bID(20)
+	l1F.ExpectL1BlockRefByNumber(4, refAt(4), nil)
+	require.NoError(t, da.Finalize(ctx, l1F, refAt(10)))
+	require.Equal(t, []eth.L1BlockRef{refAt(4)}, signaled)
+
+	// Finality does not advance beyond the origins of the challenges and commitments
+	da.commitmentOrigin = bID(12)
+	l1F.ExpectL1BlockRefByNumber(6, refAt(6), nil)
+	require.NoError(t, da.Finalize(ctx, l1F, refAt(16)))
+	require.Equal(t, refAt(6), signaled[len(signaled)-1])
+
+	// An active challenge holds back finality until its resolve window ended
+	c := RandomCommitment(rng)
+	state.TrackCommitment(c, l1Ref(8))
+	state.CreateChallenge(c, bID(12), 8)
+	da.commitmentOrigin = bID(20)
+	l1F.ExpectL1BlockRefByNumber(7, refAt(7), nil)
+	require.NoError(t, da.Finalize(ctx, l1F, refAt(17)))
+	require.Equal(t, refAt(7), signaled[len(signaled)-1])
+
+	// Finality is unchanged, so no signal
+	require.NoError(t, da.Finalize(ctx, l1F, refAt(17)))
+	require.Len(t, signaled, 3)
+
+	l1F.ExpectL1BlockRefByNumber(12, refAt(12), nil)
+	require.NoError(t, da.Finalize(ctx, l1F, refAt(18)))
+	require.Equal(t, refAt(12), signaled[len(signaled)-1])
+}
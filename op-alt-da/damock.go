@@ -94,7 +94,8 @@ func (d *AltDADisabled) Reset(ctx context.Context, base eth.L1BlockRef, baseCfg
 	return io.EOF
 }
 
-func (d *AltDADisabled) Finalize(ref eth.L1BlockRef) {
+func (d *AltDADisabled) Finalize(ctx context.Context, l1 L1BlockRefFetcher, ref eth.L1BlockRef) error {
+	return nil
 }
 
 func (d *AltDADisabled) OnFinalizedHeadSignal(f HeadSignalFn) {
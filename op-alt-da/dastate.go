@@ -52,15 +52,14 @@ func challengeKey(comm CommitmentData, inclusionBlockNumber uint64) string {
 // In the special case of a L2 reorg, challenges are still tracked but commitments are removed.
 // This will allow the altDA fetcher to find the expired challenge.
 type State struct {
-	commitments          []Commitment          // commitments where the challenge/resolve period has not expired yet
-	expiredCommitments   []Commitment          // commitments where the challenge/resolve period has expired but not finalized
-	challenges           []*Challenge          // challenges ordered by L1 inclusion
-	expiredChallenges    []*Challenge          // challenges ordered by L1 inclusion
-	challengesMap        map[string]*Challenge // challenges by serialized comm + block number for easy lookup
-	lastPrunedCommitment eth.L1BlockRef        // the last commitment to be pruned
-	cfg                  Config
-	log                  log.Logger
-	metrics              Metricer
+	commitments        []Commitment          // commitments where the challenge/resolve period has not expired yet
+	expiredCommitments []Commitment          // commitments where the challenge/resolve period has expired but not finalized
+	challenges         []*Challenge          // challenges ordered by L1 inclusion
+	expiredChallenges  []*Challenge          // challenges ordered by L1 inclusion
+	challengesMap      map[string]*Challenge // challenges by serialized comm + block number for easy lookup
+	cfg                Config
+	log                log.Logger
+	metrics            Metricer
 }
 
 func NewState(log log.Logger, m Metricer, cfg Config) *State {
@@ -206,6 +205,30 @@ func (s *State) ExpireChallenges(origin eth.BlockID) {
 	}
 }
 
+// FinalizedNumber returns the highest L1 block number up to which all commitments are final, if the challenge
+// and resolve windows ending up to the final L1 block number have passed. Commitments included up to the final
+// number minus the challenge window can't be challenged anymore. Challenged commitments are final once their
+// resolve window ends, whether the challenge was resolved or not. It returns false if no L1 block is final yet.
+func (s *State) FinalizedNumber(final uint64) (uint64, bool) {
+	if final < s.cfg.ChallengeWindow {
+		return 0, false
+	}
+	num := final - s.cfg.ChallengeWindow
+	for _, challenges := range [][]*Challenge{s.challenges, s.expiredChallenges} {
+		for _, c := range challenges {
+			if c.resolveWindowEnd <= final || c.commInclusionBlockNumber > num {
+				continue
+			}
+			// The challenge can still be resolved, so the commitment and later L1 blocks are not final yet.
+			if c.commInclusionBlockNumber == 0 {
+				return 0, false
+			}
+			num = c.commInclusionBlockNumber - 1
+		}
+	}
+	return num, true
+}
+
 // Prune removes challenges & commitments which have an expiry block number beyond the given block number.
 func (s *State) Prune(origin eth.BlockID) {
 	// Commitments rely on challenges, so we prune commitments first.
@@ -234,9 +257,6 @@ func (s *State) pruneCommitments(origin eth.BlockID) {
 
 		// Remove the commitment
 		s.expiredCommitments = s.expiredCommitments[1:]
-
-		// Record the latest inclusion block to be returned
-		s.lastPrunedCommitment = c.inclusionBlock
 	}
 }
 
@@ -0,0 +1,162 @@
+package altda
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooFewShards is returned when fewer shards are available than required to reconstruct the data.
+var ErrTooFewShards = errors.New("too few shards to reconstruct data")
+
+// maxShards is the maximum number of shards of the erasure code, bounded by the size of GF(2^8).
+const maxShards = 128
+
+// Arithmetic over GF(2^8), with the reducing polynomial x^8 + x^4 + x^3 + x^2 + 1.
+var gfExp, gfLog = func() ([512]byte, [256]byte) {
+	var exp [512]byte
+	var lg [256]byte
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		lg[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	for i := 255; i < len(exp); i++ {
+		exp[i] = exp[i-255]
+	}
+	return exp, lg
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfInv(a byte) byte {
+	if a == 0 {
+		panic("inverse of zero")
+	}
+	return gfExp[255-int(gfLog[a])]
+}
+
+// erasureRow returns the row of the systematic encoding matrix that produces the given shard.
+// The first k rows form the identity matrix, so the data shards are the data itself. The other rows
+// form a Cauchy matrix, of which every square sub-matrix is invertible, so any k shards suffice to
+// reconstruct the data.
+func erasureRow(k int, shard int) []byte {
+	row := make([]byte, k)
+	if shard < k {
+		row[shard] = 1
+		return row
+	}
+	for i := range row {
+		// The elements x = shard and y = i are distinct, since shard >= k > i.
+		row[i] = gfInv(byte(shard) ^ byte(i))
+	}
+	return row
+}
+
+// erasureEncode splits the data into k data shards, and extends them with n-k parity shards.
+// All shards are of equal size, the last data shard is padded with zeroes.
+func erasureEncode(data []byte, k int, n int) ([][]byte, error) {
+	if k < 1 || k > n || n > maxShards {
+		return nil, fmt.Errorf("invalid erasure code parameters: %d of %d shards", k, n)
+	}
+	shardSize := (len(data) + k - 1) / k
+	shards := make([][]byte, n)
+	for i := 0; i < k; i++ {
+		shards[i] = make([]byte, shardSize)
+		if start := i * shardSize; start < len(data) {
+			copy(shards[i], data[start:])
+		}
+	}
+	for j := k; j < n; j++ {
+		shards[j] = make([]byte, shardSize)
+		for i, c := range erasureRow(k, j) {
+			for b := 0; b < shardSize; b++ {
+				shards[j][b] ^= gfMul(c, shards[i][b])
+			}
+		}
+	}
+	return shards, nil
+}
+
+// erasureDecode reconstructs the data of the given size from any k of the n shards.
+// Missing shards are nil.
+func erasureDecode(shards [][]byte, k int, size int) ([]byte, error) {
+	// Select the first k available shards, and the rows of the encoding matrix that produced them.
+	var rows [][]byte
+	var available [][]byte
+	for j, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if len(available) > 0 && len(shard) != len(available[0]) {
+			return nil, fmt.Errorf("shard %d has size %d, expected %d", j, len(shard), len(available[0]))
+		}
+		rows = append(rows, erasureRow(k, j))
+		available = append(available, shard)
+		if len(available) == k {
+			break
+		}
+	}
+	if len(available) < k {
+		return nil, fmt.Errorf("%w: have %d, need %d", ErrTooFewShards, len(available), k)
+	}
+	shardSize := len(available[0])
+	if size > k*shardSize {
+		return nil, fmt.Errorf("data size %d exceeds the size of the shards", size)
+	}
+
+	// Invert the selected rows by Gauss-Jordan elimination, applying the same operations to the shards.
+	for col := 0; col < k; col++ {
+		pivot := col
+		for pivot < k && rows[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == k {
+			return nil, errors.New("singular erasure matrix")
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+		available[col], available[pivot] = available[pivot], available[col]
+
+		scale := gfInv(rows[col][col])
+		rows[col] = scaleRow(rows[col], scale)
+		available[col] = scaleRow(available[col], scale)
+		for r := 0; r < k; r++ {
+			if f := rows[r][col]; r != col && f != 0 {
+				rows[r] = addScaledRow(rows[r], rows[col], f)
+				available[r] = addScaledRow(available[r], available[col], f)
+			}
+		}
+	}
+
+	data := make([]byte, 0, k*shardSize)
+	for _, shard := range available {
+		data = append(data, shard...)
+	}
+	return data[:size], nil
+}
+
+// scaleRow returns a copy of row, multiplied by c.
+func scaleRow(row []byte, c byte) []byte {
+	out := make([]byte, len(row))
+	for i, v := range row {
+		out[i] = gfMul(c, v)
+	}
+	return out
+}
+
+// addScaledRow returns a copy of dst, to which src multiplied by c is added.
+func addScaledRow(dst []byte, src []byte, c byte) []byte {
+	out := make([]byte, len(dst))
+	for i, v := range dst {
+		out[i] = v ^ gfMul(c, src[i])
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package altda
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErasureCode(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	for _, tc := range []struct{ k, n, size int }{
+		{k: 1, n: 1, size: 100},
+		{k: 2, n: 3, size: 1001},
+		{k: 3, n: 5, size: 3},
+		{k: 4, n: 7, size: 4096},
+	} {
+		data := RandomData(rng, tc.size)
+		shards, err := erasureEncode(data, tc.k, tc.n)
+		require.NoError(t, err)
+		require.Len(t, shards, tc.n)
+
+		// Every subset of k shards reconstructs the data.
+		for mask := 0; mask < 1<<tc.n; mask++ {
+			available := make([][]byte, tc.n)
+			count := 0
+			for i := range shards {
+				if mask&(1<<i) != 0 {
+					available[i] = shards[i]
+					count++
+				}
+			}
+			decoded, err := erasureDecode(available, tc.k, tc.size)
+			if count < tc.k {
+				require.ErrorIs(t, err, ErrTooFewShards)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, data, decoded, "k=%d n=%d shards=%b", tc.k, tc.n, mask)
+		}
+	}
+}
+
+func TestErasureEncodeInvalidParams(t *testing.T) {
+	_, err := erasureEncode([]byte{1}, 0, 2)
+	require.Error(t, err)
+	_, err = erasureEncode([]byte{1}, 3, 2)
+	require.Error(t, err)
+	_, err = erasureEncode([]byte{1}, 2, maxShards+1)
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package altda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/sync/errgroup"
+)
+
+// MultiDAClient stores input with multiple DA servers, to keep the input available if some of them are not.
+// The input is erasure coded into one shard per DA server, of which any threshold shards suffice to
+// reconstruct the input. With a threshold of 1, every DA server stores a full copy of the input.
+type MultiDAClient struct {
+	clients   []DAStorage
+	threshold int
+	// verify sets the client to verify the reconstructed input against the commitment on read.
+	verify bool
+}
+
+var _ DAStorage = (*MultiDAClient)(nil)
+
+func NewMultiDAClient(clients []DAStorage, threshold int, verify bool) (*MultiDAClient, error) {
+	if len(clients) > maxShards {
+		return nil, fmt.Errorf("too many DA servers: %d, max %d", len(clients), maxShards)
+	}
+	if threshold < 1 || threshold > len(clients) {
+		return nil, fmt.Errorf("invalid threshold %d for %d DA servers", threshold, len(clients))
+	}
+	return &MultiDAClient{
+		clients:   clients,
+		threshold: threshold,
+		verify:    verify,
+	}, nil
+}
+
+// SetInput stores a shard of the input with every DA server, and returns the MultiCommitment of all shards.
+// It fails if any of the DA servers fails to store its shard, so the input is never committed with less
+// redundancy than configured.
+func (c *MultiDAClient) SetInput(ctx context.Context, img []byte) (CommitmentData, error) {
+	if len(img) == 0 {
+		return nil, ErrInvalidInput
+	}
+	shards, err := c.encode(img)
+	if err != nil {
+		return nil, err
+	}
+	comms := make([]CommitmentData, len(c.clients))
+	var g errgroup.Group
+	for i, client := range c.clients {
+		g.Go(func() error {
+			comm, err := client.SetInput(ctx, shards[i])
+			if err != nil {
+				return fmt.Errorf("failed to store shard %d: %w", i, err)
+			}
+			comms[i] = comm
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &MultiCommitment{
+		Threshold: uint8(c.threshold),
+		Size:      uint32(len(img)),
+		Hash:      crypto.Keccak256Hash(img),
+		Shards:    comms,
+	}, nil
+}
+
+func (c *MultiDAClient) encode(img []byte) ([][]byte, error) {
+	if c.threshold == 1 {
+		shards := make([][]byte, len(c.clients))
+		for i := range shards {
+			shards[i] = img
+		}
+		return shards, nil
+	}
+	return erasureEncode(img, c.threshold, len(c.clients))
+}
+
+// GetInput retrieves the shards from the DA servers, until enough shards are available to reconstruct the input.
+// The shards are assigned to the DA servers in the order they are configured, which must match the order
+// of the DA servers the input was stored with.
+func (c *MultiDAClient) GetInput(ctx context.Context, key CommitmentData) ([]byte, error) {
+	comm, ok := key.(*MultiCommitment)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected multi commitment, got type %d", ErrInvalidCommitment, key.CommitmentType())
+	}
+	if len(comm.Shards) != len(c.clients) {
+		return nil, fmt.Errorf("%w: commitment has %d shards, but %d DA servers are configured", ErrInvalidCommitment, len(comm.Shards), len(c.clients))
+	}
+	threshold := int(comm.Threshold)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu        sync.Mutex
+		shards    = make([][]byte, len(comm.Shards))
+		available int
+		errs      []error
+		wg        sync.WaitGroup
+	)
+	for i, client := range c.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shard, err := client.GetInput(ctx, comm.Shards[i])
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("shard %d: %w", i, err))
+				return
+			}
+			shards[i] = shard
+			available++
+			if available == threshold {
+				// Enough shards to reconstruct the input, abort the remaining requests.
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if available < threshold {
+		err := errors.Join(errs...)
+		// Only report the input as not found if it is missing, rather than temporarily unavailable.
+		if countNotFound(errs) > len(c.clients)-threshold {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrTooFewShards, err)
+	}
+
+	var input []byte
+	if threshold == 1 {
+		for _, shard := range shards {
+			if shard != nil {
+				input = shard
+				break
+			}
+		}
+	} else {
+		var err error
+		input, err = erasureDecode(shards, threshold, int(comm.Size))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.verify {
+		if err := comm.Verify(input); err != nil {
+			return nil, err
+		}
+	}
+	return input, nil
+}
+
+func countNotFound(errs []error) int {
+	count := 0
+	for _, err := range errs {
+		if errors.Is(err, ErrNotFound) {
+			count++
+		}
+	}
+	return count
+}
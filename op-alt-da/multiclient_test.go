@@ -0,0 +1,80 @@
+package altda
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestMultiDAClient(t *testing.T) {
+	for _, threshold := range []int{1, 2} {
+		t.Run(fmt.Sprintf("threshold-%d", threshold), func(t *testing.T) {
+			ctx := context.Background()
+			logger := testlog.Logger(t, log.LevelDebug)
+			mocks := []*MockDAClient{NewMockDAClient(logger), NewMockDAClient(logger), NewMockDAClient(logger)}
+			clients := make([]DAStorage, len(mocks))
+			for i, mock := range mocks {
+				clients[i] = mock
+			}
+			client, err := NewMultiDAClient(clients, threshold, true)
+			require.NoError(t, err)
+
+			rng := rand.New(rand.NewSource(1234))
+			input := RandomData(rng, 2000)
+			comm, err := client.SetInput(ctx, input)
+			require.NoError(t, err)
+
+			// The commitment is included in the batcher transaction, and decoded by the nodes.
+			decoded, err := DecodeCommitmentData(comm.Encode())
+			require.NoError(t, err)
+			require.Equal(t, comm, decoded)
+			require.NoError(t, decoded.Verify(input))
+
+			stored, err := client.GetInput(ctx, decoded)
+			require.NoError(t, err)
+			require.Equal(t, input, stored)
+
+			// Input remains available if all but threshold DA servers lose it.
+			multi := decoded.(*MultiCommitment)
+			for i := 0; i < len(mocks)-threshold; i++ {
+				require.NoError(t, mocks[i].DeleteData(multi.Shards[i].Encode()))
+			}
+			stored, err = client.GetInput(ctx, decoded)
+			require.NoError(t, err)
+			require.Equal(t, input, stored)
+
+			require.NoError(t, mocks[len(mocks)-threshold].DeleteData(multi.Shards[len(mocks)-threshold].Encode()))
+			_, err = client.GetInput(ctx, decoded)
+			require.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestMultiDAClientSetInputFailure(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	faker := &DAErrFaker{Client: NewMockDAClient(logger)}
+	client, err := NewMultiDAClient([]DAStorage{NewMockDAClient(logger), faker}, 1, true)
+	require.NoError(t, err)
+
+	faker.ActSetPreImageFail()
+	_, err = client.SetInput(context.Background(), []byte("input"))
+	require.ErrorContains(t, err, "failed to store shard 1")
+
+	_, err = client.SetInput(context.Background(), []byte{})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestNewMultiDAClientInvalidThreshold(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	clients := []DAStorage{NewMockDAClient(logger), NewMockDAClient(logger)}
+	_, err := NewMultiDAClient(clients, 0, true)
+	require.Error(t, err)
+	_, err = NewMultiDAClient(clients, 3, true)
+	require.Error(t, err)
+}
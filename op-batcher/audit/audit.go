@@ -0,0 +1,183 @@
+// Package audit implements the append-only audit log of the batcher transactions posted to L1.
+// Every entry records what a transaction carried, so that the posted data can be reconstructed
+// after an incident without decoding the L1 history.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxEntrySize is the maximum size of a single encoded entry when reading the log.
+const maxEntrySize = 4 * 1024 * 1024
+
+type Status string
+
+const (
+	StatusConfirmed Status = "confirmed"
+	StatusFailed    Status = "failed"
+)
+
+// Frame is a channel frame carried by a batcher transaction.
+type Frame struct {
+	ChannelID   derive.ChannelID `json:"channelID"`
+	FrameNumber uint16           `json:"frameNumber"`
+	Size        int              `json:"size"`
+}
+
+// Entry is the audit record of a single batcher transaction.
+type Entry struct {
+	// Time is the unix timestamp at which the transaction was confirmed or failed.
+	Time   uint64 `json:"time"`
+	Status Status `json:"status"`
+	// Error is the reason of a failed transaction.
+	Error  string      `json:"error,omitempty"`
+	TxHash common.Hash `json:"txHash,omitempty"`
+	// L1Block is the block the transaction was included in.
+	L1Block eth.BlockID `json:"l1Block"`
+	Blob    bool        `json:"blob"`
+	Cancel  bool        `json:"cancel,omitempty"`
+	Frames  []Frame     `json:"frames"`
+	// DataHashes are the commitments to the posted data: the versioned hashes of the blobs of blob
+	// transactions, or the keccak256 hash of the calldata of calldata transactions.
+	DataHashes []common.Hash `json:"dataHashes"`
+	// OldestL2 and LatestL2 are the range of L2 blocks of the channels the frames belong to.
+	OldestL2 eth.BlockID `json:"oldestL2"`
+	LatestL2 eth.BlockID `json:"latestL2"`
+}
+
+// HasChannel returns whether the transaction carried a frame of the channel.
+func (e *Entry) HasChannel(id derive.ChannelID) bool {
+	for _, f := range e.Frames {
+		if f.ChannelID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasL2Block returns whether the L2 block is within the range of blocks of the transaction's channels.
+func (e *Entry) HasL2Block(num uint64) bool {
+	return len(e.Frames) > 0 && e.OldestL2.Number <= num && num <= e.LatestL2.Number
+}
+
+// Log is an append-only log of entries, encoded as one JSON object per line.
+type Log struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// OpenLog opens the log at path for appending, creating it if it does not exist.
+func OpenLog(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &Log{f: f}, nil
+}
+
+// Append writes the entry to the log and syncs it to disk.
+func (l *Log) Append(entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return errors.New("audit log is closed")
+	}
+	// Write the entry with a single write, so a crash can only leave a truncated last line.
+	if _, err := l.f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return l.f.Sync()
+}
+
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
+
+// Filter selects the entries of a query. Unset fields match all entries.
+type Filter struct {
+	TxHash    *common.Hash
+	ChannelID *derive.ChannelID
+	L2Block   *uint64
+	// Since and Until bound the entry time, inclusive.
+	Since, Until uint64
+}
+
+func (f *Filter) Matches(e *Entry) bool {
+	if f.TxHash != nil && e.TxHash != *f.TxHash {
+		return false
+	}
+	if f.ChannelID != nil && !e.HasChannel(*f.ChannelID) {
+		return false
+	}
+	if f.L2Block != nil && !e.HasL2Block(*f.L2Block) {
+		return false
+	}
+	if f.Since != 0 && e.Time < f.Since {
+		return false
+	}
+	if f.Until != 0 && e.Time > f.Until {
+		return false
+	}
+	return true
+}
+
+// Query reads the entries matching the filter from the log, in the order they were written.
+// A truncated last line, left behind by a crash while writing, is ignored.
+func Query(r io.Reader, filter Filter) ([]*Entry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEntrySize)
+	var entries []*Entry
+	var pendingErr error
+	line := 0
+	for scanner.Scan() {
+		line++
+		if pendingErr != nil {
+			// Only the last line may be truncated
+			return nil, pendingErr
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			pendingErr = fmt.Errorf("invalid audit entry at line %d: %w", line, err)
+			continue
+		}
+		if filter.Matches(&entry) {
+			entries = append(entries, &entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// QueryFile reads the entries matching the filter from the log file at path.
+func QueryFile(path string, filter Filter) ([]*Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+	return Query(f, filter)
+}
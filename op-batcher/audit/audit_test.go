@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestLogAppendAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	chA, chB := derive.ChannelID{0xa}, derive.ChannelID{0xb}
+	entries := []*Entry{
+		{
+			Time:       100,
+			Status:     StatusConfirmed,
+			TxHash:     common.Hash{0x01},
+			L1Block:    eth.BlockID{Hash: common.Hash{0xaa}, Number: 10},
+			Blob:       true,
+			Frames:     []Frame{{ChannelID: chA, FrameNumber: 0, Size: 1000}},
+			DataHashes: []common.Hash{{0x11}},
+			OldestL2:   eth.BlockID{Number: 1},
+			LatestL2:   eth.BlockID{Number: 5},
+		},
+		{
+			Time:       200,
+			Status:     StatusFailed,
+			Error:      "boom",
+			Frames:     []Frame{{ChannelID: chB, FrameNumber: 3, Size: 20}},
+			DataHashes: []common.Hash{{0x22}},
+			OldestL2:   eth.BlockID{Number: 6},
+			LatestL2:   eth.BlockID{Number: 8},
+		},
+	}
+
+	log, err := OpenLog(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(entries[0]))
+	require.NoError(t, log.Close())
+	// Reopening appends to the existing log
+	log, err = OpenLog(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(entries[1]))
+	require.NoError(t, log.Close())
+	require.ErrorContains(t, log.Append(entries[1]), "closed")
+
+	all, err := QueryFile(path, Filter{})
+	require.NoError(t, err)
+	require.Equal(t, entries, all)
+
+	txHash := common.Hash{0x01}
+	l2Block := uint64(7)
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected []*Entry
+	}{
+		{"TxHash", Filter{TxHash: &txHash}, entries[:1]},
+		{"Channel", Filter{ChannelID: &chB}, entries[1:]},
+		{"L2Block", Filter{L2Block: &l2Block}, entries[1:]},
+		{"Since", Filter{Since: 150}, entries[1:]},
+		{"Until", Filter{Until: 150}, entries[:1]},
+		{"NoMatch", Filter{TxHash: &txHash, ChannelID: &chB}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := QueryFile(path, test.filter)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestQueryTruncatedLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := OpenLog(path)
+	require.NoError(t, err)
+	entry := &Entry{Time: 1, Status: StatusConfirmed}
+	require.NoError(t, log.Append(entry))
+	require.NoError(t, log.Close())
+
+	// A crash while writing leaves a truncated last line, which is ignored
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"time":2,"sta`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	result, err := QueryFile(path, Filter{})
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{entry}, result)
+
+	// A corrupt entry in the middle of the log is an error
+	f, err = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n{}\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	_, err = QueryFile(path, Filter{})
+	require.ErrorContains(t, err, "invalid audit entry at line 2")
+}
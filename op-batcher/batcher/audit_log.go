@@ -0,0 +1,60 @@
+package batcher
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/audit"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// newAuditEntry creates the audit entry of a tx about to be sent, recording the frames and data it carries.
+func (l *BatchSubmitter) newAuditEntry(txdata txData, isCancel bool, candidate *txmgr.TxCandidate) *audit.Entry {
+	entry := &audit.Entry{
+		Blob:   len(candidate.Blobs) > 0,
+		Cancel: isCancel,
+	}
+	if !isCancel {
+		for _, f := range txdata.frames {
+			entry.Frames = append(entry.Frames, audit.Frame{
+				ChannelID:   f.id.chID,
+				FrameNumber: f.id.frameNumber,
+				Size:        len(f.data),
+			})
+		}
+	}
+	if entry.Blob {
+		_, blobHashes, err := txmgr.MakeSidecar(candidate.Blobs)
+		if err != nil {
+			l.Log.Error("Failed to compute blob hashes for audit log", "err", err)
+		}
+		entry.DataHashes = blobHashes
+	} else {
+		entry.DataHashes = []common.Hash{crypto.Keccak256Hash(candidate.TxData)}
+	}
+	return entry
+}
+
+// writeAuditEntry completes the audit entry of a confirmed or failed tx and appends it to the audit log.
+// It must be called before the tx is marked as confirmed or failed in the channel manager.
+func (l *BatchSubmitter) writeAuditEntry(r txmgr.TxReceipt[txRef]) {
+	entry := *r.ID.audit
+	entry.Time = uint64(l.Clock.Now().Unix())
+	if r.Err != nil {
+		entry.Status = audit.StatusFailed
+		entry.Error = r.Err.Error()
+	} else {
+		entry.Status = audit.StatusConfirmed
+		entry.TxHash = r.Receipt.TxHash
+		entry.L1Block = eth.ReceiptBlockID(r.Receipt)
+	}
+	if !entry.Cancel {
+		l.channelMgrMutex.Lock()
+		entry.OldestL2, entry.LatestL2, _ = l.channelMgr.TxL2Range(r.ID.id)
+		l.channelMgrMutex.Unlock()
+	}
+	if err := l.AuditLog.Append(&entry); err != nil {
+		l.Log.Error("Failed to write audit log entry", "id", r.ID, "err", err)
+	}
+}
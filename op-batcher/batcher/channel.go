@@ -2,6 +2,7 @@ package batcher
 
 import (
 	"math"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -29,6 +30,14 @@ type channel struct {
 	minInclusionBlock uint64
 	// Inclusion block number of last confirmed TX
 	maxInclusionBlock uint64
+
+	// L1 cost of the confirmed transactions
+	economics channelEconomics
+
+	// time the channel was opened, and the total time spent adding blocks to it and outputting its frames,
+	// for tuning the compression level
+	openedAt        time.Time
+	compressionTime time.Duration
 }
 
 func newChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, rollupCfg *rollup.Config, latestL1OriginBlockNum uint64, channelOut derive.ChannelOut) *channel {
@@ -41,6 +50,8 @@ func newChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, rollup
 		pendingTransactions:   make(map[string]txData),
 		confirmedTransactions: make(map[string]eth.BlockID),
 		minInclusionBlock:     math.MaxUint64,
+		economics:             newChannelEconomics(),
+		openedAt:              time.Now(),
 	}
 }
 
@@ -61,6 +72,17 @@ func (c *channel) TxFailed(id string) {
 	c.metr.RecordBatchTxFailed()
 }
 
+// TxCost attributes the L1 cost of a pending transaction to the channel.
+// It must be called before the transaction is marked as confirmed.
+func (c *channel) TxCost(id string, cost txCost) {
+	data, ok := c.pendingTransactions[id]
+	if !ok {
+		c.log.Warn("unknown transaction cost recorded", "id", id)
+		return
+	}
+	c.economics.addTx(data.Len(), cost)
+}
+
 // TxConfirmed marks a transaction as confirmed on L1. Returns a bool indicating
 // whether the channel timed out on chain.
 func (c *channel) TxConfirmed(id string, inclusionBlock eth.BlockID) bool {
@@ -111,6 +133,11 @@ func (c *channel) isTimedOut() bool {
 	return len(c.confirmedTransactions) > 0 && c.maxInclusionBlock-c.minInclusionBlock >= c.cfg.ChannelTimeout
 }
 
+// isTimedOutAt returns true if a transaction of the channel included in the given L1 block would time out the channel.
+func (c *channel) isTimedOutAt(l1BlockNum uint64) bool {
+	return len(c.confirmedTransactions) > 0 && l1BlockNum >= c.minInclusionBlock+c.cfg.ChannelTimeout
+}
+
 // isFullySubmitted returns true if the channel has been fully submitted (all transactions are confirmed).
 func (c *channel) isFullySubmitted() bool {
 	return c.IsFull() && len(c.pendingTransactions)+c.PendingFrames() == 0
@@ -167,6 +194,7 @@ func (c *channel) CheckTimeout(l1BlockNum uint64) {
 }
 
 func (c *channel) AddBlock(block *types.Block) (*derive.L1BlockInfo, error) {
+	defer c.trackCompressionTime(time.Now())
 	return c.channelBuilder.AddBlock(block)
 }
 
@@ -191,9 +219,24 @@ func (c *channel) PendingFrames() int {
 }
 
 func (c *channel) OutputFrames() error {
+	defer c.trackCompressionTime(time.Now())
 	return c.channelBuilder.OutputFrames()
 }
 
+func (c *channel) trackCompressionTime(start time.Time) {
+	c.compressionTime += time.Since(start)
+}
+
+// CompressionTime returns the total time spent adding blocks to the channel and outputting its frames.
+func (c *channel) CompressionTime() time.Duration {
+	return c.compressionTime
+}
+
+// OpenDuration returns the time since the channel was opened.
+func (c *channel) OpenDuration() time.Duration {
+	return time.Since(c.openedAt)
+}
+
 // LatestL1Origin returns the latest L1 block origin from all the L2 blocks that have been added to the channel
 func (c *channel) LatestL1Origin() eth.BlockID {
 	return c.channelBuilder.LatestL1Origin()
@@ -49,6 +49,11 @@ type ChannelConfig struct {
 	// UseBlobs indicates that this channel should be sent as a multi-blob
 	// transaction with one blob per frame.
 	UseBlobs bool
+
+	// AutoTuneCompression enables choosing the brotli compression level of each new channel,
+	// based on the compression time and ratio of the previous channels.
+	// The configured compression algorithm is the starting level.
+	AutoTuneCompression bool
 }
 
 // ChannelConfig returns a copy of the receiver.
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -55,10 +57,22 @@ type channelManager struct {
 	channelQueue []*channel
 	// used to lookup channels by tx ID upon tx success / failure
 	txChannels map[string]*channel
+	// latest L1 head that tx data was requested at, to determine whether pending transactions are obsolete
+	l1Head eth.BlockID
+
+	// economic reports of the most recently fully submitted or timed out channels, oldest first
+	reports []rpc.ChannelReport
+
+	// tuner chooses the compression level of new channels, if compression auto-tuning is enabled
+	tuner *compressionTuner
+
+	// closed is set when the batcher is shutting down: every channel is closed once the pending blocks are added,
+	// so that all data can be submitted.
+	closed bool
 }
 
 func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider ChannelConfigProvider, rollupCfg *rollup.Config) *channelManager {
-	return &channelManager{
+	s := &channelManager{
 		log:         log,
 		metr:        metr,
 		cfgProvider: cfgProvider,
@@ -67,6 +81,10 @@ func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider Channe
 		outFactory:  NewChannelOut,
 		txChannels:  make(map[string]*channel),
 	}
+	if s.defaultCfg.AutoTuneCompression {
+		s.tuner = newCompressionTuner(log, metr, s.defaultCfg.CompressorConfig.CompressionAlgo)
+	}
+	return s
 }
 
 func (s *channelManager) SetChannelOutFactory(outFactory ChannelOutFactory) {
@@ -84,6 +102,36 @@ func (s *channelManager) Clear(l1OriginLastSubmittedChannel eth.BlockID) {
 	s.currentChannel = nil
 	s.channelQueue = nil
 	s.txChannels = make(map[string]*channel)
+	s.closed = false
+}
+
+// Close closes the current channel, if any, and outputs its remaining frames. Subsequent channels are closed as soon
+// as the pending blocks have been added to them. The caller should then drain the channel manager by requesting
+// TxData until it returns io.EOF, and resubmitting failed transactions until Drained returns true.
+func (s *channelManager) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.log.Info("Closing channel manager", "blocks_pending", s.pendingBlocks(), "channels", len(s.channelQueue))
+	if s.currentChannel == nil || s.currentChannel.IsFull() {
+		return nil
+	}
+	s.currentChannel.Close()
+	return s.outputFrames()
+}
+
+// Drained returns whether all blocks were added to channels, and all frames of the channels were confirmed.
+func (s *channelManager) Drained() bool {
+	if s.pendingBlocks() > 0 {
+		return false
+	}
+	for _, ch := range s.channelQueue {
+		if !ch.isFullySubmitted() {
+			return false
+		}
+	}
+	return true
 }
 
 func (s *channelManager) pendingBlocks() int {
@@ -102,6 +150,55 @@ func (s *channelManager) TxFailed(_id txID) {
 	}
 }
 
+// TxObsolete returns whether the data of a pending transaction is no longer needed: its channel was cleared or
+// invalidated, or the channel times out before the transaction could be included in the next L1 block.
+func (s *channelManager) TxObsolete(_id txID) bool {
+	channel, ok := s.txChannels[_id.String()]
+	if !ok {
+		return true
+	}
+	return channel.isTimedOutAt(s.l1Head.Number + 1)
+}
+
+// TxCanceled records a transaction as canceled because its data became obsolete.
+// Its channel is invalidated if it timed out, so that its blocks are submitted in a new channel.
+func (s *channelManager) TxCanceled(_id txID) {
+	id := _id.String()
+	channel, ok := s.txChannels[id]
+	if !ok {
+		s.log.Debug("canceled transaction from unknown channel", "id", id)
+		return
+	}
+	delete(s.txChannels, id)
+	channel.TxFailed(id)
+	if channel.isTimedOutAt(s.l1Head.Number + 1) {
+		s.log.Warn("Channel of canceled transaction timed out", "id", channel.ID(), "l1_head", s.l1Head)
+		s.metr.RecordChannelTimedOut(channel.ID())
+		s.recordChannelReport(channel)
+		s.handleChannelInvalidated(channel)
+	}
+}
+
+// TxL2Range returns the range of L2 blocks of the channel of a pending transaction.
+func (s *channelManager) TxL2Range(_id txID) (oldest, latest eth.BlockID, ok bool) {
+	channel, ok := s.txChannels[_id.String()]
+	if !ok {
+		return eth.BlockID{}, eth.BlockID{}, false
+	}
+	return channel.OldestL2(), channel.LatestL2(), true
+}
+
+// TxCost attributes the L1 cost of a transaction to its channel.
+// It must be called before the transaction is marked as confirmed.
+func (s *channelManager) TxCost(_id txID, cost txCost) {
+	id := _id.String()
+	if channel, ok := s.txChannels[id]; ok {
+		channel.TxCost(id, cost)
+	} else {
+		s.log.Warn("cost of transaction from unknown channel recorded", "id", id)
+	}
+}
+
 // TxConfirmed marks a transaction as confirmed on L1. Only if the channel timed out
 // the channelManager's state is modified.
 func (s *channelManager) TxConfirmed(_id txID, inclusionBlock eth.BlockID) {
@@ -110,7 +207,10 @@ func (s *channelManager) TxConfirmed(_id txID, inclusionBlock eth.BlockID) {
 	if channel, ok := s.txChannels[id]; ok {
 		delete(s.txChannels, id)
 		if timedOut := channel.TxConfirmed(id, inclusionBlock); timedOut {
+			s.recordChannelReport(channel)
 			s.handleChannelInvalidated(channel)
+		} else if channel.isFullySubmitted() {
+			s.recordChannelReport(channel)
 		}
 	} else {
 		s.log.Warn("transaction from unknown channel marked as confirmed", "id", id)
@@ -119,6 +219,26 @@ func (s *channelManager) TxConfirmed(_id txID, inclusionBlock eth.BlockID) {
 	s.log.Debug("marked transaction as confirmed", "id", id, "block", inclusionBlock)
 }
 
+// recordChannelReport records the economic report of a channel that is done being submitted.
+func (s *channelManager) recordChannelReport(c *channel) {
+	report := c.Report()
+	s.log.Info("Channel economic report", "id", report.ID, "l2_blocks", report.L2Blocks, "l2_gas_used", report.L2GasUsed,
+		"submitted_bytes", report.SubmittedBytes, "txs", report.Txs, "execution_gas_used", report.ExecutionGasUsed,
+		"blob_gas_used", report.BlobGasUsed, "total_cost", report.TotalCost, "cost_per_l2_gas", report.CostPerL2Gas,
+		"timed_out", report.TimedOut)
+	totalCost, _ := new(big.Float).SetInt(report.TotalCost.ToInt()).Float64()
+	s.metr.RecordChannelCost(report.ID, report.L2Blocks, report.SubmittedBytes, totalCost, report.CostPerL2Gas)
+	s.reports = append(s.reports, report)
+	if len(s.reports) > maxChannelReports {
+		s.reports = s.reports[len(s.reports)-maxChannelReports:]
+	}
+}
+
+// ChannelReports returns the economic reports of the most recently submitted channels, oldest first.
+func (s *channelManager) ChannelReports() []rpc.ChannelReport {
+	return append([]rpc.ChannelReport(nil), s.reports...)
+}
+
 // rewindToBlock updates the blockCursor to point at
 // the block with the supplied hash, only if that block exists
 // in the block queue and the blockCursor is ahead of it.
@@ -191,6 +311,7 @@ func (s *channelManager) nextTxData(channel *channel) (txData, error) {
 // When switching DA type, the channelManager state will be rebuilt
 // with a new ChannelConfig.
 func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
+	s.l1Head = l1Head
 	channel, err := s.getReadyChannel(l1Head)
 	if err != nil {
 		return emptyTxData, err
@@ -274,6 +395,11 @@ func (s *channelManager) getReadyChannel(l1Head eth.BlockID) (*channel, error) {
 	// all pending blocks be included in this channel for submission.
 	s.registerL1Block(l1Head)
 
+	if s.closed && !s.currentChannel.IsFull() {
+		// No further blocks are added when closed, so the channel can be submitted right away.
+		s.currentChannel.Close()
+	}
+
 	if err := s.outputFrames(); err != nil {
 		return nil, err
 	}
@@ -297,6 +423,9 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 	// This will be reassessed at channel submission-time,
 	// but this is our best guess at the appropriate values for now.
 	cfg := s.defaultCfg
+	if s.tuner != nil {
+		cfg.CompressorConfig.CompressionAlgo = s.tuner.CompressionAlgo()
+	}
 
 	channelOut, err := s.outFactory(cfg, s.rollupCfg)
 	if err != nil {
@@ -402,6 +531,16 @@ func (s *channelManager) outputFrames() error {
 		outBytes,
 		s.currentChannel.FullErr(),
 	)
+	if s.tuner != nil {
+		s.tuner.ChannelClosed(
+			s.currentChannel.cfg.CompressorConfig.CompressionAlgo,
+			inBytes,
+			outBytes,
+			s.currentChannel.CompressionTime(),
+			s.currentChannel.OpenDuration(),
+			s.pendingBlocks(),
+		)
+	}
 
 	var comprRatio float64
 	if inBytes > 0 {
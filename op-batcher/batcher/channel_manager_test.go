@@ -704,3 +704,84 @@ func TestChannelManager_CheckExpectedProgress(t *testing.T) {
 	err = m.CheckExpectedProgress(ss)
 	require.NoError(t, err)
 }
+
+func TestChannelManager_CloseAndDrain(t *testing.T) {
+	require := require.New(t)
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(120_000, derive.SpanBatchType)
+	m := NewChannelManager(l, metrics.NoopMetrics, cfg, defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	a := newMiniL2Block(0)
+	require.NoError(m.AddL2Block(a))
+
+	// channel is not full yet, so there is nothing to submit
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+	require.NotNil(m.currentChannel)
+	require.False(m.currentChannel.IsFull())
+	require.False(m.Drained())
+
+	require.NoError(m.Close())
+	require.True(m.currentChannel.IsFull())
+
+	// blocks added after closing end up in a new channel that is closed right away
+	b := newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())
+	require.NoError(m.AddL2Block(b))
+
+	var ids []txID
+	for {
+		txdata, err := m.TxData(eth.BlockID{})
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(err)
+		ids = append(ids, txdata.ID())
+	}
+	require.Len(m.channelQueue, 2)
+	require.Len(ids, 2)
+	require.Zero(m.pendingBlocks())
+	require.False(m.Drained(), "transactions are still pending")
+
+	for _, id := range ids {
+		m.TxConfirmed(id, eth.BlockID{Number: 1})
+	}
+	require.True(m.Drained())
+}
+
+func TestChannelManager_TxObsoleteAndCanceled(t *testing.T) {
+	require := require.New(t)
+	l := testlog.Logger(t, log.LevelCrit)
+	cfg := channelManagerTestConfig(100, derive.SingularBatchType)
+	cfg.ChannelTimeout = 10
+	m := NewChannelManager(l, metrics.NoopMetrics, cfg, defaultTestRollupConfig)
+	m.Clear(eth.BlockID{})
+
+	rng := rand.New(rand.NewSource(99))
+	a := derivetest.RandomL2BlockWithChainId(rng, 10, defaultTestRollupConfig.L2ChainID)
+	require.NoError(m.AddL2Block(a))
+
+	txdata0, err := m.TxData(eth.BlockID{Number: 8})
+	require.NoError(err)
+	txdata1, err := m.TxData(eth.BlockID{Number: 8})
+	require.NoError(err)
+	require.Len(m.channelQueue, 1)
+	ch := m.channelQueue[0]
+
+	// no transaction of the channel is confirmed yet, so it cannot time out
+	require.False(m.TxObsolete(txdata1.ID()))
+
+	m.TxConfirmed(txdata0.ID(), eth.BlockID{Number: 10})
+	m.l1Head = eth.BlockID{Number: 18}
+	require.False(m.TxObsolete(txdata1.ID()), "can still be included in time")
+	m.l1Head = eth.BlockID{Number: 19}
+	require.True(m.TxObsolete(txdata1.ID()), "would be included after the channel timed out")
+
+	m.TxCanceled(txdata1.ID())
+	require.NotContains(m.txChannels, txdata1.ID().String())
+	require.NotContains(m.channelQueue, ch, "timed out channel must be invalidated")
+	require.Zero(m.blockCursor, "blocks must be requeued")
+
+	// transactions of unknown channels are obsolete
+	require.True(m.TxObsolete(txdata1.ID()))
+}
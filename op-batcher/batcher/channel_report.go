@@ -0,0 +1,95 @@
+package batcher
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
+)
+
+// maxChannelReports is the number of reports of fully submitted channels that are retained.
+const maxChannelReports = 128
+
+// txCost is the L1 cost of a single confirmed batcher transaction.
+type txCost struct {
+	executionGas  uint64
+	executionCost *big.Int
+	blobGas       uint64
+	blobCost      *big.Int
+}
+
+// txCostFromReceipt computes the L1 cost of a transaction from its receipt.
+func txCostFromReceipt(receipt *types.Receipt) txCost {
+	cost := txCost{
+		executionGas:  receipt.GasUsed,
+		executionCost: new(big.Int),
+		blobGas:       receipt.BlobGasUsed,
+		blobCost:      new(big.Int),
+	}
+	if receipt.EffectiveGasPrice != nil {
+		cost.executionCost.Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	}
+	if receipt.BlobGasPrice != nil {
+		cost.blobCost.Mul(receipt.BlobGasPrice, new(big.Int).SetUint64(receipt.BlobGasUsed))
+	}
+	return cost
+}
+
+// channelEconomics accumulates the L1 cost of the confirmed transactions of a channel.
+type channelEconomics struct {
+	submittedBytes int
+	txs            int
+	executionGas   uint64
+	executionCost  *big.Int
+	blobGas        uint64
+	blobCost       *big.Int
+}
+
+func newChannelEconomics() channelEconomics {
+	return channelEconomics{
+		executionCost: new(big.Int),
+		blobCost:      new(big.Int),
+	}
+}
+
+func (e *channelEconomics) addTx(submittedBytes int, cost txCost) {
+	e.submittedBytes += submittedBytes
+	e.txs += 1
+	e.executionGas += cost.executionGas
+	e.executionCost.Add(e.executionCost, cost.executionCost)
+	e.blobGas += cost.blobGas
+	e.blobCost.Add(e.blobCost, cost.blobCost)
+}
+
+// Report returns the economic report of the channel, covering all confirmed transactions so far.
+func (c *channel) Report() rpc.ChannelReport {
+	var l2GasUsed uint64
+	blocks := c.channelBuilder.Blocks()
+	for _, block := range blocks {
+		l2GasUsed += block.GasUsed()
+	}
+	total := new(big.Int).Add(c.economics.executionCost, c.economics.blobCost)
+	var costPerL2Gas float64
+	if l2GasUsed > 0 {
+		costPerL2Gas, _ = new(big.Float).Quo(new(big.Float).SetInt(total), new(big.Float).SetUint64(l2GasUsed)).Float64()
+	}
+	return rpc.ChannelReport{
+		ID:               c.ID(),
+		OldestL2:         c.OldestL2(),
+		LatestL2:         c.LatestL2(),
+		L2Blocks:         len(blocks),
+		L2GasUsed:        l2GasUsed,
+		InputBytes:       c.InputBytes(),
+		SubmittedBytes:   c.economics.submittedBytes,
+		Txs:              c.economics.txs,
+		ExecutionGasUsed: c.economics.executionGas,
+		ExecutionCost:    (*hexutil.Big)(new(big.Int).Set(c.economics.executionCost)),
+		BlobGasUsed:      c.economics.blobGas,
+		BlobCost:         (*hexutil.Big)(new(big.Int).Set(c.economics.blobCost)),
+		TotalCost:        (*hexutil.Big)(total),
+		CostPerL2Gas:     costPerL2Gas,
+		TimedOut:         c.isTimedOut(),
+	}
+}
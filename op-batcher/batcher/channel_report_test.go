@@ -0,0 +1,74 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestTxCostFromReceipt(t *testing.T) {
+	cost := txCostFromReceipt(&types.Receipt{
+		GasUsed:           21_000,
+		EffectiveGasPrice: big.NewInt(10),
+		BlobGasUsed:       131_072,
+		BlobGasPrice:      big.NewInt(2),
+	})
+	require.Equal(t, uint64(21_000), cost.executionGas)
+	require.Equal(t, big.NewInt(210_000), cost.executionCost)
+	require.Equal(t, uint64(131_072), cost.blobGas)
+	require.Equal(t, big.NewInt(262_144), cost.blobCost)
+
+	// calldata txs have no blob gas price
+	cost = txCostFromReceipt(&types.Receipt{
+		GasUsed:           21_000,
+		EffectiveGasPrice: big.NewInt(10),
+	})
+	require.Equal(t, big.NewInt(210_000), cost.executionCost)
+	require.Zero(t, cost.blobCost.Sign())
+}
+
+func TestChannelManagerChannelReport(t *testing.T) {
+	log := testlog.Logger(t, log.LevelCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics, ChannelConfig{
+		ChannelTimeout: 100,
+		CompressorConfig: compressor.Config{
+			CompressionAlgo: derive.Zlib,
+		},
+	}, &rollup.Config{})
+	m.Clear(eth.BlockID{})
+	require.NoError(t, m.ensureChannelWithSpace(eth.BlockID{}))
+	channel := m.currentChannel
+	channel.Close()
+
+	data := txData{frames: []frameData{{data: make([]byte, 100)}}}
+	id := data.ID()
+	channel.pendingTransactions[id.String()] = data
+	m.txChannels[id.String()] = channel
+
+	m.TxCost(id, txCostFromReceipt(&types.Receipt{
+		GasUsed:           50_000,
+		EffectiveGasPrice: big.NewInt(3),
+	}))
+	m.TxConfirmed(id, eth.BlockID{Number: 1})
+
+	reports := m.ChannelReports()
+	require.Len(t, reports, 1)
+	report := reports[0]
+	require.Equal(t, channel.ID(), report.ID)
+	require.Equal(t, 100, report.SubmittedBytes)
+	require.Equal(t, 1, report.Txs)
+	require.Equal(t, uint64(50_000), report.ExecutionGasUsed)
+	require.Equal(t, big.NewInt(150_000), report.TotalCost.ToInt())
+	require.False(t, report.TimedOut)
+}
@@ -0,0 +1,117 @@
+package batcher
+
+import (
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+var (
+	// tunableCompressionAlgos are the brotli compression levels the compression tuner chooses from,
+	// from the fastest to the strongest level.
+	tunableCompressionAlgos = []derive.CompressionAlgo{derive.Brotli9, derive.Brotli10, derive.Brotli11}
+)
+
+const (
+	// maxCompressionBusy is the fraction of the time a channel is open that may be spent compressing its blocks.
+	// Above it, the batcher has little CPU headroom left, and the blocks queue up, so the level is lowered.
+	maxCompressionBusy = 0.5
+	// idleCompressionBusy is the fraction of the time a channel is open spent compressing its blocks,
+	// below which the batcher is considered idle, so the level is raised if there are no pending blocks.
+	idleCompressionBusy = 0.1
+	// minCompressionGain is the minimum relative size reduction of a level over the next lower level,
+	// for the additional CPU time of the level to be worth it.
+	minCompressionGain = 0.01
+	// comprRatioSmoothing is the weight of the latest channel in the moving average of the compression ratio of a level.
+	comprRatioSmoothing = 0.3
+)
+
+// compressionTuner chooses the brotli compression level of new channels, based on the CPU time spent compressing
+// the previous channels, and the marginal size reduction of the levels. It keeps the submission latency bounded
+// by lowering the level when compression falls behind, and maximizes compression when the batcher is idle.
+type compressionTuner struct {
+	log  log.Logger
+	metr metrics.Metricer
+
+	current int
+	// comprRatios are the moving averages of the compression ratio of the channels at each level, 0 if unknown.
+	comprRatios []float64
+}
+
+// newCompressionTuner creates a compressionTuner that starts at the level of the given algorithm,
+// or returns nil if the algorithm has no tunable level.
+func newCompressionTuner(log log.Logger, metr metrics.Metricer, algo derive.CompressionAlgo) *compressionTuner {
+	if algo == derive.Brotli {
+		algo = derive.Brotli10
+	}
+	current := slices.Index(tunableCompressionAlgos, algo)
+	if current < 0 {
+		return nil
+	}
+	return &compressionTuner{
+		log:         log,
+		metr:        metr,
+		current:     current,
+		comprRatios: make([]float64, len(tunableCompressionAlgos)),
+	}
+}
+
+// CompressionAlgo returns the compression algorithm to use for new channels.
+func (t *compressionTuner) CompressionAlgo() derive.CompressionAlgo {
+	return tunableCompressionAlgos[t.current]
+}
+
+// ChannelClosed updates the level with the statistics of a closed channel:
+// its compression algorithm, input and output bytes, the time spent compressing its blocks
+// and the duration it was open, as well as the number of blocks still pending.
+func (t *compressionTuner) ChannelClosed(algo derive.CompressionAlgo, inputBytes, outputBytes int,
+	compressionTime, openDuration time.Duration, pendingBlocks int,
+) {
+	level := slices.Index(tunableCompressionAlgos, algo)
+	if level < 0 {
+		return
+	}
+	if inputBytes > 0 {
+		ratio := float64(outputBytes) / float64(inputBytes)
+		if t.comprRatios[level] == 0 {
+			t.comprRatios[level] = ratio
+		} else {
+			t.comprRatios[level] += comprRatioSmoothing * (ratio - t.comprRatios[level])
+		}
+	}
+	var busy float64
+	if openDuration > 0 {
+		busy = min(float64(compressionTime)/float64(openDuration), 1)
+	}
+
+	switch {
+	case busy > maxCompressionBusy && t.current > 0:
+		t.setLevel(t.current-1, "cpu headroom exhausted", busy, pendingBlocks)
+	case t.current > 0 && t.gainKnown(t.current) && t.gain(t.current) < minCompressionGain:
+		t.setLevel(t.current-1, "marginal size reduction too small", busy, pendingBlocks)
+	case busy < idleCompressionBusy && pendingBlocks == 0 && t.current < len(tunableCompressionAlgos)-1 &&
+		(!t.gainKnown(t.current+1) || t.gain(t.current+1) >= minCompressionGain):
+		t.setLevel(t.current+1, "idle", busy, pendingBlocks)
+	}
+	t.metr.RecordCompressionLevel(derive.GetBrotliLevel(t.CompressionAlgo()), busy)
+}
+
+// gainKnown returns true if the compression ratios of the level and the next lower level are known.
+func (t *compressionTuner) gainKnown(level int) bool {
+	return t.comprRatios[level] > 0 && t.comprRatios[level-1] > 0
+}
+
+// gain returns the relative size reduction of the level over the next lower level.
+func (t *compressionTuner) gain(level int) float64 {
+	return 1 - t.comprRatios[level]/t.comprRatios[level-1]
+}
+
+func (t *compressionTuner) setLevel(level int, reason string, busy float64, pendingBlocks int) {
+	t.log.Info("Changing compression level of new channels", "from", t.CompressionAlgo(),
+		"to", tunableCompressionAlgos[level], "reason", reason, "busy", busy, "blocks_pending", pendingBlocks)
+	t.current = level
+}
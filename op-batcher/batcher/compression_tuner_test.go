@@ -0,0 +1,75 @@
+package batcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCompressionTuner(t *testing.T, algo derive.CompressionAlgo) *compressionTuner {
+	tuner := newCompressionTuner(testlog.Logger(t, log.LevelInfo), metrics.NoopMetrics, algo)
+	require.NotNil(t, tuner)
+	return tuner
+}
+
+// closeChannel reports a closed channel at the current level, compressing 1000 bytes to the given size,
+// with the given fraction of the time the channel was open spent compressing.
+func closeChannel(tuner *compressionTuner, outputBytes int, busy float64, pendingBlocks int) {
+	tuner.ChannelClosed(tuner.CompressionAlgo(), 1000, outputBytes,
+		time.Duration(busy*float64(time.Second)), time.Second, pendingBlocks)
+}
+
+func TestCompressionTuner_Init(t *testing.T) {
+	require.Nil(t, newCompressionTuner(testlog.Logger(t, log.LevelInfo), metrics.NoopMetrics, derive.Zlib))
+	require.Equal(t, derive.Brotli10, newTestCompressionTuner(t, derive.Brotli).CompressionAlgo())
+	require.Equal(t, derive.Brotli9, newTestCompressionTuner(t, derive.Brotli9).CompressionAlgo())
+	require.Equal(t, derive.Brotli11, newTestCompressionTuner(t, derive.Brotli11).CompressionAlgo())
+}
+
+func TestCompressionTuner_LowersLevelWhenBusy(t *testing.T) {
+	tuner := newTestCompressionTuner(t, derive.Brotli11)
+	closeChannel(tuner, 500, 0.8, 10)
+	require.Equal(t, derive.Brotli10, tuner.CompressionAlgo())
+	closeChannel(tuner, 520, 0.6, 10)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+	// can't go lower
+	closeChannel(tuner, 550, 0.9, 10)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+}
+
+func TestCompressionTuner_RaisesLevelWhenIdle(t *testing.T) {
+	tuner := newTestCompressionTuner(t, derive.Brotli9)
+	// pending blocks keep the level
+	closeChannel(tuner, 550, 0.05, 1)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+	// moderately busy keeps the level
+	closeChannel(tuner, 550, 0.3, 0)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+
+	closeChannel(tuner, 550, 0.05, 0)
+	require.Equal(t, derive.Brotli10, tuner.CompressionAlgo())
+	closeChannel(tuner, 500, 0.05, 0)
+	require.Equal(t, derive.Brotli11, tuner.CompressionAlgo())
+	// can't go higher
+	closeChannel(tuner, 450, 0.05, 0)
+	require.Equal(t, derive.Brotli11, tuner.CompressionAlgo())
+}
+
+func TestCompressionTuner_MarginalGain(t *testing.T) {
+	tuner := newTestCompressionTuner(t, derive.Brotli9)
+	closeChannel(tuner, 500, 0.05, 0)
+	require.Equal(t, derive.Brotli10, tuner.CompressionAlgo())
+
+	// brotli-10 barely compresses better than brotli-9, so it's not worth the CPU time
+	closeChannel(tuner, 498, 0.05, 0)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+
+	// and brotli-9 isn't raised again while the known gain of brotli-10 is too small
+	closeChannel(tuner, 500, 0.05, 0)
+	require.Equal(t, derive.Brotli9, tuner.CompressionAlgo())
+}
@@ -11,6 +11,9 @@ import (
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
 	"github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	p2pcli "github.com/ethereum-optimism/optimism/op-node/p2p/cli"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -75,6 +78,10 @@ type CLIConfig struct {
 	// Type of compression algorithm to use. Must be one of [zlib, brotli, brotli[9-11]]
 	CompressionAlgo derive.CompressionAlgo
 
+	// CompressionAutoTune enables tuning the brotli compression level of each channel,
+	// starting from CompressionAlgo, based on the compression time and ratio of the previous channels.
+	CompressionAutoTune bool
+
 	// If Stopped is true, the batcher starts stopped and won't start batching right away.
 	// Batching needs to be started via an admin RPC.
 	Stopped bool
@@ -109,6 +116,30 @@ type CLIConfig struct {
 	// ThrottleAlwaysBlockSize is the total per-block DA limit to always imposing on block building.
 	ThrottleAlwaysBlockSize uint64
 
+	// DrainTimeout is the maximum duration to spend on shutdown closing the open channels, submitting all
+	// pending frames and waiting for their confirmation, or 0 to stop without draining.
+	DrainTimeout time.Duration
+	// DrainResubmissionTimeout replaces the fee bumping interval of the transaction manager while draining,
+	// to get the pending frames included before the drain timeout. 0 keeps the configured interval.
+	DrainResubmissionTimeout time.Duration
+
+	// InteropGating holds back unsafe blocks from being batched until the rollup node reports them
+	// cross-unsafe, i.e. until the supervisor verified their cross-L2 dependencies.
+	InteropGating bool
+	// InteropGatingTimeout is the maximum duration to hold back a block that is not cross-unsafe,
+	// before batching it anyway.
+	InteropGatingTimeout time.Duration
+
+	// GossipFollow loads the blocks to batch from the unsafe blocks gossiped on the P2P network,
+	// instead of fetching them from the L2 execution engine RPC.
+	GossipFollow bool
+	// P2PConfig loads the P2P configuration of the gossip-follow mode.
+	// The peer scoring depends on the rollup config, which is only known after connecting to the rollup node.
+	P2PConfig func(rollupCfg *rollup.Config) (p2p.SetupP2P, error)
+
+	// AuditLogPath is the path of the audit log of the posted batcher transactions. Disabled if empty.
+	AuditLogPath string
+
 	// TestUseMaxTxSizeForBlobs allows to set the blob size with MaxL1TxSize.
 	// Should only be used for testing purposes.
 	TestUseMaxTxSizeForBlobs bool
@@ -149,6 +180,9 @@ func (c *CLIConfig) Check() error {
 	if !derive.ValidCompressionAlgo(c.CompressionAlgo) {
 		return fmt.Errorf("invalid compression algo %v", c.CompressionAlgo)
 	}
+	if c.CompressionAutoTune && !c.CompressionAlgo.IsBrotli() {
+		return fmt.Errorf("compression auto-tuning requires a brotli compression algo, got %v", c.CompressionAlgo)
+	}
 	if c.BatchType > derive.SpanBatchType {
 		return fmt.Errorf("unknown batch type: %v", c.BatchType)
 	}
@@ -174,6 +208,12 @@ func (c *CLIConfig) Check() error {
 	if err := c.RPC.Check(); err != nil {
 		return err
 	}
+	if c.InteropGating && c.InteropGatingTimeout == 0 {
+		return errors.New("interop gating requires a non-zero timeout")
+	}
+	if c.GossipFollow && c.P2PConfig == nil {
+		return errors.New("p2p config is required for gossip-follow mode")
+	}
 	return nil
 }
 
@@ -196,6 +236,7 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		ApproxComprRatio:             ctx.Float64(flags.ApproxComprRatioFlag.Name),
 		Compressor:                   ctx.String(flags.CompressorFlag.Name),
 		CompressionAlgo:              derive.CompressionAlgo(ctx.String(flags.CompressionAlgoFlag.Name)),
+		CompressionAutoTune:          ctx.Bool(flags.CompressionAutoTuneFlag.Name),
 		Stopped:                      ctx.Bool(flags.StoppedFlag.Name),
 		WaitNodeSync:                 ctx.Bool(flags.WaitNodeSyncFlag.Name),
 		CheckRecentTxsDepth:          ctx.Int(flags.CheckRecentTxsDepthFlag.Name),
@@ -213,5 +254,14 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		ThrottleTxSize:               ctx.Uint64(flags.ThrottleTxSizeFlag.Name),
 		ThrottleBlockSize:            ctx.Uint64(flags.ThrottleBlockSizeFlag.Name),
 		ThrottleAlwaysBlockSize:      ctx.Uint64(flags.ThrottleAlwaysBlockSizeFlag.Name),
+		DrainTimeout:                 ctx.Duration(flags.DrainTimeoutFlag.Name),
+		DrainResubmissionTimeout:     ctx.Duration(flags.DrainResubmissionTimeoutFlag.Name),
+		InteropGating:                ctx.Bool(flags.InteropGatingFlag.Name),
+		InteropGatingTimeout:         ctx.Duration(flags.InteropGatingTimeoutFlag.Name),
+		GossipFollow:                 ctx.Bool(flags.GossipFollowFlag.Name),
+		AuditLogPath:                 ctx.Path(flags.AuditLogFlag.Name),
+		P2PConfig: func(rollupCfg *rollup.Config) (p2p.SetupP2P, error) {
+			return p2pcli.NewConfig(ctx, rollupCfg)
+		},
 	}
 }
@@ -118,6 +118,14 @@ func TestBatcherConfig(t *testing.T) {
 			},
 			errString: "invalid ApproxComprRatio 4.2 for ratio compressor",
 		},
+		{
+			name: "interop gating without timeout",
+			override: func(c *batcher.CLIConfig) {
+				c.InteropGating = true
+				c.InteropGatingTimeout = 0
+			},
+			errString: "interop gating requires a non-zero timeout",
+		},
 	}
 
 	for _, test := range tests {
@@ -21,9 +21,12 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-batcher/audit"
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	batcherrpc "github.com/ethereum-optimism/optimism/op-batcher/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
@@ -45,6 +48,9 @@ type txRef struct {
 	id       txID
 	isCancel bool
 	isBlob   bool
+	// audit is the audit entry of the tx, to be completed once the tx is confirmed or failed.
+	// Nil if the audit log is disabled.
+	audit *audit.Entry
 }
 
 func (r txRef) String() string {
@@ -89,8 +95,15 @@ type DriverSetup struct {
 	L1Client          L1Client
 	EndpointProvider  dial.L2EndpointProvider
 	ChannelConfig     ChannelConfigProvider
-	AltDA             *altda.DAClient
+	AltDA             altda.DAStorage
 	ChannelOutFactory ChannelOutFactory
+	// BlockSource is optional, blocks are fetched from the L2 execution engine RPC if it is nil,
+	// or if a block is not available from the block source.
+	BlockSource BlockSource
+	// Clock drives the timers and tickers of the driver. Defaults to the system clock if nil.
+	Clock clock.Clock
+	// AuditLog records every transaction posted to L1. Optional, nil if disabled.
+	AuditLog *audit.Log
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -117,6 +130,8 @@ type BatchSubmitter struct {
 	channelMgrMutex sync.Mutex // guards channelMgr and prevCurrentL1
 	channelMgr      *channelManager
 	prevCurrentL1   eth.L1BlockRef // cached CurrentL1 from the last syncStatus
+
+	interopGate *interopGate // nil if interop gating is disabled
 }
 
 // NewBatchSubmitter initializes the BatchSubmitter driver from a preconfigured DriverSetup
@@ -125,9 +140,17 @@ func NewBatchSubmitter(setup DriverSetup) *BatchSubmitter {
 	if setup.ChannelOutFactory != nil {
 		state.SetChannelOutFactory(setup.ChannelOutFactory)
 	}
+	if setup.Clock == nil {
+		setup.Clock = clock.SystemClock
+	}
+	var gate *interopGate
+	if setup.Config.InteropGating {
+		gate = newInteropGate(setup.Log, setup.Config.InteropGatingTimeout)
+	}
 	return &BatchSubmitter{
 		DriverSetup: setup,
 		channelMgr:  state,
+		interopGate: gate,
 	}
 }
 
@@ -179,7 +202,7 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 // waitForL2Genesis waits for the L2 genesis time to be reached.
 func (l *BatchSubmitter) waitForL2Genesis() error {
 	genesisTime := time.Unix(int64(l.RollupConfig.Genesis.L2Time), 0)
-	now := time.Now()
+	now := l.Clock.Now()
 	if now.After(genesisTime) {
 		return nil
 	}
@@ -187,15 +210,15 @@ func (l *BatchSubmitter) waitForL2Genesis() error {
 	l.Log.Info("Waiting for L2 genesis", "genesisTime", genesisTime)
 
 	// Create a ticker that fires every 30 seconds
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := l.Clock.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
-	genesisTrigger := time.After(time.Until(genesisTime))
+	genesisTrigger := l.Clock.After(genesisTime.Sub(now))
 
 	for {
 		select {
-		case <-ticker.C:
-			remaining := time.Until(genesisTime)
+		case <-ticker.Ch():
+			remaining := genesisTime.Sub(l.Clock.Now())
 			l.Log.Info("Waiting for L2 genesis", "remainingTime", remaining.Round(time.Second))
 		case <-genesisTrigger:
 			l.Log.Info("L2 genesis time reached")
@@ -281,17 +304,9 @@ func (l *BatchSubmitter) loadBlocksIntoState(ctx context.Context, start, end uin
 
 // loadBlockIntoState fetches & stores a single block into `state`. It returns the block it loaded.
 func (l *BatchSubmitter) loadBlockIntoState(ctx context.Context, blockNumber uint64) (*types.Block, error) {
-	l2Client, err := l.EndpointProvider.EthClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("getting L2 client: %w", err)
-	}
-
-	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
-	defer cancel()
-
-	block, err := l2Client.BlockByNumber(cCtx, new(big.Int).SetUint64(blockNumber))
+	block, err := l.fetchBlock(ctx, blockNumber)
 	if err != nil {
-		return nil, fmt.Errorf("getting L2 block: %w", err)
+		return nil, err
 	}
 
 	l.channelMgrMutex.Lock()
@@ -310,6 +325,31 @@ func (l *BatchSubmitter) loadBlockIntoState(ctx context.Context, blockNumber uin
 	return block, nil
 }
 
+// fetchBlock fetches a block from the block source, if any, and falls back to the L2 execution engine RPC.
+func (l *BatchSubmitter) fetchBlock(ctx context.Context, blockNumber uint64) (*types.Block, error) {
+	if l.BlockSource != nil {
+		block, err := l.BlockSource.BlockByNumber(ctx, blockNumber)
+		if err == nil {
+			return block, nil
+		}
+		l.Log.Debug("Block not available from block source, fetching from L2 RPC", "block_number", blockNumber, "err", err)
+	}
+
+	l2Client, err := l.EndpointProvider.EthClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting L2 client: %w", err)
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.Config.NetworkTimeout)
+	defer cancel()
+
+	block, err := l2Client.BlockByNumber(cCtx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("getting L2 block: %w", err)
+	}
+	return block, nil
+}
+
 func (l *BatchSubmitter) getSyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	rollupClient, err := l.EndpointProvider.RollupClient(ctx)
 	if err != nil {
@@ -321,7 +361,7 @@ func (l *BatchSubmitter) getSyncStatus(ctx context.Context) (*eth.SyncStatus, er
 		backoff    = time.Second
 		maxBackoff = 30 * time.Second
 	)
-	timer := time.NewTimer(backoff)
+	timer := l.Clock.NewTimer(backoff)
 	defer timer.Stop()
 
 	for {
@@ -342,7 +382,7 @@ func (l *BatchSubmitter) getSyncStatus(ctx context.Context) (*eth.SyncStatus, er
 		// Empty sync status, implement backoff
 		l.Log.Info("Received empty sync status, backing off", "backoff", backoff)
 		select {
-		case <-timer.C:
+		case <-timer.Ch():
 			backoff *= 2
 			backoff = min(backoff, maxBackoff)
 			// Reset timer to tick of the new backoff time again
@@ -445,12 +485,12 @@ func (l *BatchSubmitter) mainLoop(ctx context.Context, receiptsCh chan txmgr.TxR
 	l.pendingBytesUpdated = make(chan int64)
 	defer close(l.pendingBytesUpdated)
 
-	ticker := time.NewTicker(l.Config.PollInterval)
+	ticker := l.Clock.NewTicker(l.Config.PollInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 
 			if !l.checkTxpool(queue, receiptsCh) {
 				continue
@@ -463,6 +503,9 @@ func (l *BatchSubmitter) mainLoop(ctx context.Context, receiptsCh chan txmgr.TxR
 			}
 
 			blocksToLoad := l.syncAndPrune(syncStatus)
+			if l.interopGate != nil {
+				blocksToLoad = l.interopGate.limit(blocksToLoad, syncStatus.CrossUnsafeL2.Number, l.Clock.Now())
+			}
 
 			if blocksToLoad != nil {
 				// Get fresh unsafe blocks
@@ -476,6 +519,9 @@ func (l *BatchSubmitter) mainLoop(ctx context.Context, receiptsCh chan txmgr.TxR
 			l.publishStateToL1(queue, receiptsCh, daGroup, l.Config.PollInterval)
 
 		case <-ctx.Done():
+			if l.Config.DrainTimeout > 0 {
+				l.drainState(queue, receiptsCh, daGroup)
+			}
 			if err := queue.Wait(); err != nil {
 				l.Log.Error("error waiting for transactions to complete", "err", err)
 			}
@@ -485,6 +531,60 @@ func (l *BatchSubmitter) mainLoop(ctx context.Context, receiptsCh chan txmgr.TxR
 	}
 }
 
+// drainState closes all channels of the channel manager and keeps submitting, and resubmitting, their frames until
+// all of them are confirmed or the drain timeout expires. Once the timeout expires, the kill context is canceled so
+// that in-flight transactions are abandoned.
+func (l *BatchSubmitter) drainState(queue *txmgr.Queue[txRef], receiptsCh chan txmgr.TxReceipt[txRef], daGroup *errgroup.Group) {
+	l.Log.Info("Draining state", "timeout", l.Config.DrainTimeout)
+	drainCtx, cancel := context.WithTimeout(l.killCtx, l.Config.DrainTimeout)
+	defer cancel()
+	stopKill := context.AfterFunc(drainCtx, l.cancelKillCtx)
+	defer stopKill()
+
+	l.channelMgrMutex.Lock()
+	err := l.channelMgr.Close()
+	l.channelMgrMutex.Unlock()
+	if err != nil {
+		l.Log.Error("Error closing channel manager", "err", err)
+	}
+
+	if l.Config.DrainResubmissionTimeout > 0 {
+		if bumper, ok := l.Txmgr.(interface{ SetBumpFeeRetryTime(time.Duration) }); ok {
+			bumper.SetBumpFeeRetryTime(l.Config.DrainResubmissionTimeout)
+		} else {
+			l.Log.Warn("Txmgr does not support changing the resubmission timeout")
+		}
+	}
+
+	ticker := l.Clock.NewTicker(l.Config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		l.publishStateToL1(queue, receiptsCh, daGroup, l.Config.DrainTimeout)
+		if err := daGroup.Wait(); err != nil {
+			l.Log.Error("Error waiting for DA requests to complete", "err", err)
+		}
+		if err := queue.Wait(); err != nil {
+			l.Log.Error("Error waiting for transactions to complete", "err", err)
+		}
+
+		l.channelMgrMutex.Lock()
+		drained := l.channelMgr.Drained()
+		l.channelMgrMutex.Unlock()
+		if drained {
+			l.Log.Info("State drained")
+			return
+		}
+
+		select {
+		case <-ticker.Ch():
+		case <-drainCtx.Done():
+			l.Log.Warn("Drain timeout exceeded, abandoning remaining state")
+			return
+		}
+	}
+}
+
 // processReceiptsLoop handles transaction receipts from the DA layer
 func (l *BatchSubmitter) processReceiptsLoop(ctx context.Context, receiptsCh chan txmgr.TxReceipt[txRef]) {
 	defer l.wg.Done()
@@ -517,7 +617,7 @@ func (l *BatchSubmitter) processReceiptsLoop(ctx context.Context, receiptsCh cha
 func (l *BatchSubmitter) throttlingLoop(ctx context.Context) {
 	defer l.wg.Done()
 	l.Log.Info("Starting DA throttling loop")
-	ticker := time.NewTicker(l.Config.ThrottleInterval)
+	ticker := l.Clock.NewTicker(l.Config.ThrottleInterval)
 	defer ticker.Stop()
 
 	updateParams := func(pendingBytes int64) {
@@ -567,7 +667,7 @@ func (l *BatchSubmitter) throttlingLoop(ctx context.Context) {
 	cachedPendingBytes := int64(0)
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			updateParams(int64(cachedPendingBytes))
 		case pendingBytes := <-l.pendingBytesUpdated:
 			cachedPendingBytes = pendingBytes
@@ -626,7 +726,7 @@ func (l *BatchSubmitter) waitNodeSync() error {
 // queue for publishing or if there was an error queing the data.  maxDuration tells this function to return from state
 // publishing after this amount of time has been exceeded even if there is more data remaining.
 func (l *BatchSubmitter) publishStateToL1(queue *txmgr.Queue[txRef], receiptsCh chan txmgr.TxReceipt[txRef], daGroup *errgroup.Group, maxDuration time.Duration) {
-	start := time.Now()
+	start := l.Clock.Now()
 	for {
 		// if the txmgr is closed, we stop the transaction sending
 		if l.Txmgr.IsClosed() {
@@ -646,7 +746,7 @@ func (l *BatchSubmitter) publishStateToL1(queue *txmgr.Queue[txRef], receiptsCh
 			}
 			return
 		}
-		if time.Since(start) > maxDuration {
+		if l.Clock.Since(start) > maxDuration {
 			l.Log.Warn("Aborting state publishing, max duration exceeded")
 			return
 		}
@@ -677,12 +777,12 @@ func (l *BatchSubmitter) clearState(ctx context.Context) {
 		return
 	}
 
-	tick := time.NewTicker(5 * time.Second)
+	tick := l.Clock.NewTicker(5 * time.Second)
 	defer tick.Stop()
 
 	for {
 		select {
-		case <-tick.C:
+		case <-tick.Ch():
 			if clearStateWithL1Origin() {
 				return
 			}
@@ -855,7 +955,24 @@ func (l *BatchSubmitter) sendTx(txdata txData, isCancel bool, candidate *txmgr.T
 		candidate.GasLimit = intrinsicGas
 	}
 
-	queue.Send(txRef{id: txdata.ID(), isCancel: isCancel, isBlob: txdata.asBlob}, *candidate, receiptsCh)
+	ref := txRef{id: txdata.ID(), isCancel: isCancel, isBlob: txdata.asBlob}
+	if txdata.asBlob && !isCancel {
+		candidate.Obsolete = l.txObsolete(ref.id)
+	}
+	if l.AuditLog != nil {
+		ref.audit = l.newAuditEntry(txdata, isCancel, candidate)
+	}
+	queue.Send(ref, *candidate, receiptsCh)
+}
+
+// txObsolete returns the check whether the data of a pending blob transaction is no longer needed,
+// so that the txmgr may cancel it once it is stuck.
+func (l *BatchSubmitter) txObsolete(id txID) func() bool {
+	return func() bool {
+		l.channelMgrMutex.Lock()
+		defer l.channelMgrMutex.Unlock()
+		return l.channelMgr.TxObsolete(id)
+	}
 }
 
 func (l *BatchSubmitter) blobTxCandidate(data txData) (*txmgr.TxCandidate, error) {
@@ -883,6 +1000,10 @@ func (l *BatchSubmitter) calldataTxCandidate(data []byte) *txmgr.TxCandidate {
 }
 
 func (l *BatchSubmitter) handleReceipt(r txmgr.TxReceipt[txRef]) {
+	// Audit the tx before recording its status, while its channel is still known
+	if r.ID.audit != nil {
+		l.writeAuditEntry(r)
+	}
 	// Record TX Status
 	if r.Err != nil {
 		l.recordFailedTx(r.ID.id, r.Err)
@@ -903,6 +1024,11 @@ func (l *BatchSubmitter) recordFailedDARequest(id txID, err error) {
 func (l *BatchSubmitter) recordFailedTx(id txID, err error) {
 	l.channelMgrMutex.Lock()
 	defer l.channelMgrMutex.Unlock()
+	if errors.Is(err, txmgr.ErrBlobTxCanceled) {
+		l.Log.Warn("Stuck blob transaction canceled", logFields(id, err)...)
+		l.channelMgr.TxCanceled(id)
+		return
+	}
 	l.Log.Warn("Transaction failed to send", logFields(id, err)...)
 	l.channelMgr.TxFailed(id)
 }
@@ -912,9 +1038,17 @@ func (l *BatchSubmitter) recordConfirmedTx(id txID, receipt *types.Receipt) {
 	defer l.channelMgrMutex.Unlock()
 	l.Log.Info("Transaction confirmed", logFields(id, receipt)...)
 	l1block := eth.ReceiptBlockID(receipt)
+	l.channelMgr.TxCost(id, txCostFromReceipt(receipt))
 	l.channelMgr.TxConfirmed(id, l1block)
 }
 
+// ChannelReports returns the economic reports of the most recently submitted channels, oldest first.
+func (l *BatchSubmitter) ChannelReports() []batcherrpc.ChannelReport {
+	l.channelMgrMutex.Lock()
+	defer l.channelMgrMutex.Unlock()
+	return l.channelMgr.ChannelReports()
+}
+
 // l1Tip gets the current L1 tip as a L1BlockRef. The passed context is assumed
 // to be a lifetime context, so it is internally wrapped with a network timeout.
 func (l *BatchSubmitter) l1Tip(ctx context.Context) (eth.L1BlockRef, error) {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -116,3 +118,25 @@ func TestBatchSubmitter_SafeL1Origin_FailsToResolveRollupClient(t *testing.T) {
 	_, err := bs.safeL1Origin(context.Background())
 	require.Error(t, err)
 }
+
+func TestBatchSubmitter_WaitForL2Genesis(t *testing.T) {
+	bs, _ := setup(t)
+	cfg := *bs.RollupConfig
+	cfg.Genesis.L2Time = 1000
+	bs.RollupConfig = &cfg
+	clk := clock.NewDeterministicClock(time.Unix(900, 0))
+	bs.Clock = clk
+	bs.shutdownCtx = context.Background()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- bs.waitForL2Genesis()
+	}()
+	// run through the tickers of the driver until genesis is reached
+	require.Eventually(t, func() bool {
+		clk.AdvanceToNextTask()
+		return len(result) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, <-result)
+	require.False(t, clk.Now().Before(time.Unix(1000, 0)), "should not return before genesis")
+}
@@ -0,0 +1,104 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxGossipBlocks is the number of gossiped blocks to retain, counting back from the highest block.
+const maxGossipBlocks = 1024
+
+var ErrBlockNotGossiped = errors.New("block not received from gossip")
+
+// BlockSource provides the L2 blocks to batch. Blocks that are not available from the block source
+// are fetched from the L2 execution engine RPC instead.
+type BlockSource interface {
+	BlockByNumber(ctx context.Context, number uint64) (*types.Block, error)
+}
+
+// GossipBlockSource follows the unsafe blocks of the sequencer on the P2P gossip network,
+// so the batcher does not depend on the L2 execution engine RPC to retrieve the blocks to batch.
+// Only blocks signed by the unsafe block signer are received, as enforced by the gossip validation.
+type GossipBlockSource struct {
+	log log.Logger
+
+	mu      sync.Mutex
+	blocks  map[uint64]*types.Block
+	highest uint64
+}
+
+var (
+	_ BlockSource  = (*GossipBlockSource)(nil)
+	_ p2p.GossipIn = (*GossipBlockSource)(nil)
+)
+
+func NewGossipBlockSource(log log.Logger) *GossipBlockSource {
+	return &GossipBlockSource{
+		log:    log,
+		blocks: make(map[uint64]*types.Block),
+	}
+}
+
+// OnUnsafeL2Payload is called by the p2p node for every gossiped payload that passed validation.
+// A payload replaces any earlier payload of the same block number, as the sequencer may reorg its unsafe chain.
+func (s *GossipBlockSource) OnUnsafeL2Payload(ctx context.Context, from peer.ID, envelope *eth.ExecutionPayloadEnvelope) error {
+	block, err := envelope.Block()
+	if err != nil {
+		return fmt.Errorf("invalid gossiped payload %s from %s: %w", envelope.ExecutionPayload.ID(), from, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	num := block.NumberU64()
+	if num+maxGossipBlocks <= s.highest {
+		return nil // too old to be batched
+	}
+	s.blocks[num] = block
+	if num > s.highest {
+		s.highest = num
+		for n := range s.blocks {
+			if n+maxGossipBlocks <= s.highest {
+				delete(s.blocks, n)
+			}
+		}
+	}
+	s.log.Debug("Received block from gossip", "block", eth.ToBlockID(block), "peer", from)
+	return nil
+}
+
+// BlockByNumber returns the gossiped block of the given number, or ErrBlockNotGossiped if it was not received.
+func (s *GossipBlockSource) BlockByNumber(_ context.Context, number uint64) (*types.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	block, ok := s.blocks[number]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrBlockNotGossiped, number)
+	}
+	return block, nil
+}
+
+// unsafeBlockSigner is the address that gossiped blocks must be signed by.
+type unsafeBlockSigner common.Address
+
+func (s unsafeBlockSigner) P2PSequencerAddress() common.Address {
+	return common.Address(s)
+}
+
+// GossipMaxSize applies the default gossip size limit.
+func (s unsafeBlockSigner) GossipMaxSize() uint64 {
+	return 0
+}
+
+// GossipMaxBlocksPerHeight applies the default limit of blocks per height.
+func (s unsafeBlockSigner) GossipMaxBlocksPerHeight() uint64 {
+	return 0
+}
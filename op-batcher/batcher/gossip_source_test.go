@@ -0,0 +1,97 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func randomBlockAt(t *testing.T, rng *rand.Rand, num uint64) (*types.Block, *eth.ExecutionPayloadEnvelope) {
+	block, _ := testutils.RandomBlock(rng, 2)
+	header := block.Header()
+	header.Number = new(big.Int).SetUint64(num)
+	block = types.NewBlockWithHeader(header).WithBody(*block.Body())
+	envelope, err := eth.BlockAsPayloadEnv(block, nil)
+	require.NoError(t, err)
+	return block, envelope
+}
+
+func TestGossipBlockSource(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(123))
+	src := NewGossipBlockSource(testlog.Logger(t, log.LevelDebug))
+
+	_, err := src.BlockByNumber(ctx, 10)
+	require.ErrorIs(t, err, ErrBlockNotGossiped)
+
+	block, envelope := randomBlockAt(t, rng, 10)
+	require.NoError(t, src.OnUnsafeL2Payload(ctx, "peer", envelope))
+	got, err := src.BlockByNumber(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), got.Hash())
+	require.Equal(t, len(block.Transactions()), len(got.Transactions()))
+
+	t.Run("Replace", func(t *testing.T) {
+		reorged, envelope := randomBlockAt(t, rng, 10)
+		require.NoError(t, src.OnUnsafeL2Payload(ctx, "peer", envelope))
+		got, err := src.BlockByNumber(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, reorged.Hash(), got.Hash())
+	})
+
+	t.Run("InvalidBlockHash", func(t *testing.T) {
+		_, envelope := randomBlockAt(t, rng, 11)
+		envelope.ExecutionPayload.BlockHash[0] ^= 1
+		require.ErrorContains(t, src.OnUnsafeL2Payload(ctx, "peer", envelope), "does not match")
+		_, err := src.BlockByNumber(ctx, 11)
+		require.ErrorIs(t, err, ErrBlockNotGossiped)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		_, envelope := randomBlockAt(t, rng, 10+maxGossipBlocks)
+		require.NoError(t, src.OnUnsafeL2Payload(ctx, "peer", envelope))
+		_, err := src.BlockByNumber(ctx, 10)
+		require.ErrorIs(t, err, ErrBlockNotGossiped)
+		_, err = src.BlockByNumber(ctx, 10+maxGossipBlocks)
+		require.NoError(t, err)
+
+		// blocks too old to be batched are ignored
+		_, envelope = randomBlockAt(t, rng, 9)
+		require.NoError(t, src.OnUnsafeL2Payload(ctx, "peer", envelope))
+		_, err = src.BlockByNumber(ctx, 9)
+		require.ErrorIs(t, err, ErrBlockNotGossiped)
+	})
+}
+
+func TestBatchSubmitter_FetchBlockFromBlockSource(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(123))
+	bs, ep := setup(t)
+	src := NewGossipBlockSource(testlog.Logger(t, log.LevelDebug))
+	bs.BlockSource = src
+	bs.Config.NetworkTimeout = time.Second
+
+	gossiped, envelope := randomBlockAt(t, rng, 10)
+	require.NoError(t, src.OnUnsafeL2Payload(ctx, "peer", envelope))
+	block, err := bs.fetchBlock(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, gossiped.Hash(), block.Hash())
+
+	// blocks missing from gossip are fetched from the L2 RPC
+	fetched, _ := randomBlockAt(t, rng, 11)
+	ep.ethClient.ExpectBlockByNumber(big.NewInt(11), fetched, nil)
+	block, err = bs.fetchBlock(ctx, 11)
+	require.NoError(t, err)
+	require.Equal(t, fetched.Hash(), block.Hash())
+	ep.ethClient.AssertExpectations(t)
+}
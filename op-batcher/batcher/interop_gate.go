@@ -0,0 +1,49 @@
+package batcher
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// interopGate holds back unsafe blocks from the channel manager until the supervisor reports them
+// cross-unsafe, as reported by the rollup node in its sync status. Blocks that are not cross-unsafe
+// may still be replaced during interop consolidation, so batching them risks posting data for blocks
+// that never become safe. To not stall batching, e.g. if the supervisor is down, a block is released
+// anyway once it has been held back for longer than the timeout.
+type interopGate struct {
+	log     log.Logger
+	timeout time.Duration
+
+	// heldBack is the lowest block that is held back, since heldBackSince.
+	heldBack      uint64
+	heldBackSince time.Time
+}
+
+func newInteropGate(log log.Logger, timeout time.Duration) *interopGate {
+	return &interopGate{log: log, timeout: timeout}
+}
+
+// limit returns the part of the block range that may be loaded into the channel manager,
+// or nil if all blocks of the range are held back.
+func (g *interopGate) limit(blocks *inclusiveBlockRange, crossUnsafe uint64, now time.Time) *inclusiveBlockRange {
+	if blocks == nil || blocks.end <= crossUnsafe {
+		g.heldBackSince = time.Time{}
+		return blocks
+	}
+	first := max(blocks.start, crossUnsafe+1)
+	if g.heldBackSince.IsZero() || g.heldBack != first {
+		g.heldBack, g.heldBackSince = first, now
+	}
+	if waited := now.Sub(g.heldBackSince); waited >= g.timeout {
+		g.log.Warn("Blocks not cross-unsafe before interop gating timeout, batching them anyway",
+			"first", first, "last", blocks.end, "crossUnsafe", crossUnsafe, "waited", waited)
+		g.heldBackSince = time.Time{}
+		return blocks
+	}
+	if first == blocks.start {
+		g.log.Debug("Holding back blocks until cross-unsafe", "first", first, "last", blocks.end)
+		return nil
+	}
+	return &inclusiveBlockRange{blocks.start, crossUnsafe}
+}
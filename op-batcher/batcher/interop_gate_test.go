@@ -0,0 +1,35 @@
+package batcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestInteropGate(t *testing.T) {
+	start := time.Unix(1000, 0)
+	g := newInteropGate(testlog.Logger(t, log.LevelDebug), time.Minute)
+
+	require.Nil(t, g.limit(nil, 5, start))
+	require.Equal(t, &inclusiveBlockRange{1, 5}, g.limit(&inclusiveBlockRange{1, 5}, 5, start), "all cross-unsafe")
+	require.Equal(t, &inclusiveBlockRange{1, 5}, g.limit(&inclusiveBlockRange{1, 8}, 5, start), "capped at cross-unsafe")
+	require.Nil(t, g.limit(&inclusiveBlockRange{6, 8}, 5, start.Add(30*time.Second)), "held back")
+
+	// The timeout runs since block 6 was first held back
+	require.Equal(t, &inclusiveBlockRange{6, 9}, g.limit(&inclusiveBlockRange{6, 9}, 5, start.Add(time.Minute)), "released after timeout")
+
+	// A new block restarts the timeout
+	require.Nil(t, g.limit(&inclusiveBlockRange{10, 10}, 5, start.Add(61*time.Second)))
+	require.Nil(t, g.limit(&inclusiveBlockRange{10, 11}, 9, start.Add(90*time.Second)))
+	require.Equal(t, &inclusiveBlockRange{10, 11}, g.limit(&inclusiveBlockRange{10, 11}, 11, start.Add(91*time.Second)))
+
+	// Progress of the cross-unsafe head restarts the timeout as well
+	require.Equal(t, &inclusiveBlockRange{12, 12}, g.limit(&inclusiveBlockRange{12, 14}, 12, start.Add(100*time.Second)))
+	require.Nil(t, g.limit(&inclusiveBlockRange{13, 14}, 12, start.Add(159*time.Second)))
+	require.Equal(t, &inclusiveBlockRange{13, 14}, g.limit(&inclusiveBlockRange{13, 14}, 12, start.Add(160*time.Second)))
+}
@@ -9,14 +9,19 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-batcher/audit"
 	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	opnodemetrics "github.com/ethereum-optimism/optimism/op-node/metrics"
+	opnode "github.com/ethereum-optimism/optimism/op-node/node"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
@@ -49,6 +54,13 @@ type BatcherConfig struct {
 	ThrottleThreshold, ThrottleTxSize          uint64
 	ThrottleBlockSize, ThrottleAlwaysBlockSize uint64
 	ThrottleInterval                           time.Duration
+
+	// For draining the pending data on shutdown. See CLIConfig in config.go for details on these parameters.
+	DrainTimeout, DrainResubmissionTimeout time.Duration
+
+	// For gating batching on the cross-unsafe status of blocks. See CLIConfig in config.go for details on these parameters.
+	InteropGating        bool
+	InteropGatingTimeout time.Duration
 }
 
 // BatcherService represents a full batch-submitter instance and its resources,
@@ -59,7 +71,7 @@ type BatcherService struct {
 	L1Client         *ethclient.Client
 	EndpointProvider dial.L2EndpointProvider
 	TxManager        txmgr.TxManager
-	AltDA            *altda.DAClient
+	AltDA            altda.DAStorage
 
 	BatcherConfig
 
@@ -68,6 +80,14 @@ type BatcherService struct {
 
 	driver *BatchSubmitter
 
+	// audit log of the posted transactions, nil if disabled
+	auditLog *audit.Log
+
+	// gossip-follow mode, nil if disabled
+	gossipSource *GossipBlockSource
+	p2pNode      *p2p.NodeP2P
+	p2pClose     context.CancelFunc
+
 	Version string
 
 	pprofService *oppprof.Service
@@ -113,6 +133,12 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	bs.ThrottleAlwaysBlockSize = cfg.ThrottleAlwaysBlockSize
 	bs.ThrottleInterval = cfg.ThrottleInterval
 
+	bs.DrainTimeout = cfg.DrainTimeout
+	bs.DrainResubmissionTimeout = cfg.DrainResubmissionTimeout
+
+	bs.InteropGating = cfg.InteropGating
+	bs.InteropGatingTimeout = cfg.InteropGatingTimeout
+
 	if err := bs.initRPCClients(ctx, cfg); err != nil {
 		return err
 	}
@@ -129,6 +155,12 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	if err := bs.initChannelConfig(cfg); err != nil {
 		return fmt.Errorf("failed to init channel config: %w", err)
 	}
+	if err := bs.initGossipFollow(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init gossip-follow mode: %w", err)
+	}
+	if err := bs.initAuditLog(cfg); err != nil {
+		return fmt.Errorf("failed to init audit log: %w", err)
+	}
 	bs.initBalanceMonitor(cfg)
 	if err := bs.initMetricsServer(cfg); err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
@@ -202,6 +234,51 @@ func (bs *BatcherService) initRollupConfig(ctx context.Context) error {
 	return nil
 }
 
+// initGossipFollow starts a p2p node to receive the blocks to batch from the gossip network.
+// The gossiped blocks are validated against the unsafe block signer of the L1 SystemConfig.
+func (bs *BatcherService) initGossipFollow(ctx context.Context, cfg *CLIConfig) error {
+	if !cfg.GossipFollow {
+		return nil
+	}
+	setup, err := cfg.P2PConfig(bs.RollupConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load p2p config: %w", err)
+	}
+	if setup.Disabled() {
+		return errors.New("p2p must not be disabled in gossip-follow mode")
+	}
+	signerVal, err := bs.L1Client.StorageAt(ctx, bs.RollupConfig.L1SystemConfigAddress, opnode.UnsafeBlockSignerAddressSystemConfigStorageSlot, nil)
+	if err != nil {
+		return fmt.Errorf("failed to load unsafe block signer: %w", err)
+	}
+	signer := unsafeBlockSigner(common.BytesToAddress(signerVal))
+	bs.Log.Info("Following blocks from gossip", "unsafe_block_signer", signer.P2PSequencerAddress())
+
+	bs.gossipSource = NewGossipBlockSource(bs.Log)
+	resourcesCtx, resourcesClose := context.WithCancel(context.Background())
+	bs.p2pClose = resourcesClose
+	// the batcher does not serve or request blocks with req-resp sync, only gossip is used
+	bs.p2pNode, err = p2p.NewNodeP2P(resourcesCtx, bs.RollupConfig, bs.Log.New("module", "p2p"), setup,
+		bs.gossipSource, nil, signer, opnodemetrics.NoopMetrics, true)
+	if err != nil {
+		return fmt.Errorf("failed to start p2p node: %w", err)
+	}
+	return nil
+}
+
+func (bs *BatcherService) initAuditLog(cfg *CLIConfig) error {
+	if cfg.AuditLogPath == "" {
+		return nil
+	}
+	auditLog, err := audit.OpenLog(cfg.AuditLogPath)
+	if err != nil {
+		return err
+	}
+	bs.auditLog = auditLog
+	bs.Log.Info("Recording posted transactions to audit log", "path", cfg.AuditLogPath)
+	return nil
+}
+
 func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 	channelTimeout := bs.RollupConfig.ChannelTimeoutBedrock
 	// Use lower channel timeout if granite is scheduled.
@@ -237,6 +314,7 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 	}
 
 	cc.InitCompressorConfig(cfg.ApproxComprRatio, cfg.Compressor, cfg.CompressionAlgo)
+	cc.AutoTuneCompression = cfg.CompressionAutoTune
 
 	if cc.UseBlobs && !bs.RollupConfig.IsEcotone(uint64(time.Now().Unix())) {
 		return errors.New("cannot use Blobs before Ecotone")
@@ -260,6 +338,7 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		"target_num_frames", cc.TargetNumFrames,
 		"compressor", cc.CompressorConfig.Kind,
 		"compression_algo", cc.CompressorConfig.CompressionAlgo,
+		"compression_auto_tune", cc.AutoTuneCompression,
 		"batch_type", cc.BatchType,
 		"max_channel_duration", cc.MaxChannelDuration,
 		"channel_timeout", cc.ChannelTimeout,
@@ -340,6 +419,10 @@ func (bs *BatcherService) initDriver(opts ...DriverSetupOption) {
 		EndpointProvider: bs.EndpointProvider,
 		ChannelConfig:    bs.ChannelConfig,
 		AltDA:            bs.AltDA,
+		AuditLog:         bs.auditLog,
+	}
+	if bs.gossipSource != nil {
+		ds.BlockSource = bs.gossipSource
 	}
 	for _, opt := range opts {
 		opt(&ds)
@@ -354,6 +437,12 @@ func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
 		bs.Version,
 		oprpc.WithLogger(bs.Log),
 	)
+	server.HealthChecker().Register("l1", func(ctx context.Context) error {
+		_, err := bs.L1Client.BlockNumber(ctx)
+		return err
+	})
+	server.HealthChecker().Register("l2", dial.L2EthHealthProbe(bs.EndpointProvider))
+	server.HealthChecker().Register("rollup", dial.RollupHealthProbe(bs.EndpointProvider))
 	if cfg.RPC.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(bs.driver, bs.Metrics, bs.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
@@ -373,7 +462,11 @@ func (bs *BatcherService) initAltDA(cfg *CLIConfig) error {
 	if err := config.Check(); err != nil {
 		return err
 	}
-	bs.AltDA = config.NewDAClient()
+	storage, err := config.NewDAStorage()
+	if err != nil {
+		return err
+	}
+	bs.AltDA = storage
 	bs.UseAltDA = config.Enabled
 	return nil
 }
@@ -411,8 +504,9 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 	bs.Log.Info("Stopping batcher")
 
 	// close the TxManager first, so that new work is denied, in-flight work is cancelled as early as possible
-	// (transactions which are expected to be confirmed are still waited for)
-	if bs.TxManager != nil {
+	// (transactions which are expected to be confirmed are still waited for).
+	// When draining, the TxManager is only closed once the driver submitted the pending data.
+	if bs.TxManager != nil && bs.DrainTimeout == 0 {
 		bs.TxManager.Close()
 	}
 
@@ -422,6 +516,14 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop batch submitting: %w", err))
 		}
 	}
+	if bs.TxManager != nil && bs.DrainTimeout > 0 {
+		bs.TxManager.Close()
+	}
+	if bs.auditLog != nil {
+		if err := bs.auditLog.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close audit log: %w", err))
+		}
+	}
 
 	if bs.rpcServer != nil {
 		// TODO(7685): the op-service RPC server is not built on top of op-service httputil Server, and has poor shutdown
@@ -446,6 +548,15 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 		}
 	}
 
+	if bs.p2pNode != nil {
+		if err := bs.p2pNode.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close p2p node: %w", err))
+		}
+	}
+	if bs.p2pClose != nil {
+		bs.p2pClose()
+	}
+
 	if bs.L1Client != nil {
 		bs.L1Client.Close()
 	}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/audit"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+var (
+	auditLogFlag = &cli.PathFlag{
+		Name:      "log",
+		Usage:     "Path of the audit log written by the batcher with --audit-log",
+		TakesFile: true,
+		Required:  true,
+	}
+	auditTxFlag = &cli.StringFlag{
+		Name:  "tx",
+		Usage: "Only show the entry of the transaction with this hash",
+	}
+	auditChannelFlag = &cli.StringFlag{
+		Name:  "channel",
+		Usage: "Only show the entries of transactions carrying frames of this channel ID",
+	}
+	auditL2BlockFlag = &cli.Uint64Flag{
+		Name:  "l2-block",
+		Usage: "Only show the entries of transactions whose channels contain this L2 block number",
+	}
+	auditSinceFlag = &cli.TimestampFlag{
+		Name:     "since",
+		Usage:    "Only show the entries written at or after this time, in UTC",
+		Layout:   "2006-01-02T15:04:05",
+		Timezone: time.UTC,
+	}
+	auditUntilFlag = &cli.TimestampFlag{
+		Name:     "until",
+		Usage:    "Only show the entries written at or before this time, in UTC",
+		Layout:   "2006-01-02T15:04:05",
+		Timezone: time.UTC,
+	}
+)
+
+// AuditCommand queries the audit log of the transactions posted by the batcher.
+var AuditCommand = &cli.Command{
+	Name:        "audit",
+	Usage:       "Query the audit log of the batcher transactions posted to L1",
+	Description: "Prints the matching entries of the audit log as JSON, one entry per line, in the order they were written.",
+	Flags:       []cli.Flag{auditLogFlag, auditTxFlag, auditChannelFlag, auditL2BlockFlag, auditSinceFlag, auditUntilFlag},
+	Action:      queryAuditLog,
+}
+
+func queryAuditLog(ctx *cli.Context) error {
+	var filter audit.Filter
+	if ctx.IsSet(auditTxFlag.Name) {
+		txHash := common.HexToHash(ctx.String(auditTxFlag.Name))
+		filter.TxHash = &txHash
+	}
+	if ctx.IsSet(auditChannelFlag.Name) {
+		var id derive.ChannelID
+		if err := id.UnmarshalText([]byte(ctx.String(auditChannelFlag.Name))); err != nil {
+			return fmt.Errorf("invalid channel ID: %w", err)
+		}
+		filter.ChannelID = &id
+	}
+	if ctx.IsSet(auditL2BlockFlag.Name) {
+		num := ctx.Uint64(auditL2BlockFlag.Name)
+		filter.L2Block = &num
+	}
+	if since := ctx.Timestamp(auditSinceFlag.Name); since != nil {
+		filter.Since = uint64(since.Unix())
+	}
+	if until := ctx.Timestamp(auditUntilFlag.Name); until != nil {
+		filter.Until = uint64(until.Unix())
+	}
+	entries, err := audit.QueryFile(ctx.Path(auditLogFlag.Name), filter)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -38,6 +38,7 @@ func main() {
 			Name:        "doc",
 			Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
 		},
+		AuditCommand,
 	}
 
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
@@ -10,6 +10,7 @@ import (
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	opnodeflags "github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
@@ -114,6 +115,12 @@ var (
 			return &out
 		}(),
 	}
+	CompressionAutoTuneFlag = &cli.BoolFlag{
+		Name: "compression-auto-tune",
+		Usage: "Tune the brotli compression level of each channel, starting from the configured compression algorithm. " +
+			"The level is lowered when compression falls behind, and raised when the batcher is idle and the higher level compresses better.",
+		EnvVars: prefixEnvVars("COMPRESSION_AUTO_TUNE"),
+	}
 	StoppedFlag = &cli.BoolFlag{
 		Name:    "stopped",
 		Usage:   "Initialize the batcher in a stopped state. The batcher can be started using the admin_startBatcher RPC",
@@ -186,6 +193,47 @@ var (
 		Value:   130_000, // should be larger than the builder's max-l2-tx-size to prevent endlessly throttling some txs
 		EnvVars: prefixEnvVars("THROTTLE_ALWAYS_BLOCK_SIZE"),
 	}
+	DrainTimeoutFlag = &cli.DurationFlag{
+		Name: "drain-timeout",
+		Usage: "Maximum duration to spend on shutdown closing the open channels, submitting all pending frames and waiting for " +
+			"their confirmation, before exiting. Zero stops without draining, which may leave data unsubmitted.",
+		Value:   0,
+		EnvVars: prefixEnvVars("DRAIN_TIMEOUT"),
+	}
+	DrainResubmissionTimeoutFlag = &cli.DurationFlag{
+		Name:    "drain-resubmission-timeout",
+		Usage:   "Fee bumping interval of transactions sent while draining on shutdown. Zero keeps the configured resubmission timeout.",
+		Value:   0,
+		EnvVars: prefixEnvVars("DRAIN_RESUBMISSION_TIMEOUT"),
+	}
+	InteropGatingFlag = &cli.BoolFlag{
+		Name: "interop-gating",
+		Usage: "Hold back unsafe blocks from being batched until the rollup node reports them cross-unsafe, " +
+			"reducing the chance of posting data for blocks that are replaced during interop consolidation.",
+		Value:   false,
+		EnvVars: prefixEnvVars("INTEROP_GATING"),
+	}
+	InteropGatingTimeoutFlag = &cli.DurationFlag{
+		Name:    "interop-gating-timeout",
+		Usage:   "Maximum duration to hold back a block that is not cross-unsafe with interop gating, before batching it anyway.",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("INTEROP_GATING_TIMEOUT"),
+	}
+	GossipFollowFlag = &cli.BoolFlag{
+		Name: "gossip-follow",
+		Usage: "Load the blocks to batch from the unsafe blocks gossiped by the sequencer on the P2P network, " +
+			"and only fetch blocks from the L2 execution engine RPC if they were not received from gossip. " +
+			"The P2P stack is configured with the p2p flags. The unsafe block signer is loaded from the L1 SystemConfig at startup.",
+		Value:   false,
+		EnvVars: prefixEnvVars("GOSSIP_FOLLOW"),
+	}
+	AuditLogFlag = &cli.PathFlag{
+		Name: "audit-log",
+		Usage: "Path of the append-only audit log recording every batcher transaction posted to L1, " +
+			"with its frames, channels, L2 block range and data commitments. Query it with the 'audit' command. Disabled if empty.",
+		TakesFile: true,
+		EnvVars:   prefixEnvVars("AUDIT_LOG"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -214,11 +262,18 @@ var optionalFlags = []cli.Flag{
 	DataAvailabilityTypeFlag,
 	ActiveSequencerCheckDurationFlag,
 	CompressionAlgoFlag,
+	CompressionAutoTuneFlag,
 	ThrottleThresholdFlag,
 	ThrottleIntervalFlag,
 	ThrottleTxSizeFlag,
 	ThrottleBlockSizeFlag,
 	ThrottleAlwaysBlockSizeFlag,
+	DrainTimeoutFlag,
+	DrainResubmissionTimeoutFlag,
+	InteropGatingFlag,
+	InteropGatingTimeoutFlag,
+	GossipFollowFlag,
+	AuditLogFlag,
 }
 
 func init() {
@@ -228,6 +283,7 @@ func init() {
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, altda.CLIFlags(EnvVarPrefix, "")...)
+	optionalFlags = append(optionalFlags, opnodeflags.P2PFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -42,6 +43,8 @@ type Metricer interface {
 	RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
 	RecordChannelFullySubmitted(id derive.ChannelID)
 	RecordChannelTimedOut(id derive.ChannelID)
+	RecordChannelCost(id derive.ChannelID, numL2Blocks int, submittedBytes int, totalCostWei float64, costPerL2Gas float64)
+	RecordCompressionLevel(level int, busy float64)
 
 	RecordBatchTxSubmitted()
 	RecordBatchTxSuccess()
@@ -86,6 +89,15 @@ type Metrics struct {
 	channelComprRatio       prometheus.Histogram
 	channelInputBytesTotal  prometheus.Counter
 	channelOutputBytesTotal prometheus.Counter
+	compressionLevel        prometheus.Gauge
+	compressionBusy         prometheus.Histogram
+
+	channelL1CostTotal        prometheus.Counter
+	channelL1Cost             prometheus.Histogram
+	channelSubmittedBytes     prometheus.Counter
+	channelL2Blocks           prometheus.Histogram
+	channelCostPerL2Gas       prometheus.Gauge
+	channelSubmittedBytesCost prometheus.Gauge
 
 	batcherTxEvs opmetrics.EventVec
 
@@ -181,6 +193,17 @@ func NewMetrics(procName string) *Metrics {
 			Help:      "Compression ratios of closed channel.",
 			Buckets:   append([]float64{0.1, 0.2}, prometheus.LinearBuckets(0.3, 0.05, 14)...),
 		}),
+		compressionLevel: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "compression_level",
+			Help:      "Compression level used for new channels.",
+		}),
+		compressionBusy: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_compression_busy",
+			Help:      "Fraction of the time a closed channel was open, that was spent compressing its blocks.",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+		}),
 		channelInputBytesTotal: factory.NewCounter(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      "input_bytes_total",
@@ -198,6 +221,39 @@ func NewMetrics(procName string) *Metrics {
 			Buckets:   prometheus.LinearBuckets(0.0, eth.MaxBlobDataSize/13, 14),
 		}),
 
+		channelL1CostTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "channel_l1_cost_gwei_total",
+			Help:      "Total L1 cost in gwei (blob and execution gas) of submitted channels.",
+		}),
+		channelL1Cost: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_l1_cost_gwei",
+			Help:      "L1 cost in gwei (blob and execution gas) of submitted channels.",
+			Buckets:   prometheus.ExponentialBuckets(1e3, 4, 14),
+		}),
+		channelSubmittedBytes: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "channel_submitted_bytes_total",
+			Help:      "Total number of frame data bytes submitted to L1 by confirmed batcher transactions of submitted channels.",
+		}),
+		channelL2Blocks: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_l2_blocks",
+			Help:      "Number of L2 blocks covered by submitted channels.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+		}),
+		channelCostPerL2Gas: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "channel_cost_per_l2_gas_wei",
+			Help:      "Effective L1 cost in wei per L2 gas of the last submitted channel.",
+		}),
+		channelSubmittedBytesCost: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "channel_cost_per_byte_wei",
+			Help:      "Effective L1 cost in wei per submitted byte of the last submitted channel.",
+		}),
+
 		batcherTxEvs: opmetrics.NewEventVec(factory, ns, "", "batcher_tx", "BatcherTx", []string{"stage"}),
 	}
 	m.pendingDABytesGaugeFunc = factory.NewGaugeFunc(prometheus.GaugeOpts{
@@ -323,6 +379,18 @@ func (m *Metrics) RecordChannelTimedOut(id derive.ChannelID) {
 	m.channelEvs.Record(StageTimedOut)
 }
 
+// RecordChannelCost records the L1 cost of a channel, after it got fully submitted or timed out.
+func (m *Metrics) RecordChannelCost(id derive.ChannelID, numL2Blocks int, submittedBytes int, totalCostWei float64, costPerL2Gas float64) {
+	m.channelL1CostTotal.Add(totalCostWei / params.GWei)
+	m.channelL1Cost.Observe(totalCostWei / params.GWei)
+	m.channelSubmittedBytes.Add(float64(submittedBytes))
+	m.channelL2Blocks.Observe(float64(numL2Blocks))
+	m.channelCostPerL2Gas.Set(costPerL2Gas)
+	if submittedBytes > 0 {
+		m.channelSubmittedBytesCost.Set(totalCostWei / float64(submittedBytes))
+	}
+}
+
 func (m *Metrics) RecordBatchTxSubmitted() {
 	m.batcherTxEvs.Record(TxStageSubmitted)
 }
@@ -335,6 +403,13 @@ func (m *Metrics) RecordBatchTxFailed() {
 	m.batcherTxEvs.Record(TxStageFailed)
 }
 
+// RecordCompressionLevel records the compression level used for new channels, and the fraction of the time
+// the last closed channel was open that was spent compressing, which the level is tuned on.
+func (m *Metrics) RecordCompressionLevel(level int, busy float64) {
+	m.compressionLevel.Set(float64(level))
+	m.compressionBusy.Observe(busy)
+}
+
 func (m *Metrics) RecordBlobUsedBytes(num int) {
 	m.blobUsedBytes.Observe(float64(num))
 }
@@ -40,6 +40,10 @@ func (*noopMetrics) RecordChannelClosed(derive.ChannelID, int, int, int, int, er
 func (*noopMetrics) RecordChannelFullySubmitted(derive.ChannelID) {}
 func (*noopMetrics) RecordChannelTimedOut(derive.ChannelID)       {}
 
+func (*noopMetrics) RecordChannelCost(derive.ChannelID, int, int, float64, float64) {}
+
+func (*noopMetrics) RecordCompressionLevel(int, float64) {}
+
 func (*noopMetrics) RecordBatchTxSubmitted() {}
 func (*noopMetrics) RecordBatchTxSuccess()   {}
 func (*noopMetrics) RecordBatchTxFailed()    {}
@@ -13,6 +13,7 @@ import (
 type BatcherDriver interface {
 	StartBatchSubmitting() error
 	StopBatchSubmitting(ctx context.Context) error
+	ChannelReports() []ChannelReport
 }
 
 type adminAPI struct {
@@ -41,3 +42,8 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.StopBatchSubmitting(ctx)
 }
+
+// ChannelReports returns the economic reports of the most recently submitted channels, oldest first.
+func (a *adminAPI) ChannelReports(_ context.Context) ([]ChannelReport, error) {
+	return a.b.ChannelReports(), nil
+}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ChannelReport is the economic report of a channel: the L1 cost of submitting it,
+// and the L2 data it covers. Operators can use it to tune channel target sizes and compression.
+type ChannelReport struct {
+	ID derive.ChannelID `json:"id"`
+
+	OldestL2 eth.BlockID `json:"oldestL2"`
+	LatestL2 eth.BlockID `json:"latestL2"`
+	// L2Blocks is the number of L2 blocks covered by the channel.
+	L2Blocks int `json:"l2Blocks"`
+	// L2GasUsed is the total gas used by the L2 blocks covered by the channel.
+	L2GasUsed uint64 `json:"l2GasUsed"`
+
+	// InputBytes is the size of the uncompressed channel input.
+	InputBytes int `json:"inputBytes"`
+	// SubmittedBytes is the size of the frame data of all confirmed batcher transactions.
+	SubmittedBytes int `json:"submittedBytes"`
+	// Txs is the number of confirmed batcher transactions.
+	Txs int `json:"txs"`
+
+	ExecutionGasUsed uint64       `json:"executionGasUsed"`
+	ExecutionCost    *hexutil.Big `json:"executionCost"`
+	BlobGasUsed      uint64       `json:"blobGasUsed"`
+	BlobCost         *hexutil.Big `json:"blobCost"`
+	// TotalCost is the total L1 cost in wei, blob and execution gas combined.
+	TotalCost *hexutil.Big `json:"totalCost"`
+	// CostPerL2Gas is the effective L1 cost in wei per unit of L2 gas covered by the channel.
+	CostPerL2Gas float64 `json:"costPerL2Gas"`
+
+	// TimedOut is true if the channel timed out on L1, and its cost was spent without effect.
+	TimedOut bool `json:"timedOut"`
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+// maxAttempts is the number of attempts to fetch the data of a block, before giving up on the verification.
+const maxAttempts = 10
+
+var errBlockNotFound = errors.New("block not found")
+
+type rpcCaller interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+// mismatch is a value that differs between the data served by the old and the new EL.
+type mismatch struct {
+	Block uint64 `json:"block"`
+	// Path locates the value, e.g. "receipts[2].logs[0].data"
+	Path string `json:"path"`
+	Old  any    `json:"old"`
+	New  any    `json:"new"`
+}
+
+// checker compares the blocks, transactions, receipts and logs served by two ELs.
+// The raw JSON responses are compared, so that fields that are unknown to this tool are verified as well.
+type checker struct {
+	log          log.Logger
+	oldEL        rpcCaller
+	newEL        rpcCaller
+	workers      int
+	batchSize    uint64
+	progressPath string
+	// ignore contains the names of the fields that are not compared
+	ignore map[string]bool
+	out    io.Writer
+}
+
+// run verifies the blocks from start to end (exclusive), resuming from the progress file if it exists,
+// and returns the total number of mismatches found.
+func (c *checker) run(ctx context.Context, start uint64, end uint64) (uint64, error) {
+	p := progress{Next: start}
+	if c.progressPath != "" {
+		prev, ok, err := loadProgress(c.progressPath)
+		if err != nil {
+			return 0, err
+		}
+		if ok && prev.Next > start {
+			c.log.Info("Resuming verification", "next", prev.Next, "mismatches", prev.Mismatches)
+			p = prev
+		}
+	}
+	batchSize := max(c.batchSize, 1)
+	c.log.Info("Starting verification", "start", p.Next, "end", end, "workers", c.workers, "batchSize", batchSize)
+	startT := time.Now()
+	for p.Next < end {
+		batchEnd := min(p.Next+batchSize, end)
+		results := make([][]mismatch, batchEnd-p.Next)
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(max(c.workers, 1))
+		for n := p.Next; n < batchEnd; n++ {
+			g.Go(func() error {
+				res, err := retry.Do(gctx, maxAttempts, retry.Exponential(), func() ([]mismatch, error) {
+					return c.checkBlock(gctx, n)
+				})
+				if err != nil {
+					return fmt.Errorf("failed to verify block %d: %w", n, err)
+				}
+				results[n-p.Next] = res
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return p.Mismatches, err
+		}
+		// Report before checkpointing: a crash in between reports the batch again, but never skips it.
+		for _, res := range results {
+			for _, m := range res {
+				if err := c.report(m); err != nil {
+					return p.Mismatches, err
+				}
+				p.Mismatches++
+			}
+		}
+		p.Next = batchEnd
+		if c.progressPath != "" {
+			if err := saveProgress(c.progressPath, p); err != nil {
+				return p.Mismatches, err
+			}
+		}
+		c.log.Info("Verified blocks", "next", p.Next, "end", end, "mismatches", p.Mismatches, "duration", time.Since(startT))
+	}
+	c.log.Info("Finished verification", "mismatches", p.Mismatches, "duration", time.Since(startT))
+	return p.Mismatches, nil
+}
+
+func (c *checker) report(m mismatch) error {
+	c.log.Warn("Mismatch", "block", m.Block, "path", m.Path, "old", m.Old, "new", m.New)
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode mismatch: %w", err)
+	}
+	if _, err := c.out.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write mismatch: %w", err)
+	}
+	return nil
+}
+
+// checkBlock compares the block, with its transactions, and the receipts, with their logs, of the two ELs.
+func (c *checker) checkBlock(ctx context.Context, number uint64) ([]mismatch, error) {
+	oldBlock, oldReceipts, err := fetchBlock(ctx, c.oldEL, number)
+	if err != nil {
+		return nil, fmt.Errorf("old EL: %w", err)
+	}
+	newBlock, newReceipts, err := fetchBlock(ctx, c.newEL, number)
+	if err != nil {
+		return nil, fmt.Errorf("new EL: %w", err)
+	}
+	var out []mismatch
+	c.diff(number, "block", oldBlock, newBlock, &out)
+	c.diff(number, "receipts", oldReceipts, newReceipts, &out)
+	return out, nil
+}
+
+// fetchBlock fetches the block, with full transactions, and its receipts, as decoded JSON values.
+func fetchBlock(ctx context.Context, el rpcCaller, number uint64) (block any, receipts any, err error) {
+	var rawBlock, rawReceipts json.RawMessage
+	if err := el.CallContext(ctx, &rawBlock, "eth_getBlockByNumber", hexutil.Uint64(number), true); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch block: %w", err)
+	}
+	if err := el.CallContext(ctx, &rawReceipts, "eth_getBlockReceipts", hexutil.Uint64(number)); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch receipts: %w", err)
+	}
+	if block, err = decodeJSON(rawBlock); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+	if receipts, err = decodeJSON(rawReceipts); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode receipts: %w", err)
+	}
+	if block == nil || receipts == nil {
+		return nil, nil, fmt.Errorf("%w: %d", errBlockNotFound, number)
+	}
+	return block, receipts, nil
+}
+
+func decodeJSON(data json.RawMessage) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// diff appends the differences between the decoded JSON values a and b at the path to out.
+// Objects are compared by field, and arrays by element, so mismatches are reported at the innermost differing value.
+func (c *checker) diff(block uint64, path string, a any, b any, out *[]mismatch) {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(av)+len(bv))
+		for k := range av {
+			keys = append(keys, k)
+		}
+		for k := range bv {
+			if _, ok := av[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if c.ignore[k] {
+				continue
+			}
+			c.diff(block, path+"."+k, av[k], bv[k], out)
+		}
+		return
+	case []any:
+		bv, ok := b.([]any)
+		if !ok {
+			break
+		}
+		if len(av) != len(bv) {
+			*out = append(*out, mismatch{Block: block, Path: path + ".length", Old: len(av), New: len(bv)})
+			return
+		}
+		for i := range av {
+			c.diff(block, fmt.Sprintf("%s[%d]", path, i), av[i], bv[i], out)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) {
+		*out = append(*out, mismatch{Block: block, Path: path, Old: a, New: b})
+	}
+}
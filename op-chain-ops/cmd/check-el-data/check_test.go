@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// fakeEL serves blocks and receipts from JSON templates, with the block number substituted for {n}.
+type fakeEL struct {
+	block    string
+	receipts string
+	// overrides replaces the receipts of specific blocks
+	overrides map[uint64]string
+	calls     int
+}
+
+func (f *fakeEL) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	f.calls++
+	number := uint64(args[0].(hexutil.Uint64))
+	var data string
+	switch method {
+	case "eth_getBlockByNumber":
+		data = f.block
+	case "eth_getBlockReceipts":
+		data = f.receipts
+		if override, ok := f.overrides[number]; ok {
+			data = override
+		}
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	data = strings.ReplaceAll(data, "{n}", hexutil.Uint64(number).String())
+	return json.Unmarshal([]byte(data), result)
+}
+
+const (
+	testBlock    = `{"number":"{n}","hash":"0x01","totalDifficulty":"0x0","transactions":[{"hash":"0xaa"}]}`
+	testReceipts = `[{"blockNumber":"{n}","status":"0x1","logs":[{"logIndex":"0x0","data":"0x1234"}]}]`
+)
+
+func newTestChecker(t *testing.T, oldEL, newEL *fakeEL) (*checker, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &checker{
+		log:       testlog.Logger(t, log.LevelInfo),
+		oldEL:     oldEL,
+		newEL:     newEL,
+		workers:   4,
+		batchSize: 3,
+		ignore:    make(map[string]bool),
+		out:       &out,
+	}, &out
+}
+
+func TestCheckBlock(t *testing.T) {
+	t.Run("identical", func(t *testing.T) {
+		c, _ := newTestChecker(t, &fakeEL{block: testBlock, receipts: testReceipts}, &fakeEL{block: testBlock, receipts: testReceipts})
+		res, err := c.checkBlock(context.Background(), 1)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("log data", func(t *testing.T) {
+		newReceipts := strings.Replace(testReceipts, "0x1234", "0x5678", 1)
+		c, _ := newTestChecker(t, &fakeEL{block: testBlock, receipts: testReceipts}, &fakeEL{block: testBlock, receipts: newReceipts})
+		res, err := c.checkBlock(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []mismatch{{Block: 1, Path: "receipts[0].logs[0].data", Old: "0x1234", New: "0x5678"}}, res)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		newBlock := strings.Replace(testBlock, `"totalDifficulty":"0x0",`, "", 1)
+		c, _ := newTestChecker(t, &fakeEL{block: testBlock, receipts: testReceipts}, &fakeEL{block: newBlock, receipts: testReceipts})
+		res, err := c.checkBlock(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []mismatch{{Block: 1, Path: "block.totalDifficulty", Old: "0x0", New: nil}}, res)
+
+		c.ignore["totalDifficulty"] = true
+		res, err = c.checkBlock(context.Background(), 1)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("receipt count", func(t *testing.T) {
+		c, _ := newTestChecker(t, &fakeEL{block: testBlock, receipts: testReceipts}, &fakeEL{block: testBlock, receipts: `[]`})
+		res, err := c.checkBlock(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []mismatch{{Block: 1, Path: "receipts.length", Old: 1, New: 0}}, res)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		c, _ := newTestChecker(t, &fakeEL{block: testBlock, receipts: testReceipts}, &fakeEL{block: `null`, receipts: `null`})
+		_, err := c.checkBlock(context.Background(), 1)
+		require.ErrorIs(t, err, errBlockNotFound)
+	})
+}
+
+func TestRunResumes(t *testing.T) {
+	oldEL := &fakeEL{block: testBlock, receipts: testReceipts}
+	newEL := &fakeEL{block: testBlock, receipts: testReceipts, overrides: map[uint64]string{
+		4: `[]`,
+		8: `[]`,
+	}}
+	c, out := newTestChecker(t, oldEL, newEL)
+	c.progressPath = filepath.Join(t.TempDir(), "progress.json")
+
+	mismatches, err := c.run(context.Background(), 0, 6)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), mismatches)
+	require.Equal(t, 1, strings.Count(out.String(), "\n"))
+	p, ok, err := loadProgress(c.progressPath)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, progress{Next: 6, Mismatches: 1}, p)
+
+	// resuming with a larger range only verifies the new blocks
+	oldEL.calls = 0
+	mismatches, err = c.run(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), mismatches)
+	require.Equal(t, 4*2, oldEL.calls)
+	require.Equal(t, 2, strings.Count(out.String(), "\n"))
+	require.Contains(t, out.String(), `{"block":8,"path":"receipts.length","old":1,"new":0}`)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+const EnvPrefix = "OP_CHAIN_OPS_CHECK_EL_DATA"
+
+var (
+	OldRPCURLFlag = &cli.StringFlag{
+		Name:     "old-rpc-url",
+		Usage:    "RPC URL of the EL with the old database format or client",
+		EnvVars:  opservice.PrefixEnvVar(EnvPrefix, "OLD_RPC_URL"),
+		Required: true,
+	}
+	NewRPCURLFlag = &cli.StringFlag{
+		Name:     "new-rpc-url",
+		Usage:    "RPC URL of the EL with the new database format or client",
+		EnvVars:  opservice.PrefixEnvVar(EnvPrefix, "NEW_RPC_URL"),
+		Required: true,
+	}
+	StartFlag = &cli.Uint64Flag{
+		Name:  "start",
+		Usage: "the first block to verify. INCLUSIVE",
+	}
+	EndFlag = &cli.Uint64Flag{
+		Name:  "end",
+		Usage: "the last block of the range to verify. EXCLUSIVE. Defaults to after the lowest head of the two ELs",
+	}
+	WorkerFlag = &cli.IntFlag{
+		Name:  "workers",
+		Value: 8,
+		Usage: "how many blocks to verify concurrently",
+	}
+	BatchSizeFlag = &cli.Uint64Flag{
+		Name:  "batch-size",
+		Value: 1000,
+		Usage: "how many blocks to verify between progress checkpoints",
+	}
+	ProgressFlag = &cli.StringFlag{
+		Name:    "progress",
+		Usage:   "the file to checkpoint the progress to. If the file exists, verification resumes from it",
+		EnvVars: opservice.PrefixEnvVar(EnvPrefix, "PROGRESS"),
+	}
+	OutputFlag = &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "the file to append the mismatches to, as JSON lines. Defaults to stdout",
+	}
+	IgnoreFieldsFlag = &cli.StringSliceFlag{
+		Name:  "ignore-fields",
+		Usage: "names of block, transaction, receipt or log fields that are not compared, e.g. fields that were removed by the upgrade",
+	}
+)
+
+func main() {
+	color := isatty.IsTerminal(os.Stderr.Fd())
+	oplog.SetGlobalLogHandler(log.NewTerminalHandlerWithLevel(os.Stderr, slog.LevelInfo, color))
+
+	app := &cli.App{
+		Name:  "check-el-data",
+		Usage: "Verify that two execution-layer nodes serve the same historical blocks, receipts and events",
+		Description: "Compares the blocks, transactions, receipts and logs served by two ELs, e.g. before and after " +
+			"a database migration or major client upgrade, and reports every mismatch. Progress is checkpointed, " +
+			"so an interrupted verification of a long history can be resumed.",
+		Flags: []cli.Flag{
+			OldRPCURLFlag, NewRPCURLFlag, StartFlag, EndFlag, WorkerFlag, BatchSizeFlag,
+			ProgressFlag, OutputFlag, IgnoreFieldsFlag,
+		},
+		Writer: os.Stdout,
+		Action: checkELData,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("critical error", "err", err)
+	}
+}
+
+func checkELData(ctx *cli.Context) error {
+	logger := log.New()
+	timeout := 1 * time.Minute
+	oldEL, err := dial.DialRPCClientWithTimeout(ctx.Context, timeout, logger, ctx.String(OldRPCURLFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial old EL: %w", err)
+	}
+	defer oldEL.Close()
+	newEL, err := dial.DialRPCClientWithTimeout(ctx.Context, timeout, logger, ctx.String(NewRPCURLFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial new EL: %w", err)
+	}
+	defer newEL.Close()
+
+	end := ctx.Uint64(EndFlag.Name)
+	if end == 0 {
+		oldHead, err := headNumber(ctx.Context, oldEL)
+		if err != nil {
+			return fmt.Errorf("failed to get head of old EL: %w", err)
+		}
+		newHead, err := headNumber(ctx.Context, newEL)
+		if err != nil {
+			return fmt.Errorf("failed to get head of new EL: %w", err)
+		}
+		end = min(oldHead, newHead) + 1
+	}
+
+	out := os.Stdout
+	if path := ctx.String(OutputFlag.Name); path != "" {
+		out, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open output file: %w", err)
+		}
+		defer out.Close()
+	}
+
+	c := &checker{
+		log:          logger,
+		oldEL:        oldEL,
+		newEL:        newEL,
+		workers:      ctx.Int(WorkerFlag.Name),
+		batchSize:    ctx.Uint64(BatchSizeFlag.Name),
+		progressPath: ctx.String(ProgressFlag.Name),
+		ignore:       make(map[string]bool),
+		out:          out,
+	}
+	for _, field := range ctx.StringSlice(IgnoreFieldsFlag.Name) {
+		c.ignore[field] = true
+	}
+	mismatches, err := c.run(ctx.Context, ctx.Uint64(StartFlag.Name), end)
+	if err != nil {
+		return err
+	}
+	if mismatches > 0 {
+		return errors.New("found mismatches between the ELs")
+	}
+	return nil
+}
+
+func headNumber(ctx context.Context, el rpcCaller) (uint64, error) {
+	var head hexutil.Uint64
+	if err := el.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return uint64(head), nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// progress is the checkpointed state of a verification, to resume it from.
+type progress struct {
+	// Next is the first block that is not verified yet
+	Next uint64 `json:"next"`
+	// Mismatches is the number of mismatches found in the verified blocks
+	Mismatches uint64 `json:"mismatches"`
+}
+
+// loadProgress reads the progress from the file, and returns false if the file does not exist.
+func loadProgress(path string) (progress, bool, error) {
+	var p progress
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, false, nil
+	} else if err != nil {
+		return p, false, fmt.Errorf("failed to read progress: %w", err)
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, false, fmt.Errorf("failed to decode progress: %w", err)
+	}
+	return p, true, nil
+}
+
+// saveProgress atomically replaces the progress file, so an interrupted write never loses the progress.
+func saveProgress(path string, p progress) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write progress: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace progress: %w", err)
+	}
+	return nil
+}
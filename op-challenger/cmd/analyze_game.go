@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	AnalyzeGameAddressFlag = &cli.StringFlag{
+		Name:    "game",
+		Usage:   "Address of the fault game contract to analyze.",
+		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "GAME"),
+	}
+)
+
+func AnalyzeGame(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx, logger)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	gameAddr, err := opservice.ParseAddress(ctx.String(AnalyzeGameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	l2Client, err := ethclient.DialContext(ctx.Context, cfg.L2Rpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L2: %w", err)
+	}
+	defer l2Client.Close()
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, cfg.RollupRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup node: %w", err)
+	}
+	defer rollupClient.Close()
+
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := contracts.NewFaultDisputeGameContract(ctx.Context, contractMetrics.NoopContractMetrics, gameAddr, caller)
+	if err != nil {
+		return err
+	}
+	// Keep the trace data outside of the game directories, which are removed once the game is no longer played
+	dir := filepath.Join(cfg.Datadir, "analysis-"+gameAddr.Hex())
+	accessor, err := fault.NewHistoricalTraceAccessor(ctx.Context, logger, metrics.NoopMetrics, cfg, contract, rollupClient, l2Client, l1Client, dir)
+	if err != nil {
+		return fmt.Errorf("failed to create trace accessor: %w", err)
+	}
+	return analyzeGame(ctx.Context, contract, accessor)
+}
+
+func analyzeGame(ctx context.Context, contract contracts.FaultDisputeGameContract, accessor types.TraceAccessor) error {
+	metadata, err := contract.GetGameMetadata(ctx, rpcblock.Latest)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve metadata: %w", err)
+	}
+	maxDepth, err := contract.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve max depth: %w", err)
+	}
+	// Claims are never removed from a game, so the claims hold the full history of moves
+	claims, err := contract.GetAllClaims(ctx, rpcblock.Latest)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve claims: %w", err)
+	}
+	analysis, err := fault.AnalyzeGame(ctx, types.NewGameState(claims, maxDepth), accessor)
+	if err != nil {
+		return err
+	}
+
+	claimFormat := "%3v %5v %-66v %-66v %-42v %v\n"
+	claimInfo := fmt.Sprintf(claimFormat, "Idx", "Depth", "Value", "Expected", "Claimant", "Honest")
+	for _, claim := range analysis.Claims {
+		claimInfo += fmt.Sprintf(claimFormat,
+			claim.ContractIndex, claim.Depth(), claim.Value.Hex(), claim.Expected.Hex(), claim.Claimant, honestMark(claim.Honest()))
+	}
+	actorFormat := "%-42v %6v %9v %5v %9v %16v %v\n"
+	actorInfo := fmt.Sprintf(actorFormat, "Actor", "Claims", "Dishonest", "Steps", "Dishonest", "Bonds (ETH)", "Honest")
+	for _, actor := range analysis.Actors {
+		actorInfo += fmt.Sprintf(actorFormat,
+			actor.Address, actor.Claims, actor.DishonestClaims, actor.Steps, actor.DishonestSteps,
+			fmt.Sprintf("%16.8f", eth.WeiToEther(actor.Bonds)), honestMark(actor.Honest()))
+	}
+	fmt.Printf("Status: %v • L1 Head: %v • L2 Block: %v • Claim Count: %v\n%v\n%v",
+		metadata.Status, metadata.L1Head, metadata.L2BlockNum, len(claims), claimInfo, actorInfo)
+	return nil
+}
+
+func honestMark(honest bool) string {
+	if honest {
+		return "✅"
+	}
+	return "❌"
+}
+
+func analyzeGameFlags() []cli.Flag {
+	return append(slices.Clone(flags.Flags), AnalyzeGameAddressFlag)
+}
+
+var AnalyzeGameCommand = &cli.Command{
+	Name:        "analyze-game",
+	Usage:       "Analyze the moves made in a dispute game",
+	Description: "Re-evaluates each claim of a dispute game with the local trace providers at the L1 head of the game and reports which actors played honestly",
+	Action:      Interruptible(AnalyzeGame),
+	Flags:       analyzeGameFlags(),
+}
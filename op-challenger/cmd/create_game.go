@@ -31,6 +31,7 @@ var (
 	}
 	L2BlockNumFlag = &cli.StringFlag{
 		Name:    "l2-block-num",
+		Aliases: []string{"l2-block"},
 		Usage:   "The l2 block number for the game.",
 		EnvVars: opservice.PrefixEnvVar(flags.EnvVarPrefix, "L2_BLOCK_NUM"),
 	}
@@ -41,7 +42,11 @@ func CreateGame(ctx *cli.Context) error {
 	gameType := ctx.Uint64(GameTypeFlag.Name)
 	l2BlockNum := ctx.Uint64(L2BlockNumFlag.Name)
 
-	contract, txMgr, err := NewContractWithTxMgr[*contracts.DisputeGameFactoryContract](ctx, flags.FactoryAddress,
+	caller, txMgr, err := newClientsFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	contract, err := newContractFromCLI[*contracts.DisputeGameFactoryContract](ctx, flags.FactoryAddress, caller,
 		func(ctx context.Context, metricer contractMetrics.ContractMetricer, address common.Address, caller *batching.MultiCaller) (*contracts.DisputeGameFactoryContract, error) {
 			return contracts.NewDisputeGameFactoryContract(metricer, address, caller), nil
 		})
@@ -49,7 +54,7 @@ func CreateGame(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create dispute game factory bindings: %w", err)
 	}
 
-	creator := tools.NewGameCreator(contract, txMgr)
+	creator := tools.NewGameCreator(contract, txMgr, caller)
 	gameAddr, err := creator.CreateGame(ctx.Context, outputRoot, gameType, l2BlockNum)
 	if err != nil {
 		return fmt.Errorf("failed to create game: %w", err)
@@ -54,9 +54,12 @@ func run(ctx context.Context, args []string, action ConfiguredLifecycle) error {
 		ListCreditsCommand,
 		CreateGameCommand,
 		MoveCommand,
+		AttackCommand,
+		DefendCommand,
 		ResolveCommand,
 		ResolveClaimCommand,
 		RunTraceCommand,
+		AnalyzeGameCommand,
 	}
 	app.Action = cliapp.LifecycleCmd(func(ctx *cli.Context, close context.CancelCauseFunc) (cliapp.Lifecycle, error) {
 		logger, err := setupLogging(ctx)
@@ -226,6 +226,45 @@ func TestGameAllowlist(t *testing.T) {
 	})
 }
 
+func TestOptimismPortalAddress(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet))
+		require.Equal(t, common.Address{}, cfg.OptimismPortalAddress)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xbb, 0xcc, 0xdd}
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--optimism-portal-address="+addr.Hex()))
+		require.Equal(t, addr, cfg.OptimismPortalAddress)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address: foo", addRequiredArgs(types.TraceTypeAlphabet, "--optimism-portal-address=foo"))
+	})
+}
+
+func TestPlugins(t *testing.T) {
+	t.Run("Optional", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet))
+		require.Empty(t, cfg.Plugins)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--plugin=42=/bin/zk", "--plugin=43=/bin/other"))
+		require.Equal(t, map[types.GameType]string{42: "/bin/zk", 43: "/bin/other"}, cfg.Plugins)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "expected <game-type>=<path>", addRequiredArgs(types.TraceTypeAlphabet, "--plugin=/bin/zk"))
+		verifyArgsInvalid(t, "invalid game type", addRequiredArgs(types.TraceTypeAlphabet, "--plugin=zk=/bin/zk"))
+		verifyArgsInvalid(t, "duplicate plugin for game type 42", addRequiredArgs(types.TraceTypeAlphabet, "--plugin=42=/bin/zk", "--plugin=42=/bin/other"))
+	})
+
+	t.Run("RequiresRollupRpc", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag rollup-rpc is required", addRequiredArgs(types.TraceTypeSuperCannon, "--plugin=42=/bin/zk"))
+	})
+}
+
 func TestTxManagerFlagsSupported(t *testing.T) {
 	// Not a comprehensive list of flags, just enough to sanity check the txmgr.CLIFlags were defined
 	cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--"+txmgr.NumConfirmationsFlagName, "7"))
@@ -274,6 +313,18 @@ func TestMaxPendingTx(t *testing.T) {
 	})
 }
 
+func TestRPCEnabled(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet))
+		require.False(t, cfg.RPCEnabled)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeAlphabet, "--rpc.enabled"))
+		require.True(t, cfg.RPCEnabled)
+	})
+}
+
 func TestPollInterval(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(types.TraceTypeCannon))
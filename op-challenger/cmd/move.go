@@ -5,10 +5,12 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/flags"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/tools"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/urfave/cli/v2"
 )
 
@@ -38,43 +40,46 @@ var (
 func Move(ctx *cli.Context) error {
 	attack := ctx.Bool(AttackFlag.Name)
 	defend := ctx.Bool(DefendFlag.Name)
-	parentIndex := ctx.Uint64(ParentIndexFlag.Name)
-	claim := common.HexToHash(ctx.String(ClaimFlag.Name))
-
 	if attack && defend {
 		return fmt.Errorf("both attack and defense flags cannot be set")
+	} else if !attack && !defend {
+		return fmt.Errorf("either attack or defense flag must be set")
 	}
+	return sendMove(ctx, attack)
+}
+
+func Attack(ctx *cli.Context) error {
+	return sendMove(ctx, true)
+}
+
+func Defend(ctx *cli.Context) error {
+	return sendMove(ctx, false)
+}
 
-	contract, txMgr, err := NewContractWithTxMgr[contracts.FaultDisputeGameContract](ctx, AddrFromFlag(GameAddressFlag.Name), contracts.NewFaultDisputeGameContract)
+func sendMove(ctx *cli.Context, attack bool) error {
+	parentIndex := ctx.Uint64(ParentIndexFlag.Name)
+	claim := common.HexToHash(ctx.String(ClaimFlag.Name))
+
+	caller, txMgr, err := newClientsFromCLI(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create dispute game bindings: %w", err)
+		return err
 	}
-
-	parentClaim, err := contract.GetClaim(ctx.Context, parentIndex)
+	contract, err := newContractFromCLI[contracts.FaultDisputeGameContract](ctx, AddrFromFlag(GameAddressFlag.Name), caller, contracts.NewFaultDisputeGameContract)
 	if err != nil {
-		return fmt.Errorf("failed to get parent claim: %w", err)
+		return fmt.Errorf("failed to create dispute game bindings: %w", err)
 	}
-	var tx txmgr.TxCandidate
+
+	mover := tools.NewGameMover(contract, txMgr, caller)
+	var rct *types.Receipt
 	if attack {
-		tx, err = contract.AttackTx(ctx.Context, parentClaim, claim)
-		if err != nil {
-			return fmt.Errorf("failed to create attack tx: %w", err)
-		}
-	} else if defend {
-		tx, err = contract.DefendTx(ctx.Context, parentClaim, claim)
-		if err != nil {
-			return fmt.Errorf("failed to create defense tx: %w", err)
-		}
+		rct, err = mover.Attack(ctx.Context, parentIndex, claim)
 	} else {
-		return fmt.Errorf("either attack or defense flag must be set")
+		rct, err = mover.Defend(ctx.Context, parentIndex, claim)
 	}
-
-	rct, err := txMgr.Send(ctx.Context, tx)
 	if err != nil {
-		return fmt.Errorf("failed to send tx: %w", err)
+		return err
 	}
 	fmt.Printf("Sent tx with status: %v, hash: %s\n", rct.Status, rct.TxHash.String())
-
 	return nil
 }
 
@@ -92,6 +97,18 @@ func moveFlags() []cli.Flag {
 	return cliFlags
 }
 
+func singleMoveFlags() []cli.Flag {
+	cliFlags := []cli.Flag{
+		flags.L1EthRpcFlag,
+		GameAddressFlag,
+		ParentIndexFlag,
+		ClaimFlag,
+	}
+	cliFlags = append(cliFlags, txmgr.CLIFlagsWithDefaults(flags.EnvVarPrefix, txmgr.DefaultChallengerFlagValues)...)
+	cliFlags = append(cliFlags, oplog.CLIFlags(flags.EnvVarPrefix)...)
+	return cliFlags
+}
+
 var MoveCommand = &cli.Command{
 	Name:        "move",
 	Usage:       "Creates and sends a move transaction to the dispute game",
@@ -99,3 +116,19 @@ var MoveCommand = &cli.Command{
 	Action:      Interruptible(Move),
 	Flags:       moveFlags(),
 }
+
+var AttackCommand = &cli.Command{
+	Name:        "attack",
+	Usage:       "Attacks a claim in the dispute game, posting the required bond",
+	Description: "Attacks a claim in the dispute game, posting the required bond",
+	Action:      Interruptible(Attack),
+	Flags:       singleMoveFlags(),
+}
+
+var DefendCommand = &cli.Command{
+	Name:        "defend",
+	Usage:       "Defends a claim in the dispute game, posting the required bond",
+	Description: "Defends a claim in the dispute game, posting the required bond",
+	Action:      Interruptible(Defend),
+	Flags:       singleMoveFlags(),
+}
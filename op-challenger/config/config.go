@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -38,6 +39,9 @@ var (
 	ErrMissingAsteriscKonaAbsolutePreState = errors.New("missing asterisc kona absolute pre-state")
 	ErrMissingAsteriscKonaSnapshotFreq     = errors.New("missing asterisc kona snapshot freq")
 	ErrMissingAsteriscKonaInfoFreq         = errors.New("missing asterisc kona info freq")
+
+	ErrMissingPluginPath      = errors.New("missing plugin path")
+	ErrPluginGameTypeConflict = errors.New("plugin game type conflicts with an enabled trace type")
 )
 
 const (
@@ -53,6 +57,8 @@ const (
 	// buffer to monitor games to ensure bonds are claimed.
 	DefaultGameWindow   = 28 * 24 * time.Hour
 	DefaultMaxPendingTx = 10
+	// DefaultAlertDedupInterval is the default minimum time between repeated alerts about the same game condition.
+	DefaultAlertDedupInterval = time.Hour
 )
 
 // Config is a well typed config that is parsed from the CLI params.
@@ -69,12 +75,19 @@ type Config struct {
 	PollInterval         time.Duration    // Polling interval for latest-block subscription when using an HTTP RPC provider
 	AllowInvalidPrestate bool             // Whether to allow responding to games where the prestate does not match
 
+	// OptimismPortalAddress is the portal whose pending withdrawals the games they were proven against are
+	// prioritized for, when the scheduler is saturated. Defensive mode is disabled if zero.
+	OptimismPortalAddress common.Address
+
 	AdditionalBondClaimants []common.Address // List of addresses to claim bonds for in addition to the tx manager sender
 
 	SelectiveClaimResolution bool // Whether to only resolve claims for the claimants in AdditionalBondClaimants union [TxSender.From()]
 
 	TraceTypes []types.TraceType // Type of traces supported
 
+	// Plugins are the executables of external plugins providing the execution trace of additional game types
+	Plugins map[types.GameType]string
+
 	RollupRpc     string // L2 Rollup RPC Url
 	SupervisorRPC string // L2 supervisor RPC URL
 	L2Rpc         string // L2 RPC Url
@@ -94,9 +107,15 @@ type Config struct {
 
 	MaxPendingTx uint64 // Maximum number of pending transactions (0 == no limit)
 
+	AlertWebhookURL    string        // Webhook to post alerts about unwinnable or at-risk games to (alerts disabled if empty)
+	AlertRoutingKey    string        // Routing key included in alerts, such as a PagerDuty integration key
+	AlertDedupInterval time.Duration // Minimum time between repeated alerts about the same game condition
+
 	TxMgrConfig   txmgr.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	RPCEnabled    bool            // Whether to serve the game history over JSON-RPC
+	RPCConfig     oprpc.CLIConfig // RPC server serving the game history
 }
 
 func NewConfig(
@@ -121,9 +140,12 @@ func NewConfig(
 
 		MaxPendingTx: DefaultMaxPendingTx,
 
+		AlertDedupInterval: DefaultAlertDedupInterval,
+
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc, txmgr.DefaultChallengerFlagValues),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		RPCConfig:     oprpc.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
@@ -176,7 +198,7 @@ func (c Config) Check() error {
 	if c.GameFactoryAddress == (common.Address{}) {
 		return ErrMissingGameFactoryAddress
 	}
-	if len(c.TraceTypes) == 0 {
+	if len(c.TraceTypes) == 0 && len(c.Plugins) == 0 {
 		return ErrMissingTraceType
 	}
 	if c.Datadir == "" {
@@ -240,6 +262,19 @@ func (c Config) Check() error {
 			return ErrMissingRollupRpc
 		}
 	}
+	for gameType, path := range c.Plugins {
+		if c.RollupRpc == "" {
+			return ErrMissingRollupRpc
+		}
+		if path == "" {
+			return fmt.Errorf("%w for game type %d", ErrMissingPluginPath, gameType)
+		}
+		for _, traceType := range c.TraceTypes {
+			if traceType.GameType() == gameType {
+				return fmt.Errorf("%w: %d (%v)", ErrPluginGameTypeConflict, gameType, traceType)
+			}
+		}
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
@@ -249,6 +284,11 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if c.RPCEnabled {
+		if err := c.RPCConfig.Check(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -696,3 +696,38 @@ func TestRequireConfigForMultipleTraceTypesForCannonAndAsterisc(t *testing.T) {
 	// Check final config is valid
 	require.NoError(t, cfg.Check())
 }
+
+func TestPlugins(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig(t, types.TraceTypeCannon)
+		cfg.Plugins = map[types.GameType]string{42: "./plugin"}
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("WithoutTraceTypes", func(t *testing.T) {
+		cfg := validConfig(t, types.TraceTypeAlphabet)
+		cfg.TraceTypes = nil
+		require.ErrorIs(t, cfg.Check(), ErrMissingTraceType)
+		cfg.Plugins = map[types.GameType]string{42: "./plugin"}
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("RollupRpcRequired", func(t *testing.T) {
+		cfg := validConfig(t, types.TraceTypeSuperCannon)
+		cfg.RollupRpc = ""
+		cfg.Plugins = map[types.GameType]string{42: "./plugin"}
+		require.ErrorIs(t, cfg.Check(), ErrMissingRollupRpc)
+	})
+
+	t.Run("PathRequired", func(t *testing.T) {
+		cfg := validConfig(t, types.TraceTypeCannon)
+		cfg.Plugins = map[types.GameType]string{42: ""}
+		require.ErrorIs(t, cfg.Check(), ErrMissingPluginPath)
+	})
+
+	t.Run("GameTypeConflict", func(t *testing.T) {
+		cfg := validConfig(t, types.TraceTypeCannon)
+		cfg.Plugins = map[types.GameType]string{types.CannonGameType: "./plugin"}
+		require.ErrorIs(t, cfg.Check(), ErrPluginGameTypeConflict)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"runtime"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
@@ -22,6 +23,7 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -219,6 +221,39 @@ var (
 		EnvVars: prefixEnvVars("UNSAFE_ALLOW_INVALID_PRESTATE"),
 		Hidden:  true, // Hidden as this is an unsafe flag added only for testing purposes
 	}
+	RPCEnabledFlag = &cli.BoolFlag{
+		Name:    "rpc.enabled",
+		Usage:   "Enable the JSON-RPC server serving the game history",
+		EnvVars: prefixEnvVars("RPC_ENABLED"),
+	}
+	AlertWebhookURLFlag = &cli.StringFlag{
+		Name:    "alert-webhook-url",
+		Usage:   "URL of a webhook to post alerts about unwinnable or at-risk games to. Payloads are compatible with the PagerDuty Events API v2.",
+		EnvVars: prefixEnvVars("ALERT_WEBHOOK_URL"),
+	}
+	AlertRoutingKeyFlag = &cli.StringFlag{
+		Name:    "alert-routing-key",
+		Usage:   "Routing key to include in alerts, such as a PagerDuty integration key",
+		EnvVars: prefixEnvVars("ALERT_ROUTING_KEY"),
+	}
+	AlertDedupIntervalFlag = &cli.DurationFlag{
+		Name:    "alert-dedup-interval",
+		Usage:   "Minimum time between repeated alerts about the same game condition",
+		EnvVars: prefixEnvVars("ALERT_DEDUP_INTERVAL"),
+		Value:   config.DefaultAlertDedupInterval,
+	}
+	OptimismPortalAddressFlag = &cli.StringFlag{
+		Name: "optimism-portal-address",
+		Usage: "Address of the OptimismPortal. If set, enables defensive mode: games that pending withdrawals were proven against " +
+			"are progressed and resolved before other games when the scheduler is saturated.",
+		EnvVars: prefixEnvVars("OPTIMISM_PORTAL_ADDRESS"),
+	}
+	PluginFlag = &cli.StringSliceFlag{
+		Name: "plugin",
+		Usage: "External plugin executable providing the execution trace of an additional game type, as <game-type>=<path>. " +
+			"Output root bisection is handled by the challenger, see the op-challenger plugin package for the protocol.",
+		EnvVars: prefixEnvVars("PLUGIN"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -257,6 +292,12 @@ var optionalFlags = []cli.Flag{
 	GameWindowFlag,
 	SelectiveClaimResolutionFlag,
 	UnsafeAllowInvalidPrestate,
+	RPCEnabledFlag,
+	AlertWebhookURLFlag,
+	AlertRoutingKeyFlag,
+	AlertDedupIntervalFlag,
+	OptimismPortalAddressFlag,
+	PluginFlag,
 }
 
 func init() {
@@ -267,6 +308,7 @@ func init() {
 	optionalFlags = append(optionalFlags, txmgr.CLIFlagsWithDefaults(EnvVarPrefix, txmgr.DefaultChallengerFlagValues)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -383,6 +425,11 @@ func CheckRequired(ctx *cli.Context, traceTypes []types.TraceType) error {
 	if !ctx.IsSet(L2EthRpcFlag.Name) {
 		return fmt.Errorf("flag %s is required", L2EthRpcFlag.Name)
 	}
+	if ctx.IsSet(PluginFlag.Name) {
+		if err := checkOutputProviderFlags(ctx); err != nil {
+			return err
+		}
+	}
 	for _, traceType := range traceTypes {
 		switch traceType {
 		case types.TraceTypeCannon, types.TraceTypePermissioned:
@@ -426,6 +473,28 @@ func parseTraceTypes(ctx *cli.Context) ([]types.TraceType, error) {
 	return traceTypes, nil
 }
 
+func parsePlugins(ctx *cli.Context) (map[types.GameType]string, error) {
+	if !ctx.IsSet(PluginFlag.Name) {
+		return nil, nil
+	}
+	plugins := make(map[types.GameType]string)
+	for _, plugin := range ctx.StringSlice(PluginFlag.Name) {
+		gameTypeStr, path, ok := strings.Cut(plugin, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %v %q, expected <game-type>=<path>", PluginFlag.Name, plugin)
+		}
+		gameType, err := strconv.ParseUint(gameTypeStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid game type of %v %q: %w", PluginFlag.Name, plugin, err)
+		}
+		if _, ok := plugins[types.GameType(gameType)]; ok {
+			return nil, fmt.Errorf("duplicate %v for game type %d", PluginFlag.Name, gameType)
+		}
+		plugins[types.GameType(gameType)] = path
+	}
+	return plugins, nil
+}
+
 func FactoryAddress(ctx *cli.Context) (common.Address, error) {
 	// Use FactoryAddressFlag in preference to Network. Allows overriding the default dispute game factory.
 	if ctx.IsSet(FactoryAddressFlag.Name) {
@@ -470,6 +539,10 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 	if err != nil {
 		return nil, err
 	}
+	plugins, err := parsePlugins(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var allowedGames []common.Address
 	if ctx.StringSlice(GameAllowlistFlag.Name) != nil {
 		for _, addr := range ctx.StringSlice(GameAllowlistFlag.Name) {
@@ -481,9 +554,18 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 		}
 	}
 
+	var portalAddress common.Address
+	if ctx.IsSet(OptimismPortalAddressFlag.Name) {
+		portalAddress, err = opservice.ParseAddress(ctx.String(OptimismPortalAddressFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %w", OptimismPortalAddressFlag.Name, err)
+		}
+	}
+
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	rpcConfig := oprpc.ReadCLIConfig(ctx)
 
 	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
 	if maxConcurrency == 0 {
@@ -532,6 +614,7 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 		L1EthRpc:                l1EthRpc,
 		L1Beacon:                l1Beacon,
 		TraceTypes:              traceTypes,
+		Plugins:                 plugins,
 		GameFactoryAddress:      gameFactoryAddress,
 		GameAllowlist:           allowedGames,
 		GameWindow:              ctx.Duration(GameWindowFlag.Name),
@@ -596,7 +679,13 @@ func NewConfigFromCLI(ctx *cli.Context, logger log.Logger) (*config.Config, erro
 		TxMgrConfig:                         txMgrConfig,
 		MetricsConfig:                       metricsConfig,
 		PprofConfig:                         pprofConfig,
+		RPCEnabled:                          ctx.Bool(RPCEnabledFlag.Name),
+		RPCConfig:                           rpcConfig,
 		SelectiveClaimResolution:            ctx.Bool(SelectiveClaimResolutionFlag.Name),
 		AllowInvalidPrestate:                ctx.Bool(UnsafeAllowInvalidPrestate.Name),
+		AlertWebhookURL:                     ctx.String(AlertWebhookURLFlag.Name),
+		AlertRoutingKey:                     ctx.String(AlertRoutingKeyFlag.Name),
+		AlertDedupInterval:                  ctx.Duration(AlertDedupIntervalFlag.Name),
+		OptimismPortalAddress:               portalAddress,
 	}, nil
 }
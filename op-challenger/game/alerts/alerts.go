@@ -0,0 +1,70 @@
+package alerts
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Severity is the urgency of an alert, using the severity levels of PagerDuty events.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityError    Severity = "error"
+	SeverityCritical Severity = "critical"
+)
+
+// rank orders the severities from least to most urgent.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInfo:
+		return 0
+	case SeverityWarning:
+		return 1
+	case SeverityError:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return -1
+	}
+}
+
+// Kind identifies the condition that raised an alert.
+type Kind string
+
+const (
+	// KindOutOfTime is raised when a claim the challenger must counter is close to running out of clock.
+	KindOutOfTime Kind = "out_of_time"
+	// KindMissingPrestate is raised when the prestate required to play a game is unavailable or invalid.
+	KindMissingPrestate Kind = "missing_prestate"
+	// KindUncounterableClaim is raised when the challenger fails to calculate the response to the claims of a game.
+	KindUncounterableClaim Kind = "uncounterable_claim"
+	// KindGameLost is raised when a game resolved against the outcome the challenger supports.
+	KindGameLost Kind = "game_lost"
+)
+
+type Alert struct {
+	Kind     Kind
+	Severity Severity
+	Game     common.Address
+	Summary  string
+	Details  map[string]any
+}
+
+// DedupKey identifies repeated alerts about the same condition of the same game.
+func (a Alert) DedupKey() string {
+	return fmt.Sprintf("%v/%v", a.Kind, a.Game)
+}
+
+// Notifier sends alerts to operators.
+// Implementations must not block the caller while the alert is delivered.
+type Notifier interface {
+	Notify(alert Alert)
+}
+
+type NoopNotifier struct{}
+
+func (n NoopNotifier) Notify(_ Alert) {}
@@ -0,0 +1,165 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	webhookSource      = "op-challenger"
+	webhookTimeout     = 10 * time.Second
+	webhookQueueLength = 100
+)
+
+// webhookEvent is the payload posted to the webhook, compatible with the PagerDuty Events API v2.
+type webhookEvent struct {
+	RoutingKey  string         `json:"routing_key,omitempty"`
+	EventAction string         `json:"event_action"`
+	DedupKey    string         `json:"dedup_key"`
+	Payload     webhookPayload `json:"payload"`
+}
+
+type webhookPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      Severity       `json:"severity"`
+	Component     string         `json:"component"`
+	Group         Kind           `json:"group"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+type sentAlert struct {
+	severity Severity
+	time     time.Time
+}
+
+// WebhookNotifier posts alerts to a webhook in the background.
+// An alert is dropped if an alert with the same DedupKey and at least the same severity was sent within the
+// dedup interval, so conditions that persist across game updates don't flood the receiver.
+type WebhookNotifier struct {
+	logger        log.Logger
+	clock         clock.Clock
+	client        *http.Client
+	url           string
+	routingKey    string
+	dedupInterval time.Duration
+
+	mu    sync.Mutex
+	sent  map[string]sentAlert
+	queue chan webhookEvent
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func NewWebhookNotifier(logger log.Logger, cl clock.Clock, url string, routingKey string, dedupInterval time.Duration) *WebhookNotifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &WebhookNotifier{
+		logger:        logger,
+		clock:         cl,
+		client:        &http.Client{Timeout: webhookTimeout},
+		url:           url,
+		routingKey:    routingKey,
+		dedupInterval: dedupInterval,
+		sent:          make(map[string]sentAlert),
+		queue:         make(chan webhookEvent, webhookQueueLength),
+		cancel:        cancel,
+	}
+	n.wg.Add(1)
+	go n.run(ctx)
+	return n
+}
+
+func (n *WebhookNotifier) Notify(alert Alert) {
+	if !n.shouldSend(alert) {
+		n.logger.Debug("Dropping duplicate alert", "kind", alert.Kind, "game", alert.Game)
+		return
+	}
+	event := webhookEvent{
+		RoutingKey:  n.routingKey,
+		EventAction: "trigger",
+		DedupKey:    alert.DedupKey(),
+		Payload: webhookPayload{
+			Summary:       alert.Summary,
+			Source:        webhookSource,
+			Severity:      alert.Severity,
+			Component:     alert.Game.Hex(),
+			Group:         alert.Kind,
+			CustomDetails: alert.Details,
+		},
+	}
+	select {
+	case n.queue <- event:
+	default:
+		n.logger.Error("Alert queue full, dropping alert", "kind", alert.Kind, "game", alert.Game, "summary", alert.Summary)
+	}
+}
+
+// shouldSend records the alert as sent, unless it duplicates an alert sent within the dedup interval.
+// Alerts that escalate the severity of a previous alert are always sent.
+func (n *WebhookNotifier) shouldSend(alert Alert) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	now := n.clock.Now()
+	key := alert.DedupKey()
+	if prev, ok := n.sent[key]; ok && now.Sub(prev.time) < n.dedupInterval && alert.Severity.rank() <= prev.severity.rank() {
+		return false
+	}
+	n.sent[key] = sentAlert{severity: alert.Severity, time: now}
+	for k, prev := range n.sent {
+		if now.Sub(prev.time) >= n.dedupInterval {
+			delete(n.sent, k)
+		}
+	}
+	return true
+}
+
+func (n *WebhookNotifier) run(ctx context.Context) {
+	defer n.wg.Done()
+	for {
+		select {
+		case event := <-n.queue:
+			if err := n.send(ctx, event); err != nil {
+				n.logger.Error("Failed to send alert", "kind", event.Payload.Group, "game", event.Payload.Component, "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (n *WebhookNotifier) send(ctx context.Context, event webhookEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post alert: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %v", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close stops sending alerts. Alerts that are still queued are dropped.
+func (n *WebhookNotifier) Close() error {
+	n.cancel()
+	n.wg.Wait()
+	return nil
+}
@@ -0,0 +1,101 @@
+package alerts
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	game := common.Address{0xaa}
+
+	t.Run("SendPayload", func(t *testing.T) {
+		notifier, _, events := setupWebhookTest(t)
+		notifier.Notify(Alert{
+			Kind:     KindGameLost,
+			Severity: SeverityCritical,
+			Game:     game,
+			Summary:  "Game lost",
+			Details:  map[string]any{"status": "DefenderWon"},
+		})
+		event := receiveEvent(t, events)
+		require.Equal(t, "routing-key", event.RoutingKey)
+		require.Equal(t, "trigger", event.EventAction)
+		require.Equal(t, "game_lost/"+game.Hex(), event.DedupKey)
+		require.Equal(t, "Game lost", event.Payload.Summary)
+		require.Equal(t, webhookSource, event.Payload.Source)
+		require.Equal(t, SeverityCritical, event.Payload.Severity)
+		require.Equal(t, game.Hex(), event.Payload.Component)
+		require.Equal(t, KindGameLost, event.Payload.Group)
+		require.Equal(t, map[string]any{"status": "DefenderWon"}, event.Payload.CustomDetails)
+	})
+
+	t.Run("DeduplicateWithinInterval", func(t *testing.T) {
+		notifier, cl, events := setupWebhookTest(t)
+		alert := Alert{Kind: KindOutOfTime, Severity: SeverityWarning, Game: game, Summary: "first"}
+		notifier.Notify(alert)
+		require.Equal(t, "first", receiveEvent(t, events).Payload.Summary)
+
+		alert.Summary = "duplicate"
+		notifier.Notify(alert)
+		cl.AdvanceTime(time.Minute)
+		alert.Summary = "after interval"
+		notifier.Notify(alert)
+		require.Equal(t, "after interval", receiveEvent(t, events).Payload.Summary)
+	})
+
+	t.Run("SendEscalatedSeverity", func(t *testing.T) {
+		notifier, _, events := setupWebhookTest(t)
+		notifier.Notify(Alert{Kind: KindOutOfTime, Severity: SeverityWarning, Game: game, Summary: "warning"})
+		require.Equal(t, "warning", receiveEvent(t, events).Payload.Summary)
+
+		notifier.Notify(Alert{Kind: KindOutOfTime, Severity: SeverityCritical, Game: game, Summary: "critical"})
+		require.Equal(t, "critical", receiveEvent(t, events).Payload.Summary)
+	})
+
+	t.Run("SendDifferentKindsAndGames", func(t *testing.T) {
+		notifier, _, events := setupWebhookTest(t)
+		notifier.Notify(Alert{Kind: KindOutOfTime, Severity: SeverityError, Game: game, Summary: "first"})
+		require.Equal(t, "first", receiveEvent(t, events).Payload.Summary)
+		notifier.Notify(Alert{Kind: KindGameLost, Severity: SeverityError, Game: game, Summary: "other kind"})
+		require.Equal(t, "other kind", receiveEvent(t, events).Payload.Summary)
+		notifier.Notify(Alert{Kind: KindOutOfTime, Severity: SeverityError, Game: common.Address{0xbb}, Summary: "other game"})
+		require.Equal(t, "other game", receiveEvent(t, events).Payload.Summary)
+	})
+}
+
+func setupWebhookTest(t *testing.T) (*WebhookNotifier, *clock.DeterministicClock, <-chan webhookEvent) {
+	events := make(chan webhookEvent, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event webhookEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(server.Close)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	notifier := NewWebhookNotifier(testlog.Logger(t, log.LevelInfo), cl, server.URL, "routing-key", time.Minute)
+	t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+	return notifier, cl, events
+}
+
+func receiveEvent(t *testing.T, events <-chan webhookEvent) webhookEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for alert")
+		return webhookEvent{}
+	}
+}
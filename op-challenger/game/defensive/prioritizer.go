@@ -0,0 +1,140 @@
+package defensive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// maxLogRange is the maximum number of blocks to request logs for at once, to stay within the limits of L1 providers.
+	maxLogRange = 10_000
+	// l1BlockTime is the L1 block time assumed to find the first block that withdrawals may be proven
+	// against games of the game window in.
+	l1BlockTime = 12 * time.Second
+)
+
+type PortalContract interface {
+	ProvenWithdrawalsFilter(fromBlock uint64, toBlock uint64) ethereum.FilterQuery
+	DecodeProvenWithdrawal(log *ethTypes.Log) (contracts.ProvenWithdrawal, error)
+	GetWithdrawalStatus(ctx context.Context, block rpcblock.Block, withdrawals ...contracts.ProvenWithdrawal) ([]contracts.WithdrawalStatus, error)
+}
+
+type LogSource interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error)
+}
+
+// Prioritizer orders the games to progress so that the games pending withdrawals were proven against come first.
+// When the scheduler is saturated, the moves and resolution of these games are then not delayed by games that
+// no withdrawal depends on.
+// All function calls must be made on the same thread.
+type Prioritizer struct {
+	logger log.Logger
+	portal PortalContract
+	logs   LogSource
+
+	// lookback is the number of blocks before the first L1 head to search for proven withdrawals.
+	lookback uint64
+	// nextBlock is the first block not yet searched for proven withdrawals, or 0 before the first search.
+	nextBlock uint64
+	// pending are the proven withdrawals that are not known to be finalized or irrelevant yet.
+	pending map[contracts.ProvenWithdrawal]struct{}
+}
+
+func NewPrioritizer(logger log.Logger, portal PortalContract, logs LogSource, gameWindow time.Duration) *Prioritizer {
+	return &Prioritizer{
+		logger:   logger,
+		portal:   portal,
+		logs:     logs,
+		lookback: uint64(gameWindow / l1BlockTime),
+		pending:  make(map[contracts.ProvenWithdrawal]struct{}),
+	}
+}
+
+// Prioritize returns the games with the games that pending withdrawals were proven against first.
+// Otherwise, the order of the games is kept.
+func (p *Prioritizer) Prioritize(ctx context.Context, blockHash common.Hash, blockNumber uint64, games []types.GameMetadata) ([]types.GameMetadata, error) {
+	if err := p.findProvenWithdrawals(ctx, blockNumber); err != nil {
+		return nil, err
+	}
+	gameAddrs := make(map[common.Address]struct{}, len(games))
+	for _, game := range games {
+		gameAddrs[game.Proxy] = struct{}{}
+	}
+	defended, err := p.pendingGames(ctx, blockHash, gameAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	prioritized := make([]types.GameMetadata, 0, len(games))
+	var others []types.GameMetadata
+	for _, game := range games {
+		if _, ok := defended[game.Proxy]; ok {
+			prioritized = append(prioritized, game)
+		} else {
+			others = append(others, game)
+		}
+	}
+	if len(prioritized) > 0 {
+		p.logger.Debug("Prioritizing games of pending withdrawals", "games", len(prioritized), "withdrawals", len(p.pending))
+	}
+	return append(prioritized, others...), nil
+}
+
+// findProvenWithdrawals records the withdrawals proven in the blocks up to blockNumber that were not searched yet.
+func (p *Prioritizer) findProvenWithdrawals(ctx context.Context, blockNumber uint64) error {
+	if p.nextBlock == 0 {
+		p.nextBlock = blockNumber - min(blockNumber, p.lookback)
+	}
+	for p.nextBlock <= blockNumber {
+		toBlock := min(p.nextBlock+maxLogRange-1, blockNumber)
+		logs, err := p.logs.FilterLogs(ctx, p.portal.ProvenWithdrawalsFilter(p.nextBlock, toBlock))
+		if err != nil {
+			return fmt.Errorf("failed to fetch proven withdrawals from block %v to %v: %w", p.nextBlock, toBlock, err)
+		}
+		for i := range logs {
+			withdrawal, err := p.portal.DecodeProvenWithdrawal(&logs[i])
+			if err != nil {
+				p.logger.Warn("Ignoring invalid proven withdrawal log", "tx", logs[i].TxHash, "index", logs[i].Index, "err", err)
+				continue
+			}
+			p.pending[withdrawal] = struct{}{}
+		}
+		p.nextBlock = toBlock + 1
+	}
+	return nil
+}
+
+// pendingGames returns the games that pending withdrawals were proven against.
+// Withdrawals that are finalized, or were proven against games that are not progressed, are no longer tracked.
+// Proving them again against another game emits a new log, so they are then found again.
+func (p *Prioritizer) pendingGames(ctx context.Context, blockHash common.Hash, games map[common.Address]struct{}) (map[common.Address]struct{}, error) {
+	withdrawals := make([]contracts.ProvenWithdrawal, 0, len(p.pending))
+	for withdrawal := range p.pending {
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	if len(withdrawals) == 0 {
+		return nil, nil
+	}
+	statuses, err := p.portal.GetWithdrawalStatus(ctx, rpcblock.ByHash(blockHash), withdrawals...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status of proven withdrawals: %w", err)
+	}
+	defended := make(map[common.Address]struct{})
+	for i, status := range statuses {
+		if _, ok := games[status.DisputeGame]; !ok || status.Finalized {
+			delete(p.pending, withdrawals[i])
+			continue
+		}
+		defended[status.DisputeGame] = struct{}{}
+	}
+	return defended, nil
+}
@@ -0,0 +1,172 @@
+package defensive
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	game1 = types.GameMetadata{Index: 0, Proxy: common.Address{0x01}}
+	game2 = types.GameMetadata{Index: 1, Proxy: common.Address{0x02}}
+	game3 = types.GameMetadata{Index: 2, Proxy: common.Address{0x03}}
+
+	withdrawal1 = contracts.ProvenWithdrawal{WithdrawalHash: common.Hash{0xa1}, ProofSubmitter: common.Address{0xb1}}
+	withdrawal2 = contracts.ProvenWithdrawal{WithdrawalHash: common.Hash{0xa2}, ProofSubmitter: common.Address{0xb2}}
+)
+
+func TestPrioritize(t *testing.T) {
+	games := []types.GameMetadata{game1, game2, game3}
+
+	t.Run("KeepOrderWithoutWithdrawals", func(t *testing.T) {
+		prioritizer, _, _ := setupPrioritizerTest(t)
+		actual, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.NoError(t, err)
+		require.Equal(t, games, actual)
+	})
+
+	t.Run("PrioritizeGamesOfPendingWithdrawals", func(t *testing.T) {
+		prioritizer, portal, logs := setupPrioritizerTest(t)
+		logs.proven[50] = []contracts.ProvenWithdrawal{withdrawal1}
+		logs.proven[90] = []contracts.ProvenWithdrawal{withdrawal2}
+		portal.status[withdrawal1] = contracts.WithdrawalStatus{DisputeGame: game3.Proxy}
+		portal.status[withdrawal2] = contracts.WithdrawalStatus{DisputeGame: game2.Proxy}
+
+		actual, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.NoError(t, err)
+		require.Equal(t, []types.GameMetadata{game2, game3, game1}, actual)
+	})
+
+	t.Run("IgnoreFinalizedWithdrawals", func(t *testing.T) {
+		prioritizer, portal, logs := setupPrioritizerTest(t)
+		logs.proven[50] = []contracts.ProvenWithdrawal{withdrawal1}
+		portal.status[withdrawal1] = contracts.WithdrawalStatus{DisputeGame: game3.Proxy, Finalized: true}
+
+		actual, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.NoError(t, err)
+		require.Equal(t, games, actual)
+		require.Empty(t, prioritizer.pending)
+	})
+
+	t.Run("StopTrackingWithdrawalsOfOtherGames", func(t *testing.T) {
+		prioritizer, portal, logs := setupPrioritizerTest(t)
+		logs.proven[50] = []contracts.ProvenWithdrawal{withdrawal1}
+		portal.status[withdrawal1] = contracts.WithdrawalStatus{DisputeGame: common.Address{0xff}}
+
+		actual, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.NoError(t, err)
+		require.Equal(t, games, actual)
+		require.Empty(t, prioritizer.pending)
+	})
+
+	t.Run("SearchOnlyNewBlocks", func(t *testing.T) {
+		prioritizer, portal, logs := setupPrioritizerTest(t)
+		portal.status[withdrawal1] = contracts.WithdrawalStatus{DisputeGame: game2.Proxy}
+		_, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.NoError(t, err)
+		require.Equal(t, [][2]uint64{{0, 100}}, logs.queries)
+
+		logs.proven[101] = []contracts.ProvenWithdrawal{withdrawal1}
+		actual, err := prioritizer.Prioritize(context.Background(), common.Hash{0xbb}, 101, games)
+		require.NoError(t, err)
+		require.Equal(t, [][2]uint64{{0, 100}, {101, 101}}, logs.queries)
+		require.Equal(t, []types.GameMetadata{game2, game1, game3}, actual)
+	})
+
+	t.Run("LimitLookbackAndLogRange", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LevelInfo)
+		logs := &stubLogSource{proven: make(map[uint64][]contracts.ProvenWithdrawal)}
+		prioritizer := NewPrioritizer(logger, &stubPortal{}, logs, 28*24*time.Hour)
+		_, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 1_000_000, games)
+		require.NoError(t, err)
+		lookback := uint64(28 * 24 * time.Hour / l1BlockTime)
+		require.Equal(t, 1_000_000-lookback, logs.queries[0][0])
+		for _, query := range logs.queries {
+			require.LessOrEqual(t, query[1]-query[0]+1, uint64(maxLogRange))
+		}
+		require.Equal(t, uint64(1_000_000), logs.queries[len(logs.queries)-1][1])
+	})
+
+	t.Run("LogError", func(t *testing.T) {
+		prioritizer, _, logs := setupPrioritizerTest(t)
+		logs.err = errors.New("boom")
+		_, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.ErrorIs(t, err, logs.err)
+	})
+
+	t.Run("StatusError", func(t *testing.T) {
+		prioritizer, portal, logs := setupPrioritizerTest(t)
+		logs.proven[50] = []contracts.ProvenWithdrawal{withdrawal1}
+		portal.err = errors.New("boom")
+		_, err := prioritizer.Prioritize(context.Background(), common.Hash{0xaa}, 100, games)
+		require.ErrorIs(t, err, portal.err)
+	})
+}
+
+func setupPrioritizerTest(t *testing.T) (*Prioritizer, *stubPortal, *stubLogSource) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	portal := &stubPortal{status: make(map[contracts.ProvenWithdrawal]contracts.WithdrawalStatus)}
+	logs := &stubLogSource{proven: make(map[uint64][]contracts.ProvenWithdrawal)}
+	// Lookback of 200 blocks, before the first block in the tests.
+	return NewPrioritizer(logger, portal, logs, 200*l1BlockTime), portal, logs
+}
+
+type stubPortal struct {
+	status map[contracts.ProvenWithdrawal]contracts.WithdrawalStatus
+	err    error
+}
+
+func (s *stubPortal) ProvenWithdrawalsFilter(fromBlock uint64, toBlock uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{FromBlock: new(big.Int).SetUint64(fromBlock), ToBlock: new(big.Int).SetUint64(toBlock)}
+}
+
+func (s *stubPortal) DecodeProvenWithdrawal(log *ethTypes.Log) (contracts.ProvenWithdrawal, error) {
+	return contracts.ProvenWithdrawal{WithdrawalHash: log.Topics[0], ProofSubmitter: common.BytesToAddress(log.Topics[1].Bytes())}, nil
+}
+
+func (s *stubPortal) GetWithdrawalStatus(_ context.Context, _ rpcblock.Block, withdrawals ...contracts.ProvenWithdrawal) ([]contracts.WithdrawalStatus, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	statuses := make([]contracts.WithdrawalStatus, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		statuses[i] = s.status[withdrawal]
+	}
+	return statuses, nil
+}
+
+type stubLogSource struct {
+	proven  map[uint64][]contracts.ProvenWithdrawal
+	queries [][2]uint64
+	err     error
+}
+
+func (s *stubLogSource) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	s.queries = append(s.queries, [2]uint64{from, to})
+	var logs []ethTypes.Log
+	for block := from; block <= to; block++ {
+		for _, withdrawal := range s.proven[block] {
+			logs = append(logs, ethTypes.Log{
+				BlockNumber: block,
+				Topics:      []common.Hash{withdrawal.WithdrawalHash, common.BytesToHash(withdrawal.ProofSubmitter.Bytes())},
+			})
+		}
+	}
+	return logs, nil
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 )
 
 const gameDirPrefix = "game-"
@@ -16,10 +18,12 @@ const gameDirPrefix = "game-"
 // diskManager coordinates the storage of game data on disk.
 type diskManager struct {
 	datadir string
+	// history of the games, removed along with the game directories. May be nil.
+	history *history.DB
 }
 
-func newDiskManager(dir string) *diskManager {
-	return &diskManager{datadir: dir}
+func newDiskManager(dir string, history *history.DB) *diskManager {
+	return &diskManager{datadir: dir, history: history}
 }
 
 func (d *diskManager) DirForGame(addr common.Address) string {
@@ -51,5 +55,8 @@ func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
 		}
 		errs = append(errs, os.RemoveAll(filepath.Join(d.datadir, entry.Name())))
 	}
+	if d.history != nil {
+		errs = append(errs, d.history.RemoveAllExcept(keep))
+	}
 	return errors.Join(errs...)
 }
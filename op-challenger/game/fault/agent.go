@@ -2,19 +2,23 @@ package fault
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"slices"
 	"sync"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -25,9 +29,25 @@ type Responder interface {
 	Resolve() error
 	CallResolveClaim(ctx context.Context, claimIdx uint64) error
 	ResolveClaims(claimIdx ...uint64) error
-	PerformAction(ctx context.Context, action types.Action) error
+	PerformAction(ctx context.Context, action types.Action) (common.Hash, error)
 }
 
+// ActionHistory persists the state of a game across restarts.
+type ActionHistory interface {
+	KnownClaims() (history.KnownClaims, error)
+	SetKnownClaims(claims history.KnownClaims) error
+	Actions() ([]history.Action, error)
+	RecordAction(action history.Action) error
+}
+
+// pendingActionTimeout is the time after which an action that was sent before a restart, without a receipt
+// being recorded, or an action that was included but is still missing from the game, is sent again.
+const pendingActionTimeout = 10 * time.Minute
+
+// outOfTimeDivisor sets the threshold for alerting about a response that could not be made: an alert is raised once
+// less than 1/outOfTimeDivisor of the max clock duration remains to counter the claim.
+const outOfTimeDivisor = 10
+
 type ClaimLoader interface {
 	GetAllClaims(ctx context.Context, block rpcblock.Block) ([]types.Claim, error)
 	IsL2BlockNumberChallenged(ctx context.Context, block rpcblock.Block) (bool, error)
@@ -40,10 +60,13 @@ type Agent struct {
 	solver           *solver.GameSolver
 	loader           ClaimLoader
 	responder        Responder
+	history          ActionHistory
 	selective        bool
 	claimants        []common.Address
 	maxDepth         types.Depth
 	maxClockDuration time.Duration
+	notifier         alerts.Notifier
+	gameAddr         common.Address
 	log              log.Logger
 }
 
@@ -56,6 +79,9 @@ func NewAgent(
 	maxClockDuration time.Duration,
 	trace types.TraceAccessor,
 	responder Responder,
+	gameHistory ActionHistory,
+	notifier alerts.Notifier,
+	gameAddr common.Address,
 	log log.Logger,
 	selective bool,
 	claimants []common.Address,
@@ -67,10 +93,13 @@ func NewAgent(
 		solver:           solver.NewGameSolver(maxDepth, trace),
 		loader:           loader,
 		responder:        responder,
+		history:          gameHistory,
 		selective:        selective,
 		claimants:        claimants,
 		maxDepth:         maxDepth,
 		maxClockDuration: maxClockDuration,
+		notifier:         notifier,
+		gameAddr:         gameAddr,
 		log:              log,
 	}
 }
@@ -98,22 +127,89 @@ func (a *Agent) Act(ctx context.Context) error {
 		return fmt.Errorf("create game from contracts: %w", err)
 	}
 
+	known := knownClaimsOf(game.Claims())
+	if prev, err := a.history.KnownClaims(); err != nil && !errors.Is(err, history.ErrNotFound) {
+		a.log.Warn("Failed to load known claims", "err", err)
+	} else if err == nil && prev == known {
+		a.log.Debug("Skipping game without new claims, no actions required", "claims", known.Count)
+		return nil
+	}
+
 	actions, err := a.solver.CalculateNextActions(ctx, game)
 	if err != nil {
 		a.log.Error("Failed to calculate all required moves", "err", err)
+		a.notifier.Notify(alerts.Alert{
+			Kind:     alerts.KindUncounterableClaim,
+			Severity: alerts.SeverityError,
+			Game:     a.gameAddr,
+			Summary:  fmt.Sprintf("Failed to calculate responses to claims in game %v", a.gameAddr),
+			Details:  map[string]any{"claims": known.Count, "err": err.Error()},
+		})
+	}
+
+	if freeloaders := countFreeloaders(actions); freeloaders > 0 {
+		a.log.Warn("Countering freeloader claims", "freeloaders", freeloaders)
 	}
 
 	var wg sync.WaitGroup
 	wg.Add(len(actions))
 	for _, action := range actions {
-		go a.performAction(ctx, &wg, action)
+		go func() {
+			defer wg.Done()
+			if !a.performAction(ctx, action) {
+				a.checkTimeRemaining(game, action)
+			}
+		}()
 	}
 	wg.Wait()
+	// Only once the current claims require no further actions, they do not have to be solved again.
+	// Actions that were performed are checked by solving the claims which include them, so that an
+	// action dropped by an L1 reorg is calculated, and sent, again.
+	if err == nil && len(actions) == 0 {
+		if err := a.history.SetKnownClaims(known); err != nil {
+			a.log.Warn("Failed to record known claims", "err", err)
+		}
+	}
 	return nil
 }
 
-func (a *Agent) performAction(ctx context.Context, wg *sync.WaitGroup, action types.Action) {
-	defer wg.Done()
+// countFreeloaders returns the number of actions that counter a freeloader claim.
+func countFreeloaders(actions []types.Action) int {
+	count := 0
+	for _, action := range actions {
+		if action.CountersFreeloader {
+			count++
+		}
+	}
+	return count
+}
+
+// knownClaimsOf identifies the set of claims in the game.
+func knownClaimsOf(claims []types.Claim) history.KnownClaims {
+	hasher := crypto.NewKeccakState()
+	for _, claim := range claims {
+		hasher.Write(claim.Value.Bytes())
+		hasher.Write(claim.Position.ToGIndex().Bytes())
+		hasher.Write(claim.Claimant.Bytes())
+		hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(claim.ParentContractIndex)))
+	}
+	return history.KnownClaims{
+		Count: uint64(len(claims)),
+		Hash:  common.BytesToHash(hasher.Sum(nil)),
+	}
+}
+
+func historyAction(action types.Action) history.Action {
+	return history.Action{
+		Type:        action.Type.String(),
+		ParentIndex: uint64(action.ParentClaim.ContractIndex),
+		IsAttack:    action.IsAttack,
+		Value:       action.Value,
+	}
+}
+
+// performAction performs the action, unless it was performed before, and returns true if it succeeded.
+func (a *Agent) performAction(ctx context.Context, action types.Action) bool {
 	actionLog := a.log.New("action", action.Type)
 	if action.Type == types.ActionTypeStep {
 		containsOracleData := action.OracleData != nil
@@ -132,6 +228,9 @@ func (a *Agent) performAction(ctx context.Context, wg *sync.WaitGroup, action ty
 	} else if action.Type == types.ActionTypeMove {
 		actionLog = actionLog.New("is_attack", action.IsAttack, "parent", action.ParentClaim.ContractIndex, "value", action.Value)
 	}
+	if action.CountersFreeloader {
+		actionLog = actionLog.New("freeloader", action.ParentClaim.Claimant)
+	}
 
 	switch action.Type {
 	case types.ActionTypeMove:
@@ -141,10 +240,118 @@ func (a *Agent) performAction(ctx context.Context, wg *sync.WaitGroup, action ty
 	case types.ActionTypeChallengeL2BlockNumber:
 		a.metrics.RecordGameL2Challenge()
 	}
+	record := historyAction(action)
+	if prev, ok := a.previousAction(record); ok {
+		switch prev.Status {
+		case history.StatusSucceeded:
+			// The action is still required by the current claims, so its transaction may not be reflected in the
+			// loaded game yet. If it remains missing, it was dropped by a reorg and is sent again.
+			if a.systemClock.Since(time.Unix(int64(prev.Timestamp), 0)) < pendingActionTimeout {
+				actionLog.Info("Skipping action, already performed", "tx", prev.TxHash)
+				return true
+			}
+			actionLog.Warn("Action was performed but is missing from the game, sending again", "tx", prev.TxHash)
+		case history.StatusPending:
+			if a.systemClock.Since(time.Unix(int64(prev.Timestamp), 0)) < pendingActionTimeout {
+				actionLog.Info("Skipping action, already pending")
+				return false
+			}
+		}
+	}
+
+	a.recordAction(record, history.StatusPending, common.Hash{}, nil)
 	actionLog.Info("Performing action")
-	err := a.responder.PerformAction(ctx, action)
+	txHash, err := a.responder.PerformAction(ctx, action)
 	if err != nil {
 		actionLog.Error("Action failed", "err", err)
+		a.recordAction(record, history.StatusFailed, common.Hash{}, err)
+		return false
+	}
+	if txHash == (common.Hash{}) {
+		a.recordAction(record, history.StatusDeferred, common.Hash{}, nil)
+		return false
+	}
+	a.recordAction(record, history.StatusSucceeded, txHash, nil)
+	if action.CountersFreeloader {
+		a.metrics.RecordFreeloaderCountered()
+	}
+	return true
+}
+
+// checkTimeRemaining raises an alert if the claim countered by an action that wasn't performed is close to
+// running out of clock, as the claim will be uncontested once its clock expires.
+func (a *Agent) checkTimeRemaining(game types.Game, action types.Action) {
+	if action.Type != types.ActionTypeMove && action.Type != types.ActionTypeStep {
+		return
+	}
+	remaining := a.maxClockDuration - game.ChessClock(a.l1Clock.Now(), action.ParentClaim)
+	if remaining >= a.maxClockDuration/outOfTimeDivisor {
+		return
+	}
+	a.notifier.Notify(alerts.Alert{
+		Kind:     alerts.KindOutOfTime,
+		Severity: alerts.SeverityCritical,
+		Game:     a.gameAddr,
+		Summary:  fmt.Sprintf("Running out of time to counter claim %v in game %v", action.ParentClaim.ContractIndex, a.gameAddr),
+		Details: map[string]any{
+			"action":    action.Type.String(),
+			"parent":    action.ParentClaim.ContractIndex,
+			"remaining": remaining.String(),
+		},
+	})
+}
+
+// CheckOutcome raises an alert if the game resolved with the outcome that the honest actor disagrees with.
+func (a *Agent) CheckOutcome(ctx context.Context, status gameTypes.GameStatus) {
+	if status == gameTypes.GameStatusInProgress {
+		return
+	}
+	game, err := a.newGameFromContracts(ctx)
+	if err != nil {
+		a.log.Error("Failed to load game to check outcome", "err", err)
+		return
+	}
+	agreeWithRootClaim, err := a.solver.AgreeWithRootClaim(ctx, game)
+	if err != nil {
+		a.log.Error("Failed to check agreement with root claim", "err", err)
+		return
+	}
+	won := agreeWithRootClaim == (status == gameTypes.GameStatusDefenderWon)
+	if won {
+		return
+	}
+	a.log.Error("Game resolved with incorrect outcome", "status", status, "agreeWithRootClaim", agreeWithRootClaim)
+	a.notifier.Notify(alerts.Alert{
+		Kind:     alerts.KindGameLost,
+		Severity: alerts.SeverityCritical,
+		Game:     a.gameAddr,
+		Summary:  fmt.Sprintf("Game %v resolved as %v", a.gameAddr, status),
+		Details:  map[string]any{"status": status.String(), "agreeWithRootClaim": agreeWithRootClaim},
+	})
+}
+
+func (a *Agent) previousAction(record history.Action) (history.Action, bool) {
+	actions, err := a.history.Actions()
+	if err != nil {
+		a.log.Warn("Failed to load action history", "err", err)
+		return history.Action{}, false
+	}
+	i := slices.IndexFunc(actions, record.SameAs)
+	if i < 0 {
+		return history.Action{}, false
+	}
+	return actions[i], true
+}
+
+func (a *Agent) recordAction(record history.Action, status history.Status, txHash common.Hash, actionErr error) {
+	record.Status = status
+	record.TxHash = txHash
+	if actionErr != nil {
+		record.Error = actionErr.Error()
+	}
+	record.Timestamp = uint64(a.systemClock.Now().Unix())
+	if err := a.history.RecordAction(record); err != nil {
+		a.log.Warn("Failed to record action", "action", record.Type, "status", status, "err", err)
 	}
 }
 
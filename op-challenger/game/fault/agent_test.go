@@ -17,9 +17,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -194,16 +196,189 @@ func TestLoadClaimsWhenGameNotResolvable(t *testing.T) {
 	require.Zero(t, responder.resolveClaimCount, "should not send resolveClaim")
 }
 
+func TestAgent_ActionHistory(t *testing.T) {
+	ctx := context.Background()
+	logger := testlog.Logger(t, log.LevelInfo)
+	db, err := history.NewDB(logger, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	gameHistory := db.Game(common.Address{0xaa})
+
+	systemClock := clock.NewDeterministicClock(time.UnixMilli(120200))
+	agent, claimLoader, responder := setupTestAgentWith(t, systemClock, gameHistory)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := types.Depth(4)
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider(big.NewInt(0), depth))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(test.WithInvalidValue(true)),
+	}
+
+	responder.performActionErr = errors.New("boom")
+	require.NoError(t, agent.Act(ctx))
+	require.Equal(t, 1, responder.performActionCount)
+	actions, err := gameHistory.Actions()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, history.StatusFailed, actions[0].Status)
+
+	// failed actions are retried
+	responder.performActionErr = nil
+	require.NoError(t, agent.Act(ctx))
+	require.Equal(t, 2, responder.performActionCount)
+	actions, err = gameHistory.Actions()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, history.StatusSucceeded, actions[0].Status)
+	require.Equal(t, stubTxHash, actions[0].TxHash)
+
+	_, err = gameHistory.KnownClaims()
+	require.ErrorIs(t, err, history.ErrNotFound, "claims still require an action")
+
+	// the included action may not be reflected in the loaded game yet
+	require.NoError(t, agent.Act(ctx))
+	require.Equal(t, 2, responder.performActionCount)
+
+	// the included action is still missing from the game, so it was dropped by a reorg
+	systemClock.AdvanceTime(pendingActionTimeout)
+	require.NoError(t, agent.Act(ctx))
+	require.Equal(t, 3, responder.performActionCount)
+
+	// once the action is reflected in the game, no further actions are required
+	claimLoader.claims = append(claimLoader.claims, claimBuilder.AttackClaim(claimLoader.claims[0]))
+	require.NoError(t, agent.Act(ctx))
+	require.Equal(t, 3, responder.performActionCount)
+	known, err := gameHistory.KnownClaims()
+	require.NoError(t, err)
+	require.Equal(t, knownClaimsOf(claimLoader.claims), known)
+
+	// the claims require no actions, so the game is not solved again after a restart
+	restarted, restartedLoader, restartedResponder := setupTestAgentWith(t, systemClock, gameHistory)
+	restartedResponder.callResolveErr = responder.callResolveErr
+	restartedResponder.callResolveClaimErr = responder.callResolveClaimErr
+	restartedLoader.claims = claimLoader.claims
+	require.NoError(t, restarted.Act(ctx))
+	require.Zero(t, restartedResponder.performActionCount)
+
+	t.Run("pending", func(t *testing.T) {
+		gameHistory := db.Game(common.Address{0xbb})
+		agent, claimLoader, responder := setupTestAgentWith(t, systemClock, gameHistory)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		claimLoader.claims = restartedLoader.claims[:1]
+		pending := actions[0]
+		pending.Status = history.StatusPending
+		pending.TxHash = common.Hash{}
+		pending.Timestamp = uint64(systemClock.Now().Unix())
+		require.NoError(t, gameHistory.RecordAction(pending))
+
+		// the action was sent before the restart, and may still be included
+		require.NoError(t, agent.Act(ctx))
+		require.Zero(t, responder.performActionCount)
+
+		systemClock.AdvanceTime(pendingActionTimeout)
+		require.NoError(t, agent.Act(ctx))
+		require.Equal(t, 1, responder.performActionCount)
+	})
+}
+
+func TestAgent_AlertWhenRunningOutOfTime(t *testing.T) {
+	depth := types.Depth(4)
+	gameDuration := 3 * time.Minute
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider(big.NewInt(0), depth))
+
+	tests := []struct {
+		name        string
+		elapsed     time.Duration
+		actionErr   error
+		expectAlert bool
+	}{
+		{name: "ActionSucceeded", elapsed: gameDuration * 95 / 100},
+		{name: "ActionFailedWithTimeRemaining", elapsed: gameDuration / 2, actionErr: errors.New("boom")},
+		{name: "ActionFailedCloseToExpiry", elapsed: gameDuration * 95 / 100, actionErr: errors.New("boom"), expectAlert: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			agent, claimLoader, responder := setupTestAgent(t)
+			notifier := &stubNotifier{}
+			agent.notifier = notifier
+			responder.callResolveErr = errors.New("game is not resolvable")
+			responder.callResolveClaimErr = errors.New("claim is not resolvable")
+			responder.performActionErr = tt.actionErr
+			claimLoader.claims = []types.Claim{
+				claimBuilder.CreateRootClaim(test.WithInvalidValue(true), test.WithClock(l1Time.Add(-tt.elapsed), 0)),
+			}
+
+			require.NoError(t, agent.Act(context.Background()))
+			require.Equal(t, 1, responder.performActionCount)
+			if tt.expectAlert {
+				require.Len(t, notifier.alerts, 1)
+				require.Equal(t, alerts.KindOutOfTime, notifier.alerts[0].Kind)
+				require.Equal(t, alerts.SeverityCritical, notifier.alerts[0].Severity)
+				require.Equal(t, common.Address{0xaa}, notifier.alerts[0].Game)
+			} else {
+				require.Empty(t, notifier.alerts)
+			}
+		})
+	}
+}
+
+func TestAgent_CheckOutcome(t *testing.T) {
+	depth := types.Depth(4)
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider(big.NewInt(0), depth))
+
+	tests := []struct {
+		name        string
+		invalidRoot bool
+		status      gameTypes.GameStatus
+		expectAlert bool
+	}{
+		{name: "InProgress", invalidRoot: true, status: gameTypes.GameStatusInProgress},
+		{name: "ValidRootDefenderWon", status: gameTypes.GameStatusDefenderWon},
+		{name: "ValidRootChallengerWon", status: gameTypes.GameStatusChallengerWon, expectAlert: true},
+		{name: "InvalidRootDefenderWon", invalidRoot: true, status: gameTypes.GameStatusDefenderWon, expectAlert: true},
+		{name: "InvalidRootChallengerWon", invalidRoot: true, status: gameTypes.GameStatusChallengerWon},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			agent, claimLoader, _ := setupTestAgent(t)
+			notifier := &stubNotifier{}
+			agent.notifier = notifier
+			claimLoader.claims = []types.Claim{
+				claimBuilder.CreateRootClaim(test.WithInvalidValue(tt.invalidRoot)),
+			}
+
+			agent.CheckOutcome(context.Background(), tt.status)
+			if tt.expectAlert {
+				require.Len(t, notifier.alerts, 1)
+				require.Equal(t, alerts.KindGameLost, notifier.alerts[0].Kind)
+				require.Equal(t, alerts.SeverityCritical, notifier.alerts[0].Severity)
+			} else {
+				require.Empty(t, notifier.alerts)
+			}
+		})
+	}
+}
+
 func setupTestAgent(t *testing.T) (*Agent, *stubClaimLoader, *stubResponder) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	db, err := history.NewDB(logger, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return setupTestAgentWith(t, clock.NewDeterministicClock(time.UnixMilli(120200)), db.Game(common.Address{0xaa}))
+}
+
+func setupTestAgentWith(t *testing.T, systemClock clock.Clock, gameHistory ActionHistory) (*Agent, *stubClaimLoader, *stubResponder) {
 	logger := testlog.Logger(t, log.LevelInfo)
 	claimLoader := &stubClaimLoader{}
 	depth := types.Depth(4)
 	gameDuration := 3 * time.Minute
 	provider := alphabet.NewTraceProvider(big.NewInt(0), depth)
 	responder := &stubResponder{}
-	systemClock := clock.NewDeterministicClock(time.UnixMilli(120200))
 	l1Clock := clock.NewDeterministicClock(l1Time)
-	agent := NewAgent(metrics.NoopMetrics, systemClock, l1Clock, claimLoader, depth, gameDuration, trace.NewSimpleTraceAccessor(provider), responder, logger, false, []common.Address{})
+	agent := NewAgent(metrics.NoopMetrics, systemClock, l1Clock, claimLoader, depth, gameDuration, trace.NewSimpleTraceAccessor(provider), responder, gameHistory, alerts.NoopNotifier{}, common.Address{0xaa}, logger, false, []common.Address{})
 	return agent, claimLoader, responder
 }
 
@@ -239,8 +414,13 @@ type stubResponder struct {
 	callResolveClaimErr   error
 	resolveClaimCount     int
 	resolvedClaims        []uint64
+
+	performActionCount int
+	performActionErr   error
 }
 
+var stubTxHash = common.Hash{0xcc}
+
 func (s *stubResponder) CallResolve(_ context.Context) (gameTypes.GameStatus, error) {
 	s.l.Lock()
 	defer s.l.Unlock()
@@ -273,6 +453,23 @@ func (s *stubResponder) ResolveClaims(claims ...uint64) error {
 	return nil
 }
 
-func (s *stubResponder) PerformAction(_ context.Context, _ types.Action) error {
-	return nil
+func (s *stubResponder) PerformAction(_ context.Context, _ types.Action) (common.Hash, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.performActionCount++
+	if s.performActionErr != nil {
+		return common.Hash{}, s.performActionErr
+	}
+	return stubTxHash, nil
+}
+
+type stubNotifier struct {
+	l      sync.Mutex
+	alerts []alerts.Alert
+}
+
+func (s *stubNotifier) Notify(alert alerts.Alert) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.alerts = append(s.alerts, alert)
 }
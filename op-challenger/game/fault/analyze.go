@@ -0,0 +1,121 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ClaimAnalysis is a claim of a game together with the value an honest actor claims at its position.
+type ClaimAnalysis struct {
+	faultTypes.Claim
+	Expected common.Hash
+}
+
+// Honest returns true if the claim agrees with the honest trace.
+func (c ClaimAnalysis) Honest() bool {
+	return c.Value == c.Expected
+}
+
+// ActorAnalysis summarises the moves an actor made in a game.
+// A step is dishonest when it countered a claim that agrees with the honest trace.
+type ActorAnalysis struct {
+	Address         common.Address
+	Claims          int
+	DishonestClaims int
+	Steps           int
+	DishonestSteps  int
+	Bonds           *big.Int
+}
+
+// Honest returns true if all moves of the actor agree with the honest trace.
+func (a ActorAnalysis) Honest() bool {
+	return a.DishonestClaims == 0 && a.DishonestSteps == 0
+}
+
+type GameAnalysis struct {
+	Claims []ClaimAnalysis
+	// Actors are sorted by address.
+	Actors []ActorAnalysis
+}
+
+// NewHistoricalTraceAccessor creates the trace accessor the challenger uses to play the game, with trace providers
+// for the L1 head of the game. The game type of the game must be enabled in the config.
+func NewHistoricalTraceAccessor(
+	ctx context.Context,
+	logger log.Logger,
+	m metrics.Metricer,
+	cfg *config.Config,
+	contract contracts.FaultDisputeGameContract,
+	rollupClient outputs.OutputRollupClient,
+	l2Client utils.L2HeaderSource,
+	l1HeaderSource L1HeaderSource,
+	dir string,
+) (faultTypes.TraceAccessor, error) {
+	gameType, err := contract.GetGameType(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range newRegisterTasks(logger, m, cfg) {
+		if task.gameType == gameType {
+			return task.newGameTraceAccessor(ctx, logger, m, contract, rollupClient, l2Client, l1HeaderSource, dir)
+		}
+	}
+	return nil, fmt.Errorf("trace type for game type %v not enabled", gameType)
+}
+
+// AnalyzeGame re-evaluates every claim of the game with the honest trace, and reports which actors played honestly.
+func AnalyzeGame(ctx context.Context, game faultTypes.Game, accessor faultTypes.TraceAccessor) (*GameAnalysis, error) {
+	actors := make(map[common.Address]*ActorAnalysis)
+	actor := func(addr common.Address) *ActorAnalysis {
+		a, ok := actors[addr]
+		if !ok {
+			a = &ActorAnalysis{Address: addr, Bonds: new(big.Int)}
+			actors[addr] = a
+		}
+		return a
+	}
+	var analysis GameAnalysis
+	for _, claim := range game.Claims() {
+		expected, err := accessor.Get(ctx, game, claim, claim.Position)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get honest value of claim %v: %w", claim.ContractIndex, err)
+		}
+		result := ClaimAnalysis{Claim: claim, Expected: expected}
+		analysis.Claims = append(analysis.Claims, result)
+
+		claimant := actor(claim.Claimant)
+		claimant.Claims++
+		if !result.Honest() {
+			claimant.DishonestClaims++
+		}
+		if claim.Bond != nil {
+			claimant.Bonds.Add(claimant.Bonds, claim.Bond)
+		}
+		// Claims at max depth can only be countered by a step
+		if claim.Depth() == game.MaxDepth() && claim.CounteredBy != (common.Address{}) {
+			stepper := actor(claim.CounteredBy)
+			stepper.Steps++
+			if result.Honest() {
+				stepper.DishonestSteps++
+			}
+		}
+	}
+	for _, a := range actors {
+		analysis.Actors = append(analysis.Actors, *a)
+	}
+	slices.SortFunc(analysis.Actors, func(a, b ActorAnalysis) int {
+		return a.Address.Cmp(b.Address)
+	})
+	return &analysis, nil
+}
@@ -0,0 +1,84 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeGame(t *testing.T) {
+	proposer := common.Address{0x01}
+	honest := common.Address{0x02}
+	other := common.Address{0x03}
+	builder := test.NewAlphabetClaimBuilder(t, big.NewInt(0), 4)
+	accessor := trace.NewSimpleTraceAccessor(builder.CorrectTraceProvider())
+
+	t.Run("ReportActors", func(t *testing.T) {
+		gameBuilder := builder.GameBuilder(test.WithInvalidValue(true), test.WithClaimant(proposer))
+		seq := gameBuilder.Seq().
+			Attack(test.WithClaimant(honest)).
+			Attack(test.WithInvalidValue(true), test.WithClaimant(proposer)).
+			Attack(test.WithClaimant(honest))
+		seq.Attack(test.WithInvalidValue(true), test.WithClaimant(proposer)).Step(test.WithClaimant(honest))
+		seq.Attack(test.WithClaimant(other)).Step(test.WithClaimant(proposer))
+
+		analysis, err := AnalyzeGame(context.Background(), gameBuilder.Game, accessor)
+		require.NoError(t, err)
+		require.Len(t, analysis.Claims, 6)
+		for _, claim := range analysis.Claims {
+			require.Equal(t, claim.Claimant != proposer, claim.Honest(), "claim %v", claim.ContractIndex)
+		}
+		require.Equal(t, []ActorAnalysis{
+			{Address: proposer, Claims: 3, DishonestClaims: 3, Steps: 1, DishonestSteps: 1, Bonds: new(big.Int)},
+			{Address: honest, Claims: 2, Steps: 1, Bonds: new(big.Int)},
+			{Address: other, Claims: 1, Bonds: new(big.Int)},
+		}, analysis.Actors)
+		require.False(t, analysis.Actors[0].Honest())
+		require.True(t, analysis.Actors[1].Honest())
+		require.True(t, analysis.Actors[2].Honest())
+	})
+
+	t.Run("SumBonds", func(t *testing.T) {
+		root := builder.CreateRootClaim(test.WithClaimant(proposer))
+		root.Bond = big.NewInt(100)
+		child := builder.AttackClaim(root, test.WithClaimant(proposer))
+		child.Bond = big.NewInt(50)
+		child.ContractIndex = 1
+		game := types.NewGameState([]types.Claim{root, child}, 4)
+
+		analysis, err := AnalyzeGame(context.Background(), game, accessor)
+		require.NoError(t, err)
+		require.Len(t, analysis.Actors, 1)
+		require.Equal(t, big.NewInt(150), analysis.Actors[0].Bonds)
+	})
+
+	t.Run("TraceError", func(t *testing.T) {
+		traceErr := errors.New("boom")
+		game := builder.GameBuilder().Game
+		_, err := AnalyzeGame(context.Background(), game, &stubTraceAccessor{err: traceErr})
+		require.ErrorIs(t, err, traceErr)
+	})
+}
+
+type stubTraceAccessor struct {
+	err error
+}
+
+func (s *stubTraceAccessor) Get(_ context.Context, _ types.Game, _ types.Claim, _ types.Position) (common.Hash, error) {
+	return common.Hash{}, s.err
+}
+
+func (s *stubTraceAccessor) GetStepData(_ context.Context, _ types.Game, _ types.Claim, _ types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	return nil, nil, nil, s.err
+}
+
+func (s *stubTraceAccessor) GetL2BlockNumberChallenge(_ context.Context, _ types.Game) (*types.InvalidL2BlockNumberChallenge, error) {
+	return nil, s.err
+}
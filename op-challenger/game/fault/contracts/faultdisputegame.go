@@ -54,6 +54,7 @@ var (
 	methodL2BlockNumberChallenger = "l2BlockNumberChallenger"
 	methodChallengeRootL2Block    = "challengeRootL2Block"
 	methodBondDistributionMode    = "bondDistributionMode"
+	methodGameType                = "gameType"
 )
 
 var (
@@ -404,6 +405,15 @@ func (f *FaultDisputeGameContractLatest) GetAbsolutePrestateHash(ctx context.Con
 	return result.GetHash(0), nil
 }
 
+func (f *FaultDisputeGameContractLatest) GetGameType(ctx context.Context) (types.GameType, error) {
+	defer f.metrics.StartContractRequest("GetGameType")()
+	result, err := f.multiCaller.SingleCall(ctx, rpcblock.Latest, f.contract.Call(methodGameType))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch game type: %w", err)
+	}
+	return types.GameType(result.GetUint32(0)), nil
+}
+
 func (f *FaultDisputeGameContractLatest) GetL1Head(ctx context.Context) (common.Hash, error) {
 	defer f.metrics.StartContractRequest("GetL1Head")()
 	result, err := f.multiCaller.SingleCall(ctx, rpcblock.Latest, f.contract.Call(methodL1Head))
@@ -632,6 +642,7 @@ type FaultDisputeGameContract interface {
 	GetMaxClockDuration(ctx context.Context) (time.Duration, error)
 	GetMaxGameDepth(ctx context.Context) (types.Depth, error)
 	GetAbsolutePrestateHash(ctx context.Context) (common.Hash, error)
+	GetGameType(ctx context.Context) (types.GameType, error)
 	GetL1Head(ctx context.Context) (common.Hash, error)
 	GetStatus(ctx context.Context) (gameTypes.GameStatus, error)
 	GetClaimCount(ctx context.Context) (uint64, error)
@@ -150,6 +150,15 @@ func TestSimpleGetters(t *testing.T) {
 				return game.GetL1Head(context.Background())
 			},
 		},
+		{
+			methodAlias: "gameType",
+			method:      methodGameType,
+			result:      uint32(faultTypes.AsteriscGameType),
+			expected:    faultTypes.AsteriscGameType,
+			call: func(game FaultDisputeGameContract) (any, error) {
+				return game.GetGameType(context.Background())
+			},
+		},
 		{
 			methodAlias: "resolve",
 			method:      methodResolve,
@@ -0,0 +1,105 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	methodProvenWithdrawals    = "provenWithdrawals"
+	methodFinalizedWithdrawals = "finalizedWithdrawals"
+
+	eventWithdrawalProvenExtension1 = "WithdrawalProvenExtension1"
+)
+
+// ProvenWithdrawal identifies the proof of a withdrawal by a single proof submitter.
+type ProvenWithdrawal struct {
+	WithdrawalHash common.Hash
+	ProofSubmitter common.Address
+}
+
+// WithdrawalStatus is the current state of a proven withdrawal.
+type WithdrawalStatus struct {
+	// DisputeGame is the game the withdrawal was proven against by the proof submitter.
+	DisputeGame common.Address
+	Finalized   bool
+}
+
+type OptimismPortalContract struct {
+	metrics     metrics.ContractMetricer
+	multiCaller *batching.MultiCaller
+	contract    *batching.BoundContract
+	abi         *abi.ABI
+}
+
+func NewOptimismPortalContract(m metrics.ContractMetricer, addr common.Address, caller *batching.MultiCaller) *OptimismPortalContract {
+	portalAbi := snapshots.LoadOptimismPortal2ABI()
+	return &OptimismPortalContract{
+		metrics:     m,
+		multiCaller: caller,
+		contract:    batching.NewBoundContract(portalAbi, addr),
+		abi:         portalAbi,
+	}
+}
+
+func (p *OptimismPortalContract) Addr() common.Address {
+	return p.contract.Addr()
+}
+
+// ProvenWithdrawalsFilter returns the query for the logs of the withdrawals proven in the given range of blocks.
+func (p *OptimismPortalContract) ProvenWithdrawalsFilter(fromBlock uint64, toBlock uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{p.contract.Addr()},
+		Topics:    [][]common.Hash{{p.abi.Events[eventWithdrawalProvenExtension1].ID}},
+	}
+}
+
+// DecodeProvenWithdrawal decodes a WithdrawalProvenExtension1 log, which is emitted for every proof of a withdrawal.
+func (p *OptimismPortalContract) DecodeProvenWithdrawal(log *ethTypes.Log) (ProvenWithdrawal, error) {
+	name, result, err := p.contract.DecodeEvent(log)
+	if err != nil {
+		return ProvenWithdrawal{}, fmt.Errorf("failed to decode log: %w", err)
+	}
+	if name != eventWithdrawalProvenExtension1 {
+		return ProvenWithdrawal{}, fmt.Errorf("%w: %v", ErrEventNotFound, eventWithdrawalProvenExtension1)
+	}
+	return ProvenWithdrawal{
+		WithdrawalHash: result.GetHash(0),
+		ProofSubmitter: result.GetAddress(1),
+	}, nil
+}
+
+// GetWithdrawalStatus returns the status of each of the proven withdrawals.
+func (p *OptimismPortalContract) GetWithdrawalStatus(ctx context.Context, block rpcblock.Block, withdrawals ...ProvenWithdrawal) ([]WithdrawalStatus, error) {
+	defer p.metrics.StartContractRequest("GetWithdrawalStatus")()
+	calls := make([]batching.Call, 0, len(withdrawals)*2)
+	for _, withdrawal := range withdrawals {
+		calls = append(calls,
+			p.contract.Call(methodProvenWithdrawals, withdrawal.WithdrawalHash, withdrawal.ProofSubmitter),
+			p.contract.Call(methodFinalizedWithdrawals, withdrawal.WithdrawalHash))
+	}
+	results, err := p.multiCaller.Call(ctx, block, calls...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch withdrawal status: %w", err)
+	}
+	statuses := make([]WithdrawalStatus, len(withdrawals))
+	for i := range withdrawals {
+		statuses[i] = WithdrawalStatus{
+			DisputeGame: results[i*2].GetAddress(0),
+			Finalized:   results[i*2+1].GetBool(0),
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,85 @@
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var portalAddr = common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed")
+
+func TestOptimismPortal_GetWithdrawalStatus(t *testing.T) {
+	stubRpc, portal := setupOptimismPortalTest(t)
+	block := rpcblock.ByHash(common.Hash{0x11})
+	pending := ProvenWithdrawal{WithdrawalHash: common.Hash{0x01}, ProofSubmitter: common.Address{0xa1}}
+	finalized := ProvenWithdrawal{WithdrawalHash: common.Hash{0x02}, ProofSubmitter: common.Address{0xa2}}
+	stubRpc.SetResponse(portalAddr, methodProvenWithdrawals, block, []interface{}{pending.WithdrawalHash, pending.ProofSubmitter}, []interface{}{common.Address{0xd1}, uint64(1234)})
+	stubRpc.SetResponse(portalAddr, methodFinalizedWithdrawals, block, []interface{}{pending.WithdrawalHash}, []interface{}{false})
+	stubRpc.SetResponse(portalAddr, methodProvenWithdrawals, block, []interface{}{finalized.WithdrawalHash, finalized.ProofSubmitter}, []interface{}{common.Address{0xd2}, uint64(1235)})
+	stubRpc.SetResponse(portalAddr, methodFinalizedWithdrawals, block, []interface{}{finalized.WithdrawalHash}, []interface{}{true})
+
+	statuses, err := portal.GetWithdrawalStatus(context.Background(), block, pending, finalized)
+	require.NoError(t, err)
+	require.Equal(t, []WithdrawalStatus{
+		{DisputeGame: common.Address{0xd1}, Finalized: false},
+		{DisputeGame: common.Address{0xd2}, Finalized: true},
+	}, statuses)
+}
+
+func TestOptimismPortal_ProvenWithdrawalLogs(t *testing.T) {
+	_, portal := setupOptimismPortalTest(t)
+	portalAbi := snapshots.LoadOptimismPortal2ABI()
+	withdrawalHash := common.Hash{0xaa}
+	submitter := common.Address{0xbb}
+
+	t.Run("ValidEvent", func(t *testing.T) {
+		log := &ethTypes.Log{
+			Address: portalAddr,
+			Topics: []common.Hash{
+				portalAbi.Events[eventWithdrawalProvenExtension1].ID,
+				withdrawalHash,
+				common.BytesToHash(submitter.Bytes()),
+			},
+		}
+		actual, err := portal.DecodeProvenWithdrawal(log)
+		require.NoError(t, err)
+		require.Equal(t, ProvenWithdrawal{WithdrawalHash: withdrawalHash, ProofSubmitter: submitter}, actual)
+	})
+
+	t.Run("WrongEvent", func(t *testing.T) {
+		log := &ethTypes.Log{
+			Address: portalAddr,
+			Topics: []common.Hash{
+				portalAbi.Events["WithdrawalFinalized"].ID,
+				withdrawalHash,
+			},
+			Data: common.LeftPadBytes([]byte{1}, 32),
+		}
+		_, err := portal.DecodeProvenWithdrawal(log)
+		require.ErrorIs(t, err, ErrEventNotFound)
+	})
+
+	t.Run("FilterProvenEvents", func(t *testing.T) {
+		query := portal.ProvenWithdrawalsFilter(10, 20)
+		require.Equal(t, uint64(10), query.FromBlock.Uint64())
+		require.Equal(t, uint64(20), query.ToBlock.Uint64())
+		require.Equal(t, []common.Address{portalAddr}, query.Addresses)
+		require.Equal(t, [][]common.Hash{{portalAbi.Events[eventWithdrawalProvenExtension1].ID}}, query.Topics)
+	})
+}
+
+func setupOptimismPortalTest(t *testing.T) (*batchingTest.AbiBasedRpc, *OptimismPortalContract) {
+	portalAbi := snapshots.LoadOptimismPortal2ABI()
+	stubRpc := batchingTest.NewAbiBasedRpc(t, portalAddr, portalAbi)
+	caller := batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize)
+	portal := NewOptimismPortalContract(contractMetrics.NoopContractMetrics, portalAddr, caller)
+	return stubRpc, portal
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/preimages"
@@ -23,6 +24,8 @@ import (
 
 type actor func(ctx context.Context) error
 
+type outcomeChecker func(ctx context.Context, status gameTypes.GameStatus)
+
 type GameInfo interface {
 	GetStatus(context.Context) (gameTypes.GameStatus, error)
 	GetClaimCount(context.Context) (uint64, error)
@@ -38,11 +41,13 @@ type L1HeaderSource interface {
 
 type TxSender interface {
 	From() common.Address
+	SendAndWait(txPurpose string, tx txmgr.TxCandidate) (*gethTypes.Receipt, error)
 	SendAndWaitSimple(txPurpose string, txs ...txmgr.TxCandidate) error
 }
 
 type GamePlayer struct {
 	act                actor
+	checkOutcome       outcomeChecker
 	loader             GameInfo
 	logger             log.Logger
 	syncValidator      SyncValidator
@@ -79,6 +84,8 @@ func NewGamePlayer(
 	dir string,
 	addr common.Address,
 	txSender TxSender,
+	gameHistory ActionHistory,
+	notifier alerts.Notifier,
 	loader GameContract,
 	syncValidator SyncValidator,
 	validators []Validator,
@@ -148,9 +155,10 @@ func NewGamePlayer(
 		return nil, fmt.Errorf("failed to create the responder: %w", err)
 	}
 
-	agent := NewAgent(m, systemClock, l1Clock, loader, gameDepth, maxClockDuration, accessor, responder, logger, selective, claimants)
+	agent := NewAgent(m, systemClock, l1Clock, loader, gameDepth, maxClockDuration, accessor, responder, gameHistory, notifier, addr, logger, selective, claimants)
 	return &GamePlayer{
 		act:                agent.Act,
+		checkOutcome:       agent.CheckOutcome,
 		loader:             loader,
 		logger:             logger,
 		status:             status,
@@ -198,8 +206,12 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 	g.logGameStatus(ctx, status)
 	g.status = status
 	if status != gameTypes.GameStatusInProgress {
+		if g.checkOutcome != nil {
+			g.checkOutcome(ctx, status)
+		}
 		// Release the agent as we will no longer need to act on this game.
 		g.act = actNoop
+		g.checkOutcome = nil
 	}
 	return status
 }
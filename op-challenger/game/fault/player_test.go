@@ -72,6 +72,11 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 			errLog := handler.FindLog(levelFilter, msgFilter)
 			require.NotNil(t, errLog, "should log game result")
 			require.Equal(t, test.status, errLog.AttrValue("status"))
+			if test.status == types.GameStatusInProgress {
+				require.Empty(t, gameState.checkedOutcomes)
+			} else {
+				require.Equal(t, []types.GameStatus{test.status}, gameState.checkedOutcomes)
+			}
 		})
 	}
 }
@@ -90,6 +95,7 @@ func TestDoNotActOnCompleteGame(t *testing.T) {
 			fetched = game.ProgressGame(context.Background())
 			require.Equal(t, 1, gameState.callCount, "does not act after game is complete")
 			require.Equal(t, status, fetched)
+			require.Equal(t, []types.GameStatus{status}, gameState.checkedOutcomes, "checks outcome only once")
 
 			// Should have replaced the act function with a noop so callCount doesn't update even when called directly
 			// This allows the agent resources to be GC'd
@@ -172,6 +178,7 @@ func setupProgressGameTest(t *testing.T) (*testlog.CapturingHandler, *GamePlayer
 	syncValidator := &stubSyncValidator{}
 	game := &GamePlayer{
 		act:           gameState.Act,
+		checkOutcome:  gameState.CheckOutcome,
 		loader:        gameState,
 		logger:        logger,
 		syncValidator: syncValidator,
@@ -197,6 +204,12 @@ type stubGameState struct {
 	callCount  int
 	actErr     error
 	Err        error
+
+	checkedOutcomes []types.GameStatus
+}
+
+func (s *stubGameState) CheckOutcome(_ context.Context, status types.GameStatus) {
+	s.checkedOutcomes = append(s.checkedOutcomes, status)
 }
 
 func (s *stubGameState) Act(ctx context.Context) error {
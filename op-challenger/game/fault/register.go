@@ -3,13 +3,17 @@ package fault
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/plugin"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 	keccakTypes "github.com/ethereum-optimism/optimism/op-challenger/game/keccak/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
@@ -54,6 +58,8 @@ func RegisterGameTypes(
 	oracles OracleRegistry,
 	rollupClient RollupClient,
 	txSender TxSender,
+	gameHistory *history.DB,
+	notifier alerts.Notifier,
 	gameFactory *contracts.DisputeGameFactoryContract,
 	caller *batching.MultiCaller,
 	l1HeaderSource L1HeaderSource,
@@ -66,6 +72,15 @@ func RegisterGameTypes(
 	}
 	syncValidator := newSyncStatusValidator(rollupClient)
 
+	for _, task := range newRegisterTasks(logger, m, cfg) {
+		if err := task.Register(ctx, registry, oracles, systemClock, l1Clock, logger, m, syncValidator, rollupClient, txSender, gameHistory, notifier, gameFactory, caller, l2Client, l1HeaderSource, selective, claimants); err != nil {
+			return nil, fmt.Errorf("failed to register %v game type: %w", task.gameType, err)
+		}
+	}
+	return l2Client.Close, nil
+}
+
+func newRegisterTasks(logger log.Logger, m metrics.Metricer, cfg *config.Config) []*RegisterTask {
 	var registerTasks []*RegisterTask
 	if cfg.TraceTypeEnabled(faultTypes.TraceTypeCannon) {
 		registerTasks = append(registerTasks, NewCannonRegisterTask(faultTypes.CannonGameType, cfg, m, vm.NewOpProgramServerExecutor(logger)))
@@ -85,10 +100,14 @@ func RegisterGameTypes(
 	if cfg.TraceTypeEnabled(faultTypes.TraceTypeAlphabet) {
 		registerTasks = append(registerTasks, NewAlphabetRegisterTask(faultTypes.AlphabetGameType))
 	}
-	for _, task := range registerTasks {
-		if err := task.Register(ctx, registry, oracles, systemClock, l1Clock, logger, m, syncValidator, rollupClient, txSender, gameFactory, caller, l2Client, l1HeaderSource, selective, claimants); err != nil {
-			return nil, fmt.Errorf("failed to register %v game type: %w", task.gameType, err)
-		}
+	pluginGameTypes := make([]faultTypes.GameType, 0, len(cfg.Plugins))
+	for gameType := range cfg.Plugins {
+		pluginGameTypes = append(pluginGameTypes, gameType)
 	}
-	return l2Client.Close, nil
+	slices.Sort(pluginGameTypes)
+	for _, gameType := range pluginGameTypes {
+		path := cfg.Plugins[gameType]
+		registerTasks = append(registerTasks, NewPluginRegisterTask(gameType, plugin.NewPlugin(logger.New("plugin", path), path)))
+	}
+	return registerTasks
 }
@@ -2,11 +2,13 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"path/filepath"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
@@ -14,10 +16,12 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/asterisc"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/plugin"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/prestates"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
@@ -171,6 +175,31 @@ func NewAlphabetRegisterTask(gameType faultTypes.GameType) *RegisterTask {
 	}
 }
 
+// NewPluginRegisterTask registers a game type whose execution trace is provided by an external plugin executable.
+func NewPluginRegisterTask(gameType faultTypes.GameType, p *plugin.Plugin) *RegisterTask {
+	return &RegisterTask{
+		gameType: gameType,
+		getPrestateProvider: func(_ context.Context, prestateHash common.Hash) (faultTypes.PrestateProvider, error) {
+			return plugin.NewPrestateProvider(p, prestateHash), nil
+		},
+		newTraceAccessor: func(
+			logger log.Logger,
+			m metrics.Metricer,
+			l2Client utils.L2HeaderSource,
+			prestateProvider faultTypes.PrestateProvider,
+			vmPrestateProvider faultTypes.PrestateProvider,
+			rollupClient outputs.OutputRollupClient,
+			dir string,
+			l1Head eth.BlockID,
+			splitDepth faultTypes.Depth,
+			prestateBlock uint64,
+			poststateBlock uint64) (*trace.Accessor, error) {
+			provider := vmPrestateProvider.(*plugin.PrestateProvider)
+			return outputs.NewOutputPluginTraceAccessor(logger, m, p, l2Client, prestateProvider, provider, rollupClient, dir, l1Head, splitDepth, prestateBlock, poststateBlock)
+		},
+	}
+}
+
 func cachePrestates(
 	gameType faultTypes.GameType,
 	stateConverter vm.StateConverter,
@@ -202,6 +231,8 @@ func (e *RegisterTask) Register(
 	syncValidator SyncValidator,
 	rollupClient outputs.OutputRollupClient,
 	txSender TxSender,
+	gameHistory *history.DB,
+	notifier alerts.Notifier,
 	gameFactory *contracts.DisputeGameFactoryContract,
 	caller *batching.MultiCaller,
 	l2Client utils.L2HeaderSource,
@@ -221,6 +252,15 @@ func (e *RegisterTask) Register(
 
 		vmPrestateProvider, err := e.getPrestateProvider(ctx, requiredPrestatehash)
 		if err != nil {
+			if errors.Is(err, prestates.ErrPrestateUnavailable) {
+				notifier.Notify(alerts.Alert{
+					Kind:     alerts.KindMissingPrestate,
+					Severity: alerts.SeverityCritical,
+					Game:     game.Proxy,
+					Summary:  fmt.Sprintf("Required prestate %v not available for game %v", requiredPrestatehash, game.Proxy),
+					Details:  map[string]any{"gameType": e.gameType.String(), "prestate": requiredPrestatehash.Hex(), "err": err.Error()},
+				})
+			}
 			return nil, fmt.Errorf("required prestate %v not available for game %v: %w", requiredPrestatehash, game.Proxy, err)
 		}
 
@@ -254,7 +294,7 @@ func (e *RegisterTask) Register(
 			validators = append(validators, NewPrestateValidator(e.gameType.String(), contract.GetAbsolutePrestateHash, vmPrestateProvider))
 			validators = append(validators, NewPrestateValidator("output root", contract.GetStartingRootHash, prestateProvider))
 		}
-		return NewGamePlayer(ctx, systemClock, l1Clock, logger, m, dir, game.Proxy, txSender, contract, syncValidator, validators, creator, l1HeaderSource, selective, claimants)
+		return NewGamePlayer(ctx, systemClock, l1Clock, logger, m, dir, game.Proxy, txSender, gameHistory.Game(game.Proxy), notifier, contract, syncValidator, validators, creator, l1HeaderSource, selective, claimants)
 	}
 	err := registerOracle(ctx, logger, m, oracles, gameFactory, caller, e.gameType)
 	if err != nil {
@@ -269,6 +309,41 @@ func (e *RegisterTask) Register(
 	return nil
 }
 
+// newGameTraceAccessor creates the trace accessor for a game without registering a player for it.
+// The trace providers use the L1 head of the game, so the honest trace can be recreated after the game resolved.
+func (e *RegisterTask) newGameTraceAccessor(
+	ctx context.Context,
+	logger log.Logger,
+	m metrics.Metricer,
+	contract contracts.FaultDisputeGameContract,
+	rollupClient outputs.OutputRollupClient,
+	l2Client utils.L2HeaderSource,
+	l1HeaderSource L1HeaderSource,
+	dir string) (*trace.Accessor, error) {
+	requiredPrestatehash, err := contract.GetAbsolutePrestateHash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load prestate hash: %w", err)
+	}
+	vmPrestateProvider, err := e.getPrestateProvider(ctx, requiredPrestatehash)
+	if err != nil {
+		return nil, fmt.Errorf("required prestate %v not available: %w", requiredPrestatehash, err)
+	}
+	prestateBlock, poststateBlock, err := contract.GetBlockRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	splitDepth, err := contract.GetSplitDepth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load split depth: %w", err)
+	}
+	l1HeadID, err := loadL1Head(contract, ctx, l1HeaderSource)
+	if err != nil {
+		return nil, err
+	}
+	prestateProvider := outputs.NewPrestateProvider(rollupClient, prestateBlock)
+	return e.newTraceAccessor(logger, m, l2Client, prestateProvider, vmPrestateProvider, rollupClient, dir, l1HeadID, splitDepth, prestateBlock, poststateBlock)
+}
+
 func registerOracle(ctx context.Context, logger log.Logger, m metrics.Metricer, oracles OracleRegistry, gameFactory *contracts.DisputeGameFactoryContract, caller *batching.MultiCaller, gameType faultTypes.GameType) error {
 	implAddr, err := gameFactory.GetGameImpl(ctx, gameType)
 	if err != nil {
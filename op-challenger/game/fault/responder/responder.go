@@ -10,6 +10,7 @@ import (
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -29,6 +30,7 @@ type Oracle interface {
 }
 
 type TxSender interface {
+	SendAndWait(txPurpose string, tx txmgr.TxCandidate) (*gethTypes.Receipt, error)
 	SendAndWaitSimple(txPurpose string, txs ...txmgr.TxCandidate) error
 }
 
@@ -87,14 +89,16 @@ func (r *FaultResponder) ResolveClaims(claimIdxs ...uint64) error {
 	return r.sender.SendAndWaitSimple("resolve claim", txs...)
 }
 
-func (r *FaultResponder) PerformAction(ctx context.Context, action types.Action) error {
+// PerformAction sends the transaction of the action, and returns its hash once included.
+// A zero hash, without error, is returned if no transaction could be sent yet.
+func (r *FaultResponder) PerformAction(ctx context.Context, action types.Action) (common.Hash, error) {
 	if action.OracleData != nil {
 		var preimageExists bool
 		var err error
 		if !action.OracleData.IsLocal {
 			preimageExists, err = r.oracle.GlobalDataExists(ctx, action.OracleData)
 			if err != nil {
-				return fmt.Errorf("failed to check if preimage exists: %w", err)
+				return common.Hash{}, fmt.Errorf("failed to check if preimage exists: %w", err)
 			}
 		}
 		// Always upload local preimages
@@ -102,9 +106,9 @@ func (r *FaultResponder) PerformAction(ctx context.Context, action types.Action)
 			err := r.uploader.UploadPreimage(ctx, uint64(action.ParentClaim.ContractIndex), action.OracleData)
 			if errors.Is(err, preimages.ErrChallengePeriodNotOver) {
 				r.log.Debug("Large Preimage Squeeze failed, challenge period not over")
-				return nil
+				return common.Hash{}, nil
 			} else if err != nil {
-				return fmt.Errorf("failed to upload preimage: %w", err)
+				return common.Hash{}, fmt.Errorf("failed to upload preimage: %w", err)
 			}
 		}
 	}
@@ -123,7 +127,11 @@ func (r *FaultResponder) PerformAction(ctx context.Context, action types.Action)
 		candidate, err = r.contract.ChallengeL2BlockNumberTx(action.InvalidL2BlockNumberChallenge)
 	}
 	if err != nil {
-		return err
+		return common.Hash{}, err
+	}
+	receipt, err := r.sender.SendAndWait("perform action", candidate)
+	if err != nil {
+		return common.Hash{}, err
 	}
-	return r.sender.SendAndWaitSimple("perform action", candidate)
+	return receipt.TxHash, nil
 }
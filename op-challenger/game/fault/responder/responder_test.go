@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,7 @@ var (
 	mockSendError         = errors.New("mock send error")
 	mockCallError         = errors.New("mock call error")
 	mockOracleExistsError = errors.New("mock oracle exists error")
+	mockTxHash            = common.Hash{0xee}
 )
 
 // TestCallResolve tests the [Responder.CallResolve].
@@ -108,7 +110,7 @@ func TestPerformAction(t *testing.T) {
 	t.Run("send fails", func(t *testing.T) {
 		responder, mockTxMgr, _, _, _ := newTestFaultResponder(t)
 		mockTxMgr.sendFails = true
-		err := responder.PerformAction(context.Background(), types.Action{
+		_, err := responder.PerformAction(context.Background(), types.Action{
 			Type:        types.ActionTypeMove,
 			ParentClaim: types.Claim{ContractIndex: 123},
 			IsAttack:    true,
@@ -120,7 +122,7 @@ func TestPerformAction(t *testing.T) {
 
 	t.Run("sends response", func(t *testing.T) {
 		responder, mockTxMgr, _, _, _ := newTestFaultResponder(t)
-		err := responder.PerformAction(context.Background(), types.Action{
+		txHash, err := responder.PerformAction(context.Background(), types.Action{
 			Type:        types.ActionTypeMove,
 			ParentClaim: types.Claim{ContractIndex: 123},
 			IsAttack:    true,
@@ -128,6 +130,7 @@ func TestPerformAction(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.Equal(t, 1, mockTxMgr.sends)
+		require.Equal(t, mockTxHash, txHash)
 	})
 
 	t.Run("attack", func(t *testing.T) {
@@ -138,7 +141,7 @@ func TestPerformAction(t *testing.T) {
 			IsAttack:    true,
 			Value:       common.Hash{0xaa},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 
 		require.Len(t, mockTxMgr.sent, 1)
@@ -154,7 +157,7 @@ func TestPerformAction(t *testing.T) {
 			IsAttack:    false,
 			Value:       common.Hash{0xaa},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 
 		require.Len(t, mockTxMgr.sent, 1)
@@ -171,7 +174,7 @@ func TestPerformAction(t *testing.T) {
 			PreState:    []byte{1, 2, 3},
 			ProofData:   []byte{4, 5, 6},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 
 		require.Len(t, mockTxMgr.sent, 1)
@@ -191,7 +194,7 @@ func TestPerformAction(t *testing.T) {
 				IsLocal: true,
 			},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 
 		require.Len(t, mockTxMgr.sent, 1)
@@ -213,7 +216,7 @@ func TestPerformAction(t *testing.T) {
 				IsLocal: false,
 			},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 
 		require.Len(t, mockTxMgr.sent, 1)
@@ -236,7 +239,7 @@ func TestPerformAction(t *testing.T) {
 				IsLocal: true,
 			},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.ErrorIs(t, err, mockPreimageUploadErr)
 		require.Len(t, mockTxMgr.sent, 0)
 		require.Nil(t, contract.updateOracleArgs) // mock uploader returns nil
@@ -256,7 +259,7 @@ func TestPerformAction(t *testing.T) {
 				IsLocal: false,
 			},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.Nil(t, err)
 		require.Len(t, mockTxMgr.sent, 1)
 		require.Nil(t, contract.updateOracleArgs) // mock uploader returns nil
@@ -277,7 +280,7 @@ func TestPerformAction(t *testing.T) {
 				IsLocal: false,
 			},
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.ErrorIs(t, err, mockOracleExistsError)
 		require.Len(t, mockTxMgr.sent, 0)
 		require.Nil(t, contract.updateOracleArgs) // mock uploader returns nil
@@ -292,7 +295,7 @@ func TestPerformAction(t *testing.T) {
 			Type:                          types.ActionTypeChallengeL2BlockNumber,
 			InvalidL2BlockNumberChallenge: challenge,
 		}
-		err := responder.PerformAction(context.Background(), action)
+		_, err := responder.PerformAction(context.Background(), action)
 		require.NoError(t, err)
 		require.Len(t, mockTxMgr.sent, 1)
 		require.Equal(t, []interface{}{challenge}, contract.challengeArgs)
@@ -355,6 +358,15 @@ func (m *mockTxManager) SendAndWaitSimple(_ string, txs ...txmgr.TxCandidate) er
 	return nil
 }
 
+func (m *mockTxManager) SendAndWait(_ string, tx txmgr.TxCandidate) (*gethTypes.Receipt, error) {
+	if m.sendFails {
+		return nil, mockSendError
+	}
+	m.sends++
+	m.sent = append(m.sent, tx)
+	return &gethTypes.Receipt{TxHash: mockTxHash, Status: gethTypes.ReceiptStatusSuccessful}, nil
+}
+
 func (m *mockTxManager) BlockNumber(_ context.Context) (uint64, error) {
 	panic("not implemented")
 }
@@ -77,6 +77,10 @@ func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, claim t
 	if claim.CounteredBy != (common.Address{}) {
 		return nil, nil
 	}
+	freeloader, err := s.countersFreeloader(game, claim, agreedClaims)
+	if err != nil {
+		return nil, err
+	}
 	step, err := s.claimSolver.AttemptStep(ctx, game, claim, agreedClaims)
 	if err != nil {
 		return nil, err
@@ -85,16 +89,21 @@ func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, claim t
 		return nil, nil
 	}
 	return &types.Action{
-		Type:        types.ActionTypeStep,
-		ParentClaim: step.LeafClaim,
-		IsAttack:    step.IsAttack,
-		PreState:    step.PreState,
-		ProofData:   step.ProofData,
-		OracleData:  step.OracleData,
+		Type:               types.ActionTypeStep,
+		ParentClaim:        step.LeafClaim,
+		IsAttack:           step.IsAttack,
+		CountersFreeloader: freeloader,
+		PreState:           step.PreState,
+		ProofData:          step.ProofData,
+		OracleData:         step.OracleData,
 	}, nil
 }
 
 func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, claim types.Claim, honestClaims *honestClaimTracker) (*types.Action, error) {
+	freeloader, err := s.countersFreeloader(game, claim, honestClaims)
+	if err != nil {
+		return nil, err
+	}
 	move, err := s.claimSolver.NextMove(ctx, claim, game, honestClaims)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate next move for claim index %v: %w", claim.ContractIndex, err)
@@ -107,9 +116,22 @@ func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, claim t
 		return nil, nil
 	}
 	return &types.Action{
-		Type:        types.ActionTypeMove,
-		IsAttack:    !game.DefendsParent(*move),
-		ParentClaim: game.Claims()[move.ParentContractIndex],
-		Value:       move.Value,
+		Type:               types.ActionTypeMove,
+		IsAttack:           !game.DefendsParent(*move),
+		ParentClaim:        game.Claims()[move.ParentContractIndex],
+		CountersFreeloader: freeloader,
+		Value:              move.Value,
 	}, nil
 }
+
+// countersFreeloader returns true if the claim is a freeloader, so the response to it counters a freeloader.
+func (s *GameSolver) countersFreeloader(game types.Game, claim types.Claim, honestClaims *honestClaimTracker) (bool, error) {
+	if claim.IsRoot() {
+		return false, nil
+	}
+	parent, err := game.GetParent(claim)
+	if err != nil {
+		return false, fmt.Errorf("no parent for claim %v: %w", claim.ContractIndex, err)
+	}
+	return isFreeloader(game, claim, parent, honestClaims), nil
+}
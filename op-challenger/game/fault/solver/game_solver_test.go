@@ -115,36 +115,36 @@ func TestCalculateNextActions(t *testing.T) {
 			name: "Freeloader-ValidClaimAtInvalidAttackPosition",
 			setupGame: func(builder *faulttest.GameBuilder) {
 				builder.Seq().
-					Attack().                // Honest response to invalid root
-					Defend().ExpectDefend(). // Defender agrees at this point, we should defend
-					Attack().ExpectDefend()  // Freeloader attacks instead of defends
+					Attack().                                             // Honest response to invalid root
+					Defend().ExpectDefend().                              // Defender agrees at this point, we should defend
+					Attack().ExpectDefend(faulttest.CountersFreeloader()) // Freeloader attacks instead of defends
 			},
 		},
 		{
 			name: "Freeloader-InvalidClaimAtInvalidAttackPosition",
 			setupGame: func(builder *faulttest.GameBuilder) {
 				builder.Seq().
-					Attack().                                                     // Honest response to invalid root
-					Defend().ExpectDefend().                                      // Defender agrees at this point, we should defend
-					Attack(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack() // Freeloader attacks with wrong claim instead of defends
+					Attack().                                                                                   // Honest response to invalid root
+					Defend().ExpectDefend().                                                                    // Defender agrees at this point, we should defend
+					Attack(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack(faulttest.CountersFreeloader()) // Freeloader attacks with wrong claim instead of defends
 			},
 		},
 		{
 			name: "Freeloader-InvalidClaimAtValidDefensePosition",
 			setupGame: func(builder *faulttest.GameBuilder) {
 				builder.Seq().
-					Attack().                                                     // Honest response to invalid root
-					Defend().ExpectDefend().                                      // Defender agrees at this point, we should defend
-					Defend(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack() // Freeloader defends with wrong claim, we should attack
+					Attack().                                                                                   // Honest response to invalid root
+					Defend().ExpectDefend().                                                                    // Defender agrees at this point, we should defend
+					Defend(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack(faulttest.CountersFreeloader()) // Freeloader defends with wrong claim, we should attack
 			},
 		},
 		{
 			name: "Freeloader-InvalidClaimAtValidAttackPosition",
 			setupGame: func(builder *faulttest.GameBuilder) {
 				builder.Seq().
-					Attack().                                                      // Honest response to invalid root
-					Defend(faulttest.WithValue(common.Hash{0xaa})).ExpectAttack(). // Defender disagrees at this point, we should attack
-					Attack(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack()  // Freeloader attacks with wrong claim instead of defends
+					Attack().                                                                                   // Honest response to invalid root
+					Defend(faulttest.WithValue(common.Hash{0xaa})).ExpectAttack().                              // Defender disagrees at this point, we should attack
+					Attack(faulttest.WithValue(common.Hash{0xbb})).ExpectAttack(faulttest.CountersFreeloader()) // Freeloader attacks with wrong claim instead of defends
 			},
 		},
 		{
@@ -160,9 +160,9 @@ func TestCalculateNextActions(t *testing.T) {
 			name: "Freeloader-ValidClaimAtInvalidAttackPosition-RespondingToDishonestButCorrectAttack",
 			setupGame: func(builder *faulttest.GameBuilder) {
 				builder.Seq().
-					Attack().                // Honest response to invalid root
-					Attack().ExpectDefend(). // Defender attacks with correct value, we should defend
-					Attack().ExpectDefend()  // Freeloader attacks with wrong claim, we should defend
+					Attack().                                             // Honest response to invalid root
+					Attack().ExpectDefend().                              // Defender attacks with correct value, we should defend
+					Attack().ExpectDefend(faulttest.CountersFreeloader()) // Freeloader attacks with wrong claim, we should defend
 			},
 		},
 		{
@@ -193,7 +193,7 @@ func TestCalculateNextActions(t *testing.T) {
 				builder.Seq().
 					ExpectAttack().                                 // Honest response to invalid root
 					Attack(faulttest.WithValue(common.Hash{0xaa})). // freeloader
-					ExpectAttack()                                  // Honest response to freeloader
+					ExpectAttack(faulttest.CountersFreeloader())    // Honest response to freeloader
 			},
 		},
 	}
@@ -48,16 +48,27 @@ func (s *claimSolver) shouldCounter(game types.Game, claim types.Claim, honestCl
 		return true, nil
 	}
 
+	// Of the claims countering a claim the honest actor countered, only counter freeloaders.
+	// Do not respond to any claim countering a claim the honest actor ignored.
+	return isFreeloader(game, claim, parent, honestClaims), nil
+}
+
+// isFreeloader returns true if the claim is a freeloader: a dishonest sibling of the honest counter to its parent,
+// at or left of the position of the honest counter.
+// The bond of a countered claim is paid to the leftmost uncountered claim countering it,
+// so uncountered freeloaders would take the bond share of the honest counter.
+// Siblings right of the honest counter are not freeloaders, as the honest counter is paid before them.
+func isFreeloader(game types.Game, claim types.Claim, parent types.Claim, honestClaims *honestClaimTracker) bool {
+	if honestClaims.IsHonest(claim) || honestClaims.IsHonest(parent) {
+		return false
+	}
 	counter, hasCounter := honestClaims.HonestCounter(parent)
-	// Do not respond to any claim countering a claim the honest actor ignored
 	if !hasCounter {
-		return false, nil
+		return false
 	}
-
-	// Do not counter sibling to an honest claim that are right of the honest claim.
 	honestIdx := counter.TraceIndex(game.MaxDepth())
 	claimIdx := claim.TraceIndex(game.MaxDepth())
-	return claimIdx.Cmp(honestIdx) <= 0, nil
+	return claimIdx.Cmp(honestIdx) <= 0
 }
 
 // NextMove returns the next move to make given the current state of the game.
@@ -98,52 +98,69 @@ func (s *GameBuilderSeq) Step(opts ...ClaimOpt) {
 	s.gameBuilder.Game = types.NewGameState(claims, s.builder.maxDepth)
 }
 
-func (s *GameBuilderSeq) ExpectAttack() *GameBuilderSeq {
+// ExpectOpt customises an expected action.
+type ExpectOpt func(action *types.Action)
+
+// CountersFreeloader expects the action to counter a freeloader claim.
+func CountersFreeloader() ExpectOpt {
+	return func(action *types.Action) {
+		action.CountersFreeloader = true
+	}
+}
+
+func (s *GameBuilderSeq) expect(action types.Action, opts ...ExpectOpt) {
+	for _, opt := range opts {
+		opt(&action)
+	}
+	s.gameBuilder.ExpectedActions = append(s.gameBuilder.ExpectedActions, action)
+}
+
+func (s *GameBuilderSeq) ExpectAttack(opts ...ExpectOpt) *GameBuilderSeq {
 	newPos := s.lastClaim.Position.Attack()
 	value := s.builder.CorrectClaimAtPosition(newPos)
-	s.gameBuilder.ExpectedActions = append(s.gameBuilder.ExpectedActions, types.Action{
+	s.expect(types.Action{
 		Type:        types.ActionTypeMove,
 		ParentClaim: s.lastClaim,
 		IsAttack:    true,
 		Value:       value,
-	})
+	}, opts...)
 	return s
 }
 
-func (s *GameBuilderSeq) ExpectDefend() *GameBuilderSeq {
+func (s *GameBuilderSeq) ExpectDefend(opts ...ExpectOpt) *GameBuilderSeq {
 	newPos := s.lastClaim.Position.Defend()
 	value := s.builder.CorrectClaimAtPosition(newPos)
-	s.gameBuilder.ExpectedActions = append(s.gameBuilder.ExpectedActions, types.Action{
+	s.expect(types.Action{
 		Type:        types.ActionTypeMove,
 		ParentClaim: s.lastClaim,
 		IsAttack:    false,
 		Value:       value,
-	})
+	}, opts...)
 	return s
 }
 
-func (s *GameBuilderSeq) ExpectStepAttack() *GameBuilderSeq {
+func (s *GameBuilderSeq) ExpectStepAttack(opts ...ExpectOpt) *GameBuilderSeq {
 	traceIdx := s.lastClaim.TraceIndex(s.builder.maxDepth)
-	s.gameBuilder.ExpectedActions = append(s.gameBuilder.ExpectedActions, types.Action{
+	s.expect(types.Action{
 		Type:        types.ActionTypeStep,
 		ParentClaim: s.lastClaim,
 		IsAttack:    true,
 		PreState:    s.builder.CorrectPreState(traceIdx),
 		ProofData:   s.builder.CorrectProofData(traceIdx),
 		OracleData:  s.builder.CorrectOracleData(traceIdx),
-	})
+	}, opts...)
 	return s
 }
 
-func (s *GameBuilderSeq) ExpectStepDefend() *GameBuilderSeq {
+func (s *GameBuilderSeq) ExpectStepDefend(opts ...ExpectOpt) *GameBuilderSeq {
 	traceIdx := new(big.Int).Add(s.lastClaim.TraceIndex(s.builder.maxDepth), big.NewInt(1))
-	s.gameBuilder.ExpectedActions = append(s.gameBuilder.ExpectedActions, types.Action{
+	s.expect(types.Action{
 		Type:        types.ActionTypeStep,
 		ParentClaim: s.lastClaim,
 		IsAttack:    false,
 		PreState:    s.builder.CorrectPreState(traceIdx),
 		ProofData:   s.builder.CorrectProofData(traceIdx),
 		OracleData:  s.builder.CorrectOracleData(traceIdx),
-	})
+	}, opts...)
 	return s
 }
@@ -0,0 +1,135 @@
+// Package plugin attaches external proof systems to the challenger. A plugin is an executable that provides the
+// execution trace below the split depth of a game type, so experimental proof systems can be played without
+// recompiling op-challenger. The output root bisection above the split depth is handled by the challenger.
+//
+// The challenger runs the executable once per request, with the method as its only argument.
+// The request is written to stdin as JSON, and the plugin writes the response to stdout as JSON.
+// A plugin that fails to serve a request exits with a non-zero status, and may log the reason to stderr.
+//
+// Methods:
+//   - prestate: returns the absolute prestate of the trace, see PrestateRequest and PrestateResponse.
+//   - proof: returns the claim and step data at a trace index, see ProofRequest and ProofResponse.
+//     The response uses the proof format of 'cannon run', so existing VMs can be wrapped easily.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+)
+
+const (
+	MethodPrestate = "prestate"
+	MethodProof    = "proof"
+)
+
+type PrestateRequest struct {
+	// PrestateHash is the absolute prestate required by the game.
+	// Plugins may use it to select the prestate, but the returned prestate is validated against it regardless.
+	PrestateHash common.Hash `json:"prestateHash"`
+}
+
+type PrestateResponse struct {
+	// Commitment is the hash of the absolute prestate, as committed to by the game.
+	Commitment common.Hash   `json:"commitment"`
+	StateData  hexutil.Bytes `json:"stateData"`
+}
+
+// LocalInputs are the inputs of the execution trace in the bottom half of the game.
+type LocalInputs struct {
+	L1Head        common.Hash  `json:"l1Head"`
+	L2Head        common.Hash  `json:"l2Head"`
+	L2OutputRoot  common.Hash  `json:"l2OutputRoot"`
+	L2Claim       common.Hash  `json:"l2Claim"`
+	L2BlockNumber *hexutil.Big `json:"l2BlockNumber"`
+}
+
+type ProofRequest struct {
+	// Dir is a data directory reserved for the trace, in which the plugin may cache data between requests.
+	Dir          string       `json:"dir"`
+	PrestateHash common.Hash  `json:"prestateHash"`
+	Inputs       LocalInputs  `json:"inputs"`
+	Depth        uint64       `json:"depth"`
+	TraceIndex   *hexutil.Big `json:"traceIndex"`
+}
+
+type ProofResponse struct {
+	utils.ProofData
+
+	// Blob data, required if the step reads a blob field element
+	BlobFieldIndex uint64        `json:"blob-field-index,omitempty"`
+	BlobCommitment hexutil.Bytes `json:"blob-commitment,omitempty"`
+	BlobProof      hexutil.Bytes `json:"blob-proof,omitempty"`
+}
+
+// runFn runs the plugin with the method and request, and returns the response.
+type runFn func(ctx context.Context, method string, request []byte) ([]byte, error)
+
+// Plugin calls an external plugin executable.
+type Plugin struct {
+	logger log.Logger
+	path   string
+	run    runFn
+}
+
+func NewPlugin(logger log.Logger, path string) *Plugin {
+	p := &Plugin{logger: logger, path: path}
+	p.run = p.exec
+	return p
+}
+
+func (p *Plugin) Prestate(ctx context.Context, req PrestateRequest) (*PrestateResponse, error) {
+	var resp PrestateResponse
+	if err := p.call(ctx, MethodPrestate, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *Plugin) Proof(ctx context.Context, req ProofRequest) (*ProofResponse, error) {
+	var resp ProofResponse
+	if err := p.call(ctx, MethodProof, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *Plugin) call(ctx context.Context, method string, req any, resp any) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode %v request: %w", method, err)
+	}
+	out, err := p.run(ctx, method, data)
+	if err != nil {
+		return fmt.Errorf("plugin %v failed to serve %v request: %w", p.path, method, err)
+	}
+	if err := json.Unmarshal(out, resp); err != nil {
+		return fmt.Errorf("invalid %v response of plugin %v: %w", method, p.path, err)
+	}
+	return nil
+}
+
+func (p *Plugin) exec(ctx context.Context, method string, request []byte) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, p.path, method)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	p.logger.Debug("Running plugin", "path", p.path, "method", method)
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %v", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,150 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestPlugin_Exec(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.sh")
+	script := `#!/bin/sh
+if [ "$1" != "prestate" ]; then
+	echo "unsupported method $1" >&2
+	exit 1
+fi
+cat > /dev/null
+echo '{"commitment":"0x0300000000000000000000000000000000000000000000000000000000000000","stateData":"0x1234"}'
+`
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+	p := NewPlugin(testlog.Logger(t, log.LevelInfo), path)
+
+	resp, err := p.Prestate(context.Background(), PrestateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0x03}, resp.Commitment)
+	require.Equal(t, hexutil.Bytes{0x12, 0x34}, resp.StateData)
+
+	_, err = p.Proof(context.Background(), ProofRequest{})
+	require.ErrorContains(t, err, "unsupported method proof")
+}
+
+func TestTraceProvider(t *testing.T) {
+	prestateHash := common.Hash{0xaa}
+	inputs := utils.LocalGameInputs{
+		L1Head:        common.Hash{0x01},
+		L2Head:        common.Hash{0x02},
+		L2OutputRoot:  common.Hash{0x03},
+		L2Claim:       common.Hash{0x04},
+		L2BlockNumber: big.NewInt(42),
+	}
+	depth := types.Depth(10)
+	pos := types.NewPosition(depth, big.NewInt(7))
+
+	setup := func(t *testing.T, proof ProofResponse) (*TraceProvider, *fakeRunner) {
+		runner := &fakeRunner{
+			responses: map[string]any{
+				MethodPrestate: PrestateResponse{Commitment: common.Hash{0xbb}},
+				MethodProof:    proof,
+			},
+		}
+		p := NewPlugin(testlog.Logger(t, log.LevelInfo), "plugin")
+		p.run = runner.run
+		return NewTraceProvider(p, NewPrestateProvider(p, prestateHash), inputs, "/data", depth), runner
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		provider, runner := setup(t, ProofResponse{ProofData: utils.ProofData{ClaimValue: common.Hash{0xcc}}})
+		claim, err := provider.Get(context.Background(), pos)
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{0xcc}, claim)
+
+		var req ProofRequest
+		require.NoError(t, json.Unmarshal(runner.requests[MethodProof], &req))
+		require.Equal(t, "/data", req.Dir)
+		require.Equal(t, prestateHash, req.PrestateHash)
+		require.Equal(t, uint64(depth), req.Depth)
+		require.Equal(t, big.NewInt(7), req.TraceIndex.ToInt())
+		require.Equal(t, inputs.L2Claim, req.Inputs.L2Claim)
+		require.Equal(t, big.NewInt(42), req.Inputs.L2BlockNumber.ToInt())
+	})
+
+	t.Run("GetMissingClaim", func(t *testing.T) {
+		provider, _ := setup(t, ProofResponse{})
+		_, err := provider.Get(context.Background(), pos)
+		require.ErrorContains(t, err, "proof missing post hash")
+	})
+
+	t.Run("GetStepData", func(t *testing.T) {
+		key := preimage.Keccak256Key(common.Hash{0xdd}).PreimageKey()
+		provider, _ := setup(t, ProofResponse{ProofData: utils.ProofData{
+			ClaimValue:   common.Hash{0xcc},
+			StateData:    []byte{0x01},
+			ProofData:    []byte{0x02},
+			OracleKey:    key[:],
+			OracleValue:  []byte{0, 0, 0, 0, 0, 0, 0, 1, 0xff},
+			OracleOffset: 4,
+		}})
+		state, proof, oracleData, err := provider.GetStepData(context.Background(), pos)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x01}, state)
+		require.Equal(t, []byte{0x02}, proof)
+		require.Equal(t, types.NewPreimageOracleData(key[:], []byte{0, 0, 0, 0, 0, 0, 0, 1, 0xff}, 4), oracleData)
+	})
+
+	t.Run("GetStepDataMissingState", func(t *testing.T) {
+		provider, _ := setup(t, ProofResponse{ProofData: utils.ProofData{ProofData: []byte{0x02}}})
+		_, _, _, err := provider.GetStepData(context.Background(), pos)
+		require.ErrorContains(t, err, "proof missing state data")
+	})
+
+	t.Run("AbsolutePreStateCommitment", func(t *testing.T) {
+		provider, runner := setup(t, ProofResponse{})
+		for i := 0; i < 2; i++ {
+			commitment, err := provider.AbsolutePreStateCommitment(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, common.Hash{0xbb}, commitment)
+		}
+		require.Equal(t, 1, runner.calls[MethodPrestate])
+	})
+
+	t.Run("PluginError", func(t *testing.T) {
+		provider, runner := setup(t, ProofResponse{})
+		runner.err = errors.New("boom")
+		_, err := provider.Get(context.Background(), pos)
+		require.ErrorIs(t, err, runner.err)
+	})
+}
+
+type fakeRunner struct {
+	responses map[string]any
+	requests  map[string][]byte
+	calls     map[string]int
+	err       error
+}
+
+func (r *fakeRunner) run(_ context.Context, method string, request []byte) ([]byte, error) {
+	if r.requests == nil {
+		r.requests = make(map[string][]byte)
+		r.calls = make(map[string]int)
+	}
+	r.requests[method] = request
+	r.calls[method]++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return json.Marshal(r.responses[method])
+}
@@ -0,0 +1,133 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+// PrestateProvider provides the absolute prestate of the plugin for the prestate required by a game.
+type PrestateProvider struct {
+	plugin       *Plugin
+	prestateHash common.Hash
+
+	mu         sync.Mutex
+	commitment common.Hash
+}
+
+var _ types.PrestateProvider = (*PrestateProvider)(nil)
+
+func NewPrestateProvider(plugin *Plugin, prestateHash common.Hash) *PrestateProvider {
+	return &PrestateProvider{plugin: plugin, prestateHash: prestateHash}
+}
+
+func (p *PrestateProvider) AbsolutePreStateCommitment(ctx context.Context) (common.Hash, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.commitment != (common.Hash{}) {
+		return p.commitment, nil
+	}
+	resp, err := p.plugin.Prestate(ctx, PrestateRequest{PrestateHash: p.prestateHash})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if resp.Commitment == (common.Hash{}) {
+		return common.Hash{}, errors.New("plugin returned no prestate commitment")
+	}
+	p.commitment = resp.Commitment
+	return p.commitment, nil
+}
+
+// PrestateHash returns the absolute prestate required by the game.
+func (p *PrestateProvider) PrestateHash() common.Hash {
+	return p.prestateHash
+}
+
+// TraceProvider is a [types.TraceProvider] that requests the execution trace from a plugin.
+type TraceProvider struct {
+	types.PrestateProvider
+	plugin       *Plugin
+	prestateHash common.Hash
+	inputs       LocalInputs
+	dir          string
+	gameDepth    types.Depth
+}
+
+var _ types.TraceProvider = (*TraceProvider)(nil)
+
+func NewTraceProvider(plugin *Plugin, prestateProvider *PrestateProvider, localInputs utils.LocalGameInputs, dir string, gameDepth types.Depth) *TraceProvider {
+	return &TraceProvider{
+		PrestateProvider: prestateProvider,
+		plugin:           plugin,
+		prestateHash:     prestateProvider.PrestateHash(),
+		inputs: LocalInputs{
+			L1Head:        localInputs.L1Head,
+			L2Head:        localInputs.L2Head,
+			L2OutputRoot:  localInputs.L2OutputRoot,
+			L2Claim:       localInputs.L2Claim,
+			L2BlockNumber: (*hexutil.Big)(localInputs.L2BlockNumber),
+		},
+		dir:       dir,
+		gameDepth: gameDepth,
+	}
+}
+
+func (p *TraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	proof, err := p.proof(ctx, pos)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if proof.ClaimValue == (common.Hash{}) {
+		return common.Hash{}, errors.New("proof missing post hash")
+	}
+	return proof.ClaimValue, nil
+}
+
+func (p *TraceProvider) GetStepData(ctx context.Context, pos types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	proof, err := p.proof(ctx, pos)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(proof.StateData) == 0 {
+		return nil, nil, nil, errors.New("proof missing state data")
+	}
+	if proof.ProofData.ProofData == nil {
+		return nil, nil, nil, errors.New("proof missing proof data")
+	}
+	var oracleData *types.PreimageOracleData
+	if len(proof.OracleKey) > 0 {
+		if preimage.KeyType(proof.OracleKey[0]) == preimage.BlobKeyType {
+			oracleData = types.NewPreimageOracleBlobData(proof.OracleKey, proof.OracleValue, proof.OracleOffset,
+				proof.BlobFieldIndex, proof.BlobCommitment, proof.BlobProof)
+		} else {
+			oracleData = types.NewPreimageOracleData(proof.OracleKey, proof.OracleValue, proof.OracleOffset)
+		}
+	}
+	return proof.StateData, proof.ProofData.ProofData, oracleData, nil
+}
+
+func (p *TraceProvider) GetL2BlockNumberChallenge(_ context.Context) (*types.InvalidL2BlockNumberChallenge, error) {
+	return nil, types.ErrL2BlockNumberValid
+}
+
+func (p *TraceProvider) proof(ctx context.Context, pos types.Position) (*ProofResponse, error) {
+	proof, err := p.plugin.Proof(ctx, ProofRequest{
+		Dir:          p.dir,
+		PrestateHash: p.prestateHash,
+		Inputs:       p.inputs,
+		Depth:        uint64(p.gameDepth),
+		TraceIndex:   (*hexutil.Big)(pos.TraceIndex(p.gameDepth)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load proof at %v: %w", pos, err)
+	}
+	return proof, nil
+}
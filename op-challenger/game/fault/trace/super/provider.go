@@ -22,7 +22,7 @@ var (
 )
 
 const (
-	StepsPerTimestamp = 1024
+	StepsPerTimestamp = interopTypes.StepsPerTimestamp
 )
 
 type RootProvider interface {
@@ -65,40 +65,83 @@ func (s *SuperTraceProvider) Get(ctx context.Context, pos types.Position) (commo
 		}
 		return common.Hash(root.SuperRoot), nil
 	}
+	state, err := s.transitionState(timestamp, step)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return state.Hash(), nil
+}
+
+// GetPreimageBytes returns the preimage of the claim at the specified position.
+// The preimage is the encoded super root on timestamp boundaries and the encoded TransitionState otherwise.
+func (s *SuperTraceProvider) GetPreimageBytes(_ context.Context, pos types.Position) ([]byte, error) {
+	timestamp, step, err := s.ComputeStep(pos)
+	if err != nil {
+		return nil, err
+	}
+	return s.preimageAt(timestamp, step)
+}
+
+// AbsolutePreStatePreimage returns the preimage of the super root at the prestate timestamp.
+func (s *SuperTraceProvider) AbsolutePreStatePreimage(_ context.Context) ([]byte, error) {
+	return s.preimageAt(s.prestateTimestamp, 0)
+}
+
+func (s *SuperTraceProvider) preimageAt(timestamp uint64, step uint64) ([]byte, error) {
+	if step == 0 {
+		root, err := s.rootProvider.SuperRootAtTimestamp(timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve super root at timestamp %v: %w", timestamp, err)
+		}
+		return superRootPreimage(root), nil
+	}
+	state, err := s.transitionState(timestamp, step)
+	if err != nil {
+		return nil, err
+	}
+	return state.Marshal(), nil
+}
+
+// transitionState computes the TransitionState reached after the specified step of the transition from timestamp.
+func (s *SuperTraceProvider) transitionState(timestamp uint64, step uint64) (*interopTypes.TransitionState, error) {
 	// Fetch the super root at the next timestamp since we are part way through the transition to it
 	prevRoot, err := s.rootProvider.SuperRootAtTimestamp(timestamp)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to retrieve super root at timestamp %v: %w", timestamp, err)
+		return nil, fmt.Errorf("failed to retrieve super root at timestamp %v: %w", timestamp, err)
 	}
 	nextTimestamp := timestamp + 1
 	nextRoot, err := s.rootProvider.SuperRootAtTimestamp(nextTimestamp)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to retrieve super root at timestamp %v: %w", nextTimestamp, err)
-	}
-	prevChainOutputs := make([]eth.ChainIDAndOutput, 0, len(prevRoot.Chains))
-	for _, chain := range prevRoot.Chains {
-		prevChainOutputs = append(prevChainOutputs, eth.ChainIDAndOutput{ChainID: chain.ChainID.ToBig().Uint64(), Output: chain.Canonical})
+		return nil, fmt.Errorf("failed to retrieve super root at timestamp %v: %w", nextTimestamp, err)
 	}
-	expectedState := interopTypes.TransitionState{
-		SuperRoot:       eth.NewSuperV1(prevRoot.Timestamp, prevChainOutputs...).Marshal(),
+	expectedState := &interopTypes.TransitionState{
+		SuperRoot:       superRootPreimage(prevRoot),
 		PendingProgress: make([]interopTypes.OptimisticBlock, 0, step),
 		Step:            step,
 	}
-	for i := uint64(0); i < min(step, uint64(len(prevChainOutputs))); i++ {
+	for i := uint64(0); i < min(step, uint64(len(prevRoot.Chains))); i++ {
 		rawOutput, err := eth.UnmarshalOutput(nextRoot.Chains[i].Pending)
 		if err != nil {
-			return common.Hash{}, fmt.Errorf("failed to unmarshal pending output %v at timestamp %v: %w", i, nextTimestamp, err)
+			return nil, fmt.Errorf("failed to unmarshal pending output %v at timestamp %v: %w", i, nextTimestamp, err)
 		}
 		output, ok := rawOutput.(*eth.OutputV0)
 		if !ok {
-			return common.Hash{}, fmt.Errorf("unsupported output version %v at timestamp %v", output.Version(), nextTimestamp)
+			return nil, fmt.Errorf("unsupported output version %v at timestamp %v", output.Version(), nextTimestamp)
 		}
 		expectedState.PendingProgress = append(expectedState.PendingProgress, interopTypes.OptimisticBlock{
 			BlockHash:  output.BlockHash,
 			OutputRoot: eth.OutputRoot(output),
 		})
 	}
-	return expectedState.Hash(), nil
+	return expectedState, nil
+}
+
+func superRootPreimage(root eth.SuperRootResponse) []byte {
+	chainOutputs := make([]eth.ChainIDAndOutput, 0, len(root.Chains))
+	for _, chain := range root.Chains {
+		chainOutputs = append(chainOutputs, eth.ChainIDAndOutput{ChainID: chain.ChainID.ToBig().Uint64(), Output: chain.Canonical})
+	}
+	return eth.NewSuperV1(root.Timestamp, chainOutputs...).Marshal()
 }
 
 func (s *SuperTraceProvider) ComputeStep(pos types.Position) (timestamp uint64, step uint64, err error) {
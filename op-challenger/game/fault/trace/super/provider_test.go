@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
@@ -148,6 +149,41 @@ func TestGet(t *testing.T) {
 	})
 }
 
+func TestGetPreimageBytes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	provider, stubSupervisor := createProvider(t)
+	superRoot1, superRoot2, output2 := addSingleChainRoots(rng, stubSupervisor)
+
+	t.Run("AbsolutePrestate", func(t *testing.T) {
+		preimage, err := provider.AbsolutePreStatePreimage(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, superRoot1.Marshal(), preimage)
+	})
+
+	t.Run("TransitionState", func(t *testing.T) {
+		pos := types.NewPosition(gameDepth, big.NewInt(0))
+		expected := &interopTypes.TransitionState{
+			SuperRoot: superRoot1.Marshal(),
+			PendingProgress: []interopTypes.OptimisticBlock{
+				{BlockHash: output2.BlockHash, OutputRoot: eth.OutputRoot(output2)},
+			},
+			Step: 1,
+		}
+		preimage, err := provider.GetPreimageBytes(context.Background(), pos)
+		require.NoError(t, err)
+		require.Equal(t, expected.Marshal(), preimage)
+		claim, err := provider.Get(context.Background(), pos)
+		require.NoError(t, err)
+		require.Equal(t, crypto.Keccak256Hash(preimage), claim)
+	})
+
+	t.Run("SuperRoot", func(t *testing.T) {
+		preimage, err := provider.GetPreimageBytes(context.Background(), types.NewPosition(gameDepth, big.NewInt(StepsPerTimestamp-1)))
+		require.NoError(t, err)
+		require.Equal(t, superRoot2.Marshal(), preimage)
+	})
+}
+
 func TestGetStepDataReturnsError(t *testing.T) {
 	provider, _ := createProvider(t)
 	_, _, _, err := provider.GetStepData(context.Background(), types.RootPosition)
@@ -201,6 +237,20 @@ func TestComputeStep(t *testing.T) {
 		require.Equal(t, uint64(0), step, "Incorrect step at trace index at root position")
 	})
 
+	t.Run("ConsolidateStepMatchesProgram", func(t *testing.T) {
+		// The program consolidates the pending blocks into the next super root when executing from the
+		// consolidation step, so that state must be followed by the super root of the next timestamp.
+		provider, _ := createProvider(t)
+		timestamp, step, err := provider.ComputeStep(types.NewPosition(gameDepth, big.NewInt(StepsPerTimestamp-2)))
+		require.NoError(t, err)
+		require.Equal(t, prestateTimestamp, timestamp)
+		require.Equal(t, uint64(interopTypes.ConsolidateStep), step)
+		timestamp, step, err = provider.ComputeStep(types.NewPosition(gameDepth, big.NewInt(StepsPerTimestamp-1)))
+		require.NoError(t, err)
+		require.Equal(t, prestateTimestamp+1, timestamp)
+		require.Zero(t, step)
+	})
+
 	t.Run("StepShouldLoopBackToZero", func(t *testing.T) {
 		provider, _ := createProvider(t)
 		prevTimestamp := prestateTimestamp
@@ -213,7 +263,7 @@ func TestComputeStep(t *testing.T) {
 			} else {
 				require.Equal(t, prevTimestamp+1, timestamp, "Incorrect timestamp at trace index %d", traceIndex)
 				require.Zero(t, step, "Incorrect step at trace index %d", traceIndex)
-				require.Equal(t, uint64(1023), prevStep, "Should only loop back to step 0 after the consolidation step")
+				require.Equal(t, uint64(interopTypes.ConsolidateStep), prevStep, "Should only loop back to step 0 after the consolidation step")
 			}
 			prevTimestamp = timestamp
 			prevStep = step
@@ -229,6 +279,31 @@ func createProvider(t *testing.T) (*SuperTraceProvider, *stubRootProvider) {
 	return NewSuperTraceProvider(logger, nil, stubSupervisor, eth.BlockID{}, gameDepth, prestateTimestamp, poststateTimestamp), stubSupervisor
 }
 
+// addSingleChainRoots adds the super roots of a single chain at the prestate timestamp and the timestamp after it.
+func addSingleChainRoots(rng *rand.Rand, stubSupervisor *stubRootProvider) (*eth.SuperV1, *eth.SuperV1, *eth.OutputV0) {
+	output1 := testutils.RandomOutputV0(rng)
+	output2 := testutils.RandomOutputV0(rng)
+	superRoot1 := eth.NewSuperV1(prestateTimestamp, eth.ChainIDAndOutput{ChainID: 1, Output: eth.OutputRoot(output1)})
+	superRoot2 := eth.NewSuperV1(prestateTimestamp+1, eth.ChainIDAndOutput{ChainID: 1, Output: eth.OutputRoot(output2)})
+	for _, root := range []struct {
+		super  *eth.SuperV1
+		output *eth.OutputV0
+	}{{superRoot1, output1}, {superRoot2, output2}} {
+		stubSupervisor.Add(eth.SuperRootResponse{
+			Timestamp: root.super.Timestamp,
+			SuperRoot: eth.SuperRoot(root.super),
+			Chains: []eth.ChainRootInfo{
+				{
+					ChainID:   eth.ChainIDFromUInt64(1),
+					Canonical: eth.OutputRoot(root.output),
+					Pending:   root.output.Marshal(),
+				},
+			},
+		})
+	}
+	return superRoot1, superRoot2, output2
+}
+
 type stubRootProvider struct {
 	rootsByTimestamp map[uint64]eth.SuperRootResponse
 }
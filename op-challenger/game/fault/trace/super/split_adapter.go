@@ -0,0 +1,53 @@
+package super
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/split"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ClaimInfo is the agreed prestate and the disputed claim that a bottom game executes the interop program between.
+type ClaimInfo struct {
+	// AgreedPrestate is the preimage of the agreed claim, either an encoded super root or TransitionState.
+	AgreedPrestate []byte
+	Claim          common.Hash
+}
+
+type ProposalTraceProviderCreator func(ctx context.Context, localContext common.Hash, depth types.Depth, claimInfo ClaimInfo) (types.TraceProvider, error)
+
+func SuperRootSplitAdapter(topProvider *SuperTraceProvider, creator ProposalTraceProviderCreator) split.ProviderCreator {
+	return func(ctx context.Context, depth types.Depth, pre types.Claim, post types.Claim) (types.TraceProvider, error) {
+		localContext := outputs.CreateLocalContext(pre, post)
+		claimInfo, err := FetchClaimInfo(ctx, topProvider, pre, post)
+		if err != nil {
+			return nil, err
+		}
+		return creator(ctx, localContext, depth, claimInfo)
+	}
+}
+
+func FetchClaimInfo(ctx context.Context, topProvider *SuperTraceProvider, pre types.Claim, post types.Claim) (ClaimInfo, error) {
+	usePrestateBlock := pre == (types.Claim{})
+	var agreedPrestate []byte
+	if usePrestateBlock {
+		prestate, err := topProvider.AbsolutePreStatePreimage(ctx)
+		if err != nil {
+			return ClaimInfo{}, fmt.Errorf("failed to retrieve absolute prestate preimage: %w", err)
+		}
+		agreedPrestate = prestate
+	} else {
+		prestate, err := topProvider.GetPreimageBytes(ctx, pre.Position)
+		if err != nil {
+			return ClaimInfo{}, fmt.Errorf("failed to retrieve agreed prestate preimage: %w", err)
+		}
+		agreedPrestate = prestate
+	}
+	return ClaimInfo{
+		AgreedPrestate: agreedPrestate,
+		Claim:          post.Value,
+	}, nil
+}
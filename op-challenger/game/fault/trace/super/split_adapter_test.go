@@ -0,0 +1,90 @@
+package super
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/split"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	interopTypes "github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var creatorError = errors.New("captured args")
+
+func TestSuperRootSplitAdapter(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	adapter, creator, stubSupervisor := setupAdapterTest(t)
+	superRoot1, _, output2 := addSingleChainRoots(rng, stubSupervisor)
+	postClaim := types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{0xbb},
+			Position: types.NewPosition(gameDepth, big.NewInt(1)),
+		},
+		ContractIndex:       7,
+		ParentContractIndex: 1,
+	}
+
+	t.Run("FromAgreedClaim", func(t *testing.T) {
+		preClaim := types.Claim{
+			ClaimData: types.ClaimData{
+				Value:    common.Hash{0xaa},
+				Position: types.NewPosition(gameDepth, big.NewInt(0)),
+			},
+			ContractIndex:       3,
+			ParentContractIndex: 2,
+		}
+		expectedPrestate := &interopTypes.TransitionState{
+			SuperRoot: superRoot1.Marshal(),
+			PendingProgress: []interopTypes.OptimisticBlock{
+				{BlockHash: output2.BlockHash, OutputRoot: eth.OutputRoot(output2)},
+			},
+			Step: 1,
+		}
+
+		_, err := adapter(context.Background(), 5, preClaim, postClaim)
+		require.ErrorIs(t, err, creatorError)
+		require.Equal(t, outputs.CreateLocalContext(preClaim, postClaim), creator.localContext)
+		require.Equal(t, types.Depth(5), creator.depth)
+		require.Equal(t, ClaimInfo{AgreedPrestate: expectedPrestate.Marshal(), Claim: postClaim.Value}, creator.claimInfo)
+	})
+
+	t.Run("FromAbsolutePrestate", func(t *testing.T) {
+		_, err := adapter(context.Background(), 5, types.Claim{}, postClaim)
+		require.ErrorIs(t, err, creatorError)
+		require.Equal(t, outputs.CreateLocalContext(types.Claim{}, postClaim), creator.localContext)
+		require.Equal(t, ClaimInfo{AgreedPrestate: superRoot1.Marshal(), Claim: postClaim.Value}, creator.claimInfo)
+	})
+}
+
+func TestSuperRootSplitAdapter_MissingSuperRoot(t *testing.T) {
+	adapter, creator, _ := setupAdapterTest(t)
+	_, err := adapter(context.Background(), 5, types.Claim{}, types.Claim{})
+	require.ErrorContains(t, err, "failed to retrieve absolute prestate preimage")
+	require.Zero(t, creator.localContext, "should not create provider")
+}
+
+func setupAdapterTest(t *testing.T) (split.ProviderCreator, *capturingCreator, *stubRootProvider) {
+	provider, stubSupervisor := createProvider(t)
+	creator := &capturingCreator{}
+	return SuperRootSplitAdapter(provider, creator.Create), creator, stubSupervisor
+}
+
+type capturingCreator struct {
+	localContext common.Hash
+	depth        types.Depth
+	claimInfo    ClaimInfo
+}
+
+func (c *capturingCreator) Create(_ context.Context, localContext common.Hash, depth types.Depth, claimInfo ClaimInfo) (types.TraceProvider, error) {
+	c.localContext = localContext
+	c.depth = depth
+	c.claimInfo = claimInfo
+	return nil, creatorError
+}
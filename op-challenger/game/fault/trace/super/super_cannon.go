@@ -0,0 +1,65 @@
+package super
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/split"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func NewSuperCannonTraceAccessor(
+	logger log.Logger,
+	m metrics.Metricer,
+	cfg vm.Config,
+	serverExecutor vm.OracleServerExecutor,
+	prestateProvider types.PrestateProvider,
+	rootProvider RootProvider,
+	cannonPrestate string,
+	dir string,
+	l1Head eth.BlockID,
+	splitDepth types.Depth,
+	prestateTimestamp uint64,
+	poststateTimestamp uint64,
+) (*trace.Accessor, error) {
+	superProvider := NewSuperTraceProvider(logger, prestateProvider, rootProvider, l1Head, splitDepth, prestateTimestamp, poststateTimestamp)
+	cannonCreator := func(ctx context.Context, localContext common.Hash, depth types.Depth, claimInfo ClaimInfo) (types.TraceProvider, error) {
+		agreedRoot := crypto.Keccak256Hash(claimInfo.AgreedPrestate)
+		logger := logger.New("pre", agreedRoot, "post", claimInfo.Claim, "localContext", localContext)
+		// Proofs are cached on disk per pair of claims, so they are reused across restarts.
+		subdir := filepath.Join(dir, localContext.Hex())
+		localInputs := utils.LocalGameInputs{
+			L1Head: l1Head.Hash,
+			// The interop program derives all chains from the agreed prestate and doesn't use the L2 head,
+			// but op-program requires one to be set.
+			L2Head:       agreedRoot,
+			L2OutputRoot: agreedRoot,
+			L2Claim:      claimInfo.Claim,
+			// The interop program uses the claimed timestamp of the game in place of the block number.
+			L2BlockNumber:  new(big.Int).SetUint64(poststateTimestamp),
+			AgreedPreState: claimInfo.AgreedPrestate,
+		}
+		// The interop program marks a checkpoint once it parsed the agreed state, before reading the claim,
+		// so executions for other claims of the same step don't start from the absolute prestate.
+		checkpoint := boot.InteropCheckpoint(l1Head.Hash, agreedRoot, poststateTimestamp)
+		provider := cannon.NewTraceProvider(logger, m.ToTypedVmMetrics(cfg.VmType.String()), cfg, serverExecutor, prestateProvider, cannonPrestate, localInputs, subdir, depth,
+			vm.WithCheckpoint(filepath.Join(dir, vm.CheckpointsDir), checkpoint))
+		return provider, nil
+	}
+
+	cache := NewProviderCache(m, "super_cannon_provider", cannonCreator)
+	selector := split.NewSplitProviderSelector(superProvider, splitDepth, SuperRootSplitAdapter(superProvider, cache.GetOrCreate))
+	return trace.NewAccessor(selector), nil
+}
@@ -16,6 +16,8 @@ type LocalGameInputs struct {
 	L2OutputRoot  common.Hash
 	L2Claim       common.Hash
 	L2BlockNumber *big.Int
+	// AgreedPreState is the preimage of the agreed prestate claim for interop games. Empty for non-interop games.
+	AgreedPreState []byte
 }
 
 type L2HeaderSource interface {
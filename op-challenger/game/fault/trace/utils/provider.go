@@ -9,6 +9,7 @@ import (
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -52,15 +53,17 @@ func ReadLastStep(dir string) (uint64, error) {
 }
 
 // WriteLastStep writes the last step and proof to disk as a persistent cache.
+// Both files are written atomically, and the proof is written first, so the cache never refers to a missing or
+// partially written proof.
 func WriteLastStep(dir string, proof *ProofData, step uint64) error {
+	if err := jsonutil.WriteJSON(proof, ioutil.ToAtomicFile(filepath.Join(dir, ProofsDir, fmt.Sprintf("%d.json.gz", step)), 0o644)); err != nil {
+		return fmt.Errorf("failed to write proof: %w", err)
+	}
 	state := diskStateCacheObj{Step: step}
 	lastStepFile := filepath.Join(dir, diskStateCache)
-	if err := ioutil.WriteCompressedJson(lastStepFile, state); err != nil {
+	if err := jsonutil.WriteJSON(state, ioutil.ToAtomicFile(lastStepFile, 0o644)); err != nil {
 		return fmt.Errorf("failed to write last step to %v: %w", lastStepFile, err)
 	}
-	if err := ioutil.WriteCompressedJson(filepath.Join(dir, ProofsDir, fmt.Sprintf("%d.json.gz", step)), proof); err != nil {
-		return fmt.Errorf("failed to write proof: %w", err)
-	}
 	return nil
 }
 
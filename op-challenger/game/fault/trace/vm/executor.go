@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 
@@ -110,10 +111,26 @@ type Executor struct {
 	inputs           utils.LocalGameInputs
 	selectSnapshot   SnapshotSelect
 	cmdExecutor      CmdExecutor
+
+	checkpointDir string
+	checkpoint    common.Hash
+}
+
+type ExecutorOption func(e *Executor)
+
+// WithCheckpoint snapshots the state when the program marks a checkpoint, such as the interop program once it
+// parsed the agreed state of the step. The snapshots are stored in dir, per checkpoint commitment, so dir can be
+// shared with executors for other local inputs. Executions without an earlier snapshot of their own start from
+// the snapshot of the expected checkpoint, if one exists.
+func WithCheckpoint(dir string, checkpoint common.Hash) ExecutorOption {
+	return func(e *Executor) {
+		e.checkpointDir = dir
+		e.checkpoint = checkpoint
+	}
 }
 
-func NewExecutor(logger log.Logger, m Metricer, cfg Config, oracleServer OracleServerExecutor, prestate string, inputs utils.LocalGameInputs) *Executor {
-	return &Executor{
+func NewExecutor(logger log.Logger, m Metricer, cfg Config, oracleServer OracleServerExecutor, prestate string, inputs utils.LocalGameInputs, opts ...ExecutorOption) *Executor {
+	e := &Executor{
 		cfg:              cfg,
 		oracleServer:     oracleServer,
 		logger:           logger,
@@ -123,6 +140,10 @@ func NewExecutor(logger log.Logger, m Metricer, cfg Config, oracleServer OracleS
 		selectSnapshot:   FindStartingSnapshot,
 		cmdExecutor:      RunCmd,
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
 }
 
 // GenerateProof executes vm to generate a proof at the specified trace index.
@@ -139,6 +160,12 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 	if err != nil {
 		return fmt.Errorf("find starting snapshot: %w", err)
 	}
+	if start == e.absolutePreState && e.checkpointDir != "" {
+		start, err = e.selectSnapshot(e.logger, filepath.Join(e.checkpointDir, e.checkpoint.Hex()), e.absolutePreState, begin, e.cfg.BinarySnapshots)
+		if err != nil {
+			return fmt.Errorf("find checkpoint snapshot: %w", err)
+		}
+	}
 	proofDir := filepath.Join(dir, utils.ProofsDir)
 	dataDir := PreimageDir(dir)
 	lastGeneratedState := FinalStatePath(dir, e.cfg.BinarySnapshots)
@@ -152,10 +179,10 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 		"--proof-fmt", filepath.Join(proofDir, "%d.json.gz"),
 		"--snapshot-at", "%" + strconv.FormatUint(uint64(e.cfg.SnapshotFreq), 10),
 	}
-	if e.cfg.BinarySnapshots {
-		args = append(args, "--snapshot-fmt", filepath.Join(snapshotDir, "%d.bin.gz"))
-	} else {
-		args = append(args, "--snapshot-fmt", filepath.Join(snapshotDir, "%d.json.gz"))
+	args = append(args, "--snapshot-fmt", filepath.Join(snapshotDir, snapshotName(e.cfg.BinarySnapshots)))
+	if e.checkpointDir != "" {
+		// Snapshots at checkpoints are named by their step, so executions start from them like from any other snapshot.
+		args = append(args, "--snapshot-at-step", filepath.Join(e.checkpointDir, "%s", snapshotName(e.cfg.BinarySnapshots)))
 	}
 	if end < math.MaxUint64 {
 		args = append(args, "--stop-at", "="+strconv.FormatUint(end+1, 10))
@@ -206,6 +233,14 @@ func (e *Executor) DoGenerateProof(ctx context.Context, dir string, begin uint64
 	return err
 }
 
+// snapshotName is the format of snapshot file names, with the step of the snapshot.
+func snapshotName(binarySnapshots bool) string {
+	if binarySnapshots {
+		return "%d.bin.gz"
+	}
+	return "%d.json.gz"
+}
+
 type debugInfo struct {
 	MemoryUsed                   hexutil.Uint64 `json:"memory_used"`
 	Steps                        uint64         `json:"total_steps"`
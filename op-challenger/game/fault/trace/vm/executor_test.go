@@ -57,9 +57,15 @@ func TestGenerateProof(t *testing.T) {
 		IdleStepCountThread0:         1314,
 	}
 
-	captureExec := func(t *testing.T, cfg Config, proofAt uint64, m Metricer) (string, string, map[string]string) {
-		executor := NewExecutor(testlog.Logger(t, log.LevelInfo), m, cfg, NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo)), prestate, inputs)
+	captureExecWithOpts := func(t *testing.T, cfg Config, proofAt uint64, m Metricer, snapshots map[string]string, opts ...ExecutorOption) (string, string, map[string]string) {
+		executor := NewExecutor(testlog.Logger(t, log.LevelInfo), m, cfg, NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo)), prestate, inputs, opts...)
 		executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64, binary bool) (string, error) {
+			if snapshots != nil {
+				if snapshot, ok := snapshots[dir]; ok {
+					return snapshot, nil
+				}
+				return absolutePreState, nil
+			}
 			return input, nil
 		}
 		var binary string
@@ -88,6 +94,9 @@ func TestGenerateProof(t *testing.T) {
 		require.NoError(t, err)
 		return binary, subcommand, args
 	}
+	captureExec := func(t *testing.T, cfg Config, proofAt uint64, m Metricer) (string, string, map[string]string) {
+		return captureExecWithOpts(t, cfg, proofAt, m, nil)
+	}
 
 	t.Run("Network", func(t *testing.T) {
 		m := newMetrics()
@@ -122,6 +131,7 @@ func TestGenerateProof(t *testing.T) {
 		require.Equal(t, cfg.Network, args["--network"])
 		require.NotContains(t, args, "--rollup.config")
 		require.NotContains(t, args, "--l2.genesis")
+		require.NotContains(t, args, "--snapshot-at-step")
 
 		// Local game inputs
 		require.Equal(t, inputs.L1Head.Hex(), args["--l1.head"])
@@ -177,6 +187,40 @@ func TestGenerateProof(t *testing.T) {
 		require.Equal(t, filepath.Join(dir, SnapsDir, "%d.json.gz"), args["--snapshot-fmt"])
 		validateMetrics(t, m, info, cfg)
 	})
+
+	t.Run("Checkpoint", func(t *testing.T) {
+		checkpointDir := filepath.Join(tempDir, CheckpointsDir)
+		checkpoint := common.Hash{0xcc}
+		checkpointSnapshots := filepath.Join(checkpointDir, checkpoint.Hex())
+		cfg.Network = "mainnet"
+		cfg.BinarySnapshots = true
+
+		t.Run("StartFromCheckpoint", func(t *testing.T) {
+			m := newMetrics()
+			snapshots := map[string]string{checkpointSnapshots: "checkpoint.bin.gz"}
+			_, _, args := captureExecWithOpts(t, cfg, 100, m, snapshots, WithCheckpoint(checkpointDir, checkpoint))
+			require.Equal(t, filepath.Join(checkpointDir, "%s", "%d.bin.gz"), args["--snapshot-at-step"])
+			require.Equal(t, "%500", args["--snapshot-at"])
+			require.Equal(t, "checkpoint.bin.gz", args["--input"])
+			validateMetrics(t, m, info, cfg)
+		})
+
+		t.Run("PreferOwnSnapshot", func(t *testing.T) {
+			m := newMetrics()
+			snapshots := map[string]string{
+				filepath.Join(dir, SnapsDir): "own.bin.gz",
+				checkpointSnapshots:          "checkpoint.bin.gz",
+			}
+			_, _, args := captureExecWithOpts(t, cfg, 100, m, snapshots, WithCheckpoint(checkpointDir, checkpoint))
+			require.Equal(t, "own.bin.gz", args["--input"])
+		})
+
+		t.Run("NoCheckpointSnapshot", func(t *testing.T) {
+			m := newMetrics()
+			_, _, args := captureExecWithOpts(t, cfg, 100, m, map[string]string{}, WithCheckpoint(checkpointDir, checkpoint))
+			require.Equal(t, prestate, args["--input"])
+		})
+	})
 }
 
 func validateMetrics(t require.TestingT, m *capturingVmMetrics, expected *mipsevm.DebugInfo, cfg Config) {
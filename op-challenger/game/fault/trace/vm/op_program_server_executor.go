@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -26,10 +27,15 @@ func (s *OpProgramServerExecutor) OracleCommand(cfg Config, dataDir string, inpu
 		"--datadir", dataDir,
 		"--l1.head", inputs.L1Head.Hex(),
 		"--l2.head", inputs.L2Head.Hex(),
-		"--l2.outputroot", inputs.L2OutputRoot.Hex(),
 		"--l2.claim", inputs.L2Claim.Hex(),
 		"--l2.blocknumber", inputs.L2BlockNumber.Text(10),
 	}
+	if len(inputs.AgreedPreState) > 0 {
+		// The agreed output root is derived from the agreed prestate by op-program and must not be set with it.
+		args = append(args, "--l2.agreed-prestate", hexutil.Encode(inputs.AgreedPreState))
+	} else {
+		args = append(args, "--l2.outputroot", inputs.L2OutputRoot.Hex())
+	}
 	if cfg.Network != "" {
 		args = append(args, "--network", cfg.Network)
 	}
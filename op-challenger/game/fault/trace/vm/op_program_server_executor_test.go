@@ -110,6 +110,25 @@ func TestOpProgramFillHostCommand(t *testing.T) {
 		require.Equal(t, "genesis.json", pairs["--l2.genesis"])
 	})
 
+	t.Run("WithAgreedPrestate", func(t *testing.T) {
+		cfg := Config{Server: "./bin/mockserver"}
+		inputs := utils.LocalGameInputs{
+			L1Head:         common.Hash{0x11},
+			L2Head:         common.Hash{0x22},
+			L2OutputRoot:   common.Hash{0x33},
+			L2Claim:        common.Hash{0x44},
+			L2BlockNumber:  big.NewInt(3333),
+			AgreedPreState: []byte{0x01, 0x02, 0x03},
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		args, err := executor.OracleCommand(cfg, dir, inputs)
+		require.NoError(t, err)
+		pairs := toPairs(args)
+		require.Equal(t, "0x010203", pairs["--l2.agreed-prestate"])
+		require.NotContains(t, pairs, "--l2.outputroot")
+		require.Equal(t, inputs.L2Claim.Hex(), pairs["--l2.claim"])
+	})
+
 	logTests := []struct {
 		level slog.Level
 		arg   string
@@ -19,6 +19,7 @@ type CmdExecutor func(ctx context.Context, l log.Logger, binary string, args ...
 
 const (
 	SnapsDir         = "snapshots"
+	CheckpointsDir   = "checkpoints"
 	PreimagesDir     = "preimages"
 	finalStateJson   = "final.json.gz"
 	finalStateBinary = "final.bin.gz"
@@ -20,6 +20,9 @@ type Action struct {
 	// Moves and Steps
 	ParentClaim Claim
 	IsAttack    bool
+	// CountersFreeloader is true if the parent claim is a freeloader,
+	// that would take the bond share of an honest claim if left uncountered.
+	CountersFreeloader bool
 
 	// Moves
 	Value common.Hash
@@ -0,0 +1,35 @@
+package history
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+type API struct {
+	db *DB
+}
+
+func NewAPI(db *DB) *API {
+	return &API{db: db}
+}
+
+func GetAPI(api *API) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "challenger",
+		Service:   api,
+	}
+}
+
+// ActionHistory returns the actions the challenger performed in the game.
+func (a *API) ActionHistory(_ context.Context, game common.Address) ([]Action, error) {
+	actions, err := a.db.Actions(game)
+	if err != nil {
+		return nil, err
+	}
+	if actions == nil {
+		actions = []Action{}
+	}
+	return actions, nil
+}
@@ -0,0 +1,276 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidEntry = errors.New("invalid db entry")
+)
+
+const (
+	// Keys are prefixed with a constant byte to allow us to differentiate different "columns" within the data
+	keyPrefixKnownClaims byte = 0
+	keyPrefixActions     byte = 1
+)
+
+type gameKey struct {
+	prefix byte
+}
+
+func (c gameKey) Of(game common.Address) []byte {
+	key := make([]byte, 0, 1+common.AddressLength)
+	key = append(key, c.prefix)
+	key = append(key, game.Bytes()...)
+	return key
+}
+
+// At returns the key of the entry with the given sequence number within the game.
+func (c gameKey) At(game common.Address, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(c.Of(game), seq)
+}
+
+func (c gameKey) IterRange(game common.Address) *pebble.IterOptions {
+	return &pebble.IterOptions{
+		LowerBound: c.Of(game),
+		UpperBound: append(c.At(game, math.MaxUint64), 0),
+	}
+}
+
+var (
+	knownClaimsKey = gameKey{prefix: keyPrefixKnownClaims}
+	actionsKey     = gameKey{prefix: keyPrefixActions}
+)
+
+type Status string
+
+const (
+	// StatusPending is an action of which the transaction was sent, but no receipt was received yet.
+	StatusPending Status = "pending"
+	// StatusSucceeded is an action of which the transaction was included successfully.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed is an action that could not be performed. It is retried.
+	StatusFailed Status = "failed"
+	// StatusDeferred is an action that was not sent yet, e.g. while waiting for a preimage to be available.
+	StatusDeferred Status = "deferred"
+)
+
+// Action is an action performed by the challenger in a game.
+type Action struct {
+	Type        string      `json:"type"`
+	ParentIndex uint64      `json:"parentIndex"`
+	IsAttack    bool        `json:"isAttack"`
+	Value       common.Hash `json:"value"`
+
+	Status Status      `json:"status"`
+	TxHash common.Hash `json:"txHash"`
+	Error  string      `json:"error,omitempty"`
+	// Timestamp is the time the status of the action was last updated, in seconds since the unix epoch.
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// SameAs returns true if both actions perform the same move in the game, regardless of their status.
+func (a Action) SameAs(b Action) bool {
+	return a.Type == b.Type && a.ParentIndex == b.ParentIndex && a.IsAttack == b.IsAttack && a.Value == b.Value
+}
+
+// KnownClaims identifies the set of claims in a game which require no further actions.
+type KnownClaims struct {
+	Count uint64
+	Hash  common.Hash
+}
+
+func (k KnownClaims) encode() []byte {
+	val := make([]byte, 0, 8+common.HashLength)
+	val = binary.BigEndian.AppendUint64(val, k.Count)
+	return append(val, k.Hash.Bytes()...)
+}
+
+func decodeKnownClaims(val []byte) (KnownClaims, error) {
+	if len(val) != 8+common.HashLength {
+		return KnownClaims{}, ErrInvalidEntry
+	}
+	return KnownClaims{
+		Count: binary.BigEndian.Uint64(val[:8]),
+		Hash:  common.BytesToHash(val[8:]),
+	}, nil
+}
+
+// DB persists the state of the games the challenger plays, so a restart does not repeat work
+// that was already done: the claims that were already responded to, and the actions performed.
+type DB struct {
+	// m ensures all read iterators are closed before closing the database by preventing concurrent read and write
+	// operations (with close considered a write operation).
+	m   sync.RWMutex
+	log log.Logger
+	db  *pebble.DB
+
+	writeOpts *pebble.WriteOptions
+
+	closed bool
+}
+
+func NewDB(logger log.Logger, path string) (*DB, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &DB{
+		log:       logger,
+		db:        db,
+		writeOpts: &pebble.WriteOptions{Sync: true},
+	}, nil
+}
+
+// Game returns the history of a single game.
+func (d *DB) Game(game common.Address) *GameHistory {
+	return &GameHistory{db: d, game: game}
+}
+
+func (d *DB) KnownClaims(game common.Address) (KnownClaims, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	val, closer, err := d.db.Get(knownClaimsKey.Of(game))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return KnownClaims{}, ErrNotFound
+	} else if err != nil {
+		return KnownClaims{}, fmt.Errorf("failed to read known claims: %w", err)
+	}
+	defer closer.Close()
+	return decodeKnownClaims(val)
+}
+
+func (d *DB) SetKnownClaims(game common.Address, claims KnownClaims) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if err := d.db.Set(knownClaimsKey.Of(game), claims.encode(), d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record known claims: %w", err)
+	}
+	return nil
+}
+
+// Actions returns the actions performed in the game, in the order they were first performed.
+func (d *DB) Actions(game common.Address) ([]Action, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	actions, _, err := d.readActions(game)
+	return actions, err
+}
+
+func (d *DB) readActions(game common.Address) ([]Action, [][]byte, error) {
+	iter, err := d.db.NewIter(actionsKey.IterRange(game))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+	var actions []Action
+	var keys [][]byte
+	for valid := iter.First(); valid; valid = iter.Next() {
+		val, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read action: %w", err)
+		}
+		var action Action
+		if err := json.Unmarshal(val, &action); err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrInvalidEntry, err)
+		}
+		actions = append(actions, action)
+		keys = append(keys, slices.Clone(iter.Key()))
+	}
+	return actions, keys, nil
+}
+
+// RecordAction stores the action, replacing the previous record of the same action, if any.
+func (d *DB) RecordAction(game common.Address, action Action) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	actions, keys, err := d.readActions(game)
+	if err != nil {
+		return err
+	}
+	key := actionsKey.At(game, uint64(len(actions)))
+	if i := slices.IndexFunc(actions, action.SameAs); i >= 0 {
+		key = keys[i]
+	}
+	val, err := json.Marshal(action)
+	if err != nil {
+		return fmt.Errorf("failed to encode action: %w", err)
+	}
+	if err := d.db.Set(key, val, d.writeOpts); err != nil {
+		return fmt.Errorf("failed to record action: %w", err)
+	}
+	return nil
+}
+
+// RemoveAllExcept deletes the history of all games, except the given games.
+func (d *DB) RemoveAllExcept(keep []common.Address) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	iter, err := d.db.NewIter(&pebble.IterOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+	batch := d.db.NewBatch()
+	defer batch.Close()
+	for valid := iter.First(); valid; valid = iter.Next() {
+		key := iter.Key()
+		if len(key) < 1+common.AddressLength {
+			continue
+		}
+		if slices.Contains(keep, common.BytesToAddress(key[1:1+common.AddressLength])) {
+			continue
+		}
+		if err := batch.Delete(key, d.writeOpts); err != nil {
+			return fmt.Errorf("failed to delete entry: %w", err)
+		}
+	}
+	if err := batch.Commit(d.writeOpts); err != nil {
+		return fmt.Errorf("failed to commit deletions: %w", err)
+	}
+	return nil
+}
+
+func (d *DB) Close() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.closed {
+		// Already closed
+		return nil
+	}
+	d.closed = true
+	return d.db.Close()
+}
+
+// GameHistory is the history of a single game.
+type GameHistory struct {
+	db   *DB
+	game common.Address
+}
+
+func (g *GameHistory) KnownClaims() (KnownClaims, error) {
+	return g.db.KnownClaims(g.game)
+}
+
+func (g *GameHistory) SetKnownClaims(claims KnownClaims) error {
+	return g.db.SetKnownClaims(g.game, claims)
+}
+
+func (g *GameHistory) Actions() ([]Action, error) {
+	return g.db.Actions(g.game)
+}
+
+func (g *GameHistory) RecordAction(action Action) error {
+	return g.db.RecordAction(g.game, action)
+}
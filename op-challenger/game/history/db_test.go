@@ -0,0 +1,86 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func newTestDB(t *testing.T) *DB {
+	db, err := NewDB(testlog.Logger(t, log.LevelInfo), t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestKnownClaims(t *testing.T) {
+	db := newTestDB(t)
+	game := db.Game(common.Address{0xaa})
+	_, err := game.KnownClaims()
+	require.ErrorIs(t, err, ErrNotFound)
+
+	claims := KnownClaims{Count: 3, Hash: common.Hash{0x01}}
+	require.NoError(t, game.SetKnownClaims(claims))
+	actual, err := game.KnownClaims()
+	require.NoError(t, err)
+	require.Equal(t, claims, actual)
+
+	_, err = db.Game(common.Address{0xbb}).KnownClaims()
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRecordAction(t *testing.T) {
+	db := newTestDB(t)
+	game := db.Game(common.Address{0xaa})
+	actions, err := game.Actions()
+	require.NoError(t, err)
+	require.Empty(t, actions)
+
+	first := Action{Type: "move", ParentIndex: 0, IsAttack: true, Value: common.Hash{0x01}, Status: StatusPending, Timestamp: 10}
+	second := Action{Type: "move", ParentIndex: 1, Value: common.Hash{0x02}, Status: StatusPending, Timestamp: 11}
+	require.NoError(t, game.RecordAction(first))
+	require.NoError(t, game.RecordAction(second))
+
+	// Updating the status of an action replaces the existing record
+	first.Status = StatusSucceeded
+	first.TxHash = common.Hash{0xee}
+	first.Timestamp = 12
+	require.NoError(t, game.RecordAction(first))
+
+	actions, err = game.Actions()
+	require.NoError(t, err)
+	require.Equal(t, []Action{first, second}, actions)
+
+	actions, err = db.Game(common.Address{0xbb}).Actions()
+	require.NoError(t, err)
+	require.Empty(t, actions)
+}
+
+func TestRemoveAllExcept(t *testing.T) {
+	db := newTestDB(t)
+	keep := db.Game(common.Address{0xaa})
+	remove := db.Game(common.Address{0xbb})
+	action := Action{Type: "step", ParentIndex: 4, Status: StatusFailed, Error: "boom"}
+	for _, game := range []*GameHistory{keep, remove} {
+		require.NoError(t, game.SetKnownClaims(KnownClaims{Count: 1}))
+		require.NoError(t, game.RecordAction(action))
+	}
+
+	require.NoError(t, db.RemoveAllExcept([]common.Address{{0xaa}}))
+
+	_, err := keep.KnownClaims()
+	require.NoError(t, err)
+	actions, err := keep.Actions()
+	require.NoError(t, err)
+	require.Equal(t, []Action{action}, actions)
+
+	_, err = remove.KnownClaims()
+	require.ErrorIs(t, err, ErrNotFound)
+	actions, err = remove.Actions()
+	require.NoError(t, err)
+	require.Empty(t, actions)
+}
@@ -41,6 +41,10 @@ type claimer interface {
 	Schedule(blockNumber uint64, games []types.GameMetadata) error
 }
 
+type gamePrioritizer interface {
+	Prioritize(ctx context.Context, blockHash common.Hash, blockNumber uint64, games []types.GameMetadata) ([]types.GameMetadata, error)
+}
+
 type gameMonitor struct {
 	logger       log.Logger
 	clock        RWClock
@@ -49,6 +53,7 @@ type gameMonitor struct {
 	preimages    preimageScheduler
 	gameWindow   time.Duration
 	claimer      claimer
+	prioritizer  gamePrioritizer
 	allowedGames []common.Address
 	l1HeadsSub   ethereum.Subscription
 	l1Source     *headSource
@@ -75,6 +80,7 @@ func newGameMonitor(
 	preimages preimageScheduler,
 	gameWindow time.Duration,
 	claimer claimer,
+	prioritizer gamePrioritizer,
 	allowedGames []common.Address,
 	l1Source MinimalSubscriber,
 ) *gameMonitor {
@@ -86,6 +92,7 @@ func newGameMonitor(
 		source:       source,
 		gameWindow:   gameWindow,
 		claimer:      claimer,
+		prioritizer:  prioritizer,
 		allowedGames: allowedGames,
 		l1Source:     &headSource{inner: l1Source},
 	}
@@ -117,6 +124,15 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash,
 		}
 		gamesToPlay = append(gamesToPlay, game)
 	}
+	if m.prioritizer != nil {
+		prioritized, err := m.prioritizer.Prioritize(ctx, blockHash, blockNumber, gamesToPlay)
+		if err != nil {
+			// Progressing the games in their default order is better than not progressing them at all.
+			m.logger.Warn("Failed to prioritize games of pending withdrawals", "err", err)
+		} else {
+			gamesToPlay = prioritized
+		}
+	}
 	if err := m.claimer.Schedule(blockNumber, gamesToPlay); err != nil {
 		return fmt.Errorf("failed to schedule bond claims: %w", err)
 	}
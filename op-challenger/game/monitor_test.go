@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -142,6 +143,39 @@ func TestMonitorOnlyScheduleSpecifiedGame(t *testing.T) {
 	require.Equal(t, 1, stubClaimer.scheduledGames)
 }
 
+func TestMonitorPrioritizeGames(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	games := []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+
+	t.Run("ScheduleInPriorityOrder", func(t *testing.T) {
+		monitor, source, sched, _, _, _ := setupMonitorTest(t, []common.Address{})
+		source.games = games
+		monitor.prioritizer = &stubPrioritizer{prioritized: []types.GameMetadata{games[1], games[0]}}
+
+		require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}, 0))
+		require.Equal(t, [][]common.Address{{addr2, addr1}}, sched.Scheduled())
+	})
+
+	t.Run("KeepOrderWhenPrioritizationFails", func(t *testing.T) {
+		monitor, source, sched, _, _, _ := setupMonitorTest(t, []common.Address{})
+		source.games = games
+		monitor.prioritizer = &stubPrioritizer{err: errors.New("boom")}
+
+		require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}, 0))
+		require.Equal(t, [][]common.Address{{addr1, addr2}}, sched.Scheduled())
+	})
+}
+
+type stubPrioritizer struct {
+	prioritized []types.GameMetadata
+	err         error
+}
+
+func (s *stubPrioritizer) Prioritize(_ context.Context, _ common.Hash, _ uint64, _ []types.GameMetadata) ([]types.GameMetadata, error) {
+	return s.prioritized, s.err
+}
+
 func newFDG(proxy common.Address, timestamp uint64) types.GameMetadata {
 	return types.GameMetadata{
 		Proxy:     proxy,
@@ -167,6 +201,7 @@ func setupMonitorTest(
 		preimages,
 		time.Duration(0),
 		stubClaimer,
+		nil,
 		allowedGames,
 		mockHeadSource,
 	)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -45,6 +46,7 @@ type coordinator struct {
 	createPlayer PlayerCreator
 	states       map[common.Address]*gameState
 	disk         DiskManager
+	notifier     alerts.Notifier
 
 	allowInvalidPrestate bool
 
@@ -135,6 +137,15 @@ func (c *coordinator) createJob(ctx context.Context, game types.GameMetadata, bl
 			return nil, fmt.Errorf("failed to create game player: %w", err)
 		}
 		if err := player.ValidatePrestate(ctx); err != nil {
+			if errors.Is(err, types.ErrInvalidPrestate) {
+				c.notifier.Notify(alerts.Alert{
+					Kind:     alerts.KindMissingPrestate,
+					Severity: alerts.SeverityCritical,
+					Game:     game.Proxy,
+					Summary:  fmt.Sprintf("Invalid prestate for game %v", game.Proxy),
+					Details:  map[string]any{"gameType": game.GameType, "err": err.Error()},
+				})
+			}
 			if !c.allowInvalidPrestate || !errors.Is(err, types.ErrInvalidPrestate) {
 				return nil, fmt.Errorf("failed to validate prestate: %w", err)
 			}
@@ -192,7 +203,7 @@ func (c *coordinator) deleteResolvedGameFiles() {
 	}
 }
 
-func newCoordinator(logger log.Logger, m CoordinatorMetricer, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager, allowInvalidPrestate bool) *coordinator {
+func newCoordinator(logger log.Logger, m CoordinatorMetricer, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager, notifier alerts.Notifier, allowInvalidPrestate bool) *coordinator {
 	return &coordinator{
 		logger:               logger,
 		m:                    m,
@@ -200,6 +211,7 @@ func newCoordinator(logger log.Logger, m CoordinatorMetricer, jobQueue chan<- jo
 		resultQueue:          resultQueue,
 		createPlayer:         createPlayer,
 		disk:                 disk,
+		notifier:             notifier,
 		states:               make(map[common.Address]*gameState),
 		allowInvalidPrestate: allowInvalidPrestate,
 	}
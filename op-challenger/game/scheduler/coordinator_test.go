@@ -6,6 +6,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -64,12 +65,18 @@ func TestExitWhenContextDoneWhileSchedulingJob(t *testing.T) {
 
 func TestSchedule_PrestateValidationErrors(t *testing.T) {
 	c, _, _, games, _, _ := setupCoordinatorTest(t, 10)
+	notifier := &stubNotifier{}
+	c.notifier = notifier
 	games.PrestateErr = types.ErrInvalidPrestate
 	gameAddr1 := common.Address{0xaa}
 	ctx := context.Background()
 
 	err := c.schedule(ctx, asGames(gameAddr1), 0)
 	require.Error(t, err)
+	require.Len(t, notifier.alerts, 1)
+	require.Equal(t, alerts.KindMissingPrestate, notifier.alerts[0].Kind)
+	require.Equal(t, alerts.SeverityCritical, notifier.alerts[0].Severity)
+	require.Equal(t, gameAddr1, notifier.alerts[0].Game)
 }
 
 func TestSchedule_SkipPrestateValidationErrors(t *testing.T) {
@@ -90,12 +97,15 @@ func TestSchedule_SkipPrestateValidationErrors(t *testing.T) {
 func TestSchedule_PrestateValidationFailure(t *testing.T) {
 	c, _, _, games, _, _ := setupCoordinatorTest(t, 10)
 	c.allowInvalidPrestate = true
+	notifier := &stubNotifier{}
+	c.notifier = notifier
 	games.PrestateErr = fmt.Errorf("failed to fetch prestate")
 	gameAddr1 := common.Address{0xaa}
 	ctx := context.Background()
 
 	err := c.schedule(ctx, asGames(gameAddr1), 0)
 	require.ErrorIs(t, err, games.PrestateErr)
+	require.Empty(t, notifier.alerts)
 }
 
 func TestScheduleGameAgainAfterCompletion(t *testing.T) {
@@ -401,10 +411,18 @@ func setupCoordinatorTest(t *testing.T, bufferSize int) (*coordinator, <-chan jo
 		created: make(map[common.Address]*test.StubGamePlayer),
 	}
 	disk := &stubDiskManager{gameDirExists: make(map[common.Address]bool)}
-	c := newCoordinator(logger, &stubSchedulerMetrics{}, workQueue, resultQueue, games.CreateGame, disk, false)
+	c := newCoordinator(logger, &stubSchedulerMetrics{}, workQueue, resultQueue, games.CreateGame, disk, alerts.NoopNotifier{}, false)
 	return c, workQueue, resultQueue, games, disk, logs
 }
 
+type stubNotifier struct {
+	alerts []alerts.Alert
+}
+
+func (s *stubNotifier) Notify(alert alerts.Alert) {
+	s.alerts = append(s.alerts, alert)
+}
+
 type createdGames struct {
 	t               *testing.T
 	createCompleted common.Address
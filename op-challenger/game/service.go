@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/keccak"
@@ -16,9 +17,12 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/alerts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/defensive"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/claims"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/history"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/registry"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
@@ -29,6 +33,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
@@ -46,6 +51,9 @@ type Service struct {
 	txMgr    *txmgr.SimpleTxManager
 	txSender *sender.TxSender
 
+	history   *history.DB
+	rpcServer *oprpc.Server
+
 	systemClock clock.Clock
 	l1Clock     *clock.SimpleClock
 
@@ -65,17 +73,35 @@ type Service struct {
 
 	balanceMetricer io.Closer
 
+	notifier       alerts.Notifier
+	notifierCloser io.Closer
+
 	stopped atomic.Bool
 }
 
+// historyDir is the directory within the datadir that the game history is stored in.
+const historyDir = "history"
+
+type ServiceOption func(s *Service)
+
+// WithSystemClock sets the clock that drives the game players and schedulers instead of the system clock.
+func WithSystemClock(cl clock.Clock) ServiceOption {
+	return func(s *Service) {
+		s.systemClock = cl
+	}
+}
+
 // NewService creates a new Service.
-func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, m metrics.Metricer) (*Service, error) {
+func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, m metrics.Metricer, opts ...ServiceOption) (*Service, error) {
 	s := &Service{
 		systemClock: clock.SystemClock,
 		l1Clock:     clock.NewSimpleClock(),
 		logger:      logger,
 		metrics:     m,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 
 	if err := s.initFromConfig(ctx, cfg); err != nil {
 		// upon initialization error we can try to close any of the service components that may have started already.
@@ -105,6 +131,13 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	if err := s.initMetricsServer(&cfg.MetricsConfig); err != nil {
 		return fmt.Errorf("failed to init metrics server: %w", err)
 	}
+	if err := s.initHistory(cfg); err != nil {
+		return fmt.Errorf("failed to init game history: %w", err)
+	}
+	s.initAlerts(cfg)
+	if err := s.initRPCServer(cfg); err != nil {
+		return fmt.Errorf("failed to init rpc server: %w", err)
+	}
 	if err := s.initFactoryContract(cfg); err != nil {
 		return fmt.Errorf("failed to create factory contract bindings: %w", err)
 	}
@@ -197,6 +230,49 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	return nil
 }
 
+func (s *Service) initHistory(cfg *config.Config) error {
+	db, err := history.NewDB(s.logger, filepath.Join(cfg.Datadir, historyDir))
+	if err != nil {
+		return fmt.Errorf("failed to open game history db: %w", err)
+	}
+	s.history = db
+	return nil
+}
+
+func (s *Service) initAlerts(cfg *config.Config) {
+	if cfg.AlertWebhookURL == "" {
+		s.notifier = alerts.NoopNotifier{}
+		return
+	}
+	notifier := alerts.NewWebhookNotifier(s.logger, s.systemClock, cfg.AlertWebhookURL, cfg.AlertRoutingKey, cfg.AlertDedupInterval)
+	s.notifier = notifier
+	s.notifierCloser = notifier
+	s.logger.Info("Sending alerts to webhook", "dedupInterval", cfg.AlertDedupInterval)
+}
+
+func (s *Service) initRPCServer(cfg *config.Config) error {
+	if !cfg.RPCEnabled {
+		return nil
+	}
+	server := oprpc.NewServer(
+		cfg.RPCConfig.ListenAddr,
+		cfg.RPCConfig.ListenPort,
+		version.SimpleWithMeta,
+		oprpc.WithLogger(s.logger),
+	)
+	server.AddAPI(history.GetAPI(history.NewAPI(s.history)))
+	if cfg.RPCConfig.EnableAdmin {
+		server.AddAPI(s.txMgr.API())
+		s.logger.Info("Admin RPC enabled")
+	}
+	s.logger.Info("Starting JSON-RPC server")
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("unable to start RPC server: %w", err)
+	}
+	s.rpcServer = server
+	return nil
+}
+
 func (s *Service) initFactoryContract(cfg *config.Config) error {
 	factoryContract := contracts.NewDisputeGameFactoryContract(s.metrics, cfg.GameFactoryAddress,
 		batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
@@ -226,7 +302,7 @@ func (s *Service) registerGameTypes(ctx context.Context, cfg *config.Config) err
 	gameTypeRegistry := registry.NewGameTypeRegistry()
 	oracles := registry.NewOracleRegistry()
 	caller := batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize)
-	closer, err := fault.RegisterGameTypes(ctx, s.systemClock, s.l1Clock, s.logger, s.metrics, cfg, gameTypeRegistry, oracles, s.rollupClient, s.txSender, s.factoryContract, caller, s.l1Client, cfg.SelectiveClaimResolution, s.claimants)
+	closer, err := fault.RegisterGameTypes(ctx, s.systemClock, s.l1Clock, s.logger, s.metrics, cfg, gameTypeRegistry, oracles, s.rollupClient, s.txSender, s.history, s.notifier, s.factoryContract, caller, s.l1Client, cfg.SelectiveClaimResolution, s.claimants)
 	if err != nil {
 		return err
 	}
@@ -237,8 +313,8 @@ func (s *Service) registerGameTypes(ctx context.Context, cfg *config.Config) err
 }
 
 func (s *Service) initScheduler(cfg *config.Config) error {
-	disk := newDiskManager(cfg.Datadir)
-	s.sched = scheduler.NewScheduler(s.logger, s.metrics, disk, cfg.MaxConcurrency, s.registry.CreatePlayer, cfg.AllowInvalidPrestate)
+	disk := newDiskManager(cfg.Datadir, s.history)
+	s.sched = scheduler.NewScheduler(s.logger, s.metrics, disk, cfg.MaxConcurrency, s.registry.CreatePlayer, s.notifier, cfg.AllowInvalidPrestate)
 	return nil
 }
 
@@ -251,7 +327,14 @@ func (s *Service) initLargePreimages() error {
 }
 
 func (s *Service) initMonitor(cfg *config.Config) {
-	s.monitor = newGameMonitor(s.logger, s.l1Clock, s.factoryContract, s.sched, s.preimages, cfg.GameWindow, s.claimer, cfg.GameAllowlist, s.pollClient)
+	var prioritizer gamePrioritizer
+	if cfg.OptimismPortalAddress != (common.Address{}) {
+		portal := contracts.NewOptimismPortalContract(s.metrics, cfg.OptimismPortalAddress,
+			batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+		prioritizer = defensive.NewPrioritizer(s.logger, portal, s.l1Client, cfg.GameWindow)
+		s.logger.Info("Defensive mode enabled, prioritizing games of pending withdrawals", "portal", cfg.OptimismPortalAddress)
+	}
+	s.monitor = newGameMonitor(s.logger, s.l1Clock, s.factoryContract, s.sched, s.preimages, cfg.GameWindow, s.claimer, prioritizer, cfg.GameAllowlist, s.pollClient)
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -294,6 +377,11 @@ func (s *Service) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to close pprof server: %w", err))
 		}
 	}
+	if s.notifierCloser != nil {
+		if err := s.notifierCloser.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close alert notifier: %w", err))
+		}
+	}
 	if s.balanceMetricer != nil {
 		if err := s.balanceMetricer.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
@@ -304,6 +392,17 @@ func (s *Service) Stop(ctx context.Context) error {
 		s.txMgr.Close()
 	}
 
+	if s.rpcServer != nil {
+		if err := s.rpcServer.Stop(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close rpc server: %w", err))
+		}
+	}
+	if s.history != nil {
+		if err := s.history.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close game history db: %w", err))
+		}
+	}
+
 	if s.rollupClient != nil {
 		s.rollupClient.Close()
 	}
@@ -41,6 +41,7 @@ type Metricer interface {
 	RecordGameStep()
 	RecordGameMove()
 	RecordGameL2Challenge()
+	RecordFreeloaderCountered()
 	RecordClaimResolutionTime(t float64)
 	RecordGameActTime(t float64)
 
@@ -96,6 +97,8 @@ type Metrics struct {
 	steps        prometheus.Counter
 	l2Challenges prometheus.Counter
 
+	freeloadersCountered prometheus.Counter
+
 	claimResolutionTime prometheus.Histogram
 	gameActTime         prometheus.Histogram
 
@@ -160,6 +163,11 @@ func NewMetrics() *Metrics {
 			Name:      "l2_challenges",
 			Help:      "Number of L2 challenges made by the challenge agent",
 		}),
+		freeloadersCountered: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "freeloaders_countered",
+			Help:      "Number of freeloader claims countered by the challenge agent, to defend the bond share of honest claims",
+		}),
 		claimResolutionTime: factory.NewHistogram(prometheus.HistogramOpts{
 			Namespace: Namespace,
 			Name:      "claim_resolution_time",
@@ -259,6 +267,10 @@ func (m *Metrics) RecordGameL2Challenge() {
 	m.l2Challenges.Add(1)
 }
 
+func (m *Metrics) RecordFreeloaderCountered() {
+	m.freeloadersCountered.Add(1)
+}
+
 func (m *Metrics) RecordPreimageChallenged() {
 	m.preimageChallenged.Add(1)
 }
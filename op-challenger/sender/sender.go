@@ -56,6 +56,22 @@ func (s *TxSender) SendAndWaitDetailed(txPurpose string, txs ...txmgr.TxCandidat
 	return errs
 }
 
+// SendAndWait sends a single transaction and waits for its receipt.
+// If the transaction reverted, the receipt is returned along with ErrTransactionReverted.
+func (s *TxSender) SendAndWait(txPurpose string, tx txmgr.TxCandidate) (*types.Receipt, error) {
+	receiptsCh := make(chan txmgr.TxReceipt[int], 1)
+	s.queue.Send(0, tx, receiptsCh)
+	rcpt := <-receiptsCh
+	if rcpt.Err != nil {
+		return nil, rcpt.Err
+	}
+	if rcpt.Receipt.Status != types.ReceiptStatusSuccessful {
+		return rcpt.Receipt, fmt.Errorf("%w purpose: %v hash: %v", ErrTransactionReverted, txPurpose, rcpt.Receipt.TxHash)
+	}
+	s.log.Debug("Transaction successfully published", "tx_hash", rcpt.Receipt.TxHash, "purpose", txPurpose)
+	return rcpt.Receipt, nil
+}
+
 func (s *TxSender) SendAndWaitSimple(txPurpose string, txs ...txmgr.TxCandidate) error {
 	errs := s.SendAndWaitDetailed(txPurpose, txs...)
 	return errors.Join(errs...)
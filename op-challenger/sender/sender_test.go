@@ -114,6 +114,27 @@ func TestSendAndWaitReturnIndividualErrors(t *testing.T) {
 	require.NoError(t, errs[2])
 }
 
+func TestSendAndWaitSingle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	txMgr := &stubTxMgr{
+		sending: make(map[byte]chan *types.Receipt),
+		syncStatus: map[byte]uint64{
+			0: types.ReceiptStatusSuccessful,
+			1: types.ReceiptStatusFailed,
+		},
+	}
+	sender := NewTxSender(ctx, testlog.Logger(t, log.LevelInfo), txMgr, 500)
+
+	rcpt, err := sender.SendAndWait("testing", txmgr.TxCandidate{TxData: []byte{0}})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, rcpt.Status)
+
+	rcpt, err = sender.SendAndWait("testing", txmgr.TxCandidate{TxData: []byte{1}})
+	require.ErrorIs(t, err, ErrTransactionReverted)
+	require.Equal(t, types.ReceiptStatusFailed, rcpt.Status)
+}
+
 type stubTxMgr struct {
 	m          sync.Mutex
 	sending    map[byte]chan *types.Receipt
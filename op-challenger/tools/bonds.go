@@ -0,0 +1,33 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient balance to post bond")
+
+type BalanceCaller interface {
+	SingleCall(ctx context.Context, block rpcblock.Block, call batching.Call) (*batching.CallResult, error)
+}
+
+// checkBondBalance verifies the sender can afford to post the bond, before sending a transaction that would revert.
+func checkBondBalance(ctx context.Context, caller BalanceCaller, sender common.Address, bond *big.Int) error {
+	if bond == nil || bond.Sign() == 0 {
+		return nil
+	}
+	result, err := caller.SingleCall(ctx, rpcblock.Latest, batching.NewBalanceCall(sender))
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance of %v: %w", sender, err)
+	}
+	if balance := result.GetBigInt(0); balance.Cmp(bond) < 0 {
+		return fmt.Errorf("%w: balance of %v is %v, bond is %v", ErrInsufficientBalance, sender, balance, bond)
+	}
+	return nil
+}
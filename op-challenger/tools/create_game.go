@@ -13,12 +13,14 @@ import (
 type GameCreator struct {
 	contract *contracts.DisputeGameFactoryContract
 	txMgr    txmgr.TxManager
+	caller   BalanceCaller
 }
 
-func NewGameCreator(contract *contracts.DisputeGameFactoryContract, txMgr txmgr.TxManager) *GameCreator {
+func NewGameCreator(contract *contracts.DisputeGameFactoryContract, txMgr txmgr.TxManager, caller BalanceCaller) *GameCreator {
 	return &GameCreator{
 		contract: contract,
 		txMgr:    txMgr,
+		caller:   caller,
 	}
 }
 
@@ -27,6 +29,9 @@ func (g *GameCreator) CreateGame(ctx context.Context, outputRoot common.Hash, tr
 	if err != nil {
 		return common.Address{}, fmt.Errorf("failed to create tx: %w", err)
 	}
+	if err := checkBondBalance(ctx, g.caller, g.txMgr.From(), txCandidate.Value); err != nil {
+		return common.Address{}, err
+	}
 
 	rct, err := g.txMgr.Send(ctx, txCandidate)
 	if err != nil {
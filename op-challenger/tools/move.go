@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type GameMover struct {
+	contract contracts.FaultDisputeGameContract
+	txMgr    txmgr.TxManager
+	caller   BalanceCaller
+}
+
+func NewGameMover(contract contracts.FaultDisputeGameContract, txMgr txmgr.TxManager, caller BalanceCaller) *GameMover {
+	return &GameMover{
+		contract: contract,
+		txMgr:    txMgr,
+		caller:   caller,
+	}
+}
+
+// Attack counters the claim at parentIndex, posting the required bond.
+func (m *GameMover) Attack(ctx context.Context, parentIndex uint64, claim common.Hash) (*types.Receipt, error) {
+	return m.move(ctx, parentIndex, claim, true)
+}
+
+// Defend supports the claim at parentIndex, posting the required bond.
+func (m *GameMover) Defend(ctx context.Context, parentIndex uint64, claim common.Hash) (*types.Receipt, error) {
+	return m.move(ctx, parentIndex, claim, false)
+}
+
+func (m *GameMover) move(ctx context.Context, parentIndex uint64, claim common.Hash, attack bool) (*types.Receipt, error) {
+	parentClaim, err := m.contract.GetClaim(ctx, parentIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent claim: %w", err)
+	}
+	var tx txmgr.TxCandidate
+	if attack {
+		tx, err = m.contract.AttackTx(ctx, parentClaim, claim)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create attack tx: %w", err)
+		}
+	} else {
+		tx, err = m.contract.DefendTx(ctx, parentClaim, claim)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create defense tx: %w", err)
+		}
+	}
+	if err := checkBondBalance(ctx, m.caller, m.txMgr.From(), tx.Value); err != nil {
+		return nil, err
+	}
+	rct, err := m.txMgr.Send(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send tx: %w", err)
+	}
+	if rct.Status != types.ReceiptStatusSuccessful {
+		return rct, fmt.Errorf("move transaction (%v) reverted", rct.TxHash.Hex())
+	}
+	return rct, nil
+}
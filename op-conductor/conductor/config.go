@@ -3,6 +3,7 @@ package conductor
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -12,6 +13,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-conductor/flags"
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -68,6 +70,9 @@ type Config struct {
 	// RPCEnableProxy is true if the sequencer RPC proxy should be enabled.
 	RPCEnableProxy bool
 
+	// TxPoolHandoff is the configuration of the transfer of the tx pool to the new leader.
+	TxPoolHandoff TxPoolHandoffConfig
+
 	LogConfig     oplog.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
@@ -97,6 +102,9 @@ func (c *Config) Check() error {
 	if err := c.HealthCheck.Check(); err != nil {
 		return errors.Wrap(err, "invalid health check config")
 	}
+	if err := c.TxPoolHandoff.Check(); err != nil {
+		return errors.Wrap(err, "invalid tx pool handoff config")
+	}
 	if err := c.RollupCfg.Check(); err != nil {
 		return errors.Wrap(err, "invalid rollup config")
 	}
@@ -123,6 +131,11 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		return nil, errors.Wrap(err, "failed to load rollup config")
 	}
 
+	txPoolHandoff, err := NewTxPoolHandoffConfig(ctx, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load tx pool handoff config")
+	}
+
 	return &Config{
 		ConsensusAddr: ctx.String(flags.ConsensusAddr.Name),
 		ConsensusPort: ctx.Int(flags.ConsensusPort.Name),
@@ -147,6 +160,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*Config, error) {
 		},
 		RollupCfg:      *rollupCfg,
 		RPCEnableProxy: ctx.Bool(flags.RPCEnableProxy.Name),
+		TxPoolHandoff:  *txPoolHandoff,
 		LogConfig:      oplog.ReadCLIConfig(ctx),
 		MetricsConfig:  opmetrics.ReadCLIConfig(ctx),
 		PprofConfig:    oppprof.ReadCLIConfig(ctx),
@@ -184,3 +198,60 @@ func (c *HealthCheckConfig) Check() error {
 	}
 	return nil
 }
+
+// TxPoolHandoffConfig defines the configuration of the transfer of the tx pool to the new leader,
+// when the sequencer stops sequencing.
+type TxPoolHandoffConfig struct {
+	// Enabled is true if the tx pool is transferred to the new leader, and the tx pools of other sequencers are accepted.
+	Enabled bool
+
+	// Secret authenticates the tx pool transfers, and must be shared by all conductors of the cluster.
+	Secret eth.Bytes32
+
+	// Peers maps the raft server IDs of the other conductors of the cluster to their RPC endpoints.
+	Peers map[string]string
+
+	// Timeout is the maximum time to wait for a new leader, and transfer the tx pool to it.
+	Timeout time.Duration
+}
+
+func (c *TxPoolHandoffConfig) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Secret == (eth.Bytes32{}) {
+		return fmt.Errorf("missing tx pool handoff secret")
+	}
+	if len(c.Peers) == 0 {
+		return fmt.Errorf("missing tx pool handoff peers")
+	}
+	if c.Timeout == 0 {
+		return fmt.Errorf("missing tx pool handoff timeout")
+	}
+	return nil
+}
+
+// NewTxPoolHandoffConfig parses the TxPoolHandoffConfig from the provided flags or environment variables.
+func NewTxPoolHandoffConfig(ctx *cli.Context, log log.Logger) (*TxPoolHandoffConfig, error) {
+	cfg := &TxPoolHandoffConfig{
+		Enabled: ctx.Bool(flags.TxPoolHandoffEnabled.Name),
+		Timeout: ctx.Duration(flags.TxPoolHandoffTimeout.Name),
+		Peers:   make(map[string]string),
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+	secret, err := oprpc.ObtainJWTSecret(log, ctx.String(flags.TxPoolHandoffSecret.Name), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read tx pool handoff secret")
+	}
+	cfg.Secret = secret
+	for _, peer := range ctx.StringSlice(flags.TxPoolHandoffPeers.Name) {
+		id, addr, ok := strings.Cut(peer, "=")
+		if !ok || id == "" || addr == "" {
+			return nil, fmt.Errorf("invalid tx pool handoff peer %q, expected <raft server ID>=<URL>", peer)
+		}
+		cfg.Peers[id] = addr
+	}
+	return cfg, nil
+}
@@ -0,0 +1,115 @@
+package conductor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+)
+
+const (
+	leadershipHistoryFile = "leadership_history.jsonl"
+	// maxLeadershipHistory is the number of leadership changes that are kept.
+	maxLeadershipHistory = 1000
+)
+
+// leadershipHistory keeps the latest leadership changes of the conductor.
+// Changes are appended to a file in the raft storage directory, so the history survives restarts.
+type leadershipHistory struct {
+	mu      sync.Mutex
+	path    string
+	changes []conductorrpc.LeadershipChange
+	// persisted is the number of changes in the file, which is compacted once it holds twice the kept changes.
+	persisted int
+}
+
+func newLeadershipHistory(dir string) (*leadershipHistory, error) {
+	h := &leadershipHistory{path: filepath.Join(dir, leadershipHistoryFile)}
+	f, err := os.Open(h.path)
+	if os.IsNotExist(err) {
+		return h, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open leadership history: %w", err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var change conductorrpc.LeadershipChange
+		if err := json.Unmarshal(scanner.Bytes(), &change); err != nil {
+			return nil, fmt.Errorf("invalid leadership history entry %d: %w", h.persisted, err)
+		}
+		h.append(change)
+		h.persisted++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read leadership history: %w", err)
+	}
+	return h, nil
+}
+
+func (h *leadershipHistory) append(change conductorrpc.LeadershipChange) {
+	h.changes = append(h.changes, change)
+	if len(h.changes) > maxLeadershipHistory {
+		h.changes = h.changes[len(h.changes)-maxLeadershipHistory:]
+	}
+}
+
+// Add records the change and persists it.
+// The change is kept in memory even if it fails to persist.
+func (h *leadershipHistory) Add(change conductorrpc.LeadershipChange) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.append(change)
+	if h.persisted >= 2*maxLeadershipHistory {
+		return h.compact()
+	}
+	line, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(h.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open leadership history: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to persist leadership change: %w", err)
+	}
+	h.persisted++
+	return nil
+}
+
+// compact rewrites the file with only the kept changes.
+func (h *leadershipHistory) compact() error {
+	tmp := h.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create leadership history: %w", err)
+	}
+	enc := json.NewEncoder(f)
+	for _, change := range h.changes {
+		if err := enc.Encode(change); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to persist leadership history: %w", err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to persist leadership history: %w", err)
+	}
+	if err := os.Rename(tmp, h.path); err != nil {
+		return fmt.Errorf("failed to replace leadership history: %w", err)
+	}
+	h.persisted = len(h.changes)
+	return nil
+}
+
+// Changes returns a copy of the kept changes, oldest first.
+func (h *leadershipHistory) Changes() []conductorrpc.LeadershipChange {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]conductorrpc.LeadershipChange(nil), h.changes...)
+}
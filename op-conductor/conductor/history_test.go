@@ -0,0 +1,59 @@
+package conductor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+)
+
+func TestLeadershipHistory(t *testing.T) {
+	change := func(i int) conductorrpc.LeadershipChange {
+		return conductorrpc.LeadershipChange{
+			Time:    time.Unix(int64(i), 0).UTC(),
+			Leader:  i%2 == 0,
+			Trigger: conductorrpc.TriggerElection,
+		}
+	}
+
+	t.Run("Persisted", func(t *testing.T) {
+		dir := t.TempDir()
+		h, err := newLeadershipHistory(dir)
+		require.NoError(t, err)
+		require.Empty(t, h.Changes())
+
+		require.NoError(t, h.Add(change(0)))
+		failure := conductorrpc.LeadershipChange{
+			Time:    time.Unix(1, 0).UTC(),
+			Trigger: conductorrpc.TriggerHealthFailure,
+			Reason:  "sequencer is not healthy",
+		}
+		require.NoError(t, h.Add(failure))
+
+		reloaded, err := newLeadershipHistory(dir)
+		require.NoError(t, err)
+		require.Equal(t, []conductorrpc.LeadershipChange{change(0), failure}, reloaded.Changes())
+	})
+
+	t.Run("KeepLatest", func(t *testing.T) {
+		dir := t.TempDir()
+		h, err := newLeadershipHistory(dir)
+		require.NoError(t, err)
+		total := 2*maxLeadershipHistory + 10
+		for i := 0; i < total; i++ {
+			require.NoError(t, h.Add(change(i)))
+		}
+		changes := h.Changes()
+		require.Len(t, changes, maxLeadershipHistory)
+		require.Equal(t, change(total-maxLeadershipHistory), changes[0])
+		require.Equal(t, change(total-1), changes[len(changes)-1])
+		// the file was compacted
+		require.Less(t, h.persisted, 2*maxLeadershipHistory)
+
+		reloaded, err := newLeadershipHistory(dir)
+		require.NoError(t, err)
+		require.Equal(t, changes, reloaded.Changes())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-conductor/client"
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/handoff"
 	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
 	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
@@ -38,6 +40,7 @@ var (
 	ErrPauseTimeout       = errors.New("timeout to pause conductor")
 	ErrUnsafeHeadMismatch = errors.New("unsafe head mismatch")
 	ErrNoUnsafeHead       = errors.New("no unsafe head")
+	ErrHandoffDisabled    = errors.New("tx pool handoff disabled")
 )
 
 // New creates a new OpConductor instance.
@@ -110,9 +113,15 @@ func (c *OpConductor) init(ctx context.Context) error {
 	if err := c.initConsensus(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize consensus")
 	}
+	if err := c.initLeadershipHistory(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize leadership history")
+	}
 	if err := c.initHealthMonitor(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize health monitor")
 	}
+	if err := c.initTxPoolHandoff(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize tx pool handoff")
+	}
 	if err := c.initRPCServer(ctx); err != nil {
 		return errors.Wrap(err, "failed to initialize rpc server")
 	}
@@ -191,6 +200,18 @@ func (c *OpConductor) initConsensus(ctx context.Context) error {
 	return nil
 }
 
+func (c *OpConductor) initLeadershipHistory(ctx context.Context) error {
+	if err := os.MkdirAll(c.cfg.RaftStorageDir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create storage dir")
+	}
+	history, err := newLeadershipHistory(c.cfg.RaftStorageDir)
+	if err != nil {
+		return err
+	}
+	c.history = history
+	return nil
+}
+
 func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 	if c.hmon != nil {
 		return nil
@@ -225,6 +246,34 @@ func (c *OpConductor) initHealthMonitor(ctx context.Context) error {
 	return nil
 }
 
+func (c *OpConductor) initTxPoolHandoff(ctx context.Context) error {
+	if !c.cfg.TxPoolHandoff.Enabled {
+		return nil
+	}
+
+	ec, err := opclient.NewRPC(ctx, c.log, c.cfg.ExecutionRPC)
+	if err != nil {
+		return errors.Wrap(err, "failed to create geth rpc client")
+	}
+	dial := func(ctx context.Context, rpcAddr string) (handoff.SnapshotImporter, error) {
+		rc, err := rpc.DialContext(ctx, rpcAddr)
+		if err != nil {
+			return nil, err
+		}
+		return conductorrpc.NewAPIClient(rc), nil
+	}
+	c.handoff = handoff.NewHandoff(
+		c.log,
+		c.cfg.RaftServerID,
+		c.cfg.TxPoolHandoff.Secret,
+		handoff.NewRPCTxPool(ec),
+		c.cfg.TxPoolHandoff.Peers,
+		c.cons.LeaderWithID,
+		dial,
+	)
+	return nil
+}
+
 func (oc *OpConductor) initRPCServer(ctx context.Context) error {
 	server := oprpc.NewServer(
 		oc.cfg.RPC.ListenAddr,
@@ -302,6 +351,10 @@ type OpConductor struct {
 	hcerr          error // error from health check
 	prevState      *state
 
+	history         *leadershipHistory
+	leaderSince     time.Time                                      // start of the current leadership, zero if not leader.
+	transferTrigger atomic.Pointer[conductorrpc.LeadershipTrigger] // trigger of an initiated leadership transfer.
+
 	healthUpdateCh <-chan error
 	leaderUpdateCh <-chan bool
 	loopActionFn   func() // loopActionFn defines the logic to be executed inside control loop.
@@ -320,6 +373,8 @@ type OpConductor struct {
 	rpcServer     *oprpc.Server
 	metricsServer *httputil.HTTPServer
 
+	handoff *handoff.Handoff // nil if the tx pool handoff is disabled
+
 	retryBackoff func() time.Duration
 }
 
@@ -530,12 +585,31 @@ func (oc *OpConductor) RemoveServer(_ context.Context, id string, version uint64
 
 // TransferLeader transfers leadership to another server.
 func (oc *OpConductor) TransferLeader(_ context.Context) error {
-	return oc.cons.TransferLeader()
+	return oc.manualTransfer(oc.cons.TransferLeader)
 }
 
 // TransferLeaderToServer transfers leadership to a specific server.
 func (oc *OpConductor) TransferLeaderToServer(_ context.Context, id string, addr string) error {
-	return oc.cons.TransferLeaderTo(id, addr)
+	return oc.manualTransfer(func() error {
+		return oc.cons.TransferLeaderTo(id, addr)
+	})
+}
+
+// manualTransfer runs a leadership transfer requested through the admin API,
+// so that the resulting leadership change is attributed to it.
+func (oc *OpConductor) manualTransfer(transfer func() error) error {
+	trigger := conductorrpc.TriggerManualTransfer
+	oc.transferTrigger.Store(&trigger)
+	err := transfer()
+	if err != nil {
+		oc.transferTrigger.Store(nil)
+	}
+	return err
+}
+
+// LeadershipHistory returns the leadership changes of this conductor, oldest first.
+func (oc *OpConductor) LeadershipHistory(_ context.Context) []conductorrpc.LeadershipChange {
+	return oc.history.Changes()
 }
 
 // CommitUnsafePayload commits an unsafe payload (latest head) to the cluster FSM ensuring strong consistency by leveraging Raft consensus mechanisms.
@@ -553,6 +627,17 @@ func (oc *OpConductor) ClusterMembership(_ context.Context) (*consensus.ClusterM
 	return oc.cons.ClusterMembership()
 }
 
+// ImportTxPoolSnapshot submits the transactions of the tx pool snapshot of the previous leader to the tx pool of the sequencer.
+func (oc *OpConductor) ImportTxPoolSnapshot(ctx context.Context, snapshot *handoff.SignedSnapshot) (uint64, error) {
+	if oc.handoff == nil {
+		return 0, ErrHandoffDisabled
+	}
+	if !oc.Leader(ctx) {
+		return 0, conductorrpc.ErrNotLeader
+	}
+	return oc.handoff.Import(ctx, snapshot)
+}
+
 // LatestUnsafePayload returns the latest unsafe payload envelope from FSM in a strongly consistent fashion.
 func (oc *OpConductor) LatestUnsafePayload(_ context.Context) (*eth.ExecutionPayloadEnvelope, error) {
 	return oc.cons.LatestUnsafePayload()
@@ -607,10 +692,38 @@ func (oc *OpConductor) queueAction() {
 func (oc *OpConductor) handleLeaderUpdate(leader bool) {
 	oc.log.Info("Leadership status changed", "server", oc.cons.ServerID(), "leader", leader)
 
-	oc.leader.Store(leader)
+	if old := oc.leader.Swap(leader); old != leader {
+		trigger := conductorrpc.TriggerElection
+		// a leadership loss after a transfer initiated through the admin API is attributed to it.
+		if initiated := oc.transferTrigger.Swap(nil); initiated != nil && !leader {
+			trigger = *initiated
+		}
+		oc.recordLeadershipChange(leader, trigger, "")
+	}
 	oc.queueAction()
 }
 
+// recordLeadershipChange adds the change to the leadership history and records its metrics.
+func (oc *OpConductor) recordLeadershipChange(leader bool, trigger conductorrpc.LeadershipTrigger, reason string) {
+	now := time.Now()
+	if leader {
+		oc.leaderSince = now
+	} else if !oc.leaderSince.IsZero() {
+		oc.metrics.RecordLeadershipDuration(string(trigger), now.Sub(oc.leaderSince).Seconds())
+		oc.leaderSince = time.Time{}
+	}
+	oc.metrics.RecordLeadershipChange(leader, string(trigger))
+	change := conductorrpc.LeadershipChange{
+		Time:    now,
+		Leader:  leader,
+		Trigger: trigger,
+		Reason:  reason,
+	}
+	if err := oc.history.Add(change); err != nil {
+		oc.log.Warn("failed to persist leadership change", "err", err)
+	}
+}
+
 // handleHealthUpdate handles health update from health monitor.
 func (oc *OpConductor) handleHealthUpdate(hcerr error) {
 	oc.log.Debug("received health update", "server", oc.cons.ServerID(), "error", hcerr)
@@ -732,7 +845,14 @@ func (oc *OpConductor) transferLeader() error {
 	err := oc.cons.TransferLeader()
 	oc.metrics.RecordLeaderTransfer(err == nil)
 	if err == nil {
-		oc.leader.Store(false)
+		// transferLeader is only called when the sequencer is unhealthy.
+		if oc.leader.Swap(false) {
+			reason := ""
+			if oc.hcerr != nil {
+				reason = oc.hcerr.Error()
+			}
+			oc.recordLeadershipChange(false, conductorrpc.TriggerHealthFailure, reason)
+		}
 		return nil // success
 	}
 
@@ -767,6 +887,7 @@ func (oc *OpConductor) stopSequencer() error {
 	if err == nil {
 		// None of the consensus state should have changed here so don't log it again.
 		oc.log.Info("stopped sequencer", "latestHead", latestHead)
+		oc.handoffTxPool()
 	} else {
 		if strings.Contains(err.Error(), driver.ErrSequencerAlreadyStopped.Error()) {
 			oc.log.Warn("sequencer already stopped", "err", err)
@@ -779,6 +900,24 @@ func (oc *OpConductor) stopSequencer() error {
 	return nil
 }
 
+// handoffTxPool transfers the tx pool to the new leader in the background, so transactions that were sent to
+// this sequencer, but not included yet, are not dropped. It is best-effort: transactions may still be dropped if
+// no new leader is elected within the handoff timeout, or it can't be reached.
+func (oc *OpConductor) handoffTxPool() {
+	if oc.handoff == nil {
+		return
+	}
+	oc.wg.Add(1)
+	go func() {
+		defer oc.wg.Done()
+		ctx, cancel := context.WithTimeout(oc.shutdownCtx, oc.cfg.TxPoolHandoff.Timeout)
+		defer cancel()
+		if err := oc.handoff.Run(ctx); err != nil {
+			oc.log.Warn("failed to transfer tx pool to new leader", "err", err)
+		}
+	}()
+}
+
 func (oc *OpConductor) startSequencer() error {
 	ctx := context.Background()
 
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-conductor/health"
 	healthmocks "github.com/ethereum-optimism/optimism/op-conductor/health/mocks"
 	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -115,6 +116,7 @@ func (s *OpConductorTestSuite) SetupTest() {
 	s.cons = &consensusmocks.Consensus{}
 	s.hmon = &healthmocks.HealthMonitor{}
 	s.cons.EXPECT().ServerID().Return("SequencerA")
+	s.cfg.RaftStorageDir = s.T().TempDir() // isolate the leadership history of every test
 
 	conductor, err := NewOpConductor(s.ctx, &s.cfg, s.log, s.metrics, s.version, s.ctrl, s.cons, s.hmon)
 	s.NoError(err)
@@ -868,6 +870,72 @@ func (s *OpConductorTestSuite) TestConductorRestart() {
 	s.ctrl.AssertCalled(s.T(), "StopSequencer", mock.Anything)
 }
 
+func (s *OpConductorTestSuite) TestLeadershipHistoryHealthFailure() {
+	s.enableSynchronization()
+
+	// set initial state
+	s.conductor.leader.Store(false)
+	s.conductor.healthy.Store(false)
+	s.conductor.seqActive.Store(false)
+	s.conductor.hcerr = health.ErrSequencerNotHealthy
+	s.conductor.prevState = &state{
+		leader:  false,
+		healthy: false,
+		active:  false,
+	}
+
+	mockPayload := &eth.ExecutionPayloadEnvelope{
+		ExecutionPayload: &eth.ExecutionPayload{
+			BlockNumber: 3,
+			BlockHash:   [32]byte{4, 5, 6},
+		},
+	}
+	mockBlockInfo := &testutils.MockBlockInfo{
+		InfoNum:  1,
+		InfoHash: [32]byte{1, 2, 3},
+	}
+	s.cons.EXPECT().TransferLeader().Return(nil)
+	s.cons.EXPECT().LatestUnsafePayload().Return(mockPayload, nil).Times(1)
+	s.ctrl.EXPECT().LatestUnsafeBlock(mock.Anything).Return(mockBlockInfo, nil).Times(1)
+
+	// become leader through election, then transfer leadership since not healthy
+	s.updateLeaderStatusAndExecuteAction(true)
+
+	history := s.conductor.LeadershipHistory(s.ctx)
+	s.Len(history, 2)
+	s.True(history[0].Leader)
+	s.Equal(conductorrpc.TriggerElection, history[0].Trigger)
+	s.False(history[1].Leader)
+	s.Equal(conductorrpc.TriggerHealthFailure, history[1].Trigger)
+	s.Equal(health.ErrSequencerNotHealthy.Error(), history[1].Reason)
+
+	// the consensus update for the completed transfer is not recorded again
+	s.updateLeaderStatusAndExecuteAction(false)
+	s.Len(s.conductor.LeadershipHistory(s.ctx), 2)
+}
+
+func (s *OpConductorTestSuite) TestLeadershipHistoryManualTransfer() {
+	s.enableSynchronization()
+
+	// set initial state
+	s.conductor.leader.Store(true)
+	s.conductor.healthy.Store(true)
+	s.conductor.seqActive.Store(true)
+
+	s.cons.EXPECT().TransferLeader().Return(nil).Times(1)
+	s.ctrl.EXPECT().StopSequencer(mock.Anything).Return(common.Hash{}, nil).Times(1)
+	s.NoError(s.conductor.TransferLeader(s.ctx))
+
+	// step down as leader
+	s.updateLeaderStatusAndExecuteAction(false)
+
+	history := s.conductor.LeadershipHistory(s.ctx)
+	s.Len(history, 1)
+	s.False(history[0].Leader)
+	s.Equal(conductorrpc.TriggerManualTransfer, history[0].Trigger)
+	s.Nil(s.conductor.transferTrigger.Load())
+}
+
 func (s *OpConductorTestSuite) TestHandleInitError() {
 	// This will cause an error in the init function, which should cause the conductor to stop successfully without issues.
 	_, err := New(s.ctx, &s.cfg, s.log, s.version)
@@ -118,6 +118,29 @@ var (
 		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "RPC_ENABLE_PROXY"),
 		Value:   true,
 	}
+	TxPoolHandoffEnabled = &cli.BoolFlag{
+		Name:    "txpool-handoff.enabled",
+		Usage:   "Transfer the tx pool of the sequencer to the new leader when it stops sequencing, and accept the tx pools of other sequencers",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "TXPOOL_HANDOFF_ENABLED"),
+		Value:   false,
+	}
+	TxPoolHandoffSecret = &cli.StringFlag{
+		Name:      "txpool-handoff.secret",
+		Usage:     "Path to the hex encoded 32 byte secret authenticating tx pool transfers, shared by all conductors of the cluster",
+		EnvVars:   opservice.PrefixEnvVar(EnvVarPrefix, "TXPOOL_HANDOFF_SECRET"),
+		TakesFile: true,
+	}
+	TxPoolHandoffPeers = &cli.StringSliceFlag{
+		Name:    "txpool-handoff.peers",
+		Usage:   "RPC endpoints of the other conductors of the cluster to transfer the tx pool to, as comma separated <raft server ID>=<URL> pairs",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "TXPOOL_HANDOFF_PEERS"),
+	}
+	TxPoolHandoffTimeout = &cli.DurationFlag{
+		Name:    "txpool-handoff.timeout",
+		Usage:   "Maximum time to wait for a new leader, and transfer the tx pool to it",
+		EnvVars: opservice.PrefixEnvVar(EnvVarPrefix, "TXPOOL_HANDOFF_TIMEOUT"),
+		Value:   30 * time.Second,
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -142,6 +165,10 @@ var optionalFlags = []cli.Flag{
 	RaftSnapshotInterval,
 	RaftSnapshotThreshold,
 	RaftTrailingLogs,
+	TxPoolHandoffEnabled,
+	TxPoolHandoffSecret,
+	TxPoolHandoffPeers,
+	TxPoolHandoffTimeout,
 }
 
 func init() {
@@ -0,0 +1,217 @@
+package handoff
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var (
+	ErrInvalidMAC       = errors.New("invalid tx pool snapshot MAC")
+	ErrSnapshotTooOld   = errors.New("tx pool snapshot too old")
+	ErrNoPeerRPC        = errors.New("no RPC endpoint known for new leader")
+	ErrNewLeaderTimeout = errors.New("timed out waiting for a new leader")
+)
+
+// MaxSnapshotAge is the maximum age of a snapshot to be imported.
+const MaxSnapshotAge = 5 * time.Minute
+
+// leaderPollInterval is the interval to check whether another server took over leadership.
+const leaderPollInterval = 500 * time.Millisecond
+
+// Snapshot is a snapshot of the transactions in the tx pool of the execution engine of a sequencer,
+// taken when it stops sequencing, so the new leader can include them instead of them being dropped.
+type Snapshot struct {
+	// ServerID is the raft server ID of the sequencer the snapshot was taken from.
+	ServerID string `json:"serverID"`
+	// Timestamp is the unix time the snapshot was taken at.
+	Timestamp uint64 `json:"timestamp"`
+	// Transactions are the binary encoded transactions, ordered by sender and nonce.
+	Transactions []hexutil.Bytes `json:"transactions"`
+}
+
+// SignedSnapshot is a Snapshot, authenticated with the secret shared by the conductors of the cluster.
+type SignedSnapshot struct {
+	Snapshot
+	MAC hexutil.Bytes `json:"mac"`
+}
+
+// mac computes the HMAC-SHA256 of the snapshot with the given secret.
+func (s *Snapshot) mac(secret eth.Bytes32) []byte {
+	h := hmac.New(sha256.New, secret[:])
+	var buf [8]byte
+	writeBytes := func(b []byte) {
+		binary.BigEndian.PutUint64(buf[:], uint64(len(b)))
+		h.Write(buf[:])
+		h.Write(b)
+	}
+	writeBytes([]byte(s.ServerID))
+	binary.BigEndian.PutUint64(buf[:], s.Timestamp)
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], uint64(len(s.Transactions)))
+	h.Write(buf[:])
+	for _, tx := range s.Transactions {
+		writeBytes(tx)
+	}
+	return h.Sum(nil)
+}
+
+// Sign authenticates the snapshot with the given secret.
+func (s *Snapshot) Sign(secret eth.Bytes32) *SignedSnapshot {
+	return &SignedSnapshot{Snapshot: *s, MAC: s.mac(secret)}
+}
+
+// Verify checks that the snapshot was authenticated with the given secret.
+func (s *SignedSnapshot) Verify(secret eth.Bytes32) error {
+	if !hmac.Equal(s.MAC, s.Snapshot.mac(secret)) {
+		return ErrInvalidMAC
+	}
+	return nil
+}
+
+// TxPool is the tx pool of the execution engine of a sequencer.
+type TxPool interface {
+	// Transactions returns the pending and queued transactions, ordered by sender and nonce.
+	Transactions(ctx context.Context) (types.Transactions, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// SnapshotImporter imports a snapshot into the tx pool of another sequencer.
+type SnapshotImporter interface {
+	ImportTxPoolSnapshot(ctx context.Context, snapshot *SignedSnapshot) (uint64, error)
+}
+
+// PeerDialer connects to the conductor at the given RPC endpoint.
+type PeerDialer func(ctx context.Context, rpcAddr string) (SnapshotImporter, error)
+
+// Handoff transfers the tx pool of a sequencer that stopped sequencing to the new leader.
+type Handoff struct {
+	log      log.Logger
+	serverID string
+	secret   eth.Bytes32
+	pool     TxPool
+	// peers maps the raft server IDs of the other conductors of the cluster to their RPC endpoints.
+	peers  map[string]string
+	leader func() *consensus.ServerInfo
+	dial   PeerDialer
+	now    func() time.Time
+}
+
+func NewHandoff(log log.Logger, serverID string, secret eth.Bytes32, pool TxPool, peers map[string]string,
+	leader func() *consensus.ServerInfo, dial PeerDialer) *Handoff {
+	return &Handoff{
+		log:      log,
+		serverID: serverID,
+		secret:   secret,
+		pool:     pool,
+		peers:    peers,
+		leader:   leader,
+		dial:     dial,
+		now:      time.Now,
+	}
+}
+
+// Run waits for another server to become leader, and transfers the snapshot of the tx pool to it.
+// The snapshot is taken once the new leader is known, to include transactions received until then.
+func (h *Handoff) Run(ctx context.Context) error {
+	newLeader, err := h.waitForNewLeader(ctx)
+	if err != nil {
+		return err
+	}
+	rpcAddr, ok := h.peers[newLeader.ID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoPeerRPC, newLeader.ID)
+	}
+	snapshot, err := h.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	peer, err := h.dial(ctx, rpcAddr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to new leader %s: %w", newLeader.ID, err)
+	}
+	imported, err := peer.ImportTxPoolSnapshot(ctx, snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to transfer tx pool snapshot to new leader %s: %w", newLeader.ID, err)
+	}
+	h.log.Info("Transferred tx pool snapshot to new leader", "leader", newLeader.ID,
+		"transactions", len(snapshot.Transactions), "imported", imported)
+	return nil
+}
+
+func (h *Handoff) waitForNewLeader(ctx context.Context) (*consensus.ServerInfo, error) {
+	ticker := time.NewTicker(leaderPollInterval)
+	defer ticker.Stop()
+	for {
+		if leader := h.leader(); leader != nil && leader.ID != "" && leader.ID != h.serverID {
+			return leader, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", ErrNewLeaderTimeout, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Snapshot takes a signed snapshot of the tx pool.
+func (h *Handoff) Snapshot(ctx context.Context) (*SignedSnapshot, error) {
+	txs, err := h.pool.Transactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tx pool: %w", err)
+	}
+	snapshot := &Snapshot{
+		ServerID:     h.serverID,
+		Timestamp:    uint64(h.now().Unix()),
+		Transactions: make([]hexutil.Bytes, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		data, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tx %s: %w", tx.Hash(), err)
+		}
+		snapshot.Transactions = append(snapshot.Transactions, data)
+	}
+	return snapshot.Sign(h.secret), nil
+}
+
+// Import verifies the snapshot of the tx pool of another sequencer, and submits its transactions
+// to the tx pool. Transactions rejected by the tx pool, e.g. because they are already known or
+// were included already, are skipped. It returns the number of transactions accepted by the tx pool.
+func (h *Handoff) Import(ctx context.Context, snapshot *SignedSnapshot) (uint64, error) {
+	if err := snapshot.Verify(h.secret); err != nil {
+		return 0, err
+	}
+	if age := h.now().Sub(time.Unix(int64(snapshot.Timestamp), 0)); age > MaxSnapshotAge {
+		return 0, fmt.Errorf("%w: taken %v ago", ErrSnapshotTooOld, age)
+	}
+	var imported uint64
+	for i, data := range snapshot.Transactions {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return imported, fmt.Errorf("failed to decode tx %d: %w", i, err)
+		}
+		if err := h.pool.SendTransaction(ctx, &tx); err != nil {
+			if ctx.Err() != nil {
+				return imported, ctx.Err()
+			}
+			h.log.Debug("Tx pool rejected transaction of snapshot", "tx", tx.Hash(), "err", err)
+			continue
+		}
+		imported++
+	}
+	h.log.Info("Imported tx pool snapshot", "from", snapshot.ServerID,
+		"transactions", len(snapshot.Transactions), "imported", imported)
+	return imported, nil
+}
@@ -0,0 +1,140 @@
+package handoff
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var errRejected = errors.New("already known")
+
+type fakeTxPool struct {
+	txs    types.Transactions
+	sent   types.Transactions
+	reject map[uint64]bool
+}
+
+func (p *fakeTxPool) Transactions(ctx context.Context) (types.Transactions, error) {
+	return p.txs, nil
+}
+
+func (p *fakeTxPool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if p.reject[tx.Nonce()] {
+		return errRejected
+	}
+	p.sent = append(p.sent, tx)
+	return nil
+}
+
+// peerImporter imports the snapshot with the Handoff of the peer, as the RPC of its conductor would.
+type peerImporter struct {
+	h *Handoff
+}
+
+func (p *peerImporter) ImportTxPoolSnapshot(ctx context.Context, snapshot *SignedSnapshot) (uint64, error) {
+	return p.h.Import(ctx, snapshot)
+}
+
+func testTxs(n int) types.Transactions {
+	txs := make(types.Transactions, n)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i), Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(int64(i))})
+	}
+	return txs
+}
+
+func newTestHandoff(t *testing.T, serverID string, secret eth.Bytes32, pool TxPool, leader func() *consensus.ServerInfo, dial PeerDialer) *Handoff {
+	peers := map[string]string{"seq-1": "http://seq-1", "seq-2": "http://seq-2"}
+	return NewHandoff(testlog.Logger(t, log.LevelDebug), serverID, secret, pool, peers, leader, dial)
+}
+
+func TestSignedSnapshot(t *testing.T) {
+	secret := eth.Bytes32{0x01}
+	snapshot := &Snapshot{ServerID: "seq-1", Timestamp: 1000}
+	for _, tx := range testTxs(3) {
+		data, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		snapshot.Transactions = append(snapshot.Transactions, data)
+	}
+	signed := snapshot.Sign(secret)
+	require.NoError(t, signed.Verify(secret))
+	require.ErrorIs(t, signed.Verify(eth.Bytes32{0x02}), ErrInvalidMAC)
+
+	tampered := *signed
+	tampered.Timestamp++
+	require.ErrorIs(t, tampered.Verify(secret), ErrInvalidMAC)
+
+	tampered = *signed
+	tampered.Transactions = tampered.Transactions[1:]
+	require.ErrorIs(t, tampered.Verify(secret), ErrInvalidMAC)
+}
+
+func TestHandoff(t *testing.T) {
+	secret := eth.Bytes32{0x01}
+	leader := func(id string) func() *consensus.ServerInfo {
+		return func() *consensus.ServerInfo { return &consensus.ServerInfo{ID: id} }
+	}
+
+	t.Run("TransferToNewLeader", func(t *testing.T) {
+		oldPool := &fakeTxPool{txs: testTxs(4)}
+		newPool := &fakeTxPool{reject: map[uint64]bool{1: true}}
+		newLeader := newTestHandoff(t, "seq-2", secret, newPool, leader("seq-2"), nil)
+		var dialed string
+		dial := func(ctx context.Context, rpcAddr string) (SnapshotImporter, error) {
+			dialed = rpcAddr
+			return &peerImporter{h: newLeader}, nil
+		}
+		oldLeader := newTestHandoff(t, "seq-1", secret, oldPool, leader("seq-2"), dial)
+		require.NoError(t, oldLeader.Run(context.Background()))
+		require.Equal(t, "http://seq-2", dialed)
+		require.Len(t, newPool.sent, 3)
+		for i, nonce := range []uint64{0, 2, 3} {
+			require.Equal(t, oldPool.txs[nonce].Hash(), newPool.sent[i].Hash())
+		}
+	})
+
+	t.Run("NoNewLeader", func(t *testing.T) {
+		h := newTestHandoff(t, "seq-1", secret, &fakeTxPool{}, leader("seq-1"), nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, h.Run(ctx), ErrNewLeaderTimeout)
+	})
+
+	t.Run("UnknownLeader", func(t *testing.T) {
+		h := newTestHandoff(t, "seq-1", secret, &fakeTxPool{}, leader("seq-3"), nil)
+		require.ErrorIs(t, h.Run(context.Background()), ErrNoPeerRPC)
+	})
+
+	t.Run("InvalidMAC", func(t *testing.T) {
+		oldLeader := newTestHandoff(t, "seq-1", eth.Bytes32{0x02}, &fakeTxPool{txs: testTxs(2)}, leader("seq-2"), nil)
+		snapshot, err := oldLeader.Snapshot(context.Background())
+		require.NoError(t, err)
+		newPool := &fakeTxPool{}
+		newLeader := newTestHandoff(t, "seq-2", secret, newPool, leader("seq-2"), nil)
+		_, err = newLeader.Import(context.Background(), snapshot)
+		require.ErrorIs(t, err, ErrInvalidMAC)
+		require.Empty(t, newPool.sent)
+	})
+
+	t.Run("SnapshotTooOld", func(t *testing.T) {
+		oldLeader := newTestHandoff(t, "seq-1", secret, &fakeTxPool{txs: testTxs(2)}, leader("seq-2"), nil)
+		oldLeader.now = func() time.Time { return time.Now().Add(-MaxSnapshotAge - time.Minute) }
+		snapshot, err := oldLeader.Snapshot(context.Background())
+		require.NoError(t, err)
+		newPool := &fakeTxPool{}
+		newLeader := newTestHandoff(t, "seq-2", secret, newPool, leader("seq-2"), nil)
+		_, err = newLeader.Import(context.Background(), snapshot)
+		require.ErrorIs(t, err, ErrSnapshotTooOld)
+		require.Empty(t, newPool.sent)
+	})
+}
@@ -0,0 +1,67 @@
+package handoff
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+)
+
+// RPCTxPool is the TxPool of an execution engine, accessed through its RPC.
+type RPCTxPool struct {
+	rpc client.RPC
+}
+
+var _ TxPool = (*RPCTxPool)(nil)
+
+func NewRPCTxPool(rpc client.RPC) *RPCTxPool {
+	return &RPCTxPool{rpc: rpc}
+}
+
+// txsByNonce are the transactions of a sender, keyed by decimal nonce, as returned by txpool_content.
+type txsByNonce map[string]*types.Transaction
+
+// Transactions implements TxPool.
+func (p *RPCTxPool) Transactions(ctx context.Context) (types.Transactions, error) {
+	var content map[string]map[common.Address]txsByNonce
+	if err := p.rpc.CallContext(ctx, &content, "txpool_content"); err != nil {
+		return nil, err
+	}
+	var txs types.Transactions
+	for _, pool := range []string{"pending", "queued"} {
+		senders := content[pool]
+		addrs := make([]common.Address, 0, len(senders))
+		for addr := range senders {
+			addrs = append(addrs, addr)
+		}
+		slices.SortFunc(addrs, func(a, b common.Address) int { return a.Cmp(b) })
+		for _, addr := range addrs {
+			senderTxs := make(types.Transactions, 0, len(senders[addr]))
+			for nonce, tx := range senders[addr] {
+				if n, err := strconv.ParseUint(nonce, 10, 64); err != nil || n != tx.Nonce() {
+					return nil, fmt.Errorf("invalid nonce %q of tx %s", nonce, tx.Hash())
+				}
+				senderTxs = append(senderTxs, tx)
+			}
+			slices.SortFunc(senderTxs, func(a, b *types.Transaction) int { return cmp.Compare(a.Nonce(), b.Nonce()) })
+			txs = append(txs, senderTxs...)
+		}
+	}
+	return txs, nil
+}
+
+// SendTransaction implements TxPool.
+func (p *RPCTxPool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return p.rpc.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data))
+}
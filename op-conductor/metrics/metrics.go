@@ -19,6 +19,8 @@ type Metricer interface {
 	RecordStopSequencer(success bool)
 	RecordHealthCheck(success bool, err error)
 	RecordLoopExecutionTime(duration float64)
+	RecordLeadershipChange(leader bool, trigger string)
+	RecordLeadershipDuration(trigger string, duration float64)
 }
 
 // Metrics implementation must implement RegistryMetricer to allow the metrics server to work.
@@ -39,6 +41,9 @@ type Metrics struct {
 	stateChanges    *prometheus.CounterVec
 
 	loopExecutionTime prometheus.Histogram
+
+	leadershipChanges  *prometheus.CounterVec
+	leadershipDuration *prometheus.HistogramVec
 }
 
 func (m *Metrics) Registry() *prometheus.Registry {
@@ -103,6 +108,17 @@ func NewMetrics() *Metrics {
 			Help:      "Time (in seconds) to execute conductor loop iteration",
 			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
 		}),
+		leadershipChanges: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "leadership_changes_count",
+			Help:      "Number of leadership changes of this conductor, by trigger",
+		}, []string{"leader", "trigger"}),
+		leadershipDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "leadership_duration_seconds",
+			Help:      "Time (in seconds) this conductor was leader, by the trigger that ended the leadership",
+			Buckets:   []float64{60, 300, 900, 3600, 4 * 3600, 24 * 3600, 7 * 24 * 3600},
+		}, []string{"trigger"}),
 	}
 }
 
@@ -155,3 +171,13 @@ func (m *Metrics) RecordStopSequencer(success bool) {
 func (m *Metrics) RecordLoopExecutionTime(duration float64) {
 	m.loopExecutionTime.Observe(duration)
 }
+
+// RecordLeadershipChange increments the leadershipChanges counter.
+func (m *Metrics) RecordLeadershipChange(leader bool, trigger string) {
+	m.leadershipChanges.WithLabelValues(strconv.FormatBool(leader), trigger).Inc()
+}
+
+// RecordLeadershipDuration records how long this conductor was leader when it lost the leadership.
+func (m *Metrics) RecordLeadershipDuration(trigger string, duration float64) {
+	m.leadershipDuration.WithLabelValues(trigger).Observe(duration)
+}
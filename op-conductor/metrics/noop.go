@@ -4,11 +4,13 @@ type NoopMetricsImpl struct{}
 
 var NoopMetrics Metricer = new(NoopMetricsImpl)
 
-func (*NoopMetricsImpl) RecordInfo(version string)                                {}
-func (*NoopMetricsImpl) RecordUp()                                                {}
-func (*NoopMetricsImpl) RecordStateChange(leader bool, healthy bool, active bool) {}
-func (*NoopMetricsImpl) RecordLeaderTransfer(success bool)                        {}
-func (*NoopMetricsImpl) RecordStartSequencer(success bool)                        {}
-func (*NoopMetricsImpl) RecordStopSequencer(success bool)                         {}
-func (*NoopMetricsImpl) RecordHealthCheck(success bool, err error)                {}
-func (*NoopMetricsImpl) RecordLoopExecutionTime(duration float64)                 {}
+func (*NoopMetricsImpl) RecordInfo(version string)                                 {}
+func (*NoopMetricsImpl) RecordUp()                                                 {}
+func (*NoopMetricsImpl) RecordStateChange(leader bool, healthy bool, active bool)  {}
+func (*NoopMetricsImpl) RecordLeaderTransfer(success bool)                         {}
+func (*NoopMetricsImpl) RecordStartSequencer(success bool)                         {}
+func (*NoopMetricsImpl) RecordStopSequencer(success bool)                          {}
+func (*NoopMetricsImpl) RecordHealthCheck(success bool, err error)                 {}
+func (*NoopMetricsImpl) RecordLoopExecutionTime(duration float64)                  {}
+func (*NoopMetricsImpl) RecordLeadershipChange(leader bool, trigger string)        {}
+func (*NoopMetricsImpl) RecordLeadershipDuration(trigger string, duration float64) {}
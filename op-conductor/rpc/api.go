@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/handoff"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
@@ -36,6 +37,8 @@ type API interface {
 	Stopped(ctx context.Context) (bool, error)
 	// SequencerHealthy returns true if the sequencer is healthy.
 	SequencerHealthy(ctx context.Context) (bool, error)
+	// LeadershipHistory returns the leadership changes of this conductor with their triggers, oldest first.
+	LeadershipHistory(ctx context.Context) ([]LeadershipChange, error)
 
 	// Consensus related APIs
 	// Leader returns true if the server is the leader.
@@ -60,6 +63,11 @@ type API interface {
 	Active(ctx context.Context) (bool, error)
 	// CommitUnsafePayload commits an unsafe payload (latest head) to the consensus layer.
 	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error
+
+	// APIs called by other op-conductors
+	// ImportTxPoolSnapshot submits the transactions of the tx pool snapshot of the previous leader to the tx pool
+	// of the sequencer. It returns the number of transactions accepted by the tx pool.
+	ImportTxPoolSnapshot(ctx context.Context, snapshot *handoff.SignedSnapshot) (uint64, error)
 }
 
 // ExecutionProxyAPI defines the methods proxied to the execution 'eth_' rpc backend
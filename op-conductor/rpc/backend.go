@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/handoff"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -18,6 +19,7 @@ type conductor interface {
 	Paused() bool
 	Stopped() bool
 	SequencerHealthy(ctx context.Context) bool
+	LeadershipHistory(ctx context.Context) []LeadershipChange
 
 	Leader(ctx context.Context) bool
 	LeaderWithID(ctx context.Context) *consensus.ServerInfo
@@ -28,6 +30,7 @@ type conductor interface {
 	TransferLeaderToServer(ctx context.Context, id string, addr string) error
 	CommitUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error
 	ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error)
+	ImportTxPoolSnapshot(ctx context.Context, snapshot *handoff.SignedSnapshot) (uint64, error)
 }
 
 // APIBackend is the backend implementation of the API.
@@ -135,7 +138,17 @@ func (api *APIBackend) SequencerHealthy(ctx context.Context) (bool, error) {
 	return api.con.SequencerHealthy(ctx), nil
 }
 
+// LeadershipHistory implements API.
+func (api *APIBackend) LeadershipHistory(ctx context.Context) ([]LeadershipChange, error) {
+	return api.con.LeadershipHistory(ctx), nil
+}
+
 // ClusterMembership implements API.
 func (api *APIBackend) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	return api.con.ClusterMembership(ctx)
 }
+
+// ImportTxPoolSnapshot implements API.
+func (api *APIBackend) ImportTxPoolSnapshot(ctx context.Context, snapshot *handoff.SignedSnapshot) (uint64, error) {
+	return api.con.ImportTxPoolSnapshot(ctx, snapshot)
+}
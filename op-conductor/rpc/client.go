@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/handoff"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -131,9 +132,23 @@ func (c *APIClient) SequencerHealthy(ctx context.Context) (bool, error) {
 	return healthy, err
 }
 
+// LeadershipHistory implements API.
+func (c *APIClient) LeadershipHistory(ctx context.Context) ([]LeadershipChange, error) {
+	var history []LeadershipChange
+	err := c.c.CallContext(ctx, &history, prefixRPC("leadershipHistory"))
+	return history, err
+}
+
 // ClusterMembership implements API.
 func (c *APIClient) ClusterMembership(ctx context.Context) (*consensus.ClusterMembership, error) {
 	var clusterMembership consensus.ClusterMembership
 	err := c.c.CallContext(ctx, &clusterMembership, prefixRPC("clusterMembership"))
 	return &clusterMembership, err
 }
+
+// ImportTxPoolSnapshot implements API.
+func (c *APIClient) ImportTxPoolSnapshot(ctx context.Context, snapshot *handoff.SignedSnapshot) (uint64, error) {
+	var imported uint64
+	err := c.c.CallContext(ctx, &imported, prefixRPC("importTxPoolSnapshot"), snapshot)
+	return imported, err
+}
@@ -0,0 +1,24 @@
+package rpc
+
+import "time"
+
+// LeadershipTrigger is the cause of a leadership change.
+type LeadershipTrigger string
+
+const (
+	// TriggerElection is a leadership change decided by a raft election, e.g. because the previous leader became unreachable.
+	TriggerElection LeadershipTrigger = "election"
+	// TriggerHealthFailure is a leadership transfer initiated by the conductor because its sequencer became unhealthy.
+	TriggerHealthFailure LeadershipTrigger = "health_failure"
+	// TriggerManualTransfer is a leadership transfer requested through the admin API.
+	TriggerManualTransfer LeadershipTrigger = "manual_transfer"
+)
+
+// LeadershipChange is a change of the leadership status of a conductor.
+type LeadershipChange struct {
+	Time    time.Time         `json:"time"`
+	Leader  bool              `json:"leader"`
+	Trigger LeadershipTrigger `json:"trigger"`
+	// Reason is the health check error for health failures, empty otherwise.
+	Reason string `json:"reason,omitempty"`
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer"
 	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/bootstrap"
 	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/inspect"
+	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/simulate"
 	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/version"
 
 	opservice "github.com/ethereum-optimism/optimism/op-service"
@@ -59,6 +60,12 @@ func main() {
 			Usage:       "performs individual operations on a chain",
 			Subcommands: manage.Commands,
 		},
+		{
+			Name:   "simulate-params",
+			Usage:  "estimates fee levels, throughput, batcher costs and proof sizes of the chain parameters in a deploy config",
+			Flags:  cliapp.ProtectFlags(simulate.Flags),
+			Action: simulate.SimulateParamsCLI,
+		},
 	}
 	app.Writer = os.Stdout
 	app.ErrWriter = os.Stderr
@@ -11,6 +11,13 @@ const (
 	ChainIDFlagName      = "chain-id"
 	SystemConfigFlagName = "system-config"
 	RemoveFlagName       = "remove"
+
+	BatcherFlagName           = "batcher"
+	UnsafeBlockSignerFlagName = "unsafe-block-signer"
+	ProposerFlagName          = "proposer"
+	ChallengerFlagName        = "challenger"
+	PermissionedGameFlagName  = "permissioned-game"
+	OutfileFlagName           = "outfile"
 )
 
 var (
@@ -31,8 +38,57 @@ var (
 		Usage:   "Remove the dependency instead of adding it.",
 		EnvVars: deployer.PrefixEnvVar("REMOVE"),
 	}
+
+	SystemConfigFlagRoles = &cli.StringFlag{
+		Name:     SystemConfigFlagName,
+		Usage:    "The system config of the chain whose roles are being rotated.",
+		EnvVars:  deployer.PrefixEnvVar("SYSTEM_CONFIG"),
+		Required: true,
+	}
+	BatcherFlag = &cli.StringFlag{
+		Name:    BatcherFlagName,
+		Usage:   "The new batcher address. Left unchanged if not set.",
+		EnvVars: deployer.PrefixEnvVar("BATCHER"),
+	}
+	UnsafeBlockSignerFlag = &cli.StringFlag{
+		Name:    UnsafeBlockSignerFlagName,
+		Usage:   "The new unsafe block signer address. Left unchanged if not set.",
+		EnvVars: deployer.PrefixEnvVar("UNSAFE_BLOCK_SIGNER"),
+	}
+	ProposerFlag = &cli.StringFlag{
+		Name:    ProposerFlagName,
+		Usage:   "The new proposer address of the permissioned dispute game. Left unchanged if not set.",
+		EnvVars: deployer.PrefixEnvVar("PROPOSER"),
+	}
+	ChallengerFlag = &cli.StringFlag{
+		Name:    ChallengerFlagName,
+		Usage:   "The new challenger address of the permissioned dispute game. Left unchanged if not set.",
+		EnvVars: deployer.PrefixEnvVar("CHALLENGER"),
+	}
+	PermissionedGameFlag = &cli.StringFlag{
+		Name: PermissionedGameFlagName,
+		Usage: "The new PermissionedDisputeGame implementation, deployed with the new proposer and challenger. " +
+			"Required to rotate the proposer or challenger, since they are immutable arguments of the game.",
+		EnvVars: deployer.PrefixEnvVar("PERMISSIONED_GAME"),
+	}
+	OutfileFlag = &cli.StringFlag{
+		Name:  OutfileFlagName,
+		Usage: "output file. set to - to use stdout",
+		Value: "-",
+	}
 )
 
+var rolesFlags = []cli.Flag{
+	deployer.L1RPCURLFlag,
+	SystemConfigFlagRoles,
+	BatcherFlag,
+	UnsafeBlockSignerFlag,
+	ProposerFlag,
+	ChallengerFlag,
+	PermissionedGameFlag,
+	OutfileFlag,
+}
+
 var Commands = []*cli.Command{
 	{
 		Name:  "dependencies",
@@ -47,4 +103,16 @@ var Commands = []*cli.Command{
 		}),
 		Action: DependenciesCLI,
 	},
+	{
+		Name:   "rotate-roles",
+		Usage:  "Outputs Safe transaction bundles rotating the batcher, unsafe block signer, proposer and challenger of a chain.",
+		Flags:  cliapp.ProtectFlags(rolesFlags),
+		Action: RotateRolesCLI,
+	},
+	{
+		Name:   "verify-roles",
+		Usage:  "Verifies the batcher, unsafe block signer, proposer and challenger of a chain, and outputs its current roles.",
+		Flags:  cliapp.ProtectFlags(rolesFlags),
+		Action: VerifyRolesCLI,
+	},
 }
@@ -0,0 +1,433 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer"
+	"github.com/ethereum-optimism/optimism/op-service/ctxinterrupt"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/lmittmann/w3"
+	"github.com/urfave/cli/v2"
+)
+
+// permissionedGameType is the game type of the PermissionedDisputeGame, which holds the proposer and challenger roles.
+const permissionedGameType uint32 = 1
+
+var (
+	ownerFunc                = w3.MustNewFunc("owner()", "address")
+	batcherHashFunc          = w3.MustNewFunc("batcherHash()", "bytes32")
+	unsafeBlockSignerFunc    = w3.MustNewFunc("unsafeBlockSigner()", "address")
+	disputeGameFactoryFunc   = w3.MustNewFunc("disputeGameFactory()", "address")
+	gameImplsFunc            = w3.MustNewFunc("gameImpls(uint32)", "address")
+	gameTypeFunc             = w3.MustNewFunc("gameType()", "uint32")
+	proposerFunc             = w3.MustNewFunc("proposer()", "address")
+	challengerFunc           = w3.MustNewFunc("challenger()", "address")
+	setBatcherHashFunc       = w3.MustNewFunc("setBatcherHash(bytes32)", "")
+	setUnsafeBlockSignerFunc = w3.MustNewFunc("setUnsafeBlockSigner(address)", "")
+	setImplementationFunc    = w3.MustNewFunc("setImplementation(uint32,address)", "")
+)
+
+// Roles are the privileged addresses of a chain that can be rotated.
+// A zero address means the role is not set, or, when used as rotation target, left unchanged.
+type Roles struct {
+	Batcher           common.Address `json:"batcher"`
+	UnsafeBlockSigner common.Address `json:"unsafeBlockSigner"`
+	Proposer          common.Address `json:"proposer"`
+	Challenger        common.Address `json:"challenger"`
+}
+
+// ChainRoles are the roles of a chain, along with the contracts that hold them and the owners of those contracts.
+type ChainRoles struct {
+	Roles
+
+	SystemConfig            common.Address `json:"systemConfig"`
+	SystemConfigOwner       common.Address `json:"systemConfigOwner"`
+	DisputeGameFactory      common.Address `json:"disputeGameFactory"`
+	DisputeGameFactoryOwner common.Address `json:"disputeGameFactoryOwner"`
+	PermissionedGame        common.Address `json:"permissionedGame"`
+}
+
+// ReadChainRoles reads the current roles of the chain with the given SystemConfig.
+func ReadChainRoles(ctx context.Context, client *ethclient.Client, systemConfig common.Address) (*ChainRoles, error) {
+	out := &ChainRoles{SystemConfig: systemConfig}
+	var batcherHash common.Hash
+	type call struct {
+		name string
+		fn   *w3.Func
+		args []any
+		res  any
+	}
+	for _, c := range []call{
+		{"SystemConfig owner", ownerFunc, nil, &out.SystemConfigOwner},
+		{"batcher hash", batcherHashFunc, nil, &batcherHash},
+		{"unsafe block signer", unsafeBlockSignerFunc, nil, &out.UnsafeBlockSigner},
+		{"dispute game factory", disputeGameFactoryFunc, nil, &out.DisputeGameFactory},
+	} {
+		if err := callContract(ctx, client, systemConfig, c.fn, c.args, c.res); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", c.name, err)
+		}
+	}
+	out.Batcher = common.BytesToAddress(batcherHash.Bytes())
+
+	if out.DisputeGameFactory == (common.Address{}) {
+		// Chains without fault proofs have no proposer and challenger roles to rotate.
+		return out, nil
+	}
+	for _, c := range []call{
+		{"DisputeGameFactory owner", ownerFunc, nil, &out.DisputeGameFactoryOwner},
+		{"permissioned game", gameImplsFunc, []any{permissionedGameType}, &out.PermissionedGame},
+	} {
+		if err := callContract(ctx, client, out.DisputeGameFactory, c.fn, c.args, c.res); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", c.name, err)
+		}
+	}
+
+	if out.PermissionedGame != (common.Address{}) {
+		proposer, challenger, err := readGameRoles(ctx, client, out.PermissionedGame)
+		if err != nil {
+			return nil, err
+		}
+		out.Proposer = proposer
+		out.Challenger = challenger
+	}
+	return out, nil
+}
+
+// readGameRoles reads the proposer and challenger of a PermissionedDisputeGame implementation.
+func readGameRoles(ctx context.Context, client *ethclient.Client, game common.Address) (common.Address, common.Address, error) {
+	var gameType uint32
+	if err := callContract(ctx, client, game, gameTypeFunc, nil, &gameType); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to read game type of %s: %w", game, err)
+	}
+	if gameType != permissionedGameType {
+		return common.Address{}, common.Address{}, fmt.Errorf("game %s has game type %d, expected permissioned game type %d", game, gameType, permissionedGameType)
+	}
+	var proposer, challenger common.Address
+	if err := callContract(ctx, client, game, proposerFunc, nil, &proposer); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to read proposer of %s: %w", game, err)
+	}
+	if err := callContract(ctx, client, game, challengerFunc, nil, &challenger); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to read challenger of %s: %w", game, err)
+	}
+	return proposer, challenger, nil
+}
+
+func callContract(ctx context.Context, client *ethclient.Client, to common.Address, fn *w3.Func, args []any, result any) error {
+	calldata, err := fn.EncodeArgs(args...)
+	if err != nil {
+		return fmt.Errorf("failed to encode call: %w", err)
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: calldata}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to call contract: %w", err)
+	}
+	if err := fn.DecodeReturns(out, result); err != nil {
+		return fmt.Errorf("failed to decode result: %w", err)
+	}
+	return nil
+}
+
+// SafeTx is a transaction in the Safe Transaction Builder batch format.
+type SafeTx struct {
+	To    common.Address `json:"to"`
+	Value string         `json:"value"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+type SafeBundleMeta struct {
+	Name                   string         `json:"name"`
+	Description            string         `json:"description"`
+	CreatedFromSafeAddress common.Address `json:"createdFromSafeAddress"`
+}
+
+// SafeBundle is a batch of transactions to be executed by the Safe owning the contracts, in the format
+// accepted by the Safe Transaction Builder.
+type SafeBundle struct {
+	Version      string         `json:"version"`
+	ChainID      string         `json:"chainId"`
+	CreatedAt    uint64         `json:"createdAt"`
+	Meta         SafeBundleMeta `json:"meta"`
+	Transactions []SafeTx       `json:"transactions"`
+}
+
+// RotationTarget are the new roles of a chain. Zero addresses leave the role unchanged.
+// Since the proposer and challenger are immutable arguments of the PermissionedDisputeGame,
+// rotating them requires a new PermissionedGame implementation with the new roles to be deployed first.
+type RotationTarget struct {
+	Roles
+	PermissionedGame common.Address
+}
+
+// BuildRotation creates the Safe bundles that rotate the roles of the chain to the target roles,
+// one bundle per owner of the affected contracts. newGameRoles are the roles of the target
+// PermissionedGame implementation, if any.
+func BuildRotation(chainID *big.Int, createdAt time.Time, current *ChainRoles, target RotationTarget, newGameRoles Roles) ([]SafeBundle, error) {
+	type ownedTx struct {
+		owner common.Address
+		tx    SafeTx
+	}
+	var txs []ownedTx
+	var descriptions []string
+	add := func(owner common.Address, to common.Address, fn *w3.Func, description string, args ...any) error {
+		data, err := fn.EncodeArgs(args...)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", description, err)
+		}
+		txs = append(txs, ownedTx{owner: owner, tx: SafeTx{To: to, Value: "0", Data: data}})
+		descriptions = append(descriptions, description)
+		return nil
+	}
+
+	if target.Batcher != (common.Address{}) && target.Batcher != current.Batcher {
+		batcherHash := common.BytesToHash(target.Batcher.Bytes())
+		if err := add(current.SystemConfigOwner, current.SystemConfig, setBatcherHashFunc,
+			fmt.Sprintf("rotate batcher from %s to %s", current.Batcher, target.Batcher), batcherHash); err != nil {
+			return nil, err
+		}
+	}
+	if target.UnsafeBlockSigner != (common.Address{}) && target.UnsafeBlockSigner != current.UnsafeBlockSigner {
+		if err := add(current.SystemConfigOwner, current.SystemConfig, setUnsafeBlockSignerFunc,
+			fmt.Sprintf("rotate unsafe block signer from %s to %s", current.UnsafeBlockSigner, target.UnsafeBlockSigner), target.UnsafeBlockSigner); err != nil {
+			return nil, err
+		}
+	}
+
+	rotateGame := target.Proposer != (common.Address{}) || target.Challenger != (common.Address{})
+	if rotateGame {
+		if target.PermissionedGame == (common.Address{}) {
+			return nil, fmt.Errorf("rotating the proposer or challenger requires a new permissioned game implementation")
+		}
+		if current.DisputeGameFactory == (common.Address{}) {
+			return nil, fmt.Errorf("chain has no dispute game factory")
+		}
+		// The new implementation must carry the target roles, and keep the roles that are not rotated.
+		expectProposer := target.Proposer
+		if expectProposer == (common.Address{}) {
+			expectProposer = current.Proposer
+		}
+		expectChallenger := target.Challenger
+		if expectChallenger == (common.Address{}) {
+			expectChallenger = current.Challenger
+		}
+		if newGameRoles.Proposer != expectProposer {
+			return nil, fmt.Errorf("permissioned game %s has proposer %s, expected %s", target.PermissionedGame, newGameRoles.Proposer, expectProposer)
+		}
+		if newGameRoles.Challenger != expectChallenger {
+			return nil, fmt.Errorf("permissioned game %s has challenger %s, expected %s", target.PermissionedGame, newGameRoles.Challenger, expectChallenger)
+		}
+		if err := add(current.DisputeGameFactoryOwner, current.DisputeGameFactory, setImplementationFunc,
+			fmt.Sprintf("set permissioned game implementation to %s (proposer %s, challenger %s)", target.PermissionedGame, expectProposer, expectChallenger),
+			permissionedGameType, target.PermissionedGame); err != nil {
+			return nil, err
+		}
+	}
+
+	var bundles []SafeBundle
+	for i, owned := range txs {
+		idx := -1
+		for j := range bundles {
+			if bundles[j].Meta.CreatedFromSafeAddress == owned.owner {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			bundles = append(bundles, SafeBundle{
+				Version:   "1.0",
+				ChainID:   chainID.String(),
+				CreatedAt: uint64(createdAt.UnixMilli()),
+				Meta: SafeBundleMeta{
+					Name:                   "Role rotation",
+					CreatedFromSafeAddress: owned.owner,
+				},
+			})
+			idx = len(bundles) - 1
+		}
+		bundle := &bundles[idx]
+		bundle.Transactions = append(bundle.Transactions, owned.tx)
+		if bundle.Meta.Description != "" {
+			bundle.Meta.Description += "; "
+		}
+		bundle.Meta.Description += descriptions[i]
+	}
+	return bundles, nil
+}
+
+// VerifyRoles checks that the roles of the chain match the expected roles. Zero addresses are not checked.
+func VerifyRoles(current *ChainRoles, expected Roles) error {
+	var mismatches []string
+	check := func(name string, actual common.Address, expected common.Address) {
+		if expected != (common.Address{}) && actual != expected {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %s, expected %s", name, actual, expected))
+		}
+	}
+	check("batcher", current.Batcher, expected.Batcher)
+	check("unsafe block signer", current.UnsafeBlockSigner, expected.UnsafeBlockSigner)
+	check("proposer", current.Proposer, expected.Proposer)
+	check("challenger", current.Challenger, expected.Challenger)
+	if len(mismatches) > 0 {
+		return fmt.Errorf("roles do not match: %s", strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+type RolesConfig struct {
+	L1RPCUrl     string
+	Logger       log.Logger
+	SystemConfig common.Address
+	Target       RotationTarget
+	Outfile      string
+}
+
+func (c *RolesConfig) Check() error {
+	if c.L1RPCUrl == "" {
+		return fmt.Errorf("l1RPCUrl must be specified")
+	}
+
+	if c.Logger == nil {
+		return fmt.Errorf("logger must be specified")
+	}
+
+	if c.SystemConfig == (common.Address{}) {
+		return fmt.Errorf("system config must be specified")
+	}
+
+	if c.Outfile == "" {
+		return fmt.Errorf("outfile must be specified")
+	}
+
+	return nil
+}
+
+func readRolesConfig(cliCtx *cli.Context) RolesConfig {
+	logCfg := oplog.ReadCLIConfig(cliCtx)
+	l := oplog.NewLogger(oplog.AppOut(cliCtx), logCfg)
+	oplog.SetGlobalLogHandler(l.Handler())
+
+	return RolesConfig{
+		L1RPCUrl:     cliCtx.String(deployer.L1RPCURLFlagName),
+		Logger:       l,
+		SystemConfig: common.HexToAddress(cliCtx.String(SystemConfigFlagName)),
+		Target: RotationTarget{
+			Roles: Roles{
+				Batcher:           parseOptionalAddress(cliCtx.String(BatcherFlagName)),
+				UnsafeBlockSigner: parseOptionalAddress(cliCtx.String(UnsafeBlockSignerFlagName)),
+				Proposer:          parseOptionalAddress(cliCtx.String(ProposerFlagName)),
+				Challenger:        parseOptionalAddress(cliCtx.String(ChallengerFlagName)),
+			},
+			PermissionedGame: parseOptionalAddress(cliCtx.String(PermissionedGameFlagName)),
+		},
+		Outfile: cliCtx.String(OutfileFlagName),
+	}
+}
+
+func parseOptionalAddress(s string) common.Address {
+	if s == "" {
+		return common.Address{}
+	}
+	return common.HexToAddress(s)
+}
+
+func RotateRolesCLI(cliCtx *cli.Context) error {
+	cfg := readRolesConfig(cliCtx)
+	ctx := ctxinterrupt.WithCancelOnInterrupt(cliCtx.Context)
+	return RotateRoles(ctx, cfg)
+}
+
+// RotateRoles writes the Safe bundles that rotate the roles of the chain to the outfile.
+// The bundles are not executed, they must be signed and executed by the owners of the contracts.
+func RotateRoles(ctx context.Context, cfg RolesConfig) error {
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	lgr := cfg.Logger
+
+	l1Client, err := ethclient.DialContext(ctx, cfg.L1RPCUrl)
+	if err != nil {
+		return fmt.Errorf("failed to connect to L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+
+	chainID, err := l1Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	current, err := ReadChainRoles(ctx, l1Client, cfg.SystemConfig)
+	if err != nil {
+		return err
+	}
+	lgr.Info("current roles", "batcher", current.Batcher, "unsafeBlockSigner", current.UnsafeBlockSigner,
+		"proposer", current.Proposer, "challenger", current.Challenger)
+
+	var newGameRoles Roles
+	if cfg.Target.PermissionedGame != (common.Address{}) {
+		newGameRoles.Proposer, newGameRoles.Challenger, err = readGameRoles(ctx, l1Client, cfg.Target.PermissionedGame)
+		if err != nil {
+			return err
+		}
+	}
+
+	bundles, err := BuildRotation(chainID, time.Now(), current, cfg.Target, newGameRoles)
+	if err != nil {
+		return err
+	}
+	if len(bundles) == 0 {
+		lgr.Info("roles are already up to date")
+	}
+	for _, bundle := range bundles {
+		lgr.Info("created safe bundle", "safe", bundle.Meta.CreatedFromSafeAddress, "txs", len(bundle.Transactions), "description", bundle.Meta.Description)
+	}
+
+	if err := jsonutil.WriteJSON(bundles, ioutil.ToStdOutOrFileOrNoop(cfg.Outfile, 0o666)); err != nil {
+		return fmt.Errorf("failed to write safe bundles: %w", err)
+	}
+	return nil
+}
+
+func VerifyRolesCLI(cliCtx *cli.Context) error {
+	cfg := readRolesConfig(cliCtx)
+	ctx := ctxinterrupt.WithCancelOnInterrupt(cliCtx.Context)
+	return VerifyRolesOnChain(ctx, cfg)
+}
+
+// VerifyRolesOnChain checks that the roles of the chain were rotated to the target roles,
+// and writes the current roles of the chain to the outfile.
+func VerifyRolesOnChain(ctx context.Context, cfg RolesConfig) error {
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+
+	l1Client, err := ethclient.DialContext(ctx, cfg.L1RPCUrl)
+	if err != nil {
+		return fmt.Errorf("failed to connect to L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+
+	current, err := ReadChainRoles(ctx, l1Client, cfg.SystemConfig)
+	if err != nil {
+		return err
+	}
+	if err := jsonutil.WriteJSON(current, ioutil.ToStdOutOrFileOrNoop(cfg.Outfile, 0o666)); err != nil {
+		return fmt.Errorf("failed to write roles: %w", err)
+	}
+	if cfg.Target.PermissionedGame != (common.Address{}) && current.PermissionedGame != cfg.Target.PermissionedGame {
+		return fmt.Errorf("permissioned game is %s, expected %s", current.PermissionedGame, cfg.Target.PermissionedGame)
+	}
+	if err := VerifyRoles(current, cfg.Target.Roles); err != nil {
+		return err
+	}
+	cfg.Logger.Info("roles verified")
+	return nil
+}
@@ -0,0 +1,110 @@
+package manage
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func testChainRoles() *ChainRoles {
+	return &ChainRoles{
+		Roles: Roles{
+			Batcher:           common.Address{0x01},
+			UnsafeBlockSigner: common.Address{0x02},
+			Proposer:          common.Address{0x03},
+			Challenger:        common.Address{0x04},
+		},
+		SystemConfig:            common.Address{0xa1},
+		SystemConfigOwner:       common.Address{0xa2},
+		DisputeGameFactory:      common.Address{0xb1},
+		DisputeGameFactoryOwner: common.Address{0xb2},
+		PermissionedGame:        common.Address{0xb3},
+	}
+}
+
+func TestBuildRotation(t *testing.T) {
+	chainID := big.NewInt(1)
+	createdAt := time.Unix(1000, 0)
+
+	t.Run("NoChanges", func(t *testing.T) {
+		current := testChainRoles()
+		bundles, err := BuildRotation(chainID, createdAt, current, RotationTarget{Roles: current.Roles}, Roles{})
+		require.NoError(t, err)
+		require.Empty(t, bundles)
+	})
+
+	t.Run("SystemConfigRoles", func(t *testing.T) {
+		current := testChainRoles()
+		target := RotationTarget{Roles: Roles{Batcher: common.Address{0x11}, UnsafeBlockSigner: common.Address{0x12}}}
+		bundles, err := BuildRotation(chainID, createdAt, current, target, Roles{})
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		bundle := bundles[0]
+		require.Equal(t, "1", bundle.ChainID)
+		require.Equal(t, uint64(1000_000), bundle.CreatedAt)
+		require.Equal(t, current.SystemConfigOwner, bundle.Meta.CreatedFromSafeAddress)
+		require.Len(t, bundle.Transactions, 2)
+
+		expectedBatcherData, err := setBatcherHashFunc.EncodeArgs(common.BytesToHash(target.Batcher.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, current.SystemConfig, bundle.Transactions[0].To)
+		require.Equal(t, expectedBatcherData, []byte(bundle.Transactions[0].Data))
+
+		expectedSignerData, err := setUnsafeBlockSignerFunc.EncodeArgs(target.UnsafeBlockSigner)
+		require.NoError(t, err)
+		require.Equal(t, current.SystemConfig, bundle.Transactions[1].To)
+		require.Equal(t, expectedSignerData, []byte(bundle.Transactions[1].Data))
+	})
+
+	t.Run("GameRolesRequireNewImplementation", func(t *testing.T) {
+		current := testChainRoles()
+		target := RotationTarget{Roles: Roles{Proposer: common.Address{0x13}}}
+		_, err := BuildRotation(chainID, createdAt, current, target, Roles{})
+		require.ErrorContains(t, err, "requires a new permissioned game implementation")
+	})
+
+	t.Run("GameImplementationRolesMismatch", func(t *testing.T) {
+		current := testChainRoles()
+		target := RotationTarget{Roles: Roles{Proposer: common.Address{0x13}}, PermissionedGame: common.Address{0xc1}}
+		// The challenger is not rotated, so the new implementation must keep the current challenger.
+		_, err := BuildRotation(chainID, createdAt, current, target, Roles{Proposer: common.Address{0x13}, Challenger: common.Address{0x14}})
+		require.ErrorContains(t, err, "has challenger")
+	})
+
+	t.Run("AllRoles", func(t *testing.T) {
+		current := testChainRoles()
+		target := RotationTarget{
+			Roles: Roles{
+				Batcher:           common.Address{0x11},
+				UnsafeBlockSigner: common.Address{0x12},
+				Proposer:          common.Address{0x13},
+				Challenger:        common.Address{0x14},
+			},
+			PermissionedGame: common.Address{0xc1},
+		}
+		bundles, err := BuildRotation(chainID, createdAt, current, target, Roles{Proposer: common.Address{0x13}, Challenger: common.Address{0x14}})
+		require.NoError(t, err)
+		require.Len(t, bundles, 2)
+		require.Equal(t, current.SystemConfigOwner, bundles[0].Meta.CreatedFromSafeAddress)
+		require.Len(t, bundles[0].Transactions, 2)
+		require.Equal(t, current.DisputeGameFactoryOwner, bundles[1].Meta.CreatedFromSafeAddress)
+		require.Len(t, bundles[1].Transactions, 1)
+
+		expectedData, err := setImplementationFunc.EncodeArgs(permissionedGameType, target.PermissionedGame)
+		require.NoError(t, err)
+		require.Equal(t, current.DisputeGameFactory, bundles[1].Transactions[0].To)
+		require.Equal(t, expectedData, []byte(bundles[1].Transactions[0].Data))
+	})
+}
+
+func TestVerifyRoles(t *testing.T) {
+	current := testChainRoles()
+	require.NoError(t, VerifyRoles(current, current.Roles))
+	require.NoError(t, VerifyRoles(current, Roles{Batcher: current.Batcher}))
+	err := VerifyRoles(current, Roles{Batcher: common.Address{0x11}, Challenger: common.Address{0x14}})
+	require.ErrorContains(t, err, "batcher is")
+	require.ErrorContains(t, err, "challenger is")
+}
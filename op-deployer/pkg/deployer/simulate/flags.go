@@ -0,0 +1,80 @@
+package simulate
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	DeployConfigFlagName  = "deploy-config"
+	DATypeFlagName        = "da-type"
+	UtilizationFlagName   = "utilization"
+	TxGasFlagName         = "tx-gas"
+	TxSizeFlagName        = "tx-size"
+	L1BaseFeeFlagName     = "l1-base-fee"
+	L1BlobBaseFeeFlagName = "l1-blob-base-fee"
+	OutfileFlagName       = "outfile"
+)
+
+var (
+	DeployConfigFlag = &cli.StringFlag{
+		Name:     DeployConfigFlagName,
+		Usage:    "Path of the deploy config with the chain parameters to simulate.",
+		EnvVars:  deployer.PrefixEnvVar("DEPLOY_CONFIG"),
+		Required: true,
+	}
+	DATypeFlag = &cli.StringFlag{
+		Name:    DATypeFlagName,
+		Usage:   fmt.Sprintf("Data availability type of the batcher. Options: %s, %s", DATypeBlobs, DATypeCalldata),
+		EnvVars: deployer.PrefixEnvVar("DA_TYPE"),
+		Value:   string(DATypeBlobs),
+	}
+	UtilizationFlag = &cli.Float64Flag{
+		Name:    UtilizationFlagName,
+		Usage:   "Fraction of the block gas limit used by the average L2 block.",
+		EnvVars: deployer.PrefixEnvVar("UTILIZATION"),
+		Value:   0.5,
+	}
+	TxGasFlag = &cli.Uint64Flag{
+		Name:    TxGasFlagName,
+		Usage:   "Gas used by the average L2 transaction.",
+		EnvVars: deployer.PrefixEnvVar("TX_GAS"),
+		Value:   70_000,
+	}
+	TxSizeFlag = &cli.Uint64Flag{
+		Name:    TxSizeFlagName,
+		Usage:   "Compressed size in bytes of the average L2 transaction.",
+		EnvVars: deployer.PrefixEnvVar("TX_SIZE"),
+		Value:   120,
+	}
+	L1BaseFeeFlag = &cli.Float64Flag{
+		Name:    L1BaseFeeFlagName,
+		Usage:   "Assumed L1 base fee in gwei.",
+		EnvVars: deployer.PrefixEnvVar("L1_BASE_FEE"),
+		Value:   10,
+	}
+	L1BlobBaseFeeFlag = &cli.Float64Flag{
+		Name:    L1BlobBaseFeeFlagName,
+		Usage:   "Assumed L1 blob base fee in gwei.",
+		EnvVars: deployer.PrefixEnvVar("L1_BLOB_BASE_FEE"),
+		Value:   1,
+	}
+	OutfileFlag = &cli.StringFlag{
+		Name:  OutfileFlagName,
+		Usage: "output file. set to - to use stdout",
+		Value: "-",
+	}
+)
+
+var Flags = []cli.Flag{
+	DeployConfigFlag,
+	DATypeFlag,
+	UtilizationFlag,
+	TxGasFlag,
+	TxSizeFlag,
+	L1BaseFeeFlag,
+	L1BlobBaseFeeFlag,
+	OutfileFlag,
+}
@@ -0,0 +1,212 @@
+package simulate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+)
+
+type DAType string
+
+const (
+	DATypeBlobs    DAType = "blobs"
+	DATypeCalldata DAType = "calldata"
+)
+
+const (
+	// maxCalldataTxSize is the default maximum size of a calldata batcher transaction.
+	maxCalldataTxSize = 120_000
+	gweiPerEther      = 1e9
+	secondsPerHour    = 3600
+)
+
+func SimulateParamsCLI(cliCtx *cli.Context) error {
+	deployConfig, err := genesis.NewDeployConfig(cliCtx.String(DeployConfigFlagName))
+	if err != nil {
+		return err
+	}
+	p := ParamsFromDeployConfig(deployConfig)
+	p.DAType = DAType(cliCtx.String(DATypeFlagName))
+	p.Utilization = cliCtx.Float64(UtilizationFlagName)
+	p.TxGas = cliCtx.Uint64(TxGasFlagName)
+	p.TxSize = cliCtx.Uint64(TxSizeFlagName)
+	p.L1BaseFee = cliCtx.Float64(L1BaseFeeFlagName)
+	p.L1BlobBaseFee = cliCtx.Float64(L1BlobBaseFeeFlagName)
+
+	report, err := Simulate(p)
+	if err != nil {
+		return fmt.Errorf("failed to simulate chain parameters: %w", err)
+	}
+	if err := jsonutil.WriteJSON(report, ioutil.ToStdOutOrFileOrNoop(cliCtx.String(OutfileFlagName), 0o666)); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// Params are the chain parameters and the assumed workload that the chain is simulated with.
+type Params struct {
+	GasLimit          uint64
+	BlockTime         uint64
+	Elasticity        uint64
+	Denominator       uint64
+	BaseFeeScalar     uint32
+	BlobBaseFeeScalar uint32
+	// MaxGameDepth and SplitDepth of the fault dispute game. Proof sizes are not reported if MaxGameDepth is 0.
+	MaxGameDepth uint64
+	SplitDepth   uint64
+
+	DAType DAType
+	// Utilization is the fraction of the gas limit used by the average L2 block.
+	Utilization float64
+	// TxGas is the gas used by the average L2 transaction.
+	TxGas uint64
+	// TxSize is the compressed size in bytes of the average L2 transaction.
+	TxSize uint64
+	// L1BaseFee and L1BlobBaseFee are the assumed L1 fees in gwei.
+	L1BaseFee     float64
+	L1BlobBaseFee float64
+}
+
+// ParamsFromDeployConfig reads the chain parameters of the deploy config. The workload is left unset.
+func ParamsFromDeployConfig(cfg *genesis.DeployConfig) Params {
+	denominator := cfg.EIP1559DenominatorCanyon
+	if denominator == 0 {
+		denominator = cfg.EIP1559Denominator
+	}
+	return Params{
+		GasLimit:          uint64(cfg.L2GenesisBlockGasLimit),
+		BlockTime:         cfg.L2BlockTime,
+		Elasticity:        cfg.EIP1559Elasticity,
+		Denominator:       denominator,
+		BaseFeeScalar:     cfg.GasPriceOracleBaseFeeScalar,
+		BlobBaseFeeScalar: cfg.GasPriceOracleBlobBaseFeeScalar,
+		MaxGameDepth:      cfg.FaultGameMaxDepth,
+		SplitDepth:        cfg.FaultGameSplitDepth,
+	}
+}
+
+func (p Params) Check() error {
+	if p.GasLimit == 0 {
+		return errors.New("gas limit must be set")
+	}
+	if p.BlockTime == 0 {
+		return errors.New("block time must be set")
+	}
+	if p.Elasticity == 0 || p.Denominator == 0 {
+		return errors.New("EIP-1559 elasticity and denominator must be set")
+	}
+	if p.DAType != DATypeBlobs && p.DAType != DATypeCalldata {
+		return fmt.Errorf("unknown DA type: %q", p.DAType)
+	}
+	if p.Utilization < 0 || p.Utilization > 1 {
+		return fmt.Errorf("utilization must be between 0 and 1: %v", p.Utilization)
+	}
+	if p.TxGas == 0 {
+		return errors.New("tx gas must be set")
+	}
+	if p.L1BaseFee < 0 || p.L1BlobBaseFee < 0 {
+		return errors.New("L1 fees must not be negative")
+	}
+	if p.MaxGameDepth != 0 && p.SplitDepth >= p.MaxGameDepth {
+		return fmt.Errorf("split depth %d must be less than max game depth %d", p.SplitDepth, p.MaxGameDepth)
+	}
+	return nil
+}
+
+// Report is the expected outcome of the chain parameters under the simulated workload.
+type Report struct {
+	GasTarget    uint64  `json:"gasTarget"`
+	TxsPerBlock  float64 `json:"txsPerBlock"`
+	TxsPerSecond float64 `json:"txsPerSecond"`
+	GasPerSecond float64 `json:"gasPerSecond"`
+
+	// BaseFeeChangePerBlock is the relative change of the L2 base fee per block under the workload.
+	BaseFeeChangePerBlock float64 `json:"baseFeeChangePerBlock"`
+	// BaseFeeMaxIncreasePerBlock and BaseFeeMaxDecreasePerBlock bound the relative change of the base fee per block.
+	BaseFeeMaxIncreasePerBlock float64 `json:"baseFeeMaxIncreasePerBlock"`
+	BaseFeeMaxDecreasePerBlock float64 `json:"baseFeeMaxDecreasePerBlock"`
+	// BaseFeeDoublingSeconds is the time for the base fee to double under the workload, 0 if it does not increase.
+	BaseFeeDoublingSeconds float64 `json:"baseFeeDoublingSeconds,omitempty"`
+	// BaseFeeHalvingSeconds is the time for the base fee to halve under the workload, 0 if it does not decrease.
+	BaseFeeHalvingSeconds float64 `json:"baseFeeHalvingSeconds,omitempty"`
+
+	// L1DataFeePerTx is the L1 data fee (gwei) charged to the average transaction.
+	L1DataFeePerTx float64 `json:"l1DataFeePerTx"`
+
+	BatchBytesPerHour float64 `json:"batchBytesPerHour"`
+	BatcherTxsPerHour float64 `json:"batcherTxsPerHour"`
+	BlobsPerHour      float64 `json:"blobsPerHour"`
+	// BatcherCostPerHour is the L1 cost (ETH) of posting the batch data.
+	BatcherCostPerHour float64 `json:"batcherCostPerHour"`
+	// L1FeeRevenuePerHour is the sum of the L1 data fees (ETH) charged to L2 transactions.
+	L1FeeRevenuePerHour float64 `json:"l1FeeRevenuePerHour"`
+	// BatcherMargin is the ratio of L1 fee revenue to batcher cost. Below 1, the chain operator subsidizes data costs.
+	BatcherMargin float64 `json:"batcherMargin"`
+
+	OutputBisectionDepth uint64 `json:"outputBisectionDepth,omitempty"`
+	ExecutionTraceDepth  uint64 `json:"executionTraceDepth,omitempty"`
+	// MaxGameMoves is the number of moves from the root claim to a step at the maximum game depth.
+	MaxGameMoves uint64 `json:"maxGameMoves,omitempty"`
+}
+
+// Simulate runs a steady-state model of the chain under the workload of the params.
+// The model is deliberately simple: it assumes constant utilization and L1 fees,
+// and that the batcher fills every blob or calldata transaction.
+func Simulate(p Params) (*Report, error) {
+	if err := p.Check(); err != nil {
+		return nil, err
+	}
+	r := &Report{GasTarget: p.GasLimit / p.Elasticity}
+
+	gasPerBlock := p.Utilization * float64(p.GasLimit)
+	r.TxsPerBlock = gasPerBlock / float64(p.TxGas)
+	r.TxsPerSecond = r.TxsPerBlock / float64(p.BlockTime)
+	r.GasPerSecond = gasPerBlock / float64(p.BlockTime)
+
+	target := float64(r.GasTarget)
+	r.BaseFeeMaxIncreasePerBlock = float64(p.Elasticity-1) / float64(p.Denominator)
+	r.BaseFeeMaxDecreasePerBlock = 1 / float64(p.Denominator)
+	r.BaseFeeChangePerBlock = (gasPerBlock - target) / target / float64(p.Denominator)
+	if change := r.BaseFeeChangePerBlock; change > 0 {
+		r.BaseFeeDoublingSeconds = math.Ln2 / math.Log1p(change) * float64(p.BlockTime)
+	} else if change < 0 {
+		r.BaseFeeHalvingSeconds = -math.Ln2 / math.Log1p(change) * float64(p.BlockTime)
+	}
+
+	// Ecotone L1 data fee: the scalars weigh the L1 base fee and blob base fee per compressed byte.
+	weightedGasPrice := 16*float64(p.BaseFeeScalar)*p.L1BaseFee + float64(p.BlobBaseFeeScalar)*p.L1BlobBaseFee
+	r.L1DataFeePerTx = float64(p.TxSize) * weightedGasPrice / 16e6
+
+	txsPerHour := r.TxsPerSecond * secondsPerHour
+	r.BatchBytesPerHour = txsPerHour * float64(p.TxSize)
+	var costGwei float64
+	switch p.DAType {
+	case DATypeBlobs:
+		r.BlobsPerHour = r.BatchBytesPerHour / eth.MaxBlobDataSize
+		r.BatcherTxsPerHour = r.BlobsPerHour / eth.MaxBlobsPerBlobTx
+		costGwei = r.BlobsPerHour * params.BlobTxBlobGasPerBlob * p.L1BlobBaseFee
+	case DATypeCalldata:
+		r.BatcherTxsPerHour = r.BatchBytesPerHour / maxCalldataTxSize
+		costGwei = r.BatchBytesPerHour * float64(params.TxDataNonZeroGasEIP2028) * p.L1BaseFee
+	}
+	costGwei += r.BatcherTxsPerHour * float64(params.TxGas) * p.L1BaseFee
+	r.BatcherCostPerHour = costGwei / gweiPerEther
+	r.L1FeeRevenuePerHour = txsPerHour * r.L1DataFeePerTx / gweiPerEther
+	if r.BatcherCostPerHour > 0 {
+		r.BatcherMargin = r.L1FeeRevenuePerHour / r.BatcherCostPerHour
+	}
+
+	if p.MaxGameDepth != 0 {
+		r.OutputBisectionDepth = p.SplitDepth
+		r.ExecutionTraceDepth = p.MaxGameDepth - p.SplitDepth - 1
+		r.MaxGameMoves = p.MaxGameDepth
+	}
+	return r, nil
+}
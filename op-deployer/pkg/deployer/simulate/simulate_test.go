@@ -0,0 +1,125 @@
+package simulate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func testParams() Params {
+	return Params{
+		GasLimit:          30_000_000,
+		BlockTime:         2,
+		Elasticity:        6,
+		Denominator:       250,
+		BaseFeeScalar:     1368,
+		BlobBaseFeeScalar: 810949,
+		MaxGameDepth:      73,
+		SplitDepth:        30,
+		DAType:            DATypeBlobs,
+		Utilization:       0.5,
+		TxGas:             50_000,
+		TxSize:            100,
+		L1BaseFee:         10,
+		L1BlobBaseFee:     1,
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	t.Run("Blobs", func(t *testing.T) {
+		r, err := Simulate(testParams())
+		require.NoError(t, err)
+		require.Equal(t, uint64(5_000_000), r.GasTarget)
+		require.Equal(t, 300.0, r.TxsPerBlock)
+		require.Equal(t, 150.0, r.TxsPerSecond)
+		require.Equal(t, 7_500_000.0, r.GasPerSecond)
+
+		require.InDelta(t, 0.02, r.BaseFeeMaxIncreasePerBlock, 1e-12)
+		require.InDelta(t, 0.004, r.BaseFeeMaxDecreasePerBlock, 1e-12)
+		require.InDelta(t, 0.008, r.BaseFeeChangePerBlock, 1e-12)
+		require.InDelta(t, math.Ln2/math.Log(1.008)*2, r.BaseFeeDoublingSeconds, 1e-9)
+		require.Zero(t, r.BaseFeeHalvingSeconds)
+
+		// (16*1368*10 + 810949*1) * 100 / 16e6
+		require.InDelta(t, 6.43643125, r.L1DataFeePerTx, 1e-9)
+		require.Equal(t, 54_000_000.0, r.BatchBytesPerHour)
+		require.InDelta(t, 54_000_000.0/130044, r.BlobsPerHour, 1e-9)
+		require.InDelta(t, r.BlobsPerHour/6, r.BatcherTxsPerHour, 1e-9)
+		require.InDelta(t, (r.BlobsPerHour*131072+r.BatcherTxsPerHour*21000*10)/1e9, r.BatcherCostPerHour, 1e-12)
+		require.InDelta(t, 540_000*6.43643125/1e9, r.L1FeeRevenuePerHour, 1e-12)
+		require.InDelta(t, r.L1FeeRevenuePerHour/r.BatcherCostPerHour, r.BatcherMargin, 1e-12)
+
+		require.Equal(t, uint64(30), r.OutputBisectionDepth)
+		require.Equal(t, uint64(42), r.ExecutionTraceDepth)
+		require.Equal(t, uint64(73), r.MaxGameMoves)
+	})
+
+	t.Run("Calldata", func(t *testing.T) {
+		p := testParams()
+		p.DAType = DATypeCalldata
+		r, err := Simulate(p)
+		require.NoError(t, err)
+		require.Zero(t, r.BlobsPerHour)
+		require.Equal(t, 450.0, r.BatcherTxsPerHour)
+		require.InDelta(t, (54_000_000.0*16*10+450*21000*10)/1e9, r.BatcherCostPerHour, 1e-12)
+	})
+
+	t.Run("LowUtilization", func(t *testing.T) {
+		p := testParams()
+		p.Utilization = 0.1
+		p.MaxGameDepth = 0
+		r, err := Simulate(p)
+		require.NoError(t, err)
+		require.Less(t, r.BaseFeeChangePerBlock, 0.0)
+		require.Zero(t, r.BaseFeeDoublingSeconds)
+		require.Positive(t, r.BaseFeeHalvingSeconds)
+		require.Zero(t, r.MaxGameMoves)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, modify := range map[string]func(p *Params){
+			"gas limit":   func(p *Params) { p.GasLimit = 0 },
+			"block time":  func(p *Params) { p.BlockTime = 0 },
+			"elasticity":  func(p *Params) { p.Elasticity = 0 },
+			"DA type":     func(p *Params) { p.DAType = "ipfs" },
+			"utilization": func(p *Params) { p.Utilization = 1.5 },
+			"tx gas":      func(p *Params) { p.TxGas = 0 },
+			"L1 fee":      func(p *Params) { p.L1BaseFee = -1 },
+			"split depth": func(p *Params) { p.SplitDepth = 73 },
+		} {
+			p := testParams()
+			modify(&p)
+			_, err := Simulate(p)
+			require.Error(t, err, name)
+		}
+	})
+}
+
+func TestParamsFromDeployConfig(t *testing.T) {
+	cfg := &genesis.DeployConfig{}
+	cfg.L2GenesisBlockGasLimit = hexutil.Uint64(60_000_000)
+	cfg.L2BlockTime = 1
+	cfg.EIP1559Elasticity = 10
+	cfg.EIP1559Denominator = 50
+	cfg.EIP1559DenominatorCanyon = 250
+	cfg.GasPriceOracleBaseFeeScalar = 1
+	cfg.GasPriceOracleBlobBaseFeeScalar = 2
+	cfg.FaultGameMaxDepth = 73
+	cfg.FaultGameSplitDepth = 30
+	require.Equal(t, Params{
+		GasLimit:          60_000_000,
+		BlockTime:         1,
+		Elasticity:        10,
+		Denominator:       250,
+		BaseFeeScalar:     1,
+		BlobBaseFeeScalar: 2,
+		MaxGameDepth:      73,
+		SplitDepth:        30,
+	}, ParamsFromDeployConfig(cfg))
+
+	cfg.EIP1559DenominatorCanyon = 0
+	require.Equal(t, uint64(50), ParamsFromDeployConfig(cfg).Denominator)
+}
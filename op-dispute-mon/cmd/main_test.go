@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -234,6 +235,57 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestMaxFeeIndexBlocks(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultMaxFeeIndexBlocks, cfg.MaxFeeIndexBlocks)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-fee-index-blocks", "1000"))
+		require.Equal(t, uint64(1000), cfg.MaxFeeIndexBlocks)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-fee-index-blocks", "0"))
+		require.Zero(t, cfg.MaxFeeIndexBlocks)
+	})
+}
+
+func TestLargeCreditThreshold(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.LargeCreditThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--large-credit-threshold", "1.5"))
+		require.Equal(t, big.NewInt(1_500_000_000_000_000_000), cfg.LargeCreditThreshold)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid large-credit-threshold", addRequiredArgs("--large-credit-threshold", "-1"))
+	})
+}
+
+func TestValidationCoverage(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultValidationDeadline, cfg.ValidationDeadline)
+		require.Equal(t, config.DefaultValidationQueueSize, cfg.ValidationQueueSize)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--validation-deadline", "3m", "--validation-queue-size", "10"))
+		require.Equal(t, 3*time.Minute, cfg.ValidationDeadline)
+		require.Equal(t, uint(10), cfg.ValidationQueueSize)
+	})
+
+	t.Run("ZeroDeadline", func(t *testing.T) {
+		verifyArgsInvalid(t, "validation-deadline must not be 0", addRequiredArgs("--validation-deadline", "0"))
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := dryRunWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
@@ -16,6 +17,8 @@ var (
 	ErrMissingGameFactoryAddress = errors.New("missing game factory address")
 	ErrMissingRollupRpc          = errors.New("missing rollup rpc url")
 	ErrMissingMaxConcurrency     = errors.New("missing max concurrency")
+	ErrNegativeCreditThreshold   = errors.New("large credit threshold must not be negative")
+	ErrMissingValidationDeadline = errors.New("missing validation deadline")
 )
 
 const (
@@ -30,6 +33,17 @@ const (
 
 	//DefaultMaxConcurrency is the default number of threads to use when fetching game data
 	DefaultMaxConcurrency = uint(5)
+
+	// DefaultMaxFeeIndexBlocks is the default maximum number of L1 blocks of which the transactions are
+	// attributed to games per update. Limits the load on the L1 RPC while the L1 fee index catches up.
+	DefaultMaxFeeIndexBlocks = uint64(200)
+
+	// DefaultValidationDeadline is the default maximum duration after a game is first seen
+	// within which its root claim must be validated against the local rollup node.
+	DefaultValidationDeadline = 10 * time.Minute
+	// DefaultValidationQueueSize is the default number of games awaiting validation above which
+	// updates of already validated games are shed.
+	DefaultValidationQueueSize = uint(50)
 )
 
 // Config is a well typed config that is parsed from the CLI params.
@@ -45,6 +59,13 @@ type Config struct {
 	IgnoredGames    []common.Address // Games to exclude from monitoring
 	MaxConcurrency  uint             // Maximum number of threads to use when fetching game data
 
+	MaxFeeIndexBlocks uint64 // Maximum number of L1 blocks to index L1 fees for per update. 0 disables L1 fee attribution.
+
+	LargeCreditThreshold *big.Int // Total credit (wei) above which a non-honest recipient is reported. nil or 0 disables the check.
+
+	ValidationDeadline  time.Duration // Maximum duration to validate the root claim of a new game before it is reported.
+	ValidationQueueSize uint          // Number of games awaiting validation above which validated games are shed from updates. 0 disables shedding.
+
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
 }
@@ -59,6 +80,11 @@ func NewConfig(gameFactoryAddress common.Address, l1EthRpc string, rollupRpc str
 		GameWindow:      DefaultGameWindow,
 		MaxConcurrency:  DefaultMaxConcurrency,
 
+		MaxFeeIndexBlocks: DefaultMaxFeeIndexBlocks,
+
+		ValidationDeadline:  DefaultValidationDeadline,
+		ValidationQueueSize: DefaultValidationQueueSize,
+
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
 	}
@@ -77,6 +103,12 @@ func (c Config) Check() error {
 	if c.MaxConcurrency == 0 {
 		return ErrMissingMaxConcurrency
 	}
+	if c.LargeCreditThreshold != nil && c.LargeCreditThreshold.Sign() < 0 {
+		return ErrNegativeCreditThreshold
+	}
+	if c.ValidationDeadline == 0 {
+		return ErrMissingValidationDeadline
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return fmt.Errorf("metrics config: %w", err)
 	}
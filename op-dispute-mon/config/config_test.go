@@ -1,6 +1,7 @@
 package config
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -45,3 +46,18 @@ func TestMaxConcurrencyRequired(t *testing.T) {
 	config.MaxConcurrency = 0
 	require.ErrorIs(t, config.Check(), ErrMissingMaxConcurrency)
 }
+
+func TestLargeCreditThresholdMustNotBeNegative(t *testing.T) {
+	config := validConfig()
+	config.LargeCreditThreshold = big.NewInt(-1)
+	require.ErrorIs(t, config.Check(), ErrNegativeCreditThreshold)
+
+	config.LargeCreditThreshold = big.NewInt(0)
+	require.NoError(t, config.Check())
+}
+
+func TestValidationDeadlineRequired(t *testing.T) {
+	config := validConfig()
+	config.ValidationDeadline = 0
+	require.ErrorIs(t, config.Check(), ErrMissingValidationDeadline)
+}
@@ -2,6 +2,8 @@ package flags
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 
 	challengerFlags "github.com/ethereum-optimism/optimism/op-challenger/flags"
 	"github.com/ethereum-optimism/optimism/op-service/flags"
@@ -13,6 +15,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 const (
@@ -71,6 +74,33 @@ var (
 		EnvVars: prefixEnvVars("MAX_CONCURRENCY"),
 		Value:   config.DefaultMaxConcurrency,
 	}
+	MaxFeeIndexBlocksFlag = &cli.Uint64Flag{
+		Name: "max-fee-index-blocks",
+		Usage: "Maximum number of L1 blocks of which the transactions are attributed to games per update, " +
+			"to track the L1 fees paid by each actor and for each game. 0 disables L1 fee attribution.",
+		EnvVars: prefixEnvVars("MAX_FEE_INDEX_BLOCKS"),
+		Value:   config.DefaultMaxFeeIndexBlocks,
+	}
+	LargeCreditThresholdFlag = &cli.Float64Flag{
+		Name: "large-credit-threshold",
+		Usage: "Total credit (in ETH) held in DelayedWETH across all games above which a recipient that is not " +
+			"an honest actor is reported. 0 disables the check.",
+		EnvVars: prefixEnvVars("LARGE_CREDIT_THRESHOLD"),
+	}
+	ValidationDeadlineFlag = &cli.DurationFlag{
+		Name: "validation-deadline",
+		Usage: "Maximum duration after a game is first seen within which its root claim must be validated against " +
+			"the rollup node. Games not validated in time are reported.",
+		EnvVars: prefixEnvVars("VALIDATION_DEADLINE"),
+		Value:   config.DefaultValidationDeadline,
+	}
+	ValidationQueueSizeFlag = &cli.UintFlag{
+		Name: "validation-queue-size",
+		Usage: "Number of games awaiting validation above which updates of already validated games are skipped, " +
+			"to validate new games first. 0 disables skipping.",
+		EnvVars: prefixEnvVars("VALIDATION_QUEUE_SIZE"),
+		Value:   config.DefaultValidationQueueSize,
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -88,6 +118,10 @@ var optionalFlags = []cli.Flag{
 	GameWindowFlag,
 	IgnoredGamesFlag,
 	MaxConcurrencyFlag,
+	MaxFeeIndexBlocksFlag,
+	LargeCreditThresholdFlag,
+	ValidationDeadlineFlag,
+	ValidationQueueSizeFlag,
 }
 
 func init() {
@@ -147,6 +181,19 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		return nil, fmt.Errorf("%v must not be 0", MaxConcurrencyFlag.Name)
 	}
 
+	validationDeadline := ctx.Duration(ValidationDeadlineFlag.Name)
+	if validationDeadline == 0 {
+		return nil, fmt.Errorf("%v must not be 0", ValidationDeadlineFlag.Name)
+	}
+
+	var largeCreditThreshold *big.Int
+	if ctx.IsSet(LargeCreditThresholdFlag.Name) {
+		largeCreditThreshold, err = etherToWei(ctx.Float64(LargeCreditThresholdFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %w", LargeCreditThresholdFlag.Name, err)
+		}
+	}
+
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
 
@@ -161,7 +208,21 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		IgnoredGames:    ignoredGames,
 		MaxConcurrency:  maxConcurrency,
 
+		MaxFeeIndexBlocks:    ctx.Uint64(MaxFeeIndexBlocksFlag.Name),
+		LargeCreditThreshold: largeCreditThreshold,
+
+		ValidationDeadline:  validationDeadline,
+		ValidationQueueSize: ctx.Uint(ValidationQueueSizeFlag.Name),
+
 		MetricsConfig: metricsConfig,
 		PprofConfig:   pprofConfig,
 	}, nil
 }
+
+func etherToWei(ether float64) (*big.Int, error) {
+	if math.IsNaN(ether) || math.IsInf(ether, 0) || ether < 0 {
+		return nil, fmt.Errorf("invalid ether value: %v", ether)
+	}
+	wei, _ := new(big.Float).Mul(big.NewFloat(ether), big.NewFloat(params.Ether)).Int(nil)
+	return wei, nil
+}
@@ -149,6 +149,32 @@ type HonestActorData struct {
 	WonBonds          *big.Int
 }
 
+// L1FeeSpend is the L1 fees paid for transactions sent to dispute games.
+type L1FeeSpend struct {
+	Fees         *big.Int
+	Transactions int
+}
+
+// DelayedWETHData is the state of the credits held by a DelayedWETH contract for the games in the game window.
+type DelayedWETHData struct {
+	// Balance is the ETH balance of the DelayedWETH contract.
+	Balance *big.Int
+	// Unrequested is the sum of unclaimed credits that have not been unlocked yet.
+	Unrequested *big.Int
+	// Locked is the sum of unclaimed credits that have been unlocked but are still within the withdrawal delay.
+	Locked *big.Int
+	// Withdrawable is the sum of unclaimed credits that can be withdrawn.
+	Withdrawable *big.Int
+	// NextUnlock is the earliest time at which a locked credit becomes withdrawable, or zero if nothing is locked.
+	NextUnlock time.Time
+}
+
+// Credits returns the total unclaimed credits that the DelayedWETH contract must be able to pay out.
+func (d DelayedWETHData) Credits() *big.Int {
+	total := new(big.Int).Add(d.Unrequested, d.Locked)
+	return total.Add(total, d.Withdrawable)
+}
+
 type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
@@ -185,6 +211,26 @@ type Metricer interface {
 
 	RecordOldestGameUpdateTime(t time.Time)
 
+	RecordGameRiskScores(scores map[common.Address]float64)
+
+	RecordL1FeesByActor(spend map[common.Address]L1FeeSpend)
+
+	RecordL1FeesByGame(spend map[common.Address]L1FeeSpend)
+
+	RecordL1FeesIndexedBlock(blockNum uint64)
+
+	RecordDelayedWETH(addr common.Address, data DelayedWETHData)
+
+	RecordRecipientCredits(credits map[common.Address]*big.Int)
+
+	RecordLargeCreditRecipients(count int)
+
+	RecordUnvalidatedGames(pending int, overdue int)
+
+	RecordValidationLatency(dur time.Duration)
+
+	RecordShedGames(count int)
+
 	caching.Metrics
 	contractMetrics.ContractMetricer
 }
@@ -229,6 +275,25 @@ type Metrics struct {
 
 	requiredCollateral  prometheus.GaugeVec
 	availableCollateral prometheus.GaugeVec
+
+	gameRiskScores   prometheus.GaugeVec
+	maxGameRiskScore prometheus.Gauge
+
+	actorL1Fees            prometheus.GaugeVec
+	actorL1FeeTransactions prometheus.GaugeVec
+	gameL1Fees             prometheus.GaugeVec
+	l1FeesIndexedBlock     prometheus.Gauge
+
+	delayedWETHBalance    prometheus.GaugeVec
+	delayedWETHCredits    prometheus.GaugeVec
+	delayedWETHShortfall  prometheus.GaugeVec
+	delayedWETHNextUnlock prometheus.GaugeVec
+	recipientCredits      prometheus.GaugeVec
+	largeCreditRecipients prometheus.Gauge
+
+	unvalidatedGames  prometheus.GaugeVec
+	validationLatency prometheus.Histogram
+	shedGames         prometheus.Gauge
 }
 
 func (m *Metrics) Registry() *prometheus.Registry {
@@ -395,6 +460,106 @@ func NewMetrics() *Metrics {
 			// An l2 block number challenge with an agreement means the challenge was invalid.
 			"root_agreement",
 		}),
+		gameRiskScores: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_risk_score",
+			Help:      "Risk score (0 to 1) of in-progress games, based on the credibility of their uncountered claims",
+		}, []string{
+			// Address of the game proxy. Only in-progress games in the game window are recorded.
+			"game",
+		}),
+		maxGameRiskScore: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "max_game_risk_score",
+			Help:      "Highest risk score (0 to 1) of all in-progress games",
+		}),
+		actorL1Fees: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "actor_l1_fees",
+			Help:      "L1 fees (ETH) paid by an actor for transactions sent to games in the game window",
+		}, []string{
+			"actor",
+		}),
+		actorL1FeeTransactions: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "actor_l1_fee_transactions",
+			Help:      "Number of transactions sent by an actor to games in the game window",
+		}, []string{
+			"actor",
+		}),
+		gameL1Fees: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_l1_fees",
+			Help:      "L1 fees (ETH) paid by all actors for transactions sent to a game in the game window",
+		}, []string{
+			// Address of the game proxy. Only games in the game window are recorded.
+			"game",
+		}),
+		l1FeesIndexedBlock: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "l1_fees_indexed_block",
+			Help:      "Latest L1 block of which the transactions sent to games are attributed in the L1 fee metrics",
+		}),
+		delayedWETHBalance: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "delayed_weth_balance",
+			Help:      "ETH balance of a DelayedWETH contract used by games in the game window",
+		}, []string{
+			"delayedWETH",
+		}),
+		delayedWETHCredits: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "delayed_weth_credits",
+			Help:      "Unclaimed credits (ETH) owed by a DelayedWETH contract, categorised by their unlock state",
+		}, []string{
+			"delayedWETH",
+			"state",
+		}),
+		delayedWETHShortfall: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "delayed_weth_credit_shortfall",
+			Help:      "Amount (ETH) by which the unclaimed credits owed by a DelayedWETH contract exceed its balance",
+		}, []string{
+			"delayedWETH",
+		}),
+		delayedWETHNextUnlock: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "delayed_weth_next_unlock",
+			Help:      "Timestamp at which the next locked credit of a DelayedWETH contract becomes withdrawable, 0 if none are locked",
+		}, []string{
+			"delayedWETH",
+		}),
+		recipientCredits: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "recipient_credits",
+			Help:      "Unclaimed credits (ETH) of a recipient summed across all games in the game window",
+		}, []string{
+			"recipient",
+		}),
+		largeCreditRecipients: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "large_credit_recipients",
+			Help:      "Number of recipients that are not honest actors with unclaimed credits above the configured threshold",
+		}),
+		unvalidatedGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "unvalidated_games",
+			Help:      "Number of games of which the root claim has not been validated against the rollup node yet",
+		}, []string{
+			// "pending" counts all unvalidated games, "overdue" those past the validation deadline
+			"status",
+		}),
+		validationLatency: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "validation_latency_seconds",
+			Help:      "Time from a game first being seen to its root claim being validated against the rollup node",
+			Buckets:   []float64{30, 60, 120, 300, 600, 1200, 3600},
+		}),
+		shedGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shed_games",
+			Help:      "Number of validated games not updated in the last update, to validate queued games first",
+		}),
 	}
 }
 
@@ -556,6 +721,83 @@ func (m *Metrics) RecordL2Challenges(agreement bool, count int) {
 	m.l2Challenges.WithLabelValues(agree).Set(float64(count))
 }
 
+func (m *Metrics) RecordGameRiskScores(scores map[common.Address]float64) {
+	// Reset to remove games that are no longer in progress
+	m.gameRiskScores.Reset()
+	var highest float64
+	for game, score := range scores {
+		m.gameRiskScores.WithLabelValues(game.Hex()).Set(score)
+		highest = max(highest, score)
+	}
+	m.maxGameRiskScore.Set(highest)
+}
+
+func (m *Metrics) RecordL1FeesByActor(spend map[common.Address]L1FeeSpend) {
+	// Reset to remove actors that no longer sent transactions to games in the game window
+	m.actorL1Fees.Reset()
+	m.actorL1FeeTransactions.Reset()
+	for actor, s := range spend {
+		m.actorL1Fees.WithLabelValues(actor.Hex()).Set(weiToEther(s.Fees))
+		m.actorL1FeeTransactions.WithLabelValues(actor.Hex()).Set(float64(s.Transactions))
+	}
+}
+
+func (m *Metrics) RecordL1FeesByGame(spend map[common.Address]L1FeeSpend) {
+	// Reset to remove games that are no longer in the game window
+	m.gameL1Fees.Reset()
+	for game, s := range spend {
+		m.gameL1Fees.WithLabelValues(game.Hex()).Set(weiToEther(s.Fees))
+	}
+}
+
+func (m *Metrics) RecordL1FeesIndexedBlock(blockNum uint64) {
+	m.l1FeesIndexedBlock.Set(float64(blockNum))
+}
+
+func (m *Metrics) RecordDelayedWETH(addr common.Address, data DelayedWETHData) {
+	m.delayedWETHBalance.WithLabelValues(addr.Hex()).Set(weiToEther(data.Balance))
+	m.delayedWETHCredits.WithLabelValues(addr.Hex(), "unrequested").Set(weiToEther(data.Unrequested))
+	m.delayedWETHCredits.WithLabelValues(addr.Hex(), "locked").Set(weiToEther(data.Locked))
+	m.delayedWETHCredits.WithLabelValues(addr.Hex(), "withdrawable").Set(weiToEther(data.Withdrawable))
+
+	shortfall := new(big.Int).Sub(data.Credits(), data.Balance)
+	if shortfall.Sign() < 0 {
+		shortfall.SetUint64(0)
+	}
+	m.delayedWETHShortfall.WithLabelValues(addr.Hex()).Set(weiToEther(shortfall))
+
+	var nextUnlock float64
+	if !data.NextUnlock.IsZero() {
+		nextUnlock = float64(data.NextUnlock.Unix())
+	}
+	m.delayedWETHNextUnlock.WithLabelValues(addr.Hex()).Set(nextUnlock)
+}
+
+func (m *Metrics) RecordRecipientCredits(credits map[common.Address]*big.Int) {
+	// Reset to remove recipients that no longer have unclaimed credits in the game window
+	m.recipientCredits.Reset()
+	for recipient, credit := range credits {
+		m.recipientCredits.WithLabelValues(recipient.Hex()).Set(weiToEther(credit))
+	}
+}
+
+func (m *Metrics) RecordLargeCreditRecipients(count int) {
+	m.largeCreditRecipients.Set(float64(count))
+}
+
+func (m *Metrics) RecordUnvalidatedGames(pending int, overdue int) {
+	m.unvalidatedGames.WithLabelValues("pending").Set(float64(pending))
+	m.unvalidatedGames.WithLabelValues("overdue").Set(float64(overdue))
+}
+
+func (m *Metrics) RecordValidationLatency(dur time.Duration) {
+	m.validationLatency.Observe(dur.Seconds())
+}
+
+func (m *Metrics) RecordShedGames(count int) {
+	m.shedGames.Set(float64(count))
+}
+
 const (
 	inProgress = true
 	correct    = true
@@ -51,3 +51,23 @@ func (*NoopMetricsImpl) RecordFailedGames(_ int) {}
 func (*NoopMetricsImpl) RecordBondCollateral(_ common.Address, _, _ *big.Int) {}
 
 func (*NoopMetricsImpl) RecordL2Challenges(_ bool, _ int) {}
+
+func (*NoopMetricsImpl) RecordGameRiskScores(_ map[common.Address]float64) {}
+
+func (*NoopMetricsImpl) RecordL1FeesByActor(_ map[common.Address]L1FeeSpend) {}
+
+func (*NoopMetricsImpl) RecordL1FeesByGame(_ map[common.Address]L1FeeSpend) {}
+
+func (*NoopMetricsImpl) RecordL1FeesIndexedBlock(_ uint64) {}
+
+func (*NoopMetricsImpl) RecordDelayedWETH(_ common.Address, _ DelayedWETHData) {}
+
+func (*NoopMetricsImpl) RecordRecipientCredits(_ map[common.Address]*big.Int) {}
+
+func (*NoopMetricsImpl) RecordLargeCreditRecipients(_ int) {}
+
+func (*NoopMetricsImpl) RecordUnvalidatedGames(_ int, _ int) {}
+
+func (*NoopMetricsImpl) RecordValidationLatency(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordShedGames(_ int) {}
@@ -0,0 +1,116 @@
+package bonds
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type DelayedWETHMetrics interface {
+	RecordDelayedWETH(addr common.Address, data metrics.DelayedWETHData)
+	RecordRecipientCredits(credits map[common.Address]*big.Int)
+	RecordLargeCreditRecipients(count int)
+}
+
+// DelayedWETHMonitor tracks the balances, unclaimed credits and unlock schedules of the DelayedWETH contracts used by
+// games in the game window. It provides an early warning for bond accounting bugs by reporting contracts that can't
+// cover the credits they owe and recipients that unexpectedly accumulate large credits.
+type DelayedWETHMonitor struct {
+	logger               log.Logger
+	clock                RClock
+	metrics              DelayedWETHMetrics
+	honestActors         types.HonestActors
+	largeCreditThreshold *big.Int
+}
+
+// NewDelayedWETHMonitor creates a new DelayedWETHMonitor.
+// A nil or zero largeCreditThreshold disables reporting recipients with large credits.
+func NewDelayedWETHMonitor(logger log.Logger, metrics DelayedWETHMetrics, clock RClock, honestActors types.HonestActors, largeCreditThreshold *big.Int) *DelayedWETHMonitor {
+	return &DelayedWETHMonitor{
+		logger:               logger,
+		clock:                clock,
+		metrics:              metrics,
+		honestActors:         honestActors,
+		largeCreditThreshold: largeCreditThreshold,
+	}
+}
+
+func (d *DelayedWETHMonitor) CheckDelayedWETH(games []*types.EnrichedGameData) {
+	now := d.clock.Now() // Use a consistent time for all checks
+	contracts := make(map[common.Address]*metrics.DelayedWETHData)
+	recipientCredits := make(map[common.Address]*big.Int)
+	for _, game := range games {
+		data, ok := contracts[game.WETHContract]
+		if !ok {
+			data = &metrics.DelayedWETHData{
+				Balance:      game.ETHCollateral,
+				Unrequested:  big.NewInt(0),
+				Locked:       big.NewInt(0),
+				Withdrawable: big.NewInt(0),
+			}
+			contracts[game.WETHContract] = data
+		}
+		for recipient, credit := range game.Credits {
+			if credit == nil || credit.Sign() == 0 {
+				continue
+			}
+			d.addCredit(data, game, recipient, credit, now)
+			total, ok := recipientCredits[recipient]
+			if !ok {
+				total = big.NewInt(0)
+			}
+			recipientCredits[recipient] = new(big.Int).Add(total, credit)
+		}
+	}
+
+	for addr, data := range contracts {
+		credits := data.Credits()
+		if credits.Cmp(data.Balance) > 0 {
+			d.logger.Error("DelayedWETH balance insufficient for unclaimed credits", "delayedWETH", addr, "credits", credits, "balance", data.Balance)
+		}
+		if !data.NextUnlock.IsZero() {
+			d.logger.Debug("Upcoming DelayedWETH unlock", "delayedWETH", addr, "nextUnlock", data.NextUnlock, "locked", data.Locked)
+		}
+		d.metrics.RecordDelayedWETH(addr, *data)
+	}
+	d.metrics.RecordRecipientCredits(recipientCredits)
+	d.metrics.RecordLargeCreditRecipients(d.checkLargeCredits(recipientCredits))
+}
+
+func (d *DelayedWETHMonitor) addCredit(data *metrics.DelayedWETHData, game *types.EnrichedGameData, recipient common.Address, credit *big.Int, now time.Time) {
+	request := game.WithdrawalRequests[recipient]
+	if request == nil || request.Amount == nil || request.Amount.Sign() == 0 || request.Timestamp == nil {
+		data.Unrequested.Add(data.Unrequested, credit)
+		return
+	}
+	unlockTime := time.Unix(request.Timestamp.Int64(), 0).Add(game.WETHDelay)
+	if !unlockTime.After(now) {
+		data.Withdrawable.Add(data.Withdrawable, credit)
+		return
+	}
+	data.Locked.Add(data.Locked, credit)
+	if data.NextUnlock.IsZero() || unlockTime.Before(data.NextUnlock) {
+		data.NextUnlock = unlockTime
+	}
+}
+
+// checkLargeCredits reports recipients other than the honest actors with total credits above the configured threshold.
+// Returns the number of such recipients.
+func (d *DelayedWETHMonitor) checkLargeCredits(recipientCredits map[common.Address]*big.Int) int {
+	if d.largeCreditThreshold == nil || d.largeCreditThreshold.Sign() == 0 {
+		return 0
+	}
+	count := 0
+	for recipient, credit := range recipientCredits {
+		if d.honestActors.Contains(recipient) || credit.Cmp(d.largeCreditThreshold) <= 0 {
+			continue
+		}
+		count++
+		d.logger.Warn("Unexpected recipient accumulated large credit", "recipient", recipient, "credit", credit, "threshold", d.largeCreditThreshold)
+	}
+	return count
+}
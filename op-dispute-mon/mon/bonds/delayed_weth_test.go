@@ -0,0 +1,139 @@
+package bonds
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDelayedWETH(t *testing.T) {
+	weth1 := common.Address{0x1a}
+	weth2 := common.Address{0x2b}
+	honest := common.Address{0xaa}
+	recipient1 := common.Address{0x01}
+	recipient2 := common.Address{0x02}
+	delay := 10 * time.Minute
+	// Unlocked recently so the credit is still locked
+	lockedRequest := &contracts.WithdrawalRequest{Amount: big.NewInt(5), Timestamp: big.NewInt(frozen.Add(-time.Minute).Unix())}
+	// Unlocked long enough ago that the credit is withdrawable
+	withdrawableRequest := &contracts.WithdrawalRequest{Amount: big.NewInt(7), Timestamp: big.NewInt(frozen.Add(-delay).Unix())}
+	laterLockedRequest := &contracts.WithdrawalRequest{Amount: big.NewInt(100), Timestamp: big.NewInt(frozen.Unix())}
+
+	game1 := &monTypes.EnrichedGameData{
+		Credits: map[common.Address]*big.Int{
+			honest:     big.NewInt(5),
+			recipient1: big.NewInt(7),
+			recipient2: big.NewInt(3),
+		},
+		WithdrawalRequests: map[common.Address]*contracts.WithdrawalRequest{
+			honest:     lockedRequest,
+			recipient1: withdrawableRequest,
+			recipient2: {Amount: big.NewInt(0), Timestamp: big.NewInt(0)},
+		},
+		WETHContract:  weth1,
+		WETHDelay:     delay,
+		ETHCollateral: big.NewInt(4200),
+	}
+	game2 := &monTypes.EnrichedGameData{
+		Credits: map[common.Address]*big.Int{
+			honest:     big.NewInt(100),
+			recipient1: big.NewInt(0),
+		},
+		WithdrawalRequests: map[common.Address]*contracts.WithdrawalRequest{
+			honest: laterLockedRequest,
+		},
+		WETHContract:  weth2,
+		WETHDelay:     delay,
+		ETHCollateral: big.NewInt(10), // Insufficient
+	}
+
+	monitor, m, logs := setupDelayedWETHTest(t, big.NewInt(5), honest)
+	monitor.CheckDelayedWETH([]*monTypes.EnrichedGameData{game1, game2})
+
+	require.Len(t, m.contracts, 2)
+	data1 := m.contracts[weth1]
+	require.Equal(t, int64(4200), data1.Balance.Int64())
+	require.Equal(t, int64(3), data1.Unrequested.Int64())
+	require.Equal(t, int64(5), data1.Locked.Int64())
+	require.Equal(t, int64(7), data1.Withdrawable.Int64())
+	require.Equal(t, frozen.Add(-time.Minute).Add(delay), data1.NextUnlock)
+
+	data2 := m.contracts[weth2]
+	require.Equal(t, int64(10), data2.Balance.Int64())
+	require.Equal(t, int64(0), data2.Unrequested.Int64())
+	require.Equal(t, int64(100), data2.Locked.Int64())
+	require.Equal(t, int64(0), data2.Withdrawable.Int64())
+	require.Equal(t, frozen.Add(delay), data2.NextUnlock)
+
+	require.Equal(t, map[common.Address]*big.Int{
+		honest:     big.NewInt(105),
+		recipient1: big.NewInt(7),
+		recipient2: big.NewInt(3),
+	}, m.recipientCredits)
+
+	require.NotNil(t, logs.FindLog(
+		testlog.NewMessageFilter("DelayedWETH balance insufficient for unclaimed credits"),
+		testlog.NewAttributesFilter("delayedWETH", weth2.Hex()),
+		testlog.NewAttributesFilter("credits", "100"),
+		testlog.NewAttributesFilter("balance", "10")))
+	require.Nil(t, logs.FindLog(
+		testlog.NewMessageFilter("DelayedWETH balance insufficient for unclaimed credits"),
+		testlog.NewAttributesFilter("delayedWETH", weth1.Hex())))
+
+	// Only recipient1 exceeds the threshold without being an honest actor
+	require.Equal(t, 1, m.largeCreditRecipients)
+	require.NotNil(t, logs.FindLog(
+		testlog.NewMessageFilter("Unexpected recipient accumulated large credit"),
+		testlog.NewAttributesFilter("recipient", recipient1.Hex())))
+}
+
+func TestCheckDelayedWETHLargeCreditsDisabled(t *testing.T) {
+	game := &monTypes.EnrichedGameData{
+		Credits: map[common.Address]*big.Int{
+			{0x01}: big.NewInt(1000),
+		},
+		ETHCollateral: big.NewInt(1000),
+	}
+	monitor, m, logs := setupDelayedWETHTest(t, big.NewInt(0))
+	monitor.CheckDelayedWETH([]*monTypes.EnrichedGameData{game})
+
+	require.Zero(t, m.largeCreditRecipients)
+	require.Nil(t, logs.FindLog(testlog.NewMessageFilter("Unexpected recipient accumulated large credit")))
+	require.True(t, m.contracts[common.Address{}].NextUnlock.IsZero())
+}
+
+func setupDelayedWETHTest(t *testing.T, threshold *big.Int, honestActors ...common.Address) (*DelayedWETHMonitor, *stubDelayedWETHMetrics, *testlog.CapturingHandler) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlDebug)
+	m := &stubDelayedWETHMetrics{
+		contracts: make(map[common.Address]metrics.DelayedWETHData),
+	}
+	monitor := NewDelayedWETHMonitor(logger, m, clock.NewDeterministicClock(frozen), monTypes.NewHonestActors(honestActors), threshold)
+	return monitor, m, logs
+}
+
+type stubDelayedWETHMetrics struct {
+	contracts             map[common.Address]metrics.DelayedWETHData
+	recipientCredits      map[common.Address]*big.Int
+	largeCreditRecipients int
+}
+
+func (s *stubDelayedWETHMetrics) RecordDelayedWETH(addr common.Address, data metrics.DelayedWETHData) {
+	s.contracts[addr] = data
+}
+
+func (s *stubDelayedWETHMetrics) RecordRecipientCredits(credits map[common.Address]*big.Int) {
+	s.recipientCredits = credits
+}
+
+func (s *stubDelayedWETHMetrics) RecordLargeCreditRecipients(count int) {
+	s.largeCreditRecipients = count
+}
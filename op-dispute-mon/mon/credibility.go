@@ -0,0 +1,144 @@
+package mon
+
+import (
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// localValidationWeight is the weight of the local output root validation in the claim credibility.
+	localValidationWeight = 0.7
+	// actorHistoryWeight is the weight of the historical behavior of the claimant in the claim credibility.
+	actorHistoryWeight = 1 - localValidationWeight
+
+	// RiskScoreWarnThreshold is the game risk score at or above which a warning is logged.
+	RiskScoreWarnThreshold = 0.5
+)
+
+type CredibilityMetrics interface {
+	RecordGameRiskScores(scores map[common.Address]float64)
+}
+
+type claimID struct {
+	game  common.Address
+	index int
+}
+
+// actorHistory counts the resolved claims of an actor, by resolution outcome.
+type actorHistory struct {
+	upheld    int
+	countered int
+}
+
+// score is the fraction of upheld claims of the actor, smoothed so actors without history score 0.5.
+func (h *actorHistory) score() float64 {
+	return float64(h.upheld+1) / float64(h.upheld+h.countered+2)
+}
+
+// CredibilityMonitor scores the credibility of claims in in-progress games,
+// combining local validation of the output root with the historical behavior of the claimant.
+// The risk score of a game is the highest lack of credibility of its uncountered claims,
+// i.e. a game is risky if it has a claim that appears dishonest and has not been responded to yet.
+type CredibilityMonitor struct {
+	logger  log.Logger
+	metrics CredibilityMetrics
+
+	history map[common.Address]*actorHistory
+	// counted tracks the resolved claims that are accounted for in the actor history
+	counted map[claimID]bool
+}
+
+func NewCredibilityMonitor(logger log.Logger, metrics CredibilityMetrics) *CredibilityMonitor {
+	return &CredibilityMonitor{
+		logger:  logger,
+		metrics: metrics,
+		history: make(map[common.Address]*actorHistory),
+		counted: make(map[claimID]bool),
+	}
+}
+
+func (m *CredibilityMonitor) CheckCredibility(games []*types.EnrichedGameData) {
+	m.updateHistory(games)
+
+	scores := make(map[common.Address]float64)
+	for _, game := range games {
+		if game.Status != gameTypes.GameStatusInProgress {
+			continue
+		}
+		risk := m.gameRiskScore(game)
+		scores[game.Proxy] = risk
+		if risk >= RiskScoreWarnThreshold {
+			m.logger.Warn("Game has uncountered claims with low credibility", "game", game.Proxy, "risk", risk, "agreement", game.AgreeWithClaim)
+		}
+	}
+	m.metrics.RecordGameRiskScores(scores)
+}
+
+// updateHistory accounts for newly resolved claims in the actor history.
+func (m *CredibilityMonitor) updateHistory(games []*types.EnrichedGameData) {
+	resolved := make(map[claimID]bool)
+	for _, game := range games {
+		for _, claim := range game.Claims {
+			if !claim.Resolved {
+				continue
+			}
+			id := claimID{game: game.Proxy, index: claim.ContractIndex}
+			resolved[id] = true
+			if m.counted[id] {
+				continue
+			}
+			m.counted[id] = true
+			h, ok := m.history[claim.Claimant]
+			if !ok {
+				h = &actorHistory{}
+				m.history[claim.Claimant] = h
+			}
+			if claim.CounteredBy == (common.Address{}) {
+				h.upheld++
+			} else {
+				h.countered++
+			}
+		}
+	}
+	// Games that left the game window will not be loaded again, so stop tracking their claims.
+	for id := range m.counted {
+		if !resolved[id] {
+			delete(m.counted, id)
+		}
+	}
+}
+
+// gameRiskScore returns the highest lack of credibility of the uncountered claims in the game.
+func (m *CredibilityMonitor) gameRiskScore(game *types.EnrichedGameData) float64 {
+	hasChildren := make(map[int]bool)
+	for _, claim := range game.Claims {
+		if !claim.IsRoot() {
+			hasChildren[claim.ParentContractIndex] = true
+		}
+	}
+	var risk float64
+	for _, claim := range game.Claims {
+		if claim.Resolved || hasChildren[claim.ContractIndex] {
+			continue
+		}
+		risk = max(risk, 1-m.credibility(game, &claim))
+	}
+	return risk
+}
+
+// credibility scores the likelihood that the claim is honest, between 0 and 1.
+func (m *CredibilityMonitor) credibility(game *types.EnrichedGameData, claim *types.EnrichedClaim) float64 {
+	// Claims at even depths support the root claim, claims at odd depths dispute it.
+	supportsRoot := claim.Position.Depth()%2 == 0
+	var local float64
+	if supportsRoot == game.AgreeWithClaim {
+		local = 1
+	}
+	history := 0.5
+	if h, ok := m.history[claim.Claimant]; ok {
+		history = h.score()
+	}
+	return localValidationWeight*local + actorHistoryWeight*history
+}
@@ -0,0 +1,112 @@
+package mon
+
+import (
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	credibilityHonest    = common.Address{0xaa}
+	credibilityDishonest = common.Address{0xbb}
+	rootPosition         = faultTypes.NewPositionFromGIndex(big.NewInt(1))
+)
+
+func TestCheckCredibility(t *testing.T) {
+	t.Run("UncounteredRootClaim", func(t *testing.T) {
+		monitor, metrics, logs := setupCredibilityTest(t)
+		games := []*types.EnrichedGameData{
+			credibilityGame(common.Address{0x01}, true, credibilityClaim(0, -1, rootPosition, credibilityHonest)),
+			credibilityGame(common.Address{0x02}, false, credibilityClaim(0, -1, rootPosition, credibilityDishonest)),
+		}
+		monitor.CheckCredibility(games)
+		require.InDelta(t, 0.15, metrics.scores[common.Address{0x01}], 0.0001)
+		require.InDelta(t, 0.85, metrics.scores[common.Address{0x02}], 0.0001)
+
+		levelFilter := testlog.NewLevelFilter(log.LevelWarn)
+		messageFilter := testlog.NewMessageFilter("Game has uncountered claims with low credibility")
+		l := logs.FindLogs(levelFilter, messageFilter)
+		require.Len(t, l, 1)
+		require.Equal(t, common.Address{0x02}, l[0].AttrValue("game"))
+	})
+
+	t.Run("CounteredClaimsIgnored", func(t *testing.T) {
+		monitor, metrics, _ := setupCredibilityTest(t)
+		root := credibilityClaim(0, -1, rootPosition, credibilityDishonest)
+		root.CounteredBy = credibilityHonest
+		games := []*types.EnrichedGameData{
+			credibilityGame(common.Address{0x01}, false, root, credibilityClaim(1, 0, rootPosition.Attack(), credibilityHonest)),
+		}
+		monitor.CheckCredibility(games)
+		require.InDelta(t, 0.15, metrics.scores[common.Address{0x01}], 0.0001)
+	})
+
+	t.Run("SkipCompletedGames", func(t *testing.T) {
+		monitor, metrics, _ := setupCredibilityTest(t)
+		game := credibilityGame(common.Address{0x01}, false, credibilityClaim(0, -1, rootPosition, credibilityDishonest))
+		game.Status = gameTypes.GameStatusChallengerWon
+		monitor.CheckCredibility([]*types.EnrichedGameData{game})
+		require.NotNil(t, metrics.scores)
+		require.Empty(t, metrics.scores)
+	})
+
+	t.Run("ActorHistory", func(t *testing.T) {
+		monitor, metrics, _ := setupCredibilityTest(t)
+		upheld := credibilityClaim(0, -1, rootPosition, credibilityHonest)
+		upheld.Resolved = true
+		resolvedGame := credibilityGame(common.Address{0x01}, true, upheld)
+		resolvedGame.Status = gameTypes.GameStatusDefenderWon
+		games := []*types.EnrichedGameData{
+			resolvedGame,
+			credibilityGame(common.Address{0x02}, false, credibilityClaim(0, -1, rootPosition, credibilityHonest)),
+		}
+		monitor.CheckCredibility(games)
+		// One upheld claim gives a history score of 2/3
+		require.InDelta(t, 1-0.3*2.0/3.0, metrics.scores[common.Address{0x02}], 0.0001)
+
+		// Resolved claims are only counted once
+		monitor.CheckCredibility(games)
+		require.InDelta(t, 1-0.3*2.0/3.0, metrics.scores[common.Address{0x02}], 0.0001)
+	})
+}
+
+func setupCredibilityTest(t *testing.T) (*CredibilityMonitor, *stubCredibilityMetrics, *testlog.CapturingHandler) {
+	logger, capturedLogs := testlog.CaptureLogger(t, log.LvlDebug)
+	metrics := &stubCredibilityMetrics{}
+	return NewCredibilityMonitor(logger, metrics), metrics, capturedLogs
+}
+
+func credibilityGame(proxy common.Address, agree bool, claims ...types.EnrichedClaim) *types.EnrichedGameData {
+	return &types.EnrichedGameData{
+		GameMetadata:   gameTypes.GameMetadata{Proxy: proxy},
+		Status:         gameTypes.GameStatusInProgress,
+		AgreeWithClaim: agree,
+		Claims:         claims,
+	}
+}
+
+func credibilityClaim(idx int, parentIdx int, pos faultTypes.Position, claimant common.Address) types.EnrichedClaim {
+	return types.EnrichedClaim{
+		Claim: faultTypes.Claim{
+			ClaimData:           faultTypes.ClaimData{Position: pos},
+			Claimant:            claimant,
+			ContractIndex:       idx,
+			ParentContractIndex: parentIdx,
+		},
+	}
+}
+
+type stubCredibilityMetrics struct {
+	scores map[common.Address]float64
+}
+
+func (s *stubCredibilityMetrics) RecordGameRiskScores(scores map[common.Address]float64) {
+	s.scores = scores
+}
@@ -41,8 +41,12 @@ func NewAgreementEnricher(logger log.Logger, metrics OutputMetrics, client Outpu
 func (o *AgreementEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
 	output, err := o.client.OutputAtBlock(ctx, game.L2BlockNumber)
 	if err != nil {
-		// string match as the error comes from the remote server so we can't use Errors.Is sadly.
-		if strings.Contains(err.Error(), "not found") {
+		// Nodes that return typed error codes distinguish a missing output from e.g. a reorg in progress.
+		// Older nodes do not, so fall back to a string match as the error comes from the remote server.
+		if code, ok := eth.NodeErrorCodeOf(err); ok && code != eth.NodeNotFoundErrorCode {
+			return fmt.Errorf("failed to get output at block: %w", err)
+		}
+		if eth.IsNodeError(err, eth.NodeNotFoundErrorCode) || strings.Contains(err.Error(), "not found") {
 			// Output root doesn't exist, so we must disagree with it.
 			game.AgreeWithClaim = false
 			return nil
@@ -134,6 +134,33 @@ func TestDetector_CheckRootAgreement(t *testing.T) {
 		require.False(t, game.AgreeWithClaim)
 		require.Zero(t, metrics.fetchTime)
 	})
+
+	t.Run("OutputNotFound_Typed", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		rollup.outputErr = eth.NewNodeError(eth.NodeNotFoundErrorCode, errors.New("unknown block"))
+		game := &types.EnrichedGameData{
+			L1HeadNum:     100,
+			L2BlockNumber: 42984924,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.NoError(t, err)
+		require.False(t, game.AgreeWithClaim)
+	})
+
+	t.Run("OutputReorged", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		// The block is missing because of a reorg in progress, so the output must be fetched again later
+		rollup.outputErr = eth.NewNodeError(eth.NodeReorgErrorCode, errors.New("L2 block was reorged out: not found"))
+		game := &types.EnrichedGameData{
+			L1HeadNum:     100,
+			L2BlockNumber: 42984924,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.ErrorIs(t, err, rollup.outputErr)
+		require.False(t, game.AgreeWithClaim)
+	})
 }
 
 func setupOutputValidatorTest(t *testing.T) (*AgreementEnricher, *stubRollupClient, *stubOutputMetrics) {
@@ -0,0 +1,112 @@
+package extract
+
+import (
+	"slices"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type CoverageMetrics interface {
+	RecordUnvalidatedGames(pending int, overdue int)
+	RecordValidationLatency(dur time.Duration)
+	RecordShedGames(count int)
+}
+
+// Coverage ensures the root claim of every game is validated against the local rollup node, not only
+// the games that happen to be enriched successfully. A game is validated once it has been enriched
+// successfully, which includes the agreement check of its root claim. Games wait in a queue until they
+// are validated and are reported if that takes longer than the deadline.
+type Coverage struct {
+	logger   log.Logger
+	clock    clock.Clock
+	metrics  CoverageMetrics
+	deadline time.Duration
+	// queueSize is the number of queued games above which validated games are shed from updates.
+	// 0 disables load shedding.
+	queueSize int
+
+	queued    map[common.Address]time.Time // time at which each unvalidated game was first seen
+	validated map[common.Address]bool
+}
+
+func NewCoverage(logger log.Logger, cl clock.Clock, metrics CoverageMetrics, deadline time.Duration, queueSize uint) *Coverage {
+	return &Coverage{
+		logger:    logger,
+		clock:     cl,
+		metrics:   metrics,
+		deadline:  deadline,
+		queueSize: int(queueSize),
+		queued:    make(map[common.Address]time.Time),
+		validated: make(map[common.Address]bool),
+	}
+}
+
+// schedule queues the games not seen before and returns the games to enrich in this update, queued games
+// first, oldest first. If more games are queued than the queue size, the already validated games are shed:
+// they are not returned and their cached data is reused, so that the update validates the queued games
+// as quickly as possible. Games that are no longer in the game window are dropped.
+// Ignored games are never validated, they are returned last.
+func (c *Coverage) schedule(games []gameTypes.GameMetadata, ignored map[common.Address]bool) []gameTypes.GameMetadata {
+	now := c.clock.Now()
+	queued := make(map[common.Address]time.Time)
+	validated := make(map[common.Address]bool)
+	var pending, done, skipped []gameTypes.GameMetadata
+	for _, game := range games {
+		if ignored[game.Proxy] {
+			skipped = append(skipped, game)
+			continue
+		}
+		if c.validated[game.Proxy] {
+			validated[game.Proxy] = true
+			done = append(done, game)
+			continue
+		}
+		firstSeen, ok := c.queued[game.Proxy]
+		if !ok {
+			firstSeen = now
+		}
+		queued[game.Proxy] = firstSeen
+		pending = append(pending, game)
+	}
+	c.queued, c.validated = queued, validated
+
+	slices.SortStableFunc(pending, func(a, b gameTypes.GameMetadata) int {
+		return c.queued[a.Proxy].Compare(c.queued[b.Proxy])
+	})
+	shed := 0
+	if c.queueSize > 0 && len(pending) > c.queueSize {
+		shed = len(done)
+		done = nil
+		c.logger.Warn("Validation queue full, shedding updates of validated games", "queued", len(pending), "shed", shed)
+	}
+	c.metrics.RecordShedGames(shed)
+	return slices.Concat(pending, done, skipped)
+}
+
+// markValidated records that the root claim of the game has been validated.
+func (c *Coverage) markValidated(game common.Address) {
+	firstSeen, ok := c.queued[game]
+	if !ok {
+		return
+	}
+	c.metrics.RecordValidationLatency(c.clock.Since(firstSeen))
+	delete(c.queued, game)
+	c.validated[game] = true
+}
+
+// report logs the games that were not validated before the deadline and records the queue metrics.
+func (c *Coverage) report() {
+	now := c.clock.Now()
+	overdue := 0
+	for game, firstSeen := range c.queued {
+		if waited := now.Sub(firstSeen); waited > c.deadline {
+			overdue++
+			c.logger.Error("Game root claim not validated before deadline", "game", game, "waited", waited, "deadline", c.deadline)
+		}
+	}
+	c.metrics.RecordUnvalidatedGames(len(c.queued), overdue)
+}
@@ -0,0 +1,125 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverage(t *testing.T) {
+	gameA := gameTypes.GameMetadata{Proxy: common.Address{0xaa}}
+	gameB := gameTypes.GameMetadata{Proxy: common.Address{0xbb}}
+	gameC := gameTypes.GameMetadata{Proxy: common.Address{0xcc}}
+	ignored := gameTypes.GameMetadata{Proxy: common.Address{0xdd}}
+	ignoredSet := map[common.Address]bool{ignored.Proxy: true}
+
+	setup := func(t *testing.T, queueSize uint) (*Coverage, *stubCoverageMetrics, *testlog.CapturingHandler, *clock.DeterministicClock) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlDebug)
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		metrics := &stubCoverageMetrics{}
+		return NewCoverage(logger, cl, metrics, 10*time.Minute, queueSize), metrics, logs, cl
+	}
+
+	t.Run("QueuedGamesFirst", func(t *testing.T) {
+		c, metrics, _, cl := setup(t, 0)
+		require.Equal(t, []gameTypes.GameMetadata{gameA, ignored}, c.schedule([]gameTypes.GameMetadata{gameA, ignored}, ignoredSet))
+		cl.AdvanceTime(time.Minute)
+		c.markValidated(gameA.Proxy)
+		require.Equal(t, time.Minute, metrics.latency)
+
+		cl.AdvanceTime(time.Minute)
+		require.Equal(t, []gameTypes.GameMetadata{gameC, gameA}, c.schedule([]gameTypes.GameMetadata{gameA, gameC}, nil))
+		cl.AdvanceTime(time.Minute)
+		// Queued games are ordered by the time they were first seen
+		require.Equal(t, []gameTypes.GameMetadata{gameC, gameB, gameA},
+			c.schedule([]gameTypes.GameMetadata{gameB, gameA, gameC}, nil))
+		c.report()
+		require.Equal(t, 2, metrics.pending)
+		require.Zero(t, metrics.overdue)
+	})
+
+	t.Run("ReportOverdue", func(t *testing.T) {
+		c, metrics, logs, cl := setup(t, 0)
+		c.schedule([]gameTypes.GameMetadata{gameA, gameB}, nil)
+		cl.AdvanceTime(11 * time.Minute)
+		c.markValidated(gameB.Proxy)
+		c.report()
+		require.Equal(t, 1, metrics.pending)
+		require.Equal(t, 1, metrics.overdue)
+		l := logs.FindLog(testlog.NewMessageFilter("Game root claim not validated before deadline"))
+		require.NotNil(t, l)
+		require.Equal(t, gameA.Proxy, l.AttrValue("game"))
+
+		// Games that leave the game window are no longer tracked
+		c.schedule([]gameTypes.GameMetadata{gameB}, nil)
+		c.report()
+		require.Zero(t, metrics.pending)
+		require.Zero(t, metrics.overdue)
+	})
+
+	t.Run("ShedValidatedGames", func(t *testing.T) {
+		c, metrics, _, _ := setup(t, 1)
+		c.schedule([]gameTypes.GameMetadata{gameA}, nil)
+		c.markValidated(gameA.Proxy)
+
+		require.Equal(t, []gameTypes.GameMetadata{gameB, gameA}, c.schedule([]gameTypes.GameMetadata{gameA, gameB}, nil))
+		require.Zero(t, metrics.shed)
+
+		require.Equal(t, []gameTypes.GameMetadata{gameB, gameC, ignored},
+			c.schedule([]gameTypes.GameMetadata{gameA, gameB, gameC, ignored}, ignoredSet))
+		require.Equal(t, 1, metrics.shed)
+	})
+}
+
+func TestExtractor_Coverage(t *testing.T) {
+	extractor, creator, games, _, cl := setupExtractorTest(t)
+	metrics := &stubCoverageMetrics{}
+	extractor.coverage = NewCoverage(testlog.Logger(t, log.LvlDebug), cl, metrics, time.Minute, 0)
+	game := gameTypes.GameMetadata{Proxy: common.Address{0xaa}}
+	games.games = []gameTypes.GameMetadata{game}
+
+	creator.err = errors.New("boom")
+	_, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, failed)
+	cl.AdvanceTime(2 * time.Minute)
+	_, _, _, err = extractor.Extract(context.Background(), common.Hash{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.pending)
+	require.Equal(t, 1, metrics.overdue)
+
+	creator.err = nil
+	enriched, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+	require.NoError(t, err)
+	require.Zero(t, failed)
+	require.Len(t, enriched, 1)
+	require.Zero(t, metrics.pending)
+	require.Zero(t, metrics.overdue)
+	require.Equal(t, 2*time.Minute, metrics.latency)
+}
+
+type stubCoverageMetrics struct {
+	pending, overdue int
+	latency          time.Duration
+	shed             int
+}
+
+func (s *stubCoverageMetrics) RecordUnvalidatedGames(pending int, overdue int) {
+	s.pending, s.overdue = pending, overdue
+}
+
+func (s *stubCoverageMetrics) RecordValidationLatency(dur time.Duration) {
+	s.latency = dur
+}
+
+func (s *stubCoverageMetrics) RecordShedGames(count int) {
+	s.shed = count
+}
@@ -38,9 +38,10 @@ type Extractor struct {
 	enrichers      []Enricher
 	ignoredGames   map[common.Address]bool
 	latestGameData map[common.Address]*monTypes.EnrichedGameData
+	coverage       *Coverage // nil if the validation coverage is not tracked
 }
 
-func NewExtractor(logger log.Logger, cl clock.Clock, creator CreateGameCaller, fetchGames FactoryGameFetcher, ignoredGames []common.Address, maxConcurrency uint, enrichers ...Enricher) *Extractor {
+func NewExtractor(logger log.Logger, cl clock.Clock, creator CreateGameCaller, fetchGames FactoryGameFetcher, ignoredGames []common.Address, maxConcurrency uint, coverage *Coverage, enrichers ...Enricher) *Extractor {
 	ignored := make(map[common.Address]bool)
 	for _, game := range ignoredGames {
 		ignored[game] = true
@@ -53,6 +54,7 @@ func NewExtractor(logger log.Logger, cl clock.Clock, creator CreateGameCaller, f
 		maxConcurrency: int(maxConcurrency),
 		enrichers:      enrichers,
 		ignoredGames:   ignored,
+		coverage:       coverage,
 	}
 }
 
@@ -107,12 +109,19 @@ func (e *Extractor) enrichGames(ctx context.Context, blockHash common.Hash, game
 
 	// Create a new store for game data. This ensures any games no longer in the monitoring set are dropped.
 	updatedGameData := make(map[common.Address]*monTypes.EnrichedGameData)
-	// Push each game into the channel and store the latest cached game data as a default if fetching fails
+	// Store the latest cached game data as a default if fetching fails or the game is shed
 	for _, game := range games {
 		previousData := e.latestGameData[game.Proxy]
 		if previousData != nil {
 			updatedGameData[game.Proxy] = previousData
 		}
+	}
+	toEnrich := games
+	if e.coverage != nil {
+		toEnrich = e.coverage.schedule(games, e.ignoredGames)
+	}
+	// Push each game into the channel
+	for _, game := range toEnrich {
 		gameCh <- game
 	}
 	close(gameCh)
@@ -123,6 +132,12 @@ func (e *Extractor) enrichGames(ctx context.Context, blockHash common.Hash, game
 	// Read the results
 	for enrichedGame := range enrichedCh {
 		updatedGameData[enrichedGame.Proxy] = enrichedGame
+		if e.coverage != nil {
+			e.coverage.markValidated(enrichedGame.Proxy)
+		}
+	}
+	if e.coverage != nil {
+		e.coverage.report()
 	}
 	e.latestGameData = updatedGameData
 	return maps.Values(updatedGameData), int(ignored.Load()), int(failed.Load())
@@ -239,6 +239,7 @@ func setupExtractorTest(t *testing.T, enrichers ...Enricher) (*Extractor, *mockG
 		games.FetchGames,
 		ignoredGames,
 		5,
+		nil,
 		enrichers...,
 	)
 	return extractor, creator, games, capturedLogs, cl
@@ -0,0 +1,243 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// feeIndexConfirmations is the number of blocks the fee index trails the L1 head by,
+// so transactions of blocks that are reorged out are unlikely to be attributed.
+const feeIndexConfirmations = 3
+
+var ErrReceiptNotFound = errors.New("receipt not found")
+
+type FeeMetrics interface {
+	RecordL1FeesByActor(spend map[common.Address]metrics.L1FeeSpend)
+	RecordL1FeesByGame(spend map[common.Address]metrics.L1FeeSpend)
+	RecordL1FeesIndexedBlock(blockNum uint64)
+}
+
+type FeeL1Source interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	InfoAndTxsByNumber(ctx context.Context, number uint64) (eth.BlockInfo, ethTypes.Transactions, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error)
+}
+
+// GameCreatedDecoder decodes the address of the game created by a transaction sent to the dispute game factory.
+type GameCreatedDecoder func(rcpt *ethTypes.Receipt) (common.Address, uint32, common.Hash, error)
+
+// FeeMonitor indexes the L1 transactions sent to the games in the game window, and attributes the L1 fees they
+// paid to the sender and the game. This quantifies the cost of each dispute for every actor involved.
+// Transactions that create a game through the dispute game factory are attributed to the created game.
+//
+// At startup, the index starts from the L1 head of the oldest game in the game window, and catches up
+// with the L1 head by at most maxBlocks blocks per update.
+type FeeMonitor struct {
+	ctx               context.Context
+	logger            log.Logger
+	metrics           FeeMetrics
+	l1                FeeL1Source
+	fetchHeadBlock    HeadBlockFetcher
+	factory           common.Address
+	decodeGameCreated GameCreatedDecoder
+	maxBlocks         uint64
+
+	signer    ethTypes.Signer
+	started   bool
+	nextBlock uint64
+	// spend is the L1 fees paid for transactions sent to a game, keyed by game and then by sender
+	spend map[common.Address]map[common.Address]*metrics.L1FeeSpend
+}
+
+func NewFeeMonitor(
+	ctx context.Context,
+	logger log.Logger,
+	m FeeMetrics,
+	l1 FeeL1Source,
+	fetchHeadBlock HeadBlockFetcher,
+	factory common.Address,
+	decodeGameCreated GameCreatedDecoder,
+	maxBlocks uint64,
+) *FeeMonitor {
+	return &FeeMonitor{
+		ctx:               ctx,
+		logger:            logger,
+		metrics:           m,
+		l1:                l1,
+		fetchHeadBlock:    fetchHeadBlock,
+		factory:           factory,
+		decodeGameCreated: decodeGameCreated,
+		maxBlocks:         maxBlocks,
+		spend:             make(map[common.Address]map[common.Address]*metrics.L1FeeSpend),
+	}
+}
+
+func (m *FeeMonitor) CheckFees(games []*types.EnrichedGameData) {
+	if err := m.index(games); err != nil {
+		m.logger.Error("Failed to index L1 fees of games", "err", err)
+	}
+	m.recordSpend(games)
+}
+
+func (m *FeeMonitor) index(games []*types.EnrichedGameData) error {
+	head, err := m.fetchHeadBlock(m.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head block: %w", err)
+	}
+	if head.Number < feeIndexConfirmations {
+		return nil
+	}
+	target := head.Number - feeIndexConfirmations
+	if m.signer == nil {
+		chainID, err := m.l1.ChainID(m.ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch chain ID: %w", err)
+		}
+		m.signer = ethTypes.LatestSignerForChainID(chainID)
+	}
+
+	inWindow := make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		inWindow[game.Proxy] = true
+	}
+	if !m.started {
+		// Games are created after their L1 head, so starting from the oldest L1 head includes all their transactions.
+		m.nextBlock = target + 1
+		for _, game := range games {
+			m.nextBlock = min(m.nextBlock, game.L1HeadNum+1)
+		}
+		m.started = true
+		m.logger.Info("Starting L1 fee index", "startBlock", m.nextBlock, "head", target)
+	}
+
+	end := min(target, m.nextBlock+m.maxBlocks-1)
+	for ; m.nextBlock <= end; m.nextBlock++ {
+		if err = m.indexBlock(m.nextBlock, inWindow); err != nil {
+			err = fmt.Errorf("failed to index block %v: %w", m.nextBlock, err)
+			break
+		}
+	}
+	if m.nextBlock > 0 {
+		m.metrics.RecordL1FeesIndexedBlock(m.nextBlock - 1)
+	}
+	if err == nil && m.nextBlock <= target {
+		m.logger.Info("L1 fee index catching up", "indexed", m.nextBlock-1, "head", target)
+	}
+	return err
+}
+
+type feeAttribution struct {
+	game   common.Address
+	sender common.Address
+	fee    *big.Int
+}
+
+// indexBlock attributes the fees of the transactions of the block. The fees are only attributed if all transactions
+// of the block could be processed, so the block can be retried without attributing fees twice.
+func (m *FeeMonitor) indexBlock(blockNum uint64, inWindow map[common.Address]bool) error {
+	_, txs, err := m.l1.InfoAndTxsByNumber(m.ctx, blockNum)
+	if err != nil {
+		return fmt.Errorf("failed to fetch transactions: %w", err)
+	}
+	var attributions []feeAttribution
+	for _, tx := range txs {
+		to := tx.To()
+		if to == nil || (*to != m.factory && !inWindow[*to]) {
+			continue
+		}
+		rcpt, err := m.l1.TransactionReceipt(m.ctx, tx.Hash())
+		if err != nil {
+			return fmt.Errorf("failed to fetch receipt of tx %v: %w", tx.Hash(), err)
+		}
+		game := *to
+		if game == m.factory {
+			created, _, _, err := m.decodeGameCreated(rcpt)
+			if err != nil {
+				// Not a game creation, e.g. a failed creation or an admin transaction.
+				continue
+			}
+			game = created
+			if !inWindow[game] {
+				continue
+			}
+		}
+		sender, err := ethTypes.Sender(m.signer, tx)
+		if err != nil {
+			return fmt.Errorf("failed to recover sender of tx %v: %w", tx.Hash(), err)
+		}
+		attributions = append(attributions, feeAttribution{game: game, sender: sender, fee: l1Fee(rcpt)})
+	}
+	for _, a := range attributions {
+		m.attribute(a.game, a.sender, a.fee)
+	}
+	return nil
+}
+
+func (m *FeeMonitor) attribute(game common.Address, sender common.Address, fee *big.Int) {
+	actors, ok := m.spend[game]
+	if !ok {
+		actors = make(map[common.Address]*metrics.L1FeeSpend)
+		m.spend[game] = actors
+	}
+	spend, ok := actors[sender]
+	if !ok {
+		spend = &metrics.L1FeeSpend{Fees: new(big.Int)}
+		actors[sender] = spend
+	}
+	spend.Fees.Add(spend.Fees, fee)
+	spend.Transactions++
+}
+
+// recordSpend records the L1 fees of the games in the game window, and forgets the fees of games that left it.
+func (m *FeeMonitor) recordSpend(games []*types.EnrichedGameData) {
+	inWindow := make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		inWindow[game.Proxy] = true
+	}
+	byActor := make(map[common.Address]metrics.L1FeeSpend)
+	byGame := make(map[common.Address]metrics.L1FeeSpend)
+	for game, actors := range m.spend {
+		if !inWindow[game] {
+			delete(m.spend, game)
+			continue
+		}
+		for actor, spend := range actors {
+			byActor[actor] = addSpend(byActor[actor], spend)
+			byGame[game] = addSpend(byGame[game], spend)
+		}
+	}
+	m.metrics.RecordL1FeesByActor(byActor)
+	m.metrics.RecordL1FeesByGame(byGame)
+}
+
+func addSpend(total metrics.L1FeeSpend, spend *metrics.L1FeeSpend) metrics.L1FeeSpend {
+	fees := new(big.Int).Set(spend.Fees)
+	if total.Fees != nil {
+		fees.Add(fees, total.Fees)
+	}
+	return metrics.L1FeeSpend{
+		Fees:         fees,
+		Transactions: total.Transactions + spend.Transactions,
+	}
+}
+
+// l1Fee is the execution and blob fee paid by the transaction of the receipt.
+func l1Fee(rcpt *ethTypes.Receipt) *big.Int {
+	fee := new(big.Int)
+	if rcpt.EffectiveGasPrice != nil {
+		fee.Mul(rcpt.EffectiveGasPrice, new(big.Int).SetUint64(rcpt.GasUsed))
+	}
+	if rcpt.BlobGasPrice != nil {
+		fee.Add(fee, new(big.Int).Mul(rcpt.BlobGasPrice, new(big.Int).SetUint64(rcpt.BlobGasUsed)))
+	}
+	return fee
+}
@@ -0,0 +1,242 @@
+package mon
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	feeChainID = big.NewInt(900)
+	feeFactory = common.Address{0xfa}
+	feeGame1   = common.Address{0x01}
+	feeGame2   = common.Address{0x02}
+)
+
+func TestCheckFees(t *testing.T) {
+	t.Run("AttributeByActorAndGame", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 100)
+		alice, bob := newFeeActor(t), newFeeActor(t)
+		l1.add(t, 11, alice, feeGame1, 100, 2)
+		l1.add(t, 11, bob, common.Address{0xee}, 1000, 2) // Not a game
+		l1.add(t, 12, bob, feeGame1, 200, 3)
+		l1.add(t, 12, alice, feeGame2, 300, 1)
+		l1.head = 15
+
+		monitor.CheckFees(feeGames(10, feeGame1, feeGame2))
+		require.Equal(t, uint64(12), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(500), Transactions: 2}, m.byActor[alice.addr])
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(600), Transactions: 1}, m.byActor[bob.addr])
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(800), Transactions: 2}, m.byGame[feeGame1])
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(300), Transactions: 1}, m.byGame[feeGame2])
+	})
+
+	t.Run("AttributeGameCreationToCreatedGame", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 100)
+		proposer := newFeeActor(t)
+		tx := l1.add(t, 11, proposer, feeFactory, 100, 2)
+		l1.created[tx.Hash()] = feeGame2
+		l1.add(t, 11, proposer, feeFactory, 100, 3) // Not a game creation
+		l1.head = 14
+
+		monitor.CheckFees(feeGames(10, feeGame1, feeGame2))
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 1}, m.byActor[proposer.addr])
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 1}, m.byGame[feeGame2])
+		require.NotContains(t, m.byGame, feeGame1)
+	})
+
+	t.Run("IncludeBlobFees", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 100)
+		alice := newFeeActor(t)
+		tx := l1.add(t, 11, alice, feeGame1, 100, 2)
+		l1.receipts[tx.Hash()].BlobGasUsed = 10
+		l1.receipts[tx.Hash()].BlobGasPrice = big.NewInt(3)
+		l1.head = 14
+
+		monitor.CheckFees(feeGames(10, feeGame1))
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(230), Transactions: 1}, m.byGame[feeGame1])
+	})
+
+	t.Run("CatchUpIncrementally", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 2)
+		alice := newFeeActor(t)
+		l1.add(t, 11, alice, feeGame1, 100, 1)
+		l1.add(t, 13, alice, feeGame1, 100, 1)
+		l1.head = 16
+		games := feeGames(10, feeGame1)
+
+		monitor.CheckFees(games)
+		require.Equal(t, uint64(12), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(100), Transactions: 1}, m.byGame[feeGame1])
+
+		monitor.CheckFees(games)
+		require.Equal(t, uint64(13), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 2}, m.byGame[feeGame1])
+
+		// Blocks are only indexed once
+		l1.head = 17
+		monitor.CheckFees(games)
+		require.Equal(t, uint64(14), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 2}, m.byGame[feeGame1])
+	})
+
+	t.Run("RetryFailedBlock", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 100)
+		alice := newFeeActor(t)
+		l1.add(t, 11, alice, feeGame1, 100, 1)
+		l1.add(t, 12, alice, feeGame1, 100, 1)
+		l1.head = 15
+		l1.failBlock = 12
+		games := feeGames(10, feeGame1)
+
+		monitor.CheckFees(games)
+		require.Equal(t, uint64(11), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(100), Transactions: 1}, m.byGame[feeGame1])
+
+		l1.failBlock = 0
+		monitor.CheckFees(games)
+		require.Equal(t, uint64(12), m.indexedBlock)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 2}, m.byGame[feeGame1])
+	})
+
+	t.Run("ForgetGamesOutsideWindow", func(t *testing.T) {
+		monitor, l1, m := setupFeeTest(t, 100)
+		alice := newFeeActor(t)
+		l1.add(t, 11, alice, feeGame1, 100, 1)
+		l1.add(t, 11, alice, feeGame2, 100, 2)
+		l1.head = 14
+
+		monitor.CheckFees(feeGames(10, feeGame1, feeGame2))
+		require.Len(t, m.byGame, 2)
+
+		monitor.CheckFees(feeGames(10, feeGame2))
+		require.Equal(t, map[common.Address]metrics.L1FeeSpend{
+			feeGame2: {Fees: big.NewInt(200), Transactions: 1},
+		}, m.byGame)
+		require.Equal(t, metrics.L1FeeSpend{Fees: big.NewInt(200), Transactions: 1}, m.byActor[alice.addr])
+	})
+}
+
+func setupFeeTest(t *testing.T, maxBlocks uint64) (*FeeMonitor, *stubFeeL1Source, *stubFeeMetrics) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	l1 := &stubFeeL1Source{
+		signer:   ethTypes.LatestSignerForChainID(feeChainID),
+		blocks:   make(map[uint64]ethTypes.Transactions),
+		receipts: make(map[common.Hash]*ethTypes.Receipt),
+		created:  make(map[common.Hash]common.Address),
+	}
+	m := &stubFeeMetrics{}
+	fetchHead := func(_ context.Context) (eth.L1BlockRef, error) {
+		return eth.L1BlockRef{Number: l1.head}, nil
+	}
+	monitor := NewFeeMonitor(context.Background(), logger, m, l1, fetchHead, feeFactory, l1.decodeGameCreated, maxBlocks)
+	return monitor, l1, m
+}
+
+func feeGames(l1HeadNum uint64, proxies ...common.Address) []*types.EnrichedGameData {
+	var games []*types.EnrichedGameData
+	for _, proxy := range proxies {
+		games = append(games, &types.EnrichedGameData{
+			GameMetadata: gameTypes.GameMetadata{Proxy: proxy},
+			L1HeadNum:    l1HeadNum,
+		})
+	}
+	return games
+}
+
+type feeActor struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newFeeActor(t *testing.T) *feeActor {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &feeActor{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+type stubFeeL1Source struct {
+	signer    ethTypes.Signer
+	head      uint64
+	failBlock uint64
+	blocks    map[uint64]ethTypes.Transactions
+	receipts  map[common.Hash]*ethTypes.Receipt
+	created   map[common.Hash]common.Address
+}
+
+// add adds a transaction from the actor to the block, paying gasUsed*gasPrice in fees.
+func (s *stubFeeL1Source) add(t *testing.T, blockNum uint64, from *feeActor, to common.Address, gasUsed uint64, gasPrice int64) *ethTypes.Transaction {
+	tx, err := ethTypes.SignNewTx(from.key, s.signer, &ethTypes.DynamicFeeTx{
+		ChainID:   feeChainID,
+		Nonce:     uint64(len(s.receipts)),
+		To:        &to,
+		Gas:       gasUsed,
+		GasFeeCap: big.NewInt(gasPrice),
+	})
+	require.NoError(t, err)
+	s.blocks[blockNum] = append(s.blocks[blockNum], tx)
+	s.receipts[tx.Hash()] = &ethTypes.Receipt{
+		TxHash:            tx.Hash(),
+		GasUsed:           gasUsed,
+		EffectiveGasPrice: big.NewInt(gasPrice),
+	}
+	return tx
+}
+
+func (s *stubFeeL1Source) ChainID(_ context.Context) (*big.Int, error) {
+	return feeChainID, nil
+}
+
+func (s *stubFeeL1Source) InfoAndTxsByNumber(_ context.Context, number uint64) (eth.BlockInfo, ethTypes.Transactions, error) {
+	if number == s.failBlock {
+		return nil, nil, errors.New("boom")
+	}
+	return nil, s.blocks[number], nil
+}
+
+func (s *stubFeeL1Source) TransactionReceipt(_ context.Context, txHash common.Hash) (*ethTypes.Receipt, error) {
+	rcpt, ok := s.receipts[txHash]
+	if !ok {
+		return nil, ErrReceiptNotFound
+	}
+	return rcpt, nil
+}
+
+func (s *stubFeeL1Source) decodeGameCreated(rcpt *ethTypes.Receipt) (common.Address, uint32, common.Hash, error) {
+	game, ok := s.created[rcpt.TxHash]
+	if !ok {
+		return common.Address{}, 0, common.Hash{}, errors.New("not a game creation")
+	}
+	return game, 0, common.Hash{}, nil
+}
+
+type stubFeeMetrics struct {
+	byActor      map[common.Address]metrics.L1FeeSpend
+	byGame       map[common.Address]metrics.L1FeeSpend
+	indexedBlock uint64
+}
+
+func (s *stubFeeMetrics) RecordL1FeesByActor(spend map[common.Address]metrics.L1FeeSpend) {
+	s.byActor = spend
+}
+
+func (s *stubFeeMetrics) RecordL1FeesByGame(spend map[common.Address]metrics.L1FeeSpend) {
+	s.byGame = spend
+}
+
+func (s *stubFeeMetrics) RecordL1FeesIndexedBlock(blockNum uint64) {
+	s.indexedBlock = blockNum
+}
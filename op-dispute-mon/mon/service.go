@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
@@ -41,9 +43,11 @@ type Service struct {
 	extractor    *extract.Extractor
 	forecast     *Forecast
 	bonds        *bonds.Bonds
+	delayedWETH  *bonds.DelayedWETHMonitor
 	game         *extract.GameCallerCreator
 	resolutions  *ResolutionMonitor
 	claims       *ClaimMonitor
+	credibility  *CredibilityMonitor
 	withdrawals  *WithdrawalMonitor
 	rollupClient *sources.RollupClient
 
@@ -91,6 +95,7 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	}
 
 	s.initClaimMonitor(cfg)
+	s.initCredibilityMonitor()
 	s.initResolutionMonitor()
 	s.initWithdrawalMonitor()
 
@@ -100,6 +105,7 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 
 	s.initForecast(cfg)
 	s.initBonds()
+	s.initDelayedWETHMonitor(cfg)
 
 	s.initMonitor(ctx, cfg) // Monitor must be initialized last
 
@@ -113,6 +119,10 @@ func (s *Service) initClaimMonitor(cfg *config.Config) {
 	s.claims = NewClaimMonitor(s.logger, s.cl, s.honestActors, s.metrics)
 }
 
+func (s *Service) initCredibilityMonitor() {
+	s.credibility = NewCredibilityMonitor(s.logger, s.metrics)
+}
+
 func (s *Service) initResolutionMonitor() {
 	s.resolutions = NewResolutionMonitor(s.logger, s.metrics, s.cl)
 }
@@ -133,6 +143,7 @@ func (s *Service) initExtractor(cfg *config.Config) {
 		s.factoryContract.GetGamesAtOrAfter,
 		cfg.IgnoredGames,
 		cfg.MaxConcurrency,
+		extract.NewCoverage(s.logger, s.cl, s.metrics, cfg.ValidationDeadline, cfg.ValidationQueueSize),
 		extract.NewClaimEnricher(),
 		extract.NewRecipientEnricher(), // Must be called before WithdrawalsEnricher and BondEnricher
 		extract.NewWithdrawalsEnricher(),
@@ -151,6 +162,10 @@ func (s *Service) initBonds() {
 	s.bonds = bonds.NewBonds(s.logger, s.metrics, s.cl)
 }
 
+func (s *Service) initDelayedWETHMonitor(cfg *config.Config) {
+	s.delayedWETH = bonds.NewDelayedWETHMonitor(s.logger, s.metrics, s.cl, s.honestActors, cfg.LargeCreditThreshold)
+}
+
 func (s *Service) initOutputRollupClient(ctx context.Context, cfg *config.Config) error {
 	outputRollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, s.logger, cfg.RollupRpc)
 	if err != nil {
@@ -226,15 +241,42 @@ func (s *Service) initMonitor(ctx context.Context, cfg *config.Config) {
 	}
 	l2ChallengesMonitor := NewL2ChallengesMonitor(s.logger, s.metrics)
 	updateTimeMonitor := NewUpdateTimeMonitor(s.cl, s.metrics)
-	s.monitor = newGameMonitor(ctx, s.logger, s.cl, s.metrics, cfg.MonitorInterval, cfg.GameWindow, headBlockFetcher,
-		s.extractor.Extract,
-		s.forecast.Forecast,
+	monitors := []Monitor{
 		s.bonds.CheckBonds,
+		s.delayedWETH.CheckDelayedWETH,
 		s.resolutions.CheckResolutions,
 		s.claims.CheckClaims,
+		s.credibility.CheckCredibility,
 		s.withdrawals.CheckWithdrawals,
 		l2ChallengesMonitor.CheckL2Challenges,
-		updateTimeMonitor.CheckUpdateTimes)
+		updateTimeMonitor.CheckUpdateTimes,
+	}
+	if cfg.MaxFeeIndexBlocks > 0 {
+		feeMonitor := NewFeeMonitor(ctx, s.logger, s.metrics, &l1FeeSource{s.l1Client, s.l1RPC}, headBlockFetcher,
+			cfg.GameFactoryAddress, s.factoryContract.DecodeDisputeGameCreatedLog, cfg.MaxFeeIndexBlocks)
+		monitors = append(monitors, feeMonitor.CheckFees)
+	}
+	s.monitor = newGameMonitor(ctx, s.logger, s.cl, s.metrics, cfg.MonitorInterval, cfg.GameWindow, headBlockFetcher,
+		s.extractor.Extract,
+		s.forecast.Forecast,
+		monitors...)
+}
+
+// l1FeeSource provides the L1 transactions and receipts to attribute L1 fees to games.
+type l1FeeSource struct {
+	*sources.L1Client
+	rpc rpcclient.RPC
+}
+
+func (s *l1FeeSource) TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error) {
+	var rcpt *ethTypes.Receipt
+	if err := s.rpc.CallContext(ctx, &rcpt, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, err
+	}
+	if rcpt == nil {
+		return nil, ErrReceiptNotFound
+	}
+	return rcpt, nil
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -64,6 +64,7 @@ type InteropSetup struct {
 type InteropActors struct {
 	L1Miner    *helpers.L1Miner
 	Supervisor *SupervisorActor
+	DepSet     *depset.StaticConfigDependencySet
 	ChainA     *Chain
 	ChainB     *Chain
 }
@@ -122,6 +123,7 @@ func (is *InteropSetup) CreateActors() *InteropActors {
 	return &InteropActors{
 		L1Miner:    l1Miner,
 		Supervisor: supervisorAPI,
+		DepSet:     is.DepSet,
 		ChainA:     chainA,
 		ChainB:     chainB,
 	}
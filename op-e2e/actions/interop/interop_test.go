@@ -485,6 +485,7 @@ func WithInteropEnabled(actors *InteropActors, agreedPrestate []byte, disputedCl
 		f.L2OutputRoot = crypto.Keccak256Hash(agreedPrestate)
 		f.L2Claim = disputedClaim
 		f.L2BlockNumber = claimTimestamp
+		f.DependencySet = actors.DepSet
 
 		// TODO: Remove these once hints all specify the L2 chain ID
 		f.L2ChainID = actors.ChainA.ChainID.ToBig().Uint64()
@@ -218,6 +218,7 @@ func NewOpProgramCfg(
 	dfault.L2ChainID = boot.CustomChainIDIndicator
 	if fi.InteropEnabled {
 		dfault.AgreedPrestate = fi.AgreedPrestate
+		dfault.DependencySet = fi.DependencySet
 	}
 	dfault.InteropEnabled = fi.InteropEnabled
 	return dfault
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"github.com/ethereum-optimism/optimism/op-e2e/actions/helpers"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -28,5 +29,6 @@ type FixtureInputs struct {
 	AgreedPrestate []byte      `toml:"agreed-prestate"`
 	InteropEnabled bool        `toml:"use-interop"`
 
-	L2Sources []*FaultProofProgramL2Source
+	L2Sources     []*FaultProofProgramL2Source
+	DependencySet *depset.StaticConfigDependencySet
 }
@@ -97,8 +97,14 @@ func RunFaultProofProgram(t helpers.Testing, logger log.Logger, l1 *helpers.L1Mi
 			sources, err := prefetcher.NewRetryingL2Sources(ctx, logger, programCfg.Rollups, rpcClients, nil)
 			require.NoError(t, err, "failed to create L2 client")
 
+			var configBundle []byte
+			if cfg.UsesConfigBundle() {
+				configBundle, err = cfg.ConfigBundle()
+				require.NoError(t, err, "failed to serialize config bundle")
+			}
+
 			executor := host.MakeProgramExecutor(logger, programCfg)
-			return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, fixtureInputs.L2ChainID, sources, kv, executor, cfg.L2Head, cfg.AgreedPrestate), nil
+			return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, fixtureInputs.L2ChainID, sources, kv, executor, cfg.L2Head, cfg.AgreedPrestate, configBundle), nil
 		})
 		ctx, cancel := context.WithTimeout(t.Ctx(), 2*time.Minute)
 		defer cancel()
@@ -21,6 +21,17 @@ func NewBlobStore() *BlobsStore {
 	return &BlobsStore{blobs: make(map[uint64]map[eth.IndexedBlobHash]*eth.Blob)}
 }
 
+// Copy returns a copy of the store, sharing the immutable blobs.
+func (store *BlobsStore) Copy() *BlobsStore {
+	out := NewBlobStore()
+	for blockTime, m := range store.blobs {
+		for indexedHash, blob := range m {
+			out.StoreBlob(blockTime, indexedHash, blob)
+		}
+	}
+	return out
+}
+
 func (store *BlobsStore) StoreBlob(blockTime uint64, indexedHash eth.IndexedBlobHash, blob *eth.Blob) {
 	m, ok := store.blobs[blockTime]
 	if !ok {
@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	"github.com/ethereum-optimism/optimism/op-service/endpoint"
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
@@ -205,6 +206,10 @@ func NewChallengerConfig(t *testing.T, sys EndpointProvider, l2NodeName string,
 		ListenAddr: "127.0.0.1",
 		ListenPort: 0, // Find any available port (avoids conflicts)
 	}
+	cfg.RPCConfig = oprpc.CLIConfig{
+		ListenAddr: "127.0.0.1",
+		ListenPort: 0, // Find any available port (avoids conflicts)
+	}
 	for _, option := range options {
 		option(&cfg)
 	}
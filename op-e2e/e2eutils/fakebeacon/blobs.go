@@ -211,6 +211,13 @@ func (f *FakeBeacon) LoadBlobsBundle(slot uint64) (*engine.BlobsBundleV1, error)
 	return &out, nil
 }
 
+// BlobStoreCopy returns a copy of the blob store of the beacon, e.g. to snapshot the blobs of the L1 chain.
+func (f *FakeBeacon) BlobStoreCopy() *e2eutils.BlobsStore {
+	f.blobsLock.Lock()
+	defer f.blobsLock.Unlock()
+	return f.blobStore.Copy()
+}
+
 func (f *FakeBeacon) Close() error {
 	var out error
 	if f.beaconSrv != nil {
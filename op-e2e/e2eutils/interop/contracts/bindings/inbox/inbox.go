@@ -1,210 +0,0 @@
-// Code generated - DO NOT EDIT.
-// This file is a generated binding and any manual changes will be lost.
-
-package inbox
-
-import (
-	"errors"
-	"math/big"
-	"strings"
-
-	ethereum "github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/event"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ = errors.New
-	_ = big.NewInt
-	_ = strings.NewReader
-	_ = ethereum.NotFound
-	_ = bind.Bind
-	_ = common.Big1
-	_ = types.BloomLookup
-	_ = event.NewSubscription
-)
-
-// Identifier is an auto generated low-level Go binding around an user-defined struct.
-type Identifier struct {
-	Origin      common.Address
-	BlockNumber *big.Int
-	LogIndex    *big.Int
-	Timestamp   *big.Int
-	ChainId     *big.Int
-}
-
-// InboxMetaData contains all meta data concerning the Inbox contract.
-var InboxMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"function\",\"name\":\"executeMessage\",\"inputs\":[{\"name\":\"_id\",\"type\":\"tuple\",\"internalType\":\"structIdentifier\",\"components\":[{\"name\":\"origin\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"blockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"logIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"_target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_message\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"validateMessage\",\"inputs\":[{\"name\":\"_id\",\"type\":\"tuple\",\"internalType\":\"structIdentifier\",\"components\":[{\"name\":\"origin\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"blockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"logIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"_msgHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
-}
-
-// InboxABI is the input ABI used to generate the binding from.
-// Deprecated: Use InboxMetaData.ABI instead.
-var InboxABI = InboxMetaData.ABI
-
-// Inbox is an auto generated Go binding around an Ethereum contract.
-type Inbox struct {
-	InboxCaller     // Read-only binding to the contract
-	InboxTransactor // Write-only binding to the contract
-	InboxFilterer   // Log filterer for contract events
-}
-
-// InboxCaller is an auto generated read-only Go binding around an Ethereum contract.
-type InboxCaller struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// InboxTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type InboxTransactor struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// InboxFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type InboxFilterer struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// InboxSession is an auto generated Go binding around an Ethereum contract,
-// with pre-set call and transact options.
-type InboxSession struct {
-	Contract     *Inbox            // Generic contract binding to set the session for
-	CallOpts     bind.CallOpts     // Call options to use throughout this session
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
-}
-
-// InboxCallerSession is an auto generated read-only Go binding around an Ethereum contract,
-// with pre-set call options.
-type InboxCallerSession struct {
-	Contract *InboxCaller  // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts // Call options to use throughout this session
-}
-
-// InboxTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
-// with pre-set transact options.
-type InboxTransactorSession struct {
-	Contract     *InboxTransactor  // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
-}
-
-// InboxRaw is an auto generated low-level Go binding around an Ethereum contract.
-type InboxRaw struct {
-	Contract *Inbox // Generic contract binding to access the raw methods on
-}
-
-// InboxCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type InboxCallerRaw struct {
-	Contract *InboxCaller // Generic read-only contract binding to access the raw methods on
-}
-
-// InboxTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type InboxTransactorRaw struct {
-	Contract *InboxTransactor // Generic write-only contract binding to access the raw methods on
-}
-
-// NewInbox creates a new instance of Inbox, bound to a specific deployed contract.
-func NewInbox(address common.Address, backend bind.ContractBackend) (*Inbox, error) {
-	contract, err := bindInbox(address, backend, backend, backend)
-	if err != nil {
-		return nil, err
-	}
-	return &Inbox{InboxCaller: InboxCaller{contract: contract}, InboxTransactor: InboxTransactor{contract: contract}, InboxFilterer: InboxFilterer{contract: contract}}, nil
-}
-
-// NewInboxCaller creates a new read-only instance of Inbox, bound to a specific deployed contract.
-func NewInboxCaller(address common.Address, caller bind.ContractCaller) (*InboxCaller, error) {
-	contract, err := bindInbox(address, caller, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &InboxCaller{contract: contract}, nil
-}
-
-// NewInboxTransactor creates a new write-only instance of Inbox, bound to a specific deployed contract.
-func NewInboxTransactor(address common.Address, transactor bind.ContractTransactor) (*InboxTransactor, error) {
-	contract, err := bindInbox(address, nil, transactor, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &InboxTransactor{contract: contract}, nil
-}
-
-// NewInboxFilterer creates a new log filterer instance of Inbox, bound to a specific deployed contract.
-func NewInboxFilterer(address common.Address, filterer bind.ContractFilterer) (*InboxFilterer, error) {
-	contract, err := bindInbox(address, nil, nil, filterer)
-	if err != nil {
-		return nil, err
-	}
-	return &InboxFilterer{contract: contract}, nil
-}
-
-// bindInbox binds a generic wrapper to an already deployed contract.
-func bindInbox(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(InboxABI))
-	if err != nil {
-		return nil, err
-	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_Inbox *InboxRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Inbox.Contract.InboxCaller.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_Inbox *InboxRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Inbox.Contract.InboxTransactor.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_Inbox *InboxRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Inbox.Contract.InboxTransactor.contract.Transact(opts, method, params...)
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_Inbox *InboxCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Inbox.Contract.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_Inbox *InboxTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Inbox.Contract.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_Inbox *InboxTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Inbox.Contract.contract.Transact(opts, method, params...)
-}
-
-// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
-//
-// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
-func (_Inbox *InboxTransactor) ValidateMessage(opts *bind.TransactOpts, _id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
-	return _Inbox.contract.Transact(opts, "validateMessage", _id, _msgHash)
-}
-
-// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
-//
-// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
-func (_Inbox *InboxSession) ValidateMessage(_id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
-	return _Inbox.Contract.ValidateMessage(&_Inbox.TransactOpts, _id, _msgHash)
-}
-
-// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
-//
-// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
-func (_Inbox *InboxTransactorSession) ValidateMessage(_id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
-	return _Inbox.Contract.ValidateMessage(&_Inbox.TransactOpts, _id, _msgHash)
-}
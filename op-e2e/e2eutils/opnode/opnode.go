@@ -38,6 +38,10 @@ func (o *Opnode) RuntimeConfig() rollupNode.ReadonlyRuntimeConfig {
 	return o.node.RuntimeConfig()
 }
 
+func (o *Opnode) CheckpointSafeDB(dir string) error {
+	return o.node.CheckpointSafeDB(dir)
+}
+
 func (o *Opnode) P2P() p2p.Node {
 	return o.node.P2P()
 }
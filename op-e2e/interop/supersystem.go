@@ -34,7 +34,6 @@ import (
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/fakebeacon"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/geth"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/interop/contracts/bindings/emit"
-	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/interop/contracts/bindings/inbox"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/interop/contracts/bindings/systemconfig"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/opnode"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/services"
@@ -56,6 +55,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys/bindings"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -614,7 +614,7 @@ func (s *interopE2ESystem) prepareContracts() {
 	l1GethClient := s.L1GethClient()
 	for id, l2Dep := range s.worldDeployment.L2s {
 		{
-			contract, err := inbox.NewInbox(predeploys.CrossL2InboxAddr, s.L2GethClient(id))
+			contract, err := bindings.NewCrossL2Inbox(predeploys.CrossL2InboxAddr, s.L2GethClient(id))
 			require.NoError(s.t, err)
 			s.l2s[id].contracts["inbox"] = contract
 		}
@@ -751,17 +751,13 @@ func (s *interopE2ESystem) ValidateMessage(
 	require.NoError(s.t, err)
 
 	auth.GasLimit = uint64(3000_000)
-	auth.GasPrice = big.NewInt(20_000_000_000)
-
-	contract := s.Contract(id, "inbox").(*inbox.Inbox)
-	identifier := inbox.Identifier{
-		Origin:      msgIdentifier.Origin,
-		BlockNumber: new(big.Int).SetUint64(msgIdentifier.BlockNumber),
-		LogIndex:    new(big.Int).SetUint64(uint64(msgIdentifier.LogIndex)),
-		Timestamp:   new(big.Int).SetUint64(msgIdentifier.Timestamp),
-		ChainId:     msgIdentifier.ChainID.ToBig(),
-	}
-	tx, err := contract.InboxTransactor.ValidateMessage(auth, identifier, msgHash)
+	auth.GasFeeCap = big.NewInt(20_000_000_000)
+	auth.GasTipCap = big.NewInt(20_000_000_000)
+	// the CrossL2Inbox only accepts messages declared in the access-list
+	auth.AccessList = bindings.ExecutingMessagesAccessList(supervisortypes.Message{Identifier: msgIdentifier, PayloadHash: msgHash})
+
+	contract := s.Contract(id, "inbox").(*bindings.CrossL2Inbox)
+	tx, err := contract.CrossL2InboxTransactor.ValidateMessage(auth, bindings.NewIdentifier(msgIdentifier), msgHash)
 	if expectedError != nil {
 		require.ErrorContains(s.t, err, expectedError.Error())
 		return nil, err
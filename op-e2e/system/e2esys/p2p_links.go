@@ -0,0 +1,77 @@
+package e2esys
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+)
+
+var errSimulatedPacketLoss = errors.New("simulated packet loss")
+
+// P2PLink identifies the link between two nodes of the P2P topology. The order of the nodes doesn't matter.
+type P2PLink struct {
+	A, B string
+}
+
+// P2PLinkConditions are the simulated network conditions of a link between two in-process p2p hosts.
+type P2PLinkConditions struct {
+	// Latency is the delay of all data sent over the link, in both directions.
+	Latency time.Duration
+	// PacketLoss is the probability in [0, 1] that a gossip RPC sent over the link is dropped by the receiver.
+	// Dropped RPCs are not retransmitted, so both messages and the gossip control messages they carry are lost.
+	PacketLoss float64
+}
+
+// p2pLinkConditions returns the conditions of the link between nodes a and b.
+func (cfg *SystemConfig) p2pLinkConditions(a, b string) P2PLinkConditions {
+	if conditions, ok := cfg.P2PLinks[P2PLink{A: a, B: b}]; ok {
+		return conditions
+	}
+	if conditions, ok := cfg.P2PLinks[P2PLink{A: b, B: a}]; ok {
+		return conditions
+	}
+	return cfg.P2PLinkDefaults
+}
+
+// gossipPacketLoss drops the gossip RPCs a host receives with the packet loss of the link to the sending peer.
+type gossipPacketLoss struct {
+	mu   sync.Mutex
+	loss map[peer.ID]float64
+}
+
+func newGossipPacketLoss() *gossipPacketLoss {
+	return &gossipPacketLoss{loss: make(map[peer.ID]float64)}
+}
+
+func (g *gossipPacketLoss) setLoss(from peer.ID, loss float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.loss[from] = loss
+}
+
+func (g *gossipPacketLoss) inspect(from peer.ID, _ *pubsub.RPC) error {
+	g.mu.Lock()
+	loss := g.loss[from]
+	g.mu.Unlock()
+	if loss > 0 && rand.Float64() < loss {
+		return errSimulatedPacketLoss
+	}
+	return nil
+}
+
+func (g *gossipPacketLoss) gossipOption() pubsub.Option {
+	return pubsub.WithAppSpecificRpcInspector(g.inspect)
+}
+
+// applyLinkConditions applies the conditions to the mocknet link between two hosts.
+// The packet loss is applied by the gossip of each host, for the RPCs received from the other.
+func applyLinkConditions(link mocknet.Link, conditions P2PLinkConditions, lossA, lossB *gossipPacketLoss, peerA, peerB peer.ID) {
+	link.SetOptions(mocknet.LinkOptions{Latency: conditions.Latency})
+	lossA.setLoss(peerB, conditions.PacketLoss)
+	lossB.setLoss(peerA, conditions.PacketLoss)
+}
@@ -23,6 +23,7 @@ import (
 
 	ds "github.com/ipfs/go-datastore"
 	dsSync "github.com/ipfs/go-datastore/sync"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	ic "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -284,6 +285,13 @@ type SystemConfig struct {
 	// Enables req-resp sync in the P2P nodes
 	P2PReqRespSync bool
 
+	// P2PLinkDefaults are the simulated network conditions of the links in the P2P topology without explicit conditions.
+	// The zero value is a perfect link.
+	P2PLinkDefaults P2PLinkConditions
+
+	// P2PLinks overrides the simulated network conditions of individual links in the P2P topology.
+	P2PLinks map[P2PLink]P2PLinkConditions
+
 	// If the proposer can make proposals for L2 blocks derived from L1 blocks which are not finalized on L1 yet.
 	NonFinalizedProposals bool
 
@@ -327,6 +335,10 @@ type SystemConfig struct {
 	// SupportL1TimeTravel determines if the L1 node supports quickly skipping forward in time
 	SupportL1TimeTravel bool
 
+	// ServiceClock, if set, drives the timers and tickers of the batcher and proposer instead of the system clock.
+	// Use a clock.DeterministicClock to advance the services deterministically instead of sleeping in tests.
+	ServiceClock clock.Clock
+
 	AllocType config.AllocType
 }
 
@@ -346,6 +358,7 @@ type System struct {
 	FakeAltDAServer   *altda.FakeDAServer
 
 	L1BeaconAPIAddr endpoint.RestHTTP
+	fakeBeacon      *fakebeacon.FakeBeacon
 
 	// TimeTravelClock is nil unless SystemConfig.SupportL1TimeTravel was set to true
 	// It provides access to the clock instance used by the L1 node. Calling TimeTravelClock.AdvanceBy
@@ -521,6 +534,11 @@ func (s *startOptions) Get(key, role string) (SystemConfigHook, bool) {
 }
 
 func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System, error) {
+	return cfg.start(t, nil, startOpts)
+}
+
+// start starts the system, from the state of the snapshot if it's not nil.
+func (cfg SystemConfig) start(t *testing.T, snap *Snapshot, startOpts []StartOption) (*System, error) {
 	parsedStartOpts, err := parseStartOptions(startOpts)
 	if err != nil {
 		return nil, err
@@ -648,10 +666,19 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 		return nil, err
 	}
 	sys.RollupConfig = &defaultConfig
+	if snap != nil {
+		if err := snap.checkGenesis(sys.RollupConfig); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create a fake Beacon node to hold on to blobs created by the L1 miner, and to serve them to L2
+	blobStore := e2eutils.NewBlobStore()
+	if snap != nil {
+		blobStore = snap.blobs.Copy()
+	}
 	bcn := fakebeacon.NewBeacon(testlog.Logger(t, log.LevelInfo).New("role", "l1_cl"),
-		e2eutils.NewBlobStore(), l1Genesis.Timestamp, cfg.DeployConfig.L1BlockTime)
+		blobStore, l1Genesis.Timestamp, cfg.DeployConfig.L1BlockTime)
 	t.Cleanup(func() {
 		_ = bcn.Close()
 	})
@@ -659,6 +686,7 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 	beaconApiAddr := bcn.BeaconAddr()
 	require.NotEmpty(t, beaconApiAddr, "beacon API listener must be up")
 	sys.L1BeaconAPIAddr = endpoint.RestHTTPURL(beaconApiAddr)
+	sys.fakeBeacon = bcn
 
 	// Initialize nodes
 	l1Geth, err := geth.InitL1(
@@ -668,6 +696,11 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 		return nil, err
 	}
 	sys.EthInstances[RoleL1] = l1Geth
+	if snap != nil {
+		if err := snap.restoreChain(RoleL1, l1Geth); err != nil {
+			return nil, err
+		}
+	}
 	err = l1Geth.Node.Start()
 	if err != nil {
 		return nil, err
@@ -704,6 +737,11 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 		if err != nil {
 			return nil, err
 		}
+		if snap != nil {
+			if err := snap.restoreChain(name, l2Geth); err != nil {
+				return nil, err
+			}
+		}
 		if err := l2Geth.Node.Start(); err != nil {
 			return nil, err
 		}
@@ -730,6 +768,7 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 	sys.Mocknet = mocknet.New()
 
 	p2pNodes := make(map[string]*p2p.Prepared)
+	packetLoss := make(map[string]*gossipPacketLoss)
 	if cfg.P2PTopology != nil {
 		// create the peer if it doesn't exist yet.
 		initHostMaybe := func(name string) (*p2p.Prepared, error) {
@@ -747,13 +786,16 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 			}
 			// TODO we can enable discv5 in the testnodes to test discovery of new peers.
 			// Would need to mock though, and the discv5 implementation does not provide nice mocks here.
+			loss := newGossipPacketLoss()
 			p := &p2p.Prepared{
 				HostP2P:           h,
 				LocalNode:         nil,
 				UDPv5:             nil,
 				EnableReqRespSync: cfg.P2PReqRespSync,
+				GossipOptions:     []pubsub.Option{loss.gossipOption()},
 			}
 			p2pNodes[name] = p
+			packetLoss[name] = loss
 			return p, nil
 		}
 		for k, vs := range cfg.P2PTopology {
@@ -767,9 +809,11 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 				if err != nil {
 					return nil, fmt.Errorf("failed to setup mocknet peer %s (peer of %s)", v, k)
 				}
-				if _, err := sys.Mocknet.LinkPeers(peerA.HostP2P.ID(), peerB.HostP2P.ID()); err != nil {
+				link, err := sys.Mocknet.LinkPeers(peerA.HostP2P.ID(), peerB.HostP2P.ID())
+				if err != nil {
 					return nil, fmt.Errorf("failed to setup mocknet link between %s and %s", k, v)
 				}
+				applyLinkConditions(link, cfg.p2pLinkConditions(k, v), packetLoss[k], packetLoss[v], peerA.HostP2P.ID(), peerB.HostP2P.ID())
 				// connect the peers after starting the full rollup node
 			}
 		}
@@ -798,6 +842,12 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 			}
 		}
 
+		if snap != nil {
+			if err := snap.restoreSafeDB(name, c.SafeDBPath); err != nil {
+				return nil, fmt.Errorf("failed to restore safe head db of %s: %w", name, err)
+			}
+		}
+
 		c.Rollup.LogDescription(cfg.Loggers[name], chaincfg.L2ChainIDToNetworkDisplayName)
 		l := cfg.Loggers[name]
 
@@ -870,7 +920,13 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 			},
 		}
 	}
-	proposer, err := l2os.ProposerServiceFromCLIConfig(context.Background(), "0.0.1", proposerCLIConfig, sys.Cfg.Loggers["proposer"])
+	var proposerOpts []l2os.DriverSetupOption
+	if cfg.ServiceClock != nil {
+		proposerOpts = append(proposerOpts, func(setup *l2os.DriverSetup) {
+			setup.Clock = cfg.ServiceClock
+		})
+	}
+	proposer, err := l2os.ProposerServiceFromCLIConfig(context.Background(), "0.0.1", proposerCLIConfig, sys.Cfg.Loggers["proposer"], proposerOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to setup l2 output submitter: %w", err)
 	}
@@ -940,7 +996,13 @@ func (cfg SystemConfig) Start(t *testing.T, startOpts ...StartOption) (*System,
 	}
 
 	// Batch Submitter
-	batcher, err := bss.BatcherServiceFromCLIConfig(context.Background(), "0.0.1", batcherCLIConfig, sys.Cfg.Loggers["batcher"])
+	var batcherOpts []bss.DriverSetupOption
+	if cfg.ServiceClock != nil {
+		batcherOpts = append(batcherOpts, func(setup *bss.DriverSetup) {
+			setup.Clock = cfg.ServiceClock
+		})
+	}
+	batcher, err := bss.BatcherServiceFromCLIConfig(context.Background(), "0.0.1", batcherCLIConfig, sys.Cfg.Loggers["batcher"], batcherOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup batch submitter: %w", err)
 	}
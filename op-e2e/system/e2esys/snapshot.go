@@ -0,0 +1,221 @@
+package e2esys
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-e2e/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/geth"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/services"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+var ErrSnapshotGenesisMismatch = errors.New("system genesis does not match snapshot")
+
+// Snapshot is a copy of the state of a running System: the L1 and L2 chains of the execution engines,
+// the blobs of the L1 chain, and the safe head databases of the rollup nodes.
+// A snapshot can be restored any number of times with SystemConfig.StartFromSnapshot,
+// to run test cases from a common, expensive to set up, prefix.
+//
+// The batcher and proposer are not part of the snapshot: they recover their state from the restored chains.
+// Transactions pending in the transaction pools are not part of the snapshot either.
+type Snapshot struct {
+	deployConfig *genesis.DeployConfig
+	premine      map[common.Address]*big.Int
+	allocType    config.AllocType
+	genesis      rollup.Genesis
+
+	// chains by role
+	chains map[string]*chainSnapshot
+	blobs  *e2eutils.BlobsStore
+	// safe head database checkpoint directories by rollup node name
+	safeDBs map[string]string
+}
+
+type chainSnapshot struct {
+	// blocks after genesis, up to the head
+	blocks    types.Blocks
+	safe      common.Hash
+	finalized common.Hash
+}
+
+type safeDBCheckpointer interface {
+	CheckpointSafeDB(dir string) error
+}
+
+// Snapshot takes a snapshot of the running system.
+// The snapshot is stored in a temporary directory of the test that started the system,
+// so it can be restored until that test completes, e.g. in its subtests.
+func (sys *System) Snapshot() (*Snapshot, error) {
+	if sys.fakeBeacon == nil {
+		return nil, errors.New("system was not started")
+	}
+	snap := &Snapshot{
+		deployConfig: sys.Cfg.DeployConfig.Copy(),
+		premine:      make(map[common.Address]*big.Int, len(sys.Cfg.Premine)),
+		allocType:    sys.Cfg.AllocType,
+		genesis:      sys.RollupConfig.Genesis,
+		chains:       make(map[string]*chainSnapshot),
+		safeDBs:      make(map[string]string),
+	}
+	for addr, amount := range sys.Cfg.Premine {
+		snap.premine[addr] = new(big.Int).Set(amount)
+	}
+
+	// Snapshot everything that references L1 blocks before L1 itself, so the L1 snapshot includes all of them.
+	dir := sys.t.TempDir()
+	for name, node := range sys.RollupNodes {
+		if sys.Cfg.Nodes[name] == nil || sys.Cfg.Nodes[name].SafeDBPath == "" {
+			continue
+		}
+		checkpointer, ok := node.(safeDBCheckpointer)
+		if !ok {
+			return nil, fmt.Errorf("rollup node %s does not support safe head db snapshots", name)
+		}
+		checkpoint := filepath.Join(dir, "safedb-"+name)
+		if err := checkpointer.CheckpointSafeDB(checkpoint); err != nil {
+			return nil, fmt.Errorf("failed to snapshot safe head db of %s: %w", name, err)
+		}
+		snap.safeDBs[name] = checkpoint
+	}
+	for name, inst := range sys.EthInstances {
+		if name == RoleL1 {
+			continue
+		}
+		chain, err := snapshotChain(inst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s chain: %w", name, err)
+		}
+		snap.chains[name] = chain
+	}
+	l1Chain, err := snapshotChain(sys.EthInstances[RoleL1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot L1 chain: %w", err)
+	}
+	snap.chains[RoleL1] = l1Chain
+	snap.blobs = sys.fakeBeacon.BlobStoreCopy()
+	return snap, nil
+}
+
+// StartFromSnapshot starts a system like Start, but from the state of the snapshot.
+// The deploy config, premine and alloc type of the snapshot replace those of the config,
+// so the genesis of the restored system matches the snapshot.
+// Nodes of the config that aren't in the snapshot start from genesis and sync the restored chains.
+func (cfg SystemConfig) StartFromSnapshot(t *testing.T, snap *Snapshot, startOpts ...StartOption) (*System, error) {
+	cfg.DeployConfig = snap.deployConfig.Copy()
+	cfg.Premine = make(map[common.Address]*big.Int, len(snap.premine))
+	for addr, amount := range snap.premine {
+		cfg.Premine[addr] = new(big.Int).Set(amount)
+	}
+	cfg.AllocType = snap.allocType
+	return cfg.start(t, snap, startOpts)
+}
+
+// checkGenesis checks that the genesis of the rollup config matches the snapshot.
+func (snap *Snapshot) checkGenesis(rollupCfg *rollup.Config) error {
+	if rollupCfg.Genesis.L1 != snap.genesis.L1 || rollupCfg.Genesis.L2 != snap.genesis.L2 {
+		return fmt.Errorf("%w: expected L1 %s, L2 %s, but got L1 %s, L2 %s", ErrSnapshotGenesisMismatch,
+			snap.genesis.L1, snap.genesis.L2, rollupCfg.Genesis.L1, rollupCfg.Genesis.L2)
+	}
+	return nil
+}
+
+// restoreChain imports the snapshot chain of the role into the execution engine, which must not be started yet.
+func (snap *Snapshot) restoreChain(role string, inst *geth.GethInstance) error {
+	chain, ok := snap.chains[role]
+	if !ok {
+		return nil
+	}
+	bc := inst.Backend.BlockChain()
+	if n, err := bc.InsertChain(chain.blocks); err != nil {
+		return fmt.Errorf("failed to import %s block %d: %w", role, chain.blocks[n].NumberU64(), err)
+	}
+	if header := bc.GetHeaderByHash(chain.safe); header != nil {
+		bc.SetSafe(header)
+	}
+	if header := bc.GetHeaderByHash(chain.finalized); header != nil {
+		bc.SetFinalized(header)
+	}
+	return nil
+}
+
+// restoreSafeDB copies the snapshot safe head database of the rollup node to the path.
+func (snap *Snapshot) restoreSafeDB(name string, path string) error {
+	checkpoint, ok := snap.safeDBs[name]
+	if !ok || path == "" {
+		return nil
+	}
+	return copyDir(checkpoint, path)
+}
+
+func snapshotChain(inst services.EthInstance) (*chainSnapshot, error) {
+	gethInst, ok := inst.(*geth.GethInstance)
+	if !ok {
+		return nil, fmt.Errorf("unsupported execution engine %T", inst)
+	}
+	bc := gethInst.Backend.BlockChain()
+	out := &chainSnapshot{}
+	if safe := bc.CurrentSafeBlock(); safe != nil {
+		out.safe = safe.Hash()
+	}
+	if finalized := bc.CurrentFinalBlock(); finalized != nil {
+		out.finalized = finalized.Hash()
+	}
+	head := bc.CurrentBlock()
+	parent := bc.Genesis().Hash()
+	for i := uint64(1); i <= head.Number.Uint64(); i++ {
+		block := bc.GetBlockByNumber(i)
+		if block == nil || block.ParentHash() != parent {
+			return nil, fmt.Errorf("chain reorged while taking snapshot at block %d", i)
+		}
+		out.blocks = append(out.blocks, block)
+		parent = block.Hash()
+	}
+	return out, nil
+}
+
+// copyDir copies the files of the src directory tree to dst, which must not exist yet.
+func copyDir(src string, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		if entries, err := os.ReadDir(dst); err != nil || len(entries) > 0 {
+			return fmt.Errorf("destination %s is not empty", dst)
+		}
+	}
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			return errors.Join(err, out.Close())
+		}
+		return out.Close()
+	})
+}
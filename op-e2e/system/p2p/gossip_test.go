@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -202,3 +203,80 @@ func TestSystemDenseTopology(t *testing.T) {
 	require.Contains(t, received2, receiptSeq.BlockHash)
 	require.Contains(t, received3, receiptSeq.BlockHash)
 }
+
+// TestSystemP2PLinkConditions confirms that unsafe payloads still propagate between a sequencer and a verifier
+// over a link with simulated latency and packet loss, and that gossip is delayed by the latency of the link.
+func TestSystemP2PLinkConditions(t *testing.T) {
+	op_e2e.InitParallel(t)
+
+	const latency = 200 * time.Millisecond
+	cfg := e2esys.DefaultSystemConfig(t)
+	// Disable batcher, so we don't sync from L1 & set a large sequence window so we only have unsafe blocks
+	cfg.DisableBatcher = true
+	cfg.DeployConfig.SequencerWindowSize = 100_000
+	cfg.DeployConfig.MaxSequencerDrift = 100_000
+	// disable at the start, so we don't miss any gossiped blocks.
+	cfg.Nodes["sequencer"].Driver.SequencerStopped = true
+	// fill the gaps of lost payloads with req-resp sync
+	cfg.P2PReqRespSync = true
+
+	cfg.P2PTopology = map[string][]string{
+		"verifier": {"sequencer"},
+	}
+	cfg.P2PLinks = map[e2esys.P2PLink]e2esys.P2PLinkConditions{
+		{A: "sequencer", B: "verifier"}: {Latency: latency, PacketLoss: 0.1},
+	}
+
+	var mu sync.Mutex
+	published := make(map[common.Hash]time.Time)
+	var delays []time.Duration
+	seqTracer, verifTracer := new(opnode.FnTracer), new(opnode.FnTracer)
+	seqTracer.OnPublishL2PayloadFn = func(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) {
+		mu.Lock()
+		defer mu.Unlock()
+		published[payload.ExecutionPayload.BlockHash] = time.Now()
+	}
+	verifTracer.OnUnsafeL2PayloadFn = func(ctx context.Context, from peer.ID, payload *eth.ExecutionPayloadEnvelope) {
+		mu.Lock()
+		defer mu.Unlock()
+		if publishedAt, ok := published[payload.ExecutionPayload.BlockHash]; ok {
+			delays = append(delays, time.Since(publishedAt))
+		}
+	}
+	cfg.Nodes["sequencer"].Tracer = seqTracer
+	cfg.Nodes["verifier"].Tracer = verifTracer
+
+	sys, err := cfg.Start(t)
+	require.Nil(t, err, "Error starting up system")
+
+	verifierPeerID := sys.RollupNodes["verifier"].P2P().Host().ID()
+	check := func() bool {
+		sequencerBlocksTopicPeers := sys.RollupNodes["sequencer"].P2P().GossipOut().AllBlockTopicsPeers()
+		return slices.Contains[[]peer.ID](sequencerBlocksTopicPeers, verifierPeerID)
+	}
+	backOffStrategy := retry.Exponential()
+	for i := 0; i < 10; i++ {
+		if check() {
+			break
+		}
+		time.Sleep(backOffStrategy.Duration(i))
+	}
+	require.True(t, check(), "verifier must be meshed with sequencer for gossip test to proceed")
+
+	require.NoError(t, sys.RollupClient("sequencer").StartSequencer(context.Background(), sys.L2GenesisCfg.ToBlock().Hash()))
+
+	l2Seq := sys.NodeClient("sequencer")
+	l2Verif := sys.NodeClient("verifier")
+	helpers.SendL2Tx(t, cfg, l2Seq, cfg.Secrets.Alice, func(opts *helpers.TxOpts) {
+		opts.ToAddr = &common.Address{0xff, 0xff}
+		opts.Value = big.NewInt(1_000_000_000)
+		opts.VerifyOnClients(l2Verif)
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, delays, "no payloads received via gossip")
+	for _, delay := range delays {
+		require.GreaterOrEqual(t, delay, latency, "payload received faster than the link latency")
+	}
+}
@@ -0,0 +1,75 @@
+package verifier
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	op_e2e "github.com/ethereum-optimism/optimism/op-e2e"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-e2e/system/e2esys"
+	"github.com/ethereum-optimism/optimism/op-e2e/system/helpers"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSystemSnapshotRestore sets up a system with a safe L2 transaction, snapshots it,
+// and branches off two systems restored from the snapshot, which include the same transaction with the same nonce.
+func TestSystemSnapshotRestore(t *testing.T) {
+	op_e2e.InitParallel(t)
+
+	cfg := e2esys.DefaultSystemConfig(t)
+	cfg.Nodes[e2esys.RoleVerif].SafeDBPath = t.TempDir()
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+
+	receipt := helpers.SendL2Tx(t, sys.Cfg, sys.NodeClient(e2esys.RoleSeq), sys.Cfg.Secrets.Alice, func(opts *helpers.TxOpts) {
+		opts.Value = big.NewInt(1_000_000_000)
+		opts.ToAddr = &common.Address{0xff, 0xff}
+		opts.VerifyOnClients(sys.NodeClient(e2esys.RoleVerif))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	require.NoError(t, wait.ForSafeBlock(ctx, sys.RollupClient(e2esys.RoleVerif), receipt.BlockNumber.Uint64()))
+	status, err := sys.RollupClient(e2esys.RoleVerif).SyncStatus(ctx)
+	require.NoError(t, err)
+
+	snap, err := sys.Snapshot()
+	require.NoError(t, err)
+	sys.Close()
+
+	for i, recipient := range []common.Address{{0xaa}, {0xbb}} {
+		t.Run(fmt.Sprintf("branch-%d", i), func(t *testing.T) {
+			cfg := e2esys.DefaultSystemConfig(t)
+			cfg.Nodes[e2esys.RoleVerif].SafeDBPath = t.TempDir()
+			sys, err := cfg.StartFromSnapshot(t, snap)
+			require.NoError(t, err, "Error restoring system")
+			l2Seq := sys.NodeClient(e2esys.RoleSeq)
+			l2Verif := sys.NodeClient(e2esys.RoleVerif)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			restored, err := wait.ForReceiptOK(ctx, l2Verif, receipt.TxHash)
+			require.NoError(t, err)
+			require.Equal(t, receipt.BlockHash, restored.BlockHash, "transaction of the snapshot not restored")
+
+			safeHead, err := sys.RollupClient(e2esys.RoleVerif).SafeHeadAtL1Block(ctx, status.CurrentL1.Number)
+			require.NoError(t, err, "safe head db not restored")
+			require.GreaterOrEqual(t, safeHead.SafeHead.Number, receipt.BlockNumber.Uint64())
+
+			branchReceipt := helpers.SendL2Tx(t, sys.Cfg, l2Seq, sys.Cfg.Secrets.Alice, func(opts *helpers.TxOpts) {
+				opts.Nonce = 1
+				opts.Value = big.NewInt(1_000_000_000)
+				opts.ToAddr = &recipient
+				opts.VerifyOnClients(l2Verif)
+			})
+			require.Greater(t, branchReceipt.BlockNumber.Uint64(), receipt.BlockNumber.Uint64())
+			balance, err := l2Verif.BalanceAt(ctx, recipient, nil)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1_000_000_000), balance)
+		})
+	}
+}
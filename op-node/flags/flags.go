@@ -67,6 +67,14 @@ var (
 		Destination: new(string),
 		Category:    RollupCategory,
 	}
+	L2EngineStandbyAddrs = &cli.StringSliceFlag{
+		Name: "l2.standby",
+		Usage: "Addresses of standby L2 Engine JSON-RPC endpoints, sharing the JWT secret of the l2 endpoint. " +
+			"The rollup node fails over to the next standby endpoint when the active endpoint is unreachable, " +
+			"after verifying that the standby chain is consistent with the rollup node view of the L2 chain.",
+		EnvVars:  prefixEnvVars("L2_STANDBY"),
+		Category: RollupCategory,
+	}
 	BeaconAddr = &cli.StringFlag{
 		Name:     "l1.beacon",
 		Usage:    "Address of L1 Beacon-node HTTP endpoint to use.",
@@ -105,6 +113,16 @@ var (
 		EnvVars:  prefixEnvVars("L1_BEACON_FETCH_ALL_SIDECARS"),
 		Category: L1RPCCategory,
 	}
+	BeaconBuffered = &cli.BoolFlag{
+		Name: "l1.beacon.buffered",
+		Usage: "If true, blob retrieval from the L1 Beacon endpoint is retried with exponential backoff when the endpoint is down, " +
+			"and the degraded status is reported in the sync status, instead of retrying the endpoint on every derivation step. " +
+			"Derivation continues with the calldata batches of an L1 block while its blobs are queued for retrieval.",
+		Required: false,
+		Value:    false,
+		EnvVars:  prefixEnvVars("L1_BEACON_BUFFERED"),
+		Category: L1RPCCategory,
+	}
 	SyncModeFlag = &cli.GenericFlag{
 		Name:    "syncmode",
 		Usage:   fmt.Sprintf("Blockchain sync mode (options: %s)", openum.EnumString(sync.ModeStrings)),
@@ -237,6 +255,82 @@ var (
 		Value:    4,
 		Category: SequencerCategory,
 	}
+	SequencerTxIngressFlag = &cli.BoolFlag{
+		Name:     "sequencer.tx-ingress",
+		Usage:    "Serve eth_sendRawTransaction on the rollup-node RPC, and forward admitted transactions to the execution engine. The limits can be adjusted with the admin_setTxIngressLimits RPC.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS"),
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressGlobalRateFlag = &cli.Float64Flag{
+		Name:     "sequencer.tx-ingress.global-rate",
+		Usage:    "Maximum number of transactions per second admitted from all senders combined. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_GLOBAL_RATE"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressGlobalBurstFlag = &cli.IntFlag{
+		Name:     "sequencer.tx-ingress.global-burst",
+		Usage:    "Maximum burst of transactions admitted from all senders combined, above the global rate.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_GLOBAL_BURST"),
+		Value:    100,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressSenderRateFlag = &cli.Float64Flag{
+		Name:     "sequencer.tx-ingress.sender-rate",
+		Usage:    "Maximum number of transactions per second admitted from a single sender. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_SENDER_RATE"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressSenderBurstFlag = &cli.IntFlag{
+		Name:     "sequencer.tx-ingress.sender-burst",
+		Usage:    "Maximum burst of transactions admitted from a single sender, above the sender rate.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_SENDER_BURST"),
+		Value:    10,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressMaxTxSizeFlag = &cli.Uint64Flag{
+		Name:     "sequencer.tx-ingress.max-tx-size",
+		Usage:    "Maximum size of an encoded transaction, in bytes. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_MAX_TX_SIZE"),
+		Value:    128 * 1024,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressDustValueFlag = &cli.Uint64Flag{
+		Name:     "sequencer.tx-ingress.dust-value",
+		Usage:    "Value in wei below which a transfer without calldata adds to the spam score of the sender. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_DUST_VALUE"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerTxIngressMaxSpamScoreFlag = &cli.Float64Flag{
+		Name:     "sequencer.tx-ingress.max-spam-score",
+		Usage:    "Spam score at which transactions of a sender are rejected. Dust transfers and reverted transactions add to the score, which decays over time. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_INGRESS_MAX_SPAM_SCORE"),
+		Value:    0,
+		Category: SequencerCategory,
+	}
+	SequencerTxFeedFlag = &cli.StringFlag{
+		Name: "sequencer.tx-feed",
+		Usage: "HTTP or WebSocket address of an ordered transaction feed to build blocks from, instead of the execution engine mempool. " +
+			"The sequencer requests the transactions of every block with txfeed_nextTransactions. Disabled if empty.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_FEED"),
+		Category: SequencerCategory,
+	}
+	SequencerTxFeedJWTSecretFlag = &cli.StringFlag{
+		Name:      "sequencer.tx-feed.jwt-secret",
+		Usage:     "Path to the JWT secret key to authenticate to the tx feed. Keys are 32 bytes, hex encoded in a file.",
+		EnvVars:   prefixEnvVars("SEQUENCER_TX_FEED_JWT_SECRET"),
+		TakesFile: true,
+		Category:  SequencerCategory,
+	}
+	SequencerTxFeedTimeoutFlag = &cli.DurationFlag{
+		Name:     "sequencer.tx-feed.timeout",
+		Usage:    "Timeout of a request for the transactions of a block to the tx feed. A block is built without transactions when the request fails.",
+		EnvVars:  prefixEnvVars("SEQUENCER_TX_FEED_TIMEOUT"),
+		Value:    time.Second,
+		Category: SequencerCategory,
+	}
 	L1EpochPollIntervalFlag = &cli.DurationFlag{
 		Name:     "l1.epoch-poll-interval",
 		Usage:    "Poll interval for retrieving new L1 epoch updates such as safe and finalized block changes. Disabled if 0 or negative.",
@@ -251,6 +345,12 @@ var (
 		Value:    time.Minute * 10,
 		Category: L1RPCCategory,
 	}
+	RuntimeConfigAddressFlag = &cli.StringFlag{
+		Name:     "l1.runtime-config-address",
+		Usage:    "Address of the L1 contract to load the extended runtime config from, such as the gossip limits. Disabled if empty.",
+		EnvVars:  prefixEnvVars("L1_RUNTIME_CONFIG_ADDRESS"),
+		Category: L1RPCCategory,
+	}
 	MetricsEnabledFlag = &cli.BoolFlag{
 		Name:     "metrics.enabled",
 		Usage:    "Enable the metrics server",
@@ -317,6 +417,68 @@ var (
 		EnvVars:  prefixEnvVars("SAFEDB_PATH"),
 		Category: OperationsCategory,
 	}
+	AttestEnabledFlag = &cli.BoolFlag{
+		Name:     "attest.enabled",
+		Usage:    "Periodically sign the safe and finalized heads with the p2p sequencer key, and serve the recent attestations over HTTP at /attestations.",
+		EnvVars:  prefixEnvVars("ATTEST_ENABLED"),
+		Category: OperationsCategory,
+	}
+	AttestAddrFlag = &cli.StringFlag{
+		Name:     "attest.addr",
+		Usage:    "Attestation server listening address",
+		EnvVars:  prefixEnvVars("ATTEST_ADDR"),
+		Value:    "0.0.0.0",
+		Category: OperationsCategory,
+	}
+	AttestPortFlag = &cli.IntFlag{
+		Name:     "attest.port",
+		Usage:    "Attestation server listening port",
+		EnvVars:  prefixEnvVars("ATTEST_PORT"),
+		Value:    7310,
+		Category: OperationsCategory,
+	}
+	AttestIntervalFlag = &cli.DurationFlag{
+		Name:     "attest.interval",
+		Usage:    "Interval between attestations of the safe and finalized heads.",
+		EnvVars:  prefixEnvVars("ATTEST_INTERVAL"),
+		Value:    time.Minute,
+		Category: OperationsCategory,
+	}
+	AttestHistorySizeFlag = &cli.IntFlag{
+		Name:     "attest.history-size",
+		Usage:    "Number of recent attestations to serve.",
+		EnvVars:  prefixEnvVars("ATTEST_HISTORY_SIZE"),
+		Value:    60,
+		Category: OperationsCategory,
+	}
+	LogIndexAddressesFlag = &cli.StringSliceFlag{
+		Name: "log-index.addresses",
+		Usage: "Contracts to locally index the logs of, served over RPC with optimism_indexedLogs. " +
+			"Accepts predeploy names, e.g. L2ToL2CrossDomainMessenger, or addresses. Disabled if empty.",
+		EnvVars:  prefixEnvVars("LOG_INDEX_ADDRESSES"),
+		Category: OperationsCategory,
+	}
+	LogIndexRetentionFlag = &cli.Uint64Flag{
+		Name:     "log-index.retention",
+		Usage:    "Number of recent L2 blocks to keep the indexed logs of.",
+		EnvVars:  prefixEnvVars("LOG_INDEX_RETENTION"),
+		Value:    100_000,
+		Category: OperationsCategory,
+	}
+	BatcherDataCachePath = &cli.StringFlag{
+		Name: "batcher-data-cache.path",
+		Usage: "File path used to persist the batcher data read by the derivation pipeline and the safe head on shutdown, and restore them on start, " +
+			"to resume derivation from the safe head without fetching L1 data again after a restart. Use a .gz extension to compress. Disabled if not set.",
+		EnvVars:  prefixEnvVars("BATCHER_DATA_CACHE_PATH"),
+		Category: OperationsCategory,
+	}
+	PipelineWitnessDir = &cli.StringFlag{
+		Name: "pipeline.witness-dir",
+		Usage: "Experimental: directory to write a witness of the L1 data and L2 state consumed by the derivation " +
+			"of every derived block to, as input of external validity provers. Disabled if not set.",
+		EnvVars:  prefixEnvVars("PIPELINE_WITNESS_DIR"),
+		Category: OperationsCategory,
+	}
 	/* Deprecated Flags */
 	L2EngineSyncEnabled = &cli.BoolFlag{
 		Name:    "l2.engine-sync",
@@ -416,11 +578,13 @@ var requiredFlags = []cli.Flag{
 }
 
 var optionalFlags = []cli.Flag{
+	L2EngineStandbyAddrs,
 	BeaconAddr,
 	BeaconHeader,
 	BeaconFallbackAddrs,
 	BeaconCheckIgnore,
 	BeaconFetchAllSidecars,
+	BeaconBuffered,
 	SyncModeFlag,
 	RPCListenAddr,
 	RPCListenPort,
@@ -436,8 +600,20 @@ var optionalFlags = []cli.Flag{
 	SequencerStoppedFlag,
 	SequencerMaxSafeLagFlag,
 	SequencerL1Confs,
+	SequencerTxIngressFlag,
+	SequencerTxIngressGlobalRateFlag,
+	SequencerTxIngressGlobalBurstFlag,
+	SequencerTxIngressSenderRateFlag,
+	SequencerTxIngressSenderBurstFlag,
+	SequencerTxIngressMaxTxSizeFlag,
+	SequencerTxIngressDustValueFlag,
+	SequencerTxIngressMaxSpamScoreFlag,
+	SequencerTxFeedFlag,
+	SequencerTxFeedJWTSecretFlag,
+	SequencerTxFeedTimeoutFlag,
 	L1EpochPollIntervalFlag,
 	RuntimeConfigReloadIntervalFlag,
+	RuntimeConfigAddressFlag,
 	RPCEnableAdmin,
 	RPCAdminPersistence,
 	MetricsEnabledFlag,
@@ -453,6 +629,15 @@ var optionalFlags = []cli.Flag{
 	ConductorRpcFlag,
 	ConductorRpcTimeoutFlag,
 	SafeDBPath,
+	AttestEnabledFlag,
+	AttestAddrFlag,
+	AttestPortFlag,
+	AttestIntervalFlag,
+	AttestHistorySizeFlag,
+	LogIndexAddressesFlag,
+	LogIndexRetentionFlag,
+	BatcherDataCachePath,
+	PipelineWitnessDir,
 	L2EngineKind,
 	InteropSupervisor,
 	InteropRPCAddr,
@@ -15,47 +15,51 @@ func p2pEnv(envprefix, v string) []string {
 }
 
 var (
-	DisableP2PName          = "p2p.disable"
-	NoDiscoveryName         = "p2p.no-discovery"
-	ScoringName             = "p2p.scoring"
-	PeerScoringName         = "p2p.scoring.peers"
-	PeerScoreBandsName      = "p2p.score.bands"
-	BanningName             = "p2p.ban.peers"
-	BanningThresholdName    = "p2p.ban.threshold"
-	BanningDurationName     = "p2p.ban.duration"
-	TopicScoringName        = "p2p.scoring.topics"
-	P2PPrivPathName         = "p2p.priv.path"
-	P2PPrivRawName          = "p2p.priv.raw"
-	ListenIPName            = "p2p.listen.ip"
-	ListenTCPPortName       = "p2p.listen.tcp"
-	ListenUDPPortName       = "p2p.listen.udp"
-	AdvertiseIPName         = "p2p.advertise.ip"
-	AdvertiseTCPPortName    = "p2p.advertise.tcp"
-	AdvertiseUDPPortName    = "p2p.advertise.udp"
-	BootnodesName           = "p2p.bootnodes"
-	StaticPeersName         = "p2p.static"
-	NetRestrictName         = "p2p.netrestrict"
-	HostMuxName             = "p2p.mux"
-	HostSecurityName        = "p2p.security"
-	PeersLoName             = "p2p.peers.lo"
-	PeersHiName             = "p2p.peers.hi"
-	PeersGraceName          = "p2p.peers.grace"
-	NATName                 = "p2p.nat"
-	UserAgentName           = "p2p.useragent"
-	TimeoutNegotiationName  = "p2p.timeout.negotiation"
-	TimeoutAcceptName       = "p2p.timeout.accept"
-	TimeoutDialName         = "p2p.timeout.dial"
-	PeerstorePathName       = "p2p.peerstore.path"
-	DiscoveryPathName       = "p2p.discovery.path"
-	SequencerP2PKeyName     = "p2p.sequencer.key"
-	GossipMeshDName         = "p2p.gossip.mesh.d"
-	GossipMeshDloName       = "p2p.gossip.mesh.lo"
-	GossipMeshDhiName       = "p2p.gossip.mesh.dhi"
-	GossipMeshDlazyName     = "p2p.gossip.mesh.dlazy"
-	GossipFloodPublishName  = "p2p.gossip.mesh.floodpublish"
-	SyncReqRespName         = "p2p.sync.req-resp"
-	SyncOnlyReqToStaticName = "p2p.sync.onlyreqtostatic"
-	P2PPingName             = "p2p.ping"
+	DisableP2PName           = "p2p.disable"
+	NoDiscoveryName          = "p2p.no-discovery"
+	ScoringName              = "p2p.scoring"
+	PeerScoringName          = "p2p.scoring.peers"
+	PeerScoreBandsName       = "p2p.score.bands"
+	BanningName              = "p2p.ban.peers"
+	BanningThresholdName     = "p2p.ban.threshold"
+	BanningDurationName      = "p2p.ban.duration"
+	TopicScoringName         = "p2p.scoring.topics"
+	P2PPrivPathName          = "p2p.priv.path"
+	P2PPrivRawName           = "p2p.priv.raw"
+	ListenIPName             = "p2p.listen.ip"
+	ListenTCPPortName        = "p2p.listen.tcp"
+	ListenUDPPortName        = "p2p.listen.udp"
+	AdvertiseIPName          = "p2p.advertise.ip"
+	AdvertiseTCPPortName     = "p2p.advertise.tcp"
+	AdvertiseUDPPortName     = "p2p.advertise.udp"
+	BootnodesName            = "p2p.bootnodes"
+	StaticPeersName          = "p2p.static"
+	NetRestrictName          = "p2p.netrestrict"
+	HostMuxName              = "p2p.mux"
+	HostSecurityName         = "p2p.security"
+	PeersLoName              = "p2p.peers.lo"
+	PeersHiName              = "p2p.peers.hi"
+	PeersGraceName           = "p2p.peers.grace"
+	NATName                  = "p2p.nat"
+	UserAgentName            = "p2p.useragent"
+	TimeoutNegotiationName   = "p2p.timeout.negotiation"
+	TimeoutAcceptName        = "p2p.timeout.accept"
+	TimeoutDialName          = "p2p.timeout.dial"
+	PeerstorePathName        = "p2p.peerstore.path"
+	DiscoveryPathName        = "p2p.discovery.path"
+	SequencerP2PKeyName      = "p2p.sequencer.key"
+	GossipMeshDName          = "p2p.gossip.mesh.d"
+	GossipMeshDloName        = "p2p.gossip.mesh.lo"
+	GossipMeshDhiName        = "p2p.gossip.mesh.dhi"
+	GossipMeshDlazyName      = "p2p.gossip.mesh.dlazy"
+	GossipFloodPublishName   = "p2p.gossip.mesh.floodpublish"
+	GossipBandwidthLimitName = "p2p.gossip.bandwidth-limit"
+	GossipMsgSizeName        = "p2p.gossip.bandwidth-msg-size"
+	SyncReqRespName          = "p2p.sync.req-resp"
+	SyncOnlyReqToStaticName  = "p2p.sync.onlyreqtostatic"
+	P2PPingName              = "p2p.ping"
+	AttestationSignersName   = "p2p.attestation.signers"
+	AttestationThresholdName = "p2p.attestation.threshold"
 )
 
 func deprecatedP2PFlags(envPrefix string) []cli.Flag {
@@ -343,6 +347,23 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			EnvVars:  p2pEnv(envPrefix, "SEQUENCER_KEY"),
 			Category: P2PCategory,
 		},
+		&cli.StringSliceFlag{
+			Name: AttestationSignersName,
+			Usage: "Addresses of the signer set attesting gossiped blocks, for shared sequencing. " +
+				"If set, blocks must be attested by a threshold of these signers, instead of being signed by the unsafe block signer. " +
+				"Nodes with a p2p sequencer key or remote signer of the set attest the blocks proposed by the other signers.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "ATTESTATION_SIGNERS"),
+			Category: P2PCategory,
+		},
+		&cli.Uint64Flag{
+			Name:     AttestationThresholdName,
+			Usage:    "Number of distinct signers of the attestation signer set that have to attest a gossiped block.",
+			Required: false,
+			Value:    1,
+			EnvVars:  p2pEnv(envPrefix, "ATTESTATION_THRESHOLD"),
+			Category: P2PCategory,
+		},
 		&cli.UintFlag{
 			Name:     GossipMeshDName,
 			Usage:    "Configure GossipSub topic stable mesh target count, a.k.a. desired outbound degree, number of peers to gossip to",
@@ -387,6 +408,21 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			EnvVars:  p2pEnv(envPrefix, "GOSSIP_FLOOD_PUBLISH"),
 			Category: P2PCategory,
 		},
+		&cli.Uint64Flag{
+			Name:     GossipBandwidthLimitName,
+			Usage:    "Outbound bandwidth budget of block gossip, in bytes per second. If set, the gossip mesh degree is reduced at startup to fit the budget, down to a minimum of 4 peers, assuming every block gossip message is of the size set with --" + GossipMsgSizeName + ". The mesh is not resized at runtime. Disabled by default.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_BANDWIDTH_LIMIT"),
+			Category: P2PCategory,
+		},
+		&cli.Uint64Flag{
+			Name:     GossipMsgSizeName,
+			Usage:    "Assumed size of a block gossip message, in bytes, to size the gossip mesh for the bandwidth limit. Set it to the average block gossip message size of the chain.",
+			Required: false,
+			Value:    p2p.DefaultGossipMessageSizeEstimate,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_BANDWIDTH_MSG_SIZE"),
+			Category: P2PCategory,
+		},
 		&cli.BoolFlag{
 			Name:     SyncReqRespName,
 			Usage:    "Enables P2P req-resp alternative sync method, on both server and client side.",
@@ -54,6 +54,7 @@ type Metricer interface {
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
 	RecordGossipEvent(evType int32)
+	RecordGossipBandwidth(topic string, direction string, total uint64)
 	IncPeerCount()
 	DecPeerCount()
 	IncStreamCount()
@@ -61,6 +62,8 @@ type Metricer interface {
 	RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.BandwidthCounter)
 	RecordSequencerBuildingDiffTime(duration time.Duration)
 	RecordSequencerSealingTime(duration time.Duration)
+	RecordSequencerBlockPhaseTime(phase string, duration time.Duration)
+	RecordTxIngress(result string)
 	Document() []metrics.DocumentedMetric
 	RecordChannelInputBytes(num int)
 	RecordHeadChannelOpened()
@@ -128,6 +131,10 @@ type Metrics struct {
 	SequencerSealingDurationSeconds prometheus.Histogram
 	SequencerSealingTotal           prometheus.Counter
 
+	SequencerBlockPhaseDurationSeconds *prometheus.SummaryVec
+
+	TxIngressTotal *prometheus.CounterVec
+
 	UnsafePayloadsBufferLen     prometheus.Gauge
 	UnsafePayloadsBufferMemSize prometheus.Gauge
 
@@ -148,6 +155,7 @@ type Metrics struct {
 	PeerCount         prometheus.Gauge
 	StreamCount       prometheus.Gauge
 	GossipEventsTotal *prometheus.CounterVec
+	GossipBytesTotal  *prometheus.GaugeVec
 	BandwidthTotal    *prometheus.GaugeVec
 	PeerUnbans        prometheus.Counter
 	IPUnbans          prometheus.Counter
@@ -299,6 +307,15 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"type",
 		}),
+		GossipBytesTotal: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_bytes_total",
+			Help:      "Size of gossip messages by topic and direction",
+		}, []string{
+			"topic",
+			"direction",
+		}),
 		BandwidthTotal: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -412,6 +429,23 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "sequencer_sealing_total",
 			Help:      "Number of sequencer block sealing jobs",
 		}),
+		SequencerBlockPhaseDurationSeconds: factory.NewSummaryVec(prometheus.SummaryOpts{
+			Namespace:  ns,
+			Name:       "sequencer_block_phase_seconds",
+			Help:       "Percentiles of the time the sequencer spends per block in each block-production phase",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+			MaxAge:     10 * time.Minute,
+		}, []string{
+			"phase",
+		}),
+
+		TxIngressTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "tx_ingress_total",
+			Help:      "Number of transactions submitted to the sequencer RPC, by result",
+		}, []string{
+			"result",
+		}),
 
 		ProtocolVersionDelta: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -553,6 +587,10 @@ func (m *Metrics) RecordGossipEvent(evType int32) {
 	m.GossipEventsTotal.WithLabelValues(pb.TraceEvent_Type_name[evType]).Inc()
 }
 
+func (m *Metrics) RecordGossipBandwidth(topic string, direction string, total uint64) {
+	m.GossipBytesTotal.WithLabelValues(topic, direction).Set(float64(total))
+}
+
 func (m *Metrics) IncPeerCount() {
 	m.PeerCount.Inc()
 }
@@ -605,6 +643,18 @@ func (m *Metrics) RecordSequencerSealingTime(duration time.Duration) {
 	m.SequencerSealingDurationSeconds.Observe(float64(duration) / float64(time.Second))
 }
 
+// RecordSequencerBlockPhaseTime tracks the amount of time the sequencer spent in a phase of producing a block,
+// so that missed slots can be attributed to a specific phase.
+func (m *Metrics) RecordSequencerBlockPhaseTime(phase string, duration time.Duration) {
+	m.SequencerBlockPhaseDurationSeconds.WithLabelValues(phase).Observe(float64(duration) / float64(time.Second))
+}
+
+// RecordTxIngress tracks the result of a transaction submitted to the sequencer RPC,
+// i.e. whether it was forwarded to the execution engine, or why it was rejected.
+func (m *Metrics) RecordTxIngress(result string) {
+	m.TxIngressTotal.WithLabelValues(result).Inc()
+}
+
 // StartServer starts the metrics server on the given hostname and port.
 func (m *Metrics) StartServer(hostname string, port int) (*ophttp.HTTPServer, error) {
 	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
@@ -758,6 +808,9 @@ func (n *noopMetricer) RecordSequencerReset() {
 func (n *noopMetricer) RecordGossipEvent(evType int32) {
 }
 
+func (n *noopMetricer) RecordGossipBandwidth(topic string, direction string, total uint64) {
+}
+
 func (n *noopMetricer) SetPeerScores(allScores []store.PeerScores) {
 }
 
@@ -782,6 +835,12 @@ func (n *noopMetricer) RecordSequencerBuildingDiffTime(duration time.Duration) {
 func (n *noopMetricer) RecordSequencerSealingTime(duration time.Duration) {
 }
 
+func (n *noopMetricer) RecordSequencerBlockPhaseTime(phase string, duration time.Duration) {
+}
+
+func (n *noopMetricer) RecordTxIngress(result string) {
+}
+
 func (n *noopMetricer) Document() []metrics.DocumentedMetric {
 	return nil
 }
@@ -1,20 +1,26 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/BurntSushi/toml"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-node/node/ingress"
 	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 )
 
 type l2EthClient interface {
@@ -29,6 +35,8 @@ type driverClient interface {
 	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
 	BlockRefWithStatus(ctx context.Context, num uint64) (eth.L2BlockRef, *eth.SyncStatus, error)
 	ResetDerivationPipeline(context.Context) error
+	PauseDerivation(context.Context) error
+	ResumeDerivation(context.Context) error
 	StartSequencer(ctx context.Context, blockHash common.Hash) error
 	StopSequencer(context.Context) (common.Hash, error)
 	SequencerActive(context.Context) (bool, error)
@@ -43,38 +51,114 @@ type SafeDBReader interface {
 
 type adminAPI struct {
 	*rpc.CommonAdminAPI
-	dr driverClient
+	dr        driverClient
+	txIngress *ingress.Ingress
+	l1Caches  []caching.ResizableCache
+	log       log.Logger
 }
 
-func NewAdminAPI(dr driverClient, m metrics.RPCMetricer, log log.Logger) *adminAPI {
+// NewAdminAPI creates the admin API. The tx ingress is optional, and may be nil if disabled.
+// The L1 caches are the caches of the L1 sources that can be inspected and resized at runtime.
+func NewAdminAPI(dr driverClient, txIngress *ingress.Ingress, l1Caches []caching.ResizableCache, m metrics.RPCMetricer, log log.Logger) *adminAPI {
 	return &adminAPI{
 		CommonAdminAPI: rpc.NewCommonAdminAPI(m, log),
 		dr:             dr,
+		txIngress:      txIngress,
+		l1Caches:       l1Caches,
+		log:            log,
 	}
 }
 
+var errTxIngressDisabled = errors.New("tx ingress is disabled")
+
+func (n *adminAPI) TxIngressLimits(_ context.Context) (ingress.Limits, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_txIngressLimits")
+	defer recordDur()
+	if n.txIngress == nil {
+		return ingress.Limits{}, toNodeError(errTxIngressDisabled)
+	}
+	return n.txIngress.Limits(), nil
+}
+
+// SetTxIngressLimits replaces the limits of the tx ingress, without restarting the node.
+func (n *adminAPI) SetTxIngressLimits(_ context.Context, limits ingress.Limits) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setTxIngressLimits")
+	defer recordDur()
+	if n.txIngress == nil {
+		return toNodeError(errTxIngressDisabled)
+	}
+	return n.txIngress.SetLimits(limits)
+}
+
+// L1CacheStats returns the size, capacity, hits, misses and evictions of each of the L1 caches.
+func (n *adminAPI) L1CacheStats(_ context.Context) ([]caching.Stats, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_l1CacheStats")
+	defer recordDur()
+	stats := make([]caching.Stats, 0, len(n.l1Caches))
+	for _, c := range n.l1Caches {
+		stats = append(stats, c.Stats())
+	}
+	return stats, nil
+}
+
+// SetL1CacheSize changes the capacity of the L1 cache with the given label, without restarting the node.
+// Shrinking a cache evicts its least recently used items. The stats of the resized cache are returned.
+func (n *adminAPI) SetL1CacheSize(_ context.Context, label string, capacity int) (caching.Stats, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_setL1CacheSize")
+	defer recordDur()
+	for _, c := range n.l1Caches {
+		if c.Label() != label {
+			continue
+		}
+		evicted, err := c.Resize(capacity)
+		if err != nil {
+			return caching.Stats{}, err
+		}
+		n.log.Info("Resized L1 cache", "cache", label, "capacity", capacity, "evicted", evicted)
+		return c.Stats(), nil
+	}
+	return caching.Stats{}, eth.NewNodeError(eth.NodeConfigErrorCode, fmt.Errorf("unknown L1 cache: %q", label))
+}
+
 func (n *adminAPI) ResetDerivationPipeline(ctx context.Context) error {
 	recordDur := n.M.RecordRPCServerRequest("admin_resetDerivationPipeline")
 	defer recordDur()
-	return n.dr.ResetDerivationPipeline(ctx)
+	return toNodeError(n.dr.ResetDerivationPipeline(ctx))
+}
+
+// PauseDerivation halts the derivation pipeline at the next stage boundary, e.g. for incident response.
+// Gossip and RPC keep being served while paused, and the paused state is reported in the sync status.
+func (n *adminAPI) PauseDerivation(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_pauseDerivation")
+	defer recordDur()
+	return toNodeError(n.dr.PauseDerivation(ctx))
+}
+
+// ResumeDerivation continues derivation after it was paused with PauseDerivation.
+func (n *adminAPI) ResumeDerivation(ctx context.Context) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_resumeDerivation")
+	defer recordDur()
+	return toNodeError(n.dr.ResumeDerivation(ctx))
 }
 
 func (n *adminAPI) StartSequencer(ctx context.Context, blockHash common.Hash) error {
 	recordDur := n.M.RecordRPCServerRequest("admin_startSequencer")
 	defer recordDur()
-	return n.dr.StartSequencer(ctx, blockHash)
+	return toNodeError(n.dr.StartSequencer(ctx, blockHash))
 }
 
 func (n *adminAPI) StopSequencer(ctx context.Context) (common.Hash, error) {
 	recordDur := n.M.RecordRPCServerRequest("admin_stopSequencer")
 	defer recordDur()
-	return n.dr.StopSequencer(ctx)
+	hash, err := n.dr.StopSequencer(ctx)
+	return hash, toNodeError(err)
 }
 
 func (n *adminAPI) SequencerActive(ctx context.Context) (bool, error) {
 	recordDur := n.M.RecordRPCServerRequest("admin_sequencerActive")
 	defer recordDur()
-	return n.dr.SequencerActive(ctx)
+	active, err := n.dr.SequencerActive(ctx)
+	return active, toNodeError(err)
 }
 
 // PostUnsafePayload is a special API that allows posting an unsafe payload to the L2 derivation pipeline.
@@ -89,21 +173,22 @@ func (n *adminAPI) PostUnsafePayload(ctx context.Context, envelope *eth.Executio
 		return fmt.Errorf("payload has bad block hash: %s, actual block hash is: %s", payload.BlockHash.String(), actual.String())
 	}
 
-	return n.dr.OnUnsafeL2Payload(ctx, envelope)
+	return toNodeError(n.dr.OnUnsafeL2Payload(ctx, envelope))
 }
 
 // OverrideLeader disables sequencer conductor interactions and allow sequencer to run in non-HA mode during disaster recovery scenarios.
 func (n *adminAPI) OverrideLeader(ctx context.Context) error {
 	recordDur := n.M.RecordRPCServerRequest("admin_overrideLeader")
 	defer recordDur()
-	return n.dr.OverrideLeader(ctx)
+	return toNodeError(n.dr.OverrideLeader(ctx))
 }
 
 // ConductorEnabled returns true if the sequencer conductor is enabled.
 func (n *adminAPI) ConductorEnabled(ctx context.Context) (bool, error) {
 	recordDur := n.M.RecordRPCServerRequest("admin_conductorEnabled")
 	defer recordDur()
-	return n.dr.ConductorEnabled(ctx)
+	enabled, err := n.dr.ConductorEnabled(ctx)
+	return enabled, toNodeError(err)
 }
 
 type nodeAPI struct {
@@ -132,12 +217,15 @@ func (n *nodeAPI) OutputAtBlock(ctx context.Context, number hexutil.Uint64) (*et
 
 	ref, status, err := n.dr.BlockRefWithStatus(ctx, uint64(number))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 block ref with sync status: %w", err)
+		return nil, toNodeError(fmt.Errorf("failed to get L2 block ref with sync status: %w", err))
 	}
 
 	output, err := n.client.OutputV0AtBlock(ctx, ref.Hash)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 output at block %s: %w", ref, err)
+	if errors.Is(err, ethereum.NotFound) {
+		// The block was found by number, but not by hash anymore: it was reorged out in the meantime.
+		return nil, eth.NewNodeError(eth.NodeReorgErrorCode, fmt.Errorf("L2 block %s was reorged out: %w", ref, err))
+	} else if err != nil {
+		return nil, toNodeError(fmt.Errorf("failed to get L2 output at block %s: %w", ref, err))
 	}
 	return &eth.OutputResponse{
 		Version:               output.Version(),
@@ -154,9 +242,9 @@ func (n *nodeAPI) SafeHeadAtL1Block(ctx context.Context, number hexutil.Uint64)
 	defer recordDur()
 	l1Block, safeHead, err := n.safeDB.SafeHeadAtL1(ctx, uint64(number))
 	if errors.Is(err, safedb.ErrNotFound) {
-		return nil, err
+		return nil, toNodeError(err)
 	} else if err != nil {
-		return nil, fmt.Errorf("failed to get safe head at l1 block %s: %w", number, err)
+		return nil, toNodeError(fmt.Errorf("failed to get safe head at l1 block %s: %w", number, err))
 	}
 	return &eth.SafeHeadResponse{
 		L1Block:  l1Block,
@@ -167,7 +255,8 @@ func (n *nodeAPI) SafeHeadAtL1Block(ctx context.Context, number hexutil.Uint64)
 func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	recordDur := n.m.RecordRPCServerRequest("optimism_syncStatus")
 	defer recordDur()
-	return n.dr.SyncStatus(ctx)
+	status, err := n.dr.SyncStatus(ctx)
+	return status, toNodeError(err)
 }
 
 func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
@@ -176,6 +265,33 @@ func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
 	return n.config, nil
 }
 
+// ExportConfig returns the rollup config in the chain config format of the superchain-registry,
+// encoded as either "toml" (the default) or "json".
+func (n *nodeAPI) ExportConfig(_ context.Context, format string) (string, error) {
+	recordDur := n.m.RecordRPCServerRequest("optimism_exportConfig")
+	defer recordDur()
+	chConfig, err := n.config.SuperchainChainConfig()
+	if err != nil {
+		return "", eth.NewNodeError(eth.NodeConfigErrorCode, err)
+	}
+	switch format {
+	case "", "toml":
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(chConfig); err != nil {
+			return "", fmt.Errorf("failed to encode config as TOML: %w", err)
+		}
+		return buf.String(), nil
+	case "json":
+		data, err := json.MarshalIndent(chConfig, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode config as JSON: %w", err)
+		}
+		return string(data), nil
+	default:
+		return "", eth.NewNodeError(eth.NodeConfigErrorCode, fmt.Errorf("unsupported config format: %q", format))
+	}
+}
+
 func (n *nodeAPI) Version(ctx context.Context) (string, error) {
 	recordDur := n.m.RecordRPCServerRequest("optimism_version")
 	defer recordDur()
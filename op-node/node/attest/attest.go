@@ -0,0 +1,220 @@
+package attest
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+)
+
+// SigningDomainHeadAttestationsV1 separates head attestations from block payloads signed with the same key.
+var SigningDomainHeadAttestationsV1 = [32]byte{31: 0x01}
+
+type SyncStatusSource interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+// Signer signs the attestations, typically with the key that signs the gossiped blocks.
+type Signer interface {
+	Sign(ctx context.Context, domain [32]byte, chainID *big.Int, encodedMsg []byte) (sig *[65]byte, err error)
+}
+
+// Attestation is a signed statement of the safe and finalized L2 heads of a node at the given time.
+type Attestation struct {
+	Timestamp uint64        `json:"timestamp"`
+	Safe      eth.BlockID   `json:"safe"`
+	Finalized eth.BlockID   `json:"finalized"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// Message returns the encoded message that is signed.
+func (a *Attestation) Message() []byte {
+	msg := make([]byte, 0, 8+2*(common.HashLength+8))
+	msg = binary.BigEndian.AppendUint64(msg, a.Timestamp)
+	msg = append(msg, a.Safe.Hash[:]...)
+	msg = binary.BigEndian.AppendUint64(msg, a.Safe.Number)
+	msg = append(msg, a.Finalized.Hash[:]...)
+	msg = binary.BigEndian.AppendUint64(msg, a.Finalized.Number)
+	return msg
+}
+
+// RecoverSigner returns the address that signed the attestation for the given L2 chain.
+func (a *Attestation) RecoverSigner(chainID *big.Int) (common.Address, error) {
+	if len(a.Signature) != 65 {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(a.Signature))
+	}
+	signingHash, err := opsigner.NewBlockPayloadArgs(SigningDomainHeadAttestationsV1, chainID, a.Message(), nil).ToSigningHash()
+	if err != nil {
+		return common.Address{}, err
+	}
+	pub, err := crypto.SigToPub(signingHash[:], a.Signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Service periodically attests the safe and finalized heads of the node, and serves the recent
+// attestations over HTTP. This allows monitoring tools to detect divergence among a fleet of nodes,
+// without requiring access to their RPC.
+type Service struct {
+	log     log.Logger
+	cfg     *Config
+	chainID *big.Int
+	source  SyncStatusSource
+	signer  Signer
+	clock   clock.Clock
+
+	mu      sync.RWMutex
+	history []*Attestation
+
+	srv *httputil.HTTPServer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewService(log log.Logger, cfg *Config, chainID *big.Int, source SyncStatusSource, signer Signer, cl clock.Clock) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		log:     log,
+		cfg:     cfg,
+		chainID: chainID,
+		source:  source,
+		signer:  signer,
+		clock:   cl,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+func (s *Service) Start() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/attestations", s.handleAttestations)
+	mux.HandleFunc("/attestations/latest", s.handleLatest)
+	srv, err := httputil.StartHTTPServer(net.JoinHostPort(s.cfg.ListenAddr, strconv.Itoa(s.cfg.ListenPort)), mux)
+	if err != nil {
+		return fmt.Errorf("failed to start attestation server: %w", err)
+	}
+	s.srv = srv
+	s.log.Info("Started attestation server", "addr", srv.Addr())
+
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *Service) Addr() net.Addr {
+	return s.srv.Addr()
+}
+
+func (s *Service) Stop(ctx context.Context) error {
+	s.cancel()
+	s.wg.Wait()
+	if s.srv != nil {
+		return s.srv.Stop(ctx)
+	}
+	return nil
+}
+
+func (s *Service) loop() {
+	defer s.wg.Done()
+	ticker := s.clock.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Attest(s.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			s.log.Warn("Failed to attest heads", "err", err)
+		}
+		select {
+		case <-ticker.Ch():
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Attest signs the current safe and finalized heads, and adds the attestation to the history.
+func (s *Service) Attest(ctx context.Context) error {
+	status, err := s.source.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	att := &Attestation{
+		Timestamp: uint64(s.clock.Now().Unix()),
+		Safe:      status.SafeL2.ID(),
+		Finalized: status.FinalizedL2.ID(),
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	sig, err := s.signer.Sign(ctx, SigningDomainHeadAttestationsV1, s.chainID, att.Message())
+	if err != nil {
+		return fmt.Errorf("failed to sign attestation: %w", err)
+	}
+	att.Signature = sig[:]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.history = append(s.history, att)
+	if excess := len(s.history) - s.cfg.HistorySize; excess > 0 {
+		s.history = append(s.history[:0:0], s.history[excess:]...)
+	}
+	s.log.Debug("Attested heads", "safe", att.Safe, "finalized", att.Finalized)
+	return nil
+}
+
+// Attestations returns the recent attestations, oldest first.
+func (s *Service) Attestations() []*Attestation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Attestation(nil), s.history...)
+}
+
+func (s *Service) handleAttestations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	history := s.Attestations()
+	if history == nil {
+		history = []*Attestation{}
+	}
+	writeJSON(w, history)
+}
+
+func (s *Service) handleLatest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	history := s.Attestations()
+	if len(history) == 0 {
+		http.Error(w, "no attestations yet", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, history[len(history)-1])
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
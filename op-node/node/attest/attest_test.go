@@ -0,0 +1,115 @@
+package attest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubSyncStatus struct {
+	status eth.SyncStatus
+}
+
+func (s *stubSyncStatus) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	status := s.status
+	return &status, nil
+}
+
+func setupService(t *testing.T, historySize int) (*Service, *stubSyncStatus, *clock.DeterministicClock, common.Address) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	source := &stubSyncStatus{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	cfg := &Config{
+		Enabled:     true,
+		ListenAddr:  "127.0.0.1",
+		ListenPort:  0,
+		Interval:    time.Minute,
+		HistorySize: historySize,
+	}
+	require.NoError(t, cfg.Check())
+	s := NewService(testlog.Logger(t, log.LevelDebug), cfg, big.NewInt(10), source, p2p.NewLocalSigner(priv), cl)
+	return s, source, cl, crypto.PubkeyToAddress(priv.PublicKey)
+}
+
+func TestAttest(t *testing.T) {
+	ctx := context.Background()
+	s, source, cl, signer := setupService(t, 2)
+
+	for i := uint64(1); i <= 3; i++ {
+		source.status.SafeL2 = eth.L2BlockRef{Hash: common.Hash{byte(i)}, Number: 10 * i}
+		source.status.FinalizedL2 = eth.L2BlockRef{Hash: common.Hash{byte(i), 0xff}, Number: 5 * i}
+		require.NoError(t, s.Attest(ctx))
+		cl.AdvanceTime(time.Minute)
+	}
+
+	history := s.Attestations()
+	require.Len(t, history, 2, "history should be bounded")
+	for i, att := range history {
+		n := uint64(i + 2)
+		require.Equal(t, eth.BlockID{Hash: common.Hash{byte(n)}, Number: 10 * n}, att.Safe)
+		require.Equal(t, eth.BlockID{Hash: common.Hash{byte(n), 0xff}, Number: 5 * n}, att.Finalized)
+		require.Equal(t, uint64(1000+60*(n-1)), att.Timestamp)
+
+		recovered, err := att.RecoverSigner(big.NewInt(10))
+		require.NoError(t, err)
+		require.Equal(t, signer, recovered)
+
+		// The signature commits to the chain
+		recovered, err = att.RecoverSigner(big.NewInt(11))
+		require.NoError(t, err)
+		require.NotEqual(t, signer, recovered)
+	}
+
+	// The signature commits to the heads
+	tampered := *history[0]
+	tampered.Safe.Number++
+	recovered, err := tampered.RecoverSigner(big.NewInt(10))
+	require.NoError(t, err)
+	require.NotEqual(t, signer, recovered)
+}
+
+func TestServeAttestations(t *testing.T) {
+	s, source, _, _ := setupService(t, 10)
+	source.status.SafeL2 = eth.L2BlockRef{Hash: common.Hash{0x01}, Number: 10}
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop(context.Background()))
+	})
+
+	url := fmt.Sprintf("http://%s", s.Addr())
+	// The service attests once on start
+	require.Eventually(t, func() bool {
+		return len(s.Attestations()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	resp, err := http.Get(url + "/attestations")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var history []*Attestation
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+	require.Equal(t, s.Attestations(), history)
+
+	resp, err = http.Get(url + "/attestations/latest")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var latest Attestation
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&latest))
+	require.Equal(t, *history[0], latest)
+}
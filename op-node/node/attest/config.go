@@ -0,0 +1,36 @@
+package attest
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Config of the safe-head attestation service.
+type Config struct {
+	// Enabled periodically signs the safe and finalized heads of the node,
+	// and serves the recent attestations over HTTP.
+	Enabled    bool
+	ListenAddr string
+	ListenPort int
+	// Interval between attestations.
+	Interval time.Duration
+	// HistorySize is the number of recent attestations that are served.
+	HistorySize int
+}
+
+func (c *Config) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ListenPort < 0 || c.ListenPort > math.MaxUint16 {
+		return errors.New("invalid attestation server port")
+	}
+	if c.Interval <= 0 {
+		return errors.New("attestation interval must be positive")
+	}
+	if c.HistorySize < 1 {
+		return errors.New("attestation history size must be at least 1")
+	}
+	return nil
+}
@@ -36,6 +36,8 @@ type L1BeaconEndpointSetup interface {
 	// ShouldIgnoreBeaconCheck returns true if the Beacon-node version check should not halt startup.
 	ShouldIgnoreBeaconCheck() bool
 	ShouldFetchAllSidecars() bool
+	// ShouldBufferOnFailure returns true if blob retrieval should back off and report a degraded status on failure.
+	ShouldBufferOnFailure() bool
 	Check() error
 }
 
@@ -47,14 +49,27 @@ type L2EndpointConfig struct {
 	// JWT secrets for L2 Engine API authentication during HTTP or initial Websocket communication.
 	// Any value for an IPC connection.
 	L2EngineJWTSecret [32]byte
+
+	// L2EngineStandbyAddrs are the addresses of standby L2 Engine JSON-RPC endpoints to fail over to,
+	// in order, when the active endpoint is unreachable. They use the same JWT secret.
+	L2EngineStandbyAddrs []string
 }
 
+// engineFailoverThreshold is the number of consecutive connection errors of the active L2 engine
+// endpoint after which the rollup node fails over to a standby endpoint.
+const engineFailoverThreshold = 3
+
 var _ L2EndpointSetup = (*L2EndpointConfig)(nil)
 
 func (cfg *L2EndpointConfig) Check() error {
 	if cfg.L2EngineAddr == "" {
 		return errors.New("empty L2 Engine Address")
 	}
+	for _, addr := range cfg.L2EngineStandbyAddrs {
+		if addr == "" || addr == cfg.L2EngineAddr {
+			return fmt.Errorf("invalid standby L2 Engine Address %q", addr)
+		}
+	}
 
 	return nil
 }
@@ -72,8 +87,24 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(cfg.L2EngineStandbyAddrs) == 0 {
+		return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	}
 
-	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	endpoints := []client.RPC{l2Node}
+	for _, addr := range cfg.L2EngineStandbyAddrs {
+		// Standby endpoints are not required to be up at startup
+		standby, err := client.NewRPC(ctx, log, addr, append(opts, client.WithLazyDial())...)
+		if err != nil {
+			for _, endpoint := range endpoints {
+				endpoint.Close()
+			}
+			return nil, nil, fmt.Errorf("failed to setup standby L2 engine %q: %w", addr, err)
+		}
+		endpoints = append(endpoints, standby)
+	}
+	log.Info("L2 engine failover enabled", "standbys", len(cfg.L2EngineStandbyAddrs))
+	return client.NewFailoverClient(log, engineFailoverThreshold, endpoints...), sources.EngineClientDefaultConfig(rollupCfg), nil
 }
 
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
@@ -198,6 +229,7 @@ type L1BeaconEndpointConfig struct {
 	BeaconFallbackAddrs    []string // Addresses of L1 Beacon-API fallback endpoints (only for blob sidecars retrieval)
 	BeaconCheckIgnore      bool     // When false, halt startup if the beacon version endpoint fails
 	BeaconFetchAllSidecars bool     // Whether to fetch all blob sidecars and filter locally
+	BeaconBuffered         bool     // Whether to back off and report a degraded status when blob retrieval fails
 }
 
 var _ L1BeaconEndpointSetup = (*L1BeaconEndpointConfig)(nil)
@@ -236,6 +268,10 @@ func (cfg *L1BeaconEndpointConfig) ShouldFetchAllSidecars() bool {
 	return cfg.BeaconFetchAllSidecars
 }
 
+func (cfg *L1BeaconEndpointConfig) ShouldBufferOnFailure() bool {
+	return cfg.BeaconBuffered
+}
+
 func parseHTTPHeader(headerStr string) (http.Header, error) {
 	h := make(http.Header, 1)
 	s := strings.SplitN(headerStr, ": ", 2)
@@ -7,10 +7,15 @@ import (
 	"math"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/node/attest"
+	"github.com/ethereum-optimism/optimism/op-node/node/ingress"
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
+	"github.com/ethereum-optimism/optimism/op-node/node/txfeed"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
@@ -57,6 +62,10 @@ type Config struct {
 	// but if log-events are not coming in (e.g. not syncing blocks) then the reload ensures the config stays accurate.
 	RuntimeConfigReloadInterval time.Duration
 
+	// RuntimeConfigAddress is the L1 contract to load the extended runtime config values from,
+	// such as the gossip limits. Disabled if zero.
+	RuntimeConfigAddress common.Address
+
 	// Optional
 	Tracer Tracer
 
@@ -76,6 +85,18 @@ type Config struct {
 
 	// AltDA config
 	AltDA altda.CLIConfig
+
+	// TxIngress config of the sequencer transaction ingress
+	TxIngress ingress.Config
+
+	// TxFeed config of the ordered transaction feed the sequencer builds blocks from
+	TxFeed txfeed.Config
+
+	// Attest config of the safe-head attestation service
+	Attest attest.Config
+
+	// LogIndex config of the local index of recent predeploy logs
+	LogIndex logindex.Config
 }
 
 // ConductorRPCFunc retrieves the endpoint. The RPC may not immediately be available.
@@ -176,6 +197,32 @@ func (cfg *Config) Check() error {
 			return fmt.Errorf("sequencer must be enabled when conductor is enabled")
 		}
 	}
+	if cfg.TxIngress.Enabled && !cfg.Driver.SequencerEnabled {
+		return fmt.Errorf("sequencer must be enabled when tx ingress is enabled")
+	}
+	if err := cfg.TxIngress.Check(); err != nil {
+		return fmt.Errorf("tx ingress config error: %w", err)
+	}
+	if cfg.TxFeed.Enabled() {
+		if !cfg.Driver.SequencerEnabled {
+			return fmt.Errorf("sequencer must be enabled when tx feed is enabled")
+		}
+		if cfg.TxIngress.Enabled {
+			return fmt.Errorf("tx ingress cannot be enabled with tx feed, the mempool is not used")
+		}
+	}
+	if err := cfg.TxFeed.Check(); err != nil {
+		return fmt.Errorf("tx feed config error: %w", err)
+	}
+	if err := cfg.Attest.Check(); err != nil {
+		return fmt.Errorf("attestation config error: %w", err)
+	}
+	if cfg.Attest.Enabled && cfg.P2PSigner == nil {
+		return fmt.Errorf("a p2p signer must be configured when attestations are enabled")
+	}
+	if err := cfg.LogIndex.Check(); err != nil {
+		return fmt.Errorf("log index config error: %w", err)
+	}
 	if err := cfg.AltDA.Check(); err != nil {
 		return fmt.Errorf("altDA config error: %w", err)
 	}
@@ -0,0 +1,109 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+var errL1TxNotFound = errors.New("L1 transaction not found")
+
+type receiptSource interface {
+	// TransactionReceipt returns the receipt of the transaction, or nil if the transaction is unknown.
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// rpcReceiptSource fetches receipts by transaction hash, which the L1 and L2 sources do not support.
+type rpcReceiptSource struct {
+	rpc client.RPC
+}
+
+func (s *rpcReceiptSource) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	if err := s.rpc.CallContext(ctx, &receipt, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// depositAPI traces L1 deposits to their inclusion on L2,
+// so explorers do not need to re-implement the derivation of deposit transactions.
+type depositAPI struct {
+	config *rollup.Config
+	l1     receiptSource
+	l2     receiptSource
+	log    log.Logger
+	m      metrics.RPCMetricer
+}
+
+func NewDepositAPI(config *rollup.Config, l1RPC client.RPC, l2RPC client.RPC, log log.Logger, m metrics.RPCMetricer) *depositAPI {
+	return &depositAPI{
+		config: config,
+		l1:     &rpcReceiptSource{rpc: l1RPC},
+		l2:     &rpcReceiptSource{rpc: l2RPC},
+		log:    log,
+		m:      m,
+	}
+}
+
+// TraceDeposit returns the L2 deposit transactions derived from the deposits of the L1 transaction,
+// and whether they are included on L2 yet. The result is empty if the L1 transaction made no deposits.
+func (d *depositAPI) TraceDeposit(ctx context.Context, l1TxHash common.Hash) ([]eth.DepositTrace, error) {
+	recordDur := d.m.RecordRPCServerRequest("optimism_traceDeposit")
+	defer recordDur()
+
+	l1Receipt, err := d.l1.TransactionReceipt(ctx, l1TxHash)
+	if err != nil {
+		return nil, toNodeError(fmt.Errorf("failed to fetch L1 receipt: %w", err))
+	}
+	if l1Receipt == nil {
+		return nil, toNodeError(fmt.Errorf("%w: %s", errL1TxNotFound, l1TxHash))
+	}
+	traces := []eth.DepositTrace{}
+	if l1Receipt.Status != types.ReceiptStatusSuccessful {
+		// Deposits of failed L1 transactions are not derived
+		return traces, nil
+	}
+	for _, l := range l1Receipt.Logs {
+		if l.Address != d.config.DepositContractAddress || len(l.Topics) == 0 || l.Topics[0] != derive.DepositEventABIHash {
+			continue
+		}
+		dep, err := derive.UnmarshalDepositLogEvent(l)
+		if err != nil {
+			// Malformed deposit logs are skipped by derivation as well
+			d.log.Warn("Skipping malformed deposit log", "l1Tx", l1TxHash, "logIndex", l.Index, "err", err)
+			continue
+		}
+		trace := eth.DepositTrace{
+			L1TxHash:   l1TxHash,
+			L1Block:    eth.BlockID{Hash: l.BlockHash, Number: l.BlockNumber},
+			L1LogIndex: uint64(l.Index),
+			L2TxHash:   types.NewTx(dep).Hash(),
+			Status:     eth.DepositPending,
+		}
+		l2Receipt, err := d.l2.TransactionReceipt(ctx, trace.L2TxHash)
+		if err != nil {
+			return nil, toNodeError(fmt.Errorf("failed to fetch L2 receipt of deposit %s: %w", trace.L2TxHash, err))
+		}
+		if l2Receipt != nil {
+			trace.L2Block = &eth.BlockID{Hash: l2Receipt.BlockHash, Number: l2Receipt.BlockNumber.Uint64()}
+			if l2Receipt.Status == types.ReceiptStatusSuccessful {
+				trace.Status = eth.DepositIncluded
+			} else {
+				trace.Status = eth.DepositFailed
+			}
+		}
+		traces = append(traces, trace)
+	}
+	return traces, nil
+}
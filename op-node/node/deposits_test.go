@@ -0,0 +1,94 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubReceiptSource map[common.Hash]*types.Receipt
+
+func (s stubReceiptSource) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return s[txHash], nil
+}
+
+type errReceiptSource struct{ err error }
+
+func (s errReceiptSource) TransactionReceipt(_ context.Context, _ common.Hash) (*types.Receipt, error) {
+	return nil, s.err
+}
+
+func TestTraceDeposit(t *testing.T) {
+	depositContract := common.Address{0xdd}
+	cfg := &rollup.Config{DepositContractAddress: depositContract}
+	l1TxHash := common.Hash{0x01}
+	l1Block := eth.BlockID{Hash: common.Hash{0xbb}, Number: 100}
+
+	depositLog := func(t *testing.T, index uint, to common.Address) (*types.Log, common.Hash) {
+		dep := &types.DepositTx{
+			SourceHash: derive.UserDepositSource{L1BlockHash: l1Block.Hash, LogIndex: uint64(index)}.SourceHash(),
+			From:       common.Address{0xaa},
+			To:         &to,
+			Value:      big.NewInt(10),
+			Gas:        100_000,
+		}
+		l, err := derive.MarshalDepositLogEvent(depositContract, dep)
+		require.NoError(t, err)
+		l.BlockHash, l.BlockNumber, l.Index, l.TxHash = l1Block.Hash, l1Block.Number, index, l1TxHash
+		return l, types.NewTx(dep).Hash()
+	}
+	logA, l2TxA := depositLog(t, 3, common.Address{0x0a})
+	logB, l2TxB := depositLog(t, 5, common.Address{0x0b})
+	logC, l2TxC := depositLog(t, 6, common.Address{0x0c})
+	other := &types.Log{Address: common.Address{0xee}, Topics: []common.Hash{derive.DepositEventABIHash}}
+	l1 := stubReceiptSource{l1TxHash: {Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{logA, other, logB, logC}}}
+	l2Block := eth.BlockID{Hash: common.Hash{0xcc}, Number: 500}
+	l2 := stubReceiptSource{
+		l2TxA: {Status: types.ReceiptStatusSuccessful, BlockHash: l2Block.Hash, BlockNumber: big.NewInt(500)},
+		l2TxB: {Status: types.ReceiptStatusFailed, BlockHash: l2Block.Hash, BlockNumber: big.NewInt(500)},
+	}
+	api := &depositAPI{config: cfg, l1: l1, l2: l2, log: testlog.Logger(t, log.LevelDebug), m: metrics.NoopMetrics}
+
+	t.Run("Statuses", func(t *testing.T) {
+		traces, err := api.TraceDeposit(context.Background(), l1TxHash)
+		require.NoError(t, err)
+		require.Equal(t, []eth.DepositTrace{
+			{L1TxHash: l1TxHash, L1Block: l1Block, L1LogIndex: 3, L2TxHash: l2TxA, Status: eth.DepositIncluded, L2Block: &l2Block},
+			{L1TxHash: l1TxHash, L1Block: l1Block, L1LogIndex: 5, L2TxHash: l2TxB, Status: eth.DepositFailed, L2Block: &l2Block},
+			{L1TxHash: l1TxHash, L1Block: l1Block, L1LogIndex: 6, L2TxHash: l2TxC, Status: eth.DepositPending},
+		}, traces)
+	})
+
+	t.Run("UnknownL1Tx", func(t *testing.T) {
+		_, err := api.TraceDeposit(context.Background(), common.Hash{0x02})
+		require.ErrorIs(t, err, errL1TxNotFound)
+	})
+
+	t.Run("FailedL1Tx", func(t *testing.T) {
+		failedTx := common.Hash{0x03}
+		l1[failedTx] = &types.Receipt{Status: types.ReceiptStatusFailed, Logs: []*types.Log{logA}}
+		traces, err := api.TraceDeposit(context.Background(), failedTx)
+		require.NoError(t, err)
+		require.Empty(t, traces)
+	})
+
+	t.Run("L2Error", func(t *testing.T) {
+		l2Err := errors.New("boom")
+		api := &depositAPI{config: cfg, l1: l1, l2: errReceiptSource{err: l2Err}, log: api.log, m: metrics.NoopMetrics}
+		_, err := api.TraceDeposit(context.Background(), l1TxHash)
+		require.ErrorIs(t, err, l2Err)
+	})
+}
@@ -0,0 +1,58 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+// checkStandbyEngine verifies that the chain of a standby L2 engine is consistent with the rollup node
+// view of the L2 chain, before failing over to it. The forkchoice state of the rollup node is then sent
+// to the standby engine, so it continues from the same unsafe head. A standby engine that is missing
+// recent blocks syncs them itself, as the rollup node cannot re-send them while the active engine is down.
+func (n *OpNode) checkStandbyEngine(ctx context.Context, standby client.RPC, rpcCfg *sources.EngineClientConfig) error {
+	status, err := n.l2Driver.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	engine, err := sources.NewEngineClient(standby, n.log, nil, rpcCfg)
+	if err != nil {
+		return err
+	}
+	l := n.log.With("unsafe", status.UnsafeL2, "safe", status.SafeL2, "finalized", status.FinalizedL2)
+
+	safe, err := engine.L2BlockRefByNumber(ctx, status.SafeL2.Number)
+	if errors.Is(err, ethereum.NotFound) {
+		l.Warn("Standby engine is behind the safe head, it has to sync before it can take over")
+	} else if err != nil {
+		return fmt.Errorf("failed to get safe block of standby engine: %w", err)
+	} else if safe.Hash != status.SafeL2.Hash {
+		return fmt.Errorf("standby engine diverged at safe block %d: has %s, expected %s",
+			status.SafeL2.Number, safe.Hash, status.SafeL2.Hash)
+	}
+
+	fc := &eth.ForkchoiceState{
+		HeadBlockHash:      status.UnsafeL2.Hash,
+		SafeBlockHash:      status.SafeL2.Hash,
+		FinalizedBlockHash: status.FinalizedL2.Hash,
+	}
+	result, err := engine.ForkchoiceUpdate(ctx, fc, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update forkchoice of standby engine: %w", err)
+	}
+	switch result.PayloadStatus.Status {
+	case eth.ExecutionValid:
+		l.Info("Standby engine is consistent with rollup node")
+	case eth.ExecutionSyncing, eth.ExecutionAccepted:
+		l.Warn("Standby engine is syncing to the rollup node view")
+	default:
+		return fmt.Errorf("standby engine rejected forkchoice state: %s", eth.ForkchoiceUpdateErr(result.PayloadStatus))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package node
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
+	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sequencing"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// toNodeError classifies the error of an RPC method into one of the typed op-node RPC errors,
+// so that clients can branch on the error code instead of matching the message.
+// Errors that already carry an RPC error code, and errors that cannot be classified, are returned as-is.
+func toNodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var rpcErr interface{ ErrorCode() int }
+	if errors.As(err, &rpcErr) {
+		return err
+	}
+	switch {
+	case errors.Is(err, ethereum.NotFound), errors.Is(err, safedb.ErrNotFound), errors.Is(err, errL1TxNotFound),
+		errors.Is(err, logindex.ErrNotIndexed):
+		return eth.NewNodeError(eth.NodeNotFoundErrorCode, err)
+	case errors.Is(err, engine.ErrEngineSyncing):
+		return eth.NewNodeError(eth.NodeEngineSyncingErrorCode, err)
+	case errors.Is(err, derive.ErrReset):
+		return eth.NewNodeError(eth.NodeReorgErrorCode, err)
+	case errors.Is(err, derive.ErrTemporary), errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return eth.NewNodeError(eth.NodeUnavailableErrorCode, err)
+	case errors.Is(err, errTxIngressDisabled), errors.Is(err, sequencing.ErrSequencerNotEnabled):
+		return eth.NewNodeError(eth.NodeConfigErrorCode, err)
+	default:
+		return err
+	}
+}
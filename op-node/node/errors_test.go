@@ -0,0 +1,58 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
+	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sequencing"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestToNodeError(t *testing.T) {
+	require.NoError(t, toNodeError(nil))
+
+	tests := []struct {
+		err  error
+		code eth.ErrorCode
+	}{
+		{err: ethereum.NotFound, code: eth.NodeNotFoundErrorCode},
+		{err: safedb.ErrNotFound, code: eth.NodeNotFoundErrorCode},
+		{err: fmt.Errorf("%w: 0x1234", errL1TxNotFound), code: eth.NodeNotFoundErrorCode},
+		{err: fmt.Errorf("%w: requested 1 to 2", logindex.ErrNotIndexed), code: eth.NodeNotFoundErrorCode},
+		{err: engine.ErrEngineSyncing, code: eth.NodeEngineSyncingErrorCode},
+		{err: derive.NewResetError(errors.New("reorg")), code: eth.NodeReorgErrorCode},
+		{err: derive.NewTemporaryError(errors.New("busy")), code: eth.NodeUnavailableErrorCode},
+		{err: fmt.Errorf("failed: %w", context.DeadlineExceeded), code: eth.NodeUnavailableErrorCode},
+		{err: errTxIngressDisabled, code: eth.NodeConfigErrorCode},
+		{err: sequencing.ErrSequencerNotEnabled, code: eth.NodeConfigErrorCode},
+	}
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			err := toNodeError(test.err)
+			require.ErrorIs(t, err, test.err)
+			require.Equal(t, test.err.Error(), err.Error())
+			code, ok := eth.NodeErrorCodeOf(err)
+			require.True(t, ok)
+			require.Equal(t, test.code, code)
+		})
+	}
+
+	t.Run("Unclassified", func(t *testing.T) {
+		err := errors.New("boom")
+		require.Equal(t, err, toNodeError(err))
+	})
+
+	t.Run("KeepExistingCode", func(t *testing.T) {
+		err := eth.InputError{Inner: ethereum.NotFound, Code: eth.InvalidParams}
+		require.Equal(t, err, toNodeError(err))
+	})
+}
@@ -0,0 +1,61 @@
+package ingress
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Config of the transaction ingress of the sequencer RPC.
+type Config struct {
+	// Enabled serves eth_sendRawTransaction on the op-node RPC,
+	// forwarding the admitted transactions to the execution engine.
+	Enabled bool
+
+	Limits Limits
+}
+
+func (c *Config) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	return c.Limits.Check()
+}
+
+// Limits of the transaction ingress. These can be adjusted at runtime through the admin API.
+type Limits struct {
+	// GlobalRate is the number of transactions per second admitted from all senders combined.
+	// Disabled if 0.
+	GlobalRate  float64 `json:"globalRate"`
+	GlobalBurst int     `json:"globalBurst"`
+	// SenderRate is the number of transactions per second admitted from a single sender.
+	// Disabled if 0.
+	SenderRate  float64 `json:"senderRate"`
+	SenderBurst int     `json:"senderBurst"`
+	// MaxTxSize is the maximum size of an encoded transaction, in bytes. Disabled if 0.
+	MaxTxSize uint64 `json:"maxTxSize"`
+	// DustValue is the value, in wei, below which a transfer without calldata is scored as dust.
+	// Disabled if 0.
+	DustValue uint64 `json:"dustValue"`
+	// MaxSpamScore is the spam score at which transactions of a sender are rejected.
+	// Spam scoring is disabled if 0.
+	MaxSpamScore float64 `json:"maxSpamScore"`
+}
+
+func (l *Limits) Check() error {
+	if l.GlobalRate < 0 {
+		return errors.New("global rate must not be negative")
+	}
+	if l.GlobalRate > 0 && l.GlobalBurst < 1 {
+		return fmt.Errorf("global burst must be at least 1 when rate limiting, got %d", l.GlobalBurst)
+	}
+	if l.SenderRate < 0 {
+		return errors.New("sender rate must not be negative")
+	}
+	if l.SenderRate > 0 && l.SenderBurst < 1 {
+		return fmt.Errorf("sender burst must be at least 1 when rate limiting, got %d", l.SenderBurst)
+	}
+	if l.MaxSpamScore < 0 {
+		return errors.New("max spam score must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+const (
+	// maxTrackedSenders is the number of senders to keep rate-limits and spam scores of.
+	// The least recently seen senders are forgotten first.
+	maxTrackedSenders = 10_000
+	// maxPendingPerSender is the number of forwarded transactions per sender
+	// of which the receipt is checked for reverts.
+	maxPendingPerSender = 8
+	// receiptCheckInterval is the interval at which the receipts of forwarded transactions are checked.
+	receiptCheckInterval = 2 * time.Second
+	// maxReceiptChecks is the number of forwarded transactions of which the receipt is checked per interval,
+	// the least recently forwarded first. The receipts are fetched in a single batch.
+	maxReceiptChecks = 200
+	// pendingTTL is the time after which a forwarded transaction is no longer checked for a receipt.
+	pendingTTL = 5 * time.Minute
+
+	// spamScoreHalfLife is the time it takes for a spam score to decay to half its value.
+	spamScoreHalfLife = time.Minute
+	// dustPenalty is added to the spam score of a sender for every dust transfer.
+	dustPenalty = 1.0
+	// revertPenalty is added to the spam score of a sender for every forwarded transaction that reverted.
+	revertPenalty = 2.0
+)
+
+// Error codes of rejected transactions, as defined in EIP-1474.
+const (
+	InvalidInputErrorCode  = -32000
+	TxRejectedErrorCode    = -32003
+	LimitExceededErrorCode = -32005
+)
+
+type TxRejectReason string
+
+const (
+	RejectInvalidTx         TxRejectReason = "invalid-tx"
+	RejectOversized         TxRejectReason = "oversized"
+	RejectSpam              TxRejectReason = "spam"
+	RejectSenderRateLimited TxRejectReason = "sender-rate-limited"
+	RejectGlobalRateLimited TxRejectReason = "global-rate-limited"
+)
+
+// TxRejectedError is returned when a transaction is not forwarded to the execution engine.
+type TxRejectedError struct {
+	Reason TxRejectReason `json:"reason"`
+	Detail string         `json:"detail"`
+}
+
+func (e *TxRejectedError) Error() string {
+	return fmt.Sprintf("transaction rejected (%s): %s", e.Reason, e.Detail)
+}
+
+func (e *TxRejectedError) ErrorCode() int {
+	switch e.Reason {
+	case RejectInvalidTx:
+		return InvalidInputErrorCode
+	case RejectSenderRateLimited, RejectGlobalRateLimited:
+		return LimitExceededErrorCode
+	default:
+		return TxRejectedErrorCode
+	}
+}
+
+func (e *TxRejectedError) ErrorData() interface{} {
+	return e
+}
+
+type Metrics interface {
+	RecordTxIngress(result string)
+}
+
+// L2RPC is the RPC of the execution engine, which transactions are forwarded to.
+type L2RPC interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// pendingTx is a forwarded transaction that has not been included yet.
+type pendingTx struct {
+	hash        common.Hash
+	nonce       uint64
+	forwardedAt time.Time
+}
+
+type sender struct {
+	limiter  *rate.Limiter
+	score    float64
+	scoredAt time.Time
+	// pending are the forwarded transactions that have not been checked for a receipt yet.
+	pending []pendingTx
+}
+
+func (s *sender) scoreAt(now time.Time) float64 {
+	return s.score * math.Pow(0.5, now.Sub(s.scoredAt).Seconds()/spamScoreHalfLife.Seconds())
+}
+
+func (s *sender) addScore(now time.Time, v float64) {
+	s.score = s.scoreAt(now) + v
+	s.scoredAt = now
+}
+
+// Ingress admits transactions to the execution engine within the global and per-sender rate limits.
+// Senders that spam dust transfers, or transactions that revert, accumulate a decaying spam score,
+// and are rejected while their score is above the limit.
+// The receipts of forwarded transactions are checked for reverts in the background, once Start is called.
+type Ingress struct {
+	log    log.Logger
+	m      Metrics
+	l2     L2RPC
+	signer types.Signer
+	clock  clock.Clock
+
+	mu      sync.Mutex
+	limits  Limits
+	global  *rate.Limiter
+	senders *lru.Cache[common.Address, *sender]
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewIngress(log log.Logger, limits Limits, chainID *big.Int, l2 L2RPC, m Metrics, clk clock.Clock) (*Ingress, error) {
+	if err := limits.Check(); err != nil {
+		return nil, fmt.Errorf("invalid tx ingress limits: %w", err)
+	}
+	senders, err := lru.New[common.Address, *sender](maxTrackedSenders)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Ingress{
+		log:     log,
+		m:       m,
+		l2:      l2,
+		signer:  types.LatestSignerForChainID(chainID),
+		clock:   clk,
+		limits:  limits,
+		global:  newLimiter(limits.GlobalRate, limits.GlobalBurst),
+		senders: senders,
+		ctx:     ctx,
+		cancel:  cancel,
+	}, nil
+}
+
+// Start starts checking the receipts of forwarded transactions in the background.
+func (in *Ingress) Start() {
+	in.wg.Add(1)
+	go in.loop()
+}
+
+func (in *Ingress) Stop() {
+	in.cancel()
+	in.wg.Wait()
+}
+
+func (in *Ingress) loop() {
+	defer in.wg.Done()
+	ticker := in.clock.NewTicker(receiptCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.Ch():
+			in.CheckReceipts(in.ctx)
+		case <-in.ctx.Done():
+			return
+		}
+	}
+}
+
+func newLimiter(r float64, burst int) *rate.Limiter {
+	if r == 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(r), burst)
+}
+
+func updateLimiter(l *rate.Limiter, now time.Time, r float64, burst int) {
+	if r == 0 {
+		l.SetLimitAt(now, rate.Inf)
+		return
+	}
+	l.SetLimitAt(now, rate.Limit(r))
+	l.SetBurstAt(now, burst)
+}
+
+// Limits returns the current limits.
+func (in *Ingress) Limits() Limits {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.limits
+}
+
+// SetLimits replaces the limits. The spam scores of senders are retained.
+func (in *Ingress) SetLimits(limits Limits) error {
+	if err := limits.Check(); err != nil {
+		return err
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	now := in.clock.Now()
+	in.limits = limits
+	updateLimiter(in.global, now, limits.GlobalRate, limits.GlobalBurst)
+	for _, addr := range in.senders.Keys() {
+		if s, ok := in.senders.Peek(addr); ok {
+			updateLimiter(s.limiter, now, limits.SenderRate, limits.SenderBurst)
+		}
+	}
+	in.log.Info("Updated tx ingress limits", "limits", limits)
+	return nil
+}
+
+// SpamScore returns the current spam score of the sender.
+func (in *Ingress) SpamScore(addr common.Address) float64 {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	s, ok := in.senders.Peek(addr)
+	if !ok {
+		return 0
+	}
+	return s.scoreAt(in.clock.Now())
+}
+
+// SendRawTransaction forwards the transaction to the execution engine, if it is admitted.
+func (in *Ingress) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	tx, from, err := in.decode(data)
+	if err != nil {
+		return in.reject(err)
+	}
+	if err := in.admit(from, tx); err != nil {
+		in.log.Debug("Rejected transaction", "tx", tx.Hash(), "from", from, "err", err)
+		return in.reject(err)
+	}
+	var result common.Hash
+	if err := in.l2.CallContext(ctx, &result, "eth_sendRawTransaction", data); err != nil {
+		in.m.RecordTxIngress("forward-error")
+		return common.Hash{}, err
+	}
+	in.trackPending(from, result, tx.Nonce())
+	in.m.RecordTxIngress("accepted")
+	return result, nil
+}
+
+// API serves eth_sendRawTransaction through the ingress. It is registered in the public eth namespace
+// instead of the Ingress itself, to not expose its other methods.
+type API struct {
+	in *Ingress
+}
+
+func NewAPI(in *Ingress) *API {
+	return &API{in: in}
+}
+
+func (a *API) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	return a.in.SendRawTransaction(ctx, data)
+}
+
+func (in *Ingress) reject(err *TxRejectedError) (common.Hash, error) {
+	in.m.RecordTxIngress(string(err.Reason))
+	return common.Hash{}, err
+}
+
+func (in *Ingress) decode(data hexutil.Bytes) (*types.Transaction, common.Address, *TxRejectedError) {
+	if maxSize := in.Limits().MaxTxSize; maxSize > 0 && uint64(len(data)) > maxSize {
+		return nil, common.Address{}, &TxRejectedError{Reason: RejectOversized,
+			Detail: fmt.Sprintf("transaction size %d exceeds the limit of %d bytes", len(data), maxSize)}
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, common.Address{}, &TxRejectedError{Reason: RejectInvalidTx, Detail: err.Error()}
+	}
+	from, err := types.Sender(in.signer, &tx)
+	if err != nil {
+		return nil, common.Address{}, &TxRejectedError{Reason: RejectInvalidTx, Detail: err.Error()}
+	}
+	return &tx, from, nil
+}
+
+// admit scores the sender and applies the rate limits.
+func (in *Ingress) admit(from common.Address, tx *types.Transaction) *TxRejectedError {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	limits := in.limits
+	now := in.clock.Now()
+	s := in.sender(from)
+	if limits.MaxSpamScore > 0 {
+		if isDust(tx, limits.DustValue) {
+			s.addScore(now, dustPenalty)
+		}
+		if score := s.scoreAt(now); score >= limits.MaxSpamScore {
+			return &TxRejectedError{Reason: RejectSpam,
+				Detail: fmt.Sprintf("spam score %.2f of sender exceeds the limit of %.2f", score, limits.MaxSpamScore)}
+		}
+	}
+	if !s.limiter.AllowN(now, 1) {
+		return &TxRejectedError{Reason: RejectSenderRateLimited, Detail: "too many transactions from sender"}
+	}
+	if !in.global.AllowN(now, 1) {
+		return &TxRejectedError{Reason: RejectGlobalRateLimited, Detail: "too many transactions"}
+	}
+	return nil
+}
+
+// sender returns the state of the sender, creating it if it is not tracked yet. The lock must be held.
+func (in *Ingress) sender(addr common.Address) *sender {
+	s, ok := in.senders.Get(addr)
+	if !ok {
+		s = &sender{limiter: newLimiter(in.limits.SenderRate, in.limits.SenderBurst)}
+		in.senders.Add(addr, s)
+	}
+	return s
+}
+
+func (in *Ingress) trackPending(from common.Address, txHash common.Hash, nonce uint64) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if in.limits.MaxSpamScore == 0 {
+		return
+	}
+	s := in.sender(from)
+	s.pending = append(s.pending, pendingTx{hash: txHash, nonce: nonce, forwardedAt: in.clock.Now()})
+	if len(s.pending) > maxPendingPerSender {
+		s.pending = s.pending[len(s.pending)-maxPendingPerSender:]
+	}
+}
+
+// receiptCheck is the receipt lookup of a pending transaction.
+type receiptCheck struct {
+	from    common.Address
+	tx      pendingTx
+	receipt *types.Receipt
+	// nonce is the nonce of the sender at the latest block, shared by the checks of the same sender.
+	// It is zero if it could not be fetched.
+	nonce *hexutil.Uint64
+}
+
+// CheckReceipts fetches the receipts of up to maxReceiptChecks pending transactions, the least recently forwarded
+// first, and adds the penalty of the included transactions that reverted to the spam score of their sender.
+// Pending transactions are dropped once included, after pendingTTL, or once the nonce of the sender has passed them,
+// as they were replaced or dropped by the execution engine.
+func (in *Ingress) CheckReceipts(ctx context.Context) {
+	in.mu.Lock()
+	now := in.clock.Now()
+	var checks []*receiptCheck
+	for _, addr := range in.senders.Keys() {
+		s, ok := in.senders.Peek(addr)
+		if !ok {
+			continue
+		}
+		s.pending = slices.DeleteFunc(s.pending, func(tx pendingTx) bool {
+			return now.Sub(tx.forwardedAt) > pendingTTL
+		})
+		for _, tx := range s.pending {
+			checks = append(checks, &receiptCheck{from: addr, tx: tx})
+		}
+	}
+	in.mu.Unlock()
+	if len(checks) == 0 {
+		return
+	}
+	slices.SortStableFunc(checks, func(a, b *receiptCheck) int {
+		return a.tx.forwardedAt.Compare(b.tx.forwardedAt)
+	})
+	checks = checks[:min(len(checks), maxReceiptChecks)]
+
+	// Receipts are fetched outside the lock, the senders are looked up again afterward.
+	batch := receiptBatch(checks)
+	if err := in.l2.BatchCallContext(ctx, batch); err != nil {
+		in.log.Debug("Failed to fetch receipts of forwarded transactions", "err", err)
+		return
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	now = in.clock.Now()
+	receipts := batch[len(batch)-len(checks):]
+	for i, check := range checks {
+		if err := receipts[i].Error; err != nil {
+			in.log.Debug("Failed to fetch receipt of forwarded transaction", "tx", check.tx.hash, "from", check.from, "err", err)
+			continue
+		}
+		stale := uint64(*check.nonce) > check.tx.nonce
+		if check.receipt == nil && !stale {
+			continue // not included yet
+		}
+		s, ok := in.senders.Peek(check.from)
+		if !ok {
+			continue
+		}
+		s.pending = slices.DeleteFunc(s.pending, func(tx pendingTx) bool {
+			return tx.hash == check.tx.hash
+		})
+		if check.receipt != nil && check.receipt.Status == types.ReceiptStatusFailed {
+			s.addScore(now, revertPenalty)
+		}
+	}
+}
+
+// receiptBatch returns the batch that fetches the nonce of the senders of the checks, followed by the receipts
+// of the checks, in order.
+// The nonces are fetched first, so a transaction included by the time its sender nonce is fetched has a receipt.
+func receiptBatch(checks []*receiptCheck) []rpc.BatchElem {
+	var batch []rpc.BatchElem
+	nonces := make(map[common.Address]*hexutil.Uint64)
+	for _, check := range checks {
+		nonce, ok := nonces[check.from]
+		if !ok {
+			nonce = new(hexutil.Uint64)
+			nonces[check.from] = nonce
+			batch = append(batch, rpc.BatchElem{Method: "eth_getTransactionCount", Args: []any{check.from, "latest"}, Result: nonce})
+		}
+		check.nonce = nonce
+	}
+	for _, check := range checks {
+		batch = append(batch, rpc.BatchElem{Method: "eth_getTransactionReceipt", Args: []any{check.tx.hash}, Result: &check.receipt})
+	}
+	return batch
+}
+
+func isDust(tx *types.Transaction, dustValue uint64) bool {
+	if dustValue == 0 || len(tx.Data()) > 0 {
+		return false
+	}
+	return tx.Value().Cmp(new(big.Int).SetUint64(dustValue)) < 0
+}
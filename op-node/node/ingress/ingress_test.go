@@ -0,0 +1,317 @@
+package ingress
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+var chainID = big.NewInt(901)
+
+type stubL2 struct {
+	sent     []common.Hash
+	receipts map[common.Hash]*types.Receipt
+	nonces   map[common.Address]uint64
+	// batches are the sizes of the batch calls.
+	batches []int
+}
+
+func (s *stubL2) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	switch method {
+	case "eth_sendRawTransaction":
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(args[0].(hexutil.Bytes)); err != nil {
+			return err
+		}
+		s.sent = append(s.sent, tx.Hash())
+		*result.(*common.Hash) = tx.Hash()
+		return nil
+	case "eth_getTransactionReceipt":
+		*result.(**types.Receipt) = s.receipts[args[0].(common.Hash)]
+		return nil
+	case "eth_getTransactionCount":
+		*result.(*hexutil.Uint64) = hexutil.Uint64(s.nonces[args[0].(common.Address)])
+		return nil
+	default:
+		return errors.New("unexpected method")
+	}
+}
+
+func (s *stubL2) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	s.batches = append(s.batches, len(b))
+	for i := range b {
+		if b[i].Method == "eth_sendRawTransaction" {
+			return errors.New("unexpected batched method")
+		}
+		b[i].Error = s.CallContext(ctx, b[i].Result, b[i].Method, b[i].Args...)
+	}
+	return nil
+}
+
+type stubMetrics struct {
+	results map[string]int
+}
+
+func (s *stubMetrics) RecordTxIngress(result string) {
+	s.results[result]++
+}
+
+type testSender struct {
+	key   *ecdsa.PrivateKey
+	nonce uint64
+}
+
+func newTestSender() *testSender {
+	return &testSender{key: testutils.RandomKey()}
+}
+
+func (s *testSender) addr() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *testSender) tx(t *testing.T, value int64, data []byte) hexutil.Bytes {
+	to := common.Address{0xaa}
+	tx := types.MustSignNewTx(s.key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     s.nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       100_000,
+		To:        &to,
+		Value:     big.NewInt(value),
+		Data:      data,
+	})
+	s.nonce++
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return raw
+}
+
+func setup(t *testing.T, limits Limits) (*Ingress, *stubL2, *stubMetrics, *clock.DeterministicClock) {
+	l2 := &stubL2{receipts: make(map[common.Hash]*types.Receipt), nonces: make(map[common.Address]uint64)}
+	m := &stubMetrics{results: make(map[string]int)}
+	clk := clock.NewDeterministicClock(time.Unix(1000, 0))
+	in, err := NewIngress(testlog.Logger(t, log.LevelDebug), limits, chainID, l2, m, clk)
+	require.NoError(t, err)
+	return in, l2, m, clk
+}
+
+func requireRejected(t *testing.T, err error, reason TxRejectReason) {
+	var rejected *TxRejectedError
+	require.ErrorAs(t, err, &rejected)
+	require.Equal(t, reason, rejected.Reason)
+}
+
+func TestIngressRateLimits(t *testing.T) {
+	ctx := context.Background()
+	in, l2, m, clk := setup(t, Limits{GlobalRate: 10, GlobalBurst: 3, SenderRate: 1, SenderBurst: 2})
+	alice, bob := newTestSender(), newTestSender()
+
+	_, err := in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+	require.NoError(t, err)
+	_, err = in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+	require.NoError(t, err)
+	_, err = in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+	requireRejected(t, err, RejectSenderRateLimited)
+
+	_, err = in.SendRawTransaction(ctx, bob.tx(t, 1, nil))
+	require.NoError(t, err)
+	_, err = in.SendRawTransaction(ctx, bob.tx(t, 1, nil))
+	requireRejected(t, err, RejectGlobalRateLimited)
+	require.Len(t, l2.sent, 3)
+
+	clk.AdvanceTime(time.Second)
+	_, err = in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+	require.NoError(t, err)
+	require.Equal(t, 4, m.results["accepted"])
+	require.Equal(t, 1, m.results[string(RejectSenderRateLimited)])
+
+	t.Run("SetLimits", func(t *testing.T) {
+		require.Error(t, in.SetLimits(Limits{SenderRate: 1}), "burst is required")
+		require.NoError(t, in.SetLimits(Limits{}))
+		for i := 0; i < 10; i++ {
+			_, err := in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+			require.NoError(t, err)
+		}
+		require.Equal(t, Limits{}, in.Limits())
+	})
+}
+
+func TestIngressInvalid(t *testing.T) {
+	ctx := context.Background()
+	in, l2, _, _ := setup(t, Limits{MaxTxSize: 500})
+	alice := newTestSender()
+
+	_, err := in.SendRawTransaction(ctx, hexutil.Bytes{0x02, 0x01})
+	requireRejected(t, err, RejectInvalidTx)
+	_, err = in.SendRawTransaction(ctx, alice.tx(t, 0, make([]byte, 500)))
+	requireRejected(t, err, RejectOversized)
+
+	// signed for another chain
+	tx := types.MustSignNewTx(alice.key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{ChainID: big.NewInt(1)})
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	_, err = in.SendRawTransaction(ctx, raw)
+	requireRejected(t, err, RejectInvalidTx)
+	require.Empty(t, l2.sent)
+}
+
+func TestIngressSpamScore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Dust", func(t *testing.T) {
+		in, _, _, clk := setup(t, Limits{DustValue: 1000, MaxSpamScore: 3})
+		alice := newTestSender()
+		_, err := in.SendRawTransaction(ctx, alice.tx(t, 5000, nil))
+		require.NoError(t, err)
+		require.Zero(t, in.SpamScore(alice.addr()))
+		// calls to contracts are not dust
+		_, err = in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+		require.NoError(t, err)
+		require.Zero(t, in.SpamScore(alice.addr()))
+
+		for i := 0; i < 2; i++ {
+			_, err = in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+			require.NoError(t, err)
+		}
+		_, err = in.SendRawTransaction(ctx, alice.tx(t, 1, nil))
+		requireRejected(t, err, RejectSpam)
+
+		// the score decays over time
+		clk.AdvanceTime(2 * spamScoreHalfLife)
+		require.Less(t, in.SpamScore(alice.addr()), 1.0)
+		_, err = in.SendRawTransaction(ctx, alice.tx(t, 5000, nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("Reverts", func(t *testing.T) {
+		in, l2, _, _ := setup(t, Limits{MaxSpamScore: 4})
+		alice := newTestSender()
+		for i := 0; i < 3; i++ {
+			_, err := in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+			require.NoError(t, err)
+		}
+		// the first is still pending, the others reverted
+		l2.receipts[l2.sent[1]] = &types.Receipt{Status: types.ReceiptStatusFailed}
+		l2.receipts[l2.sent[2]] = &types.Receipt{Status: types.ReceiptStatusFailed}
+		// receipts are only checked in the background, not when transactions are sent
+		require.Zero(t, in.SpamScore(alice.addr()))
+		in.CheckReceipts(ctx)
+		_, err := in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+		requireRejected(t, err, RejectSpam)
+		require.InDelta(t, 2*revertPenalty, in.SpamScore(alice.addr()), 0.001)
+
+		// included transactions are only scored once
+		l2.receipts[l2.sent[0]] = &types.Receipt{Status: types.ReceiptStatusSuccessful}
+		in.CheckReceipts(ctx)
+		require.NoError(t, in.SetLimits(Limits{MaxSpamScore: 5}))
+		_, err = in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+		require.NoError(t, err)
+		require.InDelta(t, 2*revertPenalty, in.SpamScore(alice.addr()), 0.001)
+	})
+}
+
+func TestIngressPendingReceipts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Batched", func(t *testing.T) {
+		in, l2, _, _ := setup(t, Limits{MaxSpamScore: 100})
+		alice, bob := newTestSender(), newTestSender()
+		for i := 0; i < 3; i++ {
+			_, err := in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+			require.NoError(t, err)
+			_, err = in.SendRawTransaction(ctx, bob.tx(t, 0, []byte{1}))
+			require.NoError(t, err)
+		}
+		in.CheckReceipts(ctx)
+		// the nonce of each sender, and the receipt of each transaction
+		require.Equal(t, []int{2 + 6}, l2.batches)
+	})
+
+	t.Run("Capped", func(t *testing.T) {
+		in, l2, _, clk := setup(t, Limits{MaxSpamScore: 100})
+		senders := make([]*testSender, maxReceiptChecks/maxPendingPerSender+1)
+		for i := range senders {
+			senders[i] = newTestSender()
+			for j := 0; j < maxPendingPerSender; j++ {
+				_, err := in.SendRawTransaction(ctx, senders[i].tx(t, 0, []byte{1}))
+				require.NoError(t, err)
+			}
+			clk.AdvanceTime(time.Millisecond)
+		}
+		in.CheckReceipts(ctx)
+		// the least recently forwarded transactions are checked first, the last sender is not checked
+		require.Equal(t, []int{len(senders) - 1 + maxReceiptChecks}, l2.batches)
+		last := senders[len(senders)-1]
+		l2.receipts[l2.sent[len(l2.sent)-1]] = &types.Receipt{Status: types.ReceiptStatusFailed}
+		in.CheckReceipts(ctx)
+		require.Zero(t, in.SpamScore(last.addr()))
+
+		// once the other transactions are included, the transactions of the last sender are checked
+		for _, txHash := range l2.sent[:maxReceiptChecks] {
+			l2.receipts[txHash] = &types.Receipt{Status: types.ReceiptStatusSuccessful}
+		}
+		in.CheckReceipts(ctx)
+		in.CheckReceipts(ctx)
+		require.InDelta(t, revertPenalty, in.SpamScore(last.addr()), 0.001)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		in, l2, _, clk := setup(t, Limits{MaxSpamScore: 100})
+		alice := newTestSender()
+		_, err := in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+		require.NoError(t, err)
+		clk.AdvanceTime(pendingTTL + time.Second)
+		l2.receipts[l2.sent[0]] = &types.Receipt{Status: types.ReceiptStatusFailed}
+		in.CheckReceipts(ctx)
+		require.Empty(t, l2.batches)
+		require.Zero(t, in.SpamScore(alice.addr()))
+	})
+
+	t.Run("StaleNonce", func(t *testing.T) {
+		in, l2, _, _ := setup(t, Limits{MaxSpamScore: 100})
+		alice := newTestSender()
+		for i := 0; i < 2; i++ {
+			_, err := in.SendRawTransaction(ctx, alice.tx(t, 0, []byte{1}))
+			require.NoError(t, err)
+		}
+		// the first transaction was replaced, the second is not included yet
+		l2.nonces[alice.addr()] = 1
+		in.CheckReceipts(ctx)
+		in.CheckReceipts(ctx)
+		require.Equal(t, []int{1 + 2, 1 + 1}, l2.batches)
+	})
+}
+
+func TestIngressAPI(t *testing.T) {
+	in, l2, _, _ := setup(t, Limits{})
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", NewAPI(in)))
+	defer srv.Stop()
+	cl := rpc.DialInProc(srv)
+	defer cl.Close()
+
+	var txHash common.Hash
+	require.NoError(t, cl.Call(&txHash, "eth_sendRawTransaction", newTestSender().tx(t, 1, nil)))
+	require.Equal(t, l2.sent, []common.Hash{txHash})
+
+	// the limits can only be changed through the admin API
+	var limits Limits
+	require.ErrorContains(t, cl.Call(&limits, "eth_limits"), "does not exist")
+	require.ErrorContains(t, cl.Call(nil, "eth_setLimits", Limits{}), "does not exist")
+}
@@ -0,0 +1,29 @@
+package logindex
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config of the local index of the logs emitted by selected contracts.
+type Config struct {
+	// Addresses of the contracts to index the logs of, typically predeploys. Disabled if empty.
+	Addresses []common.Address
+	// Retention is the number of recent L2 blocks that are indexed.
+	Retention uint64
+}
+
+func (c *Config) Enabled() bool {
+	return len(c.Addresses) > 0
+}
+
+func (c *Config) Check() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.Retention == 0 {
+		return errors.New("log index retention must be at least 1 block")
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package logindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var (
+	// ErrNotIndexed is returned when the queried blocks are not (yet) in the index.
+	ErrNotIndexed = errors.New("blocks not indexed")
+	// ErrInvalidQuery is returned for a malformed log query.
+	ErrInvalidQuery = errors.New("invalid log query")
+)
+
+// Source provides the L2 blocks and receipts to index.
+type Source interface {
+	L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error)
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
+}
+
+// Query selects logs of a range of indexed blocks, following the filter semantics of eth_getLogs.
+type Query struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+	// Addresses restricts the logs to those emitted by any of the addresses. All indexed addresses if empty.
+	Addresses []common.Address `json:"addresses,omitempty"`
+	// Topics restricts the logs by position: a log matches if, for every position, its topic is one of the
+	// topics at that position. An empty position matches any topic.
+	Topics [][]common.Hash `json:"topics,omitempty"`
+}
+
+// IndexedRange is the range of blocks that is currently indexed.
+type IndexedRange struct {
+	Oldest eth.BlockID `json:"oldest"`
+	Latest eth.BlockID `json:"latest"`
+}
+
+type indexedBlock struct {
+	ref  eth.L2BlockRef
+	logs []*types.Log
+}
+
+// Indexer follows the unsafe L2 chain and keeps the logs of the configured contracts for the most recent blocks,
+// so that their events can be looked up without an archive execution engine.
+// Reorgs are detected by the parent hash of every indexed block, and the reorged blocks are indexed again.
+type Indexer struct {
+	log       log.Logger
+	retention uint64
+	addresses map[common.Address]struct{}
+	source    Source
+	clock     clock.Clock
+	interval  time.Duration
+
+	mu     sync.RWMutex
+	blocks []indexedBlock // contiguous, oldest first
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewIndexer(log log.Logger, cfg *Config, source Source, cl clock.Clock, interval time.Duration) *Indexer {
+	addresses := make(map[common.Address]struct{}, len(cfg.Addresses))
+	for _, addr := range cfg.Addresses {
+		addresses[addr] = struct{}{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Indexer{
+		log:       log,
+		retention: cfg.Retention,
+		addresses: addresses,
+		source:    source,
+		clock:     cl,
+		interval:  interval,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (x *Indexer) Start() {
+	x.wg.Add(1)
+	go x.loop()
+}
+
+func (x *Indexer) Stop() {
+	x.cancel()
+	x.wg.Wait()
+}
+
+func (x *Indexer) loop() {
+	defer x.wg.Done()
+	ticker := x.clock.NewTicker(x.interval)
+	defer ticker.Stop()
+	for {
+		if err := x.Update(x.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			x.log.Warn("Failed to update log index", "err", err)
+		}
+		select {
+		case <-ticker.Ch():
+		case <-x.ctx.Done():
+			return
+		}
+	}
+}
+
+// Update indexes the blocks up to the current unsafe head.
+func (x *Indexer) Update(ctx context.Context) error {
+	head, err := x.source.L2BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		return fmt.Errorf("failed to get unsafe head: %w", err)
+	}
+	for {
+		latest, ok := x.latest()
+		if ok && (latest.Number > head.Number || latest.Number == head.Number && latest.Hash != head.Hash) {
+			// The chain was reorged to a block at or below the latest indexed block
+			x.dropLatest()
+			continue
+		}
+		next := head.Number + 1 - min(x.retention, head.Number+1)
+		if ok {
+			next = latest.Number + 1
+		}
+		if next > head.Number {
+			return nil
+		}
+		ref, err := x.source.L2BlockRefByNumber(ctx, next)
+		if err != nil {
+			return fmt.Errorf("failed to get block %d: %w", next, err)
+		}
+		if ok && ref.ParentHash != latest.Hash {
+			x.log.Info("Reorg of indexed block detected", "block", latest)
+			x.dropLatest()
+			continue
+		}
+		_, receipts, err := x.source.FetchReceipts(ctx, ref.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to fetch receipts of block %s: %w", ref, err)
+		}
+		var logs []*types.Log
+		for _, receipt := range receipts {
+			for _, l := range receipt.Logs {
+				if _, ok := x.addresses[l.Address]; ok {
+					logs = append(logs, l)
+				}
+			}
+		}
+		x.add(indexedBlock{ref: ref, logs: logs})
+	}
+}
+
+func (x *Indexer) latest() (eth.L2BlockRef, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if len(x.blocks) == 0 {
+		return eth.L2BlockRef{}, false
+	}
+	return x.blocks[len(x.blocks)-1].ref, true
+}
+
+func (x *Indexer) dropLatest() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.blocks = x.blocks[:len(x.blocks)-1]
+}
+
+func (x *Indexer) add(block indexedBlock) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.blocks = append(x.blocks, block)
+	if uint64(len(x.blocks)) > x.retention {
+		// The dropped blocks are released when append next reallocates the slice
+		x.blocks = x.blocks[uint64(len(x.blocks))-x.retention:]
+	}
+}
+
+// Range returns the range of indexed blocks. Returns ErrNotIndexed if no block is indexed yet.
+func (x *Indexer) Range() (IndexedRange, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if len(x.blocks) == 0 {
+		return IndexedRange{}, ErrNotIndexed
+	}
+	return IndexedRange{
+		Oldest: x.blocks[0].ref.ID(),
+		Latest: x.blocks[len(x.blocks)-1].ref.ID(),
+	}, nil
+}
+
+// Logs returns the indexed logs that match the query, in chain order.
+// Returns ErrNotIndexed if any block of the range is not indexed.
+func (x *Indexer) Logs(q Query) ([]*types.Log, error) {
+	if q.FromBlock > q.ToBlock {
+		return nil, fmt.Errorf("%w: from block %d after to block %d", ErrInvalidQuery, q.FromBlock, q.ToBlock)
+	}
+	for _, addr := range q.Addresses {
+		if _, ok := x.addresses[addr]; !ok {
+			return nil, fmt.Errorf("%w: logs of %s are not indexed", ErrInvalidQuery, addr)
+		}
+	}
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if len(x.blocks) == 0 {
+		return nil, ErrNotIndexed
+	}
+	oldest, latest := x.blocks[0].ref.Number, x.blocks[len(x.blocks)-1].ref.Number
+	if uint64(q.FromBlock) < oldest || uint64(q.ToBlock) > latest {
+		return nil, fmt.Errorf("%w: requested %d to %d, indexed %d to %d", ErrNotIndexed, q.FromBlock, q.ToBlock, oldest, latest)
+	}
+	logs := []*types.Log{}
+	for _, block := range x.blocks[uint64(q.FromBlock)-oldest : uint64(q.ToBlock)-oldest+1] {
+		for _, l := range block.logs {
+			if matches(q, l) {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func matches(q Query, l *types.Log) bool {
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, l.Address) {
+		return false
+	}
+	if len(q.Topics) > len(l.Topics) {
+		return false
+	}
+	for i, topics := range q.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, l.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+package logindex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var (
+	indexedAddr = common.Address{0x42, 0x16}
+	otherAddr   = common.Address{0x99}
+	topicA      = common.Hash{0xaa}
+	topicB      = common.Hash{0xbb}
+)
+
+type stubChain struct {
+	blocks   []eth.L2BlockRef
+	receipts map[common.Hash]types.Receipts
+}
+
+// extend adds blocks to the chain, with one indexed log and one log of another contract per block.
+// The fork byte makes the block hashes of different forks distinct.
+func (c *stubChain) extend(n int, fork byte) {
+	for i := 0; i < n; i++ {
+		num := uint64(len(c.blocks))
+		ref := eth.L2BlockRef{Number: num, Hash: common.Hash{fork, byte(num >> 8), byte(num)}}
+		if num > 0 {
+			ref.ParentHash = c.blocks[num-1].Hash
+		}
+		c.blocks = append(c.blocks, ref)
+		topic := topicA
+		if num%2 == 1 {
+			topic = topicB
+		}
+		c.receipts[ref.Hash] = types.Receipts{{Logs: []*types.Log{
+			{Address: indexedAddr, Topics: []common.Hash{topic}, BlockNumber: num, BlockHash: ref.Hash},
+			{Address: otherAddr, Topics: []common.Hash{topic}, BlockNumber: num, BlockHash: ref.Hash},
+		}}}
+	}
+}
+
+func (c *stubChain) reorg(depth int, fork byte) {
+	c.blocks = c.blocks[:len(c.blocks)-depth]
+	c.extend(depth, fork)
+}
+
+func (c *stubChain) L2BlockRefByLabel(_ context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	if label != eth.Unsafe {
+		return eth.L2BlockRef{}, fmt.Errorf("unexpected label %s", label)
+	}
+	return c.blocks[len(c.blocks)-1], nil
+}
+
+func (c *stubChain) L2BlockRefByNumber(_ context.Context, num uint64) (eth.L2BlockRef, error) {
+	if num >= uint64(len(c.blocks)) {
+		return eth.L2BlockRef{}, fmt.Errorf("unknown block %d", num)
+	}
+	return c.blocks[num], nil
+}
+
+func (c *stubChain) FetchReceipts(_ context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	receipts, ok := c.receipts[blockHash]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown block %s", blockHash)
+	}
+	return nil, receipts, nil
+}
+
+func setupIndexer(t *testing.T, retention uint64) (*Indexer, *stubChain) {
+	chain := &stubChain{receipts: make(map[common.Hash]types.Receipts)}
+	cfg := &Config{Addresses: []common.Address{indexedAddr}, Retention: retention}
+	x := NewIndexer(testlog.Logger(t, log.LevelDebug), cfg, chain, clock.NewDeterministicClock(time.Unix(0, 0)), time.Second)
+	return x, chain
+}
+
+func TestIndexer(t *testing.T) {
+	t.Run("KeepRetention", func(t *testing.T) {
+		x, chain := setupIndexer(t, 10)
+		_, err := x.Range()
+		require.ErrorIs(t, err, ErrNotIndexed)
+
+		chain.extend(25, 1)
+		require.NoError(t, x.Update(context.Background()))
+		r, err := x.Range()
+		require.NoError(t, err)
+		require.Equal(t, chain.blocks[15].ID(), r.Oldest)
+		require.Equal(t, chain.blocks[24].ID(), r.Latest)
+
+		chain.extend(3, 1)
+		require.NoError(t, x.Update(context.Background()))
+		r, err = x.Range()
+		require.NoError(t, err)
+		require.Equal(t, chain.blocks[18].ID(), r.Oldest)
+		require.Equal(t, chain.blocks[27].ID(), r.Latest)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		x, chain := setupIndexer(t, 10)
+		chain.extend(10, 1)
+		require.NoError(t, x.Update(context.Background()))
+
+		logs, err := x.Logs(Query{FromBlock: 2, ToBlock: 5})
+		require.NoError(t, err)
+		require.Len(t, logs, 4)
+		for i, l := range logs {
+			require.Equal(t, indexedAddr, l.Address)
+			require.Equal(t, uint64(2+i), l.BlockNumber)
+		}
+
+		logs, err = x.Logs(Query{FromBlock: 0, ToBlock: 9, Addresses: []common.Address{indexedAddr}, Topics: [][]common.Hash{{topicB}}})
+		require.NoError(t, err)
+		require.Len(t, logs, 5)
+
+		logs, err = x.Logs(Query{FromBlock: 0, ToBlock: 9, Topics: [][]common.Hash{{topicA}, {topicB}}})
+		require.NoError(t, err)
+		require.Empty(t, logs)
+
+		_, err = x.Logs(Query{FromBlock: 5, ToBlock: 10})
+		require.ErrorIs(t, err, ErrNotIndexed)
+		_, err = x.Logs(Query{FromBlock: 5, ToBlock: 4})
+		require.ErrorIs(t, err, ErrInvalidQuery)
+		_, err = x.Logs(Query{FromBlock: 0, ToBlock: 1, Addresses: []common.Address{otherAddr}})
+		require.ErrorIs(t, err, ErrInvalidQuery)
+	})
+
+	t.Run("Reorg", func(t *testing.T) {
+		x, chain := setupIndexer(t, 10)
+		chain.extend(10, 1)
+		require.NoError(t, x.Update(context.Background()))
+
+		chain.reorg(3, 2)
+		chain.extend(1, 2)
+		require.NoError(t, x.Update(context.Background()))
+		logs, err := x.Logs(Query{FromBlock: 6, ToBlock: 10})
+		require.NoError(t, err)
+		require.Len(t, logs, 5)
+		for _, l := range logs {
+			require.Equal(t, chain.blocks[l.BlockNumber].Hash, l.BlockHash)
+		}
+	})
+
+	t.Run("ReorgToShorterChain", func(t *testing.T) {
+		x, chain := setupIndexer(t, 10)
+		chain.extend(10, 1)
+		require.NoError(t, x.Update(context.Background()))
+
+		chain.blocks = chain.blocks[:8]
+		chain.reorg(2, 2)
+		require.NoError(t, x.Update(context.Background()))
+		r, err := x.Range()
+		require.NoError(t, err)
+		require.Equal(t, chain.blocks[7].ID(), r.Latest)
+	})
+}
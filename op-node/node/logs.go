@@ -0,0 +1,51 @@
+package node
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// logIndexAPI serves the logs of the local log index, so that the recent logs of log-heavy predeploys
+// can be queried without a historical receipts index in the execution engine.
+type logIndexAPI struct {
+	index *logindex.Indexer
+	log   log.Logger
+	m     metrics.RPCMetricer
+}
+
+func NewLogIndexAPI(index *logindex.Indexer, log log.Logger, m metrics.RPCMetricer) *logIndexAPI {
+	return &logIndexAPI{
+		index: index,
+		log:   log,
+		m:     m,
+	}
+}
+
+// IndexedLogs returns the indexed logs that match the query, in chain order.
+// The whole block range of the query must be indexed, see IndexedLogRange.
+func (api *logIndexAPI) IndexedLogs(ctx context.Context, q logindex.Query) ([]*types.Log, error) {
+	recordDur := api.m.RecordRPCServerRequest("optimism_indexedLogs")
+	defer recordDur()
+
+	logs, err := api.index.Logs(q)
+	if errors.Is(err, logindex.ErrInvalidQuery) {
+		return nil, eth.InputError{Inner: err, Code: eth.InvalidParams}
+	}
+	return logs, toNodeError(err)
+}
+
+// IndexedLogRange returns the range of L2 blocks that are currently indexed.
+func (api *logIndexAPI) IndexedLogRange(ctx context.Context) (logindex.IndexedRange, error) {
+	recordDur := api.m.RecordRPCServerRequest("optimism_indexedLogRange")
+	defer recordDur()
+
+	r, err := api.index.Range()
+	return r, toNodeError(err)
+}
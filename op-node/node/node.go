@@ -20,16 +20,22 @@ import (
 
 	altda "github.com/ethereum-optimism/optimism/op-alt-da"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/node/attest"
+	"github.com/ethereum-optimism/optimism/op-node/node/ingress"
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
 	"github.com/ethereum-optimism/optimism/op-node/node/safedb"
+	"github.com/ethereum-optimism/optimism/op-node/node/txfeed"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/conductor"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/interop"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sequencing"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -60,9 +66,13 @@ type OpNode struct {
 	eventDrain event.Drainer
 
 	l1Source  *sources.L1Client     // L1 Client to fetch data from
+	l1RPC     client.RPC            // L1 RPC, for the queries the L1 Client does not support
+	l2RPC     client.RPC            // L2 Execution Engine RPC, for the queries the Engine client does not support
 	l2Driver  *driver.Driver        // L2 Engine to Sync
 	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
 	server    *rpcServer            // RPC server hosting the rollup-node API
+	txIngress *ingress.Ingress      // Transaction ingress of the sequencer, nil if disabled
+	txFeed    *txfeed.Feed          // Ordered transaction feed of the sequencer, nil if disabled
 	p2pNode   *p2p.NodeP2P          // P2P node functionality
 	p2pMu     gosync.Mutex          // protects p2pNode
 	p2pSigner p2p.Signer            // p2p gossip application messages will be signed with this signer
@@ -76,6 +86,10 @@ type OpNode struct {
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
 
+	attestService *attest.Service // serves signed safe-head attestations, nil if disabled
+
+	logIndex *logindex.Indexer // indexes the recent logs of selected predeploys, nil if disabled
+
 	beacon *sources.L1BeaconClient
 
 	interopSys interop.SubSystem
@@ -97,6 +111,7 @@ type OpNode struct {
 
 // The OpNode handles incoming gossip
 var _ p2p.GossipIn = (*OpNode)(nil)
+var _ p2p.GossipAttester = (*OpNode)(nil)
 
 // New creates a new OpNode instance.
 // The provided ctx argument is for the span of initialization only;
@@ -153,6 +168,7 @@ func (n *OpNode) init(ctx context.Context, cfg *Config) error {
 	if err := n.initP2P(cfg); err != nil {
 		return fmt.Errorf("failed to init the P2P stack: %w", err)
 	}
+	n.initLogIndex(cfg)
 	// Only expose the server at the end, ensuring all RPC backend components are initialized.
 	if err := n.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to init the RPC server: %w", err)
@@ -160,6 +176,9 @@ func (n *OpNode) init(ctx context.Context, cfg *Config) error {
 	if err := n.initMetricsServer(cfg); err != nil {
 		return fmt.Errorf("failed to init the metrics server: %w", err)
 	}
+	if err := n.initAttestService(cfg); err != nil {
+		return fmt.Errorf("failed to init the attestation service: %w", err)
+	}
 	n.metrics.RecordInfo(n.appVersion)
 	n.metrics.RecordUp()
 	if err := n.initPProf(cfg); err != nil {
@@ -193,8 +212,8 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("failed to get L1 RPC client: %w", err)
 	}
 
-	n.l1Source, err = sources.NewL1Client(
-		client.NewInstrumentedRPC(l1RPC, &n.metrics.RPCMetrics.RPCClientMetrics), n.log, n.metrics.L1SourceCache, l1Cfg)
+	n.l1RPC = client.NewInstrumentedRPC(l1RPC, &n.metrics.RPCMetrics.RPCClientMetrics)
+	n.l1Source, err = sources.NewL1Client(n.l1RPC, n.log, n.metrics.L1SourceCache, l1Cfg)
 	if err != nil {
 		return fmt.Errorf("failed to create L1 source: %w", err)
 	}
@@ -229,7 +248,7 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 
 func (n *OpNode) initRuntimeConfig(ctx context.Context, cfg *Config) error {
 	// attempt to load runtime config, repeat N times
-	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup)
+	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup, cfg.RuntimeConfigAddress)
 
 	confDepth := cfg.Driver.VerifierConfDepth
 	reload := func(ctx context.Context) (eth.L1BlockRef, error) {
@@ -338,7 +357,9 @@ func (n *OpNode) initL1BeaconAPI(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("failed to setup L1 Beacon API client: %w", err)
 	}
 	beaconCfg := sources.L1BeaconClientConfig{
-		FetchAllSidecars: cfg.Beacon.ShouldFetchAllSidecars(),
+		FetchAllSidecars:      cfg.Beacon.ShouldFetchAllSidecars(),
+		BlobSidecarsCacheSize: sources.DefaultBlobSidecarsCacheSize,
+		CacheMetrics:          n.metrics.L1SourceCache,
 	}
 	n.beacon = sources.NewL1BeaconClient(beaconClient, beaconCfg, fallbacks...)
 
@@ -389,13 +410,26 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("failed to setup L2 execution-engine RPC client: %w", err)
 	}
 
-	n.l2Source, err = sources.NewEngineClient(
-		client.NewInstrumentedRPC(rpcClient, &n.metrics.RPCClientMetrics), n.log, n.metrics.L2SourceCache, rpcCfg,
-	)
+	l2RPC := client.NewInstrumentedRPC(rpcClient, &n.metrics.RPCClientMetrics)
+	n.l2RPC = l2RPC
+	n.l2Source, err = sources.NewEngineClient(l2RPC, n.log, n.metrics.L2SourceCache, rpcCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create Engine client: %w", err)
 	}
 
+	if cfg.TxIngress.Enabled {
+		n.txIngress, err = ingress.NewIngress(n.log.New("rpc", "ingress"), cfg.TxIngress.Limits, cfg.Rollup.L2ChainID, l2RPC, n.metrics, clock.SystemClock)
+		if err != nil {
+			return fmt.Errorf("failed to create tx ingress: %w", err)
+		}
+		n.txIngress.Start()
+	}
+
+	if cfg.Sync.SyncMode == sync.AutoSync {
+		cfg.Sync.SyncMode = sync.NegotiateMode(ctx, n.log, &cfg.Rollup, l2RPC, n.l2Source, &cfg.Sync)
+		n.log.Info("Negotiated sync mode with the execution engine", "mode", cfg.Sync.SyncMode)
+	}
+
 	if err := cfg.Rollup.ValidateL2Config(ctx, n.l2Source, cfg.Sync.SyncMode == sync.ELSync); err != nil {
 		return err
 	}
@@ -423,7 +457,10 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config) error {
 	if cfg.AltDA.Enabled && err != nil {
 		return fmt.Errorf("failed to get altDA config: %w", err)
 	}
-	altDA := altda.NewAltDA(n.log, cfg.AltDA, rpCfg, n.metrics.AltDAMetrics)
+	altDA, err := altda.NewAltDA(n.log, cfg.AltDA, rpCfg, n.metrics.AltDAMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to create altDA client: %w", err)
+	}
 	if cfg.SafeDBPath != "" {
 		n.log.Info("Safe head database enabled", "path", cfg.SafeDBPath)
 		safeDB, err := safedb.NewSafeDB(n.log, cfg.SafeDBPath)
@@ -434,8 +471,28 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config) error {
 	} else {
 		n.safeDB = safedb.Disabled
 	}
+	var l1Blobs derive.L1BlobsFetcher = n.beacon
+	if n.beacon != nil && cfg.Beacon.ShouldBufferOnFailure() {
+		n.log.Info("Buffered L1 blobs retrieval enabled, blob fetching backs off when the L1 Beacon API is down")
+		l1Blobs = derive.NewBufferedBlobsFetcher(n.log, n.beacon, clock.SystemClock, retry.Exponential())
+	}
 	n.l2Driver = driver.NewDriver(n.eventSys, n.eventDrain, &cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source,
-		n.beacon, n, n, n.log, n.metrics, cfg.ConfigPersistence, n.safeDB, &cfg.Sync, sequencerConductor, altDA, managedMode)
+		l1Blobs, n, n, n.log, n.metrics, cfg.ConfigPersistence, n.safeDB, &cfg.Sync, sequencerConductor, altDA, managedMode)
+	if cfg.TxFeed.Enabled() {
+		n.txFeed, err = txfeed.Dial(ctx, n.log.New("sequencer", "txfeed"), &cfg.TxFeed)
+		if err != nil {
+			return err
+		}
+		if err := n.l2Driver.SetSequencerTxFeed(n.txFeed); err != nil {
+			return fmt.Errorf("failed to set sequencer tx feed: %w", err)
+		}
+		n.log.Info("Sequencing from tx feed instead of the execution engine mempool", "feed", cfg.TxFeed.Addr)
+	}
+	if failover, ok := rpcClient.(*client.FailoverClient); ok {
+		failover.SetFailoverCheck(func(ctx context.Context, standby client.RPC) error {
+			return n.checkStandbyEngine(ctx, standby, rpcCfg)
+		})
+	}
 	return nil
 }
 
@@ -445,11 +502,32 @@ func (n *OpNode) initRPCServer(cfg *Config) error {
 		return err
 	}
 
+	server.HealthChecker().Register("l1", func(ctx context.Context) error {
+		_, err := n.l1Source.InfoByLabel(ctx, eth.Unsafe)
+		return err
+	})
+	server.HealthChecker().Register("l2", func(ctx context.Context) error {
+		_, err := n.l2Source.InfoByLabel(ctx, eth.Unsafe)
+		return err
+	})
+	server.EnableDepositTracing(NewDepositAPI(&cfg.Rollup, n.l1RPC, n.l2RPC, n.log.New("rpc", "deposits"), n.metrics))
 	if p2pNode := n.getP2PNodeIfEnabled(); p2pNode != nil {
 		server.EnableP2P(p2p.NewP2PAPIBackend(p2pNode, n.log, n.metrics))
 	}
+	if n.txIngress != nil {
+		server.EnableTxIngress(ingress.NewAPI(n.txIngress))
+		n.log.Info("Sequencer tx ingress enabled")
+	}
+	if n.logIndex != nil {
+		server.EnableLogIndex(NewLogIndexAPI(n.logIndex, n.log.New("rpc", "logindex"), n.metrics))
+		n.log.Info("Log index RPC enabled")
+	}
 	if cfg.RPC.EnableAdmin {
-		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log))
+		l1Caches := n.l1Source.Caches()
+		if n.beacon != nil {
+			l1Caches = append(l1Caches, n.beacon.Caches()...)
+		}
+		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.txIngress, l1Caches, n.metrics, n.log))
 		n.log.Info("Admin RPC enabled")
 	}
 	n.log.Info("Starting JSON-RPC server")
@@ -476,6 +554,26 @@ func (n *OpNode) initMetricsServer(cfg *Config) error {
 	return nil
 }
 
+func (n *OpNode) initLogIndex(cfg *Config) {
+	if !cfg.LogIndex.Enabled() {
+		return
+	}
+	n.logIndex = logindex.NewIndexer(n.log.New("service", "logindex"), &cfg.LogIndex, n.l2Source, clock.SystemClock,
+		time.Duration(cfg.Rollup.BlockTime)*time.Second)
+	n.logIndex.Start()
+}
+
+func (n *OpNode) initAttestService(cfg *Config) error {
+	if !cfg.Attest.Enabled {
+		return nil
+	}
+	if n.p2pSigner == nil {
+		return errors.New("attestations require a p2p signer")
+	}
+	n.attestService = attest.NewService(n.log.New("service", "attest"), &cfg.Attest, cfg.Rollup.L2ChainID, n.l2Driver, n.p2pSigner, clock.SystemClock)
+	return n.attestService.Start()
+}
+
 func (n *OpNode) initPProf(cfg *Config) error {
 	n.pprofService = oppprof.New(
 		cfg.Pprof.ListenEnabled,
@@ -611,6 +709,12 @@ func (n *OpNode) PublishL2Payload(ctx context.Context, envelope *eth.ExecutionPa
 	return nil
 }
 
+// AttestationSigner implements p2p.GossipAttester: the p2p signer attests the payloads proposed by the other parties
+// of the attestation signer set, if any.
+func (n *OpNode) AttestationSigner() p2p.Signer {
+	return n.p2pSigner
+}
+
 func (n *OpNode) OnUnsafeL2Payload(ctx context.Context, from peer.ID, envelope *eth.ExecutionPayloadEnvelope) error {
 	// ignore if it's from ourselves
 	if p2pNode := n.getP2PNodeIfEnabled(); p2pNode != nil && from == p2pNode.Host().ID() {
@@ -658,6 +762,16 @@ func (n *OpNode) P2P() p2p.Node {
 	return n.getP2PNodeIfEnabled()
 }
 
+// CheckpointSafeDB writes a consistent copy of the safe head database to the directory, which must not exist yet.
+// It is a no-op if the safe head database is disabled.
+func (n *OpNode) CheckpointSafeDB(dir string) error {
+	db, ok := n.safeDB.(*safedb.SafeDB)
+	if !ok {
+		return nil
+	}
+	return db.Checkpoint(dir)
+}
+
 func (n *OpNode) RuntimeConfig() ReadonlyRuntimeConfig {
 	return n.runCfg
 }
@@ -677,6 +791,20 @@ func (n *OpNode) Stop(ctx context.Context) error {
 		}
 	}
 
+	// Stop attesting before the signer and driver are closed.
+	if n.attestService != nil {
+		if err := n.attestService.Stop(ctx); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close attestation service: %w", err))
+		}
+	}
+
+	if n.logIndex != nil {
+		n.logIndex.Stop()
+	}
+	if n.txIngress != nil {
+		n.txIngress.Stop()
+	}
+
 	// Stop sequencer and report last hash. l2Driver can be nil if we're cleaning up a failed init.
 	if n.l2Driver != nil {
 		latestHead, err := n.l2Driver.StopSequencer(ctx)
@@ -731,6 +859,10 @@ func (n *OpNode) Stop(ctx context.Context) error {
 		}
 	}
 
+	if n.txFeed != nil {
+		n.txFeed.Close()
+	}
+
 	// close the interop sub system
 	if n.interopSys != nil {
 		if err := n.interopSys.Stop(ctx); err != nil {
@@ -3,6 +3,8 @@ package node
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -26,8 +28,25 @@ var (
 	// RecommendedProtocolVersionStorageSlot is the storage slot that the recommended protocol version is stored at.
 	// Computed as: `bytes32(uint256(keccak256("protocolversion.recommended")) - 1)`
 	RecommendedProtocolVersionStorageSlot = common.HexToHash("0xe314dfc40f0025322aacc0ba8ef420b62fb3b702cf01e0cdf3d829117ac2ff1a")
+
+	// GossipMaxSizeStorageSlot is the storage slot of the gossip size limit in the runtime config L1 contract.
+	// Computed as: `bytes32(uint256(keccak256("runtimeconfig.gossipmaxsize")) - 1)`
+	GossipMaxSizeStorageSlot = common.HexToHash("0x4d56048487708cbb05f6434ed87d6fa0fc111ace80a3e46068407157a55cdc6a")
+
+	// GossipMaxBlocksPerHeightStorageSlot is the storage slot of the limit of gossiped blocks per height
+	// in the runtime config L1 contract.
+	// Computed as: `bytes32(uint256(keccak256("runtimeconfig.gossipmaxblocksperheight")) - 1)`
+	GossipMaxBlocksPerHeightStorageSlot = common.HexToHash("0xfdb0af6e16bdaf9594ec4ba032eed0f33ece63e13b61374e404138ae3f91673b")
+
+	// SequencerFeeRecipientStorageSlot is the storage slot of the sequencer fee recipient `address`
+	// in the runtime config L1 contract.
+	// Computed as: `bytes32(uint256(keccak256("runtimeconfig.sequencerfeerecipient")) - 1)`
+	SequencerFeeRecipientStorageSlot = common.HexToHash("0xb3e8b9126f2ba44e475eab63fe3f3f383e8c76e708a24d1e81b939fc57064988")
 )
 
+// maxRuntimeConfigHistory is the number of L1 blocks the loaded runtime config data is cached for.
+const maxRuntimeConfigHistory = 64
+
 type RuntimeCfgL1Source interface {
 	ReadStorageAt(ctx context.Context, address common.Address, storageSlot common.Hash, blockHash common.Hash) (common.Hash, error)
 }
@@ -36,6 +55,9 @@ type ReadonlyRuntimeConfig interface {
 	P2PSequencerAddress() common.Address
 	RequiredProtocolVersion() params.ProtocolVersion
 	RecommendedProtocolVersion() params.ProtocolVersion
+	GossipMaxSize() uint64
+	GossipMaxBlocksPerHeight() uint64
+	SequencerFeeRecipient() common.Address
 }
 
 // RuntimeConfig maintains runtime-configurable options.
@@ -50,13 +72,26 @@ type RuntimeConfig struct {
 	l1Client  RuntimeCfgL1Source
 	rollupCfg *rollup.Config
 
+	// runtimeCfgAddr is the optional L1 contract of the extended runtime config values.
+	runtimeCfgAddr common.Address
+
 	// l1Ref is the current source of the data,
 	// if this is invalidated with a reorg the data will have to be reloaded.
 	l1Ref eth.L1BlockRef
 
+	// history caches the data loaded at recent L1 blocks,
+	// to not reload it, and to roll back to it when L1 reorgs.
+	history []runtimeConfigEntry
+
 	runtimeConfigData
 }
 
+// runtimeConfigEntry is the runtime config data loaded at an L1 block.
+type runtimeConfigEntry struct {
+	l1Ref eth.L1BlockRef
+	data  runtimeConfigData
+}
+
 // runtimeConfigData is a flat bundle of configurable data, easy and light to copy around.
 type runtimeConfigData struct {
 	p2pBlockSignerAddr common.Address
@@ -64,15 +99,23 @@ type runtimeConfigData struct {
 	// superchain protocol version signals
 	recommended params.ProtocolVersion
 	required    params.ProtocolVersion
+
+	// values of the runtime config contract, zero if not configured
+	gossipMaxSize            uint64
+	gossipMaxBlocksPerHeight uint64
+	sequencerFeeRecipient    common.Address
 }
 
 var _ p2p.GossipRuntimeConfig = (*RuntimeConfig)(nil)
 
-func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config) *RuntimeConfig {
+// NewRuntimeConfig creates a runtime config. The extended values are loaded from the runtimeCfgAddr L1 contract,
+// and are left zero if the address is zero.
+func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config, runtimeCfgAddr common.Address) *RuntimeConfig {
 	return &RuntimeConfig{
-		log:       log,
-		l1Client:  l1Client,
-		rollupCfg: rollupCfg,
+		log:            log,
+		l1Client:       l1Client,
+		rollupCfg:      rollupCfg,
+		runtimeCfgAddr: runtimeCfgAddr,
 	}
 }
 
@@ -94,34 +137,131 @@ func (r *RuntimeConfig) RecommendedProtocolVersion() params.ProtocolVersion {
 	return r.recommended
 }
 
+func (r *RuntimeConfig) GossipMaxSize() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.gossipMaxSize
+}
+
+func (r *RuntimeConfig) GossipMaxBlocksPerHeight() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.gossipMaxBlocksPerHeight
+}
+
+// SequencerFeeRecipient returns the fee recipient signaled by the runtime config contract.
+// Note that derivation does not use it: the fee recipient of L2 blocks is fixed by the protocol.
+func (r *RuntimeConfig) SequencerFeeRecipient() common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sequencerFeeRecipient
+}
+
 // Load resets the runtime configuration by fetching the latest config data from L1 at the given L1 block.
+// Data that was loaded at the same L1 block before is not fetched again.
+// If L1 reorged, the data loaded at reorged-out L1 blocks is dropped.
 // Load is safe to call concurrently, but will lock the runtime configuration modifications only,
 // and will thus not block other Load calls with possibly alternative L1 block views.
 func (r *RuntimeConfig) Load(ctx context.Context, l1Ref eth.L1BlockRef) error {
+	if data, ok := r.cached(l1Ref); ok {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.update(l1Ref, data)
+		r.log.Debug("loaded cached runtime config values", "l1", l1Ref)
+		return nil
+	}
+	data, err := r.fetch(ctx, l1Ref)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.update(l1Ref, data)
+	r.log.Info("loaded new runtime config values!", "p2p_seq_address", r.p2pBlockSignerAddr,
+		"gossip_max_size", r.gossipMaxSize, "gossip_max_blocks_per_height", r.gossipMaxBlocksPerHeight,
+		"sequencer_fee_recipient", r.sequencerFeeRecipient)
+	return nil
+}
+
+func (r *RuntimeConfig) cached(l1Ref eth.L1BlockRef) (runtimeConfigData, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, entry := range r.history {
+		if entry.l1Ref.Hash == l1Ref.Hash {
+			return entry.data, true
+		}
+	}
+	return runtimeConfigData{}, false
+}
+
+// update applies the data loaded at the given L1 block, and caches it.
+// The cached data of L1 blocks at or after the height of the given L1 block is dropped,
+// since these blocks may have been reorged out. r.mu must be held.
+func (r *RuntimeConfig) update(l1Ref eth.L1BlockRef, data runtimeConfigData) {
+	if r.l1Ref != (eth.L1BlockRef{}) && l1Ref.Number <= r.l1Ref.Number && l1Ref.Hash != r.l1Ref.Hash {
+		r.log.Warn("L1 reorg detected, rolling back runtime config", "from", r.l1Ref, "to", l1Ref)
+	}
+	history := r.history[:0]
+	for _, entry := range r.history {
+		if entry.l1Ref.Number < l1Ref.Number {
+			history = append(history, entry)
+		}
+	}
+	history = append(history, runtimeConfigEntry{l1Ref: l1Ref, data: data})
+	if len(history) > maxRuntimeConfigHistory {
+		history = history[len(history)-maxRuntimeConfigHistory:]
+	}
+	r.history = history
+	r.l1Ref = l1Ref
+	r.runtimeConfigData = data
+}
+
+func (r *RuntimeConfig) fetch(ctx context.Context, l1Ref eth.L1BlockRef) (runtimeConfigData, error) {
+	var data runtimeConfigData
 	p2pSignerVal, err := r.l1Client.ReadStorageAt(ctx, r.rollupCfg.L1SystemConfigAddress, UnsafeBlockSignerAddressSystemConfigStorageSlot, l1Ref.Hash)
 	if err != nil {
-		return fmt.Errorf("failed to fetch unsafe block signing address from system config: %w", err)
+		return data, fmt.Errorf("failed to fetch unsafe block signing address from system config: %w", err)
 	}
+	data.p2pBlockSignerAddr = common.BytesToAddress(p2pSignerVal[:])
 	// The superchain protocol version data is optional; only applicable to rollup configs that specify a ProtocolVersions address.
-	var requiredProtVersion, recommendedProtoVersion params.ProtocolVersion
 	if r.rollupCfg.ProtocolVersionsAddress != (common.Address{}) {
 		requiredVal, err := r.l1Client.ReadStorageAt(ctx, r.rollupCfg.ProtocolVersionsAddress, RequiredProtocolVersionStorageSlot, l1Ref.Hash)
 		if err != nil {
-			return fmt.Errorf("required-protocol-version value failed to load from L1 contract: %w", err)
+			return data, fmt.Errorf("required-protocol-version value failed to load from L1 contract: %w", err)
 		}
-		requiredProtVersion = params.ProtocolVersion(requiredVal)
+		data.required = params.ProtocolVersion(requiredVal)
 		recommendedVal, err := r.l1Client.ReadStorageAt(ctx, r.rollupCfg.ProtocolVersionsAddress, RecommendedProtocolVersionStorageSlot, l1Ref.Hash)
 		if err != nil {
-			return fmt.Errorf("recommended-protocol-version value failed to load from L1 contract: %w", err)
+			return data, fmt.Errorf("recommended-protocol-version value failed to load from L1 contract: %w", err)
 		}
-		recommendedProtoVersion = params.ProtocolVersion(recommendedVal)
+		data.recommended = params.ProtocolVersion(recommendedVal)
 	}
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.l1Ref = l1Ref
-	r.p2pBlockSignerAddr = common.BytesToAddress(p2pSignerVal[:])
-	r.required = requiredProtVersion
-	r.recommended = recommendedProtoVersion
-	r.log.Info("loaded new runtime config values!", "p2p_seq_address", r.p2pBlockSignerAddr)
-	return nil
+	// The extended runtime config is optional; only applicable if a runtime config contract is configured.
+	if r.runtimeCfgAddr != (common.Address{}) {
+		gossipMaxSizeVal, err := r.l1Client.ReadStorageAt(ctx, r.runtimeCfgAddr, GossipMaxSizeStorageSlot, l1Ref.Hash)
+		if err != nil {
+			return data, fmt.Errorf("gossip-max-size value failed to load from runtime config contract: %w", err)
+		}
+		data.gossipMaxSize = storageUint64(gossipMaxSizeVal)
+		gossipMaxBlocksVal, err := r.l1Client.ReadStorageAt(ctx, r.runtimeCfgAddr, GossipMaxBlocksPerHeightStorageSlot, l1Ref.Hash)
+		if err != nil {
+			return data, fmt.Errorf("gossip-max-blocks-per-height value failed to load from runtime config contract: %w", err)
+		}
+		data.gossipMaxBlocksPerHeight = storageUint64(gossipMaxBlocksVal)
+		feeRecipientVal, err := r.l1Client.ReadStorageAt(ctx, r.runtimeCfgAddr, SequencerFeeRecipientStorageSlot, l1Ref.Hash)
+		if err != nil {
+			return data, fmt.Errorf("sequencer-fee-recipient value failed to load from runtime config contract: %w", err)
+		}
+		data.sequencerFeeRecipient = common.BytesToAddress(feeRecipientVal[:])
+	}
+	return data, nil
+}
+
+// storageUint64 decodes a uint256 storage value, saturating at the maximum uint64 value.
+func storageUint64(v common.Hash) uint64 {
+	n := new(big.Int).SetBytes(v[:])
+	if !n.IsUint64() {
+		return math.MaxUint64
+	}
+	return n.Uint64()
 }
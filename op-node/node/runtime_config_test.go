@@ -0,0 +1,97 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type storageKey struct {
+	addr      common.Address
+	slot      common.Hash
+	blockHash common.Hash
+}
+
+type mockRuntimeCfgL1Source struct {
+	storage map[storageKey]common.Hash
+	reads   int
+}
+
+func (m *mockRuntimeCfgL1Source) ReadStorageAt(ctx context.Context, address common.Address, storageSlot common.Hash, blockHash common.Hash) (common.Hash, error) {
+	m.reads++
+	return m.storage[storageKey{address, storageSlot, blockHash}], nil
+}
+
+func TestRuntimeConfig(t *testing.T) {
+	rollupCfg := &rollup.Config{L1SystemConfigAddress: common.Address{0xaa}}
+	runtimeCfgAddr := common.Address{0xbb}
+	l1A := eth.L1BlockRef{Number: 10, Hash: common.Hash{0x0a}}
+	l1B := eth.L1BlockRef{Number: 11, Hash: common.Hash{0x0b}, ParentHash: l1A.Hash}
+	l1BReorg := eth.L1BlockRef{Number: 11, Hash: common.Hash{0x1b}, ParentHash: l1A.Hash}
+
+	src := &mockRuntimeCfgL1Source{storage: make(map[storageKey]common.Hash)}
+	set := func(addr common.Address, slot common.Hash, l1 eth.L1BlockRef, v common.Hash) {
+		src.storage[storageKey{addr, slot, l1.Hash}] = v
+	}
+	for i, l1 := range []eth.L1BlockRef{l1A, l1B, l1BReorg} {
+		set(rollupCfg.L1SystemConfigAddress, UnsafeBlockSignerAddressSystemConfigStorageSlot, l1, common.BytesToHash([]byte{0x01}))
+		set(runtimeCfgAddr, GossipMaxSizeStorageSlot, l1, common.BigToHash(common.Big256))
+		set(runtimeCfgAddr, GossipMaxBlocksPerHeightStorageSlot, l1, common.BytesToHash([]byte{byte(i + 1)}))
+		set(runtimeCfgAddr, SequencerFeeRecipientStorageSlot, l1, common.BytesToHash([]byte{0xfe}))
+	}
+
+	t.Run("loads extended values", func(t *testing.T) {
+		r := NewRuntimeConfig(testlog.Logger(t, log.LevelDebug), src, rollupCfg, runtimeCfgAddr)
+		require.NoError(t, r.Load(context.Background(), l1A))
+		require.Equal(t, common.BytesToAddress([]byte{0x01}), r.P2PSequencerAddress())
+		require.Equal(t, uint64(256), r.GossipMaxSize())
+		require.Equal(t, uint64(1), r.GossipMaxBlocksPerHeight())
+		require.Equal(t, common.BytesToAddress([]byte{0xfe}), r.SequencerFeeRecipient())
+	})
+
+	t.Run("extended values disabled", func(t *testing.T) {
+		r := NewRuntimeConfig(testlog.Logger(t, log.LevelDebug), src, rollupCfg, common.Address{})
+		require.NoError(t, r.Load(context.Background(), l1A))
+		require.Equal(t, common.BytesToAddress([]byte{0x01}), r.P2PSequencerAddress())
+		require.Zero(t, r.GossipMaxSize())
+		require.Zero(t, r.GossipMaxBlocksPerHeight())
+		require.Zero(t, r.SequencerFeeRecipient())
+	})
+
+	t.Run("cached and rolled back on reorg", func(t *testing.T) {
+		r := NewRuntimeConfig(testlog.Logger(t, log.LevelDebug), src, rollupCfg, runtimeCfgAddr)
+		require.NoError(t, r.Load(context.Background(), l1A))
+		require.NoError(t, r.Load(context.Background(), l1B))
+		require.Equal(t, uint64(2), r.GossipMaxBlocksPerHeight())
+		reads := src.reads
+
+		// reloading the same block is served from the cache
+		require.NoError(t, r.Load(context.Background(), l1B))
+		require.Equal(t, reads, src.reads)
+
+		// L1 reorgs back to A: the values of A are restored from the cache
+		require.NoError(t, r.Load(context.Background(), l1A))
+		require.Equal(t, reads, src.reads)
+		require.Equal(t, uint64(1), r.GossipMaxBlocksPerHeight())
+
+		// the reorged-out block is dropped from the cache
+		require.NoError(t, r.Load(context.Background(), l1BReorg))
+		require.Equal(t, uint64(3), r.GossipMaxBlocksPerHeight())
+		require.NoError(t, r.Load(context.Background(), l1B))
+		require.Greater(t, src.reads, reads)
+		require.Equal(t, uint64(2), r.GossipMaxBlocksPerHeight())
+	})
+
+	t.Run("saturates large values", func(t *testing.T) {
+		require.Equal(t, uint64(1<<64-1), storageUint64(common.MaxHash))
+		require.Equal(t, uint64(42), storageUint64(common.BytesToHash([]byte{42})))
+	})
+}
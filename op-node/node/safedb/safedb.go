@@ -184,6 +184,20 @@ func (d *SafeDB) SafeHeadAtL1(ctx context.Context, l1BlockNum uint64) (l1Block e
 	return
 }
 
+// Checkpoint writes a consistent copy of the database to the directory, which must not exist yet.
+// The copy can be opened with NewSafeDB.
+func (d *SafeDB) Checkpoint(dir string) error {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if d.closed {
+		return pebble.ErrClosed
+	}
+	if err := d.db.Checkpoint(dir, pebble.WithFlushedWAL()); err != nil {
+		return fmt.Errorf("failed to checkpoint safe head db: %w", err)
+	}
+	return nil
+}
+
 func (d *SafeDB) Close() error {
 	d.m.Lock()
 	defer d.m.Unlock()
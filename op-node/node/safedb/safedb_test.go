@@ -3,9 +3,11 @@ package safedb
 import (
 	"context"
 	"math"
+	"path/filepath"
 	"slices"
 	"testing"
 
+	"github.com/cockroachdb/pebble"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
@@ -83,6 +85,36 @@ func TestSafeHeadAtL1_EmptyDatabase(t *testing.T) {
 	require.ErrorIs(t, err, ErrNotFound)
 }
 
+func TestCheckpoint(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	db, err := NewSafeDB(logger, t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+	l2a := eth.L2BlockRef{Hash: common.Hash{0x02, 0xaa}, Number: 20}
+	l1a := eth.BlockID{Hash: common.Hash{0x01, 0xaa}, Number: 100}
+	require.NoError(t, db.SafeHeadUpdated(l2a, l1a))
+
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, db.Checkpoint(checkpoint))
+
+	// Updates after the checkpoint are not included in it
+	l2b := eth.L2BlockRef{Hash: common.Hash{0x02, 0xbb}, Number: 25}
+	l1b := eth.BlockID{Hash: common.Hash{0x01, 0xbb}, Number: 150}
+	require.NoError(t, db.SafeHeadUpdated(l2b, l1b))
+
+	checkpointDB, err := NewSafeDB(logger, checkpoint)
+	require.NoError(t, err)
+	defer checkpointDB.Close()
+	actualL1, actualL2, err := checkpointDB.SafeHeadAtL1(context.Background(), l1b.Number)
+	require.NoError(t, err)
+	require.Equal(t, l1a, actualL1)
+	require.Equal(t, l2a.ID(), actualL2)
+
+	// Can't checkpoint a closed DB
+	require.NoError(t, db.Close())
+	require.ErrorIs(t, db.Checkpoint(filepath.Join(t.TempDir(), "closed")), pebble.ErrClosed)
+}
+
 func TestTruncateOnSafeHeadReset(t *testing.T) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	dir := t.TempDir()
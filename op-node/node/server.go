@@ -7,12 +7,15 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/health"
 	ophttp "github.com/ethereum-optimism/optimism/op-service/httputil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/node/ingress"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
@@ -23,6 +26,7 @@ type rpcServer struct {
 	apis       []rpc.API
 	httpServer *ophttp.HTTPServer
 	appVersion string
+	health     *health.Checker
 	log        log.Logger
 	sources.L2Client
 }
@@ -39,11 +43,17 @@ func newRPCServer(rpcCfg *RPCConfig, rollupCfg *rollup.Config, l2Client l2EthCli
 			Authenticated: false,
 		}},
 		appVersion: appVersion,
+		health:     health.NewChecker(appVersion, clock.SystemClock),
 		log:        log,
 	}
 	return r, nil
 }
 
+// HealthChecker returns the checker of the readiness endpoint, to register the dependency probes of the node with.
+func (s *rpcServer) HealthChecker() *health.Checker {
+	return s.health
+}
+
 func (s *rpcServer) EnableAdminAPI(api *adminAPI) {
 	s.apis = append(s.apis, rpc.API{
 		Namespace:     "admin",
@@ -53,6 +63,36 @@ func (s *rpcServer) EnableAdminAPI(api *adminAPI) {
 	})
 }
 
+// EnableDepositTracing serves optimism_traceDeposit, to trace L1 deposits to their inclusion on L2.
+func (s *rpcServer) EnableDepositTracing(api *depositAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "optimism",
+		Version:       "",
+		Service:       api,
+		Authenticated: false,
+	})
+}
+
+// EnableLogIndex serves optimism_indexedLogs, to query the locally indexed logs of selected predeploys.
+func (s *rpcServer) EnableLogIndex(api *logIndexAPI) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "optimism",
+		Version:       "",
+		Service:       api,
+		Authenticated: false,
+	})
+}
+
+// EnableTxIngress serves eth_sendRawTransaction, to submit transactions to the sequencer.
+func (s *rpcServer) EnableTxIngress(in *ingress.API) {
+	s.apis = append(s.apis, rpc.API{
+		Namespace:     "eth",
+		Version:       "",
+		Service:       in,
+		Authenticated: false,
+	})
+}
+
 func (s *rpcServer) EnableP2P(backend *p2p.APIBackend) {
 	s.apis = append(s.apis, rpc.API{
 		Namespace:     p2p.NamespaceRPC,
@@ -76,7 +116,8 @@ func (s *rpcServer) Start() error {
 
 	mux := http.NewServeMux()
 	mux.Handle("/", nodeHandler)
-	mux.HandleFunc("/healthz", healthzHandler(s.appVersion))
+	mux.HandleFunc("/healthz", health.LivenessHandler(s.appVersion))
+	mux.HandleFunc("/readyz", s.health.ReadinessHandler())
 
 	hs, err := ophttp.StartHTTPServer(s.endpoint, mux)
 	if err != nil {
@@ -93,9 +134,3 @@ func (r *rpcServer) Stop(ctx context.Context) error {
 func (r *rpcServer) Addr() net.Addr {
 	return r.httpServer.Addr()
 }
-
-func healthzHandler(appVersion string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		_, _ = w.Write([]byte(appVersion))
-	}
-}
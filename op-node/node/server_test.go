@@ -3,9 +3,11 @@ package node
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"math/rand"
 	"testing"
 
+	"github.com/BurntSushi/toml"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -16,11 +18,15 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum-optimism/superchain-registry/superchain"
 )
 
 func TestOutputAtBlock(t *testing.T) {
@@ -154,6 +160,146 @@ func TestVersion(t *testing.T) {
 	assert.Equal(t, version.Version+"-"+version.Meta, out)
 }
 
+func TestL1CacheAdminAPI(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	headers := caching.NewLRUCache[common.Hash, eth.BlockInfo](nil, "headers", 10)
+	headers.Add(common.Hash{0x01}, nil)
+	headers.Add(common.Hash{0x02}, nil)
+	headers.Get(common.Hash{0x01})
+	receipts := caching.NewLRUCache[common.Hash, types.Receipts](nil, "receipts", 20)
+	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	server.EnableAdminAPI(NewAdminAPI(drClient, nil, []caching.ResizableCache{headers, receipts}, metrics.NoopMetrics, log))
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialAttempts(3))
+	require.NoError(t, err)
+	rollupClient := sources.NewRollupClient(client)
+
+	stats, err := rollupClient.L1CacheStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []caching.Stats{
+		{Label: "headers", Size: 2, Capacity: 10, Hits: 1},
+		{Label: "receipts", Capacity: 20},
+	}, stats)
+
+	resized, err := rollupClient.SetL1CacheSize(context.Background(), "headers", 1)
+	require.NoError(t, err)
+	require.Equal(t, caching.Stats{Label: "headers", Size: 1, Capacity: 1, Hits: 1, Evictions: 1}, resized)
+	// The least recently used item is evicted
+	_, ok := headers.Get(common.Hash{0x01})
+	require.True(t, ok)
+
+	_, err = rollupClient.SetL1CacheSize(context.Background(), "headers", 0)
+	require.ErrorContains(t, err, "invalid capacity")
+	_, err = rollupClient.SetL1CacheSize(context.Background(), "unknown", 10)
+	require.ErrorContains(t, err, "unknown L1 cache")
+	require.True(t, eth.IsNodeError(err, eth.NodeConfigErrorCode))
+}
+
+func TestPauseDerivationAdminAPI(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	safeReader := &mockSafeDBReader{}
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(rpcCfg, rollupCfg, l2Client, drClient, safeReader, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	server.EnableAdminAPI(NewAdminAPI(drClient, nil, nil, metrics.NoopMetrics, log))
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialAttempts(3))
+	require.NoError(t, err)
+	rollupClient := sources.NewRollupClient(client)
+
+	drClient.Mock.On("PauseDerivation").Return(nil).Once()
+	require.NoError(t, rollupClient.PauseDerivation(context.Background()))
+
+	paused := &eth.SyncStatus{DerivationPaused: true}
+	drClient.Mock.On("SyncStatus").Return(paused).Once()
+	status, err := rollupClient.SyncStatus(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.DerivationPaused)
+
+	drClient.Mock.On("ResumeDerivation").Return(errors.New("boom")).Once()
+	err = rollupClient.ResumeDerivation(context.Background())
+	require.ErrorContains(t, err, "boom")
+	_, ok := eth.NodeErrorCodeOf(err)
+	require.False(t, ok, "unclassified errors have no typed error code")
+
+	drClient.Mock.On("PauseDerivation").Return(derive.NewTemporaryError(errors.New("busy"))).Once()
+	err = rollupClient.PauseDerivation(context.Background())
+	require.ErrorContains(t, err, "busy")
+	require.True(t, eth.IsNodeError(err, eth.NodeUnavailableErrorCode))
+	drClient.Mock.AssertExpectations(t)
+}
+
+func TestExportConfig(t *testing.T) {
+	log := testlog.Logger(t, log.LevelError)
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg, err := rollup.LoadOPStackRollupConfig(10)
+	require.NoError(t, err)
+	server, err := newRPCServer(rpcCfg, rollupCfg, &testutils.MockL2Client{}, &mockDriverClient{}, &mockSafeDBReader{}, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialAttempts(3))
+	require.NoError(t, err)
+	expected, err := rollupCfg.SuperchainChainConfig()
+	require.NoError(t, err)
+
+	t.Run("TOML", func(t *testing.T) {
+		var out string
+		require.NoError(t, client.CallContext(context.Background(), &out, "optimism_exportConfig", "toml"))
+		var actual superchain.ChainConfig
+		_, err := toml.Decode(out, &actual)
+		require.NoError(t, err)
+		require.Equal(t, *expected, actual)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var out string
+		require.NoError(t, client.CallContext(context.Background(), &out, "optimism_exportConfig", "json"))
+		var actual superchain.ChainConfig
+		require.NoError(t, json.Unmarshal([]byte(out), &actual))
+		require.Equal(t, expected.Genesis, actual.Genesis)
+		require.Equal(t, expected.Addresses.OptimismPortalProxy, actual.Addresses.OptimismPortalProxy)
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		var out string
+		require.ErrorContains(t, client.CallContext(context.Background(), &out, "optimism_exportConfig", "yaml"), "unsupported config format")
+	})
+}
+
 func randomSyncStatus(rng *rand.Rand) *eth.SyncStatus {
 	return &eth.SyncStatus{
 		CurrentL1:          testutils.RandomBlockRef(rng),
@@ -267,6 +413,14 @@ func (c *mockDriverClient) ResetDerivationPipeline(ctx context.Context) error {
 	return c.Mock.MethodCalled("ResetDerivationPipeline").Get(0).(error)
 }
 
+func (c *mockDriverClient) PauseDerivation(ctx context.Context) error {
+	return c.Mock.MethodCalled("PauseDerivation").Error(0)
+}
+
+func (c *mockDriverClient) ResumeDerivation(ctx context.Context) error {
+	return c.Mock.MethodCalled("ResumeDerivation").Error(0)
+}
+
 func (c *mockDriverClient) StartSequencer(ctx context.Context, blockHash common.Hash) error {
 	return c.Mock.MethodCalled("StartSequencer").Get(0).(error)
 }
@@ -0,0 +1,31 @@
+package txfeed
+
+import (
+	"errors"
+	"time"
+)
+
+// Config of the ordered transaction feed that the sequencer builds blocks from,
+// instead of the mempool of the execution engine.
+type Config struct {
+	// Addr is the HTTP or WebSocket address of the JSON-RPC endpoint of the feed. Disabled if empty.
+	Addr string
+	// JWTSecret authenticates the sequencer to the feed during HTTP or initial WebSocket communication.
+	JWTSecret [32]byte
+	// Timeout of a request for the transactions of a block.
+	Timeout time.Duration
+}
+
+func (c *Config) Enabled() bool {
+	return c.Addr != ""
+}
+
+func (c *Config) Check() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.Timeout <= 0 {
+		return errors.New("tx feed timeout must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package txfeed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gn "github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sequencing"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// Feed requests the transactions of each block from an external ordering service over JSON-RPC.
+// The transactions are pulled per block, so the ordering service holds back the transactions
+// that do not fit in the block until the sequencer is ready to include them.
+type Feed struct {
+	log     log.Logger
+	rpc     client.RPC
+	timeout time.Duration
+}
+
+var _ sequencing.TxFeed = (*Feed)(nil)
+
+// Dial creates a Feed with an authenticated connection to the feed endpoint.
+// The feed does not have to be up yet, the sequencer builds blocks without transactions until it is.
+func Dial(ctx context.Context, log log.Logger, cfg *Config) (*Feed, error) {
+	auth := rpc.WithHTTPAuth(gn.NewJWTAuth(cfg.JWTSecret))
+	cl, err := client.NewRPC(ctx, log, cfg.Addr, client.WithGethRPCOptions(auth), client.WithLazyDial())
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup tx feed client: %w", err)
+	}
+	return NewFeed(log, cl, cfg.Timeout), nil
+}
+
+func NewFeed(log log.Logger, rpc client.RPC, timeout time.Duration) *Feed {
+	return &Feed{
+		log:     log,
+		rpc:     rpc,
+		timeout: timeout,
+	}
+}
+
+func (f *Feed) NextTransactions(ctx context.Context, parent eth.BlockID, timestamp uint64, gas uint64) ([]eth.Data, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	var txs []eth.Data
+	err := f.rpc.CallContext(ctx, &txs, "txfeed_nextTransactions", parent, hexutil.Uint64(timestamp), hexutil.Uint64(gas))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions from tx feed: %w", err)
+	}
+	f.log.Debug("Received transactions from tx feed", "parent", parent, "timestamp", timestamp, "gas", gas, "txs", len(txs))
+	return txs, nil
+}
+
+func (f *Feed) Close() {
+	f.rpc.Close()
+}
@@ -0,0 +1,50 @@
+package txfeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubFeedAPI struct {
+	parent    eth.BlockID
+	timestamp uint64
+	gas       uint64
+	txs       []eth.Data
+}
+
+func (s *stubFeedAPI) NextTransactions(_ context.Context, parent eth.BlockID, timestamp hexutil.Uint64, gas hexutil.Uint64) ([]eth.Data, error) {
+	s.parent = parent
+	s.timestamp = uint64(timestamp)
+	s.gas = uint64(gas)
+	return s.txs, nil
+}
+
+func TestFeed(t *testing.T) {
+	api := &stubFeedAPI{txs: []eth.Data{{0x01, 0x02}, {0x03}}}
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("txfeed", api))
+	t.Cleanup(srv.Stop)
+
+	feed := NewFeed(testlog.Logger(t, log.LevelDebug), client.NewBaseRPCClient(rpc.DialInProc(srv)), time.Minute)
+	t.Cleanup(feed.Close)
+
+	parent := eth.BlockID{Hash: common.Hash{0xaa}, Number: 100}
+	txs, err := feed.NextTransactions(context.Background(), parent, 1234, 30_000_000)
+	require.NoError(t, err)
+	require.Equal(t, api.txs, txs)
+	require.Equal(t, parent, api.parent)
+	require.Equal(t, uint64(1234), api.timestamp)
+	require.Equal(t, uint64(30_000_000), api.gas)
+}
@@ -0,0 +1,428 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	lru "github.com/hashicorp/golang-lru/v2"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	// maxAttestations is the maximum number of signatures of an attested payload, as the count is encoded in a single byte.
+	maxAttestations = 255
+	// attestationTimeout is how long a published payload waits for the attestations of the signer set.
+	attestationTimeout = 2 * time.Second
+)
+
+// Message types of the attestation topic, the first byte of the decompressed message.
+const (
+	// attestationProposalType proposes a payload to the signer set: block version (1) ++ signature (65) ++ payload.
+	attestationProposalType byte = 0
+	// attestationSignatureType attests a proposed payload: block signing hash (32) ++ signature (65).
+	attestationSignatureType byte = 1
+)
+
+// AttestationConfig configures gossiped payloads to be attested by a threshold of a set of signers,
+// instead of being signed by the single p2p sequencer address. This enables shared-sequencing setups,
+// where multiple parties co-sign blocks.
+//
+// Each party signs with its own p2p signer. The publisher of a payload proposes it to the signer set on the
+// attestation topic, the other parties gossip their signature of the payload back, and the publisher gossips the
+// payload on the blocks topic once attested by the threshold.
+//
+// Attested payloads are encoded as: count (1 byte) ++ count * 65-byte signatures ++ payload.
+type AttestationConfig struct {
+	// Signers is the set of addresses that may attest payloads.
+	Signers []common.Address
+	// Threshold is the number of distinct signers that have to attest a payload.
+	Threshold uint64
+}
+
+func (c *AttestationConfig) Check() error {
+	if len(c.Signers) == 0 {
+		return errors.New("attestation signer set is empty")
+	}
+	if len(c.Signers) > maxAttestations {
+		return fmt.Errorf("attestation signer set is too large: %d > %d", len(c.Signers), maxAttestations)
+	}
+	if c.Threshold == 0 || c.Threshold > uint64(len(c.Signers)) {
+		return fmt.Errorf("attestation threshold must be between 1 and %d, got %d", len(c.Signers), c.Threshold)
+	}
+	seen := make(map[common.Address]struct{}, len(c.Signers))
+	for _, signer := range c.Signers {
+		if _, ok := seen[signer]; ok {
+			return fmt.Errorf("duplicate attestation signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
+
+func (c *AttestationConfig) isSigner(addr common.Address) bool {
+	for _, signer := range c.Signers {
+		if signer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// GossipAttester is implemented by a GossipIn that attests the payloads proposed by the other parties of the
+// attestation signer set. The signer may be nil, if the node does not attest payloads.
+type GossipAttester interface {
+	AttestationSigner() Signer
+}
+
+func attestationsTopic(cfg *rollup.Config) string {
+	return fmt.Sprintf("/optimism/%s/0/attestations", cfg.L2ChainID.String())
+}
+
+// attestationProposal is a validated proposal of the attestation topic.
+type attestationProposal struct {
+	signingHash  common.Hash
+	payloadBytes []byte
+	envelope     *eth.ExecutionPayloadEnvelope
+}
+
+// attestationSignature is a validated signature of the attestation topic, by a signer of the signer set.
+type attestationSignature struct {
+	signingHash common.Hash
+	signer      common.Address
+	signature   [65]byte
+}
+
+// collectedAttestations are the signatures of a payload proposed by this node.
+type collectedAttestations struct {
+	signatures map[common.Address][65]byte
+	// order is the order the signers attested the payload in, to encode the signatures deterministically.
+	order []common.Address
+	// attested is closed once the threshold is reached.
+	attested chan struct{}
+}
+
+// attester proposes the payloads published by this node to the signer set, and collects their attestations.
+// It also attests the payloads proposed by the other parties, if the node has a signer.
+type attester struct {
+	log         log.Logger
+	cfg         *rollup.Config
+	runCfg      GossipRuntimeConfig
+	attestation *AttestationConfig
+	// signer attests the payloads proposed by other parties, nil if this node does not attest.
+	signer Signer
+	// publish publishes an uncompressed message on the attestation topic.
+	publish func(ctx context.Context, data []byte) error
+
+	mu        sync.Mutex
+	collected map[common.Hash]*collectedAttestations
+	// attested are the signing hashes of the payloads attested by this node per block height,
+	// to not attest conflicting payloads at the same height.
+	attested *lru.Cache[uint64, common.Hash]
+}
+
+func newAttester(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, attestation *AttestationConfig, signer Signer,
+	publish func(ctx context.Context, data []byte) error) *attester {
+	attested, err := lru.New[uint64, common.Hash](1000)
+	if err != nil {
+		panic(fmt.Errorf("failed to set up attested blocks LRU cache: %w", err))
+	}
+	return &attester{
+		log:         log,
+		cfg:         cfg,
+		runCfg:      runCfg,
+		attestation: attestation,
+		signer:      signer,
+		publish:     publish,
+		collected:   make(map[common.Hash]*collectedAttestations),
+		attested:    attested,
+	}
+}
+
+// attest signs the payload with the signer, proposes it to the signer set, and waits for the attestations of the
+// other parties until the threshold is reached or attestationTimeout passes.
+// Returns the encoded attestations to prefix the payload with.
+func (a *attester) attest(ctx context.Context, signer Signer, blockVersion eth.BlockVersion, payloadData []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, attestationTimeout)
+	defer cancel()
+	signingHash, err := BlockSigningHash(a.cfg, payloadData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute block signing hash: %w", err)
+	}
+	sig, err := signer.Sign(ctx, SigningDomainBlocksV1, a.cfg.L2ChainID, payloadData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign execution payload with signer: %w", err)
+	}
+	addr, err := recoverAttester(a.attestation, signingHash, sig[:])
+	if err != nil {
+		return nil, err
+	}
+
+	collected := &collectedAttestations{signatures: make(map[common.Address][65]byte), attested: make(chan struct{})}
+	a.mu.Lock()
+	a.collected[signingHash] = collected
+	a.mu.Unlock()
+	defer func() {
+		a.mu.Lock()
+		delete(a.collected, signingHash)
+		a.mu.Unlock()
+	}()
+	a.addSignature(signingHash, addr, sig)
+
+	select {
+	case <-collected.attested: // the threshold is met by the signer of this node alone
+	default:
+		msg := make([]byte, 0, 2+65+len(payloadData))
+		msg = append(msg, attestationProposalType, byte(blockVersion))
+		msg = append(msg, sig[:]...)
+		msg = append(msg, payloadData...)
+		if err := a.publish(ctx, msg); err != nil {
+			return nil, fmt.Errorf("failed to propose execution payload: %w", err)
+		}
+		select {
+		case <-collected.attested:
+		case <-ctx.Done():
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if uint64(len(collected.order)) < a.attestation.Threshold {
+		return nil, fmt.Errorf("insufficient attestations, got %d of %d: %w", len(collected.order), a.attestation.Threshold, ctx.Err())
+	}
+	sigs := make([][65]byte, len(collected.order))
+	for i, signer := range collected.order {
+		sigs[i] = collected.signatures[signer]
+	}
+	return encodeAttestations(sigs), nil
+}
+
+// addSignature adds the signature to the attestations of the payload, if the payload is proposed by this node.
+func (a *attester) addSignature(signingHash common.Hash, signer common.Address, sig *[65]byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	collected, ok := a.collected[signingHash]
+	if !ok {
+		return
+	}
+	if _, ok := collected.signatures[signer]; ok {
+		return
+	}
+	collected.signatures[signer] = *sig
+	collected.order = append(collected.order, signer)
+	if uint64(len(collected.order)) == a.attestation.Threshold {
+		close(collected.attested)
+	}
+}
+
+// Validate validates the messages of the attestation topic.
+func (a *attester) Validate(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+	// [REJECT] if the compression is not valid
+	outLen, err := snappy.DecodedLen(message.Data)
+	if err != nil {
+		a.log.Warn("invalid snappy compression length data", "err", err, "peer", id)
+		return pubsub.ValidationReject
+	}
+	if outLen > gossipMaxSize(a.runCfg)+2+65 {
+		a.log.Warn("possible snappy zip bomb, decoded length is too large", "decoded_length", outLen, "peer", id)
+		return pubsub.ValidationReject
+	}
+	data, err := snappy.Decode(nil, message.Data)
+	if err != nil {
+		a.log.Warn("invalid snappy compression", "err", err, "peer", id)
+		return pubsub.ValidationReject
+	}
+	if len(data) == 0 {
+		a.log.Warn("empty attestation message", "peer", id)
+		return pubsub.ValidationReject
+	}
+
+	switch data[0] {
+	case attestationProposalType:
+		if len(data) < 2+65 {
+			a.log.Warn("attestation proposal is too short", "peer", id, "length", len(data))
+			return pubsub.ValidationReject
+		}
+		blockVersion := eth.BlockVersion(data[1])
+		if blockVersion != eth.BlockV1 && blockVersion != eth.BlockV2 && blockVersion != eth.BlockV3 {
+			a.log.Warn("unknown block version of attestation proposal", "peer", id, "version", blockVersion)
+			return pubsub.ValidationReject
+		}
+		sig, payloadBytes := data[2:2+65], data[2+65:]
+		signingHash, err := BlockSigningHash(a.cfg, payloadBytes)
+		if err != nil {
+			a.log.Warn("failed to compute block signing hash", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		// [REJECT] if the proposal is not signed by a signer of the signer set
+		if _, err := recoverAttester(a.attestation, signingHash, sig); err != nil {
+			a.log.Warn("invalid attestation proposal", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		envelope, result := validatePayload(a.log, id, blockVersion, payloadBytes)
+		if result != pubsub.ValidationAccept {
+			return result
+		}
+		message.ValidatorData = &attestationProposal{signingHash: signingHash, payloadBytes: payloadBytes, envelope: envelope}
+		return pubsub.ValidationAccept
+	case attestationSignatureType:
+		if len(data) != 1+32+65 {
+			a.log.Warn("invalid attestation length", "peer", id, "length", len(data))
+			return pubsub.ValidationReject
+		}
+		signingHash := common.BytesToHash(data[1:33])
+		// [REJECT] if the attestation is not signed by a signer of the signer set
+		addr, err := recoverAttester(a.attestation, signingHash, data[33:])
+		if err != nil {
+			a.log.Warn("invalid attestation", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		message.ValidatorData = &attestationSignature{signingHash: signingHash, signer: addr, signature: [65]byte(data[33:])}
+		return pubsub.ValidationAccept
+	default:
+		a.log.Warn("unknown attestation message type", "peer", id, "type", data[0])
+		return pubsub.ValidationReject
+	}
+}
+
+// Handle handles the validated messages of the attestation topic:
+// it attests proposals with the signer of this node, and collects the signatures of its own proposals.
+func (a *attester) Handle(ctx context.Context, from peer.ID, msg any) error {
+	switch msg := msg.(type) {
+	case *attestationProposal:
+		return a.attestProposal(ctx, msg)
+	case *attestationSignature:
+		a.addSignature(msg.signingHash, msg.signer, &msg.signature)
+		return nil
+	default:
+		return fmt.Errorf("unexpected attestation message %T", msg)
+	}
+}
+
+// attestProposal signs the proposed payload and gossips the signature, if this node attests payloads.
+// Only one payload is attested per block height.
+func (a *attester) attestProposal(ctx context.Context, proposal *attestationProposal) error {
+	if a.signer == nil {
+		return nil
+	}
+	a.mu.Lock()
+	_, proposed := a.collected[proposal.signingHash]
+	a.mu.Unlock()
+	if proposed {
+		return nil // already signed when proposed by this node
+	}
+	payload := proposal.envelope.ExecutionPayload
+	height := uint64(payload.BlockNumber)
+	// The same payload is attested again, in case the proposer retries.
+	if prev, ok, _ := a.attested.PeekOrAdd(height, proposal.signingHash); ok && prev != proposal.signingHash {
+		a.log.Warn("not attesting conflicting payload", "id", payload.ID(), "attested", prev)
+		return nil
+	}
+	sig, err := a.signer.Sign(ctx, SigningDomainBlocksV1, a.cfg.L2ChainID, proposal.payloadBytes)
+	if err != nil {
+		return fmt.Errorf("failed to attest payload %s: %w", payload.ID(), err)
+	}
+	addr, err := recoverAttester(a.attestation, proposal.signingHash, sig[:])
+	if err != nil {
+		a.log.Warn("not attesting payload, signer is not in the attestation signer set", "id", payload.ID(), "err", err)
+		return nil
+	}
+	msg := make([]byte, 0, 1+32+65)
+	msg = append(msg, attestationSignatureType)
+	msg = append(msg, proposal.signingHash[:]...)
+	msg = append(msg, sig[:]...)
+	if err := a.publish(ctx, msg); err != nil {
+		return fmt.Errorf("failed to publish attestation of payload %s: %w", payload.ID(), err)
+	}
+	a.log.Debug("Attested payload", "id", payload.ID(), "signer", addr)
+	return nil
+}
+
+// recoverAttester returns the address that signed the signing hash, if it is in the signer set.
+func recoverAttester(attestation *AttestationConfig, signingHash common.Hash, sig []byte) (common.Address, error) {
+	pub, err := crypto.SigToPub(signingHash[:], sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	addr := crypto.PubkeyToAddress(*pub)
+	if !attestation.isSigner(addr) {
+		return common.Address{}, fmt.Errorf("signer %s is not in the attestation signer set", addr)
+	}
+	return addr, nil
+}
+
+// encodeAttestations encodes the signatures to prefix the attested payload with.
+func encodeAttestations(sigs [][65]byte) []byte {
+	sigs = sigs[:min(len(sigs), maxAttestations)]
+	header := make([]byte, 1, 1+len(sigs)*65)
+	header[0] = byte(len(sigs))
+	for _, sig := range sigs {
+		header = append(header, sig[:]...)
+	}
+	return header
+}
+
+// splitAttestations splits an attested message into its signatures and payload.
+func splitAttestations(data []byte) (signatures [][]byte, payloadBytes []byte, err error) {
+	if len(data) < 1 {
+		return nil, nil, errors.New("missing attestation count")
+	}
+	count := int(data[0])
+	if count == 0 {
+		return nil, nil, errors.New("no attestations")
+	}
+	end := 1 + count*65
+	if len(data) <= end {
+		return nil, nil, fmt.Errorf("message too short for %d attestations", count)
+	}
+	for i := 1; i < end; i += 65 {
+		signatures = append(signatures, data[i:i+65])
+	}
+	return signatures, data[end:], nil
+}
+
+func verifyBlockAttestations(log log.Logger, cfg *rollup.Config, attestation *AttestationConfig, id peer.ID, signatures [][]byte, payloadBytes []byte) pubsub.ValidationResult {
+	if len(signatures) > len(attestation.Signers) {
+		log.Warn("more attestations than signers", "peer", id, "attestations", len(signatures), "signers", len(attestation.Signers))
+		return pubsub.ValidationReject
+	}
+	signingHash, err := BlockSigningHash(cfg, payloadBytes)
+	if err != nil {
+		log.Warn("failed to compute block signing hash", "err", err, "peer", id)
+		return pubsub.ValidationReject
+	}
+	attesters := make(map[common.Address]struct{}, len(signatures))
+	for _, sig := range signatures {
+		pub, err := crypto.SigToPub(signingHash[:], sig)
+		if err != nil {
+			log.Warn("invalid block attestation", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		addr := crypto.PubkeyToAddress(*pub)
+		if !attestation.isSigner(addr) {
+			log.Warn("unexpected block attester", "peer", id, "addr", addr)
+			return pubsub.ValidationReject
+		}
+		if _, ok := attesters[addr]; ok {
+			log.Warn("duplicate block attestation", "peer", id, "addr", addr)
+			return pubsub.ValidationReject
+		}
+		attesters[addr] = struct{}{}
+	}
+	if uint64(len(attesters)) < attestation.Threshold {
+		log.Warn("insufficient block attestations", "peer", id, "attestations", len(attesters), "threshold", attestation.Threshold)
+		return pubsub.ValidationReject
+	}
+	return pubsub.ValidationAccept
+}
@@ -0,0 +1,215 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestAttestationConfigCheck(t *testing.T) {
+	a, b := common.Address{0xaa}, common.Address{0xbb}
+	require.NoError(t, (&AttestationConfig{Signers: []common.Address{a, b}, Threshold: 2}).Check())
+	require.ErrorContains(t, (&AttestationConfig{}).Check(), "empty")
+	require.ErrorContains(t, (&AttestationConfig{Signers: []common.Address{a, b}, Threshold: 0}).Check(), "threshold")
+	require.ErrorContains(t, (&AttestationConfig{Signers: []common.Address{a, b}, Threshold: 3}).Check(), "threshold")
+	require.ErrorContains(t, (&AttestationConfig{Signers: []common.Address{a, a}, Threshold: 1}).Check(), "duplicate")
+}
+
+func TestSplitAttestations(t *testing.T) {
+	_, _, err := splitAttestations(nil)
+	require.Error(t, err)
+	_, _, err = splitAttestations([]byte{0, 1, 2})
+	require.ErrorContains(t, err, "no attestations")
+	_, _, err = splitAttestations(append([]byte{2}, make([]byte, 130)...))
+	require.ErrorContains(t, err, "too short")
+
+	data := append([]byte{2}, bytes.Repeat([]byte{1}, 65)...)
+	data = append(data, bytes.Repeat([]byte{2}, 65)...)
+	data = append(data, 0xff)
+	sigs, payload, err := splitAttestations(data)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{bytes.Repeat([]byte{1}, 65), bytes.Repeat([]byte{2}, 65)}, sigs)
+	require.Equal(t, []byte{0xff}, payload)
+}
+
+func TestVerifyBlockAttestations(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelCrit)
+	cfg := &rollup.Config{L2ChainID: big.NewInt(100)}
+	peerId := peer.ID("foo")
+	msg := []byte("any msg")
+	keys := generateKeys(t, 4)
+	attestation := &AttestationConfig{
+		Signers:   []common.Address{addr(keys[0]), addr(keys[1]), addr(keys[2])},
+		Threshold: 2,
+	}
+	sign := func(keys ...*ecdsa.PrivateKey) [][]byte {
+		var sigs [][]byte
+		for _, key := range keys {
+			sig, err := NewLocalSigner(key).Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
+			require.NoError(t, err)
+			sigs = append(sigs, sig[:])
+		}
+		return sigs
+	}
+	verify := func(sigs [][]byte) pubsub.ValidationResult {
+		return verifyBlockAttestations(logger, cfg, attestation, peerId, sigs, msg)
+	}
+
+	require.Equal(t, pubsub.ValidationAccept, verify(sign(keys[0], keys[1])))
+	require.Equal(t, pubsub.ValidationAccept, verify(sign(keys[2], keys[0], keys[1])))
+	require.Equal(t, pubsub.ValidationReject, verify(sign(keys[0])), "below threshold")
+	require.Equal(t, pubsub.ValidationReject, verify(sign(keys[0], keys[0])), "duplicate signer")
+	require.Equal(t, pubsub.ValidationReject, verify(sign(keys[0], keys[3])), "unknown signer")
+	require.Equal(t, pubsub.ValidationReject, verify(sign(keys[0], keys[1], keys[2], keys[0])), "more attestations than signers")
+	require.Equal(t, pubsub.ValidationReject, verify([][]byte{sign(keys[0])[0], make([]byte, 65)}), "invalid signature")
+}
+
+// attestationNetwork delivers the messages published on the attestation topic to all attesters, as gossip does.
+type attestationNetwork struct {
+	attesters []*attester
+}
+
+func (n *attestationNetwork) add(t *testing.T, cfg *rollup.Config, attestation *AttestationConfig, signer Signer) *attester {
+	a := newAttester(testlog.Logger(t, log.LevelCrit), cfg, &testutils.MockRuntimeConfig{}, attestation, signer, n.publish)
+	n.attesters = append(n.attesters, a)
+	return a
+}
+
+func (n *attestationNetwork) publish(ctx context.Context, data []byte) error {
+	compressed := snappy.Encode(nil, data)
+	for _, a := range n.attesters {
+		msg := &pubsub.Message{Message: &pubsub_pb.Message{Data: compressed}}
+		if res := a.Validate(ctx, "foo", msg); res != pubsub.ValidationAccept {
+			return fmt.Errorf("attestation message not accepted: %v", validationResultString(res))
+		}
+		if err := a.Handle(ctx, "foo", msg.ValidatorData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAttester(t *testing.T) {
+	cfg := &rollup.Config{L2ChainID: big.NewInt(100)}
+	keys := generateKeys(t, 4)
+	attestation := &AttestationConfig{
+		Signers:   []common.Address{addr(keys[0]), addr(keys[1]), addr(keys[2])},
+		Threshold: 2,
+	}
+	// The p2p sequencer address is not used when blocks are attested
+	validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, &testutils.MockRuntimeConfig{}, attestation, eth.BlockV2)
+
+	encodePayload := func(t *testing.T, number uint64, extraData byte) []byte {
+		payload := createExecutionPayload(types.Withdrawals{}, nil, nil)
+		payload.BlockNumber = hexutil.Uint64(number)
+		payload.ExtraData = []byte{extraData}
+		e := &eth.ExecutionPayloadEnvelope{ExecutionPayload: payload}
+		payload.BlockHash, _ = e.CheckBlockHash()
+		var buf bytes.Buffer
+		_, err := payload.MarshalSSZ(&buf)
+		require.NoError(t, err)
+		return buf.Bytes()
+	}
+	attest := func(t *testing.T, a *attester, key *ecdsa.PrivateKey, payloadBytes []byte) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		return a.attest(ctx, NewLocalSigner(key), eth.BlockV2, payloadBytes)
+	}
+	validate := func(header []byte, payloadBytes []byte) pubsub.ValidationResult {
+		data := snappy.Encode(nil, append(header, payloadBytes...))
+		return validator(context.Background(), "foo", &pubsub.Message{Message: &pubsub_pb.Message{Data: data}})
+	}
+
+	t.Run("Attested", func(t *testing.T) {
+		var network attestationNetwork
+		proposer := network.add(t, cfg, attestation, nil)
+		network.add(t, cfg, attestation, NewLocalSigner(keys[1]))
+		network.add(t, cfg, attestation, nil)
+		payloadBytes := encodePayload(t, 1, 0)
+		header, err := attest(t, proposer, keys[0], payloadBytes)
+		require.NoError(t, err)
+		require.Equal(t, byte(2), header[0])
+		require.Equal(t, pubsub.ValidationAccept, validate(header, payloadBytes))
+	})
+
+	t.Run("InsufficientAttestations", func(t *testing.T) {
+		var network attestationNetwork
+		proposer := network.add(t, cfg, attestation, nil)
+		// signers outside the signer set do not attest
+		network.add(t, cfg, attestation, NewLocalSigner(keys[3]))
+		_, err := attest(t, proposer, keys[0], encodePayload(t, 1, 0))
+		require.ErrorContains(t, err, "insufficient attestations")
+	})
+
+	t.Run("ConflictingPayload", func(t *testing.T) {
+		var network attestationNetwork
+		proposer := network.add(t, cfg, attestation, nil)
+		network.add(t, cfg, attestation, NewLocalSigner(keys[1]))
+		_, err := attest(t, proposer, keys[0], encodePayload(t, 1, 0))
+		require.NoError(t, err)
+		// the same payload is attested again, but not a different payload at the same height
+		_, err = attest(t, proposer, keys[2], encodePayload(t, 1, 0))
+		require.NoError(t, err)
+		_, err = attest(t, proposer, keys[2], encodePayload(t, 1, 1))
+		require.ErrorContains(t, err, "insufficient attestations")
+		_, err = attest(t, proposer, keys[2], encodePayload(t, 2, 1))
+		require.NoError(t, err)
+	})
+
+	t.Run("ProposerNotInSignerSet", func(t *testing.T) {
+		var network attestationNetwork
+		proposer := network.add(t, cfg, attestation, nil)
+		network.add(t, cfg, attestation, NewLocalSigner(keys[1]))
+		_, err := attest(t, proposer, keys[3], encodePayload(t, 1, 0))
+		require.ErrorContains(t, err, "not in the attestation signer set")
+	})
+
+	t.Run("InvalidProposal", func(t *testing.T) {
+		var network attestationNetwork
+		proposer := network.add(t, cfg, attestation, nil)
+		network.add(t, cfg, attestation, NewLocalSigner(keys[1]))
+		// not a valid V2 payload, without withdrawals
+		payload := createExecutionPayload(types.Withdrawals{}, nil, nil)
+		payload.Withdrawals = nil
+		var buf bytes.Buffer
+		_, err := payload.MarshalSSZ(&buf)
+		require.NoError(t, err)
+		_, err = attest(t, proposer, keys[0], buf.Bytes())
+		require.ErrorContains(t, err, "not accepted")
+	})
+}
+
+func generateKeys(t *testing.T, n int) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	return keys
+}
+
+func addr(key *ecdsa.PrivateKey) common.Address {
+	return crypto.PubkeyToAddress(key.PublicKey)
+}
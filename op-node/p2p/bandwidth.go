@@ -0,0 +1,211 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	p2pmetrics "github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+const (
+	// DefaultGossipMessageSizeEstimate is the default assumed size of a block gossip message,
+	// used to size the gossip mesh for a bandwidth limit.
+	DefaultGossipMessageSizeEstimate = 128 * 1024
+	// minBandwidthMeshD is the lowest mesh degree a bandwidth limit may reduce the mesh to,
+	// to not compromise the propagation of blocks.
+	minBandwidthMeshD = 4
+	// gossipBandwidthReportInterval is the interval at which the gossip bandwidth totals are reported to the metrics.
+	gossipBandwidthReportInterval = 10 * time.Second
+)
+
+// MeshParamsForBandwidth reduces the mesh degree of the gossip params, such that forwarding a block gossip message
+// of msgSize bytes every block to the mesh peers fits in the given outbound bandwidth limit, in bytes per second.
+// A limit of 0 disables the adjustment.
+//
+// The mesh is sized once, when setting up gossip, as GossipSub does not support changing the mesh degree at runtime.
+// It does not adapt to the actual size of the messages: msgSize should be set to the average block gossip message size
+// of the chain.
+func MeshParamsForBandwidth(params pubsub.GossipSubParams, limit uint64, msgSize uint64, blockTime uint64) pubsub.GossipSubParams {
+	if limit == 0 || msgSize == 0 || params.D <= minBandwidthMeshD {
+		return params
+	}
+	budgetD := limit * blockTime / msgSize
+	if budgetD >= uint64(params.D) {
+		return params
+	}
+	d := max(int(budgetD), minBandwidthMeshD)
+	// keep the watermarks at the same ratio to the target degree
+	params.Dlo = max(d*params.Dlo/params.D, 1)
+	params.Dhi = max(d*params.Dhi/params.D, d)
+	params.Dout = min(params.Dout, d/2, params.Dlo-1)
+	params.Dscore = min(params.Dscore, params.Dhi)
+	params.D = d
+	return params
+}
+
+type BandwidthMetricer interface {
+	RecordGossipBandwidth(topic string, direction string, total uint64)
+}
+
+// TopicBandwidth is the total size of the gossip messages of a topic exchanged with a peer.
+type TopicBandwidth struct {
+	In  uint64 `json:"in"`
+	Out uint64 `json:"out"`
+}
+
+// PeerBandwidth is the bandwidth used with a peer, over all protocols,
+// and the part of it used by gossip messages, per topic.
+type PeerBandwidth struct {
+	TotalIn  int64                     `json:"totalIn"`
+	TotalOut int64                     `json:"totalOut"`
+	RateIn   float64                   `json:"rateIn"`  // bytes per second
+	RateOut  float64                   `json:"rateOut"` // bytes per second
+	Topics   map[string]TopicBandwidth `json:"topics"`
+}
+
+// topicCounters counts the size of the gossip messages of a topic.
+type topicCounters struct {
+	in  atomic.Uint64
+	out atomic.Uint64
+}
+
+// GossipBandwidthTracker accounts the size of gossip messages per peer and topic.
+// Control messages are not accounted, only the published messages.
+// Messages are accounted without locking, and the totals per topic are reported to the metrics periodically.
+type GossipBandwidthTracker struct {
+	self peer.ID
+	m    BandwidthMetricer
+
+	// peers maps a peer.ID to a *sync.Map of the topic to its *topicCounters
+	peers sync.Map
+	// totals maps a topic to its *topicCounters, over all peers
+	totals sync.Map
+}
+
+var _ pubsub.RawTracer = (*GossipBandwidthTracker)(nil)
+
+func NewGossipBandwidthTracker(self peer.ID, m BandwidthMetricer) *GossipBandwidthTracker {
+	return &GossipBandwidthTracker{
+		self: self,
+		m:    m,
+	}
+}
+
+func loadOrCreate[V any](m *sync.Map, key any) *V {
+	if v, ok := m.Load(key); ok {
+		return v.(*V)
+	}
+	v, _ := m.LoadOrStore(key, new(V))
+	return v.(*V)
+}
+
+// Topics returns the gossip bandwidth per topic of the given peer.
+func (t *GossipBandwidthTracker) Topics(id peer.ID) map[string]TopicBandwidth {
+	out := make(map[string]TopicBandwidth)
+	if topics, ok := t.peers.Load(id); ok {
+		topics.(*sync.Map).Range(func(topic, c any) bool {
+			counters := c.(*topicCounters)
+			out[topic.(string)] = TopicBandwidth{In: counters.in.Load(), Out: counters.out.Load()}
+			return true
+		})
+	}
+	return out
+}
+
+func (t *GossipBandwidthTracker) record(id peer.ID, topic string, size int, inbound bool) {
+	peerCounters := loadOrCreate[topicCounters](loadOrCreate[sync.Map](&t.peers, id), topic)
+	totalCounters := loadOrCreate[topicCounters](&t.totals, topic)
+	if inbound {
+		peerCounters.in.Add(uint64(size))
+		totalCounters.in.Add(uint64(size))
+	} else {
+		peerCounters.out.Add(uint64(size))
+		totalCounters.out.Add(uint64(size))
+	}
+}
+
+// ReportMetrics reports the gossip bandwidth totals per topic to the metrics periodically, until the context is done.
+func (t *GossipBandwidthTracker) ReportMetrics(ctx context.Context) {
+	if t.m == nil {
+		return
+	}
+	tick := time.NewTicker(gossipBandwidthReportInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			t.reportMetrics()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *GossipBandwidthTracker) reportMetrics() {
+	t.totals.Range(func(topic, c any) bool {
+		counters := c.(*topicCounters)
+		t.m.RecordGossipBandwidth(topic.(string), "in", counters.in.Load())
+		t.m.RecordGossipBandwidth(topic.(string), "out", counters.out.Load())
+		return true
+	})
+}
+
+func (t *GossipBandwidthTracker) recordInbound(msg *pubsub.Message) {
+	if msg.ReceivedFrom == t.self {
+		return
+	}
+	t.record(msg.ReceivedFrom, msg.GetTopic(), msg.Size(), true)
+}
+
+// ValidateMessage is called on every new message received from a peer.
+func (t *GossipBandwidthTracker) ValidateMessage(msg *pubsub.Message) {
+	t.recordInbound(msg)
+}
+
+// DuplicateMessage is called on every message received again, which still used bandwidth.
+func (t *GossipBandwidthTracker) DuplicateMessage(msg *pubsub.Message) {
+	t.recordInbound(msg)
+}
+
+func (t *GossipBandwidthTracker) SendRPC(rpc *pubsub.RPC, p peer.ID) {
+	for _, msg := range rpc.GetPublish() {
+		t.record(p, msg.GetTopic(), msg.Size(), false)
+	}
+}
+
+func (t *GossipBandwidthTracker) RemovePeer(p peer.ID) {
+	t.peers.Delete(p)
+}
+
+func (t *GossipBandwidthTracker) AddPeer(p peer.ID, proto protocol.ID)             {}
+func (t *GossipBandwidthTracker) Join(topic string)                                {}
+func (t *GossipBandwidthTracker) Leave(topic string)                               {}
+func (t *GossipBandwidthTracker) Graft(p peer.ID, topic string)                    {}
+func (t *GossipBandwidthTracker) Prune(p peer.ID, topic string)                    {}
+func (t *GossipBandwidthTracker) DeliverMessage(msg *pubsub.Message)               {}
+func (t *GossipBandwidthTracker) RejectMessage(msg *pubsub.Message, reason string) {}
+func (t *GossipBandwidthTracker) ThrottlePeer(p peer.ID)                           {}
+func (t *GossipBandwidthTracker) RecvRPC(rpc *pubsub.RPC)                          {}
+func (t *GossipBandwidthTracker) DropRPC(rpc *pubsub.RPC, p peer.ID)               {}
+func (t *GossipBandwidthTracker) UndeliverableMessage(msg *pubsub.Message)         {}
+
+// peerBandwidth combines the bandwidth of all protocols with the gossip bandwidth of the connected peers.
+func peerBandwidth(peers []peer.ID, bwc *p2pmetrics.BandwidthCounter, gossip *GossipBandwidthTracker) map[peer.ID]*PeerBandwidth {
+	out := make(map[peer.ID]*PeerBandwidth, len(peers))
+	for _, id := range peers {
+		stats := bwc.GetBandwidthForPeer(id)
+		out[id] = &PeerBandwidth{
+			TotalIn:  stats.TotalIn,
+			TotalOut: stats.TotalOut,
+			RateIn:   stats.RateIn,
+			RateOut:  stats.RateOut,
+			Topics:   gossip.Topics(id),
+		}
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+func TestMeshParamsForBandwidth(t *testing.T) {
+	params := BuildGlobalGossipParams(&rollup.Config{})
+
+	t.Run("Disabled", func(t *testing.T) {
+		require.Equal(t, params, MeshParamsForBandwidth(params, 0, DefaultGossipMessageSizeEstimate, 2))
+	})
+
+	t.Run("WithinBudget", func(t *testing.T) {
+		limit := uint64(DefaultMeshD * DefaultGossipMessageSizeEstimate)
+		require.Equal(t, params, MeshParamsForBandwidth(params, limit, DefaultGossipMessageSizeEstimate, 1))
+	})
+
+	t.Run("ReducedToBudget", func(t *testing.T) {
+		limit := uint64(6 * DefaultGossipMessageSizeEstimate)
+		adapted := MeshParamsForBandwidth(params, limit, DefaultGossipMessageSizeEstimate, 1)
+		require.Equal(t, 6, adapted.D)
+		require.Equal(t, 6*DefaultMeshDlo/DefaultMeshD, adapted.Dlo)
+		require.Equal(t, 6*DefaultMeshDhi/DefaultMeshD, adapted.Dhi)
+		require.Equal(t, DefaultMeshDlazy, adapted.Dlazy)
+		require.Less(t, adapted.Dout, adapted.Dlo)
+		require.LessOrEqual(t, adapted.Dout, adapted.D/2)
+	})
+
+	t.Run("MessageSize", func(t *testing.T) {
+		limit := uint64(6 * DefaultGossipMessageSizeEstimate)
+		adapted := MeshParamsForBandwidth(params, limit, DefaultGossipMessageSizeEstimate*6/5, 1)
+		require.Equal(t, 5, adapted.D)
+	})
+
+	t.Run("MinimumDegree", func(t *testing.T) {
+		adapted := MeshParamsForBandwidth(params, 1, DefaultGossipMessageSizeEstimate, 1)
+		require.Equal(t, minBandwidthMeshD, adapted.D)
+		require.LessOrEqual(t, adapted.Dlo, adapted.D)
+		require.GreaterOrEqual(t, adapted.Dhi, adapted.D)
+		require.Less(t, adapted.Dout, adapted.Dlo)
+		require.LessOrEqual(t, adapted.Dscore, adapted.Dhi)
+	})
+}
+
+type stubBandwidthMetrics struct {
+	totals map[string]uint64
+}
+
+func (s *stubBandwidthMetrics) RecordGossipBandwidth(topic string, direction string, total uint64) {
+	s.totals[topic+"/"+direction] = total
+}
+
+func TestGossipBandwidthTracker(t *testing.T) {
+	self := peer.ID("self")
+	alice := peer.ID("alice")
+	bob := peer.ID("bob")
+	topicA := "/optimism/10/1/blocks"
+	topicB := "/optimism/10/2/blocks"
+	msg := func(from peer.ID, topic string, size int) *pubsub.Message {
+		return &pubsub.Message{Message: &pubsub_pb.Message{Data: make([]byte, size), Topic: &topic}, ReceivedFrom: from}
+	}
+	m := &stubBandwidthMetrics{totals: make(map[string]uint64)}
+	tracker := NewGossipBandwidthTracker(self, m)
+
+	inA := msg(alice, topicA, 100)
+	tracker.ValidateMessage(inA)
+	tracker.DuplicateMessage(msg(alice, topicA, 50))
+	tracker.ValidateMessage(msg(bob, topicB, 70))
+	// messages published by ourselves are not inbound bandwidth
+	tracker.ValidateMessage(msg(self, topicA, 1000))
+
+	out := msg(self, topicB, 200)
+	tracker.SendRPC(&pubsub.RPC{RPC: pubsub_pb.RPC{Publish: []*pubsub_pb.Message{out.Message}}}, alice)
+
+	aliceTopics := tracker.Topics(alice)
+	require.Equal(t, uint64(inA.Size()+msg(alice, topicA, 50).Size()), aliceTopics[topicA].In)
+	require.Zero(t, aliceTopics[topicA].Out)
+	require.Equal(t, uint64(out.Size()), aliceTopics[topicB].Out)
+	require.Equal(t, uint64(msg(bob, topicB, 70).Size()), tracker.Topics(bob)[topicB].In)
+	require.Empty(t, tracker.Topics(self))
+
+	// metrics are only reported periodically
+	require.Empty(t, m.totals)
+	tracker.reportMetrics()
+	require.Equal(t, uint64(out.Size()), m.totals[topicB+"/out"])
+	require.Equal(t, uint64(msg(bob, topicB, 70).Size()), m.totals[topicB+"/in"])
+	require.Equal(t, uint64(inA.Size()+msg(alice, topicA, 50).Size()), m.totals[topicA+"/in"])
+
+	tracker.RemovePeer(alice)
+	require.Empty(t, tracker.Topics(alice))
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
 )
@@ -64,6 +65,10 @@ func NewConfig(ctx *cli.Context, rollupCfg *rollup.Config) (*p2p.Config, error)
 		return nil, fmt.Errorf("failed to load banning option: %w", err)
 	}
 
+	if err := loadAttestationOptions(conf, ctx); err != nil {
+		return nil, fmt.Errorf("failed to load block attestation options: %w", err)
+	}
+
 	conf.EnableReqRespSync = ctx.Bool(flags.SyncReqRespName)
 	conf.EnablePingService = ctx.Bool(flags.P2PPingName)
 	conf.SyncOnlyReqToStatic = ctx.Bool(flags.SyncOnlyReqToStaticName)
@@ -113,6 +118,25 @@ func loadBanningOptions(conf *p2p.Config, ctx *cli.Context) error {
 	return nil
 }
 
+// loadAttestationOptions loads the signer set attesting gossiped blocks from the CLI context.
+func loadAttestationOptions(conf *p2p.Config, ctx *cli.Context) error {
+	signers := ctx.StringSlice(flags.AttestationSignersName)
+	if len(signers) == 0 {
+		return nil
+	}
+	attestation := &p2p.AttestationConfig{
+		Threshold: ctx.Uint64(flags.AttestationThresholdName),
+	}
+	for _, signer := range signers {
+		if !common.IsHexAddress(signer) {
+			return fmt.Errorf("invalid attestation signer address: %q", signer)
+		}
+		attestation.Signers = append(attestation.Signers, common.HexToAddress(signer))
+	}
+	conf.Attestation = attestation
+	return nil
+}
+
 func loadListenOpts(conf *p2p.Config, ctx *cli.Context) error {
 	listenIP := ctx.String(flags.ListenIPName)
 	if listenIP != "" { // optional
@@ -340,5 +364,7 @@ func loadGossipOptions(conf *p2p.Config, ctx *cli.Context) error {
 	conf.MeshDHi = ctx.Int(flags.GossipMeshDhiName)
 	conf.MeshDLazy = ctx.Int(flags.GossipMeshDlazyName)
 	conf.FloodPublish = ctx.Bool(flags.GossipFloodPublishName)
+	conf.GossipBandwidthLimit = ctx.Uint64(flags.GossipBandwidthLimitName)
+	conf.GossipBandwidthMsgSize = ctx.Uint64(flags.GossipMsgSizeName)
 	return nil
 }
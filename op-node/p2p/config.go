@@ -61,6 +61,8 @@ type SetupP2P interface {
 	BanDuration() time.Duration
 	GossipSetupConfigurables
 	ReqRespSyncEnabled() bool
+	// BlockAttestation returns the signer set attesting gossiped blocks, or nil if blocks are signed by the sequencer only.
+	BlockAttestation() *AttestationConfig
 }
 
 // ScoringParams defines the various types of peer scoring parameters.
@@ -113,6 +115,12 @@ type Config struct {
 	MeshDHi   int // topic stable mesh high watermark
 	MeshDLazy int // gossip target
 
+	// GossipBandwidthLimit is the outbound bandwidth budget of block gossip, in bytes per second.
+	// If set, the mesh degree is reduced to fit the budget. 0 to disable.
+	GossipBandwidthLimit uint64
+	// GossipBandwidthMsgSize is the assumed size of a block gossip message, to size the mesh for the bandwidth limit.
+	GossipBandwidthMsgSize uint64
+
 	// FloodPublish publishes messages from ourselves to peers outside of the gossip topic mesh but supporting the same topic.
 	FloodPublish bool
 
@@ -132,6 +140,9 @@ type Config struct {
 	SyncOnlyReqToStatic bool
 
 	EnablePingService bool
+
+	// Attestation configures gossiped blocks to be attested by a threshold of a signer set. Nil if disabled.
+	Attestation *AttestationConfig
 }
 
 func DefaultConnManager(conf *Config) (connmgr.ConnManager, error) {
@@ -174,6 +185,10 @@ func (conf *Config) ReqRespSyncEnabled() bool {
 	return conf.EnableReqRespSync
 }
 
+func (conf *Config) BlockAttestation() *AttestationConfig {
+	return conf.Attestation
+}
+
 const maxMeshParam = 1000
 
 func (conf *Config) Check() error {
@@ -206,5 +221,10 @@ func (conf *Config) Check() error {
 	if conf.MeshDLazy <= 0 || conf.MeshDLazy > maxMeshParam {
 		return fmt.Errorf("mesh Dlazy param must not be 0 or exceed %d, but got %d", maxMeshParam, conf.MeshDLazy)
 	}
+	if conf.Attestation != nil {
+		if err := conf.Attestation.Check(); err != nil {
+			return fmt.Errorf("invalid block attestation config: %w", err)
+		}
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -43,6 +44,9 @@ const (
 	DefaultMeshDlazy = 6  // gossip target
 	// peerScoreInspectFrequency is the frequency at which peer scores are inspected
 	peerScoreInspectFrequency = 15 * time.Second
+	// defaultMaxBlocksPerHeight limits the number of different blocks that are accepted at the same height,
+	// if the runtime config does not specify a limit.
+	defaultMaxBlocksPerHeight = 5
 )
 
 // Message domains, the msg id function uncompresses to keep data monomorphic,
@@ -59,6 +63,28 @@ type GossipSetupConfigurables interface {
 
 type GossipRuntimeConfig interface {
 	P2PSequencerAddress() common.Address
+	// GossipMaxSize is the maximum decoded size of gossiped blocks.
+	// Zero, or a value above the transport limit, applies the transport limit.
+	GossipMaxSize() uint64
+	// GossipMaxBlocksPerHeight is the maximum number of different blocks accepted at the same height.
+	// Zero applies the default limit.
+	GossipMaxBlocksPerHeight() uint64
+}
+
+// gossipMaxSize returns the decoded size limit of gossiped blocks, which can only be lowered by the runtime config.
+func gossipMaxSize(runCfg GossipRuntimeConfig) int {
+	if limit := runCfg.GossipMaxSize(); limit != 0 && limit < maxGossipSize {
+		return int(limit)
+	}
+	return maxGossipSize
+}
+
+// gossipMaxBlocksPerHeight returns the number of different blocks accepted at the same height.
+func gossipMaxBlocksPerHeight(runCfg GossipRuntimeConfig) int {
+	if limit := runCfg.GossipMaxBlocksPerHeight(); limit != 0 && limit <= math.MaxInt32 {
+		return int(limit)
+	}
+	return defaultMaxBlocksPerHeight
 }
 
 //go:generate mockery --name GossipMetricer
@@ -81,7 +107,7 @@ func blocksTopicV3(cfg *rollup.Config) string {
 // BuildSubscriptionFilter builds a simple subscription filter,
 // to help protect against peers spamming useless subscriptions.
 func BuildSubscriptionFilter(cfg *rollup.Config) pubsub.SubscriptionFilter {
-	return pubsub.NewAllowlistSubscriptionFilter(blocksTopicV1(cfg), blocksTopicV2(cfg), blocksTopicV3(cfg)) // add more topics here in the future, if any.
+	return pubsub.NewAllowlistSubscriptionFilter(blocksTopicV1(cfg), blocksTopicV2(cfg), blocksTopicV3(cfg), attestationsTopic(cfg)) // add more topics here in the future, if any.
 }
 
 var msgBufPool = sync.Pool{New: func() any {
@@ -140,6 +166,7 @@ func (p *Config) ConfigureGossip(rollupCfg *rollup.Config) []pubsub.Option {
 	params.Dlo = p.MeshDLo
 	params.Dhi = p.MeshDHi
 	params.Dlazy = p.MeshDLazy
+	params = MeshParamsForBandwidth(params, p.GossipBandwidthLimit, p.GossipBandwidthMsgSize, rollupCfg.BlockTime)
 
 	// in the future we may add more advanced options like scoring and PX / direct-mesh / episub
 	return []pubsub.Option{
@@ -164,7 +191,8 @@ func BuildGlobalGossipParams(cfg *rollup.Config) pubsub.GossipSubParams {
 
 // NewGossipSub configures a new pubsub instance with the specified parameters.
 // PubSub uses a GossipSubRouter as it's router under the hood.
-func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossipConf GossipSetupConfigurables, scorer Scorer, m GossipMetricer, log log.Logger) (*pubsub.PubSub, error) {
+// If bw is not nil, it accounts the bandwidth of gossip messages.
+func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossipConf GossipSetupConfigurables, scorer Scorer, m GossipMetricer, bw *GossipBandwidthTracker, log log.Logger) (*pubsub.PubSub, error) {
 	denyList, err := pubsub.NewTimeCachedBlacklist(30 * time.Second)
 	if err != nil {
 		return nil, err
@@ -183,6 +211,9 @@ func NewGossipSub(p2pCtx context.Context, h host.Host, cfg *rollup.Config, gossi
 		pubsub.WithBlacklist(denyList),
 		pubsub.WithEventTracer(&gossipTracer{m: m}),
 	}
+	if bw != nil {
+		gossipOpts = append(gossipOpts, pubsub.WithRawTracer(bw))
+	}
 	gossipOpts = append(gossipOpts, ConfigurePeerScoring(gossipConf, scorer, log)...)
 	gossipOpts = append(gossipOpts, gossipConf.ConfigureGossip(cfg)...)
 	return pubsub.NewGossipSub(p2pCtx, h, gossipOpts...)
@@ -250,7 +281,9 @@ func (sb *seenBlocks) markSeen(h common.Hash) {
 	sb.blockHashes = append(sb.blockHashes, h)
 }
 
-func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
+// BuildBlocksValidator builds the validator of gossiped blocks.
+// If attestation is not nil, blocks must be attested by a threshold of its signers instead of signed by the p2p sequencer address.
+func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, attestation *AttestationConfig, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
 
 	// Seen block hashes per block height
 	// uint64 -> *seenBlocks
@@ -266,7 +299,7 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 			log.Warn("invalid snappy compression length data", "err", err, "peer", id)
 			return pubsub.ValidationReject
 		}
-		if outLen > maxGossipSize {
+		if outLen > gossipMaxSize(runCfg) {
 			log.Warn("possible snappy zip bomb, decoded length is too large", "decoded_length", outLen, "peer", id)
 			return pubsub.ValidationReject
 		}
@@ -287,104 +320,34 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 			*res = data[:cap(data)]
 		}
 
-		// message starts with compact-encoding secp256k1 encoded signature
-		signatureBytes, payloadBytes := data[:65], data[65:]
-
-		// [REJECT] if the signature by the sequencer is not valid
-		result := verifyBlockSignature(log, cfg, runCfg, id, signatureBytes, payloadBytes)
-		if result != pubsub.ValidationAccept {
-			return result
-		}
-
-		var envelope eth.ExecutionPayloadEnvelope
-
-		// [REJECT] if the block encoding is not valid
-		if blockVersion == eth.BlockV3 {
-			if err := envelope.UnmarshalSSZ(uint32(len(payloadBytes)), bytes.NewReader(payloadBytes)); err != nil {
-				log.Warn("invalid envelope payload", "err", err, "peer", id)
+		var payloadBytes []byte
+		var result pubsub.ValidationResult
+		if attestation != nil {
+			// message starts with the attestations by the signer set
+			var signatures [][]byte
+			signatures, payloadBytes, err = splitAttestations(data)
+			if err != nil {
+				log.Warn("invalid block attestations", "err", err, "peer", id)
 				return pubsub.ValidationReject
 			}
+			// [REJECT] if the payload is not attested by a threshold of the signer set
+			result = verifyBlockAttestations(log, cfg, attestation, id, signatures, payloadBytes)
 		} else {
-			var payload eth.ExecutionPayload
-			if err := payload.UnmarshalSSZ(blockVersion, uint32(len(payloadBytes)), bytes.NewReader(payloadBytes)); err != nil {
-				log.Warn("invalid execution payload", "err", err, "peer", id)
-				return pubsub.ValidationReject
-			}
-			envelope = eth.ExecutionPayloadEnvelope{ExecutionPayload: &payload}
-		}
-
-		payload := envelope.ExecutionPayload
-
-		// rounding down to seconds is fine here.
-		now := uint64(time.Now().Unix())
-
-		// [REJECT] if the `payload.timestamp` is older than 60 seconds in the past
-		if uint64(payload.Timestamp) < now-60 {
-			log.Warn("payload is too old", "timestamp", uint64(payload.Timestamp))
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if the `payload.timestamp` is more than 5 seconds into the future
-		if uint64(payload.Timestamp) > now+5 {
-			log.Warn("payload is too new", "timestamp", uint64(payload.Timestamp))
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if the `block_hash` in the `payload` is not valid
-		if actual, ok := envelope.CheckBlockHash(); !ok {
-			log.Warn("payload has bad block hash", "bad_hash", payload.BlockHash.String(), "actual", actual.String())
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if a V1 Block has withdrawals
-		if !blockVersion.HasWithdrawals() && payload.Withdrawals != nil {
-			log.Warn("payload is on v1 topic, but has withdrawals", "bad_hash", payload.BlockHash.String())
-			return pubsub.ValidationReject
+			// message starts with compact-encoding secp256k1 encoded signature
+			var signatureBytes []byte
+			signatureBytes, payloadBytes = data[:65], data[65:]
+			// [REJECT] if the signature by the sequencer is not valid
+			result = verifyBlockSignature(log, cfg, runCfg, id, signatureBytes, payloadBytes)
 		}
-
-		// [REJECT] if a >= V2 Block does not have withdrawals
-		if blockVersion.HasWithdrawals() && payload.Withdrawals == nil {
-			log.Warn("payload is on v2/v3 topic, but does not have withdrawals", "bad_hash", payload.BlockHash.String())
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if a >= V2 Block has non-empty withdrawals
-		if blockVersion.HasWithdrawals() && len(*payload.Withdrawals) != 0 {
-			log.Warn("payload is on v2/v3 topic, but has non-empty withdrawals", "bad_hash", payload.BlockHash.String(), "withdrawal_count", len(*payload.Withdrawals))
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if the block is on a topic <= V2 and has a blob gas value set
-		if !blockVersion.HasBlobProperties() && payload.BlobGasUsed != nil {
-			log.Warn("payload is on v1/v2 topic, but has blob gas used", "bad_hash", payload.BlockHash.String())
-			return pubsub.ValidationReject
-		}
-
-		// [REJECT] if the block is on a topic <= V2 and has an excess blob gas value set
-		if !blockVersion.HasBlobProperties() && payload.ExcessBlobGas != nil {
-			log.Warn("payload is on v1/v2 topic, but has excess blob gas", "bad_hash", payload.BlockHash.String())
-			return pubsub.ValidationReject
-		}
-
-		if blockVersion.HasBlobProperties() {
-			// [REJECT] if the block is on a topic >= V3 and has a blob gas used value that is not zero
-			if payload.BlobGasUsed == nil || *payload.BlobGasUsed != 0 {
-				log.Warn("payload is on v3 topic, but has non-zero blob gas used", "bad_hash", payload.BlockHash.String(), "blob_gas_used", payload.BlobGasUsed)
-				return pubsub.ValidationReject
-			}
-
-			// [REJECT] if the block is on a topic >= V3 and has an excess blob gas value that is not zero
-			if payload.ExcessBlobGas == nil || *payload.ExcessBlobGas != 0 {
-				log.Warn("payload is on v3 topic, but has non-zero excess blob gas", "bad_hash", payload.BlockHash.String(), "excess_blob_gas", payload.ExcessBlobGas)
-				return pubsub.ValidationReject
-			}
+		if result != pubsub.ValidationAccept {
+			return result
 		}
 
-		// [REJECT] if the block is on a topic >= V3 and the parent beacon block root is nil
-		if blockVersion.HasParentBeaconBlockRoot() && envelope.ParentBeaconBlockRoot == nil {
-			log.Warn("payload is on v3 topic, but has nil parent beacon block root", "bad_hash", payload.BlockHash.String())
-			return pubsub.ValidationReject
+		envelope, result := validatePayload(log, id, blockVersion, payloadBytes)
+		if result != pubsub.ValidationAccept {
+			return result
 		}
+		payload := envelope.ExecutionPayload
 
 		seen, ok := blockHeightLRU.Get(uint64(payload.BlockNumber))
 		if !ok {
@@ -392,9 +355,9 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 			blockHeightLRU.Add(uint64(payload.BlockNumber), seen)
 		}
 
-		if count, hasSeen := seen.hasSeen(payload.BlockHash); count > 5 {
-			// [REJECT] if more than 5 blocks have been seen with the same block height
-			log.Warn("seen too many different blocks at same height", "height", payload.BlockNumber)
+		if count, hasSeen := seen.hasSeen(payload.BlockHash); count > gossipMaxBlocksPerHeight(runCfg) {
+			// [REJECT] if more than the limit of blocks have been seen with the same block height
+			log.Warn("seen too many different blocks at same height", "height", payload.BlockNumber, "count", count)
 			return pubsub.ValidationReject
 		} else if hasSeen {
 			// [IGNORE] if the block has already been seen
@@ -402,16 +365,111 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 			return pubsub.ValidationIgnore
 		}
 
-		// mark it as seen. (note: with concurrent validation more than 5 blocks may be marked as seen still,
+		// mark it as seen. (note: with concurrent validation more blocks than the limit may be marked as seen still,
 		// but validator concurrency is limited anyway)
 		seen.markSeen(payload.BlockHash)
 
 		// remember the decoded payload for later usage in topic subscriber.
-		message.ValidatorData = &envelope
+		message.ValidatorData = envelope
 		return pubsub.ValidationAccept
 	}
 }
 
+// validatePayload decodes the gossiped payload of the block version, and checks it is a valid block to gossip.
+func validatePayload(log log.Logger, id peer.ID, blockVersion eth.BlockVersion, payloadBytes []byte) (*eth.ExecutionPayloadEnvelope, pubsub.ValidationResult) {
+	var envelope eth.ExecutionPayloadEnvelope
+
+	// [REJECT] if the block encoding is not valid
+	if blockVersion == eth.BlockV3 {
+		if err := envelope.UnmarshalSSZ(uint32(len(payloadBytes)), bytes.NewReader(payloadBytes)); err != nil {
+			log.Warn("invalid envelope payload", "err", err, "peer", id)
+			return nil, pubsub.ValidationReject
+		}
+	} else {
+		var payload eth.ExecutionPayload
+		if err := payload.UnmarshalSSZ(blockVersion, uint32(len(payloadBytes)), bytes.NewReader(payloadBytes)); err != nil {
+			log.Warn("invalid execution payload", "err", err, "peer", id)
+			return nil, pubsub.ValidationReject
+		}
+		envelope = eth.ExecutionPayloadEnvelope{ExecutionPayload: &payload}
+	}
+
+	payload := envelope.ExecutionPayload
+
+	// rounding down to seconds is fine here.
+	now := uint64(time.Now().Unix())
+
+	// [REJECT] if the `payload.timestamp` is older than 60 seconds in the past
+	if uint64(payload.Timestamp) < now-60 {
+		log.Warn("payload is too old", "timestamp", uint64(payload.Timestamp))
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if the `payload.timestamp` is more than 5 seconds into the future
+	if uint64(payload.Timestamp) > now+5 {
+		log.Warn("payload is too new", "timestamp", uint64(payload.Timestamp))
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if the `block_hash` in the `payload` is not valid
+	if actual, ok := envelope.CheckBlockHash(); !ok {
+		log.Warn("payload has bad block hash", "bad_hash", payload.BlockHash.String(), "actual", actual.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if a V1 Block has withdrawals
+	if !blockVersion.HasWithdrawals() && payload.Withdrawals != nil {
+		log.Warn("payload is on v1 topic, but has withdrawals", "bad_hash", payload.BlockHash.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if a >= V2 Block does not have withdrawals
+	if blockVersion.HasWithdrawals() && payload.Withdrawals == nil {
+		log.Warn("payload is on v2/v3 topic, but does not have withdrawals", "bad_hash", payload.BlockHash.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if a >= V2 Block has non-empty withdrawals
+	if blockVersion.HasWithdrawals() && len(*payload.Withdrawals) != 0 {
+		log.Warn("payload is on v2/v3 topic, but has non-empty withdrawals", "bad_hash", payload.BlockHash.String(), "withdrawal_count", len(*payload.Withdrawals))
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if the block is on a topic <= V2 and has a blob gas value set
+	if !blockVersion.HasBlobProperties() && payload.BlobGasUsed != nil {
+		log.Warn("payload is on v1/v2 topic, but has blob gas used", "bad_hash", payload.BlockHash.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	// [REJECT] if the block is on a topic <= V2 and has an excess blob gas value set
+	if !blockVersion.HasBlobProperties() && payload.ExcessBlobGas != nil {
+		log.Warn("payload is on v1/v2 topic, but has excess blob gas", "bad_hash", payload.BlockHash.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	if blockVersion.HasBlobProperties() {
+		// [REJECT] if the block is on a topic >= V3 and has a blob gas used value that is not zero
+		if payload.BlobGasUsed == nil || *payload.BlobGasUsed != 0 {
+			log.Warn("payload is on v3 topic, but has non-zero blob gas used", "bad_hash", payload.BlockHash.String(), "blob_gas_used", payload.BlobGasUsed)
+			return nil, pubsub.ValidationReject
+		}
+
+		// [REJECT] if the block is on a topic >= V3 and has an excess blob gas value that is not zero
+		if payload.ExcessBlobGas == nil || *payload.ExcessBlobGas != 0 {
+			log.Warn("payload is on v3 topic, but has non-zero excess blob gas", "bad_hash", payload.BlockHash.String(), "excess_blob_gas", payload.ExcessBlobGas)
+			return nil, pubsub.ValidationReject
+		}
+	}
+
+	// [REJECT] if the block is on a topic >= V3 and the parent beacon block root is nil
+	if blockVersion.HasParentBeaconBlockRoot() && envelope.ParentBeaconBlockRoot == nil {
+		log.Warn("payload is on v3 topic, but has nil parent beacon block root", "bad_hash", payload.BlockHash.String())
+		return nil, pubsub.ValidationReject
+	}
+
+	return &envelope, pubsub.ValidationAccept
+}
+
 func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, id peer.ID, signatureBytes []byte, payloadBytes []byte) pubsub.ValidationResult {
 	signingHash, err := BlockSigningHash(cfg, payloadBytes)
 	if err != nil {
@@ -487,6 +545,10 @@ type publisher struct {
 	blocksV3 *blockTopic
 
 	runCfg GossipRuntimeConfig
+	// attestations is the topic the payloads are attested on, and attester proposes the published payloads to the
+	// signer set and collects their attestations. Both are nil if payloads are signed by the sequencer only.
+	attestations *blockTopic
+	attester     *attester
 }
 
 var _ GossipOut = (*publisher)(nil)
@@ -530,7 +592,12 @@ func (p *publisher) PublishL2Payload(ctx context.Context, envelope *eth.Executio
 		defer msgBufPool.Put(res)
 	}()
 
-	buf.Write(make([]byte, 65))
+	// reserve space for the signature, unless the payload is attested by a signer set
+	sigLen := 65
+	if p.attester != nil {
+		sigLen = 0
+	}
+	buf.Write(make([]byte, sigLen))
 
 	if envelope.ParentBeaconBlockRoot != nil {
 		if _, err := envelope.MarshalSSZ(buf); err != nil {
@@ -542,39 +609,52 @@ func (p *publisher) PublishL2Payload(ctx context.Context, envelope *eth.Executio
 		}
 	}
 
+	blockVersion, topic := eth.BlockV1, p.blocksV1
+	if p.cfg.IsEcotone(uint64(envelope.ExecutionPayload.Timestamp)) {
+		blockVersion, topic = eth.BlockV3, p.blocksV3
+	} else if p.cfg.IsCanyon(uint64(envelope.ExecutionPayload.Timestamp)) {
+		blockVersion, topic = eth.BlockV2, p.blocksV2
+	}
+
 	data := buf.Bytes()
-	payloadData := data[65:]
-	sig, err := signer.Sign(ctx, SigningDomainBlocksV1, p.cfg.L2ChainID, payloadData)
-	if err != nil {
-		return fmt.Errorf("failed to sign execution payload with signer: %w", err)
+	payloadData := data[sigLen:]
+	if p.attester != nil {
+		header, err := p.attester.attest(ctx, signer, blockVersion, payloadData)
+		if err != nil {
+			return fmt.Errorf("failed to attest execution payload: %w", err)
+		}
+		data = append(header, payloadData...)
+	} else {
+		sig, err := signer.Sign(ctx, SigningDomainBlocksV1, p.cfg.L2ChainID, payloadData)
+		if err != nil {
+			return fmt.Errorf("failed to sign execution payload with signer: %w", err)
+		}
+		copy(data[:65], sig[:])
 	}
-	copy(data[:65], sig[:])
 
 	// compress the full message
 	// This also copies the data, freeing up the original buffer to go back into the pool
 	out := snappy.Encode(nil, data)
 
-	if p.cfg.IsEcotone(uint64(envelope.ExecutionPayload.Timestamp)) {
-		return p.blocksV3.topic.Publish(ctx, out)
-	} else if p.cfg.IsCanyon(uint64(envelope.ExecutionPayload.Timestamp)) {
-		return p.blocksV2.topic.Publish(ctx, out)
-	} else {
-		return p.blocksV1.topic.Publish(ctx, out)
-	}
+	return topic.topic.Publish(ctx, out)
 }
 
 func (p *publisher) Close() error {
 	p.p2pCancel()
 	e1 := p.blocksV1.Close()
 	e2 := p.blocksV2.Close()
-	return errors.Join(e1, e2)
+	var e3 error
+	if p.attestations != nil {
+		e3 = p.attestations.Close()
+	}
+	return errors.Join(e1, e2, e3)
 }
 
-func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (GossipOut, error) {
+func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, attestation *AttestationConfig, gossipIn GossipIn) (GossipOut, error) {
 	p2pCtx, p2pCancel := context.WithCancel(context.Background())
 
 	v1Logger := log.New("topic", "blocksV1")
-	blocksV1Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, eth.BlockV1)))
+	blocksV1Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, attestation, eth.BlockV1)))
 	blocksV1, err := newBlockTopic(p2pCtx, blocksTopicV1(cfg), ps, v1Logger, gossipIn, blocksV1Validator)
 	if err != nil {
 		p2pCancel()
@@ -582,7 +662,7 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 	}
 
 	v2Logger := log.New("topic", "blocksV2")
-	blocksV2Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, eth.BlockV2)))
+	blocksV2Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, attestation, eth.BlockV2)))
 	blocksV2, err := newBlockTopic(p2pCtx, blocksTopicV2(cfg), ps, v2Logger, gossipIn, blocksV2Validator)
 	if err != nil {
 		p2pCancel()
@@ -590,14 +670,14 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 	}
 
 	v3Logger := log.New("topic", "blocksV3")
-	blocksV3Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv3", v3Logger, BuildBlocksValidator(v3Logger, cfg, runCfg, eth.BlockV3)))
+	blocksV3Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv3", v3Logger, BuildBlocksValidator(v3Logger, cfg, runCfg, attestation, eth.BlockV3)))
 	blocksV3, err := newBlockTopic(p2pCtx, blocksTopicV3(cfg), ps, v3Logger, gossipIn, blocksV3Validator)
 	if err != nil {
 		p2pCancel()
 		return nil, fmt.Errorf("failed to setup blocks v3 p2p: %w", err)
 	}
 
-	return &publisher{
+	p := &publisher{
 		log:       log,
 		cfg:       cfg,
 		p2pCancel: p2pCancel,
@@ -605,10 +685,31 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 		blocksV2:  blocksV2,
 		blocksV3:  blocksV3,
 		runCfg:    runCfg,
-	}, nil
+	}
+	if attestation != nil {
+		var signer Signer
+		if gossipAttester, ok := gossipIn.(GossipAttester); ok {
+			signer = gossipAttester.AttestationSigner()
+		}
+		attestationsLogger := log.New("topic", "attestations")
+		p.attester = newAttester(attestationsLogger, cfg, runCfg, attestation, signer, func(ctx context.Context, data []byte) error {
+			return p.attestations.topic.Publish(ctx, snappy.Encode(nil, data))
+		})
+		attestationsValidator := guardGossipValidator(log, logValidationResult(self, "validated attestation", attestationsLogger, p.attester.Validate))
+		p.attestations, err = newTopic(p2pCtx, attestationsTopic(cfg), ps, attestationsLogger, p.attester.Handle, attestationsValidator)
+		if err != nil {
+			p2pCancel()
+			return nil, fmt.Errorf("failed to setup attestations p2p: %w", err)
+		}
+	}
+	return p, nil
 }
 
 func newBlockTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log log.Logger, gossipIn GossipIn, validator pubsub.ValidatorEx) (*blockTopic, error) {
+	return newTopic(ctx, topicId, ps, log, BlocksHandler(gossipIn.OnUnsafeL2Payload), validator)
+}
+
+func newTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log log.Logger, handler MessageHandler, validator pubsub.ValidatorEx) (*blockTopic, error) {
 	err := ps.RegisterTopicValidator(topicId,
 		validator,
 		pubsub.WithValidatorTimeout(3*time.Second),
@@ -636,7 +737,7 @@ func newBlockTopic(ctx context.Context, topicId string, ps *pubsub.PubSub, log l
 		return nil, fmt.Errorf("failed to subscribe to blocks gossip topic: %w", err)
 	}
 
-	subscriber := MakeSubscriber(log, BlocksHandler(gossipIn.OnUnsafeL2Payload))
+	subscriber := MakeSubscriber(log, handler)
 	go subscriber(ctx, subscription)
 
 	return &blockTopic{
@@ -271,8 +271,8 @@ func TestBlockValidator(t *testing.T) {
 	// Params Set 2: Call the validation function
 	peerID := peer.ID("foo")
 
-	v2Validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, eth.BlockV2)
-	v3Validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, eth.BlockV3)
+	v2Validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, nil, eth.BlockV2)
+	v3Validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, nil, eth.BlockV3)
 
 	zero, one := uint64(0), uint64(1)
 	beaconHash := common.HexToHash("0x1234")
@@ -326,3 +326,44 @@ func TestBlockValidator(t *testing.T) {
 		})
 	}
 }
+
+// TestBlockValidatorRuntimeLimits tests that the gossip limits of the runtime config are applied
+func TestBlockValidatorRuntimeLimits(t *testing.T) {
+	cfg := &rollup.Config{
+		L2ChainID: big.NewInt(100),
+	}
+	secrets, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := &PreparedSigner{Signer: NewLocalSigner(secrets)}
+	peerID := peer.ID("foo")
+
+	validate := func(t *testing.T, validator pubsub.ValidatorEx, extraData byte) pubsub.ValidationResult {
+		payload := createExecutionPayload(types.Withdrawals{}, nil, nil)
+		payload.BlockNumber = 1
+		payload.ExtraData = []byte{extraData}
+		e := &eth.ExecutionPayloadEnvelope{ExecutionPayload: payload}
+		payload.BlockHash, _ = e.CheckBlockHash()
+		data, err := createSignedP2Payload(payload, signer, cfg.L2ChainID)
+		require.NoError(t, err)
+		return validator(context.TODO(), peerID, &pubsub.Message{Message: &pubsub_pb.Message{Data: data}})
+	}
+
+	t.Run("MaxSize", func(t *testing.T) {
+		runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: crypto.PubkeyToAddress(secrets.PublicKey), MaxGossipSize: 100}
+		validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, nil, eth.BlockV2)
+		require.Equal(t, pubsub.ValidationReject, validate(t, validator, 0))
+		runCfg.MaxGossipSize = 0
+		require.Equal(t, pubsub.ValidationAccept, validate(t, validator, 0))
+	})
+
+	t.Run("MaxBlocksPerHeight", func(t *testing.T) {
+		runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: crypto.PubkeyToAddress(secrets.PublicKey), MaxBlocksPerHeight: 1}
+		validator := BuildBlocksValidator(testlog.Logger(t, log.LevelCrit), cfg, runCfg, nil, eth.BlockV2)
+		require.Equal(t, pubsub.ValidationAccept, validate(t, validator, 0))
+		require.Equal(t, pubsub.ValidationAccept, validate(t, validator, 1))
+		require.Equal(t, pubsub.ValidationReject, validate(t, validator, 2))
+		// the limit is read at validation time
+		runCfg.MaxBlocksPerHeight = 0
+		require.Equal(t, pubsub.ValidationAccept, validate(t, validator, 2))
+	})
+}
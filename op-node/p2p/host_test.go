@@ -222,6 +222,33 @@ func TestP2PFull(t *testing.T) {
 	require.Equal(t, subnet, blockedSubnets[0])
 	require.NoError(t, p2pClientA.UnblockSubnet(ctx, subnet))
 
+	pC, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	idC, err := peer.IDFromPublicKey(pC.GetPublic())
+	require.NoError(t, err)
+	require.Error(t, p2pClientA.ImportBanList(ctx, nil))
+	require.Error(t, p2pClientA.ImportBanList(ctx, &BanList{Peers: []PeerBan{{PeerID: "", TTL: time.Hour}}}))
+	require.Error(t, p2pClientA.ImportBanList(ctx, &BanList{IPs: []IPBan{{IP: nil, TTL: time.Hour}}}))
+	require.NoError(t, p2pClientA.ImportBanList(ctx, &BanList{
+		Peers: []PeerBan{
+			{PeerID: idC, TTL: time.Hour},
+			{PeerID: hostA.ID(), TTL: time.Hour}, // a node never bans itself
+		},
+		IPs: []IPBan{
+			{IP: net.IP{124, 124, 124, 124}, TTL: time.Hour},
+			{IP: net.IP{125, 125, 125, 125}, TTL: -time.Second}, // already expired
+		},
+	}))
+	// A shorter ban does not shorten the existing ban
+	require.NoError(t, p2pClientA.ImportBanList(ctx, &BanList{Peers: []PeerBan{{PeerID: idC, TTL: time.Minute}}}))
+	reputation, err := p2pClientA.ExportPeerReputation(ctx)
+	require.NoError(t, err)
+	require.Len(t, reputation.Bans.Peers, 1)
+	require.Equal(t, idC, reputation.Bans.Peers[0].PeerID)
+	require.Greater(t, reputation.Bans.Peers[0].TTL, 50*time.Minute)
+	require.Len(t, reputation.Bans.IPs, 1)
+	require.Equal(t, net.IP{124, 124, 124, 124}, reputation.Bans.IPs[0].IP.To4())
+
 	// Ask host A for all peer information they have
 	peerDump, err := p2pClientA.Peers(ctx, false)
 	require.Nil(t, err)
@@ -321,6 +321,111 @@ func (_c *API_DiscoveryTable_Call) RunAndReturn(run func(context.Context) ([]*en
 	return _c
 }
 
+// ExportPeerReputation provides a mock function with given fields: ctx
+func (_m *API) ExportPeerReputation(ctx context.Context) (*p2p.PeerReputation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPeerReputation")
+	}
+
+	var r0 *p2p.PeerReputation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*p2p.PeerReputation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *p2p.PeerReputation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*p2p.PeerReputation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ExportPeerReputation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPeerReputation'
+type API_ExportPeerReputation_Call struct {
+	*mock.Call
+}
+
+// ExportPeerReputation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *API_Expecter) ExportPeerReputation(ctx interface{}) *API_ExportPeerReputation_Call {
+	return &API_ExportPeerReputation_Call{Call: _e.mock.On("ExportPeerReputation", ctx)}
+}
+
+func (_c *API_ExportPeerReputation_Call) Run(run func(ctx context.Context)) *API_ExportPeerReputation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *API_ExportPeerReputation_Call) Return(_a0 *p2p.PeerReputation, _a1 error) *API_ExportPeerReputation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ExportPeerReputation_Call) RunAndReturn(run func(context.Context) (*p2p.PeerReputation, error)) *API_ExportPeerReputation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportBanList provides a mock function with given fields: ctx, bans
+func (_m *API) ImportBanList(ctx context.Context, bans *p2p.BanList) error {
+	ret := _m.Called(ctx, bans)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportBanList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *p2p.BanList) error); ok {
+		r0 = rf(ctx, bans)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_ImportBanList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportBanList'
+type API_ImportBanList_Call struct {
+	*mock.Call
+}
+
+// ImportBanList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bans *p2p.BanList
+func (_e *API_Expecter) ImportBanList(ctx interface{}, bans interface{}) *API_ImportBanList_Call {
+	return &API_ImportBanList_Call{Call: _e.mock.On("ImportBanList", ctx, bans)}
+}
+
+func (_c *API_ImportBanList_Call) Run(run func(ctx context.Context, bans *p2p.BanList)) *API_ImportBanList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*p2p.BanList))
+	})
+	return _c
+}
+
+func (_c *API_ImportBanList_Call) Return(_a0 error) *API_ImportBanList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_ImportBanList_Call) RunAndReturn(run func(context.Context, *p2p.BanList) error) *API_ImportBanList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListBlockedAddrs provides a mock function with given fields: ctx
 func (_m *API) ListBlockedAddrs(ctx context.Context) ([]net.IP, error) {
 	ret := _m.Called(ctx)
@@ -495,6 +600,64 @@ func (_c *API_ListBlockedSubnets_Call) RunAndReturn(run func(context.Context) ([
 	return _c
 }
 
+// PeerBandwidth provides a mock function with given fields: ctx
+func (_m *API) PeerBandwidth(ctx context.Context) (map[peer.ID]*p2p.PeerBandwidth, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PeerBandwidth")
+	}
+
+	var r0 map[peer.ID]*p2p.PeerBandwidth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[peer.ID]*p2p.PeerBandwidth, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[peer.ID]*p2p.PeerBandwidth); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[peer.ID]*p2p.PeerBandwidth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_PeerBandwidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PeerBandwidth'
+type API_PeerBandwidth_Call struct {
+	*mock.Call
+}
+
+// PeerBandwidth is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *API_Expecter) PeerBandwidth(ctx interface{}) *API_PeerBandwidth_Call {
+	return &API_PeerBandwidth_Call{Call: _e.mock.On("PeerBandwidth", ctx)}
+}
+
+func (_c *API_PeerBandwidth_Call) Run(run func(ctx context.Context)) *API_PeerBandwidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *API_PeerBandwidth_Call) Return(_a0 map[peer.ID]*p2p.PeerBandwidth, _a1 error) *API_PeerBandwidth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_PeerBandwidth_Call) RunAndReturn(run func(context.Context) (map[peer.ID]*p2p.PeerBandwidth, error)) *API_PeerBandwidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PeerStats provides a mock function with given fields: ctx
 func (_m *API) PeerStats(ctx context.Context) (*p2p.PeerStats, error) {
 	ret := _m.Called(ctx)
@@ -42,10 +42,12 @@ type NodeP2P struct {
 	appScorer   ApplicationScorer
 	log         log.Logger
 	// the below components are all optional, and may be nil. They require the host to not be nil.
-	dv5Local *enode.LocalNode // p2p discovery identity
-	dv5Udp   *discover.UDPv5  // p2p discovery service
-	gs       *pubsub.PubSub   // p2p gossip router
-	gsOut    GossipOut        // p2p gossip application interface for publishing
+	dv5Local *enode.LocalNode             // p2p discovery identity
+	dv5Udp   *discover.UDPv5              // p2p discovery service
+	gs       *pubsub.PubSub               // p2p gossip router
+	gsOut    GossipOut                    // p2p gossip application interface for publishing
+	gsBw     *GossipBandwidthTracker      // p2p gossip bandwidth per peer and topic
+	bwc      *p2pmetrics.BandwidthCounter // p2p bandwidth per peer and protocol
 	syncCl   *SyncClient
 	syncSrv  *ReqRespServer
 }
@@ -97,6 +99,7 @@ func (n *NodeP2P) init(
 	elSyncEnabled bool,
 ) error {
 	bwc := p2pmetrics.NewBandwidthCounter()
+	n.bwc = bwc
 
 	n.log = log
 
@@ -157,11 +160,12 @@ func (n *NodeP2P) init(
 	// notify of any new connections/streams/etc.
 	n.host.Network().Notify(NewNetworkNotifier(log, metrics))
 	// note: the IDDelta functionality was removed from libP2P, and no longer needs to be explicitly disabled.
-	n.gs, err = NewGossipSub(resourcesCtx, n.host, rollupCfg, setup, n.scorer, metrics, log)
+	n.gsBw = NewGossipBandwidthTracker(n.host.ID(), metrics)
+	n.gs, err = NewGossipSub(resourcesCtx, n.host, rollupCfg, setup, n.scorer, metrics, n.gsBw, log)
 	if err != nil {
 		return fmt.Errorf("failed to start gossipsub router: %w", err)
 	}
-	n.gsOut, err = JoinGossip(n.host.ID(), n.gs, log, rollupCfg, runCfg, gossipIn)
+	n.gsOut, err = JoinGossip(n.host.ID(), n.gs, log, rollupCfg, runCfg, setup.BlockAttestation(), gossipIn)
 	if err != nil {
 		return fmt.Errorf("failed to join blocks gossip topic: %w", err)
 	}
@@ -180,6 +184,7 @@ func (n *NodeP2P) init(
 
 	if metrics != nil {
 		go metrics.RecordBandwidth(resourcesCtx, bwc)
+		go n.gsBw.ReportMetrics(resourcesCtx)
 	}
 
 	if setup.BanPeers() {
@@ -222,6 +227,11 @@ func (n *NodeP2P) GossipOut() GossipOut {
 	return n.gsOut
 }
 
+// PeerBandwidth returns the bandwidth used with each connected peer, and the part of it used by gossip per topic.
+func (n *NodeP2P) PeerBandwidth() map[peer.ID]*PeerBandwidth {
+	return peerBandwidth(n.host.Network().Peers(), n.bwc, n.gsBw)
+}
+
 func (n *NodeP2P) ConnectionGater() gating.BlockingConnectionGater {
 	return n.gater
 }
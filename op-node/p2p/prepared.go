@@ -25,6 +25,12 @@ type Prepared struct {
 	UDPv5     *discover.UDPv5
 
 	EnableReqRespSync bool
+
+	Attestation *AttestationConfig
+
+	// GossipOptions are applied to the GossipSub setup after the default options,
+	// e.g. to simulate network conditions between in-process hosts.
+	GossipOptions []pubsub.Option
 }
 
 var _ SetupP2P = (*Prepared)(nil)
@@ -64,9 +70,10 @@ func (p *Prepared) Discovery(log log.Logger, rollupCfg *rollup.Config, tcpPort u
 }
 
 func (p *Prepared) ConfigureGossip(rollupCfg *rollup.Config) []pubsub.Option {
-	return []pubsub.Option{
+	opts := []pubsub.Option{
 		pubsub.WithGossipSubParams(BuildGlobalGossipParams(rollupCfg)),
 	}
+	return append(opts, p.GossipOptions...)
 }
 
 func (p *Prepared) PeerScoringParams() *ScoringParams {
@@ -92,3 +99,7 @@ func (p *Prepared) Disabled() bool {
 func (p *Prepared) ReqRespSyncEnabled() bool {
 	return p.EnableReqRespSync
 }
+
+func (p *Prepared) BlockAttestation() *AttestationConfig {
+	return p.Attestation
+}
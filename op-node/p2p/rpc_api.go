@@ -41,11 +41,39 @@ type PeerDump struct {
 	BannedSubnets  []*net.IPNet         `json:"bannedSubnets"`
 }
 
+// PeerBan is a ban of a peer, with the time left until the ban expires.
+type PeerBan struct {
+	PeerID peer.ID       `json:"peerID"`
+	TTL    time.Duration `json:"ttl"`
+}
+
+// IPBan is a ban of an IP address, with the time left until the ban expires.
+type IPBan struct {
+	IP  net.IP        `json:"ip"`
+	TTL time.Duration `json:"ttl"`
+}
+
+// BanList is a list of bans that can be shared between nodes.
+// Bans are shared with a TTL rather than an expiry time, so nodes do not have to agree on the time.
+type BanList struct {
+	Peers []PeerBan `json:"peers"`
+	IPs   []IPBan   `json:"ips"`
+}
+
+// PeerReputation is the scoring and ban state of the node.
+type PeerReputation struct {
+	// We don't use the peer.ID type as key,
+	// since JSON decoding can't use the provided json unmarshaler (on *string type).
+	Scores map[string]store.PeerScores `json:"scores"`
+	Bans   BanList                     `json:"bans"`
+}
+
 //go:generate mockery --name API --output mocks/ --with-expecter=true
 type API interface {
 	Self(ctx context.Context) (*PeerInfo, error)
 	Peers(ctx context.Context, connected bool) (*PeerDump, error)
 	PeerStats(ctx context.Context) (*PeerStats, error)
+	PeerBandwidth(ctx context.Context) (map[peer.ID]*PeerBandwidth, error)
 	DiscoveryTable(ctx context.Context) ([]*enode.Node, error)
 	BlockPeer(ctx context.Context, p peer.ID) error
 	UnblockPeer(ctx context.Context, p peer.ID) error
@@ -60,4 +88,6 @@ type API interface {
 	UnprotectPeer(ctx context.Context, p peer.ID) error
 	ConnectPeer(ctx context.Context, addr string) error
 	DisconnectPeer(ctx context.Context, id peer.ID) error
+	ExportPeerReputation(ctx context.Context) (*PeerReputation, error)
+	ImportBanList(ctx context.Context, bans *BanList) error
 }
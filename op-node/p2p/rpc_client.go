@@ -44,6 +44,12 @@ func (c *Client) PeerStats(ctx context.Context) (*PeerStats, error) {
 	return out, err
 }
 
+func (c *Client) PeerBandwidth(ctx context.Context) (map[peer.ID]*PeerBandwidth, error) {
+	var out map[peer.ID]*PeerBandwidth
+	err := c.c.CallContext(ctx, &out, prefixRPC("peerBandwidth"))
+	return out, err
+}
+
 func (c *Client) DiscoveryTable(ctx context.Context) ([]*enode.Node, error) {
 	var out []*enode.Node
 	err := c.c.CallContext(ctx, &out, prefixRPC("discoveryTable"))
@@ -107,3 +113,13 @@ func (c *Client) ConnectPeer(ctx context.Context, addr string) error {
 func (c *Client) DisconnectPeer(ctx context.Context, id peer.ID) error {
 	return c.c.CallContext(ctx, nil, prefixRPC("disconnectPeer"), id)
 }
+
+func (c *Client) ExportPeerReputation(ctx context.Context) (*PeerReputation, error) {
+	var out *PeerReputation
+	err := c.c.CallContext(ctx, &out, prefixRPC("exportPeerReputation"))
+	return out, err
+}
+
+func (c *Client) ImportBanList(ctx context.Context, bans *BanList) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("importBanList"), bans)
+}
@@ -37,6 +37,7 @@ var (
 	ErrNoConnectionManager = errors.New("no connection manager")
 	ErrNoConnectionGater   = errors.New("no connection gater")
 	ErrInvalidRequest      = errors.New("invalid request")
+	ErrNoBanStore          = errors.New("no ban store")
 )
 
 type Node interface {
@@ -54,6 +55,12 @@ type Node interface {
 	ConnectionGater() gating.BlockingConnectionGater
 	// ConnectionManager returns the connection manager, to protect peers with, may be nil
 	ConnectionManager() connmgr.ConnManager
+	// PeerBandwidth returns the bandwidth used with each connected peer
+	PeerBandwidth() map[peer.ID]*PeerBandwidth
+	// BanPeer bans the peer until the expiration time, and disconnects it
+	BanPeer(id peer.ID, expiration time.Time) error
+	// BanIP bans the IP address until the expiration time, and disconnects all peers with the IP address
+	BanIP(ip net.IP, expiration time.Time) error
 }
 
 type APIBackend struct {
@@ -235,6 +242,13 @@ func (s *APIBackend) PeerStats(_ context.Context) (*PeerStats, error) {
 	return stats, nil
 }
 
+// PeerBandwidth returns the bandwidth used with each connected peer, in total and by gossip per topic.
+func (s *APIBackend) PeerBandwidth(_ context.Context) (map[peer.ID]*PeerBandwidth, error) {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_peerBandwidth")
+	defer recordDur()
+	return s.node.PeerBandwidth(), nil
+}
+
 func (s *APIBackend) DiscoveryTable(_ context.Context) ([]*enode.Node, error) {
 	recordDur := s.m.RecordRPCServerRequest("opp2p_discoveryTable")
 	defer recordDur()
@@ -428,3 +442,95 @@ func (s *APIBackend) DisconnectPeer(_ context.Context, id peer.ID) error {
 	}
 	return nil
 }
+
+// ExportPeerReputation returns the scores of all known peers, and the bans that have not expired yet,
+// to share with other nodes with ImportBanList.
+func (s *APIBackend) ExportPeerReputation(_ context.Context) (*PeerReputation, error) {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_exportPeerReputation")
+	defer recordDur()
+	eps, ok := s.node.Host().Peerstore().(store.ExtendedPeerstore)
+	if !ok {
+		return nil, ErrNoBanStore
+	}
+	out := &PeerReputation{Scores: make(map[string]store.PeerScores)}
+	for _, id := range eps.Peers() {
+		if scores, err := eps.GetPeerScores(id); err == nil {
+			out.Scores[id.String()] = scores
+		}
+	}
+	now := time.Now()
+	peerBans, err := eps.PeerBans()
+	if err != nil {
+		return nil, err
+	}
+	for id, expiry := range peerBans {
+		out.Bans.Peers = append(out.Bans.Peers, PeerBan{PeerID: id, TTL: expiry.Sub(now)})
+	}
+	ipBans, err := eps.IPBans()
+	if err != nil {
+		return nil, err
+	}
+	for ip, expiry := range ipBans {
+		out.Bans.IPs = append(out.Bans.IPs, IPBan{IP: net.ParseIP(ip), TTL: expiry.Sub(now)})
+	}
+	return out, nil
+}
+
+// ImportBanList bans the peers and IP addresses of the ban list, e.g. as exported by another node of the same fleet,
+// until their TTL runs out. Existing bans are only ever extended, never shortened,
+// and bans with a TTL that already ran out are ignored.
+func (s *APIBackend) ImportBanList(_ context.Context, bans *BanList) error {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_importBanList")
+	if bans == nil {
+		s.log.Warn("invalid ban list", "method", "ImportBanList")
+		return ErrInvalidRequest
+	}
+	for _, ban := range bans.Peers {
+		if err := ban.PeerID.Validate(); err != nil {
+			s.log.Warn("invalid peer ID", "method", "ImportBanList", "peer", ban.PeerID, "err", err)
+			return ErrInvalidRequest
+		}
+	}
+	for _, ban := range bans.IPs {
+		if ban.IP == nil {
+			s.log.Warn("invalid IP", "method", "ImportBanList")
+			return ErrInvalidRequest
+		}
+	}
+	defer recordDur()
+	eps, ok := s.node.Host().Peerstore().(store.ExtendedPeerstore)
+	if !ok {
+		return ErrNoBanStore
+	}
+	self := s.node.Host().ID()
+	now := time.Now()
+	var imported int
+	for _, ban := range bans.Peers {
+		if ban.TTL <= 0 || ban.PeerID == self {
+			continue
+		}
+		expiry := now.Add(ban.TTL)
+		if current, err := eps.GetPeerBanExpiration(ban.PeerID); err == nil && !current.Before(expiry) {
+			continue
+		}
+		if err := s.node.BanPeer(ban.PeerID, expiry); err != nil {
+			return fmt.Errorf("failed to ban peer %s: %w", ban.PeerID, err)
+		}
+		imported++
+	}
+	for _, ban := range bans.IPs {
+		if ban.TTL <= 0 {
+			continue
+		}
+		expiry := now.Add(ban.TTL)
+		if current, err := eps.GetIPBanExpiration(ban.IP); err == nil && !current.Before(expiry) {
+			continue
+		}
+		if err := s.node.BanIP(ban.IP, expiry); err != nil {
+			return fmt.Errorf("failed to ban IP %s: %w", ban.IP, err)
+		}
+		imported++
+	}
+	s.log.Info("Imported ban list", "peers", len(bans.Peers), "ips", len(bans.IPs), "imported", imported)
+	return nil
+}
@@ -120,6 +120,14 @@ type IPBanStore interface {
 	GetIPBanExpiration(ip net.IP) (time.Time, error)
 }
 
+// BanLister lists the bans that have not expired yet.
+type BanLister interface {
+	// PeerBans returns the expiration time of every banned peer.
+	PeerBans() (map[peer.ID]time.Time, error)
+	// IPBans returns the expiration time of every banned IP address, keyed by the IP string.
+	IPBans() (map[string]time.Time, error)
+}
+
 type MetadataStore interface {
 	// SetPeerMetadata sets the metadata for the specified peer
 	SetPeerMetadata(id peer.ID, md PeerMetadata) (PeerMetadata, error)
@@ -134,5 +142,6 @@ type ExtendedPeerstore interface {
 	peerstore.CertifiedAddrBook
 	PeerBanStore
 	IPBanStore
+	BanLister
 	MetadataStore
 }
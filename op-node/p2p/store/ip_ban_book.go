@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net"
 	"sync"
 	"time"
@@ -90,6 +91,29 @@ func (d *ipBanBook) SetIPBanExpiration(ip net.IP, expirationTime time.Time) erro
 	return err
 }
 
+// IPBans returns the expiration times of all IP bans that have not expired yet, keyed by IP address.
+func (d *ipBanBook) IPBans() (map[string]time.Time, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	recs, err := d.book.records()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP bans: %w", err)
+	}
+	now := d.book.clock.Now()
+	out := make(map[string]time.Time, len(recs))
+	for key, rec := range recs {
+		expiry := time.Unix(rec.Expiry, 0)
+		if !now.Before(expiry) {
+			continue
+		}
+		if net.ParseIP(key) == nil {
+			return nil, fmt.Errorf("invalid IP ban key %q", key)
+		}
+		out[key] = expiry
+	}
+	return out, nil
+}
+
 func (d *ipBanBook) Close() {
 	d.book.Close()
 }
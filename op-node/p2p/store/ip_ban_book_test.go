@@ -33,6 +33,22 @@ func TestRoundTripIPBan(t *testing.T) {
 	require.Equal(t, result, expiry)
 }
 
+func TestListIPBans(t *testing.T) {
+	book := createMemoryIPBanBook(t)
+	defer book.Close()
+	expiry := time.Unix(2484924, 0)
+	require.NoError(t, book.SetIPBanExpiration(net.IPv4(1, 2, 3, 4), expiry))
+	require.NoError(t, book.SetIPBanExpiration(net.ParseIP("2001:db8::1"), expiry.Add(time.Hour)))
+	// Bans that already expired are not listed
+	require.NoError(t, book.SetIPBanExpiration(net.IPv4(5, 6, 7, 8), time.UnixMilli(50)))
+	bans, err := book.IPBans()
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Time{
+		"1.2.3.4":     expiry,
+		"2001:db8::1": expiry.Add(time.Hour),
+	}, bans)
+}
+
 func createMemoryIPBanBook(t *testing.T) *ipBanBook {
 	store := sync.MutexWrap(ds.NewMapDatastore())
 	logger := testlog.Logger(t, log.LevelInfo)
@@ -149,6 +149,33 @@ func (d *recordsBook[K, V]) setRecord(key K, diff recordDiff[V]) (V, error) {
 	return rec, nil
 }
 
+// records returns all records in the store that have not expired yet,
+// keyed by the base name of their datastore key, as encoded by dsEntryKey.
+func (d *recordsBook[K, V]) records() (map[string]V, error) {
+	results, err := d.store.Query(d.ctx, query.Query{
+		Prefix: d.dsBaseKey.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	out := make(map[string]V)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		v := d.newRecord()
+		if err := v.UnmarshalBinary(result.Value); err != nil {
+			return nil, fmt.Errorf("invalid value for key %v: %w", result.Key, err)
+		}
+		if d.hasExpired(v) {
+			continue
+		}
+		out[ds.RawKey(result.Key).BaseNamespace()] = v
+	}
+	return out, nil
+}
+
 // prune deletes entries from the store that are older than the configured prune expiration.
 // Entries that are eligible for deletion may still be present either because the prune function hasn't yet run or
 // because they are still preserved in the in-memory cache after having been deleted from the database.
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/ethereum-optimism/optimism/op-node/params"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -124,16 +123,13 @@ func (s *ChainSpec) IsIsthmus(t uint64) bool {
 // MaxChannelBankSize returns the maximum number of bytes the can allocated inside the channel bank
 // before pruning occurs at the given timestamp.
 func (s *ChainSpec) MaxChannelBankSize(t uint64) uint64 {
-	if s.config.IsFjord(t) {
-		return maxChannelBankSizeFjord
-	}
-	return maxChannelBankSizeBedrock
+	return s.config.RulesAt(t).MaxChannelBankSize
 }
 
 // ChannelTimeout returns the channel timeout constant.
 func (s *ChainSpec) ChannelTimeout(t uint64) uint64 {
-	if s.config.IsGranite(t) {
-		return params.ChannelTimeoutGranite
+	if timeout := s.config.RulesAt(t).ChannelTimeout; timeout != 0 {
+		return timeout
 	}
 	return s.config.ChannelTimeoutBedrock
 }
@@ -141,89 +137,38 @@ func (s *ChainSpec) ChannelTimeout(t uint64) uint64 {
 // MaxRLPBytesPerChannel returns the maximum amount of bytes that will be read from
 // a channel at a given timestamp.
 func (s *ChainSpec) MaxRLPBytesPerChannel(t uint64) uint64 {
-	if s.config.IsFjord(t) {
-		return maxRLPBytesPerChannelFjord
-	}
-	return maxRLPBytesPerChannelBedrock
+	return s.config.RulesAt(t).MaxRLPBytesPerChannel
 }
 
 // IsFeatMaxSequencerDriftConstant specifies in which fork the max sequencer drift change to a
 // constant will be performed.
 func (s *ChainSpec) IsFeatMaxSequencerDriftConstant(t uint64) bool {
-	return s.config.IsFjord(t)
+	return s.config.RulesAt(t).MaxSequencerDrift != 0
 }
 
 // MaxSequencerDrift returns the maximum sequencer drift for the given block timestamp. Until Fjord,
 // this was a rollup configuration parameter. Since Fjord, it is a constant, so its effective value
 // should always be queried via the ChainSpec.
 func (s *ChainSpec) MaxSequencerDrift(t uint64) uint64 {
-	if s.IsFeatMaxSequencerDriftConstant(t) {
-		return maxSequencerDriftFjord
+	if drift := s.config.RulesAt(t).MaxSequencerDrift; drift != 0 {
+		return drift
 	}
 	return s.config.MaxSequencerDrift
 }
 
 func (s *ChainSpec) CheckForkActivation(log log.Logger, block eth.L2BlockRef) {
-	if s.currentFork == Interop {
+	if nextFork[s.currentFork] == None {
 		return
 	}
 
 	if s.currentFork == "" {
 		// Initialize currentFork if it is not set yet
-		s.currentFork = Bedrock
-		if s.config.IsRegolith(block.Time) {
-			s.currentFork = Regolith
-		}
-		if s.config.IsCanyon(block.Time) {
-			s.currentFork = Canyon
-		}
-		if s.config.IsDelta(block.Time) {
-			s.currentFork = Delta
-		}
-		if s.config.IsEcotone(block.Time) {
-			s.currentFork = Ecotone
-		}
-		if s.config.IsFjord(block.Time) {
-			s.currentFork = Fjord
-		}
-		if s.config.IsGranite(block.Time) {
-			s.currentFork = Granite
-		}
-		if s.config.IsHolocene(block.Time) {
-			s.currentFork = Holocene
-		}
-		if s.config.IsIsthmus(block.Time) {
-			s.currentFork = Isthmus
-		}
-		if s.config.IsInterop(block.Time) {
-			s.currentFork = Interop
-		}
+		s.currentFork = s.config.ActiveFork(block.Time)
 		log.Info("Current hardfork version detected", "forkName", s.currentFork)
 		return
 	}
 
-	foundActivationBlock := false
-
-	switch nextFork[s.currentFork] {
-	case Regolith:
-		foundActivationBlock = s.config.IsRegolithActivationBlock(block.Time)
-	case Canyon:
-		foundActivationBlock = s.config.IsCanyonActivationBlock(block.Time)
-	case Delta:
-		foundActivationBlock = s.config.IsDeltaActivationBlock(block.Time)
-	case Ecotone:
-		foundActivationBlock = s.config.IsEcotoneActivationBlock(block.Time)
-	case Fjord:
-		foundActivationBlock = s.config.IsFjordActivationBlock(block.Time)
-	case Granite:
-		foundActivationBlock = s.config.IsGraniteActivationBlock(block.Time)
-	case Holocene:
-		foundActivationBlock = s.config.IsHoloceneActivationBlock(block.Time)
-	case Isthmus:
-		foundActivationBlock = s.config.IsIsthmusActivationBlock(block.Time)
-	case Interop:
-		foundActivationBlock = s.config.IsInteropActivationBlock(block.Time)
-	}
+	foundActivationBlock := s.config.IsForkActivationBlock(nextFork[s.currentFork], block.Time)
 
 	if foundActivationBlock {
 		s.currentFork = nextFork[s.currentFork]
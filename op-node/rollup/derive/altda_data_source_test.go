@@ -154,7 +154,7 @@ func TestAltDADataSource(t *testing.T) {
 		// called once per derivation
 		l1F.ExpectInfoAndTxsByHash(ref.Hash, testutils.RandomBlockInfo(rng), txs, nil)
 
-		if ref.Number == 2 {
+		if ref.Number == 4 {
 			l1F.ExpectL1BlockRefByNumber(ref.Number, ref, nil)
 			finalitySignal.ExpectFinalized(ref)
 		}
@@ -277,8 +277,9 @@ func TestAltDADataSource(t *testing.T) {
 
 	}
 
-	// finalize based on the second to last block, which will prune the commitment on block 2, and make it finalized
-	da.Finalize(l1Refs[len(l1Refs)-2])
+	// finalize based on the second to last block, which will prune the commitment on block 2. Blocks up to 4 are
+	// finalized, as the resolve window of the challenge of the commitment on block 5 has not ended yet.
+	require.NoError(t, da.Finalize(ctx, l1F, l1Refs[len(l1Refs)-2]))
 	finalitySignal.AssertExpectations(t)
 }
 
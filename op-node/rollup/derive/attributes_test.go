@@ -226,7 +226,7 @@ func TestPreparePayloadAttributes(t *testing.T) {
 		epoch := l1Info.ID()
 		l1InfoTx, err := L1InfoDepositBytes(cfg, testSysCfg, seqNumber, l1Info, 0)
 		require.NoError(t, err)
-		depositsComplete, err := DepositsCompleteBytes(seqNumber, l1Info)
+		depositsComplete, err := DepositsCompleteBytes(cfg, seqNumber, l1Info)
 		require.NoError(t, err)
 
 		var l2Txs []eth.Data
@@ -267,7 +267,7 @@ func TestPreparePayloadAttributes(t *testing.T) {
 		epoch := l1Info.ID()
 		l1InfoTx, err := L1InfoDepositBytes(cfg, testSysCfg, seqNumber, l1Info, 0)
 		require.NoError(t, err)
-		depositsComplete, err := DepositsCompleteBytes(seqNumber, l1Info)
+		depositsComplete, err := DepositsCompleteBytes(cfg, seqNumber, l1Info)
 		require.NoError(t, err)
 
 		var l2Txs []eth.Data
@@ -0,0 +1,223 @@
+package derive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// BatcherDataCacheVersion is the version of the PersistedBatcherData format.
+const BatcherDataCacheVersion = 2
+
+// maxCachedDataBytes limits the batcher data retained in a BatcherDataCache.
+// The oldest L1 blocks are dropped first, and will be fetched from L1 again after a restart.
+const maxCachedDataBytes = 256 * 1024 * 1024
+
+// PersistedBatcherData is the persisted content of a BatcherDataCache,
+// and the point the pipeline resumes from after a restart.
+// It does not hold the state of the pipeline stages: after a restart, the pipeline resets to the persisted safe head,
+// and derives the channel bank and batch queue contents again from the cached data rather than from L1 (blobs in particular).
+type PersistedBatcherData struct {
+	Version uint64 `json:"version"`
+	// Origin is the L1 origin of the pipeline when the cache was persisted.
+	Origin eth.L1BlockRef `json:"origin"`
+	// SafeHead is the safe head when the cache was persisted, derived from the L1 chain up to Origin.
+	SafeHead eth.L2BlockRef `json:"safeHead"`
+	// Blocks holds the batcher data of recent L1 blocks, ordered by L1 block number.
+	Blocks []CachedBatcherData `json:"blocks"`
+}
+
+// CachedBatcherData is the batcher data of a single L1 block.
+type CachedBatcherData struct {
+	L1          eth.BlockID     `json:"l1"`
+	BatcherAddr common.Address  `json:"batcherAddr"`
+	Data        []hexutil.Bytes `json:"data"`
+}
+
+func (b *CachedBatcherData) size() int {
+	var size int
+	for _, data := range b.Data {
+		size += len(data)
+	}
+	return size
+}
+
+type batcherDataKey struct {
+	l1          common.Hash
+	batcherAddr common.Address
+}
+
+// BatcherDataCache retains the batcher data of the L1 blocks within the rewind window of the pipeline,
+// i.e. the sequencing window and channel timeout before the latest read L1 block.
+// Data is keyed by L1 block hash, so data of reorged L1 blocks is never served.
+type BatcherDataCache struct {
+	log  log.Logger
+	cfg  *rollup.Config
+	spec *rollup.ChainSpec
+
+	mu     sync.Mutex
+	blocks []CachedBatcherData
+	index  map[batcherDataKey]int
+	size   int
+}
+
+func NewBatcherDataCache(log log.Logger, cfg *rollup.Config) *BatcherDataCache {
+	return &BatcherDataCache{
+		log:   log,
+		cfg:   cfg,
+		spec:  rollup.NewChainSpec(cfg),
+		index: make(map[batcherDataKey]int),
+	}
+}
+
+// Restore loads the blocks of a persisted cache.
+func (s *BatcherDataCache) Restore(cache *PersistedBatcherData) error {
+	if cache.Version != BatcherDataCacheVersion {
+		return fmt.Errorf("unsupported batcher data cache version %d", cache.Version)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, block := range cache.Blocks {
+		s.add(block)
+	}
+	s.log.Info("Restored batcher data cache", "origin", cache.Origin, "blocks", len(s.blocks), "bytes", s.size)
+	return nil
+}
+
+// Export returns the cache to persist, with the given pipeline origin and safe head.
+func (s *BatcherDataCache) Export(origin eth.L1BlockRef, safeHead eth.L2BlockRef) *PersistedBatcherData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &PersistedBatcherData{
+		Version:  BatcherDataCacheVersion,
+		Origin:   origin,
+		SafeHead: safeHead,
+		Blocks:   append([]CachedBatcherData(nil), s.blocks...),
+	}
+}
+
+func (s *BatcherDataCache) get(ref eth.L1BlockRef, batcherAddr common.Address) ([]hexutil.Bytes, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[batcherDataKey{l1: ref.Hash, batcherAddr: batcherAddr}]
+	if !ok {
+		return nil, false
+	}
+	return s.blocks[i].Data, true
+}
+
+func (s *BatcherDataCache) record(ref eth.L1BlockRef, batcherAddr common.Address, data []hexutil.Bytes) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(CachedBatcherData{L1: ref.ID(), BatcherAddr: batcherAddr, Data: data})
+	s.prune(ref)
+}
+
+func (s *BatcherDataCache) add(block CachedBatcherData) {
+	key := batcherDataKey{l1: block.L1.Hash, batcherAddr: block.BatcherAddr}
+	if _, ok := s.index[key]; ok {
+		return
+	}
+	// Blocks are mostly read in order, only a reset re-reads older blocks.
+	i := len(s.blocks)
+	for i > 0 && s.blocks[i-1].L1.Number > block.L1.Number {
+		i--
+	}
+	s.blocks = append(s.blocks, CachedBatcherData{})
+	copy(s.blocks[i+1:], s.blocks[i:])
+	s.blocks[i] = block
+	s.size += block.size()
+	if i == len(s.blocks)-1 {
+		s.index[key] = i
+	} else {
+		s.reindex()
+	}
+}
+
+// prune drops the blocks outside the rewind window of the latest L1 block, and the oldest blocks over the size limit.
+func (s *BatcherDataCache) prune(latest eth.L1BlockRef) {
+	window := s.cfg.SeqWindowSize + s.spec.ChannelTimeout(latest.Time)
+	drop := 0
+	for drop < len(s.blocks) {
+		block := &s.blocks[drop]
+		if block.L1.Number+window >= latest.Number && s.size <= maxCachedDataBytes {
+			break
+		}
+		s.size -= block.size()
+		drop++
+	}
+	if drop > 0 {
+		s.blocks = append(s.blocks[:0], s.blocks[drop:]...)
+		s.reindex()
+	}
+}
+
+func (s *BatcherDataCache) reindex() {
+	clear(s.index)
+	for i, block := range s.blocks {
+		s.index[batcherDataKey{l1: block.L1.Hash, batcherAddr: block.BatcherAddr}] = i
+	}
+}
+
+// cachedDataSource serves batcher data from the cache if available,
+// and records the data read from the underlying source otherwise.
+type cachedDataSource struct {
+	src   DataAvailabilitySource
+	cache *BatcherDataCache
+}
+
+func (s *cachedDataSource) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error) {
+	if data, ok := s.cache.get(ref, batcherAddr); ok {
+		return &cachedDataIter{data: data}, nil
+	}
+	src, err := s.src.OpenData(ctx, ref, batcherAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingDataIter{src: src, cache: s.cache, ref: ref, batcherAddr: batcherAddr}, nil
+}
+
+type cachedDataIter struct {
+	data []hexutil.Bytes
+}
+
+func (it *cachedDataIter) Next(_ context.Context) (eth.Data, error) {
+	if len(it.data) == 0 {
+		return nil, io.EOF
+	}
+	next := it.data[0]
+	it.data = it.data[1:]
+	return next, nil
+}
+
+// recordingDataIter records the data of an L1 block, once all of it has been read successfully.
+type recordingDataIter struct {
+	src         DataIter
+	cache       *BatcherDataCache
+	ref         eth.L1BlockRef
+	batcherAddr common.Address
+	data        []hexutil.Bytes
+}
+
+func (it *recordingDataIter) Next(ctx context.Context) (eth.Data, error) {
+	data, err := it.src.Next(ctx)
+	if err == io.EOF {
+		if it.cache != nil {
+			it.cache.record(it.ref, it.batcherAddr, it.data)
+			it.cache = nil
+		}
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, err
+	}
+	it.data = append(it.data, data)
+	return data, nil
+}
@@ -0,0 +1,132 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubDataSource struct {
+	opened int
+	data   map[common.Hash][]eth.Data
+	err    error
+}
+
+func (s *stubDataSource) OpenData(_ context.Context, ref eth.L1BlockRef, _ common.Address) (DataIter, error) {
+	s.opened++
+	return &stubDataIter{data: s.data[ref.Hash], err: s.err}, nil
+}
+
+type stubDataIter struct {
+	data []eth.Data
+	err  error
+}
+
+func (it *stubDataIter) Next(_ context.Context) (eth.Data, error) {
+	if len(it.data) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+		return nil, io.EOF
+	}
+	next := it.data[0]
+	it.data = it.data[1:]
+	return next, nil
+}
+
+func readAll(t *testing.T, src DataAvailabilitySource, ref eth.L1BlockRef) ([]eth.Data, error) {
+	it, err := src.OpenData(context.Background(), ref, common.Address{0xaa})
+	require.NoError(t, err)
+	var out []eth.Data
+	for {
+		data, err := it.Next(context.Background())
+		if err == io.EOF {
+			return out, nil
+		} else if err != nil {
+			return out, err
+		}
+		out = append(out, data)
+	}
+}
+
+func TestBatcherDataCache(t *testing.T) {
+	cfg := &rollup.Config{SeqWindowSize: 10, ChannelTimeoutBedrock: 5}
+	logger := testlog.Logger(t, log.LevelInfo)
+	ref := func(num uint64) eth.L1BlockRef {
+		return eth.L1BlockRef{Hash: common.Hash{byte(num)}, Number: num}
+	}
+	data := map[common.Hash][]eth.Data{
+		ref(1).Hash: {{0x01}, {0x02}},
+	}
+
+	t.Run("RecordAndServe", func(t *testing.T) {
+		stub := &stubDataSource{data: data}
+		src := &cachedDataSource{src: stub, cache: NewBatcherDataCache(logger, cfg)}
+		out, err := readAll(t, src, ref(1))
+		require.NoError(t, err)
+		require.Equal(t, data[ref(1).Hash], out)
+
+		out, err = readAll(t, src, ref(1))
+		require.NoError(t, err)
+		require.Equal(t, data[ref(1).Hash], out)
+		require.Equal(t, 1, stub.opened, "second read should be served from the cache")
+	})
+
+	t.Run("DoNotRecordIncomplete", func(t *testing.T) {
+		stub := &stubDataSource{data: data, err: errors.New("boom")}
+		src := &cachedDataSource{src: stub, cache: NewBatcherDataCache(logger, cfg)}
+		_, err := readAll(t, src, ref(1))
+		require.ErrorContains(t, err, "boom")
+		_, err = readAll(t, src, ref(1))
+		require.ErrorContains(t, err, "boom")
+		require.Equal(t, 2, stub.opened)
+	})
+
+	t.Run("PruneOutsideWindow", func(t *testing.T) {
+		cache := NewBatcherDataCache(logger, cfg)
+		src := &cachedDataSource{src: &stubDataSource{}, cache: cache}
+		for i := uint64(1); i <= 20; i++ {
+			_, err := readAll(t, src, ref(i))
+			require.NoError(t, err)
+		}
+		blocks := cache.Export(eth.L1BlockRef{}, eth.L2BlockRef{}).Blocks
+		// window of 15 blocks before the latest block 20
+		require.Len(t, blocks, 16)
+		require.Equal(t, uint64(5), blocks[0].L1.Number)
+		require.Equal(t, uint64(20), blocks[len(blocks)-1].L1.Number)
+	})
+
+	t.Run("ExportAndRestore", func(t *testing.T) {
+		cache := NewBatcherDataCache(logger, cfg)
+		src := &cachedDataSource{src: &stubDataSource{data: data}, cache: cache}
+		_, err := readAll(t, src, ref(1))
+		require.NoError(t, err)
+		safeHead := eth.L2BlockRef{Hash: common.Hash{0xbb}, Number: 3, L1Origin: ref(1).ID()}
+		persisted := cache.Export(ref(1), safeHead)
+		require.Equal(t, ref(1), persisted.Origin)
+		require.Equal(t, safeHead, persisted.SafeHead)
+		require.Equal(t, []CachedBatcherData{{L1: ref(1).ID(), BatcherAddr: common.Address{0xaa}, Data: []hexutil.Bytes{{0x01}, {0x02}}}}, persisted.Blocks)
+
+		restored := NewBatcherDataCache(logger, cfg)
+		require.NoError(t, restored.Restore(persisted))
+		stub := &stubDataSource{}
+		out, err := readAll(t, &cachedDataSource{src: stub, cache: restored}, ref(1))
+		require.NoError(t, err)
+		require.Equal(t, data[ref(1).Hash], out)
+		require.Zero(t, stub.opened)
+
+		persisted.Version = 1
+		require.ErrorContains(t, NewBatcherDataCache(logger, cfg).Restore(persisted), "unsupported")
+	})
+}
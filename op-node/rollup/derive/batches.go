@@ -72,7 +72,7 @@ func checkSingularBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1Blo
 
 	nextTimestamp := l2SafeHead.Time + cfg.BlockTime
 	if batch.Timestamp > nextTimestamp {
-		if cfg.IsHolocene(l1InclusionBlock.Time) {
+		if cfg.RulesAt(l1InclusionBlock.Time).StrictBatchOrdering {
 			log.Warn("dropping future batch", "next_timestamp", nextTimestamp)
 			return BatchDrop
 		}
@@ -81,7 +81,7 @@ func checkSingularBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1Blo
 	}
 	if batch.Timestamp < nextTimestamp {
 		log.Warn("dropping past batch with old timestamp", "min_timestamp", nextTimestamp)
-		if cfg.IsHolocene(l1InclusionBlock.Time) {
+		if cfg.RulesAt(l1InclusionBlock.Time).StrictBatchOrdering {
 			return BatchPast
 		}
 		return BatchDrop
@@ -201,7 +201,7 @@ func checkSpanBatchPrefix(ctx context.Context, cfg *rollup.Config, log log.Logge
 		}
 		batchOrigin = l1Blocks[1]
 	}
-	if !cfg.IsDelta(batchOrigin.Time) {
+	if !cfg.RulesAt(batchOrigin.Time).SpanBatches {
 		log.Warn("received SpanBatch with L1 origin before Delta hard fork", "l1_origin", batchOrigin.ID(), "l1_origin_time", batchOrigin.Time)
 		return BatchDrop, eth.L2BlockRef{}
 	}
@@ -209,7 +209,7 @@ func checkSpanBatchPrefix(ctx context.Context, cfg *rollup.Config, log log.Logge
 	nextTimestamp := l2SafeHead.Time + cfg.BlockTime
 
 	if batch.GetTimestamp() > nextTimestamp {
-		if cfg.IsHolocene(l1InclusionBlock.Time) {
+		if cfg.RulesAt(l1InclusionBlock.Time).StrictBatchOrdering {
 			log.Warn("dropping future span batch", "next_timestamp", nextTimestamp)
 			return BatchDrop, eth.L2BlockRef{}
 		}
@@ -218,7 +218,7 @@ func checkSpanBatchPrefix(ctx context.Context, cfg *rollup.Config, log log.Logge
 	}
 	if batch.GetBlockTimestamp(batch.GetBlockCount()-1) < nextTimestamp {
 		log.Warn("span batch has no new blocks after safe head")
-		if cfg.IsHolocene(l1InclusionBlock.Time) {
+		if cfg.RulesAt(l1InclusionBlock.Time).StrictBatchOrdering {
 			return BatchPast, eth.L2BlockRef{}
 		}
 		return BatchDrop, eth.L2BlockRef{}
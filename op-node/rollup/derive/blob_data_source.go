@@ -23,7 +23,9 @@ type blobOrCalldata struct {
 // BlobDataSource fetches blobs or calldata as appropriate and transforms them into usable rollup
 // data.
 type BlobDataSource struct {
-	data         []blobOrCalldata
+	data []blobOrCalldata
+	// hashes are the blob hashes of the blob placeholders in data, until the blobs are fetched
+	hashes       []eth.IndexedBlobHash
 	ref          eth.L1BlockRef
 	batcherAddr  common.Address
 	dsCfg        DataSourceConfig
@@ -47,10 +49,16 @@ func NewBlobDataSource(ctx context.Context, log log.Logger, dsCfg DataSourceConf
 // Next returns the next piece of batcher data, or an io.EOF error if no data remains. It returns
 // ResetError if it cannot find the referenced block or a referenced blob, or TemporaryError for
 // any other failure to fetch a block or blob.
+//
+// The blobs are only fetched once the first blob is reached, so the calldata that precedes it is
+// returned even while the blobs source is unavailable. A failure to fetch the blobs is retried by
+// the next call. If the blobs fetcher buffers blob retrieval (see BufferedBlobsFetcher), the
+// calldata that follows the blobs is returned while the blobs cannot be fetched, and the blobs
+// are queued behind it.
 func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
 	if ds.data == nil {
 		var err error
-		if ds.data, err = ds.open(ctx); err != nil {
+		if ds.data, ds.hashes, err = ds.open(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -59,8 +67,18 @@ func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
 		return nil, io.EOF
 	}
 
-	next := ds.data[0]
-	ds.data = ds.data[1:]
+	i := 0
+	if ds.data[0].calldata == nil && ds.data[0].blob == nil {
+		if err := ds.fetchBlobs(ctx); err != nil {
+			if i = ds.calldataBehindBlobs(); i < 0 || !errors.Is(err, ErrTemporary) {
+				return nil, err
+			}
+			ds.log.Warn("Blobs not available yet, continuing with calldata queued behind them", "err", err)
+		}
+	}
+
+	next := ds.data[i]
+	ds.data = append(ds.data[:i], ds.data[i+1:]...)
 	if next.calldata != nil {
 		return *next.calldata, nil
 	}
@@ -74,42 +92,58 @@ func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
 }
 
 // open fetches and returns the blob or calldata (as appropriate) from all valid batcher
-// transactions in the referenced block. Returns an empty (non-nil) array if no batcher
-// transactions are found. It returns ResetError if it cannot find the referenced block or a
-// referenced blob, or TemporaryError for any other failure to fetch a block or blob.
-func (ds *BlobDataSource) open(ctx context.Context) ([]blobOrCalldata, error) {
+// transactions in the referenced block, with a placeholder for each blob, and the hashes of the
+// blobs to fetch. Returns an empty (non-nil) array if no batcher transactions are found. It
+// returns ResetError if it cannot find the referenced block, or TemporaryError for any other
+// failure to fetch the block.
+func (ds *BlobDataSource) open(ctx context.Context) ([]blobOrCalldata, []eth.IndexedBlobHash, error) {
 	_, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.ref.Hash)
 	if err != nil {
 		if errors.Is(err, ethereum.NotFound) {
-			return nil, NewResetError(fmt.Errorf("failed to open blob data source: %w", err))
+			return nil, nil, NewResetError(fmt.Errorf("failed to open blob data source: %w", err))
 		}
-		return nil, NewTemporaryError(fmt.Errorf("failed to open blob data source: %w", err))
+		return nil, nil, NewTemporaryError(fmt.Errorf("failed to open blob data source: %w", err))
 	}
 
 	data, hashes := dataAndHashesFromTxs(txs, &ds.dsCfg, ds.batcherAddr, ds.log)
+	return data, hashes, nil
+}
 
-	if len(hashes) == 0 {
-		// there are no blobs to fetch so we can return immediately
-		return data, nil
-	}
-
-	// download the actual blob bodies corresponding to the indexed blob hashes
-	blobs, err := ds.blobsFetcher.GetBlobs(ctx, ds.ref, hashes)
+// fetchBlobs downloads the blob bodies of the remaining blob placeholders. It returns ResetError
+// if it cannot find a referenced blob, or TemporaryError for any other failure to fetch the blobs.
+func (ds *BlobDataSource) fetchBlobs(ctx context.Context) error {
+	blobs, err := ds.blobsFetcher.GetBlobs(ctx, ds.ref, ds.hashes)
 	if errors.Is(err, ethereum.NotFound) {
 		// If the L1 block was available, then the blobs should be available too. The only
 		// exception is if the blob retention window has expired, which we will ultimately handle
 		// by failing over to a blob archival service.
-		return nil, NewResetError(fmt.Errorf("failed to fetch blobs: %w", err))
+		return NewResetError(fmt.Errorf("failed to fetch blobs: %w", err))
 	} else if err != nil {
-		return nil, NewTemporaryError(fmt.Errorf("failed to fetch blobs: %w", err))
+		return NewTemporaryError(fmt.Errorf("failed to fetch blobs: %w", err))
 	}
 
-	// go back over the data array and populate the blob pointers
-	if err := fillBlobPointers(data, blobs); err != nil {
+	// go back over the data array and populate the blob pointers.
+	// Only calldata was consumed, so all blob placeholders remain.
+	if err := fillBlobPointers(ds.data, blobs); err != nil {
 		// this shouldn't happen unless there is a bug in the blobs fetcher
-		return nil, NewResetError(fmt.Errorf("failed to fill blob pointers: %w", err))
+		return NewResetError(fmt.Errorf("failed to fill blob pointers: %w", err))
 	}
-	return data, nil
+	ds.hashes = nil
+	return nil
+}
+
+// calldataBehindBlobs returns the index of the first calldata that follows the unfetched blobs,
+// or -1 if there is none, or if the blobs fetcher does not buffer blob retrieval.
+func (ds *BlobDataSource) calldataBehindBlobs() int {
+	if _, ok := ds.blobsFetcher.(BlobsFetcherStatusProvider); !ok {
+		return -1
+	}
+	for i, d := range ds.data {
+		if d.calldata != nil {
+			return i
+		}
+	}
+	return -1
 }
 
 // dataAndHashesFromTxs extracts calldata and datahashes from the input transactions and returns them. It
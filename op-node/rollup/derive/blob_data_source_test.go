@@ -1,10 +1,14 @@
 package derive
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"errors"
+	"io"
 	"math/big"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,7 +16,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
 	"github.com/ethereum/go-ethereum/log"
@@ -138,3 +144,135 @@ func TestFillBlobPointers(t *testing.T) {
 		require.Equal(t, calldataLen, calldataCount)
 	}
 }
+
+// blobDataSourceTest is an L1 block with a blob batcher transaction between two calldata batcher transactions.
+type blobDataSourceTest struct {
+	config      DataSourceConfig
+	batcherAddr common.Address
+	ref         eth.L1BlockRef
+	firstTx     *types.Transaction
+	lastTx      *types.Transaction
+	txs         types.Transactions
+	blob        eth.Blob
+	blobData    eth.Data
+	hashes      []eth.IndexedBlobHash
+}
+
+func newBlobDataSourceTest(t *testing.T) *blobDataSourceTest {
+	rng := rand.New(rand.NewSource(1234))
+	privateKey := testutils.InsecureRandomKey(rng)
+	batchInboxAddr := testutils.RandomAddress(rng)
+	signer := types.NewCancunSigner(big.NewInt(901))
+
+	calldataTx := func() *types.Transaction {
+		tx, err := types.SignNewTx(privateKey, signer, &types.LegacyTx{
+			Nonce:    rng.Uint64(),
+			GasPrice: big.NewInt(1),
+			Gas:      2_000_000,
+			To:       &batchInboxAddr,
+			Data:     testutils.RandomData(rng, 100),
+		})
+		require.NoError(t, err)
+		return tx
+	}
+	test := &blobDataSourceTest{
+		config: DataSourceConfig{
+			l1Signer:          signer,
+			batchInboxAddress: batchInboxAddr,
+		},
+		batcherAddr: crypto.PubkeyToAddress(privateKey.PublicKey),
+		ref:         testutils.RandomBlockRef(rng),
+		firstTx:     calldataTx(),
+	}
+	blobHash := testutils.RandomHash(rng)
+	blobTx, err := types.SignNewTx(privateKey, signer, &types.BlobTx{
+		Nonce:      rng.Uint64(),
+		Gas:        2_000_000,
+		To:         batchInboxAddr,
+		BlobHashes: []common.Hash{blobHash},
+	})
+	require.NoError(t, err)
+	test.lastTx = calldataTx()
+	test.txs = types.Transactions{test.firstTx, blobTx, test.lastTx}
+	test.blobData = testutils.RandomData(rng, 100)
+	require.NoError(t, test.blob.FromData(test.blobData))
+	test.hashes = []eth.IndexedBlobHash{{Index: 0, Hash: blobHash}}
+	return test
+}
+
+func TestBlobDataSourceCalldataBeforeBlobs(t *testing.T) {
+	ctx := context.Background()
+	test := newBlobDataSourceTest(t)
+	rng := rand.New(rand.NewSource(1234))
+
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectInfoAndTxsByHash(test.ref.Hash, testutils.RandomBlockInfo(rng), test.txs, nil)
+	blobsF := &testutils.MockBlobsFetcher{}
+	src := NewBlobDataSource(ctx, testlog.Logger(t, log.LvlInfo), test.config, l1F, blobsF, test.ref, test.batcherAddr)
+
+	// The calldata before the blob is returned without fetching the blobs
+	data, err := src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(test.firstTx.Data()), data)
+	blobsF.AssertExpectations(t)
+
+	// A failure to fetch the blobs is temporary, and retried by the next call
+	blobsF.ExpectOnGetBlobs(ctx, test.ref, test.hashes, nil, errors.New("beacon down"))
+	_, err = src.Next(ctx)
+	require.ErrorIs(t, err, ErrTemporary)
+
+	blobsF.ExpectOnGetBlobs(ctx, test.ref, test.hashes, []*eth.Blob{&test.blob}, nil)
+	data, err = src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, test.blobData, data)
+
+	data, err = src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(test.lastTx.Data()), data)
+
+	_, err = src.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	l1F.AssertExpectations(t)
+	blobsF.AssertExpectations(t)
+}
+
+func TestBlobDataSourceCalldataBehindBlobs(t *testing.T) {
+	ctx := context.Background()
+	test := newBlobDataSourceTest(t)
+	rng := rand.New(rand.NewSource(1234))
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectInfoAndTxsByHash(test.ref.Hash, testutils.RandomBlockInfo(rng), test.txs, nil)
+	blobsF := &testutils.MockBlobsFetcher{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	buffered := NewBufferedBlobsFetcher(logger, blobsF, cl, &retry.FixedStrategy{Dur: 10 * time.Second})
+	src := NewBlobDataSource(ctx, logger, test.config, l1F, buffered, test.ref, test.batcherAddr)
+
+	data, err := src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(test.firstTx.Data()), data)
+
+	// The calldata after the blob is returned while the blobs cannot be fetched
+	blobsF.ExpectOnGetBlobs(ctx, test.ref, test.hashes, nil, errors.New("beacon down"))
+	data, err = src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(test.lastTx.Data()), data)
+	require.True(t, buffered.BlobsStatus().Degraded)
+
+	// Only the queued blob remains, which waits for the blobs source to recover
+	_, err = src.Next(ctx)
+	require.ErrorIs(t, err, ErrBlobsBackoff)
+	require.ErrorIs(t, err, ErrTemporary)
+
+	cl.AdvanceTime(10 * time.Second)
+	blobsF.ExpectOnGetBlobs(ctx, test.ref, test.hashes, []*eth.Blob{&test.blob}, nil)
+	data, err = src.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, test.blobData, data)
+
+	_, err = src.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	l1F.AssertExpectations(t)
+	blobsF.AssertExpectations(t)
+}
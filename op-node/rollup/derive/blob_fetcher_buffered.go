@@ -0,0 +1,147 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+// maxPendingBlobRefs bounds the number of L1 blocks that are queued for blob retrieval,
+// the oldest entries are dropped first when the queue overflows.
+const maxPendingBlobRefs = 256
+
+// ErrBlobsBackoff is returned when blob retrieval is skipped because the L1 blobs source
+// recently failed and is still backing off.
+var ErrBlobsBackoff = errors.New("L1 blobs source is backing off after failure")
+
+// BlobsFetcherStatus describes the health of the L1 blobs source.
+type BlobsFetcherStatus struct {
+	// Degraded is true while blob retrieval is failing and being retried with backoff.
+	Degraded bool
+	// OldestPending is the oldest L1 block whose blobs could not be retrieved yet.
+	// It is zeroed if no L1 blocks are waiting for blobs.
+	OldestPending eth.L1BlockRef
+	// Pending is the number of L1 blocks that are waiting for blobs.
+	Pending int
+}
+
+// BlobsFetcherStatusProvider is implemented by L1BlobsFetcher implementations that track
+// the health of the underlying blobs source.
+type BlobsFetcherStatusProvider interface {
+	BlobsStatus() BlobsFetcherStatus
+}
+
+// BufferedBlobsFetcher wraps a L1BlobsFetcher, such as the L1 Beacon API client,
+// to degrade gracefully when the blobs source is unavailable.
+//
+// Failures to retrieve blobs are retried with exponential backoff: while backing off,
+// requests fail fast with a temporary error instead of hitting the unavailable endpoint again.
+// The L1 blocks that require blobs are queued until their blobs are retrieved,
+// and the degraded status is exposed through BlobsStatus.
+// Calldata batches do not depend on the blobs source, so derivation continues through them
+// while the blobs source is degraded: the BlobDataSource returns the calldata of an L1 block
+// ahead of the blobs that cannot be retrieved yet, and queues the blobs behind it.
+type BufferedBlobsFetcher struct {
+	log      log.Logger
+	inner    L1BlobsFetcher
+	clock    clock.Clock
+	strategy retry.Strategy
+
+	mu          sync.Mutex
+	pending     []eth.L1BlockRef
+	failures    int
+	nextAttempt time.Time
+}
+
+var _ L1BlobsFetcher = (*BufferedBlobsFetcher)(nil)
+var _ BlobsFetcherStatusProvider = (*BufferedBlobsFetcher)(nil)
+
+func NewBufferedBlobsFetcher(log log.Logger, inner L1BlobsFetcher, cl clock.Clock, strategy retry.Strategy) *BufferedBlobsFetcher {
+	return &BufferedBlobsFetcher{
+		log:      log,
+		inner:    inner,
+		clock:    cl,
+		strategy: strategy,
+	}
+}
+
+func (f *BufferedBlobsFetcher) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	f.mu.Lock()
+	if now := f.clock.Now(); now.Before(f.nextAttempt) {
+		f.enqueue(ref)
+		retryIn := f.nextAttempt.Sub(now)
+		f.mu.Unlock()
+		return nil, fmt.Errorf("%w: retrying blobs of L1 block %s in %s", ErrBlobsBackoff, ref, retryIn)
+	}
+	f.mu.Unlock()
+
+	blobs, err := f.inner.GetBlobs(ctx, ref, hashes)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		f.enqueue(ref)
+		f.failures += 1
+		delay := f.strategy.Duration(f.failures - 1)
+		f.nextAttempt = f.clock.Now().Add(delay)
+		f.log.Warn("Failed to fetch blobs, backing off", "l1", ref, "failures", f.failures,
+			"pending", len(f.pending), "retry_in", delay, "err", err)
+		return nil, err
+	}
+	if f.failures > 0 {
+		f.log.Info("L1 blobs source recovered", "l1", ref, "failures", f.failures)
+	}
+	f.failures = 0
+	f.nextAttempt = time.Time{}
+	f.dequeue(ref)
+	return blobs, err
+}
+
+// enqueue adds the L1 block to the queue of blocks waiting for blobs, if it is not queued yet.
+// The caller must hold the lock.
+func (f *BufferedBlobsFetcher) enqueue(ref eth.L1BlockRef) {
+	for _, p := range f.pending {
+		if p == ref {
+			return
+		}
+	}
+	f.pending = append(f.pending, ref)
+	if len(f.pending) > maxPendingBlobRefs {
+		f.pending = f.pending[len(f.pending)-maxPendingBlobRefs:]
+	}
+}
+
+// dequeue removes the L1 block, and any L1 blocks at or below its height, from the queue.
+// Derivation traverses L1 in order, so older entries are either done or were reorged out.
+// The caller must hold the lock.
+func (f *BufferedBlobsFetcher) dequeue(ref eth.L1BlockRef) {
+	remaining := f.pending[:0]
+	for _, p := range f.pending {
+		if p.Number > ref.Number {
+			remaining = append(remaining, p)
+		}
+	}
+	f.pending = remaining
+}
+
+func (f *BufferedBlobsFetcher) BlobsStatus() BlobsFetcherStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	status := BlobsFetcherStatus{
+		Degraded: f.failures > 0,
+		Pending:  len(f.pending),
+	}
+	if len(f.pending) > 0 {
+		status.OldestPending = f.pending[0]
+	}
+	return status
+}
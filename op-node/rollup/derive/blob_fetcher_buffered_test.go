@@ -0,0 +1,99 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestBufferedBlobsFetcher(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	ctx := context.Background()
+	logger := testlog.Logger(t, log.LevelInfo)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	strategy := &retry.FixedStrategy{Dur: 10 * time.Second}
+
+	ref := testutils.RandomBlockRef(rng)
+	hashes := []eth.IndexedBlobHash{{Index: 0, Hash: testutils.RandomHash(rng)}}
+	blobs := []*eth.Blob{new(eth.Blob)}
+
+	t.Run("success", func(t *testing.T) {
+		inner := &testutils.MockBlobsFetcher{}
+		f := NewBufferedBlobsFetcher(logger, inner, cl, strategy)
+		inner.ExpectOnGetBlobs(ctx, ref, hashes, blobs, nil)
+		res, err := f.GetBlobs(ctx, ref, hashes)
+		require.NoError(t, err)
+		require.Equal(t, blobs, res)
+		require.Equal(t, BlobsFetcherStatus{}, f.BlobsStatus())
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("not found is not degraded", func(t *testing.T) {
+		inner := &testutils.MockBlobsFetcher{}
+		f := NewBufferedBlobsFetcher(logger, inner, cl, strategy)
+		inner.ExpectOnGetBlobs(ctx, ref, hashes, nil, ethereum.NotFound)
+		_, err := f.GetBlobs(ctx, ref, hashes)
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Equal(t, BlobsFetcherStatus{}, f.BlobsStatus())
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("backoff and recover", func(t *testing.T) {
+		inner := &testutils.MockBlobsFetcher{}
+		f := NewBufferedBlobsFetcher(logger, inner, cl, strategy)
+		errDown := errors.New("beacon down")
+		inner.ExpectOnGetBlobs(ctx, ref, hashes, nil, errDown)
+		_, err := f.GetBlobs(ctx, ref, hashes)
+		require.ErrorIs(t, err, errDown)
+		require.Equal(t, BlobsFetcherStatus{Degraded: true, OldestPending: ref, Pending: 1}, f.BlobsStatus())
+
+		// While backing off the inner fetcher is not called
+		_, err = f.GetBlobs(ctx, ref, hashes)
+		require.ErrorIs(t, err, ErrBlobsBackoff)
+		require.Equal(t, 1, f.BlobsStatus().Pending, "same block is queued once")
+		inner.AssertExpectations(t)
+
+		cl.AdvanceTime(10 * time.Second)
+		inner.ExpectOnGetBlobs(ctx, ref, hashes, blobs, nil)
+		res, err := f.GetBlobs(ctx, ref, hashes)
+		require.NoError(t, err)
+		require.Equal(t, blobs, res)
+		require.Equal(t, BlobsFetcherStatus{}, f.BlobsStatus())
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("dequeue older blocks", func(t *testing.T) {
+		inner := &testutils.MockBlobsFetcher{}
+		f := NewBufferedBlobsFetcher(logger, inner, cl, strategy)
+		next := testutils.NextRandomRef(rng, ref)
+		errDown := errors.New("beacon down")
+		inner.ExpectOnGetBlobs(ctx, ref, hashes, nil, errDown)
+		_, err := f.GetBlobs(ctx, ref, hashes)
+		require.ErrorIs(t, err, errDown)
+		cl.AdvanceTime(10 * time.Second)
+		inner.ExpectOnGetBlobs(ctx, next, hashes, nil, errDown)
+		_, err = f.GetBlobs(ctx, next, hashes)
+		require.ErrorIs(t, err, errDown)
+		require.Equal(t, BlobsFetcherStatus{Degraded: true, OldestPending: ref, Pending: 2}, f.BlobsStatus())
+
+		cl.AdvanceTime(10 * time.Second)
+		inner.ExpectOnGetBlobs(ctx, next, hashes, blobs, nil)
+		_, err = f.GetBlobs(ctx, next, hashes)
+		require.NoError(t, err)
+		require.Equal(t, BlobsFetcherStatus{}, f.BlobsStatus())
+		inner.AssertExpectations(t)
+	})
+}
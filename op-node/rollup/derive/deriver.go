@@ -48,6 +48,15 @@ func (d DeriverL1StatusEvent) String() string {
 	return "deriver-l1-status"
 }
 
+// L1BlobsStatusEvent is emitted when the health of the L1 blobs source changes.
+type L1BlobsStatusEvent struct {
+	Status BlobsFetcherStatus
+}
+
+func (d L1BlobsStatusEvent) String() string {
+	return "l1-blobs-status"
+}
+
 type DeriverMoreEvent struct{}
 
 func (d DeriverMoreEvent) String() string {
@@ -97,6 +106,31 @@ func (ev DepositsOnlyPayloadAttributesRequestEvent) String() string {
 	return "deposits-only-payload-attributes-request"
 }
 
+// PauseDerivationEvent requests the pipeline to stop deriving, until a ResumeDerivationEvent.
+// The pipeline halts at a stage boundary: a step that is already in progress completes first.
+type PauseDerivationEvent struct{}
+
+func (ev PauseDerivationEvent) String() string {
+	return "pause-derivation"
+}
+
+// ResumeDerivationEvent requests a paused pipeline to continue deriving.
+type ResumeDerivationEvent struct{}
+
+func (ev ResumeDerivationEvent) String() string {
+	return "resume-derivation"
+}
+
+// DerivationPausedEvent is emitted when the pipeline is paused or resumed.
+type DerivationPausedEvent struct {
+	Paused bool
+	Origin eth.L1BlockRef
+}
+
+func (ev DerivationPausedEvent) String() string {
+	return "derivation-paused"
+}
+
 type PipelineDeriver struct {
 	pipeline *DerivationPipeline
 
@@ -105,6 +139,11 @@ type PipelineDeriver struct {
 	emitter event.Emitter
 
 	needAttributesConfirmation bool
+
+	blobsStatus BlobsFetcherStatus
+
+	// paused is true while the pipeline is manually paused, and steps are ignored.
+	paused bool
 }
 
 func NewPipelineDeriver(ctx context.Context, pipeline *DerivationPipeline) *PipelineDeriver {
@@ -123,6 +162,10 @@ func (d *PipelineDeriver) OnEvent(ev event.Event) bool {
 	case rollup.ResetEvent:
 		d.pipeline.Reset()
 	case PipelineStepEvent:
+		if d.paused {
+			d.pipeline.log.Debug("Derivation pipeline is paused, ignoring step", "origin", d.pipeline.Origin())
+			return true
+		}
 		// Don't generate attributes if there are already attributes in-flight
 		if d.needAttributesConfirmation {
 			d.pipeline.log.Debug("Previously sent attributes are unconfirmed to be received")
@@ -135,6 +178,10 @@ func (d *PipelineDeriver) OnEvent(ev event.Event) bool {
 		if preOrigin != postOrigin {
 			d.emitter.Emit(DeriverL1StatusEvent{Origin: postOrigin, LastL2: x.PendingSafe})
 		}
+		if blobsStatus := d.pipeline.BlobsStatus(); blobsStatus != d.blobsStatus {
+			d.blobsStatus = blobsStatus
+			d.emitter.Emit(L1BlobsStatusEvent{Status: blobsStatus})
+		}
 		if err == io.EOF {
 			d.pipeline.log.Debug("Derivation process went idle", "progress", d.pipeline.Origin(), "err", err)
 			d.emitter.Emit(DeriverIdleEvent{Origin: d.pipeline.Origin()})
@@ -161,6 +208,19 @@ func (d *PipelineDeriver) OnEvent(ev event.Event) bool {
 				d.emitter.Emit(DeriverMoreEvent{}) // continue with the next step if we can
 			}
 		}
+	case PauseDerivationEvent:
+		if !d.paused {
+			d.paused = true
+			d.pipeline.log.Warn("Derivation pipeline paused", "origin", d.pipeline.Origin())
+			d.emitter.Emit(DerivationPausedEvent{Paused: true, Origin: d.pipeline.Origin()})
+		}
+	case ResumeDerivationEvent:
+		if d.paused {
+			d.paused = false
+			d.pipeline.log.Info("Derivation pipeline resumed", "origin", d.pipeline.Origin())
+			d.emitter.Emit(DerivationPausedEvent{Paused: false, Origin: d.pipeline.Origin()})
+			d.emitter.Emit(DeriverMoreEvent{}) // continue where the pipeline left off
+		}
 	case ConfirmPipelineResetEvent:
 		d.pipeline.ConfirmEngineReset()
 	case ConfirmReceivedAttributesEvent:
@@ -374,7 +374,7 @@ func L1InfoDeposit(rollupCfg *rollup.Config, sysCfg eth.SystemConfig, seqNumber
 	// With the regolith fork we disable the IsSystemTx functionality, and allocate real gas
 	if rollupCfg.IsRegolith(l2Timestamp) {
 		out.IsSystemTransaction = false
-		out.Gas = RegolithSystemTxGas
+		out.Gas = systemTxGas(rollupCfg)
 	}
 	return out, nil
 }
@@ -393,7 +393,23 @@ func L1InfoDepositBytes(rollupCfg *rollup.Config, sysCfg eth.SystemConfig, seqNu
 	return opaqueL1Tx, nil
 }
 
-func DepositsCompleteDeposit(seqNumber uint64, block eth.BlockInfo) (*types.DepositTx, error) {
+// systemTxGas returns the gas limit of the L1 attributes deposit after Regolith.
+func systemTxGas(rollupCfg *rollup.Config) uint64 {
+	if rollupCfg.DepositGasConfig != nil && rollupCfg.DepositGasConfig.SystemTxGas != 0 {
+		return rollupCfg.DepositGasConfig.SystemTxGas
+	}
+	return RegolithSystemTxGas
+}
+
+// depositsCompleteGas returns the gas limit of the DepositsComplete transaction.
+func depositsCompleteGas(rollupCfg *rollup.Config) uint64 {
+	if rollupCfg.DepositGasConfig != nil && rollupCfg.DepositGasConfig.DepositsCompleteGas != 0 {
+		return rollupCfg.DepositGasConfig.DepositsCompleteGas
+	}
+	return DepositsCompleteGas
+}
+
+func DepositsCompleteDeposit(rollupCfg *rollup.Config, seqNumber uint64, block eth.BlockInfo) (*types.DepositTx, error) {
 	source := AfterForceIncludeSource{
 		L1BlockHash: block.Hash(),
 		SeqNumber:   seqNumber,
@@ -404,15 +420,15 @@ func DepositsCompleteDeposit(seqNumber uint64, block eth.BlockInfo) (*types.Depo
 		To:                  &L1BlockAddress,
 		Mint:                nil,
 		Value:               big.NewInt(0),
-		Gas:                 DepositsCompleteGas,
+		Gas:                 depositsCompleteGas(rollupCfg),
 		IsSystemTransaction: false,
 		Data:                DepositsCompleteBytes4,
 	}
 	return out, nil
 }
 
-func DepositsCompleteBytes(seqNumber uint64, l1Info eth.BlockInfo) ([]byte, error) {
-	dep, err := DepositsCompleteDeposit(seqNumber, l1Info)
+func DepositsCompleteBytes(rollupCfg *rollup.Config, seqNumber uint64, l1Info eth.BlockInfo) ([]byte, error) {
+	dep, err := DepositsCompleteDeposit(rollupCfg, seqNumber, l1Info)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create DepositsComplete tx: %w", err)
 	}
@@ -117,6 +117,16 @@ func TestParseL1InfoDepositTxData(t *testing.T) {
 		require.False(t, depTx.IsSystemTransaction)
 		require.Equal(t, depTx.Gas, uint64(RegolithSystemTxGas))
 	})
+	t.Run("regolith system tx gas override", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1234))
+		info := testutils.MakeBlockInfo(nil)(rng)
+		rollupCfg := rollup.Config{DepositGasConfig: &rollup.DepositGasConfig{SystemTxGas: 2_000_000}}
+		rollupCfg.ActivateAtGenesis(rollup.Regolith)
+		depTx, err := L1InfoDeposit(&rollupCfg, randomL1Cfg(rng, info), randomSeqNr(rng), info, 0)
+		require.NoError(t, err)
+		require.False(t, depTx.IsSystemTransaction)
+		require.Equal(t, uint64(2_000_000), depTx.Gas)
+	})
 	t.Run("ecotone", func(t *testing.T) {
 		rng := rand.New(rand.NewSource(1234))
 		info := testutils.MakeBlockInfo(nil)(rng)
@@ -203,7 +213,7 @@ func TestDepositsCompleteBytes(t *testing.T) {
 	t.Run("valid return bytes", func(t *testing.T) {
 		rng := rand.New(rand.NewSource(1234))
 		info := testutils.MakeBlockInfo(nil)(rng)
-		depTxByes, err := DepositsCompleteBytes(randomSeqNr(rng), info)
+		depTxByes, err := DepositsCompleteBytes(&rollup.Config{}, randomSeqNr(rng), info)
 		require.NoError(t, err)
 		var depTx types.Transaction
 		require.NoError(t, depTx.UnmarshalBinary(depTxByes))
@@ -221,7 +231,7 @@ func TestDepositsCompleteBytes(t *testing.T) {
 	t.Run("valid return Transaction", func(t *testing.T) {
 		rng := rand.New(rand.NewSource(1234))
 		info := testutils.MakeBlockInfo(nil)(rng)
-		depTx, err := DepositsCompleteDeposit(randomSeqNr(rng), info)
+		depTx, err := DepositsCompleteDeposit(&rollup.Config{}, randomSeqNr(rng), info)
 		require.NoError(t, err)
 		require.Equal(t, depTx.Data, DepositsCompleteBytes4)
 		require.Equal(t, DepositsCompleteLen, len(depTx.Data))
@@ -230,4 +240,12 @@ func TestDepositsCompleteBytes(t *testing.T) {
 		require.Equal(t, depTx.Value, big.NewInt(0))
 		require.Equal(t, L1InfoDepositerAddress, depTx.From)
 	})
+	t.Run("gas override", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1234))
+		info := testutils.MakeBlockInfo(nil)(rng)
+		rollupCfg := &rollup.Config{DepositGasConfig: &rollup.DepositGasConfig{DepositsCompleteGas: 50_000}}
+		depTx, err := DepositsCompleteDeposit(rollupCfg, randomSeqNr(rng), info)
+		require.NoError(t, err)
+		require.Equal(t, uint64(50_000), depTx.Gas)
+	})
 }
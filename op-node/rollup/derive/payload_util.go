@@ -90,7 +90,7 @@ func PayloadToSystemConfig(rollupCfg *rollup.Config, payload *eth.ExecutionPaylo
 		Scalar:      info.L1FeeScalar,
 		GasLimit:    uint64(payload.GasLimit),
 	}
-	if rollupCfg.IsHolocene(uint64(payload.Timestamp)) {
+	if rollupCfg.RulesAt(uint64(payload.Timestamp)).EIP1559Params {
 		if err := eip1559.ValidateHoloceneExtraData(payload.ExtraData); err != nil {
 			return eth.SystemConfig{}, err
 		}
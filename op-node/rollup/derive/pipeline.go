@@ -85,6 +85,12 @@ type DerivationPipeline struct {
 
 	attrib *AttributesQueue
 
+	// blobsStatus reports the health of the L1 blobs source, nil if the source does not track it
+	blobsStatus BlobsFetcherStatusProvider
+
+	// witness records the data consumed per derived block, nil if the witness mode is disabled
+	witness *WitnessRecorder
+
 	// L1 block that the next returned attributes are derived from, i.e. at the L2-end of the pipeline.
 	origin         eth.L1BlockRef
 	resetL2Safe    eth.L2BlockRef
@@ -95,8 +101,10 @@ type DerivationPipeline struct {
 }
 
 // NewDerivationPipeline creates a DerivationPipeline, to turn L1 data into L2 block-inputs.
+// If a batcher data cache is provided, the batcher data of L1 blocks is served from and recorded to it.
 func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L1Fetcher, l1Blobs L1BlobsFetcher,
-	altDA AltDAInputFetcher, l2Source L2Source, metrics Metrics, managedMode bool,
+	altDA AltDAInputFetcher, l2Source L2Source, metrics Metrics, managedMode bool, cache *BatcherDataCache,
+	witness *WitnessRecorder,
 ) *DerivationPipeline {
 	spec := rollup.NewChainSpec(rollupCfg)
 	// Stages are strung together into a pipeline,
@@ -107,13 +115,23 @@ func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L
 	} else {
 		l1Traversal = NewL1Traversal(log, rollupCfg, l1Fetcher)
 	}
-	dataSrc := NewDataSourceFactory(log, rollupCfg, l1Fetcher, l1Blobs, altDA) // auxiliary stage for L1Retrieval
+	var dataSrc DataAvailabilitySource = NewDataSourceFactory(log, rollupCfg, l1Fetcher, l1Blobs, altDA) // auxiliary stage for L1Retrieval
+	if cache != nil {
+		dataSrc = &cachedDataSource{src: dataSrc, cache: cache}
+	}
+	var l1ReceiptsSrc L1ReceiptsFetcher = l1Fetcher
+	var sysCfgSrc SystemConfigL2Fetcher = l2Source
+	if witness != nil {
+		dataSrc = &witnessDataSource{src: dataSrc, recorder: witness}
+		l1ReceiptsSrc = &witnessL1Fetcher{L1ReceiptsFetcher: l1Fetcher, recorder: witness}
+		sysCfgSrc = &witnessL2Fetcher{SystemConfigL2Fetcher: l2Source, recorder: witness}
+	}
 	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
 	frameQueue := NewFrameQueue(log, rollupCfg, l1Src)
 	channelMux := NewChannelMux(log, spec, frameQueue, metrics)
 	chInReader := NewChannelInReader(rollupCfg, log, channelMux, metrics)
 	batchMux := NewBatchMux(log, rollupCfg, chInReader, l2Source)
-	attrBuilder := NewFetchingAttributesBuilder(rollupCfg, l1Fetcher, l2Source)
+	attrBuilder := NewFetchingAttributesBuilder(rollupCfg, l1ReceiptsSrc, sysCfgSrc)
 	attributesQueue := NewAttributesQueue(log, rollupCfg, attrBuilder, batchMux)
 
 	// Reset from ResetEngine then up from L1 Traversal. The stages do not talk to each other during
@@ -121,6 +139,8 @@ func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L
 	// Note: The ResetEngine is the only reset that can fail.
 	stages := []ResettableStage{l1Traversal, l1Src, altDA, frameQueue, channelMux, chInReader, batchMux, attributesQueue}
 
+	blobsStatus, _ := l1Blobs.(BlobsFetcherStatusProvider)
+
 	return &DerivationPipeline{
 		log:       log,
 		rollupCfg: rollupCfg,
@@ -132,6 +152,9 @@ func NewDerivationPipeline(log log.Logger, rollupCfg *rollup.Config, l1Fetcher L
 		traversal: l1Traversal,
 		attrib:    attributesQueue,
 		l2:        l2Source,
+		witness:   witness,
+
+		blobsStatus: blobsStatus,
 	}
 }
 
@@ -146,6 +169,9 @@ func (dp *DerivationPipeline) Reset() {
 	dp.resetSysConfig = eth.SystemConfig{}
 	dp.resetL2Safe = eth.L2BlockRef{}
 	dp.engineIsReset = false
+	if dp.witness != nil {
+		dp.witness.reset()
+	}
 }
 
 func (dp *DerivationPipeline) DepositsOnlyAttributes(parent eth.BlockID, derivedFrom eth.L1BlockRef) (*AttributesWithParent, error) {
@@ -158,6 +184,15 @@ func (dp *DerivationPipeline) Origin() eth.L1BlockRef {
 	return dp.origin
 }
 
+// BlobsStatus returns the health of the L1 blobs source.
+// A zero status is returned if the blobs source does not track its health.
+func (dp *DerivationPipeline) BlobsStatus() BlobsFetcherStatus {
+	if dp.blobsStatus == nil {
+		return BlobsFetcherStatus{}
+	}
+	return dp.blobsStatus.BlobsStatus()
+}
+
 // Step tries to progress the buffer.
 // An EOF is returned if the pipeline is blocked by waiting for new L1 data.
 // If ctx errors no error is returned, but the step may exit early in a state that can still be continued.
@@ -212,6 +247,9 @@ func (dp *DerivationPipeline) Step(ctx context.Context, pendingSafeHead eth.L2Bl
 	}
 
 	if attrib, err := dp.attrib.NextAttributes(ctx, pendingSafeHead); err == nil {
+		if dp.witness != nil {
+			dp.witness.complete(attrib)
+		}
 		return attrib, nil
 	} else if err == io.EOF {
 		// If every stage has returned io.EOF, try to advance the L1 Origin
@@ -0,0 +1,211 @@
+package derive
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// BlockWitnessVersion is the version of the BlockWitness format.
+const BlockWitnessVersion = 1
+
+// BlockWitness is the data that the derivation of the payload attributes of a single L2 block consumed.
+// It is intended as input of external validity provers, that re-derive the block from the witness alone.
+//
+// The format is canonical: all entries are in the order they were consumed by the pipeline,
+// and L1 headers and receipts use their consensus encoding.
+// Batcher data is attributed to the first block derived after it was read. A channel may span multiple L1 blocks,
+// and a batch may be derived from data that was read for earlier blocks, so the witnesses of consecutive blocks
+// since the last pipeline reset together contain all batcher data the blocks were derived from.
+type BlockWitness struct {
+	Version uint64 `json:"version"`
+	// Parent is the L2 block the attributes were derived on top of.
+	Parent    eth.BlockID    `json:"parent"`
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+	// L1Origin is the L1 origin of the derived block, DerivedFrom the L1 block the block was derived from.
+	L1Origin    eth.BlockID `json:"l1Origin"`
+	DerivedFrom eth.BlockID `json:"derivedFrom"`
+	// L1Data is the batcher data read from L1.
+	L1Data []WitnessL1Data `json:"l1Data"`
+	// L1Headers and L1Receipts are the L1 data read to build the attributes, i.e. the L1 info and deposits.
+	L1Headers  []hexutil.Bytes     `json:"l1Headers"`
+	L1Receipts []WitnessL1Receipts `json:"l1Receipts"`
+	// L2SystemConfigs are the L2 state accesses: the system configs read from the L2 blocks.
+	L2SystemConfigs []WitnessSystemConfig `json:"l2SystemConfigs"`
+}
+
+// WitnessL1Data is a batcher data item, e.g. the calldata of a batcher transaction or a blob, of an L1 block.
+type WitnessL1Data struct {
+	L1   eth.BlockID   `json:"l1"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// WitnessL1Receipts are the consensus encoded receipts of an L1 block.
+type WitnessL1Receipts struct {
+	BlockHash common.Hash     `json:"blockHash"`
+	Receipts  []hexutil.Bytes `json:"receipts"`
+}
+
+// WitnessSystemConfig is the system config of an L2 block.
+type WitnessSystemConfig struct {
+	L2           common.Hash      `json:"l2"`
+	SystemConfig eth.SystemConfig `json:"systemConfig"`
+}
+
+// WitnessSink receives the witnesses of the derived blocks.
+type WitnessSink interface {
+	WriteWitness(w *BlockWitness) error
+}
+
+// WitnessRecorder records the data consumed by the derivation pipeline into a BlockWitness per derived block.
+// The recorder only observes the pipeline, it never changes the derived attributes.
+type WitnessRecorder struct {
+	log  log.Logger
+	sink WitnessSink
+
+	mu      sync.Mutex
+	pending BlockWitness
+}
+
+func NewWitnessRecorder(log log.Logger, sink WitnessSink) *WitnessRecorder {
+	return &WitnessRecorder{log: log, sink: sink}
+}
+
+// reset drops the pending data, as the pipeline reads all data again after a reset.
+func (r *WitnessRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = BlockWitness{}
+}
+
+func (r *WitnessRecorder) recordL1Data(ref eth.L1BlockRef, data eth.Data) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending.L1Data = append(r.pending.L1Data, WitnessL1Data{L1: ref.ID(), Data: hexutil.Bytes(data)})
+}
+
+func (r *WitnessRecorder) recordL1Header(info eth.BlockInfo) {
+	header, err := info.HeaderRLP()
+	if err != nil {
+		r.log.Error("Failed to encode L1 header for witness", "hash", info.Hash(), "err", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending.L1Headers = append(r.pending.L1Headers, header)
+	// The attributes builder reads the header of the L1 origin last
+	r.pending.L1Origin = eth.ToBlockID(info)
+}
+
+func (r *WitnessRecorder) recordL1Receipts(blockHash common.Hash, receipts types.Receipts) {
+	encoded := make([]hexutil.Bytes, len(receipts))
+	for i, rcpt := range receipts {
+		data, err := rcpt.MarshalBinary()
+		if err != nil {
+			r.log.Error("Failed to encode L1 receipt for witness", "block", blockHash, "index", i, "err", err)
+		}
+		encoded[i] = data
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending.L1Receipts = append(r.pending.L1Receipts, WitnessL1Receipts{BlockHash: blockHash, Receipts: encoded})
+}
+
+func (r *WitnessRecorder) recordSystemConfig(l2 common.Hash, sysCfg eth.SystemConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending.L2SystemConfigs = append(r.pending.L2SystemConfigs, WitnessSystemConfig{L2: l2, SystemConfig: sysCfg})
+}
+
+// complete writes the witness of the derived attributes, with the data recorded since the previous attributes.
+// Failing to write a witness is not fatal to the derivation, the derivation remains the source of truth.
+func (r *WitnessRecorder) complete(attrib *AttributesWithParent) {
+	r.mu.Lock()
+	witness := r.pending
+	r.pending = BlockWitness{}
+	r.mu.Unlock()
+
+	witness.Version = BlockWitnessVersion
+	witness.Parent = attrib.Parent.ID()
+	witness.Timestamp = attrib.Attributes.Timestamp
+	witness.DerivedFrom = attrib.DerivedFrom.ID()
+	if err := r.sink.WriteWitness(&witness); err != nil {
+		r.log.Error("Failed to write block witness", "parent", witness.Parent, "timestamp", witness.Timestamp, "err", err)
+	}
+}
+
+// witnessDataSource records the batcher data read from the underlying source.
+type witnessDataSource struct {
+	src      DataAvailabilitySource
+	recorder *WitnessRecorder
+}
+
+func (s *witnessDataSource) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) (DataIter, error) {
+	src, err := s.src.OpenData(ctx, ref, batcherAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &witnessDataIter{src: src, recorder: s.recorder, ref: ref}, nil
+}
+
+type witnessDataIter struct {
+	src      DataIter
+	recorder *WitnessRecorder
+	ref      eth.L1BlockRef
+}
+
+func (it *witnessDataIter) Next(ctx context.Context) (eth.Data, error) {
+	data, err := it.src.Next(ctx)
+	if err == nil {
+		it.recorder.recordL1Data(it.ref, data)
+	} else if err != io.EOF {
+		return nil, err
+	}
+	return data, err
+}
+
+// witnessL1Fetcher records the L1 headers and receipts read by the attributes builder.
+type witnessL1Fetcher struct {
+	L1ReceiptsFetcher
+	recorder *WitnessRecorder
+}
+
+func (f *witnessL1Fetcher) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	info, err := f.L1ReceiptsFetcher.InfoByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	f.recorder.recordL1Header(info)
+	return info, nil
+}
+
+func (f *witnessL1Fetcher) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	info, receipts, err := f.L1ReceiptsFetcher.FetchReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	f.recorder.recordL1Header(info)
+	f.recorder.recordL1Receipts(blockHash, receipts)
+	return info, receipts, nil
+}
+
+// witnessL2Fetcher records the system configs read by the attributes builder.
+type witnessL2Fetcher struct {
+	SystemConfigL2Fetcher
+	recorder *WitnessRecorder
+}
+
+func (f *witnessL2Fetcher) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	sysCfg, err := f.SystemConfigL2Fetcher.SystemConfigByL2Hash(ctx, hash)
+	if err != nil {
+		return eth.SystemConfig{}, err
+	}
+	f.recorder.recordSystemConfig(hash, sysCfg)
+	return sysCfg, nil
+}
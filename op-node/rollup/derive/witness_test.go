@@ -0,0 +1,119 @@
+package derive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type witnessCollector struct {
+	witnesses []*BlockWitness
+}
+
+func (c *witnessCollector) WriteWitness(w *BlockWitness) error {
+	c.witnesses = append(c.witnesses, w)
+	return nil
+}
+
+type stubReceiptsFetcher struct {
+	info     eth.BlockInfo
+	receipts types.Receipts
+}
+
+func (s *stubReceiptsFetcher) InfoByHash(_ context.Context, _ common.Hash) (eth.BlockInfo, error) {
+	return s.info, nil
+}
+
+func (s *stubReceiptsFetcher) FetchReceipts(_ context.Context, _ common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	return s.info, s.receipts, nil
+}
+
+type stubSystemConfigFetcher struct {
+	sysCfg eth.SystemConfig
+}
+
+func (s *stubSystemConfigFetcher) SystemConfigByL2Hash(_ context.Context, _ common.Hash) (eth.SystemConfig, error) {
+	return s.sysCfg, nil
+}
+
+func TestWitnessRecorder(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	l1Ref := eth.L1BlockRef{Hash: common.Hash{0x01}, Number: 1}
+	info := &testutils.MockBlockInfo{InfoHash: common.Hash{0x02}, InfoNum: 2, InfoHeaderRLP: []byte{0xc0}}
+	receipt := &types.Receipt{Type: types.DynamicFeeTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21000}
+	receiptData, err := receipt.MarshalBinary()
+	require.NoError(t, err)
+	sysCfg := eth.SystemConfig{BatcherAddr: common.Address{0xaa}, GasLimit: 30_000_000}
+	attrib := &AttributesWithParent{
+		Attributes:  &eth.PayloadAttributes{Timestamp: 100},
+		Parent:      eth.L2BlockRef{Hash: common.Hash{0x03}, Number: 10},
+		DerivedFrom: l1Ref,
+	}
+
+	setup := func() (*witnessCollector, *WitnessRecorder, DataAvailabilitySource, L1ReceiptsFetcher, SystemConfigL2Fetcher) {
+		sink := &witnessCollector{}
+		recorder := NewWitnessRecorder(logger, sink)
+		dataSrc := &witnessDataSource{
+			src:      &stubDataSource{data: map[common.Hash][]eth.Data{l1Ref.Hash: {{0x0a}, {0x0b}}}},
+			recorder: recorder,
+		}
+		l1 := &witnessL1Fetcher{L1ReceiptsFetcher: &stubReceiptsFetcher{info: info, receipts: types.Receipts{receipt}}, recorder: recorder}
+		l2 := &witnessL2Fetcher{SystemConfigL2Fetcher: &stubSystemConfigFetcher{sysCfg: sysCfg}, recorder: recorder}
+		return sink, recorder, dataSrc, l1, l2
+	}
+
+	t.Run("RecordConsumedData", func(t *testing.T) {
+		sink, recorder, dataSrc, l1, l2 := setup()
+		_, err := readAll(t, dataSrc, l1Ref)
+		require.NoError(t, err)
+		_, err = l2.SystemConfigByL2Hash(context.Background(), attrib.Parent.Hash)
+		require.NoError(t, err)
+		_, _, err = l1.FetchReceipts(context.Background(), info.Hash())
+		require.NoError(t, err)
+		recorder.complete(attrib)
+
+		require.Len(t, sink.witnesses, 1)
+		require.Equal(t, &BlockWitness{
+			Version:     BlockWitnessVersion,
+			Parent:      attrib.Parent.ID(),
+			Timestamp:   100,
+			L1Origin:    eth.ToBlockID(info),
+			DerivedFrom: l1Ref.ID(),
+			L1Data:      []WitnessL1Data{{L1: l1Ref.ID(), Data: []byte{0x0a}}, {L1: l1Ref.ID(), Data: []byte{0x0b}}},
+			L1Headers:   []hexutil.Bytes{{0xc0}},
+			L1Receipts:  []WitnessL1Receipts{{BlockHash: info.Hash(), Receipts: []hexutil.Bytes{receiptData}}},
+			L2SystemConfigs: []WitnessSystemConfig{
+				{L2: attrib.Parent.Hash, SystemConfig: sysCfg},
+			},
+		}, sink.witnesses[0])
+
+		// The next witness only contains the data consumed after the previous one
+		_, err = l1.InfoByHash(context.Background(), info.Hash())
+		require.NoError(t, err)
+		recorder.complete(attrib)
+		require.Len(t, sink.witnesses, 2)
+		require.Empty(t, sink.witnesses[1].L1Data)
+		require.Empty(t, sink.witnesses[1].L1Receipts)
+		require.Equal(t, []hexutil.Bytes{{0xc0}}, sink.witnesses[1].L1Headers)
+	})
+
+	t.Run("ResetDropsPendingData", func(t *testing.T) {
+		sink, recorder, dataSrc, _, _ := setup()
+		_, err := readAll(t, dataSrc, l1Ref)
+		require.NoError(t, err)
+		recorder.reset()
+		recorder.complete(attrib)
+		require.Len(t, sink.witnesses, 1)
+		require.Empty(t, sink.witnesses[0].L1Data)
+	})
+}
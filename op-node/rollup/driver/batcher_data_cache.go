@@ -0,0 +1,55 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/engine"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+)
+
+// loadBatcherDataCache creates the batcher data cache of the derivation pipeline,
+// restoring the cache persisted at the given path, if any, and returns the point to resume the pipeline from.
+// A missing or invalid cache is not fatal: the pipeline then fetches all data from L1,
+// starting from the safe head found by the engine reset.
+func loadBatcherDataCache(log log.Logger, cfg *rollup.Config, path string) (*derive.BatcherDataCache, *engine.ResumePoint) {
+	cache := derive.NewBatcherDataCache(log, cfg)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		log.Info("No batcher data cache to restore", "path", path)
+		return cache, nil
+	}
+	persisted, err := jsonutil.LoadJSON[derive.PersistedBatcherData](path)
+	if err != nil {
+		log.Warn("Failed to load batcher data cache", "path", path, "err", err)
+		return cache, nil
+	}
+	if err := cache.Restore(persisted); err != nil {
+		log.Warn("Failed to restore batcher data cache", "path", path, "err", err)
+		return cache, nil
+	}
+	if persisted.SafeHead == (eth.L2BlockRef{}) {
+		return cache, nil
+	}
+	return cache, &engine.ResumePoint{Origin: persisted.Origin, SafeHead: persisted.SafeHead}
+}
+
+// saveBatcherDataCache persists the batcher data cache of the derivation pipeline.
+func (s *Driver) saveBatcherDataCache() error {
+	if s.batcherDataCache == nil {
+		return nil
+	}
+	path := s.driverConfig.BatcherDataCachePath
+	persisted := s.batcherDataCache.Export(s.SyncDeriver.Derivation.Origin(), s.SyncDeriver.Engine.SafeL2Head())
+	if err := jsonutil.WriteJSON(persisted, ioutil.ToAtomicFile(path, 0o644)); err != nil {
+		return fmt.Errorf("failed to write batcher data cache: %w", err)
+	}
+	s.log.Info("Saved batcher data cache", "path", path, "origin", persisted.Origin, "safe", persisted.SafeHead, "blocks", len(persisted.Blocks))
+	return nil
+}
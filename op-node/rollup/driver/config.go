@@ -20,4 +20,12 @@ type Config struct {
 	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// BatcherDataCachePath is the file the batcher data cache of the derivation pipeline and the safe head
+	// are persisted to on shutdown, and restored from on start. Disabled if empty.
+	BatcherDataCachePath string `json:"batcher_data_cache_path"`
+
+	// WitnessDir is the directory the witnesses of the derived blocks are written to. Disabled if empty.
+	// Experimental: the witness format may change.
+	WitnessDir string `json:"witness_dir"`
 }
@@ -108,7 +108,7 @@ type Finalizer interface {
 
 type AltDAIface interface {
 	// Notify L1 finalized head so AltDA finality is always behind L1
-	Finalize(ref eth.L1BlockRef)
+	Finalize(ctx context.Context, l1 altda.L1BlockRefFetcher, ref eth.L1BlockRef) error
 	// Set the engine finalization signal callback
 	OnFinalizedHeadSignal(f altda.HeadSignalFn)
 
@@ -190,8 +190,8 @@ func NewDriver(
 	ec := engine.NewEngineController(l2, log, metrics, cfg, syncCfg,
 		sys.Register("engine-controller", nil, opts))
 
-	sys.Register("engine-reset",
-		engine.NewEngineResetDeriver(driverCtx, log, cfg, l1, l2, syncCfg), opts)
+	engineReset := engine.NewEngineResetDeriver(driverCtx, log, cfg, l1, l2, syncCfg)
+	sys.Register("engine-reset", engineReset, opts)
 
 	clSync := clsync.NewCLSync(log, cfg, metrics) // alt-sync still uses cl-sync state to determine what to sync to
 	sys.Register("cl-sync", clSync, opts)
@@ -207,7 +207,29 @@ func NewDriver(
 	sys.Register("attributes-handler",
 		attributes.NewAttributesHandler(log, cfg, driverCtx, l2), opts)
 
-	derivationPipeline := derive.NewDerivationPipeline(log, cfg, verifConfDepth, l1Blobs, altDA, l2, metrics, managedMode)
+	// The batcher data cache is not used with alt-DA, as resolving alt-DA inputs depends on the challenge state.
+	// In managed mode the supervisor determines the safe head to reset to, so the pipeline does not resume from a persisted one.
+	var batcherDataCache *derive.BatcherDataCache
+	if driverCfg.BatcherDataCachePath != "" && !cfg.AltDAEnabled() {
+		var resume *engine.ResumePoint
+		batcherDataCache, resume = loadBatcherDataCache(log, cfg, driverCfg.BatcherDataCachePath)
+		if resume != nil && !managedMode {
+			engineReset.ResumeFrom(resume)
+		}
+	}
+	var witnessSink *witnessFileSink
+	var witness *derive.WitnessRecorder
+	if driverCfg.WitnessDir != "" {
+		sink, err := openWitnessSink(driverCfg.WitnessDir)
+		if err != nil {
+			log.Error("Failed to open witness directory, not recording block witnesses", "dir", driverCfg.WitnessDir, "err", err)
+		} else {
+			log.Warn("Recording block witnesses, this is an experimental feature", "dir", driverCfg.WitnessDir)
+			witnessSink = sink
+			witness = derive.NewWitnessRecorder(log, sink)
+		}
+	}
+	derivationPipeline := derive.NewDerivationPipeline(log, cfg, verifConfDepth, l1Blobs, altDA, l2, metrics, managedMode, batcherDataCache, witness)
 
 	sys.Register("pipeline",
 		derive.NewPipelineDeriver(driverCtx, derivationPipeline), opts)
@@ -256,6 +278,8 @@ func NewDriver(
 		drain:            drain.Drain,
 		stateReq:         make(chan chan struct{}),
 		forceReset:       make(chan chan struct{}, 10),
+		pauseDerivation:  make(chan chan struct{}),
+		resumeDerivation: make(chan chan struct{}),
 		driverConfig:     driverCfg,
 		driverCtx:        driverCtx,
 		driverCancel:     driverCancel,
@@ -268,6 +292,8 @@ func NewDriver(
 		l1FinalizedSig:   make(chan eth.L1BlockRef, 10),
 		unsafeL2Payloads: make(chan *eth.ExecutionPayloadEnvelope, 10),
 		altSync:          altSync,
+		batcherDataCache: batcherDataCache,
+		witnessSink:      witnessSink,
 	}
 
 	return driver
@@ -42,6 +42,11 @@ type Driver struct {
 	// It tells the caller that the reset occurred by closing the passed in channel.
 	forceReset chan chan struct{}
 
+	// Upon receiving a channel in these channels, the derivation pipeline is paused or resumed.
+	// It tells the caller that the request was processed by closing the passed in channel.
+	pauseDerivation  chan chan struct{}
+	resumeDerivation chan chan struct{}
+
 	// Driver config: verifier and sequencer settings.
 	// May not be modified after starting the Driver.
 	driverConfig *Config
@@ -69,6 +74,12 @@ type Driver struct {
 	metrics Metrics
 	log     log.Logger
 
+	// batcherDataCache is the batcher data cache of the derivation pipeline, persisted on close. Nil if disabled.
+	batcherDataCache *derive.BatcherDataCache
+
+	// witnessSink is the sink of the block witnesses, closed on close. Nil if disabled.
+	witnessSink *witnessFileSink
+
 	wg gosync.WaitGroup
 
 	driverCtx    context.Context
@@ -89,6 +100,8 @@ func (s *Driver) Start() error {
 		}
 	}
 
+	s.emitter.Emit(engine.SyncModeEvent{Mode: s.SyncCfg.SyncMode})
+
 	s.wg.Add(1)
 	go s.eventLoop()
 
@@ -99,7 +112,12 @@ func (s *Driver) Close() error {
 	s.driverCancel()
 	s.wg.Wait()
 	s.sequencer.Close()
-	return nil
+	if s.witnessSink != nil {
+		if err := s.witnessSink.Close(); err != nil {
+			s.log.Error("Failed to close witness sink", "err", err)
+		}
+	}
+	return s.saveBatcherDataCache()
 }
 
 // OnL1Head signals the driver that the L1 chain changed the "unsafe" block,
@@ -273,6 +291,14 @@ func (s *Driver) eventLoop() {
 			s.Derivation.Reset()
 			s.metrics.RecordPipelineReset()
 			close(respCh)
+		case respCh := <-s.pauseDerivation:
+			s.log.Warn("Derivation pipeline is manually paused")
+			s.emitter.Emit(derive.PauseDerivationEvent{})
+			close(respCh)
+		case respCh := <-s.resumeDerivation:
+			s.log.Info("Derivation pipeline is manually resumed")
+			s.emitter.Emit(derive.ResumeDerivationEvent{})
+			close(respCh)
 		case <-s.driverCtx.Done():
 			return
 		}
@@ -466,11 +492,28 @@ func (s *SyncDeriver) SyncStep() {
 // It waits for the reset to occur. It simply unblocks the caller rather
 // than fully cancelling the reset request upon a context cancellation.
 func (s *Driver) ResetDerivationPipeline(ctx context.Context) error {
+	return s.requestDerivationChange(ctx, s.forceReset)
+}
+
+// PauseDerivation halts the derivation pipeline at the next stage boundary, until ResumeDerivation is called.
+// Unsafe L2 payloads, e.g. from gossip, are still processed while derivation is paused.
+// The paused state is reported in the sync status.
+func (s *Driver) PauseDerivation(ctx context.Context) error {
+	return s.requestDerivationChange(ctx, s.pauseDerivation)
+}
+
+// ResumeDerivation continues derivation after it was paused with PauseDerivation.
+func (s *Driver) ResumeDerivation(ctx context.Context) error {
+	return s.requestDerivationChange(ctx, s.resumeDerivation)
+}
+
+// requestDerivationChange sends a request to the event loop, and waits for the event loop to process it.
+func (s *Driver) requestDerivationChange(ctx context.Context, reqCh chan chan struct{}) error {
 	respCh := make(chan struct{}, 1)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case s.forceReset <- respCh:
+	case reqCh <- respCh:
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -492,6 +535,17 @@ func (s *Driver) SequencerActive(ctx context.Context) (bool, error) {
 	return s.sequencer.Active(), nil
 }
 
+// SetSequencerTxFeed makes the sequencer build blocks from the transactions of the feed,
+// instead of the mempool of the execution engine.
+func (s *Driver) SetSequencerTxFeed(feed sequencing.TxFeed) error {
+	seq, ok := s.sequencer.(*sequencing.Sequencer)
+	if !ok {
+		return sequencing.ErrSequencerNotEnabled
+	}
+	seq.SetTxFeed(feed)
+	return nil
+}
+
 func (s *Driver) OverrideLeader(ctx context.Context) error {
 	return s.sequencer.OverrideLeader(ctx)
 }
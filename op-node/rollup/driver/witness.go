@@ -0,0 +1,46 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+// witnessSegmentSize is the size of the active witness file after which it is rotated into a compressed segment.
+const witnessSegmentSize = 256 * 1024 * 1024
+
+// witnessFileSink writes the block witnesses as JSON lines, rotated into checksummed, compressed segments.
+// Segments are never removed by the sink, consumers of the witnesses are expected to clean them up.
+type witnessFileSink struct {
+	w *ioutil.RotatingWriter
+}
+
+var _ derive.WitnessSink = (*witnessFileSink)(nil)
+
+func openWitnessSink(dir string) (*witnessFileSink, error) {
+	w, err := ioutil.NewRotatingWriter(ioutil.RotatingWriterConfig{
+		Dir:      dir,
+		Name:     "witness.jsonl",
+		MaxSize:  witnessSegmentSize,
+		Compress: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open witness writer: %w", err)
+	}
+	return &witnessFileSink{w: w}, nil
+}
+
+func (s *witnessFileSink) WriteWitness(w *derive.BlockWitness) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return fmt.Errorf("failed to encode witness: %w", err)
+	}
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+func (s *witnessFileSink) Close() error {
+	return s.w.Close()
+}
@@ -324,6 +324,7 @@ func (e *EngineController) InsertUnsafePayload(ctx context.Context, envelope *et
 		} else if err == nil {
 			e.syncStatus = syncStatusFinishedEL
 			e.log.Info("Skipping EL sync and going straight to CL sync because there is a finalized block", "id", b.ID())
+			e.emitter.Emit(SyncModeEvent{Mode: sync.CLSync})
 			return nil
 		} else {
 			return derive.NewTemporaryError(fmt.Errorf("failed to fetch finalized head: %w", err))
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 // ResetEngineRequestEvent requests the EngineResetDeriver to walk
@@ -28,9 +30,23 @@ type EngineResetDeriver struct {
 	l2      sync.L2Chain
 	syncCfg *sync.Config
 
+	// resume is the point the first reset resumes from, if any.
+	resume *ResumePoint
+
 	emitter event.Emitter
 }
 
+// ResumePoint is a safe head persisted before a restart, derived from the L1 chain up to Origin.
+type ResumePoint struct {
+	Origin   eth.L1BlockRef
+	SafeHead eth.L2BlockRef
+}
+
+// L2BlockRefByNumber is needed to check that the safe head of a ResumePoint is still canonical.
+type canonicalL2Chain interface {
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+}
+
 func NewEngineResetDeriver(ctx context.Context, log log.Logger, cfg *rollup.Config,
 	l1 sync.L1Chain, l2 sync.L2Chain, syncCfg *sync.Config) *EngineResetDeriver {
 	return &EngineResetDeriver{
@@ -47,6 +63,12 @@ func (d *EngineResetDeriver) AttachEmitter(em event.Emitter) {
 	d.emitter = em
 }
 
+// ResumeFrom makes the first reset use the safe head of the resume point,
+// instead of the safe head found by walking back the L2 chain, if the resume point is still canonical.
+func (d *EngineResetDeriver) ResumeFrom(resume *ResumePoint) {
+	d.resume = resume
+}
+
 func (d *EngineResetDeriver) OnEvent(ev event.Event) bool {
 	switch ev.(type) {
 	case ResetEngineRequestEvent:
@@ -55,6 +77,16 @@ func (d *EngineResetDeriver) OnEvent(ev event.Event) bool {
 			d.emitter.Emit(rollup.ResetEvent{Err: fmt.Errorf("failed to find the L2 Heads to start from: %w", err)})
 			return true
 		}
+		if d.resume != nil {
+			resume := d.resume
+			d.resume = nil
+			if err := d.checkResumePoint(resume, result); err != nil {
+				d.log.Warn("Not resuming from persisted safe head", "safe", resume.SafeHead, "origin", resume.Origin, "err", err)
+			} else {
+				d.log.Info("Resuming from persisted safe head", "safe", resume.SafeHead, "origin", resume.Origin, "reset_safe", result.Safe)
+				result.Safe = resume.SafeHead
+			}
+		}
 		d.emitter.Emit(ForceEngineResetEvent{
 			Unsafe:    result.Unsafe,
 			Safe:      result.Safe,
@@ -65,3 +97,36 @@ func (d *EngineResetDeriver) OnEvent(ev event.Event) bool {
 	}
 	return true
 }
+
+// checkResumePoint checks that the resume point is ahead of the safe head found by the reset,
+// not ahead of the unsafe head, and that both its L1 origin and safe head are still canonical.
+func (d *EngineResetDeriver) checkResumePoint(resume *ResumePoint, result *sync.FindHeadsResult) error {
+	if resume.SafeHead.Number <= result.Safe.Number {
+		return fmt.Errorf("not ahead of the safe head %s", result.Safe)
+	}
+	if resume.SafeHead.Number > result.Unsafe.Number {
+		return fmt.Errorf("ahead of the unsafe head %s", result.Unsafe)
+	}
+	if resume.SafeHead.L1Origin.Number > resume.Origin.Number {
+		return fmt.Errorf("safe head L1 origin %s is ahead of the pipeline origin", resume.SafeHead.L1Origin)
+	}
+	l2, ok := d.l2.(canonicalL2Chain)
+	if !ok {
+		return errors.New("L2 chain does not support lookups by number")
+	}
+	origin, err := d.l1.L1BlockRefByNumber(d.ctx, resume.Origin.Number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %d: %w", resume.Origin.Number, err)
+	}
+	if origin.Hash != resume.Origin.Hash {
+		return fmt.Errorf("L1 origin was reorged to %s", origin)
+	}
+	safe, err := l2.L2BlockRefByNumber(d.ctx, resume.SafeHead.Number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d: %w", resume.SafeHead.Number, err)
+	}
+	if safe.Hash != resume.SafeHead.Hash {
+		return fmt.Errorf("safe head was reorged to %s", safe)
+	}
+	return nil
+}
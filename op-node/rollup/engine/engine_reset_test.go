@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// fakeL2Chain adapts the L2 block lookup by number of the fake chain source.
+type fakeL2Chain struct {
+	*testutils.FakeChainSource
+}
+
+func (c fakeL2Chain) L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error) {
+	return c.FakeChainSource.L2BlockRefByNumber(ctx, new(big.Int).SetUint64(num))
+}
+
+func TestEngineResetResume(t *testing.T) {
+	hash := func(id rune) common.Hash {
+		var h common.Hash
+		copy(h[:], string(id))
+		return h
+	}
+	// runs the first reset of a chain of which the engine was safe up to D,
+	// and returns the safe head of the reset.
+	reset := func(t *testing.T, resume *ResumePoint) eth.L2BlockRef {
+		logger := testlog.Logger(t, log.LevelInfo)
+		chain := testutils.NewFakeChainSource([]string{"abcdef"}, []string{"ABCDE"}, 0, logger)
+		chain.SetL2Head(4)
+		chain.SetL2Finalized(hash('A'))
+		chain.SetL2Safe(hash('D'))
+		for i := 0; i < 5; i++ {
+			chain.AdvanceL1()
+		}
+		cfg := &rollup.Config{Genesis: testutils.FakeGenesis('a', 'A', 0), SeqWindowSize: 2}
+		d := NewEngineResetDeriver(context.Background(), logger, cfg, chain, fakeL2Chain{chain}, &sync.Config{})
+		var events []ForceEngineResetEvent
+		d.AttachEmitter(event.EmitterFunc(func(ev event.Event) {
+			x, ok := ev.(ForceEngineResetEvent)
+			require.True(t, ok, "unexpected event %s", ev)
+			events = append(events, x)
+		}))
+		d.ResumeFrom(resume)
+		require.True(t, d.OnEvent(ResetEngineRequestEvent{}))
+		// the resume point only applies to the first reset
+		require.True(t, d.OnEvent(ResetEngineRequestEvent{}))
+		require.Len(t, events, 2)
+		require.Equal(t, hash('A'), events[1].Safe.Hash)
+		require.Equal(t, hash('E'), events[0].Unsafe.Hash)
+		return events[0].Safe
+	}
+	safeHead := func(id rune, num uint64, origin eth.BlockID) eth.L2BlockRef {
+		return eth.L2BlockRef{Hash: hash(id), Number: num, L1Origin: origin}
+	}
+	l1 := func(id rune, num uint64) eth.L1BlockRef {
+		return eth.L1BlockRef{Hash: hash(id), Number: num}
+	}
+
+	t.Run("NoResumePoint", func(t *testing.T) {
+		require.Equal(t, hash('A'), reset(t, nil).Hash)
+	})
+	t.Run("Resume", func(t *testing.T) {
+		safe := reset(t, &ResumePoint{Origin: l1('e', 4), SafeHead: safeHead('D', 3, l1('d', 3).ID())})
+		require.Equal(t, hash('D'), safe.Hash)
+	})
+	t.Run("ReorgedOrigin", func(t *testing.T) {
+		safe := reset(t, &ResumePoint{Origin: l1('x', 4), SafeHead: safeHead('D', 3, l1('d', 3).ID())})
+		require.Equal(t, hash('A'), safe.Hash)
+	})
+	t.Run("ReorgedSafeHead", func(t *testing.T) {
+		safe := reset(t, &ResumePoint{Origin: l1('e', 4), SafeHead: safeHead('X', 3, l1('d', 3).ID())})
+		require.Equal(t, hash('A'), safe.Hash)
+	})
+	t.Run("AheadOfUnsafe", func(t *testing.T) {
+		safe := reset(t, &ResumePoint{Origin: l1('f', 5), SafeHead: safeHead('F', 5, l1('e', 4).ID())})
+		require.Equal(t, hash('A'), safe.Hash)
+	})
+	t.Run("AheadOfOrigin", func(t *testing.T) {
+		safe := reset(t, &ResumePoint{Origin: l1('c', 2), SafeHead: safeHead('D', 3, l1('d', 3).ID())})
+		require.Equal(t, hash('A'), safe.Hash)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -40,6 +41,16 @@ func (ev ForkchoiceUpdateEvent) String() string {
 	return "forkchoice-update"
 }
 
+// SyncModeEvent signals the sync mode of the initial catch-up of the engine.
+// It is emitted at startup, and again when the engine falls back from EL sync to CL sync.
+type SyncModeEvent struct {
+	Mode sync.Mode
+}
+
+func (ev SyncModeEvent) String() string {
+	return "sync-mode"
+}
+
 // PromoteUnsafeEvent signals that the given block may now become a canonical unsafe block.
 // This is pre-forkchoice update; the change may not be reflected yet in the EL.
 // Note that the legacy pre-event-refactor code-path (processing P2P blocks) does fire this,
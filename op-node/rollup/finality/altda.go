@@ -2,6 +2,8 @@ package finality
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 
@@ -13,7 +15,8 @@ import (
 
 type AltDABackend interface {
 	// Finalize notifies the L1 finalized head so AltDA finality is always behind L1.
-	Finalize(ref eth.L1BlockRef)
+	// The L1 fetcher is used to look up the AltDA finalized block, which trails the L1 finalized head.
+	Finalize(ctx context.Context, l1 altda.L1BlockRefFetcher, ref eth.L1BlockRef) error
 	// OnFinalizedHeadSignal sets the engine finalization signal callback.
 	OnFinalizedHeadSignal(f altda.HeadSignalFn)
 }
@@ -49,7 +52,11 @@ func NewAltDAFinalizer(ctx context.Context, log log.Logger, cfg *rollup.Config,
 func (fi *AltDAFinalizer) OnEvent(ev event.Event) bool {
 	switch x := ev.(type) {
 	case FinalizeL1Event:
-		fi.backend.Finalize(x.FinalizedL1)
+		ctx, cancel := context.WithTimeout(fi.ctx, time.Second*10)
+		defer cancel()
+		if err := fi.backend.Finalize(ctx, fi.l1Fetcher, x.FinalizedL1); err != nil {
+			fi.emitter.Emit(rollup.L1TemporaryErrorEvent{Err: fmt.Errorf("failed to apply L1 finality signal to AltDA: %w", err)})
+		}
 		return true
 	default:
 		return fi.Finalizer.OnEvent(ev)
@@ -25,8 +25,9 @@ type fakeAltDABackend struct {
 	forwardTo altda.HeadSignalFn
 }
 
-func (b *fakeAltDABackend) Finalize(ref eth.L1BlockRef) {
+func (b *fakeAltDABackend) Finalize(ctx context.Context, l1 altda.L1BlockRefFetcher, ref eth.L1BlockRef) error {
 	b.altDAFn(ref)
+	return nil
 }
 
 func (b *fakeAltDABackend) OnFinalizedHeadSignal(f altda.HeadSignalFn) {
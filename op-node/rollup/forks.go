@@ -0,0 +1,228 @@
+package rollup
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/params"
+)
+
+// ForkRules are the changes a fork makes to the derivation rules. A zero value means the fork
+// leaves the rule unchanged, and the rule of the most recent active fork that sets it applies.
+//
+// To introduce a new fork, add its activation time to the Config, its ForkName to AllForks
+// and its entry to forkSpecs. The activation checks of all pipeline stages and of the ChainSpec
+// are then derived from the registry, instead of requiring another set of IsX functions.
+type ForkRules struct {
+	// MaxChannelBankSize is the number of bytes a channel bank can hold before it is pruned.
+	MaxChannelBankSize uint64
+	// MaxRLPBytesPerChannel is the maximum number of bytes read from a channel when decoding it.
+	MaxRLPBytesPerChannel uint64
+	// MaxSequencerDrift is the protocol constant replacing the configured max sequencer drift.
+	MaxSequencerDrift uint64
+	// ChannelTimeout is the protocol constant replacing the configured channel timeout.
+	ChannelTimeout uint64
+	// TransformsStages is set if the derivation pipeline stages must be transformed when the L1
+	// origin crosses the activation time of the fork. See derive.ForkTransformer.
+	TransformsStages bool
+
+	// Batch validity rules, activated by the L1 inclusion (or origin) time of the batch.
+
+	// SpanBatches is set if span batches are accepted.
+	SpanBatches bool
+	// BrotliChannels is set if channels may be compressed with brotli.
+	BrotliChannels bool
+	// StrictBatchOrdering is set if batches must be submitted in order: future batches are dropped
+	// instead of buffered, and past batches are skipped instead of dropped.
+	StrictBatchOrdering bool
+
+	// Payload attributes rules, activated by the L2 block time.
+
+	// EmptyWithdrawals is set if the payload attributes carry an empty withdrawals list.
+	EmptyWithdrawals bool
+	// ParentBeaconBlockRoot is set if the payload attributes carry the L1 parent beacon block root.
+	ParentBeaconBlockRoot bool
+	// DepositsCompleteTx is set if a deposits-complete transaction follows the user deposits.
+	DepositsCompleteTx bool
+
+	// Gas parameter rules.
+
+	// EcotoneL1Scalars is set if the system config scalar is read in the versioned Ecotone encoding,
+	// replacing the overhead. Activated by the L1 time of the system config update.
+	EcotoneL1Scalars bool
+	// EIP1559Params is set if the payload attributes carry the EIP-1559 parameters of the system
+	// config, and the block extra-data encodes them. Activated by the L2 block time.
+	EIP1559Params bool
+}
+
+// merge returns the rules with the changes of the next fork applied.
+// TransformsStages is a property of a single fork, see ForkName.Rules, and is not merged.
+func (r ForkRules) merge(next ForkRules) ForkRules {
+	mergeUint64 := func(v *uint64, next uint64) {
+		if next != 0 {
+			*v = next
+		}
+	}
+	mergeUint64(&r.MaxChannelBankSize, next.MaxChannelBankSize)
+	mergeUint64(&r.MaxRLPBytesPerChannel, next.MaxRLPBytesPerChannel)
+	mergeUint64(&r.MaxSequencerDrift, next.MaxSequencerDrift)
+	mergeUint64(&r.ChannelTimeout, next.ChannelTimeout)
+	r.SpanBatches = r.SpanBatches || next.SpanBatches
+	r.BrotliChannels = r.BrotliChannels || next.BrotliChannels
+	r.StrictBatchOrdering = r.StrictBatchOrdering || next.StrictBatchOrdering
+	r.EmptyWithdrawals = r.EmptyWithdrawals || next.EmptyWithdrawals
+	r.ParentBeaconBlockRoot = r.ParentBeaconBlockRoot || next.ParentBeaconBlockRoot
+	r.DepositsCompleteTx = r.DepositsCompleteTx || next.DepositsCompleteTx
+	r.EcotoneL1Scalars = r.EcotoneL1Scalars || next.EcotoneL1Scalars
+	r.EIP1559Params = r.EIP1559Params || next.EIP1559Params
+	return r
+}
+
+// forkSpec registers a fork with the rollup config field that holds its activation time.
+type forkSpec struct {
+	name ForkName
+	// activation returns the activation time field of the fork. Nil for Bedrock, which is always active.
+	activation func(c *Config) **uint64
+	rules      ForkRules
+}
+
+// forkSpecs are all forks, ordered from oldest to newest, matching AllForks.
+var forkSpecs = []forkSpec{
+	{
+		name: Bedrock,
+		rules: ForkRules{
+			MaxChannelBankSize:    maxChannelBankSizeBedrock,
+			MaxRLPBytesPerChannel: maxRLPBytesPerChannelBedrock,
+		},
+	},
+	{name: Regolith, activation: func(c *Config) **uint64 { return &c.RegolithTime }},
+	{
+		name:       Canyon,
+		activation: func(c *Config) **uint64 { return &c.CanyonTime },
+		rules: ForkRules{
+			EmptyWithdrawals: true,
+		},
+	},
+	{
+		name:       Delta,
+		activation: func(c *Config) **uint64 { return &c.DeltaTime },
+		rules: ForkRules{
+			SpanBatches: true,
+		},
+	},
+	{
+		name:       Ecotone,
+		activation: func(c *Config) **uint64 { return &c.EcotoneTime },
+		rules: ForkRules{
+			ParentBeaconBlockRoot: true,
+			EcotoneL1Scalars:      true,
+		},
+	},
+	{
+		name:       Fjord,
+		activation: func(c *Config) **uint64 { return &c.FjordTime },
+		rules: ForkRules{
+			MaxChannelBankSize:    maxChannelBankSizeFjord,
+			MaxRLPBytesPerChannel: maxRLPBytesPerChannelFjord,
+			MaxSequencerDrift:     maxSequencerDriftFjord,
+			BrotliChannels:        true,
+		},
+	},
+	{
+		name:       Granite,
+		activation: func(c *Config) **uint64 { return &c.GraniteTime },
+		rules: ForkRules{
+			ChannelTimeout: params.ChannelTimeoutGranite,
+		},
+	},
+	{
+		name:       Holocene,
+		activation: func(c *Config) **uint64 { return &c.HoloceneTime },
+		rules: ForkRules{
+			TransformsStages:    true,
+			StrictBatchOrdering: true,
+			EIP1559Params:       true,
+		},
+	},
+	{name: Isthmus, activation: func(c *Config) **uint64 { return &c.IsthmusTime }},
+	{
+		name:       Interop,
+		activation: func(c *Config) **uint64 { return &c.InteropTime },
+		rules: ForkRules{
+			DepositsCompleteTx: true,
+		},
+	},
+}
+
+var forkSpecsByName = func() map[ForkName]*forkSpec {
+	if len(forkSpecs) != len(AllForks) {
+		panic("forkSpecs and AllForks are out of sync")
+	}
+	m := make(map[ForkName]*forkSpec, len(forkSpecs))
+	for i := range forkSpecs {
+		if forkSpecs[i].name != AllForks[i] {
+			panic(fmt.Sprintf("fork %s registered out of order", forkSpecs[i].name))
+		}
+		m[forkSpecs[i].name] = &forkSpecs[i]
+	}
+	return m
+}()
+
+func mustForkSpec(fork ForkName) *forkSpec {
+	spec, ok := forkSpecsByName[fork]
+	if !ok {
+		panic(fmt.Sprintf("invalid fork: %s", fork))
+	}
+	return spec
+}
+
+// Rules returns the rule changes of the given fork.
+func (f ForkName) Rules() ForkRules {
+	return mustForkSpec(f).rules
+}
+
+// ActivationTime returns the activation time of the fork, or nil if the fork is not scheduled.
+// Bedrock activates at genesis.
+func (c *Config) ActivationTime(fork ForkName) *uint64 {
+	spec := mustForkSpec(fork)
+	if spec.activation == nil {
+		return &c.Genesis.L2Time
+	}
+	return *spec.activation(c)
+}
+
+// IsForkActive returns true if the fork is active at or past the given timestamp.
+func (c *Config) IsForkActive(fork ForkName, timestamp uint64) bool {
+	t := c.ActivationTime(fork)
+	return mustForkSpec(fork).activation == nil || (t != nil && timestamp >= *t)
+}
+
+// IsForkActivationBlock returns whether the L2 block with the given time is the first block
+// subject to the fork. Activation at genesis does not count.
+func (c *Config) IsForkActivationBlock(fork ForkName, l2BlockTime uint64) bool {
+	return c.IsForkActive(fork, l2BlockTime) &&
+		l2BlockTime >= c.BlockTime &&
+		!c.IsForkActive(fork, l2BlockTime-c.BlockTime)
+}
+
+// ActiveFork returns the most recent fork that is active at the given timestamp.
+func (c *Config) ActiveFork(timestamp uint64) ForkName {
+	active := Bedrock
+	for _, spec := range forkSpecs {
+		if c.IsForkActive(spec.name, timestamp) {
+			active = spec.name
+		}
+	}
+	return active
+}
+
+// RulesAt returns the derivation rules in effect at the given timestamp: the rules of all active
+// forks, where each fork overrides the rules it changes.
+func (c *Config) RulesAt(timestamp uint64) ForkRules {
+	var rules ForkRules
+	for _, spec := range forkSpecs {
+		if c.IsForkActive(spec.name, timestamp) {
+			rules = rules.merge(spec.rules)
+		}
+	}
+	return rules
+}
@@ -0,0 +1,80 @@
+package rollup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/params"
+)
+
+func TestForkSpecsMatchAllForks(t *testing.T) {
+	require.Len(t, forkSpecs, len(AllForks))
+	for i, fork := range AllForks {
+		require.Equal(t, fork, forkSpecs[i].name)
+	}
+}
+
+func TestActiveFork(t *testing.T) {
+	require.Equal(t, Bedrock, testConfig.ActiveFork(0))
+	require.Equal(t, Regolith, testConfig.ActiveFork(10))
+	require.Equal(t, Regolith, testConfig.ActiveFork(19))
+	require.Equal(t, Holocene, testConfig.ActiveFork(79))
+	require.Equal(t, Isthmus, testConfig.ActiveFork(1000))
+}
+
+func TestIsForkActive(t *testing.T) {
+	require.True(t, testConfig.IsForkActive(Bedrock, 0))
+	require.False(t, testConfig.IsForkActive(Granite, 59))
+	require.True(t, testConfig.IsForkActive(Granite, 60))
+	require.False(t, testConfig.IsForkActive(Interop, 1000))
+
+	require.True(t, testConfig.IsForkActivationBlock(Granite, 60))
+	require.False(t, testConfig.IsForkActivationBlock(Granite, 62))
+	require.False(t, testConfig.IsForkActivationBlock(Interop, 1000))
+}
+
+func TestRulesAt(t *testing.T) {
+	rules := testConfig.RulesAt(0)
+	require.Equal(t, uint64(maxChannelBankSizeBedrock), rules.MaxChannelBankSize)
+	require.Zero(t, rules.ChannelTimeout)
+	require.False(t, rules.EmptyWithdrawals)
+	require.False(t, rules.SpanBatches)
+
+	// Rules of earlier forks remain in effect, unless overridden
+	rules = testConfig.RulesAt(60)
+	require.Equal(t, uint64(maxChannelBankSizeFjord), rules.MaxChannelBankSize)
+	require.Equal(t, uint64(params.ChannelTimeoutGranite), rules.ChannelTimeout)
+	require.True(t, rules.EmptyWithdrawals)
+	require.True(t, rules.SpanBatches)
+	require.True(t, rules.ParentBeaconBlockRoot)
+	require.True(t, rules.BrotliChannels)
+	require.False(t, rules.StrictBatchOrdering)
+	require.False(t, rules.EIP1559Params)
+
+	rules = testConfig.RulesAt(1000)
+	require.True(t, rules.StrictBatchOrdering)
+	require.True(t, rules.EIP1559Params)
+	require.False(t, rules.DepositsCompleteTx)
+	require.False(t, rules.TransformsStages)
+}
+
+func TestIsActivationBlock(t *testing.T) {
+	// Only forks transforming the pipeline stages are reported
+	require.Equal(t, ForkName(""), testConfig.IsActivationBlock(55, 65))
+	require.Equal(t, Holocene, testConfig.IsActivationBlock(65, 75))
+	require.Equal(t, Holocene, testConfig.IsActivationBlock(0, 1000))
+	require.Equal(t, ForkName(""), testConfig.IsActivationBlock(70, 80))
+}
+
+func TestActivateAtGenesis(t *testing.T) {
+	cfg := &Config{}
+	cfg.ActivateAtGenesis(Granite)
+	for _, fork := range AllForks {
+		require.Equal(t, fork != Holocene && fork != Isthmus && fork != Interop, cfg.IsForkActive(fork, 0), fork)
+	}
+
+	cfg = &Config{}
+	cfg.ActivateAtGenesis(None)
+	require.Equal(t, Bedrock, cfg.ActiveFork(0))
+}
@@ -37,7 +37,21 @@ type Metrics interface {
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
 	RecordSequencingError()
-}
+	RecordSequencerBlockPhaseTime(phase string, duration time.Duration)
+}
+
+// Block-production phases, that the sequencer records the time spent in per block.
+const (
+	// PhaseAttributes is the preparation of the payload attributes of the block.
+	PhaseAttributes = "attributes"
+	// PhaseGetPayload is the sealing of the block, from the seal request until the engine returned the payload.
+	PhaseGetPayload = "get_payload"
+	// PhaseConductorCommit is the commitment of the sealed block to the conductor.
+	PhaseConductorCommit = "conductor_commit"
+	// PhaseGossipPublish is the hand-off of the sealed block to the async gossiper,
+	// which waits for the publishing of any previous block to complete.
+	PhaseGossipPublish = "gossip_publish"
+)
 
 type SequencerStateListener interface {
 	SequencerStarted() error
@@ -70,6 +84,18 @@ type BuildingState struct {
 
 	// Set once known
 	Ref eth.L2BlockRef
+
+	timings blockTimings
+}
+
+// blockTimings tracks where time is spent while producing a block,
+// so that a missed slot can be attributed to a specific phase.
+type blockTimings struct {
+	attributes      time.Duration
+	sealRequested   time.Time
+	getPayload      time.Duration
+	conductorCommit time.Duration
+	gossipPublish   time.Duration
 }
 
 // Sequencer implements the sequencing interface of the driver: it starts and completes block building jobs.
@@ -102,6 +128,9 @@ type Sequencer struct {
 	attrBuilder      derive.AttributesBuilder
 	l1OriginSelector L1OriginSelectorIface
 
+	// txFeed provides the transactions to sequence instead of the execution engine mempool, if not nil.
+	txFeed TxFeed
+
 	metrics Metrics
 
 	// timeNow enables sequencer testing to mock the time
@@ -266,10 +295,19 @@ func (d *Sequencer) onBuildSealed(x engine.BuildSealedEvent) {
 		"txs", len(x.Envelope.ExecutionPayload.Transactions),
 		"time", uint64(x.Envelope.ExecutionPayload.Timestamp))
 
+	if sealRequested := d.latest.timings.sealRequested; !sealRequested.IsZero() {
+		d.latest.timings.getPayload = d.timeNow().Sub(sealRequested)
+		d.metrics.RecordSequencerBlockPhaseTime(PhaseGetPayload, d.latest.timings.getPayload)
+	}
+
 	// generous timeout, the conductor is important
 	ctx, cancel := context.WithTimeout(d.ctx, time.Second*30)
 	defer cancel()
-	if err := d.conductor.CommitUnsafePayload(ctx, x.Envelope); err != nil {
+	commitStart := d.timeNow()
+	err := d.conductor.CommitUnsafePayload(ctx, x.Envelope)
+	d.latest.timings.conductorCommit = d.timeNow().Sub(commitStart)
+	d.metrics.RecordSequencerBlockPhaseTime(PhaseConductorCommit, d.latest.timings.conductorCommit)
+	if err != nil {
 		d.emitter.Emit(rollup.EngineTemporaryErrorEvent{
 			Err: fmt.Errorf("failed to commit unsafe payload to conductor: %w", err),
 		})
@@ -279,7 +317,11 @@ func (d *Sequencer) onBuildSealed(x engine.BuildSealedEvent) {
 	// begin gossiping as soon as possible
 	// asyncGossip.Clear() will be called later if an non-temporary error is found,
 	// or if the payload is successfully inserted
+	gossipStart := d.timeNow()
 	d.asyncGossip.Gossip(x.Envelope)
+	d.latest.timings.gossipPublish = d.timeNow().Sub(gossipStart)
+	d.metrics.RecordSequencerBlockPhaseTime(PhaseGossipPublish, d.latest.timings.gossipPublish)
+	d.checkSlotDeadline(x.Ref)
 	// Now after having gossiped the block, try to put it in our own canonical chain
 	d.emitter.Emit(engine.PayloadProcessEvent{
 		Concluding:   x.Concluding,
@@ -368,6 +410,7 @@ func (d *Sequencer) onSequencerAction(SequencerActionEvent) {
 		if d.latest.Info != (eth.PayloadInfo{}) {
 			// We should not repeat the seal request.
 			d.nextActionOK = false
+			d.latest.timings.sealRequested = d.timeNow()
 			// No known payload for block building job,
 			// we have to retrieve it first.
 			d.emitter.Emit(engine.BuildSealEvent{
@@ -465,6 +508,24 @@ func (d *Sequencer) onForkchoiceUpdate(x engine.ForkchoiceUpdateEvent) {
 	d.setLatestHead(x.UnsafeL2Head)
 }
 
+// checkSlotDeadline logs the time spent per block-production phase,
+// if the sealed block was not handed off for gossip before the slot of the block started.
+func (d *Sequencer) checkSlotDeadline(ref eth.L2BlockRef) {
+	slot := time.Unix(int64(ref.Time), 0)
+	late := d.timeNow().Sub(slot)
+	if late <= 0 {
+		return
+	}
+	t := d.latest.timings
+	var build time.Duration
+	if !d.latest.Started.IsZero() && !t.sealRequested.IsZero() {
+		build = t.sealRequested.Sub(d.latest.Started)
+	}
+	d.log.Warn("Sequencer block missed its slot", "block", ref, "late", late,
+		PhaseAttributes, t.attributes, "build", build, PhaseGetPayload, t.getPayload,
+		PhaseConductorCommit, t.conductorCommit, PhaseGossipPublish, t.gossipPublish)
+}
+
 func (d *Sequencer) setLatestHead(head eth.L2BlockRef) {
 	d.latestHead = head
 	if d.latestHeadSet != nil {
@@ -508,7 +569,10 @@ func (d *Sequencer) startBuildingBlock() {
 	fetchCtx, cancel := context.WithTimeout(ctx, time.Second*20)
 	defer cancel()
 
+	attrsStart := d.timeNow()
 	attrs, err := d.attrBuilder.PreparePayloadAttributes(fetchCtx, l2Head, l1Origin.ID())
+	attrsTime := d.timeNow().Sub(attrsStart)
+	d.metrics.RecordSequencerBlockPhaseTime(PhaseAttributes, attrsTime)
 	if err != nil {
 		if errors.Is(err, derive.ErrTemporary) {
 			d.emitter.Emit(rollup.EngineTemporaryErrorEvent{Err: err})
@@ -548,6 +612,17 @@ func (d *Sequencer) startBuildingBlock() {
 		d.log.Info("Sequencing Granite upgrade block")
 	}
 
+	if d.txFeed != nil && !attrs.NoTxPool {
+		txs, err := feedTransactions(fetchCtx, d.txFeed, l2Head, attrs)
+		if err != nil {
+			d.log.Warn("Failed to get transactions from tx feed, building block without them", "err", err)
+		} else {
+			attrs.Transactions = append(attrs.Transactions, txs...)
+		}
+		// The mempool of the execution engine is never used when sequencing from a feed
+		attrs.NoTxPool = true
+	}
+
 	d.log.Debug("prepared attributes for new block",
 		"num", l2Head.Number+1, "time", uint64(attrs.Timestamp),
 		"origin", l1Origin, "origin_time", l1Origin.Time, "noTxPool", attrs.NoTxPool)
@@ -565,7 +640,7 @@ func (d *Sequencer) startBuildingBlock() {
 
 	// Reset building state, and remember what we are building on.
 	// If we get a forkchoice update that conflicts, we will have to abort building.
-	d.latest = BuildingState{Onto: l2Head}
+	d.latest = BuildingState{Onto: l2Head, timings: blockTimings{attributes: attrsTime}}
 
 	d.emitter.Emit(engine.BuildStartEvent{
 		Attributes: withParent,
@@ -711,6 +786,13 @@ func (d *Sequencer) SetMaxSafeLag(ctx context.Context, v uint64) error {
 	return nil
 }
 
+// SetTxFeed makes the sequencer build blocks from the transactions of the feed, instead of the mempool of the execution engine.
+func (d *Sequencer) SetTxFeed(feed TxFeed) {
+	d.l.Lock()
+	defer d.l.Unlock()
+	d.txFeed = feed
+}
+
 func (d *Sequencer) OverrideLeader(ctx context.Context) error {
 	return d.conductor.OverrideLeader(ctx)
 }
@@ -156,6 +156,15 @@ func (f *FakeAsyncGossip) Start() {
 
 var _ AsyncGossiper = (*FakeAsyncGossip)(nil)
 
+type phaseTimesMetrics struct {
+	metrics.Metricer
+	phases map[string]time.Duration
+}
+
+func (m *phaseTimesMetrics) RecordSequencerBlockPhaseTime(phase string, duration time.Duration) {
+	m.phases[phase] = duration
+}
+
 // TestSequencer_StartStop runs through start/stop state back and forth to test state changes.
 func TestSequencer_StartStop(t *testing.T) {
 	logger := testlog.Logger(t, log.LevelError)
@@ -459,6 +468,8 @@ func TestSequencer_StaleBuild(t *testing.T) {
 func TestSequencerBuild(t *testing.T) {
 	logger := testlog.Logger(t, log.LevelError)
 	seq, deps := createSequencer(logger)
+	metr := &phaseTimesMetrics{Metricer: metrics.NoopMetrics, phases: make(map[string]time.Duration)}
+	seq.metrics = metr
 	testClock := clock.NewSimpleClock()
 	seq.timeNow = testClock.Now
 	testClock.SetTime(30000)
@@ -543,6 +554,8 @@ func TestSequencerBuild(t *testing.T) {
 	emitter.AssertExpectations(t)
 	_, ok = seq.NextAction()
 	require.False(t, ok, "cannot act until sealing completes/fails")
+	// pretend the engine takes 30ms to return the payload
+	testClock.Set(startedTime.Add(time.Millisecond * 30))
 
 	payloadEnvelope := &eth.ExecutionPayloadEnvelope{
 		ParentBeaconBlockRoot: sentAttributes.Attributes.ParentBeaconBlockRoot,
@@ -583,6 +596,10 @@ func TestSequencerBuild(t *testing.T) {
 	// But also optimistically give it to the conductor and the async gossip
 	require.Equal(t, payloadEnvelope, deps.conductor.committed, "must commit to conductor")
 	require.Equal(t, payloadEnvelope, deps.asyncGossip.payload, "must send to async gossip")
+	require.Equal(t, time.Millisecond*30, metr.phases[PhaseGetPayload])
+	require.Contains(t, metr.phases, PhaseAttributes)
+	require.Contains(t, metr.phases, PhaseConductorCommit)
+	require.Contains(t, metr.phases, PhaseGossipPublish)
 	_, ok = seq.NextAction()
 	require.False(t, ok, "optimistically published, but not ready to sequence next, until local processing completes")
 
@@ -675,3 +692,35 @@ func createSequencer(log log.Logger) (*Sequencer, *sequencerTestDeps) {
 	}
 	return seq, deps
 }
+
+func TestSequencer_MissedSlot(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LevelInfo)
+	seq, _ := createSequencer(logger)
+	testClock := clock.NewSimpleClock()
+	seq.timeNow = testClock.Now
+	ref := eth.L2BlockRef{Hash: common.Hash{0x12, 0x34}, Number: 101, Time: 30002}
+	slot := time.Unix(int64(ref.Time), 0)
+	seq.latest = BuildingState{
+		Started: slot.Add(-time.Second * 2),
+		Ref:     ref,
+		timings: blockTimings{
+			attributes:      time.Millisecond * 10,
+			sealRequested:   slot.Add(-sealingDuration),
+			getPayload:      time.Millisecond * 400,
+			conductorCommit: time.Millisecond * 5,
+		},
+	}
+	missedSlot := testlog.NewMessageContainsFilter("missed its slot")
+
+	testClock.Set(slot.Add(-time.Millisecond))
+	seq.checkSlotDeadline(ref)
+	require.Nil(t, logs.FindLog(missedSlot), "block in time")
+
+	testClock.Set(slot.Add(time.Millisecond * 355))
+	seq.checkSlotDeadline(ref)
+	rec := logs.FindLog(missedSlot)
+	require.NotNil(t, rec)
+	require.Equal(t, time.Millisecond*355, rec.AttrValue("late"))
+	require.Equal(t, time.Millisecond*400, rec.AttrValue(PhaseGetPayload))
+	require.Equal(t, time.Second*2-sealingDuration, rec.AttrValue("build"))
+}
@@ -0,0 +1,55 @@
+package sequencing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// TxFeed provides the ordered transactions to sequence, instead of the transaction pool of the execution engine.
+type TxFeed interface {
+	// NextTransactions returns the transactions to include, in order, in the block with the given timestamp
+	// on top of the parent block, using no more than the given amount of gas.
+	// The feed is asked again for the same parent if the block was not sealed,
+	// so the feed can detect transactions that were not included.
+	NextTransactions(ctx context.Context, parent eth.BlockID, timestamp uint64, gas uint64) ([]eth.Data, error)
+}
+
+// feedTransactions gets the transactions to append to the attributes from the feed,
+// and checks that they are regular transactions that fit in the gas left by the deposits.
+func feedTransactions(ctx context.Context, feed TxFeed, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) ([]eth.Data, error) {
+	if attrs.GasLimit == nil {
+		return nil, errors.New("attributes have no gas limit")
+	}
+	gas := uint64(*attrs.GasLimit)
+	for i, otx := range attrs.Transactions {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(otx); err != nil {
+			return nil, fmt.Errorf("failed to decode deposit %d: %w", i, err)
+		}
+		gas -= min(tx.Gas(), gas)
+	}
+	txs, err := feed.NextTransactions(ctx, parent.ID(), uint64(attrs.Timestamp), gas)
+	if err != nil {
+		return nil, err
+	}
+	var used uint64
+	for i, otx := range txs {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(otx); err != nil {
+			return nil, fmt.Errorf("failed to decode feed transaction %d: %w", i, err)
+		}
+		if tx.IsDepositTx() || tx.Type() == types.BlobTxType {
+			return nil, fmt.Errorf("feed transaction %d has unsupported type %d", i, tx.Type())
+		}
+		used += tx.Gas()
+		if used > gas {
+			return nil, fmt.Errorf("feed transactions exceed the %d gas left in the block", gas)
+		}
+	}
+	return txs, nil
+}
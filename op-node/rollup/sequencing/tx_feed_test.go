@@ -0,0 +1,76 @@
+package sequencing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type stubTxFeed struct {
+	gas uint64
+	txs []eth.Data
+	err error
+}
+
+func (s *stubTxFeed) NextTransactions(_ context.Context, _ eth.BlockID, _ uint64, gas uint64) ([]eth.Data, error) {
+	s.gas = gas
+	return s.txs, s.err
+}
+
+func encodeTx(t *testing.T, inner types.TxData) eth.Data {
+	data, err := types.NewTx(inner).MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+func TestFeedTransactions(t *testing.T) {
+	gasLimit := eth.Uint64Quantity(100_000)
+	newAttrs := func() *eth.PayloadAttributes {
+		return &eth.PayloadAttributes{
+			Timestamp:    1000,
+			GasLimit:     &gasLimit,
+			Transactions: []eth.Data{encodeTx(t, &types.DepositTx{Gas: 40_000})},
+		}
+	}
+	parent := eth.L2BlockRef{Hash: common.Hash{0x01}, Number: 10}
+
+	t.Run("GasLeftByDeposits", func(t *testing.T) {
+		txs := []eth.Data{encodeTx(t, &types.DynamicFeeTx{Gas: 21_000}), encodeTx(t, &types.LegacyTx{Gas: 39_000})}
+		feed := &stubTxFeed{txs: txs}
+		result, err := feedTransactions(context.Background(), feed, parent, newAttrs())
+		require.NoError(t, err)
+		require.Equal(t, txs, result)
+		require.Equal(t, uint64(60_000), feed.gas)
+	})
+
+	t.Run("ExceedGas", func(t *testing.T) {
+		feed := &stubTxFeed{txs: []eth.Data{encodeTx(t, &types.DynamicFeeTx{Gas: 60_001})}}
+		_, err := feedTransactions(context.Background(), feed, parent, newAttrs())
+		require.ErrorContains(t, err, "exceed")
+	})
+
+	t.Run("RejectDeposit", func(t *testing.T) {
+		feed := &stubTxFeed{txs: []eth.Data{encodeTx(t, &types.DepositTx{Gas: 21_000})}}
+		_, err := feedTransactions(context.Background(), feed, parent, newAttrs())
+		require.ErrorContains(t, err, "unsupported type")
+	})
+
+	t.Run("RejectInvalid", func(t *testing.T) {
+		feed := &stubTxFeed{txs: []eth.Data{{0xff, 0x01}}}
+		_, err := feedTransactions(context.Background(), feed, parent, newAttrs())
+		require.ErrorContains(t, err, "failed to decode")
+	})
+
+	t.Run("FeedError", func(t *testing.T) {
+		feedErr := errors.New("boom")
+		_, err := feedTransactions(context.Background(), &stubTxFeed{err: feedErr}, parent, newAttrs())
+		require.ErrorIs(t, err, feedErr)
+	})
+}
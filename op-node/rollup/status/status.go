@@ -83,6 +83,20 @@ func (st *StatusTracker) OnEvent(ev event.Event) bool {
 		st.data.LocalSafeL2 = x.LocalSafe
 	case derive.DeriverL1StatusEvent:
 		st.data.CurrentL1 = x.Origin
+	case derive.L1BlobsStatusEvent:
+		if x.Status.Degraded != st.data.L1BlobsDegraded {
+			if x.Status.Degraded {
+				st.log.Warn("L1 blobs source is degraded", "pending", x.Status.Pending, "oldest_pending", x.Status.OldestPending)
+			} else {
+				st.log.Info("L1 blobs source is healthy again")
+			}
+		}
+		st.data.L1BlobsDegraded = x.Status.Degraded
+		st.data.PendingBlobsL1 = x.Status.OldestPending
+	case derive.DerivationPausedEvent:
+		st.data.DerivationPaused = x.Paused
+	case engine.SyncModeEvent:
+		st.data.SyncMode = x.Mode.String()
 	case L1UnsafeEvent:
 		st.metrics.RecordL1Ref("l1_head", x.L1Unsafe)
 		// We don't need to do anything if the head hasn't changed.
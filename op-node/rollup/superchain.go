@@ -1,6 +1,7 @@
 package rollup
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -14,6 +15,12 @@ import (
 
 var OPStackSupport = params.ProtocolVersionV0{Build: [8]byte{}, Major: 9, Minor: 0, Patch: 0, PreRelease: 0}.Encode()
 
+// registryChannelTimeoutBedrock is the Bedrock channel timeout of all chains in the superchain-registry,
+// which does not represent the channel timeout.
+const registryChannelTimeoutBedrock = 300
+
+var ErrNotRegistryCompatible = errors.New("rollup config cannot be represented in the superchain-registry format")
+
 // LoadOPStackRollupConfig loads the rollup configuration of the requested chain ID from the superchain-registry.
 // Some chains may require a SystemConfigProvider to retrieve any values not part of the registry.
 func LoadOPStackRollupConfig(chainID uint64) (*Config, error) {
@@ -82,7 +89,7 @@ func LoadOPStackRollupConfig(chainID uint64) (*Config, error) {
 		BlockTime:              chConfig.BlockTime,
 		MaxSequencerDrift:      chConfig.MaxSequencerDrift,
 		SeqWindowSize:          chConfig.SequencerWindowSize,
-		ChannelTimeoutBedrock:  300,
+		ChannelTimeoutBedrock:  registryChannelTimeoutBedrock,
 		L1ChainID:              new(big.Int).SetUint64(superChain.Config.L1.ChainID),
 		L2ChainID:              new(big.Int).SetUint64(chConfig.ChainID),
 		RegolithTime:           &regolithTime,
@@ -103,3 +110,84 @@ func LoadOPStackRollupConfig(chainID uint64) (*Config, error) {
 	}
 	return cfg, nil
 }
+
+// SuperchainChainConfig converts the rollup config into the chain config format of the superchain-registry.
+// It is the inverse of LoadOPStackRollupConfig: values that the registry does not represent must match
+// the values LoadOPStackRollupConfig assumes. The chain metadata, such as the name and RPC endpoints,
+// and the L1 contract addresses other than the deposit contract and system config are left empty.
+func (cfg *Config) SuperchainChainConfig() (*superchain.ChainConfig, error) {
+	if cfg.RegolithTime == nil || *cfg.RegolithTime != 0 {
+		return nil, fmt.Errorf("%w: regolith must be active at genesis", ErrNotRegistryCompatible)
+	}
+	if cfg.ChannelTimeoutBedrock != registryChannelTimeoutBedrock {
+		return nil, fmt.Errorf("%w: bedrock channel timeout must be %d, got %d",
+			ErrNotRegistryCompatible, registryChannelTimeoutBedrock, cfg.ChannelTimeoutBedrock)
+	}
+	if cfg.InteropTime != nil {
+		return nil, fmt.Errorf("%w: interop is not supported", ErrNotRegistryCompatible)
+	}
+
+	sysCfg := superchain.SystemConfig{
+		BatcherAddr: superchain.Address(cfg.Genesis.SystemConfig.BatcherAddr),
+		Overhead:    superchain.Hash(cfg.Genesis.SystemConfig.Overhead),
+		Scalar:      superchain.Hash(cfg.Genesis.SystemConfig.Scalar),
+		GasLimit:    cfg.Genesis.SystemConfig.GasLimit,
+	}
+	if cfg.Genesis.SystemConfig.Scalar[0] == eth.L1ScalarEcotone {
+		scalars, err := eth.DecodeScalar(cfg.Genesis.SystemConfig.Scalar)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis system config scalar: %w", err)
+		}
+		baseFeeScalar, blobBaseFeeScalar := uint64(scalars.BaseFeeScalar), uint64(scalars.BlobBaseFeeScalar)
+		sysCfg.BaseFeeScalar = &baseFeeScalar
+		sysCfg.BlobBaseFeeScalar = &blobBaseFeeScalar
+	}
+
+	chConfig := &superchain.ChainConfig{
+		ChainID:        cfg.L2ChainID.Uint64(),
+		BatchInboxAddr: superchain.Address(cfg.BatchInboxAddress),
+		HardForkConfiguration: superchain.HardForkConfiguration{
+			CanyonTime:   cfg.CanyonTime,
+			DeltaTime:    cfg.DeltaTime,
+			EcotoneTime:  cfg.EcotoneTime,
+			FjordTime:    cfg.FjordTime,
+			GraniteTime:  cfg.GraniteTime,
+			HoloceneTime: cfg.HoloceneTime,
+			IsthmusTime:  cfg.IsthmusTime,
+		},
+		BlockTime:            cfg.BlockTime,
+		SequencerWindowSize:  cfg.SeqWindowSize,
+		MaxSequencerDrift:    cfg.MaxSequencerDrift,
+		DataAvailabilityType: superchain.EthDA,
+		Genesis: superchain.ChainGenesis{
+			L1: superchain.BlockID{
+				Hash:   superchain.Hash(cfg.Genesis.L1.Hash),
+				Number: cfg.Genesis.L1.Number,
+			},
+			L2: superchain.BlockID{
+				Hash:   superchain.Hash(cfg.Genesis.L2.Hash),
+				Number: cfg.Genesis.L2.Number,
+			},
+			L2Time:       cfg.Genesis.L2Time,
+			SystemConfig: sysCfg,
+		},
+		Addresses: superchain.AddressList{
+			OptimismPortalProxy: superchain.Address(cfg.DepositContractAddress),
+			SystemConfigProxy:   superchain.Address(cfg.L1SystemConfigAddress),
+		},
+	}
+	if cfg.AltDAConfig != nil {
+		challengeAddr := superchain.Address(cfg.AltDAConfig.DAChallengeAddress)
+		challengeWindow, resolveWindow := cfg.AltDAConfig.DAChallengeWindow, cfg.AltDAConfig.DAResolveWindow
+		commitmentType := cfg.AltDAConfig.CommitmentType
+		chConfig.DataAvailabilityType = superchain.AltDA
+		chConfig.AltDA = &superchain.AltDAConfig{
+			DAChallengeAddress: &challengeAddr,
+			DAChallengeWindow:  &challengeWindow,
+			DAResolveWindow:    &resolveWindow,
+			DACommitmentType:   &commitmentType,
+		}
+		chConfig.Addresses.DAChallengeAddress = challengeAddr
+	}
+	return chConfig, nil
+}
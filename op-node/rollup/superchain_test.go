@@ -0,0 +1,64 @@
+package rollup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+)
+
+func TestSuperchainChainConfig(t *testing.T) {
+	for _, chainID := range []uint64{10, 11155420} {
+		cfg, err := LoadOPStackRollupConfig(chainID)
+		require.NoError(t, err)
+		expected := superchain.OPChains[chainID]
+
+		actual, err := cfg.SuperchainChainConfig()
+		require.NoError(t, err)
+		require.Equal(t, expected.ChainID, actual.ChainID)
+		require.Equal(t, expected.BatchInboxAddr, actual.BatchInboxAddr)
+		require.Equal(t, expected.BlockTime, actual.BlockTime)
+		require.Equal(t, expected.SequencerWindowSize, actual.SequencerWindowSize)
+		require.Equal(t, expected.MaxSequencerDrift, actual.MaxSequencerDrift)
+		require.Equal(t, expected.Genesis.L1, actual.Genesis.L1)
+		require.Equal(t, expected.Genesis.L2, actual.Genesis.L2)
+		require.Equal(t, expected.Genesis.L2Time, actual.Genesis.L2Time)
+		require.Equal(t, expected.Genesis.SystemConfig.BatcherAddr, actual.Genesis.SystemConfig.BatcherAddr)
+		require.Equal(t, expected.Genesis.SystemConfig.Scalar, actual.Genesis.SystemConfig.Scalar)
+		require.Equal(t, expected.Genesis.SystemConfig.GasLimit, actual.Genesis.SystemConfig.GasLimit)
+		require.Equal(t, expected.Addresses.OptimismPortalProxy, actual.Addresses.OptimismPortalProxy)
+		require.Equal(t, expected.Addresses.SystemConfigProxy, actual.Addresses.SystemConfigProxy)
+		require.Equal(t, expected.CanyonTime, actual.CanyonTime)
+		require.Equal(t, expected.HoloceneTime, actual.HoloceneTime)
+		require.Equal(t, superchain.EthDA, actual.DataAvailabilityType)
+	}
+}
+
+func TestSuperchainChainConfigIncompatible(t *testing.T) {
+	cfg, err := LoadOPStackRollupConfig(10)
+	require.NoError(t, err)
+
+	t.Run("RegolithAfterGenesis", func(t *testing.T) {
+		cfg := *cfg
+		regolithTime := uint64(100)
+		cfg.RegolithTime = &regolithTime
+		_, err := cfg.SuperchainChainConfig()
+		require.ErrorIs(t, err, ErrNotRegistryCompatible)
+	})
+
+	t.Run("ChannelTimeout", func(t *testing.T) {
+		cfg := *cfg
+		cfg.ChannelTimeoutBedrock = 50
+		_, err := cfg.SuperchainChainConfig()
+		require.ErrorIs(t, err, ErrNotRegistryCompatible)
+	})
+
+	t.Run("Interop", func(t *testing.T) {
+		cfg := *cfg
+		interopTime := uint64(0)
+		cfg.InteropTime = &interopTime
+		_, err := cfg.SuperchainChainConfig()
+		require.ErrorIs(t, err, ErrNotRegistryCompatible)
+	})
+}
@@ -12,18 +12,22 @@ type Mode int
 //     fetches unsafe blocks that it has missed.
 //  2. In execution-layer (EL) sync, the op-node tells the execution client to sync towards the tip of the chain.
 //     It will consolidate the chain as usual. This allows execution clients to snap sync if they are capable of it.
+//
+// The auto sync mode chooses between the two at startup, see NegotiateMode.
 const (
 	CLSync Mode = iota
 	ELSync
+	AutoSync
 )
 
 const (
-	CLSyncString string = "consensus-layer"
-	ELSyncString string = "execution-layer"
+	CLSyncString   string = "consensus-layer"
+	ELSyncString   string = "execution-layer"
+	AutoSyncString string = "auto"
 )
 
-var Modes = []Mode{CLSync, ELSync}
-var ModeStrings = []string{CLSyncString, ELSyncString}
+var Modes = []Mode{CLSync, ELSync, AutoSync}
+var ModeStrings = []string{CLSyncString, ELSyncString, AutoSyncString}
 
 func StringToMode(s string) (Mode, error) {
 	switch strings.ToLower(s) {
@@ -31,6 +35,8 @@ func StringToMode(s string) (Mode, error) {
 		return CLSync, nil
 	case ELSyncString:
 		return ELSync, nil
+	case AutoSyncString:
+		return AutoSync, nil
 	default:
 		return 0, fmt.Errorf("unknown sync mode: %s", s)
 	}
@@ -42,6 +48,8 @@ func (m Mode) String() string {
 		return CLSyncString
 	case ELSync:
 		return ELSyncString
+	case AutoSync:
+		return AutoSyncString
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// elSyncClients are the client names, as reported by web3_clientVersion,
+// of execution engines that support EL sync.
+var elSyncClients = []string{"geth", "reth", "erigon", "nethermind"}
+
+// NodeInfoRPC is the RPC of the execution engine, used to detect whether it supports EL sync.
+type NodeInfoRPC interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+// NegotiateMode chooses the sync mode of the initial catch-up if the auto sync mode is configured,
+// and returns the configured sync mode otherwise.
+//
+// EL sync is chosen if the execution engine supports it, and it either has not finalized any block
+// past the rollup genesis yet, or it supports EL sync after finalization.
+// If the execution engine cannot be probed, it falls back to CL sync, which works with any engine.
+func NegotiateMode(ctx context.Context, lgr log.Logger, cfg *rollup.Config, rpc NodeInfoRPC, l2 L2Chain, syncCfg *Config) Mode {
+	if syncCfg.SyncMode != AutoSync {
+		return syncCfg.SyncMode
+	}
+	supported, err := supportsELSync(ctx, rpc, syncCfg)
+	if err != nil {
+		lgr.Warn("Failed to detect whether the execution engine supports EL sync, falling back to CL sync", "err", err)
+		return CLSync
+	}
+	if !supported {
+		lgr.Info("Execution engine does not support EL sync, using CL sync")
+		return CLSync
+	}
+	if syncCfg.SupportsPostFinalizationELSync {
+		lgr.Info("Execution engine supports EL sync after finalization, using EL sync")
+		return ELSync
+	}
+	finalized, err := l2.L2BlockRefByLabel(ctx, eth.Finalized)
+	if errors.Is(err, ethereum.NotFound) || (err == nil && finalized.Hash == cfg.Genesis.L2.Hash) {
+		lgr.Info("Execution engine supports EL sync and has not finalized any blocks yet, using EL sync")
+		return ELSync
+	} else if err != nil {
+		lgr.Warn("Failed to fetch finalized block of the execution engine, falling back to CL sync", "err", err)
+		return CLSync
+	}
+	lgr.Info("Execution engine already finalized blocks, using CL sync", "finalized", finalized.ID())
+	return CLSync
+}
+
+// supportsELSync detects whether the execution engine supports EL sync. The snap protocol of the node info
+// is checked first, as it is the most precise signal, but the admin namespace is often not exposed on the engine RPC.
+// The client name of the client version is checked then.
+func supportsELSync(ctx context.Context, rpc NodeInfoRPC, syncCfg *Config) (bool, error) {
+	// Engines that support EL sync after finalization sync with their own pipeline, snap sync is not needed.
+	if syncCfg.SupportsPostFinalizationELSync {
+		return true, nil
+	}
+	var info struct {
+		Protocols map[string]json.RawMessage `json:"protocols"`
+	}
+	if err := rpc.CallContext(ctx, &info, "admin_nodeInfo"); err == nil {
+		_, ok := info.Protocols["snap"]
+		return ok, nil
+	}
+	var version string
+	if err := rpc.CallContext(ctx, &version, "web3_clientVersion"); err != nil {
+		return false, fmt.Errorf("failed to fetch client version: %w", err)
+	}
+	name, _, _ := strings.Cut(strings.ToLower(version), "/")
+	for _, client := range elSyncClients {
+		if name == client {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// stubNodeInfoRPC returns the JSON encoded responses of the methods, and an error for other methods.
+type stubNodeInfoRPC map[string]string
+
+func (s stubNodeInfoRPC) CallContext(_ context.Context, result any, method string, _ ...any) error {
+	resp, ok := s[method]
+	if !ok {
+		return errors.New("method not found")
+	}
+	return json.Unmarshal([]byte(resp), result)
+}
+
+func TestNegotiateMode(t *testing.T) {
+	genesis := eth.BlockID{Hash: common.Hash{0xaa}, Number: 0}
+	cfg := &rollup.Config{Genesis: rollup.Genesis{L2: genesis}}
+	snapNodeInfo := stubNodeInfoRPC{"admin_nodeInfo": `{"protocols":{"eth":{},"snap":{}}}`}
+
+	negotiate := func(t *testing.T, rpc NodeInfoRPC, l2 L2Chain, syncCfg *Config) Mode {
+		logger := testlog.Logger(t, log.LevelDebug)
+		return NegotiateMode(context.Background(), logger, cfg, rpc, l2, syncCfg)
+	}
+
+	t.Run("KeepConfiguredMode", func(t *testing.T) {
+		require.Equal(t, CLSync, negotiate(t, nil, nil, &Config{SyncMode: CLSync}))
+		require.Equal(t, ELSync, negotiate(t, nil, nil, &Config{SyncMode: ELSync}))
+	})
+
+	t.Run("ELSyncWithoutFinalizedBlocks", func(t *testing.T) {
+		l2 := new(testutils.MockL2Client)
+		l2.ExpectL2BlockRefByLabel(eth.Finalized, eth.L2BlockRef{}, ethereum.NotFound)
+		require.Equal(t, ELSync, negotiate(t, snapNodeInfo, l2, &Config{SyncMode: AutoSync}))
+		l2.AssertExpectations(t)
+	})
+
+	t.Run("ELSyncWithFinalizedGenesis", func(t *testing.T) {
+		l2 := new(testutils.MockL2Client)
+		l2.ExpectL2BlockRefByLabel(eth.Finalized, eth.L2BlockRef{Hash: genesis.Hash, Number: genesis.Number}, nil)
+		require.Equal(t, ELSync, negotiate(t, snapNodeInfo, l2, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("CLSyncWithFinalizedBlocks", func(t *testing.T) {
+		l2 := new(testutils.MockL2Client)
+		l2.ExpectL2BlockRefByLabel(eth.Finalized, eth.L2BlockRef{Hash: common.Hash{0xbb}, Number: 10}, nil)
+		require.Equal(t, CLSync, negotiate(t, snapNodeInfo, l2, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("CLSyncOnFinalizedError", func(t *testing.T) {
+		l2 := new(testutils.MockL2Client)
+		l2.ExpectL2BlockRefByLabel(eth.Finalized, eth.L2BlockRef{}, errors.New("boom"))
+		require.Equal(t, CLSync, negotiate(t, snapNodeInfo, l2, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("CLSyncWithoutSnapProtocol", func(t *testing.T) {
+		rpc := stubNodeInfoRPC{"admin_nodeInfo": `{"protocols":{"eth":{}}}`}
+		require.Equal(t, CLSync, negotiate(t, rpc, nil, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("FallbackToClientVersion", func(t *testing.T) {
+		l2 := new(testutils.MockL2Client)
+		l2.ExpectL2BlockRefByLabel(eth.Finalized, eth.L2BlockRef{}, ethereum.NotFound)
+		rpc := stubNodeInfoRPC{"web3_clientVersion": `"Geth/v1.101500.0-stable/linux-amd64/go1.23.4"`}
+		require.Equal(t, ELSync, negotiate(t, rpc, l2, &Config{SyncMode: AutoSync}))
+
+		rpc = stubNodeInfoRPC{"web3_clientVersion": `"Other/v1.0.0"`}
+		require.Equal(t, CLSync, negotiate(t, rpc, nil, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("CLSyncWhenProbeFails", func(t *testing.T) {
+		require.Equal(t, CLSync, negotiate(t, stubNodeInfoRPC{}, nil, &Config{SyncMode: AutoSync}))
+	})
+
+	t.Run("ELSyncAfterFinalization", func(t *testing.T) {
+		syncCfg := &Config{SyncMode: AutoSync, SupportsPostFinalizationELSync: true}
+		require.Equal(t, ELSync, negotiate(t, stubNodeInfoRPC{}, nil, syncCfg))
+	})
+}
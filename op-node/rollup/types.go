@@ -37,6 +37,7 @@ var (
 	ErrChainIDsSame                  = errors.New("L1 and L2 chain IDs must be different")
 	ErrL1ChainIDNotPositive          = errors.New("L1 chain ID must be non-zero and positive")
 	ErrL2ChainIDNotPositive          = errors.New("L2 chain ID must be non-zero and positive")
+	ErrDepositGasTooLow              = errors.New("deposit gas override below intrinsic gas")
 )
 
 type Genesis struct {
@@ -52,6 +53,16 @@ type Genesis struct {
 	SystemConfig eth.SystemConfig `json:"system_config"`
 }
 
+// DepositGasConfig overrides the gas accounting of deposit processing, for chains that customize
+// their deposits, e.g. to account for custom gas token semantics, without forking the node.
+// Zero values keep the protocol defaults.
+type DepositGasConfig struct {
+	// Gas limit of the L1 attributes deposit transaction, once Regolith is active.
+	SystemTxGas uint64 `json:"system_tx_gas,omitempty"`
+	// Gas limit of the DepositsComplete transaction, once Interop is active.
+	DepositsCompleteGas uint64 `json:"deposits_complete_gas,omitempty"`
+}
+
 type AltDAConfig struct {
 	// L1 DataAvailabilityChallenge contract proxy address
 	DAChallengeAddress common.Address `json:"da_challenge_contract_address,omitempty"`
@@ -143,6 +154,9 @@ type Config struct {
 
 	// AltDAConfig. We are in the process of migrating to the AltDAConfig from these legacy top level values
 	AltDAConfig *AltDAConfig `json:"alt_da,omitempty"`
+
+	// DepositGasConfig overrides the gas accounting of deposits, optional.
+	DepositGasConfig *DepositGasConfig `json:"deposit_gas,omitempty"`
 }
 
 // ValidateL1Config checks L1 config variables for errors.
@@ -313,6 +327,9 @@ func (cfg *Config) Check() error {
 	if err := validateAltDAConfig(cfg); err != nil {
 		return err
 	}
+	if err := validateDepositGasConfig(cfg); err != nil {
+		return err
+	}
 
 	if err := checkFork(cfg.RegolithTime, cfg.CanyonTime, Regolith, Canyon); err != nil {
 		return err
@@ -344,14 +361,37 @@ func (cfg *Config) HasOptimismWithdrawalsRoot(timestamp uint64) bool {
 // If the legacy values are set, they are copied to the new location. If both are set, they are check for consistency.
 func validateAltDAConfig(cfg *Config) error {
 	if cfg.AltDAConfig != nil {
-		if !(cfg.AltDAConfig.CommitmentType == altda.KeccakCommitmentString || cfg.AltDAConfig.CommitmentType == altda.GenericCommitmentString) {
+		switch cfg.AltDAConfig.CommitmentType {
+		case altda.KeccakCommitmentString:
+			if cfg.AltDAConfig.DAChallengeAddress == (common.Address{}) {
+				return errors.New("Must set da_challenge_contract_address for keccak commitments")
+			}
+		case altda.GenericCommitmentString:
+			if cfg.AltDAConfig.DAChallengeAddress != (common.Address{}) {
+				return errors.New("Must set empty da_challenge_contract_address for generic commitments")
+			}
+		case altda.MultiCommitmentString:
+			if cfg.AltDAConfig.DAChallengeAddress != (common.Address{}) {
+				return errors.New("Must set empty da_challenge_contract_address for multi commitments")
+			}
+		default:
 			return fmt.Errorf("invalid commitment type: %v", cfg.AltDAConfig.CommitmentType)
 		}
-		if cfg.AltDAConfig.CommitmentType == altda.KeccakCommitmentString && cfg.AltDAConfig.DAChallengeAddress == (common.Address{}) {
-			return errors.New("Must set da_challenge_contract_address for keccak commitments")
-		} else if cfg.AltDAConfig.CommitmentType == altda.GenericCommitmentString && cfg.AltDAConfig.DAChallengeAddress != (common.Address{}) {
-			return errors.New("Must set empty da_challenge_contract_address for generic commitments")
-		}
+	}
+	return nil
+}
+
+// validateDepositGasConfig checks that the deposit gas overrides cover the intrinsic gas of the system deposits.
+func validateDepositGasConfig(cfg *Config) error {
+	dg := cfg.DepositGasConfig
+	if dg == nil {
+		return nil
+	}
+	if dg.SystemTxGas != 0 && dg.SystemTxGas < params.TxGas {
+		return fmt.Errorf("%w: system tx gas %d", ErrDepositGasTooLow, dg.SystemTxGas)
+	}
+	if dg.DepositsCompleteGas != 0 && dg.DepositsCompleteGas < params.TxGas {
+		return fmt.Errorf("%w: deposits complete gas %d", ErrDepositGasTooLow, dg.DepositsCompleteGas)
 	}
 	return nil
 }
@@ -379,158 +419,120 @@ func (c *Config) L1Signer() types.Signer {
 
 // IsRegolith returns true if the Regolith hardfork is active at or past the given timestamp.
 func (c *Config) IsRegolith(timestamp uint64) bool {
-	return c.RegolithTime != nil && timestamp >= *c.RegolithTime
+	return c.IsForkActive(Regolith, timestamp)
 }
 
 // IsCanyon returns true if the Canyon hardfork is active at or past the given timestamp.
 func (c *Config) IsCanyon(timestamp uint64) bool {
-	return c.CanyonTime != nil && timestamp >= *c.CanyonTime
+	return c.IsForkActive(Canyon, timestamp)
 }
 
 // IsDelta returns true if the Delta hardfork is active at or past the given timestamp.
 func (c *Config) IsDelta(timestamp uint64) bool {
-	return c.DeltaTime != nil && timestamp >= *c.DeltaTime
+	return c.IsForkActive(Delta, timestamp)
 }
 
 // IsEcotone returns true if the Ecotone hardfork is active at or past the given timestamp.
 func (c *Config) IsEcotone(timestamp uint64) bool {
-	return c.EcotoneTime != nil && timestamp >= *c.EcotoneTime
+	return c.IsForkActive(Ecotone, timestamp)
 }
 
 // IsFjord returns true if the Fjord hardfork is active at or past the given timestamp.
 func (c *Config) IsFjord(timestamp uint64) bool {
-	return c.FjordTime != nil && timestamp >= *c.FjordTime
+	return c.IsForkActive(Fjord, timestamp)
 }
 
 // IsGranite returns true if the Granite hardfork is active at or past the given timestamp.
 func (c *Config) IsGranite(timestamp uint64) bool {
-	return c.GraniteTime != nil && timestamp >= *c.GraniteTime
+	return c.IsForkActive(Granite, timestamp)
 }
 
 // IsHolocene returns true if the Holocene hardfork is active at or past the given timestamp.
 func (c *Config) IsHolocene(timestamp uint64) bool {
-	return c.HoloceneTime != nil && timestamp >= *c.HoloceneTime
+	return c.IsForkActive(Holocene, timestamp)
 }
 
 // IsIsthmus returns true if the Isthmus hardfork is active at or past the given timestamp.
 func (c *Config) IsIsthmus(timestamp uint64) bool {
-	return c.IsthmusTime != nil && timestamp >= *c.IsthmusTime
+	return c.IsForkActive(Isthmus, timestamp)
 }
 
 // IsInterop returns true if the Interop hardfork is active at or past the given timestamp.
 func (c *Config) IsInterop(timestamp uint64) bool {
-	return c.InteropTime != nil && timestamp >= *c.InteropTime
+	return c.IsForkActive(Interop, timestamp)
 }
 
 func (c *Config) IsRegolithActivationBlock(l2BlockTime uint64) bool {
-	return c.IsRegolith(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsRegolith(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Regolith, l2BlockTime)
 }
 
 func (c *Config) IsCanyonActivationBlock(l2BlockTime uint64) bool {
-	return c.IsCanyon(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsCanyon(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Canyon, l2BlockTime)
 }
 
 func (c *Config) IsDeltaActivationBlock(l2BlockTime uint64) bool {
-	return c.IsDelta(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsDelta(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Delta, l2BlockTime)
 }
 
 // IsEcotoneActivationBlock returns whether the specified block is the first block subject to the
 // Ecotone upgrade. Ecotone activation at genesis does not count.
 func (c *Config) IsEcotoneActivationBlock(l2BlockTime uint64) bool {
-	return c.IsEcotone(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsEcotone(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Ecotone, l2BlockTime)
 }
 
 // IsFjordActivationBlock returns whether the specified block is the first block subject to the
 // Fjord upgrade.
 func (c *Config) IsFjordActivationBlock(l2BlockTime uint64) bool {
-	return c.IsFjord(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsFjord(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Fjord, l2BlockTime)
 }
 
 // IsGraniteActivationBlock returns whether the specified block is the first block subject to the
 // Granite upgrade.
 func (c *Config) IsGraniteActivationBlock(l2BlockTime uint64) bool {
-	return c.IsGranite(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsGranite(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Granite, l2BlockTime)
 }
 
 // IsHoloceneActivationBlock returns whether the specified block is the first block subject to the
 // Holocene upgrade.
 func (c *Config) IsHoloceneActivationBlock(l2BlockTime uint64) bool {
-	return c.IsHolocene(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsHolocene(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Holocene, l2BlockTime)
 }
 
 // IsIsthmusActivationBlock returns whether the specified block is the first block subject to the
 // Isthmus upgrade.
 func (c *Config) IsIsthmusActivationBlock(l2BlockTime uint64) bool {
-	return c.IsIsthmus(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsIsthmus(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Isthmus, l2BlockTime)
 }
 
 func (c *Config) IsInteropActivationBlock(l2BlockTime uint64) bool {
-	return c.IsInterop(l2BlockTime) &&
-		l2BlockTime >= c.BlockTime &&
-		!c.IsInterop(l2BlockTime-c.BlockTime)
+	return c.IsForkActivationBlock(Interop, l2BlockTime)
 }
 
 // IsActivationBlock returns the fork which activates at the block with time newTime if the previous
 // block's time is oldTime. It return an empty ForkName if no fork activation takes place between
 // those timestamps. It can be used for both, L1 and L2 blocks.
-// TODO(12490): Currently only supports Holocene. Will be modularized in a follow-up.
+// Only forks that transform the derivation pipeline stages are considered, see ForkRules.TransformsStages.
 func (c *Config) IsActivationBlock(oldTime, newTime uint64) ForkName {
-	if c.IsHolocene(newTime) && !c.IsHolocene(oldTime) {
-		return Holocene
+	for _, spec := range forkSpecs {
+		if spec.rules.TransformsStages && c.IsForkActive(spec.name, newTime) && !c.IsForkActive(spec.name, oldTime) {
+			return spec.name
+		}
 	}
 	return ""
 }
 
+// ActivateAtGenesis activates the given fork, and all forks before it, at genesis.
 func (c *Config) ActivateAtGenesis(hardfork ForkName) {
-	// IMPORTANT! ordered from newest to oldest
-	switch hardfork {
-	case Interop:
-		c.InteropTime = new(uint64)
-		fallthrough
-	case Isthmus:
-		c.IsthmusTime = new(uint64)
-		fallthrough
-	case Holocene:
-		c.HoloceneTime = new(uint64)
-		fallthrough
-	case Granite:
-		c.GraniteTime = new(uint64)
-		fallthrough
-	case Fjord:
-		c.FjordTime = new(uint64)
-		fallthrough
-	case Ecotone:
-		c.EcotoneTime = new(uint64)
-		fallthrough
-	case Delta:
-		c.DeltaTime = new(uint64)
-		fallthrough
-	case Canyon:
-		c.CanyonTime = new(uint64)
-		fallthrough
-	case Regolith:
-		c.RegolithTime = new(uint64)
-		fallthrough
-	case Bedrock:
-		// default
-	case None:
-		break
+	if !IsValidFork(hardfork) {
+		return
+	}
+	for _, fork := range AllForks {
+		if spec := mustForkSpec(fork); spec.activation != nil {
+			*spec.activation(c) = new(uint64)
+		}
+		if fork == hardfork {
+			return
+		}
 	}
 }
 
@@ -652,6 +654,10 @@ func (c *Config) Description(l2Chains map[string]string) string {
 	if c.AltDAConfig != nil {
 		banner += fmt.Sprintf("Node supports Alt-DA Mode with CommitmentType %v\n", c.AltDAConfig.CommitmentType)
 	}
+	if c.DepositGasConfig != nil {
+		banner += fmt.Sprintf("Deposit gas overrides: system tx gas %d, deposits complete gas %d\n",
+			c.DepositGasConfig.SystemTxGas, c.DepositGasConfig.DepositsCompleteGas)
+	}
 	return banner
 }
 
@@ -685,6 +691,7 @@ func (c *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 		"isthmus_time", fmtForkTimeOrUnset(c.IsthmusTime),
 		"interop_time", fmtForkTimeOrUnset(c.InteropTime),
 		"alt_da", c.AltDAConfig != nil,
+		"deposit_gas_overrides", c.DepositGasConfig != nil,
 	)
 }
 
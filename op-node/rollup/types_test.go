@@ -518,6 +518,23 @@ func TestConfig_Check(t *testing.T) {
 			modifier:    func(cfg *Config) { cfg.L2ChainID = big.NewInt(0) },
 			expectedErr: ErrL2ChainIDNotPositive,
 		},
+		{
+			name: "DepositGasOverrides",
+			modifier: func(cfg *Config) {
+				cfg.DepositGasConfig = &DepositGasConfig{SystemTxGas: 2_000_000, DepositsCompleteGas: 50_000}
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "SystemTxGasTooLow",
+			modifier:    func(cfg *Config) { cfg.DepositGasConfig = &DepositGasConfig{SystemTxGas: 20_999} },
+			expectedErr: ErrDepositGasTooLow,
+		},
+		{
+			name:        "DepositsCompleteGasTooLow",
+			modifier:    func(cfg *Config) { cfg.DepositGasConfig = &DepositGasConfig{DepositsCompleteGas: 1} },
+			expectedErr: ErrDepositGasTooLow,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
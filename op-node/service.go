@@ -16,6 +16,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/node"
+	"github.com/ethereum-optimism/optimism/op-node/node/attest"
+	"github.com/ethereum-optimism/optimism/op-node/node/ingress"
+	"github.com/ethereum-optimism/optimism/op-node/node/logindex"
+	"github.com/ethereum-optimism/optimism/op-node/node/txfeed"
 	p2pcli "github.com/ethereum-optimism/optimism/op-node/p2p/cli"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
@@ -24,6 +28,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 )
@@ -44,6 +49,14 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		rollupConfig.ProtocolVersionsAddress = common.Address{}
 	}
 
+	var runtimeConfigAddress common.Address
+	if addr := ctx.String(flags.RuntimeConfigAddressFlag.Name); addr != "" {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid runtime config address: %q", addr)
+		}
+		runtimeConfigAddress = common.HexToAddress(addr)
+	}
+
 	configPersistence := NewConfigPersistence(ctx)
 
 	driverConfig := NewDriverConfig(ctx)
@@ -70,6 +83,16 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, fmt.Errorf("failed to create the sync config: %w", err)
 	}
 
+	txFeedConfig, err := NewTxFeedConfig(ctx, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tx feed config: %w", err)
+	}
+
+	logIndexConfig, err := NewLogIndexConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load log index config: %w", err)
+	}
+
 	haltOption := ctx.String(flags.RollupHalt.Name)
 	if haltOption == "none" {
 		haltOption = ""
@@ -103,6 +126,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
+		RuntimeConfigAddress:        runtimeConfigAddress,
 		ConfigPersistence:           configPersistence,
 		SafeDBPath:                  ctx.String(flags.SafeDBPath.Name),
 		Sync:                        *syncConfig,
@@ -115,6 +139,14 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		ConductorRpcTimeout: ctx.Duration(flags.ConductorRpcTimeoutFlag.Name),
 
 		AltDA: altda.ReadCLIConfig(ctx),
+
+		TxIngress: NewTxIngressConfig(ctx),
+
+		TxFeed: *txFeedConfig,
+
+		Attest: NewAttestConfig(ctx),
+
+		LogIndex: *logIndexConfig,
 	}
 
 	if err := cfg.LoadPersisted(log); err != nil {
@@ -148,6 +180,7 @@ func NewBeaconEndpointConfig(ctx *cli.Context) node.L1BeaconEndpointSetup {
 		BeaconFallbackAddrs:    ctx.StringSlice(flags.BeaconFallbackAddrs.Name),
 		BeaconCheckIgnore:      ctx.Bool(flags.BeaconCheckIgnore.Name),
 		BeaconFetchAllSidecars: ctx.Bool(flags.BeaconFetchAllSidecars.Name),
+		BeaconBuffered:         ctx.Bool(flags.BeaconBuffered.Name),
 	}
 }
 
@@ -172,8 +205,9 @@ func NewL2EndpointConfig(ctx *cli.Context, logger log.Logger) (*node.L2EndpointC
 		return nil, err
 	}
 	return &node.L2EndpointConfig{
-		L2EngineAddr:      l2Addr,
-		L2EngineJWTSecret: secret,
+		L2EngineAddr:         l2Addr,
+		L2EngineJWTSecret:    secret,
+		L2EngineStandbyAddrs: ctx.StringSlice(flags.L2EngineStandbyAddrs.Name),
 	}, nil
 }
 
@@ -187,12 +221,74 @@ func NewConfigPersistence(ctx *cli.Context) node.ConfigPersistence {
 
 func NewDriverConfig(ctx *cli.Context) *driver.Config {
 	return &driver.Config{
-		VerifierConfDepth:   ctx.Uint64(flags.VerifierL1Confs.Name),
-		SequencerConfDepth:  ctx.Uint64(flags.SequencerL1Confs.Name),
-		SequencerEnabled:    ctx.Bool(flags.SequencerEnabledFlag.Name),
-		SequencerStopped:    ctx.Bool(flags.SequencerStoppedFlag.Name),
-		SequencerMaxSafeLag: ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
+		VerifierConfDepth:    ctx.Uint64(flags.VerifierL1Confs.Name),
+		SequencerConfDepth:   ctx.Uint64(flags.SequencerL1Confs.Name),
+		SequencerEnabled:     ctx.Bool(flags.SequencerEnabledFlag.Name),
+		SequencerStopped:     ctx.Bool(flags.SequencerStoppedFlag.Name),
+		SequencerMaxSafeLag:  ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
+		BatcherDataCachePath: ctx.String(flags.BatcherDataCachePath.Name),
+		WitnessDir:           ctx.String(flags.PipelineWitnessDir.Name),
+	}
+}
+
+func NewTxFeedConfig(ctx *cli.Context, logger log.Logger) (*txfeed.Config, error) {
+	addr := ctx.String(flags.SequencerTxFeedFlag.Name)
+	if addr == "" {
+		return &txfeed.Config{}, nil
+	}
+	secret, err := rpc.ObtainJWTSecret(logger, ctx.String(flags.SequencerTxFeedJWTSecretFlag.Name), false)
+	if err != nil {
+		return nil, err
 	}
+	return &txfeed.Config{
+		Addr:      addr,
+		JWTSecret: secret,
+		Timeout:   ctx.Duration(flags.SequencerTxFeedTimeoutFlag.Name),
+	}, nil
+}
+
+func NewTxIngressConfig(ctx *cli.Context) ingress.Config {
+	return ingress.Config{
+		Enabled: ctx.Bool(flags.SequencerTxIngressFlag.Name),
+		Limits: ingress.Limits{
+			GlobalRate:   ctx.Float64(flags.SequencerTxIngressGlobalRateFlag.Name),
+			GlobalBurst:  ctx.Int(flags.SequencerTxIngressGlobalBurstFlag.Name),
+			SenderRate:   ctx.Float64(flags.SequencerTxIngressSenderRateFlag.Name),
+			SenderBurst:  ctx.Int(flags.SequencerTxIngressSenderBurstFlag.Name),
+			MaxTxSize:    ctx.Uint64(flags.SequencerTxIngressMaxTxSizeFlag.Name),
+			DustValue:    ctx.Uint64(flags.SequencerTxIngressDustValueFlag.Name),
+			MaxSpamScore: ctx.Float64(flags.SequencerTxIngressMaxSpamScoreFlag.Name),
+		},
+	}
+}
+
+func NewAttestConfig(ctx *cli.Context) attest.Config {
+	return attest.Config{
+		Enabled:     ctx.Bool(flags.AttestEnabledFlag.Name),
+		ListenAddr:  ctx.String(flags.AttestAddrFlag.Name),
+		ListenPort:  ctx.Int(flags.AttestPortFlag.Name),
+		Interval:    ctx.Duration(flags.AttestIntervalFlag.Name),
+		HistorySize: ctx.Int(flags.AttestHistorySizeFlag.Name),
+	}
+}
+
+// NewLogIndexConfig reads the log index config. Contracts are given by predeploy name or by address.
+func NewLogIndexConfig(ctx *cli.Context) (*logindex.Config, error) {
+	cfg := &logindex.Config{
+		Retention: ctx.Uint64(flags.LogIndexRetentionFlag.Name),
+	}
+	for _, name := range ctx.StringSlice(flags.LogIndexAddressesFlag.Name) {
+		if common.IsHexAddress(name) {
+			cfg.Addresses = append(cfg.Addresses, common.HexToAddress(name))
+			continue
+		}
+		predeploy, ok := predeploys.Predeploys[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown predeploy %q, expected a predeploy name or address", name)
+		}
+		cfg.Addresses = append(cfg.Addresses, predeploy.Address)
+	}
+	return cfg, nil
 }
 
 func NewRollupConfigFromCLI(log log.Logger, ctx *cli.Context) (*rollup.Config, error) {
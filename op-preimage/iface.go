@@ -141,3 +141,15 @@ type HinterFn func(v Hint)
 func (fn HinterFn) Hint(v Hint) {
 	fn(v)
 }
+
+// HintCheckpoint is the type of the hints that mark a point in the execution of a program,
+// that a VM can snapshot the state at, to restore it from when executing the program again with the same inputs.
+// The hints do not request any pre-images, so hosts ignore them.
+const HintCheckpoint = "checkpoint"
+
+// CheckpointHint marks a checkpoint, identified by a commitment to the inputs the program state depends on.
+type CheckpointHint [32]byte
+
+func (c CheckpointHint) Hint() string {
+	return HintCheckpoint + " 0x" + hex.EncodeToString(c[:])
+}
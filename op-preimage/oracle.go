@@ -3,10 +3,15 @@ package preimage
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 )
 
+// ErrPreimageUnavailable is wrapped by the error the OracleClient panics with when a pre-image cannot be read,
+// e.g. because the oracle server does not have the pre-image and stopped.
+var ErrPreimageUnavailable = errors.New("pre-image unavailable")
+
 // OracleClient implements the Oracle by writing the pre-image key to the given stream,
 // and reading back a length-prefixed value.
 type OracleClient struct {
@@ -22,16 +27,16 @@ var _ Oracle = (*OracleClient)(nil)
 func (o *OracleClient) Get(key Key) []byte {
 	h := key.PreimageKey()
 	if _, err := o.rw.Write(h[:]); err != nil {
-		panic(fmt.Errorf("failed to write key %s (%T) to pre-image oracle: %w", key, key, err))
+		panic(fmt.Errorf("%w: failed to write key %s (%T) to pre-image oracle: %w", ErrPreimageUnavailable, key, key, err))
 	}
 
 	var length uint64
 	if err := binary.Read(o.rw, binary.BigEndian, &length); err != nil {
-		panic(fmt.Errorf("failed to read pre-image length of key %s (%T) from pre-image oracle: %w", key, key, err))
+		panic(fmt.Errorf("%w: failed to read pre-image length of key %s (%T) from pre-image oracle: %w", ErrPreimageUnavailable, key, key, err))
 	}
 	payload := make([]byte, length)
 	if _, err := io.ReadFull(o.rw, payload); err != nil {
-		panic(fmt.Errorf("failed to read pre-image payload (length %d) of key %s (%T) from pre-image oracle: %w", length, key, key, err))
+		panic(fmt.Errorf("%w: failed to read pre-image payload (length %d) of key %s (%T) from pre-image oracle: %w", ErrPreimageUnavailable, length, key, key, err))
 	}
 
 	return payload
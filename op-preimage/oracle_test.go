@@ -81,3 +81,22 @@ func TestOracle(t *testing.T) {
 		testPreimage(dat)
 	})
 }
+
+func TestOracleClientPanicsWhenUnavailable(t *testing.T) {
+	a, b := bidirectionalPipe()
+	cl := NewOracleClient(a)
+	go func() {
+		// Read the key, and close the channel without responding, as a server without the pre-image would.
+		var key [32]byte
+		_, _ = io.ReadFull(b, key[:])
+		_ = b.(readWritePair).Writer.(*io.PipeWriter).Close()
+	}()
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		err, ok := r.(error)
+		require.True(t, ok)
+		require.ErrorIs(t, err, ErrPreimageUnavailable)
+	}()
+	cl.Get(Keccak256Key(Keccak256([]byte("missing"))))
+}
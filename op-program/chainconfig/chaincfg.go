@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -21,15 +23,89 @@ func OPSepoliaChainConfig() *params.ChainConfig {
 //go:embed configs/*json
 var customChainConfigFS embed.FS
 
-func RollupConfigByChainID(chainID uint64) (*rollup.Config, error) {
-	config, err := rollup.LoadOPStackRollupConfig(chainID)
-	if err == nil {
-		return config, err
+// Bundle is a set of chain configs the program loads the configs of a chain from.
+type Bundle struct {
+	// customChainFS holds the custom configs, in the layout of the embedded configs directory.
+	customChainFS fs.FS
+	// useRegistry sets the bundle to load chains from the superchain registry, before its custom configs.
+	useRegistry bool
+	// rollupConfigs, chainConfigs and depSet hold the configs of a deserialized bundle, instead of customChainFS.
+	rollupConfigs map[uint64]*rollup.Config
+	chainConfigs  map[uint64]*params.ChainConfig
+	depSet        *depset.StaticConfigDependencySet
+}
+
+var embeddedBundle = &Bundle{customChainFS: customChainConfigFS, useRegistry: true}
+
+// EmbeddedBundle returns the bundle of the superchain registry and the custom configs embedded in the program.
+func EmbeddedBundle() *Bundle {
+	return embeddedBundle
+}
+
+// NewDirBundle returns the bundle of the configs in the configs subdirectory of dir, in the layout of
+// the embedded configs directory. It is used to compare the program with different embedded configs,
+// without rebuilding it. The superchain registry is not used, so the bundle must contain every chain.
+func NewDirBundle(dir string) *Bundle {
+	return &Bundle{customChainFS: os.DirFS(dir)}
+}
+
+func (b *Bundle) RollupConfig(chainID uint64) (*rollup.Config, error) {
+	if b.useRegistry {
+		if config, err := rollup.LoadOPStackRollupConfig(chainID); err == nil {
+			return config, nil
+		}
+	}
+	if b.rollupConfigs != nil {
+		if config, ok := b.rollupConfigs[chainID]; ok {
+			return config, nil
+		}
+		return nil, fmt.Errorf("no rollup config available for chain ID: %d", chainID)
+	}
+	return rollupConfigByChainID(chainID, b.customChainFS)
+}
+
+func (b *Bundle) ChainConfig(chainID uint64) (*params.ChainConfig, error) {
+	if b.useRegistry {
+		if config, err := params.LoadOPStackChainConfig(chainID); err == nil {
+			return config, nil
+		}
 	}
-	return rollupConfigByChainID(chainID, customChainConfigFS)
+	if b.chainConfigs != nil {
+		if config, ok := b.chainConfigs[chainID]; ok {
+			return config, nil
+		}
+		return nil, fmt.Errorf("no chain config available for chain ID: %d", chainID)
+	}
+	return chainConfigByChainID(chainID, b.customChainFS)
 }
 
-func rollupConfigByChainID(chainID uint64, customChainFS embed.FS) (*rollup.Config, error) {
+// DependencySet returns the interop dependency set of the chains of the bundle.
+// It is loaded from configs/depset.json, in the format of the supervisor dependency set config.
+func (b *Bundle) DependencySet() (depset.DependencySet, error) {
+	if b.rollupConfigs != nil {
+		if b.depSet == nil {
+			return nil, errors.New("no dependency set available")
+		}
+		return b.depSet, nil
+	}
+	data, err := fs.ReadFile(b.customChainFS, "configs/depset.json")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New("no dependency set available")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get dependency set: %w", err)
+	}
+	var depSet depset.StaticConfigDependencySet
+	if err := json.Unmarshal(data, &depSet); err != nil {
+		return nil, fmt.Errorf("failed to parse dependency set: %w", err)
+	}
+	return &depSet, nil
+}
+
+func RollupConfigByChainID(chainID uint64) (*rollup.Config, error) {
+	return embeddedBundle.RollupConfig(chainID)
+}
+
+func rollupConfigByChainID(chainID uint64, customChainFS fs.FS) (*rollup.Config, error) {
 	// Load custom rollup configs from embed FS
 	file, err := customChainFS.Open(fmt.Sprintf("configs/%d-rollup.json", chainID))
 	if errors.Is(err, os.ErrNotExist) {
@@ -44,16 +120,12 @@ func rollupConfigByChainID(chainID uint64, customChainFS embed.FS) (*rollup.Conf
 }
 
 func ChainConfigByChainID(chainID uint64) (*params.ChainConfig, error) {
-	config, err := params.LoadOPStackChainConfig(chainID)
-	if err == nil {
-		return config, err
-	}
-	return chainConfigByChainID(chainID, customChainConfigFS)
+	return embeddedBundle.ChainConfig(chainID)
 }
 
-func chainConfigByChainID(chainID uint64, customChainFS embed.FS) (*params.ChainConfig, error) {
+func chainConfigByChainID(chainID uint64, customChainFS fs.FS) (*params.ChainConfig, error) {
 	// Load from custom chain configs from embed FS
-	data, err := customChainFS.ReadFile(fmt.Sprintf("configs/%d-genesis-l2.json", chainID))
+	data, err := fs.ReadFile(customChainFS, fmt.Sprintf("configs/%d-genesis-l2.json", chainID))
 	if errors.Is(err, os.ErrNotExist) {
 		return nil, fmt.Errorf("no chain config available for chain ID: %d", chainID)
 	} else if err != nil {
@@ -1,9 +1,14 @@
 package chainconfig
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,3 +32,88 @@ func TestGetCustomChainConfig(t *testing.T) {
 	_, err = chainConfigByChainID(900, test.TestCustomChainConfigFS)
 	require.Error(t, err)
 }
+
+func TestDirBundle(t *testing.T) {
+	bundle := NewDirBundle("test")
+	rollupCfg, err := bundle.RollupConfig(901)
+	require.NoError(t, err)
+	require.Equal(t, uint64(901), rollupCfg.L2ChainID.Uint64())
+	chainCfg, err := bundle.ChainConfig(901)
+	require.NoError(t, err)
+	require.Equal(t, uint64(901), chainCfg.ChainID.Uint64())
+
+	// Chains of the superchain registry are not loaded from the registry
+	_, err = bundle.RollupConfig(OPSepoliaChainConfig().ChainID.Uint64())
+	require.Error(t, err)
+	_, err = bundle.ChainConfig(OPSepoliaChainConfig().ChainID.Uint64())
+	require.Error(t, err)
+
+	depSet, err := bundle.DependencySet()
+	require.NoError(t, err)
+	require.Equal(t, []eth.ChainID{eth.ChainIDFromUInt64(901)}, depSet.Chains())
+	require.Equal(t, uint64(3600), depSet.MessageExpiryWindow())
+}
+
+func TestSerializedBundle(t *testing.T) {
+	rollupCfg1, err := rollupConfigByChainID(901, test.TestCustomChainConfigFS)
+	require.NoError(t, err)
+	chainCfg1, err := chainConfigByChainID(901, test.TestCustomChainConfigFS)
+	require.NoError(t, err)
+	rollupCfg2 := *rollupCfg1
+	rollupCfg2.L2ChainID = big.NewInt(902)
+	chainCfg2 := *chainCfg1
+	chainCfg2.ChainID = big.NewInt(902)
+	depSet, err := depset.NewStaticConfigDependencySetWithMessageExpiryWindow(map[eth.ChainID]*depset.StaticConfigDependency{
+		eth.ChainIDFromUInt64(901): {ChainIndex: 1},
+		eth.ChainIDFromUInt64(902): {ChainIndex: 2},
+	}, 3600)
+	require.NoError(t, err)
+
+	data, err := SerializeBundle([]*rollup.Config{&rollupCfg2, rollupCfg1}, []*params.ChainConfig{chainCfg1, &chainCfg2}, depSet)
+	require.NoError(t, err)
+	// The encoding does not depend on the order of the configs
+	reordered, err := SerializeBundle([]*rollup.Config{rollupCfg1, &rollupCfg2}, []*params.ChainConfig{&chainCfg2, chainCfg1}, depSet)
+	require.NoError(t, err)
+	require.Equal(t, data, reordered)
+
+	bundle, err := DeserializeBundle(data)
+	require.NoError(t, err)
+	for _, chainID := range []uint64{901, 902} {
+		rollupCfg, err := bundle.RollupConfig(chainID)
+		require.NoError(t, err)
+		require.Equal(t, chainID, rollupCfg.L2ChainID.Uint64())
+		chainCfg, err := bundle.ChainConfig(chainID)
+		require.NoError(t, err)
+		require.Equal(t, chainID, chainCfg.ChainID.Uint64())
+	}
+	require.Equal(t, rollupCfg1.Genesis, bundle.rollupConfigs[901].Genesis)
+	bundleDepSet, err := bundle.DependencySet()
+	require.NoError(t, err)
+	require.Equal(t, depSet, bundleDepSet)
+
+	// Chains of the superchain registry are not loaded from the registry
+	_, err = bundle.RollupConfig(OPSepoliaChainConfig().ChainID.Uint64())
+	require.Error(t, err)
+	_, err = bundle.ChainConfig(OPSepoliaChainConfig().ChainID.Uint64())
+	require.Error(t, err)
+
+	_, err = SerializeBundle([]*rollup.Config{rollupCfg1, &rollupCfg2}, []*params.ChainConfig{chainCfg1}, depSet)
+	require.Error(t, err)
+	_, err = SerializeBundle([]*rollup.Config{rollupCfg1}, []*params.ChainConfig{chainCfg1}, nil)
+	require.Error(t, err)
+	otherDepSet, err := depset.NewStaticConfigDependencySet(map[eth.ChainID]*depset.StaticConfigDependency{
+		eth.ChainIDFromUInt64(902): {ChainIndex: 2},
+	})
+	require.NoError(t, err)
+	_, err = SerializeBundle([]*rollup.Config{rollupCfg1}, []*params.ChainConfig{chainCfg1}, otherDepSet)
+	require.ErrorContains(t, err, "not in the dependency set")
+
+	_, err = DeserializeBundle([]byte(`{"chains":[{"rollup":{"l2_chain_id":901},"genesis":{"config":{"chainId":902}}}],"dependencySet":{"dependencies":{}}}`))
+	require.ErrorIs(t, err, ErrInvalidSerializedBundle)
+	_, err = DeserializeBundle([]byte(`{"chains":[{"rollup":{"l2_chain_id":901},"genesis":{"config":{"chainId":901}}}],"dependencySet":{"dependencies":{}}}`))
+	require.ErrorIs(t, err, ErrInvalidSerializedBundle)
+	_, err = DeserializeBundle([]byte(`{"chains":[]}`))
+	require.ErrorIs(t, err, ErrInvalidSerializedBundle)
+	_, err = DeserializeBundle([]byte("{"))
+	require.ErrorIs(t, err, ErrInvalidSerializedBundle)
+}
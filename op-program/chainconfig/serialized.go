@@ -0,0 +1,100 @@
+package chainconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var ErrInvalidSerializedBundle = errors.New("invalid serialized bundle")
+
+// serializedBundle is the encoding of a bundle, the configs of its chains and their dependency set.
+type serializedBundle struct {
+	Chains        []serializedChain                  `json:"chains"`
+	DependencySet *depset.StaticConfigDependencySet `json:"dependencySet"`
+}
+
+// serializedChain is a chain of a serialized bundle, with the contents of its rollup.json and genesis.json.
+// The genesis only includes its chain config, as the program does not use the genesis allocs.
+type serializedChain struct {
+	Rollup  *rollup.Config    `json:"rollup"`
+	Genesis serializedGenesis `json:"genesis"`
+}
+
+type serializedGenesis struct {
+	Config *params.ChainConfig `json:"config"`
+}
+
+// SerializeBundle encodes the rollup and chain configs of a set of chains and their dependency set into a bundle,
+// in order of chain ID, so that the same configs always result in the same bundle and the bundle can be committed
+// to by its hash. Every chain must be part of the dependency set.
+func SerializeBundle(rollupCfgs []*rollup.Config, chainCfgs []*params.ChainConfig, depSet *depset.StaticConfigDependencySet) ([]byte, error) {
+	if depSet == nil {
+		return nil, errors.New("no dependency set")
+	}
+	chainCfgByID := make(map[uint64]*params.ChainConfig, len(chainCfgs))
+	for _, chainCfg := range chainCfgs {
+		chainCfgByID[chainCfg.ChainID.Uint64()] = chainCfg
+	}
+	chains := make([]serializedChain, 0, len(rollupCfgs))
+	for _, rollupCfg := range rollupCfgs {
+		chainID := rollupCfg.L2ChainID.Uint64()
+		chainCfg, ok := chainCfgByID[chainID]
+		if !ok {
+			return nil, fmt.Errorf("no chain config for chain ID %d", chainID)
+		}
+		if !depSet.HasChain(eth.ChainIDFromUInt64(chainID)) {
+			return nil, fmt.Errorf("chain ID %d is not in the dependency set", chainID)
+		}
+		chains = append(chains, serializedChain{Rollup: rollupCfg, Genesis: serializedGenesis{Config: chainCfg}})
+	}
+	if len(chains) != len(chainCfgs) {
+		return nil, fmt.Errorf("got %d chain configs for %d rollup configs", len(chainCfgs), len(rollupCfgs))
+	}
+	slices.SortFunc(chains, func(a, b serializedChain) int {
+		return a.Rollup.L2ChainID.Cmp(b.Rollup.L2ChainID)
+	})
+	return json.Marshal(serializedBundle{Chains: chains, DependencySet: depSet})
+}
+
+// DeserializeBundle decodes a bundle encoded by SerializeBundle.
+// The superchain registry is not used, so the bundle must contain every chain it is used for.
+func DeserializeBundle(data []byte) (*Bundle, error) {
+	var bundle serializedBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSerializedBundle, err)
+	}
+	if bundle.DependencySet == nil {
+		return nil, fmt.Errorf("%w: missing dependency set", ErrInvalidSerializedBundle)
+	}
+	b := &Bundle{
+		rollupConfigs: make(map[uint64]*rollup.Config, len(bundle.Chains)),
+		chainConfigs:  make(map[uint64]*params.ChainConfig, len(bundle.Chains)),
+		depSet:        bundle.DependencySet,
+	}
+	for i, chain := range bundle.Chains {
+		if chain.Rollup == nil || chain.Rollup.L2ChainID == nil || chain.Genesis.Config == nil || chain.Genesis.Config.ChainID == nil {
+			return nil, fmt.Errorf("%w: chain %d is missing its configs", ErrInvalidSerializedBundle, i)
+		}
+		chainID := chain.Rollup.L2ChainID.Uint64()
+		if chain.Genesis.Config.ChainID.Uint64() != chainID {
+			return nil, fmt.Errorf("%w: rollup config of chain ID %d has chain config of chain ID %v",
+				ErrInvalidSerializedBundle, chainID, chain.Genesis.Config.ChainID)
+		}
+		if _, ok := b.rollupConfigs[chainID]; ok {
+			return nil, fmt.Errorf("%w: duplicate chain ID %d", ErrInvalidSerializedBundle, chainID)
+		}
+		if !bundle.DependencySet.HasChain(eth.ChainIDFromUInt64(chainID)) {
+			return nil, fmt.Errorf("%w: chain ID %d is not in the dependency set", ErrInvalidSerializedBundle, chainID)
+		}
+		b.rollupConfigs[chainID] = chain.Rollup
+		b.chainConfigs[chainID] = chain.Genesis.Config
+	}
+	return b, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -21,16 +22,25 @@ type BootInfo struct {
 	L2ClaimBlockNumber uint64
 	L2ChainID          uint64
 
+	AcceleratedPrecompiles engineapi.PrecompileFlags
+
 	L2ChainConfig *params.ChainConfig
 	RollupConfig  *rollup.Config
 }
 
 type BootstrapClient struct {
-	r oracleClient
+	r       oracleClient
+	configs ConfigSource
 }
 
 func NewBootstrapClient(r oracleClient) *BootstrapClient {
-	return &BootstrapClient{r: r}
+	return NewBootstrapClientWithConfigs(r, chainconfig.EmbeddedBundle())
+}
+
+// NewBootstrapClientWithConfigs creates a BootstrapClient that loads the configs of chains
+// that are not custom from the given source, instead of the configs embedded in the program.
+func NewBootstrapClientWithConfigs(r oracleClient, configs ConfigSource) *BootstrapClient {
+	return &BootstrapClient{r: r, configs: configs}
 }
 
 func (br *BootstrapClient) BootInfo() *BootInfo {
@@ -42,7 +52,13 @@ func (br *BootstrapClient) BootInfo() *BootInfo {
 
 	var l2ChainConfig *params.ChainConfig
 	var rollupConfig *rollup.Config
+	// Named chains always accelerate all precompiles, as only custom chains can provide additional local keys.
+	// The dispute games only provide the local keys up to the L2 chain ID on-chain, so reading the
+	// accelerated precompiles for named chains would leave the program unable to run on-chain.
+	// The host rejects other precompile settings for named chains accordingly.
+	acceleratedPrecompiles := engineapi.AllPrecompiles
 	if l2ChainID == CustomChainIDIndicator {
+		acceleratedPrecompiles = readAcceleratedPrecompiles(br.r)
 		l2ChainConfig = new(params.ChainConfig)
 		err := json.Unmarshal(br.r.Get(L2ChainConfigLocalIndex), &l2ChainConfig)
 		if err != nil {
@@ -55,11 +71,11 @@ func (br *BootstrapClient) BootInfo() *BootInfo {
 		}
 	} else {
 		var err error
-		rollupConfig, err = chainconfig.RollupConfigByChainID(l2ChainID)
+		rollupConfig, err = br.configs.RollupConfig(l2ChainID)
 		if err != nil {
 			panic(err)
 		}
-		l2ChainConfig, err = chainconfig.ChainConfigByChainID(l2ChainID)
+		l2ChainConfig, err = br.configs.ChainConfig(l2ChainID)
 		if err != nil {
 			panic(err)
 		}
@@ -71,7 +87,10 @@ func (br *BootstrapClient) BootInfo() *BootInfo {
 		L2Claim:            l2Claim,
 		L2ClaimBlockNumber: l2ClaimBlockNumber,
 		L2ChainID:          l2ChainID,
-		L2ChainConfig:      l2ChainConfig,
-		RollupConfig:       rollupConfig,
+
+		AcceleratedPrecompiles: acceleratedPrecompiles,
+
+		L2ChainConfig: l2ChainConfig,
+		RollupConfig:  rollupConfig,
 	}
 }
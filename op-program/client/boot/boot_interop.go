@@ -5,15 +5,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
-	ErrUnknownChainID = errors.New("unknown chain id")
+	ErrUnknownChainID      = errors.New("unknown chain id")
+	ErrInconsistentConfigs = errors.New("inconsistent chain configs")
 )
 
 type BootInfoInterop struct {
@@ -21,21 +27,47 @@ type BootInfoInterop struct {
 
 	L1Head         common.Hash
 	AgreedPrestate common.Hash
+	// Claim is the claim to validate the post-state against. BootstrapInterop leaves it unset, see ReadClaim.
 	Claim          common.Hash
 	ClaimTimestamp uint64
+
+	// AcceleratedPrecompiles are always all precompiles, as the dispute games only provide the local keys
+	// up to the L2 chain ID on-chain, see BootstrapClient.BootInfo.
+	AcceleratedPrecompiles engineapi.PrecompileFlags
+
+	oracle oracleClient
 }
 
 type ConfigSource interface {
 	RollupConfig(chainID uint64) (*rollup.Config, error)
 	ChainConfig(chainID uint64) (*params.ChainConfig, error)
+	// DependencySet returns the interop dependency set of the chains, that decides what messages they may execute.
+	DependencySet() (depset.DependencySet, error)
+}
+
+// HintConfigBundle requests the serialized config bundle of the custom chains.
+const HintConfigBundle = "config-bundle"
+
+// ConfigBundleHint is the hint for the serialized config bundle with the given keccak256 commitment.
+type ConfigBundleHint common.Hash
+
+var _ preimage.Hint = ConfigBundleHint{}
+
+func (l ConfigBundleHint) Hint() string {
+	return HintConfigBundle + " " + (common.Hash)(l).String()
 }
+
+// OracleConfigSource loads the configs of the chains embedded in the program,
+// and the configs of custom chains from the config bundle that the boot info commits to.
 type OracleConfigSource struct {
 	oracle oracleClient
+	hinter preimage.Hinter
 
-	customConfigsLoaded bool
+	customConfigs *chainconfig.Bundle
 
 	l2ChainConfigs map[uint64]*params.ChainConfig
 	rollupConfigs  map[uint64]*rollup.Config
+	depSet         depset.DependencySet
 }
 
 func (c *OracleConfigSource) RollupConfig(chainID uint64) (*rollup.Config, error) {
@@ -43,15 +75,11 @@ func (c *OracleConfigSource) RollupConfig(chainID uint64) (*rollup.Config, error
 		return cfg, nil
 	}
 	cfg, err := chainconfig.RollupConfigByChainID(chainID)
-	if !c.customConfigsLoaded && err != nil {
-		c.loadCustomConfigs()
-		if cfg, ok := c.rollupConfigs[chainID]; !ok {
+	if err != nil {
+		cfg, err = c.loadCustomConfigs().RollupConfig(chainID)
+		if err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrUnknownChainID, chainID)
-		} else {
-			return cfg, nil
 		}
-	} else if err != nil {
-		return nil, err
 	}
 	c.rollupConfigs[chainID] = cfg
 	return cfg, nil
@@ -62,56 +90,197 @@ func (c *OracleConfigSource) ChainConfig(chainID uint64) (*params.ChainConfig, e
 		return cfg, nil
 	}
 	cfg, err := chainconfig.ChainConfigByChainID(chainID)
-	if !c.customConfigsLoaded && err != nil {
-		c.loadCustomConfigs()
-		if cfg, ok := c.l2ChainConfigs[chainID]; !ok {
+	if err != nil {
+		cfg, err = c.loadCustomConfigs().ChainConfig(chainID)
+		if err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrUnknownChainID, chainID)
-		} else {
-			return cfg, nil
 		}
-	} else if err != nil {
-		return nil, err
 	}
 	c.l2ChainConfigs[chainID] = cfg
 	return cfg, nil
 }
 
-func (c *OracleConfigSource) loadCustomConfigs() {
-	var rollupConfigs []*rollup.Config
-	err := json.Unmarshal(c.oracle.Get(RollupConfigLocalIndex), &rollupConfigs)
-	if err != nil {
-		panic("failed to bootstrap rollup configs")
+func (c *OracleConfigSource) DependencySet() (depset.DependencySet, error) {
+	if c.depSet != nil {
+		return c.depSet, nil
 	}
-	for _, config := range rollupConfigs {
-		c.rollupConfigs[config.L2ChainID.Uint64()] = config
+	depSet, err := chainconfig.EmbeddedBundle().DependencySet()
+	if err != nil {
+		depSet, err = c.loadCustomConfigs().DependencySet()
+		if err != nil {
+			return nil, err
+		}
 	}
+	c.depSet = depSet
+	return depSet, nil
+}
 
-	var chainConfigs []*params.ChainConfig
-	err = json.Unmarshal(c.oracle.Get(L2ChainConfigLocalIndex), &chainConfigs)
-	if err != nil {
-		panic("failed to bootstrap chain configs")
+// loadCustomConfigs loads the config bundle of the custom chains on first use.
+// The bundle is a keccak256 pre-image of the commitment in the boot info, so the configs are verified by the oracle.
+func (c *OracleConfigSource) loadCustomConfigs() *chainconfig.Bundle {
+	if c.customConfigs != nil {
+		return c.customConfigs
 	}
-	for _, config := range chainConfigs {
-		c.l2ChainConfigs[config.ChainID.Uint64()] = config
+	commitment := common.BytesToHash(c.oracle.Get(ConfigBundleLocalIndex))
+	c.hinter.Hint(ConfigBundleHint(commitment))
+	bundle, err := chainconfig.DeserializeBundle(c.oracle.Get(preimage.Keccak256Key(commitment)))
+	if err != nil {
+		panic(fmt.Errorf("failed to bootstrap custom chain configs: %w", err))
 	}
-	c.customConfigsLoaded = true
+	c.customConfigs = bundle
+	return bundle
 }
 
-func BootstrapInterop(r oracleClient) *BootInfoInterop {
+func BootstrapInterop(r oracleClient, h preimage.Hinter) *BootInfoInterop {
 	l1Head := common.BytesToHash(r.Get(L1HeadLocalIndex))
 	agreedPrestate := common.BytesToHash(r.Get(L2OutputRootLocalIndex))
-	claim := common.BytesToHash(r.Get(L2ClaimLocalIndex))
 	claimTimestamp := binary.BigEndian.Uint64(r.Get(L2ClaimBlockNumberLocalIndex))
 
 	return &BootInfoInterop{
 		Configs: &OracleConfigSource{
 			oracle:         r,
+			hinter:         h,
 			l2ChainConfigs: make(map[uint64]*params.ChainConfig),
 			rollupConfigs:  make(map[uint64]*rollup.Config),
 		},
 		L1Head:         l1Head,
 		AgreedPrestate: agreedPrestate,
-		Claim:          claim,
 		ClaimTimestamp: claimTimestamp,
+
+		AcceleratedPrecompiles: engineapi.AllPrecompiles,
+
+		oracle: r,
+	}
+}
+
+// ReadClaim returns the claim, reading it from the oracle if it is not set.
+// The claim is not read when bootstrapping, so the program state up to the step checkpoint doesn't depend on it,
+// and a snapshot at the checkpoint can be restored to validate other claims of the same step.
+func (b *BootInfoInterop) ReadClaim() common.Hash {
+	if b.Claim == (common.Hash{}) && b.oracle != nil {
+		b.Claim = common.BytesToHash(b.oracle.Get(L2ClaimLocalIndex))
+	}
+	return b.Claim
+}
+
+// Checkpoint returns the commitment to the boot info the program state depends on at the step checkpoint.
+func (b *BootInfoInterop) Checkpoint() common.Hash {
+	return InteropCheckpoint(b.L1Head, b.AgreedPrestate, b.ClaimTimestamp)
+}
+
+// InteropCheckpoint is the commitment the interop program marks the step checkpoint with, once it parsed the
+// agreed state. Up to the checkpoint, the program state only depends on the L1 head, agreed prestate and claimed
+// timestamp, and the chain configs and options of the host, so executions that agree on them can share a snapshot
+// at the checkpoint.
+func InteropCheckpoint(l1Head common.Hash, agreedPrestate common.Hash, claimTimestamp uint64) common.Hash {
+	return crypto.Keccak256Hash(l1Head[:], agreedPrestate[:], binary.BigEndian.AppendUint64(nil, claimTimestamp))
+}
+
+// ValidateConfigs checks that the rollup and chain configs of the given chains are available and consistent,
+// and returns a fingerprint of the config set.
+// The configs of a chain must be for the chain ID they are used for, and agree on the activation of the hardforks.
+// The chains must share the L1 chain, have distinct L2 genesis blocks, and activate interop at the same time.
+// The fingerprint is the keccak256 hash of the JSON encoded configs, in order of chain ID, so the exact configs
+// a claim was computed with can be audited.
+func (b *BootInfoInterop) ValidateConfigs(chainIDs []uint64) (common.Hash, error) {
+	chainIDs = slices.Clone(chainIDs)
+	slices.Sort(chainIDs)
+	var first *rollup.Config
+	genesis := make(map[common.Hash]uint64)
+	var encoded []byte
+	for _, chainID := range chainIDs {
+		rollupCfg, err := b.Configs.RollupConfig(chainID)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("no rollup config for chain ID %v: %w", chainID, err)
+		}
+		chainCfg, err := b.Configs.ChainConfig(chainID)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("no chain config for chain ID %v: %w", chainID, err)
+		}
+		if err := checkChainConfigs(chainID, rollupCfg, chainCfg); err != nil {
+			return common.Hash{}, err
+		}
+		if other, ok := genesis[rollupCfg.Genesis.L2.Hash]; ok {
+			return common.Hash{}, fmt.Errorf("%w: chain IDs %v and %v have the same L2 genesis %v",
+				ErrInconsistentConfigs, other, chainID, rollupCfg.Genesis.L2.Hash)
+		}
+		genesis[rollupCfg.Genesis.L2.Hash] = chainID
+		if first == nil {
+			first = rollupCfg
+		} else if err := checkSharedConfigs(first, rollupCfg); err != nil {
+			return common.Hash{}, err
+		}
+
+		rollupJSON, err := json.Marshal(rollupCfg)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to encode rollup config of chain ID %v: %w", chainID, err)
+		}
+		chainJSON, err := json.Marshal(chainCfg)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to encode chain config of chain ID %v: %w", chainID, err)
+		}
+		encoded = binary.BigEndian.AppendUint64(encoded, chainID)
+		encoded = append(encoded, crypto.Keccak256(rollupJSON)...)
+		encoded = append(encoded, crypto.Keccak256(chainJSON)...)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// checkChainConfigs checks that the rollup and chain config of a chain belong together.
+func checkChainConfigs(chainID uint64, rollupCfg *rollup.Config, chainCfg *params.ChainConfig) error {
+	if rollupCfg.L2ChainID == nil || !rollupCfg.L2ChainID.IsUint64() || rollupCfg.L2ChainID.Uint64() != chainID {
+		return fmt.Errorf("%w: rollup config of chain ID %v is for chain ID %v", ErrInconsistentConfigs, chainID, rollupCfg.L2ChainID)
+	}
+	if chainCfg.ChainID == nil || !chainCfg.ChainID.IsUint64() || chainCfg.ChainID.Uint64() != chainID {
+		return fmt.Errorf("%w: chain config of chain ID %v is for chain ID %v", ErrInconsistentConfigs, chainID, chainCfg.ChainID)
+	}
+	if rollupCfg.Genesis.L2.Hash == (common.Hash{}) {
+		return fmt.Errorf("%w: rollup config of chain ID %v has no L2 genesis", ErrInconsistentConfigs, chainID)
+	}
+	for _, fork := range []struct {
+		name              string
+		rollupAt, chainAt *uint64
+	}{
+		{"regolith", rollupCfg.RegolithTime, chainCfg.RegolithTime},
+		{"canyon", rollupCfg.CanyonTime, chainCfg.CanyonTime},
+		{"ecotone", rollupCfg.EcotoneTime, chainCfg.EcotoneTime},
+		{"fjord", rollupCfg.FjordTime, chainCfg.FjordTime},
+		{"granite", rollupCfg.GraniteTime, chainCfg.GraniteTime},
+		{"holocene", rollupCfg.HoloceneTime, chainCfg.HoloceneTime},
+		{"isthmus", rollupCfg.IsthmusTime, chainCfg.IsthmusTime},
+		{"interop", rollupCfg.InteropTime, chainCfg.InteropTime},
+	} {
+		if !equalForkTime(fork.rollupAt, fork.chainAt) {
+			return fmt.Errorf("%w: %v activation of chain ID %v differs: rollup config %v, chain config %v",
+				ErrInconsistentConfigs, fork.name, chainID, fmtForkTime(fork.rollupAt), fmtForkTime(fork.chainAt))
+		}
+	}
+	return nil
+}
+
+// checkSharedConfigs checks that two chains of the dependency set agree on the configuration they share.
+func checkSharedConfigs(a *rollup.Config, b *rollup.Config) error {
+	if a.L1ChainID == nil || b.L1ChainID == nil || a.L1ChainID.Cmp(b.L1ChainID) != 0 {
+		return fmt.Errorf("%w: chain IDs %v and %v have different L1 chain IDs %v and %v",
+			ErrInconsistentConfigs, a.L2ChainID, b.L2ChainID, a.L1ChainID, b.L1ChainID)
+	}
+	if !equalForkTime(a.InteropTime, b.InteropTime) {
+		return fmt.Errorf("%w: chain IDs %v and %v activate interop at %v and %v",
+			ErrInconsistentConfigs, a.L2ChainID, b.L2ChainID, fmtForkTime(a.InteropTime), fmtForkTime(b.InteropTime))
+	}
+	return nil
+}
+
+func equalForkTime(a *uint64, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func fmtForkTime(t *uint64) string {
+	if t == nil {
+		return "never"
 	}
+	return fmt.Sprint(*t)
 }
@@ -1,8 +1,6 @@
 package boot
 
 import (
-	"encoding/json"
-	"fmt"
 	"math/big"
 	"testing"
 
@@ -10,7 +8,12 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
@@ -21,13 +24,45 @@ func TestInteropBootstrap_SimpleValues(t *testing.T) {
 		AgreedPrestate: common.Hash{0xbb},
 		Claim:          common.Hash{0xcc},
 		ClaimTimestamp: 49829482,
+
+		AcceleratedPrecompiles: engineapi.EcrecoverFlag,
 	}
 	mockOracle := newMockInteropBootstrapOracle(expected, false)
-	actual := BootstrapInterop(mockOracle)
+	actual := BootstrapInterop(mockOracle, mockOracle)
 	require.Equal(t, expected.L1Head, actual.L1Head)
 	require.Equal(t, expected.AgreedPrestate, actual.AgreedPrestate)
-	require.Equal(t, expected.Claim, actual.Claim)
 	require.Equal(t, expected.ClaimTimestamp, actual.ClaimTimestamp)
+	// The accelerated precompiles are not provided by the dispute games on-chain, so interop accelerates all of them
+	require.Equal(t, engineapi.AllPrecompiles, actual.AcceleratedPrecompiles)
+
+	// The claim is only read when it is used
+	require.Zero(t, actual.Claim)
+	require.Equal(t, expected.Claim, actual.ReadClaim())
+	require.Equal(t, expected.Claim, actual.Claim)
+}
+
+func TestInteropCheckpoint(t *testing.T) {
+	bootInfo := &BootInfoInterop{
+		L1Head:         common.Hash{0xaa},
+		AgreedPrestate: common.Hash{0xbb},
+		Claim:          common.Hash{0xcc},
+		ClaimTimestamp: 49829482,
+	}
+	checkpoint := bootInfo.Checkpoint()
+	require.Equal(t, InteropCheckpoint(common.Hash{0xaa}, common.Hash{0xbb}, 49829482), checkpoint)
+
+	// The claim is read after the checkpoint, so it is not committed to
+	otherClaim := *bootInfo
+	otherClaim.Claim = common.Hash{0xdd}
+	require.Equal(t, checkpoint, otherClaim.Checkpoint())
+
+	otherTimestamp := *bootInfo
+	otherTimestamp.ClaimTimestamp++
+	require.NotEqual(t, checkpoint, otherTimestamp.Checkpoint())
+
+	otherPrestate := *bootInfo
+	otherPrestate.AgreedPrestate = common.Hash{0xee}
+	require.NotEqual(t, checkpoint, otherPrestate.Checkpoint())
 }
 
 func TestInteropBootstrap_RollupConfigBuiltIn(t *testing.T) {
@@ -39,7 +74,7 @@ func TestInteropBootstrap_RollupConfigBuiltIn(t *testing.T) {
 		ClaimTimestamp: 49829482,
 	}
 	mockOracle := newMockInteropBootstrapOracle(expected, false)
-	actual := BootstrapInterop(mockOracle)
+	actual := BootstrapInterop(mockOracle, mockOracle)
 	actualCfg, err := actual.Configs.RollupConfig(expectedCfg.L2ChainID.Uint64())
 	require.NoError(t, err)
 	require.Equal(t, expectedCfg, actualCfg)
@@ -56,7 +91,8 @@ func TestInteropBootstrap_RollupConfigCustom(t *testing.T) {
 	}
 	mockOracle := newMockInteropBootstrapOracle(source, true)
 	mockOracle.rollupCfgs = []*rollup.Config{config1, config2}
-	actual := BootstrapInterop(mockOracle)
+	mockOracle.chainCfgs = []*params.ChainConfig{{ChainID: big.NewInt(1111)}, {ChainID: big.NewInt(2222)}}
+	actual := BootstrapInterop(mockOracle, mockOracle)
 	actualCfg, err := actual.Configs.RollupConfig(config1.L2ChainID.Uint64())
 	require.NoError(t, err)
 	require.Equal(t, config1, actualCfg)
@@ -64,6 +100,13 @@ func TestInteropBootstrap_RollupConfigCustom(t *testing.T) {
 	actualCfg, err = actual.Configs.RollupConfig(config2.L2ChainID.Uint64())
 	require.NoError(t, err)
 	require.Equal(t, config2, actualCfg)
+
+	// The bundle is requested once, by its commitment
+	commitment := crypto.Keccak256Hash(mockOracle.configBundle())
+	require.Equal(t, []string{ConfigBundleHint(commitment).Hint()}, mockOracle.hints)
+
+	_, err = actual.Configs.RollupConfig(3333)
+	require.ErrorIs(t, err, ErrUnknownChainID)
 }
 
 func TestInteropBootstrap_ChainConfigBuiltIn(t *testing.T) {
@@ -75,7 +118,7 @@ func TestInteropBootstrap_ChainConfigBuiltIn(t *testing.T) {
 		ClaimTimestamp: 49829482,
 	}
 	mockOracle := newMockInteropBootstrapOracle(expected, false)
-	actual := BootstrapInterop(mockOracle)
+	actual := BootstrapInterop(mockOracle, mockOracle)
 	actualCfg, err := actual.Configs.ChainConfig(expectedCfg.ChainID.Uint64())
 	require.NoError(t, err)
 	require.Equal(t, expectedCfg, actualCfg)
@@ -91,8 +134,9 @@ func TestInteropBootstrap_ChainConfigCustom(t *testing.T) {
 		ClaimTimestamp: 49829482,
 	}
 	mockOracle := newMockInteropBootstrapOracle(expected, true)
+	mockOracle.rollupCfgs = []*rollup.Config{{L2ChainID: big.NewInt(1111)}, {L2ChainID: big.NewInt(2222)}}
 	mockOracle.chainCfgs = []*params.ChainConfig{config1, config2}
-	actual := BootstrapInterop(mockOracle)
+	actual := BootstrapInterop(mockOracle, mockOracle)
 
 	actualCfg, err := actual.Configs.ChainConfig(config1.ChainID.Uint64())
 	require.NoError(t, err)
@@ -103,6 +147,122 @@ func TestInteropBootstrap_ChainConfigCustom(t *testing.T) {
 	require.Equal(t, config2, actualCfg)
 }
 
+func TestInteropBootstrap_DependencySetCustom(t *testing.T) {
+	expected := &BootInfoInterop{
+		L1Head:         common.Hash{0xaa},
+		AgreedPrestate: common.Hash{0xbb},
+		Claim:          common.Hash{0xcc},
+		ClaimTimestamp: 49829482,
+	}
+	mockOracle := newMockInteropBootstrapOracle(expected, true)
+	mockOracle.rollupCfgs = []*rollup.Config{{L2ChainID: big.NewInt(1111)}, {L2ChainID: big.NewInt(2222)}}
+	mockOracle.chainCfgs = []*params.ChainConfig{{ChainID: big.NewInt(1111)}, {ChainID: big.NewInt(2222)}}
+	depSet, err := depset.NewStaticConfigDependencySetWithMessageExpiryWindow(map[eth.ChainID]*depset.StaticConfigDependency{
+		eth.ChainIDFromUInt64(1111): {ChainIndex: 5, ActivationTime: 10},
+		eth.ChainIDFromUInt64(2222): {ChainIndex: 6, ActivationTime: 20},
+	}, 3600)
+	require.NoError(t, err)
+	mockOracle.depSet = depSet
+	actual := BootstrapInterop(mockOracle, mockOracle)
+
+	actualDepSet, err := actual.Configs.DependencySet()
+	require.NoError(t, err)
+	require.Equal(t, depSet, actualDepSet)
+	require.Equal(t, uint64(3600), actualDepSet.MessageExpiryWindow())
+	chainID, err := actualDepSet.ChainIDFromIndex(6)
+	require.NoError(t, err)
+	require.Equal(t, eth.ChainIDFromUInt64(2222), chainID)
+}
+
+func TestInteropBootstrap_ValidateConfigs(t *testing.T) {
+	setup := func() (*rollup.Config, *params.ChainConfig, *rollup.Config, *params.ChainConfig, *BootInfoInterop) {
+		rollupCfg1 := chaincfg.OPSepolia()
+		chainCfg1 := chainconfig.OPSepoliaChainConfig()
+		rollupCfg2 := *chaincfg.OPSepolia()
+		rollupCfg2.L2ChainID = big.NewInt(42)
+		rollupCfg2.Genesis.L2.Hash = common.Hash{0x42}
+		chainCfg2 := *chainconfig.OPSepoliaChainConfig()
+		chainCfg2.ChainID = big.NewInt(42)
+		mockOracle := newMockInteropBootstrapOracle(&BootInfoInterop{}, true)
+		mockOracle.rollupCfgs = []*rollup.Config{rollupCfg1, &rollupCfg2}
+		mockOracle.chainCfgs = []*params.ChainConfig{chainCfg1, &chainCfg2}
+		return rollupCfg1, chainCfg1, &rollupCfg2, &chainCfg2, BootstrapInterop(mockOracle, mockOracle)
+	}
+	chainIDs := func(cfgs ...*rollup.Config) []uint64 {
+		var ids []uint64
+		for _, cfg := range cfgs {
+			ids = append(ids, cfg.L2ChainID.Uint64())
+		}
+		return ids
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		rollupCfg1, _, rollupCfg2, _, bootInfo := setup()
+		fingerprint, err := bootInfo.ValidateConfigs(chainIDs(rollupCfg1, rollupCfg2))
+		require.NoError(t, err)
+		require.NotEqual(t, common.Hash{}, fingerprint)
+
+		// The fingerprint does not depend on the order of the chains
+		reordered, err := bootInfo.ValidateConfigs(chainIDs(rollupCfg2, rollupCfg1))
+		require.NoError(t, err)
+		require.Equal(t, fingerprint, reordered)
+
+		// but on the configs
+		rollupCfg1, _, rollupCfg2, _, bootInfo = setup()
+		rollupCfg2.BlockTime++
+		changed, err := bootInfo.ValidateConfigs(chainIDs(rollupCfg1, rollupCfg2))
+		require.NoError(t, err)
+		require.NotEqual(t, fingerprint, changed)
+	})
+
+	t.Run("UnknownChain", func(t *testing.T) {
+		rollupCfg1, _, _, _, bootInfo := setup()
+		_, err := bootInfo.ValidateConfigs(append(chainIDs(rollupCfg1), 1234))
+		require.ErrorIs(t, err, ErrUnknownChainID)
+	})
+
+	t.Run("MismatchedChainConfig", func(t *testing.T) {
+		rollupCfg1, _, rollupCfg2, _, bootInfo := setup()
+		// The config bundle pairs the chain configs with the rollup configs by chain ID,
+		// so the mismatch can only be introduced once the configs are loaded.
+		chainCfg2, err := bootInfo.Configs.ChainConfig(rollupCfg2.L2ChainID.Uint64())
+		require.NoError(t, err)
+		chainCfg2.ChainID = big.NewInt(43)
+		_, err = bootInfo.ValidateConfigs(chainIDs(rollupCfg1, rollupCfg2))
+		require.ErrorIs(t, err, ErrInconsistentConfigs)
+	})
+
+	for _, test := range []struct {
+		name   string
+		modify func(rollupCfg1 *rollup.Config, chainCfg2 *params.ChainConfig, rollupCfg2 *rollup.Config)
+	}{
+		{"MissingGenesis", func(_ *rollup.Config, _ *params.ChainConfig, rollupCfg2 *rollup.Config) {
+			rollupCfg2.Genesis.L2.Hash = common.Hash{}
+		}},
+		{"DuplicateGenesis", func(rollupCfg1 *rollup.Config, _ *params.ChainConfig, rollupCfg2 *rollup.Config) {
+			rollupCfg2.Genesis.L2.Hash = rollupCfg1.Genesis.L2.Hash
+		}},
+		{"DifferentForkActivation", func(_ *rollup.Config, chainCfg2 *params.ChainConfig, _ *rollup.Config) {
+			chainCfg2.CanyonTime = nil
+		}},
+		{"DifferentL1", func(_ *rollup.Config, _ *params.ChainConfig, rollupCfg2 *rollup.Config) {
+			rollupCfg2.L1ChainID = big.NewInt(1)
+		}},
+		{"DifferentInteropActivation", func(_ *rollup.Config, chainCfg2 *params.ChainConfig, rollupCfg2 *rollup.Config) {
+			rollupCfg2.InteropTime = new(uint64)
+			chainCfg2.InteropTime = new(uint64)
+		}},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rollupCfg1, _, rollupCfg2, chainCfg2, bootInfo := setup()
+			test.modify(rollupCfg1, chainCfg2, rollupCfg2)
+			_, err := bootInfo.ValidateConfigs(chainIDs(rollupCfg1, rollupCfg2))
+			require.ErrorIs(t, err, ErrInconsistentConfigs)
+		})
+	}
+}
+
 func newMockInteropBootstrapOracle(b *BootInfoInterop, custom bool) *mockInteropBootstrapOracle {
 	return &mockInteropBootstrapOracle{
 		mockBoostrapOracle: mockBoostrapOracle{
@@ -110,6 +270,8 @@ func newMockInteropBootstrapOracle(b *BootInfoInterop, custom bool) *mockInterop
 			l2OutputRoot:       b.AgreedPrestate,
 			l2Claim:            b.Claim,
 			l2ClaimBlockNumber: b.ClaimTimestamp,
+
+			acceleratedPrecompiles: b.AcceleratedPrecompiles,
 		},
 		custom: custom,
 	}
@@ -119,24 +281,49 @@ type mockInteropBootstrapOracle struct {
 	mockBoostrapOracle
 	rollupCfgs []*rollup.Config
 	chainCfgs  []*params.ChainConfig
-	custom     bool
+	// depSet is the dependency set of the config bundle, of all chains of rollupCfgs if nil
+	depSet *depset.StaticConfigDependencySet
+	custom bool
+	hints  []string
 }
 
-func (o *mockInteropBootstrapOracle) Get(key preimage.Key) []byte {
-	switch key.PreimageKey() {
-	case L2ChainConfigLocalIndex.PreimageKey():
-		if !o.custom {
-			panic(fmt.Sprintf("unexpected oracle request for preimage key %x", key.PreimageKey()))
+func (o *mockInteropBootstrapOracle) configBundle() []byte {
+	if !o.custom {
+		panic("unexpected oracle request for the config bundle")
+	}
+	depSet := o.depSet
+	if depSet == nil {
+		deps := make(map[eth.ChainID]*depset.StaticConfigDependency)
+		for i, rollupCfg := range o.rollupCfgs {
+			deps[eth.ChainIDFromBig(rollupCfg.L2ChainID)] = &depset.StaticConfigDependency{ChainIndex: supervisortypes.ChainIndex(i)}
 		}
-		b, _ := json.Marshal(o.chainCfgs)
-		return b
-	case RollupConfigLocalIndex.PreimageKey():
-		if !o.custom {
-			panic(fmt.Sprintf("unexpected oracle request for preimage key %x", key.PreimageKey()))
+		var err error
+		if depSet, err = depset.NewStaticConfigDependencySet(deps); err != nil {
+			panic(err)
 		}
-		b, _ := json.Marshal(o.rollupCfgs)
-		return b
+	}
+	bundle, err := chainconfig.SerializeBundle(o.rollupCfgs, o.chainCfgs, depSet)
+	if err != nil {
+		panic(err)
+	}
+	return bundle
+}
+
+func (o *mockInteropBootstrapOracle) Get(key preimage.Key) []byte {
+	switch key.PreimageKey() {
+	case ConfigBundleLocalIndex.PreimageKey():
+		return crypto.Keccak256(o.configBundle())
 	default:
+		if o.custom {
+			bundle := o.configBundle()
+			if key.PreimageKey() == preimage.Keccak256Key(crypto.Keccak256Hash(bundle)).PreimageKey() {
+				return bundle
+			}
+		}
 		return o.mockBoostrapOracle.Get(key)
 	}
 }
+
+func (o *mockInteropBootstrapOracle) Hint(v preimage.Hint) {
+	o.hints = append(o.hints, v.Hint())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
@@ -21,8 +22,11 @@ func TestBootstrapClient(t *testing.T) {
 		L2Claim:            common.HexToHash("0x3333"),
 		L2ClaimBlockNumber: 1,
 		L2ChainID:          rollupCfg.L2ChainID.Uint64(),
-		L2ChainConfig:      chainconfig.OPSepoliaChainConfig(),
-		RollupConfig:       rollupCfg,
+
+		AcceleratedPrecompiles: engineapi.AllPrecompiles,
+
+		L2ChainConfig: chainconfig.OPSepoliaChainConfig(),
+		RollupConfig:  rollupCfg,
 	}
 	mockOracle := newMockPreinteropBootstrapOracle(bootInfo, false)
 	readBootInfo := NewBootstrapClient(mockOracle).BootInfo()
@@ -36,8 +40,11 @@ func TestBootstrapClient_CustomChain(t *testing.T) {
 		L2Claim:            common.HexToHash("0x3333"),
 		L2ClaimBlockNumber: 1,
 		L2ChainID:          CustomChainIDIndicator,
-		L2ChainConfig:      chainconfig.OPSepoliaChainConfig(),
-		RollupConfig:       chaincfg.OPSepolia(),
+
+		AcceleratedPrecompiles: engineapi.EcrecoverFlag,
+
+		L2ChainConfig: chainconfig.OPSepoliaChainConfig(),
+		RollupConfig:  chaincfg.OPSepolia(),
 	}
 	mockOracle := newMockPreinteropBootstrapOracle(bootInfo, true)
 	readBootInfo := NewBootstrapClient(mockOracle).BootInfo()
@@ -57,6 +64,25 @@ func TestBootstrapClient_UnknownChainPanics(t *testing.T) {
 	require.Panics(t, func() { client.BootInfo() })
 }
 
+func TestBootstrapClient_UnknownPrecompilesPanics(t *testing.T) {
+	rollupCfg := chaincfg.OPSepolia()
+	bootInfo := &BootInfo{
+		L1Head:             common.HexToHash("0x1111"),
+		L2OutputRoot:       common.HexToHash("0x2222"),
+		L2Claim:            common.HexToHash("0x3333"),
+		L2ClaimBlockNumber: 1,
+		L2ChainID:          CustomChainIDIndicator,
+
+		AcceleratedPrecompiles: engineapi.AllPrecompiles << 1,
+
+		L2ChainConfig: chainconfig.OPSepoliaChainConfig(),
+		RollupConfig:  rollupCfg,
+	}
+	mockOracle := newMockPreinteropBootstrapOracle(bootInfo, true)
+	client := NewBootstrapClient(mockOracle)
+	require.Panics(t, func() { client.BootInfo() })
+}
+
 func newMockPreinteropBootstrapOracle(info *BootInfo, custom bool) *mockPreinteropBoostrapOracle {
 	return &mockPreinteropBoostrapOracle{
 		mockBoostrapOracle: mockBoostrapOracle{
@@ -64,6 +90,8 @@ func newMockPreinteropBootstrapOracle(info *BootInfo, custom bool) *mockPreinter
 			l2OutputRoot:       info.L2OutputRoot,
 			l2Claim:            info.L2Claim,
 			l2ClaimBlockNumber: info.L2ClaimBlockNumber,
+
+			acceleratedPrecompiles: info.AcceleratedPrecompiles,
 		},
 		b:      info,
 		custom: custom,
@@ -92,6 +120,11 @@ func (o *mockPreinteropBoostrapOracle) Get(key preimage.Key) []byte {
 		}
 		b, _ := json.Marshal(o.b.RollupConfig)
 		return b
+	case AcceleratedPrecompilesLocalIndex.PreimageKey():
+		if !o.custom {
+			panic(fmt.Sprintf("unexpected oracle request for preimage key %x", key.PreimageKey()))
+		}
+		return o.mockBoostrapOracle.Get(key)
 	default:
 		return o.mockBoostrapOracle.Get(key)
 	}
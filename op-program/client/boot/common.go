@@ -1,6 +1,12 @@
 package boot
 
-import preimage "github.com/ethereum-optimism/optimism/op-preimage"
+import (
+	"encoding/binary"
+	"fmt"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+)
 
 const (
 	L1HeadLocalIndex preimage.LocalIndexKey = iota + 1
@@ -12,8 +18,24 @@ const (
 	// These local keys are only used for custom chains
 	L2ChainConfigLocalIndex
 	RollupConfigLocalIndex
+	AcceleratedPrecompilesLocalIndex
+
+	// Only used for interop
+	// ConfigBundleLocalIndex is the keccak256 commitment to the serialized config bundle of the custom chains
+	ConfigBundleLocalIndex
 )
 
 type oracleClient interface {
 	Get(key preimage.Key) []byte
 }
+
+// readAcceleratedPrecompiles reads the flags of the accelerated precompiles.
+// Panics if the flags enable a precompile this program can't accelerate, as the boot info was then
+// created for a different program and the precompile oracle calls would not match the expected prestate.
+func readAcceleratedPrecompiles(r oracleClient) engineapi.PrecompileFlags {
+	flags := engineapi.PrecompileFlags(binary.BigEndian.Uint64(r.Get(AcceleratedPrecompilesLocalIndex)))
+	if err := flags.Check(); err != nil {
+		panic(fmt.Errorf("failed to bootstrap accelerated precompiles: %w", err))
+	}
+	return flags
+}
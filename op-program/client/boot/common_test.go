@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -12,6 +13,8 @@ type mockBoostrapOracle struct {
 	l2OutputRoot       common.Hash
 	l2Claim            common.Hash
 	l2ClaimBlockNumber uint64
+
+	acceleratedPrecompiles engineapi.PrecompileFlags
 }
 
 func (o *mockBoostrapOracle) Get(key preimage.Key) []byte {
@@ -24,6 +27,8 @@ func (o *mockBoostrapOracle) Get(key preimage.Key) []byte {
 		return o.l2Claim[:]
 	case L2ClaimBlockNumberLocalIndex.PreimageKey():
 		return binary.BigEndian.AppendUint64(nil, o.l2ClaimBlockNumber)
+	case AcceleratedPrecompilesLocalIndex.PreimageKey():
+		return binary.BigEndian.AppendUint64(nil, uint64(o.acceleratedPrecompiles))
 	default:
 		panic("unknown key")
 	}
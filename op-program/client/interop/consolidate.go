@@ -0,0 +1,326 @@
+package interop
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/cross"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/processors"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errInvalidMessage = errors.New("invalid executing message")
+
+// RunConsolidation checks the executing messages of the optimistic blocks of all chains against their initiating
+// chains, and replaces the blocks with invalid messages by deposit-only blocks.
+// The messages are checked against the dependency set of the chains, with the same checks as the cross-safe
+// validation of the supervisor, see cross.ValidateExecutingMessages and cross.HazardCycleChecks.
+// The message dependencies of an IntermediateTransitionVersionV2 state must commit to the messages of the optimistic blocks.
+// Returns the super root of the next timestamp, made of the output roots of the consolidated blocks.
+func RunConsolidation(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle,
+	transitionState *types.TransitionState, superRoot *eth.SuperV1, tasks taskExecutor) (eth.Bytes32, error) {
+	if len(transitionState.PendingProgress) != len(superRoot.Chains) {
+		return eth.Bytes32{}, fmt.Errorf("cannot consolidate %d optimistic blocks of %d chains",
+			len(transitionState.PendingProgress), len(superRoot.Chains))
+	}
+	depSet, err := bootInfo.Configs.DependencySet()
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("%w: no dependency set available: %w", ErrConfigUnavailable, err)
+	}
+	c := &consolidation{
+		logger:     logger,
+		l2Oracle:   l2PreimageOracle,
+		depSet:     depSet,
+		superRoot:  superRoot,
+		indices:    make([]supervisortypes.ChainIndex, len(superRoot.Chains)),
+		positions:  make(map[supervisortypes.ChainIndex]int, len(superRoot.Chains)),
+		pending:    slices.Clone(transitionState.PendingProgress),
+		optimistic: make([]common.Hash, len(superRoot.Chains)),
+		replaced:   make([]bool, len(superRoot.Chains)),
+	}
+	for i, chain := range superRoot.Chains {
+		index, err := depSet.ChainIndexFromID(eth.ChainIDFromUInt64(chain.ChainID))
+		if err != nil {
+			return eth.Bytes32{}, fmt.Errorf("%w: chain ID %v is not in the dependency set: %w", ErrConfigUnavailable, chain.ChainID, err)
+		}
+		c.indices[i] = index
+		c.positions[index] = i
+	}
+	for i, block := range transitionState.PendingProgress {
+		c.optimistic[i] = block.BlockHash
+	}
+	if transitionState.Version() == types.IntermediateTransitionVersionV2 {
+		if err := c.checkDependencies(transitionState.Dependencies); err != nil {
+			return eth.Bytes32{}, err
+		}
+	}
+	// Replacing a block drops the messages it initiated, which may invalidate the messages executed by other chains.
+	// The checks are repeated until no more blocks are replaced. Each round replaces at least one block, so this ends.
+	// The cycle checks open the blocks the messages at the same timestamp are initiated in, so they only run
+	// once the messages of all blocks are valid.
+	for {
+		invalid, err := c.findInvalid(c.checkBlock)
+		if err != nil {
+			return eth.Bytes32{}, err
+		}
+		if len(invalid) == 0 {
+			invalid, err = c.findInvalid(c.checkCycles)
+			if err != nil {
+				return eth.Bytes32{}, err
+			}
+		}
+		if len(invalid) == 0 {
+			break
+		}
+		for _, i := range invalid {
+			if err := c.replaceBlock(bootInfo, l1PreimageOracle, i, tasks); err != nil {
+				return eth.Bytes32{}, err
+			}
+		}
+	}
+
+	consolidated := &eth.SuperV1{Timestamp: superRoot.Timestamp + 1}
+	for i, chain := range superRoot.Chains {
+		consolidated.Chains = append(consolidated.Chains, eth.ChainIDAndOutput{ChainID: chain.ChainID, Output: c.pending[i].OutputRoot})
+	}
+	return eth.SuperRoot(consolidated), nil
+}
+
+type consolidation struct {
+	logger    log.Logger
+	l2Oracle  l2.Oracle
+	depSet    depset.DependencySet
+	superRoot *eth.SuperV1
+
+	// indices are the chain indices in the dependency set of the chains of the super root,
+	// and positions the positions in the super root of the chain indices.
+	indices   []supervisortypes.ChainIndex
+	positions map[supervisortypes.ChainIndex]int
+
+	// pending are the blocks of the next timestamp, the optimistic blocks or their deposit-only replacements.
+	pending []types.OptimisticBlock
+	// optimistic are the hashes of the optimistic blocks, kept when a block is replaced.
+	// The deposits of a replaced block produce the same logs in the deposit-only block, as they are always executed first.
+	optimistic []common.Hash
+	replaced   []bool
+}
+
+// findInvalid returns the chains of which the optimistic block fails the check with an errInvalidMessage error.
+// Deposits cannot execute messages, so deposit-only blocks are always valid and not checked.
+func (c *consolidation) findInvalid(check func(i int) error) ([]int, error) {
+	var invalid []int
+	for i := range c.superRoot.Chains {
+		if c.replaced[i] {
+			continue
+		}
+		if err := check(i); errors.Is(err, errInvalidMessage) {
+			c.logger.Warn("Replacing optimistic block with invalid message", "chainID", c.superRoot.Chains[i].ChainID, "block", c.pending[i].BlockHash, "err", err)
+			invalid = append(invalid, i)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return invalid, nil
+}
+
+// executingMessages returns the optimistic block of the chain, with the logs of its executing messages and the messages.
+func (c *consolidation) executingMessages(i int) (*ethtypes.Block, []*ethtypes.Log, []*supervisortypes.ExecutingMessage, error) {
+	chainID := c.superRoot.Chains[i].ChainID
+	block, receipts := c.l2Oracle.ReceiptsByBlockHash(c.optimistic[i], chainID)
+	var logs []*ethtypes.Log
+	var msgs []*supervisortypes.ExecutingMessage
+	for _, rcpt := range receipts {
+		for _, l := range rcpt.Logs {
+			msg, err := processors.DecodeExecutingMessageLog(l, c.depSet)
+			if errors.Is(err, supervisortypes.ErrUnknownChain) {
+				return nil, nil, nil, fmt.Errorf("%w: log %d of block %s on chain %v: %w", errInvalidMessage, l.Index, block.Hash(), chainID, err)
+			} else if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to decode log %d of block %s on chain %v: %w", l.Index, block.Hash(), chainID, err)
+			}
+			if msg == nil {
+				continue
+			}
+			logs = append(logs, l)
+			msgs = append(msgs, msg)
+		}
+	}
+	return block, logs, msgs, nil
+}
+
+// checkBlock returns an errInvalidMessage error if the optimistic block of the chain executes an invalid message.
+func (c *consolidation) checkBlock(i int) error {
+	chainID := c.superRoot.Chains[i].ChainID
+	block, logs, msgs, err := c.executingMessages(i)
+	if err != nil {
+		return err
+	}
+	candidate := supervisortypes.BlockSeal{Hash: block.Hash(), Number: block.NumberU64(), Timestamp: block.Time()}
+	if _, err := cross.ValidateExecutingMessages(c.depSet, eth.ChainIDFromUInt64(chainID), candidate, msgs); errors.Is(err, supervisortypes.ErrConflict) {
+		return fmt.Errorf("%w: %w", errInvalidMessage, err)
+	} else if err != nil {
+		return fmt.Errorf("failed to validate executing messages of block %s on chain %v: %w", block.Hash(), chainID, err)
+	}
+	for j, msg := range msgs {
+		if err := c.checkMessage(i, block, logs[j], msg); err != nil {
+			return fmt.Errorf("%w: %v executed by log %d of block %s on chain %v: %w",
+				errInvalidMessage, msg, logs[j].Index, block.Hash(), chainID, err)
+		}
+	}
+	return nil
+}
+
+// checkMessage returns an error if the message is not initiated on its initiating chain.
+func (c *consolidation) checkMessage(execChain int, execBlock *ethtypes.Block, execLog *ethtypes.Log, msg *supervisortypes.ExecutingMessage) error {
+	initChain, ok := c.positions[msg.Chain]
+	if !ok {
+		return fmt.Errorf("initiating chain %v is not in the super root", msg.Chain)
+	}
+	chainID := c.superRoot.Chains[initChain].ChainID
+	initBlock := c.l2Oracle.BlockByHash(c.optimistic[initChain], chainID)
+	if msg.BlockNum > initBlock.NumberU64() {
+		return errors.New("initiated after the pending block")
+	}
+	// The initiating block is found by walking back the parents. Block times strictly decrease along the way,
+	// so the walk ends once the message timestamp is reached, which is at most the expiry window back.
+	for initBlock.NumberU64() > msg.BlockNum {
+		if initBlock.Time() <= msg.Timestamp {
+			return fmt.Errorf("timestamp does not match initiating block number %d", msg.BlockNum)
+		}
+		initBlock = c.l2Oracle.BlockByHash(initBlock.ParentHash(), chainID)
+	}
+	if initBlock.Time() != msg.Timestamp {
+		return fmt.Errorf("timestamp does not match initiating block time %d", initBlock.Time())
+	}
+	if initChain == execChain && initBlock.Hash() == execBlock.Hash() && uint(msg.LogIdx) >= execLog.Index {
+		return errors.New("initiated after executing message")
+	}
+	for _, l := range c.initiatedLogs(initChain, initBlock.Hash()) {
+		if l.Index != uint(msg.LogIdx) {
+			continue
+		}
+		payloadHash := crypto.Keccak256Hash(supervisortypes.LogToMessagePayload(l))
+		if supervisortypes.PayloadHashToLogHash(payloadHash, l.Address) != msg.Hash {
+			return errors.New("log hash does not match initiating log")
+		}
+		return nil
+	}
+	return errors.New("initiating log not found")
+}
+
+// initiatedLogs returns the logs of the block of the chain that can be executed as messages.
+// Only the deposits remain of a replaced pending block, other logs are no longer initiated.
+func (c *consolidation) initiatedLogs(i int, blockHash common.Hash) []*ethtypes.Log {
+	depositsOnly := c.replaced[i] && blockHash == c.optimistic[i]
+	_, receipts := c.l2Oracle.ReceiptsByBlockHash(blockHash, c.superRoot.Chains[i].ChainID)
+	var logs []*ethtypes.Log
+	for _, rcpt := range receipts {
+		if depositsOnly && rcpt.Type != ethtypes.DepositTxType {
+			break
+		}
+		logs = append(logs, rcpt.Logs...)
+	}
+	return logs
+}
+
+// checkCycles returns an errInvalidMessage error if the messages executed at the timestamp of the optimistic block
+// of the chain depend on each other in a cycle. The hazards are the pending blocks of the chains that initiate
+// messages executed at the same timestamp, transitively, as in the cross-safe validation of the supervisor.
+func (c *consolidation) checkCycles(i int) error {
+	block := c.l2Oracle.BlockByHash(c.optimistic[i], c.superRoot.Chains[i].ChainID)
+	hazards := make(map[supervisortypes.ChainIndex]supervisortypes.BlockSeal)
+	for next := []int{i}; len(next) > 0; next = next[1:] {
+		j := next[0]
+		if _, ok := hazards[c.indices[j]]; ok {
+			continue
+		}
+		pending := c.l2Oracle.BlockByHash(c.optimistic[j], c.superRoot.Chains[j].ChainID)
+		ref, _, msgs, err := c.OpenBlock(eth.ChainIDFromUInt64(c.superRoot.Chains[j].ChainID), pending.NumberU64())
+		if err != nil {
+			return err
+		}
+		hazards[c.indices[j]] = supervisortypes.BlockSeal{Hash: ref.Hash, Number: ref.Number, Timestamp: ref.Time}
+		for _, msg := range msgs {
+			if msg.Timestamp == block.Time() {
+				next = append(next, c.positions[msg.Chain])
+			}
+		}
+	}
+	if err := cross.HazardCycleChecks(c.depSet, c, block.Time(), hazards); errors.Is(err, cross.ErrCycle) || errors.Is(err, supervisortypes.ErrConflict) {
+		return fmt.Errorf("%w: block %s on chain %v: %w", errInvalidMessage, block.Hash(), c.superRoot.Chains[i].ChainID, err)
+	} else if err != nil {
+		return fmt.Errorf("failed to check message cycles of block %s on chain %v: %w", block.Hash(), c.superRoot.Chains[i].ChainID, err)
+	}
+	return nil
+}
+
+var _ cross.CycleCheckDeps = (*consolidation)(nil)
+
+// OpenBlock implements cross.CycleCheckDeps for the pending blocks, the only blocks at the timestamp of the cycle checks.
+// A replaced block is opened as its deposit-only block: only the logs of its deposits, which execute no messages.
+func (c *consolidation) OpenBlock(chainID eth.ChainID, blockNum uint64) (eth.BlockRef, uint32, map[uint32]*supervisortypes.ExecutingMessage, error) {
+	index, err := c.depSet.ChainIndexFromID(chainID)
+	if err != nil {
+		return eth.BlockRef{}, 0, nil, err
+	}
+	i, ok := c.positions[index]
+	if !ok {
+		return eth.BlockRef{}, 0, nil, fmt.Errorf("%w: chain %v is not in the super root", supervisortypes.ErrUnknownChain, chainID)
+	}
+	block := c.l2Oracle.BlockByHash(c.optimistic[i], c.superRoot.Chains[i].ChainID)
+	if block.NumberU64() != blockNum {
+		return eth.BlockRef{}, 0, nil, fmt.Errorf("block %d of chain %v is not the pending block %d", blockNum, chainID, block.NumberU64())
+	}
+	ref := eth.BlockRef{Hash: block.Hash(), Number: block.NumberU64(), ParentHash: block.ParentHash(), Time: block.Time()}
+	logCount := uint32(len(c.initiatedLogs(i, block.Hash())))
+	execMsgs := make(map[uint32]*supervisortypes.ExecutingMessage)
+	if !c.replaced[i] {
+		_, logs, msgs, err := c.executingMessages(i)
+		if err != nil {
+			return eth.BlockRef{}, 0, nil, err
+		}
+		for j, msg := range msgs {
+			execMsgs[uint32(logs[j].Index)] = msg
+		}
+	}
+	return ref, logCount, execMsgs, nil
+}
+
+// replaceBlock replaces the optimistic block of the chain with a block of only its deposits.
+func (c *consolidation) replaceBlock(bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, i int, tasks taskExecutor) error {
+	chain := c.superRoot.Chains[i]
+	rollupCfg, err := bootInfo.Configs.RollupConfig(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("%w: no rollup config available for chain ID %v: %w", ErrConfigUnavailable, chain.ChainID, err)
+	}
+	l2ChainConfig, err := bootInfo.Configs.ChainConfig(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("%w: no chain config available for chain ID %v: %w", ErrConfigUnavailable, chain.ChainID, err)
+	}
+	blockHash, outputRoot, err := tasks.BuildDepositOnlyBlock(
+		c.logger,
+		rollupCfg,
+		l2ChainConfig,
+		c.optimistic[i],
+		chain.Output,
+		bootInfo.AcceleratedPrecompiles,
+		l1PreimageOracle,
+		c.l2Oracle,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to build deposit-only block for chain %v: %w", chain.ChainID, err)
+	}
+	c.pending[i] = types.OptimisticBlock{BlockHash: blockHash, OutputRoot: outputRoot}
+	c.replaced[i] = true
+	return nil
+}
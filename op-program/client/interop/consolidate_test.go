@@ -0,0 +1,378 @@
+package interop
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/types/interoptypes"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+type consolidationTest struct {
+	t               *testing.T
+	configSource    *staticConfigSource
+	agreedSuperRoot *eth.SuperV1
+	tasksStub       stubTasks
+	oracle          *test.StubBlockOracle
+
+	// parents and pending are the agreed and optimistic blocks of each chain.
+	parents []*ethtypes.Block
+	pending []*ethtypes.Block
+	// dependencies is set to consolidate an IntermediateTransitionVersionV2 state, and returns its message dependencies.
+	dependencies func() []types.MessageDependencies
+}
+
+func newConsolidationTest(t *testing.T) *consolidationTest {
+	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+	tasksStub.depositOnlyBlockHash = common.Hash{0xd0}
+	tasksStub.depositOnlyOutputRoot = eth.Bytes32{0xd1}
+	oracle, _ := test.NewStubOracle(t)
+	c := &consolidationTest{
+		t:               t,
+		configSource:    configSource,
+		agreedSuperRoot: agreedSuperRoot,
+		tasksStub:       tasksStub,
+		oracle:          oracle,
+	}
+	for i := range agreedSuperRoot.Chains {
+		parent := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number: big.NewInt(int64(100 + i)),
+			Time:   agreedSuperRoot.Timestamp,
+		})
+		block := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			ParentHash: parent.Hash(),
+			Number:     big.NewInt(int64(101 + i)),
+			Time:       agreedSuperRoot.Timestamp + 1,
+		})
+		for _, b := range []*ethtypes.Block{parent, block} {
+			oracle.Blocks[b.Hash()] = b
+			oracle.Receipts[b.Hash()] = ethtypes.Receipts{}
+		}
+		c.parents = append(c.parents, parent)
+		c.pending = append(c.pending, block)
+	}
+	return c
+}
+
+// addLog adds a log to a new receipt of the block, and returns the log with its index in the block.
+func (c *consolidationTest) addLog(block *ethtypes.Block, txType uint8, l *ethtypes.Log) *ethtypes.Log {
+	receipts := c.oracle.Receipts[block.Hash()]
+	index := uint(0)
+	for _, rcpt := range receipts {
+		index += uint(len(rcpt.Logs))
+	}
+	l.Index = index
+	c.oracle.Receipts[block.Hash()] = append(receipts, &ethtypes.Receipt{Type: txType, Logs: []*ethtypes.Log{l}})
+	return l
+}
+
+// addInitiatingLog adds a log, which can be executed as message, to the block.
+func (c *consolidationTest) addInitiatingLog(block *ethtypes.Block, txType uint8) *ethtypes.Log {
+	return c.addLog(block, txType, &ethtypes.Log{
+		Address: common.Address{0xaa},
+		Topics:  []common.Hash{{0xbb}},
+		Data:    []byte{1, 2, 3},
+	})
+}
+
+// addExecutingLog adds an executing message of the initiating log to the block.
+func (c *consolidationTest) addExecutingLog(block *ethtypes.Block, initBlock *ethtypes.Block, initLog *ethtypes.Log, initChainID uint64) *ethtypes.Log {
+	return c.addLog(block, ethtypes.DynamicFeeTxType, executingLog(initBlock, initLog, initChainID))
+}
+
+// executingLog returns the log of an executing message of the initiating log.
+func executingLog(initBlock *ethtypes.Block, initLog *ethtypes.Log, initChainID uint64) *ethtypes.Log {
+	data := make([]byte, 32*5)
+	copy(data[12:32], initLog.Address[:])
+	binary.BigEndian.PutUint64(data[56:64], initBlock.NumberU64())
+	binary.BigEndian.PutUint32(data[92:96], uint32(initLog.Index))
+	binary.BigEndian.PutUint64(data[120:128], initBlock.Time())
+	binary.BigEndian.PutUint64(data[152:160], initChainID)
+	payloadHash := crypto.Keccak256Hash(supervisortypes.LogToMessagePayload(initLog))
+	return &ethtypes.Log{
+		Address: params.InteropCrossL2InboxAddress,
+		Topics:  []common.Hash{interoptypes.ExecutingMessageEventTopic, payloadHash},
+		Data:    data,
+	}
+}
+
+// depSetDependencies returns the dependencies of the dependency set of the chains, to configure a different dependency set.
+func (c *consolidationTest) depSetDependencies() map[eth.ChainID]*depset.StaticConfigDependency {
+	return map[eth.ChainID]*depset.StaticConfigDependency{
+		eth.ChainIDFromUInt64(c.chainID(0)): {ChainIndex: 3},
+		eth.ChainIDFromUInt64(c.chainID(1)): {ChainIndex: 7},
+	}
+}
+
+func (c *consolidationTest) chainID(i int) uint64 {
+	return c.agreedSuperRoot.Chains[i].ChainID
+}
+
+// verify runs the consolidation step and checks the resulting super root has the expected output roots.
+func (c *consolidationTest) verify(expectedOutputs ...eth.Bytes32) {
+	agreedTransitionState := &types.TransitionState{
+		SuperRoot: c.agreedSuperRoot.Marshal(),
+		Step:      types.ConsolidateStep,
+	}
+	for i, block := range c.pending {
+		agreedTransitionState.PendingProgress = append(agreedTransitionState.PendingProgress,
+			types.OptimisticBlock{BlockHash: block.Hash(), OutputRoot: eth.Bytes32{byte(i + 1)}})
+	}
+	if c.dependencies != nil {
+		agreedTransitionState.Dependencies = c.dependencies()
+		agreedTransitionState.StateVersion = types.IntermediateTransitionVersionV2
+	}
+	agreedPrestate := agreedTransitionState.Hash()
+	c.oracle.TransitionStates[agreedPrestate] = agreedTransitionState
+
+	expectedSuperRoot := &eth.SuperV1{Timestamp: c.agreedSuperRoot.Timestamp + 1}
+	for i, output := range expectedOutputs {
+		expectedSuperRoot.Chains = append(expectedSuperRoot.Chains, eth.ChainIDAndOutput{ChainID: c.chainID(i), Output: output})
+	}
+	logger := testlog.Logger(c.t, log.LevelError)
+	verifyResult(c.t, logger, c.tasksStub, c.configSource, c.oracle, c.agreedSuperRoot, agreedPrestate, common.Hash(eth.SuperRoot(expectedSuperRoot)))
+}
+
+// messageDependencies returns the message dependencies of the optimistic blocks.
+func (c *consolidationTest) messageDependencies() []types.MessageDependencies {
+	var deps []types.MessageDependencies
+	for i, block := range c.pending {
+		dep, err := messageDependencies(c.oracle, c.chainID(i), block.Hash())
+		require.NoError(c.t, err)
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
+func TestConsolidateWithoutMessages(t *testing.T) {
+	c := newConsolidationTest(t)
+	c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+	c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+}
+
+func TestConsolidateValidMessages(t *testing.T) {
+	t.Run("SameTimestamp", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+	})
+
+	t.Run("PreviousBlock", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.parents[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.parents[0], initLog, c.chainID(0))
+		c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+	})
+
+	t.Run("SameBlock", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[1], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[1], initLog, c.chainID(1))
+		c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+	})
+}
+
+func TestConsolidateReplaceInvalidMessages(t *testing.T) {
+	t.Run("UnknownChain", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, 99999)
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("WrongPayload", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		initLog.Data = []byte{4, 5, 6}
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("UnknownLog", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := &ethtypes.Log{Address: common.Address{0xaa}, Index: 5}
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("WrongBlock", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.parents[0], ethtypes.DynamicFeeTxType)
+		// Claims the initiating message was in the pending block, but it is in its parent
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		// The initiating block is not known to the oracle, the message must be rejected without walking back to it
+		initBlock := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number: big.NewInt(1),
+			Time:   c.pending[1].Time() - depset.DefaultMessageExpiryWindow - 1,
+		})
+		c.addExecutingLog(c.pending[1], initBlock, &ethtypes.Log{Address: common.Address{0xaa}}, c.chainID(0))
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("ExpiredByDependencySet", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initBlock := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number: big.NewInt(1),
+			Time:   c.pending[1].Time() - 101,
+		})
+		c.addExecutingLog(c.pending[1], initBlock, &ethtypes.Log{Address: common.Address{0xaa}}, c.chainID(0))
+		c.configSource.depSet = newDependencySet(c.depSetDependencies(), 100)
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("ExecutedBeforeActivation", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.parents[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.parents[0], initLog, c.chainID(0))
+		deps := c.depSetDependencies()
+		deps[eth.ChainIDFromUInt64(c.chainID(1))].ActivationTime = c.pending[1].Time() + 1
+		c.configSource.depSet = newDependencySet(deps, 0)
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("InitiatedBeforeHistory", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.parents[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.parents[0], initLog, c.chainID(0))
+		deps := c.depSetDependencies()
+		deps[eth.ChainIDFromUInt64(c.chainID(0))].HistoryMinTime = c.parents[0].Time() + 1
+		c.configSource.depSet = newDependencySet(deps, 0)
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("TimestampBeforeBlockNumber", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.parents[0], ethtypes.DynamicFeeTxType)
+		// Claims an earlier block number at the timestamp of the parent block,
+		// the walk must stop at the parent instead of walking back to the claimed number
+		initBlock := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number: big.NewInt(10),
+			Time:   c.parents[0].Time(),
+		})
+		c.addExecutingLog(c.pending[1], initBlock, initLog, c.chainID(0))
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("InitiatedLaterInSameBlock", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := &ethtypes.Log{Address: common.Address{0xaa}, Topics: []common.Hash{{0xbb}}, Index: 1}
+		c.addExecutingLog(c.pending[1], c.pending[1], initLog, c.chainID(1))
+		c.addInitiatingLog(c.pending[1], ethtypes.DynamicFeeTxType)
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+}
+
+func TestConsolidateReplaceCycles(t *testing.T) {
+	t.Run("SameTimestamp", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		// The first log of the second chain executes the second log of the first chain, which executes the second log
+		// of the second chain, so the messages depend on each other in a cycle. The first log is added as placeholder,
+		// as it executes a message that is added after it.
+		placeholder := c.addInitiatingLog(c.pending[1], ethtypes.DynamicFeeTxType)
+		execLog := c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		cycleLog := c.addExecutingLog(c.pending[0], c.pending[1], execLog, c.chainID(1))
+		*placeholder = *executingLog(c.pending[0], cycleLog, c.chainID(0))
+		placeholder.Index = 0
+		c.verify(c.tasksStub.depositOnlyOutputRoot, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("NoCycle", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		// The same messages without the first log of the second chain
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addInitiatingLog(c.pending[1], ethtypes.DynamicFeeTxType)
+		execLog := c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.addExecutingLog(c.pending[0], c.pending[1], execLog, c.chainID(1))
+		c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+	})
+}
+
+func TestConsolidateCascadingReplacements(t *testing.T) {
+	t.Run("ReplaceDependentBlock", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		// The first chain executes an invalid message, so its block is replaced
+		c.addExecutingLog(c.pending[0], c.pending[1], &ethtypes.Log{}, 99999)
+		// Which drops the message initiated in the block, invalidating the block of the second chain
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.verify(c.tasksStub.depositOnlyOutputRoot, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("KeepMessagesInitiatedByDeposits", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DepositTxType)
+		c.addExecutingLog(c.pending[0], c.pending[1], &ethtypes.Log{}, 99999)
+		// The deposit is also included in the deposit-only block, so its message stays valid
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.verify(c.tasksStub.depositOnlyOutputRoot, eth.Bytes32{2})
+	})
+}
+
+func TestConsolidateMessageDependencies(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		c.dependencies = c.messageDependencies
+		c.verify(eth.Bytes32{1}, eth.Bytes32{2})
+	})
+
+	t.Run("ReplaceInvalidMessages", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, 99999)
+		c.dependencies = c.messageDependencies
+		c.verify(eth.Bytes32{1}, c.tasksStub.depositOnlyOutputRoot)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		c := newConsolidationTest(t)
+		initLog := c.addInitiatingLog(c.pending[0], ethtypes.DynamicFeeTxType)
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+		// Commits to the optimistic blocks without the executing message
+		deps := c.messageDependencies()
+		c.addExecutingLog(c.pending[1], c.pending[0], initLog, c.chainID(0))
+
+		agreedTransitionState := &types.TransitionState{
+			SuperRoot:    c.agreedSuperRoot.Marshal(),
+			Step:         types.ConsolidateStep,
+			Dependencies: deps,
+			StateVersion: types.IntermediateTransitionVersionV2,
+		}
+		for i, block := range c.pending {
+			agreedTransitionState.PendingProgress = append(agreedTransitionState.PendingProgress,
+				types.OptimisticBlock{BlockHash: block.Hash(), OutputRoot: eth.Bytes32{byte(i + 1)}})
+		}
+		agreedPrestate := agreedTransitionState.Hash()
+		c.oracle.TransitionStates[agreedPrestate] = agreedTransitionState
+		bootInfo := &boot.BootInfoInterop{
+			AgreedPrestate: agreedPrestate,
+			ClaimTimestamp: c.agreedSuperRoot.Timestamp + 1,
+			Configs:        c.configSource,
+		}
+		logger := testlog.Logger(t, log.LevelError)
+		_, err := runInteropProgram(logger, bootInfo, nil, c.oracle, false, &c.tasksStub, nil, nil)
+		require.ErrorIs(t, err, ErrConsolidationFailed)
+		require.ErrorIs(t, err, ErrInvalidAgreedPrestate)
+	})
+}
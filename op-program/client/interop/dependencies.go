@@ -0,0 +1,70 @@
+package interop
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/types/interoptypes"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// messageDependencies returns the message dependencies of the block of the chain.
+// The commitment is the hash of the checksums of the executed messages, in log order, see supervisortypes.ChecksumArgs.
+// The checksums commit to the chain ID of the initiating chain instead of its index, so the dependency set is not needed.
+func messageDependencies(l2Oracle l2.Oracle, chainID uint64, blockHash common.Hash) (types.MessageDependencies, error) {
+	_, receipts := l2Oracle.ReceiptsByBlockHash(blockHash, chainID)
+	var checksums []byte
+	for _, rcpt := range receipts {
+		for _, l := range rcpt.Logs {
+			if !isExecutingMessageLog(l) {
+				continue
+			}
+			var msg interoptypes.Message
+			if err := msg.DecodeEvent(l.Topics, l.Data); err != nil {
+				return types.MessageDependencies{}, fmt.Errorf("invalid executing message in log %d of block %s on chain %v: %w", l.Index, blockHash, chainID, err)
+			}
+			checksum := supervisortypes.ChecksumArgs{
+				BlockNumber: msg.Identifier.BlockNumber,
+				LogIndex:    msg.Identifier.LogIndex,
+				Timestamp:   msg.Identifier.Timestamp,
+				ChainID:     eth.ChainID(msg.Identifier.ChainID),
+				LogHash:     supervisortypes.PayloadHashToLogHash(msg.PayloadHash, msg.Identifier.Origin),
+			}.Checksum()
+			checksums = append(checksums, checksum[:]...)
+		}
+	}
+	return types.MessageDependencies{ChainID: chainID, Commitment: crypto.Keccak256Hash(checksums)}, nil
+}
+
+// isExecutingMessageLog returns true if the log is an ExecutingMessage event of the CrossL2Inbox,
+// the same logs as decoded by processors.DecodeExecutingMessageLog.
+func isExecutingMessageLog(l *ethtypes.Log) bool {
+	return l.Address == params.InteropCrossL2InboxAddress &&
+		len(l.Topics) == 2 &&
+		l.Topics[0] == interoptypes.ExecutingMessageEventTopic
+}
+
+// checkDependencies returns an error if the message dependencies of the agreed transition state
+// do not commit to the executing messages of the optimistic blocks.
+func (c *consolidation) checkDependencies(dependencies []types.MessageDependencies) error {
+	if len(dependencies) != len(c.optimistic) {
+		return fmt.Errorf("%w: %d message dependencies for %d optimistic blocks", ErrInvalidAgreedPrestate, len(dependencies), len(c.optimistic))
+	}
+	for i, chain := range c.superRoot.Chains {
+		expected, err := messageDependencies(c.l2Oracle, chain.ChainID, c.optimistic[i])
+		if err != nil {
+			return err
+		}
+		if dependencies[i] != expected {
+			return fmt.Errorf("%w: message dependencies %v do not match optimistic block %s on chain %v",
+				ErrInvalidAgreedPrestate, dependencies[i], c.optimistic[i], chain.ChainID)
+		}
+	}
+	return nil
+}
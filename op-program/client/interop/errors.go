@@ -0,0 +1,42 @@
+package interop
+
+import (
+	"errors"
+	"fmt"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/claim"
+)
+
+// The errors returned by RunInteropProgram wrap one of these errors, so the cause of a failure can be determined
+// with errors.Is.
+var (
+	// ErrMissingPreimage is returned when a pre-image required by the state transition cannot be read from the oracle.
+	ErrMissingPreimage = errors.New("missing pre-image")
+	// ErrConfigUnavailable is returned when the rollup or chain config of a chain in the super root is not available,
+	// the chain is not in the dependency set, or the configs of the chains are inconsistent.
+	ErrConfigUnavailable = errors.New("config unavailable")
+	// ErrInvalidAgreedPrestate is returned when the agreed prestate is neither a super root nor a transition state.
+	ErrInvalidAgreedPrestate = errors.New("invalid agreed prestate")
+	// ErrDerivationFailed is returned when the optimistic block of a chain cannot be derived.
+	ErrDerivationFailed = errors.New("derivation failed")
+	// ErrConsolidationFailed is returned when the optimistic blocks cannot be consolidated into the next super root.
+	ErrConsolidationFailed = errors.New("consolidation failed")
+	// ErrClaimMismatch is returned when the claim does not match the computed post-state.
+	ErrClaimMismatch = claim.ErrClaimNotValid
+)
+
+// recoverMissingPreimage turns a panic of the pre-image oracle, because a pre-image is unavailable, into an
+// ErrMissingPreimage error. Other panics are not recovered.
+// Must be deferred directly, so it can recover the panic.
+func recoverMissingPreimage(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if panicErr, ok := r.(error); ok && errors.Is(panicErr, preimage.ErrPreimageUnavailable) {
+		*err = fmt.Errorf("%w: %w", ErrMissingPreimage, panicErr)
+		return
+	}
+	panic(r)
+}
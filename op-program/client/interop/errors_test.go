@@ -0,0 +1,146 @@
+package interop
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type errorTest struct {
+	configSource    *staticConfigSource
+	agreedSuperRoot *eth.SuperV1
+	tasksStub       stubTasks
+	oracle          *test.StubBlockOracle
+	agreedPrestate  common.Hash
+	claim           common.Hash
+}
+
+func newErrorTest(t *testing.T) *errorTest {
+	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+	oracle, _ := test.NewStubOracle(t)
+	agreedPrestate := common.Hash(eth.SuperRoot(agreedSuperRoot))
+	oracle.TransitionStates[agreedPrestate] = &types.TransitionState{SuperRoot: agreedSuperRoot.Marshal()}
+	expectedState := &types.TransitionState{
+		SuperRoot:       agreedSuperRoot.Marshal(),
+		PendingProgress: []types.OptimisticBlock{{BlockHash: tasksStub.blockHash, OutputRoot: tasksStub.outputRoot}},
+		Step:            1,
+	}
+	return &errorTest{
+		configSource:    configSource,
+		agreedSuperRoot: agreedSuperRoot,
+		tasksStub:       tasksStub,
+		oracle:          oracle,
+		agreedPrestate:  agreedPrestate,
+		claim:           expectedState.Hash(),
+	}
+}
+
+func (e *errorTest) run(t *testing.T, l2Oracle l2.Oracle) error {
+	bootInfo := &boot.BootInfoInterop{
+		AgreedPrestate: e.agreedPrestate,
+		ClaimTimestamp: e.agreedSuperRoot.Timestamp + 1,
+		Claim:          e.claim,
+		Configs:        e.configSource,
+	}
+	if l2Oracle == nil {
+		l2Oracle = e.oracle
+	}
+	_, err := runInteropProgram(testlog.Logger(t, log.LevelError), bootInfo, nil, l2Oracle, true, &e.tasksStub, nil, nil)
+	return err
+}
+
+func TestRunInteropProgramErrors(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		e := newErrorTest(t)
+		require.NoError(t, e.run(t, nil))
+	})
+
+	t.Run("ClaimMismatch", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.claim = common.Hash{0xba, 0xd0}
+		require.ErrorIs(t, e.run(t, nil), ErrClaimMismatch)
+	})
+
+	t.Run("ConfigUnavailable", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.configSource.rollupCfgs = e.configSource.rollupCfgs[1:]
+		require.ErrorIs(t, e.run(t, nil), ErrConfigUnavailable)
+	})
+
+	t.Run("DependencySetUnavailable", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.configSource.depSet = nil
+		e.oracle.TransitionStates[e.agreedPrestate] = &types.TransitionState{
+			SuperRoot:       e.agreedSuperRoot.Marshal(),
+			PendingProgress: []types.OptimisticBlock{{BlockHash: common.Hash{0x01}}, {BlockHash: common.Hash{0x02}}},
+			Step:            types.ConsolidateStep,
+		}
+		require.ErrorIs(t, e.run(t, nil), ErrConfigUnavailable)
+	})
+
+	t.Run("InconsistentConfigs", func(t *testing.T) {
+		e := newErrorTest(t)
+		chainCfg := *e.configSource.chainConfigs[1]
+		chainCfg.InteropTime = new(uint64)
+		e.configSource.chainConfigs[1] = &chainCfg
+		err := e.run(t, nil)
+		require.ErrorIs(t, err, ErrConfigUnavailable)
+		require.ErrorIs(t, err, boot.ErrInconsistentConfigs)
+	})
+
+	t.Run("InvalidAgreedPrestate", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.oracle.TransitionStates[e.agreedPrestate] = &types.TransitionState{SuperRoot: []byte{eth.SuperRootVersionV1, 1, 2}}
+		require.ErrorIs(t, e.run(t, nil), ErrInvalidAgreedPrestate)
+	})
+
+	t.Run("DerivationFailed", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.tasksStub.err = errors.New("boom")
+		err := e.run(t, nil)
+		require.ErrorIs(t, err, ErrDerivationFailed)
+		require.ErrorIs(t, err, e.tasksStub.err)
+	})
+
+	t.Run("MissingPreimage", func(t *testing.T) {
+		e := newErrorTest(t)
+		oracle := &panickingL2Oracle{Oracle: e.oracle, err: fmt.Errorf("%w: closed", preimage.ErrPreimageUnavailable)}
+		require.ErrorIs(t, e.run(t, oracle), ErrMissingPreimage)
+	})
+
+	t.Run("MissingPreimageInDerivation", func(t *testing.T) {
+		e := newErrorTest(t)
+		e.tasksStub.panicErr = fmt.Errorf("%w: closed", preimage.ErrPreimageUnavailable)
+		require.ErrorIs(t, e.run(t, nil), ErrMissingPreimage)
+	})
+
+	t.Run("OtherPanicsNotRecovered", func(t *testing.T) {
+		e := newErrorTest(t)
+		oracle := &panickingL2Oracle{Oracle: e.oracle, err: errors.New("bad pre-image")}
+		require.Panics(t, func() {
+			_ = e.run(t, oracle)
+		})
+	})
+}
+
+// panickingL2Oracle panics when reading the agreed prestate, like the pre-image oracle client does when a pre-image
+// cannot be read.
+type panickingL2Oracle struct {
+	l2.Oracle
+	err error
+}
+
+func (o *panickingL2Oracle) TransitionStateByRoot(common.Hash) *types.TransitionState {
+	panic(o.err)
+}
@@ -3,13 +3,18 @@ package interop
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	"github.com/ethereum-optimism/optimism/op-program/client/claim"
 	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+	"github.com/ethereum-optimism/optimism/op-program/client/metrics"
 	"github.com/ethereum-optimism/optimism/op-program/client/tasks"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
@@ -34,28 +39,53 @@ type taskExecutor interface {
 		l1Head common.Hash,
 		agreedOutputRoot eth.Bytes32,
 		claimedBlockNumber uint64,
+		precompileFlags engineapi.PrecompileFlags,
 		l1Oracle l1.Oracle,
 		l2Oracle l2.Oracle) (tasks.DerivationResult, error)
+
+	BuildDepositOnlyBlock(
+		logger log.Logger,
+		rollupCfg *rollup.Config,
+		l2ChainConfig *params.ChainConfig,
+		optimisticBlockHash common.Hash,
+		agreedOutputRoot eth.Bytes32,
+		precompileFlags engineapi.PrecompileFlags,
+		l1Oracle l1.Oracle,
+		l2Oracle l2.Oracle) (common.Hash, eth.Bytes32, error)
 }
 
-func RunInteropProgram(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, validateClaim bool) error {
-	return runInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, validateClaim, &interopTaskExecutor{})
+// RunInteropProgram executes a single step of the interop state transition and returns the resulting post-state.
+// Errors wrap one of ErrMissingPreimage, ErrConfigUnavailable, ErrInvalidAgreedPrestate, ErrDerivationFailed,
+// ErrConsolidationFailed or ErrClaimMismatch, to identify the cause of the failure.
+// If tracer is not nil, the execution is recorded to it.
+// If checkpoints is not nil, a checkpoint hint is sent to it once the agreed state is parsed,
+// so VMs can snapshot the state there and restore it to re-execute the same step, e.g. for another claim.
+// The duration of the derivations is recorded to m.
+func RunInteropProgram(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, validateClaim bool, tracer ExecutionTracer, checkpoints preimage.Hinter, m metrics.Metricer) (eth.Bytes32, error) {
+	return runInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, validateClaim, &interopTaskExecutor{m: m}, tracer, checkpoints)
 }
 
-func runInteropProgram(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, validateClaim bool, tasks taskExecutor) error {
+func runInteropProgram(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, validateClaim bool, tasks taskExecutor, tracer ExecutionTracer, checkpoints preimage.Hinter) (_ eth.Bytes32, err error) {
+	defer recoverMissingPreimage(&err)
 	logger.Info("Interop Program Bootstrapped", "bootInfo", bootInfo)
 
-	expected, err := stateTransition(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, tasks)
+	if tracer != nil {
+		logger.Info("Recording execution trace")
+		l1PreimageOracle = &tracingL1Oracle{tracer: tracer, oracle: l1PreimageOracle}
+		l2PreimageOracle = &tracingL2Oracle{tracer: tracer, oracle: l2PreimageOracle}
+		tasks = &tracingTaskExecutor{tracer: tracer, tasks: tasks}
+	}
+	expected, err := stateTransition(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, tasks, tracer, checkpoints)
 	if err != nil {
-		return err
+		return eth.Bytes32{}, err
 	}
 	if !validateClaim {
-		return nil
+		return eth.Bytes32(expected), nil
 	}
-	return claim.ValidateClaim(logger, eth.Bytes32(bootInfo.Claim), eth.Bytes32(expected))
+	return eth.Bytes32(expected), claim.ValidateClaim(logger, eth.Bytes32(bootInfo.ReadClaim()), eth.Bytes32(expected))
 }
 
-func stateTransition(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, tasks taskExecutor) (common.Hash, error) {
+func stateTransition(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, tasks taskExecutor, tracer ExecutionTracer, checkpoints preimage.Hinter) (common.Hash, error) {
 	if bootInfo.AgreedPrestate == InvalidTransitionHash {
 		return InvalidTransitionHash, nil
 	}
@@ -63,71 +93,165 @@ func stateTransition(logger log.Logger, bootInfo *boot.BootInfoInterop, l1Preima
 	if err != nil {
 		return common.Hash{}, err
 	}
-	expectedPendingProgress := transitionState.PendingProgress
+	if checkpoints != nil {
+		// The state of the program only depends on the boot info committed to by the checkpoint up to here,
+		// and the claim is only read afterwards, so a snapshot of the state can be restored to re-execute the step,
+		// instead of parsing the agreed state again.
+		checkpoints.Hint(preimage.CheckpointHint(bootInfo.Checkpoint()))
+	}
+	if err := validateConfigs(logger, bootInfo, superRoot); err != nil {
+		return common.Hash{}, err
+	}
+	if transitionState.Step == types.ConsolidateStep {
+		// The last step of the timestamp, consolidate the optimistic blocks into the super root of the next timestamp.
+		consolidated, err := RunConsolidation(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, transitionState, superRoot, tasks)
+		if err != nil {
+			if !errors.Is(err, ErrConfigUnavailable) {
+				err = fmt.Errorf("%w: %w", ErrConsolidationFailed, err)
+			}
+			return common.Hash{}, err
+		}
+		traceStateHash(tracer, transitionState.Step+1, common.Hash(consolidated))
+		return common.Hash(consolidated), nil
+	}
+	var blocks []types.OptimisticBlock
+	var dependencies []types.MessageDependencies
 	if transitionState.Step < uint64(len(superRoot.Chains)) {
-		block, err := deriveOptimisticBlock(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, superRoot, transitionState, tasks)
+		block, err := deriveOptimisticBlock(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, superRoot, transitionState.Step, tasks)
 		if errors.Is(err, ErrL1HeadReached) {
+			traceStateHash(tracer, transitionState.Step+1, InvalidTransitionHash)
 			return InvalidTransitionHash, nil
 		} else if err != nil {
 			return common.Hash{}, err
 		}
-		expectedPendingProgress = append(expectedPendingProgress, block)
+		blocks = append(blocks, block)
+		if transitionState.Version() == types.IntermediateTransitionVersionV2 {
+			deps, err := messageDependencies(l2PreimageOracle, superRoot.Chains[transitionState.Step].ChainID, block.BlockHash)
+			if err != nil {
+				return common.Hash{}, fmt.Errorf("%w: %w", ErrDerivationFailed, err)
+			}
+			dependencies = append(dependencies, deps)
+		}
 	}
-	finalState := &types.TransitionState{
+	finalState := nextTransitionState(transitionState, blocks, dependencies)
+	finalHash := finalState.Hash()
+	traceStateHash(tracer, finalState.Step, finalHash)
+	return finalHash, nil
+}
+
+// nextTransitionState returns the transition state after the given blocks are derived from the agreed state,
+// with the message dependencies of the blocks if the state is IntermediateTransitionVersionV2.
+// Every invocation of the program is a single step, so the post-state of a claim doesn't depend on how the host runs it.
+func nextTransitionState(transitionState *types.TransitionState, blocks []types.OptimisticBlock, dependencies []types.MessageDependencies) *types.TransitionState {
+	return &types.TransitionState{
 		SuperRoot:       transitionState.SuperRoot,
-		PendingProgress: expectedPendingProgress,
+		PendingProgress: append(slices.Clone(transitionState.PendingProgress), blocks...),
 		Step:            transitionState.Step + 1,
+		// The state keeps the version it was agreed with, and carries the message dependencies over to consolidation.
+		Dependencies: append(slices.Clone(transitionState.Dependencies), dependencies...),
+		StateVersion: transitionState.StateVersion,
+	}
+}
+
+// traceStateHash records the state after the step in the execution trace, if it is traced.
+func traceStateHash(tracer ExecutionTracer, step uint64, state common.Hash) {
+	if tracer != nil {
+		tracer.StateHash(step, state)
 	}
-	return finalState.Hash(), nil
 }
 
 func parseAgreedState(bootInfo *boot.BootInfoInterop, l2PreimageOracle l2.Oracle) (*types.TransitionState, *eth.SuperV1, error) {
 	// For the first step in a timestamp, we would get a SuperRoot as the agreed claim - TransitionStateByRoot will
 	// automatically convert it to a TransitionState with Step: 0.
 	transitionState := l2PreimageOracle.TransitionStateByRoot(bootInfo.AgreedPrestate)
-	if transitionState.Version() != types.IntermediateTransitionVersion {
-		return nil, nil, fmt.Errorf("%w: %v", ErrIncorrectOutputRootType, transitionState.Version())
+	if !types.IsTransitionVersion(transitionState.Version()) {
+		return nil, nil, fmt.Errorf("%w: %w: %v", ErrInvalidAgreedPrestate, ErrIncorrectOutputRootType, transitionState.Version())
 	}
 
 	super, err := eth.UnmarshalSuperRoot(transitionState.SuperRoot)
 	if err != nil {
-		return nil, nil, fmt.Errorf("invalid super root: %w", err)
+		return nil, nil, fmt.Errorf("%w: invalid super root: %w", ErrInvalidAgreedPrestate, err)
 	}
 	if super.Version() != eth.SuperRootVersionV1 {
-		return nil, nil, fmt.Errorf("%w: %v", ErrIncorrectOutputRootType, super.Version())
+		return nil, nil, fmt.Errorf("%w: %w: %v", ErrInvalidAgreedPrestate, ErrIncorrectOutputRootType, super.Version())
 	}
 	superRoot := super.(*eth.SuperV1)
+	if transitionState.Version() == types.IntermediateTransitionVersionV2 && len(transitionState.Dependencies) != len(transitionState.PendingProgress) {
+		return nil, nil, fmt.Errorf("%w: %d message dependencies for %d pending blocks",
+			ErrInvalidAgreedPrestate, len(transitionState.Dependencies), len(transitionState.PendingProgress))
+	}
 	return transitionState, superRoot, nil
 }
 
-func deriveOptimisticBlock(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, superRoot *eth.SuperV1, transitionState *types.TransitionState, tasks taskExecutor) (types.OptimisticBlock, error) {
-	chainAgreedPrestate := superRoot.Chains[transitionState.Step]
+// validateConfigs checks the configs of all chains in the super root before any chain is derived,
+// so inconsistent configs fail the program upfront instead of during derivation or consolidation.
+func validateConfigs(logger log.Logger, bootInfo *boot.BootInfoInterop, superRoot *eth.SuperV1) error {
+	chainIDs := make([]uint64, len(superRoot.Chains))
+	for i, chain := range superRoot.Chains {
+		chainIDs[i] = chain.ChainID
+	}
+	fingerprint, err := bootInfo.ValidateConfigs(chainIDs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrConfigUnavailable, err)
+	}
+	logger.Info("Validated chain configs", "chains", len(chainIDs), "fingerprint", fingerprint)
+	return nil
+}
+
+// chainDerivation is the input to derive the optimistic block of a single chain.
+type chainDerivation struct {
+	rollupCfg          *rollup.Config
+	l2ChainConfig      *params.ChainConfig
+	agreedOutputRoot   eth.Bytes32
+	claimedBlockNumber uint64
+}
+
+func prepareDerivation(bootInfo *boot.BootInfoInterop, superRoot *eth.SuperV1, step uint64) (chainDerivation, error) {
+	chainAgreedPrestate := superRoot.Chains[step]
 	rollupCfg, err := bootInfo.Configs.RollupConfig(chainAgreedPrestate.ChainID)
 	if err != nil {
-		return types.OptimisticBlock{}, fmt.Errorf("no rollup config available for chain ID %v: %w", chainAgreedPrestate.ChainID, err)
+		return chainDerivation{}, fmt.Errorf("%w: no rollup config available for chain ID %v: %w", ErrConfigUnavailable, chainAgreedPrestate.ChainID, err)
 	}
 	l2ChainConfig, err := bootInfo.Configs.ChainConfig(chainAgreedPrestate.ChainID)
 	if err != nil {
-		return types.OptimisticBlock{}, fmt.Errorf("no chain config available for chain ID %v: %w", chainAgreedPrestate.ChainID, err)
+		return chainDerivation{}, fmt.Errorf("%w: no chain config available for chain ID %v: %w", ErrConfigUnavailable, chainAgreedPrestate.ChainID, err)
 	}
 	claimedBlockNumber, err := rollupCfg.TargetBlockNumber(superRoot.Timestamp + 1)
+	if err != nil {
+		return chainDerivation{}, fmt.Errorf("%w: no target block of chain ID %v: %w", ErrDerivationFailed, chainAgreedPrestate.ChainID, err)
+	}
+	return chainDerivation{
+		rollupCfg:          rollupCfg,
+		l2ChainConfig:      l2ChainConfig,
+		agreedOutputRoot:   chainAgreedPrestate.Output,
+		claimedBlockNumber: claimedBlockNumber,
+	}, nil
+}
+
+func deriveOptimisticBlock(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, superRoot *eth.SuperV1, step uint64, tasks taskExecutor) (types.OptimisticBlock, error) {
+	derivation, err := prepareDerivation(bootInfo, superRoot, step)
 	if err != nil {
 		return types.OptimisticBlock{}, err
 	}
+	return runDerivation(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, derivation, tasks)
+}
+
+func runDerivation(logger log.Logger, bootInfo *boot.BootInfoInterop, l1PreimageOracle l1.Oracle, l2PreimageOracle l2.Oracle, derivation chainDerivation, tasks taskExecutor) (types.OptimisticBlock, error) {
 	derivationResult, err := tasks.RunDerivation(
 		logger,
-		rollupCfg,
-		l2ChainConfig,
+		derivation.rollupCfg,
+		derivation.l2ChainConfig,
 		bootInfo.L1Head,
-		chainAgreedPrestate.Output,
-		claimedBlockNumber,
+		derivation.agreedOutputRoot,
+		derivation.claimedBlockNumber,
+		bootInfo.AcceleratedPrecompiles,
 		l1PreimageOracle,
 		l2PreimageOracle,
 	)
 	if err != nil {
-		return types.OptimisticBlock{}, err
+		return types.OptimisticBlock{}, fmt.Errorf("%w: chain ID %v: %w", ErrDerivationFailed, derivation.rollupCfg.L2ChainID, err)
 	}
-	if derivationResult.Head.Number < claimedBlockNumber {
+	if derivationResult.Head.Number < derivation.claimedBlockNumber {
 		return types.OptimisticBlock{}, ErrL1HeadReached
 	}
 
@@ -139,6 +263,7 @@ func deriveOptimisticBlock(logger log.Logger, bootInfo *boot.BootInfoInterop, l1
 }
 
 type interopTaskExecutor struct {
+	m metrics.Metricer
 }
 
 func (t *interopTaskExecutor) RunDerivation(
@@ -148,8 +273,13 @@ func (t *interopTaskExecutor) RunDerivation(
 	l1Head common.Hash,
 	agreedOutputRoot eth.Bytes32,
 	claimedBlockNumber uint64,
+	precompileFlags engineapi.PrecompileFlags,
 	l1Oracle l1.Oracle,
 	l2Oracle l2.Oracle) (tasks.DerivationResult, error) {
+	start := time.Now()
+	defer func() {
+		t.m.RecordDerivationDuration(rollupCfg.L2ChainID.Uint64(), time.Since(start))
+	}()
 	return tasks.RunDerivation(
 		logger,
 		rollupCfg,
@@ -157,6 +287,27 @@ func (t *interopTaskExecutor) RunDerivation(
 		l1Head,
 		common.Hash(agreedOutputRoot),
 		claimedBlockNumber,
+		precompileFlags,
+		l1Oracle,
+		l2Oracle)
+}
+
+func (t *interopTaskExecutor) BuildDepositOnlyBlock(
+	logger log.Logger,
+	rollupCfg *rollup.Config,
+	l2ChainConfig *params.ChainConfig,
+	optimisticBlockHash common.Hash,
+	agreedOutputRoot eth.Bytes32,
+	precompileFlags engineapi.PrecompileFlags,
+	l1Oracle l1.Oracle,
+	l2Oracle l2.Oracle) (common.Hash, eth.Bytes32, error) {
+	return tasks.BuildDepositOnlyBlock(
+		logger,
+		rollupCfg,
+		l2ChainConfig,
+		optimisticBlockHash,
+		agreedOutputRoot,
+		precompileFlags,
 		l1Oracle,
 		l2Oracle)
 }
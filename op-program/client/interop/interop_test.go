@@ -1,22 +1,29 @@
 package interop
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2/test"
 	"github.com/ethereum-optimism/optimism/op-program/client/tasks"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
@@ -28,6 +35,7 @@ func setupTwoChains() (*staticConfigSource, *eth.SuperV1, stubTasks) {
 
 	rollupCfg2 := *chaincfg.OPSepolia()
 	rollupCfg2.L2ChainID = new(big.Int).SetUint64(42)
+	rollupCfg2.Genesis.L2.Hash = common.Hash{0x42}
 	chainCfg2 := *chainconfig.OPSepoliaChainConfig()
 	chainCfg2.ChainID = rollupCfg2.L2ChainID
 
@@ -41,6 +49,10 @@ func setupTwoChains() (*staticConfigSource, *eth.SuperV1, stubTasks) {
 	configSource := &staticConfigSource{
 		rollupCfgs:   []*rollup.Config{rollupCfg1, &rollupCfg2},
 		chainConfigs: []*params.ChainConfig{chainCfg1, &chainCfg2},
+		depSet: newDependencySet(map[eth.ChainID]*depset.StaticConfigDependency{
+			eth.ChainIDFromBig(rollupCfg1.L2ChainID): {ChainIndex: 3},
+			eth.ChainIDFromBig(rollupCfg2.L2ChainID): {ChainIndex: 7},
+		}, 0),
 	}
 	tasksStub := stubTasks{
 		l2SafeHead: eth.L2BlockRef{Number: 918429823450218}, // Past the claimed block
@@ -97,35 +109,140 @@ func TestDeriveBlockForSecondChainFromTransitionState(t *testing.T) {
 }
 
 func TestNoOpStep(t *testing.T) {
+	// All chains are derived, the steps until the consolidation step are padding
+	for _, step := range []uint64{2, types.ConsolidateStep - 1} {
+		t.Run(fmt.Sprintf("Step%d", step), func(t *testing.T) {
+			logger := testlog.Logger(t, log.LevelError)
+			configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+			agreedTransitionState := &types.TransitionState{
+				SuperRoot: agreedSuperRoot.Marshal(),
+				PendingProgress: []types.OptimisticBlock{
+					{BlockHash: common.Hash{0xaa}, OutputRoot: eth.Bytes32{6: 22}},
+					{BlockHash: tasksStub.blockHash, OutputRoot: tasksStub.outputRoot},
+				},
+				Step: step,
+			}
+			outputRootHash := agreedTransitionState.Hash()
+			l2PreimageOracle, _ := test.NewStubOracle(t)
+			l2PreimageOracle.TransitionStates[outputRootHash] = agreedTransitionState
+			expectedIntermediateRoot := *agreedTransitionState // Copy agreed state
+			expectedIntermediateRoot.Step = step + 1
+
+			expectedClaim := expectedIntermediateRoot.Hash()
+			verifyResult(t, logger, tasksStub, configSource, l2PreimageOracle, agreedSuperRoot, outputRootHash, expectedClaim)
+		})
+	}
+}
+
+func TestKeepTransitionStateVersion(t *testing.T) {
 	logger := testlog.Logger(t, log.LevelError)
 	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+	dependencies := []types.MessageDependencies{
+		{ChainID: agreedSuperRoot.Chains[0].ChainID, Commitment: common.Hash{0xdd}},
+	}
 	agreedTransitionState := &types.TransitionState{
 		SuperRoot: agreedSuperRoot.Marshal(),
 		PendingProgress: []types.OptimisticBlock{
 			{BlockHash: common.Hash{0xaa}, OutputRoot: eth.Bytes32{6: 22}},
-			{BlockHash: tasksStub.blockHash, OutputRoot: tasksStub.outputRoot},
 		},
-		Step: 2,
+		Step:         1,
+		Dependencies: dependencies,
+		StateVersion: types.IntermediateTransitionVersionV2,
 	}
 	outputRootHash := agreedTransitionState.Hash()
 	l2PreimageOracle, _ := test.NewStubOracle(t)
 	l2PreimageOracle.TransitionStates[outputRootHash] = agreedTransitionState
-	expectedIntermediateRoot := *agreedTransitionState // Copy agreed state
-	expectedIntermediateRoot.Step = 3
+
+	// The derived block executes a message, which the dependencies of the next state commit to.
+	initBlock := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(5), Time: agreedSuperRoot.Timestamp})
+	initLog := &ethtypes.Log{Address: common.Address{0xaa}, Topics: []common.Hash{{0xbb}}, Index: 2}
+	execLog := executingLog(initBlock, initLog, agreedSuperRoot.Chains[0].ChainID)
+	l2PreimageOracle.Blocks[tasksStub.blockHash] = ethtypes.NewBlockWithHeader(&ethtypes.Header{})
+	l2PreimageOracle.Receipts[tasksStub.blockHash] = ethtypes.Receipts{{Logs: []*ethtypes.Log{{Address: common.Address{0xcc}}, execLog}}}
+	checksum := supervisortypes.ChecksumArgs{
+		BlockNumber: initBlock.NumberU64(),
+		LogIndex:    uint32(initLog.Index),
+		Timestamp:   initBlock.Time(),
+		ChainID:     eth.ChainIDFromUInt64(agreedSuperRoot.Chains[0].ChainID),
+		LogHash:     supervisortypes.PayloadHashToLogHash(execLog.Topics[1], initLog.Address),
+	}.Checksum()
+
+	expectedIntermediateRoot := &types.TransitionState{
+		SuperRoot: agreedSuperRoot.Marshal(),
+		PendingProgress: []types.OptimisticBlock{
+			{BlockHash: common.Hash{0xaa}, OutputRoot: eth.Bytes32{6: 22}},
+			{BlockHash: tasksStub.blockHash, OutputRoot: tasksStub.outputRoot},
+		},
+		Step: 2,
+		Dependencies: []types.MessageDependencies{
+			dependencies[0],
+			{ChainID: agreedSuperRoot.Chains[1].ChainID, Commitment: crypto.Keccak256Hash(checksum[:])},
+		},
+		StateVersion: types.IntermediateTransitionVersionV2,
+	}
 
 	expectedClaim := expectedIntermediateRoot.Hash()
 	verifyResult(t, logger, tasksStub, configSource, l2PreimageOracle, agreedSuperRoot, outputRootHash, expectedClaim)
 }
 
-func verifyResult(t *testing.T, logger log.Logger, tasks stubTasks, configSource *staticConfigSource, l2PreimageOracle *test.StubBlockOracle, agreedSuperRoot *eth.SuperV1, agreedPrestate common.Hash, expectedClaim common.Hash) {
+func TestRejectInconsistentMessageDependencies(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+	agreedTransitionState := &types.TransitionState{
+		SuperRoot: agreedSuperRoot.Marshal(),
+		PendingProgress: []types.OptimisticBlock{
+			{BlockHash: common.Hash{0xaa}, OutputRoot: eth.Bytes32{6: 22}},
+		},
+		Step:         1,
+		StateVersion: types.IntermediateTransitionVersionV2,
+	}
+	outputRootHash := agreedTransitionState.Hash()
+	l2PreimageOracle, _ := test.NewStubOracle(t)
+	l2PreimageOracle.TransitionStates[outputRootHash] = agreedTransitionState
+	bootInfo := &boot.BootInfoInterop{
+		AgreedPrestate: outputRootHash,
+		ClaimTimestamp: agreedSuperRoot.Timestamp + 1,
+		Configs:        configSource,
+	}
+	_, err := runInteropProgram(logger, bootInfo, nil, l2PreimageOracle, false, &tasksStub, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidAgreedPrestate)
+}
+
+func TestCheckpointAfterAgreedState(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+
+	outputRootHash := common.Hash(eth.SuperRoot(agreedSuperRoot))
+	l2PreimageOracle, _ := test.NewStubOracle(t)
+	l2PreimageOracle.TransitionStates[outputRootHash] = &types.TransitionState{SuperRoot: agreedSuperRoot.Marshal()}
+	bootInfo := &boot.BootInfoInterop{
+		AgreedPrestate: outputRootHash,
+		ClaimTimestamp: agreedSuperRoot.Timestamp + 1,
+		Configs:        configSource,
+	}
+
+	var hints []string
+	checkpoints := preimage.HinterFn(func(v preimage.Hint) {
+		hints = append(hints, v.Hint())
+	})
+	_, err := runInteropProgram(logger, bootInfo, nil, l2PreimageOracle, false, &tasksStub, nil, checkpoints)
+	require.NoError(t, err)
+	require.Equal(t, []string{preimage.CheckpointHint(bootInfo.Checkpoint()).Hint()}, hints)
+}
+
+func verifyResult(t *testing.T, logger log.Logger, tasks stubTasks, configSource *staticConfigSource, l2PreimageOracle *test.StubBlockOracle, agreedSuperRoot *eth.SuperV1, agreedPrestate common.Hash, expectedClaim common.Hash, opts ...func(*boot.BootInfoInterop)) {
 	bootInfo := &boot.BootInfoInterop{
 		AgreedPrestate: agreedPrestate,
 		ClaimTimestamp: agreedSuperRoot.Timestamp + 1,
 		Claim:          expectedClaim,
 		Configs:        configSource,
 	}
-	err := runInteropProgram(logger, bootInfo, nil, l2PreimageOracle, true, &tasks)
+	for _, opt := range opts {
+		opt(bootInfo)
+	}
+	claim, err := runInteropProgram(logger, bootInfo, nil, l2PreimageOracle, true, &tasks, nil, nil)
 	require.NoError(t, err)
+	require.Equal(t, eth.Bytes32(expectedClaim), claim)
 }
 
 type stubTasks struct {
@@ -133,6 +250,11 @@ type stubTasks struct {
 	blockHash  common.Hash
 	outputRoot eth.Bytes32
 	err        error
+	// panicErr is raised as panic by RunDerivation, if set.
+	panicErr error
+
+	depositOnlyBlockHash  common.Hash
+	depositOnlyOutputRoot eth.Bytes32
 }
 
 func (t *stubTasks) RunDerivation(
@@ -142,8 +264,12 @@ func (t *stubTasks) RunDerivation(
 	_ common.Hash,
 	_ eth.Bytes32,
 	_ uint64,
+	_ engineapi.PrecompileFlags,
 	_ l1.Oracle,
 	_ l2.Oracle) (tasks.DerivationResult, error) {
+	if t.panicErr != nil {
+		panic(t.panicErr)
+	}
 	return tasks.DerivationResult{
 		Head:       t.l2SafeHead,
 		BlockHash:  t.blockHash,
@@ -151,9 +277,30 @@ func (t *stubTasks) RunDerivation(
 	}, t.err
 }
 
+func (t *stubTasks) BuildDepositOnlyBlock(
+	_ log.Logger,
+	_ *rollup.Config,
+	_ *params.ChainConfig,
+	_ common.Hash,
+	_ eth.Bytes32,
+	_ engineapi.PrecompileFlags,
+	_ l1.Oracle,
+	_ l2.Oracle) (common.Hash, eth.Bytes32, error) {
+	return t.depositOnlyBlockHash, t.depositOnlyOutputRoot, nil
+}
+
+func newDependencySet(deps map[eth.ChainID]*depset.StaticConfigDependency, messageExpiryWindow uint64) *depset.StaticConfigDependencySet {
+	depSet, err := depset.NewStaticConfigDependencySetWithMessageExpiryWindow(deps, messageExpiryWindow)
+	if err != nil {
+		panic(err)
+	}
+	return depSet
+}
+
 type staticConfigSource struct {
 	rollupCfgs   []*rollup.Config
 	chainConfigs []*params.ChainConfig
+	depSet       *depset.StaticConfigDependencySet
 }
 
 func (s *staticConfigSource) RollupConfig(chainID uint64) (*rollup.Config, error) {
@@ -173,3 +320,10 @@ func (s *staticConfigSource) ChainConfig(chainID uint64) (*params.ChainConfig, e
 	}
 	return nil, fmt.Errorf("no chain config found for chain %d", chainID)
 }
+
+func (s *staticConfigSource) DependencySet() (depset.DependencySet, error) {
+	if s.depSet == nil {
+		return nil, errors.New("no dependency set")
+	}
+	return s.depSet, nil
+}
@@ -0,0 +1,94 @@
+package interop
+
+import (
+	"encoding/json"
+	"fmt"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HintExecutionTrace is the type of the hints that carry the events of the execution trace to the host.
+const HintExecutionTrace = "execution-trace"
+
+// ExecutionTracer records the execution of the interop state transition, to find where the execution of a
+// disputed transition diverges between two actors. The program calls it in a deterministic order,
+// so the traces of the same transition only differ where the executions differ.
+type ExecutionTracer interface {
+	// OracleRequest is called for every request to the L1 or L2 oracle, with the oracle method and its arguments.
+	OracleRequest(method string, args ...string)
+	// DerivedBlock is called for every block derived for a chain, and for every deposit-only block that
+	// replaces a block with invalid executing messages during consolidation.
+	DerivedBlock(chainID uint64, block types.OptimisticBlock, depositOnly bool)
+	// StateHash is called with the hash of the state after every step of the transition.
+	// The state after the last step is the super root of the next timestamp.
+	StateHash(step uint64, state common.Hash)
+}
+
+type TraceEventType string
+
+const (
+	TraceOracleRequest TraceEventType = "oracle-request"
+	TraceDerivedBlock  TraceEventType = "derived-block"
+	TraceStateHash     TraceEventType = "state-hash"
+)
+
+// TraceEvent is a single event of the execution trace. Only the fields of its type are set.
+type TraceEvent struct {
+	Type TraceEventType `json:"type"`
+
+	Method string   `json:"method,omitempty"`
+	Args   []string `json:"args,omitempty"`
+
+	ChainID     uint64       `json:"chainID,omitempty"`
+	BlockHash   *common.Hash `json:"blockHash,omitempty"`
+	OutputRoot  *eth.Bytes32 `json:"outputRoot,omitempty"`
+	DepositOnly bool         `json:"depositOnly,omitempty"`
+
+	Step  uint64       `json:"step,omitempty"`
+	State *common.Hash `json:"state,omitempty"`
+}
+
+type ExecutionTraceHint TraceEvent
+
+var _ preimage.Hint = ExecutionTraceHint{}
+
+func (e ExecutionTraceHint) Hint() string {
+	data, err := json.Marshal(TraceEvent(e))
+	if err != nil {
+		panic(fmt.Errorf("failed to encode trace event: %w", err))
+	}
+	return HintExecutionTrace + " " + hexutil.Encode(data)
+}
+
+// hintTracer sends the events of the execution trace to the host as hints.
+type hintTracer struct {
+	hinter preimage.Hinter
+}
+
+// NewHintTracer creates an ExecutionTracer that sends the trace to the host through the hint channel.
+// The hints do not request any pre-images, so they do not affect the execution.
+func NewHintTracer(hinter preimage.Hinter) ExecutionTracer {
+	return &hintTracer{hinter: hinter}
+}
+
+func (t *hintTracer) OracleRequest(method string, args ...string) {
+	t.hinter.Hint(ExecutionTraceHint{Type: TraceOracleRequest, Method: method, Args: args})
+}
+
+func (t *hintTracer) DerivedBlock(chainID uint64, block types.OptimisticBlock, depositOnly bool) {
+	t.hinter.Hint(ExecutionTraceHint{
+		Type:        TraceDerivedBlock,
+		ChainID:     chainID,
+		BlockHash:   &block.BlockHash,
+		OutputRoot:  &block.OutputRoot,
+		DepositOnly: depositOnly,
+	})
+}
+
+func (t *hintTracer) StateHash(step uint64, state common.Hash) {
+	t.hinter.Hint(ExecutionTraceHint{Type: TraceStateHash, Step: step, State: &state})
+}
@@ -0,0 +1,86 @@
+package interop
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceExecution(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelError)
+	configSource, agreedSuperRoot, tasksStub := setupTwoChains()
+
+	outputRootHash := common.Hash(eth.SuperRoot(agreedSuperRoot))
+	l2PreimageOracle, _ := test.NewStubOracle(t)
+	l2PreimageOracle.TransitionStates[outputRootHash] = &types.TransitionState{SuperRoot: agreedSuperRoot.Marshal()}
+
+	block := types.OptimisticBlock{BlockHash: tasksStub.blockHash, OutputRoot: tasksStub.outputRoot}
+	step1 := (&types.TransitionState{SuperRoot: agreedSuperRoot.Marshal(), PendingProgress: []types.OptimisticBlock{block}, Step: 1}).Hash()
+
+	bootInfo := &boot.BootInfoInterop{
+		AgreedPrestate: outputRootHash,
+		ClaimTimestamp: agreedSuperRoot.Timestamp + 1,
+		Claim:          step1,
+		Configs:        configSource,
+	}
+	hinter := &traceHinter{t: t}
+	claim, err := runInteropProgram(logger, bootInfo, nil, l2PreimageOracle, true, &tasksStub, NewHintTracer(hinter), nil)
+	require.NoError(t, err)
+	require.Equal(t, eth.Bytes32(step1), claim)
+
+	chain1 := agreedSuperRoot.Chains[0].ChainID
+	expected := []TraceEvent{
+		{Type: TraceOracleRequest, Method: "l2.TransitionStateByRoot", Args: []string{outputRootHash.Hex()}},
+		{Type: TraceDerivedBlock, ChainID: chain1, BlockHash: &block.BlockHash, OutputRoot: &block.OutputRoot},
+		{Type: TraceStateHash, Step: 1, State: &step1},
+	}
+	require.Equal(t, expected, hinter.events)
+}
+
+func TestTraceOracleRequests(t *testing.T) {
+	l2PreimageOracle, _ := test.NewStubOracle(t)
+	root := common.Hash{0xaa}
+	l2PreimageOracle.TransitionStates[root] = &types.TransitionState{Step: 1}
+	l2PreimageOracle.Blocks[common.Hash{0x02}] = ethtypes.NewBlockWithHeader(&ethtypes.Header{})
+	hinter := &traceHinter{t: t}
+	oracle := &tracingL2Oracle{tracer: NewHintTracer(hinter), oracle: l2PreimageOracle}
+
+	require.Equal(t, uint64(1), oracle.TransitionStateByRoot(root).Step)
+	oracle.BlockDataByHash(common.Hash{0x01}, common.Hash{0x02}, 42)
+
+	expected := []TraceEvent{
+		{Type: TraceOracleRequest, Method: "l2.TransitionStateByRoot", Args: []string{root.Hex()}},
+		{Type: TraceOracleRequest, Method: "l2.BlockDataByHash", Args: []string{common.Hash{0x01}.Hex(), common.Hash{0x02}.Hex(), strconv.Itoa(42)}},
+	}
+	require.Equal(t, expected, hinter.events)
+}
+
+// traceHinter decodes the execution trace hints of a hintTracer.
+type traceHinter struct {
+	t      *testing.T
+	events []TraceEvent
+}
+
+func (h *traceHinter) Hint(v preimage.Hint) {
+	hintType, payload, found := strings.Cut(v.Hint(), " ")
+	require.True(h.t, found)
+	require.Equal(h.t, HintExecutionTrace, hintType)
+	data, err := hexutil.Decode(payload)
+	require.NoError(h.t, err)
+	var event TraceEvent
+	require.NoError(h.t, json.Unmarshal(data, &event))
+	h.events = append(h.events, event)
+}
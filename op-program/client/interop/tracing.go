@@ -0,0 +1,147 @@
+package interop
+
+import (
+	"strconv"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	interopTypes "github.com/ethereum-optimism/optimism/op-program/client/interop/types"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+	"github.com/ethereum-optimism/optimism/op-program/client/tasks"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// tracingL1Oracle records the requests to an l1.Oracle in the execution trace.
+type tracingL1Oracle struct {
+	tracer ExecutionTracer
+	oracle l1.Oracle
+}
+
+var _ l1.Oracle = (*tracingL1Oracle)(nil)
+
+func (o *tracingL1Oracle) HeaderByBlockHash(blockHash common.Hash) eth.BlockInfo {
+	o.tracer.OracleRequest("l1.HeaderByBlockHash", blockHash.Hex())
+	return o.oracle.HeaderByBlockHash(blockHash)
+}
+
+func (o *tracingL1Oracle) TransactionsByBlockHash(blockHash common.Hash) (eth.BlockInfo, types.Transactions) {
+	o.tracer.OracleRequest("l1.TransactionsByBlockHash", blockHash.Hex())
+	return o.oracle.TransactionsByBlockHash(blockHash)
+}
+
+func (o *tracingL1Oracle) ReceiptsByBlockHash(blockHash common.Hash) (eth.BlockInfo, types.Receipts) {
+	o.tracer.OracleRequest("l1.ReceiptsByBlockHash", blockHash.Hex())
+	return o.oracle.ReceiptsByBlockHash(blockHash)
+}
+
+func (o *tracingL1Oracle) GetBlob(ref eth.L1BlockRef, blobHash eth.IndexedBlobHash) *eth.Blob {
+	o.tracer.OracleRequest("l1.GetBlob", ref.Hash.Hex(), strconv.FormatUint(blobHash.Index, 10), blobHash.Hash.Hex())
+	return o.oracle.GetBlob(ref, blobHash)
+}
+
+func (o *tracingL1Oracle) Precompile(precompileAddress common.Address, input []byte, requiredGas uint64) ([]byte, bool) {
+	o.tracer.OracleRequest("l1.Precompile", precompileAddress.Hex(), hexutil.Encode(input), strconv.FormatUint(requiredGas, 10))
+	return o.oracle.Precompile(precompileAddress, input, requiredGas)
+}
+
+func (o *tracingL1Oracle) HintBlockRange(head common.Hash, count uint64, receiptsCount uint64) {
+	o.tracer.OracleRequest("l1.HintBlockRange", head.Hex(), strconv.FormatUint(count, 10), strconv.FormatUint(receiptsCount, 10))
+	o.oracle.HintBlockRange(head, count, receiptsCount)
+}
+
+// tracingL2Oracle records the requests to an l2.Oracle in the execution trace.
+type tracingL2Oracle struct {
+	tracer ExecutionTracer
+	oracle l2.Oracle
+}
+
+var _ l2.Oracle = (*tracingL2Oracle)(nil)
+
+func (o *tracingL2Oracle) NodeByHash(nodeHash common.Hash, chainID uint64) []byte {
+	o.tracer.OracleRequest("l2.NodeByHash", nodeHash.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.NodeByHash(nodeHash, chainID)
+}
+
+func (o *tracingL2Oracle) CodeByHash(codeHash common.Hash, chainID uint64) []byte {
+	o.tracer.OracleRequest("l2.CodeByHash", codeHash.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.CodeByHash(codeHash, chainID)
+}
+
+func (o *tracingL2Oracle) BlockByHash(blockHash common.Hash, chainID uint64) *types.Block {
+	o.tracer.OracleRequest("l2.BlockByHash", blockHash.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.BlockByHash(blockHash, chainID)
+}
+
+func (o *tracingL2Oracle) ReceiptsByBlockHash(blockHash common.Hash, chainID uint64) (*types.Block, types.Receipts) {
+	o.tracer.OracleRequest("l2.ReceiptsByBlockHash", blockHash.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.ReceiptsByBlockHash(blockHash, chainID)
+}
+
+func (o *tracingL2Oracle) OutputByRoot(root common.Hash, chainID uint64) eth.Output {
+	o.tracer.OracleRequest("l2.OutputByRoot", root.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.OutputByRoot(root, chainID)
+}
+
+func (o *tracingL2Oracle) BlockDataByHash(agreedBlockHash, blockHash common.Hash, chainID uint64) *types.Block {
+	o.tracer.OracleRequest("l2.BlockDataByHash", agreedBlockHash.Hex(), blockHash.Hex(), strconv.FormatUint(chainID, 10))
+	return o.oracle.BlockDataByHash(agreedBlockHash, blockHash, chainID)
+}
+
+func (o *tracingL2Oracle) TransitionStateByRoot(root common.Hash) *interopTypes.TransitionState {
+	o.tracer.OracleRequest("l2.TransitionStateByRoot", root.Hex())
+	return o.oracle.TransitionStateByRoot(root)
+}
+
+func (o *tracingL2Oracle) HintBlockRange(head common.Hash, count uint64, chainID uint64) {
+	o.tracer.OracleRequest("l2.HintBlockRange", head.Hex(), strconv.FormatUint(count, 10), strconv.FormatUint(chainID, 10))
+	o.oracle.HintBlockRange(head, count, chainID)
+}
+
+// tracingTaskExecutor records the blocks of a taskExecutor in the execution trace.
+type tracingTaskExecutor struct {
+	tracer ExecutionTracer
+	tasks  taskExecutor
+}
+
+var _ taskExecutor = (*tracingTaskExecutor)(nil)
+
+func (t *tracingTaskExecutor) RunDerivation(
+	logger log.Logger,
+	rollupCfg *rollup.Config,
+	l2ChainConfig *params.ChainConfig,
+	l1Head common.Hash,
+	agreedOutputRoot eth.Bytes32,
+	claimedBlockNumber uint64,
+	precompileFlags engineapi.PrecompileFlags,
+	l1Oracle l1.Oracle,
+	l2Oracle l2.Oracle) (tasks.DerivationResult, error) {
+	result, err := t.tasks.RunDerivation(logger, rollupCfg, l2ChainConfig, l1Head, agreedOutputRoot, claimedBlockNumber, precompileFlags, l1Oracle, l2Oracle)
+	if err == nil {
+		block := interopTypes.OptimisticBlock{BlockHash: result.BlockHash, OutputRoot: result.OutputRoot}
+		t.tracer.DerivedBlock(rollupCfg.L2ChainID.Uint64(), block, false)
+	}
+	return result, err
+}
+
+func (t *tracingTaskExecutor) BuildDepositOnlyBlock(
+	logger log.Logger,
+	rollupCfg *rollup.Config,
+	l2ChainConfig *params.ChainConfig,
+	optimisticBlockHash common.Hash,
+	agreedOutputRoot eth.Bytes32,
+	precompileFlags engineapi.PrecompileFlags,
+	l1Oracle l1.Oracle,
+	l2Oracle l2.Oracle) (common.Hash, eth.Bytes32, error) {
+	blockHash, outputRoot, err := t.tasks.BuildDepositOnlyBlock(logger, rollupCfg, l2ChainConfig, optimisticBlockHash, agreedOutputRoot, precompileFlags, l1Oracle, l2Oracle)
+	if err == nil {
+		block := interopTypes.OptimisticBlock{BlockHash: blockHash, OutputRoot: outputRoot}
+		t.tracer.DerivedBlock(rollupCfg.L2ChainID.Uint64(), block, true)
+	}
+	return blockHash, outputRoot, err
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -11,6 +12,20 @@ import (
 
 var (
 	IntermediateTransitionVersion = byte(255)
+	// IntermediateTransitionVersionV2 is a transition state that also commits to the cross-chain message dependencies
+	// of the pending blocks.
+	IntermediateTransitionVersionV2 = byte(254)
+)
+
+var ErrUnknownTransitionVersion = errors.New("unknown transition state version")
+
+const (
+	// StepsPerTimestamp is the number of steps of the transition from the super root of a timestamp
+	// to the super root of the next timestamp.
+	StepsPerTimestamp = 1024
+	// ConsolidateStep is the step that consolidates the pending blocks into the super root of the next timestamp.
+	// The steps after the chains are derived and before the consolidation step are padding, which only increment the step.
+	ConsolidateStep = StepsPerTimestamp - 1
 )
 
 type OptimisticBlock struct {
@@ -18,26 +33,46 @@ type OptimisticBlock struct {
 	OutputRoot eth.Bytes32
 }
 
+// MessageDependencies commits to the executing messages of the pending block of a chain,
+// so that they can be validated against their initiating chains without re-deriving those chains.
+type MessageDependencies struct {
+	ChainID uint64
+	// Commitment is the hash of the identifiers of all messages executed by the pending block.
+	Commitment common.Hash
+}
+
 type TransitionState struct {
 	SuperRoot       []byte
 	PendingProgress []OptimisticBlock
 	Step            uint64
+
+	// Dependencies are the message dependencies of the pending blocks. Only encoded from IntermediateTransitionVersionV2.
+	Dependencies []MessageDependencies
+	// StateVersion is the version the state is encoded with. Zero is IntermediateTransitionVersion.
+	StateVersion byte
 }
 
 func (t *TransitionState) String() string {
-	return fmt.Sprintf("{SuperRoot: %x, PendingProgress: %v, Step: %d}", t.SuperRoot, t.PendingProgress, t.Step)
+	if t.Version() == IntermediateTransitionVersion {
+		return fmt.Sprintf("{SuperRoot: %x, PendingProgress: %v, Step: %d}", t.SuperRoot, t.PendingProgress, t.Step)
+	}
+	return fmt.Sprintf("{Version: %d, SuperRoot: %x, PendingProgress: %v, Step: %d, Dependencies: %v}",
+		t.Version(), t.SuperRoot, t.PendingProgress, t.Step, t.Dependencies)
 }
 
 func (i *TransitionState) Version() byte {
-	return IntermediateTransitionVersion
+	if i.StateVersion == 0 {
+		return IntermediateTransitionVersion
+	}
+	return i.StateVersion
 }
 
 func (i *TransitionState) Marshal() []byte {
-	rlpData, err := rlp.EncodeToBytes(i)
+	data, err := EncodeTransitionState(i)
 	if err != nil {
 		panic(err)
 	}
-	return append([]byte{IntermediateTransitionVersion}, rlpData...)
+	return data
 }
 
 func (i *TransitionState) Hash() common.Hash {
@@ -45,27 +80,133 @@ func (i *TransitionState) Hash() common.Hash {
 	return crypto.Keccak256Hash(data)
 }
 
+// TransitionStateCodec encodes and decodes the transition states of a single version.
+// The version byte that prefixes the encoding is handled by the registry and not part of the data of the codec.
+type TransitionStateCodec interface {
+	Encode(state *TransitionState) ([]byte, error)
+	Decode(data []byte) (*TransitionState, error)
+}
+
+var transitionStateCodecs = map[byte]TransitionStateCodec{
+	IntermediateTransitionVersion:   transitionStateV1Codec{},
+	IntermediateTransitionVersionV2: transitionStateV2Codec{},
+}
+
+// RegisterTransitionStateCodec adds the codec of a new transition state version.
+// Panics if the version is already in use, either by a transition state or by a super root.
+func RegisterTransitionStateCodec(version byte, codec TransitionStateCodec) {
+	if _, ok := transitionStateCodecs[version]; ok || version == eth.SuperRootVersionV1 {
+		panic(fmt.Errorf("transition state version %d already registered", version))
+	}
+	transitionStateCodecs[version] = codec
+}
+
+// IsTransitionVersion returns true if a codec is registered for the transition state version.
+func IsTransitionVersion(version byte) bool {
+	_, ok := transitionStateCodecs[version]
+	return ok
+}
+
+// EncodeTransitionState encodes the state with the codec of its version, prefixed by the version byte.
+func EncodeTransitionState(state *TransitionState) ([]byte, error) {
+	version := state.Version()
+	codec, ok := transitionStateCodecs[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownTransitionVersion, version)
+	}
+	data, err := codec.Encode(state)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{version}, data...), nil
+}
+
+// DecodeTransitionState decodes a transition state with the codec of its version byte.
+// Unlike UnmarshalTransitionState, super roots are not accepted.
+func DecodeTransitionState(data []byte) (*TransitionState, error) {
+	if len(data) == 0 {
+		return nil, eth.ErrInvalidSuperRoot
+	}
+	codec, ok := transitionStateCodecs[data[0]]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownTransitionVersion, data[0])
+	}
+	return codec.Decode(data[1:])
+}
+
 func UnmarshalTransitionState(data []byte) (*TransitionState, error) {
 	if len(data) == 0 {
 		return nil, eth.ErrInvalidSuperRoot
 	}
-	switch data[0] {
-	case IntermediateTransitionVersion:
-		return unmarshalTransitionSate(data)
-	case eth.SuperRootVersionV1:
+	switch {
+	case IsTransitionVersion(data[0]):
+		return DecodeTransitionState(data)
+	case data[0] == eth.SuperRootVersionV1:
 		return &TransitionState{SuperRoot: data}, nil
 	default:
 		return nil, eth.ErrInvalidSuperRootVersion
 	}
 }
 
-func unmarshalTransitionSate(data []byte) (*TransitionState, error) {
-	if len(data) == 0 {
-		return nil, eth.ErrInvalidSuperRoot
+type transitionStateV1 struct {
+	SuperRoot       []byte
+	PendingProgress []OptimisticBlock
+	Step            uint64
+}
+
+type transitionStateV1Codec struct{}
+
+func (transitionStateV1Codec) Encode(state *TransitionState) ([]byte, error) {
+	if len(state.Dependencies) != 0 {
+		return nil, fmt.Errorf("transition state version %d cannot encode message dependencies", IntermediateTransitionVersion)
 	}
-	var state TransitionState
-	if err := rlp.DecodeBytes(data[1:], &state); err != nil {
+	return rlp.EncodeToBytes(&transitionStateV1{
+		SuperRoot:       state.SuperRoot,
+		PendingProgress: state.PendingProgress,
+		Step:            state.Step,
+	})
+}
+
+func (transitionStateV1Codec) Decode(data []byte) (*TransitionState, error) {
+	var state transitionStateV1
+	if err := rlp.DecodeBytes(data, &state); err != nil {
+		return nil, err
+	}
+	return &TransitionState{
+		SuperRoot:       state.SuperRoot,
+		PendingProgress: state.PendingProgress,
+		Step:            state.Step,
+	}, nil
+}
+
+type transitionStateV2 struct {
+	SuperRoot       []byte
+	PendingProgress []OptimisticBlock
+	Step            uint64
+	Dependencies    []MessageDependencies
+}
+
+type transitionStateV2Codec struct{}
+
+func (transitionStateV2Codec) Encode(state *TransitionState) ([]byte, error) {
+	return rlp.EncodeToBytes(&transitionStateV2{
+		SuperRoot:       state.SuperRoot,
+		PendingProgress: state.PendingProgress,
+		Step:            state.Step,
+		Dependencies:    state.Dependencies,
+	})
+}
+
+func (transitionStateV2Codec) Decode(data []byte) (*TransitionState, error) {
+	var state transitionStateV2
+	if err := rlp.DecodeBytes(data, &state); err != nil {
 		return nil, err
 	}
-	return &state, nil
+	return &TransitionState{
+		SuperRoot:       state.SuperRoot,
+		PendingProgress: state.PendingProgress,
+		Step:            state.Step,
+		Dependencies:    state.Dependencies,
+		StateVersion:    IntermediateTransitionVersionV2,
+	}, nil
 }
@@ -31,6 +31,52 @@ func TestTransitionStateCodec(t *testing.T) {
 		require.Equal(t, state, actual)
 	})
 
+	t.Run("TransitionStateV2", func(t *testing.T) {
+		superRoot := &eth.SuperV1{
+			Timestamp: 9842494,
+			Chains: []eth.ChainIDAndOutput{
+				{ChainID: 34, Output: eth.Bytes32{0x01}},
+				{ChainID: 35, Output: eth.Bytes32{0x02}},
+			},
+		}
+		state := &TransitionState{
+			SuperRoot: superRoot.Marshal(),
+			PendingProgress: []OptimisticBlock{
+				{BlockHash: common.Hash{0x05}, OutputRoot: eth.Bytes32{0x03}},
+			},
+			Step: 1,
+			Dependencies: []MessageDependencies{
+				{ChainID: 34, Commitment: common.Hash{0x07}},
+			},
+			StateVersion: IntermediateTransitionVersionV2,
+		}
+		data := state.Marshal()
+		require.Equal(t, IntermediateTransitionVersionV2, data[0])
+		actual, err := UnmarshalTransitionState(data)
+		require.NoError(t, err)
+		require.Equal(t, state, actual)
+		require.NotEqual(t, state.Hash(), (&TransitionState{SuperRoot: state.SuperRoot, PendingProgress: state.PendingProgress, Step: 1}).Hash())
+	})
+
+	t.Run("V1RejectsDependencies", func(t *testing.T) {
+		state := &TransitionState{
+			Dependencies: []MessageDependencies{{ChainID: 34}},
+		}
+		_, err := EncodeTransitionState(state)
+		require.Error(t, err)
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		_, err := EncodeTransitionState(&TransitionState{StateVersion: 42})
+		require.ErrorIs(t, err, ErrUnknownTransitionVersion)
+		_, err = DecodeTransitionState([]byte{42, 0xc0})
+		require.ErrorIs(t, err, ErrUnknownTransitionVersion)
+		_, err = DecodeTransitionState((&eth.SuperV1{Timestamp: 1}).Marshal())
+		require.ErrorIs(t, err, ErrUnknownTransitionVersion)
+		_, err = UnmarshalTransitionState([]byte{42, 0xc0})
+		require.ErrorIs(t, err, eth.ErrInvalidSuperRootVersion)
+	})
+
 	t.Run("SuperRoot", func(t *testing.T) {
 		superRoot := &eth.SuperV1{
 			Timestamp: 9842494,
@@ -48,3 +94,26 @@ func TestTransitionStateCodec(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 }
+
+func TestRegisterTransitionStateCodec(t *testing.T) {
+	require.Panics(t, func() {
+		RegisterTransitionStateCodec(IntermediateTransitionVersion, transitionStateV1Codec{})
+	})
+	require.Panics(t, func() {
+		RegisterTransitionStateCodec(eth.SuperRootVersionV1, transitionStateV1Codec{})
+	})
+
+	version := byte(200)
+	RegisterTransitionStateCodec(version, transitionStateV1Codec{})
+	t.Cleanup(func() {
+		delete(transitionStateCodecs, version)
+	})
+	require.True(t, IsTransitionVersion(version))
+	state := &TransitionState{SuperRoot: []byte{1}, Step: 3, StateVersion: version}
+	data, err := EncodeTransitionState(state)
+	require.NoError(t, err)
+	require.Equal(t, version, data[0])
+	actual, err := DecodeTransitionState(data)
+	require.NoError(t, err)
+	require.Equal(t, state.Step, actual.Step)
+}
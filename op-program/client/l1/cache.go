@@ -15,6 +15,13 @@ import (
 // Cache size is quite high as retrieving data from the pre-image oracle can be quite expensive
 const cacheSize = 2000
 
+// Approximate sizes in bytes of decoded data, used to bound the memory of the caches.
+const (
+	headerSize  = 700
+	receiptSize = 512
+	logSize     = 160
+)
+
 // CachingOracle is an implementation of Oracle that delegates to another implementation, adding caching of all results
 type CachingOracle struct {
 	oracle Oracle
@@ -23,6 +30,12 @@ type CachingOracle struct {
 	rcpts  *simplelru.LRU[common.Hash, types.Receipts]
 	blobs  *simplelru.LRU[common.Hash, *eth.Blob]
 	pcmps  *simplelru.LRU[common.Hash, precompileResult]
+
+	maxSize int
+	size    int
+	// evict removes the least recently used entry of one of the caches, largest data first.
+	// It returns false if all caches are empty.
+	evict func() bool
 }
 
 type precompileResult struct {
@@ -31,19 +44,73 @@ type precompileResult struct {
 }
 
 func NewCachingOracle(oracle Oracle) *CachingOracle {
-	blockLRU, _ := simplelru.NewLRU[common.Hash, eth.BlockInfo](cacheSize, nil)
-	txsLRU, _ := simplelru.NewLRU[common.Hash, types.Transactions](cacheSize, nil)
-	rcptsLRU, _ := simplelru.NewLRU[common.Hash, types.Receipts](cacheSize, nil)
-	blobsLRU, _ := simplelru.NewLRU[common.Hash, *eth.Blob](cacheSize, nil)
-	pcmps, _ := simplelru.NewLRU[common.Hash, precompileResult](cacheSize, nil)
-	return &CachingOracle{
-		oracle: oracle,
-		blocks: blockLRU,
-		txs:    txsLRU,
-		rcpts:  rcptsLRU,
-		blobs:  blobsLRU,
-		pcmps:  pcmps,
+	return NewSizedCachingOracle(oracle, 0)
+}
+
+// NewSizedCachingOracle creates a CachingOracle that additionally bounds the approximate total size of the cached
+// results to maxSize bytes, to limit the memory used in the MIPS VM. The size is not bounded if maxSize is 0.
+func NewSizedCachingOracle(oracle Oracle, maxSize int) *CachingOracle {
+	o := &CachingOracle{oracle: oracle, maxSize: maxSize}
+	o.blocks, _ = simplelru.NewLRU[common.Hash, eth.BlockInfo](cacheSize, func(_ common.Hash, _ eth.BlockInfo) {
+		o.size -= headerSize
+	})
+	o.txs, _ = simplelru.NewLRU[common.Hash, types.Transactions](cacheSize, func(_ common.Hash, txs types.Transactions) {
+		o.size -= transactionsSize(txs)
+	})
+	o.rcpts, _ = simplelru.NewLRU[common.Hash, types.Receipts](cacheSize, func(_ common.Hash, rcpts types.Receipts) {
+		o.size -= receiptsSize(rcpts)
+	})
+	o.blobs, _ = simplelru.NewLRU[common.Hash, *eth.Blob](cacheSize, func(_ common.Hash, _ *eth.Blob) {
+		o.size -= eth.BlobSize
+	})
+	o.pcmps, _ = simplelru.NewLRU[common.Hash, precompileResult](cacheSize, func(_ common.Hash, res precompileResult) {
+		o.size -= len(res.result)
+	})
+	o.evict = func() bool {
+		return removeOldest(o.blobs) || removeOldest(o.rcpts) || removeOldest(o.txs) ||
+			removeOldest(o.blocks) || removeOldest(o.pcmps)
 	}
+	return o
+}
+
+func removeOldest[V any](cache *simplelru.LRU[common.Hash, V]) bool {
+	_, _, ok := cache.RemoveOldest()
+	return ok
+}
+
+// add adds the value of the given approximate size to the cache, evicting entries while the size bound is exceeded.
+func add[V any](o *CachingOracle, cache *simplelru.LRU[common.Hash, V], key common.Hash, value V, size int) {
+	if o.maxSize > 0 && size > o.maxSize {
+		return
+	}
+	// Replace, rather than update, existing entries so that their size is released by the eviction callback.
+	cache.Remove(key)
+	cache.Add(key, value)
+	o.size += size
+	for o.maxSize > 0 && o.size > o.maxSize {
+		if !o.evict() {
+			break
+		}
+	}
+}
+
+func transactionsSize(txs types.Transactions) int {
+	size := 0
+	for _, tx := range txs {
+		size += int(tx.Size())
+	}
+	return size
+}
+
+func receiptsSize(rcpts types.Receipts) int {
+	size := 0
+	for _, rcpt := range rcpts {
+		size += receiptSize
+		for _, l := range rcpt.Logs {
+			size += logSize + len(l.Data) + len(l.Topics)*common.HashLength
+		}
+	}
+	return size
 }
 
 func (o *CachingOracle) HeaderByBlockHash(blockHash common.Hash) eth.BlockInfo {
@@ -52,7 +119,7 @@ func (o *CachingOracle) HeaderByBlockHash(blockHash common.Hash) eth.BlockInfo {
 		return block
 	}
 	block = o.oracle.HeaderByBlockHash(blockHash)
-	o.blocks.Add(blockHash, block)
+	add(o, o.blocks, blockHash, block, headerSize)
 	return block
 }
 
@@ -62,8 +129,8 @@ func (o *CachingOracle) TransactionsByBlockHash(blockHash common.Hash) (eth.Bloc
 		return o.HeaderByBlockHash(blockHash), txs
 	}
 	block, txs := o.oracle.TransactionsByBlockHash(blockHash)
-	o.blocks.Add(blockHash, block)
-	o.txs.Add(blockHash, txs)
+	add(o, o.blocks, blockHash, block, headerSize)
+	add(o, o.txs, blockHash, txs, transactionsSize(txs))
 	return block, txs
 }
 
@@ -73,8 +140,8 @@ func (o *CachingOracle) ReceiptsByBlockHash(blockHash common.Hash) (eth.BlockInf
 		return o.HeaderByBlockHash(blockHash), rcpts
 	}
 	block, rcpts := o.oracle.ReceiptsByBlockHash(blockHash)
-	o.blocks.Add(blockHash, block)
-	o.rcpts.Add(blockHash, rcpts)
+	add(o, o.blocks, blockHash, block, headerSize)
+	add(o, o.rcpts, blockHash, rcpts, receiptsSize(rcpts))
 	return block, rcpts
 }
 
@@ -91,7 +158,7 @@ func (o *CachingOracle) GetBlob(ref eth.L1BlockRef, blobHash eth.IndexedBlobHash
 		return blob
 	}
 	blob = o.oracle.GetBlob(ref, blobHash)
-	o.blobs.Add(cacheKey, blob)
+	add(o, o.blobs, cacheKey, blob, eth.BlobSize)
 	return blob
 }
 
@@ -101,6 +168,11 @@ func (o *CachingOracle) Precompile(address common.Address, input []byte, require
 		return val.result, val.ok
 	}
 	res, ok := o.oracle.Precompile(address, input, requiredGas)
-	o.pcmps.Add(cacheKey, precompileResult{res, ok})
+	add(o, o.pcmps, cacheKey, precompileResult{res, ok}, len(res))
 	return res, ok
 }
+
+func (o *CachingOracle) HintBlockRange(head common.Hash, count uint64, receiptsCount uint64) {
+	// Nothing to cache, the hint only affects the host
+	o.oracle.HintBlockRange(head, count, receiptsCount)
+}
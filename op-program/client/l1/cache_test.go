@@ -118,3 +118,27 @@ func TestCachingOracle_Precompile(t *testing.T) {
 	require.True(t, actualStatus)
 	require.EqualValues(t, output, actualResult)
 }
+
+func TestCachingOracle_MaxSize(t *testing.T) {
+	stub := test.NewStubOracle(t)
+	oracle := NewSizedCachingOracle(stub, 2*eth.BlobSize)
+
+	l1BlockRef := eth.L1BlockRef{Time: 0}
+	stub.Blobs[l1BlockRef] = make(map[eth.IndexedBlobHash]*eth.Blob)
+	var hashes []eth.IndexedBlobHash
+	for i := uint64(0); i < 3; i++ {
+		hash := eth.IndexedBlobHash{Hash: [32]byte{byte(i)}, Index: i}
+		stub.Blobs[l1BlockRef][hash] = &eth.Blob{byte(i)}
+		oracle.GetBlob(l1BlockRef, hash)
+		hashes = append(hashes, hash)
+	}
+	require.Equal(t, 2*eth.BlobSize, oracle.size)
+
+	// The least recently used blob was evicted, so it is retrieved from the stub again
+	delete(stub.Blobs[l1BlockRef], hashes[1])
+	delete(stub.Blobs[l1BlockRef], hashes[2])
+	require.Equal(t, &eth.Blob{0}, oracle.GetBlob(l1BlockRef, hashes[0]))
+	delete(stub.Blobs[l1BlockRef], hashes[0])
+	require.Equal(t, &eth.Blob{2}, oracle.GetBlob(l1BlockRef, hashes[2]))
+	require.Equal(t, 2*eth.BlobSize, oracle.size)
+}
@@ -1,6 +1,8 @@
 package l1
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
@@ -14,6 +16,7 @@ const (
 	HintL1Blob         = "l1-blob"
 	HintL1Precompile   = "l1-precompile"
 	HintL1PrecompileV2 = "l1-precompile-v2"
+	HintL1BlockRange   = "l1-block-range"
 )
 
 type BlockHeaderHint common.Hash
@@ -63,3 +66,21 @@ var _ preimage.Hint = PrecompileHintV2{}
 func (l PrecompileHintV2) Hint() string {
 	return HintL1PrecompileV2 + " " + hexutil.Encode(l)
 }
+
+// BlockRangeHint requests the headers and transactions of Count blocks, from the block with hash Head back through
+// its ancestors, and the receipts of the ReceiptsCount most recent of them.
+type BlockRangeHint struct {
+	Head          common.Hash
+	Count         uint64
+	ReceiptsCount uint64
+}
+
+var _ preimage.Hint = BlockRangeHint{}
+
+func (l BlockRangeHint) Hint() string {
+	hintBytes := make([]byte, 32+8+8)
+	copy(hintBytes[:32], l.Head[:])
+	binary.BigEndian.PutUint64(hintBytes[32:40], l.Count)
+	binary.BigEndian.PutUint64(hintBytes[40:48], l.ReceiptsCount)
+	return HintL1BlockRange + " " + hexutil.Encode(hintBytes)
+}
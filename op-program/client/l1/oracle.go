@@ -30,6 +30,11 @@ type Oracle interface {
 
 	// Precompile retrieves the result and success indicator of a precompile call for the given input.
 	Precompile(precompileAddress common.Address, input []byte, requiredGas uint64) ([]byte, bool)
+
+	// HintBlockRange hints that the headers and transactions of count blocks, from the block with the given hash
+	// back through its ancestors, and the receipts of the receiptsCount most recent of them, are about to be retrieved.
+	// It lets the host prefetch the whole range at once instead of one block at a time.
+	HintBlockRange(head common.Hash, count uint64, receiptsCount uint64)
 }
 
 // PreimageOracle implements Oracle using by interfacing with the pure preimage.Oracle
@@ -130,3 +135,7 @@ func (p *PreimageOracle) Precompile(address common.Address, input []byte, requir
 	}
 	return result[1:], result[0] == 1
 }
+
+func (p *PreimageOracle) HintBlockRange(head common.Hash, count uint64, receiptsCount uint64) {
+	p.hint.Hint(BlockRangeHint{Head: head, Count: count, ReceiptsCount: receiptsCount})
+}
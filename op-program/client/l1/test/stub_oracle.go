@@ -85,3 +85,5 @@ func (o StubOracle) Precompile(addr common.Address, input []byte, requiredGas ui
 	}
 	return result, true
 }
+
+func (o StubOracle) HintBlockRange(head common.Hash, count uint64, receiptsCount uint64) {}
@@ -13,6 +13,15 @@ import (
 const blockCacheSize = 3_000
 const nodeCacheSize = 100_000
 const codeCacheSize = 10_000
+const receiptsCacheSize = 1_000
+
+// Approximate sizes in bytes of decoded data, used to bound the memory of the caches.
+const (
+	headerSize  = 700
+	receiptSize = 512
+	logSize     = 160
+	outputSize  = 128
+)
 
 type CachingOracle struct {
 	oracle  Oracle
@@ -20,20 +29,86 @@ type CachingOracle struct {
 	nodes   *simplelru.LRU[common.Hash, []byte]
 	codes   *simplelru.LRU[common.Hash, []byte]
 	outputs *simplelru.LRU[common.Hash, eth.Output]
+	rcpts   *simplelru.LRU[common.Hash, types.Receipts]
+
+	maxSize int
+	size    int
+	// evict removes the least recently used entry of one of the caches, largest data first.
+	// It returns false if all caches are empty.
+	evict func() bool
 }
 
 func NewCachingOracle(oracle Oracle) *CachingOracle {
-	blockLRU, _ := simplelru.NewLRU[common.Hash, *types.Block](blockCacheSize, nil)
-	nodeLRU, _ := simplelru.NewLRU[common.Hash, []byte](nodeCacheSize, nil)
-	codeLRU, _ := simplelru.NewLRU[common.Hash, []byte](codeCacheSize, nil)
-	outputLRU, _ := simplelru.NewLRU[common.Hash, eth.Output](codeCacheSize, nil)
-	return &CachingOracle{
-		oracle:  oracle,
-		blocks:  blockLRU,
-		nodes:   nodeLRU,
-		codes:   codeLRU,
-		outputs: outputLRU,
+	return NewSizedCachingOracle(oracle, 0)
+}
+
+// NewSizedCachingOracle creates a CachingOracle that additionally bounds the approximate total size of the cached
+// results to maxSize bytes, to limit the memory used in the MIPS VM. The size is not bounded if maxSize is 0.
+func NewSizedCachingOracle(oracle Oracle, maxSize int) *CachingOracle {
+	o := &CachingOracle{oracle: oracle, maxSize: maxSize}
+	o.blocks, _ = simplelru.NewLRU[common.Hash, *types.Block](blockCacheSize, func(_ common.Hash, block *types.Block) {
+		o.size -= blockSize(block)
+	})
+	o.nodes, _ = simplelru.NewLRU[common.Hash, []byte](nodeCacheSize, func(_ common.Hash, node []byte) {
+		o.size -= len(node)
+	})
+	o.codes, _ = simplelru.NewLRU[common.Hash, []byte](codeCacheSize, func(_ common.Hash, code []byte) {
+		o.size -= len(code)
+	})
+	o.outputs, _ = simplelru.NewLRU[common.Hash, eth.Output](codeCacheSize, func(_ common.Hash, _ eth.Output) {
+		o.size -= outputSize
+	})
+	o.rcpts, _ = simplelru.NewLRU[common.Hash, types.Receipts](receiptsCacheSize, func(_ common.Hash, rcpts types.Receipts) {
+		o.size -= receiptsSize(rcpts)
+	})
+	o.evict = func() bool {
+		return removeOldest(o.rcpts) || removeOldest(o.blocks) || removeOldest(o.codes) ||
+			removeOldest(o.nodes) || removeOldest(o.outputs)
+	}
+	return o
+}
+
+func removeOldest[V any](cache *simplelru.LRU[common.Hash, V]) bool {
+	_, _, ok := cache.RemoveOldest()
+	return ok
+}
+
+// add adds the value of the given approximate size to the cache, evicting entries while the size bound is exceeded.
+func add[V any](o *CachingOracle, cache *simplelru.LRU[common.Hash, V], key common.Hash, value V, size int) {
+	if o.maxSize > 0 && size > o.maxSize {
+		return
+	}
+	// Replace, rather than update, existing entries so that their size is released by the eviction callback.
+	cache.Remove(key)
+	cache.Add(key, value)
+	o.size += size
+	for o.maxSize > 0 && o.size > o.maxSize {
+		if !o.evict() {
+			break
+		}
+	}
+}
+
+func blockSize(block *types.Block) int {
+	if block == nil {
+		return 0
+	}
+	size := headerSize
+	for _, tx := range block.Transactions() {
+		size += int(tx.Size())
+	}
+	return size
+}
+
+func receiptsSize(rcpts types.Receipts) int {
+	size := 0
+	for _, rcpt := range rcpts {
+		size += receiptSize
+		for _, l := range rcpt.Logs {
+			size += logSize + len(l.Data) + len(l.Topics)*common.HashLength
+		}
 	}
+	return size
 }
 
 func (o *CachingOracle) NodeByHash(nodeHash common.Hash, chainID uint64) []byte {
@@ -42,7 +117,7 @@ func (o *CachingOracle) NodeByHash(nodeHash common.Hash, chainID uint64) []byte
 		return node
 	}
 	node = o.oracle.NodeByHash(nodeHash, chainID)
-	o.nodes.Add(nodeHash, node)
+	add(o, o.nodes, nodeHash, node, len(node))
 	return node
 }
 
@@ -52,7 +127,7 @@ func (o *CachingOracle) CodeByHash(codeHash common.Hash, chainID uint64) []byte
 		return code
 	}
 	code = o.oracle.CodeByHash(codeHash, chainID)
-	o.codes.Add(codeHash, code)
+	add(o, o.codes, codeHash, code, len(code))
 	return code
 }
 
@@ -62,24 +137,35 @@ func (o *CachingOracle) BlockByHash(blockHash common.Hash, chainID uint64) *type
 		return block
 	}
 	block = o.oracle.BlockByHash(blockHash, chainID)
-	o.blocks.Add(blockHash, block)
+	add(o, o.blocks, blockHash, block, blockSize(block))
 	return block
 }
 
+func (o *CachingOracle) ReceiptsByBlockHash(blockHash common.Hash, chainID uint64) (*types.Block, types.Receipts) {
+	rcpts, ok := o.rcpts.Get(blockHash)
+	if ok {
+		return o.BlockByHash(blockHash, chainID), rcpts
+	}
+	block, rcpts := o.oracle.ReceiptsByBlockHash(blockHash, chainID)
+	add(o, o.blocks, blockHash, block, blockSize(block))
+	add(o, o.rcpts, blockHash, rcpts, receiptsSize(rcpts))
+	return block, rcpts
+}
+
 func (o *CachingOracle) OutputByRoot(root common.Hash, chainID uint64) eth.Output {
 	output, ok := o.outputs.Get(root)
 	if ok {
 		return output
 	}
 	output = o.oracle.OutputByRoot(root, chainID)
-	o.outputs.Add(root, output)
+	add(o, o.outputs, root, output, outputSize)
 	return output
 }
 
 func (o *CachingOracle) BlockDataByHash(agreedBlockHash, blockHash common.Hash, chainID uint64) *types.Block {
 	// Always request from the oracle even on cache hit. as we want the effects of the host oracle hinting
 	block := o.oracle.BlockDataByHash(agreedBlockHash, blockHash, chainID)
-	o.blocks.Add(blockHash, block)
+	add(o, o.blocks, blockHash, block, blockSize(block))
 	return block
 }
 
@@ -87,3 +173,8 @@ func (o *CachingOracle) TransitionStateByRoot(root common.Hash) *interopTypes.Tr
 	// Don't bother caching as this is only requested once as part of the bootstrap process
 	return o.oracle.TransitionStateByRoot(root)
 }
+
+func (o *CachingOracle) HintBlockRange(head common.Hash, count uint64, chainID uint64) {
+	// Nothing to cache, the hint only affects the host
+	o.oracle.HintBlockRange(head, count, chainID)
+}
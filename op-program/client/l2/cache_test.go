@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,3 +88,53 @@ func TestOutputByRoot(t *testing.T) {
 	actual = oracle.OutputByRoot(root, 9193)
 	require.Equal(t, output, actual)
 }
+
+func TestReceiptsByBlockHash(t *testing.T) {
+	stub, _ := test.NewStubOracle(t)
+	oracle := NewCachingOracle(stub)
+
+	rng := rand.New(rand.NewSource(1))
+	block, receipts := testutils.RandomBlock(rng, 3)
+	rcpts := types.Receipts(receipts)
+
+	// Initial call retrieves from the stub
+	stub.Blocks[block.Hash()] = block
+	stub.Receipts[block.Hash()] = rcpts
+	actualBlock, actualRcpts := oracle.ReceiptsByBlockHash(block.Hash(), 48294)
+	require.Equal(t, block, actualBlock)
+	require.Equal(t, rcpts, actualRcpts)
+
+	// Later calls should retrieve from cache
+	delete(stub.Blocks, block.Hash())
+	delete(stub.Receipts, block.Hash())
+	actualBlock, actualRcpts = oracle.ReceiptsByBlockHash(block.Hash(), 48294)
+	require.Equal(t, block, actualBlock)
+	require.Equal(t, rcpts, actualRcpts)
+}
+
+func TestMaxSize(t *testing.T) {
+	stub, stateStub := test.NewStubOracle(t)
+	oracle := NewSizedCachingOracle(stub, 300)
+
+	for i := byte(0); i < 3; i++ {
+		stateStub.Data[common.Hash{i}] = make([]byte, 100)
+		oracle.NodeByHash(common.Hash{i}, 1234)
+	}
+	require.Equal(t, 300, oracle.size)
+
+	// Mark the first node as recently used, so the second is evicted next
+	delete(stateStub.Data, common.Hash{0})
+	oracle.NodeByHash(common.Hash{0}, 1234)
+	stateStub.Data[common.Hash{3}] = make([]byte, 100)
+	oracle.NodeByHash(common.Hash{3}, 1234)
+	require.Equal(t, 300, oracle.size)
+	_, ok := oracle.nodes.Get(common.Hash{1})
+	require.False(t, ok, "should evict least recently used node")
+
+	// Entries larger than the bound are not cached
+	stateStub.Data[common.Hash{4}] = make([]byte, 301)
+	oracle.NodeByHash(common.Hash{4}, 1234)
+	require.Equal(t, 300, oracle.size)
+	_, ok = oracle.nodes.Get(common.Hash{4})
+	require.False(t, ok)
+}
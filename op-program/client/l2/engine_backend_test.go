@@ -259,7 +259,7 @@ func TestPrecompileOracle(t *testing.T) {
 			precompileOracle.Results = map[common.Hash]l2test.PrecompileResult{
 				crypto.Keccak256Hash(arg): {Result: test.result, Ok: true},
 			}
-			chain, err := NewOracleBackedL2Chain(logger, oracle, precompileOracle, chainCfg, common.Hash(eth.OutputRoot(&stubOutput)))
+			chain, err := NewOracleBackedL2Chain(logger, oracle, precompileOracle, engineapi.AllPrecompiles, chainCfg, common.Hash(eth.OutputRoot(&stubOutput)))
 			require.NoError(t, err)
 
 			newBlock := createBlock(t, chain, WithInput(test.input), WithTargetAddress(test.target))
@@ -288,7 +288,7 @@ func setupOracleBackedChainWithLowerHead(t *testing.T, blockCount int, headBlock
 	head := blocks[headBlockNumber].Hash()
 	stubOutput := eth.OutputV0{BlockHash: head}
 	precompileOracle := l2test.NewStubPrecompileOracle(t)
-	chain, err := NewOracleBackedL2Chain(logger, oracle, precompileOracle, chainCfg, common.Hash(eth.OutputRoot(&stubOutput)))
+	chain, err := NewOracleBackedL2Chain(logger, oracle, precompileOracle, engineapi.AllPrecompiles, chainCfg, common.Hash(eth.OutputRoot(&stubOutput)))
 	require.NoError(t, err)
 	return blocks, chain
 }
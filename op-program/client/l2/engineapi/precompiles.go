@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -49,26 +50,154 @@ type PrecompileOracle interface {
 	Precompile(address common.Address, input []byte, requiredGas uint64) ([]byte, bool)
 }
 
-func CreatePrecompileOverrides(precompileOracle PrecompileOracle) vm.PrecompileOverrides {
+// PrecompileFlags is a bitmask of the precompiles whose execution is accelerated by the precompile oracle.
+// Each accelerated precompile has its own flag, so the accelerated set is committed to by the boot info.
+type PrecompileFlags uint64
+
+const (
+	EcrecoverFlag PrecompileFlags = 1 << iota
+	Bn256PairingFlag
+	KZGPointEvaluationFlag
+)
+
+var ErrUnknownPrecompileFlags = errors.New("unknown accelerated precompile flags")
+
+// acceleratedPrecompile describes a precompile that can be executed with the precompile oracle.
+type acceleratedPrecompile struct {
+	name    string
+	flag    PrecompileFlags
+	address common.Address
+	// forks are the oracle-backed implementations of the precompile by fork activation, latest fork first.
+	forks []acceleratedFork
+}
+
+// acceleratedFork is the oracle-backed implementation of a precompile from the activation of a fork.
+type acceleratedFork struct {
+	// active returns whether the fork is active in the rules
+	active func(rules params.Rules) bool
+	create func(orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract
+}
+
+// create returns the implementation of the latest fork active in rules, or nil if no fork is active.
+func (p *acceleratedPrecompile) create(rules params.Rules, orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract {
+	for _, fork := range p.forks {
+		if fork.active(rules) {
+			return fork.create(orig, oracle)
+		}
+	}
+	return nil
+}
+
+func genesisActive(params.Rules) bool { return true }
+
+// acceleratedPrecompiles is the table of all precompiles that can be accelerated.
+// Accelerating another precompile only requires adding it here, along with its flag,
+// and adding its address to the precompiles the host accepts.
+//
+// The BLS12-381 precompiles (EIP-2537) are not accelerated: no OP Stack fork of the op-geth version
+// the program is built with activates them, so L2 execution never calls them.
+// They are to be added here, keyed by the fork that activates them, once op-geth does.
+var acceleratedPrecompiles = []acceleratedPrecompile{
+	{
+		name:    "ecrecover",
+		flag:    EcrecoverFlag,
+		address: ecrecoverPrecompileAddress,
+		forks: []acceleratedFork{{
+			active: genesisActive,
+			create: func(orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract {
+				return &ecrecoverOracle{Orig: orig, Oracle: oracle}
+			},
+		}},
+	},
+	{
+		name:    "bn256Pairing",
+		flag:    Bn256PairingFlag,
+		address: bn256PairingPrecompileAddress,
+		forks: []acceleratedFork{{
+			// Granite limits the input size of the pairing
+			active: func(rules params.Rules) bool { return rules.IsOptimismGranite },
+			create: func(orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract {
+				return &bn256PairingOracleGranite{bn256PairingOracle{Orig: orig, Oracle: oracle}}
+			},
+		}, {
+			active: genesisActive,
+			create: func(orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract {
+				return &bn256PairingOracle{Orig: orig, Oracle: oracle}
+			},
+		}},
+	},
+	{
+		name:    "kzgPointEvaluation",
+		flag:    KZGPointEvaluationFlag,
+		address: kzgPointEvaluationPrecompileAddress,
+		forks: []acceleratedFork{{
+			// Activated with Cancun on L2 by Ecotone
+			active: func(rules params.Rules) bool { return rules.IsCancun },
+			create: func(orig vm.PrecompiledContract, oracle PrecompileOracle) vm.PrecompiledContract {
+				return &kzgPointEvaluationOracle{Orig: orig, Oracle: oracle}
+			},
+		}},
+	},
+}
+
+// AllPrecompiles enables the acceleration of every precompile known to the program.
+var AllPrecompiles = func() PrecompileFlags {
+	var flags PrecompileFlags
+	for _, p := range acceleratedPrecompiles {
+		flags |= p.flag
+	}
+	return flags
+}()
+
+// Check returns an error if flags enables a precompile that is not known to the program.
+// This happens when the boot info was created for a different program version, so it must fail loudly
+// rather than silently execute the precompile without acceleration.
+func (f PrecompileFlags) Check() error {
+	if unknown := f &^ AllPrecompiles; unknown != 0 {
+		return fmt.Errorf("%w: %#x", ErrUnknownPrecompileFlags, uint64(unknown))
+	}
+	return nil
+}
+
+// Names returns the names of the precompiles enabled by the flags.
+func (f PrecompileFlags) Names() []string {
+	var names []string
+	for _, p := range acceleratedPrecompiles {
+		if f&p.flag != 0 {
+			names = append(names, p.name)
+		}
+	}
+	return names
+}
+
+// PrecompileFlagsFromNames returns the flags that enable the precompiles with the given names.
+func PrecompileFlagsFromNames(names []string) (PrecompileFlags, error) {
+	var flags PrecompileFlags
+	for _, name := range names {
+		idx := slices.IndexFunc(acceleratedPrecompiles, func(p acceleratedPrecompile) bool { return p.name == name })
+		if idx < 0 {
+			return 0, fmt.Errorf("unknown accelerated precompile: %q", name)
+		}
+		flags |= acceleratedPrecompiles[idx].flag
+	}
+	return flags, nil
+}
+
+// CreatePrecompileOverrides returns the overrides that execute the precompiles enabled by flags with the precompile oracle.
+func CreatePrecompileOverrides(precompileOracle PrecompileOracle, flags PrecompileFlags) vm.PrecompileOverrides {
 	return func(rules params.Rules, orig vm.PrecompiledContract, address common.Address) vm.PrecompiledContract {
 		if orig == nil { // Only override existing contracts. Never introduce a precompile that is not there.
 			return nil
 		}
-		// NOTE: Ignoring chain rules for now. We assume that precompile behavior won't change for the foreseeable future
-		switch address {
-		case ecrecoverPrecompileAddress:
-			return &ecrecoverOracle{Orig: orig, Oracle: precompileOracle}
-		case bn256PairingPrecompileAddress:
-			precompile := bn256PairingOracle{Orig: orig, Oracle: precompileOracle}
-			if rules.IsOptimismGranite {
-				return &bn256PairingOracleGranite{precompile}
+		for _, p := range acceleratedPrecompiles {
+			if p.address != address || flags&p.flag == 0 {
+				continue
+			}
+			if accelerated := p.create(rules, orig, precompileOracle); accelerated != nil {
+				return accelerated
 			}
-			return &precompile
-		case kzgPointEvaluationPrecompileAddress:
-			return &kzgPointEvaluationOracle{Orig: orig, Oracle: precompileOracle}
-		default:
-			return orig
 		}
+		return orig
 	}
 }
 
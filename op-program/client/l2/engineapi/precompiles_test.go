@@ -25,7 +25,10 @@ func TestOverriddenPrecompiles(t *testing.T) {
 		{name: "ecrecover", addr: ecrecoverPrecompileAddress, overrideWith: &ecrecoverOracle{}},
 		{name: "bn256Pairing", addr: bn256PairingPrecompileAddress, overrideWith: &bn256PairingOracle{}},
 		{name: "bn256PairingGranite", addr: bn256PairingPrecompileAddress, rules: params.Rules{IsOptimismGranite: true}, overrideWith: &bn256PairingOracleGranite{}},
-		{name: "kzgPointEvaluation", addr: kzgPointEvaluationPrecompileAddress, overrideWith: &kzgPointEvaluationOracle{}},
+		{name: "kzgPointEvaluation", addr: kzgPointEvaluationPrecompileAddress, rules: params.Rules{IsCancun: true}, overrideWith: &kzgPointEvaluationOracle{}},
+
+		// Not overridden before the fork that activates the accelerated precompile
+		{name: "kzgPointEvaluationPreCancun", addr: kzgPointEvaluationPrecompileAddress},
 
 		// Actual precompiles but not overridden
 		{name: "identity", addr: common.Address{0x04}},
@@ -42,7 +45,7 @@ func TestOverriddenPrecompiles(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			orig := &stubPrecompile{}
 			oracle := &stubPrecompileOracle{}
-			overrides := CreatePrecompileOverrides(oracle)
+			overrides := CreatePrecompileOverrides(oracle, AllPrecompiles)
 
 			actual := overrides(test.rules, orig, test.addr)
 			if test.overrideWith != nil {
@@ -57,18 +60,57 @@ func TestOverriddenPrecompiles(t *testing.T) {
 	// Ensures that if the pre-compile isn't present in the active fork, we don't add an override that enables it
 	t.Run("nil-orig", func(t *testing.T) {
 		oracle := &stubPrecompileOracle{}
-		overrides := CreatePrecompileOverrides(oracle)
+		overrides := CreatePrecompileOverrides(oracle, AllPrecompiles)
 
 		actual := overrides(params.Rules{}, nil, ecrecoverPrecompileAddress)
 		require.Nil(t, actual, "should not add new pre-compiles")
 	})
 }
 
+func TestDisabledPrecompiles(t *testing.T) {
+	orig := &stubPrecompile{}
+	overrides := CreatePrecompileOverrides(&stubPrecompileOracle{}, AllPrecompiles&^Bn256PairingFlag)
+
+	require.IsType(t, &ecrecoverOracle{}, overrides(params.Rules{}, orig, ecrecoverPrecompileAddress))
+	require.Same(t, orig, overrides(params.Rules{}, orig, bn256PairingPrecompileAddress), "should not override disabled precompile")
+	require.IsType(t, &kzgPointEvaluationOracle{}, overrides(params.Rules{IsCancun: true}, orig, kzgPointEvaluationPrecompileAddress))
+
+	overrides = CreatePrecompileOverrides(&stubPrecompileOracle{}, 0)
+	require.Same(t, orig, overrides(params.Rules{}, orig, ecrecoverPrecompileAddress), "should not override any precompile")
+}
+
+func TestPrecompileFlags(t *testing.T) {
+	t.Run("Check", func(t *testing.T) {
+		require.NoError(t, AllPrecompiles.Check())
+		require.NoError(t, PrecompileFlags(0).Check())
+		require.ErrorIs(t, (AllPrecompiles << 1).Check(), ErrUnknownPrecompileFlags)
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		require.Equal(t, []string{"ecrecover", "bn256Pairing", "kzgPointEvaluation"}, AllPrecompiles.Names())
+		require.Equal(t, []string{"kzgPointEvaluation"}, KZGPointEvaluationFlag.Names())
+		require.Empty(t, PrecompileFlags(0).Names())
+	})
+
+	t.Run("FromNames", func(t *testing.T) {
+		flags, err := PrecompileFlagsFromNames(AllPrecompiles.Names())
+		require.NoError(t, err)
+		require.Equal(t, AllPrecompiles, flags)
+
+		flags, err = PrecompileFlagsFromNames([]string{"ecrecover", "kzgPointEvaluation"})
+		require.NoError(t, err)
+		require.Equal(t, EcrecoverFlag|KZGPointEvaluationFlag, flags)
+
+		_, err = PrecompileFlagsFromNames([]string{"sha256"})
+		require.ErrorContains(t, err, "sha256")
+	})
+}
+
 func TestEcrecover(t *testing.T) {
 	setup := func() (vm.PrecompiledContract, *stubPrecompileOracle) {
 		orig := &stubPrecompile{}
 		oracle := &stubPrecompileOracle{}
-		overrides := CreatePrecompileOverrides(oracle)
+		overrides := CreatePrecompileOverrides(oracle, AllPrecompiles)
 		override := overrides(params.Rules{}, orig, ecrecoverPrecompileAddress)
 		return override, oracle
 	}
@@ -141,7 +183,7 @@ func TestBn256Pairing(t *testing.T) {
 	setup := func(enableGranite bool) (vm.PrecompiledContract, *stubPrecompileOracle) {
 		orig := &stubPrecompile{}
 		oracle := &stubPrecompileOracle{result: true32Byte}
-		overrides := CreatePrecompileOverrides(oracle)
+		overrides := CreatePrecompileOverrides(oracle, AllPrecompiles)
 		override := overrides(params.Rules{IsOptimismGranite: enableGranite}, orig, bn256PairingPrecompileAddress)
 		return override, oracle
 	}
@@ -217,8 +259,8 @@ func TestKzgPointEvaluationPrecompile(t *testing.T) {
 	setup := func() (vm.PrecompiledContract, *stubPrecompileOracle) {
 		orig := &stubPrecompile{}
 		oracle := &stubPrecompileOracle{result: oracleResult}
-		overrides := CreatePrecompileOverrides(oracle)
-		override := overrides(params.Rules{}, orig, kzgPointEvaluationPrecompileAddress)
+		overrides := CreatePrecompileOverrides(oracle, AllPrecompiles)
+		override := overrides(params.Rules{IsCancun: true}, orig, kzgPointEvaluationPrecompileAddress)
 		return override, oracle
 	}
 	validInput := common.FromHex("01e798154708fe7789429634053cbf9f99b619f9f084048927333fce637f549b564c0a11a0f704f4fc3e8acfe0f8245f0ad1347b378fbf96e206da11a5d3630624d25032e67a7e6a4910df5834b8fe70e6bcfeeac0352434196bdf4b2485d5a18f59a8d2a1a625a17f3fea0fe5eb8c896db3764f3185481bc22f91b4aaffcca25f26936857bc3a7c2539ea8ec3a952b7873033e038326e87ed3e1276fd140253fa08e9fc25fb2d9a98527fc22a2c9612fbeafdad446cbc7bcdbdcd780af2c16a")
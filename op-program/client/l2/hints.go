@@ -13,10 +13,12 @@ import (
 const (
 	HintL2BlockHeader  = "l2-block-header"
 	HintL2Transactions = "l2-transactions"
+	HintL2Receipts     = "l2-receipts"
 	HintL2Code         = "l2-code"
 	HintL2StateNode    = "l2-state-node"
 	HintL2Output       = "l2-output"
 	HintL2BlockData    = "l2-block-data"
+	HintL2BlockRange   = "l2-block-range"
 	HintAgreedPrestate = "agreed-pre-state"
 )
 
@@ -64,6 +66,14 @@ func (l TransactionsHint) Hint() string {
 	return HintL2Transactions + " " + hexutil.Encode(HashAndChainID(l).Marshal())
 }
 
+type ReceiptsHint HashAndChainID
+
+var _ preimage.Hint = ReceiptsHint{}
+
+func (l ReceiptsHint) Hint() string {
+	return HintL2Receipts + " " + hexutil.Encode(HashAndChainID(l).Marshal())
+}
+
 type CodeHint HashAndChainID
 
 var _ preimage.Hint = CodeHint{}
@@ -128,6 +138,24 @@ func (l L2BlockDataHint) Hint() string {
 	return fmt.Sprintf("%s 0x%s", HintL2BlockData, common.Bytes2Hex(hintBytes))
 }
 
+// BlockRangeHint requests the headers and transactions of Count blocks of the chain with ChainID,
+// from the block with hash Head back through its ancestors.
+type BlockRangeHint struct {
+	Head    common.Hash
+	Count   uint64
+	ChainID uint64
+}
+
+var _ preimage.Hint = BlockRangeHint{}
+
+func (l BlockRangeHint) Hint() string {
+	hintBytes := make([]byte, 32+8+8)
+	copy(hintBytes[:32], l.Head[:])
+	binary.BigEndian.PutUint64(hintBytes[32:40], l.Count)
+	binary.BigEndian.PutUint64(hintBytes[40:], l.ChainID)
+	return HintL2BlockRange + " " + hexutil.Encode(hintBytes)
+}
+
 type AgreedPrestateHint common.Hash
 
 var _ preimage.Hint = AgreedPrestateHint{}
@@ -34,12 +34,20 @@ type Oracle interface {
 	// BlockByHash retrieves the block with the given hash.
 	BlockByHash(blockHash common.Hash, chainID uint64) *types.Block
 
+	// ReceiptsByBlockHash retrieves the block with the given hash and the receipts of its transactions.
+	ReceiptsByBlockHash(blockHash common.Hash, chainID uint64) (*types.Block, types.Receipts)
+
 	OutputByRoot(root common.Hash, chainID uint64) eth.Output
 
 	// BlockDataByHash retrieves the block, including all data used to construct it.
 	BlockDataByHash(agreedBlockHash, blockHash common.Hash, chainID uint64) *types.Block
 
 	TransitionStateByRoot(root common.Hash) *interopTypes.TransitionState
+
+	// HintBlockRange hints that the headers and transactions of count blocks,
+	// from the block with the given hash back through its ancestors, are about to be retrieved.
+	// It lets the host prefetch the whole range at once instead of one block at a time.
+	HintBlockRange(head common.Hash, count uint64, chainID uint64)
 }
 
 // PreimageOracle implements Oracle using by interfacing with the pure preimage.Oracle
@@ -99,6 +107,23 @@ func (p *PreimageOracle) LoadTransactions(blockHash common.Hash, txHash common.H
 	return txs
 }
 
+func (p *PreimageOracle) ReceiptsByBlockHash(blockHash common.Hash, chainID uint64) (*types.Block, types.Receipts) {
+	block := p.BlockByHash(blockHash, chainID)
+
+	p.hint.Hint(ReceiptsHint{Hash: blockHash, ChainID: chainID})
+
+	opaqueReceipts := mpt.ReadTrie(block.ReceiptHash(), func(key common.Hash) []byte {
+		return p.oracle.Get(preimage.Keccak256Key(key))
+	})
+
+	txHashes := eth.TransactionsToHashes(block.Transactions())
+	receipts, err := eth.DecodeRawReceipts(eth.ToBlockID(block), opaqueReceipts, txHashes)
+	if err != nil {
+		panic(fmt.Errorf("bad receipts data for block %s: %w", blockHash, err))
+	}
+	return block, receipts
+}
+
 func (p *PreimageOracle) NodeByHash(nodeHash common.Hash, chainID uint64) []byte {
 	if p.hintL2ChainIDs {
 		p.hint.Hint(StateNodeHint{Hash: nodeHash, ChainID: chainID})
@@ -152,3 +177,7 @@ func (p *PreimageOracle) TransitionStateByRoot(root common.Hash) *interopTypes.T
 	}
 	return output
 }
+
+func (p *PreimageOracle) HintBlockRange(head common.Hash, count uint64, chainID uint64) {
+	p.hint.Hint(BlockRangeHint{Head: head, Count: count, ChainID: chainID})
+}
@@ -193,3 +193,36 @@ func TestPreimageOracleOutputByRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestPreimageOracleReceiptsByBlockHash(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	chainID := uint64(4924)
+	block, receipts := testutils.RandomBlock(rng, 10)
+
+	po, hints, preimages := mockPreimageOracle(t, true)
+	hdrBytes, err := rlp.EncodeToBytes(block.Header())
+	require.NoError(t, err)
+	preimages[preimage.Keccak256Key(block.Hash()).PreimageKey()] = hdrBytes
+	opaqueTxs, err := eth.EncodeTransactions(block.Transactions())
+	require.NoError(t, err)
+	_, txsNodes := mpt.WriteTrie(opaqueTxs)
+	opaqueReceipts, err := eth.EncodeReceipts(receipts)
+	require.NoError(t, err)
+	_, receiptNodes := mpt.WriteTrie(opaqueReceipts)
+	for _, p := range append(txsNodes, receiptNodes...) {
+		preimages[preimage.Keccak256Key(crypto.Keccak256Hash(p)).PreimageKey()] = p
+	}
+
+	hints.On("hint", BlockHeaderHint{Hash: block.Hash(), ChainID: chainID}.Hint()).Once().Return()
+	hints.On("hint", TransactionsHint{Hash: block.Hash(), ChainID: chainID}.Hint()).Once().Return()
+	hints.On("hint", ReceiptsHint{Hash: block.Hash(), ChainID: chainID}.Hint()).Once().Return()
+	gotBlock, gotReceipts := po.ReceiptsByBlockHash(block.Hash(), chainID)
+	hints.AssertExpectations(t)
+
+	require.Equal(t, block.Hash(), gotBlock.Hash())
+	require.Len(t, gotReceipts, len(receipts))
+	for i, rcpt := range gotReceipts {
+		require.Equalf(t, receipts[i].TxHash, rcpt.TxHash, "expecting receipt %d to match", i)
+		require.Equalf(t, receipts[i].Logs, rcpt.Logs, "expecting logs of receipt %d to match", i)
+	}
+}
@@ -22,6 +22,7 @@ type stateOracle interface {
 type StubBlockOracle struct {
 	t                *testing.T
 	Blocks           map[common.Hash]*gethTypes.Block
+	Receipts         map[common.Hash]gethTypes.Receipts
 	Outputs          map[common.Hash]eth.Output
 	TransitionStates map[common.Hash]*interopTypes.TransitionState
 	stateOracle
@@ -32,6 +33,7 @@ func NewStubOracle(t *testing.T) (*StubBlockOracle, *StubStateOracle) {
 	blockOracle := StubBlockOracle{
 		t:                t,
 		Blocks:           make(map[common.Hash]*gethTypes.Block),
+		Receipts:         make(map[common.Hash]gethTypes.Receipts),
 		Outputs:          make(map[common.Hash]eth.Output),
 		TransitionStates: make(map[common.Hash]*interopTypes.TransitionState),
 		stateOracle:      stateOracle,
@@ -51,6 +53,7 @@ func NewStubOracleWithBlocks(t *testing.T, chain []*gethTypes.Block, outputs []e
 	return &StubBlockOracle{
 		t:           t,
 		Blocks:      blocks,
+		Receipts:    make(map[common.Hash]gethTypes.Receipts),
 		Outputs:     o,
 		stateOracle: &KvStateOracle{t: t, Source: db},
 	}
@@ -64,6 +67,14 @@ func (o StubBlockOracle) BlockByHash(blockHash common.Hash, chainID uint64) *get
 	return block
 }
 
+func (o StubBlockOracle) ReceiptsByBlockHash(blockHash common.Hash, chainID uint64) (*gethTypes.Block, gethTypes.Receipts) {
+	receipts, ok := o.Receipts[blockHash]
+	if !ok {
+		o.t.Fatalf("requested unknown receipts for block %s", blockHash)
+	}
+	return o.BlockByHash(blockHash, chainID), receipts
+}
+
 func (o StubBlockOracle) OutputByRoot(root common.Hash, chainID uint64) eth.Output {
 	output, ok := o.Outputs[root]
 	if !ok {
@@ -87,6 +98,8 @@ func (o StubBlockOracle) BlockDataByHash(agreedBlockHash, blockHash common.Hash,
 	return block
 }
 
+func (o StubBlockOracle) HintBlockRange(head common.Hash, count uint64, chainID uint64) {}
+
 // KvStateOracle loads data from a source ethdb.KeyValueStore
 type KvStateOracle struct {
 	t      *testing.T
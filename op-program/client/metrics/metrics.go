@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const Namespace = "op_program_client"
+
+// Metricer records the execution of the client program.
+// The client program uses NoopMetrics when it runs in a VM, where there is nothing to export the metrics to.
+type Metricer interface {
+	// RecordOracleRequest records a pre-image request of the given key type, and the size of the served pre-image.
+	RecordOracleRequest(keyType string, size int)
+	// RecordDerivationDuration records how long the derivation of a block of the chain took.
+	RecordDerivationDuration(chainID uint64, duration time.Duration)
+}
+
+// Metrics records the execution of the client program to Prometheus, when it runs in the host process.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	oracleRequests     *prometheus.CounterVec
+	payloadSize        *prometheus.HistogramVec
+	derivationDuration *prometheus.HistogramVec
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+// Metrics implementation must implement RegistryMetricer to allow the metrics server to work.
+var _ opmetrics.RegistryMetricer = (*Metrics)(nil)
+
+func NewMetrics() *Metrics {
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+	return &Metrics{
+		registry: registry,
+		oracleRequests: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "oracle_requests_total",
+			Help:      "Number of pre-image requests of the client program, by key type",
+		}, []string{"type"}),
+		payloadSize: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "oracle_payload_size_bytes",
+			Help:      "Size of the pre-images served to the client program, by key type",
+			Buckets:   prometheus.ExponentialBuckets(32, 4, 10),
+		}, []string{"type"}),
+		derivationDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "derivation_duration_seconds",
+			Help:      "Time taken to derive a block, by chain ID",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		}, []string{"chain"}),
+	}
+}
+
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+func (m *Metrics) RecordOracleRequest(keyType string, size int) {
+	m.oracleRequests.WithLabelValues(keyType).Inc()
+	m.payloadSize.WithLabelValues(keyType).Observe(float64(size))
+}
+
+func (m *Metrics) RecordDerivationDuration(chainID uint64, duration time.Duration) {
+	m.derivationDuration.WithLabelValues(strconv.FormatUint(chainID, 10)).Observe(duration.Seconds())
+}
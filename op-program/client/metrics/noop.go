@@ -0,0 +1,11 @@
+package metrics
+
+import "time"
+
+type noopMetrics struct{}
+
+// NoopMetrics discards all metrics.
+var NoopMetrics Metricer = noopMetrics{}
+
+func (noopMetrics) RecordOracleRequest(string, int)                {}
+func (noopMetrics) RecordDerivationDuration(uint64, time.Duration) {}
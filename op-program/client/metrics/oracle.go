@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+// keyTypes are the labels of the pre-image key types.
+var keyTypes = map[preimage.KeyType]string{
+	preimage.LocalKeyType:         "local",
+	preimage.Keccak256KeyType:     "keccak256",
+	preimage.GlobalGenericKeyType: "global-generic",
+	preimage.Sha256KeyType:        "sha256",
+	preimage.BlobKeyType:          "blob",
+	preimage.PrecompileKeyType:    "precompile",
+}
+
+// oracle records the requests to a preimage.Oracle.
+type oracle struct {
+	oracle preimage.Oracle
+	m      Metricer
+}
+
+// NewOracle returns an oracle that records the pre-image requests to the given oracle, by key type.
+func NewOracle(o preimage.Oracle, m Metricer) preimage.Oracle {
+	return &oracle{oracle: o, m: m}
+}
+
+func (o *oracle) Get(key preimage.Key) []byte {
+	data := o.oracle.Get(key)
+	keyType, ok := keyTypes[preimage.KeyType(key.PreimageKey()[0])]
+	if !ok {
+		keyType = "unknown"
+	}
+	o.m.RecordOracleRequest(keyType, len(data))
+	return data
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+type stubOracle map[[32]byte][]byte
+
+func (o stubOracle) Get(key preimage.Key) []byte {
+	return o[key.PreimageKey()]
+}
+
+type stubMetrics struct {
+	requests map[string]int
+	bytes    map[string]int
+}
+
+func (m *stubMetrics) RecordOracleRequest(keyType string, size int) {
+	m.requests[keyType]++
+	m.bytes[keyType] += size
+}
+
+func (m *stubMetrics) RecordDerivationDuration(uint64, time.Duration) {}
+
+func TestOracle(t *testing.T) {
+	local := preimage.LocalIndexKey(1)
+	keccak := preimage.Keccak256Key(common.Hash{0xaa})
+	blob := preimage.BlobKey(common.Hash{0xbb})
+	m := &stubMetrics{requests: make(map[string]int), bytes: make(map[string]int)}
+	oracle := NewOracle(stubOracle{
+		local.PreimageKey():  make([]byte, 8),
+		keccak.PreimageKey(): make([]byte, 100),
+		blob.PreimageKey():   make([]byte, 32),
+	}, m)
+
+	require.Len(t, oracle.Get(local), 8)
+	require.Len(t, oracle.Get(keccak), 100)
+	require.Len(t, oracle.Get(keccak), 100)
+	require.Len(t, oracle.Get(blob), 32)
+	require.Equal(t, map[string]int{"local": 1, "keccak256": 2, "blob": 1}, m.requests)
+	require.Equal(t, map[string]int{"local": 8, "keccak256": 200, "blob": 32}, m.bytes)
+}
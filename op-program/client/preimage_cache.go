@@ -0,0 +1,50 @@
+package client
+
+import (
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+// sharedPreimageCache is a preimage.Oracle that retains the global pre-images read through the oracle channel.
+// In interop runs the chains of a super root share most of their L1 data, so the derivation of every chain
+// after the first is served from memory instead of re-reading identical pre-images from the host.
+// Only pre-images that are keyed by their content are cached; local pre-images are specific to the program
+// instance and cheap to read.
+type sharedPreimageCache struct {
+	oracle  preimage.Oracle
+	maxSize int
+	size    int
+	entries *simplelru.LRU[[32]byte, []byte]
+}
+
+var _ preimage.Oracle = (*sharedPreimageCache)(nil)
+
+func newSharedPreimageCache(oracle preimage.Oracle, maxSize int) *sharedPreimageCache {
+	c := &sharedPreimageCache{oracle: oracle, maxSize: maxSize}
+	// The number of entries is bounded by the total size, not by the LRU itself.
+	c.entries, _ = simplelru.NewLRU[[32]byte, []byte](maxSize, func(_ [32]byte, value []byte) {
+		c.size -= len(value)
+	})
+	return c
+}
+
+func (c *sharedPreimageCache) Get(key preimage.Key) []byte {
+	k := key.PreimageKey()
+	if preimage.KeyType(k[0]) == preimage.LocalKeyType {
+		return c.oracle.Get(key)
+	}
+	if value, ok := c.entries.Get(k); ok {
+		return value
+	}
+	value := c.oracle.Get(key)
+	if len(value) > c.maxSize {
+		return value
+	}
+	c.entries.Add(k, value)
+	c.size += len(value)
+	for c.size > c.maxSize {
+		c.entries.RemoveOldest()
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+type countingOracle struct {
+	preimages map[[32]byte][]byte
+	reads     map[[32]byte]int
+}
+
+func (o *countingOracle) Get(key preimage.Key) []byte {
+	k := key.PreimageKey()
+	o.reads[k]++
+	return o.preimages[k]
+}
+
+func TestSharedPreimageCache(t *testing.T) {
+	keyA := preimage.Keccak256Key(common.Hash{31: 0xaa})
+	keyB := preimage.Keccak256Key(common.Hash{31: 0xbb})
+	keyC := preimage.Keccak256Key(common.Hash{31: 0xcc})
+	keyD := preimage.Keccak256Key(common.Hash{31: 0xdd})
+	local := preimage.LocalIndexKey(1)
+	oracle := &countingOracle{
+		preimages: map[[32]byte][]byte{
+			keyA.PreimageKey():  make([]byte, 4),
+			keyB.PreimageKey():  make([]byte, 4),
+			keyC.PreimageKey():  make([]byte, 20),
+			keyD.PreimageKey():  make([]byte, 4),
+			local.PreimageKey(): {0x01},
+		},
+		reads: make(map[[32]byte]int),
+	}
+	cache := newSharedPreimageCache(oracle, 10)
+
+	t.Run("ReadOnce", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			require.Len(t, cache.Get(keyA), 4)
+		}
+		require.Equal(t, 1, oracle.reads[keyA.PreimageKey()])
+	})
+
+	t.Run("LocalKeysNotCached", func(t *testing.T) {
+		cache.Get(local)
+		cache.Get(local)
+		require.Equal(t, 2, oracle.reads[local.PreimageKey()])
+	})
+
+	t.Run("EvictBySize", func(t *testing.T) {
+		cache.Get(keyB)
+		cache.Get(keyA)
+		require.Equal(t, 8, cache.size)
+		// Larger than the max size, so it is not cached and does not evict anything
+		cache.Get(keyC)
+		cache.Get(keyC)
+		require.Equal(t, 2, oracle.reads[keyC.PreimageKey()])
+		require.Equal(t, 8, cache.size)
+
+		// Evicts the least recently used pre-image
+		cache.Get(keyD)
+		require.Equal(t, 8, cache.size)
+		cache.Get(keyA)
+		require.Equal(t, 1, oracle.reads[keyA.PreimageKey()])
+		cache.Get(keyB)
+		require.Equal(t, 2, oracle.reads[keyB.PreimageKey()])
+	})
+}
@@ -1,17 +1,21 @@
 package client
 
 import (
+	"time"
+
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	"github.com/ethereum-optimism/optimism/op-program/client/claim"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/metrics"
 	"github.com/ethereum-optimism/optimism/op-program/client/tasks"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/log"
 )
 
-func RunPreInteropProgram(logger log.Logger, bootInfo *boot.BootInfo, l1PreimageOracle *l1.CachingOracle, l2PreimageOracle *l2.CachingOracle) error {
+func RunPreInteropProgram(logger log.Logger, bootInfo *boot.BootInfo, l1PreimageOracle *l1.CachingOracle, l2PreimageOracle *l2.CachingOracle, validateClaim bool, m metrics.Metricer) (eth.Bytes32, error) {
 	logger.Info("Program Bootstrapped", "bootInfo", bootInfo)
+	start := time.Now()
 	result, err := tasks.RunDerivation(
 		logger,
 		bootInfo.RollupConfig,
@@ -19,11 +23,16 @@ func RunPreInteropProgram(logger log.Logger, bootInfo *boot.BootInfo, l1Preimage
 		bootInfo.L1Head,
 		bootInfo.L2OutputRoot,
 		bootInfo.L2ClaimBlockNumber,
+		bootInfo.AcceleratedPrecompiles,
 		l1PreimageOracle,
 		l2PreimageOracle,
 	)
+	m.RecordDerivationDuration(bootInfo.RollupConfig.L2ChainID.Uint64(), time.Since(start))
 	if err != nil {
-		return err
+		return eth.Bytes32{}, err
+	}
+	if !validateClaim {
+		return result.OutputRoot, nil
 	}
-	return claim.ValidateClaim(logger, eth.Bytes32(bootInfo.L2Claim), result.OutputRoot)
+	return result.OutputRoot, claim.ValidateClaim(logger, eth.Bytes32(bootInfo.L2Claim), result.OutputRoot)
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strconv"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
@@ -11,14 +12,32 @@ import (
 	"github.com/ethereum-optimism/optimism/op-program/client/interop"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/log"
 )
 
 type Config struct {
 	SkipValidation bool
 	InteropEnabled bool
+	// ChainConfigs overrides the source of the configs of chains that are not custom.
+	// If nil, the configs embedded in the program are used. Not supported with interop.
+	ChainConfigs boot.ConfigSource
+	// OracleCacheSize is the maximum approximate size in bytes of the data cached in interop runs.
+	// Half of it retains the pre-images shared by the chains, the other half the decoded L1 and L2 data.
+	// If zero, DefaultOracleCacheSize is used.
+	OracleCacheSize int
+	// TraceExecution sends an execution trace of the interop program to the host through the hint channel.
+	TraceExecution bool
+	// Metrics records the pre-image requests and the derivations of the program.
+	// If nil, metrics.NoopMetrics is used, as there is nothing to export the metrics to when running in a VM.
+	Metrics metrics.Metricer
 }
 
+// DefaultOracleCacheSize is the default maximum approximate size in bytes of the data cached in interop runs.
+// It is kept well below the memory available to the program in the MIPS VM.
+const DefaultOracleCacheSize = 128 * 1024 * 1024
+
 // Main executes the client program in a detached context and exits the current process.
 // The client runtime environment must be preset before calling this function.
 func Main(logger log.Logger) {
@@ -27,30 +46,92 @@ func Main(logger log.Logger) {
 	preimageHinter := preimage.ClientHinterChannel()
 	config := Config{
 		InteropEnabled: os.Getenv("OP_PROGRAM_CLIENT_USE_INTEROP") == "true",
+		TraceExecution: os.Getenv("OP_PROGRAM_CLIENT_TRACE_EXECUTION") == "true",
+	}
+	if size, err := strconv.Atoi(os.Getenv("OP_PROGRAM_CLIENT_ORACLE_CACHE_SIZE")); err == nil {
+		config.OracleCacheSize = size
 	}
-	if err := RunProgram(logger, preimageOracle, preimageHinter, config); errors.Is(err, claim.ErrClaimNotValid) {
+	_, err := RunProgram(logger, preimageOracle, preimageHinter, config)
+	if errors.Is(err, claim.ErrClaimNotValid) {
 		log.Error("Claim is invalid", "err", err)
-		os.Exit(1)
 	} else if err != nil {
 		log.Error("Program failed", "err", err)
-		os.Exit(2)
 	} else {
 		log.Info("Claim successfully verified")
-		os.Exit(0)
+	}
+	os.Exit(ExitCode(err))
+}
+
+// Exit codes of the client program, which identify the cause of a failure.
+const (
+	ExitCodeSuccess       = 0
+	ExitCodeInvalidClaim  = 1
+	ExitCodeProgramFailed = 2
+	// The exit codes below are only used by the interop program.
+	ExitCodeMissingPreimage       = 3
+	ExitCodeConfigUnavailable     = 4
+	ExitCodeInvalidAgreedPrestate = 5
+	ExitCodeDerivationFailed      = 6
+	ExitCodeConsolidationFailed   = 7
+)
+
+// ExitCode returns the exit code of the client program for the error returned by RunProgram.
+// Errors without a more specific exit code result in ExitCodeProgramFailed.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitCodeSuccess
+	case errors.Is(err, claim.ErrClaimNotValid):
+		return ExitCodeInvalidClaim
+	case errors.Is(err, interop.ErrMissingPreimage):
+		return ExitCodeMissingPreimage
+	case errors.Is(err, interop.ErrConfigUnavailable):
+		return ExitCodeConfigUnavailable
+	case errors.Is(err, interop.ErrInvalidAgreedPrestate):
+		return ExitCodeInvalidAgreedPrestate
+	case errors.Is(err, interop.ErrDerivationFailed):
+		return ExitCodeDerivationFailed
+	case errors.Is(err, interop.ErrConsolidationFailed):
+		return ExitCodeConsolidationFailed
+	default:
+		return ExitCodeProgramFailed
 	}
 }
 
 // RunProgram executes the Program, while attached to an IO based pre-image oracle, to be served by a host.
-func RunProgram(logger log.Logger, preimageOracle io.ReadWriter, preimageHinter io.ReadWriter, cfg Config) error {
-	pClient := preimage.NewOracleClient(preimageOracle)
+// The claim computed by the program is returned, after validating it against the claim of the boot info
+// unless SkipValidation is set.
+func RunProgram(logger log.Logger, preimageOracle io.ReadWriter, preimageHinter io.ReadWriter, cfg Config) (eth.Bytes32, error) {
+	m := cfg.Metrics
+	if m == nil {
+		m = metrics.NoopMetrics
+	}
+	pClient := metrics.NewOracle(preimage.NewOracleClient(preimageOracle), m)
 	hClient := preimage.NewHintWriter(preimageHinter)
-	l1PreimageOracle := l1.NewCachingOracle(l1.NewPreimageOracle(pClient, hClient))
-	l2PreimageOracle := l2.NewCachingOracle(l2.NewPreimageOracle(pClient, hClient, cfg.InteropEnabled))
-
 	if cfg.InteropEnabled {
-		bootInfo := boot.BootstrapInterop(pClient)
-		return interop.RunInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, !cfg.SkipValidation)
+		cacheSize := cfg.OracleCacheSize
+		if cacheSize == 0 {
+			cacheSize = DefaultOracleCacheSize
+		}
+		// The chains of the super root share their L1 data, read it through the oracle channel only once.
+		pClient = newSharedPreimageCache(pClient, cacheSize/2)
+
+		// Retain the decoded data across the derivations of all chains, which also avoids re-sending their hints.
+		l1PreimageOracle := l1.NewSizedCachingOracle(l1.NewPreimageOracle(pClient, hClient), cacheSize/4)
+		l2PreimageOracle := l2.NewSizedCachingOracle(l2.NewPreimageOracle(pClient, hClient, true), cacheSize/4)
+		bootInfo := boot.BootstrapInterop(pClient, hClient)
+		var tracer interop.ExecutionTracer
+		if cfg.TraceExecution {
+			tracer = interop.NewHintTracer(hClient)
+		}
+		return interop.RunInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, !cfg.SkipValidation, tracer, hClient, m)
+	}
+	bootClient := boot.NewBootstrapClient(pClient)
+	if cfg.ChainConfigs != nil {
+		bootClient = boot.NewBootstrapClientWithConfigs(pClient, cfg.ChainConfigs)
 	}
-	bootInfo := boot.NewBootstrapClient(pClient).BootInfo()
-	return RunPreInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle)
+	bootInfo := bootClient.BootInfo()
+	l1PreimageOracle := l1.NewCachingOracle(l1.NewPreimageOracle(pClient, hClient))
+	l2PreimageOracle := l2.NewCachingOracle(l2.NewPreimageOracle(pClient, hClient, false))
+	return RunPreInteropProgram(logger, bootInfo, l1PreimageOracle, l2PreimageOracle, !cfg.SkipValidation, m)
 }
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-program/client/claim"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected int
+	}{
+		{nil, ExitCodeSuccess},
+		{claim.ErrClaimNotValid, ExitCodeInvalidClaim},
+		{interop.ErrClaimMismatch, ExitCodeInvalidClaim},
+		{errors.New("boom"), ExitCodeProgramFailed},
+		{interop.ErrMissingPreimage, ExitCodeMissingPreimage},
+		{interop.ErrConfigUnavailable, ExitCodeConfigUnavailable},
+		{interop.ErrInvalidAgreedPrestate, ExitCodeInvalidAgreedPrestate},
+		{interop.ErrDerivationFailed, ExitCodeDerivationFailed},
+		{interop.ErrConsolidationFailed, ExitCodeConsolidationFailed},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprintf("%v", test.err), func(t *testing.T) {
+			require.Equal(t, test.expected, ExitCode(test.err))
+			if test.err != nil {
+				require.Equal(t, test.expected, ExitCode(fmt.Errorf("wrapped: %w", test.err)))
+			}
+		})
+	}
+}
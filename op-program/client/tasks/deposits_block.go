@@ -0,0 +1,115 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// BuildDepositOnlyBlock builds the replacement of an invalid optimistic block, which only includes the deposit
+// transactions of the optimistic block, on top of the block of the agreed output root.
+// Returns the block hash and output root of the replacement block.
+func BuildDepositOnlyBlock(
+	logger log.Logger,
+	cfg *rollup.Config,
+	l2Cfg *params.ChainConfig,
+	optimisticBlockHash common.Hash,
+	agreedL2OutputRoot eth.Bytes32,
+	precompileFlags engineapi.PrecompileFlags,
+	l1Oracle l1.Oracle,
+	l2Oracle l2.Oracle) (common.Hash, eth.Bytes32, error) {
+	engineBackend, err := l2.NewOracleBackedL2Chain(logger, l2Oracle, l1Oracle /* precompile oracle */, precompileFlags, l2Cfg, common.Hash(agreedL2OutputRoot))
+	if err != nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("failed to create oracle-backed L2 chain: %w", err)
+	}
+	l2Source := l2.NewOracleEngine(cfg, logger, engineBackend)
+
+	optimisticBlock := l2Oracle.BlockByHash(optimisticBlockHash, l2Cfg.ChainID.Uint64())
+	head := engineBackend.CurrentHeader()
+	if optimisticBlock.ParentHash() != head.Hash() {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("optimistic block %s does not build on agreed block %s", optimisticBlockHash, head.Hash())
+	}
+	attrs, err := depositsOnlyAttributes(cfg, optimisticBlock)
+	if err != nil {
+		return common.Hash{}, eth.Bytes32{}, err
+	}
+
+	logger.Info("Building deposit-only block", "chainID", cfg.L2ChainID, "replaced", optimisticBlockHash, "deposits", len(attrs.Transactions))
+	ctx := context.Background()
+	fcState := &eth.ForkchoiceState{
+		HeadBlockHash:      head.Hash(),
+		SafeBlockHash:      head.Hash(),
+		FinalizedBlockHash: head.Hash(),
+	}
+	result, err := l2Source.ForkchoiceUpdate(ctx, fcState, attrs)
+	if err != nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("failed to start deposit-only block: %w", err)
+	}
+	if result.PayloadStatus.Status != eth.ExecutionValid || result.PayloadID == nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("deposit-only block not started: %v", result.PayloadStatus.Status)
+	}
+	envelope, err := l2Source.GetPayload(ctx, eth.PayloadInfo{ID: *result.PayloadID, Timestamp: uint64(attrs.Timestamp)})
+	if err != nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("failed to get deposit-only block: %w", err)
+	}
+	status, err := l2Source.NewPayload(ctx, envelope.ExecutionPayload, envelope.ParentBeaconBlockRoot)
+	if err != nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("failed to insert deposit-only block: %w", err)
+	}
+	if status.Status != eth.ExecutionValid {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("invalid deposit-only block: %v", status.Status)
+	}
+	fcState.HeadBlockHash = envelope.ExecutionPayload.BlockHash
+	if _, err := l2Source.ForkchoiceUpdate(ctx, fcState, nil); err != nil {
+		return common.Hash{}, eth.Bytes32{}, fmt.Errorf("failed to make deposit-only block canonical: %w", err)
+	}
+	return l2Source.L2OutputRoot(uint64(envelope.ExecutionPayload.BlockNumber))
+}
+
+// depositsOnlyAttributes returns the attributes of the optimistic block, with only its deposit transactions.
+func depositsOnlyAttributes(cfg *rollup.Config, block *types.Block) (*eth.PayloadAttributes, error) {
+	var deposits []eth.Data
+	for _, tx := range block.Transactions() {
+		if tx.Type() != types.DepositTxType {
+			// Deposits are always at the start of the block.
+			break
+		}
+		data, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode deposit %s: %w", tx.Hash(), err)
+		}
+		deposits = append(deposits, data)
+	}
+	gasLimit := eth.Uint64Quantity(block.GasLimit())
+	attrs := &eth.PayloadAttributes{
+		Timestamp:             eth.Uint64Quantity(block.Time()),
+		PrevRandao:            eth.Bytes32(block.MixDigest()),
+		SuggestedFeeRecipient: block.Coinbase(),
+		ParentBeaconBlockRoot: block.BeaconRoot(),
+		Transactions:          deposits,
+		NoTxPool:              true,
+		GasLimit:              &gasLimit,
+	}
+	if cfg.IsCanyon(block.Time()) {
+		attrs.Withdrawals = &types.Withdrawals{}
+	}
+	if cfg.IsHolocene(block.Time()) {
+		// The Holocene extra data is a version byte followed by the EIP-1559 parameters.
+		extra := block.Extra()
+		if len(extra) != 9 {
+			return nil, fmt.Errorf("invalid holocene extra data of block %s: %x", block.Hash(), extra)
+		}
+		params := eth.Bytes8(extra[1:9])
+		attrs.EIP1559Params = &params
+	}
+	return attrs, nil
+}
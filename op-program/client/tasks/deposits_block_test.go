@@ -0,0 +1,47 @@
+package tasks
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositsOnlyAttributes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	cfg := *chaincfg.OPSepolia()
+	deposit := types.NewTx(&types.DepositTx{
+		SourceHash: common.Hash{0xaa},
+		From:       common.Address{0xbb},
+		Value:      big.NewInt(0),
+		Gas:        100_000,
+	})
+
+	t.Run("KeepOnlyDeposits", func(t *testing.T) {
+		block, _ := testutils.RandomBlockPrependTxsWithTime(rng, 3, *cfg.CanyonTime, deposit)
+		attrs, err := depositsOnlyAttributes(&cfg, block)
+		require.NoError(t, err)
+		require.Len(t, attrs.Transactions, 1)
+		require.True(t, attrs.IsDepositsOnly())
+		require.True(t, attrs.NoTxPool)
+		require.Equal(t, eth.Uint64Quantity(block.Time()), attrs.Timestamp)
+		require.Equal(t, eth.Uint64Quantity(block.GasLimit()), *attrs.GasLimit)
+		require.Equal(t, block.Coinbase(), attrs.SuggestedFeeRecipient)
+		require.NotNil(t, attrs.Withdrawals)
+	})
+
+	t.Run("InvalidHoloceneExtraData", func(t *testing.T) {
+		holocene := uint64(0)
+		cfg := cfg
+		cfg.HoloceneTime = &holocene
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Time: 1000, Extra: []byte{0, 1}})
+		_, err := depositsOnlyAttributes(&cfg, block)
+		require.ErrorContains(t, err, "invalid holocene extra data")
+	})
+}
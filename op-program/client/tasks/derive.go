@@ -1,18 +1,30 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	cldr "github.com/ethereum-optimism/optimism/op-program/client/driver"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 )
 
+// maxBlockRangeHint is the maximum number of blocks hinted in a single block range.
+// Larger L1 ranges are not hinted, as derivation likely reaches the claimed block long before the L1 head.
+const maxBlockRangeHint = 1000
+
+// l1BlockTime is the L1 block time in seconds, used to estimate how many L2 blocks the pipeline reset walks back.
+// The rollup config doesn't include the L1 block time, so the slot time of Ethereum is assumed. On L1 chains with
+// another block time, such as devnets, the estimate is off. That only affects prefetching, as blocks outside of the
+// hinted range are still fetched when they are requested.
+const l1BlockTime = 12
+
 type L2Source interface {
 	L2OutputRoot(uint64) (common.Hash, eth.Bytes32, error)
 }
@@ -35,15 +47,21 @@ func RunDerivation(
 	l1Head common.Hash,
 	l2OutputRoot common.Hash,
 	l2ClaimBlockNum uint64,
+	precompileFlags engineapi.PrecompileFlags,
 	l1Oracle l1.Oracle,
 	l2Oracle l2.Oracle) (DerivationResult, error) {
 	l1Source := l1.NewOracleL1Client(logger, l1Oracle, l1Head)
 	l1BlobsSource := l1.NewBlobFetcher(logger, l1Oracle)
-	engineBackend, err := l2.NewOracleBackedL2Chain(logger, l2Oracle, l1Oracle /* kzg oracle */, l2Cfg, l2OutputRoot)
+	engineBackend, err := l2.NewOracleBackedL2Chain(logger, l2Oracle, l1Oracle /* precompile oracle */, precompileFlags, l2Cfg, l2OutputRoot)
 	if err != nil {
 		return DerivationResult{}, fmt.Errorf("failed to create oracle-backed L2 chain: %w", err)
 	}
 	l2Source := l2.NewOracleEngine(cfg, logger, engineBackend)
+	agreed, err := l2Source.L2BlockRefByLabel(context.Background(), eth.Unsafe)
+	if err != nil {
+		return DerivationResult{}, fmt.Errorf("failed to load agreed L2 block: %w", err)
+	}
+	hintDerivationRanges(logger, cfg, l2Cfg, l1Oracle, l2Oracle, l1Head, agreed)
 
 	logger.Info("Starting derivation", "chainID", cfg.L2ChainID)
 	d := cldr.NewDriver(logger, cfg, l1Source, l1BlobsSource, l2Source, l2ClaimBlockNum)
@@ -55,6 +73,34 @@ func RunDerivation(
 	return loadOutputRoot(l2ClaimBlockNum, result, l2Source)
 }
 
+// hintDerivationRanges hints the L1 and L2 blocks that derivation is expected to read, so the host can prefetch
+// them at once instead of one block at a time as they are requested.
+// The L1 range spans from the L1 head back to where the pipeline reset starts reading channel data,
+// the channel timeout before the L1 origin of the agreed L2 block. Receipts are only hinted from the L1 origin
+// onwards, for the epochs of the L2 blocks to derive.
+// The L2 range spans the L2 blocks that the pipeline reset walks back through to find that L1 block.
+// Both ranges are estimates, blocks outside of them are still fetched when requested.
+func hintDerivationRanges(logger log.Logger, cfg *rollup.Config, l2Cfg *params.ChainConfig, l1Oracle l1.Oracle, l2Oracle l2.Oracle, l1Head common.Hash, agreed eth.L2BlockRef) {
+	channelTimeout := rollup.NewChainSpec(cfg).ChannelTimeout(agreed.Time)
+
+	head := l1Oracle.HeaderByBlockHash(l1Head)
+	start := max(agreed.L1Origin.Number, cfg.Genesis.L1.Number+channelTimeout) - channelTimeout
+	if head.NumberU64() >= start {
+		if count := head.NumberU64() - start + 1; count <= maxBlockRangeHint {
+			var receiptsCount uint64
+			if head.NumberU64() >= agreed.L1Origin.Number {
+				receiptsCount = head.NumberU64() - agreed.L1Origin.Number + 1
+			}
+			l1Oracle.HintBlockRange(l1Head, count, receiptsCount)
+		} else {
+			logger.Debug("Not hinting L1 block range, too many blocks", "head", head.NumberU64(), "start", start)
+		}
+	}
+
+	l2Count := min(channelTimeout*l1BlockTime/cfg.BlockTime+1, agreed.Number-cfg.Genesis.L2.Number+1, maxBlockRangeHint)
+	l2Oracle.HintBlockRange(agreed.Hash, l2Count, l2Cfg.ChainID.Uint64())
+}
+
 func loadOutputRoot(l2ClaimBlockNum uint64, head eth.L2BlockRef, src L2Source) (DerivationResult, error) {
 	blockHash, outputRoot, err := src.L2OutputRoot(min(l2ClaimBlockNum, head.Number))
 	if err != nil {
@@ -2,10 +2,18 @@ package tasks
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	l1test "github.com/ethereum-optimism/optimism/op-program/client/l1/test"
+	l2test "github.com/ethereum-optimism/optimism/op-program/client/l2/test"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,6 +57,70 @@ func TestLoadOutputRoot(t *testing.T) {
 	})
 }
 
+func TestHintDerivationRanges(t *testing.T) {
+	l1Head := common.Hash{0xaa}
+	agreedHash := common.Hash{0xbb}
+	cfg := &rollup.Config{
+		Genesis:               rollup.Genesis{L1: eth.BlockID{Number: 10}, L2: eth.BlockID{Number: 50}},
+		BlockTime:             2,
+		ChannelTimeoutBedrock: 50,
+	}
+	l2Cfg := &params.ChainConfig{ChainID: big.NewInt(4)}
+	run := func(t *testing.T, l1HeadNum uint64, agreed eth.L2BlockRef) (*rangeHintingL1Oracle, *rangeHintingL2Oracle) {
+		l1Oracle := &rangeHintingL1Oracle{StubOracle: l1test.NewStubOracle(t)}
+		l1Oracle.Blocks[l1Head] = &testutils.MockBlockInfo{InfoHash: l1Head, InfoNum: l1HeadNum}
+		stub, _ := l2test.NewStubOracle(t)
+		l2Oracle := &rangeHintingL2Oracle{StubBlockOracle: stub}
+		hintDerivationRanges(testlog.Logger(t, log.LevelInfo), cfg, l2Cfg, l1Oracle, l2Oracle, l1Head, agreed)
+		return l1Oracle, l2Oracle
+	}
+
+	t.Run("FromChannelTimeout", func(t *testing.T) {
+		l1Oracle, l2Oracle := run(t, 1000, eth.L2BlockRef{Hash: agreedHash, Number: 5000, L1Origin: eth.BlockID{Number: 900}})
+		// From the L1 head back to the channel timeout before the agreed L1 origin, with receipts from the L1 origin
+		require.Equal(t, []rangeHint{{head: l1Head, count: 151, receiptsCount: 101}}, l1Oracle.hints)
+		// The L2 blocks of the channel timeout, at 12s L1 blocks and 2s L2 blocks
+		require.Equal(t, []rangeHint{{head: agreedHash, count: 301, chainID: 4}}, l2Oracle.hints)
+	})
+
+	t.Run("StopAtGenesis", func(t *testing.T) {
+		l1Oracle, l2Oracle := run(t, 100, eth.L2BlockRef{Hash: agreedHash, Number: 100, L1Origin: eth.BlockID{Number: 20}})
+		require.Equal(t, []rangeHint{{head: l1Head, count: 91, receiptsCount: 81}}, l1Oracle.hints)
+		require.Equal(t, []rangeHint{{head: agreedHash, count: 51, chainID: 4}}, l2Oracle.hints)
+	})
+
+	t.Run("SkipLargeL1Range", func(t *testing.T) {
+		l1Oracle, l2Oracle := run(t, 5000, eth.L2BlockRef{Hash: agreedHash, Number: 5000, L1Origin: eth.BlockID{Number: 900}})
+		require.Empty(t, l1Oracle.hints)
+		require.Len(t, l2Oracle.hints, 1)
+	})
+}
+
+type rangeHint struct {
+	head          common.Hash
+	count         uint64
+	receiptsCount uint64
+	chainID       uint64
+}
+
+type rangeHintingL1Oracle struct {
+	*l1test.StubOracle
+	hints []rangeHint
+}
+
+func (o *rangeHintingL1Oracle) HintBlockRange(head common.Hash, count uint64, receiptsCount uint64) {
+	o.hints = append(o.hints, rangeHint{head: head, count: count, receiptsCount: receiptsCount})
+}
+
+type rangeHintingL2Oracle struct {
+	*l2test.StubBlockOracle
+	hints []rangeHint
+}
+
+func (o *rangeHintingL2Oracle) HintBlockRange(head common.Hash, count uint64, chainID uint64) {
+	o.hints = append(o.hints, rangeHint{head: head, count: count, chainID: chainID})
+}
+
 func assertDerivationResult(t *testing.T, actual DerivationResult, safeHead eth.L2BlockRef, blockHash common.Hash, outputRoot eth.Bytes32) {
 	require.Equal(t, safeHead, actual.Head)
 	require.Equal(t, blockHash, actual.BlockHash)
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-program/host/flags"
+	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
+)
+
+var CompactCommand = &cli.Command{
+	Name:  "compact",
+	Usage: "Compact the pre-images of a data directory into one pack file per shard",
+	Description: "Compacts the pre-image files of a data directory in the " + string(types.DataFormatSharded) + " format " +
+		"into one pack file per shard, which is much faster to read and copy than millions of small files. " +
+		"The data directory must not be in use while it is compacted.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     flags.DataDir.Name,
+			Usage:    "Directory of the preimage data to compact",
+			EnvVars:  flags.DataDir.EnvVars,
+			Required: true,
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		logger, err := setupLogging(ctx)
+		if err != nil {
+			return err
+		}
+		return kvstore.Compact(logger, ctx.String(flags.DataDir.Name))
+	},
+}
@@ -44,6 +44,7 @@ func run(args []string, action ConfigAction) error {
 	app.Name = "op-program"
 	app.Usage = "Optimism Fault Proof Program"
 	app.Description = "The Optimism Fault Proof Program fault proof program that runs through the rollup state-transition to verify an L2 output from L1 inputs."
+	app.Commands = []*cli.Command{CompactCommand, RemoteClientCommand, SymbolizeCommand}
 	app.Action = func(ctx *cli.Context) error {
 		logger, err := setupLogging(ctx)
 		if err != nil {
@@ -63,7 +64,15 @@ func run(args []string, action ConfigAction) error {
 
 func setupLogging(ctx *cli.Context) (log.Logger, error) {
 	logCfg := oplog.ReadCLIConfig(ctx)
-	logger := oplog.NewLogger(oplog.AppOut(ctx), logCfg)
+	out := oplog.AppOut(ctx)
+	if ctx.Bool(flags.OutputClaim.Name) || ctx.IsSet(flags.DiffChainConfigs.Name) {
+		// The result is written to stdout as JSON, so keep the logs separate from it.
+		out = os.Stderr
+		if ctx.App != nil && ctx.App.ErrWriter != nil {
+			out = ctx.App.ErrWriter
+		}
+	}
+	logger := oplog.NewLogger(out, logCfg)
 	oplog.SetGlobalLogHandler(logger.Handler())
 	return logger, nil
 }
@@ -5,18 +5,23 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -150,6 +155,47 @@ func TestDataFormat(t *testing.T) {
 	})
 }
 
+func TestCompact(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		dir := t.TempDir()
+		kv, err := kvstore.NewDiskKV(testlog.Logger(t, log.LevelError), dir, types.DataFormatSharded)
+		require.NoError(t, err)
+		require.NoError(t, kv.Put(common.Hash{0xaa}, []byte{1, 2, 3}))
+		require.NoError(t, kv.Close())
+		_, _, err = runWithArgs([]string{"compact", "--datadir", dir})
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(dir, "aa", "pack"))
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		dir := t.TempDir()
+		kv, err := kvstore.NewDiskKV(testlog.Logger(t, log.LevelError), dir, types.DataFormatFile)
+		require.NoError(t, err)
+		require.NoError(t, kv.Close())
+		verifyArgsInvalid(t, "unsupported format", []string{"compact", "--datadir", dir})
+	})
+
+	t.Run("MissingDataDir", func(t *testing.T) {
+		verifyArgsInvalid(t, "datadir", []string{"compact"})
+	})
+}
+
+func TestSymbolize(t *testing.T) {
+	t.Run("MissingELF", func(t *testing.T) {
+		verifyArgsInvalid(t, "elf", []string{"symbolize", "--input", "output.log"})
+	})
+
+	t.Run("MissingInput", func(t *testing.T) {
+		verifyArgsInvalid(t, "must specify the --input path or program counters", []string{"symbolize", "--elf", "client.elf"})
+	})
+
+	t.Run("InvalidELF", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "client.elf")
+		require.NoError(t, os.WriteFile(path, []byte("not an elf"), 0o644))
+		verifyArgsInvalid(t, "failed to open ELF", []string{"symbolize", "--elf", path, "0x1000"})
+	})
+}
+
 func TestL2(t *testing.T) {
 	t.Run("Single", func(t *testing.T) {
 		expected := "https://example.com:8545"
@@ -385,6 +431,153 @@ func TestL2Claim(t *testing.T) {
 	})
 }
 
+func TestOutputClaim(t *testing.T) {
+	t.Run("DefaultFalse", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.OutputClaim)
+	})
+	t.Run("ClaimNotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgsExcept("--l2.claim", "--output-claim"))
+		require.True(t, cfg.OutputClaim)
+		require.Equal(t, common.Hash{}, cfg.L2Claim)
+	})
+	t.Run("RejectClaim", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l2.claim must not be specified with output-claim", addRequiredArgs("--output-claim"))
+	})
+}
+
+func TestDiffChainConfigs(t *testing.T) {
+	t.Run("DefaultEmpty", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.DiffChainConfigsDir)
+	})
+	t.Run("ClaimNotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgsExcept("--l2.claim", "--diff-chain-configs", "/configs"))
+		require.Equal(t, "/configs", cfg.DiffChainConfigsDir)
+		require.Equal(t, common.Hash{}, cfg.L2Claim)
+	})
+	t.Run("RejectClaim", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l2.claim must not be specified with diff-chain-configs", addRequiredArgs("--diff-chain-configs", "/configs"))
+	})
+}
+
+func TestOracleSimulation(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.OracleLatency)
+		require.Zero(t, cfg.OracleBandwidth)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--oracle.latency", "2ms", "--oracle.bandwidth", "1048576"))
+		require.Equal(t, 2*time.Millisecond, cfg.OracleLatency)
+		require.Equal(t, uint64(1048576), cfg.OracleBandwidth)
+	})
+}
+
+func TestAcceleratedPrecompiles(t *testing.T) {
+	t.Run("DefaultAll", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, engineapi.AllPrecompiles, cfg.AcceleratedPrecompiles)
+	})
+	t.Run("CustomChain", func(t *testing.T) {
+		rollupCfgFile := writeValidRollupConfig(t)
+		genesisFile := writeValidGenesis(t)
+		cfg := configForArgs(t, addRequiredArgsExcept("--network",
+			"--rollup.config", rollupCfgFile,
+			"--l2.genesis", genesisFile,
+			"--l2.custom",
+			"--accelerated-precompiles", "ecrecover,kzgPointEvaluation"))
+		require.Equal(t, engineapi.EcrecoverFlag|engineapi.KZGPointEvaluationFlag, cfg.AcceleratedPrecompiles)
+	})
+	t.Run("RejectUnknown", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown accelerated precompile", addRequiredArgs("--accelerated-precompiles", "sha256"))
+	})
+}
+
+func TestOracleCacheSize(t *testing.T) {
+	t.Run("DefaultZero", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.OracleCacheSize)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--oracle-cache-size", "1048576"))
+		require.Equal(t, uint64(1048576), cfg.OracleCacheSize)
+	})
+}
+
+func TestExecutionTrace(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.ExecutionTracePath)
+	})
+	t.Run("RejectPreInterop", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--execution-trace", "trace.json"))
+		require.ErrorIs(t, cfg.Check(), config.ErrExecutionTraceNotInterop)
+	})
+}
+
+func TestDependencySetConfig(t *testing.T) {
+	t.Run("DefaultNone", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.DependencySet)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "depset.json")
+		require.NoError(t, os.WriteFile(file,
+			[]byte(`{"dependencies":{"11155420":{"chainIndex":"3","activationTime":10,"historyMinTime":0}},"messageExpiryWindow":3600}`), 0o644))
+		cfg := configForArgs(t, addRequiredArgs("--depset.config", file))
+		require.NotNil(t, cfg.DependencySet)
+		require.Equal(t, uint64(3600), cfg.DependencySet.MessageExpiryWindow())
+		chainID, err := cfg.DependencySet.ChainIDFromIndex(3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(11155420), chainID.ToBig().Uint64())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "depset.json")
+		require.NoError(t, os.WriteFile(file, []byte("{"), 0o644))
+		verifyArgsInvalid(t, "invalid dependency set", addRequiredArgs("--depset.config", file))
+	})
+	t.Run("Missing", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid dependency set", addRequiredArgs("--depset.config", filepath.Join(t.TempDir(), "missing.json")))
+	})
+}
+
+func TestInputReport(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.InputReportPath)
+		require.Zero(t, cfg.InputReportStepsPerByte)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(),
+			"--input-report", "report.json", "--input-report.steps-per-byte", "25.5"))
+		require.Equal(t, "report.json", cfg.InputReportPath)
+		require.Equal(t, 25.5, cfg.InputReportStepsPerByte)
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectNegativeStepsPerByte", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--input-report.steps-per-byte", "-1"))
+		require.ErrorIs(t, cfg.Check(), config.ErrNegativeStepsPerByte)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.MetricsConfig.Enabled)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--metrics.enabled", "--metrics.port", "7301"))
+		require.True(t, cfg.MetricsConfig.Enabled)
+		require.Equal(t, 7301, cfg.MetricsConfig.ListenPort)
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectExec", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--metrics.enabled", "--exec", "echo"))
+		require.ErrorIs(t, cfg.Check(), config.ErrMetricsNotInProcess)
+	})
+}
+
 func TestL2Experimental(t *testing.T) {
 	t.Run("DefaultEmpty", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
@@ -453,6 +646,34 @@ func TestServerMode(t *testing.T) {
 	})
 }
 
+func TestServerListenAddr(t *testing.T) {
+	t.Run("DefaultEmpty", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--server"))
+		require.Empty(t, cfg.ServerListenAddr)
+	})
+	t.Run("Set", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--server", "--server.listen-addr", "0.0.0.0:7300"))
+		require.Equal(t, "0.0.0.0:7300", cfg.ServerListenAddr)
+	})
+	t.Run("NotServerMode", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--server.listen-addr", "0.0.0.0:7300"))
+		require.ErrorIs(t, cfg.Check(), config.ErrListenAddrNotServer)
+	})
+	t.Run("TLS", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--server", "--server.listen-addr", "0.0.0.0:7300",
+			"--server.tls.ca", "ca.crt", "--server.tls.cert", "tls.crt", "--server.tls.key", "tls.key"))
+		require.True(t, cfg.ServerTLS.TLSEnabled())
+		require.Equal(t, "ca.crt", cfg.ServerTLS.TLSCaCert)
+		require.Equal(t, "tls.crt", cfg.ServerTLS.TLSCert)
+		require.Equal(t, "tls.key", cfg.ServerTLS.TLSKey)
+	})
+	t.Run("TLSDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--datadir", t.TempDir(), "--server", "--server.listen-addr", "127.0.0.1:7300", "--server.tls.enabled=false"))
+		require.False(t, cfg.ServerTLS.TLSEnabled())
+		require.NoError(t, cfg.Check())
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := runWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
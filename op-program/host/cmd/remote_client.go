@@ -0,0 +1,48 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/host/flags"
+	"github.com/ethereum-optimism/optimism/op-program/host/remote"
+	"github.com/ethereum-optimism/optimism/op-service/ctxinterrupt"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+var RemoteClientCommand = &cli.Command{
+	Name:  "remote-client",
+	Usage: "Forward the pre-image requests of a client program to a remote pre-image server",
+	Description: "Connects the pre-image and hint file descriptors of the client program to a host running with --" +
+		flags.Server.Name + " and --" + flags.ServerListenAddr.Name + ", so the fault proof VM can run on a different " +
+		"machine than the host. Use it as the exec command of the host, e.g. the command run by the VM. " +
+		"The client authenticates with mutual TLS, unless disabled to connect to a host on a loopback address.",
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     "addr",
+			Usage:    "TCP address of the remote pre-image server",
+			Required: true,
+		},
+	}, optls.CLIFlags(flags.EnvVarPrefix)...),
+	Action: func(ctx *cli.Context) error {
+		logger, err := setupLogging(ctx)
+		if err != nil {
+			return err
+		}
+		var tlsConfig *tls.Config
+		if tlsCfg := optls.ReadCLIConfig(ctx); tlsCfg.TLSEnabled() {
+			clientTLS, err := optls.NewClientConfig(logger, tlsCfg)
+			if err != nil {
+				return fmt.Errorf("failed to load client tls config: %w", err)
+			}
+			defer clientTLS.Stop()
+			tlsConfig = clientTLS.Config
+		}
+		bridgeCtx, stop := ctxinterrupt.WithSignalWaiter(ctx.Context)
+		defer stop()
+		return remote.Bridge(ctxinterrupt.WithCancelOnInterrupt(bridgeCtx), logger, ctx.String("addr"), tlsConfig, preimage.ClientPreimageChannel(), preimage.ClientHinterChannel())
+	},
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-program/symbolize"
+)
+
+var SymbolizeCommand = &cli.Command{
+	Name:      "symbolize",
+	Usage:     "Map the program counters in the captured output of a client program to Go source locations",
+	ArgsUsage: "[pc...]",
+	Description: "Annotates every address in the captured output of a client program that aborted inside the VM " +
+		"with its Go function, file and line, using the DWARF debug info of the client ELF. " +
+		"If program counters are given as arguments, only these are symbolized.",
+	Flags: []cli.Flag{
+		&cli.PathFlag{
+			Name:     "elf",
+			Usage:    "Path of the client ELF, with DWARF debug info",
+			Required: true,
+		},
+		&cli.PathFlag{
+			Name:  "input",
+			Usage: "Path of the captured client output to symbolize",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		if !ctx.Args().Present() && !ctx.IsSet("input") {
+			return errors.New("must specify the --input path or program counters to symbolize")
+		}
+		s, err := symbolize.NewSymbolizer(ctx.Path("elf"))
+		if err != nil {
+			return err
+		}
+		out := ctx.App.Writer
+		if ctx.Args().Present() {
+			for _, v := range ctx.Args().Slice() {
+				pc, err := strconv.ParseUint(strings.TrimPrefix(v, "0x"), 16, 64)
+				if err != nil {
+					return fmt.Errorf("invalid pc %q: %w", v, err)
+				}
+				if frame, ok := s.Lookup(pc); ok {
+					_, err = fmt.Fprintf(out, "%#x %s\n\t%s:%d\n", pc, frame.Function, frame.File, frame.Line)
+				} else {
+					_, err = fmt.Fprintf(out, "%#x ?\n", pc)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		f, err := os.Open(ctx.Path("input"))
+		if err != nil {
+			return fmt.Errorf("failed to open input: %w", err)
+		}
+		defer f.Close()
+		return s.SymbolizeText(f, out)
+	},
+}
@@ -11,12 +11,19 @@ import (
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	cl "github.com/ethereum-optimism/optimism/op-program/client"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop"
+	"github.com/ethereum-optimism/optimism/op-program/client/metrics"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// ErrMissingWitness is returned when a pre-image is missing from the witness archive.
+var ErrMissingWitness = errors.New("pre-image missing from witness archive")
+
 type Prefetcher interface {
 	Hint(hint string) error
 	GetPreimage(ctx context.Context, key common.Hash) ([]byte, error)
@@ -25,6 +32,9 @@ type PrefetcherCreator func(ctx context.Context, logger log.Logger, kv kvstore.K
 type programCfg struct {
 	prefetcher     PrefetcherCreator
 	skipValidation bool
+	claimOutput    func(claim eth.Bytes32)
+	chainConfigs   boot.ConfigSource
+	clientMetrics  metrics.Metricer
 }
 
 type ProgramOpt func(c *programCfg)
@@ -41,6 +51,30 @@ func WithSkipValidation(skip bool) ProgramOpt {
 	}
 }
 
+// WithClaimOutput sets the function that the claim computed by the client program is passed to.
+// Only supported when the client program runs in the host process.
+func WithClaimOutput(output func(claim eth.Bytes32)) ProgramOpt {
+	return func(c *programCfg) {
+		c.claimOutput = output
+	}
+}
+
+// WithChainConfigs sets the source the client program loads the configs of chains that are not custom from,
+// instead of the configs embedded in the program. Only supported when the client program runs in the host process.
+func WithChainConfigs(configs boot.ConfigSource) ProgramOpt {
+	return func(c *programCfg) {
+		c.chainConfigs = configs
+	}
+}
+
+// WithClientMetrics sets the metrics the client program records its pre-image requests and derivations to.
+// Only supported when the client program runs in the host process.
+func WithClientMetrics(m metrics.Metricer) ProgramOpt {
+	return func(c *programCfg) {
+		c.clientMetrics = m
+	}
+}
+
 // FaultProofProgram is the programmatic entry-point for the fault proof program
 func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Config, opts ...ProgramOpt) error {
 	programConfig := &programCfg{}
@@ -91,6 +125,15 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 
 	var cmd *exec.Cmd
 	if cfg.ExecCmd != "" {
+		if programConfig.claimOutput != nil {
+			return errors.New("claim output is not supported when executing the client program in a separate process")
+		}
+		if programConfig.chainConfigs != nil {
+			return errors.New("chain configs are not supported when executing the client program in a separate process")
+		}
+		if programConfig.clientMetrics != nil {
+			return errors.New("client metrics are not supported when executing the client program in a separate process")
+		}
 		cmd = exec.CommandContext(ctx, cfg.ExecCmd)
 		cmd.ExtraFiles = make([]*os.File, cl.MaxFd-3) // not including stdin, stdout and stderr
 		cmd.ExtraFiles[cl.HClientRFd-3] = hClientRW.Reader()
@@ -101,6 +144,12 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 		cmd.Stderr = os.Stderr // for debugging
 		if cfg.InteropEnabled {
 			cmd.Env = append(os.Environ(), "OP_PROGRAM_CLIENT_USE_INTEROP=true")
+			if cfg.ExecutionTracePath != "" {
+				cmd.Env = append(cmd.Env, "OP_PROGRAM_CLIENT_TRACE_EXECUTION=true")
+			}
+			if cfg.OracleCacheSize != 0 {
+				cmd.Env = append(cmd.Env, fmt.Sprintf("OP_PROGRAM_CLIENT_ORACLE_CACHE_SIZE=%d", cfg.OracleCacheSize))
+			}
 		}
 
 		err := cmd.Start()
@@ -118,19 +167,63 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 			clientCfg.SkipValidation = true
 		}
 		clientCfg.InteropEnabled = cfg.InteropEnabled
-		return cl.RunProgram(logger, pClientRW, hClientRW, clientCfg)
+		clientCfg.ChainConfigs = programConfig.chainConfigs
+		clientCfg.TraceExecution = cfg.ExecutionTracePath != ""
+		clientCfg.OracleCacheSize = int(cfg.OracleCacheSize)
+		clientCfg.Metrics = programConfig.clientMetrics
+		claim, err := runClientProgram(logger, pClientRW, hClientRW, clientCfg, cfg.WitnessDir != "")
+		if errors.Is(err, errClientPanic) || errors.Is(err, interop.ErrMissingPreimage) {
+			// The client panics when the pre-image server stopped, so report why the server stopped.
+			// Close the client channels first, to stop the server if the client panicked for another reason.
+			_ = pClientRW.Close()
+			_ = hClientRW.Close()
+			pClientRW, hClientRW = nil, nil
+			if srvErr := <-serverErr; srvErr != nil {
+				if errors.Is(err, interop.ErrMissingPreimage) {
+					// Keep the cause reported by the interop program, so callers can still identify it.
+					err = fmt.Errorf("%w: %w", interop.ErrMissingPreimage, srvErr)
+				} else {
+					err = srvErr
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if programConfig.claimOutput != nil {
+			programConfig.claimOutput(claim)
+		}
+		return nil
 	}
 }
 
+var errClientPanic = errors.New("client program panicked")
+
+// runClientProgram runs the client program in-process. If recoverPanic is set, a panic of the client program,
+// e.g. because a pre-image could not be read, is returned as errClientPanic, so the host can fail with the cause.
+func runClientProgram(logger log.Logger, pClientRW preimage.FileChannel, hClientRW preimage.FileChannel, clientCfg cl.Config, recoverPanic bool) (claim eth.Bytes32, err error) {
+	if recoverPanic {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%w: %v", errClientPanic, r)
+			}
+		}()
+	}
+	return cl.RunProgram(logger, pClientRW, hClientRW, clientCfg)
+}
+
 // PreimageServer reads hints and preimage requests from the provided channels and processes those requests.
 // This method will block until both the hinter and preimage handlers complete.
 // If either returns an error both handlers are stopped.
 // The supplied preimageChannel and hintChannel will be closed before this function returns.
-func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config, preimageChannel preimage.FileChannel, hintChannel preimage.FileChannel, prefetcherCreator PrefetcherCreator) error {
+func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config, preimageChannel io.ReadWriteCloser, hintChannel io.ReadWriteCloser, prefetcherCreator PrefetcherCreator) error {
 	var serverDone chan error
 	var hinterDone chan error
 	logger.Info("Starting preimage server")
 	var kv kvstore.KV
+	var throttle *oracleThrottle
+	var trace *executionTrace
+	var report *inputReport
 
 	// Close the preimage/hint channels, and then kv store once the server and hinter have exited.
 	defer func() {
@@ -144,13 +237,34 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 			// Wait for hinter to complete
 			<-hinterDone
 		}
+		if throttle != nil {
+			throttle.logStats(logger)
+		}
+		if trace != nil {
+			if err := trace.write(cfg.ExecutionTracePath); err != nil {
+				logger.Error("Failed to write execution trace", "path", cfg.ExecutionTracePath, "err", err)
+			} else {
+				logger.Info("Wrote execution trace", "path", cfg.ExecutionTracePath, "events", len(trace.events))
+			}
+		}
+		if report != nil {
+			if err := report.write(logger, cfg.InputReportPath); err != nil {
+				logger.Error("Failed to write input report", "path", cfg.InputReportPath, "err", err)
+			}
+		}
 
 		if kv != nil {
 			kv.Close()
 		}
 	}()
 
-	if cfg.DataDir == "" {
+	if cfg.WitnessDir != "" {
+		store, err := kvstore.OpenReadOnlyDiskKV(logger, cfg.WitnessDir)
+		if err != nil {
+			return fmt.Errorf("opening witness archive: %w", err)
+		}
+		kv = store
+	} else if cfg.DataDir == "" {
 		logger.Info("Using in-memory storage")
 		kv = kvstore.NewMemKV()
 	} else {
@@ -168,25 +282,51 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 		getPreimage kvstore.PreimageSource
 		hinter      preimage.HintHandler
 	)
-	prefetch, err := prefetcherCreator(ctx, logger, kv, cfg)
-	if err != nil {
-		return fmt.Errorf("failed to create prefetcher: %w", err)
-	}
-	if prefetch != nil {
-		getPreimage = func(key common.Hash) ([]byte, error) { return prefetch.GetPreimage(ctx, key) }
-		hinter = prefetch.Hint
-	} else {
-		logger.Info("Using offline mode. All required pre-images must be pre-populated.")
-		getPreimage = kv.Get
+	if cfg.WitnessDir != "" {
+		logger.Info("Using witness archive. All required pre-images must be in the archive.", "witness", cfg.WitnessDir)
+		getPreimage = witnessPreimageSource(kv)
 		hinter = func(hint string) error {
-			logger.Debug("ignoring prefetch hint", "hint", hint)
+			logger.Trace("ignoring hint in witness mode", "hint", hint)
 			return nil
 		}
+	} else {
+		prefetch, err := prefetcherCreator(ctx, logger, kv, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create prefetcher: %w", err)
+		}
+		if prefetch != nil {
+			getPreimage = func(key common.Hash) ([]byte, error) { return prefetch.GetPreimage(ctx, key) }
+			hinter = prefetch.Hint
+		} else {
+			logger.Info("Using offline mode. All required pre-images must be pre-populated.")
+			getPreimage = kv.Get
+			hinter = func(hint string) error {
+				logger.Debug("ignoring prefetch hint", "hint", hint)
+				return nil
+			}
+		}
+	}
+
+	if cfg.ExecutionTracePath != "" {
+		trace = newExecutionTrace(hinter)
+		hinter = trace.Hint
+	}
+	if cfg.InputReportPath != "" {
+		report = newInputReport(hinter, cfg.InputReportStepsPerByte)
+		hinter = report.Hint
 	}
 
 	localPreimageSource := kvstore.NewLocalPreimageSource(cfg)
 	splitter := kvstore.NewPreimageSourceSplitter(localPreimageSource.Get, getPreimage)
 	preimageGetter := preimage.WithVerification(splitter.Get)
+	if report != nil {
+		preimageGetter = report.wrap(preimageGetter)
+	}
+	if cfg.OracleLatency > 0 || cfg.OracleBandwidth > 0 {
+		logger.Info("Simulating preimage channel", "latency", cfg.OracleLatency, "bandwidth", cfg.OracleBandwidth)
+		throttle = newOracleThrottle(cfg.OracleLatency, cfg.OracleBandwidth)
+		preimageGetter = throttle.wrap(preimageGetter)
+	}
 
 	serverDone = launchOracleServer(logger, preimageChannel, preimageGetter)
 	hinterDone = routeHints(logger, hintChannel, hinter)
@@ -205,6 +345,17 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 	}
 }
 
+// witnessPreimageSource reads pre-images from the witness archive, and reports missing pre-images as ErrMissingWitness.
+func witnessPreimageSource(kv kvstore.KV) kvstore.PreimageSource {
+	return func(key common.Hash) ([]byte, error) {
+		value, err := kv.Get(key)
+		if errors.Is(err, kvstore.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrMissingWitness, key)
+		}
+		return value, err
+	}
+}
+
 func routeHints(logger log.Logger, hHostRW io.ReadWriter, hinter preimage.HintHandler) chan error {
 	chErr := make(chan error)
 	hintReader := preimage.NewHintReader(hHostRW)
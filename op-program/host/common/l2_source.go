@@ -109,6 +109,14 @@ func (l *L2Source) InfoAndTxsByHash(ctx context.Context, blockHash common.Hash)
 	return l.canonicalEthClient.InfoAndTxsByHash(ctx, blockHash)
 }
 
+// FetchReceipts implements prefetcher.L2Source.
+func (l *L2Source) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	if l.ExperimentalEnabled() {
+		return l.experimentalClient.FetchReceipts(ctx, blockHash)
+	}
+	return l.canonicalEthClient.FetchReceipts(ctx, blockHash)
+}
+
 // OutputByRoot implements prefetcher.L2Source.
 func (l *L2Source) OutputByRoot(ctx context.Context, blockRoot common.Hash) (eth.Output, error) {
 	if l.ExperimentalEnabled() {
@@ -0,0 +1,175 @@
+package common
+
+import (
+	"math/bits"
+	"strings"
+	"sync"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Types of the pre-images in the input report.
+const (
+	InputBoot         = "boot"
+	InputHeaders      = "headers"
+	InputTransactions = "transactions"
+	InputReceipts     = "receipts"
+	InputTrieNodes    = "trie-nodes"
+	InputCode         = "code"
+	InputBlobs        = "blobs"
+	InputPrecompiles  = "precompiles"
+	InputOther        = "other"
+)
+
+// Rough gas costs of the transactions of a dispute, to project its onchain cost.
+const (
+	// disputeMoveGas is the gas of a single attack or defend move.
+	disputeMoveGas = 200_000
+	// disputeStepGas is the gas of the step that executes a single instruction onchain.
+	disputeStepGas = 1_500_000
+	// preimageLoadGas is the gas to load a pre-image into the onchain oracle, in addition to the calldata.
+	preimageLoadGas = 100_000
+	// preimageByteGas is the calldata gas per byte of a pre-image loaded into the onchain oracle.
+	preimageByteGas = 16
+)
+
+// InputStats are the number and total size of the pre-images served to the client program.
+type InputStats struct {
+	Requests uint64 `json:"requests"`
+	Bytes    uint64 `json:"bytes"`
+}
+
+// DisputeCost is the projected cost of a dispute down to a single instruction of the execution trace.
+type DisputeCost struct {
+	// ExecutionDepth is the number of moves to bisect the execution trace down to a single instruction.
+	ExecutionDepth uint64 `json:"executionDepth"`
+	// Gas is the gas of the moves, the step and loading the largest pre-image, the worst case for the step.
+	Gas uint64 `json:"gas"`
+}
+
+// InputReport is the size of the input of a proof, the pre-images served to the client program.
+type InputReport struct {
+	// Types are the pre-images by type. Keccak256 pre-images are typed by the hint that preceded their request.
+	Types map[string]InputStats `json:"types"`
+	Total InputStats            `json:"total"`
+	// LargestPreimage is the size of the largest pre-image, which bounds the cost to load a pre-image onchain.
+	LargestPreimage uint64 `json:"largestPreimage"`
+	// EstimatedSteps is the number of cannon steps, estimated from the steps per byte of a sampling run.
+	// Only set if the steps per byte are configured, as is DisputeCost.
+	EstimatedSteps uint64       `json:"estimatedSteps,omitempty"`
+	DisputeCost    *DisputeCost `json:"disputeCost,omitempty"`
+}
+
+// inputReport records the pre-images served to the client program by type, and passes hints on.
+// The pre-image requests and hints are served concurrently, so the recorded state is guarded by a mutex.
+type inputReport struct {
+	next         preimage.HintHandler
+	stepsPerByte float64
+
+	mu       sync.Mutex
+	lastHint string
+	types    map[string]*InputStats
+	largest  uint64
+}
+
+func newInputReport(next preimage.HintHandler, stepsPerByte float64) *inputReport {
+	return &inputReport{next: next, stepsPerByte: stepsPerByte, types: make(map[string]*InputStats)}
+}
+
+// Hint records the type of the hint, to type the pre-images requested after it.
+func (r *inputReport) Hint(hint string) error {
+	hintType, _, _ := strings.Cut(hint, " ")
+	switch hintType {
+	case l1.HintL1BlockRange, l2.HintL2BlockRange, interop.HintExecutionTrace, preimage.HintCheckpoint:
+		// These hints do not precede the requests of any pre-images.
+	default:
+		r.mu.Lock()
+		r.lastHint = hintType
+		r.mu.Unlock()
+	}
+	return r.next(hint)
+}
+
+// wrap returns a getter that records the pre-images served by getter.
+func (r *inputReport) wrap(getter preimage.PreimageGetter) preimage.PreimageGetter {
+	return func(key [32]byte) ([]byte, error) {
+		data, err := getter(key)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		inputType := r.inputType(preimage.KeyType(key[0]))
+		stats, ok := r.types[inputType]
+		if !ok {
+			stats = new(InputStats)
+			r.types[inputType] = stats
+		}
+		stats.Requests++
+		stats.Bytes += uint64(len(data))
+		r.largest = max(r.largest, uint64(len(data)))
+		return data, nil
+	}
+}
+
+func (r *inputReport) inputType(keyType preimage.KeyType) string {
+	switch keyType {
+	case preimage.LocalKeyType:
+		return InputBoot
+	case preimage.Sha256KeyType, preimage.BlobKeyType:
+		return InputBlobs
+	case preimage.PrecompileKeyType:
+		return InputPrecompiles
+	case preimage.Keccak256KeyType:
+		switch r.lastHint {
+		case l1.HintL1BlockHeader, l2.HintL2BlockHeader:
+			return InputHeaders
+		case l1.HintL1Transactions, l2.HintL2Transactions:
+			return InputTransactions
+		case l1.HintL1Receipts, l2.HintL2Receipts:
+			return InputReceipts
+		case l2.HintL2StateNode:
+			return InputTrieNodes
+		case l2.HintL2Code:
+			return InputCode
+		}
+	}
+	return InputOther
+}
+
+func (r *inputReport) report() InputReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := InputReport{Types: make(map[string]InputStats), LargestPreimage: r.largest}
+	for inputType, stats := range r.types {
+		report.Types[inputType] = *stats
+		report.Total.Requests += stats.Requests
+		report.Total.Bytes += stats.Bytes
+	}
+	if r.stepsPerByte > 0 {
+		report.EstimatedSteps = uint64(float64(report.Total.Bytes) * r.stepsPerByte)
+		report.DisputeCost = estimateDisputeCost(report.EstimatedSteps, report.LargestPreimage)
+	}
+	return report
+}
+
+func estimateDisputeCost(steps uint64, largestPreimage uint64) *DisputeCost {
+	depth := uint64(bits.Len64(max(steps, 1) - 1))
+	return &DisputeCost{
+		ExecutionDepth: depth,
+		Gas:            depth*disputeMoveGas + disputeStepGas + preimageLoadGas + largestPreimage*preimageByteGas,
+	}
+}
+
+func (r *inputReport) write(logger log.Logger, path string) error {
+	report := r.report()
+	logger.Info("Proof input size", "requests", report.Total.Requests, "bytes", report.Total.Bytes,
+		"largestPreimage", report.LargestPreimage, "estimatedSteps", report.EstimatedSteps)
+	return jsonutil.WriteJSON(report, ioutil.ToAtomicFile(path, 0o644))
+}
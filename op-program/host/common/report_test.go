@@ -0,0 +1,106 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/l1"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestInputReport(t *testing.T) {
+	setup := func(stepsPerByte float64) (*inputReport, preimage.PreimageGetter, *[]string) {
+		var passed []string
+		report := newInputReport(func(hint string) error {
+			passed = append(passed, hint)
+			return nil
+		}, stepsPerByte)
+		getter := report.wrap(func(key [32]byte) ([]byte, error) {
+			return make([]byte, key[1]), nil
+		})
+		return report, getter, &passed
+	}
+	// The stub getter serves pre-images of the size encoded in the key.
+	key := func(keyType preimage.KeyType, size byte) [32]byte {
+		return [32]byte{byte(keyType), size}
+	}
+
+	t.Run("TypeByHint", func(t *testing.T) {
+		report, getter, passed := setup(0)
+		_, err := getter(key(preimage.LocalKeyType, 8))
+		require.NoError(t, err)
+		require.NoError(t, report.Hint(l1.HintL1BlockHeader+" 0x1234"))
+		_, err = getter(key(preimage.Keccak256KeyType, 100))
+		require.NoError(t, err)
+		require.NoError(t, report.Hint(l2.HintL2StateNode+" 0x1234"))
+		_, err = getter(key(preimage.Keccak256KeyType, 50))
+		require.NoError(t, err)
+		_, err = getter(key(preimage.Keccak256KeyType, 30))
+		require.NoError(t, err)
+		require.NoError(t, report.Hint(l2.HintL2Receipts+" 0x1234"))
+		_, err = getter(key(preimage.Sha256KeyType, 32))
+		require.NoError(t, err)
+		require.Equal(t, []string{l1.HintL1BlockHeader + " 0x1234", l2.HintL2StateNode + " 0x1234", l2.HintL2Receipts + " 0x1234"}, *passed)
+
+		result := report.report()
+		require.Equal(t, map[string]InputStats{
+			InputBoot:      {Requests: 1, Bytes: 8},
+			InputHeaders:   {Requests: 1, Bytes: 100},
+			InputTrieNodes: {Requests: 2, Bytes: 80},
+			InputBlobs:     {Requests: 1, Bytes: 32},
+		}, result.Types)
+		require.Equal(t, InputStats{Requests: 5, Bytes: 220}, result.Total)
+		require.Equal(t, uint64(100), result.LargestPreimage)
+		require.Zero(t, result.EstimatedSteps)
+		require.Nil(t, result.DisputeCost)
+	})
+
+	t.Run("IgnoreRangeAndCheckpointHints", func(t *testing.T) {
+		report, getter, passed := setup(0)
+		require.NoError(t, report.Hint(l2.HintL2Code+" 0x1234"))
+		require.NoError(t, report.Hint(l1.HintL1BlockRange+" 0x1234"))
+		require.NoError(t, report.Hint(preimage.CheckpointHint(common.Hash{0xaa}).Hint()))
+		_, err := getter(key(preimage.Keccak256KeyType, 10))
+		require.NoError(t, err)
+		require.Len(t, *passed, 3, "hints are passed on")
+		require.Equal(t, map[string]InputStats{InputCode: {Requests: 1, Bytes: 10}}, report.report().Types)
+	})
+
+	t.Run("EstimateDisputeCost", func(t *testing.T) {
+		report, getter, _ := setup(2.5)
+		_, err := getter(key(preimage.LocalKeyType, 200))
+		require.NoError(t, err)
+		result := report.report()
+		require.Equal(t, uint64(500), result.EstimatedSteps)
+		require.Equal(t, &DisputeCost{
+			ExecutionDepth: 9,
+			Gas:            9*disputeMoveGas + disputeStepGas + preimageLoadGas + 200*preimageByteGas,
+		}, result.DisputeCost)
+	})
+
+	t.Run("Write", func(t *testing.T) {
+		report, getter, _ := setup(1)
+		_, err := getter(key(preimage.LocalKeyType, 8))
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "report.json")
+		require.NoError(t, report.write(testlog.Logger(t, log.LevelInfo), path))
+		written, err := jsonutil.LoadJSON[InputReport](path)
+		require.NoError(t, err)
+		require.Equal(t, report.report(), *written)
+	})
+}
+
+func TestEstimateDisputeCost(t *testing.T) {
+	require.Zero(t, estimateDisputeCost(0, 0).ExecutionDepth)
+	require.Zero(t, estimateDisputeCost(1, 0).ExecutionDepth)
+	require.Equal(t, uint64(1), estimateDisputeCost(2, 0).ExecutionDepth)
+	require.Equal(t, uint64(10), estimateDisputeCost(1024, 0).ExecutionDepth)
+	require.Equal(t, uint64(11), estimateDisputeCost(1025, 0).ExecutionDepth)
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Bytes sent over the preimage channel per request, in addition to the pre-image: the key and the length prefix.
+const preimageRequestOverhead = 32 + 8
+
+type oracleStats struct {
+	requests uint64
+	bytes    uint64
+	delay    time.Duration
+}
+
+// oracleThrottle simulates a preimage channel with added latency and limited throughput, by delaying each
+// pre-image response. It records the served pre-images per key type, to find code paths that request many pre-images.
+type oracleThrottle struct {
+	latency   time.Duration
+	bandwidth uint64 // bytes per second, zero is unlimited
+	sleep     func(time.Duration)
+	stats     map[preimage.KeyType]*oracleStats
+}
+
+func newOracleThrottle(latency time.Duration, bandwidth uint64) *oracleThrottle {
+	return &oracleThrottle{
+		latency:   latency,
+		bandwidth: bandwidth,
+		sleep:     time.Sleep,
+		stats:     make(map[preimage.KeyType]*oracleStats),
+	}
+}
+
+// delay returns the time it takes to serve a pre-image of the given size over the simulated channel.
+func (t *oracleThrottle) delay(size int) time.Duration {
+	d := t.latency
+	if t.bandwidth > 0 {
+		d += time.Duration(uint64(size+preimageRequestOverhead) * uint64(time.Second) / t.bandwidth)
+	}
+	return d
+}
+
+// wrap returns a getter that serves pre-images from getter with the simulated delay.
+// The returned getter must only be used by a single oracle server.
+func (t *oracleThrottle) wrap(getter preimage.PreimageGetter) preimage.PreimageGetter {
+	return func(key [32]byte) ([]byte, error) {
+		data, err := getter(key)
+		if err != nil {
+			return nil, err
+		}
+		d := t.delay(len(data))
+		t.sleep(d)
+		stats, ok := t.stats[preimage.KeyType(key[0])]
+		if !ok {
+			stats = new(oracleStats)
+			t.stats[preimage.KeyType(key[0])] = stats
+		}
+		stats.requests++
+		stats.bytes += uint64(len(data) + preimageRequestOverhead)
+		stats.delay += d
+		return data, nil
+	}
+}
+
+func (t *oracleThrottle) logStats(logger log.Logger) {
+	var total oracleStats
+	for keyType, stats := range t.stats {
+		logger.Info("Simulated preimage channel usage", "keyType", keyTypeName(keyType),
+			"requests", stats.requests, "bytes", stats.bytes, "delay", stats.delay)
+		total.requests += stats.requests
+		total.bytes += stats.bytes
+		total.delay += stats.delay
+	}
+	logger.Info("Simulated preimage channel total", "latency", t.latency, "bandwidth", t.bandwidth,
+		"requests", total.requests, "bytes", total.bytes, "delay", total.delay)
+}
+
+func keyTypeName(keyType preimage.KeyType) string {
+	switch keyType {
+	case preimage.LocalKeyType:
+		return "local"
+	case preimage.Keccak256KeyType:
+		return "keccak256"
+	case preimage.GlobalGenericKeyType:
+		return "generic"
+	case preimage.Sha256KeyType:
+		return "sha256"
+	case preimage.BlobKeyType:
+		return "blob"
+	case preimage.PrecompileKeyType:
+		return "precompile"
+	default:
+		return fmt.Sprintf("unknown(%d)", keyType)
+	}
+}
@@ -0,0 +1,50 @@
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleThrottle(t *testing.T) {
+	t.Run("Delay", func(t *testing.T) {
+		require.Equal(t, 5*time.Millisecond, newOracleThrottle(5*time.Millisecond, 0).delay(1000))
+		require.Equal(t, time.Second, newOracleThrottle(0, 1000).delay(1000-preimageRequestOverhead))
+		require.Equal(t, 2*time.Second+5*time.Millisecond, newOracleThrottle(5*time.Millisecond, 500).delay(1000-preimageRequestOverhead))
+	})
+
+	t.Run("DelayResponses", func(t *testing.T) {
+		throttle := newOracleThrottle(time.Millisecond, 1000)
+		var slept []time.Duration
+		throttle.sleep = func(d time.Duration) {
+			slept = append(slept, d)
+		}
+		getter := throttle.wrap(func(key [32]byte) ([]byte, error) {
+			return make([]byte, 960), nil
+		})
+		_, err := getter(preimage.Keccak256Key{0x01}.PreimageKey())
+		require.NoError(t, err)
+		_, err = getter(preimage.LocalIndexKey(1).PreimageKey())
+		require.NoError(t, err)
+		require.Equal(t, []time.Duration{time.Second + time.Millisecond, time.Second + time.Millisecond}, slept)
+		require.Equal(t, &oracleStats{requests: 1, bytes: 1000, delay: time.Second + time.Millisecond}, throttle.stats[preimage.Keccak256KeyType])
+		require.Equal(t, &oracleStats{requests: 1, bytes: 1000, delay: time.Second + time.Millisecond}, throttle.stats[preimage.LocalKeyType])
+	})
+
+	t.Run("NoDelayOnError", func(t *testing.T) {
+		throttle := newOracleThrottle(time.Millisecond, 0)
+		throttle.sleep = func(d time.Duration) {
+			t.Fatal("should not delay failed requests")
+		}
+		getterErr := errors.New("boom")
+		getter := throttle.wrap(func(key [32]byte) ([]byte, error) {
+			return nil, getterErr
+		})
+		_, err := getter(preimage.Keccak256Key{0x01}.PreimageKey())
+		require.ErrorIs(t, err, getterErr)
+		require.Empty(t, throttle.stats)
+	})
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/interop"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// executionTrace collects the execution trace the interop program sends as hints, and passes other hints on.
+type executionTrace struct {
+	next   preimage.HintHandler
+	events []interop.TraceEvent
+}
+
+func newExecutionTrace(next preimage.HintHandler) *executionTrace {
+	return &executionTrace{next: next, events: []interop.TraceEvent{}}
+}
+
+// Hint records the event of an execution trace hint. The trace hints are not passed on,
+// so they do not replace the last hint the prefetcher fetches pre-images for.
+func (t *executionTrace) Hint(hint string) error {
+	hintType, payload, _ := strings.Cut(hint, " ")
+	if hintType != interop.HintExecutionTrace {
+		return t.next(hint)
+	}
+	data, err := hexutil.Decode(payload)
+	if err != nil {
+		return fmt.Errorf("invalid execution trace hint: %w", err)
+	}
+	var event interop.TraceEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("invalid execution trace event: %w", err)
+	}
+	t.events = append(t.events, event)
+	return nil
+}
+
+func (t *executionTrace) write(path string) error {
+	return jsonutil.WriteJSON(t.events, ioutil.ToAtomicFile(path, 0o644))
+}
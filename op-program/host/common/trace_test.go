@@ -0,0 +1,32 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-program/client/interop"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionTrace(t *testing.T) {
+	var passed []string
+	trace := newExecutionTrace(func(hint string) error {
+		passed = append(passed, hint)
+		return nil
+	})
+	state := common.Hash{0xaa}
+	event := interop.TraceEvent{Type: interop.TraceStateHash, Step: 1, State: &state}
+
+	require.NoError(t, trace.Hint("l2-block-header 0x1234"))
+	require.NoError(t, trace.Hint(interop.ExecutionTraceHint(event).Hint()))
+	require.Equal(t, []string{"l2-block-header 0x1234"}, passed, "trace hints are not passed on")
+	require.ErrorContains(t, trace.Hint(interop.HintExecutionTrace+" 0xzz"), "invalid execution trace hint")
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	require.NoError(t, trace.write(path))
+	written, err := jsonutil.LoadJSON[[]interop.TraceEvent](path)
+	require.NoError(t, err)
+	require.Equal(t, []interop.TraceEvent{event}, *written)
+}
@@ -4,19 +4,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/host/flags"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/log"
@@ -41,8 +47,25 @@ var (
 	ErrInvalidL2ClaimBlock   = errors.New("invalid l2 claim block number")
 	ErrDataDirRequired       = errors.New("datadir must be specified when in non-fetching mode")
 	ErrNoExecInServerMode    = errors.New("exec command must not be set when in server mode")
+	ErrNoExecInOutputClaim   = errors.New("exec command must not be set when outputting the claim")
+	ErrOutputClaimServerMode = errors.New("claim cannot be output when in server mode")
+	ErrListenAddrNotServer   = errors.New("server listen address must only be set in server mode")
+	ErrListenAddrNotLoopback = errors.New("server listen address must be a loopback address when TLS is disabled")
+	ErrNoExecInDiffMode      = errors.New("exec command must not be set when comparing chain configs")
+	ErrDiffModeConflict      = errors.New("chain configs cannot be compared when in server or output-claim mode")
+	ErrDiffModeUnsupported   = errors.New("chain configs can only be compared for a single, non-custom chain without interop")
 	ErrInvalidDataFormat     = errors.New("invalid data format")
 	ErrMissingAgreedPrestate = errors.New("missing agreed prestate")
+	ErrMissingDependencySet  = errors.New("missing dependency set")
+	ErrWitnessWithDataDir    = errors.New("datadir must not be set when running from a witness archive")
+	ErrWitnessWithFetching   = errors.New("l1 and l2 options must not be set when running from a witness archive")
+	ErrNegativeOracleLatency = errors.New("oracle latency must not be negative")
+
+	ErrPrecompilesNotCustom = errors.New("accelerated precompiles can only be configured for custom chains")
+
+	ErrExecutionTraceNotInterop = errors.New("execution trace can only be recorded for interop")
+	ErrNegativeStepsPerByte     = errors.New("input report steps per byte must not be negative")
+	ErrMetricsNotInProcess      = errors.New("metrics can only be recorded when the client program runs in the host process")
 )
 
 type Config struct {
@@ -55,6 +78,11 @@ type Config struct {
 	// DataFormat specifies the format to use for on-disk storage. Only applies when DataDir is set.
 	DataFormat types.DataFormat
 
+	// WitnessDir is the directory of a witness archive, a pre-populated key-value store of all pre-images.
+	// If set, all pre-images are read from the archive, which is never modified: hints are ignored,
+	// nothing is fetched, and a pre-image that is missing from the archive fails the program.
+	WitnessDir string
+
 	// L1Head is the block hash of the L1 chain head block
 	L1Head      common.Hash
 	L1URL       string
@@ -89,11 +117,57 @@ type Config struct {
 	// ServerMode indicates that the program should run in pre-image server mode and wait for requests.
 	// No client program is run.
 	ServerMode bool
+	// ServerListenAddr is the TCP address to serve pre-images on in server mode.
+	// If unset, the pre-images are served on the file descriptors of the client program.
+	ServerListenAddr string
+	// ServerTLS is the mutual TLS config of the server listening on ServerListenAddr.
+	// If disabled, ServerListenAddr must be a loopback address, as connections are not authenticated.
+	ServerTLS optls.CLIConfig
+
+	// OutputClaim indicates that the claim is computed and output instead of validated against L2Claim.
+	// The client program must run in the same process.
+	OutputClaim bool
+
+	// DiffChainConfigsDir is the directory of the chain configs to compare the embedded chain configs with.
+	// If set, the client program runs with both and the first L2 block at which their outputs diverge is reported.
+	// The client program must run in the same process.
+	DiffChainConfigsDir string
 
 	// InteropEnabled enables interop fault proof rules when running the client in-process
 	InteropEnabled bool
 	// AgreedPrestate is the preimage of the agreed prestate claim. Required for interop.
 	AgreedPrestate []byte
+	// DependencySet is the interop dependency set of the L2 chains. Required for interop with custom chains,
+	// as it is part of their config bundle. Named chains use the dependency set embedded in the client program.
+	DependencySet *depset.StaticConfigDependencySet
+
+	// OracleLatency is the delay added to each pre-image served to the client program.
+	OracleLatency time.Duration
+	// OracleBandwidth limits the bytes per second sent over the preimage channel. Zero is unlimited.
+	// Together with OracleLatency, it simulates the host I/O of running the client program in a VM.
+	OracleBandwidth uint64
+
+	// AcceleratedPrecompiles are the precompiles executed with the precompile oracle.
+	// Named chains and interop always accelerate all precompiles, so may only differ from the default for custom chains.
+	AcceleratedPrecompiles engineapi.PrecompileFlags
+
+	// OracleCacheSize is the maximum approximate size in bytes of the data cached by the interop program.
+	// The default of the program is used if 0.
+	OracleCacheSize uint64
+
+	// ExecutionTracePath is the file the execution trace of the interop program is written to. Disabled if empty.
+	ExecutionTracePath string
+
+	// InputReportPath is the file the report of the pre-images served to the client program is written to,
+	// to track the proof input size. Disabled if empty.
+	InputReportPath string
+	// InputReportStepsPerByte is the number of cannon steps per pre-image byte, measured with a sampling run.
+	// The input report estimates the cannon steps and the onchain cost of a dispute with it. Not estimated if zero.
+	InputReportStepsPerByte float64
+
+	// MetricsConfig configures the metrics server that exports the metrics of the client program.
+	// Only supported when the client program runs in the host process.
+	MetricsConfig opmetrics.CLIConfig
 }
 
 func (c *Config) Check() error {
@@ -150,12 +224,69 @@ func (c *Config) Check() error {
 	if (c.L1URL != "") != (len(c.L2URLs) > 0) {
 		return ErrL1AndL2Inconsistent
 	}
-	if !c.FetchingEnabled() && c.DataDir == "" {
+	if c.WitnessDir != "" {
+		if c.DataDir != "" {
+			return ErrWitnessWithDataDir
+		}
+		if c.L1URL != "" || len(c.L2URLs) > 0 || c.L1BeaconURL != "" {
+			return ErrWitnessWithFetching
+		}
+	} else if !c.FetchingEnabled() && c.DataDir == "" {
 		return ErrDataDirRequired
 	}
 	if c.ServerMode && c.ExecCmd != "" {
 		return ErrNoExecInServerMode
 	}
+	if c.ServerListenAddr != "" && !c.ServerMode {
+		return ErrListenAddrNotServer
+	}
+	if c.ServerListenAddr != "" {
+		if c.ServerTLS.TLSEnabled() {
+			if err := c.ServerTLS.Check(); err != nil {
+				return fmt.Errorf("invalid server tls config: %w", err)
+			}
+		} else if !isLoopbackAddr(c.ServerListenAddr) {
+			return ErrListenAddrNotLoopback
+		}
+	}
+	if c.OutputClaim && c.ExecCmd != "" {
+		return ErrNoExecInOutputClaim
+	}
+	if c.OutputClaim && c.ServerMode {
+		return ErrOutputClaimServerMode
+	}
+	if c.DiffChainConfigsDir != "" {
+		if c.ExecCmd != "" {
+			return ErrNoExecInDiffMode
+		}
+		if c.ServerMode || c.OutputClaim {
+			return ErrDiffModeConflict
+		}
+		if c.InteropEnabled || c.L2ChainID == boot.CustomChainIDIndicator {
+			return ErrDiffModeUnsupported
+		}
+	}
+	if c.OracleLatency < 0 {
+		return ErrNegativeOracleLatency
+	}
+	if err := c.AcceleratedPrecompiles.Check(); err != nil {
+		return err
+	}
+	if c.AcceleratedPrecompiles != engineapi.AllPrecompiles && (c.InteropEnabled || c.L2ChainID != boot.CustomChainIDIndicator) {
+		return ErrPrecompilesNotCustom
+	}
+	if c.ExecutionTracePath != "" && !c.InteropEnabled {
+		return ErrExecutionTraceNotInterop
+	}
+	if c.InputReportStepsPerByte < 0 {
+		return ErrNegativeStepsPerByte
+	}
+	if err := c.MetricsConfig.Check(); err != nil {
+		return err
+	}
+	if c.MetricsConfig.Enabled && (c.ExecCmd != "" || c.ServerMode) {
+		return ErrMetricsNotInProcess
+	}
 	if c.DataDir != "" && !slices.Contains(types.SupportedDataFormats, c.DataFormat) {
 		return ErrInvalidDataFormat
 	}
@@ -167,9 +298,23 @@ func (c *Config) Check() error {
 			return fmt.Errorf("%w: must be preimage of L2 output root", ErrInvalidAgreedPrestate)
 		}
 	}
+	if c.UsesConfigBundle() && c.DependencySet == nil {
+		return ErrMissingDependencySet
+	}
 	return nil
 }
 
+// UsesConfigBundle returns whether the client loads the chain configs from the serialized config bundle.
+// Only interop runs of custom chains do.
+func (c *Config) UsesConfigBundle() bool {
+	return c.InteropEnabled && c.L2ChainID == boot.CustomChainIDIndicator
+}
+
+// ConfigBundle returns the serialized config bundle of the rollup and chain configs, and the dependency set.
+func (c *Config) ConfigBundle() ([]byte, error) {
+	return chainconfig.SerializeBundle(c.Rollups, c.L2ChainConfigs, c.DependencySet)
+}
+
 func (c *Config) FetchingEnabled() bool {
 	return c.L1URL != "" && len(c.L2URLs) > 0 && c.L1BeaconURL != ""
 }
@@ -221,6 +366,10 @@ func NewConfig(
 		L2ClaimBlockNumber: l2ClaimBlockNum,
 		L1RPCKind:          sources.RPCKindStandard,
 		DataFormat:         types.DataFormatDirectory,
+		ServerTLS:          optls.NewCLIConfig(),
+
+		AcceleratedPrecompiles: engineapi.AllPrecompiles,
+		MetricsConfig:          opmetrics.DefaultCLIConfig(),
 	}
 }
 
@@ -251,13 +400,16 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 	if l2OutputRoot == (common.Hash{}) {
 		return nil, ErrInvalidL2OutputRoot
 	}
-	strClaim := ctx.String(flags.L2Claim.Name)
-	l2Claim := common.HexToHash(strClaim)
-	// Require a valid hash, with the zero hash explicitly allowed.
-	if l2Claim == (common.Hash{}) &&
-		strClaim != "0x0000000000000000000000000000000000000000000000000000000000000000" &&
-		strClaim != "0000000000000000000000000000000000000000000000000000000000000000" {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidL2Claim, strClaim)
+	var l2Claim common.Hash
+	if ctx.IsSet(flags.L2Claim.Name) {
+		strClaim := ctx.String(flags.L2Claim.Name)
+		l2Claim = common.HexToHash(strClaim)
+		// Require a valid hash, with the zero hash explicitly allowed.
+		if l2Claim == (common.Hash{}) &&
+			strClaim != "0x0000000000000000000000000000000000000000000000000000000000000000" &&
+			strClaim != "0000000000000000000000000000000000000000000000000000000000000000" {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2Claim, strClaim)
+		}
 	}
 	l2ClaimBlockNum := ctx.Uint64(flags.L2BlockNumber.Name)
 	l1Head := common.HexToHash(ctx.String(flags.L1Head.Name))
@@ -320,30 +472,59 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 		l2ChainID = 0
 	}
 
+	var depSet *depset.StaticConfigDependencySet
+	if ctx.IsSet(flags.DependencySetConfig.Name) {
+		depSet, err = loadDependencySet(ctx.Path(flags.DependencySetConfig.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid dependency set: %w", err)
+		}
+	}
+
 	dbFormat := types.DataFormat(ctx.String(flags.DataFormat.Name))
 	if !slices.Contains(types.SupportedDataFormats, dbFormat) {
 		return nil, fmt.Errorf("invalid %w: %v", ErrInvalidDataFormat, dbFormat)
 	}
+	acceleratedPrecompiles, err := engineapi.PrecompileFlagsFromNames(ctx.StringSlice(flags.AcceleratedPrecompiles.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v: %w", flags.AcceleratedPrecompiles.Name, err)
+	}
 	return &Config{
-		L2ChainID:          l2ChainID,
-		Rollups:            rollupCfgs,
-		DataDir:            ctx.String(flags.DataDir.Name),
-		DataFormat:         dbFormat,
-		L2URLs:             ctx.StringSlice(flags.L2NodeAddr.Name),
-		L2ExperimentalURLs: ctx.StringSlice(flags.L2NodeExperimentalAddr.Name),
-		L2ChainConfigs:     l2ChainConfigs,
-		L2Head:             l2Head,
-		L2OutputRoot:       l2OutputRoot,
-		AgreedPrestate:     agreedPrestate,
-		L2Claim:            l2Claim,
-		L2ClaimBlockNumber: l2ClaimBlockNum,
-		L1Head:             l1Head,
-		L1URL:              ctx.String(flags.L1NodeAddr.Name),
-		L1BeaconURL:        ctx.String(flags.L1BeaconAddr.Name),
-		L1TrustRPC:         ctx.Bool(flags.L1TrustRPC.Name),
-		L1RPCKind:          sources.RPCProviderKind(ctx.String(flags.L1RPCProviderKind.Name)),
-		ExecCmd:            ctx.String(flags.Exec.Name),
-		ServerMode:         ctx.Bool(flags.Server.Name),
+		L2ChainID:           l2ChainID,
+		Rollups:             rollupCfgs,
+		DataDir:             ctx.String(flags.DataDir.Name),
+		DataFormat:          dbFormat,
+		WitnessDir:          ctx.String(flags.WitnessDir.Name),
+		L2URLs:              ctx.StringSlice(flags.L2NodeAddr.Name),
+		L2ExperimentalURLs:  ctx.StringSlice(flags.L2NodeExperimentalAddr.Name),
+		L2ChainConfigs:      l2ChainConfigs,
+		L2Head:              l2Head,
+		L2OutputRoot:        l2OutputRoot,
+		AgreedPrestate:      agreedPrestate,
+		DependencySet:       depSet,
+		L2Claim:             l2Claim,
+		L2ClaimBlockNumber:  l2ClaimBlockNum,
+		L1Head:              l1Head,
+		L1URL:               ctx.String(flags.L1NodeAddr.Name),
+		L1BeaconURL:         ctx.String(flags.L1BeaconAddr.Name),
+		L1TrustRPC:          ctx.Bool(flags.L1TrustRPC.Name),
+		L1RPCKind:           sources.RPCProviderKind(ctx.String(flags.L1RPCProviderKind.Name)),
+		ExecCmd:             ctx.String(flags.Exec.Name),
+		ServerMode:          ctx.Bool(flags.Server.Name),
+		ServerListenAddr:    ctx.String(flags.ServerListenAddr.Name),
+		ServerTLS:           optls.ReadCLIConfigWithPrefix(ctx, flags.ServerTLSFlagPrefix),
+		OutputClaim:         ctx.Bool(flags.OutputClaim.Name),
+		DiffChainConfigsDir: ctx.String(flags.DiffChainConfigs.Name),
+		OracleLatency:       ctx.Duration(flags.OracleLatency.Name),
+		OracleBandwidth:     ctx.Uint64(flags.OracleBandwidth.Name),
+
+		AcceleratedPrecompiles: acceleratedPrecompiles,
+		OracleCacheSize:        ctx.Uint64(flags.OracleCacheSize.Name),
+		ExecutionTracePath:     ctx.Path(flags.ExecutionTrace.Name),
+
+		InputReportPath:         ctx.Path(flags.InputReport.Name),
+		InputReportStepsPerByte: ctx.Float64(flags.InputReportStepsPerByte.Name),
+
+		MetricsConfig: opmetrics.ReadCLIConfig(ctx),
 	}, nil
 }
 
@@ -370,3 +551,28 @@ func loadRollupConfig(rollupConfigPath string) (*rollup.Config, error) {
 	var rollupConfig rollup.Config
 	return &rollupConfig, rollupConfig.ParseRollupConfig(file)
 }
+
+func loadDependencySet(path string) (*depset.StaticConfigDependencySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependency set: %w", err)
+	}
+	var depSet depset.StaticConfigDependencySet
+	if err := json.Unmarshal(data, &depSet); err != nil {
+		return nil, fmt.Errorf("failed to parse dependency set: %w", err)
+	}
+	return &depSet, nil
+}
+
+// isLoopbackAddr returns true if the host of the TCP address is a loopback address or localhost.
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
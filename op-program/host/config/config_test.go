@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
@@ -210,6 +214,35 @@ func TestRequireDataDirInNonFetchingMode(t *testing.T) {
 	require.ErrorIs(t, err, ErrDataDirRequired)
 }
 
+func TestWitnessDir(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DataDir = ""
+		cfg.WitnessDir = "/tmp/witness"
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectDataDir", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.WitnessDir = "/tmp/witness"
+		require.ErrorIs(t, cfg.Check(), ErrWitnessWithDataDir)
+	})
+	t.Run("RejectFetching", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DataDir = ""
+		cfg.WitnessDir = "/tmp/witness"
+		cfg.L1URL = "https://example.com:1234"
+		cfg.L1BeaconURL = "https://example.com:5678"
+		cfg.L2URLs = []string{"https://example.com:91011"}
+		require.ErrorIs(t, cfg.Check(), ErrWitnessWithFetching)
+	})
+}
+
+func TestRejectNegativeOracleLatency(t *testing.T) {
+	cfg := validConfig()
+	cfg.OracleLatency = -time.Millisecond
+	require.ErrorIs(t, cfg.Check(), ErrNegativeOracleLatency)
+}
+
 func TestRejectExecAndServerMode(t *testing.T) {
 	cfg := validConfig()
 	cfg.ServerMode = true
@@ -218,6 +251,204 @@ func TestRejectExecAndServerMode(t *testing.T) {
 	require.ErrorIs(t, err, ErrNoExecInServerMode)
 }
 
+func TestServerListenAddr(t *testing.T) {
+	t.Run("RequiresServerMode", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.ServerListenAddr = "127.0.0.1:7300"
+		require.ErrorIs(t, cfg.Check(), ErrListenAddrNotServer)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.ServerMode = true
+		cfg.ServerListenAddr = "127.0.0.1:7300"
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RequiresTLSFiles", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.ServerMode = true
+		cfg.ServerListenAddr = "0.0.0.0:7300"
+		cfg.ServerTLS.TLSCert = ""
+		require.ErrorContains(t, cfg.Check(), "tls")
+	})
+	t.Run("LoopbackWithoutTLS", func(t *testing.T) {
+		for _, addr := range []string{"127.0.0.1:7300", "[::1]:7300", "localhost:7300"} {
+			cfg := validConfig()
+			cfg.ServerMode = true
+			cfg.ServerListenAddr = addr
+			cfg.ServerTLS.Enabled = false
+			require.NoError(t, cfg.Check(), addr)
+		}
+	})
+	t.Run("RejectNonLoopbackWithoutTLS", func(t *testing.T) {
+		for _, addr := range []string{"0.0.0.0:7300", ":7300", "10.0.0.1:7300", "example.com:7300"} {
+			cfg := validConfig()
+			cfg.ServerMode = true
+			cfg.ServerListenAddr = addr
+			cfg.ServerTLS.Enabled = false
+			require.ErrorIs(t, cfg.Check(), ErrListenAddrNotLoopback, addr)
+		}
+	})
+}
+
+func TestOutputClaim(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.OutputClaim = true
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectExec", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.OutputClaim = true
+		cfg.ExecCmd = "echo"
+		require.ErrorIs(t, cfg.Check(), ErrNoExecInOutputClaim)
+	})
+	t.Run("RejectServerMode", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.OutputClaim = true
+		cfg.ServerMode = true
+		require.ErrorIs(t, cfg.Check(), ErrOutputClaimServerMode)
+	})
+}
+
+func TestDiffChainConfigs(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DiffChainConfigsDir = "configs"
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectExec", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DiffChainConfigsDir = "configs"
+		cfg.ExecCmd = "echo"
+		require.ErrorIs(t, cfg.Check(), ErrNoExecInDiffMode)
+	})
+	t.Run("RejectServerMode", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DiffChainConfigsDir = "configs"
+		cfg.ServerMode = true
+		require.ErrorIs(t, cfg.Check(), ErrDiffModeConflict)
+	})
+	t.Run("RejectOutputClaim", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DiffChainConfigsDir = "configs"
+		cfg.OutputClaim = true
+		require.ErrorIs(t, cfg.Check(), ErrDiffModeConflict)
+	})
+	t.Run("RejectCustomChain", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DiffChainConfigsDir = "configs"
+		cfg.L2ChainID = boot.CustomChainIDIndicator
+		require.ErrorIs(t, cfg.Check(), ErrDiffModeUnsupported)
+	})
+}
+
+func TestAcceleratedPrecompiles(t *testing.T) {
+	t.Run("DefaultAll", func(t *testing.T) {
+		cfg := validConfig()
+		require.Equal(t, engineapi.AllPrecompiles, cfg.AcceleratedPrecompiles)
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectNamedChain", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.AcceleratedPrecompiles = engineapi.EcrecoverFlag
+		require.ErrorIs(t, cfg.Check(), ErrPrecompilesNotCustom)
+	})
+	t.Run("CustomChain", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.L2ChainID = boot.CustomChainIDIndicator
+		cfg.AcceleratedPrecompiles = engineapi.EcrecoverFlag
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectInterop", func(t *testing.T) {
+		cfg := validInteropConfig()
+		cfg.AcceleratedPrecompiles = 0
+		require.ErrorIs(t, cfg.Check(), ErrPrecompilesNotCustom)
+	})
+	t.Run("RejectUnknown", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.L2ChainID = boot.CustomChainIDIndicator
+		cfg.AcceleratedPrecompiles = engineapi.AllPrecompiles << 1
+		require.ErrorIs(t, cfg.Check(), engineapi.ErrUnknownPrecompileFlags)
+	})
+}
+
+func TestExecutionTrace(t *testing.T) {
+	t.Run("Interop", func(t *testing.T) {
+		cfg := validInteropConfig()
+		cfg.ExecutionTracePath = "trace.json"
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectPreInterop", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.ExecutionTracePath = "trace.json"
+		require.ErrorIs(t, cfg.Check(), ErrExecutionTraceNotInterop)
+	})
+}
+
+func TestDependencySet(t *testing.T) {
+	t.Run("RequiredWithCustomInterop", func(t *testing.T) {
+		cfg := validInteropConfig()
+		cfg.L2ChainID = boot.CustomChainIDIndicator
+		require.ErrorIs(t, cfg.Check(), ErrMissingDependencySet)
+	})
+	t.Run("NotRequiredWithNamedInterop", func(t *testing.T) {
+		cfg := validInteropConfig()
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("InConfigBundle", func(t *testing.T) {
+		cfg := validInteropConfig()
+		cfg.L2ChainID = boot.CustomChainIDIndicator
+		depSet, err := depset.NewStaticConfigDependencySet(map[eth.ChainID]*depset.StaticConfigDependency{
+			eth.ChainIDFromBig(validRollupConfig.L2ChainID): {ChainIndex: 1},
+		})
+		require.NoError(t, err)
+		cfg.DependencySet = depSet
+		require.NoError(t, cfg.Check())
+		data, err := cfg.ConfigBundle()
+		require.NoError(t, err)
+		bundle, err := chainconfig.DeserializeBundle(data)
+		require.NoError(t, err)
+		actual, err := bundle.DependencySet()
+		require.NoError(t, err)
+		require.Equal(t, depSet, actual)
+	})
+}
+
+func TestInputReport(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.InputReportPath = "report.json"
+		cfg.InputReportStepsPerByte = 25.5
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectNegativeStepsPerByte", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.InputReportPath = "report.json"
+		cfg.InputReportStepsPerByte = -1
+		require.ErrorIs(t, cfg.Check(), ErrNegativeStepsPerByte)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("InProcess", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.MetricsConfig.Enabled = true
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("RejectExec", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.MetricsConfig.Enabled = true
+		cfg.ExecCmd = "echo"
+		require.ErrorIs(t, cfg.Check(), ErrMetricsNotInProcess)
+	})
+	t.Run("RejectServerMode", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.MetricsConfig.Enabled = true
+		cfg.ServerMode = true
+		require.ErrorIs(t, cfg.Check(), ErrMetricsNotInProcess)
+	})
+}
+
 func TestCustomL2ChainID(t *testing.T) {
 	t.Run("nonCustom", func(t *testing.T) {
 		cfg := validConfig()
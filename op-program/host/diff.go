@@ -0,0 +1,139 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	hostcommon "github.com/ethereum-optimism/optimism/op-program/host/common"
+	"github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DiffOutput is the result of comparing the outputs of the client program with the embedded chain configs
+// and with the alternate chain configs, for the same L1 head and agreed L2 output.
+type DiffOutput struct {
+	L1Head        common.Hash `json:"l1Head"`
+	L2BlockNumber uint64      `json:"l2BlockNumber"`
+	Diverged      bool        `json:"diverged"`
+	// DivergentBlockNumber is the first L2 block at which the outputs diverge. Only set if Diverged.
+	DivergentBlockNumber uint64 `json:"divergentBlockNumber,omitempty"`
+	// Embedded and Alternate are the results at DivergentBlockNumber if Diverged, or L2BlockNumber otherwise.
+	Embedded  DiffResult `json:"embedded"`
+	Alternate DiffResult `json:"alternate"`
+}
+
+// DiffResult is the result of a single run of the client program.
+type DiffResult struct {
+	Claim eth.Bytes32 `json:"claim"`
+	Error string      `json:"error,omitempty"`
+}
+
+// diffRunner runs the client program with the given chain configs, and returns the claim for the L2 block.
+type diffRunner func(ctx context.Context, configs boot.ConfigSource, blockNum uint64) (eth.Bytes32, error)
+
+// diffChainConfigs runs the client program with both the embedded chain configs and the chain configs of
+// cfg.DiffChainConfigsDir, and writes the first L2 block at which their outputs diverge to out as JSON.
+// This validates that a change of the embedded chain configs, and so of the absolute prestate, does not change
+// the outcome of derivation before the new prestate is published.
+func diffChainConfigs(ctx context.Context, logger log.Logger, cfg *config.Config, out io.Writer) error {
+	if cfg.DataDir == "" {
+		logger.Warn("No data directory set, pre-images are fetched again for every run of the client program")
+	}
+	run := func(ctx context.Context, configs boot.ConfigSource, blockNum uint64) (eth.Bytes32, error) {
+		runCfg := *cfg
+		runCfg.L2ClaimBlockNumber = blockNum
+		var claim eth.Bytes32
+		err := FaultProofProgramWithDefaultPrefecher(ctx, logger, &runCfg,
+			hostcommon.WithSkipValidation(true),
+			hostcommon.WithChainConfigs(configs),
+			hostcommon.WithClaimOutput(func(c eth.Bytes32) { claim = c }))
+		return claim, err
+	}
+	result, err := findDivergence(ctx, logger, cfg.L2ClaimBlockNumber,
+		chainconfig.EmbeddedBundle(), chainconfig.NewDirBundle(cfg.DiffChainConfigsDir), run)
+	if err != nil {
+		return err
+	}
+	result.L1Head = cfg.L1Head
+	if result.Diverged {
+		logger.Warn("Outputs diverge", "l2BlockNumber", result.DivergentBlockNumber,
+			"embedded", result.Embedded.Claim, "alternate", result.Alternate.Claim)
+	} else {
+		logger.Info("Outputs match", "l2BlockNumber", result.L2BlockNumber, "claim", result.Embedded.Claim)
+	}
+	return json.NewEncoder(out).Encode(result)
+}
+
+// findDivergence finds the first L2 block up to claimBlockNum at which the outputs of the client program with
+// the embedded and the alternate chain configs diverge. Once diverged, the outputs of all later blocks diverge as
+// well, since every block commits to its parent. The outputs of blocks up to the agreed L2 head are read from
+// the agreed chain and always match, so the search steps back from the claim block with exponentially increasing
+// steps until the outputs match, and then bisects. Failing runs of the client program are compared by their error.
+func findDivergence(ctx context.Context, logger log.Logger, claimBlockNum uint64, embedded boot.ConfigSource, alternate boot.ConfigSource, run diffRunner) (*DiffOutput, error) {
+	compare := func(blockNum uint64) (DiffResult, DiffResult, error) {
+		var results [2]DiffResult
+		for i, configs := range []boot.ConfigSource{embedded, alternate} {
+			claim, err := run(ctx, configs, blockNum)
+			if ctx.Err() != nil {
+				return DiffResult{}, DiffResult{}, ctx.Err()
+			}
+			results[i].Claim = claim
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}
+		logger.Debug("Compared outputs", "l2BlockNumber", blockNum, "embedded", results[0], "alternate", results[1])
+		return results[0], results[1], nil
+	}
+
+	embeddedResult, alternateResult, err := compare(claimBlockNum)
+	if err != nil {
+		return nil, err
+	}
+	output := &DiffOutput{
+		L2BlockNumber: claimBlockNum,
+		Embedded:      embeddedResult,
+		Alternate:     alternateResult,
+	}
+	if embeddedResult == alternateResult {
+		return output, nil
+	}
+	output.Diverged = true
+
+	// The outputs diverge at hi, and match at lo. The genesis block is never derived, so always matches.
+	hi := claimBlockNum
+	var lo uint64
+	diverges := func(blockNum uint64) (bool, error) {
+		embeddedResult, alternateResult, err := compare(blockNum)
+		if err != nil {
+			return false, err
+		}
+		if embeddedResult == alternateResult {
+			lo = blockNum
+			return false, nil
+		}
+		hi = blockNum
+		output.Embedded = embeddedResult
+		output.Alternate = alternateResult
+		return true, nil
+	}
+	for step := uint64(1); step < hi; step *= 2 {
+		if diverged, err := diverges(hi - step); err != nil {
+			return nil, err
+		} else if !diverged {
+			break
+		}
+	}
+	for hi-lo > 1 {
+		if _, err := diverges(lo + (hi-lo)/2); err != nil {
+			return nil, err
+		}
+	}
+	output.DivergentBlockNumber = hi
+	return output, nil
+}
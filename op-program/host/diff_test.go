@@ -0,0 +1,86 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDivergence(t *testing.T) {
+	embedded := chainconfig.EmbeddedBundle()
+	alternate := chainconfig.NewDirBundle("alternate")
+	claim := func(blockNum uint64, alt bool) eth.Bytes32 {
+		c := eth.Bytes32{byte(blockNum), byte(blockNum >> 8)}
+		if alt {
+			c[31] = 1
+		}
+		return c
+	}
+	// runnerDivergingAt returns a runner for which the outputs diverge from the given block, and the blocks it ran.
+	runnerDivergingAt := func(divergentBlock uint64, altErr error) (diffRunner, *[]uint64) {
+		var runs []uint64
+		return func(_ context.Context, configs boot.ConfigSource, blockNum uint64) (eth.Bytes32, error) {
+			alt := configs == alternate
+			if alt {
+				runs = append(runs, blockNum)
+			}
+			if alt && blockNum >= divergentBlock && altErr != nil {
+				return eth.Bytes32{}, altErr
+			}
+			return claim(blockNum, alt && blockNum >= divergentBlock), nil
+		}, &runs
+	}
+
+	t.Run("NoDivergence", func(t *testing.T) {
+		run, runs := runnerDivergingAt(1001, nil)
+		result, err := findDivergence(context.Background(), testlog.Logger(t, log.LevelInfo), 1000, embedded, alternate, run)
+		require.NoError(t, err)
+		require.Equal(t, &DiffOutput{
+			L2BlockNumber: 1000,
+			Embedded:      DiffResult{Claim: claim(1000, false)},
+			Alternate:     DiffResult{Claim: claim(1000, false)},
+		}, result)
+		require.Equal(t, []uint64{1000}, *runs)
+	})
+
+	for _, divergentBlock := range []uint64{1, 2, 500, 937, 999, 1000} {
+		t.Run("Diverged", func(t *testing.T) {
+			run, runs := runnerDivergingAt(divergentBlock, nil)
+			result, err := findDivergence(context.Background(), testlog.Logger(t, log.LevelInfo), 1000, embedded, alternate, run)
+			require.NoError(t, err)
+			require.Equal(t, &DiffOutput{
+				L2BlockNumber:        1000,
+				Diverged:             true,
+				DivergentBlockNumber: divergentBlock,
+				Embedded:             DiffResult{Claim: claim(divergentBlock, false)},
+				Alternate:            DiffResult{Claim: claim(divergentBlock, true)},
+			}, result)
+			require.LessOrEqual(t, len(*runs), 21, "should search logarithmically")
+		})
+	}
+
+	t.Run("DivergedWithError", func(t *testing.T) {
+		run, _ := runnerDivergingAt(600, errors.New("boom"))
+		result, err := findDivergence(context.Background(), testlog.Logger(t, log.LevelInfo), 1000, embedded, alternate, run)
+		require.NoError(t, err)
+		require.True(t, result.Diverged)
+		require.Equal(t, uint64(600), result.DivergentBlockNumber)
+		require.Equal(t, DiffResult{Claim: claim(600, false)}, result.Embedded)
+		require.Equal(t, DiffResult{Error: "boom"}, result.Alternate)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		run, _ := runnerDivergingAt(600, nil)
+		_, err := findDivergence(ctx, testlog.Logger(t, log.LevelInfo), 1000, embedded, alternate, run)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
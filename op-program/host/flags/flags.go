@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/urfave/cli/v2"
 
@@ -11,11 +12,19 @@ import (
 	service "github.com/ethereum-optimism/optimism/op-service"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 )
 
 const EnvVarPrefix = "OP_PROGRAM"
 
+// The server.tls flags configure the mutual TLS of the pre-image server listening on ServerListenAddr.
+const (
+	ServerTLSFlagPrefix   = "server"
+	ServerTLSEnvVarPrefix = EnvVarPrefix + "_SERVER"
+)
+
 func prefixEnvVars(name string) []string {
 	return service.PrefixEnvVar(EnvVarPrefix, name)
 }
@@ -50,6 +59,13 @@ var (
 		EnvVars: prefixEnvVars("DATA_FORMAT"),
 		Value:   string(types.DataFormatDirectory),
 	}
+	WitnessDir = &cli.StringFlag{
+		Name: "witness",
+		Usage: "Directory of a witness archive, a pre-populated preimage data storage, to read all preimages from. " +
+			"The archive is not modified, hints are ignored and a missing preimage fails the program. " +
+			"Must not be used with datadir or the L1 and L2 options",
+		EnvVars: prefixEnvVars("WITNESS"),
+	}
 	L2NodeAddr = &cli.StringSliceFlag{
 		Name:    "l2",
 		Usage:   "Address of L2 JSON-RPC endpoint to use (eth and debug namespace required)",
@@ -96,6 +112,13 @@ var (
 		Usage:   "Path to the op-geth genesis file",
 		EnvVars: prefixEnvVars("L2_GENESIS"),
 	}
+	DependencySetConfig = &cli.PathFlag{
+		Name: "depset.config",
+		Usage: "Path to the interop dependency set of the L2 chains, in the format of the op-supervisor dependency set config. " +
+			"Required for interop with custom chains.",
+		EnvVars:   prefixEnvVars("DEPSET_CONFIG"),
+		TakesFile: true,
+	}
 	L1NodeAddr = &cli.StringFlag{
 		Name:    "l1",
 		Usage:   "Address of L1 JSON-RPC endpoint to use (eth namespace required)",
@@ -131,6 +154,77 @@ var (
 		Usage:   "Run in pre-image server mode without executing any client program.",
 		EnvVars: prefixEnvVars("SERVER"),
 	}
+	ServerListenAddr = &cli.StringFlag{
+		Name: "server.listen-addr",
+		Usage: "TCP address to serve pre-images on in server mode, e.g. 0.0.0.0:7300, instead of the file descriptors of the client program. " +
+			"The client program connects with the remote-client command, so it can run on a different machine than the host. " +
+			"Clients must authenticate with mutual TLS, configured with the server.tls flags. " +
+			"If TLS is disabled, the address must be a loopback address.",
+		EnvVars: prefixEnvVars("SERVER_LISTEN_ADDR"),
+	}
+	OutputClaim = &cli.BoolFlag{
+		Name: "output-claim",
+		Usage: "Compute the claim for the L1 head and L2 block number instead of validating it, and write it as JSON to stdout. " +
+			"Logs are written to stderr instead. " +
+			fmt.Sprintf("The %v flag is not required. The client program must run in the host process.", L2Claim.Name),
+		EnvVars: prefixEnvVars("OUTPUT_CLAIM"),
+	}
+	DiffChainConfigs = &cli.StringFlag{
+		Name: "diff-chain-configs",
+		Usage: "Directory with a configs subdirectory of chain configs, in the layout of the configs embedded in the program. " +
+			"Runs the client program with both the embedded and these chain configs, and writes the first L2 block at which " +
+			"their outputs diverge as JSON to stdout. Logs are written to stderr instead. " +
+			fmt.Sprintf("The %v flag is not required. The client program must run in the host process.", L2Claim.Name),
+		EnvVars:   prefixEnvVars("DIFF_CHAIN_CONFIGS"),
+		TakesFile: true,
+	}
+	OracleLatency = &cli.DurationFlag{
+		Name: "oracle.latency",
+		Usage: "Latency to add to each pre-image served to the client program, to simulate the host I/O of a VM. " +
+			"Simulated preimage channel usage is logged when the program completes.",
+		EnvVars: prefixEnvVars("ORACLE_LATENCY"),
+	}
+	OracleBandwidth = &cli.Uint64Flag{
+		Name: "oracle.bandwidth",
+		Usage: "Bytes per second to limit the preimage channel to, to simulate the host I/O of a VM. 0 is unlimited. " +
+			"Simulated preimage channel usage is logged when the program completes.",
+		EnvVars: prefixEnvVars("ORACLE_BANDWIDTH"),
+	}
+	AcceleratedPrecompiles = &cli.StringSliceFlag{
+		Name: "accelerated-precompiles",
+		Usage: "Precompiles to execute with the precompile oracle. Named chains and interop always accelerate all precompiles. " +
+			fmt.Sprintf("Only applies to custom chains. Options: %v", strings.Join(engineapi.AllPrecompiles.Names(), ", ")),
+		EnvVars: prefixEnvVars("ACCELERATED_PRECOMPILES"),
+		Value:   cli.NewStringSlice(engineapi.AllPrecompiles.Names()...),
+	}
+	OracleCacheSize = &cli.Uint64Flag{
+		Name: "oracle-cache-size",
+		Usage: "Maximum approximate size in bytes of the pre-images and decoded L1 and L2 data cached by the interop program. " +
+			"0 for the default of the program. Only applies to interop.",
+		EnvVars: prefixEnvVars("ORACLE_CACHE_SIZE"),
+	}
+	ExecutionTrace = &cli.PathFlag{
+		Name: "execution-trace",
+		Usage: "Path to write the execution trace of the interop program to, as JSON. The trace lists every oracle request, " +
+			"derived block and state hash after each step, to compare the execution of a disputed transition with another trace. " +
+			"Only applies to interop.",
+		EnvVars:   prefixEnvVars("EXECUTION_TRACE"),
+		TakesFile: true,
+	}
+	InputReport = &cli.PathFlag{
+		Name: "input-report",
+		Usage: "Path to write the proof input size report to, as JSON. The report lists the requests and bytes of the pre-images " +
+			"served to the client program by type, to track proof cost regressions.",
+		EnvVars:   prefixEnvVars("INPUT_REPORT"),
+		TakesFile: true,
+	}
+	InputReportStepsPerByte = &cli.Float64Flag{
+		Name: "input-report.steps-per-byte",
+		Usage: "Cannon steps per pre-image byte, measured with a sampling run: the total steps of the cannon debug info " +
+			"divided by the total bytes of the input report of the same run. " +
+			"If set, the input report includes the estimated cannon steps and the projected onchain cost of a dispute.",
+		EnvVars: prefixEnvVars("INPUT_REPORT_STEPS_PER_BYTE"),
+	}
 )
 
 // Flags contains the list of configuration options available to the binary.
@@ -138,11 +232,11 @@ var Flags []cli.Flag
 
 var requiredFlags = []cli.Flag{
 	L1Head,
-	L2Claim,
 	L2BlockNumber,
 }
 
 var programFlags = []cli.Flag{
+	L2Claim,
 	L2Head,
 	L2OutputRoot,
 	L2AgreedPrestate,
@@ -151,21 +245,35 @@ var programFlags = []cli.Flag{
 	Network,
 	DataDir,
 	DataFormat,
+	WitnessDir,
 	L2NodeAddr,
 	L2NodeExperimentalAddr,
 	L2GenesisPath,
+	DependencySetConfig,
 	L1NodeAddr,
 	L1BeaconAddr,
 	L1TrustRPC,
 	L1RPCProviderKind,
 	Exec,
 	Server,
+	ServerListenAddr,
+	OutputClaim,
+	DiffChainConfigs,
+	OracleLatency,
+	OracleBandwidth,
+	AcceleratedPrecompiles,
+	OracleCacheSize,
+	ExecutionTrace,
+	InputReport,
+	InputReportStepsPerByte,
 }
 
 func init() {
 	Flags = append(Flags, oplog.CLIFlags(EnvVarPrefix)...)
 	Flags = append(Flags, requiredFlags...)
 	Flags = append(Flags, programFlags...)
+	Flags = append(Flags, opmetrics.CLIFlags(EnvVarPrefix)...)
+	Flags = append(Flags, optls.CLIFlagsWithFlagPrefix(ServerTLSEnvVarPrefix, ServerTLSFlagPrefix, "")...)
 }
 
 func CheckRequired(ctx *cli.Context) error {
@@ -177,6 +285,17 @@ func CheckRequired(ctx *cli.Context) error {
 			return fmt.Errorf("flag %s is required", flag.Names()[0])
 		}
 	}
+	if ctx.Bool(OutputClaim.Name) {
+		if ctx.IsSet(L2Claim.Name) {
+			return fmt.Errorf("flag %s must not be specified with %s", L2Claim.Name, OutputClaim.Name)
+		}
+	} else if ctx.IsSet(DiffChainConfigs.Name) {
+		if ctx.IsSet(L2Claim.Name) {
+			return fmt.Errorf("flag %s must not be specified with %s", L2Claim.Name, DiffChainConfigs.Name)
+		}
+	} else if !ctx.IsSet(L2Claim.Name) {
+		return fmt.Errorf("flag %s is required", L2Claim.Name)
+	}
 	if !ctx.IsSet(L2OutputRoot.Name) && !ctx.IsSet(L2AgreedPrestate.Name) {
 		return fmt.Errorf("flag %s or %s is required", L2OutputRoot.Name, L2AgreedPrestate.Name)
 	}
@@ -2,19 +2,28 @@ package host
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/metrics"
 	hostcommon "github.com/ethereum-optimism/optimism/op-program/host/common"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
 	"github.com/ethereum-optimism/optimism/op-program/host/flags"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
 	"github.com/ethereum-optimism/optimism/op-program/host/prefetcher"
+	"github.com/ethereum-optimism/optimism/op-program/host/remote"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/ctxinterrupt"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -49,19 +58,83 @@ func Main(logger log.Logger, cfg *config.Config) error {
 	hostCtx, stop := ctxinterrupt.WithSignalWaiter(context.Background())
 	defer stop()
 	ctx := ctxinterrupt.WithCancelOnInterrupt(hostCtx)
+	if cfg.ServerMode && cfg.ServerListenAddr != "" {
+		var tlsConfig *tls.Config
+		if cfg.ServerTLS.TLSEnabled() {
+			serverTLS, err := optls.NewServerConfig(logger, cfg.ServerTLS)
+			if err != nil {
+				return fmt.Errorf("failed to load server tls config: %w", err)
+			}
+			defer serverTLS.Stop()
+			tlsConfig = serverTLS.Config
+		}
+		return remote.ListenAndServe(ctx, logger, cfg.ServerListenAddr, tlsConfig, func(ctx context.Context, preimages io.ReadWriteCloser, hints io.ReadWriteCloser) error {
+			return hostcommon.PreimageServer(ctx, logger, cfg, preimages, hints, makeDefaultPrefetcher)
+		})
+	}
 	if cfg.ServerMode {
 		preimageChan := preimage.ClientPreimageChannel()
 		hinterChan := preimage.ClientHinterChannel()
 		return hostcommon.PreimageServer(ctx, logger, cfg, preimageChan, hinterChan, makeDefaultPrefetcher)
 	}
 
-	if err := FaultProofProgramWithDefaultPrefecher(ctx, logger, cfg); err != nil {
+	if cfg.DiffChainConfigsDir != "" {
+		return diffChainConfigs(ctx, logger, cfg, os.Stdout)
+	}
+
+	var opts []hostcommon.ProgramOpt
+	if cfg.MetricsConfig.Enabled {
+		m := metrics.NewMetrics()
+		metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
+		if err != nil {
+			return fmt.Errorf("failed to start metrics server: %w", err)
+		}
+		logger.Info("Started metrics server", "addr", metricsSrv.Addr())
+		defer func() {
+			if err := metricsSrv.Stop(context.Background()); err != nil {
+				logger.Error("Failed to stop metrics server", "err", err)
+			}
+		}()
+		opts = append(opts, hostcommon.WithClientMetrics(m))
+	}
+
+	if cfg.OutputClaim {
+		return outputClaim(ctx, logger, cfg, os.Stdout, opts...)
+	}
+
+	if err := FaultProofProgramWithDefaultPrefecher(ctx, logger, cfg, opts...); err != nil {
 		return err
 	}
 	log.Info("Claim successfully verified")
 	return nil
 }
 
+// ClaimOutput is the claim computed in output-claim mode.
+type ClaimOutput struct {
+	L1Head        common.Hash `json:"l1Head"`
+	L2BlockNumber uint64      `json:"l2BlockNumber"`
+	Claim         eth.Bytes32 `json:"claim"`
+}
+
+// outputClaim runs the client program without validating the claim,
+// and writes the claim computed for the L1 head and L2 block number to out as JSON.
+func outputClaim(ctx context.Context, logger log.Logger, cfg *config.Config, out io.Writer, opts ...hostcommon.ProgramOpt) error {
+	var claim eth.Bytes32
+	opts = append(opts,
+		hostcommon.WithSkipValidation(true),
+		hostcommon.WithClaimOutput(func(c eth.Bytes32) { claim = c }))
+	err := FaultProofProgramWithDefaultPrefecher(ctx, logger, cfg, opts...)
+	if err != nil {
+		return err
+	}
+	logger.Info("Computed claim", "l1Head", cfg.L1Head, "l2BlockNumber", cfg.L2ClaimBlockNumber, "claim", claim)
+	return json.NewEncoder(out).Encode(&ClaimOutput{
+		L1Head:        cfg.L1Head,
+		L2BlockNumber: cfg.L2ClaimBlockNumber,
+		Claim:         claim,
+	})
+}
+
 // FaultProofProgramWithDefaultPrefecher is the programmatic entry-point for the fault proof program
 func FaultProofProgramWithDefaultPrefecher(ctx context.Context, logger log.Logger, cfg *config.Config, opts ...hostcommon.ProgramOpt) error {
 	var newopts []hostcommon.ProgramOpt
@@ -97,8 +170,16 @@ func makeDefaultPrefetcher(ctx context.Context, logger log.Logger, kv kvstore.KV
 		return nil, fmt.Errorf("failed to create L2 sources: %w", err)
 	}
 
+	var configBundle []byte
+	if cfg.UsesConfigBundle() {
+		configBundle, err = cfg.ConfigBundle()
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize config bundle: %w", err)
+		}
+	}
+
 	executor := MakeProgramExecutor(logger, cfg)
-	return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, cfg.Rollups[0].L2ChainID.Uint64(), sources, kv, executor, cfg.L2Head, cfg.AgreedPrestate), nil
+	return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, cfg.Rollups[0].L2ChainID.Uint64(), sources, kv, executor, cfg.L2Head, cfg.AgreedPrestate, configBundle), nil
 }
 
 type programExecutor struct {
@@ -115,6 +196,8 @@ func (p *programExecutor) RunProgram(
 	newCfg := *p.cfg
 	newCfg.L2ChainID = chainID
 	newCfg.L2ClaimBlockNumber = blockNum
+	// The trace is of the program the host runs, not of the programs it runs to fetch pre-images.
+	newCfg.ExecutionTracePath = ""
 
 	withPrefetcher := hostcommon.WithPrefetcher(
 		func(context.Context, log.Logger, kvstore.KV, *config.Config) (hostcommon.Prefetcher, error) {
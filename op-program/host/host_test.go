@@ -14,6 +14,7 @@ import (
 	hostcommon "github.com/ethereum-optimism/optimism/op-program/host/common"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -55,6 +56,64 @@ func TestServerMode(t *testing.T) {
 	require.ErrorIs(t, waitFor(result), kvstore.ErrNotFound)
 }
 
+func TestWitnessMode(t *testing.T) {
+	l1Head := common.Hash{0x11}
+	l2OutputRoot := common.Hash{0x33}
+	newWitnessConfig := func(t *testing.T) *config.Config {
+		dir := t.TempDir()
+		kv, err := kvstore.NewDiskKV(testlog.Logger(t, log.LevelError), dir, types.DataFormatDirectory)
+		require.NoError(t, err)
+		require.NoError(t, kv.Close())
+		cfg := config.NewSingleChainConfig(chaincfg.OPSepolia(), chainconfig.OPSepoliaChainConfig(), l1Head, common.Hash{0x22}, l2OutputRoot, common.Hash{0x44}, 1000)
+		cfg.WitnessDir = dir
+		return cfg
+	}
+	noPrefetcher := func(ctx context.Context, logger log.Logger, kv kvstore.KV, cfg *config.Config) (hostcommon.Prefetcher, error) {
+		return nil, errors.New("prefetcher must not be created in witness mode")
+	}
+
+	t.Run("Server", func(t *testing.T) {
+		cfg := newWitnessConfig(t)
+		cfg.ServerMode = true
+
+		preimageServer, preimageClient, err := preimage.CreateBidirectionalChannel()
+		require.NoError(t, err)
+		defer preimageClient.Close()
+		hintServer, hintClient, err := preimage.CreateBidirectionalChannel()
+		require.NoError(t, err)
+		defer hintClient.Close()
+		logger := testlog.Logger(t, log.LevelTrace)
+		result := make(chan error)
+		go func() {
+			result <- hostcommon.PreimageServer(context.Background(), logger, cfg, preimageServer, hintServer, noPrefetcher)
+		}()
+
+		pClient := preimage.NewOracleClient(preimageClient)
+		hClient := preimage.NewHintWriter(hintClient)
+		l1PreimageOracle := l1.NewPreimageOracle(pClient, hClient)
+
+		require.Equal(t, l1Head.Bytes(), pClient.Get(boot.L1HeadLocalIndex), "Should get l1 head preimages")
+		require.Panics(t, func() {
+			l1PreimageOracle.HeaderByBlockHash(common.HexToHash("0x1234"))
+		}, "Preimage should not be available")
+		require.ErrorIs(t, waitFor(result), hostcommon.ErrMissingWitness)
+	})
+
+	t.Run("InProcess", func(t *testing.T) {
+		cfg := newWitnessConfig(t)
+		logger := testlog.Logger(t, log.LevelInfo)
+		err := hostcommon.FaultProofProgram(context.Background(), logger, cfg, hostcommon.WithPrefetcher(noPrefetcher))
+		require.ErrorIs(t, err, hostcommon.ErrMissingWitness)
+	})
+
+	t.Run("MissingArchive", func(t *testing.T) {
+		cfg := newWitnessConfig(t)
+		cfg.WitnessDir = t.TempDir()
+		err := hostcommon.FaultProofProgram(context.Background(), testlog.Logger(t, log.LevelInfo), cfg, hostcommon.WithPrefetcher(noPrefetcher))
+		require.ErrorIs(t, err, kvstore.ErrFormatUnavailable)
+	})
+}
+
 func waitFor(ch chan error) error {
 	timeout := time.After(30 * time.Second)
 	select {
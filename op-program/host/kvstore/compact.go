@@ -0,0 +1,140 @@
+package kvstore
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
+)
+
+// Compact packs the pre-image files of every shard of the sharded KV store in dir into a single pack file per shard,
+// and removes the temp files left behind by interrupted writes.
+// Pre-image files replace the packed pre-image with the same key, as they were written after the last compaction.
+// The KV store must not be in use while it is compacted.
+func Compact(logger log.Logger, dir string) error {
+	format, err := readKVFormat(dir)
+	if err != nil {
+		return err
+	}
+	if format != types.DataFormatSharded {
+		return fmt.Errorf("%w: only %s data can be compacted, found %s", ErrUnsupportedFormat, types.DataFormatSharded, format)
+	}
+	var packed atomic.Int64
+	var g errgroup.Group
+	g.SetLimit(runtime.NumCPU())
+	for shard := 0; shard < shardCount; shard++ {
+		g.Go(func() error {
+			n, err := compactShard(dir, byte(shard))
+			if err != nil {
+				return fmt.Errorf("failed to compact shard %s: %w", shardDirName(byte(shard)), err)
+			}
+			packed.Add(int64(n))
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	logger.Info("Compacted pre-images", "datadir", dir, "packed", packed.Load())
+	return nil
+}
+
+// compactShard rewrites the pack file of the shard with the pre-image files of the shard appended,
+// and returns the number of pre-image files that were packed.
+func compactShard(dir string, shard byte) (int, error) {
+	shardDir := filepath.Join(dir, shardDirName(shard))
+	entries, err := os.ReadDir(shardDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	loose := make(map[common.Hash]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == packFilename || entry.IsDir() {
+			continue
+		}
+		keyHex, ok := strings.CutSuffix(name, preimageFileExt)
+		rest, err := hex.DecodeString(keyHex)
+		if !ok || err != nil || len(rest) != common.HashLength-1 {
+			// Temp files of writes that were interrupted before being moved into place.
+			if err := os.Remove(filepath.Join(shardDir, name)); err != nil {
+				return 0, fmt.Errorf("failed to remove temp file %s: %w", name, err)
+			}
+			continue
+		}
+		loose[common.BytesToHash(append([]byte{shard}, rest...))] = filepath.Join(shardDir, name)
+	}
+	if len(loose) == 0 {
+		return 0, nil
+	}
+
+	f, err := os.CreateTemp(shardDir, packFilename+".*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temp pack file: %w", err)
+	}
+	defer os.Remove(f.Name()) // Clean up the temp file if it doesn't actually get moved into place
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	packPath := filepath.Join(shardDir, packFilename)
+	if old, err := os.Open(packPath); err == nil {
+		err = readPack(old, func(k common.Hash, _ int64, _ uint32, value io.Reader) error {
+			if _, ok := loose[k]; ok {
+				return nil
+			}
+			v, err := io.ReadAll(value)
+			if err != nil {
+				return err
+			}
+			return writePackRecord(w, k, v)
+		})
+		_ = old.Close()
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy existing pack file: %w", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("failed to open existing pack file: %w", err)
+	}
+	for k, path := range loose {
+		v, err := os.ReadFile(path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read pre-image file %s: %w", k, err)
+		}
+		if err := writePackRecord(w, k, v); err != nil {
+			return 0, fmt.Errorf("failed to pack pre-image %s: %w", k, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to write pack file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return 0, fmt.Errorf("failed to sync pack file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close pack file: %w", err)
+	}
+	if err := os.Rename(f.Name(), packPath); err != nil {
+		return 0, fmt.Errorf("failed to move temp pack file %v to final destination %v: %w", f.Name(), packPath, err)
+	}
+	// The pre-images are packed now, so their files can be removed.
+	for k, path := range loose {
+		if err := os.Remove(path); err != nil {
+			return 0, fmt.Errorf("failed to remove packed pre-image file %s: %w", k, err)
+		}
+	}
+	return len(loose), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-program/host/types"
@@ -63,6 +64,8 @@ func NewDiskKV(logger log.Logger, dir string, defaultFormat types.DataFormat) (K
 		return newDirectoryKV(dir), nil
 	case types.DataFormatPebble:
 		return newPebbleKV(dir), nil
+	case types.DataFormatSharded:
+		return newShardedKV(dir, runtime.NumCPU()), nil
 	default:
 		return nil, fmt.Errorf("invalid data format: %s", format)
 	}
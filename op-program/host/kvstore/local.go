@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type LocalPreimageSource struct {
@@ -25,6 +26,8 @@ var (
 	l2ChainIDKey          = boot.L2ChainIDLocalIndex.PreimageKey()
 	l2ChainConfigKey      = boot.L2ChainConfigLocalIndex.PreimageKey()
 	rollupKey             = boot.RollupConfigLocalIndex.PreimageKey()
+	precompilesKey        = boot.AcceleratedPrecompilesLocalIndex.PreimageKey()
+	configBundleKey       = boot.ConfigBundleLocalIndex.PreimageKey()
 )
 
 func (s *LocalPreimageSource) Get(key common.Hash) ([]byte, error) {
@@ -40,21 +43,29 @@ func (s *LocalPreimageSource) Get(key common.Hash) ([]byte, error) {
 	case l2ChainIDKey:
 		return binary.BigEndian.AppendUint64(nil, s.config.L2ChainID), nil
 	case l2ChainConfigKey:
-		if s.config.L2ChainID != boot.CustomChainIDIndicator {
+		if s.config.L2ChainID != boot.CustomChainIDIndicator || s.config.InteropEnabled {
 			return nil, ErrNotFound
 		}
-		if s.config.InteropEnabled {
-			return json.Marshal(s.config.L2ChainConfigs)
-		}
 		return json.Marshal(s.config.L2ChainConfigs[0])
 	case rollupKey:
-		if s.config.L2ChainID != boot.CustomChainIDIndicator {
+		if s.config.L2ChainID != boot.CustomChainIDIndicator || s.config.InteropEnabled {
 			return nil, ErrNotFound
 		}
-		if s.config.InteropEnabled {
-			return json.Marshal(s.config.Rollups)
-		}
 		return json.Marshal(s.config.Rollups[0])
+	case configBundleKey:
+		if !s.config.UsesConfigBundle() {
+			return nil, ErrNotFound
+		}
+		bundle, err := s.config.ConfigBundle()
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(bundle), nil
+	case precompilesKey:
+		if s.config.L2ChainID != boot.CustomChainIDIndicator || s.config.InteropEnabled {
+			return nil, ErrNotFound
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(s.config.AcceleratedPrecompiles)), nil
 	default:
 		return nil, ErrNotFound
 	}
@@ -9,9 +9,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/client/boot"
+	"github.com/ethereum-optimism/optimism/op-program/client/l2/engineapi"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +44,8 @@ func TestLocalPreimageSource(t *testing.T) {
 		{"L2ChainID", l2ChainIDKey, binary.BigEndian.AppendUint64(nil, 86)},
 		{"Rollup", rollupKey, nil},             // Only available for custom chain configs
 		{"ChainConfig", l2ChainConfigKey, nil}, // Only available for custom chain configs
+		{"Precompiles", precompilesKey, nil},   // Only available for custom chain configs
+		{"ConfigBundle", configBundleKey, nil}, // Only available for interop with custom chain configs
 		{"Unknown", preimage.LocalIndexKey(1000).PreimageKey(), nil},
 	}
 	for _, test := range tests {
@@ -63,6 +70,8 @@ func TestGetCustomChainConfigPreimages(t *testing.T) {
 		L2Claim:            common.HexToHash("0x3333"),
 		L2ClaimBlockNumber: 1234,
 		L2ChainConfigs:     []*params.ChainConfig{params.SepoliaChainConfig},
+
+		AcceleratedPrecompiles: engineapi.EcrecoverFlag,
 	}
 	source := NewLocalPreimageSource(cfg)
 	actualRollup, err := source.Get(rollupKey)
@@ -71,6 +80,9 @@ func TestGetCustomChainConfigPreimages(t *testing.T) {
 	actualChainConfig, err := source.Get(l2ChainConfigKey)
 	require.NoError(t, err)
 	require.Equal(t, asJson(t, cfg.L2ChainConfigs[0]), actualChainConfig)
+	actualPrecompiles, err := source.Get(precompilesKey)
+	require.NoError(t, err)
+	require.Equal(t, binary.BigEndian.AppendUint64(nil, uint64(cfg.AcceleratedPrecompiles)), actualPrecompiles)
 }
 
 func TestGetCustomChainConfigPreimagesInterop(t *testing.T) {
@@ -83,16 +95,30 @@ func TestGetCustomChainConfigPreimagesInterop(t *testing.T) {
 		L2OutputRoot:       common.HexToHash("0x2222"),
 		L2Claim:            common.HexToHash("0x3333"),
 		L2ClaimBlockNumber: 1234,
-		L2ChainConfigs:     []*params.ChainConfig{params.SepoliaChainConfig, chainCfg2},
+		L2ChainConfigs:     []*params.ChainConfig{chainconfig.OPSepoliaChainConfig(), chainCfg2},
 		InteropEnabled:     true,
 	}
+	depSet, err := depset.NewStaticConfigDependencySet(map[eth.ChainID]*depset.StaticConfigDependency{
+		eth.ChainIDFromBig(chaincfg.OPSepolia().L2ChainID): {ChainIndex: 1},
+		eth.ChainIDFromUInt64(2498):                        {ChainIndex: 2},
+	})
+	require.NoError(t, err)
+	cfg.DependencySet = depSet
 	source := NewLocalPreimageSource(cfg)
-	actualRollup, err := source.Get(rollupKey)
+	bundle, err := chainconfig.SerializeBundle(cfg.Rollups, cfg.L2ChainConfigs, depSet)
 	require.NoError(t, err)
-	require.Equal(t, asJson(t, cfg.Rollups), actualRollup)
-	actualChainConfig, err := source.Get(l2ChainConfigKey)
+	actualCommitment, err := source.Get(configBundleKey)
 	require.NoError(t, err)
-	require.Equal(t, asJson(t, cfg.L2ChainConfigs), actualChainConfig)
+	require.Equal(t, crypto.Keccak256(bundle), actualCommitment)
+
+	// Interop runs load the configs of custom chains from the bundle only
+	_, err = source.Get(rollupKey)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = source.Get(l2ChainConfigKey)
+	require.ErrorIs(t, err, ErrNotFound)
+	// Interop always accelerates all precompiles
+	_, err = source.Get(precompilesKey)
+	require.ErrorIs(t, err, ErrNotFound)
 }
 
 func asJson(t *testing.T, v any) []byte {
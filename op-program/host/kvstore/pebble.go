@@ -20,19 +20,30 @@ type pebbleKV struct {
 // newPebbleKV creates a pebbleKV that puts/gets pre-images as files in the given directory path.
 // The path must exist, or subsequent Put/Get calls will error when it does not.
 func newPebbleKV(path string) *pebbleKV {
+	kv, err := openPebbleKV(path, false)
+	if err != nil {
+		panic(err)
+	}
+	return kv
+}
+
+// openPebbleKV opens a pebbleKV at the given directory path.
+// If readOnly is set, the database must exist, and is not modified.
+func openPebbleKV(path string, readOnly bool) (*pebbleKV, error) {
 	opts := &pebble.Options{
 		Cache:                    pebble.NewCache(int64(32 * 1024 * 1024)),
 		MaxConcurrentCompactions: runtime.NumCPU,
 		Levels: []pebble.LevelOptions{
 			{Compression: pebble.SnappyCompression},
 		},
+		ReadOnly: readOnly,
 	}
 	db, err := pebble.Open(path, opts)
 	if err != nil {
-		panic(fmt.Errorf("failed to open pebbledb at %s: %w", path, err))
+		return nil, fmt.Errorf("failed to open pebbledb at %s: %w", path, err)
 	}
 
-	return &pebbleKV{db: db}
+	return &pebbleKV{db: db}, nil
 }
 
 func (d *pebbleKV) Put(k common.Hash, v []byte) error {
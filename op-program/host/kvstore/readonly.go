@@ -0,0 +1,51 @@
+package kvstore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrReadOnly is returned when putting a pre-image into a read-only KV store.
+var ErrReadOnly = errors.New("kv store is read-only")
+
+// readOnlyKV is a KV store that rejects all writes.
+type readOnlyKV struct {
+	KV
+}
+
+func (r *readOnlyKV) Put(k common.Hash, v []byte) error {
+	return ErrReadOnly
+}
+
+// OpenReadOnlyDiskKV opens the existing KV store in the given directory for reading only.
+// Unlike NewDiskKV, the directory must contain a KV store with its format recorded,
+// and the directory is never modified.
+func OpenReadOnlyDiskKV(logger log.Logger, dir string) (KV, error) {
+	format, err := readKVFormat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open read-only kv store at %s: %w", dir, err)
+	}
+	logger.Info("Using read-only disk storage", "datadir", dir, "format", format)
+
+	var kv KV
+	switch format {
+	case types.DataFormatFile:
+		kv = newFileKV(dir)
+	case types.DataFormatDirectory:
+		kv = newDirectoryKV(dir)
+	case types.DataFormatPebble:
+		kv, err = openPebbleKV(dir, true)
+		if err != nil {
+			return nil, err
+		}
+	case types.DataFormatSharded:
+		kv = newShardedKV(dir, 1)
+	default:
+		return nil, fmt.Errorf("invalid data format: %s", format)
+	}
+	return &readOnlyKV{KV: kv}, nil
+}
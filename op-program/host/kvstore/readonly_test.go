@@ -0,0 +1,42 @@
+package kvstore
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenReadOnlyDiskKV(t *testing.T) {
+	for _, format := range types.SupportedDataFormats {
+		format := format
+		t.Run(string(format), func(t *testing.T) {
+			dir := t.TempDir()
+			logger := testlog.Logger(t, log.LevelError)
+			hash := common.Hash{0xaa}
+			value := []byte{1, 2, 3, 4, 5, 6}
+			kv, err := NewDiskKV(logger, dir, format)
+			require.NoError(t, err)
+			require.NoError(t, kv.Put(hash, value))
+			require.NoError(t, kv.Close())
+
+			readOnly, err := OpenReadOnlyDiskKV(logger, dir)
+			require.NoError(t, err)
+			defer readOnly.Close()
+			actual, err := readOnly.Get(hash)
+			require.NoError(t, err)
+			require.Equal(t, value, actual)
+			_, err = readOnly.Get(common.Hash{0xbb})
+			require.ErrorIs(t, err, ErrNotFound)
+			require.ErrorIs(t, readOnly.Put(common.Hash{0xbb}, value), ErrReadOnly)
+		})
+	}
+
+	t.Run("NotRecorded", func(t *testing.T) {
+		_, err := OpenReadOnlyDiskKV(testlog.Logger(t, log.LevelError), t.TempDir())
+		require.ErrorIs(t, err, ErrFormatUnavailable)
+	})
+}
@@ -0,0 +1,287 @@
+package kvstore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// shardCount is the number of shards. Pre-images are sharded by the first byte of their key.
+	shardCount = 256
+	// packFilename is the name of the file the pre-images of a shard are compacted into.
+	packFilename = "pack"
+	// preimageFileExt is the extension of the files of pre-images that are not compacted yet.
+	preimageFileExt = ".bin"
+	// shardWriteQueueSize is the number of writes that can be queued per write worker, before Put blocks.
+	shardWriteQueueSize = 1024
+	// packRecordHeaderSize is the size of the key and big-endian uint32 value length preceding each packed pre-image.
+	packRecordHeaderSize = common.HashLength + 4
+)
+
+var ErrClosed = errors.New("kv store closed")
+
+type shardWrite struct {
+	key   common.Hash
+	value []byte
+}
+
+type packEntry struct {
+	offset int64
+	length uint32
+}
+
+// packIndex locates the pre-images in the pack file of a shard.
+type packIndex struct {
+	f       *os.File
+	entries map[common.Hash]packEntry
+}
+
+// shardedKV is a disk-backed key-value store, that shards the pre-images by the first byte of their key,
+// into a directory per shard. Every pre-image is written as a binary file in the directory of its shard,
+// by a pool of write workers that each own a subset of the shards. Put only queues the write,
+// so the writes of different shards do not wait on each other, nor on the caller.
+// Compact packs the pre-image files of each shard into a single pack file, while the store is not in use.
+// shardedKV is safe for concurrent use with a single shardedKV instance.
+type shardedKV struct {
+	path   string
+	queues []chan *shardWrite
+	wg     sync.WaitGroup
+
+	// closeLock prevents the write queues from being closed while a write is queued.
+	closeLock sync.RWMutex
+	closed    bool
+
+	mu sync.RWMutex
+	// pending are the queued writes that are not on disk yet.
+	pending map[common.Hash]*shardWrite
+	// err is the first write error, returned by all subsequent Put calls and Close.
+	err error
+
+	packsLock sync.Mutex
+	packs     map[byte]*packIndex
+}
+
+// newShardedKV creates a shardedKV that puts/gets pre-images in shard directories in the given directory path,
+// with the given number of write workers. The directories are created if they do not exist yet.
+func newShardedKV(path string, workers int) *shardedKV {
+	workers = max(workers, 1)
+	d := &shardedKV{
+		path:    path,
+		pending: make(map[common.Hash]*shardWrite),
+		packs:   make(map[byte]*packIndex),
+	}
+	for i := 0; i < workers; i++ {
+		queue := make(chan *shardWrite, shardWriteQueueSize)
+		d.queues = append(d.queues, queue)
+		d.wg.Add(1)
+		go d.writeWorker(queue)
+	}
+	return d
+}
+
+func shardDirName(shard byte) string {
+	return hex.EncodeToString([]byte{shard})
+}
+
+func (d *shardedKV) shardDir(k common.Hash) string {
+	return filepath.Join(d.path, shardDirName(k[0]))
+}
+
+// pathKey returns the file path for the given key.
+// This is the directory of the shard, and the remainder of the hex key, after the shard byte, as the file name.
+func (d *shardedKV) pathKey(k common.Hash) string {
+	return filepath.Join(d.shardDir(k), hex.EncodeToString(k[1:])+preimageFileExt)
+}
+
+func (d *shardedKV) Put(k common.Hash, v []byte) error {
+	d.closeLock.RLock()
+	defer d.closeLock.RUnlock()
+	if d.closed {
+		return ErrClosed
+	}
+	w := &shardWrite{key: k, value: slices.Clone(v)}
+	d.mu.Lock()
+	if d.err != nil {
+		d.mu.Unlock()
+		return d.err
+	}
+	d.pending[k] = w
+	d.mu.Unlock()
+	d.queues[int(k[0])%len(d.queues)] <- w
+	return nil
+}
+
+func (d *shardedKV) writeWorker(queue <-chan *shardWrite) {
+	defer d.wg.Done()
+	for w := range queue {
+		err := d.writeFile(w.key, w.value)
+		d.mu.Lock()
+		if err != nil {
+			if d.err == nil {
+				d.err = err
+			}
+		} else if d.pending[w.key] == w {
+			delete(d.pending, w.key)
+		}
+		d.mu.Unlock()
+	}
+}
+
+func (d *shardedKV) writeFile(k common.Hash, v []byte) error {
+	f, err := openTempFile(d.shardDir(k), hex.EncodeToString(k[1:])+preimageFileExt+".*")
+	if err != nil {
+		return fmt.Errorf("failed to open temp file for pre-image %s: %w", k, err)
+	}
+	defer os.Remove(f.Name()) // Clean up the temp file if it doesn't actually get moved into place
+	if _, err := f.Write(v); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write pre-image %s to disk: %w", k, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close temp pre-image %s file: %w", k, err)
+	}
+	targetFile := d.pathKey(k)
+	if err := os.Rename(f.Name(), targetFile); err != nil {
+		return fmt.Errorf("failed to move temp file %v to final destination %v: %w", f.Name(), targetFile, err)
+	}
+	return nil
+}
+
+func (d *shardedKV) Get(k common.Hash) ([]byte, error) {
+	d.mu.RLock()
+	w, ok := d.pending[k]
+	d.mu.RUnlock()
+	if ok {
+		return slices.Clone(w.value), nil
+	}
+	dat, err := os.ReadFile(d.pathKey(k))
+	if err == nil {
+		return dat, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read pre-image file %s: %w", k, err)
+	}
+	idx, err := d.packIndex(k[0])
+	if err != nil {
+		return nil, err
+	}
+	if idx == nil {
+		return nil, ErrNotFound
+	}
+	entry, ok := idx.entries[k]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	dat = make([]byte, entry.length)
+	if _, err := idx.f.ReadAt(dat, entry.offset); err != nil {
+		return nil, fmt.Errorf("failed to read packed pre-image %s: %w", k, err)
+	}
+	return dat, nil
+}
+
+// packIndex returns the index of the pack file of the shard, or nil if the shard has not been compacted.
+// The index is loaded on first use: the pack file only changes by compaction, while the store is not in use.
+func (d *shardedKV) packIndex(shard byte) (*packIndex, error) {
+	d.packsLock.Lock()
+	defer d.packsLock.Unlock()
+	if idx, ok := d.packs[shard]; ok {
+		return idx, nil
+	}
+	f, err := os.Open(filepath.Join(d.path, shardDirName(shard), packFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		d.packs[shard] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open pack file of shard %s: %w", shardDirName(shard), err)
+	}
+	idx := &packIndex{f: f, entries: make(map[common.Hash]packEntry)}
+	err = readPack(f, func(k common.Hash, offset int64, length uint32, _ io.Reader) error {
+		idx.entries[k] = packEntry{offset: offset, length: length}
+		return nil
+	})
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to index pack file of shard %s: %w", shardDirName(shard), err)
+	}
+	d.packs[shard] = idx
+	return idx, nil
+}
+
+// Close waits for all queued writes to be written to disk, and returns the first write error, if any.
+func (d *shardedKV) Close() error {
+	d.closeLock.Lock()
+	if d.closed {
+		d.closeLock.Unlock()
+		return nil
+	}
+	d.closed = true
+	for _, queue := range d.queues {
+		close(queue)
+	}
+	d.closeLock.Unlock()
+	d.wg.Wait()
+
+	d.packsLock.Lock()
+	defer d.packsLock.Unlock()
+	var result error
+	for _, idx := range d.packs {
+		if idx != nil {
+			result = errors.Join(result, idx.f.Close())
+		}
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return errors.Join(d.err, result)
+}
+
+// readPack reads the records of a pack file, calling fn with the key, offset and length of each pre-image,
+// and a reader of the pre-image, that fn may read from.
+func readPack(f io.Reader, fn func(k common.Hash, offset int64, length uint32, value io.Reader) error) error {
+	r := bufio.NewReader(f)
+	var header [packRecordHeaderSize]byte
+	var offset int64
+	for {
+		if _, err := io.ReadFull(r, header[:]); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("truncated pack record header at offset %d: %w", offset, err)
+		}
+		k := common.BytesToHash(header[:common.HashLength])
+		length := binary.BigEndian.Uint32(header[common.HashLength:])
+		offset += packRecordHeaderSize
+		value := &io.LimitedReader{R: r, N: int64(length)}
+		if err := fn(k, offset, length, value); err != nil {
+			return err
+		}
+		// Skip the remainder of the value that fn did not read
+		if _, err := io.Copy(io.Discard, value); err != nil {
+			return err
+		}
+		if value.N != 0 {
+			return fmt.Errorf("truncated pack record %s at offset %d", k, offset)
+		}
+		offset += int64(length)
+	}
+}
+
+func writePackRecord(w io.Writer, k common.Hash, v []byte) error {
+	var header [packRecordHeaderSize]byte
+	copy(header[:], k[:])
+	binary.BigEndian.PutUint32(header[common.HashLength:], uint32(len(v)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(v)
+	return err
+}
+
+var _ KV = (*shardedKV)(nil)
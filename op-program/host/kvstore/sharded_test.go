@@ -0,0 +1,112 @@
+package kvstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestShardedKV(t *testing.T) {
+	tmp := t.TempDir() // automatically removed by testing cleanup
+	kv := newShardedKV(tmp, 4)
+	t.Cleanup(func() { // Can't use defer because kvTest runs tests in parallel.
+		require.NoError(t, kv.Close())
+	})
+	kvTest(t, kv)
+}
+
+func TestShardedKV_ShardDirectories(t *testing.T) {
+	tmp := t.TempDir()
+	kv := newShardedKV(tmp, 4)
+	key := common.Hash{0xab, 0xcd}
+	require.NoError(t, kv.Put(key, []byte{1, 2, 3}))
+	require.NoError(t, kv.Close())
+	dat, err := os.ReadFile(filepath.Join(tmp, "ab", key.Hex()[4:]+preimageFileExt))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, dat)
+
+	require.ErrorIs(t, kv.Put(key, []byte{4}), ErrClosed)
+}
+
+func TestCompact(t *testing.T) {
+	dir := t.TempDir()
+	logger := testlog.Logger(t, log.LevelInfo)
+	kv, err := NewDiskKV(logger, dir, types.DataFormatSharded)
+	require.NoError(t, err)
+	preimages := make(map[common.Hash][]byte)
+	for i := 0; i < 1000; i++ {
+		v := []byte{byte(i), byte(i >> 8)}
+		k := crypto.Keccak256Hash(v)
+		preimages[k] = v
+		require.NoError(t, kv.Put(k, v))
+	}
+	require.NoError(t, kv.Put(common.Hash{}, []byte{}))
+	preimages[common.Hash{}] = []byte{}
+	require.NoError(t, kv.Close())
+	// Left behind by an interrupted write
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00", "00ab"+preimageFileExt+".123"), []byte{1}, 0o644))
+
+	require.NoError(t, Compact(logger, dir))
+	requireNoPreimageFiles(t, dir)
+	kv, err = NewDiskKV(logger, dir, types.DataFormatSharded)
+	require.NoError(t, err)
+	for k, v := range preimages {
+		actual, err := kv.Get(k)
+		require.NoError(t, err)
+		require.Equal(t, v, actual)
+	}
+	_, err = kv.Get(common.Hash{0xff})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Pre-images written after the compaction are merged into the existing pack files.
+	updated := crypto.Keccak256Hash([]byte{0, 0})
+	preimages[updated] = []byte{0xaa}
+	require.NoError(t, kv.Put(updated, preimages[updated]))
+	added := common.Hash{0x01}
+	preimages[added] = []byte{0xbb}
+	require.NoError(t, kv.Put(added, preimages[added]))
+	require.NoError(t, kv.Close())
+
+	require.NoError(t, Compact(logger, dir))
+	requireNoPreimageFiles(t, dir)
+	kv, err = NewDiskKV(logger, dir, types.DataFormatSharded)
+	require.NoError(t, err)
+	defer kv.Close()
+	for k, v := range preimages {
+		actual, err := kv.Get(k)
+		require.NoError(t, err)
+		require.Equal(t, v, actual)
+	}
+}
+
+func TestCompact_UnsupportedFormat(t *testing.T) {
+	dir := t.TempDir()
+	logger := testlog.Logger(t, log.LevelInfo)
+	kv, err := NewDiskKV(logger, dir, types.DataFormatDirectory)
+	require.NoError(t, err)
+	require.NoError(t, kv.Close())
+	require.ErrorIs(t, Compact(logger, dir), ErrUnsupportedFormat)
+}
+
+func requireNoPreimageFiles(t *testing.T, dir string) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		shardEntries, err := os.ReadDir(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+		for _, shardEntry := range shardEntries {
+			require.Equal(t, packFilename, shardEntry.Name())
+		}
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	clientTypes "github.com/ethereum-optimism/optimism/op-program/client/interop/types"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
@@ -23,6 +26,17 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxBlockRange is the maximum number of blocks prefetched for a block range hint. Larger ranges are truncated.
+	maxBlockRange = 1000
+	// blockRangeBatchSize is the number of L1 blocks of a block range that are fetched concurrently.
+	blockRangeBatchSize = 16
+	// blockRangeTimeout bounds the time spent prefetching a block range.
+	// Blocks that are not prefetched by then are fetched when they are requested.
+	blockRangeTimeout = 2 * time.Minute
 )
 
 var (
@@ -30,6 +44,7 @@ var (
 	precompileFailure = [1]byte{0}
 
 	ErrAgreedPrestateUnavailable = errors.New("agreed prestate unavailable")
+	ErrConfigBundleUnavailable   = errors.New("config bundle unavailable")
 )
 
 var acceleratedPrecompiles = []common.Address{
@@ -41,6 +56,7 @@ var acceleratedPrecompiles = []common.Address{
 type L1Source interface {
 	InfoByHash(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, error)
 	InfoAndTxsByHash(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Transactions, error)
+	InfoAndTxsByNumber(ctx context.Context, number uint64) (eth.BlockInfo, types.Transactions, error)
 	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
 }
 
@@ -63,6 +79,13 @@ type Prefetcher struct {
 	// Used to run the program for native block execution
 	executor       ProgramExecutor
 	agreedPrestate []byte
+	// configBundle is the serialized config bundle of the custom chains, if the client loads its configs from it
+	configBundle []byte
+
+	// rangesLock guards rangesInFlight, the block range hints that are being prefetched in the background
+	rangesLock     sync.Mutex
+	rangesInFlight map[string]struct{}
+	rangesWg       sync.WaitGroup
 }
 
 func NewPrefetcher(
@@ -75,6 +98,7 @@ func NewPrefetcher(
 	executor ProgramExecutor,
 	l2Head common.Hash,
 	agreedPrestate []byte,
+	configBundle []byte,
 ) *Prefetcher {
 	return &Prefetcher{
 		logger:         logger,
@@ -86,20 +110,57 @@ func NewPrefetcher(
 		executor:       executor,
 		l2Head:         l2Head,
 		agreedPrestate: agreedPrestate,
+		configBundle:   configBundle,
+		rangesInFlight: make(map[string]struct{}),
 	}
 }
 
 func (p *Prefetcher) Hint(hint string) error {
 	p.logger.Trace("Received hint", "hint", hint)
+	hintType, _, err := parseHint(hint)
+	if err == nil && (hintType == l1.HintL1BlockRange || hintType == l2.HintL2BlockRange) {
+		// Block ranges are prefetched eagerly in the background, ahead of the requests for the individual blocks.
+		// They are not kept as the last hint, as the requests that follow send their own hints.
+		p.prefetchRangeAsync(hint)
+		return nil
+	}
+	if err == nil && hintType == preimage.HintCheckpoint {
+		// Checkpoints are only used by the VM to snapshot the state at, they do not request any pre-images.
+		return nil
+	}
 	p.lastHint = hint
 
 	// This is a special case to force block execution in order to populate the cache with preimage data
-	if hintType, _, err := parseHint(hint); err == nil && hintType == l2.HintL2BlockData {
+	if err == nil && hintType == l2.HintL2BlockData {
 		return p.prefetch(context.Background(), hint)
 	}
 	return nil
 }
 
+// prefetchRangeAsync prefetches the block range hint in the background, unless it is already being prefetched.
+// Prefetching is bounded by blockRangeTimeout. Failing to prefetch a range is not fatal,
+// the blocks are then fetched when they are requested.
+func (p *Prefetcher) prefetchRangeAsync(hint string) {
+	p.rangesLock.Lock()
+	defer p.rangesLock.Unlock()
+	if _, ok := p.rangesInFlight[hint]; ok {
+		return
+	}
+	p.rangesInFlight[hint] = struct{}{}
+	p.rangesWg.Add(1)
+	go func() {
+		defer p.rangesWg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), blockRangeTimeout)
+		defer cancel()
+		if err := p.prefetch(ctx, hint); err != nil {
+			p.logger.Warn("Failed to prefetch block range", "hint", hint, "err", err)
+		}
+		p.rangesLock.Lock()
+		defer p.rangesLock.Unlock()
+		delete(p.rangesInFlight, hint)
+	}()
+}
+
 func (p *Prefetcher) GetPreimage(ctx context.Context, key common.Hash) ([]byte, error) {
 	p.logger.Trace("Pre-image requested", "key", key)
 	pre, err := p.kvStore.Get(key)
@@ -160,6 +221,14 @@ func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
 			return fmt.Errorf("failed to fetch L1 block %s receipts: %w", hash, err)
 		}
 		return p.storeReceipts(receipts)
+	case l1.HintL1BlockRange:
+		if len(hintBytes) != 32+8+8 {
+			return fmt.Errorf("invalid L1 block range hint: %x", hint)
+		}
+		head := common.Hash(hintBytes[:32])
+		count := binary.BigEndian.Uint64(hintBytes[32:40])
+		receiptsCount := binary.BigEndian.Uint64(hintBytes[40:48])
+		return p.prefetchL1BlockRange(ctx, hintBytes, head, count, receiptsCount)
 	case l1.HintL1Blob:
 		if len(hintBytes) != 48 {
 			return fmt.Errorf("invalid blob hint: %x", hint)
@@ -280,6 +349,20 @@ func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
 			return err
 		}
 		return p.storeTransactions(txs)
+	case l2.HintL2Receipts:
+		hash, chainID, err := p.parseHashAndChainID("L2 receipts", hintBytes)
+		if err != nil {
+			return err
+		}
+		source, err := p.l2Sources.ForChainID(chainID)
+		if err != nil {
+			return err
+		}
+		_, receipts, err := source.FetchReceipts(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to fetch L2 block %s receipts: %w", hash, err)
+		}
+		return p.storeReceipts(receipts)
 	case l2.HintL2StateNode:
 		hash, chainID, err := p.parseHashAndChainID("L2 state node", hintBytes)
 		if err != nil {
@@ -368,12 +451,42 @@ func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
 			return fmt.Errorf("failed to re-execute block: %w", err)
 		}
 		return p.kvStore.Put(BlockDataKey(blockHash).Key(), []byte{1})
+	case l2.HintL2BlockRange:
+		if len(hintBytes) != 32+8+8 {
+			return fmt.Errorf("invalid L2 block range hint: %x", hint)
+		}
+		head := common.Hash(hintBytes[:32])
+		count := binary.BigEndian.Uint64(hintBytes[32:40])
+		chainID := binary.BigEndian.Uint64(hintBytes[40:48])
+		source, err := p.l2Sources.ForChainID(chainID)
+		if err != nil {
+			return err
+		}
+		return p.prefetchBlockRange(hintBytes, head, count, func(hash common.Hash) (common.Hash, error) {
+			header, txs, err := source.InfoAndTxsByHash(ctx, hash)
+			if err != nil {
+				return common.Hash{}, fmt.Errorf("failed to fetch L2 block %s: %w", hash, err)
+			}
+			if err := p.storeHeader(header); err != nil {
+				return common.Hash{}, err
+			}
+			return header.ParentHash(), p.storeTransactions(txs)
+		})
 	case l2.HintAgreedPrestate:
 		if len(p.agreedPrestate) == 0 {
 			return ErrAgreedPrestateUnavailable
 		}
 		hash := crypto.Keccak256Hash(p.agreedPrestate)
 		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), p.agreedPrestate)
+	case boot.HintConfigBundle:
+		if len(p.configBundle) == 0 {
+			return ErrConfigBundleUnavailable
+		}
+		hash := crypto.Keccak256Hash(p.configBundle)
+		if len(hintBytes) != 32 || common.Hash(hintBytes) != hash {
+			return fmt.Errorf("%w: hint %x does not match commitment %v", ErrConfigBundleUnavailable, hintBytes, hash)
+		}
+		return p.kvStore.Put(preimage.Keccak256Key(hash).PreimageKey(), p.configBundle)
 	}
 	return fmt.Errorf("unknown hint type: %v", hintType)
 }
@@ -395,6 +508,106 @@ func (p BlockDataKey) Key() [32]byte {
 	return crypto.Keccak256Hash([]byte("block_data"), p[:])
 }
 
+// BlockRangeKey is the key that marks the blocks of a range hint as stored, keyed by the hash of the hint.
+type BlockRangeKey [32]byte
+
+func (p BlockRangeKey) Key() [32]byte {
+	return crypto.Keccak256Hash([]byte("block_range"), p[:])
+}
+
+// prefetchBlockRange stores count blocks, up to maxBlockRange, from the head back through its ancestors, with storeBlock.
+// storeBlock stores the block with the given hash and returns the hash of its parent.
+func (p *Prefetcher) prefetchBlockRange(hintBytes []byte, head common.Hash, count uint64, storeBlock func(hash common.Hash) (common.Hash, error)) error {
+	key := BlockRangeKey(crypto.Keccak256Hash(hintBytes))
+	if _, err := p.kvStore.Get(key.Key()); err == nil {
+		return nil
+	}
+	hash := head
+	for i := uint64(0); i < min(count, maxBlockRange); i++ {
+		parent, err := storeBlock(hash)
+		if err != nil {
+			return err
+		}
+		hash = parent
+	}
+	p.logger.Debug("Prefetched block range", "head", head, "count", count)
+	return p.kvStore.Put(key.Key(), []byte{1})
+}
+
+// prefetchL1BlockRange stores the headers and transactions of count L1 blocks, up to maxBlockRange,
+// from the head back through its ancestors, and the receipts of the receiptsCount most recent of them.
+// The blocks are fetched by number in concurrent batches of blockRangeBatchSize,
+// and prefetching stops at the first block that is not an ancestor of the head, e.g. after a reorg.
+func (p *Prefetcher) prefetchL1BlockRange(ctx context.Context, hintBytes []byte, head common.Hash, count uint64, receiptsCount uint64) error {
+	key := BlockRangeKey(crypto.Keccak256Hash(hintBytes))
+	if _, err := p.kvStore.Get(key.Key()); err == nil {
+		return nil
+	}
+	headInfo, err := p.l1Fetcher.InfoByHash(ctx, head)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 block %s header: %w", head, err)
+	}
+	headNum := headInfo.NumberU64()
+	first := headNum + 1 - min(count, maxBlockRange, headNum+1)
+	expected := head
+	for end := headNum + 1; end > first; {
+		start := max(first, end-min(end, blockRangeBatchSize))
+		infos := make([]eth.BlockInfo, end-start)
+		var g errgroup.Group
+		for num := start; num < end; num++ {
+			g.Go(func() error {
+				info, err := p.storeL1Block(ctx, num, headNum-num < receiptsCount)
+				infos[num-start] = info
+				return err
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		for i := len(infos) - 1; i >= 0; i-- {
+			if infos[i].Hash() != expected {
+				return fmt.Errorf("L1 block %d %s is not an ancestor of the block range head %s", start+uint64(i), infos[i].Hash(), head)
+			}
+			expected = infos[i].ParentHash()
+		}
+		end = start
+	}
+	p.logger.Debug("Prefetched L1 block range", "head", head, "count", headNum+1-first, "receipts", min(receiptsCount, headNum+1-first))
+	return p.kvStore.Put(key.Key(), []byte{1})
+}
+
+// storeL1Block stores the header and transactions of the L1 block with the given number, and its receipts if requested.
+func (p *Prefetcher) storeL1Block(ctx context.Context, num uint64, withReceipts bool) (eth.BlockInfo, error) {
+	header, txs, err := p.l1Fetcher.InfoAndTxsByNumber(ctx, num)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 block %d txs: %w", num, err)
+	}
+	if err := p.storeHeader(header); err != nil {
+		return nil, err
+	}
+	if err := p.storeTransactions(txs); err != nil {
+		return nil, err
+	}
+	if withReceipts {
+		_, receipts, err := p.l1Fetcher.FetchReceipts(ctx, header.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 block %s receipts: %w", header.Hash(), err)
+		}
+		if err := p.storeReceipts(receipts); err != nil {
+			return nil, err
+		}
+	}
+	return header, nil
+}
+
+func (p *Prefetcher) storeHeader(header eth.BlockInfo) error {
+	data, err := header.HeaderRLP()
+	if err != nil {
+		return fmt.Errorf("failed to encode header to RLP: %w", err)
+	}
+	return p.kvStore.Put(preimage.Keccak256Key(header.Hash()).PreimageKey(), data)
+}
+
 func (p *Prefetcher) storeReceipts(receipts types.Receipts) error {
 	opaqueReceipts, err := eth.EncodeReceipts(receipts)
 	if err != nil {
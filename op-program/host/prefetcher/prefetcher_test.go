@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"testing"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-program/client/boot"
 	"github.com/ethereum-optimism/optimism/op-program/client/l1"
 	"github.com/ethereum-optimism/optimism/op-program/client/l2"
 	"github.com/ethereum-optimism/optimism/op-program/client/mpt"
@@ -493,6 +495,35 @@ func TestFetchL2Transactions(t *testing.T) {
 	})
 }
 
+func TestFetchL2Receipts(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	block, receipts := testutils.RandomBlock(rng, 10)
+	hash := block.Hash()
+
+	t.Run("AlreadyKnown", func(t *testing.T) {
+		prefetcher, _, _, _, kv := createPrefetcher(t)
+		storeBlock(t, kv, block, receipts)
+
+		oracle := l2.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher), true)
+		result, actualReceipts := oracle.ReceiptsByBlockHash(hash, 7)
+		require.EqualValues(t, hash, result.Hash())
+		assertReceiptsEqual(t, receipts, actualReceipts)
+	})
+
+	t.Run("WithChainID", func(t *testing.T) {
+		prefetcher, _, _, l2Cls, _ := createPrefetcher(t, 5, 7, 10)
+		l2Cl := l2Cls.sources[7]
+		l2Cl.ExpectInfoAndTxsByHash(hash, eth.BlockToInfo(block), block.Transactions(), nil)
+		l2Cl.ExpectFetchReceipts(hash, eth.BlockToInfo(block), receipts, nil)
+		defer assertAllClientExpectations(t, l2Cls)
+
+		oracle := l2.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher), true)
+		result, actualReceipts := oracle.ReceiptsByBlockHash(hash, 7)
+		require.EqualValues(t, hash, result.Hash())
+		assertReceiptsEqual(t, receipts, actualReceipts)
+	})
+}
+
 func TestFetchL2Node(t *testing.T) {
 	rng := rand.New(rand.NewSource(123))
 	node := testutils.RandomData(rng, 30)
@@ -674,6 +705,161 @@ func TestFetchL2BlockData(t *testing.T) {
 	})
 }
 
+func TestIgnoreCheckpointHint(t *testing.T) {
+	prefetcher, _, _, _, _ := createPrefetcher(t)
+	require.NoError(t, prefetcher.Hint(preimage.CheckpointHint{0xaa}.Hint()))
+	// The checkpoint is not kept as last hint to prefetch pre-images for
+	_, err := prefetcher.GetPreimage(context.Background(), common.Hash{0xbb})
+	require.ErrorIs(t, err, kvstore.ErrNotFound)
+}
+
+func TestFetchL1BlockRange(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	// Spans multiple batches
+	blocks, receipts := randomChain(rng, blockRangeBatchSize+4)
+	head := blocks[len(blocks)-1]
+	count := uint64(len(blocks))
+	receiptsCount := uint64(2)
+
+	expectRange := func(l1Cl *testutils.MockL1Source) {
+		l1Cl.ExpectInfoByHash(head.Hash(), eth.BlockToInfo(head), nil)
+		for i, block := range blocks {
+			l1Cl.ExpectInfoAndTxsByNumber(block.NumberU64(), eth.BlockToInfo(block), block.Transactions(), nil)
+			if uint64(len(blocks)-i) <= receiptsCount {
+				l1Cl.ExpectFetchReceipts(block.Hash(), eth.BlockToInfo(block), receipts[i], nil)
+			}
+		}
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		prefetcher, l1Cl, _, _, _ := createPrefetcher(t)
+		expectRange(l1Cl)
+		defer l1Cl.AssertExpectations(t)
+
+		oracle := l1.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher))
+		oracle.HintBlockRange(head.Hash(), count, receiptsCount)
+		prefetcher.rangesWg.Wait()
+		// The blocks are served from the kv store, without fetching them again
+		for i, block := range blocks {
+			header, txs := oracle.TransactionsByBlockHash(block.Hash())
+			require.EqualValues(t, block.Hash(), header.Hash())
+			assertTransactionsEqual(t, block.Transactions(), txs)
+			if uint64(len(blocks)-i) <= receiptsCount {
+				_, actualReceipts := oracle.ReceiptsByBlockHash(block.Hash())
+				assertReceiptsEqual(t, receipts[i], actualReceipts)
+			}
+		}
+	})
+
+	t.Run("AlreadyKnown", func(t *testing.T) {
+		prefetcher, l1Cl, _, _, _ := createPrefetcher(t)
+		expectRange(l1Cl)
+		defer l1Cl.AssertExpectations(t)
+
+		hint := l1.BlockRangeHint{Head: head.Hash(), Count: count, ReceiptsCount: receiptsCount}.Hint()
+		require.NoError(t, prefetcher.Hint(hint))
+		prefetcher.rangesWg.Wait()
+		// Only fetched once
+		require.NoError(t, prefetcher.Hint(hint))
+		prefetcher.rangesWg.Wait()
+	})
+
+	t.Run("NotAncestor", func(t *testing.T) {
+		prefetcher, l1Cl, _, _, kv := createPrefetcher(t)
+		otherBlocks, _ := randomChain(rng, len(blocks))
+		last := len(blocks) - 1
+		// The block before the head is not its parent, e.g. after a reorg
+		l1Cl.ExpectInfoByHash(head.Hash(), eth.BlockToInfo(head), nil)
+		for i := last - blockRangeBatchSize + 1; i < last; i++ {
+			l1Cl.ExpectInfoAndTxsByNumber(otherBlocks[i].NumberU64(), eth.BlockToInfo(otherBlocks[i]), otherBlocks[i].Transactions(), nil)
+		}
+		l1Cl.ExpectInfoAndTxsByNumber(head.NumberU64(), eth.BlockToInfo(head), head.Transactions(), nil)
+		defer l1Cl.AssertExpectations(t)
+
+		hint := l1.BlockRangeHint{Head: head.Hash(), Count: count}
+		require.NoError(t, prefetcher.Hint(hint.Hint()))
+		prefetcher.rangesWg.Wait()
+		// The range is not marked as prefetched
+		_, hintBytes, err := parseHint(hint.Hint())
+		require.NoError(t, err)
+		_, err = kv.Get(BlockRangeKey(crypto.Keccak256Hash(hintBytes)).Key())
+		require.ErrorIs(t, err, kvstore.ErrNotFound)
+	})
+}
+
+func TestFetchL2BlockRange(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	blocks, _ := randomChain(rng, 3)
+	head := blocks[len(blocks)-1].Hash()
+
+	t.Run("Unknown", func(t *testing.T) {
+		prefetcher, _, _, l2Cls, _ := createPrefetcher(t, 5, 7)
+		l2Cl := l2Cls.sources[7]
+		for _, block := range blocks {
+			l2Cl.ExpectInfoAndTxsByHash(block.Hash(), eth.BlockToInfo(block), block.Transactions(), nil)
+		}
+		defer assertAllClientExpectations(t, l2Cls)
+
+		oracle := l2.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher), true)
+		oracle.HintBlockRange(head, uint64(len(blocks)), 7)
+		prefetcher.rangesWg.Wait()
+		// The blocks are served from the kv store, without fetching them again
+		for _, block := range blocks {
+			result := oracle.BlockByHash(block.Hash(), 7)
+			require.EqualValues(t, block.Header(), result.Header())
+			assertTransactionsEqual(t, block.Transactions(), result.Transactions())
+		}
+	})
+
+	t.Run("FetchErrorIgnored", func(t *testing.T) {
+		prefetcher, _, _, l2Cls, kv := createPrefetcher(t, 5, 7)
+		l2Cl := l2Cls.sources[7]
+		l2Cl.ExpectInfoAndTxsByHash(head, eth.BlockToInfo(blocks[2]), blocks[2].Transactions(), nil)
+		l2Cl.ExpectInfoAndTxsByHash(blocks[1].Hash(), eth.BlockToInfo(nil), nil, errors.New("fetch error"))
+		defer assertAllClientExpectations(t, l2Cls)
+
+		require.NoError(t, prefetcher.Hint(l2.BlockRangeHint{Head: head, Count: uint64(len(blocks)), ChainID: 7}.Hint()))
+		prefetcher.rangesWg.Wait()
+		// Blocks fetched before the error are kept
+		_, err := kv.Get(preimage.Keccak256Key(head).PreimageKey())
+		require.NoError(t, err)
+		_, err = kv.Get(preimage.Keccak256Key(blocks[1].Hash()).PreimageKey())
+		require.ErrorIs(t, err, kvstore.ErrNotFound)
+	})
+
+	t.Run("UnknownChain", func(t *testing.T) {
+		prefetcher, _, _, _, _ := createPrefetcher(t, 5, 7)
+		require.NoError(t, prefetcher.Hint(l2.BlockRangeHint{Head: head, Count: 1, ChainID: 8}.Hint()))
+		prefetcher.rangesWg.Wait()
+	})
+}
+
+// randomChain creates blocks that each reference the previous block as their parent, oldest first.
+func randomChain(rng *rand.Rand, length int) ([]*types.Block, []types.Receipts) {
+	blocks := make([]*types.Block, 0, length)
+	receipts := make([]types.Receipts, 0, length)
+	parent := testutils.RandomHash(rng)
+	for i := 0; i < length; i++ {
+		block, rcpts := testutils.RandomBlock(rng, 2)
+		header := block.Header()
+		header.ParentHash = parent
+		header.Number = big.NewInt(int64(100 + i))
+		block = types.NewBlockWithHeader(header).WithBody(types.Body{Transactions: block.Transactions()})
+		for _, rcpt := range rcpts {
+			rcpt.BlockHash = block.Hash()
+			rcpt.BlockNumber = block.Number()
+			for _, l := range rcpt.Logs {
+				l.BlockHash = block.Hash()
+				l.BlockNumber = block.NumberU64()
+			}
+		}
+		blocks = append(blocks, block)
+		receipts = append(receipts, rcpts)
+		parent = block.Hash()
+	}
+	return blocks, receipts
+}
+
 func TestFetchAgreedPrestate(t *testing.T) {
 	t.Run("unavailable", func(t *testing.T) {
 		prefetcher, _, _, _, _ := createPrefetcher(t)
@@ -696,6 +882,36 @@ func TestFetchAgreedPrestate(t *testing.T) {
 	})
 }
 
+func TestFetchConfigBundle(t *testing.T) {
+	bundle := []byte(`{"chains":[{"rollup":{},"genesis":{}}],"dependencySet":{"dependencies":{}}}`)
+	hash := crypto.Keccak256Hash(bundle)
+
+	t.Run("unavailable", func(t *testing.T) {
+		prefetcher, _, _, _, _ := createPrefetcher(t)
+		require.NoError(t, prefetcher.Hint(boot.ConfigBundleHint(hash).Hint()))
+		_, err := prefetcher.GetPreimage(context.Background(), preimage.Keccak256Key(hash).PreimageKey())
+		require.ErrorIs(t, err, ErrConfigBundleUnavailable)
+	})
+
+	t.Run("different commitment", func(t *testing.T) {
+		prefetcher, _, _, _, _ := createPrefetcher(t)
+		prefetcher.configBundle = bundle
+		other := common.Hash{0xaa}
+		require.NoError(t, prefetcher.Hint(boot.ConfigBundleHint(other).Hint()))
+		_, err := prefetcher.GetPreimage(context.Background(), preimage.Keccak256Key(other).PreimageKey())
+		require.ErrorIs(t, err, ErrConfigBundleUnavailable)
+	})
+
+	t.Run("available", func(t *testing.T) {
+		prefetcher, _, _, _, _ := createPrefetcher(t)
+		prefetcher.configBundle = bundle
+		require.NoError(t, prefetcher.Hint(boot.ConfigBundleHint(hash).Hint()))
+		actual, err := prefetcher.GetPreimage(context.Background(), preimage.Keccak256Key(hash).PreimageKey())
+		require.NoError(t, err)
+		require.Equal(t, bundle, actual)
+	})
+}
+
 func TestBadHints(t *testing.T) {
 	prefetcher, _, _, _, kv := createPrefetcher(t)
 	hash := common.Hash{0xad}
@@ -755,7 +971,7 @@ func TestRetryWhenNotAvailableAfterPrefetching(t *testing.T) {
 	putsToIgnore := 2
 	kv = &unreliableKvStore{KV: kv, putsToIgnore: putsToIgnore}
 	sources := &l2Clients{sources: map[uint64]*l2Client{6: l2Cls.sources[defaultChainID]}}
-	prefetcher := NewPrefetcher(testlog.Logger(t, log.LevelInfo), l1Source, l1BlobSource, 6, sources, kv, nil, common.Hash{}, nil)
+	prefetcher := NewPrefetcher(testlog.Logger(t, log.LevelInfo), l1Source, l1BlobSource, 6, sources, kv, nil, common.Hash{}, nil, nil)
 
 	l2Cl := sources.sources[6]
 	// Expect one call for each ignored put, plus one more request for when the put succeeds
@@ -861,7 +1077,7 @@ func createPrefetcherWithAgreedPrestate(t *testing.T, agreedPrestate []byte, cha
 		l2Sources.sources[chainID] = l2Source
 	}
 
-	prefetcher := NewPrefetcher(logger, l1Source, l1BlobSource, chainIDs[0], l2Sources, kv, nil, common.Hash{0xdd}, agreedPrestate)
+	prefetcher := NewPrefetcher(logger, l1Source, l1BlobSource, chainIDs[0], l2Sources, kv, nil, common.Hash{0xdd}, agreedPrestate, nil)
 	return prefetcher, l1Source, l1BlobSource, l2Sources, kv
 }
 
@@ -79,6 +79,19 @@ func TestRetryingL1Source(t *testing.T) {
 		require.Equal(t, txs, actualTxs)
 	})
 
+	t.Run("InfoAndTxsByNumber Error", func(t *testing.T) {
+		source, mock := createL1Source(t)
+		defer mock.AssertExpectations(t)
+		expectedErr := errors.New("boom")
+		mock.ExpectInfoAndTxsByNumber(7, wrongInfo, nil, expectedErr)
+		mock.ExpectInfoAndTxsByNumber(7, info, txs, nil)
+
+		actualInfo, actualTxs, err := source.InfoAndTxsByNumber(ctx, 7)
+		require.NoError(t, err)
+		require.Equal(t, info, actualInfo)
+		require.Equal(t, txs, actualTxs)
+	})
+
 	t.Run("FetchReceipts Success", func(t *testing.T) {
 		source, mock := createL1Source(t)
 		defer mock.AssertExpectations(t)
@@ -261,6 +274,32 @@ func TestRetryingL2Source(t *testing.T) {
 		require.Equal(t, txs, actualTxs)
 	})
 
+	t.Run("FetchReceipts Success", func(t *testing.T) {
+		source, mock := createL2Source(t)
+		defer mock.AssertExpectations(t)
+		rcpts := types.Receipts{&types.Receipt{}}
+		mock.ExpectFetchReceipts(hash, info, rcpts, nil)
+
+		actualInfo, actualRcpts, err := source.FetchReceipts(ctx, hash)
+		require.NoError(t, err)
+		require.Equal(t, info, actualInfo)
+		require.Equal(t, rcpts, actualRcpts)
+	})
+
+	t.Run("FetchReceipts Error", func(t *testing.T) {
+		source, mock := createL2Source(t)
+		defer mock.AssertExpectations(t)
+		rcpts := types.Receipts{&types.Receipt{}}
+		expectedErr := errors.New("boom")
+		mock.ExpectFetchReceipts(hash, wrongInfo, nil, expectedErr)
+		mock.ExpectFetchReceipts(hash, info, rcpts, nil)
+
+		actualInfo, actualRcpts, err := source.FetchReceipts(ctx, hash)
+		require.NoError(t, err)
+		require.Equal(t, info, actualInfo)
+		require.Equal(t, rcpts, actualRcpts)
+	})
+
 	t.Run("NodeByHash Success", func(t *testing.T) {
 		source, mock := createL2Source(t)
 		defer mock.AssertExpectations(t)
@@ -378,6 +417,11 @@ func (m *MockL2Source) InfoAndTxsByHash(ctx context.Context, blockHash common.Ha
 	return out[0].(eth.BlockInfo), out[1].(types.Transactions), *out[2].(*error)
 }
 
+func (m *MockL2Source) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	out := m.Mock.MethodCalled("FetchReceipts", blockHash)
+	return out[0].(eth.BlockInfo), out[1].(types.Receipts), *out[2].(*error)
+}
+
 func (m *MockL2Source) NodeByHash(ctx context.Context, hash common.Hash) ([]byte, error) {
 	out := m.Mock.MethodCalled("NodeByHash", hash)
 	return out[0].([]byte), *out[1].(*error)
@@ -402,6 +446,10 @@ func (m *MockL2Source) ExpectInfoAndTxsByHash(blockHash common.Hash, info eth.Bl
 	m.Mock.On("InfoAndTxsByHash", blockHash).Once().Return(info, txs, &err)
 }
 
+func (m *MockL2Source) ExpectFetchReceipts(blockHash common.Hash, info eth.BlockInfo, receipts types.Receipts, err error) {
+	m.Mock.On("FetchReceipts", blockHash).Once().Return(info, receipts, &err)
+}
+
 func (m *MockL2Source) ExpectNodeByHash(hash common.Hash, node []byte, err error) {
 	m.Mock.On("NodeByHash", hash).Once().Return(node, &err)
 }
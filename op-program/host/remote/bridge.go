@@ -0,0 +1,54 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Bridge connects the local pre-image and hint channels of a client program to the host at the given TCP address,
+// and forwards the data in both directions until either side closes its channels.
+// If tlsConfig is set, the connection to the host uses TLS.
+func Bridge(ctx context.Context, logger log.Logger, addr string, tlsConfig *tls.Config, preimages io.ReadWriter, hints io.ReadWriter) error {
+	var conn net.Conn
+	var err error
+	if tlsConfig != nil {
+		dialer := tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to pre-image server %s: %w", addr, err)
+	}
+	logger.Info("Connected to remote pre-image server", "addr", addr)
+	session := NewSession(conn)
+	defer session.Close()
+
+	done := make(chan error, 4)
+	forward := func(dst io.Writer, src io.Reader) {
+		_, err := io.Copy(dst, src)
+		done <- err
+	}
+	go forward(session.Preimages(), preimages)
+	go forward(preimages, session.Preimages())
+	go forward(session.Hints(), hints)
+	go forward(hints, session.Hints())
+
+	select {
+	case err := <-done:
+		// Either the client program exited or the host closed the connection
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+			return fmt.Errorf("failed to forward pre-image data: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return nil
+	}
+}
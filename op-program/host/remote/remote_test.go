@@ -0,0 +1,213 @@
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestSession(t *testing.T) {
+	a, b := net.Pipe()
+	client := NewSession(a)
+	server := NewSession(b)
+
+	// Writes larger than a frame are split and reassembled
+	large := make([]byte, maxFramePayload*2+10)
+	for i := range large {
+		large[i] = byte(i)
+	}
+	go func() {
+		_, _ = client.Hints().Write([]byte("hint"))
+		_, _ = client.Preimages().Write(large)
+	}()
+	hint := make([]byte, 4)
+	_, err := io.ReadFull(server.Hints(), hint)
+	require.NoError(t, err)
+	require.Equal(t, "hint", string(hint))
+	received := make([]byte, len(large))
+	_, err = io.ReadFull(server.Preimages(), received)
+	require.NoError(t, err)
+	require.Equal(t, large, received)
+
+	require.NoError(t, client.Close())
+	_, err = server.Preimages().Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+	_, err = server.Hints().Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestBridge(t *testing.T) {
+	t.Run("Plain", func(t *testing.T) {
+		testBridge(t, nil, nil)
+	})
+	t.Run("MutualTLS", func(t *testing.T) {
+		serverTLS, clientTLS := mutualTLSConfigs(t)
+		testBridge(t, serverTLS, clientTLS)
+	})
+}
+
+func testBridge(t *testing.T, serverTLS *tls.Config, clientTLS *tls.Config) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	data := []byte("remote pre-image")
+	key := preimage.Keccak256Key(crypto.Keccak256Hash(data))
+	hints := make(chan string, 1)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if serverTLS != nil {
+		listener = tls.NewListener(listener, serverTLS)
+	}
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- Serve(ctx, logger, listener, DefaultIdleTimeout, func(ctx context.Context, preimages io.ReadWriteCloser, hintRW io.ReadWriteCloser) error {
+			defer preimages.Close()
+			defer hintRW.Close()
+			hintErr := make(chan error, 1)
+			go func() {
+				hintErr <- preimage.NewHintReader(hintRW).NextHint(func(hint string) error {
+					hints <- hint
+					return nil
+				})
+			}()
+			if err := <-hintErr; err != nil {
+				return err
+			}
+			return preimage.NewOracleServer(preimages).NextPreimageRequest(func(k [32]byte) ([]byte, error) {
+				if common.Hash(k) != common.Hash(key.PreimageKey()) {
+					return nil, errors.New("unknown key")
+				}
+				return data, nil
+			})
+		})
+	}()
+
+	// The local channels of the client program, as the VM would provide them
+	clientPreimages, localPreimages, err := preimage.CreateBidirectionalChannel()
+	require.NoError(t, err)
+	clientHints, localHints, err := preimage.CreateBidirectionalChannel()
+	require.NoError(t, err)
+	bridgeDone := make(chan error, 1)
+	go func() {
+		bridgeDone <- Bridge(ctx, logger, listener.Addr().String(), clientTLS, localPreimages, localHints)
+	}()
+
+	preimage.NewHintWriter(clientHints).Hint(rawHint("l1-block 0x01"))
+	require.Equal(t, "l1-block 0x01", <-hints)
+	require.Equal(t, data, preimage.NewOracleClient(clientPreimages).Get(key))
+
+	// The bridge stops once the server closes the session
+	require.NoError(t, <-bridgeDone)
+	cancel()
+	require.NoError(t, <-serveDone)
+}
+
+func TestServeIdleTimeout(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	handlerErr := make(chan error, 1)
+	serveDone := make(chan error, 1)
+	go func() {
+		serveDone <- Serve(ctx, logger, listener, 50*time.Millisecond, func(ctx context.Context, preimages io.ReadWriteCloser, hints io.ReadWriteCloser) error {
+			defer preimages.Close()
+			defer hints.Close()
+			_, err := preimages.Read(make([]byte, 1))
+			handlerErr <- err
+			return err
+		})
+	}()
+
+	// The client connects but never sends any data
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.ErrorIs(t, <-handlerErr, os.ErrDeadlineExceeded)
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF, "should close the idle session")
+
+	cancel()
+	require.NoError(t, <-serveDone)
+}
+
+// mutualTLSConfigs creates the TLS configs of a server and a client that authenticate each other
+// with certificates signed by the same CA.
+func mutualTLSConfigs(t *testing.T) (*tls.Config, *tls.Config) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	leaf := func(serial int64, usage x509.ExtKeyUsage) tls.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	}
+	server := &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{leaf(2, x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	client := &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{leaf(3, x509.ExtKeyUsageClientAuth)},
+		RootCAs:      pool,
+	}
+	return server, client
+}
+
+type rawHint string
+
+func (h rawHint) Hint() string {
+	return string(h)
+}
@@ -0,0 +1,85 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Handler serves the pre-image and hint channels of a client program until the client disconnects.
+// The handler closes the channels before returning.
+type Handler func(ctx context.Context, preimages io.ReadWriteCloser, hints io.ReadWriteCloser) error
+
+// DefaultIdleTimeout is the time after which the session of a client that sent no data is closed.
+// As clients are served one at a time, it bounds the time a stalled or abandoned client blocks the server.
+const DefaultIdleTimeout = 5 * time.Minute
+
+// ListenAndServe listens for client programs on the given TCP address and serves them with the handler,
+// one client at a time, until the context is done.
+// Clients are served one at a time, as the pre-image store of the host is not safe for concurrent sessions.
+// If tlsConfig is set, clients must connect with TLS, e.g. mutual TLS to authenticate them. Without TLS, the
+// connections are neither authenticated nor encrypted, so the address should only be a loopback address.
+func ListenAndServe(ctx context.Context, logger log.Logger, addr string, tlsConfig *tls.Config, handler Handler) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	return Serve(ctx, logger, listener, DefaultIdleTimeout, handler)
+}
+
+// Serve serves the client programs connecting to the listener. The listener is closed when the context is done.
+// The session of a client is closed if no data is received from it within idleTimeout, which also bounds the
+// TLS handshake of TLS listeners.
+func Serve(ctx context.Context, logger log.Logger, listener net.Listener, idleTimeout time.Duration, handler Handler) error {
+	logger.Info("Serving pre-images to remote clients", "addr", listener.Addr())
+	stop := context.AfterFunc(ctx, func() {
+		_ = listener.Close()
+	})
+	defer stop()
+	defer listener.Close()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+		logger.Info("Remote client connected", "remote", conn.RemoteAddr())
+		session := NewSession(&idleTimeoutConn{Conn: conn, timeout: idleTimeout})
+		// Close the session of the current client when shutting down
+		stopSession := context.AfterFunc(ctx, func() {
+			_ = session.Close()
+		})
+		err = handler(ctx, session.Preimages(), session.Hints())
+		stopSession()
+		_ = session.Close()
+		if err != nil {
+			logger.Error("Failed to serve remote client", "remote", conn.RemoteAddr(), "err", err)
+		} else {
+			logger.Info("Remote client disconnected", "remote", conn.RemoteAddr())
+		}
+	}
+}
+
+// idleTimeoutConn is a net.Conn that fails reads once no data was received for the timeout.
+type idleTimeoutConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *idleTimeoutConn) Read(b []byte) (int, error) {
+	if err := c.Conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
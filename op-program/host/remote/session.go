@@ -0,0 +1,176 @@
+// Package remote serves pre-images to a client program running on a different machine than the host.
+//
+// The pre-image and hint channels of the client program are multiplexed over a single TCP connection,
+// as frames of a stream ID byte, a big-endian uint32 payload length and the payload.
+// The host listens for connections with ListenAndServe, and Bridge connects the local channels of a client
+// program, e.g. the file descriptors passed by the fault proof VM, to a remote host.
+package remote
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	streamPreimage byte = iota
+	streamHint
+	numStreams
+)
+
+// maxFramePayload limits the payload of a single frame. Larger writes are split into multiple frames.
+const maxFramePayload = 1 << 20
+
+// Session multiplexes the pre-image and hint streams over a single connection.
+type Session struct {
+	conn net.Conn
+
+	writeLock sync.Mutex
+	streams   [numStreams]*stream
+
+	closeOnce sync.Once
+}
+
+// NewSession starts multiplexing the streams over the connection. The session owns the connection.
+func NewSession(conn net.Conn) *Session {
+	s := &Session{conn: conn}
+	for i := range s.streams {
+		s.streams[i] = newStream(s, byte(i))
+	}
+	go s.readLoop()
+	return s
+}
+
+// Preimages returns the stream of the pre-image channel.
+func (s *Session) Preimages() io.ReadWriteCloser {
+	return s.streams[streamPreimage]
+}
+
+// Hints returns the stream of the hint channel.
+func (s *Session) Hints() io.ReadWriteCloser {
+	return s.streams[streamHint]
+}
+
+// Close closes the connection. Reads of the streams return io.EOF once the received data is consumed.
+func (s *Session) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		err = s.conn.Close()
+		for _, st := range s.streams {
+			st.fail(io.EOF)
+		}
+	})
+	return err
+}
+
+func (s *Session) readLoop() {
+	var header [5]byte
+	for {
+		if _, err := io.ReadFull(s.conn, header[:]); err != nil {
+			s.failStreams(err)
+			return
+		}
+		id, length := header[0], binary.BigEndian.Uint32(header[1:])
+		if id >= numStreams || length > maxFramePayload {
+			s.failStreams(fmt.Errorf("invalid frame: stream %d, length %d", id, length))
+			return
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(s.conn, payload); err != nil {
+			s.failStreams(err)
+			return
+		}
+		s.streams[id].push(payload)
+	}
+}
+
+func (s *Session) failStreams(err error) {
+	if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+		err = io.EOF
+	} else {
+		err = fmt.Errorf("remote pre-image connection failed: %w", err)
+	}
+	for _, st := range s.streams {
+		st.fail(err)
+	}
+}
+
+func (s *Session) writeFrame(id byte, payload []byte) error {
+	var header [5]byte
+	header[0] = id
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	if _, err := s.conn.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := s.conn.Write(payload)
+	return err
+}
+
+// stream is one direction-agnostic channel of a session. Received data is buffered until read.
+type stream struct {
+	session *Session
+	id      byte
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	buf  []byte
+	err  error
+}
+
+func newStream(session *Session, id byte) *stream {
+	st := &stream{session: session, id: id}
+	st.cond = sync.NewCond(&st.mu)
+	return st
+}
+
+func (st *stream) push(data []byte) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.buf = append(st.buf, data...)
+	st.cond.Broadcast()
+}
+
+func (st *stream) fail(err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.err == nil {
+		st.err = err
+	}
+	st.cond.Broadcast()
+}
+
+func (st *stream) Read(p []byte) (int, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for len(st.buf) == 0 && st.err == nil {
+		st.cond.Wait()
+	}
+	if len(st.buf) == 0 {
+		return 0, st.err
+	}
+	n := copy(p, st.buf)
+	st.buf = st.buf[n:]
+	return n, nil
+}
+
+func (st *stream) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := min(len(p), written+maxFramePayload)
+		if err := st.session.writeFrame(st.id, p[written:end]); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+// Close closes the whole session, as the pre-image and hint channels are only used together.
+func (st *stream) Close() error {
+	return st.session.Close()
+}
@@ -15,12 +15,14 @@ const (
 	DataFormatFile      DataFormat = "file"
 	DataFormatDirectory DataFormat = "directory"
 	DataFormatPebble    DataFormat = "pebble"
+	DataFormatSharded   DataFormat = "sharded"
 )
 
-var SupportedDataFormats = []DataFormat{DataFormatFile, DataFormatDirectory, DataFormatPebble}
+var SupportedDataFormats = []DataFormat{DataFormatFile, DataFormatDirectory, DataFormatPebble, DataFormatSharded}
 
 type L2Source interface {
 	InfoAndTxsByHash(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Transactions, error)
+	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
 	NodeByHash(ctx context.Context, hash common.Hash) ([]byte, error)
 	CodeByHash(ctx context.Context, hash common.Hash) ([]byte, error)
 	OutputByRoot(ctx context.Context, blockRoot common.Hash) (eth.Output, error)
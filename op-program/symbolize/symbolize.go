@@ -0,0 +1,190 @@
+// Package symbolize maps program counters of the fault proof program client to Go source locations,
+// using the DWARF debug info of the client ELF.
+// Guest panics and VM aborts often only report raw addresses,
+// symbolizing them turns these into actionable stack traces.
+package symbolize
+
+import (
+	"bufio"
+	"debug/dwarf"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Frame is the source location of a program counter.
+type Frame struct {
+	PC       uint64
+	Function string
+	File     string
+	Line     int
+}
+
+func (f Frame) String() string {
+	return fmt.Sprintf("%s %s:%d", f.Function, f.File, f.Line)
+}
+
+type lineEntry struct {
+	addr uint64
+	file string
+	line int
+	// end marks the first address after a sequence of instructions, i.e. no source location
+	end bool
+}
+
+type funcRange struct {
+	low  uint64
+	high uint64
+	name string
+}
+
+// Symbolizer resolves program counters to source locations.
+type Symbolizer struct {
+	lines []lineEntry
+	funcs []funcRange
+}
+
+// NewSymbolizer loads the DWARF debug info of the ELF file at the given path.
+// The ELF must not be stripped of debug info, e.g. by building with -ldflags=-w.
+func NewSymbolizer(path string) (*Symbolizer, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ELF: %w", err)
+	}
+	defer f.Close()
+	d, err := f.DWARF()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DWARF info: %w", err)
+	}
+	return newSymbolizer(d)
+}
+
+func newSymbolizer(d *dwarf.Data) (*Symbolizer, error) {
+	s := &Symbolizer{}
+	r := d.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DWARF entry: %w", err)
+		}
+		if entry == nil {
+			break
+		}
+		switch entry.Tag {
+		case dwarf.TagCompileUnit:
+			if err := s.addLines(d, entry); err != nil {
+				return nil, err
+			}
+		case dwarf.TagSubprogram:
+			name, _ := entry.Val(dwarf.AttrName).(string)
+			ranges, err := d.Ranges(entry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ranges of %s: %w", name, err)
+			}
+			for _, rng := range ranges {
+				s.funcs = append(s.funcs, funcRange{low: rng[0], high: rng[1], name: name})
+			}
+			r.SkipChildren()
+		}
+	}
+	// Keep the original order of entries at the same address, the last one of a sequence is the relevant one
+	sort.SliceStable(s.lines, func(i, j int) bool { return s.lines[i].addr < s.lines[j].addr })
+	sort.Slice(s.funcs, func(i, j int) bool { return s.funcs[i].low < s.funcs[j].low })
+	if len(s.lines) == 0 {
+		return nil, errors.New("no line info found")
+	}
+	return s, nil
+}
+
+func (s *Symbolizer) addLines(d *dwarf.Data, cu *dwarf.Entry) error {
+	lr, err := d.LineReader(cu)
+	if err != nil {
+		return fmt.Errorf("failed to read line table: %w", err)
+	}
+	if lr == nil {
+		return nil
+	}
+	var entry dwarf.LineEntry
+	for {
+		if err := lr.Next(&entry); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read line entry: %w", err)
+		}
+		line := lineEntry{addr: entry.Address, line: entry.Line, end: entry.EndSequence}
+		if entry.File != nil {
+			line.file = entry.File.Name
+		}
+		s.lines = append(s.lines, line)
+	}
+}
+
+// Lookup returns the source location of the instruction at the given program counter.
+// Note that the program counters of callers in a stack trace are return addresses,
+// which point after the call instruction (and its delay slot on MIPS).
+func (s *Symbolizer) Lookup(pc uint64) (Frame, bool) {
+	i := sort.Search(len(s.lines), func(i int) bool { return s.lines[i].addr > pc })
+	if i == 0 || s.lines[i-1].end {
+		return Frame{}, false
+	}
+	line := s.lines[i-1]
+	frame := Frame{PC: pc, Function: "?", File: line.file, Line: line.line}
+	j := sort.Search(len(s.funcs), func(j int) bool { return s.funcs[j].low > pc })
+	if j > 0 && pc < s.funcs[j-1].high {
+		frame.Function = s.funcs[j-1].name
+	}
+	return frame, true
+}
+
+// addrPattern matches hex addresses, with or without 0x prefix if labelled as program counter,
+// e.g. "pc=0x4a5c8", "PC: 0004a5c8" or "0x4a5c8".
+var addrPattern = regexp.MustCompile(`(?i)\bpc[:=]\s*(?:0x)?([0-9a-f]+)\b|\b0x([0-9a-f]+)\b`)
+
+// SymbolizeLine annotates every address in the line that resolves to a source location.
+// Offsets into functions, as printed by Go tracebacks ("+0x1c"), are not addresses and are left as is.
+func (s *Symbolizer) SymbolizeLine(line string) string {
+	var out []byte
+	last := 0
+	for _, m := range addrPattern.FindAllStringSubmatchIndex(line, -1) {
+		start, end := m[0], m[1]
+		if start > 0 && line[start-1] == '+' {
+			continue
+		}
+		hexStart, hexEnd := m[2], m[3]
+		if hexStart < 0 {
+			hexStart, hexEnd = m[4], m[5]
+		}
+		pc, err := strconv.ParseUint(line[hexStart:hexEnd], 16, 64)
+		if err != nil {
+			continue
+		}
+		frame, ok := s.Lookup(pc)
+		if !ok {
+			continue
+		}
+		out = append(out, line[last:end]...)
+		out = append(out, " ["+frame.String()+"]"...)
+		last = end
+	}
+	return string(append(out, line[last:]...))
+}
+
+// SymbolizeText annotates the addresses of every line read from in, and writes the result to out.
+func (s *Symbolizer) SymbolizeText(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	w := bufio.NewWriter(out)
+	for scanner.Scan() {
+		if _, err := w.WriteString(s.SymbolizeLine(scanner.Text()) + "\n"); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
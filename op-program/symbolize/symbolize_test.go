@@ -0,0 +1,75 @@
+package symbolize
+
+import (
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// buildTestProgram builds the test program with debug info, and returns its path and the address of main.target.
+func buildTestProgram(t *testing.T) (string, uint64) {
+	if runtime.GOOS != "linux" {
+		t.Skip("test requires building an ELF")
+	}
+	path := filepath.Join(t.TempDir(), "prog.elf")
+	cmd := exec.Command("go", "build", "-o", path, "./testdata/prog")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	f, err := elf.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	syms, err := f.Symbols()
+	require.NoError(t, err)
+	for _, sym := range syms {
+		if sym.Name == "main.target" {
+			return path, sym.Value
+		}
+	}
+	t.Fatal("main.target symbol not found")
+	return "", 0
+}
+
+func TestLookup(t *testing.T) {
+	path, pc := buildTestProgram(t)
+	s, err := NewSymbolizer(path)
+	require.NoError(t, err)
+
+	frame, ok := s.Lookup(pc)
+	require.True(t, ok)
+	require.Equal(t, pc, frame.PC)
+	require.Equal(t, "main.target", frame.Function)
+	require.True(t, strings.HasSuffix(frame.File, "testdata/prog/main.go"), "unexpected file %s", frame.File)
+	// The entry of main.target is attributed either to the function declaration or its first statement
+	require.Contains(t, []int{6, 7}, frame.Line)
+
+	_, ok = s.Lookup(0)
+	require.False(t, ok)
+}
+
+func TestSymbolizeText(t *testing.T) {
+	path, pc := buildTestProgram(t)
+	s, err := NewSymbolizer(path)
+	require.NoError(t, err)
+
+	input := strings.Join([]string{
+		fmt.Sprintf("failed at step 100 (PC: %08x): boom", pc),
+		fmt.Sprintf("\t/app/main.go:12 +0x%x", pc),
+		fmt.Sprintf("pc=0x%x unknown=0x0", pc),
+	}, "\n")
+	var out bytes.Buffer
+	require.NoError(t, s.SymbolizeText(strings.NewReader(input), &out))
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[0], fmt.Sprintf("PC: %08x [main.target ", pc))
+	require.Equal(t, fmt.Sprintf("\t/app/main.go:12 +0x%x", pc), lines[1], "offsets must not be symbolized")
+	require.Contains(t, lines[2], fmt.Sprintf("pc=0x%x [main.target ", pc))
+	require.True(t, strings.HasSuffix(lines[2], "unknown=0x0"))
+}
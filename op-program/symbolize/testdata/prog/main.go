@@ -0,0 +1,12 @@
+package main
+
+import "os"
+
+//go:noinline
+func target() int {
+	return len(os.Args)
+}
+
+func main() {
+	os.Exit(target())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
@@ -20,10 +21,16 @@ const (
 	methodInitBonds   = "initBonds"
 	methodCreateGame  = "create"
 	methodVersion     = "version"
+	methodGameImpls   = "gameImpls"
 
-	methodClaim = "claimData"
+	methodClaim           = "claimData"
+	methodMaxGameDepth    = "maxGameDepth"
+	methodGetRequiredBond = "getRequiredBond"
 )
 
+// proposalTimesBatchSize is the number of games loaded per batch when scanning for proposal times.
+const proposalTimesBatchSize = 100
+
 type gameMetadata struct {
 	GameType  uint32
 	Timestamp time.Time
@@ -32,6 +39,16 @@ type gameMetadata struct {
 	Claim     common.Hash
 }
 
+// GameBonds are the bonds the proposer posts, when creating a game of a specific game type and defending it.
+type GameBonds struct {
+	// InitBond is the bond posted when creating the game.
+	InitBond *big.Int
+	// DefenseBonds is the total bond of defending the root claim against a challenge at every depth,
+	// i.e. the bonds of the proposer moves at all even depths up to the max game depth.
+	// Defense bonds are returned to the proposer if the root claim is valid.
+	DefenseBonds *big.Int
+}
+
 type DisputeGameFactory struct {
 	caller         *batching.MultiCaller
 	contract       *batching.BoundContract
@@ -91,6 +108,36 @@ func (f *DisputeGameFactory) HasProposedSince(ctx context.Context, proposer comm
 	}
 }
 
+// ProposalTimesSince returns the creation times of all games with the specified game type created by any
+// proposer at or after the given cut off time, oldest first.
+// Games are loaded in batches of proposalTimesBatchSize, newest first, until a game before the cut off is found.
+func (f *DisputeGameFactory) ProposalTimesSince(ctx context.Context, cutoff time.Time, gameType uint32) ([]time.Time, error) {
+	gameCount, err := f.gameCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute game count: %w", err)
+	}
+	var times []time.Time
+	for end := gameCount; end > 0; {
+		start := end - min(end, proposalTimesBatchSize)
+		games, err := f.gamesAtIndices(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(games) - 1; i >= 0; i-- {
+			if games[i].Timestamp.Before(cutoff) {
+				slices.Reverse(times)
+				return times, nil
+			}
+			if games[i].GameType == gameType {
+				times = append(times, games[i].Timestamp)
+			}
+		}
+		end = start
+	}
+	slices.Reverse(times)
+	return times, nil
+}
+
 func (f *DisputeGameFactory) ProposalTx(ctx context.Context, gameType uint32, outputRoot common.Hash, l2BlockNum uint64) (txmgr.TxCandidate, error) {
 	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
 	defer cancel()
@@ -108,6 +155,55 @@ func (f *DisputeGameFactory) ProposalTx(ctx context.Context, gameType uint32, ou
 	return candidate, err
 }
 
+// GameBonds loads the init bond of the game type, and the bonds of defending a game of this game type.
+func (f *DisputeGameFactory) GameBonds(ctx context.Context, gameType uint32) (GameBonds, error) {
+	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	results, err := f.caller.Call(cCtx, rpcblock.Latest,
+		f.contract.Call(methodInitBonds, gameType),
+		f.contract.Call(methodGameImpls, gameType))
+	if err != nil {
+		return GameBonds{}, fmt.Errorf("failed to fetch init bond and game implementation: %w", err)
+	}
+	initBond := results[0].GetBigInt(0)
+	impl := results[1].GetAddress(0)
+	if impl == (common.Address{}) {
+		return GameBonds{}, fmt.Errorf("no game implementation for game type %v", gameType)
+	}
+
+	gameContract := batching.NewBoundContract(f.gameABI, impl)
+	cCtx, cancel = context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	result, err := f.caller.SingleCall(cCtx, rpcblock.Latest, gameContract.Call(methodMaxGameDepth))
+	if err != nil {
+		return GameBonds{}, fmt.Errorf("failed to fetch max game depth: %w", err)
+	}
+	maxDepth := result.GetBigInt(0).Uint64()
+
+	// The required bond only depends on the depth of the position, so use the left-most position at each depth.
+	var calls []batching.Call
+	for depth := uint64(2); depth <= maxDepth; depth += 2 {
+		position := new(big.Int).Lsh(big.NewInt(1), uint(depth))
+		calls = append(calls, gameContract.Call(methodGetRequiredBond, position))
+	}
+	defenseBonds := new(big.Int)
+	if len(calls) > 0 {
+		cCtx, cancel = context.WithTimeout(ctx, f.networkTimeout)
+		defer cancel()
+		results, err = f.caller.Call(cCtx, rpcblock.Latest, calls...)
+		if err != nil {
+			return GameBonds{}, fmt.Errorf("failed to fetch required bonds: %w", err)
+		}
+		for _, result := range results {
+			defenseBonds.Add(defenseBonds, result.GetBigInt(0))
+		}
+	}
+	return GameBonds{
+		InitBond:     initBond,
+		DefenseBonds: defenseBonds,
+	}, nil
+}
+
 func (f *DisputeGameFactory) gameCount(ctx context.Context) (uint64, error) {
 	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
 	defer cancel()
@@ -118,6 +214,30 @@ func (f *DisputeGameFactory) gameCount(ctx context.Context) (uint64, error) {
 	return result.GetBigInt(0).Uint64(), nil
 }
 
+// gamesAtIndices loads the game type, creation time and address of the games with index in [start, end),
+// without loading their root claims.
+func (f *DisputeGameFactory) gamesAtIndices(ctx context.Context, start, end uint64) ([]gameMetadata, error) {
+	calls := make([]batching.Call, 0, end-start)
+	for idx := start; idx < end; idx++ {
+		calls = append(calls, f.contract.Call(methodGameAtIndex, new(big.Int).SetUint64(idx)))
+	}
+	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
+	defer cancel()
+	results, err := f.caller.Call(cCtx, rpcblock.Latest, calls...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load games %v to %v: %w", start, end-1, err)
+	}
+	games := make([]gameMetadata, 0, len(results))
+	for _, result := range results {
+		games = append(games, gameMetadata{
+			GameType:  result.GetUint32(0),
+			Timestamp: time.Unix(int64(result.GetUint64(1)), 0),
+			Address:   result.GetAddress(2),
+		})
+	}
+	return games, nil
+}
+
 func (f *DisputeGameFactory) gameAtIndex(ctx context.Context, idx uint64) (gameMetadata, error) {
 	cCtx, cancel := context.WithTimeout(ctx, f.networkTimeout)
 	defer cancel()
@@ -185,6 +185,70 @@ func TestHasProposedSince(t *testing.T) {
 	})
 }
 
+func TestProposalTimesSince(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	withClaims(
+		stubRpc,
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(999, 0), // Before cut off
+			Address:   common.Address{0x11},
+			Proposer:  proposerAddr,
+		},
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(1000, 0),
+			Address:   common.Address{0x22},
+			Proposer:  proposerAddr,
+		},
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(1100, 0),
+			Address:   common.Address{0x33},
+			Proposer:  common.Address{0xee}, // Other proposer
+		},
+		gameMetadata{
+			GameType:  1, // Wrong game type
+			Timestamp: time.Unix(1200, 0),
+			Address:   common.Address{0x44},
+			Proposer:  proposerAddr,
+		},
+		gameMetadata{
+			GameType:  0,
+			Timestamp: time.Unix(1300, 0),
+			Address:   common.Address{0x55},
+			Proposer:  proposerAddr,
+		},
+	)
+
+	times, err := factory.ProposalTimesSince(context.Background(), time.Unix(1000, 0), 0)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{time.Unix(1000, 0), time.Unix(1100, 0), time.Unix(1300, 0)}, times)
+}
+
+func TestProposalTimesSinceMultipleBatches(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	games := make([]gameMetadata, 0, 2*proposalTimesBatchSize+10)
+	var expected []time.Time
+	for i := 0; i < cap(games); i++ {
+		game := gameMetadata{
+			GameType:  uint32(i % 2),
+			Timestamp: time.Unix(int64(1000+i), 0),
+			Address:   common.Address{byte(i), byte(i >> 8), 0x01},
+			Proposer:  proposerAddr,
+		}
+		games = append(games, game)
+		if i >= 5 && game.GameType == 0 {
+			expected = append(expected, game.Timestamp)
+		}
+	}
+	withClaims(stubRpc, games...)
+
+	times, err := factory.ProposalTimesSince(context.Background(), time.Unix(1005, 0), 0)
+	require.NoError(t, err)
+	require.Equal(t, expected, times)
+}
+
 func TestProposalTx(t *testing.T) {
 	stubRpc, factory := setupDisputeGameFactoryTest(t)
 	traceType := uint32(123)
@@ -200,6 +264,23 @@ func TestProposalTx(t *testing.T) {
 	require.Truef(t, bond.Cmp(tx.Value) == 0, "Expected bond %v but was %v", bond, tx.Value)
 }
 
+func TestGameBonds(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	gameType := uint32(1)
+	impl := common.Address{0xcc}
+	stubRpc.SetResponse(factoryAddr, methodInitBonds, rpcblock.Latest, []interface{}{gameType}, []interface{}{big.NewInt(1000)})
+	stubRpc.SetResponse(factoryAddr, methodGameImpls, rpcblock.Latest, []interface{}{gameType}, []interface{}{impl})
+	stubRpc.AddContract(impl, snapshots.LoadFaultDisputeGameABI())
+	stubRpc.SetResponse(impl, methodMaxGameDepth, rpcblock.Latest, nil, []interface{}{big.NewInt(5)})
+	stubRpc.SetResponse(impl, methodGetRequiredBond, rpcblock.Latest, []interface{}{big.NewInt(4)}, []interface{}{big.NewInt(20)})
+	stubRpc.SetResponse(impl, methodGetRequiredBond, rpcblock.Latest, []interface{}{big.NewInt(16)}, []interface{}{big.NewInt(300)})
+
+	bonds, err := factory.GameBonds(context.Background(), gameType)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), bonds.InitBond)
+	require.Equal(t, big.NewInt(320), bonds.DefenseBonds)
+}
+
 func withClaims(stubRpc *batchingTest.AbiBasedRpc, games ...gameMetadata) {
 	gameAbi := snapshots.LoadFaultDisputeGameABI()
 	stubRpc.SetResponse(factoryAddr, methodGameCount, rpcblock.Latest, nil, []interface{}{big.NewInt(int64(len(games)))})
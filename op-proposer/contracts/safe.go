@@ -0,0 +1,128 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	methodNonce              = "nonce"
+	methodGetThreshold       = "getThreshold"
+	methodGetOwners          = "getOwners"
+	methodGetTransactionHash = "getTransactionHash"
+	methodExecTransaction    = "execTransaction"
+)
+
+// SafeOperationCall is the Safe operation of a regular call, as opposed to a delegate call.
+const SafeOperationCall uint8 = 0
+
+// safeABI is the subset of the Safe (>= v1.3.0) ABI used to execute transactions.
+const safeABI = `[
+	{"type":"function","name":"nonce","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getThreshold","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getOwners","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address[]"}]},
+	{"type":"function","name":"getTransactionHash","stateMutability":"view","inputs":[
+		{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},
+		{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},
+		{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},
+		{"name":"_nonce","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"execTransaction","stateMutability":"payable","inputs":[
+		{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},
+		{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},
+		{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},
+		{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]}
+]`
+
+// SafeTx is a transaction executed by a Safe. Gas refunds are not used, so the executor of the
+// Safe transaction pays for the gas and the Safe transaction is executed with all available gas.
+type SafeTx struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// LoadSafeABI returns the ABI of the Safe functions used to execute transactions.
+func LoadSafeABI() *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(safeABI))
+	if err != nil {
+		panic(fmt.Errorf("invalid safe abi: %w", err))
+	}
+	return &parsed
+}
+
+type Safe struct {
+	caller         *batching.MultiCaller
+	contract       *batching.BoundContract
+	networkTimeout time.Duration
+}
+
+func NewSafe(addr common.Address, caller *batching.MultiCaller, networkTimeout time.Duration) *Safe {
+	return &Safe{
+		caller:         caller,
+		contract:       batching.NewBoundContract(LoadSafeABI(), addr),
+		networkTimeout: networkTimeout,
+	}
+}
+
+func (s *Safe) Addr() common.Address {
+	return s.contract.Addr()
+}
+
+// Owners returns the owners of the Safe and the number of owner signatures required to execute a transaction.
+func (s *Safe) Owners(ctx context.Context) ([]common.Address, uint64, error) {
+	cCtx, cancel := context.WithTimeout(ctx, s.networkTimeout)
+	defer cancel()
+	results, err := s.caller.Call(cCtx, rpcblock.Latest,
+		s.contract.Call(methodGetOwners),
+		s.contract.Call(methodGetThreshold))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch safe owners: %w", err)
+	}
+	var owners []common.Address
+	results[0].GetStruct(0, &owners)
+	return owners, results[1].GetBigInt(0).Uint64(), nil
+}
+
+// TransactionHash returns the nonce of the next Safe transaction, and the hash of tx with this nonce
+// that the owners have to sign.
+func (s *Safe) TransactionHash(ctx context.Context, tx SafeTx) (uint64, common.Hash, error) {
+	cCtx, cancel := context.WithTimeout(ctx, s.networkTimeout)
+	defer cancel()
+	result, err := s.caller.SingleCall(cCtx, rpcblock.Latest, s.contract.Call(methodNonce))
+	if err != nil {
+		return 0, common.Hash{}, fmt.Errorf("failed to fetch safe nonce: %w", err)
+	}
+	nonce := result.GetBigInt(0)
+	result, err = s.caller.SingleCall(cCtx, rpcblock.Latest, s.contract.Call(methodGetTransactionHash,
+		tx.To, safeTxValue(tx), tx.Data, SafeOperationCall, common.Big0, common.Big0, common.Big0,
+		common.Address{}, common.Address{}, nonce))
+	if err != nil {
+		return 0, common.Hash{}, fmt.Errorf("failed to fetch safe transaction hash: %w", err)
+	}
+	return nonce.Uint64(), result.GetHash(0), nil
+}
+
+// ExecTransactionTx returns the transaction executing tx with the owner signatures.
+// The signatures must be sorted by owner address, as required by the Safe.
+func (s *Safe) ExecTransactionTx(tx SafeTx, signatures []byte) (txmgr.TxCandidate, error) {
+	call := s.contract.Call(methodExecTransaction,
+		tx.To, safeTxValue(tx), tx.Data, SafeOperationCall, common.Big0, common.Big0, common.Big0,
+		common.Address{}, common.Address{}, signatures)
+	return call.ToTxCandidate()
+}
+
+func safeTxValue(tx SafeTx) *big.Int {
+	if tx.Value == nil {
+		return common.Big0
+	}
+	return tx.Value
+}
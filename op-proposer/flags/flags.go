@@ -60,6 +60,12 @@ var (
 		Usage:   "Interval between submitting L2 output proposals when the dispute game factory address is set",
 		EnvVars: prefixEnvVars("PROPOSAL_INTERVAL"),
 	}
+	ProposalScheduleFlag = &cli.StringFlag{
+		Name: "proposal-schedule",
+		Usage: "Schedule of L2 output proposals when the dispute game factory address is set, instead of a proposal interval. " +
+			"Either a cron schedule in UTC, e.g. \"cron:0 */6 * * *\", or every Nth L1 epoch boundary, e.g. \"l1-epochs:32\"",
+		EnvVars: prefixEnvVars("PROPOSAL_SCHEDULE"),
+	}
 	DisputeGameTypeFlag = &cli.UintFlag{
 		Name:    "game-type",
 		Usage:   "Dispute game type to create via the configured DisputeGameFactory",
@@ -79,6 +85,59 @@ var (
 		Value:   false,
 		EnvVars: prefixEnvVars("WAIT_NODE_SYNC"),
 	}
+	GameBudgetFlag = &cli.Float64Flag{
+		Name: "game-budget",
+		Usage: "Maximum expected cost, in gwei, of creating and defending a dispute game. " +
+			"The game creation is simulated before proposing, and the proposal is refused if the expected cost exceeds the budget. " +
+			"Disabled if zero.",
+		Value:   0,
+		EnvVars: prefixEnvVars("GAME_BUDGET"),
+	}
+	ForceFlag = &cli.BoolFlag{
+		Name:    "force",
+		Usage:   "Propose even if the expected cost of the dispute game exceeds the game budget.",
+		Value:   false,
+		EnvVars: prefixEnvVars("FORCE"),
+	}
+	ConductorRpcFlag = &cli.StringFlag{
+		Name: "conductor-rpc",
+		Usage: "HTTP provider URL of the op-conductor of the paired sequencer. " +
+			"If set, outputs are only proposed while the paired sequencer is the healthy leader, " +
+			"so redundant proposers in a high-availability setup do not propose the same output twice.",
+		EnvVars: prefixEnvVars("CONDUCTOR_RPC"),
+	}
+	BackfillLookbackFlag = &cli.DurationFlag{
+		Name: "backfill-lookback",
+		Usage: "How far back to scan the DisputeGameFactory on startup for missed proposal intervals, e.g. during downtime. " +
+			"Proposals are backfilled for the missed checkpoints. Disabled if zero.",
+		Value:   0,
+		EnvVars: prefixEnvVars("BACKFILL_LOOKBACK"),
+	}
+	BackfillMaxAgeFlag = &cli.DurationFlag{
+		Name: "backfill-max-age",
+		Usage: "Maximum age of a missed checkpoint to still be backfilled. Older missed checkpoints are reported as skipped. " +
+			"If zero, all missed checkpoints within the backfill lookback are backfilled.",
+		Value:   0,
+		EnvVars: prefixEnvVars("BACKFILL_MAX_AGE"),
+	}
+	SafeAddressFlag = &cli.StringFlag{
+		Name: "safe-address",
+		Usage: "Address of a Safe to propose through. Proposals are wrapped in Safe transactions, signed by the Safe signers " +
+			"and executed by the transaction manager account, which only pays the gas.",
+		EnvVars: prefixEnvVars("SAFE_ADDRESS"),
+	}
+	SupervisorRpcFlag = &cli.StringFlag{
+		Name: "supervisor-rpc",
+		Usage: "HTTP provider URL for the interop supervisor. If set, super roots of all chains in the dependency set " +
+			"are proposed to the DisputeGameFactory instead of output roots of the rollup node, and the rollup RPC is not used.",
+		EnvVars: prefixEnvVars("SUPERVISOR_RPC"),
+	}
+	SafeSignersFlag = &cli.StringSliceFlag{
+		Name: "safe-signers",
+		Usage: "Signer services of the Safe owners, in the form <owner address>=<signer endpoint>. " +
+			"Signatures are collected in order until the Safe threshold is met. The signer headers and TLS config are shared with the transaction manager signer.",
+		EnvVars: prefixEnvVars("SAFE_SIGNERS"),
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -95,9 +154,18 @@ var optionalFlags = []cli.Flag{
 	L2OutputHDPathFlag,
 	DisputeGameFactoryAddressFlag,
 	ProposalIntervalFlag,
+	ProposalScheduleFlag,
 	DisputeGameTypeFlag,
 	ActiveSequencerCheckDurationFlag,
 	WaitNodeSyncFlag,
+	GameBudgetFlag,
+	ForceFlag,
+	ConductorRpcFlag,
+	BackfillLookbackFlag,
+	BackfillMaxAgeFlag,
+	SafeAddressFlag,
+	SafeSignersFlag,
+	SupervisorRpcFlag,
 }
 
 func init() {
@@ -115,6 +183,10 @@ var Flags []cli.Flag
 
 func CheckRequired(ctx *cli.Context) error {
 	for _, f := range requiredFlags {
+		// Super roots are proposed from the supervisor, without a rollup node.
+		if f == RollupRpcFlag && ctx.IsSet(SupervisorRpcFlag.Name) {
+			continue
+		}
 		if !ctx.IsSet(f.Names()[0]) {
 			return fmt.Errorf("flag %s is required", f.Names()[0])
 		}
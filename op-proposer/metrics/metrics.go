@@ -34,6 +34,7 @@ type Metricer interface {
 	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
 
 	RecordL2BlocksProposed(l2ref eth.L2BlockRef)
+	RecordSuperRootProposed(timestamp uint64)
 }
 
 type Metrics struct {
@@ -47,6 +48,8 @@ type Metrics struct {
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
+
+	superRootProposed prometheus.Gauge
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -81,6 +84,11 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-proposer has finished starting up",
 		}),
+		superRootProposed: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "super_root_proposed_timestamp",
+			Help:      "Timestamp of the latest proposed super root",
+		}),
 	}
 }
 
@@ -113,6 +121,11 @@ func (m *Metrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
 	m.RecordL2Ref(BlockProposed, l2ref)
 }
 
+// RecordSuperRootProposed should be called when a new super root is proposed
+func (m *Metrics) RecordSuperRootProposed(timestamp uint64) {
+	m.superRootProposed.Set(float64(timestamp))
+}
+
 func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
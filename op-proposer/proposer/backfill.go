@@ -0,0 +1,148 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	skipReasonMaxAge      = "older than max age"
+	skipReasonNotProposed = "L2 block not yet proposable"
+	skipReasonFailed      = "proposal failed"
+)
+
+// SkippedRange is a range of missed checkpoints that were not backfilled.
+type SkippedRange struct {
+	From   time.Time
+	To     time.Time
+	Reason string
+}
+
+// BackfillReport summarizes a backfill run.
+type BackfillReport struct {
+	// Missed is the number of missed checkpoints that were found.
+	Missed int
+	// Proposed are the L2 block numbers that were proposed to backfill missed checkpoints.
+	Proposed []uint64
+	// Skipped are the ranges of missed checkpoints that were not backfilled, in chronological order.
+	Skipped []SkippedRange
+}
+
+func (r *BackfillReport) skip(checkpoint time.Time, reason string) {
+	if n := len(r.Skipped); n > 0 && r.Skipped[n-1].Reason == reason {
+		r.Skipped[n-1].To = checkpoint
+		return
+	}
+	r.Skipped = append(r.Skipped, SkippedRange{From: checkpoint, To: checkpoint, Reason: reason})
+}
+
+// missedCheckpoints returns the checkpoints in [start, end - interval] without a proposal in the interval following
+// them. Checkpoints start at the given start time, and are re-aligned to every proposal made.
+// The proposal times must be sorted in ascending order.
+func missedCheckpoints(start, end time.Time, proposals []time.Time, interval time.Duration) []time.Time {
+	var missed []time.Time
+	checkpoint := start
+	for _, proposal := range append(slices.Clone(proposals), end) {
+		for !checkpoint.Add(interval).After(proposal) {
+			missed = append(missed, checkpoint)
+			checkpoint = checkpoint.Add(interval)
+		}
+		if !proposal.Before(checkpoint) {
+			checkpoint = proposal.Add(interval)
+		}
+	}
+	return missed
+}
+
+// backfill scans the DisputeGameFactory for proposal intervals missed within the backfill lookback,
+// e.g. because the proposer was down, and proposes the outputs of the missed checkpoints.
+// Games of the proposal game type created by any proposer count as proposals, so intervals covered by
+// another proposer are not backfilled.
+// Missed checkpoints older than the backfill max age are not backfilled, but reported as skipped.
+func (l *L2OutputSubmitter) backfill(ctx context.Context) (*BackfillReport, error) {
+	now := l.Clock.Now()
+	start := now.Add(-l.Cfg.BackfillLookback)
+	proposals, err := l.dgfContract.ProposalTimesSince(ctx, start, l.Cfg.DisputeGameType)
+	if err != nil {
+		return nil, fmt.Errorf("could not load recent proposals: %w", err)
+	}
+	report := &BackfillReport{}
+	missed := missedCheckpoints(start, now, proposals, l.Cfg.ProposalInterval)
+	report.Missed = len(missed)
+	if len(missed) == 0 {
+		return report, nil
+	}
+
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup config: %w", err)
+	}
+	currentBlockNumber, err := l.FetchCurrentBlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch current block number: %w", err)
+	}
+
+	var lastProposed uint64
+	for _, checkpoint := range missed {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		if l.Cfg.BackfillMaxAge != 0 && now.Sub(checkpoint) > l.Cfg.BackfillMaxAge {
+			report.skip(checkpoint, skipReasonMaxAge)
+			continue
+		}
+		blockNum, err := rollupCfg.TargetBlockNumber(uint64(checkpoint.Unix()))
+		if err != nil || blockNum == 0 || blockNum > currentBlockNumber {
+			report.skip(checkpoint, skipReasonNotProposed)
+			continue
+		}
+		if blockNum == lastProposed {
+			continue
+		}
+		output, err := l.FetchOutput(ctx, blockNum)
+		if err != nil {
+			l.Log.Warn("Failed to fetch output to backfill", "checkpoint", checkpoint, "l2blocknum", blockNum, "err", err)
+			report.skip(checkpoint, skipReasonFailed)
+			continue
+		}
+		l.Log.Info("Backfilling missed proposal", "checkpoint", checkpoint, "l2blocknum", blockNum)
+		if !l.proposeOutput(ctx, output) {
+			report.skip(checkpoint, skipReasonFailed)
+			continue
+		}
+		report.Proposed = append(report.Proposed, blockNum)
+		lastProposed = blockNum
+	}
+	return report, nil
+}
+
+// runBackfill runs the backfill, if enabled, and logs its report.
+func (l *L2OutputSubmitter) runBackfill(ctx context.Context) {
+	if l.dgfContract == nil || l.Cfg.BackfillLookback == 0 {
+		return
+	}
+	if active, err := l.isActiveProposer(ctx); err != nil {
+		l.Log.Warn("Error checking sequencer leadership, not backfilling", "err", err)
+		return
+	} else if !active {
+		l.Log.Info("Paired sequencer is not the healthy leader, not backfilling")
+		return
+	}
+
+	report, err := l.backfill(ctx)
+	if err != nil {
+		l.Log.Error("Failed to backfill missed proposals", "err", err)
+		return
+	}
+	for _, skipped := range report.Skipped {
+		l.Log.Warn("Skipped backfilling missed checkpoints", "from", skipped.From, "to", skipped.To, "reason", skipped.Reason)
+	}
+	l.Log.Info("Backfill complete", "lookback", l.Cfg.BackfillLookback, "missed", report.Missed,
+		"proposed", len(report.Proposed), "skipped_ranges", len(report.Skipped))
+}
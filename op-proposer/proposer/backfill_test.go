@@ -0,0 +1,104 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	txmgrmocks "github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissedCheckpoints(t *testing.T) {
+	at := func(secs int64) time.Time { return time.Unix(secs, 0) }
+	const interval = 10 * time.Second
+	tests := []struct {
+		name      string
+		proposals []time.Time
+		expected  []time.Time
+	}{
+		{name: "NoProposals", expected: []time.Time{at(0), at(10), at(20), at(30), at(40)}},
+		{name: "NoneMissed", proposals: []time.Time{at(5), at(14), at(24), at(33), at(43)}},
+		{name: "Gap", proposals: []time.Time{at(5), at(38)}, expected: []time.Time{at(15), at(25)}},
+		{name: "MissedBeforeFirst", proposals: []time.Time{at(25), at(30), at(41)}, expected: []time.Time{at(0), at(10)}},
+		{name: "MissedAfterLast", proposals: []time.Time{at(1)}, expected: []time.Time{at(11), at(21), at(31)}},
+		{name: "ProposalAtCheckpoint", proposals: []time.Time{at(10), at(20), at(30), at(40)}, expected: []time.Time{at(0)}},
+		{name: "MultipleWithinInterval", proposals: []time.Time{at(0), at(2), at(4), at(30)}, expected: []time.Time{at(10), at(20), at(40)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missed := missedCheckpoints(at(0), at(50), tt.proposals, interval)
+			require.Equal(t, tt.expected, missed)
+		})
+	}
+}
+
+func TestBackfill_ReportsSkippedRanges(t *testing.T) {
+	ep := newEndpointProvider()
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab})
+	clk := clock.NewDeterministicClock(time.Unix(10_000, 0))
+	dgf := &StubDGFContract{
+		// Proposals at 9_000 and 9_500 leave missed checkpoints between 9_100 and 9_400, and 9_600 and 9_900.
+		proposalTimes: []time.Time{time.Unix(9_000, 0), time.Unix(9_500, 0)},
+	}
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:            testlog.Logger(t, log.LevelDebug),
+			Txmgr:          txmgr,
+			RollupProvider: ep,
+			Clock:          clk,
+			Cfg: ProposerConfig{
+				ProposalInterval: 100 * time.Second,
+				BackfillLookback: 1000 * time.Second,
+				BackfillMaxAge:   500 * time.Second,
+			},
+		},
+		dgfContract: dgf,
+	}
+	rollupCfg := &rollup.Config{
+		Genesis:   rollup.Genesis{L2Time: 0},
+		BlockTime: 2,
+	}
+	ep.rollupClient.ExpectRollupConfig(rollupCfg, nil)
+	// The finalized L2 head is at timestamp 9_000, so none of the recent missed checkpoints can be proposed.
+	ep.rollupClient.ExpectSyncStatus(&eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: 4_500}}, nil)
+
+	report, err := ps.backfill(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 8, report.Missed)
+	require.Empty(t, report.Proposed)
+	require.Equal(t, []SkippedRange{
+		{From: time.Unix(9_100, 0), To: time.Unix(9_400, 0), Reason: skipReasonMaxAge},
+		{From: time.Unix(9_600, 0), To: time.Unix(9_900, 0), Reason: skipReasonNotProposed},
+	}, report.Skipped)
+}
+
+func TestBackfill_NothingMissed(t *testing.T) {
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xab})
+	ps := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:   testlog.Logger(t, log.LevelDebug),
+			Txmgr: txmgr,
+			Clock: clock.NewDeterministicClock(time.Unix(1000, 0)),
+			Cfg: ProposerConfig{
+				ProposalInterval: 100 * time.Second,
+				BackfillLookback: 250 * time.Second,
+			},
+		},
+		dgfContract: &StubDGFContract{
+			proposalTimes: []time.Time{time.Unix(800, 0), time.Unix(900, 0)},
+		},
+	}
+	report, err := ps.backfill(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, report.Missed)
+	require.Empty(t, report.Skipped)
+}
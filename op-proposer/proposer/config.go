@@ -2,11 +2,14 @@ package proposer
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -53,6 +56,10 @@ type CLIConfig struct {
 	// ProposalInterval is the delay between submitting L2 output proposals when the DGFAddress is set.
 	ProposalInterval time.Duration
 
+	// ProposalSchedule is an alternative to the ProposalInterval, when the DGFAddress is set.
+	// It is either a cron schedule, "cron:<expr>", or every Nth L1 epoch boundary, "l1-epochs:<N>".
+	ProposalSchedule string
+
 	// DisputeGameType is the type of dispute game to create when submitting an output proposal.
 	DisputeGameType uint32
 
@@ -61,6 +68,34 @@ type CLIConfig struct {
 
 	// Whether to wait for the sequencer to sync to a recent block at startup.
 	WaitNodeSync bool
+
+	// GameBudgetGwei is the maximum expected cost, in gwei, of creating and defending a dispute game.
+	// Zero disables the budget check.
+	GameBudgetGwei float64
+
+	// ForceProposal proposes even if the expected game cost exceeds the game budget.
+	ForceProposal bool
+
+	// ConductorRpc is the HTTP provider URL of the op-conductor of the paired sequencer.
+	// If set, proposals are only made while the paired sequencer is the healthy leader.
+	ConductorRpc string
+
+	// BackfillLookback is how far back the DisputeGameFactory is scanned on startup for missed proposal intervals.
+	// Zero disables the backfill.
+	BackfillLookback time.Duration
+
+	// BackfillMaxAge is the maximum age of a missed checkpoint to still be backfilled. Zero means no limit.
+	BackfillMaxAge time.Duration
+
+	// SafeAddress is the address of the Safe to propose through. Empty if proposing directly.
+	SafeAddress string
+
+	// SafeSigners are the signer services of the Safe owners, in the form <owner address>=<signer endpoint>.
+	SafeSigners []string
+
+	// SupervisorRpc is the HTTP provider URL for the interop supervisor.
+	// If set, super roots are proposed instead of the output roots of the rollup node.
+	SupervisorRpc string
 }
 
 func (c *CLIConfig) Check() error {
@@ -83,12 +118,67 @@ func (c *CLIConfig) Check() error {
 	if c.DGFAddress != "" && c.L2OOAddress != "" {
 		return errors.New("both the `DisputeGameFactory` and `L2OutputOracle` addresses were provided")
 	}
-	if c.DGFAddress != "" && c.ProposalInterval == 0 {
-		return errors.New("the `DisputeGameFactory` address was provided but the `ProposalInterval` was not set")
+	if c.DGFAddress != "" && c.ProposalInterval == 0 && c.ProposalSchedule == "" {
+		return errors.New("the `DisputeGameFactory` address was provided but neither the `ProposalInterval` nor the `ProposalSchedule` was set")
 	}
 	if c.ProposalInterval != 0 && c.DGFAddress == "" {
 		return errors.New("the `ProposalInterval` was provided but the `DisputeGameFactory` address was not set")
 	}
+	if c.ProposalSchedule != "" {
+		if c.DGFAddress == "" {
+			return errors.New("the `ProposalSchedule` was provided but the `DisputeGameFactory` address was not set")
+		}
+		if c.ProposalInterval != 0 {
+			return errors.New("both the `ProposalInterval` and the `ProposalSchedule` were provided")
+		}
+		if c.BackfillLookback != 0 {
+			return errors.New("the backfill requires a `ProposalInterval`, it is not supported with a `ProposalSchedule`")
+		}
+		if err := ParseProposalSchedule(c.ProposalSchedule); err != nil {
+			return err
+		}
+	}
+	if c.GameBudgetGwei < 0 {
+		return errors.New("the game budget must not be negative")
+	}
+	if _, err := eth.GweiToWei(c.GameBudgetGwei); err != nil {
+		return fmt.Errorf("invalid game budget: %w", err)
+	}
+	if c.GameBudgetGwei != 0 && c.DGFAddress == "" {
+		return errors.New("the game budget was provided but the `DisputeGameFactory` address was not set")
+	}
+	if c.BackfillLookback != 0 && c.DGFAddress == "" {
+		return errors.New("the backfill lookback was provided but the `DisputeGameFactory` address was not set")
+	}
+	if c.BackfillLookback < 0 || c.BackfillMaxAge < 0 {
+		return errors.New("the backfill lookback and max age must not be negative")
+	}
+	if c.SafeAddress != "" {
+		if _, err := opservice.ParseAddress(c.SafeAddress); err != nil {
+			return fmt.Errorf("invalid safe address: %w", err)
+		}
+		if len(c.SafeSigners) == 0 {
+			return errors.New("the safe address was provided but no safe signers were set")
+		}
+		for _, s := range c.SafeSigners {
+			if _, _, err := parseSafeSigner(s); err != nil {
+				return err
+			}
+		}
+	} else if len(c.SafeSigners) != 0 {
+		return errors.New("safe signers were provided but the safe address was not set")
+	}
+	if c.SupervisorRpc != "" {
+		if c.DGFAddress == "" {
+			return errors.New("the supervisor RPC was provided but the `DisputeGameFactory` address was not set")
+		}
+		if c.RollupRpc != "" {
+			return errors.New("both the rollup RPC and the supervisor RPC were provided")
+		}
+		if c.AllowNonFinalized || c.WaitNodeSync || c.BackfillLookback != 0 {
+			return errors.New("non-finalized proposals, waiting for node sync and the backfill are not supported with the supervisor RPC")
+		}
+	}
 
 	return nil
 }
@@ -110,8 +200,17 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		PprofConfig:                  oppprof.ReadCLIConfig(ctx),
 		DGFAddress:                   ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
 		ProposalInterval:             ctx.Duration(flags.ProposalIntervalFlag.Name),
+		ProposalSchedule:             ctx.String(flags.ProposalScheduleFlag.Name),
 		DisputeGameType:              uint32(ctx.Uint(flags.DisputeGameTypeFlag.Name)),
 		ActiveSequencerCheckDuration: ctx.Duration(flags.ActiveSequencerCheckDurationFlag.Name),
 		WaitNodeSync:                 ctx.Bool(flags.WaitNodeSyncFlag.Name),
+		GameBudgetGwei:               ctx.Float64(flags.GameBudgetFlag.Name),
+		ForceProposal:                ctx.Bool(flags.ForceFlag.Name),
+		ConductorRpc:                 ctx.String(flags.ConductorRpcFlag.Name),
+		BackfillLookback:             ctx.Duration(flags.BackfillLookbackFlag.Name),
+		BackfillMaxAge:               ctx.Duration(flags.BackfillMaxAgeFlag.Name),
+		SafeAddress:                  ctx.String(flags.SafeAddressFlag.Name),
+		SafeSigners:                  ctx.StringSlice(flags.SafeSignersFlag.Name),
+		SupervisorRpc:                ctx.String(flags.SupervisorRpcFlag.Name),
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-proposer/bindings"
 	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -37,6 +39,9 @@ type L1Client interface {
 	// CallContract executes an Ethereum contract call with the specified data as the
 	// input.
 	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+
+	// EstimateGas estimates the gas of executing the call against the latest state.
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
 }
 
 type L2OOContract interface {
@@ -47,7 +52,15 @@ type L2OOContract interface {
 type DGFContract interface {
 	Version(ctx context.Context) (string, error)
 	HasProposedSince(ctx context.Context, proposer common.Address, cutoff time.Time, gameType uint32) (bool, time.Time, common.Hash, error)
+	ProposalTimesSince(ctx context.Context, cutoff time.Time, gameType uint32) ([]time.Time, error)
 	ProposalTx(ctx context.Context, gameType uint32, outputRoot common.Hash, l2BlockNum uint64) (txmgr.TxCandidate, error)
+	GameBonds(ctx context.Context, gameType uint32) (contracts.GameBonds, error)
+}
+
+// SupervisorClient provides the super roots of all chains in the dependency set of the interop supervisor.
+type SupervisorClient interface {
+	SuperRootAtTimestamp(ctx context.Context, timestamp hexutil.Uint64) (eth.SuperRootResponse, error)
+	FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error)
 }
 
 type RollupClient interface {
@@ -55,6 +68,14 @@ type RollupClient interface {
 	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
 }
 
+// Conductor reports the leadership and health of the sequencer paired with this proposer, as seen by op-conductor.
+// In a high-availability setup, only the proposer of the healthy leader sequencer proposes,
+// so redundant proposers do not propose, and bond, the same output twice.
+type Conductor interface {
+	Leader(ctx context.Context) (bool, error)
+	SequencerHealthy(ctx context.Context) (bool, error)
+}
+
 type DriverSetup struct {
 	Log         log.Logger
 	Metr        metrics.Metricer
@@ -65,6 +86,15 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// SupervisorClient is optional. If set, super roots of all chains are proposed instead of output roots.
+	SupervisorClient SupervisorClient
+
+	// Conductor is optional. If set, proposals are only made while the paired sequencer is the healthy leader.
+	Conductor Conductor
+
+	// Clock drives the polling and the proposal interval. Defaults to the system clock if nil.
+	Clock clock.Clock
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -84,6 +114,7 @@ type L2OutputSubmitter struct {
 	l2ooABI      *abi.ABI
 
 	dgfContract DGFContract
+	schedule    ProposalSchedule
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -98,6 +129,12 @@ func NewL2OutputSubmitter(setup DriverSetup) (_ *L2OutputSubmitter, err error) {
 		}
 	}()
 
+	if setup.Clock == nil {
+		setup.Clock = clock.SystemClock
+	}
+	if setup.SupervisorClient != nil && setup.Cfg.DisputeGameFactoryAddr == nil {
+		return nil, errors.New("super roots can only be proposed to the `DisputeGameFactory`")
+	}
 	if setup.Cfg.L2OutputOracleAddr != nil {
 		return newL2OOSubmitter(ctx, cancel, setup)
 	} else if setup.Cfg.DisputeGameFactoryAddr != nil {
@@ -150,6 +187,13 @@ func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup Drive
 	}
 	log.Info("Connected to DisputeGameFactory", "address", setup.Cfg.DisputeGameFactoryAddr, "version", version)
 
+	schedule, err := newProposalSchedule(setup.Cfg.ProposalSchedule, setup.Cfg.ProposalInterval, setup.L1Client)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	log.Info("Proposing on schedule", "schedule", schedule)
+
 	return &L2OutputSubmitter{
 		DriverSetup: setup,
 		done:        make(chan struct{}),
@@ -157,6 +201,7 @@ func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup Drive
 		cancel:      cancel,
 
 		dgfContract: dgfCaller,
+		schedule:    schedule,
 	}, nil
 }
 
@@ -268,14 +313,11 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 // The passed context is expected to be a lifecycle context. A network timeout
 // context will be derived from it.
 func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResponse, bool, error) {
-	cutoff := time.Now().Add(-l.Cfg.ProposalInterval)
-	proposedRecently, proposalTime, claim, err := l.dgfContract.HasProposedSince(ctx, l.Txmgr.From(), cutoff, l.Cfg.DisputeGameType)
+	due, cutoff, claim, err := l.proposalDue(ctx)
 	if err != nil {
-		return nil, false, fmt.Errorf("could not check for recent proposal: %w", err)
+		return nil, false, err
 	}
-
-	if proposedRecently {
-		l.Log.Debug("Duration since last game not past proposal interval", "duration", time.Since(proposalTime))
+	if !due {
 		return nil, false, nil
 	}
 
@@ -300,11 +342,30 @@ func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResp
 		return nil, false, nil
 	}
 
-	l.Log.Info("No proposals found for at least proposal interval, submitting proposal now", "proposalInterval", l.Cfg.ProposalInterval)
+	l.Log.Info("No proposals found since last scheduled proposal, submitting proposal now", "schedule", l.schedule, "due", cutoff)
 
 	return output, true, nil
 }
 
+// proposalDue returns whether a proposal is due according to the schedule, because no game was proposed since
+// the last due time, along with that due time and the root claim of the most recently proposed game.
+func (l *L2OutputSubmitter) proposalDue(ctx context.Context) (bool, time.Time, common.Hash, error) {
+	cutoff, err := l.schedule.LastDue(ctx, l.Clock.Now())
+	if err != nil {
+		return false, time.Time{}, common.Hash{}, fmt.Errorf("could not determine when the last proposal was due: %w", err)
+	}
+	proposedRecently, proposalTime, claim, err := l.dgfContract.HasProposedSince(ctx, l.Txmgr.From(), cutoff, l.Cfg.DisputeGameType)
+	if err != nil {
+		return false, time.Time{}, common.Hash{}, fmt.Errorf("could not check for recent proposal: %w", err)
+	}
+
+	if proposedRecently {
+		l.Log.Debug("Proposed since last scheduled proposal", "duration", l.Clock.Since(proposalTime), "schedule", l.schedule)
+		return false, cutoff, claim, nil
+	}
+	return true, cutoff, claim, nil
+}
+
 // FetchCurrentBlockNumber gets the current block number from the [L2OutputSubmitter]'s [RollupClient]. If the `AllowNonFinalized` configuration
 // option is set, it will return the safe head block number, and if not, it will return the finalized head block number.
 func (l *L2OutputSubmitter) FetchCurrentBlockNumber(ctx context.Context) (uint64, error) {
@@ -372,7 +433,7 @@ func (l *L2OutputSubmitter) ProposeL2OutputDGFTxCandidate(ctx context.Context, o
 // will produce a value of 0 within EstimateGas, and the call will fail when the contract checks
 // that l1blockhash matches blockhash(l1blocknum).
 func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64) error {
-	ticker := time.NewTicker(l.Cfg.PollInterval)
+	ticker := l.Clock.NewTicker(l.Cfg.PollInterval)
 	defer ticker.Stop()
 	l1head, err := l.Txmgr.BlockNumber(ctx)
 	if err != nil {
@@ -381,7 +442,7 @@ func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64)
 	for l1head <= blockNum {
 		l.Log.Debug("Waiting for l1 head > l1blocknum1+1", "l1head", l1head, "l1blocknum", blockNum)
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			l1head, err = l.Txmgr.BlockNumber(ctx)
 			if err != nil {
 				return err
@@ -403,11 +464,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	l.Log.Info("Proposing output root", "output", output.OutputRoot, "block", output.BlockRef)
 	var receipt *types.Receipt
 	if l.Cfg.DisputeGameFactoryAddr != nil {
-		candidate, err := l.ProposeL2OutputDGFTxCandidate(ctx, output)
-		if err != nil {
-			return err
-		}
-		receipt, err = l.Txmgr.Send(ctx, candidate)
+		receipt, err = l.sendDGFProposal(ctx, common.Hash(output.OutputRoot), output.BlockRef.Number)
 		if err != nil {
 			return err
 		}
@@ -437,17 +494,36 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	return nil
 }
 
+// sendDGFProposal creates a dispute game for the root claim.
+// If a game budget is configured, the game creation is simulated first to check its expected cost.
+// The sequence number is the L2 block number of an output root, or the timestamp of a super root.
+func (l *L2OutputSubmitter) sendDGFProposal(ctx context.Context, root common.Hash, sequenceNum uint64) (*types.Receipt, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	candidate, err := l.dgfContract.ProposalTx(cCtx, l.Cfg.DisputeGameType, root, sequenceNum)
+	if err != nil {
+		return nil, err
+	}
+	if l.Cfg.GameBudget != nil {
+		if _, err := l.simulateGameCreation(ctx, candidate); err != nil {
+			return nil, err
+		}
+	}
+	return l.Txmgr.Send(ctx, candidate)
+}
+
 // loop is responsible for creating & submitting the next outputs
 // The loop regularly polls the L2 chain to infer whether to make the next proposal.
 func (l *L2OutputSubmitter) loop() {
 	defer l.wg.Done()
 	defer l.Log.Info("loop returning")
 	ctx := l.ctx
-	ticker := time.NewTicker(l.Cfg.PollInterval)
+	l.runBackfill(ctx)
+	ticker := l.Clock.NewTicker(l.Cfg.PollInterval)
 	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			// prioritize quit signal
 			select {
 			case <-l.done:
@@ -455,6 +531,11 @@ func (l *L2OutputSubmitter) loop() {
 			default:
 			}
 
+			if l.SupervisorClient != nil {
+				l.proposeSuperRootIfDue(ctx)
+				continue
+			}
+
 			// A note on retrying: the outer ticker already runs on a short
 			// poll interval, which has a default value of 6 seconds. So no
 			// retry logic is needed around output fetching here.
@@ -474,6 +555,14 @@ func (l *L2OutputSubmitter) loop() {
 				continue
 			}
 
+			if active, err := l.isActiveProposer(ctx); err != nil {
+				l.Log.Warn("Error checking sequencer leadership, not proposing", "err", err)
+				continue
+			} else if !active {
+				l.Log.Info("Paired sequencer is not the healthy leader, not proposing", "l2blocknum", output.BlockRef.Number)
+				continue
+			}
+
 			l.proposeOutput(ctx, output)
 		case <-l.done:
 			return
@@ -482,6 +571,28 @@ func (l *L2OutputSubmitter) loop() {
 
 }
 
+// isActiveProposer returns true if no conductor is configured,
+// or if the conductor reports the paired sequencer to be the healthy leader.
+func (l *L2OutputSubmitter) isActiveProposer(ctx context.Context) (bool, error) {
+	if l.Conductor == nil {
+		return true, nil
+	}
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	leader, err := l.Conductor.Leader(cCtx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check conductor leadership: %w", err)
+	}
+	if !leader {
+		return false, nil
+	}
+	healthy, err := l.Conductor.SequencerHealthy(cCtx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check sequencer health: %w", err)
+	}
+	return healthy, nil
+}
+
 func (l *L2OutputSubmitter) waitNodeSync() error {
 	cCtx, cancel := context.WithTimeout(l.ctx, l.Cfg.NetworkTimeout)
 	defer cancel()
@@ -499,7 +610,8 @@ func (l *L2OutputSubmitter) waitNodeSync() error {
 	return dial.WaitRollupSync(l.ctx, l.Log, rollupClient, l1head, time.Second*12)
 }
 
-func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse) {
+// proposeOutput proposes the output and returns whether the proposal transaction was published.
+func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse) bool {
 	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
@@ -509,7 +621,8 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 			"l1blocknum", output.Status.CurrentL1.Number,
 			"l1blockhash", output.Status.CurrentL1.Hash,
 			"l1head", output.Status.HeadL1.Number)
-		return
+		return false
 	}
 	l.Metr.RecordL2BlocksProposed(output.BlockRef)
+	return true
 }
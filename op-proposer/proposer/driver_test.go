@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/bindings"
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -40,6 +42,7 @@ func (m *MockL2OOContract) NextBlockNumber(opts *bind.CallOpts) (*big.Int, error
 
 type StubDGFContract struct {
 	hasProposedCount int
+	proposalTimes    []time.Time
 }
 
 func (m *StubDGFContract) HasProposedSince(_ context.Context, _ common.Address, _ time.Time, _ uint32) (bool, time.Time, common.Hash, error) {
@@ -47,6 +50,10 @@ func (m *StubDGFContract) HasProposedSince(_ context.Context, _ common.Address,
 	return false, time.Unix(1000, 0), common.Hash{0xdd}, nil
 }
 
+func (m *StubDGFContract) ProposalTimesSince(_ context.Context, _ time.Time, _ uint32) ([]time.Time, error) {
+	return m.proposalTimes, nil
+}
+
 func (m *StubDGFContract) ProposalTx(_ context.Context, _ uint32, _ common.Hash, _ uint64) (txmgr.TxCandidate, error) {
 	panic("not implemented")
 }
@@ -55,6 +62,10 @@ func (m *StubDGFContract) Version(_ context.Context) (string, error) {
 	panic("not implemented")
 }
 
+func (m *StubDGFContract) GameBonds(_ context.Context, _ uint32) (contracts.GameBonds, error) {
+	panic("not implemented")
+}
+
 type mockRollupEndpointProvider struct {
 	rollupClient    *testutils.MockRollupClient
 	rollupClientErr error
@@ -92,6 +103,7 @@ func setup(t *testing.T, testName string) (*L2OutputSubmitter, *mockRollupEndpoi
 		Cfg:            proposerConfig,
 		Txmgr:          txmgr,
 		RollupProvider: ep,
+		Clock:          clock.SystemClock,
 	}
 
 	parsed, err := bindings.L2OutputOracleMetaData.GetAbi()
@@ -111,6 +123,7 @@ func setup(t *testing.T, testName string) (*L2OutputSubmitter, *mockRollupEndpoi
 	if testName == "DGF" {
 		mockDGFContract = new(StubDGFContract)
 		l2OutputSubmitter.dgfContract = mockDGFContract
+		l2OutputSubmitter.schedule = intervalSchedule(proposerConfig.ProposalInterval)
 	} else {
 		mockL2OOContract = new(MockL2OOContract)
 		l2OutputSubmitter.l2ooContract = mockL2OOContract
@@ -179,3 +192,50 @@ func TestL2OutputSubmitter_OutputRetry(t *testing.T) {
 		})
 	}
 }
+
+type stubConductor struct {
+	leader  bool
+	healthy bool
+	err     error
+}
+
+func (s *stubConductor) Leader(_ context.Context) (bool, error) {
+	return s.leader, s.err
+}
+
+func (s *stubConductor) SequencerHealthy(_ context.Context) (bool, error) {
+	return s.healthy, s.err
+}
+
+func TestL2OutputSubmitter_IsActiveProposer(t *testing.T) {
+	tests := []struct {
+		name      string
+		conductor *stubConductor
+		expected  bool
+		expectErr bool
+	}{
+		{name: "NoConductor", expected: true},
+		{name: "HealthyLeader", conductor: &stubConductor{leader: true, healthy: true}, expected: true},
+		{name: "UnhealthyLeader", conductor: &stubConductor{leader: true, healthy: false}, expected: false},
+		{name: "Follower", conductor: &stubConductor{leader: false, healthy: true}, expected: false},
+		{name: "ConductorError", conductor: &stubConductor{err: fmt.Errorf("boom")}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := &L2OutputSubmitter{DriverSetup: DriverSetup{
+				Log: testlog.Logger(t, log.LevelDebug),
+				Cfg: ProposerConfig{NetworkTimeout: time.Second},
+			}}
+			if tt.conductor != nil {
+				ps.Conductor = tt.conductor
+			}
+			active, err := ps.isActiveProposer(context.Background())
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, active)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// SafeSigner signs Safe transaction hashes on behalf of a Safe owner.
+type SafeSigner interface {
+	Owner() common.Address
+	// SignSafeTx returns the owner signature of the Safe transaction hash, encoded as expected by the Safe.
+	SignSafeTx(ctx context.Context, safeTxHash common.Hash) ([]byte, error)
+}
+
+// RemoteSafeSigner signs Safe transaction hashes with the owner key held by a signer service.
+type RemoteSafeSigner struct {
+	owner  common.Address
+	client *signer.SignerClient
+}
+
+func NewRemoteSafeSigner(owner common.Address, client *signer.SignerClient) *RemoteSafeSigner {
+	return &RemoteSafeSigner{owner: owner, client: client}
+}
+
+func (s *RemoteSafeSigner) Owner() common.Address {
+	return s.owner
+}
+
+func (s *RemoteSafeSigner) SignSafeTx(ctx context.Context, safeTxHash common.Hash) ([]byte, error) {
+	sig, err := s.client.SignMessage(ctx, s.owner, safeTxHash[:])
+	if err != nil {
+		return nil, err
+	}
+	return safeMessageSignature(s.owner, safeTxHash, sig)
+}
+
+// safeMessageSignature converts an EIP-191 personal message signature of the Safe transaction hash
+// into the eth_sign signature type of the Safe, which is marked by adding 4 to the recovery id.
+func safeMessageSignature(owner common.Address, safeTxHash common.Hash, sig [65]byte) ([]byte, error) {
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(accounts.TextHash(safeTxHash[:]), sig[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid safe signature: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pub); recovered != owner {
+		return nil, fmt.Errorf("safe signature by %s, expected owner %s", recovered, owner)
+	}
+	sig[64] += 27 + 4
+	return sig[:], nil
+}
+
+// parseSafeSigner parses a Safe signer of the form <owner address>=<signer endpoint>.
+func parseSafeSigner(s string) (common.Address, string, error) {
+	owner, endpoint, ok := strings.Cut(s, "=")
+	if !ok || endpoint == "" {
+		return common.Address{}, "", fmt.Errorf("invalid safe signer %q, expected <owner address>=<signer endpoint>", s)
+	}
+	if !common.IsHexAddress(owner) {
+		return common.Address{}, "", fmt.Errorf("invalid safe owner address %q", owner)
+	}
+	return common.HexToAddress(owner), endpoint, nil
+}
+
+// SafeTxManager sends transactions through a Safe: every transaction is wrapped in a Safe transaction,
+// which is signed by a threshold of the Safe owners and then executed by the underlying TxManager.
+// This allows proposing from a governance-managed Safe, while the executing account only pays the gas.
+//
+// From returns the address of the Safe, which is the sender of the wrapped transactions.
+type SafeTxManager struct {
+	txmgr.TxManager
+
+	log     log.Logger
+	safe    *contracts.Safe
+	signers []SafeSigner
+
+	// mu serializes Safe transactions, as each of them is signed for the next Safe nonce.
+	mu sync.Mutex
+}
+
+var _ txmgr.TxManager = (*SafeTxManager)(nil)
+
+func NewSafeTxManager(log log.Logger, executor txmgr.TxManager, safe *contracts.Safe, signers []SafeSigner) *SafeTxManager {
+	return &SafeTxManager{
+		TxManager: executor,
+		log:       log.With("safe", safe.Addr()),
+		safe:      safe,
+		signers:   signers,
+	}
+}
+
+// CheckSigners verifies that the signers are owners of the Safe, and can meet its threshold.
+func (m *SafeTxManager) CheckSigners(ctx context.Context) error {
+	owners, threshold, err := m.safe.Owners(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range m.signers {
+		if !slices.Contains(owners, s.Owner()) {
+			return fmt.Errorf("safe signer %s is not an owner of the safe", s.Owner())
+		}
+	}
+	if uint64(len(m.signers)) < threshold {
+		return fmt.Errorf("safe threshold %d exceeds the number of safe signers %d", threshold, len(m.signers))
+	}
+	return nil
+}
+
+func (m *SafeTxManager) From() common.Address {
+	return m.safe.Addr()
+}
+
+func (m *SafeTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execCandidate, err := m.wrap(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+	return m.TxManager.Send(ctx, execCandidate)
+}
+
+// SendAsync wraps the candidate synchronously. Concurrent Safe transactions are not supported,
+// as the next Safe transaction can only be signed once the previous one is executed.
+func (m *SafeTxManager) SendAsync(ctx context.Context, candidate txmgr.TxCandidate, ch chan txmgr.SendResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execCandidate, err := m.wrap(ctx, candidate)
+	if err != nil {
+		ch <- txmgr.SendResponse{Err: err}
+		return
+	}
+	m.TxManager.SendAsync(ctx, execCandidate, ch)
+}
+
+// wrap collects the owner signatures of the candidate as Safe transaction, and returns the
+// transaction executing it. The gas limit of the candidate is not used; the execution is estimated.
+func (m *SafeTxManager) wrap(ctx context.Context, candidate txmgr.TxCandidate) (txmgr.TxCandidate, error) {
+	if candidate.To == nil {
+		return txmgr.TxCandidate{}, errors.New("safe transactions cannot create contracts")
+	}
+	if len(candidate.Blobs) > 0 {
+		return txmgr.TxCandidate{}, errors.New("safe transactions cannot carry blobs")
+	}
+	tx := contracts.SafeTx{To: *candidate.To, Value: candidate.Value, Data: candidate.TxData}
+	_, threshold, err := m.safe.Owners(ctx)
+	if err != nil {
+		return txmgr.TxCandidate{}, err
+	}
+	nonce, safeTxHash, err := m.safe.TransactionHash(ctx, tx)
+	if err != nil {
+		return txmgr.TxCandidate{}, err
+	}
+	l := m.log.With("nonce", nonce, "safeTxHash", safeTxHash)
+
+	type ownerSignature struct {
+		owner common.Address
+		sig   []byte
+	}
+	var sigs []ownerSignature
+	var signErr error
+	for _, s := range m.signers {
+		if uint64(len(sigs)) >= threshold {
+			break
+		}
+		sig, err := s.SignSafeTx(ctx, safeTxHash)
+		if err != nil {
+			l.Warn("Safe signer failed", "owner", s.Owner(), "err", err)
+			signErr = errors.Join(signErr, fmt.Errorf("safe signer %s failed: %w", s.Owner(), err))
+			continue
+		}
+		sigs = append(sigs, ownerSignature{owner: s.Owner(), sig: sig})
+	}
+	if uint64(len(sigs)) < threshold {
+		return txmgr.TxCandidate{}, fmt.Errorf("insufficient safe signatures, got %d of %d: %w", len(sigs), threshold, signErr)
+	}
+	// The Safe requires the signatures to be sorted by owner address
+	slices.SortFunc(sigs, func(a, b ownerSignature) int {
+		return bytes.Compare(a.owner[:], b.owner[:])
+	})
+	signatures := make([]byte, 0, len(sigs)*65)
+	for _, s := range sigs {
+		signatures = append(signatures, s.sig...)
+	}
+	l.Info("Executing Safe transaction", "to", tx.To, "value", tx.Value, "signatures", len(sigs))
+	return m.safe.ExecTransactionTx(tx, signatures)
+}
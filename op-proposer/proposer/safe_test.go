@@ -0,0 +1,180 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmgrmocks "github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
+)
+
+type localSafeSigner struct {
+	key *ecdsa.PrivateKey
+	err error
+}
+
+func (s *localSafeSigner) Owner() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *localSafeSigner) SignSafeTx(_ context.Context, safeTxHash common.Hash) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	sig, err := crypto.Sign(accounts.TextHash(safeTxHash[:]), s.key)
+	if err != nil {
+		return nil, err
+	}
+	return safeMessageSignature(s.Owner(), safeTxHash, [65]byte(sig))
+}
+
+func TestSafeTxManager(t *testing.T) {
+	safeAddr := common.Address{0x5a, 0xfe}
+	factoryAddr := common.Address{0xff}
+	safeTxHash := common.Hash{0xab}
+	candidate := txmgr.TxCandidate{
+		To:     &factoryAddr,
+		TxData: []byte{0x01, 0x02},
+		Value:  big.NewInt(1000),
+	}
+
+	signers := make([]*localSafeSigner, 3)
+	owners := make([]common.Address, len(signers))
+	for i := range signers {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signers[i] = &localSafeSigner{key: key}
+		owners[i] = signers[i].Owner()
+	}
+
+	setupSafe := func(t *testing.T, signers ...SafeSigner) (*SafeTxManager, *txmgrmocks.TxManager) {
+		safeABI := contracts.LoadSafeABI()
+		stubRpc := batchingTest.NewAbiBasedRpc(t, safeAddr, safeABI)
+		stubRpc.SetResponse(safeAddr, "getOwners", rpcblock.Latest, nil, []interface{}{owners})
+		stubRpc.SetResponse(safeAddr, "getThreshold", rpcblock.Latest, nil, []interface{}{big.NewInt(2)})
+		stubRpc.SetResponse(safeAddr, "nonce", rpcblock.Latest, nil, []interface{}{big.NewInt(7)})
+		stubRpc.SetResponse(safeAddr, "getTransactionHash", rpcblock.Latest, []interface{}{
+			factoryAddr, candidate.Value, candidate.TxData, contracts.SafeOperationCall, common.Big0, common.Big0, common.Big0,
+			common.Address{}, common.Address{}, big.NewInt(7),
+		}, []interface{}{safeTxHash})
+		safe := contracts.NewSafe(safeAddr, batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize), time.Minute)
+		executor := txmgrmocks.NewTxManager(t)
+		return NewSafeTxManager(testlog.Logger(t, log.LevelDebug), executor, safe, signers), executor
+	}
+
+	t.Run("ExecuteWithSortedSignatures", func(t *testing.T) {
+		m, executor := setupSafe(t, signers[2], signers[1], signers[0])
+		require.NoError(t, m.CheckSigners(context.Background()))
+		require.Equal(t, safeAddr, m.From())
+
+		var sent txmgr.TxCandidate
+		executor.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).(txmgr.TxCandidate)
+		}).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+		_, err := m.Send(context.Background(), candidate)
+		require.NoError(t, err)
+
+		require.Equal(t, safeAddr, *sent.To)
+		require.Nil(t, sent.Value)
+		method, args, err := batching.NewBoundContract(contracts.LoadSafeABI(), safeAddr).DecodeCall(sent.TxData)
+		require.NoError(t, err)
+		require.Equal(t, "execTransaction", method)
+		require.Equal(t, factoryAddr, args.GetAddress(0))
+		require.Equal(t, candidate.Value, args.GetBigInt(1))
+		require.Equal(t, candidate.TxData, args.GetBytes(2))
+
+		// The first two signers meet the threshold, and their signatures are sorted by owner
+		signatures := args.GetBytes(9)
+		require.Len(t, signatures, 2*65)
+		expected := []common.Address{owners[2], owners[1]}
+		slices.SortFunc(expected, func(a, b common.Address) int { return bytes.Compare(a[:], b[:]) })
+		for i, owner := range expected {
+			sig := signatures[i*65 : (i+1)*65]
+			require.Contains(t, []byte{31, 32}, sig[64], "eth_sign signature type")
+			sig = append(slices.Clone(sig[:64]), sig[64]-31)
+			pub, err := crypto.SigToPub(accounts.TextHash(safeTxHash[:]), sig)
+			require.NoError(t, err)
+			require.Equal(t, owner, crypto.PubkeyToAddress(*pub))
+		}
+	})
+
+	t.Run("SkipFailingSigner", func(t *testing.T) {
+		failing := &localSafeSigner{key: signers[0].key, err: errors.New("boom")}
+		m, executor := setupSafe(t, failing, signers[1], signers[2])
+		executor.On("Send", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+		_, err := m.Send(context.Background(), candidate)
+		require.NoError(t, err)
+	})
+
+	t.Run("InsufficientSignatures", func(t *testing.T) {
+		failing := &localSafeSigner{key: signers[0].key, err: errors.New("boom")}
+		m, _ := setupSafe(t, failing, signers[1])
+		_, err := m.Send(context.Background(), candidate)
+		require.ErrorContains(t, err, "insufficient safe signatures")
+		require.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("SignerNotOwner", func(t *testing.T) {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		m, _ := setupSafe(t, signers[0], &localSafeSigner{key: key})
+		require.ErrorContains(t, m.CheckSigners(context.Background()), "not an owner")
+	})
+
+	t.Run("BelowThreshold", func(t *testing.T) {
+		m, _ := setupSafe(t, signers[0])
+		require.ErrorContains(t, m.CheckSigners(context.Background()), "threshold")
+	})
+}
+
+func TestSafeMessageSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	hash := common.Hash{0x01}
+	sig, err := crypto.Sign(accounts.TextHash(hash[:]), key)
+	require.NoError(t, err)
+
+	// Signer services may return the recovery id with or without the legacy offset of 27
+	for _, v := range []byte{sig[64], sig[64] + 27} {
+		raw := [65]byte(sig)
+		raw[64] = v
+		safeSig, err := safeMessageSignature(owner, hash, raw)
+		require.NoError(t, err)
+		require.Equal(t, sig[64]+31, safeSig[64])
+	}
+
+	_, err = safeMessageSignature(common.Address{0xaa}, hash, [65]byte(sig))
+	require.ErrorContains(t, err, "expected owner")
+}
+
+func TestParseSafeSigner(t *testing.T) {
+	owner, endpoint, err := parseSafeSigner("0x00000000000000000000000000000000000000aa=http://signer:8080/?a=b")
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xaa"), owner)
+	require.Equal(t, "http://signer:8080/?a=b", endpoint)
+
+	_, _, err = parseSafeSigner("http://signer:8080")
+	require.Error(t, err)
+	_, _, err = parseSafeSigner("0xaa=http://signer:8080")
+	require.ErrorContains(t, err, "owner address")
+}
@@ -0,0 +1,224 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	cronSchedulePrefix    = "cron:"
+	l1EpochSchedulePrefix = "l1-epochs:"
+)
+
+var ErrInvalidProposalSchedule = errors.New("invalid proposal schedule")
+
+// ProposalSchedule determines when proposals are due.
+// A proposal is due if no proposal was made since the last due time.
+type ProposalSchedule interface {
+	// LastDue returns the most recent time, at or before now, at which a proposal was due.
+	LastDue(ctx context.Context, now time.Time) (time.Time, error)
+	String() string
+}
+
+// L1HeaderSource provides the L1 headers to align proposals with L1 epochs.
+type L1HeaderSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// ParseProposalSchedule checks that the schedule is either a cron schedule, "cron:<minute> <hour> <day of month>
+// <month> <day of week>", or an L1 epoch schedule, "l1-epochs:<N>", which is due at every Nth L1 epoch boundary.
+func ParseProposalSchedule(schedule string) error {
+	_, err := newProposalSchedule(schedule, 0, nil)
+	return err
+}
+
+// newProposalSchedule creates the schedule described by the given string,
+// or the schedule of a fixed interval if the string is empty.
+func newProposalSchedule(schedule string, interval time.Duration, l1 L1HeaderSource) (ProposalSchedule, error) {
+	switch {
+	case schedule == "":
+		return intervalSchedule(interval), nil
+	case strings.HasPrefix(schedule, cronSchedulePrefix):
+		return parseCronSchedule(strings.TrimPrefix(schedule, cronSchedulePrefix))
+	case strings.HasPrefix(schedule, l1EpochSchedulePrefix):
+		n, err := strconv.ParseUint(strings.TrimPrefix(schedule, l1EpochSchedulePrefix), 10, 64)
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("%w: number of L1 epochs must be a positive integer: %q", ErrInvalidProposalSchedule, schedule)
+		}
+		return &l1EpochSchedule{epochs: n, l1: l1}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown schedule %q, expected %q or %q prefix",
+			ErrInvalidProposalSchedule, schedule, cronSchedulePrefix, l1EpochSchedulePrefix)
+	}
+}
+
+// intervalSchedule is due once the interval elapsed since the last proposal.
+type intervalSchedule time.Duration
+
+func (s intervalSchedule) LastDue(_ context.Context, now time.Time) (time.Time, error) {
+	return now.Add(-time.Duration(s)), nil
+}
+
+func (s intervalSchedule) String() string {
+	return "every " + time.Duration(s).String()
+}
+
+// l1EpochSchedule is due at every L1 block with a number that is a multiple of epochs.
+type l1EpochSchedule struct {
+	epochs uint64
+	l1     L1HeaderSource
+}
+
+func (s *l1EpochSchedule) LastDue(ctx context.Context, _ time.Time) (time.Time, error) {
+	head, err := s.l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	boundary := head.Number.Uint64() / s.epochs * s.epochs
+	if boundary == head.Number.Uint64() {
+		return time.Unix(int64(head.Time), 0), nil
+	}
+	header, err := s.l1.HeaderByNumber(ctx, new(big.Int).SetUint64(boundary))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to fetch L1 epoch boundary %d: %w", boundary, err)
+	}
+	return time.Unix(int64(header.Time), 0), nil
+}
+
+func (s *l1EpochSchedule) String() string {
+	return fmt.Sprintf("every %d L1 epochs", s.epochs)
+}
+
+// cronLookback bounds the search for the last due time of a cron schedule,
+// so schedules that never match, e.g. on February 30th, do not search forever.
+const cronLookback = 5 * 366 * 24 * time.Hour
+
+// cronSchedule is due at the times matching a standard 5 field cron expression, evaluated in UTC.
+type cronSchedule struct {
+	expr    string
+	minutes uint64 // bits 0-59
+	hours   uint64 // bits 0-23
+	days    uint64 // bits 1-31
+	months  uint64 // bits 1-12
+	weekday uint64 // bits 0-6, Sunday is 0
+	// anyDay and anyWeekday are set if the day of month or day of week field is a wildcard.
+	// If neither is, a time matches if either of them matches.
+	anyDay     bool
+	anyWeekday bool
+}
+
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: cron schedule %q must have 5 fields", ErrInvalidProposalSchedule, expr)
+	}
+	s := &cronSchedule{expr: expr}
+	for i, field := range []struct {
+		dest     *uint64
+		min, max uint64
+	}{
+		{&s.minutes, 0, 59},
+		{&s.hours, 0, 23},
+		{&s.days, 1, 31},
+		{&s.months, 1, 12},
+		{&s.weekday, 0, 7},
+	} {
+		bits, err := parseCronField(fields[i], field.min, field.max)
+		if err != nil {
+			return nil, fmt.Errorf("%w: cron field %q of %q: %w", ErrInvalidProposalSchedule, fields[i], expr, err)
+		}
+		*field.dest = bits
+	}
+	// Both 0 and 7 are Sunday
+	if s.weekday&(1<<7) != 0 {
+		s.weekday |= 1
+	}
+	s.anyDay = strings.HasPrefix(fields[2], "*")
+	s.anyWeekday = strings.HasPrefix(fields[4], "*")
+	return s, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges (a-b), wildcards (*) and steps (/n)
+// into a bitset of the matching values.
+func parseCronField(field string, min, max uint64) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := uint64(1)
+		if hasStep {
+			var err error
+			step, err = strconv.ParseUint(stepStr, 10, 64)
+			if err != nil || step == 0 {
+				return 0, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+		start, end := min, max
+		if rng != "*" {
+			startStr, endStr, isRange := strings.Cut(rng, "-")
+			var err error
+			start, err = strconv.ParseUint(startStr, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", startStr)
+			}
+			end = start
+			if isRange {
+				end, err = strconv.ParseUint(endStr, 10, 64)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q", endStr)
+				}
+			} else if hasStep {
+				end = max
+			}
+		}
+		if start < min || end > max || start > end {
+			return 0, fmt.Errorf("range %d-%d not within %d-%d", start, end, min, max)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	weekday := s.weekday&(1<<uint(t.Weekday())) != 0
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
+
+// LastDue searches backwards from now for the most recent minute that matches the schedule,
+// skipping whole months, days and hours that do not match.
+func (s *cronSchedule) LastDue(_ context.Context, now time.Time) (time.Time, error) {
+	t := now.UTC().Truncate(time.Minute)
+	limit := t.Add(-cronLookback)
+	for !t.Before(limit) {
+		switch {
+		case s.months&(1<<uint(t.Month())) == 0:
+			// Last minute of the previous month
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).Add(-time.Minute)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(-time.Minute)
+		case s.hours&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(-time.Minute)
+		case s.minutes&(1<<uint(t.Minute())) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cron schedule %q did not match within %v", s.expr, cronLookback)
+}
+
+func (s *cronSchedule) String() string {
+	return "cron " + s.expr
+}
@@ -0,0 +1,121 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProposalSchedule(t *testing.T) {
+	for _, valid := range []string{
+		"cron:0 */6 * * *",
+		"cron:30 9 1,15 * 1-5",
+		"cron:*/15 0-12/2 * 1-6 0,7",
+		"l1-epochs:1",
+		"l1-epochs:32",
+	} {
+		require.NoError(t, ParseProposalSchedule(valid), valid)
+	}
+	for _, invalid := range []string{
+		"4h",
+		"cron:0 * * *",
+		"cron:60 * * * *",
+		"cron:0 24 * * *",
+		"cron:0 0 0 * *",
+		"cron:0 0 * 13 *",
+		"cron:0 0 * * 8",
+		"cron:5-1 * * * *",
+		"cron:*/0 * * * *",
+		"cron:a * * * *",
+		"l1-epochs:0",
+		"l1-epochs:-1",
+		"l1-epochs:",
+	} {
+		require.ErrorIs(t, ParseProposalSchedule(invalid), ErrInvalidProposalSchedule, invalid)
+	}
+}
+
+func TestCronSchedule_LastDue(t *testing.T) {
+	// Wednesday
+	now := time.Date(2024, time.May, 15, 13, 37, 42, 0, time.UTC)
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.May, 15, 13, 37, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)},
+		{"45 13 * * *", time.Date(2024, time.May, 14, 13, 45, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 1", time.Date(2024, time.May, 13, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 0", time.Date(2024, time.May, 12, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2024, time.May, 12, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 12 *", time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or the day of week matches, if both are restricted
+		{"0 0 1 * 1", time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 14 * 6", time.Date(2024, time.May, 14, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.expr, func(t *testing.T) {
+			s, err := parseCronSchedule(test.expr)
+			require.NoError(t, err)
+			due, err := s.LastDue(context.Background(), now)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, due)
+		})
+	}
+
+	t.Run("NeverDue", func(t *testing.T) {
+		s, err := parseCronSchedule("0 0 30 2 *")
+		require.NoError(t, err)
+		_, err = s.LastDue(context.Background(), now)
+		require.Error(t, err)
+	})
+}
+
+func TestIntervalSchedule_LastDue(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	due, err := intervalSchedule(time.Hour).LastDue(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-time.Hour), due)
+}
+
+type stubL1Headers struct {
+	head uint64
+	err  error
+}
+
+func (s *stubL1Headers) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	num := s.head
+	if number != nil {
+		num = number.Uint64()
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(num), Time: 1000 + num*12}, nil
+}
+
+func TestL1EpochSchedule_LastDue(t *testing.T) {
+	l1 := &stubL1Headers{head: 100}
+	s, err := newProposalSchedule("l1-epochs:32", 0, l1)
+	require.NoError(t, err)
+	due, err := s.LastDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1000+96*12, 0), due)
+
+	l1.head = 128
+	due, err = s.LastDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1000+128*12, 0), due)
+
+	l1.err = errors.New("boom")
+	_, err = s.LastDue(context.Background(), time.Now())
+	require.ErrorIs(t, err, l1.err)
+}
@@ -5,19 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	conductorRpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer/rpc"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/signer"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
@@ -35,6 +42,8 @@ type ProposerConfig struct {
 
 	// How frequently to post L2 outputs when the DisputeGameFactory is configured
 	ProposalInterval time.Duration
+	// ProposalSchedule replaces the ProposalInterval if set. See CLIConfig.ProposalSchedule for the format.
+	ProposalSchedule string
 
 	L2OutputOracleAddr     *common.Address
 	DisputeGameFactoryAddr *common.Address
@@ -47,6 +56,18 @@ type ProposerConfig struct {
 	AllowNonFinalized bool
 
 	WaitNodeSync bool
+
+	// GameBudget is the maximum expected cost of creating and defending a dispute game.
+	// Proposals are refused if the simulated game cost exceeds it, unless ForceProposal is set.
+	// No budget is enforced if nil.
+	GameBudget *big.Int
+	// ForceProposal proposes even if the expected game cost exceeds the GameBudget.
+	ForceProposal bool
+
+	// BackfillLookback is how far back missed proposal intervals are searched for on startup. Disabled if zero.
+	BackfillLookback time.Duration
+	// BackfillMaxAge is the maximum age of a missed checkpoint to still be backfilled. No limit if zero.
+	BackfillMaxAge time.Duration
 }
 
 type ProposerService struct {
@@ -55,9 +76,14 @@ type ProposerService struct {
 
 	ProposerConfig
 
-	TxManager      txmgr.TxManager
-	L1Client       *ethclient.Client
+	TxManager txmgr.TxManager
+	L1Client  *ethclient.Client
+	// RollupProvider is nil if super roots are proposed from the SupervisorClient
 	RollupProvider dial.RollupProvider
+	// SupervisorClient is nil if output roots are proposed from the RollupProvider
+	SupervisorClient *sources.SupervisorClient
+	// Conductor is nil if proposals are not gated by the leadership of the paired sequencer
+	Conductor *conductorRpc.APIClient
 
 	driver *L2OutputSubmitter
 
@@ -72,18 +98,20 @@ type ProposerService struct {
 	stopped atomic.Bool
 }
 
+type DriverSetupOption func(setup *DriverSetup)
+
 // ProposerServiceFromCLIConfig creates a new ProposerService from a CLIConfig.
 // The service components are fully started, except for the driver,
 // which will not be submitting state (if it was configured to) until the Start part of the lifecycle.
-func ProposerServiceFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger) (*ProposerService, error) {
+func ProposerServiceFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger, opts ...DriverSetupOption) (*ProposerService, error) {
 	var ps ProposerService
-	if err := ps.initFromCLIConfig(ctx, version, cfg, log); err != nil {
+	if err := ps.initFromCLIConfig(ctx, version, cfg, log, opts...); err != nil {
 		return nil, errors.Join(err, ps.Stop(ctx)) // try to clean up our failed initialization attempt
 	}
 	return &ps, nil
 }
 
-func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger) error {
+func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger, opts ...DriverSetupOption) error {
 	ps.Version = version
 	ps.Log = log
 
@@ -103,6 +131,9 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	if err := ps.initTxManager(cfg); err != nil {
 		return fmt.Errorf("failed to init Tx manager: %w", err)
 	}
+	if err := ps.initSafe(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init Safe: %w", err)
+	}
 	ps.initBalanceMonitor(cfg)
 	if err := ps.initMetricsServer(cfg); err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
@@ -110,7 +141,7 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	if err := ps.initPProf(cfg); err != nil {
 		return fmt.Errorf("failed to init profiling: %w", err)
 	}
-	if err := ps.initDriver(); err != nil {
+	if err := ps.initDriver(opts...); err != nil {
 		return fmt.Errorf("failed to init Driver: %w", err)
 	}
 	if err := ps.initRPCServer(cfg); err != nil {
@@ -129,17 +160,35 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 	}
 	ps.L1Client = l1Client
 
-	var rollupProvider dial.RollupProvider
-	if strings.Contains(cfg.RollupRpc, ",") {
-		rollupUrls := strings.Split(cfg.RollupRpc, ",")
-		rollupProvider, err = dial.NewActiveL2RollupProvider(ctx, rollupUrls, cfg.ActiveSequencerCheckDuration, dial.DefaultDialTimeout, ps.Log)
+	if cfg.SupervisorRpc != "" {
+		supervisorClient, err := dial.DialRPCClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.SupervisorRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial supervisor RPC: %w", err)
+		}
+		ps.SupervisorClient = sources.NewSupervisorClient(client.NewBaseRPCClient(supervisorClient))
+		ps.Log.Info("Proposing super roots", "supervisor", cfg.SupervisorRpc)
 	} else {
-		rollupProvider, err = dial.NewStaticL2RollupProvider(ctx, ps.Log, cfg.RollupRpc)
+		var rollupProvider dial.RollupProvider
+		if strings.Contains(cfg.RollupRpc, ",") {
+			rollupUrls := strings.Split(cfg.RollupRpc, ",")
+			rollupProvider, err = dial.NewActiveL2RollupProvider(ctx, rollupUrls, cfg.ActiveSequencerCheckDuration, dial.DefaultDialTimeout, ps.Log)
+		} else {
+			rollupProvider, err = dial.NewStaticL2RollupProvider(ctx, ps.Log, cfg.RollupRpc)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
+		}
+		ps.RollupProvider = rollupProvider
 	}
-	if err != nil {
-		return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
+
+	if cfg.ConductorRpc != "" {
+		conductorClient, err := dial.DialRPCClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.ConductorRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial conductor RPC: %w", err)
+		}
+		ps.Conductor = conductorRpc.NewAPIClient(conductorClient)
+		ps.Log.Info("Gating proposals on the leadership of the paired sequencer", "conductor", cfg.ConductorRpc)
 	}
-	ps.RollupProvider = rollupProvider
 	return nil
 }
 
@@ -168,6 +217,38 @@ func (ps *ProposerService) initTxManager(cfg *CLIConfig) error {
 	return nil
 }
 
+// initSafe wraps the TxManager to propose through the configured Safe. It depends on the L1Client and TxManager.
+func (ps *ProposerService) initSafe(ctx context.Context, cfg *CLIConfig) error {
+	if cfg.SafeAddress == "" {
+		return nil
+	}
+	safeAddr, err := opservice.ParseAddress(cfg.SafeAddress)
+	if err != nil {
+		return err
+	}
+	signerCfg := cfg.TxMgrConfig.SignerCLIConfig
+	var signers []SafeSigner
+	for _, s := range cfg.SafeSigners {
+		owner, endpoint, err := parseSafeSigner(s)
+		if err != nil {
+			return err
+		}
+		client, err := signer.NewSignerClient(ps.Log, endpoint, signerCfg.Headers, signerCfg.TLSConfig)
+		if err != nil {
+			return fmt.Errorf("failed to dial safe signer of %s: %w", owner, err)
+		}
+		signers = append(signers, NewRemoteSafeSigner(owner, client))
+	}
+	safe := contracts.NewSafe(safeAddr, batching.NewMultiCaller(ps.L1Client.Client(), batching.DefaultBatchSize), cfg.TxMgrConfig.NetworkTimeout)
+	safeTxManager := NewSafeTxManager(ps.Log, ps.TxManager, safe, signers)
+	if err := safeTxManager.CheckSigners(ctx); err != nil {
+		return err
+	}
+	ps.Log.Info("Proposing through Safe", "safe", safeAddr, "executor", ps.TxManager.From(), "signers", len(signers))
+	ps.TxManager = safeTxManager
+	return nil
+}
+
 func (ps *ProposerService) initPProf(cfg *CLIConfig) error {
 	ps.pprofService = oppprof.New(
 		cfg.PprofConfig.ListenEnabled,
@@ -221,11 +302,19 @@ func (ps *ProposerService) initDGF(cfg *CLIConfig) {
 	}
 	ps.DisputeGameFactoryAddr = &dgfAddress
 	ps.ProposalInterval = cfg.ProposalInterval
+	ps.ProposalSchedule = cfg.ProposalSchedule
 	ps.DisputeGameType = cfg.DisputeGameType
+	if cfg.GameBudgetGwei > 0 {
+		// The budget is validated by the CLIConfig check
+		ps.GameBudget, _ = eth.GweiToWei(cfg.GameBudgetGwei)
+	}
+	ps.ForceProposal = cfg.ForceProposal
+	ps.BackfillLookback = cfg.BackfillLookback
+	ps.BackfillMaxAge = cfg.BackfillMaxAge
 }
 
-func (ps *ProposerService) initDriver() error {
-	driver, err := NewL2OutputSubmitter(DriverSetup{
+func (ps *ProposerService) initDriver(opts ...DriverSetupOption) error {
+	setup := DriverSetup{
 		Log:            ps.Log,
 		Metr:           ps.Metrics,
 		Cfg:            ps.ProposerConfig,
@@ -233,7 +322,17 @@ func (ps *ProposerService) initDriver() error {
 		L1Client:       ps.L1Client,
 		Multicaller:    batching.NewMultiCaller(ps.L1Client.Client(), batching.DefaultBatchSize),
 		RollupProvider: ps.RollupProvider,
-	})
+	}
+	if ps.Conductor != nil {
+		setup.Conductor = ps.Conductor
+	}
+	if ps.SupervisorClient != nil {
+		setup.SupervisorClient = ps.SupervisorClient
+	}
+	for _, opt := range opts {
+		opt(&setup)
+	}
+	driver, err := NewL2OutputSubmitter(setup)
 	if err != nil {
 		return err
 	}
@@ -248,6 +347,13 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 		ps.Version,
 		oprpc.WithLogger(ps.Log),
 	)
+	server.HealthChecker().Register("l1", func(ctx context.Context) error {
+		_, err := ps.L1Client.BlockNumber(ctx)
+		return err
+	})
+	if ps.RollupProvider != nil {
+		server.HealthChecker().Register("rollup", dial.RollupHealthProbe(ps.RollupProvider))
+	}
 	if cfg.RPCConfig.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(ps.driver, ps.Metrics, ps.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
@@ -330,6 +436,14 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		ps.RollupProvider.Close()
 	}
 
+	if ps.SupervisorClient != nil {
+		ps.SupervisorClient.Close()
+	}
+
+	if ps.Conductor != nil {
+		ps.Conductor.Close()
+	}
+
 	if result == nil {
 		ps.stopped.Store(true)
 		ps.Log.Info("L2Output Submitter stopped")
@@ -0,0 +1,83 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+var ErrGameBudgetExceeded = errors.New("expected game cost exceeds the game budget")
+
+// GameCost is the expected cost of proposing an output root by creating a dispute game.
+type GameCost struct {
+	// InitBond is the bond posted when creating the game.
+	InitBond *big.Int
+	// DefenseBonds is the total bond of defending the root claim against a challenge at every depth.
+	DefenseBonds *big.Int
+	// CreateGas is the estimated gas of the game creation transaction.
+	CreateGas uint64
+	// CreateFee is the estimated fee of the game creation transaction.
+	CreateFee *big.Int
+}
+
+// Total returns the total expected cost of creating and defending the game.
+func (c GameCost) Total() *big.Int {
+	total := new(big.Int).Add(c.InitBond, c.DefenseBonds)
+	return total.Add(total, c.CreateFee)
+}
+
+// simulateGameCreation simulates the game creation transaction against the latest L1 state,
+// to validate the init bond before sending it, and estimates the total cost of the game.
+// If a game budget is configured, and the expected cost exceeds it, ErrGameBudgetExceeded is returned,
+// unless the proposer is configured to force proposals.
+func (l *L2OutputSubmitter) simulateGameCreation(ctx context.Context, candidate txmgr.TxCandidate) (GameCost, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	msg := ethereum.CallMsg{
+		From:  l.Txmgr.From(),
+		To:    candidate.To,
+		Value: candidate.Value,
+		Data:  candidate.TxData,
+	}
+	if _, err := l.L1Client.CallContract(cCtx, msg, nil); err != nil {
+		return GameCost{}, fmt.Errorf("game creation simulation failed, bond %v: %w", candidate.Value, err)
+	}
+	gas, err := l.L1Client.EstimateGas(cCtx, msg)
+	if err != nil {
+		return GameCost{}, fmt.Errorf("failed to estimate game creation gas: %w", err)
+	}
+	tipCap, baseFee, _, err := l.Txmgr.SuggestGasPriceCaps(cCtx)
+	if err != nil {
+		return GameCost{}, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	bonds, err := l.dgfContract.GameBonds(cCtx, l.Cfg.DisputeGameType)
+	if err != nil {
+		return GameCost{}, fmt.Errorf("failed to get game bonds: %w", err)
+	}
+	gasPrice := new(big.Int).Add(tipCap, baseFee)
+	cost := GameCost{
+		InitBond:     candidate.Value,
+		DefenseBonds: bonds.DefenseBonds,
+		CreateGas:    gas,
+		CreateFee:    new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas)),
+	}
+	if cost.InitBond == nil {
+		cost.InitBond = new(big.Int)
+	}
+
+	total := cost.Total()
+	l.Log.Info("Simulated game creation", "init_bond", cost.InitBond, "defense_bonds", cost.DefenseBonds,
+		"create_gas", cost.CreateGas, "create_fee", cost.CreateFee, "total", total)
+	if budget := l.Cfg.GameBudget; budget != nil && total.Cmp(budget) > 0 {
+		if !l.Cfg.ForceProposal {
+			return cost, fmt.Errorf("%w: expected cost %v, budget %v", ErrGameBudgetExceeded, total, budget)
+		}
+		l.Log.Warn("Expected game cost exceeds the game budget, proposing anyway", "total", total, "budget", budget)
+	}
+	return cost, nil
+}
@@ -0,0 +1,146 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/contracts"
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmgrmocks "github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
+)
+
+type stubSimulationL1Client struct {
+	callErr error
+	gas     uint64
+	calls   int
+}
+
+func (s *stubSimulationL1Client) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	panic("not implemented")
+}
+
+func (s *stubSimulationL1Client) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	panic("not implemented")
+}
+
+func (s *stubSimulationL1Client) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	s.calls++
+	return nil, s.callErr
+}
+
+func (s *stubSimulationL1Client) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return s.gas, nil
+}
+
+type stubBondsDGFContract struct {
+	StubDGFContract
+	bonds     contracts.GameBonds
+	candidate txmgr.TxCandidate
+}
+
+func (s *stubBondsDGFContract) GameBonds(_ context.Context, _ uint32) (contracts.GameBonds, error) {
+	return s.bonds, nil
+}
+
+func (s *stubBondsDGFContract) ProposalTx(_ context.Context, _ uint32, _ common.Hash, _ uint64) (txmgr.TxCandidate, error) {
+	return s.candidate, nil
+}
+
+func TestSimulateGameCreation(t *testing.T) {
+	factoryAddr := common.Address{0xff}
+	candidate := txmgr.TxCandidate{
+		To:     &factoryAddr,
+		TxData: []byte{0x01},
+		Value:  big.NewInt(1000),
+	}
+	setupSimulation := func(t *testing.T, l1 *stubSimulationL1Client, budget *big.Int, force bool) *L2OutputSubmitter {
+		txMgr := txmgrmocks.NewTxManager(t)
+		txMgr.On("From").Return(common.Address{0xaa}).Maybe()
+		txMgr.On("SuggestGasPriceCaps", mock.Anything).Return(big.NewInt(1), big.NewInt(9), big.NewInt(0), nil).Maybe()
+		return &L2OutputSubmitter{
+			DriverSetup: DriverSetup{
+				Log:  testlog.Logger(t, log.LevelInfo),
+				Metr: metrics.NoopMetrics,
+				Cfg: ProposerConfig{
+					NetworkTimeout: time.Minute,
+					GameBudget:     budget,
+					ForceProposal:  force,
+				},
+				Txmgr:    txMgr,
+				L1Client: l1,
+			},
+			dgfContract: &stubBondsDGFContract{
+				bonds: contracts.GameBonds{
+					InitBond:     big.NewInt(1000),
+					DefenseBonds: big.NewInt(500),
+				},
+				candidate: candidate,
+			},
+		}
+	}
+
+	t.Run("NoBudget", func(t *testing.T) {
+		l := setupSimulation(t, &stubSimulationL1Client{gas: 100}, nil, false)
+		cost, err := l.simulateGameCreation(context.Background(), candidate)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1000), cost.InitBond)
+		require.Equal(t, big.NewInt(500), cost.DefenseBonds)
+		require.Equal(t, uint64(100), cost.CreateGas)
+		require.Equal(t, big.NewInt(1000), cost.CreateFee)
+		require.Equal(t, big.NewInt(2500), cost.Total())
+	})
+
+	t.Run("WithinBudget", func(t *testing.T) {
+		l := setupSimulation(t, &stubSimulationL1Client{gas: 100}, big.NewInt(2500), false)
+		_, err := l.simulateGameCreation(context.Background(), candidate)
+		require.NoError(t, err)
+	})
+
+	t.Run("ExceedsBudget", func(t *testing.T) {
+		l := setupSimulation(t, &stubSimulationL1Client{gas: 100}, big.NewInt(2499), false)
+		_, err := l.simulateGameCreation(context.Background(), candidate)
+		require.ErrorIs(t, err, ErrGameBudgetExceeded)
+	})
+
+	t.Run("ExceedsBudgetForced", func(t *testing.T) {
+		l := setupSimulation(t, &stubSimulationL1Client{gas: 100}, big.NewInt(2499), true)
+		_, err := l.simulateGameCreation(context.Background(), candidate)
+		require.NoError(t, err)
+	})
+
+	t.Run("SimulationReverts", func(t *testing.T) {
+		revertErr := errors.New("execution reverted: IncorrectBondAmount()")
+		l := setupSimulation(t, &stubSimulationL1Client{callErr: revertErr}, nil, false)
+		_, err := l.simulateGameCreation(context.Background(), candidate)
+		require.ErrorIs(t, err, revertErr)
+	})
+
+	t.Run("SendWithoutBudgetSkipsSimulation", func(t *testing.T) {
+		l1 := &stubSimulationL1Client{callErr: errors.New("unexpected call")}
+		l := setupSimulation(t, l1, nil, false)
+		l.Txmgr.(*txmgrmocks.TxManager).On("Send", mock.Anything, candidate).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+		_, err := l.sendDGFProposal(context.Background(), common.Hash{0x01}, 100)
+		require.NoError(t, err)
+		require.Zero(t, l1.calls)
+	})
+
+	t.Run("SendWithBudgetSimulates", func(t *testing.T) {
+		l1 := &stubSimulationL1Client{gas: 100}
+		l := setupSimulation(t, l1, big.NewInt(2499), false)
+		_, err := l.sendDGFProposal(context.Background(), common.Hash{0x01}, 100)
+		require.ErrorIs(t, err, ErrGameBudgetExceeded)
+		require.Equal(t, 1, l1.calls)
+	})
+}
@@ -0,0 +1,111 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// FetchSuperRootProposal queries the DGF for the latest game and infers whether it is time to make another proposal.
+// If necessary, it gets the super root to propose from the supervisor, and returns it along with
+// a boolean for whether the proposal should be submitted at all.
+//
+// Super roots are only proposed once all chains in the dependency set finalized the proposed timestamp,
+// so chains whose safe or finalized heads lag behind the others delay the proposal instead of being proposed
+// at an older state. With a proposal interval, the proposed timestamps are multiples of the interval.
+func (l *L2OutputSubmitter) FetchSuperRootProposal(ctx context.Context) (*eth.SuperRootResponse, bool, error) {
+	due, cutoff, claim, err := l.proposalDue(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if !due {
+		return nil, false, nil
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	// The finalized super root is at the latest timestamp that all chains finalized.
+	finalized, err := l.SupervisorClient.FinalizedSuperRoot(cCtx)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not fetch finalized super root: %w", err)
+	}
+	timestamp := l.proposalTimestamp(finalized.Timestamp)
+	if timestamp == 0 {
+		l.Log.Info("Waiting for all chains to reach the first proposal timestamp", "finalized", finalized.Timestamp)
+		return nil, false, nil
+	}
+
+	root := finalized
+	if timestamp != finalized.Timestamp {
+		root, err = l.SupervisorClient.SuperRootAtTimestamp(cCtx, hexutil.Uint64(timestamp))
+		if err != nil {
+			return nil, false, fmt.Errorf("could not fetch super root at timestamp %d: %w", timestamp, err)
+		}
+	}
+
+	if claim == common.Hash(root.SuperRoot) {
+		l.Log.Debug("Skipping proposal: super root unchanged since last proposed game", "last_proposed_root", claim, "super_root", root.SuperRoot, "timestamp", timestamp)
+		return nil, false, nil
+	}
+
+	l.Log.Info("No proposals found since last scheduled proposal, submitting super root now", "schedule", l.schedule, "due", cutoff, "timestamp", timestamp)
+	return &root, true, nil
+}
+
+// proposalTimestamp returns the timestamp to propose the super root at, given the latest timestamp that all chains
+// reached. It is the latest multiple of the proposal interval, or the reached timestamp if proposing on a schedule.
+func (l *L2OutputSubmitter) proposalTimestamp(reached uint64) uint64 {
+	interval := uint64(l.Cfg.ProposalInterval / time.Second)
+	if interval == 0 {
+		return reached
+	}
+	return reached / interval * interval
+}
+
+// proposeSuperRootIfDue fetches the super root to propose and proposes it, if a proposal is due.
+func (l *L2OutputSubmitter) proposeSuperRootIfDue(ctx context.Context) {
+	root, shouldPropose, err := l.FetchSuperRootProposal(ctx)
+	if err != nil {
+		l.Log.Warn("Error getting super root", "err", err)
+		return
+	} else if !shouldPropose {
+		// debug logging already in FetchSuperRootProposal
+		return
+	}
+
+	if active, err := l.isActiveProposer(ctx); err != nil {
+		l.Log.Warn("Error checking sequencer leadership, not proposing", "err", err)
+		return
+	} else if !active {
+		l.Log.Info("Paired sequencer is not the healthy leader, not proposing", "timestamp", root.Timestamp)
+		return
+	}
+
+	l.proposeSuperRoot(ctx, root)
+}
+
+// proposeSuperRoot proposes the super root and returns whether the proposal transaction was published.
+func (l *L2OutputSubmitter) proposeSuperRoot(ctx context.Context, root *eth.SuperRootResponse) bool {
+	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	l.Log.Info("Proposing super root", "super_root", root.SuperRoot, "timestamp", root.Timestamp, "chains", len(root.Chains))
+	receipt, err := l.sendDGFProposal(cCtx, common.Hash(root.SuperRoot), root.Timestamp)
+	if err != nil {
+		l.Log.Error("Failed to send super root proposal transaction", "err", err, "timestamp", root.Timestamp)
+		return false
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		l.Log.Error("Proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
+	} else {
+		l.Log.Info("Proposer tx successfully published", "tx_hash", receipt.TxHash, "timestamp", root.Timestamp)
+	}
+	l.Metr.RecordSuperRootProposed(root.Timestamp)
+	return true
+}
@@ -0,0 +1,110 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	txmgrmocks "github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
+)
+
+type stubSupervisor struct {
+	finalized eth.SuperRootResponse
+	roots     map[uint64]eth.SuperRootResponse
+	requested []uint64
+}
+
+func (s *stubSupervisor) SuperRootAtTimestamp(_ context.Context, timestamp hexutil.Uint64) (eth.SuperRootResponse, error) {
+	s.requested = append(s.requested, uint64(timestamp))
+	return s.roots[uint64(timestamp)], nil
+}
+
+func (s *stubSupervisor) FinalizedSuperRoot(_ context.Context) (eth.SuperRootResponse, error) {
+	return s.finalized, nil
+}
+
+func setupSuperRootTest(t *testing.T, interval time.Duration) (*L2OutputSubmitter, *stubSupervisor) {
+	supervisor := &stubSupervisor{roots: make(map[uint64]eth.SuperRootResponse)}
+	txmgr := txmgrmocks.NewTxManager(t)
+	txmgr.On("From").Return(common.Address{0xaa}).Maybe()
+	cfg := ProposerConfig{
+		NetworkTimeout:   time.Second,
+		ProposalInterval: interval,
+	}
+	l := &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:              testlog.Logger(t, log.LevelDebug),
+			Metr:             metrics.NoopMetrics,
+			Cfg:              cfg,
+			Txmgr:            txmgr,
+			Clock:            clock.SystemClock,
+			SupervisorClient: supervisor,
+		},
+		dgfContract: new(StubDGFContract),
+		schedule:    intervalSchedule(time.Microsecond),
+	}
+	return l, supervisor
+}
+
+func TestFetchSuperRootProposal(t *testing.T) {
+	t.Run("ProposeFinalized", func(t *testing.T) {
+		l, supervisor := setupSuperRootTest(t, 0)
+		supervisor.finalized = eth.SuperRootResponse{Timestamp: 1234, SuperRoot: eth.Bytes32{0x01}}
+
+		root, shouldPropose, err := l.FetchSuperRootProposal(context.Background())
+		require.NoError(t, err)
+		require.True(t, shouldPropose)
+		require.Equal(t, supervisor.finalized, *root)
+		require.Empty(t, supervisor.requested)
+	})
+
+	t.Run("AlignToProposalInterval", func(t *testing.T) {
+		l, supervisor := setupSuperRootTest(t, 100*time.Second)
+		supervisor.finalized = eth.SuperRootResponse{Timestamp: 1234, SuperRoot: eth.Bytes32{0x01}}
+		supervisor.roots[1200] = eth.SuperRootResponse{Timestamp: 1200, SuperRoot: eth.Bytes32{0x02}}
+
+		root, shouldPropose, err := l.FetchSuperRootProposal(context.Background())
+		require.NoError(t, err)
+		require.True(t, shouldPropose)
+		require.Equal(t, supervisor.roots[1200], *root)
+		require.Equal(t, []uint64{1200}, supervisor.requested)
+	})
+
+	t.Run("WaitForFirstProposalTimestamp", func(t *testing.T) {
+		l, supervisor := setupSuperRootTest(t, 100*time.Second)
+		supervisor.finalized = eth.SuperRootResponse{Timestamp: 99, SuperRoot: eth.Bytes32{0x01}}
+
+		_, shouldPropose, err := l.FetchSuperRootProposal(context.Background())
+		require.NoError(t, err)
+		require.False(t, shouldPropose)
+		require.Empty(t, supervisor.requested)
+	})
+
+	t.Run("SkipUnchangedSuperRoot", func(t *testing.T) {
+		l, supervisor := setupSuperRootTest(t, 0)
+		// StubDGFContract reports 0xdd as the claim of the latest game
+		supervisor.finalized = eth.SuperRootResponse{Timestamp: 1234, SuperRoot: eth.Bytes32{0xdd}}
+
+		_, shouldPropose, err := l.FetchSuperRootProposal(context.Background())
+		require.NoError(t, err)
+		require.False(t, shouldPropose)
+	})
+}
+
+func TestNewL2OutputSubmitter_SuperRootsRequireDGF(t *testing.T) {
+	_, err := NewL2OutputSubmitter(DriverSetup{
+		Log:              testlog.Logger(t, log.LevelDebug),
+		Cfg:              ProposerConfig{L2OutputOracleAddr: &common.Address{0xbb}},
+		SupervisorClient: &stubSupervisor{},
+	})
+	require.ErrorContains(t, err, "super roots can only be proposed to the `DisputeGameFactory`")
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// failoverCheckTimeout is the time a standby endpoint has to pass the failover check.
+const failoverCheckTimeout = 30 * time.Second
+
+// FailoverCheck verifies that a standby endpoint is fit to take over, before it becomes the active endpoint.
+// It may also prepare the standby endpoint to take over.
+type FailoverCheck func(ctx context.Context, standby RPC) error
+
+// FailoverClient is an RPC that sends all requests to a single active endpoint, and fails over to the
+// next standby endpoint when the active endpoint fails with consecutive connection errors.
+// Errors returned by the endpoint itself, like JSON-RPC errors, do not count as failures.
+// The request that triggers the failover still returns its error, so the caller can retry it.
+type FailoverClient struct {
+	log       log.Logger
+	endpoints []RPC
+	threshold int
+
+	mu       sync.Mutex
+	active   int
+	failures int
+	check    FailoverCheck
+}
+
+var _ RPC = (*FailoverClient)(nil)
+
+// NewFailoverClient creates a FailoverClient with the first endpoint as active endpoint, which fails over
+// after the given number of consecutive connection errors.
+func NewFailoverClient(log log.Logger, threshold int, endpoints ...RPC) *FailoverClient {
+	return &FailoverClient{
+		log:       log,
+		endpoints: endpoints,
+		threshold: max(threshold, 1),
+	}
+}
+
+// SetFailoverCheck sets the check a standby endpoint has to pass to become the active endpoint.
+func (f *FailoverClient) SetFailoverCheck(check FailoverCheck) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.check = check
+}
+
+// Active returns the index of the active endpoint.
+func (f *FailoverClient) Active() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active
+}
+
+func (f *FailoverClient) current() (int, RPC) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active, f.endpoints[f.active]
+}
+
+func (f *FailoverClient) Close() {
+	for _, endpoint := range f.endpoints {
+		endpoint.Close()
+	}
+}
+
+func (f *FailoverClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	idx, endpoint := f.current()
+	err := endpoint.CallContext(ctx, result, method, args...)
+	f.handleResult(idx, err)
+	return err
+}
+
+func (f *FailoverClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	idx, endpoint := f.current()
+	err := endpoint.BatchCallContext(ctx, b)
+	f.handleResult(idx, err)
+	return err
+}
+
+func (f *FailoverClient) Subscribe(ctx context.Context, namespace string, channel any, args ...any) (ethereum.Subscription, error) {
+	idx, endpoint := f.current()
+	sub, err := endpoint.Subscribe(ctx, namespace, channel, args...)
+	f.handleResult(idx, err)
+	return sub, err
+}
+
+func (f *FailoverClient) handleResult(idx int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if idx != f.active {
+		// The request was sent before a failover
+		return
+	}
+	if !IsConnectionError(err) {
+		f.failures = 0
+		return
+	}
+	f.failures++
+	if f.failures < f.threshold || len(f.endpoints) < 2 {
+		return
+	}
+	f.failures = 0
+	f.failover(err)
+}
+
+// failover makes the next standby endpoint that passes the failover check the active endpoint.
+// Requests are held back while the standby endpoints are checked.
+func (f *FailoverClient) failover(cause error) {
+	for i := 1; i < len(f.endpoints); i++ {
+		next := (f.active + i) % len(f.endpoints)
+		if f.check != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), failoverCheckTimeout)
+			err := f.check(ctx, f.endpoints[next])
+			cancel()
+			if err != nil {
+				f.log.Warn("Standby endpoint failed failover check", "endpoint", next, "err", err)
+				continue
+			}
+		}
+		f.log.Warn("Failed over to standby endpoint", "from", f.active, "to", next, "cause", cause)
+		f.active = next
+		return
+	}
+	f.log.Error("No standby endpoint to fail over to", "active", f.active, "cause", cause)
+}
+
+// IsConnectionError returns whether the error indicates that the endpoint could not be reached or did not respond.
+func IsConnectionError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	// The endpoint responded with an error
+	return !errors.As(err, &rpcErr)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubRPC struct {
+	err   error
+	calls int
+}
+
+func (s *stubRPC) Close() {}
+
+func (s *stubRPC) CallContext(_ context.Context, _ any, _ string, _ ...any) error {
+	s.calls++
+	return s.err
+}
+
+func (s *stubRPC) BatchCallContext(_ context.Context, _ []rpc.BatchElem) error {
+	s.calls++
+	return s.err
+}
+
+func (s *stubRPC) Subscribe(_ context.Context, _ string, _ any, _ ...any) (ethereum.Subscription, error) {
+	s.calls++
+	return nil, s.err
+}
+
+type stubJSONError struct{}
+
+func (stubJSONError) Error() string  { return "execution reverted" }
+func (stubJSONError) ErrorCode() int { return 3 }
+
+func TestFailoverClient(t *testing.T) {
+	connErr := errors.New("connection refused")
+
+	t.Run("FailoverAfterThreshold", func(t *testing.T) {
+		primary, standby := &stubRPC{err: connErr}, &stubRPC{}
+		f := NewFailoverClient(testlog.Logger(t, log.LevelDebug), 2, primary, standby)
+		require.ErrorIs(t, f.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.Equal(t, 0, f.Active())
+		require.ErrorIs(t, f.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.Equal(t, 1, f.Active())
+		require.NoError(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, 2, primary.calls)
+		require.Equal(t, 1, standby.calls)
+	})
+
+	t.Run("SuccessResetsFailures", func(t *testing.T) {
+		primary := &stubRPC{err: connErr}
+		f := NewFailoverClient(testlog.Logger(t, log.LevelDebug), 2, primary, &stubRPC{})
+		require.Error(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		primary.err = nil
+		require.NoError(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		primary.err = connErr
+		require.Error(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, 0, f.Active())
+	})
+
+	t.Run("IgnoreEndpointErrors", func(t *testing.T) {
+		primary := &stubRPC{err: stubJSONError{}}
+		f := NewFailoverClient(testlog.Logger(t, log.LevelDebug), 1, primary, &stubRPC{})
+		require.Error(t, f.CallContext(context.Background(), nil, "eth_call"))
+		primary.err = context.Canceled
+		require.Error(t, f.BatchCallContext(context.Background(), nil))
+		require.Equal(t, 0, f.Active())
+	})
+
+	t.Run("SkipStandbyFailingCheck", func(t *testing.T) {
+		primary, standby1, standby2 := &stubRPC{err: connErr}, &stubRPC{}, &stubRPC{}
+		f := NewFailoverClient(testlog.Logger(t, log.LevelDebug), 1, primary, standby1, standby2)
+		var checked []RPC
+		f.SetFailoverCheck(func(ctx context.Context, standby RPC) error {
+			checked = append(checked, standby)
+			if standby == standby1 {
+				return errors.New("diverged")
+			}
+			return nil
+		})
+		require.Error(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, 2, f.Active())
+		require.Equal(t, []RPC{standby1, standby2}, checked)
+	})
+
+	t.Run("NoStandbyPassesCheck", func(t *testing.T) {
+		f := NewFailoverClient(testlog.Logger(t, log.LevelDebug), 1, &stubRPC{err: connErr}, &stubRPC{})
+		f.SetFailoverCheck(func(ctx context.Context, standby RPC) error {
+			return errors.New("diverged")
+		})
+		require.Error(t, f.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, 0, f.Active())
+	})
+}
@@ -2,6 +2,7 @@ package clock
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 )
@@ -10,6 +11,9 @@ type action interface {
 	// Return true if the action is due to fire
 	isDue(time.Time) bool
 
+	// dueAt returns the time the action is due to fire
+	dueAt() time.Time
+
 	// fire triggers the action. Returns true if the action needs to fire again in the future
 	fire(time.Time) bool
 }
@@ -23,6 +27,10 @@ func (t task) isDue(now time.Time) bool {
 	return !t.due.After(now)
 }
 
+func (t task) dueAt() time.Time {
+	return t.due
+}
+
 func (t task) fire(now time.Time) bool {
 	t.ch <- now
 	close(t.ch)
@@ -44,6 +52,12 @@ func (t *timer) isDue(now time.Time) bool {
 	return !t.due.After(now)
 }
 
+func (t *timer) dueAt() time.Time {
+	t.Lock()
+	defer t.Unlock()
+	return t.due
+}
+
 func (t *timer) fire(now time.Time) bool {
 	t.Lock()
 	defer t.Unlock()
@@ -67,7 +81,7 @@ func (t *timer) Stop() bool {
 }
 
 type ticker struct {
-	c       Clock
+	c       *DeterministicClock
 	ch      chan time.Time
 	nextDue time.Time
 	period  time.Duration
@@ -89,10 +103,15 @@ func (t *ticker) Reset(d time.Duration) {
 	if d <= 0 {
 		panic("Continuously firing tickers are a really bad idea")
 	}
+	// Read the time before locking the ticker, as the clock locks tickers while holding its own lock
+	now := t.c.Now()
 	t.Lock()
-	defer t.Unlock()
 	t.period = d
-	t.nextDue = t.c.Now().Add(d)
+	t.nextDue = now.Add(d)
+	t.stopped = false
+	t.Unlock()
+	// A stopped ticker may have been removed from the pending actions already
+	t.c.schedule(t)
 }
 
 func (t *ticker) isDue(now time.Time) bool {
@@ -101,6 +120,12 @@ func (t *ticker) isDue(now time.Time) bool {
 	return !t.nextDue.After(now)
 }
 
+func (t *ticker) dueAt() time.Time {
+	t.Lock()
+	defer t.Unlock()
+	return t.nextDue
+}
+
 func (t *ticker) fire(now time.Time) bool {
 	t.Lock()
 	defer t.Unlock()
@@ -197,7 +222,11 @@ func (s *DeterministicClock) NewTimer(d time.Duration) Timer {
 		ch:  ch,
 		due: s.now.Add(d),
 	}
-	s.addPending(t)
+	if d <= 0 {
+		t.fire(s.now)
+	} else {
+		s.addPending(t)
+	}
 	return t
 }
 
@@ -214,6 +243,15 @@ func (s *DeterministicClock) addPending(t action) {
 	}
 }
 
+// schedule adds the action to the pending actions, unless it is pending already.
+func (s *DeterministicClock) schedule(a action) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !slices.Contains(s.pending, a) {
+		s.addPending(a)
+	}
+}
+
 func (s *DeterministicClock) WaitForNewPendingTaskWithTimeout(timeout time.Duration) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -245,4 +283,25 @@ func (s *DeterministicClock) AdvanceTime(d time.Duration) {
 	s.pending = remaining
 }
 
+// AdvanceToNextTask moves the time forward to when the earliest pending task is due, and fires the due tasks.
+// Returns false, without changing the time, if there are no pending tasks.
+// This allows services to run through their timers and tickers without knowing the durations they use.
+func (s *DeterministicClock) AdvanceToNextTask() bool {
+	s.lock.Lock()
+	if len(s.pending) == 0 {
+		s.lock.Unlock()
+		return false
+	}
+	next := s.pending[0].dueAt()
+	for _, a := range s.pending[1:] {
+		if due := a.dueAt(); due.Before(next) {
+			next = due
+		}
+	}
+	d := max(next.Sub(s.now), 0)
+	s.lock.Unlock()
+	s.AdvanceTime(d)
+	return true
+}
+
 var _ Clock = (*DeterministicClock)(nil)
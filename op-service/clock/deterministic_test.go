@@ -239,6 +239,19 @@ func TestNewTicker(t *testing.T) {
 		require.Equal(t, clock.Now(), <-ticker.Ch(), "should post current time")
 	})
 
+	t.Run("ResetAfterStop", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		ticker := clock.NewTicker(5 * time.Second)
+		ticker.Stop()
+		clock.AdvanceTime(5 * time.Second)
+		require.Len(t, ticker.Ch(), 0, "should not fire when stopped")
+
+		ticker.Reset(1 * time.Second)
+		clock.AdvanceTime(1 * time.Second)
+		require.Len(t, ticker.Ch(), 1, "should fire again after reset")
+		require.Equal(t, clock.Now(), <-ticker.Ch(), "should post current time")
+	})
+
 	t.Run("RegisterAsPending", func(t *testing.T) {
 		clock := NewDeterministicClock(time.UnixMilli(1000))
 		ticker := clock.NewTicker(5 * time.Second)
@@ -288,6 +301,35 @@ func TestNewTimer(t *testing.T) {
 
 		require.False(t, timer.Stop())
 	})
+
+	t.Run("FireImmediatelyWhenNotPositive", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		timer := clock.NewTimer(0)
+		require.Len(t, timer.Ch(), 1, "should fire immediately")
+		require.Equal(t, clock.Now(), <-timer.Ch(), "should post current time")
+	})
+}
+
+func TestAdvanceToNextTask(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	require.False(t, clock.AdvanceToNextTask(), "should not advance without pending tasks")
+	require.Equal(t, time.UnixMilli(1000), clock.Now())
+
+	ticker := clock.NewTicker(3 * time.Second)
+	ch := clock.After(5 * time.Second)
+
+	require.True(t, clock.AdvanceToNextTask())
+	require.Equal(t, time.UnixMilli(4000), clock.Now())
+	require.Equal(t, clock.Now(), <-ticker.Ch(), "should fire ticker")
+	require.Len(t, ch, 0, "should not fire later task")
+
+	require.True(t, clock.AdvanceToNextTask())
+	require.Equal(t, time.UnixMilli(6000), clock.Now())
+	require.Equal(t, clock.Now(), <-ch, "should fire task")
+
+	ticker.Stop()
+	require.True(t, clock.AdvanceToNextTask(), "should remove stopped ticker")
+	require.False(t, clock.AdvanceToNextTask())
 }
 
 func TestWaitForPending(t *testing.T) {
@@ -0,0 +1,33 @@
+package dial
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-service/health"
+)
+
+// RollupHealthProbe returns a readiness probe that checks the sync status of the current rollup node can be fetched.
+func RollupHealthProbe(provider RollupProvider) health.Probe {
+	return func(ctx context.Context) error {
+		rollupClient, err := provider.RollupClient(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = rollupClient.SyncStatus(ctx)
+		return err
+	}
+}
+
+// L2EthHealthProbe returns a readiness probe that checks the head of the current L2 execution node can be fetched.
+func L2EthHealthProbe(provider L2EndpointProvider) health.Probe {
+	return func(ctx context.Context) error {
+		ethClient, err := provider.EthClient(ctx)
+		if err != nil {
+			return err
+		}
+		var head hexutil.Uint64
+		return ethClient.Client().CallContext(ctx, &head, "eth_blockNumber")
+	}
+}
@@ -0,0 +1,116 @@
+package dial
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// srvSchemePrefix marks an endpoint that is resolved from DNS SRV records,
+	// e.g. srv+http://_rpc._tcp.op-node.example.com dials http://<target>:<port> of each record.
+	srvSchemePrefix = "srv+"
+	// topologyFileScheme marks an endpoint that is resolved from a topology file,
+	// e.g. file:///etc/optimism/topology.json#op-node dials the addresses listed for op-node in the file.
+	topologyFileScheme = "file://"
+)
+
+// Resolver resolves the addresses of a service endpoint, in order of preference.
+type Resolver interface {
+	Resolve(ctx context.Context) ([]string, error)
+}
+
+// NewResolver creates a Resolver for the endpoint spec, which is one of:
+//   - srv+<scheme>://<name>: the targets of the DNS SRV records of name, dialed with scheme.
+//   - file://<path>#<service>: the addresses of the service in a JSON topology file,
+//     mapping service names to address lists. The file is read again on every resolution.
+//   - a comma-separated list of static addresses.
+func NewResolver(spec string) (Resolver, error) {
+	switch {
+	case strings.HasPrefix(spec, srvSchemePrefix):
+		scheme, name, ok := strings.Cut(strings.TrimPrefix(spec, srvSchemePrefix), "://")
+		if !ok || scheme == "" || name == "" {
+			return nil, fmt.Errorf("invalid SRV endpoint %q, expected srv+<scheme>://<name>", spec)
+		}
+		return &SRVResolver{Scheme: scheme, Name: name}, nil
+	case strings.HasPrefix(spec, topologyFileScheme):
+		path, service, ok := strings.Cut(strings.TrimPrefix(spec, topologyFileScheme), "#")
+		if !ok || path == "" || service == "" {
+			return nil, fmt.Errorf("invalid topology endpoint %q, expected file://<path>#<service>", spec)
+		}
+		return &TopologyFileResolver{Path: path, Service: service}, nil
+	default:
+		var addrs StaticResolver
+		for _, addr := range strings.Split(spec, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			return nil, errors.New("empty endpoint")
+		}
+		return addrs, nil
+	}
+}
+
+// StaticResolver resolves to a fixed list of addresses.
+type StaticResolver []string
+
+func (s StaticResolver) Resolve(_ context.Context) ([]string, error) {
+	return s, nil
+}
+
+// SRVResolver resolves the addresses from the DNS SRV records of Name.
+// The addresses are ordered by priority, and randomized by weight within a priority.
+type SRVResolver struct {
+	Scheme string
+	Name   string
+
+	// lookupSRV defaults to the lookup of the default net.Resolver
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+func (s *SRVResolver) Resolve(ctx context.Context) ([]string, error) {
+	lookup := s.lookupSRV
+	if lookup == nil {
+		lookup = net.DefaultResolver.LookupSRV
+	}
+	_, records, err := lookup(ctx, "", "", s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up SRV records of %v: %w", s.Name, err)
+	}
+	addrs := make([]string, 0, len(records))
+	for _, record := range records {
+		host := net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port)))
+		addrs = append(addrs, s.Scheme+"://"+host)
+	}
+	return addrs, nil
+}
+
+// TopologyFileResolver resolves the addresses of Service from a JSON topology file,
+// which maps service names to lists of addresses.
+type TopologyFileResolver struct {
+	Path    string
+	Service string
+}
+
+func (t *TopologyFileResolver) Resolve(_ context.Context) ([]string, error) {
+	data, err := os.ReadFile(t.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read topology file: %w", err)
+	}
+	var topology map[string][]string
+	if err := json.Unmarshal(data, &topology); err != nil {
+		return nil, fmt.Errorf("failed to parse topology file %v: %w", t.Path, err)
+	}
+	addrs, ok := topology[t.Service]
+	if !ok {
+		return nil, fmt.Errorf("service %v not in topology file %v", t.Service, t.Path)
+	}
+	return addrs, nil
+}
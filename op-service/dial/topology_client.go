@@ -0,0 +1,191 @@
+package dial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+// DefaultTopologyFailureThreshold is the number of consecutive connection errors after which
+// a TopologyClient rotates to the next address.
+const DefaultTopologyFailureThreshold = 3
+
+type rpcDialer func(ctx context.Context, log log.Logger, addr string) (client.RPC, error)
+
+func rpcDialerWithOptions(opts ...client.RPCOption) rpcDialer {
+	return func(ctx context.Context, log log.Logger, addr string) (client.RPC, error) {
+		return client.NewRPC(ctx, log, addr, opts...)
+	}
+}
+
+// TopologyClient is an RPC that sends requests to one of the addresses of a service endpoint.
+// It rotates to the next address when the current address fails with consecutive connection errors,
+// and resolves the addresses again once it went through all of them,
+// so addresses can be added and removed without restarting the client.
+// The request that triggers the rotation still returns its error, so the caller can retry it.
+type TopologyClient struct {
+	log       log.Logger
+	resolver  Resolver
+	dialer    rpcDialer
+	threshold int
+
+	mu       sync.Mutex
+	addrs    []string
+	next     int
+	current  client.RPC
+	failures int
+}
+
+var _ client.RPC = (*TopologyClient)(nil)
+
+// DialTopologyClient resolves the endpoint spec, see NewResolver, and dials the first reachable address.
+func DialTopologyClient(ctx context.Context, log log.Logger, spec string, threshold int, opts ...client.RPCOption) (*TopologyClient, error) {
+	resolver, err := NewResolver(spec)
+	if err != nil {
+		return nil, err
+	}
+	return newTopologyClient(ctx, log, resolver, threshold, rpcDialerWithOptions(opts...))
+}
+
+// DialTopologyRollupClientWithTimeout dials a rollup client for the endpoint spec, see DialTopologyClient.
+func DialTopologyRollupClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, spec string, opts ...client.RPCOption) (*sources.RollupClient, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rpcCl, err := DialTopologyClient(ctx, log, spec, DefaultTopologyFailureThreshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sources.NewRollupClient(rpcCl), nil
+}
+
+func newTopologyClient(ctx context.Context, log log.Logger, resolver Resolver, threshold int, dialer rpcDialer) (*TopologyClient, error) {
+	t := &TopologyClient{
+		log:       log,
+		resolver:  resolver,
+		dialer:    dialer,
+		threshold: max(threshold, 1),
+	}
+	if _, err := t.endpoint(ctx); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Current returns the address of the current endpoint, or an empty string if no address is dialed.
+func (t *TopologyClient) Current() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.current == nil {
+		return ""
+	}
+	return t.addrs[t.next-1]
+}
+
+// endpoint returns the current endpoint, dialing the next address if there is none.
+// The addresses are resolved again when all of them have been tried.
+func (t *TopologyClient) endpoint(ctx context.Context) (client.RPC, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.current != nil {
+		return t.current, nil
+	}
+	var errs error
+	resolved := false
+	for {
+		if t.next >= len(t.addrs) {
+			if resolved {
+				return nil, fmt.Errorf("failed to dial any of %v: %w", t.addrs, errs)
+			}
+			addrs, err := t.resolver.Resolve(ctx)
+			if err != nil {
+				return nil, errors.Join(errs, err)
+			}
+			if len(addrs) == 0 {
+				return nil, errors.Join(errs, errors.New("endpoint resolved to no addresses"))
+			}
+			t.log.Debug("Resolved endpoint addresses", "addrs", addrs)
+			t.addrs, t.next, resolved = addrs, 0, true
+		}
+		addr := t.addrs[t.next]
+		t.next++
+		endpoint, err := t.dialer(ctx, t.log, addr)
+		if err != nil {
+			t.log.Warn("Failed to dial endpoint address", "addr", addr, "err", err)
+			errs = errors.Join(errs, err)
+			continue
+		}
+		t.log.Info("Dialed endpoint address", "addr", addr)
+		t.current = endpoint
+		return endpoint, nil
+	}
+}
+
+func (t *TopologyClient) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.current != nil {
+		t.current.Close()
+		t.current = nil
+	}
+}
+
+func (t *TopologyClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	endpoint, err := t.endpoint(ctx)
+	if err != nil {
+		return err
+	}
+	err = endpoint.CallContext(ctx, result, method, args...)
+	t.handleResult(endpoint, err)
+	return err
+}
+
+func (t *TopologyClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	endpoint, err := t.endpoint(ctx)
+	if err != nil {
+		return err
+	}
+	err = endpoint.BatchCallContext(ctx, b)
+	t.handleResult(endpoint, err)
+	return err
+}
+
+func (t *TopologyClient) Subscribe(ctx context.Context, namespace string, channel any, args ...any) (ethereum.Subscription, error) {
+	endpoint, err := t.endpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := endpoint.Subscribe(ctx, namespace, channel, args...)
+	t.handleResult(endpoint, err)
+	return sub, err
+}
+
+func (t *TopologyClient) handleResult(endpoint client.RPC, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if endpoint != t.current {
+		// The request was sent before a rotation
+		return
+	}
+	if !client.IsConnectionError(err) {
+		t.failures = 0
+		return
+	}
+	t.failures++
+	if t.failures < t.threshold {
+		return
+	}
+	t.log.Warn("Rotating away from failing endpoint address", "addr", t.addrs[t.next-1], "err", err)
+	t.failures = 0
+	t.current.Close()
+	t.current = nil
+}
@@ -0,0 +1,179 @@
+package dial
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestNewResolver(t *testing.T) {
+	r, err := NewResolver("http://a:8545, http://b:8545")
+	require.NoError(t, err)
+	require.Equal(t, StaticResolver{"http://a:8545", "http://b:8545"}, r)
+
+	r, err = NewResolver("srv+ws://_rpc._tcp.op-node.example.com")
+	require.NoError(t, err)
+	require.Equal(t, &SRVResolver{Scheme: "ws", Name: "_rpc._tcp.op-node.example.com"}, r)
+
+	r, err = NewResolver("file:///etc/topology.json#op-node")
+	require.NoError(t, err)
+	require.Equal(t, &TopologyFileResolver{Path: "/etc/topology.json", Service: "op-node"}, r)
+
+	for _, spec := range []string{"", " , ", "srv+http://", "srv+://name", "file:///etc/topology.json", "file://#op-node"} {
+		_, err := NewResolver(spec)
+		require.Error(t, err, "spec %q", spec)
+	}
+}
+
+func TestSRVResolver(t *testing.T) {
+	lookupErr := errors.New("no such host")
+	r := &SRVResolver{Scheme: "http", Name: "_rpc._tcp.op-node.example.com"}
+	r.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		require.Empty(t, service)
+		require.Empty(t, proto)
+		require.Equal(t, r.Name, name)
+		return "", []*net.SRV{
+			{Target: "node-1.example.com.", Port: 8545},
+			{Target: "node-2.example.com.", Port: 9545},
+		}, nil
+	}
+	addrs, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://node-1.example.com:8545", "http://node-2.example.com:9545"}, addrs)
+
+	r.lookupSRV = func(_ context.Context, _, _, _ string) (string, []*net.SRV, error) {
+		return "", nil, lookupErr
+	}
+	_, err = r.Resolve(context.Background())
+	require.ErrorIs(t, err, lookupErr)
+}
+
+func TestTopologyFileResolver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "topology.json")
+	r := &TopologyFileResolver{Path: path, Service: "op-node"}
+	_, err := r.Resolve(context.Background())
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"op-node": ["http://a:8545", "http://b:8545"]}`), 0o644))
+	addrs, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://a:8545", "http://b:8545"}, addrs)
+
+	r.Service = "op-supervisor"
+	_, err = r.Resolve(context.Background())
+	require.ErrorContains(t, err, "not in topology file")
+}
+
+type stubResolver struct {
+	addrs    []string
+	resolved int
+}
+
+func (s *stubResolver) Resolve(_ context.Context) ([]string, error) {
+	s.resolved++
+	return s.addrs, nil
+}
+
+type stubEndpoint struct {
+	err    error
+	calls  int
+	closed bool
+}
+
+func (s *stubEndpoint) Close() { s.closed = true }
+
+func (s *stubEndpoint) CallContext(_ context.Context, _ any, _ string, _ ...any) error {
+	s.calls++
+	return s.err
+}
+
+func (s *stubEndpoint) BatchCallContext(_ context.Context, _ []rpc.BatchElem) error {
+	s.calls++
+	return s.err
+}
+
+func (s *stubEndpoint) Subscribe(_ context.Context, _ string, _ any, _ ...any) (ethereum.Subscription, error) {
+	s.calls++
+	return nil, s.err
+}
+
+func TestTopologyClient(t *testing.T) {
+	connErr := errors.New("connection refused")
+	dialErr := errors.New("dial failed")
+
+	setup := func(t *testing.T, addrs ...string) (*TopologyClient, *stubResolver, map[string]*stubEndpoint, map[string]error) {
+		resolver := &stubResolver{addrs: addrs}
+		endpoints := make(map[string]*stubEndpoint)
+		dialErrs := make(map[string]error)
+		dialer := func(_ context.Context, _ log.Logger, addr string) (client.RPC, error) {
+			if err := dialErrs[addr]; err != nil {
+				return nil, err
+			}
+			endpoint := &stubEndpoint{}
+			endpoints[addr] = endpoint
+			return endpoint, nil
+		}
+		tc, err := newTopologyClient(context.Background(), testlog.Logger(t, log.LevelDebug), resolver, 2, dialer)
+		require.NoError(t, err)
+		return tc, resolver, endpoints, dialErrs
+	}
+
+	t.Run("RotateAfterThreshold", func(t *testing.T) {
+		tc, resolver, endpoints, _ := setup(t, "a", "b")
+		require.Equal(t, "a", tc.Current())
+		endpoints["a"].err = connErr
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.Equal(t, "a", tc.Current())
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.True(t, endpoints["a"].closed)
+
+		require.NoError(t, tc.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, "b", tc.Current())
+		require.Equal(t, 1, endpoints["b"].calls)
+		require.Equal(t, 1, resolver.resolved)
+	})
+
+	t.Run("IgnoreJSONErrors", func(t *testing.T) {
+		tc, _, endpoints, _ := setup(t, "a", "b")
+		endpoints["a"].err = &rpc.JsonError{Code: 3, Message: "execution reverted"}
+		for i := 0; i < 3; i++ {
+			require.Error(t, tc.CallContext(context.Background(), nil, "eth_call"))
+		}
+		require.Equal(t, "a", tc.Current())
+	})
+
+	t.Run("ResolveAgainAfterAllAddresses", func(t *testing.T) {
+		tc, resolver, endpoints, dialErrs := setup(t, "a", "b")
+		dialErrs["b"] = dialErr
+		resolver.addrs = []string{"c"}
+		endpoints["a"].err = connErr
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+
+		require.NoError(t, tc.CallContext(context.Background(), nil, "eth_chainId"))
+		require.Equal(t, "c", tc.Current())
+		require.Equal(t, 2, resolver.resolved)
+	})
+
+	t.Run("NoReachableAddress", func(t *testing.T) {
+		tc, _, endpoints, dialErrs := setup(t, "a")
+		dialErrs["a"] = dialErr
+		endpoints["a"].err = connErr
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), connErr)
+		require.ErrorIs(t, tc.CallContext(context.Background(), nil, "eth_chainId"), dialErr)
+		require.Equal(t, "", tc.Current())
+	})
+}
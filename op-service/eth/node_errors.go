@@ -0,0 +1,81 @@
+package eth
+
+import (
+	"errors"
+)
+
+// Error codes of the op-node RPC, in the implementation-defined server error range of JSON-RPC.
+// Clients can branch on these codes, see NodeErrorCodeOf, instead of matching error messages.
+const (
+	// NodeConfigErrorCode is returned when a request cannot be served with the configuration of the node,
+	// e.g. because the requested feature is disabled.
+	NodeConfigErrorCode ErrorCode = -32070
+	// NodeUnavailableErrorCode is returned when the node is temporarily unable to serve a request,
+	// e.g. because it is busy or a dependency is unavailable. The request may be retried.
+	NodeUnavailableErrorCode ErrorCode = -32071
+	// NodeReorgErrorCode is returned when the chain changed while the request was served.
+	// The request may be retried once the node is consistent again.
+	NodeReorgErrorCode ErrorCode = -32072
+	// NodeEngineSyncingErrorCode is returned while the execution engine is syncing and cannot serve the request yet.
+	NodeEngineSyncingErrorCode ErrorCode = -32073
+	// NodeNotFoundErrorCode is returned when the requested data does not exist (yet).
+	NodeNotFoundErrorCode ErrorCode = -32074
+)
+
+var nodeErrorKinds = map[ErrorCode]string{
+	NodeConfigErrorCode:        "config",
+	NodeUnavailableErrorCode:   "unavailable",
+	NodeReorgErrorCode:         "reorg",
+	NodeEngineSyncingErrorCode: "engine-syncing",
+	NodeNotFoundErrorCode:      "not-found",
+}
+
+// IsNodeError returns true if the code is one of the typed error codes of the op-node RPC.
+func (c ErrorCode) IsNodeError() bool {
+	_, ok := nodeErrorKinds[c]
+	return ok
+}
+
+// NodeError is a typed error of the op-node RPC. It implements the rpc.Error interface,
+// so that the code is returned as JSON-RPC error code, and the kind of error as error data.
+type NodeError struct {
+	Code ErrorCode
+	Err  error
+}
+
+func NewNodeError(code ErrorCode, err error) *NodeError {
+	return &NodeError{Code: code, Err: err}
+}
+
+func (e *NodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *NodeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *NodeError) ErrorCode() int {
+	return int(e.Code)
+}
+
+func (e *NodeError) ErrorData() interface{} {
+	return nodeErrorKinds[e.Code]
+}
+
+// NodeErrorCodeOf returns the typed op-node RPC error code of the error, both of errors returned by
+// the RPC client and of NodeError values. It returns false if the error has no such code.
+func NodeErrorCodeOf(err error) (ErrorCode, bool) {
+	var rpcErr interface{ ErrorCode() int }
+	if !errors.As(err, &rpcErr) {
+		return 0, false
+	}
+	code := ErrorCode(rpcErr.ErrorCode())
+	return code, code.IsNodeError()
+}
+
+// IsNodeError returns true if the error has the given typed op-node RPC error code.
+func IsNodeError(err error, code ErrorCode) bool {
+	c, ok := NodeErrorCodeOf(err)
+	return ok && c == code
+}
@@ -0,0 +1,45 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testRPCError struct {
+	code int
+}
+
+func (e testRPCError) Error() string  { return "rpc error" }
+func (e testRPCError) ErrorCode() int { return e.code }
+
+func TestNodeError(t *testing.T) {
+	inner := errors.New("engine is syncing")
+	err := NewNodeError(NodeEngineSyncingErrorCode, inner)
+	require.Equal(t, inner.Error(), err.Error())
+	require.ErrorIs(t, err, inner)
+	require.Equal(t, -32073, err.ErrorCode())
+	require.Equal(t, "engine-syncing", err.ErrorData())
+
+	code, ok := NodeErrorCodeOf(fmt.Errorf("wrapped: %w", err))
+	require.True(t, ok)
+	require.Equal(t, NodeEngineSyncingErrorCode, code)
+	require.True(t, IsNodeError(err, NodeEngineSyncingErrorCode))
+	require.False(t, IsNodeError(err, NodeReorgErrorCode))
+}
+
+func TestNodeErrorCodeOf(t *testing.T) {
+	// Errors returned by the RPC client carry the code of the server
+	code, ok := NodeErrorCodeOf(fmt.Errorf("call failed: %w", testRPCError{code: -32074}))
+	require.True(t, ok)
+	require.Equal(t, NodeNotFoundErrorCode, code)
+
+	_, ok = NodeErrorCodeOf(testRPCError{code: int(InvalidParams)})
+	require.False(t, ok)
+	_, ok = NodeErrorCodeOf(errors.New("boom"))
+	require.False(t, ok)
+	_, ok = NodeErrorCodeOf(nil)
+	require.False(t, ok)
+}
@@ -87,3 +87,27 @@ func unmarshalOutputV0(data []byte) (*OutputV0, error) {
 	copy(output.BlockHash[:], data[96:128])
 	return &output, nil
 }
+
+type DepositStatus string
+
+const (
+	// DepositPending is the status of a deposit that is not yet included in an L2 block.
+	DepositPending DepositStatus = "pending"
+	// DepositIncluded is the status of a deposit that was included in an L2 block and executed successfully.
+	DepositIncluded DepositStatus = "included"
+	// DepositFailed is the status of a deposit that was included in an L2 block, but failed to execute.
+	// Any minted ETH is still credited to the sender.
+	DepositFailed DepositStatus = "failed"
+)
+
+// DepositTrace correlates a deposit of an L1 transaction with the L2 deposit transaction derived from it.
+type DepositTrace struct {
+	L1TxHash common.Hash `json:"l1TxHash"`
+	L1Block  BlockID     `json:"l1Block"`
+	// L1LogIndex is the index of the deposit log in the L1 block.
+	L1LogIndex uint64        `json:"l1LogIndex"`
+	L2TxHash   common.Hash   `json:"l2TxHash"`
+	Status     DepositStatus `json:"status"`
+	// L2Block is the block the deposit was included in, nil if the deposit is pending.
+	L2Block *BlockID `json:"l2Block,omitempty"`
+}
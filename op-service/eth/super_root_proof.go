@@ -0,0 +1,123 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var ErrSuperRootProofMismatch = errors.New("super root proof does not match super root")
+
+// SuperRootProof proves that the output root of a chain at a timestamp is included in a V1 super root.
+// A V1 super root commits to the output roots of all chains with a single hash, so the proof consists
+// of the output roots of the other chains, which are hashed together with the proven output root.
+type SuperRootProof struct {
+	Timestamp  uint64
+	ChainID    uint64
+	OutputRoot Bytes32
+	// Preceding and Following are the other chains of the super root, with lower and higher chain IDs.
+	Preceding []ChainIDAndOutput
+	Following []ChainIDAndOutput
+}
+
+// NewSuperRootProof creates the proof of inclusion of the output root of the chain in the super root.
+func NewSuperRootProof(super *SuperV1, chainID uint64) (*SuperRootProof, error) {
+	for i, chain := range super.Chains {
+		if chain.ChainID != chainID {
+			continue
+		}
+		return &SuperRootProof{
+			Timestamp:  super.Timestamp,
+			ChainID:    chainID,
+			OutputRoot: chain.Output,
+			Preceding:  append([]ChainIDAndOutput(nil), super.Chains[:i]...),
+			Following:  append([]ChainIDAndOutput(nil), super.Chains[i+1:]...),
+		}, nil
+	}
+	return nil, fmt.Errorf("chain %d not in super root", chainID)
+}
+
+// Super returns the super root preimage the proof is built from.
+func (p *SuperRootProof) Super() (*SuperV1, error) {
+	chains := make([]ChainIDAndOutput, 0, len(p.Preceding)+1+len(p.Following))
+	chains = append(chains, p.Preceding...)
+	chains = append(chains, ChainIDAndOutput{ChainID: p.ChainID, Output: p.OutputRoot})
+	chains = append(chains, p.Following...)
+	// Require the canonical order of the super root, so the chain is proven at a unique position.
+	for i := 1; i < len(chains); i++ {
+		if chains[i-1].ChainID >= chains[i].ChainID {
+			return nil, fmt.Errorf("chains of super root proof not sorted by chain ID at index %d", i)
+		}
+	}
+	return &SuperV1{Timestamp: p.Timestamp, Chains: chains}, nil
+}
+
+// Verify checks that the proof proves the inclusion of the output root in the super root.
+func (p *SuperRootProof) Verify(superRoot Bytes32) error {
+	super, err := p.Super()
+	if err != nil {
+		return err
+	}
+	if computed := SuperRoot(super); computed != superRoot {
+		return fmt.Errorf("%w: computed %s, expected %s", ErrSuperRootProofMismatch, computed, superRoot)
+	}
+	return nil
+}
+
+type chainIDAndOutputMarshalling struct {
+	ChainID hexutil.Uint64 `json:"chainID"`
+	Output  common.Hash    `json:"output"`
+}
+
+type superRootProofMarshalling struct {
+	Timestamp  hexutil.Uint64                `json:"timestamp"`
+	ChainID    hexutil.Uint64                `json:"chainID"`
+	OutputRoot common.Hash                   `json:"outputRoot"`
+	Preceding  []chainIDAndOutputMarshalling `json:"preceding"`
+	Following  []chainIDAndOutputMarshalling `json:"following"`
+}
+
+func marshalChainOutputs(chains []ChainIDAndOutput) []chainIDAndOutputMarshalling {
+	out := make([]chainIDAndOutputMarshalling, len(chains))
+	for i, c := range chains {
+		out[i] = chainIDAndOutputMarshalling{ChainID: hexutil.Uint64(c.ChainID), Output: common.Hash(c.Output)}
+	}
+	return out
+}
+
+func unmarshalChainOutputs(chains []chainIDAndOutputMarshalling) []ChainIDAndOutput {
+	if len(chains) == 0 {
+		return nil
+	}
+	out := make([]ChainIDAndOutput, len(chains))
+	for i, c := range chains {
+		out[i] = ChainIDAndOutput{ChainID: uint64(c.ChainID), Output: Bytes32(c.Output)}
+	}
+	return out
+}
+
+func (p SuperRootProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&superRootProofMarshalling{
+		Timestamp:  hexutil.Uint64(p.Timestamp),
+		ChainID:    hexutil.Uint64(p.ChainID),
+		OutputRoot: common.Hash(p.OutputRoot),
+		Preceding:  marshalChainOutputs(p.Preceding),
+		Following:  marshalChainOutputs(p.Following),
+	})
+}
+
+func (p *SuperRootProof) UnmarshalJSON(input []byte) error {
+	var dec superRootProofMarshalling
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	p.Timestamp = uint64(dec.Timestamp)
+	p.ChainID = uint64(dec.ChainID)
+	p.OutputRoot = Bytes32(dec.OutputRoot)
+	p.Preceding = unmarshalChainOutputs(dec.Preceding)
+	p.Following = unmarshalChainOutputs(dec.Following)
+	return nil
+}
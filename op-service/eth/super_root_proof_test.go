@@ -0,0 +1,61 @@
+package eth
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuperRootProof(t *testing.T) {
+	super := NewSuperV1(1000,
+		ChainIDAndOutput{ChainID: 3, Output: Bytes32{0x03}},
+		ChainIDAndOutput{ChainID: 1, Output: Bytes32{0x01}},
+		ChainIDAndOutput{ChainID: 2, Output: Bytes32{0x02}})
+	superRoot := SuperRoot(super)
+
+	for _, chainID := range []uint64{1, 2, 3} {
+		proof, err := NewSuperRootProof(super, chainID)
+		require.NoError(t, err)
+		require.Equal(t, Bytes32{byte(chainID)}, proof.OutputRoot)
+		require.Len(t, proof.Preceding, int(chainID)-1)
+		require.NoError(t, proof.Verify(superRoot))
+	}
+
+	t.Run("UnknownChain", func(t *testing.T) {
+		_, err := NewSuperRootProof(super, 4)
+		require.ErrorContains(t, err, "not in super root")
+	})
+
+	t.Run("WrongOutputRoot", func(t *testing.T) {
+		proof, err := NewSuperRootProof(super, 2)
+		require.NoError(t, err)
+		proof.OutputRoot = Bytes32{0xff}
+		require.ErrorIs(t, proof.Verify(superRoot), ErrSuperRootProofMismatch)
+	})
+
+	t.Run("WrongTimestamp", func(t *testing.T) {
+		proof, err := NewSuperRootProof(super, 2)
+		require.NoError(t, err)
+		proof.Timestamp++
+		require.ErrorIs(t, proof.Verify(superRoot), ErrSuperRootProofMismatch)
+	})
+
+	t.Run("UnsortedChains", func(t *testing.T) {
+		proof, err := NewSuperRootProof(super, 2)
+		require.NoError(t, err)
+		proof.Preceding, proof.Following = proof.Following, proof.Preceding
+		require.ErrorContains(t, proof.Verify(superRoot), "not sorted")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		proof, err := NewSuperRootProof(super, 1)
+		require.NoError(t, err)
+		data, err := json.Marshal(proof)
+		require.NoError(t, err)
+		var decoded SuperRootProof
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, *proof, decoded)
+		require.NoError(t, decoded.Verify(superRoot))
+	})
+}
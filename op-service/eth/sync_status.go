@@ -38,4 +38,19 @@ type SyncStatus struct {
 	CrossUnsafeL2 L2BlockRef `json:"cross_unsafe_l2"`
 	// LocalSafeL2 is an L2 block derived from L1, not yet verified to have valid cross-L2 dependencies.
 	LocalSafeL2 L2BlockRef `json:"local_safe_l2"`
+	// L1BlobsDegraded is true while the L1 blobs source (the L1 Beacon API) is failing,
+	// and blob retrieval is being retried with backoff. While degraded, derivation continues
+	// through calldata batches, up to the first blob batch. L1 is derived in order, so the
+	// L1 blocks after that blob batch are not derived until its blobs are retrieved.
+	L1BlobsDegraded bool `json:"l1_blobs_degraded"`
+	// PendingBlobsL1 is the oldest L1 block that is waiting for its blobs to be retrieved.
+	// This is zeroed if no L1 blocks are waiting for blobs.
+	PendingBlobsL1 L1BlockRef `json:"pending_blobs_l1"`
+	// DerivationPaused is true while derivation is manually paused through the admin API.
+	// Unsafe L2 blocks are still processed while paused, but the safe L2 chain does not progress.
+	DerivationPaused bool `json:"derivation_paused"`
+	// SyncMode is the sync mode of the initial catch-up, consensus-layer or execution-layer.
+	// With the auto sync mode, this is the mode negotiated with the execution engine at startup.
+	// It changes to consensus-layer if EL sync is skipped, because the engine already finalized blocks.
+	SyncMode string `json:"sync_mode,omitempty"`
 }
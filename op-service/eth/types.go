@@ -258,12 +258,39 @@ func (payload *ExecutionPayload) CanyonBlock() bool {
 
 // CheckBlockHash recomputes the block hash and returns if the embedded block hash matches.
 func (envelope *ExecutionPayloadEnvelope) CheckBlockHash() (actual common.Hash, ok bool) {
+	blockHash := envelope.blockHeader().Hash()
+	return blockHash, blockHash == envelope.ExecutionPayload.BlockHash
+}
+
+// Block converts the payload into a block, and checks that the block hash matches the embedded block hash.
+func (envelope *ExecutionPayloadEnvelope) Block() (*types.Block, error) {
+	payload := envelope.ExecutionPayload
+	txs := make([]*types.Transaction, len(payload.Transactions))
+	for i, opaqueTx := range payload.Transactions {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(opaqueTx); err != nil {
+			return nil, fmt.Errorf("failed to decode tx %d: %w", i, err)
+		}
+		txs[i] = &tx
+	}
+	header := envelope.blockHeader()
+	if blockHash := header.Hash(); blockHash != payload.BlockHash {
+		return nil, fmt.Errorf("block hash %s does not match payload block hash %s", blockHash, payload.BlockHash)
+	}
+	body := types.Body{Transactions: txs}
+	if payload.Withdrawals != nil {
+		body.Withdrawals = *payload.Withdrawals
+	}
+	return types.NewBlockWithHeader(header).WithBody(body), nil
+}
+
+func (envelope *ExecutionPayloadEnvelope) blockHeader() *types.Header {
 	payload := envelope.ExecutionPayload
 
 	hasher := trie.NewStackTrie(nil)
 	txHash := types.DeriveSha(rawTransactions(payload.Transactions), hasher)
 
-	header := types.Header{
+	header := &types.Header{
 		ParentHash:       payload.ParentHash,
 		UncleHash:        types.EmptyUncleHash,
 		Coinbase:         payload.FeeRecipient,
@@ -280,6 +307,8 @@ func (envelope *ExecutionPayloadEnvelope) CheckBlockHash() (actual common.Hash,
 		MixDigest:        common.Hash(payload.PrevRandao),
 		Nonce:            types.BlockNonce{}, // zeroed, proof-of-work legacy
 		BaseFee:          (*uint256.Int)(&payload.BaseFeePerGas).ToBig(),
+		BlobGasUsed:      (*uint64)(payload.BlobGasUsed),
+		ExcessBlobGas:    (*uint64)(payload.ExcessBlobGas),
 		ParentBeaconRoot: envelope.ParentBeaconBlockRoot,
 	}
 
@@ -287,9 +316,7 @@ func (envelope *ExecutionPayloadEnvelope) CheckBlockHash() (actual common.Hash,
 		withdrawalHash := types.DeriveSha(*payload.Withdrawals, hasher)
 		header.WithdrawalsHash = &withdrawalHash
 	}
-
-	blockHash := header.Hash()
-	return blockHash, blockHash == payload.BlockHash
+	return header
 }
 
 func BlockAsPayload(bl *types.Block, shanghaiTime *uint64) (*ExecutionPayload, error) {
@@ -0,0 +1,204 @@
+// Package health provides the liveness and readiness endpoints shared by the op-stack services.
+//
+// Liveness (/healthz) only reports that the service is up, and never depends on other systems.
+// Readiness (/readyz) runs the dependency probes registered by the service, e.g. on the L1 and L2 RPCs,
+// and reports the service as unavailable if any of the required probes fails.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+const (
+	// DefaultProbeTimeout is the time a probe may take before it is considered failed.
+	DefaultProbeTimeout = 5 * time.Second
+	// DefaultProbeCacheTTL is the time a probe result is reused for, before the probe runs again.
+	DefaultProbeCacheTTL = 10 * time.Second
+)
+
+var ErrProbeTimeout = errors.New("probe timed out")
+
+// Probe checks a dependency of the service, and returns an error if the dependency is unavailable.
+type Probe func(ctx context.Context) error
+
+type ProbeOption func(p *probe)
+
+// WithTimeout overrides the DefaultProbeTimeout of the probe.
+func WithTimeout(timeout time.Duration) ProbeOption {
+	return func(p *probe) {
+		p.timeout = timeout
+	}
+}
+
+// WithCacheTTL overrides the DefaultProbeCacheTTL of the probe. A zero TTL disables caching.
+func WithCacheTTL(ttl time.Duration) ProbeOption {
+	return func(p *probe) {
+		p.cacheTTL = ttl
+	}
+}
+
+// Optional marks the probe as optional: its failure is reported, but does not make the service unready.
+func Optional() ProbeOption {
+	return func(p *probe) {
+		p.optional = true
+	}
+}
+
+// CheckResult is the result of a single probe.
+type CheckResult struct {
+	Healthy   bool          `json:"healthy"`
+	Optional  bool          `json:"optional,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	CheckedAt time.Time     `json:"checkedAt"`
+	Latency   time.Duration `json:"latency"`
+}
+
+// Report is the readiness of the service, with the result of each of its probes.
+type Report struct {
+	Version string                 `json:"version"`
+	Ready   bool                   `json:"ready"`
+	Checks  map[string]CheckResult `json:"checks"`
+}
+
+type probe struct {
+	name     string
+	fn       Probe
+	timeout  time.Duration
+	cacheTTL time.Duration
+	optional bool
+
+	// mu serializes runs of the probe, so concurrent readiness requests share a single result.
+	mu   sync.Mutex
+	last *CheckResult
+}
+
+func (p *probe) check(ctx context.Context, clk clock.Clock) CheckResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.last != nil && p.cacheTTL > 0 && clk.Since(p.last.CheckedAt) < p.cacheTTL {
+		return *p.last
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	start := clk.Now()
+	err := p.fn(ctx)
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("%w after %s: %w", ErrProbeTimeout, p.timeout, err)
+	}
+	result := CheckResult{
+		Healthy:   err == nil,
+		Optional:  p.optional,
+		CheckedAt: start,
+		Latency:   clk.Since(start),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	p.last = &result
+	return result
+}
+
+// Checker runs the dependency probes of a service.
+type Checker struct {
+	appVersion string
+	clock      clock.Clock
+
+	mu     sync.RWMutex
+	probes []*probe
+}
+
+func NewChecker(appVersion string, clk clock.Clock) *Checker {
+	return &Checker{
+		appVersion: appVersion,
+		clock:      clk,
+	}
+}
+
+// Register adds a dependency probe to the readiness check.
+// The name must be unique, e.g. "l1", "l2", "rollup", "signer" or "db".
+func (c *Checker) Register(name string, fn Probe, opts ...ProbeOption) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, p := range c.probes {
+		if p.name == name {
+			panic(fmt.Errorf("health probe %q is already registered", name))
+		}
+	}
+	p := &probe{
+		name:     name,
+		fn:       fn,
+		timeout:  DefaultProbeTimeout,
+		cacheTTL: DefaultProbeCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	c.probes = append(c.probes, p)
+}
+
+// Check runs all probes concurrently, reusing the results that are still cached, and reports the readiness.
+func (c *Checker) Check(ctx context.Context) *Report {
+	c.mu.RLock()
+	probes := c.probes
+	c.mu.RUnlock()
+
+	results := make([]CheckResult, len(probes))
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = p.check(ctx, c.clock)
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{
+		Version: c.appVersion,
+		Ready:   true,
+		Checks:  make(map[string]CheckResult, len(probes)),
+	}
+	for i, p := range probes {
+		report.Checks[p.name] = results[i]
+		if !results[i].Healthy && !p.optional {
+			report.Ready = false
+		}
+	}
+	return report
+}
+
+// ReadinessHandler serves the readiness report, with status 503 if the service is not ready.
+func (c *Checker) ReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := c.Check(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
+
+type LivenessResponse struct {
+	Version string `json:"version"`
+}
+
+// LivenessHandler serves the version of the service. It does not depend on any of the service dependencies,
+// so a service is not restarted when e.g. its L1 RPC is unavailable.
+func LivenessHandler(appVersion string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&LivenessResponse{Version: appVersion})
+	}
+}
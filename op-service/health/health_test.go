@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+func TestChecker(t *testing.T) {
+	clk := clock.NewDeterministicClock(time.Unix(1000, 0))
+	checker := NewChecker("v1.2.3", clk)
+	var l1Calls, l2Calls int
+	var l2Err error
+	checker.Register("l1", func(ctx context.Context) error {
+		l1Calls++
+		return nil
+	})
+	checker.Register("l2", func(ctx context.Context) error {
+		l2Calls++
+		return l2Err
+	}, WithCacheTTL(time.Minute))
+	checker.Register("signer", func(ctx context.Context) error {
+		return errors.New("signer down")
+	}, Optional())
+
+	report := checker.Check(context.Background())
+	require.True(t, report.Ready, "optional probe must not fail readiness")
+	require.Equal(t, "v1.2.3", report.Version)
+	require.True(t, report.Checks["l1"].Healthy)
+	require.True(t, report.Checks["l2"].Healthy)
+	require.False(t, report.Checks["signer"].Healthy)
+	require.Equal(t, "signer down", report.Checks["signer"].Error)
+	require.True(t, report.Checks["signer"].Optional)
+
+	t.Run("CachesResults", func(t *testing.T) {
+		l2Err = errors.New("l2 down")
+		clk.AdvanceTime(DefaultProbeCacheTTL + time.Second)
+		report := checker.Check(context.Background())
+		require.Equal(t, 2, l1Calls)
+		require.Equal(t, 1, l2Calls)
+		require.True(t, report.Ready)
+
+		clk.AdvanceTime(time.Minute)
+		report = checker.Check(context.Background())
+		require.Equal(t, 2, l2Calls)
+		require.False(t, report.Ready)
+		require.Equal(t, "l2 down", report.Checks["l2"].Error)
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		require.Panics(t, func() {
+			checker.Register("l1", func(ctx context.Context) error { return nil })
+		})
+	})
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	checker := NewChecker("v1.2.3", clock.SystemClock)
+	checker.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithTimeout(10*time.Millisecond))
+	report := checker.Check(context.Background())
+	require.False(t, report.Ready)
+	require.Contains(t, report.Checks["slow"].Error, ErrProbeTimeout.Error())
+}
+
+func TestReadinessHandler(t *testing.T) {
+	checker := NewChecker("v1.2.3", clock.SystemClock)
+	var err error
+	checker.Register("l1", func(ctx context.Context) error { return err }, WithCacheTTL(0))
+
+	get := func() (int, *Report) {
+		rec := httptest.NewRecorder()
+		checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var report Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		return rec.Code, &report
+	}
+
+	code, report := get()
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, report.Ready)
+
+	err = errors.New("connection refused")
+	code, report = get()
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, report.Ready)
+	require.Equal(t, "connection refused", report.Checks["l1"].Error)
+}
@@ -14,6 +14,7 @@ type AtomicWriter struct {
 
 // NewAtomicWriterCompressed creates a io.WriteCloser that performs an atomic write.
 // The contents are initially written to a temporary file and only renamed into place when the writer is closed.
+// The temporary file and its directory are fsynced, so the contents are durable once Close returns.
 // NOTE: It's vital to check if an error is returned from Close() as it may indicate the file could not be renamed
 // If path ends in .gz the contents written will be gzipped.
 func NewAtomicWriterCompressed(path string, perm os.FileMode) (*AtomicWriter, error) {
@@ -22,6 +23,7 @@ func NewAtomicWriterCompressed(path string, perm os.FileMode) (*AtomicWriter, er
 
 // NewAtomicWriter creates a io.WriteCloser that performs an atomic write.
 // The contents are initially written to a temporary file and only renamed into place when the writer is closed.
+// The temporary file and its directory are fsynced, so the contents are durable once Close returns.
 // NOTE: It's vital to check if an error is returned from Close() as it may indicate the file could not be renamed
 func NewAtomicWriter(path string, perm os.FileMode) (*AtomicWriter, error) {
 	return newAtomicWriter(path, perm, false)
@@ -36,7 +38,7 @@ func newAtomicWriter(path string, perm os.FileMode, compressByFileType bool) (*A
 		_ = f.Close()
 		return nil, err
 	}
-	out := io.WriteCloser(f)
+	out := io.WriteCloser(&syncOnClose{f})
 	if compressByFileType {
 		out = CompressByFileType(path, f)
 	}
@@ -65,5 +67,31 @@ func (a *AtomicWriter) Close() error {
 	if err := a.out.Close(); err != nil {
 		return err
 	}
-	return os.Rename(a.temp, a.dest)
+	if err := os.Rename(a.temp, a.dest); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(a.dest))
+}
+
+// syncOnClose flushes the file to disk before closing it.
+type syncOnClose struct {
+	*os.File
+}
+
+func (s *syncOnClose) Close() error {
+	if err := s.File.Sync(); err != nil {
+		_ = s.File.Close()
+		return err
+	}
+	return s.File.Close()
+}
+
+// syncDir fsyncs the directory, so that renames and removals of its entries are durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
 }
@@ -0,0 +1,435 @@
+package ioutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+var (
+	ErrCorruptSegment    = errors.New("corrupt segment")
+	ErrMissingChecksum   = errors.New("missing segment checksum")
+	ErrRotatingWriterCfg = errors.New("invalid rotating writer config")
+)
+
+const (
+	checksumSuffix = ".sha256"
+	pendingSuffix  = ".pending"
+)
+
+type RotatingWriterConfig struct {
+	// Dir is the directory the active file and the rotated segments are stored in.
+	Dir string
+	// Name is the file name of the active file. Rotated segments are named <Name>.<sequence number>.
+	Name string
+	// MaxSize is the size in bytes after which the active file is rotated. Zero disables size based rotation.
+	MaxSize int64
+	// MaxAge is the time after which the active file is rotated. Zero disables time based rotation.
+	// The age is checked when writing, so an idle writer does not rotate.
+	MaxAge time.Duration
+	// MaxSegments is the number of rotated segments to keep, the oldest segments are removed first.
+	// Zero keeps all segments.
+	MaxSegments int
+	// Compress gzips the rotated segments.
+	Compress bool
+	// SyncWrites fsyncs the active file after every write.
+	// Otherwise, written data is only guaranteed to be on disk after Sync, Rotate or Close.
+	SyncWrites bool
+	// Perm is the file mode of the active file and segments. Defaults to 0o644.
+	Perm os.FileMode
+	// Clock is used to determine the age of the active file. Defaults to the system clock.
+	Clock clock.Clock
+}
+
+func (c *RotatingWriterConfig) Check() error {
+	if c.Dir == "" {
+		return fmt.Errorf("%w: missing dir", ErrRotatingWriterCfg)
+	}
+	if c.Name == "" || c.Name != filepath.Base(c.Name) {
+		return fmt.Errorf("%w: invalid file name %q", ErrRotatingWriterCfg, c.Name)
+	}
+	if c.MaxSize < 0 {
+		return fmt.Errorf("%w: negative max size %d", ErrRotatingWriterCfg, c.MaxSize)
+	}
+	if c.MaxAge < 0 {
+		return fmt.Errorf("%w: negative max age %v", ErrRotatingWriterCfg, c.MaxAge)
+	}
+	if c.MaxSegments < 0 {
+		return fmt.Errorf("%w: negative max segments %d", ErrRotatingWriterCfg, c.MaxSegments)
+	}
+	return nil
+}
+
+// RotatingWriter is an io.WriteCloser that appends to an active file, and rotates the active file into a numbered
+// segment once it exceeds the configured size or age.
+// Segments are written atomically, optionally gzipped, and with a sha256 checksum of their contents, which is
+// verified when the segment is read with OpenSegment.
+// A rotation interrupted by a crash is completed when the writer is next created.
+type RotatingWriter struct {
+	cfg   RotatingWriterConfig
+	clock clock.Clock
+
+	mu      sync.Mutex
+	active  *os.File
+	size    int64
+	opened  time.Time
+	nextSeq uint64
+	closed  bool
+}
+
+// NewRotatingWriter creates the directory of the writer if needed, and opens the active file for appending.
+func NewRotatingWriter(cfg RotatingWriterConfig) (*RotatingWriter, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	if cfg.Perm == 0 {
+		cfg.Perm = 0o644
+	}
+	w := &RotatingWriter{cfg: cfg, clock: cfg.Clock}
+	if w.clock == nil {
+		w.clock = clock.SystemClock
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dir %q: %w", cfg.Dir, err)
+	}
+	if err := w.recover(); err != nil {
+		return nil, fmt.Errorf("failed to recover interrupted rotation: %w", err)
+	}
+	if err := w.openActive(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *RotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+	if w.size > 0 && w.shouldRotate(int64(len(p))) {
+		if err := w.rotate(); err != nil {
+			return 0, fmt.Errorf("failed to rotate: %w", err)
+		}
+	}
+	n, err := w.active.Write(p)
+	w.size += int64(n)
+	if err != nil {
+		return n, err
+	}
+	if w.cfg.SyncWrites {
+		if err := w.active.Sync(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (w *RotatingWriter) shouldRotate(size int64) bool {
+	if w.cfg.MaxSize > 0 && w.size+size > w.cfg.MaxSize {
+		return true
+	}
+	return w.cfg.MaxAge > 0 && w.clock.Since(w.opened) >= w.cfg.MaxAge
+}
+
+// Sync fsyncs the active file.
+func (w *RotatingWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return os.ErrClosed
+	}
+	return w.active.Sync()
+}
+
+// Rotate moves the contents of the active file into a new segment. Does nothing if the active file is empty.
+func (w *RotatingWriter) Rotate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return os.ErrClosed
+	}
+	if w.size == 0 {
+		return nil
+	}
+	return w.rotate()
+}
+
+// Close fsyncs and closes the active file. The active file is not rotated, later writers append to it.
+func (w *RotatingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return os.ErrClosed
+	}
+	w.closed = true
+	return (&syncOnClose{w.active}).Close()
+}
+
+// Segments returns the paths of the rotated segments, from oldest to newest.
+func (w *RotatingWriter) Segments() ([]string, error) {
+	seqs, err := w.segmentSeqs()
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(seqs))
+	for i, seq := range seqs {
+		paths[i] = w.segmentPath(seq)
+	}
+	return paths, nil
+}
+
+func (w *RotatingWriter) activePath() string {
+	return filepath.Join(w.cfg.Dir, w.cfg.Name)
+}
+
+func (w *RotatingWriter) segmentPath(seq uint64) string {
+	path := filepath.Join(w.cfg.Dir, fmt.Sprintf("%s.%08d", w.cfg.Name, seq))
+	if w.cfg.Compress {
+		path += ".gz"
+	}
+	return path
+}
+
+func (w *RotatingWriter) pendingPath(seq uint64) string {
+	return filepath.Join(w.cfg.Dir, fmt.Sprintf("%s.%08d%s", w.cfg.Name, seq, pendingSuffix))
+}
+
+func (w *RotatingWriter) openActive() error {
+	f, err := os.OpenFile(w.activePath(), os.O_WRONLY|os.O_APPEND|os.O_CREATE, w.cfg.Perm)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", w.activePath(), err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat %q: %w", w.activePath(), err)
+	}
+	w.active = f
+	w.size = info.Size()
+	w.opened = w.clock.Now()
+	return nil
+}
+
+// rotate renames the active file to a pending file, so that its contents are not written twice if the rotation is
+// interrupted, then finalizes the pending file into a segment and opens a new active file.
+func (w *RotatingWriter) rotate() error {
+	if err := (&syncOnClose{w.active}).Close(); err != nil {
+		return fmt.Errorf("failed to close %q: %w", w.activePath(), err)
+	}
+	seq := w.nextSeq
+	pending := w.pendingPath(seq)
+	if err := os.Rename(w.activePath(), pending); err != nil {
+		// Keep appending to the active file, so the rotation can be retried.
+		return errors.Join(fmt.Errorf("failed to move %q to %q: %w", w.activePath(), pending, err), w.openActive())
+	}
+	// The sequence number is taken even if the segment is not finalized, its pending file is recovered later.
+	w.nextSeq = seq + 1
+	if err := syncDir(w.cfg.Dir); err != nil {
+		return err
+	}
+	if err := w.openActive(); err != nil {
+		return err
+	}
+	if err := w.finalize(seq, pending); err != nil {
+		return err
+	}
+	return w.prune()
+}
+
+// finalize writes the contents of the pending file to the segment with its checksum, and then removes it.
+func (w *RotatingWriter) finalize(seq uint64, pending string) error {
+	in, err := os.Open(pending)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	segment := w.segmentPath(seq)
+	out, err := NewAtomicWriterCompressed(segment, w.cfg.Perm)
+	if err != nil {
+		return fmt.Errorf("failed to create segment %q: %w", segment, err)
+	}
+	defer func() {
+		_ = out.Abort()
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), in); err != nil {
+		return fmt.Errorf("failed to write segment %q: %w", segment, err)
+	}
+	// The checksum is written first, so any segment that exists has a checksum.
+	if err := WriteAtomic(segment+checksumSuffix, []byte(hex.EncodeToString(h.Sum(nil))), w.cfg.Perm); err != nil {
+		return fmt.Errorf("failed to write checksum of segment %q: %w", segment, err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to close segment %q: %w", segment, err)
+	}
+	if err := os.Remove(pending); err != nil {
+		return fmt.Errorf("failed to remove %q: %w", pending, err)
+	}
+	return syncDir(w.cfg.Dir)
+}
+
+// prune removes the oldest segments beyond the configured number of segments to keep.
+func (w *RotatingWriter) prune() error {
+	if w.cfg.MaxSegments == 0 {
+		return nil
+	}
+	seqs, err := w.segmentSeqs()
+	if err != nil {
+		return err
+	}
+	for len(seqs) > w.cfg.MaxSegments {
+		segment := w.segmentPath(seqs[0])
+		if err := os.Remove(segment); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove segment %q: %w", segment, err)
+		}
+		if err := os.Remove(segment + checksumSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove checksum of segment %q: %w", segment, err)
+		}
+		seqs = seqs[1:]
+	}
+	return nil
+}
+
+// recover completes the rotations interrupted by a crash, and determines the sequence number of the next segment.
+func (w *RotatingWriter) recover() error {
+	entries, err := os.ReadDir(w.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	var pending []uint64
+	for _, entry := range entries {
+		if seq, ok := w.parseSeq(entry.Name(), pendingSuffix); ok {
+			pending = append(pending, seq)
+		}
+	}
+	seqs, err := w.segmentSeqs()
+	if err != nil {
+		return err
+	}
+	if len(seqs) > 0 {
+		w.nextSeq = seqs[len(seqs)-1] + 1
+	}
+	for _, seq := range pending {
+		w.nextSeq = max(w.nextSeq, seq+1)
+		path := w.pendingPath(seq)
+		if slices.Contains(seqs, seq) {
+			// The segment was completed before the crash, only the pending file was left behind.
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.finalize(seq, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// segmentSeqs returns the sorted sequence numbers of the segments in the directory.
+func (w *RotatingWriter) segmentSeqs() ([]uint64, error) {
+	entries, err := os.ReadDir(w.cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	suffix := ""
+	if w.cfg.Compress {
+		suffix = ".gz"
+	}
+	var seqs []uint64
+	for _, entry := range entries {
+		if seq, ok := w.parseSeq(entry.Name(), suffix); ok {
+			seqs = append(seqs, seq)
+		}
+	}
+	slices.Sort(seqs)
+	return seqs, nil
+}
+
+func (w *RotatingWriter) parseSeq(name string, suffix string) (uint64, bool) {
+	rest, ok := strings.CutPrefix(name, w.cfg.Name+".")
+	if !ok {
+		return 0, false
+	}
+	rest, ok = strings.CutSuffix(rest, suffix)
+	if !ok || len(rest) < 8 {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(rest, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return seq, true
+}
+
+// WriteAtomic writes data to the file atomically, so it either has the full contents or is unchanged after a crash.
+func WriteAtomic(path string, data []byte, perm os.FileMode) error {
+	out, err := NewAtomicWriter(path, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := out.Write(data); err != nil {
+		_ = out.Abort()
+		return err
+	}
+	return out.Close()
+}
+
+// OpenSegment opens a reader of the decompressed contents of a segment written by a RotatingWriter.
+// The contents are checked against the checksum of the segment as they are read, so the reader returns an
+// ErrCorruptSegment error instead of io.EOF if the contents do not match.
+func OpenSegment(path string) (io.ReadCloser, error) {
+	checksum, err := os.ReadFile(path + checksumSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %v", ErrMissingChecksum, path)
+	} else if err != nil {
+		return nil, err
+	}
+	expected, err := hex.DecodeString(strings.TrimSpace(string(checksum)))
+	if err != nil || len(expected) != sha256.Size {
+		return nil, fmt.Errorf("%w: invalid checksum of %v", ErrCorruptSegment, path)
+	}
+	in, err := OpenDecompressed(path)
+	if err != nil {
+		if IsGzip(path) {
+			return nil, fmt.Errorf("%w: %w", ErrCorruptSegment, err)
+		}
+		return nil, err
+	}
+	return &verifyingReader{ReadCloser: in, path: path, h: sha256.New(), expected: expected}, nil
+}
+
+type verifyingReader struct {
+	io.ReadCloser
+	path     string
+	h        hash.Hash
+	expected []byte
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.h.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if !bytes.Equal(r.h.Sum(nil), r.expected) {
+			return n, fmt.Errorf("%w: checksum mismatch of %v", ErrCorruptSegment, r.path)
+		}
+	} else if err != nil && IsGzip(r.path) {
+		// Corrupted gzip data is detected by the decompression before the checksum is checked.
+		return n, fmt.Errorf("%w: %w", ErrCorruptSegment, err)
+	}
+	return n, err
+}
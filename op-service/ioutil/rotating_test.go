@@ -0,0 +1,209 @@
+package ioutil
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func readSegment(t *testing.T, path string) []byte {
+	in, err := OpenSegment(path)
+	require.NoError(t, err)
+	defer in.Close()
+	data, err := io.ReadAll(in)
+	require.NoError(t, err)
+	return data
+}
+
+func TestRotatingWriter_RotateBySize(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		compress := compress
+		t.Run(map[bool]string{false: "Uncompressed", true: "Compressed"}[compress], func(t *testing.T) {
+			dir := t.TempDir()
+			w, err := NewRotatingWriter(RotatingWriterConfig{Dir: dir, Name: "audit.log", MaxSize: 10, Compress: compress})
+			require.NoError(t, err)
+
+			for _, line := range []string{"first\n", "second\n", "third\n"} {
+				_, err := w.Write([]byte(line))
+				require.NoError(t, err)
+			}
+			segments, err := w.Segments()
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+			require.Equal(t, "first\n", string(readSegment(t, segments[0])))
+			require.Equal(t, "second\n", string(readSegment(t, segments[1])))
+			require.Equal(t, compress, IsGzip(segments[0]))
+
+			require.NoError(t, w.Close())
+			active, err := os.ReadFile(filepath.Join(dir, "audit.log"))
+			require.NoError(t, err)
+			require.Equal(t, "third\n", string(active))
+		})
+	}
+}
+
+func TestRotatingWriter_RotateByAge(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewDeterministicClock(time.Unix(1000, 0))
+	w, err := NewRotatingWriter(RotatingWriterConfig{Dir: dir, Name: "trace", MaxAge: time.Minute, Clock: clk})
+	require.NoError(t, err)
+	defer w.Close()
+
+	_, err = w.Write([]byte("a"))
+	require.NoError(t, err)
+	clk.AdvanceTime(30 * time.Second)
+	_, err = w.Write([]byte("b"))
+	require.NoError(t, err)
+	segments, err := w.Segments()
+	require.NoError(t, err)
+	require.Empty(t, segments)
+
+	clk.AdvanceTime(30 * time.Second)
+	_, err = w.Write([]byte("c"))
+	require.NoError(t, err)
+	segments, err = w.Segments()
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	require.Equal(t, "ab", string(readSegment(t, segments[0])))
+}
+
+func TestRotatingWriter_PruneSegments(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewRotatingWriter(RotatingWriterConfig{Dir: dir, Name: "trace", MaxSegments: 2})
+	require.NoError(t, err)
+	defer w.Close()
+
+	for _, data := range []string{"1", "2", "3", "4"} {
+		_, err := w.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Rotate())
+	}
+	segments, err := w.Segments()
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "trace.00000002"), filepath.Join(dir, "trace.00000003")}, segments)
+	_, err = os.Stat(filepath.Join(dir, "trace.00000000"+checksumSuffix))
+	require.ErrorIs(t, err, os.ErrNotExist, "should remove checksum of pruned segment")
+}
+
+func TestRotatingWriter_ResumeAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	cfg := RotatingWriterConfig{Dir: dir, Name: "trace"}
+	w, err := NewRotatingWriter(cfg)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("segment"))
+	require.NoError(t, err)
+	require.NoError(t, w.Rotate())
+	_, err = w.Write([]byte("active"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.ErrorIs(t, w.Close(), os.ErrClosed)
+
+	w, err = NewRotatingWriter(cfg)
+	require.NoError(t, err)
+	defer w.Close()
+	_, err = w.Write([]byte("-appended"))
+	require.NoError(t, err)
+	require.NoError(t, w.Rotate())
+	segments, err := w.Segments()
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+	require.Equal(t, "segment", string(readSegment(t, segments[0])))
+	require.Equal(t, "active-appended", string(readSegment(t, segments[1])))
+}
+
+func TestRotatingWriter_RecoverInterruptedRotation(t *testing.T) {
+	dir := t.TempDir()
+	cfg := RotatingWriterConfig{Dir: dir, Name: "trace", Compress: true}
+	w, err := NewRotatingWriter(cfg)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("finished"))
+	require.NoError(t, err)
+	require.NoError(t, w.Rotate())
+	require.NoError(t, w.Close())
+
+	// Crashed after moving the active file, before the segment was written
+	require.NoError(t, os.WriteFile(w.pendingPath(1), []byte("interrupted"), 0o644))
+	// Crashed after writing the segment, before the pending file was removed
+	require.NoError(t, os.WriteFile(w.pendingPath(0), []byte("finished"), 0o644))
+
+	w, err = NewRotatingWriter(cfg)
+	require.NoError(t, err)
+	defer w.Close()
+	segments, err := w.Segments()
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+	require.Equal(t, "finished", string(readSegment(t, segments[0])))
+	require.Equal(t, "interrupted", string(readSegment(t, segments[1])))
+	_, err = os.Stat(w.pendingPath(0))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Stat(w.pendingPath(1))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = w.Write([]byte("next"))
+	require.NoError(t, err)
+	require.NoError(t, w.Rotate())
+	segments, err = w.Segments()
+	require.NoError(t, err)
+	require.Equal(t, w.segmentPath(2), segments[2])
+}
+
+func TestOpenSegment_DetectCorruption(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		compress := compress
+		t.Run(map[bool]string{false: "Uncompressed", true: "Compressed"}[compress], func(t *testing.T) {
+			dir := t.TempDir()
+			w, err := NewRotatingWriter(RotatingWriterConfig{Dir: dir, Name: "trace", Compress: compress})
+			require.NoError(t, err)
+			defer w.Close()
+			_, err = w.Write([]byte("some trace data that is long enough to be corrupted"))
+			require.NoError(t, err)
+			require.NoError(t, w.Rotate())
+			segment := w.segmentPath(0)
+
+			data, err := os.ReadFile(segment)
+			require.NoError(t, err)
+			data[len(data)-5] ^= 0xff
+			require.NoError(t, os.WriteFile(segment, data, 0o644))
+
+			in, err := OpenSegment(segment)
+			if err == nil {
+				defer in.Close()
+				_, err = io.ReadAll(in)
+			}
+			require.ErrorIs(t, err, ErrCorruptSegment)
+		})
+	}
+
+	t.Run("MissingChecksum", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "trace.00000000")
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+		_, err := OpenSegment(path)
+		require.ErrorIs(t, err, ErrMissingChecksum)
+	})
+}
+
+func TestRotatingWriterConfig_Check(t *testing.T) {
+	valid := RotatingWriterConfig{Dir: "dir", Name: "trace"}
+	require.NoError(t, valid.Check())
+
+	for name, modify := range map[string]func(cfg *RotatingWriterConfig){
+		"MissingDir":          func(cfg *RotatingWriterConfig) { cfg.Dir = "" },
+		"MissingName":         func(cfg *RotatingWriterConfig) { cfg.Name = "" },
+		"NameWithDir":         func(cfg *RotatingWriterConfig) { cfg.Name = "sub/trace" },
+		"NegativeMaxSize":     func(cfg *RotatingWriterConfig) { cfg.MaxSize = -1 },
+		"NegativeMaxAge":      func(cfg *RotatingWriterConfig) { cfg.MaxAge = -time.Second },
+		"NegativeMaxSegments": func(cfg *RotatingWriterConfig) { cfg.MaxSegments = -1 },
+	} {
+		modify := modify
+		t.Run(name, func(t *testing.T) {
+			cfg := valid
+			modify(&cfg)
+			require.ErrorIs(t, cfg.Check(), ErrRotatingWriterCfg)
+		})
+	}
+}
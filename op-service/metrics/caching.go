@@ -7,9 +7,11 @@ import (
 // CacheMetrics implements the Metrics interface in the caching package,
 // implementing reusable metrics for different caches.
 type CacheMetrics struct {
-	SizeVec *prometheus.GaugeVec
-	GetVec  *prometheus.CounterVec
-	AddVec  *prometheus.CounterVec
+	SizeVec          *prometheus.GaugeVec
+	CapacityVec      *prometheus.GaugeVec
+	GetVec           *prometheus.CounterVec
+	AddVec           *prometheus.CounterVec
+	ResizeEvictedVec *prometheus.CounterVec
 }
 
 // CacheAdd meters the addition of an item with a given type to the cache,
@@ -33,6 +35,14 @@ func (m *CacheMetrics) CacheGet(typeLabel string, hit bool) {
 	}
 }
 
+// CacheResize meters the capacity of the cache of a given type,
+// and the items evicted from it when it is resized.
+func (m *CacheMetrics) CacheResize(typeLabel string, typeCacheSize int, capacity int, evicted int) {
+	m.SizeVec.WithLabelValues(typeLabel).Set(float64(typeCacheSize))
+	m.CapacityVec.WithLabelValues(typeLabel).Set(float64(capacity))
+	m.ResizeEvictedVec.WithLabelValues(typeLabel).Add(float64(evicted))
+}
+
 func NewCacheMetrics(factory Factory, ns string, name string, displayName string) *CacheMetrics {
 	return &CacheMetrics{
 		SizeVec: factory.NewGaugeVec(prometheus.GaugeOpts{
@@ -42,6 +52,13 @@ func NewCacheMetrics(factory Factory, ns string, name string, displayName string
 		}, []string{
 			"type",
 		}),
+		CapacityVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      name + "_capacity",
+			Help:      displayName + " cache capacity",
+		}, []string{
+			"type",
+		}),
 		GetVec: factory.NewCounterVec(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      name + "_get",
@@ -58,5 +75,12 @@ func NewCacheMetrics(factory Factory, ns string, name string, displayName string
 			"type",
 			"evicted",
 		}),
+		ResizeEvictedVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      name + "_resize_evicted",
+			Help:      displayName + " values evicted by resizing the cache",
+		}, []string{
+			"type",
+		}),
 	}
 }
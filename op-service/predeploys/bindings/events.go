@@ -0,0 +1,100 @@
+package bindings
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+// The filterers are only used to parse logs, so they are not bound to a backend.
+var (
+	crossL2InboxFilterer          = mustFilterer(NewCrossL2InboxFilterer(predeploys.CrossL2InboxAddr, nil))
+	l2ToL2MessengerFilterer       = mustFilterer(NewL2ToL2CrossDomainMessengerFilterer(predeploys.L2toL2CrossDomainMessengerAddr, nil))
+	superchainTokenBridgeFilterer = mustFilterer(NewSuperchainTokenBridgeFilterer(predeploys.SuperchainTokenBridgeAddr, nil))
+)
+
+func mustFilterer[T any](filterer *T, err error) *T {
+	if err != nil {
+		panic(fmt.Errorf("invalid generated binding: %w", err))
+	}
+	return filterer
+}
+
+// ParseExecutingMessage parses the ExecutingMessage event, emitted by the CrossL2Inbox for every executing message.
+// It returns nil if the log is not an ExecutingMessage event of the CrossL2Inbox predeploy.
+func ParseExecutingMessage(l *types.Log) (*CrossL2InboxExecutingMessage, error) {
+	if !isEvent(l, predeploys.CrossL2InboxAddr, CrossL2InboxMetaData, "ExecutingMessage") {
+		return nil, nil
+	}
+	return crossL2InboxFilterer.ParseExecutingMessage(*l)
+}
+
+// ParseSentMessage parses the SentMessage event, the initiating message of a cross-chain message
+// sent through the L2ToL2CrossDomainMessenger.
+// It returns nil if the log is not a SentMessage event of the L2ToL2CrossDomainMessenger predeploy.
+func ParseSentMessage(l *types.Log) (*L2ToL2CrossDomainMessengerSentMessage, error) {
+	if !isEvent(l, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "SentMessage") {
+		return nil, nil
+	}
+	return l2ToL2MessengerFilterer.ParseSentMessage(*l)
+}
+
+// ParseRelayedMessage parses the RelayedMessage event, emitted by the L2ToL2CrossDomainMessenger
+// when a cross-chain message is relayed to its target.
+// It returns nil if the log is not a RelayedMessage event of the L2ToL2CrossDomainMessenger predeploy.
+func ParseRelayedMessage(l *types.Log) (*L2ToL2CrossDomainMessengerRelayedMessage, error) {
+	if !isEvent(l, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "RelayedMessage") {
+		return nil, nil
+	}
+	return l2ToL2MessengerFilterer.ParseRelayedMessage(*l)
+}
+
+// ParseSendERC20 parses the SendERC20 event, emitted by the SuperchainTokenBridge when tokens are sent to another chain.
+// It returns nil if the log is not a SendERC20 event of the SuperchainTokenBridge predeploy.
+func ParseSendERC20(l *types.Log) (*SuperchainTokenBridgeSendERC20, error) {
+	if !isEvent(l, predeploys.SuperchainTokenBridgeAddr, SuperchainTokenBridgeMetaData, "SendERC20") {
+		return nil, nil
+	}
+	return superchainTokenBridgeFilterer.ParseSendERC20(*l)
+}
+
+// ParseRelayERC20 parses the RelayERC20 event, emitted by the SuperchainTokenBridge when tokens sent
+// from another chain are relayed.
+// It returns nil if the log is not a RelayERC20 event of the SuperchainTokenBridge predeploy.
+func ParseRelayERC20(l *types.Log) (*SuperchainTokenBridgeRelayERC20, error) {
+	if !isEvent(l, predeploys.SuperchainTokenBridgeAddr, SuperchainTokenBridgeMetaData, "RelayERC20") {
+		return nil, nil
+	}
+	return superchainTokenBridgeFilterer.ParseRelayERC20(*l)
+}
+
+// FilterEvents parses the logs with the given parser, e.g. ParseSentMessage, and returns the events in log order.
+// Logs that are not events of the parser are skipped.
+func FilterEvents[E any](logs []*types.Log, parse func(*types.Log) (*E, error)) ([]*E, error) {
+	var events []*E
+	for _, l := range logs {
+		event, err := parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event in log %d: %w", l.Index, err)
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func isEvent(l *types.Log, addr common.Address, metaData *bind.MetaData, name string) bool {
+	if l.Address != addr || len(l.Topics) == 0 {
+		return false
+	}
+	contractABI, err := metaData.GetAbi()
+	if err != nil {
+		panic(fmt.Errorf("invalid generated binding: %w", err))
+	}
+	return l.Topics[0] == contractABI.Events[name].ID
+}
@@ -0,0 +1,136 @@
+package bindings
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/types/interoptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+// eventLog encodes the event of the contract as a log, as emitted by the contract.
+func eventLog(t *testing.T, addr common.Address, metaData *bind.MetaData, name string, args ...any) *types.Log {
+	contractABI, err := metaData.GetAbi()
+	require.NoError(t, err)
+	event := contractABI.Events[name]
+	topics := []common.Hash{event.ID}
+	var nonIndexed []any
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		switch arg := args[i].(type) {
+		case common.Address:
+			topics = append(topics, common.BytesToHash(arg.Bytes()))
+		case *big.Int:
+			topics = append(topics, common.BigToHash(arg))
+		case common.Hash:
+			topics = append(topics, arg)
+		default:
+			t.Fatalf("unsupported indexed argument %T", arg)
+		}
+	}
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	require.NoError(t, err)
+	return &types.Log{Address: addr, Topics: topics, Data: data, BlockNumber: 10, Index: 3}
+}
+
+func TestParseExecutingMessage(t *testing.T) {
+	id := Identifier{
+		Origin:      common.Address{0xaa},
+		BlockNumber: big.NewInt(100),
+		LogIndex:    big.NewInt(2),
+		Timestamp:   big.NewInt(1000),
+		ChainId:     big.NewInt(900),
+	}
+	msgHash := common.Hash{0xbb}
+	l := eventLog(t, predeploys.CrossL2InboxAddr, CrossL2InboxMetaData, "ExecutingMessage", msgHash, id)
+
+	event, err := ParseExecutingMessage(l)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(msgHash), event.MsgHash)
+	require.Equal(t, id, event.Id)
+
+	// The event matches the encoding the execution engine decodes executing messages from.
+	require.Equal(t, interoptypes.ExecutingMessageEventTopic, l.Topics[0])
+	var msg interoptypes.Message
+	require.NoError(t, msg.DecodeEvent(l.Topics, l.Data))
+	require.Equal(t, msgHash, msg.PayloadHash)
+	require.Equal(t, id.Origin, msg.Identifier.Origin)
+	require.Equal(t, id.BlockNumber.Uint64(), msg.Identifier.BlockNumber)
+
+	t.Run("IgnoreOtherAddress", func(t *testing.T) {
+		other := *l
+		other.Address = common.Address{0xcc}
+		event, err := ParseExecutingMessage(&other)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+	t.Run("IgnoreOtherEvent", func(t *testing.T) {
+		event, err := ParseSentMessage(l)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+	t.Run("RejectInvalidData", func(t *testing.T) {
+		invalid := *l
+		invalid.Data = invalid.Data[:10]
+		_, err := ParseExecutingMessage(&invalid)
+		require.Error(t, err)
+	})
+}
+
+func TestParseMessengerEvents(t *testing.T) {
+	sent := eventLog(t, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "SentMessage",
+		big.NewInt(901), common.Address{0xaa}, big.NewInt(5), common.Address{0xbb}, []byte("hello"))
+	relayed := eventLog(t, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "RelayedMessage",
+		big.NewInt(900), big.NewInt(5), common.Hash{0xcc})
+
+	sentEvent, err := ParseSentMessage(sent)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(901), sentEvent.Destination)
+	require.Equal(t, common.Address{0xaa}, sentEvent.Target)
+	require.Equal(t, big.NewInt(5), sentEvent.MessageNonce)
+	require.Equal(t, common.Address{0xbb}, sentEvent.Sender)
+	require.Equal(t, []byte("hello"), sentEvent.Message)
+
+	relayedEvent, err := ParseRelayedMessage(relayed)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(900), relayedEvent.Source)
+	require.Equal(t, big.NewInt(5), relayedEvent.MessageNonce)
+	require.Equal(t, [32]byte{0xcc}, relayedEvent.MessageHash)
+
+	events, err := FilterEvents([]*types.Log{relayed, sent, {Address: common.Address{0xdd}}, sent}, ParseSentMessage)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, sentEvent, events[0])
+}
+
+func TestParseBridgeEvents(t *testing.T) {
+	token, from, to := common.Address{0xaa}, common.Address{0xbb}, common.Address{0xcc}
+	send := eventLog(t, predeploys.SuperchainTokenBridgeAddr, SuperchainTokenBridgeMetaData, "SendERC20",
+		token, from, to, big.NewInt(1000), big.NewInt(901))
+	relay := eventLog(t, predeploys.SuperchainTokenBridgeAddr, SuperchainTokenBridgeMetaData, "RelayERC20",
+		token, from, to, big.NewInt(1000), big.NewInt(900))
+
+	sendEvent, err := ParseSendERC20(send)
+	require.NoError(t, err)
+	require.Equal(t, token, sendEvent.Token)
+	require.Equal(t, from, sendEvent.From)
+	require.Equal(t, to, sendEvent.To)
+	require.Equal(t, big.NewInt(1000), sendEvent.Amount)
+	require.Equal(t, big.NewInt(901), sendEvent.Destination)
+
+	relayEvent, err := ParseRelayERC20(relay)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(900), relayEvent.Source)
+
+	event, err := ParseRelayERC20(send)
+	require.NoError(t, err)
+	require.Nil(t, event)
+}
@@ -0,0 +1,5 @@
+// Package bindings contains the Go bindings of the interop predeploys, and helpers to parse their events
+// and to build the transactions that execute cross-chain messages, without hand-rolled ABI handling.
+package bindings
+
+//go:generate ./generate.sh
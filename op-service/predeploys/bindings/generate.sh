@@ -0,0 +1,19 @@
+#!/bin/sh
+
+# Generates the Go bindings of the interop predeploys from the ABI snapshots of the contracts.
+# The contracts are bound in a single run, so the structs they share, like the message Identifier, are only declared once.
+
+set -eu
+
+ABI_DIR=../../../packages/contracts-bedrock/snapshots/abi
+
+jq -n \
+  --slurpfile inbox "$ABI_DIR/CrossL2Inbox.json" \
+  --slurpfile messenger "$ABI_DIR/L2ToL2CrossDomainMessenger.json" \
+  --slurpfile bridge "$ABI_DIR/SuperchainTokenBridge.json" \
+  '{contracts: {
+    "CrossL2Inbox": {abi: $inbox[0], bin: ""},
+    "L2ToL2CrossDomainMessenger": {abi: $messenger[0], bin: ""},
+    "SuperchainTokenBridge": {abi: $bridge[0], bin: ""}
+  }}' |
+  go run github.com/ethereum/go-ethereum/cmd/abigen --combined-json - --pkg bindings --out interop_gen.go
@@ -0,0 +1,1896 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Identifier is an auto generated low-level Go binding around an user-defined struct.
+type Identifier struct {
+	Origin      common.Address
+	BlockNumber *big.Int
+	LogIndex    *big.Int
+	Timestamp   *big.Int
+	ChainId     *big.Int
+}
+
+// CrossL2InboxMetaData contains all meta data concerning the CrossL2Inbox contract.
+var CrossL2InboxMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"blockNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"chainId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"interopStart\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"interopStart_\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"logIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"origin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"setInteropStart\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"timestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"origin\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"logIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"}],\"internalType\":\"structIdentifier\",\"name\":\"_id\",\"type\":\"tuple\"},{\"internalType\":\"bytes32\",\"name\":\"_msgHash\",\"type\":\"bytes32\"}],\"name\":\"validateMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"msgHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"origin\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"logIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIdentifier\",\"name\":\"id\",\"type\":\"tuple\"}],\"name\":\"ExecutingMessage\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"InteropStartAlreadySet\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NoExecutingDeposits\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotDepositor\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotEntered\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrantCall\",\"type\":\"error\"}]",
+}
+
+// CrossL2InboxABI is the input ABI used to generate the binding from.
+// Deprecated: Use CrossL2InboxMetaData.ABI instead.
+var CrossL2InboxABI = CrossL2InboxMetaData.ABI
+
+// CrossL2Inbox is an auto generated Go binding around an Ethereum contract.
+type CrossL2Inbox struct {
+	CrossL2InboxCaller     // Read-only binding to the contract
+	CrossL2InboxTransactor // Write-only binding to the contract
+	CrossL2InboxFilterer   // Log filterer for contract events
+}
+
+// CrossL2InboxCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CrossL2InboxCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrossL2InboxTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CrossL2InboxTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrossL2InboxFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CrossL2InboxFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrossL2InboxSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CrossL2InboxSession struct {
+	Contract     *CrossL2Inbox     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CrossL2InboxCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CrossL2InboxCallerSession struct {
+	Contract *CrossL2InboxCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// CrossL2InboxTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CrossL2InboxTransactorSession struct {
+	Contract     *CrossL2InboxTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// CrossL2InboxRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CrossL2InboxRaw struct {
+	Contract *CrossL2Inbox // Generic contract binding to access the raw methods on
+}
+
+// CrossL2InboxCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CrossL2InboxCallerRaw struct {
+	Contract *CrossL2InboxCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CrossL2InboxTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CrossL2InboxTransactorRaw struct {
+	Contract *CrossL2InboxTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCrossL2Inbox creates a new instance of CrossL2Inbox, bound to a specific deployed contract.
+func NewCrossL2Inbox(address common.Address, backend bind.ContractBackend) (*CrossL2Inbox, error) {
+	contract, err := bindCrossL2Inbox(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossL2Inbox{CrossL2InboxCaller: CrossL2InboxCaller{contract: contract}, CrossL2InboxTransactor: CrossL2InboxTransactor{contract: contract}, CrossL2InboxFilterer: CrossL2InboxFilterer{contract: contract}}, nil
+}
+
+// NewCrossL2InboxCaller creates a new read-only instance of CrossL2Inbox, bound to a specific deployed contract.
+func NewCrossL2InboxCaller(address common.Address, caller bind.ContractCaller) (*CrossL2InboxCaller, error) {
+	contract, err := bindCrossL2Inbox(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossL2InboxCaller{contract: contract}, nil
+}
+
+// NewCrossL2InboxTransactor creates a new write-only instance of CrossL2Inbox, bound to a specific deployed contract.
+func NewCrossL2InboxTransactor(address common.Address, transactor bind.ContractTransactor) (*CrossL2InboxTransactor, error) {
+	contract, err := bindCrossL2Inbox(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossL2InboxTransactor{contract: contract}, nil
+}
+
+// NewCrossL2InboxFilterer creates a new log filterer instance of CrossL2Inbox, bound to a specific deployed contract.
+func NewCrossL2InboxFilterer(address common.Address, filterer bind.ContractFilterer) (*CrossL2InboxFilterer, error) {
+	contract, err := bindCrossL2Inbox(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossL2InboxFilterer{contract: contract}, nil
+}
+
+// bindCrossL2Inbox binds a generic wrapper to an already deployed contract.
+func bindCrossL2Inbox(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CrossL2InboxMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CrossL2Inbox *CrossL2InboxRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CrossL2Inbox.Contract.CrossL2InboxCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CrossL2Inbox *CrossL2InboxRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.CrossL2InboxTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CrossL2Inbox *CrossL2InboxRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.CrossL2InboxTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CrossL2Inbox *CrossL2InboxCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CrossL2Inbox.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CrossL2Inbox *CrossL2InboxTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CrossL2Inbox *CrossL2InboxTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.contract.Transact(opts, method, params...)
+}
+
+// BlockNumber is a free data retrieval call binding the contract method 0x57e871e7.
+//
+// Solidity: function blockNumber() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCaller) BlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "blockNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BlockNumber is a free data retrieval call binding the contract method 0x57e871e7.
+//
+// Solidity: function blockNumber() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxSession) BlockNumber() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.BlockNumber(&_CrossL2Inbox.CallOpts)
+}
+
+// BlockNumber is a free data retrieval call binding the contract method 0x57e871e7.
+//
+// Solidity: function blockNumber() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) BlockNumber() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.BlockNumber(&_CrossL2Inbox.CallOpts)
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCaller) ChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "chainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxSession) ChainId() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.ChainId(&_CrossL2Inbox.CallOpts)
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) ChainId() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.ChainId(&_CrossL2Inbox.CallOpts)
+}
+
+// InteropStart is a free data retrieval call binding the contract method 0xb1745ada.
+//
+// Solidity: function interopStart() view returns(uint256 interopStart_)
+func (_CrossL2Inbox *CrossL2InboxCaller) InteropStart(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "interopStart")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// InteropStart is a free data retrieval call binding the contract method 0xb1745ada.
+//
+// Solidity: function interopStart() view returns(uint256 interopStart_)
+func (_CrossL2Inbox *CrossL2InboxSession) InteropStart() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.InteropStart(&_CrossL2Inbox.CallOpts)
+}
+
+// InteropStart is a free data retrieval call binding the contract method 0xb1745ada.
+//
+// Solidity: function interopStart() view returns(uint256 interopStart_)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) InteropStart() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.InteropStart(&_CrossL2Inbox.CallOpts)
+}
+
+// LogIndex is a free data retrieval call binding the contract method 0xda99f729.
+//
+// Solidity: function logIndex() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCaller) LogIndex(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "logIndex")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LogIndex is a free data retrieval call binding the contract method 0xda99f729.
+//
+// Solidity: function logIndex() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxSession) LogIndex() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.LogIndex(&_CrossL2Inbox.CallOpts)
+}
+
+// LogIndex is a free data retrieval call binding the contract method 0xda99f729.
+//
+// Solidity: function logIndex() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) LogIndex() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.LogIndex(&_CrossL2Inbox.CallOpts)
+}
+
+// Origin is a free data retrieval call binding the contract method 0x938b5f32.
+//
+// Solidity: function origin() view returns(address)
+func (_CrossL2Inbox *CrossL2InboxCaller) Origin(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "origin")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Origin is a free data retrieval call binding the contract method 0x938b5f32.
+//
+// Solidity: function origin() view returns(address)
+func (_CrossL2Inbox *CrossL2InboxSession) Origin() (common.Address, error) {
+	return _CrossL2Inbox.Contract.Origin(&_CrossL2Inbox.CallOpts)
+}
+
+// Origin is a free data retrieval call binding the contract method 0x938b5f32.
+//
+// Solidity: function origin() view returns(address)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) Origin() (common.Address, error) {
+	return _CrossL2Inbox.Contract.Origin(&_CrossL2Inbox.CallOpts)
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCaller) Timestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "timestamp")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxSession) Timestamp() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.Timestamp(&_CrossL2Inbox.CallOpts)
+}
+
+// Timestamp is a free data retrieval call binding the contract method 0xb80777ea.
+//
+// Solidity: function timestamp() view returns(uint256)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) Timestamp() (*big.Int, error) {
+	return _CrossL2Inbox.Contract.Timestamp(&_CrossL2Inbox.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_CrossL2Inbox *CrossL2InboxCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CrossL2Inbox.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_CrossL2Inbox *CrossL2InboxSession) Version() (string, error) {
+	return _CrossL2Inbox.Contract.Version(&_CrossL2Inbox.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_CrossL2Inbox *CrossL2InboxCallerSession) Version() (string, error) {
+	return _CrossL2Inbox.Contract.Version(&_CrossL2Inbox.CallOpts)
+}
+
+// SetInteropStart is a paid mutator transaction binding the contract method 0xc8ab72ca.
+//
+// Solidity: function setInteropStart() returns()
+func (_CrossL2Inbox *CrossL2InboxTransactor) SetInteropStart(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrossL2Inbox.contract.Transact(opts, "setInteropStart")
+}
+
+// SetInteropStart is a paid mutator transaction binding the contract method 0xc8ab72ca.
+//
+// Solidity: function setInteropStart() returns()
+func (_CrossL2Inbox *CrossL2InboxSession) SetInteropStart() (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.SetInteropStart(&_CrossL2Inbox.TransactOpts)
+}
+
+// SetInteropStart is a paid mutator transaction binding the contract method 0xc8ab72ca.
+//
+// Solidity: function setInteropStart() returns()
+func (_CrossL2Inbox *CrossL2InboxTransactorSession) SetInteropStart() (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.SetInteropStart(&_CrossL2Inbox.TransactOpts)
+}
+
+// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
+//
+// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
+func (_CrossL2Inbox *CrossL2InboxTransactor) ValidateMessage(opts *bind.TransactOpts, _id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
+	return _CrossL2Inbox.contract.Transact(opts, "validateMessage", _id, _msgHash)
+}
+
+// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
+//
+// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
+func (_CrossL2Inbox *CrossL2InboxSession) ValidateMessage(_id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.ValidateMessage(&_CrossL2Inbox.TransactOpts, _id, _msgHash)
+}
+
+// ValidateMessage is a paid mutator transaction binding the contract method 0xab4d6f75.
+//
+// Solidity: function validateMessage((address,uint256,uint256,uint256,uint256) _id, bytes32 _msgHash) returns()
+func (_CrossL2Inbox *CrossL2InboxTransactorSession) ValidateMessage(_id Identifier, _msgHash [32]byte) (*types.Transaction, error) {
+	return _CrossL2Inbox.Contract.ValidateMessage(&_CrossL2Inbox.TransactOpts, _id, _msgHash)
+}
+
+// CrossL2InboxExecutingMessageIterator is returned from FilterExecutingMessage and is used to iterate over the raw logs and unpacked data for ExecutingMessage events raised by the CrossL2Inbox contract.
+type CrossL2InboxExecutingMessageIterator struct {
+	Event *CrossL2InboxExecutingMessage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CrossL2InboxExecutingMessageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CrossL2InboxExecutingMessage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CrossL2InboxExecutingMessage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CrossL2InboxExecutingMessageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CrossL2InboxExecutingMessageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CrossL2InboxExecutingMessage represents a ExecutingMessage event raised by the CrossL2Inbox contract.
+type CrossL2InboxExecutingMessage struct {
+	MsgHash [32]byte
+	Id      Identifier
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutingMessage is a free log retrieval operation binding the contract event 0x5c37832d2e8d10e346e55ad62071a6a2f9fa5130614ef2ec6617555c6f467ba7.
+//
+// Solidity: event ExecutingMessage(bytes32 indexed msgHash, (address,uint256,uint256,uint256,uint256) id)
+func (_CrossL2Inbox *CrossL2InboxFilterer) FilterExecutingMessage(opts *bind.FilterOpts, msgHash [][32]byte) (*CrossL2InboxExecutingMessageIterator, error) {
+
+	var msgHashRule []interface{}
+	for _, msgHashItem := range msgHash {
+		msgHashRule = append(msgHashRule, msgHashItem)
+	}
+
+	logs, sub, err := _CrossL2Inbox.contract.FilterLogs(opts, "ExecutingMessage", msgHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossL2InboxExecutingMessageIterator{contract: _CrossL2Inbox.contract, event: "ExecutingMessage", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutingMessage is a free log subscription operation binding the contract event 0x5c37832d2e8d10e346e55ad62071a6a2f9fa5130614ef2ec6617555c6f467ba7.
+//
+// Solidity: event ExecutingMessage(bytes32 indexed msgHash, (address,uint256,uint256,uint256,uint256) id)
+func (_CrossL2Inbox *CrossL2InboxFilterer) WatchExecutingMessage(opts *bind.WatchOpts, sink chan<- *CrossL2InboxExecutingMessage, msgHash [][32]byte) (event.Subscription, error) {
+
+	var msgHashRule []interface{}
+	for _, msgHashItem := range msgHash {
+		msgHashRule = append(msgHashRule, msgHashItem)
+	}
+
+	logs, sub, err := _CrossL2Inbox.contract.WatchLogs(opts, "ExecutingMessage", msgHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CrossL2InboxExecutingMessage)
+				if err := _CrossL2Inbox.contract.UnpackLog(event, "ExecutingMessage", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutingMessage is a log parse operation binding the contract event 0x5c37832d2e8d10e346e55ad62071a6a2f9fa5130614ef2ec6617555c6f467ba7.
+//
+// Solidity: event ExecutingMessage(bytes32 indexed msgHash, (address,uint256,uint256,uint256,uint256) id)
+func (_CrossL2Inbox *CrossL2InboxFilterer) ParseExecutingMessage(log types.Log) (*CrossL2InboxExecutingMessage, error) {
+	event := new(CrossL2InboxExecutingMessage)
+	if err := _CrossL2Inbox.contract.UnpackLog(event, "ExecutingMessage", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// L2ToL2CrossDomainMessengerMetaData contains all meta data concerning the L2ToL2CrossDomainMessenger contract.
+var L2ToL2CrossDomainMessengerMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"crossDomainMessageContext\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"sender_\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"source_\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"crossDomainMessageSender\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"sender_\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"crossDomainMessageSource\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"source_\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageVersion\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"origin\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"logIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"}],\"internalType\":\"structIdentifier\",\"name\":\"_id\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"_sentMessage\",\"type\":\"bytes\"}],\"name\":\"relayMessage\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"returnData_\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_destination\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_message\",\"type\":\"bytes\"}],\"name\":\"sendMessage\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"successfulMessages\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"source\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"messageNonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"messageHash\",\"type\":\"bytes32\"}],\"name\":\"RelayedMessage\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"destination\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"messageNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"SentMessage\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"EventPayloadNotSentMessage\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IdOriginNotL2ToL2CrossDomainMessenger\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidChainId\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MessageAlreadyRelayed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MessageDestinationNotRelayChain\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MessageDestinationSameChain\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MessageTargetCrossL2Inbox\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MessageTargetL2ToL2CrossDomainMessenger\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotEntered\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrantCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TargetCallFailed\",\"type\":\"error\"}]",
+}
+
+// L2ToL2CrossDomainMessengerABI is the input ABI used to generate the binding from.
+// Deprecated: Use L2ToL2CrossDomainMessengerMetaData.ABI instead.
+var L2ToL2CrossDomainMessengerABI = L2ToL2CrossDomainMessengerMetaData.ABI
+
+// L2ToL2CrossDomainMessenger is an auto generated Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessenger struct {
+	L2ToL2CrossDomainMessengerCaller     // Read-only binding to the contract
+	L2ToL2CrossDomainMessengerTransactor // Write-only binding to the contract
+	L2ToL2CrossDomainMessengerFilterer   // Log filterer for contract events
+}
+
+// L2ToL2CrossDomainMessengerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessengerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L2ToL2CrossDomainMessengerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessengerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L2ToL2CrossDomainMessengerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type L2ToL2CrossDomainMessengerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L2ToL2CrossDomainMessengerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type L2ToL2CrossDomainMessengerSession struct {
+	Contract     *L2ToL2CrossDomainMessenger // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts               // Call options to use throughout this session
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// L2ToL2CrossDomainMessengerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type L2ToL2CrossDomainMessengerCallerSession struct {
+	Contract *L2ToL2CrossDomainMessengerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                     // Call options to use throughout this session
+}
+
+// L2ToL2CrossDomainMessengerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type L2ToL2CrossDomainMessengerTransactorSession struct {
+	Contract     *L2ToL2CrossDomainMessengerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                     // Transaction auth options to use throughout this session
+}
+
+// L2ToL2CrossDomainMessengerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessengerRaw struct {
+	Contract *L2ToL2CrossDomainMessenger // Generic contract binding to access the raw methods on
+}
+
+// L2ToL2CrossDomainMessengerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessengerCallerRaw struct {
+	Contract *L2ToL2CrossDomainMessengerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// L2ToL2CrossDomainMessengerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type L2ToL2CrossDomainMessengerTransactorRaw struct {
+	Contract *L2ToL2CrossDomainMessengerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewL2ToL2CrossDomainMessenger creates a new instance of L2ToL2CrossDomainMessenger, bound to a specific deployed contract.
+func NewL2ToL2CrossDomainMessenger(address common.Address, backend bind.ContractBackend) (*L2ToL2CrossDomainMessenger, error) {
+	contract, err := bindL2ToL2CrossDomainMessenger(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessenger{L2ToL2CrossDomainMessengerCaller: L2ToL2CrossDomainMessengerCaller{contract: contract}, L2ToL2CrossDomainMessengerTransactor: L2ToL2CrossDomainMessengerTransactor{contract: contract}, L2ToL2CrossDomainMessengerFilterer: L2ToL2CrossDomainMessengerFilterer{contract: contract}}, nil
+}
+
+// NewL2ToL2CrossDomainMessengerCaller creates a new read-only instance of L2ToL2CrossDomainMessenger, bound to a specific deployed contract.
+func NewL2ToL2CrossDomainMessengerCaller(address common.Address, caller bind.ContractCaller) (*L2ToL2CrossDomainMessengerCaller, error) {
+	contract, err := bindL2ToL2CrossDomainMessenger(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessengerCaller{contract: contract}, nil
+}
+
+// NewL2ToL2CrossDomainMessengerTransactor creates a new write-only instance of L2ToL2CrossDomainMessenger, bound to a specific deployed contract.
+func NewL2ToL2CrossDomainMessengerTransactor(address common.Address, transactor bind.ContractTransactor) (*L2ToL2CrossDomainMessengerTransactor, error) {
+	contract, err := bindL2ToL2CrossDomainMessenger(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessengerTransactor{contract: contract}, nil
+}
+
+// NewL2ToL2CrossDomainMessengerFilterer creates a new log filterer instance of L2ToL2CrossDomainMessenger, bound to a specific deployed contract.
+func NewL2ToL2CrossDomainMessengerFilterer(address common.Address, filterer bind.ContractFilterer) (*L2ToL2CrossDomainMessengerFilterer, error) {
+	contract, err := bindL2ToL2CrossDomainMessenger(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessengerFilterer{contract: contract}, nil
+}
+
+// bindL2ToL2CrossDomainMessenger binds a generic wrapper to an already deployed contract.
+func bindL2ToL2CrossDomainMessenger(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := L2ToL2CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _L2ToL2CrossDomainMessenger.Contract.L2ToL2CrossDomainMessengerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.L2ToL2CrossDomainMessengerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.L2ToL2CrossDomainMessengerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _L2ToL2CrossDomainMessenger.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.contract.Transact(opts, method, params...)
+}
+
+// CrossDomainMessageContext is a free data retrieval call binding the contract method 0x7936cbee.
+//
+// Solidity: function crossDomainMessageContext() view returns(address sender_, uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) CrossDomainMessageContext(opts *bind.CallOpts) (struct {
+	Sender common.Address
+	Source *big.Int
+}, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "crossDomainMessageContext")
+
+	outstruct := new(struct {
+		Sender common.Address
+		Source *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Sender = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Source = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// CrossDomainMessageContext is a free data retrieval call binding the contract method 0x7936cbee.
+//
+// Solidity: function crossDomainMessageContext() view returns(address sender_, uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) CrossDomainMessageContext() (struct {
+	Sender common.Address
+	Source *big.Int
+}, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageContext(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// CrossDomainMessageContext is a free data retrieval call binding the contract method 0x7936cbee.
+//
+// Solidity: function crossDomainMessageContext() view returns(address sender_, uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) CrossDomainMessageContext() (struct {
+	Sender common.Address
+	Source *big.Int
+}, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageContext(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// CrossDomainMessageSender is a free data retrieval call binding the contract method 0x38ffde18.
+//
+// Solidity: function crossDomainMessageSender() view returns(address sender_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) CrossDomainMessageSender(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "crossDomainMessageSender")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CrossDomainMessageSender is a free data retrieval call binding the contract method 0x38ffde18.
+//
+// Solidity: function crossDomainMessageSender() view returns(address sender_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) CrossDomainMessageSender() (common.Address, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageSender(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// CrossDomainMessageSender is a free data retrieval call binding the contract method 0x38ffde18.
+//
+// Solidity: function crossDomainMessageSender() view returns(address sender_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) CrossDomainMessageSender() (common.Address, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageSender(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// CrossDomainMessageSource is a free data retrieval call binding the contract method 0x24794462.
+//
+// Solidity: function crossDomainMessageSource() view returns(uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) CrossDomainMessageSource(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "crossDomainMessageSource")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CrossDomainMessageSource is a free data retrieval call binding the contract method 0x24794462.
+//
+// Solidity: function crossDomainMessageSource() view returns(uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) CrossDomainMessageSource() (*big.Int, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageSource(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// CrossDomainMessageSource is a free data retrieval call binding the contract method 0x24794462.
+//
+// Solidity: function crossDomainMessageSource() view returns(uint256 source_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) CrossDomainMessageSource() (*big.Int, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.CrossDomainMessageSource(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// MessageNonce is a free data retrieval call binding the contract method 0xecc70428.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) MessageNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "messageNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MessageNonce is a free data retrieval call binding the contract method 0xecc70428.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) MessageNonce() (*big.Int, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.MessageNonce(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// MessageNonce is a free data retrieval call binding the contract method 0xecc70428.
+//
+// Solidity: function messageNonce() view returns(uint256)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) MessageNonce() (*big.Int, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.MessageNonce(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// MessageVersion is a free data retrieval call binding the contract method 0x52617f3c.
+//
+// Solidity: function messageVersion() view returns(uint16)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) MessageVersion(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "messageVersion")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// MessageVersion is a free data retrieval call binding the contract method 0x52617f3c.
+//
+// Solidity: function messageVersion() view returns(uint16)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) MessageVersion() (uint16, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.MessageVersion(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// MessageVersion is a free data retrieval call binding the contract method 0x52617f3c.
+//
+// Solidity: function messageVersion() view returns(uint16)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) MessageVersion() (uint16, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.MessageVersion(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// SuccessfulMessages is a free data retrieval call binding the contract method 0xb1b1b209.
+//
+// Solidity: function successfulMessages(bytes32 ) view returns(bool)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) SuccessfulMessages(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "successfulMessages", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SuccessfulMessages is a free data retrieval call binding the contract method 0xb1b1b209.
+//
+// Solidity: function successfulMessages(bytes32 ) view returns(bool)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) SuccessfulMessages(arg0 [32]byte) (bool, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.SuccessfulMessages(&_L2ToL2CrossDomainMessenger.CallOpts, arg0)
+}
+
+// SuccessfulMessages is a free data retrieval call binding the contract method 0xb1b1b209.
+//
+// Solidity: function successfulMessages(bytes32 ) view returns(bool)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) SuccessfulMessages(arg0 [32]byte) (bool, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.SuccessfulMessages(&_L2ToL2CrossDomainMessenger.CallOpts, arg0)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _L2ToL2CrossDomainMessenger.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) Version() (string, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.Version(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerCallerSession) Version() (string, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.Version(&_L2ToL2CrossDomainMessenger.CallOpts)
+}
+
+// RelayMessage is a paid mutator transaction binding the contract method 0x8d1d298f.
+//
+// Solidity: function relayMessage((address,uint256,uint256,uint256,uint256) _id, bytes _sentMessage) payable returns(bytes returnData_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactor) RelayMessage(opts *bind.TransactOpts, _id Identifier, _sentMessage []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.contract.Transact(opts, "relayMessage", _id, _sentMessage)
+}
+
+// RelayMessage is a paid mutator transaction binding the contract method 0x8d1d298f.
+//
+// Solidity: function relayMessage((address,uint256,uint256,uint256,uint256) _id, bytes _sentMessage) payable returns(bytes returnData_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) RelayMessage(_id Identifier, _sentMessage []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.RelayMessage(&_L2ToL2CrossDomainMessenger.TransactOpts, _id, _sentMessage)
+}
+
+// RelayMessage is a paid mutator transaction binding the contract method 0x8d1d298f.
+//
+// Solidity: function relayMessage((address,uint256,uint256,uint256,uint256) _id, bytes _sentMessage) payable returns(bytes returnData_)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactorSession) RelayMessage(_id Identifier, _sentMessage []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.RelayMessage(&_L2ToL2CrossDomainMessenger.TransactOpts, _id, _sentMessage)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x7056f41f.
+//
+// Solidity: function sendMessage(uint256 _destination, address _target, bytes _message) returns(bytes32)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactor) SendMessage(opts *bind.TransactOpts, _destination *big.Int, _target common.Address, _message []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.contract.Transact(opts, "sendMessage", _destination, _target, _message)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x7056f41f.
+//
+// Solidity: function sendMessage(uint256 _destination, address _target, bytes _message) returns(bytes32)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerSession) SendMessage(_destination *big.Int, _target common.Address, _message []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.SendMessage(&_L2ToL2CrossDomainMessenger.TransactOpts, _destination, _target, _message)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x7056f41f.
+//
+// Solidity: function sendMessage(uint256 _destination, address _target, bytes _message) returns(bytes32)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerTransactorSession) SendMessage(_destination *big.Int, _target common.Address, _message []byte) (*types.Transaction, error) {
+	return _L2ToL2CrossDomainMessenger.Contract.SendMessage(&_L2ToL2CrossDomainMessenger.TransactOpts, _destination, _target, _message)
+}
+
+// L2ToL2CrossDomainMessengerRelayedMessageIterator is returned from FilterRelayedMessage and is used to iterate over the raw logs and unpacked data for RelayedMessage events raised by the L2ToL2CrossDomainMessenger contract.
+type L2ToL2CrossDomainMessengerRelayedMessageIterator struct {
+	Event *L2ToL2CrossDomainMessengerRelayedMessage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L2ToL2CrossDomainMessengerRelayedMessageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L2ToL2CrossDomainMessengerRelayedMessage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L2ToL2CrossDomainMessengerRelayedMessage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L2ToL2CrossDomainMessengerRelayedMessageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L2ToL2CrossDomainMessengerRelayedMessageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L2ToL2CrossDomainMessengerRelayedMessage represents a RelayedMessage event raised by the L2ToL2CrossDomainMessenger contract.
+type L2ToL2CrossDomainMessengerRelayedMessage struct {
+	Source       *big.Int
+	MessageNonce *big.Int
+	MessageHash  [32]byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterRelayedMessage is a free log retrieval operation binding the contract event 0x5948076590932b9d173029c7df03fe386e755a61c86c7fe2671011a2faa2a379.
+//
+// Solidity: event RelayedMessage(uint256 indexed source, uint256 indexed messageNonce, bytes32 indexed messageHash)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) FilterRelayedMessage(opts *bind.FilterOpts, source []*big.Int, messageNonce []*big.Int, messageHash [][32]byte) (*L2ToL2CrossDomainMessengerRelayedMessageIterator, error) {
+
+	var sourceRule []interface{}
+	for _, sourceItem := range source {
+		sourceRule = append(sourceRule, sourceItem)
+	}
+	var messageNonceRule []interface{}
+	for _, messageNonceItem := range messageNonce {
+		messageNonceRule = append(messageNonceRule, messageNonceItem)
+	}
+	var messageHashRule []interface{}
+	for _, messageHashItem := range messageHash {
+		messageHashRule = append(messageHashRule, messageHashItem)
+	}
+
+	logs, sub, err := _L2ToL2CrossDomainMessenger.contract.FilterLogs(opts, "RelayedMessage", sourceRule, messageNonceRule, messageHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessengerRelayedMessageIterator{contract: _L2ToL2CrossDomainMessenger.contract, event: "RelayedMessage", logs: logs, sub: sub}, nil
+}
+
+// WatchRelayedMessage is a free log subscription operation binding the contract event 0x5948076590932b9d173029c7df03fe386e755a61c86c7fe2671011a2faa2a379.
+//
+// Solidity: event RelayedMessage(uint256 indexed source, uint256 indexed messageNonce, bytes32 indexed messageHash)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) WatchRelayedMessage(opts *bind.WatchOpts, sink chan<- *L2ToL2CrossDomainMessengerRelayedMessage, source []*big.Int, messageNonce []*big.Int, messageHash [][32]byte) (event.Subscription, error) {
+
+	var sourceRule []interface{}
+	for _, sourceItem := range source {
+		sourceRule = append(sourceRule, sourceItem)
+	}
+	var messageNonceRule []interface{}
+	for _, messageNonceItem := range messageNonce {
+		messageNonceRule = append(messageNonceRule, messageNonceItem)
+	}
+	var messageHashRule []interface{}
+	for _, messageHashItem := range messageHash {
+		messageHashRule = append(messageHashRule, messageHashItem)
+	}
+
+	logs, sub, err := _L2ToL2CrossDomainMessenger.contract.WatchLogs(opts, "RelayedMessage", sourceRule, messageNonceRule, messageHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L2ToL2CrossDomainMessengerRelayedMessage)
+				if err := _L2ToL2CrossDomainMessenger.contract.UnpackLog(event, "RelayedMessage", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRelayedMessage is a log parse operation binding the contract event 0x5948076590932b9d173029c7df03fe386e755a61c86c7fe2671011a2faa2a379.
+//
+// Solidity: event RelayedMessage(uint256 indexed source, uint256 indexed messageNonce, bytes32 indexed messageHash)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) ParseRelayedMessage(log types.Log) (*L2ToL2CrossDomainMessengerRelayedMessage, error) {
+	event := new(L2ToL2CrossDomainMessengerRelayedMessage)
+	if err := _L2ToL2CrossDomainMessenger.contract.UnpackLog(event, "RelayedMessage", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// L2ToL2CrossDomainMessengerSentMessageIterator is returned from FilterSentMessage and is used to iterate over the raw logs and unpacked data for SentMessage events raised by the L2ToL2CrossDomainMessenger contract.
+type L2ToL2CrossDomainMessengerSentMessageIterator struct {
+	Event *L2ToL2CrossDomainMessengerSentMessage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L2ToL2CrossDomainMessengerSentMessageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L2ToL2CrossDomainMessengerSentMessage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L2ToL2CrossDomainMessengerSentMessage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L2ToL2CrossDomainMessengerSentMessageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L2ToL2CrossDomainMessengerSentMessageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L2ToL2CrossDomainMessengerSentMessage represents a SentMessage event raised by the L2ToL2CrossDomainMessenger contract.
+type L2ToL2CrossDomainMessengerSentMessage struct {
+	Destination  *big.Int
+	Target       common.Address
+	MessageNonce *big.Int
+	Sender       common.Address
+	Message      []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSentMessage is a free log retrieval operation binding the contract event 0x382409ac69001e11931a28435afef442cbfd20d9891907e8fa373ba7d351f320.
+//
+// Solidity: event SentMessage(uint256 indexed destination, address indexed target, uint256 indexed messageNonce, address sender, bytes message)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) FilterSentMessage(opts *bind.FilterOpts, destination []*big.Int, target []common.Address, messageNonce []*big.Int) (*L2ToL2CrossDomainMessengerSentMessageIterator, error) {
+
+	var destinationRule []interface{}
+	for _, destinationItem := range destination {
+		destinationRule = append(destinationRule, destinationItem)
+	}
+	var targetRule []interface{}
+	for _, targetItem := range target {
+		targetRule = append(targetRule, targetItem)
+	}
+	var messageNonceRule []interface{}
+	for _, messageNonceItem := range messageNonce {
+		messageNonceRule = append(messageNonceRule, messageNonceItem)
+	}
+
+	logs, sub, err := _L2ToL2CrossDomainMessenger.contract.FilterLogs(opts, "SentMessage", destinationRule, targetRule, messageNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &L2ToL2CrossDomainMessengerSentMessageIterator{contract: _L2ToL2CrossDomainMessenger.contract, event: "SentMessage", logs: logs, sub: sub}, nil
+}
+
+// WatchSentMessage is a free log subscription operation binding the contract event 0x382409ac69001e11931a28435afef442cbfd20d9891907e8fa373ba7d351f320.
+//
+// Solidity: event SentMessage(uint256 indexed destination, address indexed target, uint256 indexed messageNonce, address sender, bytes message)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) WatchSentMessage(opts *bind.WatchOpts, sink chan<- *L2ToL2CrossDomainMessengerSentMessage, destination []*big.Int, target []common.Address, messageNonce []*big.Int) (event.Subscription, error) {
+
+	var destinationRule []interface{}
+	for _, destinationItem := range destination {
+		destinationRule = append(destinationRule, destinationItem)
+	}
+	var targetRule []interface{}
+	for _, targetItem := range target {
+		targetRule = append(targetRule, targetItem)
+	}
+	var messageNonceRule []interface{}
+	for _, messageNonceItem := range messageNonce {
+		messageNonceRule = append(messageNonceRule, messageNonceItem)
+	}
+
+	logs, sub, err := _L2ToL2CrossDomainMessenger.contract.WatchLogs(opts, "SentMessage", destinationRule, targetRule, messageNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L2ToL2CrossDomainMessengerSentMessage)
+				if err := _L2ToL2CrossDomainMessenger.contract.UnpackLog(event, "SentMessage", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSentMessage is a log parse operation binding the contract event 0x382409ac69001e11931a28435afef442cbfd20d9891907e8fa373ba7d351f320.
+//
+// Solidity: event SentMessage(uint256 indexed destination, address indexed target, uint256 indexed messageNonce, address sender, bytes message)
+func (_L2ToL2CrossDomainMessenger *L2ToL2CrossDomainMessengerFilterer) ParseSentMessage(log types.Log) (*L2ToL2CrossDomainMessengerSentMessage, error) {
+	event := new(L2ToL2CrossDomainMessengerSentMessage)
+	if err := _L2ToL2CrossDomainMessenger.contract.UnpackLog(event, "SentMessage", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SuperchainTokenBridgeMetaData contains all meta data concerning the SuperchainTokenBridge contract.
+var SuperchainTokenBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"relayERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_chainId\",\"type\":\"uint256\"}],\"name\":\"sendERC20\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"msgHash_\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"source\",\"type\":\"uint256\"}],\"name\":\"RelayERC20\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"destination\",\"type\":\"uint256\"}],\"name\":\"SendERC20\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"InvalidCrossDomainSender\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidERC7802\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"Unauthorized\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"}]",
+}
+
+// SuperchainTokenBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use SuperchainTokenBridgeMetaData.ABI instead.
+var SuperchainTokenBridgeABI = SuperchainTokenBridgeMetaData.ABI
+
+// SuperchainTokenBridge is an auto generated Go binding around an Ethereum contract.
+type SuperchainTokenBridge struct {
+	SuperchainTokenBridgeCaller     // Read-only binding to the contract
+	SuperchainTokenBridgeTransactor // Write-only binding to the contract
+	SuperchainTokenBridgeFilterer   // Log filterer for contract events
+}
+
+// SuperchainTokenBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SuperchainTokenBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperchainTokenBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SuperchainTokenBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperchainTokenBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SuperchainTokenBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperchainTokenBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SuperchainTokenBridgeSession struct {
+	Contract     *SuperchainTokenBridge // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// SuperchainTokenBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SuperchainTokenBridgeCallerSession struct {
+	Contract *SuperchainTokenBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// SuperchainTokenBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SuperchainTokenBridgeTransactorSession struct {
+	Contract     *SuperchainTokenBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// SuperchainTokenBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SuperchainTokenBridgeRaw struct {
+	Contract *SuperchainTokenBridge // Generic contract binding to access the raw methods on
+}
+
+// SuperchainTokenBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SuperchainTokenBridgeCallerRaw struct {
+	Contract *SuperchainTokenBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SuperchainTokenBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SuperchainTokenBridgeTransactorRaw struct {
+	Contract *SuperchainTokenBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSuperchainTokenBridge creates a new instance of SuperchainTokenBridge, bound to a specific deployed contract.
+func NewSuperchainTokenBridge(address common.Address, backend bind.ContractBackend) (*SuperchainTokenBridge, error) {
+	contract, err := bindSuperchainTokenBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridge{SuperchainTokenBridgeCaller: SuperchainTokenBridgeCaller{contract: contract}, SuperchainTokenBridgeTransactor: SuperchainTokenBridgeTransactor{contract: contract}, SuperchainTokenBridgeFilterer: SuperchainTokenBridgeFilterer{contract: contract}}, nil
+}
+
+// NewSuperchainTokenBridgeCaller creates a new read-only instance of SuperchainTokenBridge, bound to a specific deployed contract.
+func NewSuperchainTokenBridgeCaller(address common.Address, caller bind.ContractCaller) (*SuperchainTokenBridgeCaller, error) {
+	contract, err := bindSuperchainTokenBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridgeCaller{contract: contract}, nil
+}
+
+// NewSuperchainTokenBridgeTransactor creates a new write-only instance of SuperchainTokenBridge, bound to a specific deployed contract.
+func NewSuperchainTokenBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*SuperchainTokenBridgeTransactor, error) {
+	contract, err := bindSuperchainTokenBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridgeTransactor{contract: contract}, nil
+}
+
+// NewSuperchainTokenBridgeFilterer creates a new log filterer instance of SuperchainTokenBridge, bound to a specific deployed contract.
+func NewSuperchainTokenBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*SuperchainTokenBridgeFilterer, error) {
+	contract, err := bindSuperchainTokenBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridgeFilterer{contract: contract}, nil
+}
+
+// bindSuperchainTokenBridge binds a generic wrapper to an already deployed contract.
+func bindSuperchainTokenBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SuperchainTokenBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SuperchainTokenBridge.Contract.SuperchainTokenBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.SuperchainTokenBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.SuperchainTokenBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SuperchainTokenBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _SuperchainTokenBridge.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeSession) Version() (string, error) {
+	return _SuperchainTokenBridge.Contract.Version(&_SuperchainTokenBridge.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeCallerSession) Version() (string, error) {
+	return _SuperchainTokenBridge.Contract.Version(&_SuperchainTokenBridge.CallOpts)
+}
+
+// RelayERC20 is a paid mutator transaction binding the contract method 0x7cfd6dbc.
+//
+// Solidity: function relayERC20(address _token, address _from, address _to, uint256 _amount) returns()
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactor) RelayERC20(opts *bind.TransactOpts, _token common.Address, _from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.contract.Transact(opts, "relayERC20", _token, _from, _to, _amount)
+}
+
+// RelayERC20 is a paid mutator transaction binding the contract method 0x7cfd6dbc.
+//
+// Solidity: function relayERC20(address _token, address _from, address _to, uint256 _amount) returns()
+func (_SuperchainTokenBridge *SuperchainTokenBridgeSession) RelayERC20(_token common.Address, _from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.RelayERC20(&_SuperchainTokenBridge.TransactOpts, _token, _from, _to, _amount)
+}
+
+// RelayERC20 is a paid mutator transaction binding the contract method 0x7cfd6dbc.
+//
+// Solidity: function relayERC20(address _token, address _from, address _to, uint256 _amount) returns()
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactorSession) RelayERC20(_token common.Address, _from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.RelayERC20(&_SuperchainTokenBridge.TransactOpts, _token, _from, _to, _amount)
+}
+
+// SendERC20 is a paid mutator transaction binding the contract method 0xc1a433d8.
+//
+// Solidity: function sendERC20(address _token, address _to, uint256 _amount, uint256 _chainId) returns(bytes32 msgHash_)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactor) SendERC20(opts *bind.TransactOpts, _token common.Address, _to common.Address, _amount *big.Int, _chainId *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.contract.Transact(opts, "sendERC20", _token, _to, _amount, _chainId)
+}
+
+// SendERC20 is a paid mutator transaction binding the contract method 0xc1a433d8.
+//
+// Solidity: function sendERC20(address _token, address _to, uint256 _amount, uint256 _chainId) returns(bytes32 msgHash_)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeSession) SendERC20(_token common.Address, _to common.Address, _amount *big.Int, _chainId *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.SendERC20(&_SuperchainTokenBridge.TransactOpts, _token, _to, _amount, _chainId)
+}
+
+// SendERC20 is a paid mutator transaction binding the contract method 0xc1a433d8.
+//
+// Solidity: function sendERC20(address _token, address _to, uint256 _amount, uint256 _chainId) returns(bytes32 msgHash_)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeTransactorSession) SendERC20(_token common.Address, _to common.Address, _amount *big.Int, _chainId *big.Int) (*types.Transaction, error) {
+	return _SuperchainTokenBridge.Contract.SendERC20(&_SuperchainTokenBridge.TransactOpts, _token, _to, _amount, _chainId)
+}
+
+// SuperchainTokenBridgeRelayERC20Iterator is returned from FilterRelayERC20 and is used to iterate over the raw logs and unpacked data for RelayERC20 events raised by the SuperchainTokenBridge contract.
+type SuperchainTokenBridgeRelayERC20Iterator struct {
+	Event *SuperchainTokenBridgeRelayERC20 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SuperchainTokenBridgeRelayERC20Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SuperchainTokenBridgeRelayERC20)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SuperchainTokenBridgeRelayERC20)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SuperchainTokenBridgeRelayERC20Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SuperchainTokenBridgeRelayERC20Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SuperchainTokenBridgeRelayERC20 represents a RelayERC20 event raised by the SuperchainTokenBridge contract.
+type SuperchainTokenBridgeRelayERC20 struct {
+	Token  common.Address
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+	Source *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRelayERC20 is a free log retrieval operation binding the contract event 0x434965d7426acf45a548f00783c067e9ad789c8c66444f0a5ad8941d5005be93.
+//
+// Solidity: event RelayERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 source)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) FilterRelayERC20(opts *bind.FilterOpts, token []common.Address, from []common.Address, to []common.Address) (*SuperchainTokenBridgeRelayERC20Iterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _SuperchainTokenBridge.contract.FilterLogs(opts, "RelayERC20", tokenRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridgeRelayERC20Iterator{contract: _SuperchainTokenBridge.contract, event: "RelayERC20", logs: logs, sub: sub}, nil
+}
+
+// WatchRelayERC20 is a free log subscription operation binding the contract event 0x434965d7426acf45a548f00783c067e9ad789c8c66444f0a5ad8941d5005be93.
+//
+// Solidity: event RelayERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 source)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) WatchRelayERC20(opts *bind.WatchOpts, sink chan<- *SuperchainTokenBridgeRelayERC20, token []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _SuperchainTokenBridge.contract.WatchLogs(opts, "RelayERC20", tokenRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SuperchainTokenBridgeRelayERC20)
+				if err := _SuperchainTokenBridge.contract.UnpackLog(event, "RelayERC20", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRelayERC20 is a log parse operation binding the contract event 0x434965d7426acf45a548f00783c067e9ad789c8c66444f0a5ad8941d5005be93.
+//
+// Solidity: event RelayERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 source)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) ParseRelayERC20(log types.Log) (*SuperchainTokenBridgeRelayERC20, error) {
+	event := new(SuperchainTokenBridgeRelayERC20)
+	if err := _SuperchainTokenBridge.contract.UnpackLog(event, "RelayERC20", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SuperchainTokenBridgeSendERC20Iterator is returned from FilterSendERC20 and is used to iterate over the raw logs and unpacked data for SendERC20 events raised by the SuperchainTokenBridge contract.
+type SuperchainTokenBridgeSendERC20Iterator struct {
+	Event *SuperchainTokenBridgeSendERC20 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SuperchainTokenBridgeSendERC20Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SuperchainTokenBridgeSendERC20)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SuperchainTokenBridgeSendERC20)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SuperchainTokenBridgeSendERC20Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SuperchainTokenBridgeSendERC20Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SuperchainTokenBridgeSendERC20 represents a SendERC20 event raised by the SuperchainTokenBridge contract.
+type SuperchainTokenBridgeSendERC20 struct {
+	Token       common.Address
+	From        common.Address
+	To          common.Address
+	Amount      *big.Int
+	Destination *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendERC20 is a free log retrieval operation binding the contract event 0x0247bfe63a1aaa59e073e20b172889babfda8d3273b5798e0e9ac4388e6dd11c.
+//
+// Solidity: event SendERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 destination)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) FilterSendERC20(opts *bind.FilterOpts, token []common.Address, from []common.Address, to []common.Address) (*SuperchainTokenBridgeSendERC20Iterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _SuperchainTokenBridge.contract.FilterLogs(opts, "SendERC20", tokenRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperchainTokenBridgeSendERC20Iterator{contract: _SuperchainTokenBridge.contract, event: "SendERC20", logs: logs, sub: sub}, nil
+}
+
+// WatchSendERC20 is a free log subscription operation binding the contract event 0x0247bfe63a1aaa59e073e20b172889babfda8d3273b5798e0e9ac4388e6dd11c.
+//
+// Solidity: event SendERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 destination)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) WatchSendERC20(opts *bind.WatchOpts, sink chan<- *SuperchainTokenBridgeSendERC20, token []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _SuperchainTokenBridge.contract.WatchLogs(opts, "SendERC20", tokenRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SuperchainTokenBridgeSendERC20)
+				if err := _SuperchainTokenBridge.contract.UnpackLog(event, "SendERC20", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendERC20 is a log parse operation binding the contract event 0x0247bfe63a1aaa59e073e20b172889babfda8d3273b5798e0e9ac4388e6dd11c.
+//
+// Solidity: event SendERC20(address indexed token, address indexed from, address indexed to, uint256 amount, uint256 destination)
+func (_SuperchainTokenBridge *SuperchainTokenBridgeFilterer) ParseSendERC20(log types.Log) (*SuperchainTokenBridgeSendERC20, error) {
+	event := new(SuperchainTokenBridgeSendERC20)
+	if err := _SuperchainTokenBridge.contract.UnpackLog(event, "SendERC20", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
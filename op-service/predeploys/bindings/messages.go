@@ -0,0 +1,131 @@
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+var ErrNotSentMessage = errors.New("log is not a SentMessage event of the L2ToL2CrossDomainMessenger")
+
+var ErrInvalidIdentifier = errors.New("invalid message identifier")
+
+// NewIdentifier converts the identifier of an initiating message to the struct of the contract calls.
+func NewIdentifier(id supervisortypes.Identifier) Identifier {
+	return Identifier{
+		Origin:      id.Origin,
+		BlockNumber: new(big.Int).SetUint64(id.BlockNumber),
+		LogIndex:    new(big.Int).SetUint64(uint64(id.LogIndex)),
+		Timestamp:   new(big.Int).SetUint64(id.Timestamp),
+		ChainId:     id.ChainID.ToBig(),
+	}
+}
+
+// identifierFromArg converts the identifier argument of a contract call to the identifier of an initiating message.
+func identifierFromArg(arg any) (supervisortypes.Identifier, error) {
+	id := *abi.ConvertType(arg, new(Identifier)).(*Identifier)
+	if !id.BlockNumber.IsUint64() || !id.Timestamp.IsUint64() || !id.LogIndex.IsUint64() || id.LogIndex.Uint64() > math.MaxUint32 {
+		return supervisortypes.Identifier{}, fmt.Errorf("%w: %+v", ErrInvalidIdentifier, id)
+	}
+	return supervisortypes.Identifier{
+		Origin:      id.Origin,
+		BlockNumber: id.BlockNumber.Uint64(),
+		LogIndex:    uint32(id.LogIndex.Uint64()),
+		Timestamp:   id.Timestamp.Uint64(),
+		ChainID:     eth.ChainIDFromBig(id.ChainId),
+	}, nil
+}
+
+// MessageFromLog returns the initiating message of the log, emitted in a block with the given timestamp
+// of the chain with the given ID. The log must be from a receipt, so its block number and index are set.
+func MessageFromLog(l *types.Log, timestamp uint64, chainID eth.ChainID) supervisortypes.Message {
+	return supervisortypes.Message{
+		Identifier: supervisortypes.Identifier{
+			Origin:      l.Address,
+			BlockNumber: l.BlockNumber,
+			LogIndex:    uint32(l.Index),
+			Timestamp:   timestamp,
+			ChainID:     chainID,
+		},
+		PayloadHash: crypto.Keccak256Hash(supervisortypes.LogToMessagePayload(l)),
+	}
+}
+
+// ExecutingMessagesAccessList returns the access-list that declares the executing messages of a transaction.
+// Transactions must declare all messages they execute through the CrossL2Inbox, or they are rejected.
+func ExecutingMessagesAccessList(messages ...supervisortypes.Message) types.AccessList {
+	return types.AccessList{{
+		Address:     predeploys.CrossL2InboxAddr,
+		StorageKeys: supervisortypes.EncodeAccessList(messages),
+	}}
+}
+
+// RelayMessageCalldata returns the calldata of the L2ToL2CrossDomainMessenger call that relays the message
+// of the SentMessage log to its target. The log is the initiating message, see MessageFromLog.
+// The relay transaction must declare the message in its access-list, see ExecutingMessagesAccessList.
+func RelayMessageCalldata(sentMessage *types.Log, timestamp uint64, chainID eth.ChainID) ([]byte, error) {
+	if !isEvent(sentMessage, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "SentMessage") {
+		return nil, ErrNotSentMessage
+	}
+	msg := MessageFromLog(sentMessage, timestamp, chainID)
+	messengerABI, err := L2ToL2CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated binding: %w", err)
+	}
+	return messengerABI.Pack("relayMessage", NewIdentifier(msg.Identifier), supervisortypes.LogToMessagePayload(sentMessage))
+}
+
+// ExecutingMessageOfCall returns the message executed by a transaction that calls the CrossL2Inbox validateMessage,
+// or the L2ToL2CrossDomainMessenger relayMessage, directly. It returns nil for any other call.
+// Messages executed by other contracts can only be found by executing the transaction.
+func ExecutingMessageOfCall(to *common.Address, data []byte) (*supervisortypes.Message, error) {
+	if to == nil || len(data) < 4 {
+		return nil, nil
+	}
+	var metaData *bind.MetaData
+	var method string
+	switch *to {
+	case predeploys.CrossL2InboxAddr:
+		metaData, method = CrossL2InboxMetaData, "validateMessage"
+	case predeploys.L2toL2CrossDomainMessengerAddr:
+		metaData, method = L2ToL2CrossDomainMessengerMetaData, "relayMessage"
+	default:
+		return nil, nil
+	}
+	contractABI, err := metaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated binding: %w", err)
+	}
+	m := contractABI.Methods[method]
+	if !bytes.Equal(data[:4], m.ID) {
+		return nil, nil
+	}
+	args, err := m.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s call: %w", method, err)
+	}
+	id, err := identifierFromArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	msg := &supervisortypes.Message{Identifier: id}
+	switch payload := args[1].(type) {
+	case [32]byte:
+		msg.PayloadHash = payload
+	case []byte:
+		msg.PayloadHash = crypto.Keccak256Hash(payload)
+	}
+	return msg, nil
+}
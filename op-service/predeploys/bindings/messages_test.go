@@ -0,0 +1,117 @@
+package bindings
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	supervisortypes "github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestMessageFromLog(t *testing.T) {
+	l := &types.Log{
+		Address:     common.Address{0xaa},
+		Topics:      []common.Hash{{0x01}, {0x02}},
+		Data:        []byte{0x03},
+		BlockNumber: 10,
+		Index:       3,
+	}
+	msg := MessageFromLog(l, 1000, eth.ChainIDFromUInt64(900))
+	require.Equal(t, supervisortypes.Identifier{
+		Origin:      common.Address{0xaa},
+		BlockNumber: 10,
+		LogIndex:    3,
+		Timestamp:   1000,
+		ChainID:     eth.ChainIDFromUInt64(900),
+	}, msg.Identifier)
+	require.Equal(t, crypto.Keccak256Hash(common.Hash{0x01}.Bytes(), common.Hash{0x02}.Bytes(), []byte{0x03}), msg.PayloadHash)
+
+	require.Equal(t, Identifier{
+		Origin:      common.Address{0xaa},
+		BlockNumber: big.NewInt(10),
+		LogIndex:    big.NewInt(3),
+		Timestamp:   big.NewInt(1000),
+		ChainId:     big.NewInt(900),
+	}, NewIdentifier(msg.Identifier))
+}
+
+func TestExecutingMessagesAccessList(t *testing.T) {
+	msgs := []supervisortypes.Message{
+		MessageFromLog(&types.Log{Address: common.Address{0xaa}, BlockNumber: 10, Index: 1}, 1000, eth.ChainIDFromUInt64(900)),
+		MessageFromLog(&types.Log{Address: common.Address{0xbb}, BlockNumber: 11, Index: 2}, 1002, eth.ChainIDFromUInt64(901)),
+	}
+	accessList := ExecutingMessagesAccessList(msgs...)
+	require.Len(t, accessList, 1)
+	require.Equal(t, predeploys.CrossL2InboxAddr, accessList[0].Address)
+	parsed, err := supervisortypes.ParseAccessList(accessList)
+	require.NoError(t, err)
+	require.Equal(t, []supervisortypes.Access{msgs[0].Access(), msgs[1].Access()}, parsed)
+}
+
+func TestRelayMessageCalldata(t *testing.T) {
+	sent := eventLog(t, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "SentMessage",
+		big.NewInt(901), common.Address{0xaa}, big.NewInt(5), common.Address{0xbb}, []byte("hello"))
+	calldata, err := RelayMessageCalldata(sent, 1000, eth.ChainIDFromUInt64(900))
+	require.NoError(t, err)
+
+	messengerABI, err := L2ToL2CrossDomainMessengerMetaData.GetAbi()
+	require.NoError(t, err)
+	method, err := messengerABI.MethodById(calldata[:4])
+	require.NoError(t, err)
+	require.Equal(t, "relayMessage", method.Name)
+	args, err := method.Inputs.Unpack(calldata[4:])
+	require.NoError(t, err)
+	id := MessageFromLog(sent, 1000, eth.ChainIDFromUInt64(900)).Identifier
+	require.EqualValues(t, NewIdentifier(id), args[0])
+	require.Equal(t, supervisortypes.LogToMessagePayload(sent), args[1])
+
+	_, err = RelayMessageCalldata(&types.Log{Address: predeploys.L2toL2CrossDomainMessengerAddr}, 1000, eth.ChainIDFromUInt64(900))
+	require.ErrorIs(t, err, ErrNotSentMessage)
+}
+
+func TestExecutingMessageOfCall(t *testing.T) {
+	sent := eventLog(t, predeploys.L2toL2CrossDomainMessengerAddr, L2ToL2CrossDomainMessengerMetaData, "SentMessage",
+		big.NewInt(901), common.Address{0xaa}, big.NewInt(5), common.Address{0xbb}, []byte("hello"))
+	expected := MessageFromLog(sent, 1000, eth.ChainIDFromUInt64(900))
+
+	t.Run("RelayMessage", func(t *testing.T) {
+		calldata, err := RelayMessageCalldata(sent, 1000, eth.ChainIDFromUInt64(900))
+		require.NoError(t, err)
+		msg, err := ExecutingMessageOfCall(&predeploys.L2toL2CrossDomainMessengerAddr, calldata)
+		require.NoError(t, err)
+		require.Equal(t, &expected, msg)
+	})
+	t.Run("ValidateMessage", func(t *testing.T) {
+		inboxABI, err := CrossL2InboxMetaData.GetAbi()
+		require.NoError(t, err)
+		calldata, err := inboxABI.Pack("validateMessage", NewIdentifier(expected.Identifier), expected.PayloadHash)
+		require.NoError(t, err)
+		msg, err := ExecutingMessageOfCall(&predeploys.CrossL2InboxAddr, calldata)
+		require.NoError(t, err)
+		require.Equal(t, &expected, msg)
+
+		id := NewIdentifier(expected.Identifier)
+		id.LogIndex = new(big.Int).Lsh(big.NewInt(1), 32)
+		calldata, err = inboxABI.Pack("validateMessage", id, expected.PayloadHash)
+		require.NoError(t, err)
+		_, err = ExecutingMessageOfCall(&predeploys.CrossL2InboxAddr, calldata)
+		require.ErrorIs(t, err, ErrInvalidIdentifier)
+	})
+	t.Run("OtherCalls", func(t *testing.T) {
+		msg, err := ExecutingMessageOfCall(nil, []byte{0x01, 0x02, 0x03, 0x04})
+		require.NoError(t, err)
+		require.Nil(t, msg)
+		msg, err = ExecutingMessageOfCall(&common.Address{0xaa}, []byte{0x01, 0x02, 0x03, 0x04})
+		require.NoError(t, err)
+		require.Nil(t, msg)
+		msg, err = ExecutingMessageOfCall(&predeploys.CrossL2InboxAddr, []byte{0x01, 0x02, 0x03, 0x04})
+		require.NoError(t, err)
+		require.Nil(t, msg)
+	})
+}
@@ -3,7 +3,6 @@ package rpc
 import (
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -15,6 +14,8 @@ import (
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/health"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
@@ -27,12 +28,14 @@ type Server struct {
 	apis           []rpc.API
 	appVersion     string
 	healthzHandler http.Handler
+	healthChecker  *health.Checker
 	corsHosts      []string
 	vHosts         []string
 	jwtSecret      []byte
 	wsEnabled      bool
 	rpcPath        string
 	healthzPath    string
+	readyzPath     string
 	httpRecorder   opmetrics.HTTPRecorder
 	httpServer     *http.Server
 	listener       net.Listener
@@ -85,7 +88,7 @@ func WithWebsocketEnabled() ServerOption {
 }
 
 // WithJWTSecret adds authentication to the RPCs (HTTP, and WS pre-upgrade if enabled).
-// The health endpoint is still available without authentication.
+// The health and readiness endpoints are still available without authentication.
 func WithJWTSecret(secret []byte) ServerOption {
 	return func(b *Server) {
 		b.jwtSecret = secret
@@ -104,6 +107,12 @@ func WithHealthzPath(path string) ServerOption {
 	}
 }
 
+func WithReadyzPath(path string) ServerOption {
+	return func(b *Server) {
+		b.readyzPath = path
+	}
+}
+
 func WithHTTPRecorder(recorder opmetrics.HTTPRecorder) ServerOption {
 	return func(b *Server) {
 		b.httpRecorder = recorder
@@ -137,11 +146,13 @@ func NewServer(host string, port int, appVersion string, opts ...ServerOption) *
 	bs := &Server{
 		endpoint:       endpoint,
 		appVersion:     appVersion,
-		healthzHandler: defaultHealthzHandler(appVersion),
+		healthzHandler: health.LivenessHandler(appVersion),
+		healthChecker:  health.NewChecker(appVersion, clock.SystemClock),
 		corsHosts:      wildcardHosts,
 		vHosts:         wildcardHosts,
 		rpcPath:        "/",
 		healthzPath:    "/healthz",
+		readyzPath:     "/readyz",
 		httpRecorder:   opmetrics.NoopHTTPRecorder,
 		httpServer: &http.Server{
 			Addr: endpoint,
@@ -173,6 +184,11 @@ func (b *Server) Endpoint() string {
 	return b.listener.Addr().String()
 }
 
+// HealthChecker returns the checker of the readiness endpoint, to register the dependency probes of the service with.
+func (b *Server) HealthChecker() *health.Checker {
+	return b.healthChecker
+}
+
 func (b *Server) AddAPI(api rpc.API) {
 	b.apis = append(b.apis, api)
 }
@@ -265,6 +281,10 @@ func (b *Server) newHealthMiddleware(next http.Handler) http.Handler {
 			b.healthzHandler.ServeHTTP(w, r)
 			return
 		}
+		if r.URL.Path == b.readyzPath {
+			b.healthChecker.ReadinessHandler().ServeHTTP(w, r)
+			return
+		}
 		next.ServeHTTP(w, r)
 	})
 }
@@ -313,17 +333,7 @@ func (b *Server) Stop() error {
 	return nil
 }
 
-type HealthzResponse struct {
-	Version string `json:"version"`
-}
-
-func defaultHealthzHandler(appVersion string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		enc := json.NewEncoder(w)
-		_ = enc.Encode(&HealthzResponse{Version: appVersion})
-	}
-}
+type HealthzResponse = health.LivenessResponse
 
 type healthzAPI struct {
 	appVersion string
@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -15,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/ethereum-optimism/optimism/op-service/health"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
@@ -56,6 +59,30 @@ func TestBaseServer(t *testing.T) {
 		require.EqualValues(t, fmt.Sprintf("{\"version\":\"%s\"}\n", appVersion), string(body))
 	})
 
+	t.Run("supports GET /readyz", func(t *testing.T) {
+		var probeErr error
+		server.HealthChecker().Register("test", func(ctx context.Context) error {
+			return probeErr
+		}, health.WithCacheTTL(0))
+		readyz := func() *http.Response {
+			res, err := http.Get(fmt.Sprintf("http://%s/readyz", server.endpoint))
+			require.NoError(t, err)
+			return res
+		}
+		res := readyz()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, res.Body.Close())
+
+		probeErr = errors.New("test dependency down")
+		res = readyz()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		var report health.Report
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&report))
+		require.False(t, report.Ready)
+		require.Equal(t, "test dependency down", report.Checks["test"].Error)
+	})
+
 	t.Run("supports health_status", func(t *testing.T) {
 		var res string
 		require.NoError(t, rpcClient.Call(&res, "health_status"))
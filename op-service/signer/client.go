@@ -2,16 +2,12 @@ package signer
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"math/big"
 	"net/http"
-	"os"
 	"time"
 
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
-	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -29,35 +25,13 @@ func NewSignerClient(logger log.Logger, endpoint string, headers http.Header, tl
 	var httpClient *http.Client
 	if tlsConfig.Enabled {
 		logger.Info("tlsConfig specified, loading tls config")
-		caCert, err := os.ReadFile(tlsConfig.TLSCaCert)
+		// the client certificate is reloaded when updated on disk
+		clientTLS, err := optls.NewClientConfig(logger, tlsConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read tls.ca: %w", err)
-		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-
-		// certman watches for newer client certifictes and automatically reloads them
-		cm, err := certman.New(logger, tlsConfig.TLSCert, tlsConfig.TLSKey)
-		if err != nil {
-			logger.Error("failed to read tls cert or key", "err", err)
+			logger.Error("failed to load tls config", "err", err)
 			return nil, err
 		}
-		if err := cm.Watch(); err != nil {
-			logger.Error("failed to start certman watcher", "err", err)
-			return nil, err
-		}
-
-		httpClient = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					RootCAs:    caCertPool,
-					GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-						return cm.GetCertificate(nil)
-					},
-				},
-			},
-		}
+		httpClient = optls.NewHTTPClient(clientTLS.Config)
 	} else {
 		logger.Info("no tlsConfig specified, using default http client")
 		httpClient = http.DefaultClient
@@ -129,3 +103,15 @@ func (s *SignerClient) SignBlockPayload(ctx context.Context, args *BlockPayloadA
 
 	return signature, nil
 }
+
+// SignMessage signs the message with the EIP-191 personal message prefix, using eth_sign.
+func (s *SignerClient) SignMessage(ctx context.Context, from common.Address, message []byte) ([65]byte, error) {
+	var result hexutil.Bytes
+	if err := s.client.CallContext(ctx, &result, "eth_sign", from, hexutil.Bytes(message)); err != nil {
+		return [65]byte{}, fmt.Errorf("eth_sign failed: %w", err)
+	}
+	if len(result) != 65 {
+		return [65]byte{}, fmt.Errorf("invalid signature: %s", result.String())
+	}
+	return [65]byte(result), nil
+}
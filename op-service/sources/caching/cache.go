@@ -1,21 +1,63 @@
 package caching
 
-import lru "github.com/hashicorp/golang-lru/v2"
+import (
+	"fmt"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
 
 type Metrics interface {
 	CacheAdd(label string, cacheSize int, evicted bool)
 	CacheGet(label string, hit bool)
 }
 
+// ResizeMetrics is optionally implemented by Metrics, to track the capacity of caches,
+// and the items evicted when a cache is resized.
+type ResizeMetrics interface {
+	CacheResize(label string, cacheSize int, capacity int, evicted int)
+}
+
+// Stats are the current size and capacity of a cache, and the hits, misses and evictions since it was created.
+type Stats struct {
+	Label     string `json:"label"`
+	Size      int    `json:"size"`
+	Capacity  int    `json:"capacity"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+}
+
+// ResizableCache is a cache of which the stats can be inspected, and the capacity changed at runtime.
+type ResizableCache interface {
+	Label() string
+	Stats() Stats
+	// Resize changes the capacity of the cache, evicting the least recently used items if it shrinks.
+	// It returns the number of evicted items.
+	Resize(capacity int) (evicted int, err error)
+}
+
 // LRUCache wraps hashicorp *lru.Cache and tracks cache metrics
 type LRUCache[K comparable, V any] struct {
 	m     Metrics
 	label string
 	inner *lru.Cache[K, V]
+
+	capacity  atomic.Int64
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
 }
 
+var _ ResizableCache = (*LRUCache[int, int])(nil)
+
 func (c *LRUCache[K, V]) Get(key K) (value V, ok bool) {
 	value, ok = c.inner.Get(key)
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
 	if c.m != nil {
 		c.m.CacheGet(c.label, ok)
 	}
@@ -24,20 +66,56 @@ func (c *LRUCache[K, V]) Get(key K) (value V, ok bool) {
 
 func (c *LRUCache[K, V]) Add(key K, value V) (evicted bool) {
 	evicted = c.inner.Add(key, value)
+	if evicted {
+		c.evictions.Add(1)
+	}
 	if c.m != nil {
 		c.m.CacheAdd(c.label, c.inner.Len(), evicted)
 	}
 	return evicted
 }
 
+func (c *LRUCache[K, V]) Label() string {
+	return c.label
+}
+
+func (c *LRUCache[K, V]) Stats() Stats {
+	return Stats{
+		Label:     c.label,
+		Size:      c.inner.Len(),
+		Capacity:  int(c.capacity.Load()),
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+func (c *LRUCache[K, V]) Resize(capacity int) (int, error) {
+	if capacity <= 0 {
+		return 0, fmt.Errorf("invalid capacity %d for %s cache: must be positive", capacity, c.label)
+	}
+	evicted := c.inner.Resize(capacity)
+	c.capacity.Store(int64(capacity))
+	c.evictions.Add(uint64(evicted))
+	if m, ok := c.m.(ResizeMetrics); ok {
+		m.CacheResize(c.label, c.inner.Len(), capacity, evicted)
+	}
+	return evicted, nil
+}
+
 // NewLRUCache creates a LRU cache with the given metrics, labeling the cache adds/gets.
 // Metrics are optional: no metrics will be tracked if m == nil.
 func NewLRUCache[K comparable, V any](m Metrics, label string, maxSize int) *LRUCache[K, V] {
 	// no errors if the size is positive
 	cache, _ := lru.New[K, V](maxSize)
-	return &LRUCache[K, V]{
+	c := &LRUCache[K, V]{
 		m:     m,
 		label: label,
 		inner: cache,
 	}
+	c.capacity.Store(int64(maxSize))
+	if m, ok := m.(ResizeMetrics); ok {
+		m.CacheResize(label, 0, maxSize, 0)
+	}
+	return c
 }
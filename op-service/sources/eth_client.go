@@ -147,6 +147,15 @@ func NewEthClient(client client.RPC, log log.Logger, metrics caching.Metrics, co
 	}, nil
 }
 
+// Caches returns the caches of the client, to inspect and resize them at runtime.
+func (s *EthClient) Caches() []caching.ResizableCache {
+	caches := []caching.ResizableCache{s.headersCache, s.transactionsCache, s.payloadsCache, s.blockRefsCache}
+	if p, ok := s.recProvider.(*CachingReceiptsProvider); ok {
+		caches = append(caches, p.cache)
+	}
+	return caches
+}
+
 // SubscribeNewHead subscribes to notifications about the current blockchain head on the given channel.
 func (s *EthClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
 	// Note that *types.Header does not cache the block hash unlike *HeaderInfo, it always recomputes.
@@ -13,10 +13,12 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 )
 
 const (
@@ -26,8 +28,21 @@ const (
 	sidecarsMethodPrefix = "eth/v1/beacon/blob_sidecars/"
 )
 
+// DefaultBlobSidecarsCacheSize is the number of blob sidecars cached by default, 12 MiB worth of blobs.
+const DefaultBlobSidecarsCacheSize = 96
+
 type L1BeaconClientConfig struct {
 	FetchAllSidecars bool
+	// BlobSidecarsCacheSize is the number of blob sidecars to cache. Caching is disabled if zero.
+	BlobSidecarsCacheSize int
+	// CacheMetrics are the optional metrics of the blob sidecars cache.
+	CacheMetrics caching.Metrics
+}
+
+// blobSidecarKey identifies a blob sidecar by the L1 block it was confirmed in.
+type blobSidecarKey struct {
+	block common.Hash
+	hash  eth.IndexedBlobHash
 }
 
 // L1BeaconClient is a high level golang client for the Beacon API.
@@ -36,6 +51,9 @@ type L1BeaconClient struct {
 	pool *ClientPool[BlobSideCarsFetcher]
 	cfg  L1BeaconClientConfig
 
+	// sidecarsCache is nil if caching is disabled
+	sidecarsCache *caching.LRUCache[blobSidecarKey, *eth.BlobSidecar]
+
 	initLock     sync.Mutex
 	timeToSlotFn TimeToSlotFn
 }
@@ -177,13 +195,26 @@ func (p *ClientPool[T]) MoveToNext() {
 // the `cl` and the fallbacks whenever a client runs into an error while fetching blobs.
 func NewL1BeaconClient(cl BeaconClient, cfg L1BeaconClientConfig, fallbacks ...BlobSideCarsFetcher) *L1BeaconClient {
 	cs := append([]BlobSideCarsFetcher{cl}, fallbacks...)
+	var sidecarsCache *caching.LRUCache[blobSidecarKey, *eth.BlobSidecar]
+	if cfg.BlobSidecarsCacheSize > 0 {
+		sidecarsCache = caching.NewLRUCache[blobSidecarKey, *eth.BlobSidecar](cfg.CacheMetrics, "blobs", cfg.BlobSidecarsCacheSize)
+	}
 	return &L1BeaconClient{
-		cl:   cl,
-		pool: NewClientPool(cs...),
-		cfg:  cfg,
+		cl:            cl,
+		pool:          NewClientPool(cs...),
+		cfg:           cfg,
+		sidecarsCache: sidecarsCache,
 	}
 }
 
+// Caches returns the caches of the client, to inspect and resize them at runtime.
+func (cl *L1BeaconClient) Caches() []caching.ResizableCache {
+	if cl.sidecarsCache == nil {
+		return nil
+	}
+	return []caching.ResizableCache{cl.sidecarsCache}
+}
+
 type TimeToSlotFn func(timestamp uint64) (uint64, error)
 
 // GetTimeToSlotFn returns a function that converts a timestamp to a slot number.
@@ -241,6 +272,9 @@ func (cl *L1BeaconClient) GetBlobSidecars(ctx context.Context, ref eth.L1BlockRe
 	if len(hashes) == 0 {
 		return []*eth.BlobSidecar{}, nil
 	}
+	if cached, ok := cl.cachedBlobSidecars(ref, hashes); ok {
+		return cached, nil
+	}
 	slotFn, err := cl.GetTimeToSlotFn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get time to slot function: %w", err)
@@ -271,13 +305,34 @@ func (cl *L1BeaconClient) GetBlobSidecars(ctx context.Context, ref eth.L1BlockRe
 	}
 
 	bscs := make([]*eth.BlobSidecar, 0, len(hashes))
-	for _, apisc := range apiscs {
-		bscs = append(bscs, apisc.BlobSidecar())
+	for i, apisc := range apiscs {
+		bsc := apisc.BlobSidecar()
+		if cl.sidecarsCache != nil {
+			cl.sidecarsCache.Add(blobSidecarKey{block: ref.Hash, hash: hashes[i]}, bsc)
+		}
+		bscs = append(bscs, bsc)
 	}
 
 	return bscs, nil
 }
 
+// cachedBlobSidecars returns the blob sidecars of the given block from the cache,
+// if all of them are cached.
+func (cl *L1BeaconClient) cachedBlobSidecars(ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.BlobSidecar, bool) {
+	if cl.sidecarsCache == nil {
+		return nil, false
+	}
+	bscs := make([]*eth.BlobSidecar, 0, len(hashes))
+	for _, h := range hashes {
+		bsc, ok := cl.sidecarsCache.Get(blobSidecarKey{block: ref.Hash, hash: h})
+		if !ok {
+			return nil, false
+		}
+		bscs = append(bscs, bsc)
+	}
+	return bscs, true
+}
+
 // GetBlobs fetches blobs that were confirmed in the specified L1 block with the given indexed
 // hashes. The order of the returned blobs will match the order of `hashes`.  Confirms each
 // blob's validity by checking its proof against the commitment, and confirming the commitment
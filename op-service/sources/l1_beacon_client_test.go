@@ -16,6 +16,7 @@ import (
 	client_mocks "github.com/ethereum-optimism/optimism/op-service/client/mocks"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/mocks"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/stretchr/testify/require"
 )
@@ -183,6 +184,50 @@ func TestBeaconClientFallback(t *testing.T) {
 
 }
 
+func TestBeaconClientCache(t *testing.T) {
+	index0, sidecar0 := makeTestBlobSidecar(5)
+	index1, sidecar1 := makeTestBlobSidecar(7)
+	hashes := []eth.IndexedBlobHash{index0, index1}
+	sidecars := []*eth.BlobSidecar{sidecar0, sidecar1}
+
+	ctx := context.Background()
+	p := mocks.NewBeaconClient(t)
+	c := NewL1BeaconClient(p, L1BeaconClientConfig{BlobSidecarsCacheSize: 2})
+	p.EXPECT().BeaconGenesis(ctx).Return(eth.APIGenesisResponse{Data: eth.ReducedGenesisData{GenesisTime: 10}}, nil)
+	p.EXPECT().ConfigSpec(ctx).Return(eth.APIConfigResponse{Data: eth.ReducedConfigData{SecondsPerSlot: 2}}, nil)
+	p.EXPECT().BeaconBlobSideCars(ctx, false, uint64(1), hashes).Return(eth.APIGetBlobSidecarsResponse{Data: toAPISideCars(sidecars)}, nil).Once()
+
+	ref := eth.L1BlockRef{Hash: common.Hash{0xaa}, Time: 12}
+	resp, err := c.GetBlobSidecars(ctx, ref, hashes)
+	require.NoError(t, err)
+	require.Equal(t, sidecars, resp)
+
+	// Served from the cache, without fetching the sidecars again
+	resp, err = c.GetBlobSidecars(ctx, ref, hashes[1:])
+	require.NoError(t, err)
+	require.Equal(t, sidecars[1:], resp)
+
+	caches := c.Caches()
+	require.Len(t, caches, 1)
+	stats := caches[0].Stats()
+	require.Equal(t, "blobs", stats.Label)
+	require.Equal(t, 2, stats.Size)
+	require.Equal(t, 2, stats.Capacity)
+	require.EqualValues(t, 1, stats.Hits)
+	require.EqualValues(t, 1, stats.Misses)
+
+	evicted, err := caches[0].Resize(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, evicted)
+	stats = caches[0].Stats()
+	require.Equal(t, 1, stats.Size)
+	require.Equal(t, 1, stats.Capacity)
+	require.EqualValues(t, 1, stats.Evictions)
+
+	_, err = caches[0].Resize(0)
+	require.Error(t, err)
+}
+
 func TestBeaconHTTPClient(t *testing.T) {
 	c := client_mocks.NewHTTP(t)
 	b := NewBeaconHTTPClient(c)
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 )
 
 type RollupClient struct {
@@ -32,6 +33,12 @@ func (r *RollupClient) SafeHeadAtL1Block(ctx context.Context, blockNum uint64) (
 	return output, err
 }
 
+func (r *RollupClient) TraceDeposit(ctx context.Context, l1TxHash common.Hash) ([]eth.DepositTrace, error) {
+	var output []eth.DepositTrace
+	err := r.rpc.CallContext(ctx, &output, "optimism_traceDeposit", l1TxHash)
+	return output, err
+}
+
 func (r *RollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	var output *eth.SyncStatus
 	err := r.rpc.CallContext(ctx, &output, "optimism_syncStatus")
@@ -44,6 +51,14 @@ func (r *RollupClient) RollupConfig(ctx context.Context) (*rollup.Config, error)
 	return output, err
 }
 
+// ExportConfig returns the rollup config of the node in the superchain-registry chain config format,
+// encoded as "toml" or "json".
+func (r *RollupClient) ExportConfig(ctx context.Context, format string) (string, error) {
+	var output string
+	err := r.rpc.CallContext(ctx, &output, "optimism_exportConfig", format)
+	return output, err
+}
+
 func (r *RollupClient) Version(ctx context.Context) (string, error) {
 	var output string
 	err := r.rpc.CallContext(ctx, &output, "optimism_version")
@@ -66,6 +81,14 @@ func (r *RollupClient) SequencerActive(ctx context.Context) (bool, error) {
 	return result, err
 }
 
+func (r *RollupClient) PauseDerivation(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_pauseDerivation")
+}
+
+func (r *RollupClient) ResumeDerivation(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_resumeDerivation")
+}
+
 func (r *RollupClient) PostUnsafePayload(ctx context.Context, payload *eth.ExecutionPayloadEnvelope) error {
 	return r.rpc.CallContext(ctx, nil, "admin_postUnsafePayload", payload)
 }
@@ -84,6 +107,18 @@ func (r *RollupClient) SetLogLevel(ctx context.Context, lvl slog.Level) error {
 	return r.rpc.CallContext(ctx, nil, "admin_setLogLevel", lvl.String())
 }
 
+func (r *RollupClient) L1CacheStats(ctx context.Context) ([]caching.Stats, error) {
+	var result []caching.Stats
+	err := r.rpc.CallContext(ctx, &result, "admin_l1CacheStats")
+	return result, err
+}
+
+func (r *RollupClient) SetL1CacheSize(ctx context.Context, label string, capacity int) (caching.Stats, error) {
+	var result caching.Stats
+	err := r.rpc.CallContext(ctx, &result, "admin_setL1CacheSize", label, capacity)
+	return result, err
+}
+
 func (r *RollupClient) Close() {
 	r.rpc.Close()
 }
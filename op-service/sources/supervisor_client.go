@@ -164,6 +164,35 @@ func (cl *SupervisorClient) SuperRootAtTimestamp(ctx context.Context, timestamp
 	return result, err
 }
 
+func (cl *SupervisorClient) SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error) {
+	var result *eth.SuperRootProof
+	err := cl.client.CallContext(
+		ctx,
+		&result,
+		"supervisor_superRootProofAtTimestamp",
+		chainID,
+		timestamp)
+	return result, err
+}
+
+func (cl *SupervisorClient) FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error) {
+	var result eth.SuperRootResponse
+	err := cl.client.CallContext(
+		ctx,
+		&result,
+		"supervisor_finalizedSuperRoot")
+	return result, err
+}
+
+func (cl *SupervisorClient) MessageStats(ctx context.Context) (types.MessageStats, error) {
+	var result types.MessageStats
+	err := cl.client.CallContext(
+		ctx,
+		&result,
+		"supervisor_messageStats")
+	return result, err
+}
+
 func (cl *SupervisorClient) Close() {
 	cl.client.Close()
 }
@@ -3,9 +3,19 @@ package testutils
 import "github.com/ethereum/go-ethereum/common"
 
 type MockRuntimeConfig struct {
-	P2PSeqAddress common.Address
+	P2PSeqAddress      common.Address
+	MaxGossipSize      uint64
+	MaxBlocksPerHeight uint64
 }
 
 func (m *MockRuntimeConfig) P2PSequencerAddress() common.Address {
 	return m.P2PSeqAddress
 }
+
+func (m *MockRuntimeConfig) GossipMaxSize() uint64 {
+	return m.MaxGossipSize
+}
+
+func (m *MockRuntimeConfig) GossipMaxBlocksPerHeight() uint64 {
+	return m.MaxBlocksPerHeight
+}
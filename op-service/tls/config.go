@@ -0,0 +1,104 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
+)
+
+// Config is a TLS config for mutual TLS, with a certificate that is reloaded when the files change.
+// Stop must be called to stop watching the certificate files.
+type Config struct {
+	*tls.Config
+	cm *certman.CertMan
+}
+
+// Stop stops watching the certificate and key files for changes.
+func (c *Config) Stop() {
+	c.cm.Stop()
+}
+
+// NewServerConfig creates a TLS config for a server, which requires clients to present
+// a certificate signed by the CA bundle (mutual TLS).
+// The server certificate is reloaded when the certificate or key files change.
+func NewServerConfig(logger log.Logger, cfg CLIConfig) (*Config, error) {
+	caPool, cm, err := loadMutualTLS(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		Config: &tls.Config{
+			MinVersion:     tls.VersionTLS13,
+			ClientCAs:      caPool,
+			ClientAuth:     tls.RequireAndVerifyClientCert,
+			GetCertificate: cm.GetCertificate,
+		},
+		cm: cm,
+	}, nil
+}
+
+// NewClientConfig creates a TLS config for a client, which presents its certificate to the server,
+// and only trusts servers with a certificate signed by the CA bundle.
+// The client certificate is reloaded when the certificate or key files change.
+func NewClientConfig(logger log.Logger, cfg CLIConfig) (*Config, error) {
+	caPool, cm, err := loadMutualTLS(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		Config: &tls.Config{
+			MinVersion:           tls.VersionTLS13,
+			RootCAs:              caPool,
+			GetClientCertificate: cm.GetClientCertificate,
+		},
+		cm: cm,
+	}, nil
+}
+
+// NewHTTPClient creates an HTTP client that uses the given TLS config for all connections.
+func NewHTTPClient(cfg *tls.Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = cfg
+	return &http.Client{Transport: transport}
+}
+
+func loadMutualTLS(logger log.Logger, cfg CLIConfig) (*x509.CertPool, *certman.CertMan, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, nil, err
+	}
+	if !cfg.TLSEnabled() {
+		return nil, nil, errors.New("tls is not enabled")
+	}
+	caPool, err := loadCAPool(cfg.TLSCaCert)
+	if err != nil {
+		return nil, nil, err
+	}
+	// certman watches for newer certificates and automatically reloads them
+	cm, err := certman.New(logger, cfg.TLSCert, cfg.TLSKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read tls cert or key: %w", err)
+	}
+	if err := cm.Watch(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start certman watcher: %w", err)
+	}
+	return caPool, cm, nil
+}
+
+func loadCAPool(path string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tls ca: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificates found in tls ca %q", path)
+	}
+	return caPool, nil
+}
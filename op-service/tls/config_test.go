@@ -0,0 +1,162 @@
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) writeCA(t *testing.T, dir string) string {
+	path := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+	return path
+}
+
+// writeCert writes a certificate signed by the CA, valid for both server and client authentication on localhost.
+func (ca *testCA) writeCert(t *testing.T, dir string, name string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certPath, keyPath
+}
+
+func TestMutualTLS(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	ca := newTestCA(t)
+	serverDir, clientDir := t.TempDir(), t.TempDir()
+	serverCert, serverKey := ca.writeCert(t, serverDir, "server")
+	clientCert, clientKey := ca.writeCert(t, clientDir, "client")
+
+	serverTLS, err := NewServerConfig(logger, CLIConfig{
+		TLSCaCert: ca.writeCA(t, serverDir),
+		TLSCert:   serverCert,
+		TLSKey:    serverKey,
+		Enabled:   true,
+	})
+	require.NoError(t, err)
+	defer serverTLS.Stop()
+
+	srv := httptest.NewUnstartedServer(NewPeerTLSMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(PeerTLSInfoFromContext(r.Context()).LeafCertificate.Subject.CommonName))
+	})))
+	// StartTLS would add its own certificate, which is served to clients that do not send a server name.
+	srv.Listener = tls.NewListener(srv.Listener, serverTLS.Config)
+	srv.Start()
+	defer srv.Close()
+	srvURL := "https://" + srv.Listener.Addr().String()
+
+	t.Run("AuthenticatedClient", func(t *testing.T) {
+		clientTLS, err := NewClientConfig(logger, CLIConfig{
+			TLSCaCert: ca.writeCA(t, clientDir),
+			TLSCert:   clientCert,
+			TLSKey:    clientKey,
+			Enabled:   true,
+		})
+		require.NoError(t, err)
+		defer clientTLS.Stop()
+
+		resp, err := NewHTTPClient(clientTLS.Config).Get(srvURL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, "client", string(body))
+	})
+
+	t.Run("UntrustedClient", func(t *testing.T) {
+		otherCA := newTestCA(t)
+		otherDir := t.TempDir()
+		otherCert, otherKey := otherCA.writeCert(t, otherDir, "other")
+		clientTLS, err := NewClientConfig(logger, CLIConfig{
+			TLSCaCert: ca.writeCA(t, otherDir),
+			TLSCert:   otherCert,
+			TLSKey:    otherKey,
+			Enabled:   true,
+		})
+		require.NoError(t, err)
+		defer clientTLS.Stop()
+
+		_, err = NewHTTPClient(clientTLS.Config).Get(srvURL)
+		require.Error(t, err)
+	})
+
+	t.Run("NoClientCert", func(t *testing.T) {
+		pool := x509.NewCertPool()
+		pool.AddCert(ca.cert)
+		client := NewHTTPClient(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS13})
+		_, err := client.Get(srvURL)
+		require.Error(t, err)
+	})
+}
+
+func TestMutualTLSInvalidCA(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caPath, []byte("not a certificate"), 0o600))
+	_, err := NewServerConfig(logger, CLIConfig{
+		TLSCaCert: caPath,
+		TLSCert:   filepath.Join(dir, "tls.crt"),
+		TLSKey:    filepath.Join(dir, "tls.key"),
+		Enabled:   true,
+	})
+	require.ErrorContains(t, err, "no certificates found")
+}
@@ -0,0 +1,166 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ErrBlobTxCanceled is returned by Send if a stuck blob transaction was canceled because its data became obsolete.
+var ErrBlobTxCanceled = errors.New("blob transaction canceled")
+
+// blobTxCancellation tracks the cancellation of a stuck blob transaction, whose data is no longer needed.
+//
+// The blob transaction is replaced by a minimal non-blob transaction of the same nonce, to unblock the nonce.
+// Transaction pools that never replace blob transactions with non-blob transactions, like geth's, reject it as
+// already reserved, in which case the blob transaction is replaced by a transaction with a single empty blob instead.
+type blobTxCancellation struct {
+	obsolete func() bool
+	timeout  time.Duration
+
+	// deadline is the time after which the blob transaction is stuck, once it was first published.
+	deadline time.Time
+	// stuck is the blob transaction that is replaced, nil until it is canceled.
+	stuck *types.Transaction
+	// asBlob is set if the cancellation is a blob transaction.
+	asBlob bool
+	// txs are the hashes of all cancellation transactions, including fee bumped ones.
+	txs map[common.Hash]struct{}
+}
+
+// newBlobTxCancellation returns the cancellation tracker of the transaction,
+// or nil if the transaction is not a cancelable blob transaction.
+func newBlobTxCancellation(tx *types.Transaction, obsolete func() bool, timeout time.Duration) *blobTxCancellation {
+	if tx.Type() != types.BlobTxType || obsolete == nil || timeout == 0 {
+		return nil
+	}
+	return &blobTxCancellation{
+		obsolete: obsolete,
+		timeout:  timeout,
+		txs:      make(map[common.Hash]struct{}),
+	}
+}
+
+// published records the publication of tx, which is either the blob transaction or its cancellation.
+func (c *blobTxCancellation) published(tx *types.Transaction, now time.Time) {
+	if c.deadline.IsZero() {
+		c.deadline = now.Add(c.timeout)
+	}
+	if c.stuck != nil {
+		c.txs[tx.Hash()] = struct{}{}
+	}
+}
+
+// isCancellation returns whether the transaction with the given hash is a cancellation transaction.
+func (c *blobTxCancellation) isCancellation(txHash common.Hash) bool {
+	_, ok := c.txs[txHash]
+	return ok
+}
+
+// checkBlobTxCancellation returns the transaction to publish next: tx, or the transaction replacing it if tx is a blob
+// transaction that is stuck beyond the cancellation timeout and whose data is obsolete.
+func (m *SimpleTxManager) checkBlobTxCancellation(ctx context.Context, c *blobTxCancellation, tx *types.Transaction, sendState *SendState) *types.Transaction {
+	if c.stuck != nil || c.deadline.IsZero() || time.Now().Before(c.deadline) || !c.obsolete() {
+		return tx
+	}
+	l := m.txLogger(tx, true)
+	cancelTx, err := m.cancellationTx(ctx, tx, false)
+	if err != nil {
+		l.Warn("Failed to create cancellation of stuck blob transaction, will retry", "err", err)
+		return tx
+	}
+	l.Warn("Canceling stuck blob transaction, its data is obsolete", "cancellation", cancelTx.Hash())
+	c.stuck = tx
+	// The cancellation already pays the fees required to replace the blob transaction
+	sendState.bumpFees = false
+	return cancelTx
+}
+
+// fallbackBlobTxCancellation returns the cancellation with a single empty blob, if the transaction pool rejected
+// the non-blob cancellation as already reserved by the blob transaction. Otherwise tx is returned.
+func (m *SimpleTxManager) fallbackBlobTxCancellation(ctx context.Context, c *blobTxCancellation, tx *types.Transaction, sendState *SendState) *types.Transaction {
+	if c.stuck == nil || c.asBlob || !sendState.takeAlreadyReserved() {
+		return tx
+	}
+	l := m.txLogger(c.stuck, true)
+	cancelTx, err := m.cancellationTx(ctx, c.stuck, true)
+	if err != nil {
+		l.Warn("Failed to create blob cancellation of stuck blob transaction, will retry", "err", err)
+		return tx
+	}
+	l.Warn("Transaction pool does not replace blob transactions with non-blob transactions, canceling with an empty blob",
+		"cancellation", cancelTx.Hash())
+	c.asBlob = true
+	sendState.bumpFees = false
+	return cancelTx
+}
+
+// cancellationTx returns a minimal transaction with the nonce of the blob transaction: an empty transaction to the same
+// recipient, with a single empty blob if asBlob is set.
+// Its fees are at least the suggested fees, and at least double the fees of the blob transaction,
+// as required to replace a blob transaction in the transaction pool.
+func (m *SimpleTxManager) cancellationTx(ctx context.Context, tx *types.Transaction, asBlob bool) (*types.Transaction, error) {
+	tip, baseFee, blobBaseFee, err := m.SuggestGasPriceCaps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggested gas tip and base fee: %w", err)
+	}
+	bumpedTip := bigMax(calcThresholdValue(tx.GasTipCap(), true), tip)
+	bumpedFee := bigMax(calcThresholdValue(tx.GasFeeCap(), true), calcGasFeeCap(baseFee, tip))
+	if err := m.checkLimits(tip, baseFee, bumpedTip, bumpedFee); err != nil {
+		return nil, err
+	}
+
+	var message types.TxData
+	if asBlob {
+		if blobBaseFee == nil {
+			return nil, errors.New("expected non-nil blobBaseFee")
+		}
+		bumpedBlobFee := bigMax(calcThresholdValue(tx.BlobGasFeeCap(), true), m.calcBlobFeeCap(blobBaseFee))
+		if err := m.checkBlobFeeLimits(blobBaseFee, bumpedBlobFee); err != nil {
+			return nil, err
+		}
+		sidecar, blobHashes, err := MakeSidecar([]*eth.Blob{{}})
+		if err != nil {
+			return nil, err
+		}
+		blobTx := &types.BlobTx{
+			Nonce:      tx.Nonce(),
+			To:         *tx.To(),
+			Gas:        params.TxGas,
+			BlobHashes: blobHashes,
+			Sidecar:    sidecar,
+		}
+		if err := finishBlobTx(blobTx, tx.ChainId(), bumpedTip, bumpedFee, bumpedBlobFee, new(big.Int)); err != nil {
+			return nil, err
+		}
+		message = blobTx
+	} else {
+		message = &types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			To:        tx.To(),
+			GasTipCap: bumpedTip,
+			GasFeeCap: bumpedFee,
+			Gas:       params.TxGas,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	return m.cfg.Signer(ctx, m.cfg.From, types.NewTx(message))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
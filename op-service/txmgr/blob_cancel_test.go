@@ -0,0 +1,111 @@
+package txmgr
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newBlobCancelHarness(t *testing.T) *testHarness {
+	cfg := configWithNumConfs(1)
+	cfg.ResubmissionTimeout.Store(int64(50 * time.Millisecond))
+	cfg.BlobTxCancelTimeout = time.Millisecond
+	return newTestHarnessWithConfig(t, cfg)
+}
+
+func TestBlobTxCancellation(t *testing.T) {
+	t.Parallel()
+
+	obsolete := func() bool { return true }
+
+	t.Run("NonBlobReplacement", func(t *testing.T) {
+		h := newBlobCancelHarness(t)
+		var mu sync.Mutex
+		var stuck, canceled *types.Transaction
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if tx.Type() == types.BlobTxType {
+				// The blob tx never gets mined
+				stuck = tx
+				return nil
+			}
+			canceled = tx
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap(), nil)
+			return nil
+		})
+		candidate := h.createBlobTxCandidate()
+		candidate.Obsolete = obsolete
+		_, err := h.mgr.Send(context.Background(), candidate)
+		require.ErrorIs(t, err, ErrBlobTxCanceled)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, stuck.Nonce(), canceled.Nonce())
+		require.Empty(t, canceled.Data())
+		require.Equal(t, stuck.To(), canceled.To())
+		// Replacing a blob tx requires a 100% fee bump
+		require.GreaterOrEqual(t, canceled.GasTipCap().Cmp(calcThresholdValue(stuck.GasTipCap(), true)), 0)
+		require.GreaterOrEqual(t, canceled.GasFeeCap().Cmp(calcThresholdValue(stuck.GasFeeCap(), true)), 0)
+	})
+
+	t.Run("BlobReplacementIfReserved", func(t *testing.T) {
+		h := newBlobCancelHarness(t)
+		var mu sync.Mutex
+		var stuck, canceled *types.Transaction
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case tx.Type() != types.BlobTxType:
+				// Like geth, refuse to replace a blob tx with a non-blob tx
+				return txpool.ErrAlreadyReserved
+			case len(tx.Data()) > 0:
+				stuck = tx
+				return nil
+			default:
+				canceled = tx
+				txHash := tx.Hash()
+				h.backend.mine(&txHash, tx.GasFeeCap(), tx.BlobGasFeeCap())
+				return nil
+			}
+		})
+		candidate := h.createBlobTxCandidate()
+		candidate.Obsolete = obsolete
+		_, err := h.mgr.Send(context.Background(), candidate)
+		require.ErrorIs(t, err, ErrBlobTxCanceled)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, stuck.Nonce(), canceled.Nonce())
+		require.Len(t, canceled.BlobHashes(), 1)
+		require.GreaterOrEqual(t, canceled.GasFeeCap().Cmp(calcThresholdValue(stuck.GasFeeCap(), true)), 0)
+		require.GreaterOrEqual(t, canceled.BlobGasFeeCap().Cmp(calcThresholdValue(stuck.BlobGasFeeCap(), true)), 0)
+	})
+
+	t.Run("KeepNeededData", func(t *testing.T) {
+		h := newBlobCancelHarness(t)
+		published := 0
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			require.Equal(t, types.BlobTxType, int(tx.Type()))
+			published++
+			if published == 5 {
+				txHash := tx.Hash()
+				h.backend.mine(&txHash, tx.GasFeeCap(), tx.BlobGasFeeCap())
+			}
+			return nil
+		})
+		candidate := h.createBlobTxCandidate()
+		candidate.Obsolete = func() bool { return false }
+		receipt, err := h.mgr.Send(context.Background(), candidate)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+	})
+}
@@ -38,6 +38,9 @@ const (
 	TxSendTimeoutFlagName             = "txmgr.send-timeout"
 	TxNotInMempoolTimeoutFlagName     = "txmgr.not-in-mempool-timeout"
 	ReceiptQueryIntervalFlagName      = "txmgr.receipt-query-interval"
+	BumpOnlyWhenStuckFlagName         = "txmgr.bump-only-when-stuck"
+	InclusionPercentileFlagName       = "txmgr.inclusion-percentile"
+	BlobTxCancelTimeoutFlagName       = "txmgr.blob-cancel-timeout"
 )
 
 var (
@@ -67,6 +70,7 @@ type DefaultFlagValues struct {
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
 	ReceiptQueryInterval      time.Duration
+	InclusionPercentile       uint64
 }
 
 var (
@@ -82,6 +86,7 @@ var (
 		TxSendTimeout:             0, // Try sending txs indefinitely, to preserve tx ordering for Holocene
 		TxNotInMempoolTimeout:     2 * time.Minute,
 		ReceiptQueryInterval:      12 * time.Second,
+		InclusionPercentile:       50,
 	}
 	DefaultChallengerFlagValues = DefaultFlagValues{
 		NumConfirmations:          uint64(3),
@@ -95,6 +100,7 @@ var (
 		TxSendTimeout:             2 * time.Minute,
 		TxNotInMempoolTimeout:     1 * time.Minute,
 		ReceiptQueryInterval:      12 * time.Second,
+		InclusionPercentile:       50,
 	}
 
 	// geth enforces a 1 gwei minimum for blob tx fee
@@ -191,6 +197,23 @@ func CLIFlagsWithDefaults(envPrefix string, defaults DefaultFlagValues) []cli.Fl
 			Value:   defaults.ReceiptQueryInterval,
 			EnvVars: prefixEnvVars("TXMGR_RECEIPT_QUERY_INTERVAL"),
 		},
+		&cli.BoolFlag{
+			Name:    BumpOnlyWhenStuckFlagName,
+			Usage:   "Only bump the fees of a pending transaction when they fall below the fees estimated to be required for inclusion, instead of on every resubmission",
+			EnvVars: prefixEnvVars("TXMGR_BUMP_ONLY_WHEN_STUCK"),
+		},
+		&cli.Uint64Flag{
+			Name:    InclusionPercentileFlagName,
+			Usage:   "The percentile of the priority fees paid in recent blocks a pending transaction must match to not be considered stuck. Only used with --" + BumpOnlyWhenStuckFlagName,
+			Value:   defaults.InclusionPercentile,
+			EnvVars: prefixEnvVars("TXMGR_INCLUSION_PERCENTILE"),
+		},
+		&cli.DurationFlag{
+			Name: BlobTxCancelTimeoutFlagName,
+			Usage: "Time after which a pending blob transaction whose data is no longer needed, e.g. because its channel timed out, " +
+				"is canceled by replacing it with a minimal transaction of the same nonce. If 0 it is disabled.",
+			EnvVars: prefixEnvVars("TXMGR_BLOB_CANCEL_TIMEOUT"),
+		},
 	}, opsigner.CLIFlags(envPrefix, "")...)
 }
 
@@ -213,6 +236,9 @@ type CLIConfig struct {
 	NetworkTimeout            time.Duration
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
+	BumpOnlyWhenStuck         bool
+	InclusionPercentile       uint64
+	BlobTxCancelTimeout       time.Duration
 }
 
 func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
@@ -229,6 +255,7 @@ func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
 		TxSendTimeout:             defaults.TxSendTimeout,
 		TxNotInMempoolTimeout:     defaults.TxNotInMempoolTimeout,
 		ReceiptQueryInterval:      defaults.ReceiptQueryInterval,
+		InclusionPercentile:       defaults.InclusionPercentile,
 		SignerCLIConfig:           opsigner.NewCLIConfig(),
 	}
 }
@@ -262,6 +289,9 @@ func (m CLIConfig) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	if m.BumpOnlyWhenStuck && (m.InclusionPercentile == 0 || m.InclusionPercentile > 100) {
+		return fmt.Errorf("InclusionPercentile must be between 1 and 100, got %d", m.InclusionPercentile)
+	}
 	if err := m.SignerCLIConfig.Check(); err != nil {
 		return err
 	}
@@ -288,6 +318,9 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		NetworkTimeout:            ctx.Duration(NetworkTimeoutFlagName),
 		TxSendTimeout:             ctx.Duration(TxSendTimeoutFlagName),
 		TxNotInMempoolTimeout:     ctx.Duration(TxNotInMempoolTimeoutFlagName),
+		BumpOnlyWhenStuck:         ctx.Bool(BumpOnlyWhenStuckFlagName),
+		InclusionPercentile:       ctx.Uint64(InclusionPercentileFlagName),
+		BlobTxCancelTimeout:       ctx.Duration(BlobTxCancelTimeoutFlagName),
 	}
 }
 
@@ -347,6 +380,9 @@ func NewConfig(cfg CLIConfig, l log.Logger) (*Config, error) {
 		ReceiptQueryInterval:      cfg.ReceiptQueryInterval,
 		NumConfirmations:          cfg.NumConfirmations,
 		SafeAbortNonceTooLowCount: cfg.SafeAbortNonceTooLowCount,
+		BumpOnlyWhenStuck:         cfg.BumpOnlyWhenStuck,
+		InclusionPercentile:       cfg.InclusionPercentile,
+		BlobTxCancelTimeout:       cfg.BlobTxCancelTimeout,
 		Signer:                    signerFactory(chainID),
 		From:                      from,
 	}
@@ -415,6 +451,19 @@ type Config struct {
 	// confirmation.
 	SafeAbortNonceTooLowCount uint64
 
+	// BumpOnlyWhenStuck disables bumping the fees on every resubmission. Instead, the fees are only
+	// bumped when they fall below the fees estimated to be required for inclusion, which requires
+	// the Backend to implement ethereum.FeeHistoryReader.
+	BumpOnlyWhenStuck bool
+
+	// InclusionPercentile is the percentile of the priority fees paid in recent blocks that the tip
+	// of a pending transaction must match to not be considered stuck.
+	InclusionPercentile uint64
+
+	// BlobTxCancelTimeout is how long a published blob transaction may remain unconfirmed before it is canceled,
+	// if the TxCandidate reports that its data is obsolete. If 0, blob transactions are never canceled.
+	BlobTxCancelTimeout time.Duration
+
 	// Signer is used to sign transactions when the gas price is increased.
 	Signer opcrypto.SignerFn
 	From   common.Address
@@ -455,6 +504,9 @@ func (m *Config) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	if m.BumpOnlyWhenStuck && (m.InclusionPercentile == 0 || m.InclusionPercentile > 100) {
+		return fmt.Errorf("InclusionPercentile must be between 1 and 100, got %d", m.InclusionPercentile)
+	}
 	if m.Signer == nil {
 		return errors.New("must provide the Signer")
 	}
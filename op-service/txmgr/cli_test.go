@@ -36,6 +36,15 @@ func configForArgs(args ...string) CLIConfig {
 		config = ReadCLIConfig(ctx)
 		return nil
 	}
-	_ = app.Run(args)
+	_ = app.Run(append([]string{"program"}, args...))
 	return config
 }
+
+func TestInclusionPercentileRange(t *testing.T) {
+	cfg := configForArgs("--"+BumpOnlyWhenStuckFlagName, "--"+InclusionPercentileFlagName, "101")
+	require.True(t, cfg.BumpOnlyWhenStuck)
+	require.ErrorContains(t, cfg.Check(), "InclusionPercentile must be between 1 and 100")
+
+	cfg = configForArgs("--"+BumpOnlyWhenStuckFlagName, "--"+InclusionPercentileFlagName, "90")
+	require.NoError(t, cfg.Check())
+}
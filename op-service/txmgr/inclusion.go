@@ -0,0 +1,102 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// inclusionHistoryBlocks is the number of recent blocks used to estimate the tip required for inclusion.
+const inclusionHistoryBlocks = 10
+
+var ErrFeeHistoryUnsupported = errors.New("backend does not support fee history")
+
+// inclusionFees are the fees a transaction needs to pay to be included in the next block
+// with the configured inclusion probability.
+type inclusionFees struct {
+	baseFee     *big.Int
+	tip         *big.Int
+	blobBaseFee *big.Int
+}
+
+// estimateInclusionFees estimates the fees required for inclusion in the next block, based on the
+// tips paid at the configured percentile over the recent blocks and the base fee of the next block.
+func (m *SimpleTxManager) estimateInclusionFees(ctx context.Context) (*inclusionFees, error) {
+	reader, ok := m.backend.(ethereum.FeeHistoryReader)
+	if !ok {
+		return nil, ErrFeeHistoryUnsupported
+	}
+	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	history, err := reader.FeeHistory(cCtx, inclusionHistoryBlocks, nil, []float64{float64(m.cfg.InclusionPercentile)})
+	if err != nil {
+		m.metr.RPCError()
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 || len(history.Reward) == 0 {
+		return nil, errors.New("empty fee history")
+	}
+	tips := make([]*big.Int, 0, len(history.Reward))
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			tips = append(tips, rewards[0])
+		}
+	}
+	if len(tips) == 0 {
+		return nil, errors.New("fee history contains no rewards")
+	}
+	// Use the median of the per-block percentiles to smooth out single blocks with unusual tips.
+	slices.SortFunc(tips, func(a, b *big.Int) int {
+		return a.Cmp(b)
+	})
+	fees := &inclusionFees{
+		// The last base fee is the one of the next block.
+		baseFee: history.BaseFee[len(history.BaseFee)-1],
+		tip:     tips[len(tips)/2],
+	}
+
+	cCtx, cancel = context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	head, err := m.backend.HeaderByNumber(cCtx, nil)
+	if err != nil {
+		m.metr.RPCError()
+		return nil, fmt.Errorf("failed to get head header: %w", err)
+	}
+	if head.ExcessBlobGas != nil {
+		fees.blobBaseFee = eip4844.CalcBlobFee(*head.ExcessBlobGas)
+	}
+	return fees, nil
+}
+
+// isTxStuck returns whether the fees of the transaction fall below the fees estimated to be required
+// for inclusion, in which case a fee bump is needed to get the transaction included.
+func (m *SimpleTxManager) isTxStuck(ctx context.Context, tx *types.Transaction) (bool, error) {
+	fees, err := m.estimateInclusionFees(ctx)
+	if err != nil {
+		return false, err
+	}
+	l := m.txLogger(tx, true).With("inclusionBaseFee", fees.baseFee, "inclusionTip", fees.tip)
+	if tx.GasFeeCap().Cmp(fees.baseFee) < 0 {
+		l.Debug("Transaction fee cap below next base fee")
+		return true, nil
+	}
+	effectiveTip := new(big.Int).Sub(tx.GasFeeCap(), fees.baseFee)
+	if tx.GasTipCap().Cmp(effectiveTip) < 0 {
+		effectiveTip = tx.GasTipCap()
+	}
+	if effectiveTip.Cmp(fees.tip) < 0 {
+		l.Debug("Transaction tip below inclusion percentile", "effectiveTip", effectiveTip)
+		return true, nil
+	}
+	if tx.Type() == types.BlobTxType && fees.blobBaseFee != nil && tx.BlobGasFeeCap().Cmp(fees.blobBaseFee) < 0 {
+		l.Debug("Transaction blob fee cap below blob base fee", "blobBaseFee", fees.blobBaseFee)
+		return true, nil
+	}
+	return false, nil
+}
@@ -137,6 +137,17 @@ func (s *SendState) CriticalError() error {
 	return nil
 }
 
+// takeAlreadyReserved returns whether an attempt to send the tx resulted in ErrAlreadyReserved, and forgets it,
+// because the tx is replaced by a tx of a compatible type.
+func (s *SendState) takeAlreadyReserved() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reserved := s.alreadyReserved
+	s.alreadyReserved = false
+	return reserved
+}
+
 // IsWaitingForConfirmation returns true if we have at least one confirmation on
 // one of our txs.
 func (s *SendState) IsWaitingForConfirmation() bool {
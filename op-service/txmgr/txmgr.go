@@ -227,6 +227,10 @@ type TxCandidate struct {
 	GasLimit uint64
 	// Value is the value to be used in the constructed tx.
 	Value *big.Int
+	// Obsolete optionally reports whether the data of a blob tx is no longer needed. A blob tx that is not confirmed
+	// within the BlobTxCancelTimeout after it was first published, and whose data is obsolete, is canceled by
+	// replacing it with a minimal tx of the same nonce. Send then returns ErrBlobTxCanceled.
+	Obsolete func() bool
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -260,7 +264,7 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 		m.resetNonce()
 		return nil, err
 	}
-	receipt, err := m.sendTx(ctx, tx)
+	receipt, err := m.sendTx(ctx, tx, candidate.Obsolete)
 	if err != nil {
 		m.resetNonce()
 		return nil, err
@@ -306,7 +310,7 @@ func (m *SimpleTxManager) SendAsync(ctx context.Context, candidate TxCandidate,
 	go func() {
 		defer m.metr.RecordPendingTx(m.pending.Add(-1))
 		defer cancel()
-		receipt, err := m.sendTx(ctx, tx)
+		receipt, err := m.sendTx(ctx, tx, candidate.Obsolete)
 		if err != nil {
 			m.resetNonce()
 		}
@@ -553,7 +557,8 @@ func (m *SimpleTxManager) resetNonce() {
 
 // send submits the same transaction several times with increasing gas prices as necessary.
 // It waits for the transaction to be confirmed on chain.
-func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+// A blob transaction is canceled if it is stuck and obsolete reports that its data is no longer needed.
+func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction, obsolete func() bool) (*types.Receipt, error) {
 	var wg sync.WaitGroup
 	defer wg.Wait()
 	ctx, cancel := context.WithCancel(ctx)
@@ -564,6 +569,7 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 	resubmissionTimeout := m.GetBumpFeeRetryTime()
 	ticker := time.NewTicker(resubmissionTimeout)
 	defer ticker.Stop()
+	cancellation := newBlobTxCancellation(tx, obsolete, m.cfg.BlobTxCancelTimeout)
 
 	for {
 		if !sendState.IsWaitingForConfirmation() {
@@ -572,13 +578,21 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 				m.txLogger(tx, false).Warn("TxManager closed, aborting transaction submission")
 				return nil, ErrClosed
 			}
+			if cancellation != nil {
+				tx = m.checkBlobTxCancellation(ctx, cancellation, tx, sendState)
+			}
 			var published bool
 			if tx, published = m.publishTx(ctx, tx, sendState); published {
+				if cancellation != nil {
+					cancellation.published(tx, time.Now())
+				}
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
 					m.waitForTx(ctx, tx, sendState, receiptChan)
 				}()
+			} else if cancellation != nil {
+				tx = m.fallbackBlobTxCancellation(ctx, cancellation, tx, sendState)
 			}
 		}
 		if err := sendState.CriticalError(); err != nil {
@@ -595,6 +609,10 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 		case receipt := <-receiptChan:
 			m.metr.RecordGasBumpCount(sendState.bumpCount)
 			m.metr.TxConfirmed(receipt)
+			if cancellation != nil && cancellation.isCancellation(receipt.TxHash) {
+				m.txLogger(tx, false).Warn("Stuck blob transaction canceled", "cancellation", receipt.TxHash)
+				return nil, fmt.Errorf("%w: nonce %d", ErrBlobTxCanceled, tx.Nonce())
+			}
 			return receipt, nil
 		}
 	}
@@ -608,8 +626,21 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 
 	l.Info("Publishing transaction")
 
+	// underpriced is set when the backend rejected the tx as underpriced, so the fees must be bumped
+	// regardless of the estimated inclusion fees.
+	underpriced := false
 	for {
-		if sendState.bumpFees {
+		skipBump := false
+		if sendState.bumpFees && m.cfg.BumpOnlyWhenStuck && !underpriced {
+			if stuck, err := m.isTxStuck(ctx, tx); err != nil {
+				l.Warn("unable to estimate inclusion fees, bumping fees", "err", err)
+			} else if !stuck {
+				// Still re-publish the tx, in case it got dropped from the mempool.
+				l.Info("Transaction fees above inclusion percentile, skipping fee bump")
+				skipBump = true
+			}
+		}
+		if sendState.bumpFees && !skipBump {
 			if newTx, err := m.increaseGasPrice(ctx, tx); err != nil {
 				l.Warn("unable to increase gas, will try to re-publish the tx", "err", err)
 				m.metr.TxPublished("bump_failed")
@@ -669,6 +700,7 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 			// retry tx with fee bump, unless we already just tried to bump them
 			if !sendState.bumpFees {
 				sendState.bumpFees = true
+				underpriced = true
 				continue
 			}
 		case errStringMatch(err, txpool.ErrUnderpriced):
@@ -677,6 +709,7 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 			// retry tx with fee bump, unless we already just tried to bump them
 			if !sendState.bumpFees {
 				sendState.bumpFees = true
+				underpriced = true
 				continue
 			}
 		default:
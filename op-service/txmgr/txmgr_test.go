@@ -385,7 +385,7 @@ func TestTxMgrConfirmAtMinGasPrice(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -413,7 +413,7 @@ func TestTxMgrNeverConfirmCancel(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
@@ -490,7 +490,7 @@ func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -524,7 +524,7 @@ func TestTxMgrConfirmsBlobTxAtHigherGasPrice(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	// the fee cap for the blob tx at epoch == 3 should end up higher than the min required gas
@@ -558,7 +558,7 @@ func TestTxMgrBlocksOnFailingRpcCalls(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
@@ -742,7 +742,7 @@ func TestTxMgrOnlyOnePublicationSucceeds(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 
 	require.NotNil(t, receipt)
@@ -777,7 +777,7 @@ func TestTxMgrConfirmsMinGasPriceAfterBumping(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -818,7 +818,7 @@ func TestTxMgrRetriesUnbumpableTx(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, txToSend)
+	receipt, err := h.mgr.sendTx(ctx, txToSend, nil)
 	require.NoError(t, err)
 	require.NotNil(t, receipt)
 	require.Greater(t, sameTxPublishAttempts, 1, "expected the original tx to be retried at least once")
@@ -863,7 +863,7 @@ func TestTxMgrDoesntAbortNonceTooLowAfterMiningTx(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -1610,3 +1610,80 @@ func TestSendAsyncUnbufferedChan(t *testing.T) {
 		h.mgr.SendAsync(context.Background(), TxCandidate{}, make(chan SendResponse))
 	})
 }
+
+// feeHistoryBackend extends the mockBackend with a fee history, for estimating inclusion fees.
+type feeHistoryBackend struct {
+	*mockBackend
+	nextBaseFee *big.Int
+	tips        []*big.Int
+}
+
+func (b *feeHistoryBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	history := &ethereum.FeeHistory{}
+	for _, tip := range b.tips {
+		history.Reward = append(history.Reward, []*big.Int{tip})
+		history.BaseFee = append(history.BaseFee, b.nextBaseFee)
+	}
+	history.BaseFee = append(history.BaseFee, b.nextBaseFee)
+	return history, nil
+}
+
+func TestBumpOnlyWhenStuck(t *testing.T) {
+	setup := func(t *testing.T, nextBaseFee int64, tips ...int64) (*testHarness, *types.Transaction) {
+		cfg := configWithNumConfs(1)
+		cfg.BumpOnlyWhenStuck = true
+		cfg.InclusionPercentile = 50
+		// Allow bumping over the suggested fees, which are far below the fees of the test tx
+		cfg.FeeLimitThreshold.Store(big.NewInt(1000))
+		h := newTestHarnessWithConfig(t, cfg)
+		backend := &feeHistoryBackend{mockBackend: h.backend, nextBaseFee: big.NewInt(nextBaseFee)}
+		for _, tip := range tips {
+			backend.tips = append(backend.tips, big.NewInt(tip))
+		}
+		h.mgr.backend = backend
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			return nil
+		})
+		tx := types.NewTx(&types.DynamicFeeTx{
+			GasTipCap: big.NewInt(10),
+			GasFeeCap: big.NewInt(100),
+		})
+		return h, tx
+	}
+	publish := func(t *testing.T, h *testHarness, tx *types.Transaction) *types.Transaction {
+		sendState := testSendState()
+		sendState.bumpFees = true
+		newTx, published := h.mgr.publishTx(context.Background(), tx, sendState)
+		require.True(t, published)
+		return newTx
+	}
+
+	t.Run("NotStuck", func(t *testing.T) {
+		h, tx := setup(t, 50, 1, 10, 20)
+		require.Equal(t, tx.Hash(), publish(t, h, tx).Hash(), "should not bump fees")
+	})
+
+	t.Run("TipBelowPercentile", func(t *testing.T) {
+		h, tx := setup(t, 50, 5, 15, 20)
+		newTx := publish(t, h, tx)
+		require.NotEqual(t, tx.Hash(), newTx.Hash(), "should bump fees")
+		require.Greater(t, newTx.GasTipCap().Uint64(), tx.GasTipCap().Uint64())
+	})
+
+	t.Run("EffectiveTipBelowPercentile", func(t *testing.T) {
+		// The fee cap only leaves a tip of 5 at the next base fee.
+		h, tx := setup(t, 95, 10, 10, 10)
+		require.NotEqual(t, tx.Hash(), publish(t, h, tx).Hash(), "should bump fees")
+	})
+
+	t.Run("FeeCapBelowBaseFee", func(t *testing.T) {
+		h, tx := setup(t, 101, 1, 1, 1)
+		require.NotEqual(t, tx.Hash(), publish(t, h, tx).Hash(), "should bump fees")
+	})
+
+	t.Run("FeeHistoryUnsupported", func(t *testing.T) {
+		h, tx := setup(t, 50, 1, 1, 1)
+		h.mgr.backend = h.backend
+		require.NotEqual(t, tx.Hash(), publish(t, h, tx).Hash(), "should fall back to bumping fees")
+	})
+}
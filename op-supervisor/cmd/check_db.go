@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-supervisor/flags"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db"
+)
+
+var (
+	CheckDBRepairFlag = &cli.BoolFlag{
+		Name: "repair",
+		Usage: "Truncate every inconsistent database to its last consistent point, " +
+			"and rewind heads that are inconsistent with the other databases.",
+	}
+	CheckDBChainsFlag = &cli.StringSliceFlag{
+		Name:  "chains",
+		Usage: "Chain IDs to check. Defaults to all chains found in the data directory.",
+	}
+)
+
+var CheckDBCommand = &cli.Command{
+	Name:  "check-db",
+	Usage: "Verify the integrity of the supervisor databases, and optionally repair them",
+	Description: "Verifies the entries of the log DB, local-safe DB and cross-safe DB of every chain, " +
+		"and cross-checks the heads of the databases against each other. " +
+		"The supervisor must not be running while its databases are checked.",
+	Flags: append([]cli.Flag{
+		&cli.PathFlag{
+			Name:     flags.DataDirFlag.Name,
+			Usage:    "Directory of the supervisor databases",
+			EnvVars:  flags.DataDirFlag.EnvVars,
+			Required: true,
+		},
+		CheckDBRepairFlag,
+		CheckDBChainsFlag,
+	}, oplog.CLIFlags(flags.EnvVarPrefix)...),
+	Action: checkDB,
+}
+
+func checkDB(ctx *cli.Context) error {
+	logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.ReadCLIConfig(ctx))
+	dataDir := ctx.Path(flags.DataDirFlag.Name)
+	repair := ctx.Bool(CheckDBRepairFlag.Name)
+
+	chains, err := checkDBChains(dataDir, ctx.StringSlice(CheckDBChainsFlag.Name))
+	if err != nil {
+		return err
+	}
+	if len(chains) == 0 {
+		return fmt.Errorf("no chain databases found in %s", dataDir)
+	}
+
+	var inconsistent []eth.ChainID
+	for _, chainID := range chains {
+		report, err := db.CheckChainDBs(logger, chainID, dataDir, repair)
+		if err != nil {
+			return fmt.Errorf("failed to check chain %s: %w", chainID, err)
+		}
+		for _, err := range report.CrossRefErrs {
+			logger.Error("Inconsistent databases", "chain", chainID, "err", err)
+		}
+		switch {
+		case report.Repaired:
+			logger.Warn("Repaired chain databases", "chain", chainID)
+		case report.Consistent():
+			logger.Info("Chain databases are consistent", "chain", chainID)
+		default:
+			logger.Error("Chain databases are inconsistent", "chain", chainID)
+			inconsistent = append(inconsistent, chainID)
+		}
+	}
+	if len(inconsistent) > 0 {
+		return fmt.Errorf("inconsistent databases of chains %v, run with --%s to truncate to the last consistent point",
+			inconsistent, CheckDBRepairFlag.Name)
+	}
+	return nil
+}
+
+// checkDBChains parses the given chain IDs, or lists the chains that have a directory in the data directory.
+func checkDBChains(dataDir string, names []string) ([]eth.ChainID, error) {
+	var chains []eth.ChainID
+	if len(names) > 0 {
+		for _, name := range names {
+			var chainID eth.ChainID
+			if err := chainID.UnmarshalText([]byte(name)); err != nil {
+				return nil, fmt.Errorf("invalid chain ID %q: %w", name, err)
+			}
+			chains = append(chains, chainID)
+		}
+		return chains, nil
+	}
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data directory: %w", err)
+	}
+	for _, entry := range entries {
+		var chainID eth.ChainID
+		if !entry.IsDir() || chainID.UnmarshalText([]byte(entry.Name())) != nil {
+			continue
+		}
+		chains = append(chains, chainID)
+	}
+	return chains, nil
+}
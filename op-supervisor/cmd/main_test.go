@@ -81,6 +81,16 @@ func TestMockRun(t *testing.T) {
 	})
 }
 
+func TestSimulate(t *testing.T) {
+	t.Run("NoRequiredFlags", func(t *testing.T) {
+		cfg := configForArgs(t, []string{"--simulate.chains=3", "--simulate.block-time=1", "--simulate.genesis-time=1000"})
+		require.Equal(t, uint64(3), cfg.SimulatedChains)
+		require.Equal(t, uint64(1), cfg.SimulatedBlockTime)
+		require.Equal(t, uint32(4), cfg.SimulatedMessagesPerBlock)
+		require.Equal(t, uint64(1000), cfg.SimulatedGenesisTime)
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := dryRunWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
@@ -2,7 +2,11 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -15,6 +19,9 @@ var (
 	ErrMissingSyncSources   = errors.New("must specify sync source collection")
 	ErrMissingDependencySet = errors.New("must specify a dependency set source")
 	ErrMissingDatadir       = errors.New("must specify datadir")
+
+	ErrZeroSimulatedBlockTime   = errors.New("simulated block time must be positive")
+	ErrZeroHeadWatchdogInterval = errors.New("head watchdog interval must be positive")
 )
 
 type Config struct {
@@ -30,6 +37,16 @@ type Config struct {
 	// MockRun runs the service with a mock backend
 	MockRun bool
 
+	// SimulatedChains runs the service with a simulated interop cluster of this many chains,
+	// instead of syncing real chains. Zero disables the simulation.
+	SimulatedChains uint64
+	// SimulatedBlockTime is the block time of the simulated chains, in seconds.
+	SimulatedBlockTime uint64
+	// SimulatedMessagesPerBlock is the number of initiating messages in every simulated block.
+	SimulatedMessagesPerBlock uint32
+	// SimulatedGenesisTime is the genesis timestamp of the simulated chains, the start time of the service if zero.
+	SimulatedGenesisTime uint64
+
 	// SynchronousProcessors disables background-workers,
 	// requiring manual triggers for the backend to process anything.
 	SynchronousProcessors bool
@@ -41,6 +58,18 @@ type Config struct {
 
 	Datadir             string
 	DatadirSyncEndpoint string
+
+	// ProxySequencers lists the sequencer RPC per chain, as <chainID>=<rpc> entries.
+	// If any are configured, wallets can send raw transactions to the supervisor,
+	// to be forwarded to the sequencer after validation of the executing messages.
+	ProxySequencers []string
+
+	// HeadWatchdogRPCs lists an independent RPC per chain, as <chainID>=<rpc> entries.
+	// The heads reported by the managed nodes of a chain are periodically checked against the blocks of its RPC,
+	// to detect managed nodes that forked from the canonical chain.
+	HeadWatchdogRPCs []string
+	// HeadWatchdogInterval is the interval between checks of the head watchdog.
+	HeadWatchdogInterval time.Duration
 }
 
 func (c *Config) Check() error {
@@ -48,6 +77,13 @@ func (c *Config) Check() error {
 	result = errors.Join(result, c.MetricsConfig.Check())
 	result = errors.Join(result, c.PprofConfig.Check())
 	result = errors.Join(result, c.RPC.Check())
+	if c.Simulated() {
+		if c.SimulatedBlockTime == 0 {
+			result = errors.Join(result, ErrZeroSimulatedBlockTime)
+		}
+		// The simulated cluster does not sync any chains
+		return result
+	}
 	if c.DependencySetSource == nil {
 		result = errors.Join(result, ErrMissingDependencySet)
 	}
@@ -59,21 +95,69 @@ func (c *Config) Check() error {
 	} else {
 		result = errors.Join(result, c.SyncSources.Check())
 	}
+	if _, err := ParseProxySequencers(c.ProxySequencers); err != nil {
+		result = errors.Join(result, err)
+	}
+	if _, err := ParseHeadWatchdogRPCs(c.HeadWatchdogRPCs); err != nil {
+		result = errors.Join(result, err)
+	}
+	if len(c.HeadWatchdogRPCs) > 0 && c.HeadWatchdogInterval <= 0 {
+		result = errors.Join(result, ErrZeroHeadWatchdogInterval)
+	}
 	return result
 }
 
+// Simulated returns true if the service runs a simulated interop cluster.
+func (c *Config) Simulated() bool {
+	return c.SimulatedChains > 0
+}
+
+// ParseProxySequencers parses <chainID>=<rpc> entries into a sequencer RPC per chain.
+func ParseProxySequencers(entries []string) (map[eth.ChainID]string, error) {
+	return parseChainRPCs("proxy sequencer", entries)
+}
+
+// ParseHeadWatchdogRPCs parses <chainID>=<rpc> entries into an independent RPC per chain.
+func ParseHeadWatchdogRPCs(entries []string) (map[eth.ChainID]string, error) {
+	return parseChainRPCs("head watchdog RPC", entries)
+}
+
+func parseChainRPCs(kind string, entries []string) (map[eth.ChainID]string, error) {
+	out := make(map[eth.ChainID]string, len(entries))
+	for _, entry := range entries {
+		id, addr, ok := strings.Cut(entry, "=")
+		if !ok || addr == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected <chainID>=<rpc>", kind, entry)
+		}
+		var chainID eth.ChainID
+		if err := chainID.UnmarshalText([]byte(id)); err != nil {
+			return nil, fmt.Errorf("invalid chain ID of %s %q: %w", kind, entry, err)
+		}
+		if _, ok := out[chainID]; ok {
+			return nil, fmt.Errorf("duplicate %s for chain %v", kind, chainID)
+		}
+		out[chainID] = addr
+	}
+	return out, nil
+}
+
 // NewConfig creates a new config using default values whenever possible.
 // Required options with no suitable default are passed as parameters.
 func NewConfig(l1RPC string, syncSrcs syncnode.SyncNodeCollection, depSet depset.DependencySetSource, datadir string) *Config {
 	return &Config{
-		LogConfig:           oplog.DefaultCLIConfig(),
-		MetricsConfig:       opmetrics.DefaultCLIConfig(),
-		PprofConfig:         oppprof.DefaultCLIConfig(),
-		RPC:                 oprpc.DefaultCLIConfig(),
-		DependencySetSource: depSet,
-		MockRun:             false,
-		L1RPC:               l1RPC,
-		SyncSources:         syncSrcs,
-		Datadir:             datadir,
+		LogConfig:                 oplog.DefaultCLIConfig(),
+		MetricsConfig:             opmetrics.DefaultCLIConfig(),
+		PprofConfig:               oppprof.DefaultCLIConfig(),
+		RPC:                       oprpc.DefaultCLIConfig(),
+		DependencySetSource:       depSet,
+		MockRun:                   false,
+		SimulatedBlockTime:        2,
+		SimulatedMessagesPerBlock: 4,
+		ProxySequencers:           []string{},
+		HeadWatchdogRPCs:          []string{},
+		HeadWatchdogInterval:      12 * time.Second,
+		L1RPC:                     l1RPC,
+		SyncSources:               syncSrcs,
+		Datadir:                   datadir,
 	}
 }
@@ -56,6 +56,49 @@ func TestValidateRPCConfig(t *testing.T) {
 	require.ErrorIs(t, cfg.Check(), rpc.ErrInvalidPort)
 }
 
+func TestValidateProxySequencers(t *testing.T) {
+	cfg := validConfig()
+	cfg.ProxySequencers = []string{"900=http://localhost:8545", "901=http://localhost:9545"}
+	require.NoError(t, cfg.Check())
+	sequencers, err := ParseProxySequencers(cfg.ProxySequencers)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9545", sequencers[eth.ChainIDFromUInt64(901)])
+
+	cfg.ProxySequencers = []string{"http://localhost:8545"}
+	require.ErrorContains(t, cfg.Check(), "expected <chainID>=<rpc>")
+
+	cfg.ProxySequencers = []string{"abc=http://localhost:8545"}
+	require.ErrorContains(t, cfg.Check(), "invalid chain ID")
+
+	cfg.ProxySequencers = []string{"900=http://localhost:8545", "900=http://localhost:9545"}
+	require.ErrorContains(t, cfg.Check(), "duplicate proxy sequencer")
+}
+
+func TestValidateHeadWatchdog(t *testing.T) {
+	cfg := validConfig()
+	cfg.HeadWatchdogRPCs = []string{"900=http://localhost:8545"}
+	require.NoError(t, cfg.Check())
+	rpcs, err := ParseHeadWatchdogRPCs(cfg.HeadWatchdogRPCs)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8545", rpcs[eth.ChainIDFromUInt64(900)])
+
+	cfg.HeadWatchdogRPCs = []string{"900=http://localhost:8545", "900=http://localhost:9545"}
+	require.ErrorContains(t, cfg.Check(), "duplicate head watchdog RPC")
+
+	cfg.HeadWatchdogRPCs = []string{"900=http://localhost:8545"}
+	cfg.HeadWatchdogInterval = 0
+	require.ErrorIs(t, cfg.Check(), ErrZeroHeadWatchdogInterval)
+}
+
+func TestValidateSimulation(t *testing.T) {
+	cfg := NewConfig("", nil, nil, "")
+	cfg.SimulatedChains = 2
+	require.NoError(t, cfg.Check(), "simulation does not sync real chains")
+
+	cfg.SimulatedBlockTime = 0
+	require.ErrorIs(t, cfg.Check(), ErrZeroSimulatedBlockTime)
+}
+
 func validConfig() *Config {
 	depSet, err := depset.NewStaticConfigDependencySet(map[eth.ChainID]*depset.StaticConfigDependency{
 		eth.ChainIDFromUInt64(900): &depset.StaticConfigDependency{
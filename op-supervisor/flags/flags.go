@@ -2,6 +2,7 @@ package flags
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -56,12 +57,55 @@ var (
 		EnvVars:   prefixEnvVars("DEPENDENCY_SET"),
 		TakesFile: true,
 	}
+	ProxySequencersFlag = &cli.StringSliceFlag{
+		Name: "proxy.sequencers",
+		Usage: "Sequencer RPC per chain, as <chainID>=<rpc> entries. " +
+			"If set, eth_sendRawTransaction is served, forwarding transactions to the sequencer " +
+			"if all executing messages in the access-list are valid.",
+		EnvVars: prefixEnvVars("PROXY_SEQUENCERS"),
+	}
+	HeadWatchdogRPCsFlag = &cli.StringSliceFlag{
+		Name: "head-watchdog.rpcs",
+		Usage: "Independent RPC per chain, as <chainID>=<rpc> entries. " +
+			"If set, the heads reported by the managed nodes of the chain are periodically checked against the RPC, " +
+			"to detect managed nodes that forked from the canonical chain.",
+		EnvVars: prefixEnvVars("HEAD_WATCHDOG_RPCS"),
+	}
+	HeadWatchdogIntervalFlag = &cli.DurationFlag{
+		Name:    "head-watchdog.interval",
+		Usage:   "Interval between checks of the heads of the managed nodes against the head watchdog RPCs.",
+		EnvVars: prefixEnvVars("HEAD_WATCHDOG_INTERVAL"),
+		Value:   12 * time.Second,
+	}
 	MockRunFlag = &cli.BoolFlag{
 		Name:    "mock-run",
 		Usage:   "Mock run, no actual backend used, just presenting the service",
 		EnvVars: prefixEnvVars("MOCK_RUN"),
 		Hidden:  true, // this is for testing only
 	}
+	SimulateChainsFlag = &cli.Uint64Flag{
+		Name: "simulate.chains",
+		Usage: "Simulate an interop cluster of this many chains, with deterministic blocks and messages, " +
+			"instead of syncing real chains. For developing against the supervisor API without a devnet.",
+		EnvVars: prefixEnvVars("SIMULATE_CHAINS"),
+	}
+	SimulateBlockTimeFlag = &cli.Uint64Flag{
+		Name:    "simulate.block-time",
+		Usage:   "Block time of the simulated chains, in seconds.",
+		EnvVars: prefixEnvVars("SIMULATE_BLOCK_TIME"),
+		Value:   2,
+	}
+	SimulateMessagesPerBlockFlag = &cli.UintFlag{
+		Name:    "simulate.messages-per-block",
+		Usage:   "Number of initiating messages in every simulated block.",
+		EnvVars: prefixEnvVars("SIMULATE_MESSAGES_PER_BLOCK"),
+		Value:   4,
+	}
+	SimulateGenesisTimeFlag = &cli.Uint64Flag{
+		Name:    "simulate.genesis-time",
+		Usage:   "Genesis timestamp of the simulated chains. Defaults to the start time of the service.",
+		EnvVars: prefixEnvVars("SIMULATE_GENESIS_TIME"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -74,7 +118,14 @@ var requiredFlags = []cli.Flag{
 
 var optionalFlags = []cli.Flag{
 	MockRunFlag,
+	SimulateChainsFlag,
+	SimulateBlockTimeFlag,
+	SimulateMessagesPerBlockFlag,
+	SimulateGenesisTimeFlag,
 	DataDirSyncEndpointFlag,
+	ProxySequencersFlag,
+	HeadWatchdogRPCsFlag,
+	HeadWatchdogIntervalFlag,
 }
 
 func init() {
@@ -91,6 +142,10 @@ func init() {
 var Flags []cli.Flag
 
 func CheckRequired(ctx *cli.Context) error {
+	if ctx.Uint64(SimulateChainsFlag.Name) > 0 {
+		// The simulated cluster does not sync any chains
+		return nil
+	}
 	for _, f := range requiredFlags {
 		if !ctx.IsSet(f.Names()[0]) {
 			return fmt.Errorf("flag %s is required", f.Names()[0])
@@ -101,17 +156,24 @@ func CheckRequired(ctx *cli.Context) error {
 
 func ConfigFromCLI(ctx *cli.Context, version string) *config.Config {
 	return &config.Config{
-		Version:             version,
-		LogConfig:           oplog.ReadCLIConfig(ctx),
-		MetricsConfig:       opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:         oppprof.ReadCLIConfig(ctx),
-		RPC:                 oprpc.ReadCLIConfig(ctx),
-		DependencySetSource: &depset.JsonDependencySetLoader{Path: ctx.Path(DependencySetFlag.Name)},
-		MockRun:             ctx.Bool(MockRunFlag.Name),
-		L1RPC:               ctx.String(L1RPCFlag.Name),
-		SyncSources:         syncSourceSetups(ctx),
-		Datadir:             ctx.Path(DataDirFlag.Name),
-		DatadirSyncEndpoint: ctx.Path(DataDirSyncEndpointFlag.Name),
+		Version:                   version,
+		LogConfig:                 oplog.ReadCLIConfig(ctx),
+		MetricsConfig:             opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:               oppprof.ReadCLIConfig(ctx),
+		RPC:                       oprpc.ReadCLIConfig(ctx),
+		DependencySetSource:       &depset.JsonDependencySetLoader{Path: ctx.Path(DependencySetFlag.Name)},
+		MockRun:                   ctx.Bool(MockRunFlag.Name),
+		SimulatedChains:           ctx.Uint64(SimulateChainsFlag.Name),
+		SimulatedBlockTime:        ctx.Uint64(SimulateBlockTimeFlag.Name),
+		SimulatedMessagesPerBlock: uint32(ctx.Uint(SimulateMessagesPerBlockFlag.Name)),
+		SimulatedGenesisTime:      ctx.Uint64(SimulateGenesisTimeFlag.Name),
+		L1RPC:                     ctx.String(L1RPCFlag.Name),
+		SyncSources:               syncSourceSetups(ctx),
+		Datadir:                   ctx.Path(DataDirFlag.Name),
+		DatadirSyncEndpoint:       ctx.Path(DataDirSyncEndpointFlag.Name),
+		ProxySequencers:           filterEmpty(ctx.StringSlice(ProxySequencersFlag.Name)),
+		HeadWatchdogRPCs:          filterEmpty(ctx.StringSlice(HeadWatchdogRPCsFlag.Name)),
+		HeadWatchdogInterval:      ctx.Duration(HeadWatchdogIntervalFlag.Name),
 	}
 }
 
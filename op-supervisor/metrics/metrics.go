@@ -21,6 +21,12 @@ type Metricer interface {
 	RecordDBEntryCount(chainID eth.ChainID, kind string, count int64)
 	RecordDBSearchEntriesRead(chainID eth.ChainID, count int64)
 
+	RecordHeadDivergence(chainID eth.ChainID, node string, head string, diverged bool)
+
+	RecordExecutingMessages(initiating eth.ChainID, executing eth.ChainID, count int)
+	RecordInvalidMessageReference(executing eth.ChainID)
+	RecordMessageAnomaly(kind string)
+
 	Document() []opmetrics.DocumentedMetric
 }
 
@@ -38,6 +44,13 @@ type Metrics struct {
 	DBEntryCountVec        *prometheus.GaugeVec
 	DBSearchEntriesReadVec *prometheus.HistogramVec
 
+	HeadDivergenceVec *prometheus.GaugeVec
+
+	ExecutingMessagesVec         *prometheus.CounterVec
+	ExecutingMessagesPerBlockVec *prometheus.HistogramVec
+	InvalidMessageReferencesVec  *prometheus.CounterVec
+	MessageAnomaliesVec          *prometheus.CounterVec
+
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
 }
@@ -119,6 +132,48 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"chain",
 		}),
+
+		HeadDivergenceVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "head_divergence",
+			Help:      "1 if the head reported by a managed node differs from the canonical block of the head watchdog RPC",
+		}, []string{
+			"chain",
+			"node",
+			"head",
+		}),
+
+		ExecutingMessagesVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "executing_messages_total",
+			Help:      "Number of executing messages, by initiating and executing chain",
+		}, []string{
+			"initiating_chain",
+			"executing_chain",
+		}),
+		ExecutingMessagesPerBlockVec: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "executing_messages_per_block",
+			Help:      "Executing messages per block with executing messages, by initiating and executing chain",
+			Buckets:   []float64{1, 2, 5, 10, 20, 50, 100, 200, 500},
+		}, []string{
+			"initiating_chain",
+			"executing_chain",
+		}),
+		InvalidMessageReferencesVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "invalid_message_references_total",
+			Help:      "Number of transactions rejected by the proxy for referencing initiating messages that do not exist",
+		}, []string{
+			"executing_chain",
+		}),
+		MessageAnomaliesVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "message_anomalies_total",
+			Help:      "Number of detected anomalies of cross-chain messages, by kind",
+		}, []string{
+			"kind",
+		}),
 	}
 }
 
@@ -168,6 +223,28 @@ func (m *Metrics) RecordDBSearchEntriesRead(chainID eth.ChainID, count int64) {
 	m.DBSearchEntriesReadVec.WithLabelValues(chainIDLabel(chainID)).Observe(float64(count))
 }
 
+func (m *Metrics) RecordHeadDivergence(chainID eth.ChainID, node string, head string, diverged bool) {
+	value := 0.0
+	if diverged {
+		value = 1
+	}
+	m.HeadDivergenceVec.WithLabelValues(chainIDLabel(chainID), node, head).Set(value)
+}
+
+func (m *Metrics) RecordExecutingMessages(initiating eth.ChainID, executing eth.ChainID, count int) {
+	initiatingChain, executingChain := chainIDLabel(initiating), chainIDLabel(executing)
+	m.ExecutingMessagesVec.WithLabelValues(initiatingChain, executingChain).Add(float64(count))
+	m.ExecutingMessagesPerBlockVec.WithLabelValues(initiatingChain, executingChain).Observe(float64(count))
+}
+
+func (m *Metrics) RecordInvalidMessageReference(executing eth.ChainID) {
+	m.InvalidMessageReferencesVec.WithLabelValues(chainIDLabel(executing)).Inc()
+}
+
+func (m *Metrics) RecordMessageAnomaly(kind string) {
+	m.MessageAnomaliesVec.WithLabelValues(kind).Inc()
+}
+
 func chainIDLabel(chainID eth.ChainID) string {
 	return chainID.String()
 }
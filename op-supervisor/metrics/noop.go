@@ -21,3 +21,9 @@ func (m *noopMetrics) CacheGet(_ eth.ChainID, _ string, _ bool)        {}
 
 func (m *noopMetrics) RecordDBEntryCount(_ eth.ChainID, _ string, _ int64) {}
 func (m *noopMetrics) RecordDBSearchEntriesRead(_ eth.ChainID, _ int64)    {}
+
+func (m *noopMetrics) RecordHeadDivergence(_ eth.ChainID, _ string, _ string, _ bool) {}
+
+func (m *noopMetrics) RecordExecutingMessages(_ eth.ChainID, _ eth.ChainID, _ int) {}
+func (m *noopMetrics) RecordInvalidMessageReference(_ eth.ChainID)                 {}
+func (m *noopMetrics) RecordMessageAnomaly(_ string)                               {}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"sync/atomic"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/locks"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
@@ -22,6 +24,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/sync"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/l1access"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/msgstats"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/processors"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/syncnode"
@@ -52,15 +55,23 @@ type SupervisorBackend struct {
 	// chainProcessors are notified of new unsafe blocks, and add the unsafe log events data into the events DB
 	chainProcessors locks.RWMap[eth.ChainID, *processors.ChainProcessor]
 
-	syncSources locks.RWMap[eth.ChainID, syncnode.SyncSource]
+	// syncSources fail over between all the sync sources of each chain
+	syncSources locks.RWMap[eth.ChainID, *syncnode.FailoverSource]
 
 	// syncNodesController controls the derivation or reset of the sync nodes
 	syncNodesController *syncnode.SyncNodesController
 
+	// headWatchdog checks the heads of the sync nodes against independent RPCs, if any are configured
+	headWatchdog     *syncnode.HeadWatchdog
+	headWatchdogRPCs []client.RPC
+
 	// synchronousProcessors disables background-workers,
 	// requiring manual triggers for the backend to process l2 data.
 	synchronousProcessors bool
 
+	// msgStats tracks the executing messages of each chain pair and detects anomalies in them
+	msgStats *msgstats.Tracker
+
 	// chainMetrics are used to track metrics for each chain
 	// they are reused for processors and databases of the same chain
 	chainMetrics locks.RWMap[eth.ChainID, *chainMetrics]
@@ -118,6 +129,7 @@ func NewSupervisorBackend(ctx context.Context, logger log.Logger,
 		depSet:     depSet,
 		chainDBs:   chainsDBs,
 		l1Accessor: l1Accessor,
+		msgStats:   msgstats.NewTracker(logger, m, clock.SystemClock, depSet, msgstats.DefaultConfig),
 		// For testing we can avoid running the processors.
 		synchronousProcessors: cfg.SynchronousProcessors,
 		eventSys:              eventSys,
@@ -189,17 +201,20 @@ func (su *SupervisorBackend) initResources(ctx context.Context, cfg *config.Conf
 		worker := cross.NewCrossSafeWorker(su.logger, chainID, su.chainDBs)
 		su.eventSys.Register(fmt.Sprintf("cross-safe-%s", chainID), worker, eventOpts)
 	}
+	// propagate reorgs to the chains that depend on the reorged chain
+	coordinator := cross.NewReorgCoordinator(su.logger, su.chainDBs)
+	su.eventSys.Register("reorg-coordinator", coordinator, eventOpts)
 	// For each chain initialize a chain processor service,
 	// after cross-unsafe workers are ready to receive updates
 	for _, chainID := range chains {
-		logProcessor := processors.NewLogProcessor(chainID, su.chainDBs, su.depSet)
+		logProcessor := processors.NewLogProcessor(chainID, su.chainDBs, su.depSet, su.msgStats)
 		chainProcessor := processors.NewChainProcessor(su.sysContext, su.logger, chainID, logProcessor, su.chainDBs)
 		su.eventSys.Register(fmt.Sprintf("events-%s", chainID), chainProcessor, eventOpts)
 		su.chainProcessors.Set(chainID, chainProcessor)
 	}
 	// initialize sync sources
 	for _, chainID := range chains {
-		su.syncSources.Set(chainID, nil)
+		su.syncSources.Set(chainID, syncnode.NewFailoverSource())
 	}
 
 	if cfg.L1RPC != "" {
@@ -224,6 +239,41 @@ func (su *SupervisorBackend) initResources(ctx context.Context, cfg *config.Conf
 			return fmt.Errorf("failed to attach sync source %s: %w", src, err)
 		}
 	}
+
+	if len(cfg.HeadWatchdogRPCs) > 0 {
+		if err := su.initHeadWatchdog(ctx, cfg); err != nil {
+			return fmt.Errorf("failed to create head watchdog: %w", err)
+		}
+	}
+	return nil
+}
+
+// initHeadWatchdog dials the independent RPCs of the chains and creates the head watchdog.
+// It is a sub-task of initResources.
+func (su *SupervisorBackend) initHeadWatchdog(ctx context.Context, cfg *config.Config) error {
+	addrs, err := config.ParseHeadWatchdogRPCs(cfg.HeadWatchdogRPCs)
+	if err != nil {
+		return err
+	}
+	srcs := make(map[eth.ChainID]syncnode.HeadSource, len(addrs))
+	for chainID, addr := range addrs {
+		if !slices.Contains(su.depSet.Chains(), chainID) {
+			return fmt.Errorf("head watchdog RPC configured for unknown chain %s", chainID)
+		}
+		logger := su.logger.New("chain", chainID, "watchdog-rpc", addr)
+		rpc, err := client.NewRPC(ctx, logger, addr)
+		if err != nil {
+			return fmt.Errorf("failed to dial head watchdog RPC of chain %s: %w", chainID, err)
+		}
+		su.headWatchdogRPCs = append(su.headWatchdogRPCs, rpc)
+		cl, err := sources.NewEthClient(rpc, logger, nil,
+			&sources.L1ClientSimpleConfig(true, sources.RPCKindBasic, 10).EthClientConfig)
+		if err != nil {
+			return fmt.Errorf("failed to setup head watchdog client of chain %s: %w", chainID, err)
+		}
+		srcs[chainID] = cl
+	}
+	su.headWatchdog = syncnode.NewHeadWatchdog(su.logger, su.m, su.syncNodesController, srcs, cfg.HeadWatchdogInterval)
 	return nil
 }
 
@@ -269,14 +319,16 @@ func (su *SupervisorBackend) AttachSyncNode(ctx context.Context, src syncnode.Sy
 	if !su.depSet.HasChain(chainID) {
 		return nil, fmt.Errorf("chain %s is not part of the interop dependency set: %w", chainID, types.ErrUnknownChain)
 	}
-	err = su.AttachProcessorSource(chainID, src)
-	if err != nil {
-		return nil, fmt.Errorf("failed to attach sync source to processor: %w", err)
-	}
 	err = su.AttachSyncSource(chainID, src)
 	if err != nil {
 		return nil, fmt.Errorf("failed to attach sync source to node: %w", err)
 	}
+	// The processor fetches the blocks from any of the sync sources of the chain
+	failoverSrc, _ := su.syncSources.Get(chainID)
+	err = su.AttachProcessorSource(chainID, failoverSrc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach sync source to processor: %w", err)
+	}
 	return su.syncNodesController.AttachNodeController(chainID, src, noSubscribe)
 }
 
@@ -289,12 +341,13 @@ func (su *SupervisorBackend) AttachProcessorSource(chainID eth.ChainID, src proc
 	return nil
 }
 
+// AttachSyncSource adds a sync source to the chain, as fallback of the sync sources attached before it.
 func (su *SupervisorBackend) AttachSyncSource(chainID eth.ChainID, src syncnode.SyncSource) error {
-	_, ok := su.syncSources.Get(chainID)
+	failoverSrc, ok := su.syncSources.Get(chainID)
 	if !ok {
 		return fmt.Errorf("unknown chain %s, cannot attach RPC to sync source", chainID)
 	}
-	su.syncSources.Set(chainID, src)
+	failoverSrc.Add(src)
 	return nil
 }
 
@@ -338,6 +391,10 @@ func (su *SupervisorBackend) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to resume chains db: %w", err)
 	}
 
+	if su.headWatchdog != nil && !su.synchronousProcessors {
+		su.headWatchdog.Start()
+	}
+
 	return nil
 }
 
@@ -354,11 +411,19 @@ func (su *SupervisorBackend) Stop(ctx context.Context) error {
 
 	su.syncNodesController.Close()
 
+	if su.headWatchdog != nil {
+		su.headWatchdog.Close()
+	}
+	for _, rpc := range su.headWatchdogRPCs {
+		rpc.Close()
+	}
+
 	// close the databases
 	return su.chainDBs.Close()
 }
 
-// AddL2RPC attaches an RPC as the RPC for the given chain, overriding the previous RPC source, if any.
+// AddL2RPC attaches an RPC as an additional RPC for the given chain.
+// The supervisor fails over between the RPCs of a chain, preferring the RPCs that were attached first.
 func (su *SupervisorBackend) AddL2RPC(ctx context.Context, rpc string, jwtSecret eth.Bytes32) error {
 	setupSrc := &syncnode.RPCDialSetup{
 		JWTSecret: jwtSecret,
@@ -402,6 +467,31 @@ func (su *SupervisorBackend) CheckMessage(identifier types.Identifier, payloadHa
 	return su.chainDBs.Safest(chainID, blockNum, logIdx)
 }
 
+func (su *SupervisorBackend) CheckAccess(access types.Access) (types.SafetyLevel, error) {
+	_, err := su.chainDBs.CheckAccess(access)
+	if errors.Is(err, types.ErrFuture) {
+		su.logger.Debug("Future message", "access", access, "err", err)
+		return types.LocalUnsafe, nil
+	}
+	if errors.Is(err, types.ErrConflict) {
+		su.logger.Debug("Conflicting message", "access", access, "err", err)
+		return types.Invalid, nil
+	}
+	if err != nil {
+		return types.Invalid, fmt.Errorf("failed to check access: %w", err)
+	}
+	return su.chainDBs.Safest(access.ChainID, access.BlockNumber, access.LogIndex)
+}
+
+// RecordInvalidMessage records a transaction of the sender to the chain, which references an invalid message.
+func (su *SupervisorBackend) RecordInvalidMessage(chainID eth.ChainID, sender common.Address) {
+	su.msgStats.RecordInvalidMessage(chainID, sender)
+}
+
+func (su *SupervisorBackend) MessageStats(ctx context.Context) (types.MessageStats, error) {
+	return su.msgStats.Stats(), nil
+}
+
 func (su *SupervisorBackend) CheckMessages(
 	messages []types.Message,
 	minSafety types.SafetyLevel) error {
@@ -553,6 +643,39 @@ func (su *SupervisorBackend) SuperRootAtTimestamp(ctx context.Context, timestamp
 	}, nil
 }
 
+// SuperRootProofAtTimestamp returns the proof of inclusion of the output root of the chain
+// in the super root at the timestamp.
+func (su *SupervisorBackend) SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error) {
+	if !su.depSet.HasChain(chainID) {
+		return nil, fmt.Errorf("%w: %s", types.ErrUnknownChain, chainID)
+	}
+	resp, err := su.SuperRootAtTimestamp(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	chains := make([]eth.ChainIDAndOutput, len(resp.Chains))
+	for i, chain := range resp.Chains {
+		chains[i] = eth.ChainIDAndOutput{ChainID: chain.ChainID.ToBig().Uint64(), Output: chain.Canonical}
+	}
+	return eth.NewSuperRootProof(eth.NewSuperV1(resp.Timestamp, chains...), chainID.ToBig().Uint64())
+}
+
+// FinalizedSuperRoot returns the super root of the latest timestamp at which all chains are finalized.
+func (su *SupervisorBackend) FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error) {
+	finalized, err := su.chainDBs.FinalizedChains()
+	if err != nil {
+		return eth.SuperRootResponse{}, err
+	}
+	if len(finalized) == 0 {
+		return eth.SuperRootResponse{}, errors.New("no chains in dependency set")
+	}
+	timestamp := uint64(math.MaxUint64)
+	for _, seal := range finalized {
+		timestamp = min(timestamp, seal.Timestamp)
+	}
+	return su.SuperRootAtTimestamp(ctx, hexutil.Uint64(timestamp))
+}
+
 // PullLatestL1 makes the supervisor aware of the latest L1 block. Exposed for testing purposes.
 func (su *SupervisorBackend) PullLatestL1() error {
 	return su.l1Accessor.PullLatest()
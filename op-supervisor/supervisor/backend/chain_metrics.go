@@ -12,6 +12,12 @@ type Metrics interface {
 
 	RecordDBEntryCount(chainID eth.ChainID, kind string, count int64)
 	RecordDBSearchEntriesRead(chainID eth.ChainID, count int64)
+
+	RecordHeadDivergence(chainID eth.ChainID, node string, head string, diverged bool)
+
+	RecordExecutingMessages(initiating eth.ChainID, executing eth.ChainID, count int)
+	RecordInvalidMessageReference(executing eth.ChainID)
+	RecordMessageAnomaly(kind string)
 }
 
 // chainMetrics is an adapter between the metrics API expected by clients that assume there's only a single chain
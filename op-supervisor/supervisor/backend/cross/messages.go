@@ -0,0 +1,54 @@
+package cross
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+// ValidateExecutingMessages checks the invariants of the executing messages of a candidate block
+// that do not depend on the initiating blocks:
+//   - the chain may execute messages at the time of the candidate, if it executes any
+//   - every message is initiated by a chain of the dependency set, that may initiate messages at the message time
+//   - no message is initiated after the candidate, or expired by the message expiry window of the dependency set
+//
+// Returns the chain ID of the initiating chain of every message. Invalid messages result in an ErrConflict error.
+// This is shared by the cross-safe and cross-unsafe checks, and the fault proof program,
+// so they agree on what messages may be executed.
+func ValidateExecutingMessages(depSet depset.DependencySet, chainID eth.ChainID, candidate types.BlockSeal, execMsgs []*types.ExecutingMessage) (initChainIDs []eth.ChainID, err error) {
+	if len(execMsgs) == 0 {
+		return nil, nil
+	}
+	if ok, err := depSet.CanExecuteAt(chainID, candidate.Timestamp); err != nil {
+		return nil, fmt.Errorf("cannot check message execution of block %s (chain %s): %w", candidate, chainID, err)
+	} else if !ok {
+		return nil, fmt.Errorf("cannot execute messages in block %s (chain %s): %w", candidate, chainID, types.ErrConflict)
+	}
+	expiryWindow := depSet.MessageExpiryWindow()
+	for _, msg := range execMsgs {
+		initChainID, err := depSet.ChainIDFromIndex(msg.Chain)
+		if err != nil {
+			if errors.Is(err, types.ErrUnknownChain) {
+				err = fmt.Errorf("msg %s may not execute from unknown chain %s: %w", msg, msg.Chain, types.ErrConflict)
+			}
+			return nil, err
+		}
+		if ok, err := depSet.CanInitiateAt(initChainID, msg.Timestamp); err != nil {
+			return nil, fmt.Errorf("cannot check message initiation of msg %s (chain %s): %w", msg, chainID, err)
+		} else if !ok {
+			return nil, fmt.Errorf("cannot allow initiating message %s (chain %s): %w", msg, chainID, types.ErrConflict)
+		}
+		if msg.Timestamp > candidate.Timestamp {
+			// The predeploy inbox contract should not have allowed this executing message through.
+			return nil, fmt.Errorf("executing message %s in %s breaks timestamp invariant: %w", msg, candidate, types.ErrConflict)
+		}
+		if msg.Timestamp+expiryWindow < candidate.Timestamp {
+			return nil, fmt.Errorf("executing message %s in %s expired after %d seconds: %w", msg, candidate, expiryWindow, types.ErrConflict)
+		}
+		initChainIDs = append(initChainIDs, initChainID)
+	}
+	return initChainIDs, nil
+}
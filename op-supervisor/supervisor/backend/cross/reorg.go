@@ -0,0 +1,129 @@
+package cross
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+type ReorgDeps interface {
+	DependencySet() depset.DependencySet
+
+	LatestBlockNum(chain eth.ChainID) (num uint64, ok bool)
+
+	OpenBlock(chainID eth.ChainID, blockNum uint64) (block eth.BlockRef, logCount uint32, execMsgs map[uint32]*types.ExecutingMessage, err error)
+
+	Rewind(chain eth.ChainID, headBlockNum uint64) error
+}
+
+// ReorgDependents determines, for every other chain in the dependency set,
+// the first block that executes an initiating message of the reorged chain that is no longer canonical:
+// any message of a block after newHead. Chains without such a dependent block are omitted.
+// Only blocks with a timestamp at or after the first invalidated block are searched,
+// since messages cannot be executed before they are initiated.
+func ReorgDependents(d ReorgDeps, reorged eth.ChainID, newHead uint64, invalidated types.BlockSeal) (map[eth.ChainID]eth.BlockRef, error) {
+	depSet := d.DependencySet()
+	reorgedIndex, err := depSet.ChainIndexFromID(reorged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine chain index of %s: %w", reorged, err)
+	}
+	out := make(map[eth.ChainID]eth.BlockRef)
+	for _, chainID := range depSet.Chains() {
+		if chainID == reorged {
+			continue
+		}
+		latest, ok := d.LatestBlockNum(chainID)
+		if !ok {
+			continue
+		}
+		// Walk back from the tip, the earliest dependent block is the one to reset to.
+		for num := latest; ; num-- {
+			ref, _, execMsgs, err := d.OpenBlock(chainID, num)
+			if errors.Is(err, types.ErrSkipped) {
+				break // reached the start of the database
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to open block %d of chain %s: %w", num, chainID, err)
+			}
+			if ref.Time < invalidated.Timestamp {
+				break
+			}
+			for _, msg := range execMsgs {
+				if msg.Chain == reorgedIndex && msg.BlockNum > newHead {
+					out[chainID] = ref
+					break
+				}
+			}
+			if num == 0 {
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// ReorgCoordinator propagates a local-unsafe reorg of one chain to the chains that depend on it.
+// The dependent blocks are rewound from the database, and the managed nodes of the dependent chains
+// are instructed to reset, rather than relying on each node to discover the inconsistency independently.
+// Since rewinding a dependent chain is a reorg itself, the reorg propagates until no dependents are left.
+type ReorgCoordinator struct {
+	logger log.Logger
+	d      ReorgDeps
+
+	emitter event.Emitter
+}
+
+var _ event.AttachEmitter = (*ReorgCoordinator)(nil)
+var _ event.Deriver = (*ReorgCoordinator)(nil)
+
+func NewReorgCoordinator(logger log.Logger, d ReorgDeps) *ReorgCoordinator {
+	return &ReorgCoordinator{
+		logger: logger,
+		d:      d,
+	}
+}
+
+func (c *ReorgCoordinator) AttachEmitter(em event.Emitter) {
+	c.emitter = em
+}
+
+func (c *ReorgCoordinator) OnEvent(ev event.Event) bool {
+	switch x := ev.(type) {
+	case superevents.LocalUnsafeRewindEvent:
+		c.onRewind(x.ChainID, x.NewHead, x.Invalidated)
+	default:
+		return false
+	}
+	return true
+}
+
+func (c *ReorgCoordinator) onRewind(chainID eth.ChainID, newHead uint64, invalidated types.BlockSeal) {
+	logger := c.logger.New("chain", chainID, "head", newHead, "invalidated", invalidated)
+	dependents, err := ReorgDependents(c.d, chainID, newHead, invalidated)
+	if err != nil {
+		logger.Error("Failed to determine blocks depending on reorged chain", "err", err)
+		return
+	}
+	for depChainID, dependent := range dependents {
+		if dependent.Number == 0 {
+			logger.Error("Cannot reorg genesis block of dependent chain", "dependentChain", depChainID, "dependent", dependent)
+			continue
+		}
+		logger.Warn("Reorging dependent chain", "dependentChain", depChainID, "dependent", dependent)
+		if err := c.d.Rewind(depChainID, dependent.Number-1); err != nil {
+			logger.Error("Failed to rewind dependent chain", "dependentChain", depChainID, "dependent", dependent, "err", err)
+			continue
+		}
+		c.emitter.Emit(superevents.DependentReorgEvent{
+			ChainID:   depChainID,
+			Dependent: types.BlockSealFromRef(dependent),
+			ResetTo:   dependent.ParentID(),
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package cross
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestReorgDependents(t *testing.T) {
+	chainA := eth.ChainIDFromUInt64(1)
+	chainB := eth.ChainIDFromUInt64(2)
+	chainC := eth.ChainIDFromUInt64(3)
+	d := newMockReorgDeps(chainA, chainB, chainC)
+	indexA, err := d.deps.ChainIndexFromID(chainA)
+	require.NoError(t, err)
+
+	// chain A: blocks 0..9, with timestamps 100..109
+	d.addBlocks(chainA, 10, nil)
+	// chain B executes messages of chain A block 4 and block 7
+	d.addBlocks(chainB, 10, map[uint64]*types.ExecutingMessage{
+		5: {Chain: indexA, BlockNum: 4, Timestamp: 104},
+		8: {Chain: indexA, BlockNum: 7, Timestamp: 107},
+	})
+	// chain C only executes a message of chain A that stays canonical
+	d.addBlocks(chainC, 10, map[uint64]*types.ExecutingMessage{
+		9: {Chain: indexA, BlockNum: 3, Timestamp: 103},
+	})
+
+	t.Run("dependents", func(t *testing.T) {
+		invalidated, err := d.blockSeal(chainA, 4)
+		require.NoError(t, err)
+		deps, err := ReorgDependents(d, chainA, 3, invalidated)
+		require.NoError(t, err)
+		require.Len(t, deps, 1)
+		require.Equal(t, uint64(5), deps[chainB].Number, "earliest dependent block")
+	})
+	t.Run("no dependents", func(t *testing.T) {
+		invalidated, err := d.blockSeal(chainA, 9)
+		require.NoError(t, err)
+		deps, err := ReorgDependents(d, chainA, 8, invalidated)
+		require.NoError(t, err)
+		require.Empty(t, deps)
+	})
+	t.Run("coordinator", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LevelDebug)
+		ex := event.NewGlobalSynchronous(context.Background())
+		eventSys := event.NewSystem(logger, ex)
+		eventSys.Register("reorg", NewReorgCoordinator(logger, d), event.DefaultRegisterOpts())
+		var reorgs []superevents.DependentReorgEvent
+		eventSys.Register("monitor", event.DeriverFunc(func(ev event.Event) bool {
+			if x, ok := ev.(superevents.DependentReorgEvent); ok {
+				reorgs = append(reorgs, x)
+				return true
+			}
+			return false
+		}), event.DefaultRegisterOpts())
+		emitter := eventSys.Register("test", nil, event.DefaultRegisterOpts())
+
+		invalidated, err := d.blockSeal(chainA, 7)
+		require.NoError(t, err)
+		emitter.Emit(superevents.LocalUnsafeRewindEvent{ChainID: chainA, NewHead: 6, Invalidated: invalidated})
+		require.NoError(t, ex.Drain())
+
+		require.Equal(t, map[eth.ChainID]uint64{chainB: 7}, d.rewinds)
+		require.Len(t, reorgs, 1)
+		require.Equal(t, chainB, reorgs[0].ChainID)
+		require.Equal(t, uint64(8), reorgs[0].Dependent.Number)
+		require.Equal(t, eth.BlockID{Hash: blockHash(chainB, 7), Number: 7}, reorgs[0].ResetTo)
+	})
+}
+
+type mockReorgDeps struct {
+	deps    mockDependencySet
+	blocks  map[eth.ChainID][]eth.BlockRef
+	msgs    map[eth.ChainID]map[uint64]*types.ExecutingMessage
+	rewinds map[eth.ChainID]uint64
+}
+
+var _ ReorgDeps = (*mockReorgDeps)(nil)
+
+func newMockReorgDeps(chains ...eth.ChainID) *mockReorgDeps {
+	return &mockReorgDeps{
+		deps:    mockDependencySet{chains: chains},
+		blocks:  make(map[eth.ChainID][]eth.BlockRef),
+		msgs:    make(map[eth.ChainID]map[uint64]*types.ExecutingMessage),
+		rewinds: make(map[eth.ChainID]uint64),
+	}
+}
+
+func blockHash(chainID eth.ChainID, num uint64) common.Hash {
+	id, _ := chainID.ToUInt32()
+	return common.Hash{byte(id), byte(num)}
+}
+
+func (m *mockReorgDeps) addBlocks(chainID eth.ChainID, count uint64, msgs map[uint64]*types.ExecutingMessage) {
+	for num := uint64(0); num < count; num++ {
+		ref := eth.BlockRef{Hash: blockHash(chainID, num), Number: num, Time: 100 + num}
+		if num > 0 {
+			ref.ParentHash = blockHash(chainID, num-1)
+		}
+		m.blocks[chainID] = append(m.blocks[chainID], ref)
+	}
+	m.msgs[chainID] = msgs
+}
+
+func (m *mockReorgDeps) blockSeal(chainID eth.ChainID, num uint64) (types.BlockSeal, error) {
+	ref, _, _, err := m.OpenBlock(chainID, num)
+	return types.BlockSealFromRef(ref), err
+}
+
+func (m *mockReorgDeps) DependencySet() depset.DependencySet {
+	return m.deps
+}
+
+func (m *mockReorgDeps) LatestBlockNum(chain eth.ChainID) (num uint64, ok bool) {
+	blocks := m.blocks[chain]
+	if len(blocks) == 0 {
+		return 0, false
+	}
+	return uint64(len(blocks) - 1), true
+}
+
+func (m *mockReorgDeps) OpenBlock(chainID eth.ChainID, blockNum uint64) (eth.BlockRef, uint32, map[uint32]*types.ExecutingMessage, error) {
+	blocks := m.blocks[chainID]
+	if blockNum >= uint64(len(blocks)) {
+		return eth.BlockRef{}, 0, nil, types.ErrFuture
+	}
+	var execMsgs map[uint32]*types.ExecutingMessage
+	if msg, ok := m.msgs[chainID][blockNum]; ok {
+		execMsgs = map[uint32]*types.ExecutingMessage{0: msg}
+	}
+	return blocks[blockNum], uint32(len(execMsgs)), execMsgs, nil
+}
+
+func (m *mockReorgDeps) Rewind(chain eth.ChainID, headBlockNum uint64) error {
+	m.rewinds[chain] = headBlockNum
+	m.blocks[chain] = m.blocks[chain][:headBlockNum+1]
+	return nil
+}
@@ -165,6 +165,9 @@ type mockDependencySet struct {
 	chainIDFromIndexfn func() (eth.ChainID, error)
 	canExecuteAtfn     func() (bool, error)
 	canInitiateAtfn    func() (bool, error)
+	chains             []eth.ChainID
+	// messageExpiryWindow is the expiry window, depset.DefaultMessageExpiryWindow if zero
+	messageExpiryWindow uint64
 }
 
 func (m mockDependencySet) CanExecuteAt(chain eth.ChainID, timestamp uint64) (bool, error) {
@@ -181,6 +184,13 @@ func (m mockDependencySet) CanInitiateAt(chain eth.ChainID, timestamp uint64) (b
 	return true, nil
 }
 
+func (m mockDependencySet) MessageExpiryWindow() uint64 {
+	if m.messageExpiryWindow == 0 {
+		return depset.DefaultMessageExpiryWindow
+	}
+	return m.messageExpiryWindow
+}
+
 func (m mockDependencySet) ChainIDFromIndex(index types.ChainIndex) (eth.ChainID, error) {
 	if m.chainIDFromIndexfn != nil {
 		return m.chainIDFromIndexfn()
@@ -199,7 +209,7 @@ func (m mockDependencySet) ChainIndexFromID(chain eth.ChainID) (types.ChainIndex
 }
 
 func (m mockDependencySet) Chains() []eth.ChainID {
-	return nil
+	return m.chains
 }
 
 func (m mockDependencySet) HasChain(chain eth.ChainID) bool {
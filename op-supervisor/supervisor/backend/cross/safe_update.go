@@ -113,6 +113,9 @@ type CrossSafeWorker struct {
 	logger  log.Logger
 	chainID eth.ChainID
 	d       CrossSafeDeps
+
+	// degraded pauses cross-safety promotion while the managed nodes of the chain disagree
+	degraded bool
 }
 
 func (c *CrossSafeWorker) OnEvent(ev event.Event) bool {
@@ -121,6 +124,10 @@ func (c *CrossSafeWorker) OnEvent(ev event.Event) bool {
 		if x.ChainID != c.chainID {
 			return false
 		}
+		if c.degraded {
+			c.logger.Debug("Chain is degraded, not promoting cross-safe blocks")
+			return true
+		}
 		if err := CrossSafeUpdate(c.logger, c.chainID, c.d); err != nil {
 			if errors.Is(err, types.ErrFuture) {
 				c.logger.Debug("Worker awaits additional blocks", "err", err)
@@ -128,6 +135,14 @@ func (c *CrossSafeWorker) OnEvent(ev event.Event) bool {
 				c.logger.Warn("Failed to process work", "err", err)
 			}
 		}
+	case superevents.ChainDegradedEvent:
+		if x.ChainID != c.chainID {
+			return false
+		}
+		if x.Degraded != c.degraded {
+			c.logger.Warn("Chain degraded status changed", "degraded", x.Degraded)
+		}
+		c.degraded = x.Degraded
 	default:
 		return false
 	}
@@ -1,7 +1,6 @@
 package cross
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -35,29 +34,14 @@ func CrossUnsafeHazards(d UnsafeStartDeps, chainID eth.ChainID,
 	// all with the same timestamp, without message cycles.
 
 	depSet := d.DependencySet()
-
-	if len(execMsgs) > 0 {
-		if ok, err := depSet.CanExecuteAt(chainID, candidate.Timestamp); err != nil {
-			return nil, fmt.Errorf("cannot check message execution of block %s (chain %s): %w", candidate, chainID, err)
-		} else if !ok {
-			return nil, fmt.Errorf("cannot execute messages in block %s (chain %s): %w", candidate, chainID, types.ErrConflict)
-		}
+	initChainIDs, err := ValidateExecutingMessages(depSet, chainID, candidate, execMsgs)
+	if err != nil {
+		return nil, err
 	}
 
 	// check all executing messages
-	for _, msg := range execMsgs {
-		initChainID, err := depSet.ChainIDFromIndex(msg.Chain)
-		if err != nil {
-			if errors.Is(err, types.ErrUnknownChain) {
-				err = fmt.Errorf("msg %s may not execute from unknown chain %s: %w", msg, msg.Chain, types.ErrConflict)
-			}
-			return nil, err
-		}
-		if ok, err := depSet.CanInitiateAt(initChainID, msg.Timestamp); err != nil {
-			return nil, fmt.Errorf("cannot check message initiation of msg %s (chain %s): %w", msg, chainID, err)
-		} else if !ok {
-			return nil, fmt.Errorf("cannot allow initiating message %s (chain %s): %w", msg, chainID, types.ErrConflict)
-		}
+	for i, msg := range execMsgs {
+		initChainID := initChainIDs[i]
 		if msg.Timestamp < candidate.Timestamp {
 			// If timestamp is older: invariant ensures non-cyclic ordering relative to other messages.
 			// Check that the block that they are included in is cross-safe already.
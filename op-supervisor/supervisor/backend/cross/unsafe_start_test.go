@@ -132,6 +132,20 @@ func TestCrossUnsafeHazards(t *testing.T) {
 		require.ErrorContains(t, err, "breaks timestamp invariant")
 		require.Empty(t, hazards)
 	})
+	t.Run("message expired", func(t *testing.T) {
+		usd := &mockUnsafeStartDeps{}
+		usd.deps = mockDependencySet{messageExpiryWindow: 5}
+		chainID := eth.ChainIDFromUInt64(0)
+		candidate := types.BlockSeal{Timestamp: 20}
+		em1 := &types.ExecutingMessage{Chain: types.ChainIndex(0), Timestamp: 14}
+		execMsgs := []*types.ExecutingMessage{em1}
+		// when the message is older than the expiry window of the dependency set,
+		// an error is returned
+		hazards, err := CrossUnsafeHazards(usd, chainID, candidate, execMsgs)
+		require.ErrorIs(t, err, types.ErrConflict)
+		require.ErrorContains(t, err, "expired")
+		require.Empty(t, hazards)
+	})
 	t.Run("timestamp is equal, Check returns error", func(t *testing.T) {
 		usd := &mockUnsafeStartDeps{}
 		usd.checkFn = func() (includedIn types.BlockSeal, err error) {
@@ -0,0 +1,235 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/entrydb"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/fromda"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/logs"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+// ChainCheckReport is the outcome of checking the databases of a single chain.
+type ChainCheckReport struct {
+	ChainID eth.ChainID
+
+	Logs         entrydb.CheckResult
+	LocalDerived entrydb.CheckResult
+	CrossDerived entrydb.CheckResult
+
+	// CrossRefErrs are the inconsistencies between the databases,
+	// e.g. a cross-safe block that is not local-safe, or a local-safe block that conflicts with the log DB.
+	CrossRefErrs []error
+
+	// Repaired is true if any of the databases was truncated to its last consistent point.
+	Repaired bool
+}
+
+// Consistent returns true if no inconsistency was found.
+func (r *ChainCheckReport) Consistent() bool {
+	return r.Logs.Consistent() && r.LocalDerived.Consistent() && r.CrossDerived.Consistent() && len(r.CrossRefErrs) == 0
+}
+
+// CheckChainDBs verifies the log DB, local-safe DB and cross-safe DB of the chain in the data directory,
+// each entry by entry, and then cross-checks their heads:
+// the cross-safe head must be local-safe, and the local-safe head must match the log DB, if the log DB has it.
+// If repair is set, every database is truncated to its last consistent point,
+// and heads that are inconsistent with the other databases are rewound, until all checks pass.
+// The supervisor must not be running while its databases are checked.
+func CheckChainDBs(logger log.Logger, chainID eth.ChainID, dataDir string, repair bool) (*ChainCheckReport, error) {
+	report := &ChainCheckReport{ChainID: chainID}
+
+	logsPath, err := prepLogDBPath(chainID, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	localPath, err := prepLocalDerivedFromDBPath(chainID, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	crossPath, err := prepCrossDerivedFromDBPath(chainID, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range []string{logsPath, localPath, crossPath} {
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("failed to find database of chain %s: %w", chainID, err)
+		}
+	}
+
+	report.Logs, err = checkStore[logs.EntryType, logs.Entry, logs.EntryBinary](logger, logsPath, repair,
+		func(store entrydb.EntryStore[logs.EntryType, logs.Entry]) entrydb.CheckResult {
+			result, last := logs.Check(store)
+			logger.Info("Checked log DB", "chain", chainID, "entries", result.Entries, "lastValid", result.LastValid, "lastSealed", last)
+			return result
+		})
+	if err != nil {
+		return nil, err
+	}
+	checkDerived := func(kind string) func(store entrydb.EntryStore[fromda.EntryType, fromda.Entry]) entrydb.CheckResult {
+		return func(store entrydb.EntryStore[fromda.EntryType, fromda.Entry]) entrydb.CheckResult {
+			result, last := fromda.Check(store)
+			logger.Info("Checked "+kind+" DB", "chain", chainID, "entries", result.Entries, "lastValid", result.LastValid,
+				"derivedFrom", last.DerivedFrom, "derived", last.Derived)
+			return result
+		}
+	}
+	report.LocalDerived, err = checkStore[fromda.EntryType, fromda.Entry, fromda.EntryBinary](logger, localPath, repair, checkDerived("local-safe"))
+	if err != nil {
+		return nil, err
+	}
+	report.CrossDerived, err = checkStore[fromda.EntryType, fromda.Entry, fromda.EntryBinary](logger, crossPath, repair, checkDerived("cross-safe"))
+	if err != nil {
+		return nil, err
+	}
+	if !(report.Logs.Consistent() && report.LocalDerived.Consistent() && report.CrossDerived.Consistent()) {
+		if !repair {
+			// The heads can only be cross-checked once the databases themselves are consistent.
+			return report, nil
+		}
+		report.Repaired = true
+	}
+
+	m := &noopCheckMetrics{}
+	logDB, err := logs.NewFromFile(logger, m, logsPath, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log DB: %w", err)
+	}
+	defer logDB.Close()
+	localDB, err := fromda.NewFromFile(logger, m, localPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open local-safe DB: %w", err)
+	}
+	defer localDB.Close()
+	crossDB, err := fromda.NewFromFile(logger, m, crossPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cross-safe DB: %w", err)
+	}
+	defer crossDB.Close()
+
+	for {
+		errs, err := crossCheckHeads(logger, logDB, localDB, crossDB, repair)
+		if err != nil {
+			return nil, err
+		}
+		if len(errs) == 0 {
+			return report, nil
+		}
+		if !repair {
+			report.CrossRefErrs = errs
+			return report, nil
+		}
+		report.Repaired = true
+	}
+}
+
+// checkStore opens the entry DB at the given path, checks it, and truncates it to its last valid entry if repair is set.
+func checkStore[T entrydb.EntryType, E entrydb.Entry[T], B entrydb.Binary[T, E]](logger log.Logger, path string, repair bool,
+	check func(store entrydb.EntryStore[T, E]) entrydb.CheckResult) (entrydb.CheckResult, error) {
+	store, err := entrydb.NewEntryDB[T, E, B](logger, path)
+	if err != nil {
+		return entrydb.CheckResult{}, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer store.Close()
+	result := check(store)
+	if result.Consistent() {
+		return result, nil
+	}
+	logger.Warn("Inconsistent database", "path", path, "lastValid", result.LastValid, "err", result.Err)
+	if !repair {
+		return result, nil
+	}
+	logger.Warn("Truncating database to last consistent entry", "path", path, "lastValid", result.LastValid,
+		"removed", result.Entries-int64(result.LastValid)-1)
+	if err := store.Truncate(result.LastValid); err != nil {
+		return result, fmt.Errorf("failed to truncate %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// crossCheckHeads checks the heads of the databases against each other.
+// If repair is set, the first inconsistent head is rewound, and the inconsistency is returned, to check again.
+func crossCheckHeads(logger log.Logger, logDB *logs.DB, localDB, crossDB *fromda.DB, repair bool) ([]error, error) {
+	var errs []error
+
+	_, localDerived, err := localDB.Latest()
+	if errors.Is(err, types.ErrFuture) {
+		logger.Info("Local-safe DB is empty")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read local-safe head: %w", err)
+	} else if logDerived, err := logDB.FindSealedBlock(localDerived.Number); errors.Is(err, types.ErrFuture) {
+		// The log DB may lag behind the local-safe DB, it is synced from the node independently.
+		logger.Info("Log DB is behind local-safe head", "localSafe", localDerived)
+	} else if err != nil {
+		logger.Warn("Cannot find local-safe head in log DB", "localSafe", localDerived, "err", err)
+	} else if logDerived != localDerived {
+		errs = append(errs, fmt.Errorf("%w: local-safe head %s conflicts with log DB block %s", types.ErrConflict, localDerived, logDerived))
+		if repair {
+			logger.Warn("Rewinding log DB to before conflicting local-safe head", "localSafe", localDerived, "logs", logDerived)
+			if err := rewindLogDB(logDB, localDerived.Number); err != nil {
+				return nil, err
+			}
+			return errs, nil
+		}
+	}
+
+	_, crossDerived, err := crossDB.Latest()
+	if errors.Is(err, types.ErrFuture) {
+		logger.Info("Cross-safe DB is empty")
+		return errs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read cross-safe head: %w", err)
+	}
+	if _, err := localDB.DerivedFrom(crossDerived.ID()); errors.Is(err, types.ErrFuture) {
+		errs = append(errs, fmt.Errorf("%w: cross-safe head %s is ahead of local-safe head %s", types.ErrDataCorruption, crossDerived, localDerived))
+		if repair {
+			logger.Warn("Rewinding cross-safe DB to local-safe head", "crossSafe", crossDerived, "localSafe", localDerived)
+			if err := crossDB.RewindDerived(localDerived.Number); err != nil {
+				return nil, fmt.Errorf("failed to rewind cross-safe DB: %w", err)
+			}
+			return errs, nil
+		}
+	} else if err != nil {
+		errs = append(errs, fmt.Errorf("cross-safe head %s is not local-safe: %w", crossDerived, err))
+		if repair {
+			logger.Warn("Rewinding cross-safe DB to before conflicting head", "crossSafe", crossDerived)
+			if err := rewindDerivedDB(crossDB, crossDerived.Number); err != nil {
+				return nil, fmt.Errorf("failed to rewind cross-safe DB: %w", err)
+			}
+			return errs, nil
+		}
+	}
+	return errs, nil
+}
+
+// rewindLogDB rewinds the log DB to the block before the given block.
+func rewindLogDB(logDB *logs.DB, conflicting uint64) error {
+	if conflicting == 0 {
+		return errors.New("cannot rewind log DB to before genesis")
+	}
+	if err := logDB.Rewind(conflicting - 1); err != nil {
+		return fmt.Errorf("failed to rewind log DB: %w", err)
+	}
+	return nil
+}
+
+// rewindDerivedDB rewinds the derived-from DB to the last entry of the block before the given derived block.
+func rewindDerivedDB(derivedDB *fromda.DB, conflicting uint64) error {
+	if conflicting == 0 {
+		return errors.New("cannot rewind derived-from DB to before genesis")
+	}
+	return derivedDB.RewindDerived(conflicting - 1)
+}
+
+type noopCheckMetrics struct{}
+
+func (*noopCheckMetrics) RecordDBEntryCount(kind string, count int64) {}
+
+func (*noopCheckMetrics) RecordDBSearchEntriesRead(count int64) {}
+
+func (*noopCheckMetrics) RecordDBDerivedEntryCount(count int64) {}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestCheckChainDBs(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(900)
+	l1 := func(i uint64) eth.BlockRef {
+		return eth.BlockRef{Hash: common.Hash{0x01, byte(i)}, Number: i, ParentHash: common.Hash{0x01, byte(i - 1)}, Time: 1000 + i*12}
+	}
+	l2 := func(i uint64) eth.BlockRef {
+		return eth.BlockRef{Hash: common.Hash{0x02, byte(i)}, Number: i, ParentHash: common.Hash{0x02, byte(i - 1)}, Time: 1000 + i*2}
+	}
+
+	// setup writes a log DB with L2 blocks 0 to 3, a local-safe DB up to L2 block 2, and a cross-safe DB up to crossHead.
+	setup := func(t *testing.T, crossHead uint64) string {
+		logger := testlog.Logger(t, log.LevelInfo)
+		dataDir := t.TempDir()
+		m := &noopCheckMetrics{}
+		logDB, err := OpenLogDB(logger, chainID, dataDir, m)
+		require.NoError(t, err)
+		require.NoError(t, logDB.SealBlock(common.Hash{}, l2(0).ID(), l2(0).Time))
+		for i := uint64(1); i <= 3; i++ {
+			require.NoError(t, logDB.SealBlock(l2(i-1).Hash, l2(i).ID(), l2(i).Time))
+		}
+		require.NoError(t, logDB.Close())
+
+		localDB, err := OpenLocalDerivedFromDB(logger, chainID, dataDir, m)
+		require.NoError(t, err)
+		crossDB, err := OpenCrossDerivedFromDB(logger, chainID, dataDir, m)
+		require.NoError(t, err)
+		for i := uint64(0); i <= 3; i++ {
+			if i <= 2 {
+				require.NoError(t, localDB.AddDerived(l1(i), l2(i)))
+			}
+			if i <= crossHead {
+				require.NoError(t, crossDB.AddDerived(l1(i), l2(i)))
+			}
+		}
+		require.NoError(t, localDB.Close())
+		require.NoError(t, crossDB.Close())
+		return dataDir
+	}
+
+	t.Run("Consistent", func(t *testing.T) {
+		dataDir := setup(t, 1)
+		report, err := CheckChainDBs(testlog.Logger(t, log.LevelInfo), chainID, dataDir, false)
+		require.NoError(t, err)
+		require.True(t, report.Consistent())
+		require.False(t, report.Repaired)
+	})
+
+	t.Run("CrossSafeAheadOfLocalSafe", func(t *testing.T) {
+		dataDir := setup(t, 3)
+		logger := testlog.Logger(t, log.LevelInfo)
+		report, err := CheckChainDBs(logger, chainID, dataDir, false)
+		require.NoError(t, err)
+		require.False(t, report.Consistent())
+		require.Len(t, report.CrossRefErrs, 1)
+
+		report, err = CheckChainDBs(logger, chainID, dataDir, true)
+		require.NoError(t, err)
+		require.True(t, report.Repaired)
+
+		report, err = CheckChainDBs(logger, chainID, dataDir, false)
+		require.NoError(t, err)
+		require.True(t, report.Consistent())
+		crossDB, err := OpenCrossDerivedFromDB(logger, chainID, dataDir, &noopCheckMetrics{})
+		require.NoError(t, err)
+		defer crossDB.Close()
+		_, derived, err := crossDB.Latest()
+		require.NoError(t, err)
+		require.Equal(t, l2(2).ID(), derived.ID())
+	})
+
+	t.Run("MissingDB", func(t *testing.T) {
+		_, err := CheckChainDBs(testlog.Logger(t, log.LevelInfo), chainID, t.TempDir(), false)
+		require.Error(t, err)
+	})
+}
@@ -46,6 +46,9 @@ type LogStorage interface {
 	// This seal may be fully zeroed, without error, if the block isn't fully known yet.
 	Contains(blockNum uint64, logIdx uint32, logHash common.Hash) (includedIn types.BlockSeal, err error)
 
+	// Get returns the hash of the log at the specified blockNum and logIdx.
+	Get(blockNum uint64, logIdx uint32) (common.Hash, error)
+
 	// OpenBlock accumulates the ExecutingMessage events for a block and returns them
 	OpenBlock(blockNum uint64) (ref eth.BlockRef, logCount uint32, execMsgs map[uint32]*types.ExecutingMessage, err error)
 }
@@ -93,6 +96,10 @@ type ChainsDB struct {
 	// an error until it has this L1 finality to work with.
 	finalizedL1 locks.RWValue[eth.L1BlockRef]
 
+	// finalizedL2: the finalized L2 block of every chain, promoted together from the finalized L1 block.
+	// It is nil until all chains have a finalized L2 block.
+	finalizedL2 locks.RWValue[map[eth.ChainID]types.BlockSeal]
+
 	// depSet is the dependency set, used to determine what may be tracked,
 	// what is missing, and to provide it to DB users.
 	depSet depset.DependencySet
@@ -0,0 +1,17 @@
+package entrydb
+
+// CheckResult is the outcome of verifying the entries of a database, from its first entry onwards.
+type CheckResult struct {
+	// Entries is the total number of entries in the database.
+	Entries int64
+	// LastValid is the index of the last entry of the longest consistent prefix of the database.
+	// It is -1 if not even the first entry is consistent.
+	LastValid EntryIdx
+	// Err is the first inconsistency found after the consistent prefix. Nil if all entries are consistent.
+	Err error
+}
+
+// Consistent returns true if no inconsistency was found.
+func (r *CheckResult) Consistent() bool {
+	return r.Err == nil
+}
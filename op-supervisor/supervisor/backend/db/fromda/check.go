@@ -0,0 +1,114 @@
+package fromda
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/entrydb"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+type linkInvariant func(prev, current LinkEntry) error
+
+var linkInvariants = []linkInvariant{
+	invariantDerivedTimestamp,
+	invariantDerivedFromTimestamp,
+	invariantNumberIncrement,
+	invariantRepeatedHash,
+}
+
+// Check verifies the entries of the store from the first entry onwards:
+// the entry encoding, and that every link extends the previous link.
+// The last link of the consistent prefix is returned with the result.
+func Check(store EntryStore) (entrydb.CheckResult, types.DerivedBlockSealPair) {
+	result := entrydb.CheckResult{Entries: store.Size(), LastValid: -1}
+	var prev LinkEntry
+	for idx := entrydb.EntryIdx(0); ; idx++ {
+		entry, err := store.Read(idx)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			result.Err = fmt.Errorf("failed to read entry %d: %w", idx, err)
+			break
+		}
+		var link LinkEntry
+		if err := link.decode(entry); err != nil {
+			result.Err = fmt.Errorf("failed to decode entry %d: %w", idx, err)
+			break
+		}
+		if idx > 0 {
+			for _, invariant := range linkInvariants {
+				if err := invariant(prev, link); err != nil {
+					result.Err = fmt.Errorf("%w: entry %d: %w", types.ErrDataCorruption, idx, err)
+					break
+				}
+			}
+			if result.Err != nil {
+				break
+			}
+		}
+		result.LastValid = idx
+		prev = link
+	}
+	return result, types.DerivedBlockSealPair{DerivedFrom: prev.derivedFrom, Derived: prev.derived}
+}
+
+// RewindDerived removes all entries after the last entry that derived the L2 block with the given number.
+func (db *DB) RewindDerived(derived uint64) error {
+	db.rwLock.Lock()
+	defer db.rwLock.Unlock()
+	index, _, err := db.lastDerivedFrom(derived)
+	if err != nil {
+		return fmt.Errorf("failed to find point to rewind to: %w", err)
+	}
+	if err := db.store.Truncate(index); err != nil {
+		return err
+	}
+	db.m.RecordDBDerivedEntryCount(int64(index) + 1)
+	return nil
+}
+
+func invariantDerivedTimestamp(prev, current LinkEntry) error {
+	if current.derived.Timestamp < prev.derived.Timestamp {
+		return fmt.Errorf("derived timestamp must be >=, current: %s, prev: %s", current.derived, prev.derived)
+	}
+	return nil
+}
+
+func invariantNumberIncrement(prev, current LinkEntry) error {
+	// derived stays the same if the new L1 block is empty.
+	derivedSame := current.derived.Number == prev.derived.Number
+	// derivedFrom stays the same if this L2 block is derived from the same L1 block as the last L2 block
+	derivedFromSame := current.derivedFrom.Number == prev.derivedFrom.Number
+	// At least one of the two must increment, otherwise we are just repeating data in the DB.
+	if derivedSame && derivedFromSame {
+		return fmt.Errorf("expected at least either derivedFrom or derived to increment, but both have same number")
+	}
+	derivedIncrement := current.derived.Number == prev.derived.Number+1
+	derivedFromIncrement := current.derivedFrom.Number == prev.derivedFrom.Number+1
+	if !(derivedSame || derivedIncrement) {
+		return fmt.Errorf("expected derived to either stay the same or increment, got prev %s current %s", prev.derived, current.derived)
+	}
+	if !(derivedFromSame || derivedFromIncrement) {
+		return fmt.Errorf("expected derivedFrom to either stay the same or increment, got prev %s current %s", prev.derivedFrom, current.derivedFrom)
+	}
+	return nil
+}
+
+func invariantDerivedFromTimestamp(prev, current LinkEntry) error {
+	if current.derivedFrom.Timestamp < prev.derivedFrom.Timestamp {
+		return fmt.Errorf("derivedFrom timestamp must be >=, current: %s, prev: %s", current.derivedFrom, prev.derivedFrom)
+	}
+	return nil
+}
+
+func invariantRepeatedHash(prev, current LinkEntry) error {
+	if current.derived.Number == prev.derived.Number && current.derived != prev.derived {
+		return fmt.Errorf("repeated derived block must be identical, current: %s, prev: %s", current.derived, prev.derived)
+	}
+	if current.derivedFrom.Number == prev.derivedFrom.Number && current.derivedFrom != prev.derivedFrom {
+		return fmt.Errorf("repeated derivedFrom block must be identical, current: %s, prev: %s", current.derivedFrom, prev.derivedFrom)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package fromda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/entrydb"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func setupCheckDB(t *testing.T) (*DB, *entrydb.MemEntryStore[EntryType, Entry]) {
+	store := &entrydb.MemEntryStore[EntryType, Entry]{}
+	db, err := NewFromEntryStore(testlog.Logger(t, log.LevelInfo), &stubMetrics{}, store)
+	require.NoError(t, err)
+	require.NoError(t, db.AddDerived(toRef(mockL1(0), common.Hash{}), toRef(mockL2(0), common.Hash{})))
+	require.NoError(t, db.AddDerived(toRef(mockL1(1), mockL1(0).Hash), toRef(mockL2(1), mockL2(0).Hash)))
+	require.NoError(t, db.AddDerived(toRef(mockL1(1), mockL1(0).Hash), toRef(mockL2(2), mockL2(1).Hash)))
+	require.NoError(t, db.AddDerived(toRef(mockL1(2), mockL1(1).Hash), toRef(mockL2(2), mockL2(1).Hash)))
+	require.NoError(t, db.AddDerived(toRef(mockL1(3), mockL1(2).Hash), toRef(mockL2(3), mockL2(2).Hash)))
+	return db, store
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("Consistent", func(t *testing.T) {
+		_, store := setupCheckDB(t)
+		result, last := Check(store)
+		require.NoError(t, result.Err)
+		require.Equal(t, store.LastEntryIdx(), result.LastValid)
+		require.Equal(t, types.DerivedBlockSealPair{DerivedFrom: mockL1(3), Derived: mockL2(3)}, last)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		result, _ := Check(&entrydb.MemEntryStore[EntryType, Entry]{})
+		require.NoError(t, result.Err)
+		require.EqualValues(t, -1, result.LastValid)
+	})
+
+	corrupt := func(t *testing.T, store *entrydb.MemEntryStore[EntryType, Entry], idx entrydb.EntryIdx, fn func(link *LinkEntry)) {
+		var entries []Entry
+		for i := entrydb.EntryIdx(0); i <= store.LastEntryIdx(); i++ {
+			entry, err := store.Read(i)
+			require.NoError(t, err)
+			if i == idx {
+				var link LinkEntry
+				require.NoError(t, link.decode(entry))
+				fn(&link)
+				entry = link.encode()
+			}
+			entries = append(entries, entry)
+		}
+		require.NoError(t, store.Truncate(-1))
+		require.NoError(t, store.Append(entries...))
+	}
+
+	t.Run("SkippedDerived", func(t *testing.T) {
+		_, store := setupCheckDB(t)
+		corrupt(t, store, 2, func(link *LinkEntry) { link.derived = mockL2(3) })
+		result, last := Check(store)
+		require.ErrorIs(t, result.Err, types.ErrDataCorruption)
+		require.EqualValues(t, 1, result.LastValid)
+		require.Equal(t, types.DerivedBlockSealPair{DerivedFrom: mockL1(1), Derived: mockL2(1)}, last)
+	})
+
+	t.Run("RepeatedWithDifferentHash", func(t *testing.T) {
+		_, store := setupCheckDB(t)
+		corrupt(t, store, 3, func(link *LinkEntry) { link.derived.Hash = common.Hash{0xaa} })
+		result, _ := Check(store)
+		require.ErrorIs(t, result.Err, types.ErrDataCorruption)
+		require.EqualValues(t, 2, result.LastValid)
+	})
+
+	t.Run("UnknownEntryType", func(t *testing.T) {
+		_, store := setupCheckDB(t)
+		entries := []Entry{}
+		for i := entrydb.EntryIdx(0); i <= store.LastEntryIdx(); i++ {
+			entry, err := store.Read(i)
+			require.NoError(t, err)
+			entries = append(entries, entry)
+		}
+		entries[4][0] = 0xff
+		require.NoError(t, store.Truncate(-1))
+		require.NoError(t, store.Append(entries...))
+		result, _ := Check(store)
+		require.ErrorIs(t, result.Err, types.ErrDataCorruption)
+		require.EqualValues(t, 3, result.LastValid)
+	})
+}
+
+func TestRewindDerived(t *testing.T) {
+	db, store := setupCheckDB(t)
+	require.NoError(t, db.RewindDerived(2))
+	// Both entries that derived L2 block 2 are retained
+	require.EqualValues(t, 3, store.LastEntryIdx())
+	derivedFrom, derived, err := db.Latest()
+	require.NoError(t, err)
+	require.Equal(t, mockL1(2), derivedFrom)
+	require.Equal(t, mockL2(2), derived)
+
+	require.ErrorIs(t, db.RewindDerived(5), types.ErrFuture)
+}
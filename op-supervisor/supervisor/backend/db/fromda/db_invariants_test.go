@@ -10,7 +10,6 @@ import (
 )
 
 type statInvariant func(stat os.FileInfo, m *stubMetrics) error
-type linkInvariant func(prev, current LinkEntry) error
 
 // checkDBInvariants reads the database log directly and asserts a set of invariants on the data.
 func checkDBInvariants(t *testing.T, dbPath string, m *stubMetrics) {
@@ -41,11 +40,6 @@ func checkDBInvariants(t *testing.T, dbPath string, m *stubMetrics) {
 		links = append(links, v)
 	}
 
-	linkInvariants := []linkInvariant{
-		invariantDerivedTimestamp,
-		invariantDerivedFromTimestamp,
-		invariantNumberIncrement,
-	}
 	for i, link := range links {
 		if i == 0 {
 			continue
@@ -82,37 +76,3 @@ func invariantFileSizeMatchesEntryCountMetric(stat os.FileInfo, m *stubMetrics)
 	}
 	return nil
 }
-
-func invariantDerivedTimestamp(prev, current LinkEntry) error {
-	if current.derived.Timestamp < prev.derived.Timestamp {
-		return fmt.Errorf("derived timestamp must be >=, current: %s, prev: %s", current.derived, prev.derived)
-	}
-	return nil
-}
-
-func invariantNumberIncrement(prev, current LinkEntry) error {
-	// derived stays the same if the new L1 block is empty.
-	derivedSame := current.derived.Number == prev.derived.Number
-	// derivedFrom stays the same if this L2 block is derived from the same L1 block as the last L2 block
-	derivedFromSame := current.derivedFrom.Number == prev.derivedFrom.Number
-	// At least one of the two must increment, otherwise we are just repeating data in the DB.
-	if derivedSame && derivedFromSame {
-		return fmt.Errorf("expected at least either derivedFrom or derived to increment, but both have same number")
-	}
-	derivedIncrement := current.derived.Number == prev.derived.Number+1
-	derivedFromIncrement := current.derivedFrom.Number == prev.derivedFrom.Number+1
-	if !(derivedSame || derivedIncrement) {
-		return fmt.Errorf("expected derived to either stay the same or increment, got prev %s current %s", prev.derived, current.derived)
-	}
-	if !(derivedFromSame || derivedFromIncrement) {
-		return fmt.Errorf("expected derivedFrom to either stay the same or increment, got prev %s current %s", prev.derivedFrom, current.derivedFrom)
-	}
-	return nil
-}
-
-func invariantDerivedFromTimestamp(prev, current LinkEntry) error {
-	if current.derivedFrom.Timestamp < prev.derivedFrom.Timestamp {
-		return fmt.Errorf("derivedFrom timestamp must be >=, current: %s, prev: %s", current.derivedFrom, prev.derivedFrom)
-	}
-	return nil
-}
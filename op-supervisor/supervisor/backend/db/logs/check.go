@@ -0,0 +1,60 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/entrydb"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+// Check verifies the entries of the store from the first entry onwards: the entry encoding,
+// the entry ordering, the search checkpoints at every searchCheckpointFrequency entries,
+// and that every sealed block extends the previously sealed block.
+// The returned result ends at the last sealed block of the consistent prefix, which is returned too.
+// Trailing entries of an unsealed block are not an inconsistency; they are trimmed when opening the DB.
+func Check(store entrydb.EntryStore[EntryType, Entry]) (entrydb.CheckResult, types.BlockSeal) {
+	result := entrydb.CheckResult{Entries: store.Size(), LastValid: -1}
+	var last types.BlockSeal
+	state := logContext{}
+	for {
+		idx := state.nextEntryIndex
+		entry, err := store.Read(idx)
+		if errors.Is(err, io.EOF) {
+			return result, last
+		} else if err != nil {
+			result.Err = fmt.Errorf("failed to read entry %d: %w", idx, err)
+			return result, last
+		}
+		if idx%searchCheckpointFrequency == 0 && entry.Type() != TypeSearchCheckpoint {
+			result.Err = fmt.Errorf("%w: expected search checkpoint at entry %d, but got %s entry", types.ErrDataCorruption, idx, entry.Type())
+			return result, last
+		}
+		if err := state.ApplyEntry(entry); err != nil {
+			result.Err = fmt.Errorf("%w: %w", types.ErrDataCorruption, err)
+			return result, last
+		}
+		if entry.Type() != TypeCanonicalHash {
+			continue
+		}
+		seal := types.BlockSeal{Hash: state.blockHash, Number: state.blockNum, Timestamp: state.timestamp}
+		if result.LastValid >= 0 && seal.Number == last.Number {
+			// search checkpoints repeat the last sealed block
+			if seal != last {
+				result.Err = fmt.Errorf("%w: entry %d repeats block %d as %s, but it was sealed as %s",
+					types.ErrDataCorruption, idx, seal.Number, seal, last)
+				return result, last
+			}
+		} else if result.LastValid >= 0 && (seal.Number != last.Number+1 || seal.Timestamp < last.Timestamp) {
+			result.Err = fmt.Errorf("%w: entry %d seals %s, which does not extend previously sealed %s",
+				types.ErrDataCorruption, idx, seal, last)
+			return result, last
+		}
+		if state.logsSince == 0 {
+			// only a freshly sealed block, without logs of the next block yet, is a consistent point
+			result.LastValid = idx
+			last = seal
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/entrydb"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestCheck(t *testing.T) {
+	block0 := eth.BlockID{Hash: createHash(0), Number: 0}
+	block1 := eth.BlockID{Hash: createHash(1), Number: 1}
+	block2 := eth.BlockID{Hash: createHash(2), Number: 2}
+
+	setup := func(t *testing.T) (*entrydb.MemEntryStore[EntryType, Entry], entrydb.EntryIdx) {
+		store := &entrydb.MemEntryStore[EntryType, Entry]{}
+		db, err := NewFromEntryStore(testlog.Logger(t, log.LevelInfo), &stubMetrics{}, store, false)
+		require.NoError(t, err)
+		require.NoError(t, db.SealBlock(common.Hash{}, block0, 5000))
+		require.NoError(t, db.AddLog(createHash(10), block0, 0, nil))
+		require.NoError(t, db.SealBlock(block0.Hash, block1, 5002))
+		block1End := store.LastEntryIdx()
+		require.NoError(t, db.AddLog(createHash(20), block1, 0, nil))
+		require.NoError(t, db.AddLog(createHash(21), block1, 1, nil))
+		require.NoError(t, db.SealBlock(block1.Hash, block2, 5004))
+		return store, block1End
+	}
+
+	t.Run("Consistent", func(t *testing.T) {
+		store, _ := setup(t)
+		result, last := Check(store)
+		require.NoError(t, result.Err)
+		require.Equal(t, store.LastEntryIdx(), result.LastValid)
+		require.Equal(t, store.Size(), result.Entries)
+		require.Equal(t, types.BlockSeal{Hash: block2.Hash, Number: 2, Timestamp: 5004}, last)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		result, _ := Check(&entrydb.MemEntryStore[EntryType, Entry]{})
+		require.NoError(t, result.Err)
+		require.EqualValues(t, -1, result.LastValid)
+	})
+
+	t.Run("TrailingUnsealed", func(t *testing.T) {
+		store, _ := setup(t)
+		sealed := store.LastEntryIdx()
+		require.NoError(t, store.Append(newInitiatingEvent(createHash(30), false).encode()))
+		result, _ := Check(store)
+		require.NoError(t, result.Err)
+		require.Equal(t, sealed, result.LastValid)
+	})
+
+	t.Run("CorruptEntry", func(t *testing.T) {
+		store, block1End := setup(t)
+		entries := readAll(t, store)
+		corrupt := block1End + 2
+		entries[corrupt][0] = 0xff // unknown entry type
+		require.NoError(t, store.Truncate(-1))
+		require.NoError(t, store.Append(entries...))
+
+		result, last := Check(store)
+		require.ErrorIs(t, result.Err, types.ErrDataCorruption)
+		require.Equal(t, block1End, result.LastValid)
+		require.Equal(t, types.BlockSeal{Hash: block1.Hash, Number: 1, Timestamp: 5002}, last)
+	})
+
+	t.Run("NonContiguousBlocks", func(t *testing.T) {
+		store, block1End := setup(t)
+		entries := readAll(t, store)
+		// Replace the checkpoint that seals block 2 with one that seals block 3
+		last := len(entries) - 2
+		require.Equal(t, TypeSearchCheckpoint, entries[last].Type())
+		entries[last] = newSearchCheckpoint(3, 0, 5004).encode()
+		require.NoError(t, store.Truncate(-1))
+		require.NoError(t, store.Append(entries...))
+
+		result, _ := Check(store)
+		require.ErrorIs(t, result.Err, types.ErrDataCorruption)
+		require.Equal(t, block1End, result.LastValid)
+	})
+}
+
+func readAll(t *testing.T, store entrydb.EntryStore[EntryType, Entry]) []Entry {
+	var entries []Entry
+	for i := entrydb.EntryIdx(0); i <= store.LastEntryIdx(); i++ {
+		entry, err := store.Read(i)
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	return entries
+}
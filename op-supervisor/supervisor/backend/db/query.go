@@ -3,6 +3,7 @@ package db
 import (
 	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -166,12 +167,36 @@ func (db *ChainsDB) FinalizedL1() eth.BlockRef {
 	return db.finalizedL1.Get()
 }
 
+// Finalized returns the finalized L2 block of the chain.
+// The finalized blocks of all chains are promoted together, see FinalizedChains.
 func (db *ChainsDB) Finalized(chainID eth.ChainID) (types.BlockSeal, error) {
-	finalizedL1 := db.finalizedL1.Get()
-	if finalizedL1 == (eth.L1BlockRef{}) {
-		return types.BlockSeal{}, fmt.Errorf("no finalized L1 signal, cannot determine L2 finality of chain %s yet", chainID)
+	finalized, err := db.FinalizedChains()
+	if err != nil {
+		return types.BlockSeal{}, fmt.Errorf("cannot determine L2 finality of chain %s yet: %w", chainID, err)
 	}
+	seal, ok := finalized[chainID]
+	if !ok {
+		return types.BlockSeal{}, types.ErrUnknownChain
+	}
+	return seal, nil
+}
+
+// FinalizedChains returns the finalized L2 block of every chain in the dependency set.
+// The blocks are promoted together when the finalized L1 block or the cross-safe data changes,
+// so the result is a consistent view across all chains.
+func (db *ChainsDB) FinalizedChains() (map[eth.ChainID]types.BlockSeal, error) {
+	finalized := db.finalizedL2.Get()
+	if finalized == nil {
+		if db.finalizedL1.Get() == (eth.L1BlockRef{}) {
+			return nil, errors.New("no finalized L1 signal")
+		}
+		return nil, errors.New("finalized L2 blocks not determined for all chains")
+	}
+	return maps.Clone(finalized), nil
+}
 
+// finalizedAt determines the last L2 block of the chain that was derived from the finalized L1 block.
+func (db *ChainsDB) finalizedAt(chainID eth.ChainID, finalizedL1 eth.BlockRef) (types.BlockSeal, error) {
 	// compare the finalized L1 block with the last derived block in the cross DB
 	xDB, ok := db.crossDBs.Get(chainID)
 	if !ok {
@@ -184,7 +209,7 @@ func (db *ChainsDB) Finalized(chainID eth.ChainID) (types.BlockSeal, error) {
 	// if the finalized L1 block is newer than the latest L1 block used to derive L2 blocks,
 	// the finality signal automatically applies to all previous blocks, including the latest derived block
 	if finalizedL1.Number > latestDerivedFrom.Number {
-		db.logger.Warn("Finalized L1 block is newer than the latest L1 for this chain. Assuming latest L2 is finalized",
+		db.logger.Debug("Finalized L1 block is newer than the latest L1 for this chain. Assuming latest L2 is finalized",
 			"chain", chainID,
 			"finalizedL1", finalizedL1.Number,
 			"latestDerivedFrom", latestDerivedFrom.Number,
@@ -252,6 +277,24 @@ func (db *ChainsDB) Check(chain eth.ChainID, blockNum uint64, timestamp uint64,
 	return includedIn, nil
 }
 
+// CheckAccess checks that the access-list declaration of an executing message matches
+// the initiating message at the declared location, and returns the block the message was included in.
+// If the checksum does not match the log, then ErrConflict is returned.
+func (db *ChainsDB) CheckAccess(access types.Access) (includedIn types.BlockSeal, err error) {
+	logDB, ok := db.logDBs.Get(access.ChainID)
+	if !ok {
+		return types.BlockSeal{}, fmt.Errorf("%w: %v", types.ErrUnknownChain, access.ChainID)
+	}
+	logHash, err := logDB.Get(access.BlockNumber, access.LogIndex)
+	if err != nil {
+		return types.BlockSeal{}, err
+	}
+	if checksum := access.ChecksumArgs(logHash).Checksum(); checksum != access.Checksum {
+		return types.BlockSeal{}, fmt.Errorf("checksum mismatch: expected %s, got %s: %w", checksum, access.Checksum, types.ErrConflict)
+	}
+	return db.Check(access.ChainID, access.BlockNumber, access.Timestamp, access.LogIndex, logHash)
+}
+
 // OpenBlock returns the Executing Messages for the block at the given number on the given chain.
 // it routes the request to the appropriate logDB.
 func (db *ChainsDB) OpenBlock(chainID eth.ChainID, blockNum uint64) (seal eth.BlockRef, logCount uint32, execMsgs map[uint32]*types.ExecutingMessage, err error) {
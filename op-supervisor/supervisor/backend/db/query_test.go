@@ -0,0 +1,71 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestCheckAccess(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(900)
+	depSet, err := depset.NewStaticConfigDependencySet(
+		map[eth.ChainID]*depset.StaticConfigDependency{
+			chainID: {ChainIndex: 900},
+		})
+	require.NoError(t, err)
+	l2 := func(i uint64) eth.BlockRef {
+		return eth.BlockRef{Hash: common.Hash{0x02, byte(i)}, Number: i, ParentHash: common.Hash{0x02, byte(i - 1)}, Time: 1000 + i*2}
+	}
+
+	logger := testlog.Logger(t, log.LevelInfo)
+	logDB, err := OpenLogDB(logger, chainID, t.TempDir(), &noopCheckMetrics{})
+	require.NoError(t, err)
+	defer logDB.Close()
+	chainsDB := NewChainsDB(logger, depSet)
+	chainsDB.AddLogDB(chainID, logDB)
+
+	msg := types.Message{
+		Identifier: types.Identifier{
+			Origin:      common.Address{0xaa},
+			BlockNumber: 1,
+			LogIndex:    0,
+			Timestamp:   l2(1).Time,
+			ChainID:     chainID,
+		},
+		PayloadHash: common.Hash{0xbb},
+	}
+	require.NoError(t, logDB.SealBlock(common.Hash{}, l2(0).ID(), l2(0).Time))
+	require.NoError(t, logDB.AddLog(types.PayloadHashToLogHash(msg.PayloadHash, msg.Identifier.Origin), l2(0).ID(), 0, nil))
+	require.NoError(t, logDB.SealBlock(l2(0).Hash, l2(1).ID(), l2(1).Time))
+
+	includedIn, err := chainsDB.CheckAccess(msg.Access())
+	require.NoError(t, err)
+	require.Equal(t, types.BlockSealFromRef(l2(1)), includedIn)
+
+	wrongPayload := msg
+	wrongPayload.PayloadHash = common.Hash{0xcc}
+	_, err = chainsDB.CheckAccess(wrongPayload.Access())
+	require.ErrorIs(t, err, types.ErrConflict)
+
+	wrongTimestamp := msg
+	wrongTimestamp.Identifier.Timestamp++
+	_, err = chainsDB.CheckAccess(wrongTimestamp.Access())
+	require.ErrorIs(t, err, types.ErrConflict)
+
+	future := msg
+	future.Identifier.BlockNumber = 2
+	_, err = chainsDB.CheckAccess(future.Access())
+	require.ErrorIs(t, err, types.ErrFuture)
+
+	unknown := msg
+	unknown.Identifier.ChainID = eth.ChainIDFromUInt64(901)
+	_, err = chainsDB.CheckAccess(unknown.Access())
+	require.ErrorIs(t, err, types.ErrUnknownChain)
+}
@@ -45,7 +45,27 @@ func (db *ChainsDB) Rewind(chain eth.ChainID, headBlockNum uint64) error {
 	if !ok {
 		return fmt.Errorf("cannot Rewind: %w: %s", types.ErrUnknownChain, chain)
 	}
-	return logDB.Rewind(headBlockNum)
+	// Remember the first block that is removed, so dependent chains can be reorged too.
+	var invalidated types.BlockSeal
+	if latest, ok := logDB.LatestSealedBlockNum(); ok && latest > headBlockNum {
+		seal, err := logDB.FindSealedBlock(headBlockNum + 1)
+		if err != nil {
+			return fmt.Errorf("failed to find first block to rewind: %w", err)
+		}
+		invalidated = seal
+	}
+	if err := logDB.Rewind(headBlockNum); err != nil {
+		return err
+	}
+	if invalidated != (types.BlockSeal{}) {
+		db.logger.Warn("Rewound local unsafe", "chain", chain, "head", headBlockNum, "invalidated", invalidated)
+		db.emitter.Emit(superevents.LocalUnsafeRewindEvent{
+			ChainID:     chain,
+			NewHead:     headBlockNum,
+			Invalidated: invalidated,
+		})
+	}
+	return nil
 }
 
 func (db *ChainsDB) UpdateLocalSafe(chain eth.ChainID, derivedFrom eth.BlockRef, lastDerived eth.BlockRef) {
@@ -105,6 +125,10 @@ func (db *ChainsDB) UpdateCrossSafe(chain eth.ChainID, l1View eth.BlockRef, last
 			Derived:     types.BlockSealFromRef(lastCrossDerived),
 		},
 	})
+	// new cross-safe blocks may already be derived from finalized L1 blocks
+	if err := db.promoteFinalized(); err != nil {
+		db.logger.Debug("Not promoting finality after cross-safe update", "chain", chain, "err", err)
+	}
 	return nil
 }
 
@@ -125,13 +149,48 @@ func (db *ChainsDB) onFinalizedL1(finalized eth.BlockRef) {
 	db.emitter.Emit(superevents.FinalizedL1UpdateEvent{
 		FinalizedL1: finalized,
 	})
-	// whenever the L1 Finalized changes, the L2 Finalized may change, notify subscribers
+	// whenever the L1 Finalized changes, the L2 Finalized may change
+	if err := db.promoteFinalized(); err != nil {
+		db.logger.Warn("Unable to promote finalized L2 blocks", "l1Finalized", finalized, "err", err)
+	}
+}
+
+// promoteFinalized determines the finalized L2 block of every chain from the finalized L1 block,
+// and promotes them together: if the finalized block of any chain cannot be determined,
+// none of the chains is promoted, so the finalized blocks always form a consistent view across chains.
+// Subscribers are notified of every chain of which the finalized block changed.
+func (db *ChainsDB) promoteFinalized() error {
+	finalizedL1 := db.finalizedL1.Get()
+	if finalizedL1 == (eth.BlockRef{}) {
+		return nil
+	}
+	next := make(map[eth.ChainID]types.BlockSeal)
 	for _, chain := range db.depSet.Chains() {
-		fin, err := db.Finalized(chain)
+		fin, err := db.finalizedAt(chain, finalizedL1)
 		if err != nil {
-			db.logger.Warn("Unable to determine finalized L2 block", "chain", chain, "l1Finalized", finalized)
+			return fmt.Errorf("unable to determine finalized L2 block of chain %s: %w", chain, err)
+		}
+		next[chain] = fin
+	}
+
+	db.finalizedL2.Lock()
+	prev := db.finalizedL2.Value
+	for chain, fin := range next {
+		if v, ok := prev[chain]; ok && v.Number > fin.Number {
+			db.finalizedL2.Unlock()
+			return fmt.Errorf("cannot rewind finalized L2 block of chain %s from %s to %s", chain, v, fin)
+		}
+	}
+	db.finalizedL2.Value = next
+	db.finalizedL2.Unlock()
+
+	// notify subscribers after releasing the lock, of the chains that changed
+	for chain, fin := range next {
+		if v, ok := prev[chain]; ok && v == fin {
 			continue
 		}
+		db.logger.Info("Promoted finalized L2 block", "chain", chain, "finalized", fin, "l1Finalized", finalizedL1)
 		db.emitter.Emit(superevents.FinalizedL2UpdateEvent{ChainID: chain, FinalizedL2: fin})
 	}
+	return nil
 }
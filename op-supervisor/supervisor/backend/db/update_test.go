@@ -0,0 +1,137 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/db/fromda"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestPromoteFinalized(t *testing.T) {
+	chainA := eth.ChainIDFromUInt64(900)
+	chainB := eth.ChainIDFromUInt64(901)
+	depSet, err := depset.NewStaticConfigDependencySet(
+		map[eth.ChainID]*depset.StaticConfigDependency{
+			chainA: {ChainIndex: 900},
+			chainB: {ChainIndex: 901},
+		})
+	require.NoError(t, err)
+
+	l1 := func(i uint64) eth.BlockRef {
+		return eth.BlockRef{Hash: common.Hash{0x01, byte(i)}, Number: i, ParentHash: common.Hash{0x01, byte(i - 1)}, Time: 1000 + i*12}
+	}
+	l2 := func(chain byte, i uint64) eth.BlockRef {
+		return eth.BlockRef{Hash: common.Hash{chain, byte(i)}, Number: i, ParentHash: common.Hash{chain, byte(i - 1)}, Time: 1000 + i*2}
+	}
+	a := func(i uint64) eth.BlockRef { return l2(0xaa, i) }
+	b := func(i uint64) eth.BlockRef { return l2(0xbb, i) }
+
+	// open creates a ChainsDB with the cross-safe DBs of both chains in dataDir,
+	// and records the finalized L2 blocks it emits. The returned function closes the cross-safe DBs.
+	open := func(t *testing.T, dataDir string) (*ChainsDB, *[]superevents.FinalizedL2UpdateEvent, func()) {
+		logger := testlog.Logger(t, log.LevelInfo)
+		chainsDB := NewChainsDB(logger, depSet)
+		var promoted []superevents.FinalizedL2UpdateEvent
+		chainsDB.AttachEmitter(event.EmitterFunc(func(ev event.Event) {
+			if x, ok := ev.(superevents.FinalizedL2UpdateEvent); ok {
+				promoted = append(promoted, x)
+			}
+		}))
+		var crossDBs []*fromda.DB
+		for _, chainID := range []eth.ChainID{chainA, chainB} {
+			crossDB, err := OpenCrossDerivedFromDB(logger, chainID, dataDir, &noopCheckMetrics{})
+			require.NoError(t, err)
+			chainsDB.AddCrossDerivedFromDB(chainID, crossDB)
+			crossDBs = append(crossDBs, crossDB)
+		}
+		return chainsDB, &promoted, func() {
+			for _, crossDB := range crossDBs {
+				require.NoError(t, crossDB.Close())
+			}
+		}
+	}
+
+	t.Run("PartialPromotion", func(t *testing.T) {
+		chainsDB, promoted, closeDBs := open(t, t.TempDir())
+		defer closeDBs()
+
+		_, err := chainsDB.FinalizedChains()
+		require.ErrorContains(t, err, "no finalized L1 signal")
+
+		for i := uint64(0); i <= 2; i++ {
+			require.NoError(t, chainsDB.UpdateCrossSafe(chainA, l1(i), a(i)))
+		}
+		chainsDB.onFinalizedL1(l1(1))
+
+		// chain B has no cross-safe data yet, so neither chain may be promoted
+		_, err = chainsDB.FinalizedChains()
+		require.ErrorContains(t, err, "finalized L2 blocks not determined for all chains")
+		_, err = chainsDB.Finalized(chainA)
+		require.Error(t, err)
+		require.Empty(t, *promoted)
+
+		// once chain B catches up, both chains are promoted together
+		require.NoError(t, chainsDB.UpdateCrossSafe(chainB, l1(0), b(0)))
+		finalized, err := chainsDB.FinalizedChains()
+		require.NoError(t, err)
+		require.Equal(t, map[eth.ChainID]types.BlockSeal{
+			chainA: types.BlockSealFromRef(a(1)),
+			chainB: types.BlockSealFromRef(b(0)),
+		}, finalized)
+		require.ElementsMatch(t, []superevents.FinalizedL2UpdateEvent{
+			{ChainID: chainA, FinalizedL2: types.BlockSealFromRef(a(1))},
+			{ChainID: chainB, FinalizedL2: types.BlockSealFromRef(b(0))},
+		}, *promoted)
+
+		// only the chains of which the finalized block changed are notified
+		*promoted = nil
+		require.NoError(t, chainsDB.UpdateCrossSafe(chainB, l1(1), b(1)))
+		finalized, err = chainsDB.FinalizedChains()
+		require.NoError(t, err)
+		require.Equal(t, types.BlockSealFromRef(a(1)), finalized[chainA])
+		require.Equal(t, types.BlockSealFromRef(b(1)), finalized[chainB])
+		require.Equal(t, []superevents.FinalizedL2UpdateEvent{
+			{ChainID: chainB, FinalizedL2: types.BlockSealFromRef(b(1))},
+		}, *promoted)
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		dataDir := t.TempDir()
+		chainsDB, _, closeDBs := open(t, dataDir)
+		for i := uint64(0); i <= 2; i++ {
+			require.NoError(t, chainsDB.UpdateCrossSafe(chainA, l1(i), a(i)))
+			require.NoError(t, chainsDB.UpdateCrossSafe(chainB, l1(i), b(i)))
+		}
+		chainsDB.onFinalizedL1(l1(1))
+		expected, err := chainsDB.FinalizedChains()
+		require.NoError(t, err)
+		closeDBs()
+
+		// the finalized blocks are not persisted, and are determined again from the next finalized L1 signal
+		chainsDB, promoted, closeDBs := open(t, dataDir)
+		defer closeDBs()
+		_, err = chainsDB.FinalizedChains()
+		require.ErrorContains(t, err, "no finalized L1 signal")
+
+		chainsDB.onFinalizedL1(l1(1))
+		finalized, err := chainsDB.FinalizedChains()
+		require.NoError(t, err)
+		require.Equal(t, expected, finalized)
+		require.Len(t, *promoted, 2)
+
+		// an older finalized L1 signal after the restart does not rewind the finalized blocks
+		chainsDB.onFinalizedL1(l1(0))
+		finalized, err = chainsDB.FinalizedChains()
+		require.NoError(t, err)
+		require.Equal(t, expected, finalized)
+	})
+}
@@ -27,6 +27,9 @@ type DependencySet interface {
 	// E.g. if the DependencySet is syncing new changes.
 	CanInitiateAt(chainID eth.ChainID, initTimestamp uint64) (bool, error)
 
+	// MessageExpiryWindow is the time in seconds after which an initiating message can no longer be executed.
+	MessageExpiryWindow() uint64
+
 	// Chains returns the list of chains that are part of the dependency set.
 	Chains() []eth.ChainID
 
@@ -76,3 +76,25 @@ func TestDependencySet(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, v, "902 not a dependency")
 }
+
+func TestMessageExpiryWindow(t *testing.T) {
+	deps := map[eth.ChainID]*StaticConfigDependency{
+		eth.ChainIDFromUInt64(900): {ChainIndex: 900},
+	}
+	t.Run("Default", func(t *testing.T) {
+		depSet, err := NewStaticConfigDependencySet(deps)
+		require.NoError(t, err)
+		require.Equal(t, uint64(DefaultMessageExpiryWindow), depSet.MessageExpiryWindow())
+	})
+	t.Run("Configured", func(t *testing.T) {
+		depSet, err := NewStaticConfigDependencySetWithMessageExpiryWindow(deps, 3600)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3600), depSet.MessageExpiryWindow())
+
+		data, err := json.Marshal(depSet)
+		require.NoError(t, err)
+		var result StaticConfigDependencySet
+		require.NoError(t, json.Unmarshal(data, &result))
+		require.Equal(t, uint64(3600), result.MessageExpiryWindow())
+	})
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
 )
 
+// DefaultMessageExpiryWindow is the message expiry window of a dependency set that does not configure one.
+const DefaultMessageExpiryWindow = 7 * 24 * 60 * 60
+
 type StaticConfigDependency struct {
 	// ChainIndex is the unique short identifier of this chain.
 	ChainIndex types.ChainIndex `json:"chainIndex"`
@@ -30,6 +33,8 @@ type StaticConfigDependency struct {
 type StaticConfigDependencySet struct {
 	// dependency info per chain
 	dependencies map[eth.ChainID]*StaticConfigDependency
+	// messageExpiryWindow overrides DefaultMessageExpiryWindow, if not zero
+	messageExpiryWindow uint64
 	// cached mapping of chain index to chain ID
 	indexToID map[types.ChainIndex]eth.ChainID
 	// cached list of chain IDs, sorted by ID value
@@ -44,16 +49,28 @@ func NewStaticConfigDependencySet(dependencies map[eth.ChainID]*StaticConfigDepe
 	return out, nil
 }
 
+// NewStaticConfigDependencySetWithMessageExpiryWindow creates a StaticConfigDependencySet
+// with the given message expiry window, instead of DefaultMessageExpiryWindow.
+func NewStaticConfigDependencySetWithMessageExpiryWindow(dependencies map[eth.ChainID]*StaticConfigDependency, messageExpiryWindow uint64) (*StaticConfigDependencySet, error) {
+	out := &StaticConfigDependencySet{dependencies: dependencies, messageExpiryWindow: messageExpiryWindow}
+	if err := out.hydrate(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // jsonStaticConfigDependencySet is a util for JSON encoding/decoding,
 // to encode/decode just the attributes that matter,
 // while wrapping the decoding functionality with additional hydration step.
 type jsonStaticConfigDependencySet struct {
-	Dependencies map[eth.ChainID]*StaticConfigDependency `json:"dependencies"`
+	Dependencies        map[eth.ChainID]*StaticConfigDependency `json:"dependencies"`
+	MessageExpiryWindow uint64                                  `json:"messageExpiryWindow,omitempty"`
 }
 
 func (ds *StaticConfigDependencySet) MarshalJSON() ([]byte, error) {
 	out := &jsonStaticConfigDependencySet{
-		Dependencies: ds.dependencies,
+		Dependencies:        ds.dependencies,
+		MessageExpiryWindow: ds.messageExpiryWindow,
 	}
 	return json.Marshal(out)
 }
@@ -64,6 +81,7 @@ func (ds *StaticConfigDependencySet) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	ds.dependencies = v.Dependencies
+	ds.messageExpiryWindow = v.MessageExpiryWindow
 	return ds.hydrate()
 }
 
@@ -108,6 +126,13 @@ func (ds *StaticConfigDependencySet) CanInitiateAt(chainID eth.ChainID, initTime
 	return initTimestamp >= dep.HistoryMinTime, nil
 }
 
+func (ds *StaticConfigDependencySet) MessageExpiryWindow() uint64 {
+	if ds.messageExpiryWindow == 0 {
+		return DefaultMessageExpiryWindow
+	}
+	return ds.messageExpiryWindow
+}
+
 func (ds *StaticConfigDependencySet) Chains() []eth.ChainID {
 	return slices.Clone(ds.chainIDs)
 }
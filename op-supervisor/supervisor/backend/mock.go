@@ -51,6 +51,10 @@ func (m *MockBackend) CheckMessage(identifier types.Identifier, payloadHash comm
 	return types.CrossUnsafe, nil
 }
 
+func (m *MockBackend) CheckAccess(access types.Access) (types.SafetyLevel, error) {
+	return types.CrossUnsafe, nil
+}
+
 func (m *MockBackend) CheckMessages(messages []types.Message, minSafety types.SafetyLevel) error {
 	return nil
 }
@@ -79,6 +83,20 @@ func (m *MockBackend) SuperRootAtTimestamp(ctx context.Context, timestamp hexuti
 	return eth.SuperRootResponse{}, nil
 }
 
+func (m *MockBackend) SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error) {
+	return &eth.SuperRootProof{}, nil
+}
+
+func (m *MockBackend) FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error) {
+	return eth.SuperRootResponse{}, nil
+}
+
+func (m *MockBackend) MessageStats(ctx context.Context) (types.MessageStats, error) {
+	return types.MessageStats{}, nil
+}
+
+func (m *MockBackend) RecordInvalidMessage(chainID eth.ChainID, sender common.Address) {}
+
 func (m *MockBackend) Close() error {
 	return nil
 }
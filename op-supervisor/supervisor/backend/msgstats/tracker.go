@@ -0,0 +1,231 @@
+package msgstats
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/depset"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+type Metrics interface {
+	RecordExecutingMessages(initiating eth.ChainID, executing eth.ChainID, count int)
+	RecordInvalidMessageReference(executing eth.ChainID)
+	RecordMessageAnomaly(kind string)
+}
+
+type Config struct {
+	// AverageBlocks is the number of blocks that the moving average of the messages per block follows.
+	// Spikes are only detected once a chain pair had executing messages for this many blocks.
+	AverageBlocks uint64
+	// SpikeFactor is how many times the moving average of a chain pair the messages of a block must exceed
+	// to be reported as a spike.
+	SpikeFactor float64
+	// SpikeMinMessages is the minimum number of messages of a block to be reported as a spike.
+	SpikeMinMessages uint64
+	// InvalidThreshold is the number of invalid messages of a sender within the InvalidWindow
+	// to be reported as repeated invalid messages.
+	InvalidThreshold int
+	InvalidWindow    time.Duration
+	// MaxAnomalies is the number of most recent anomalies that are kept for the query API.
+	MaxAnomalies int
+}
+
+var DefaultConfig = Config{
+	AverageBlocks:    100,
+	SpikeFactor:      10,
+	SpikeMinMessages: 20,
+	InvalidThreshold: 5,
+	InvalidWindow:    time.Hour,
+	MaxAnomalies:     100,
+}
+
+// maxTrackedSenders bounds the senders of invalid messages that are tracked.
+// Senders without invalid messages within the window are dropped once it is exceeded.
+const maxTrackedSenders = 10_000
+
+type chainPair struct {
+	initiating eth.ChainID
+	executing  eth.ChainID
+}
+
+type pairStats struct {
+	// blocks is the number of blocks of the executing chain since the first executing message of the pair.
+	blocks uint64
+	stats  types.ChainPairMessageStats
+}
+
+// Tracker keeps statistics of the executing messages of each chain pair, and detects anomalies in them:
+// blocks with a spike of executing messages, and senders that repeatedly reference initiating messages
+// that do not exist. Only the number of messages is tracked, as the supervisor only knows the hashes of the payloads.
+// Blocks that are processed again after a reorg are counted again.
+type Tracker struct {
+	log    log.Logger
+	m      Metrics
+	clock  clock.Clock
+	depSet depset.ChainIDFromIndex
+	cfg    Config
+
+	mu        sync.Mutex
+	pairs     map[chainPair]*pairStats
+	invalid   map[common.Address][]time.Time
+	anomalies []types.MessageAnomaly
+}
+
+func NewTracker(log log.Logger, m Metrics, clock clock.Clock, depSet depset.ChainIDFromIndex, cfg Config) *Tracker {
+	return &Tracker{
+		log:     log,
+		m:       m,
+		clock:   clock,
+		depSet:  depSet,
+		cfg:     cfg,
+		pairs:   make(map[chainPair]*pairStats),
+		invalid: make(map[common.Address][]time.Time),
+	}
+}
+
+// RecordBlock records the executing messages of a block of the executing chain.
+func (t *Tracker) RecordBlock(executing eth.ChainID, block eth.BlockRef, execMsgs []*types.ExecutingMessage) {
+	counts := make(map[eth.ChainID]uint64)
+	for _, msg := range execMsgs {
+		initiating, err := t.depSet.ChainIDFromIndex(msg.Chain)
+		if err != nil {
+			// The message was already validated against the dependency set when it was decoded.
+			t.log.Warn("Ignoring executing message of unknown chain in statistics", "chainIndex", msg.Chain, "err", err)
+			continue
+		}
+		counts[initiating]++
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for initiating := range counts {
+		pair := chainPair{initiating: initiating, executing: executing}
+		if _, ok := t.pairs[pair]; !ok {
+			t.pairs[pair] = &pairStats{stats: types.ChainPairMessageStats{InitiatingChain: initiating, ExecutingChain: executing}}
+		}
+	}
+	// Pairs without messages in this block are updated too, to lower their average.
+	for pair, ps := range t.pairs {
+		if pair.executing != executing {
+			continue
+		}
+		count := counts[pair.initiating]
+		t.checkSpike(pair, ps, block, count)
+		ps.blocks++
+		alpha := 1.0
+		if t.cfg.AverageBlocks > 0 {
+			alpha = 2 / (float64(min(ps.blocks, t.cfg.AverageBlocks)) + 1)
+		}
+		ps.stats.AveragePerBlock += alpha * (float64(count) - ps.stats.AveragePerBlock)
+		if count == 0 {
+			continue
+		}
+		ps.stats.Messages += count
+		ps.stats.MaxPerBlock = max(ps.stats.MaxPerBlock, count)
+		ps.stats.LastBlock = block.ID()
+		t.m.RecordExecutingMessages(pair.initiating, pair.executing, int(count))
+	}
+}
+
+func (t *Tracker) checkSpike(pair chainPair, ps *pairStats, block eth.BlockRef, count uint64) {
+	if ps.blocks < t.cfg.AverageBlocks || count < t.cfg.SpikeMinMessages {
+		return
+	}
+	if float64(count) <= t.cfg.SpikeFactor*ps.stats.AveragePerBlock {
+		return
+	}
+	t.log.Warn("Spike of executing messages", "initiating", pair.initiating, "executing", pair.executing,
+		"block", block.ID(), "count", count, "average", ps.stats.AveragePerBlock)
+	initiating := pair.initiating
+	blockID := block.ID()
+	t.addAnomaly(types.MessageAnomaly{
+		Kind:            types.MessageSpike,
+		ExecutingChain:  pair.executing,
+		InitiatingChain: &initiating,
+		Block:           &blockID,
+		Count:           count,
+	})
+}
+
+// RecordInvalidMessage records a transaction of the sender to the executing chain that references
+// an initiating message that does not exist.
+func (t *Tracker) RecordInvalidMessage(executing eth.ChainID, sender common.Address) {
+	t.m.RecordInvalidMessageReference(executing)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.clock.Now()
+	if len(t.invalid) >= maxTrackedSenders {
+		t.pruneInvalid(now)
+	}
+	times := append(t.recentInvalid(sender, now), now)
+	t.invalid[sender] = times
+	// Only report the sender when crossing the threshold, not for every further invalid message in the window.
+	if len(times) != t.cfg.InvalidThreshold {
+		return
+	}
+	t.log.Warn("Sender repeatedly referenced invalid messages", "sender", sender, "executing", executing,
+		"count", len(times), "window", t.cfg.InvalidWindow)
+	t.addAnomaly(types.MessageAnomaly{
+		Kind:           types.RepeatedInvalidMessages,
+		ExecutingChain: executing,
+		Sender:         &sender,
+		Count:          uint64(len(times)),
+	})
+}
+
+// recentInvalid returns the times of the invalid messages of the sender within the window.
+func (t *Tracker) recentInvalid(sender common.Address, now time.Time) []time.Time {
+	times := t.invalid[sender]
+	i := 0
+	for i < len(times) && now.Sub(times[i]) > t.cfg.InvalidWindow {
+		i++
+	}
+	return times[i:]
+}
+
+func (t *Tracker) pruneInvalid(now time.Time) {
+	for sender := range t.invalid {
+		if times := t.recentInvalid(sender, now); len(times) > 0 {
+			t.invalid[sender] = times
+		} else {
+			delete(t.invalid, sender)
+		}
+	}
+}
+
+func (t *Tracker) addAnomaly(anomaly types.MessageAnomaly) {
+	anomaly.Time = uint64(t.clock.Now().Unix())
+	t.m.RecordMessageAnomaly(string(anomaly.Kind))
+	t.anomalies = append(t.anomalies, anomaly)
+	if over := len(t.anomalies) - t.cfg.MaxAnomalies; over > 0 {
+		t.anomalies = slices.Delete(t.anomalies, 0, over)
+	}
+}
+
+// Stats returns the statistics of all chain pairs, ordered by chain IDs, and the most recent anomalies, oldest first.
+func (t *Tracker) Stats() types.MessageStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	pairs := make([]types.ChainPairMessageStats, 0, len(t.pairs))
+	for _, ps := range t.pairs {
+		pairs = append(pairs, ps.stats)
+	}
+	slices.SortFunc(pairs, func(a, b types.ChainPairMessageStats) int {
+		if c := a.ExecutingChain.Cmp(b.ExecutingChain); c != 0 {
+			return c
+		}
+		return a.InitiatingChain.Cmp(b.InitiatingChain)
+	})
+	return types.MessageStats{
+		Pairs:     pairs,
+		Anomalies: append([]types.MessageAnomaly{}, t.anomalies...),
+	}
+}
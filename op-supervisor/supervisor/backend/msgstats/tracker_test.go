@@ -0,0 +1,157 @@
+package msgstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+var (
+	chainA = eth.ChainIDFromUInt64(900)
+	chainB = eth.ChainIDFromUInt64(901)
+)
+
+func TestRecordBlock(t *testing.T) {
+	t.Run("CountPerChainPair", func(t *testing.T) {
+		tracker, m, _ := setupTrackerTest(t, DefaultConfig)
+		tracker.RecordBlock(chainB, block(1), execMsgs(chainA, 3))
+		tracker.RecordBlock(chainB, block(2), append(execMsgs(chainA, 1), execMsgs(chainB, 2)...))
+		tracker.RecordBlock(chainB, block(3), nil)
+
+		stats := tracker.Stats()
+		require.Len(t, stats.Pairs, 2)
+		require.Equal(t, chainA, stats.Pairs[0].InitiatingChain)
+		require.Equal(t, chainB, stats.Pairs[0].ExecutingChain)
+		require.Equal(t, uint64(4), stats.Pairs[0].Messages)
+		require.Equal(t, uint64(3), stats.Pairs[0].MaxPerBlock)
+		require.Equal(t, block(2).ID(), stats.Pairs[0].LastBlock)
+		require.Equal(t, chainB, stats.Pairs[1].InitiatingChain)
+		require.Equal(t, uint64(2), stats.Pairs[1].Messages)
+		require.Empty(t, stats.Anomalies)
+		require.Equal(t, 6, m.executing)
+	})
+
+	t.Run("DetectSpike", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.AverageBlocks = 10
+		tracker, m, _ := setupTrackerTest(t, cfg)
+		for i := uint64(0); i < 10; i++ {
+			tracker.RecordBlock(chainB, block(i), execMsgs(chainA, 2))
+		}
+		tracker.RecordBlock(chainB, block(10), execMsgs(chainA, 19))
+		require.Empty(t, tracker.Stats().Anomalies, "below the spike factor")
+		tracker.RecordBlock(chainB, block(11), execMsgs(chainA, 100))
+
+		anomalies := tracker.Stats().Anomalies
+		require.Len(t, anomalies, 1)
+		require.Equal(t, types.MessageSpike, anomalies[0].Kind)
+		require.Equal(t, chainA, *anomalies[0].InitiatingChain)
+		require.Equal(t, chainB, anomalies[0].ExecutingChain)
+		require.Equal(t, block(11).ID(), *anomalies[0].Block)
+		require.Equal(t, uint64(100), anomalies[0].Count)
+		require.Equal(t, 1, m.anomalies[string(types.MessageSpike)])
+	})
+
+	t.Run("NoSpikeDuringWarmup", func(t *testing.T) {
+		tracker, _, _ := setupTrackerTest(t, DefaultConfig)
+		tracker.RecordBlock(chainB, block(0), execMsgs(chainA, 1))
+		tracker.RecordBlock(chainB, block(1), execMsgs(chainA, 1000))
+		require.Empty(t, tracker.Stats().Anomalies)
+	})
+}
+
+func TestRecordInvalidMessage(t *testing.T) {
+	sender := common.Address{0xaa}
+
+	t.Run("ReportOnceAtThreshold", func(t *testing.T) {
+		tracker, m, _ := setupTrackerTest(t, DefaultConfig)
+		for i := 0; i < DefaultConfig.InvalidThreshold+2; i++ {
+			tracker.RecordInvalidMessage(chainA, sender)
+		}
+		tracker.RecordInvalidMessage(chainA, common.Address{0xbb})
+
+		anomalies := tracker.Stats().Anomalies
+		require.Len(t, anomalies, 1)
+		require.Equal(t, types.RepeatedInvalidMessages, anomalies[0].Kind)
+		require.Equal(t, sender, *anomalies[0].Sender)
+		require.Equal(t, chainA, anomalies[0].ExecutingChain)
+		require.Equal(t, uint64(DefaultConfig.InvalidThreshold), anomalies[0].Count)
+		require.Equal(t, DefaultConfig.InvalidThreshold+3, m.invalid)
+	})
+
+	t.Run("ForgetOutsideWindow", func(t *testing.T) {
+		tracker, _, clk := setupTrackerTest(t, DefaultConfig)
+		for i := 0; i < DefaultConfig.InvalidThreshold-1; i++ {
+			tracker.RecordInvalidMessage(chainA, sender)
+		}
+		clk.AdvanceTime(DefaultConfig.InvalidWindow + time.Second)
+		tracker.RecordInvalidMessage(chainA, sender)
+		require.Empty(t, tracker.Stats().Anomalies)
+	})
+
+	t.Run("KeepMostRecentAnomalies", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.InvalidThreshold = 1
+		cfg.MaxAnomalies = 2
+		tracker, _, _ := setupTrackerTest(t, cfg)
+		for i := byte(0); i < 3; i++ {
+			tracker.RecordInvalidMessage(chainA, common.Address{i})
+		}
+		anomalies := tracker.Stats().Anomalies
+		require.Len(t, anomalies, 2)
+		require.Equal(t, common.Address{1}, *anomalies[0].Sender)
+		require.Equal(t, common.Address{2}, *anomalies[1].Sender)
+	})
+}
+
+func setupTrackerTest(t *testing.T, cfg Config) (*Tracker, *stubMetrics, *clock.DeterministicClock) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	m := &stubMetrics{anomalies: make(map[string]int)}
+	clk := clock.NewDeterministicClock(time.Unix(1000, 0))
+	return NewTracker(logger, m, clk, stubDepSet{}, cfg), m, clk
+}
+
+func block(n uint64) eth.BlockRef {
+	return eth.BlockRef{Hash: common.Hash{byte(n)}, Number: n}
+}
+
+func execMsgs(initiating eth.ChainID, count int) []*types.ExecutingMessage {
+	index, _ := initiating.ToUInt32()
+	msgs := make([]*types.ExecutingMessage, count)
+	for i := range msgs {
+		msgs[i] = &types.ExecutingMessage{Chain: types.ChainIndex(index), LogIdx: uint32(i)}
+	}
+	return msgs
+}
+
+type stubDepSet struct{}
+
+func (stubDepSet) ChainIDFromIndex(index types.ChainIndex) (eth.ChainID, error) {
+	return eth.ChainIDFromUInt64(uint64(index)), nil
+}
+
+type stubMetrics struct {
+	executing int
+	invalid   int
+	anomalies map[string]int
+}
+
+func (s *stubMetrics) RecordExecutingMessages(_ eth.ChainID, _ eth.ChainID, count int) {
+	s.executing += count
+}
+
+func (s *stubMetrics) RecordInvalidMessageReference(_ eth.ChainID) {
+	s.invalid++
+}
+
+func (s *stubMetrics) RecordMessageAnomaly(kind string) {
+	s.anomalies[kind]++
+}
@@ -18,25 +18,34 @@ type LogStorage interface {
 	AddLog(chain eth.ChainID, logHash common.Hash, parentBlock eth.BlockID, logIdx uint32, execMsg *types.ExecutingMessage) error
 }
 
+// MessageStats records the executing messages of each processed block.
+type MessageStats interface {
+	RecordBlock(chain eth.ChainID, block eth.BlockRef, execMsgs []*types.ExecutingMessage)
+}
+
 type logProcessor struct {
 	chain        eth.ChainID
 	logStore     LogStorage
 	eventDecoder EventDecoderFn
 	depSet       depset.ChainIndexFromID
+	// stats is nil if the executing messages are not recorded
+	stats MessageStats
 }
 
-func NewLogProcessor(chain eth.ChainID, logStore LogStorage, depSet depset.ChainIndexFromID) LogProcessor {
+func NewLogProcessor(chain eth.ChainID, logStore LogStorage, depSet depset.ChainIndexFromID, stats MessageStats) LogProcessor {
 	return &logProcessor{
 		chain:        chain,
 		logStore:     logStore,
 		eventDecoder: DecodeExecutingMessageLog,
 		depSet:       depSet,
+		stats:        stats,
 	}
 }
 
 // ProcessLogs processes logs from a block and stores them in the log storage
 // for any logs that are related to executing messages, they are decoded and stored
 func (p *logProcessor) ProcessLogs(_ context.Context, block eth.BlockRef, rcpts ethTypes.Receipts) error {
+	var execMsgs []*types.ExecutingMessage
 	for _, rcpt := range rcpts {
 		for _, l := range rcpt.Logs {
 			// log hash represents the hash of *this* log as a potentially initiating message
@@ -46,6 +55,9 @@ func (p *logProcessor) ProcessLogs(_ context.Context, block eth.BlockRef, rcpts
 			if err != nil {
 				return fmt.Errorf("invalid log %d from block %s: %w", l.Index, block.ID(), err)
 			}
+			if execMsg != nil {
+				execMsgs = append(execMsgs, execMsg)
+			}
 			// executing messages have multiple entries in the database
 			// they should start with the initiating message and then include the execution
 			if err := p.logStore.AddLog(p.chain, logHash, block.ParentID(), uint32(l.Index), execMsg); err != nil {
@@ -56,6 +68,9 @@ func (p *logProcessor) ProcessLogs(_ context.Context, block eth.BlockRef, rcpts
 	if err := p.logStore.SealBlock(p.chain, block); err != nil {
 		return fmt.Errorf("failed to seal block %s: %w", block.ID(), err)
 	}
+	if p.stats != nil {
+		p.stats.RecordBlock(p.chain, block, execMsgs)
+	}
 	return nil
 }
 
@@ -34,7 +34,7 @@ func TestLogProcessor(t *testing.T) {
 
 	t.Run("NoOutputWhenLogsAreEmpty", func(t *testing.T) {
 		store := &stubLogStorage{}
-		processor := NewLogProcessor(logProcessorChainID, store, depSet)
+		processor := NewLogProcessor(logProcessorChainID, store, depSet, nil)
 
 		err := processor.ProcessLogs(ctx, block1, ethTypes.Receipts{})
 		require.NoError(t, err)
@@ -68,7 +68,7 @@ func TestLogProcessor(t *testing.T) {
 			},
 		}
 		store := &stubLogStorage{}
-		processor := NewLogProcessor(logProcessorChainID, store, depSet)
+		processor := NewLogProcessor(logProcessorChainID, store, depSet, nil)
 
 		err := processor.ProcessLogs(ctx, block1, rcpts)
 		require.NoError(t, err)
@@ -124,7 +124,7 @@ func TestLogProcessor(t *testing.T) {
 			Hash:      common.Hash{0xaa},
 		}
 		store := &stubLogStorage{}
-		processor := NewLogProcessor(eth.ChainID{4}, store, depSet).(*logProcessor)
+		processor := NewLogProcessor(eth.ChainID{4}, store, depSet, nil).(*logProcessor)
 		processor.eventDecoder = func(l *ethTypes.Log, translator depset.ChainIndexFromID) (*types.ExecutingMessage, error) {
 			require.Equal(t, rcpts[0].Logs[0], l)
 			return execMsg, nil
@@ -151,6 +151,26 @@ func TestLogProcessor(t *testing.T) {
 		}
 		require.Equal(t, expectedBlocks, store.seals)
 	})
+
+	t.Run("RecordMessageStats", func(t *testing.T) {
+		rcpts := ethTypes.Receipts{
+			{Logs: []*ethTypes.Log{{Address: common.Address{0x11}}, {Address: predeploys.CrossL2InboxAddr}}},
+		}
+		execMsg := &types.ExecutingMessage{Chain: 4, BlockNum: 6, Hash: common.Hash{0xaa}}
+		stats := &stubMessageStats{}
+		processor := NewLogProcessor(eth.ChainID{4}, &stubLogStorage{}, depSet, stats).(*logProcessor)
+		processor.eventDecoder = func(l *ethTypes.Log, translator depset.ChainIndexFromID) (*types.ExecutingMessage, error) {
+			if l.Address == predeploys.CrossL2InboxAddr {
+				return execMsg, nil
+			}
+			return nil, nil
+		}
+
+		require.NoError(t, processor.ProcessLogs(ctx, block1, rcpts))
+		require.Equal(t, eth.ChainID{4}, stats.chain)
+		require.Equal(t, block1, stats.block)
+		require.Equal(t, []*types.ExecutingMessage{execMsg}, stats.execMsgs)
+	})
 }
 
 func TestToLogHash(t *testing.T) {
@@ -251,3 +271,15 @@ type storedLog struct {
 	logHash common.Hash
 	execMsg *types.ExecutingMessage
 }
+
+type stubMessageStats struct {
+	chain    eth.ChainID
+	block    eth.BlockRef
+	execMsgs []*types.ExecutingMessage
+}
+
+func (s *stubMessageStats) RecordBlock(chain eth.ChainID, block eth.BlockRef, execMsgs []*types.ExecutingMessage) {
+	s.chain = chain
+	s.block = block
+	s.execMsgs = execMsgs
+}
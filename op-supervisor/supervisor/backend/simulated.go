@@ -0,0 +1,370 @@
+package backend
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/frontend"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+const (
+	// SimulatedFirstChainID is the chain ID of the first simulated chain, the others follow sequentially.
+	SimulatedFirstChainID = 901
+	// simulatedL1BlockTime is the block time of the simulated L1 chain, in seconds.
+	simulatedL1BlockTime = 12
+	// simulatedSafeLag is the number of blocks the cross-safe head trails behind the unsafe head.
+	simulatedSafeLag = 16
+	// simulatedFinalizedLag is the number of blocks the finalized head trails behind the unsafe head.
+	simulatedFinalizedLag = 64
+)
+
+// SimulatedOrigin is the origin address of all simulated messages.
+var SimulatedOrigin = common.HexToAddress("0x4200000000000000000000000000000000000023")
+
+var ErrSimulated = errors.New("not supported by the simulated cluster")
+
+type SimulatedConfig struct {
+	// Chains is the number of simulated chains.
+	Chains uint64
+	// BlockTime is the block time of all simulated chains, in seconds.
+	BlockTime uint64
+	// MessagesPerBlock is the number of initiating messages emitted in every block.
+	MessagesPerBlock uint32
+	// GenesisTime is the timestamp of the genesis block of all chains.
+	GenesisTime uint64
+}
+
+func (c *SimulatedConfig) Check() error {
+	if c.Chains == 0 {
+		return errors.New("must simulate at least one chain")
+	}
+	if c.BlockTime == 0 {
+		return errors.New("block time must be positive")
+	}
+	return nil
+}
+
+// SimulatedBackend fakes an interop cluster of chains, without any execution engines or databases,
+// so interop tooling can be developed against the supervisor API.
+// All chains produce a block every block time since genesis, each block emitting initiating messages.
+// All blocks, messages and output roots are derived deterministically from the chain ID and block number,
+// and can be reproduced with SimulatedBlock and SimulatedMessage.
+// Blocks become cross-unsafe once the next block is produced, and cross-safe and finalized after a fixed lag.
+type SimulatedBackend struct {
+	log     log.Logger
+	clock   clock.Clock
+	cfg     SimulatedConfig
+	started atomic.Bool
+}
+
+var _ frontend.Backend = (*SimulatedBackend)(nil)
+
+func NewSimulatedBackend(logger log.Logger, cl clock.Clock, cfg SimulatedConfig) (*SimulatedBackend, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	logger.Info("Simulating interop cluster", "chains", cfg.Chains, "blockTime", cfg.BlockTime,
+		"messagesPerBlock", cfg.MessagesPerBlock, "genesisTime", cfg.GenesisTime)
+	return &SimulatedBackend{log: logger, clock: cl, cfg: cfg}, nil
+}
+
+// Chains returns the chain IDs of the simulated chains.
+func (s *SimulatedBackend) Chains() []eth.ChainID {
+	chains := make([]eth.ChainID, s.cfg.Chains)
+	for i := range chains {
+		chains[i] = eth.ChainIDFromUInt64(SimulatedFirstChainID + uint64(i))
+	}
+	return chains
+}
+
+// SimulatedBlock returns the ID of block number n of the simulated chain.
+func (s *SimulatedBackend) SimulatedBlock(chainID eth.ChainID, n uint64) eth.BlockID {
+	return eth.BlockID{Hash: simulatedHash("l2-block", chainID, n), Number: n}
+}
+
+// SimulatedMessage returns the initiating message at the log index of block n of the simulated chain.
+func (s *SimulatedBackend) SimulatedMessage(chainID eth.ChainID, n uint64, logIdx uint32) types.Message {
+	return types.Message{
+		Identifier: types.Identifier{
+			Origin:      SimulatedOrigin,
+			BlockNumber: n,
+			LogIndex:    logIdx,
+			Timestamp:   s.timestamp(n),
+			ChainID:     chainID,
+		},
+		PayloadHash: simulatedHash("message", chainID, n, uint64(logIdx)),
+	}
+}
+
+func simulatedHash(kind string, chainID eth.ChainID, nums ...uint64) common.Hash {
+	data := append([]byte("simulated-"+kind), chainID.ToBig().FillBytes(make([]byte, 32))...)
+	for _, n := range nums {
+		data = binary.BigEndian.AppendUint64(data, n)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+func (s *SimulatedBackend) checkChain(chainID eth.ChainID) error {
+	if !chainID.ToBig().IsUint64() {
+		return fmt.Errorf("%w: %s", types.ErrUnknownChain, chainID)
+	}
+	id := chainID.ToBig().Uint64()
+	if id < SimulatedFirstChainID || id >= SimulatedFirstChainID+s.cfg.Chains {
+		return fmt.Errorf("%w: %s", types.ErrUnknownChain, chainID)
+	}
+	return nil
+}
+
+func (s *SimulatedBackend) timestamp(n uint64) uint64 {
+	return s.cfg.GenesisTime + n*s.cfg.BlockTime
+}
+
+// unsafeHead returns the number of the latest block of all chains.
+func (s *SimulatedBackend) unsafeHead() uint64 {
+	now := uint64(s.clock.Now().Unix())
+	if now < s.cfg.GenesisTime {
+		return 0
+	}
+	return (now - s.cfg.GenesisTime) / s.cfg.BlockTime
+}
+
+func (s *SimulatedBackend) safeHead() uint64 {
+	return s.unsafeHead() - min(s.unsafeHead(), simulatedSafeLag)
+}
+
+func (s *SimulatedBackend) finalizedHead() uint64 {
+	return s.unsafeHead() - min(s.unsafeHead(), simulatedFinalizedLag)
+}
+
+func (s *SimulatedBackend) l1Block(num uint64) eth.BlockRef {
+	ref := eth.BlockRef{
+		Hash:   simulatedHash("l1-block", eth.ChainID{}, num),
+		Number: num,
+		Time:   s.cfg.GenesisTime + num*simulatedL1BlockTime,
+	}
+	if num > 0 {
+		ref.ParentHash = simulatedHash("l1-block", eth.ChainID{}, num-1)
+	}
+	return ref
+}
+
+// l1Origin returns the L1 block that L2 block n is derived from.
+func (s *SimulatedBackend) l1Origin(n uint64) eth.BlockRef {
+	return s.l1Block(n * s.cfg.BlockTime / simulatedL1BlockTime)
+}
+
+func (s *SimulatedBackend) safety(n uint64) types.SafetyLevel {
+	switch {
+	case n > s.unsafeHead():
+		return types.LocalUnsafe
+	case n <= s.finalizedHead():
+		return types.Finalized
+	case n <= s.safeHead():
+		return types.CrossSafe
+	case n < s.unsafeHead():
+		return types.CrossUnsafe
+	default:
+		return types.LocalUnsafe
+	}
+}
+
+func (s *SimulatedBackend) output(chainID eth.ChainID, n uint64) *eth.OutputV0 {
+	return &eth.OutputV0{
+		StateRoot:                eth.Bytes32(simulatedHash("state-root", chainID, n)),
+		MessagePasserStorageRoot: eth.Bytes32(simulatedHash("message-passer-root", chainID, n)),
+		BlockHash:                s.SimulatedBlock(chainID, n).Hash,
+	}
+}
+
+func (s *SimulatedBackend) Start(ctx context.Context) error {
+	if !s.started.CompareAndSwap(false, true) {
+		return errors.New("already started")
+	}
+	return nil
+}
+
+func (s *SimulatedBackend) Stop(ctx context.Context) error {
+	if !s.started.CompareAndSwap(true, false) {
+		return errors.New("already stopped")
+	}
+	return nil
+}
+
+func (s *SimulatedBackend) AddL2RPC(ctx context.Context, rpc string, jwtSecret eth.Bytes32) error {
+	return ErrSimulated
+}
+
+func (s *SimulatedBackend) CheckMessage(identifier types.Identifier, payloadHash common.Hash) (types.SafetyLevel, error) {
+	if err := s.checkChain(identifier.ChainID); err != nil {
+		return types.Invalid, err
+	}
+	if identifier.BlockNumber > s.unsafeHead() {
+		return types.LocalUnsafe, nil
+	}
+	if identifier.LogIndex >= s.cfg.MessagesPerBlock {
+		return types.Invalid, nil
+	}
+	expected := s.SimulatedMessage(identifier.ChainID, identifier.BlockNumber, identifier.LogIndex)
+	if identifier != expected.Identifier || payloadHash != expected.PayloadHash {
+		return types.Invalid, nil
+	}
+	return s.safety(identifier.BlockNumber), nil
+}
+
+func (s *SimulatedBackend) CheckAccess(access types.Access) (types.SafetyLevel, error) {
+	if err := s.checkChain(access.ChainID); err != nil {
+		return types.Invalid, err
+	}
+	if access.BlockNumber > s.unsafeHead() {
+		return types.LocalUnsafe, nil
+	}
+	if access.LogIndex >= s.cfg.MessagesPerBlock {
+		return types.Invalid, nil
+	}
+	expected := s.SimulatedMessage(access.ChainID, access.BlockNumber, access.LogIndex)
+	if access != expected.Access() {
+		return types.Invalid, nil
+	}
+	return s.safety(access.BlockNumber), nil
+}
+
+func (s *SimulatedBackend) CheckMessages(messages []types.Message, minSafety types.SafetyLevel) error {
+	for _, msg := range messages {
+		safety, err := s.CheckMessage(msg.Identifier, msg.PayloadHash)
+		if err != nil {
+			return fmt.Errorf("failed to check message: %w", err)
+		}
+		if !safety.AtLeastAsSafe(minSafety) {
+			return fmt.Errorf("message %v (safety level: %v) does not meet the minimum safety %v",
+				msg.Identifier, safety, minSafety)
+		}
+	}
+	return nil
+}
+
+func (s *SimulatedBackend) LocalUnsafe(ctx context.Context, chainID eth.ChainID) (eth.BlockID, error) {
+	if err := s.checkChain(chainID); err != nil {
+		return eth.BlockID{}, err
+	}
+	return s.SimulatedBlock(chainID, s.unsafeHead()), nil
+}
+
+func (s *SimulatedBackend) CrossSafe(ctx context.Context, chainID eth.ChainID) (types.DerivedIDPair, error) {
+	if err := s.checkChain(chainID); err != nil {
+		return types.DerivedIDPair{}, err
+	}
+	n := s.safeHead()
+	return types.DerivedIDPair{
+		DerivedFrom: s.l1Origin(n).ID(),
+		Derived:     s.SimulatedBlock(chainID, n),
+	}, nil
+}
+
+func (s *SimulatedBackend) Finalized(ctx context.Context, chainID eth.ChainID) (eth.BlockID, error) {
+	if err := s.checkChain(chainID); err != nil {
+		return eth.BlockID{}, err
+	}
+	return s.SimulatedBlock(chainID, s.finalizedHead()), nil
+}
+
+func (s *SimulatedBackend) FinalizedL1() eth.BlockRef {
+	return s.l1Origin(s.finalizedHead())
+}
+
+func (s *SimulatedBackend) CrossDerivedFrom(ctx context.Context, chainID eth.ChainID, derived eth.BlockID) (derivedFrom eth.BlockRef, err error) {
+	if err := s.checkChain(chainID); err != nil {
+		return eth.BlockRef{}, err
+	}
+	if derived.Number > s.safeHead() {
+		return eth.BlockRef{}, types.ErrFuture
+	}
+	if derived != s.SimulatedBlock(chainID, derived.Number) {
+		return eth.BlockRef{}, types.ErrConflict
+	}
+	return s.l1Origin(derived.Number), nil
+}
+
+func (s *SimulatedBackend) AllSafeDerivedAt(ctx context.Context, derivedFrom eth.BlockID) (map[eth.ChainID]eth.BlockID, error) {
+	if derivedFrom.Number > s.l1Origin(s.safeHead()).Number {
+		return nil, types.ErrFuture
+	}
+	if derivedFrom != s.l1Block(derivedFrom.Number).ID() {
+		return nil, types.ErrConflict
+	}
+	// The last L2 block with a timestamp before the next L1 block
+	n := min(((derivedFrom.Number+1)*simulatedL1BlockTime-1)/s.cfg.BlockTime, s.safeHead())
+	derived := make(map[eth.ChainID]eth.BlockID, s.cfg.Chains)
+	for _, chainID := range s.Chains() {
+		derived[chainID] = s.SimulatedBlock(chainID, n)
+	}
+	return derived, nil
+}
+
+func (s *SimulatedBackend) SuperRootAtTimestamp(ctx context.Context, timestamp hexutil.Uint64) (eth.SuperRootResponse, error) {
+	if uint64(timestamp) < s.cfg.GenesisTime {
+		return eth.SuperRootResponse{}, fmt.Errorf("timestamp %d is before genesis", timestamp)
+	}
+	n := (uint64(timestamp) - s.cfg.GenesisTime) / s.cfg.BlockTime
+	if n > s.unsafeHead() {
+		return eth.SuperRootResponse{}, types.ErrFuture
+	}
+	chains := s.Chains()
+	chainInfos := make([]eth.ChainRootInfo, len(chains))
+	superRootChains := make([]eth.ChainIDAndOutput, len(chains))
+	for i, chainID := range chains {
+		output := s.output(chainID, n)
+		canonicalRoot := eth.OutputRoot(output)
+		chainInfos[i] = eth.ChainRootInfo{
+			ChainID:   chainID,
+			Canonical: canonicalRoot,
+			Pending:   output.Marshal(),
+		}
+		superRootChains[i] = eth.ChainIDAndOutput{ChainID: chainID.ToBig().Uint64(), Output: canonicalRoot}
+	}
+	return eth.SuperRootResponse{
+		Timestamp: uint64(timestamp),
+		SuperRoot: eth.SuperRoot(eth.NewSuperV1(uint64(timestamp), superRootChains...)),
+		Chains:    chainInfos,
+	}, nil
+}
+
+func (s *SimulatedBackend) SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error) {
+	if err := s.checkChain(chainID); err != nil {
+		return nil, err
+	}
+	resp, err := s.SuperRootAtTimestamp(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	chains := make([]eth.ChainIDAndOutput, len(resp.Chains))
+	for i, chain := range resp.Chains {
+		chains[i] = eth.ChainIDAndOutput{ChainID: chain.ChainID.ToBig().Uint64(), Output: chain.Canonical}
+	}
+	return eth.NewSuperRootProof(eth.NewSuperV1(resp.Timestamp, chains...), chainID.ToBig().Uint64())
+}
+
+func (s *SimulatedBackend) FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error) {
+	return s.SuperRootAtTimestamp(ctx, hexutil.Uint64(s.timestamp(s.finalizedHead())))
+}
+
+// MessageStats returns no statistics, the simulated messages are not tracked.
+func (s *SimulatedBackend) MessageStats(ctx context.Context) (types.MessageStats, error) {
+	return types.MessageStats{}, nil
+}
+
+func (s *SimulatedBackend) RecordInvalidMessage(chainID eth.ChainID, sender common.Address) {}
+
+func (s *SimulatedBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,132 @@
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestSimulatedBackend(t *testing.T) {
+	genesis := uint64(1_000_000)
+	cl := clock.NewDeterministicClock(time.Unix(int64(genesis), 0))
+	cfg := SimulatedConfig{Chains: 2, BlockTime: 2, MessagesPerBlock: 3, GenesisTime: genesis}
+	sim, err := NewSimulatedBackend(testlog.Logger(t, log.LevelError), cl, cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	chainA, chainB := eth.ChainIDFromUInt64(901), eth.ChainIDFromUInt64(902)
+	require.Equal(t, []eth.ChainID{chainA, chainB}, sim.Chains())
+
+	// Advance to block 100 of all chains
+	cl.AdvanceTime(200 * time.Second)
+
+	t.Run("Heads", func(t *testing.T) {
+		unsafe, err := sim.LocalUnsafe(ctx, chainA)
+		require.NoError(t, err)
+		require.Equal(t, sim.SimulatedBlock(chainA, 100), unsafe)
+
+		safe, err := sim.CrossSafe(ctx, chainB)
+		require.NoError(t, err)
+		require.Equal(t, sim.SimulatedBlock(chainB, 100-simulatedSafeLag), safe.Derived)
+
+		finalized, err := sim.Finalized(ctx, chainA)
+		require.NoError(t, err)
+		require.Equal(t, sim.SimulatedBlock(chainA, 100-simulatedFinalizedLag), finalized)
+		require.Equal(t, sim.l1Origin(finalized.Number), sim.FinalizedL1())
+
+		_, err = sim.LocalUnsafe(ctx, eth.ChainIDFromUInt64(903))
+		require.ErrorIs(t, err, types.ErrUnknownChain)
+		require.NotEqual(t, sim.SimulatedBlock(chainA, 100), sim.SimulatedBlock(chainB, 100))
+	})
+
+	t.Run("CheckMessage", func(t *testing.T) {
+		for n, expected := range map[uint64]types.SafetyLevel{
+			100:                         types.LocalUnsafe,
+			99:                          types.CrossUnsafe,
+			100 - simulatedSafeLag:      types.CrossSafe,
+			100 - simulatedFinalizedLag: types.Finalized,
+		} {
+			msg := sim.SimulatedMessage(chainB, n, 2)
+			safety, err := sim.CheckMessage(msg.Identifier, msg.PayloadHash)
+			require.NoError(t, err)
+			require.Equal(t, expected, safety, "block %d", n)
+		}
+		require.NoError(t, sim.CheckMessages([]types.Message{sim.SimulatedMessage(chainA, 10, 0)}, types.Finalized))
+		require.Error(t, sim.CheckMessages([]types.Message{sim.SimulatedMessage(chainA, 99, 0)}, types.CrossSafe))
+
+		msg := sim.SimulatedMessage(chainA, 50, 1)
+		safety, err := sim.CheckMessage(msg.Identifier, common.Hash{0xaa})
+		require.NoError(t, err)
+		require.Equal(t, types.Invalid, safety, "wrong payload")
+
+		msg = sim.SimulatedMessage(chainA, 50, 3)
+		safety, err = sim.CheckMessage(msg.Identifier, msg.PayloadHash)
+		require.NoError(t, err)
+		require.Equal(t, types.Invalid, safety, "log index out of range")
+
+		msg = sim.SimulatedMessage(chainA, 101, 0)
+		safety, err = sim.CheckMessage(msg.Identifier, msg.PayloadHash)
+		require.NoError(t, err)
+		require.Equal(t, types.LocalUnsafe, safety, "future message")
+	})
+
+	t.Run("CheckAccess", func(t *testing.T) {
+		msg := sim.SimulatedMessage(chainB, 100-simulatedSafeLag, 1)
+		safety, err := sim.CheckAccess(msg.Access())
+		require.NoError(t, err)
+		require.Equal(t, types.CrossSafe, safety)
+
+		access := msg.Access()
+		access.Checksum[1] ^= 0xff
+		safety, err = sim.CheckAccess(access)
+		require.NoError(t, err)
+		require.Equal(t, types.Invalid, safety, "wrong checksum")
+	})
+
+	t.Run("Derivation", func(t *testing.T) {
+		derived := sim.SimulatedBlock(chainA, 30)
+		derivedFrom, err := sim.CrossDerivedFrom(ctx, chainA, derived)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), derivedFrom.Number)
+
+		all, err := sim.AllSafeDerivedAt(ctx, derivedFrom.ID())
+		require.NoError(t, err)
+		require.Equal(t, map[eth.ChainID]eth.BlockID{
+			chainA: sim.SimulatedBlock(chainA, 35),
+			chainB: sim.SimulatedBlock(chainB, 35),
+		}, all)
+
+		_, err = sim.CrossDerivedFrom(ctx, chainA, eth.BlockID{Number: 30})
+		require.ErrorIs(t, err, types.ErrConflict)
+		_, err = sim.CrossDerivedFrom(ctx, chainA, sim.SimulatedBlock(chainA, 99))
+		require.ErrorIs(t, err, types.ErrFuture)
+	})
+
+	t.Run("SuperRoot", func(t *testing.T) {
+		resp, err := sim.SuperRootAtTimestamp(ctx, hexutil.Uint64(genesis+21))
+		require.NoError(t, err)
+		require.Len(t, resp.Chains, 2)
+		require.Equal(t, eth.OutputRoot(sim.output(chainB, 10)), resp.Chains[1].Canonical)
+
+		proof, err := sim.SuperRootProofAtTimestamp(ctx, chainA, hexutil.Uint64(genesis+21))
+		require.NoError(t, err)
+		require.NoError(t, proof.Verify(resp.SuperRoot))
+
+		finalized, err := sim.FinalizedSuperRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, genesis+2*(100-simulatedFinalizedLag), finalized.Timestamp)
+
+		_, err = sim.SuperRootAtTimestamp(ctx, hexutil.Uint64(genesis+202))
+		require.ErrorIs(t, err, types.ErrFuture)
+	})
+}
@@ -127,3 +127,39 @@ type AnchorEvent struct {
 func (ev AnchorEvent) String() string {
 	return "anchor"
 }
+
+type LocalUnsafeRewindEvent struct {
+	ChainID eth.ChainID
+	// NewHead is the number of the local-unsafe head after rewinding.
+	NewHead uint64
+	// Invalidated is the first block that was removed from the local-unsafe chain.
+	Invalidated types.BlockSeal
+}
+
+func (ev LocalUnsafeRewindEvent) String() string {
+	return "local-unsafe-rewind"
+}
+
+type DependentReorgEvent struct {
+	ChainID eth.ChainID
+	// Dependent is the first block of the chain that executes an initiating message
+	// which was invalidated by a reorg of another chain.
+	Dependent types.BlockSeal
+	// ResetTo is the parent of the dependent block, the latest block that is not affected by the reorg.
+	ResetTo eth.BlockID
+}
+
+func (ev DependentReorgEvent) String() string {
+	return "dependent-reorg"
+}
+
+// ChainDegradedEvent signals that the managed nodes of a chain started or stopped disagreeing on its canonical blocks.
+// Cross-safety promotion of the chain is paused while it is degraded.
+type ChainDegradedEvent struct {
+	ChainID  eth.ChainID
+	Degraded bool
+}
+
+func (ev ChainDegradedEvent) String() string {
+	return "chain-degraded"
+}
@@ -23,6 +23,8 @@ type SyncNodesController struct {
 
 	id          atomic.Uint64
 	controllers locks.RWMap[eth.ChainID, *locks.RWMap[*ManagedNode, struct{}]]
+	// groups reconcile the managed nodes of each chain
+	groups locks.RWMap[eth.ChainID, *nodeGroup]
 
 	eventSys event.System
 
@@ -53,6 +55,26 @@ func (snc *SyncNodesController) OnEvent(ev event.Event) bool {
 	return false
 }
 
+// Degraded returns true if the managed nodes of the chain disagree on its canonical blocks.
+func (snc *SyncNodesController) Degraded(chainID eth.ChainID) bool {
+	group, ok := snc.groups.Get(chainID)
+	return ok && group.Degraded()
+}
+
+// Nodes returns the managed nodes of the chain.
+func (snc *SyncNodesController) Nodes(chainID eth.ChainID) []*ManagedNode {
+	controllers, ok := snc.controllers.Get(chainID)
+	if !ok {
+		return nil
+	}
+	var nodes []*ManagedNode
+	controllers.Range(func(node *ManagedNode, _ struct{}) bool {
+		nodes = append(nodes, node)
+		return true
+	})
+	return nodes
+}
+
 func (snc *SyncNodesController) Close() error {
 	snc.controllers.Range(func(chainID eth.ChainID, controllers *locks.RWMap[*ManagedNode, struct{}]) bool {
 		controllers.Range(func(node *ManagedNode, _ struct{}) bool {
@@ -75,10 +97,18 @@ func (snc *SyncNodesController) AttachNodeController(chainID eth.ChainID, ctrl S
 		return &locks.RWMap[*ManagedNode, struct{}]{}
 	})
 	controllersForChain, _ := snc.controllers.Get(chainID)
+	snc.groups.Default(chainID, func() *nodeGroup {
+		return newNodeGroup(snc.logger, chainID, snc.emitter)
+	})
+	group, _ := snc.groups.Get(chainID)
 	node := NewManagedNode(snc.logger, chainID, ctrl, snc.backend, noSubscribe)
 
 	nodeID := snc.id.Add(1)
 	name := fmt.Sprintf("syncnode-%s-%d", chainID, nodeID)
+	node.name = name
+	node.log = node.log.New("node", name)
+	node.group = group
+	group.add(node)
 	snc.eventSys.Register(name, node, event.DefaultRegisterOpts())
 
 	controllersForChain.Set(node, struct{}{})
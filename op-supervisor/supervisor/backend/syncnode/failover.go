@@ -0,0 +1,148 @@
+package syncnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// failoverBackoff is the duration a failed source is avoided for, if other sources are available.
+const failoverBackoff = time.Second * 30
+
+var ErrNoSyncSources = errors.New("no sync sources")
+
+// FailoverSource is a SyncSource backed by all the sync sources of a chain.
+// Requests are served by the first healthy source, and fail over to the next source upon error.
+// A source that failed is avoided for failoverBackoff, unless all sources failed.
+type FailoverSource struct {
+	mu      sync.Mutex
+	sources []SyncSource
+	// failedAt is the time of the last failure of each source, zero if the last request succeeded
+	failedAt []time.Time
+}
+
+var _ SyncSource = (*FailoverSource)(nil)
+
+func NewFailoverSource() *FailoverSource {
+	return &FailoverSource{}
+}
+
+// Add adds a source, with the lowest preference of all sources.
+func (f *FailoverSource) Add(src SyncSource) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sources = append(f.sources, src)
+	f.failedAt = append(f.failedAt, time.Time{})
+}
+
+// Len returns the number of sources.
+func (f *FailoverSource) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sources)
+}
+
+// ordered returns the indices of the healthy sources in order of preference, followed by those of the failed sources.
+func (f *FailoverSource) ordered() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	healthy := make([]int, 0, len(f.sources))
+	var failed []int
+	for i, t := range f.failedAt {
+		if !t.IsZero() && time.Since(t) < failoverBackoff {
+			failed = append(failed, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+	return append(healthy, failed...)
+}
+
+func (f *FailoverSource) source(i int) SyncSource {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sources[i]
+}
+
+func (f *FailoverSource) markResult(i int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// A block that is not found is not a failure of the source, it may just not be synced yet.
+	if err == nil || errors.Is(err, ethereum.NotFound) {
+		f.failedAt[i] = time.Time{}
+	} else {
+		f.failedAt[i] = time.Now()
+	}
+}
+
+// failover calls fn with each source in order of preference, until it succeeds or the context is canceled.
+func failover[T any](ctx context.Context, f *FailoverSource, fn func(src SyncSource) (T, error)) (T, error) {
+	var result T
+	var errs error
+	sources := f.ordered()
+	if len(sources) == 0 {
+		return result, ErrNoSyncSources
+	}
+	for _, i := range sources {
+		src := f.source(i)
+		res, err := fn(src)
+		f.markResult(i, err)
+		if err == nil {
+			return res, nil
+		}
+		errs = errors.Join(errs, fmt.Errorf("sync source %s: %w", src, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return result, errs
+}
+
+func (f *FailoverSource) BlockRefByNumber(ctx context.Context, number uint64) (eth.BlockRef, error) {
+	return failover(ctx, f, func(src SyncSource) (eth.BlockRef, error) {
+		return src.BlockRefByNumber(ctx, number)
+	})
+}
+
+func (f *FailoverSource) FetchReceipts(ctx context.Context, blockHash common.Hash) (gethtypes.Receipts, error) {
+	return failover(ctx, f, func(src SyncSource) (gethtypes.Receipts, error) {
+		return src.FetchReceipts(ctx, blockHash)
+	})
+}
+
+func (f *FailoverSource) ChainID(ctx context.Context) (eth.ChainID, error) {
+	return failover(ctx, f, func(src SyncSource) (eth.ChainID, error) {
+		return src.ChainID(ctx)
+	})
+}
+
+func (f *FailoverSource) OutputV0AtTimestamp(ctx context.Context, timestamp uint64) (*eth.OutputV0, error) {
+	return failover(ctx, f, func(src SyncSource) (*eth.OutputV0, error) {
+		return src.OutputV0AtTimestamp(ctx, timestamp)
+	})
+}
+
+func (f *FailoverSource) PendingOutputV0AtTimestamp(ctx context.Context, timestamp uint64) (*eth.OutputV0, error) {
+	return failover(ctx, f, func(src SyncSource) (*eth.OutputV0, error) {
+		return src.PendingOutputV0AtTimestamp(ctx, timestamp)
+	})
+}
+
+func (f *FailoverSource) String() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	names := make([]string, len(f.sources))
+	for i, src := range f.sources {
+		names[i] = src.String()
+	}
+	return "failover(" + strings.Join(names, ", ") + ")"
+}
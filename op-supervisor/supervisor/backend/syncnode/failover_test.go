@@ -0,0 +1,124 @@
+package syncnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type mockSyncSource struct {
+	name  string
+	calls int
+	err   error
+}
+
+var _ SyncSource = (*mockSyncSource)(nil)
+
+func (m *mockSyncSource) BlockRefByNumber(ctx context.Context, number uint64) (eth.BlockRef, error) {
+	m.calls++
+	if m.err != nil {
+		return eth.BlockRef{}, m.err
+	}
+	return eth.BlockRef{Number: number, Hash: common.Hash{byte(len(m.name))}}, nil
+}
+
+func (m *mockSyncSource) FetchReceipts(ctx context.Context, blockHash common.Hash) (gethtypes.Receipts, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func (m *mockSyncSource) ChainID(ctx context.Context) (eth.ChainID, error) {
+	m.calls++
+	return eth.ChainIDFromUInt64(900), m.err
+}
+
+func (m *mockSyncSource) OutputV0AtTimestamp(ctx context.Context, timestamp uint64) (*eth.OutputV0, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func (m *mockSyncSource) PendingOutputV0AtTimestamp(ctx context.Context, timestamp uint64) (*eth.OutputV0, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func (m *mockSyncSource) String() string {
+	return m.name
+}
+
+func TestFailoverSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no sources", func(t *testing.T) {
+		f := NewFailoverSource()
+		_, err := f.BlockRefByNumber(ctx, 1)
+		require.ErrorIs(t, err, ErrNoSyncSources)
+	})
+
+	t.Run("fails over and avoids the failed source", func(t *testing.T) {
+		a := &mockSyncSource{name: "a", err: errors.New("connection refused")}
+		b := &mockSyncSource{name: "b"}
+		f := NewFailoverSource()
+		f.Add(a)
+		f.Add(b)
+		require.Equal(t, 2, f.Len())
+		require.Equal(t, "failover(a, b)", f.String())
+
+		_, err := f.BlockRefByNumber(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, a.calls)
+		require.Equal(t, 1, b.calls)
+
+		// the failed source is skipped while backing off
+		_, err = f.BlockRefByNumber(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, a.calls)
+		require.Equal(t, 2, b.calls)
+	})
+
+	t.Run("retries failed sources if all failed", func(t *testing.T) {
+		a := &mockSyncSource{name: "a", err: errors.New("a down")}
+		b := &mockSyncSource{name: "b", err: errors.New("b down")}
+		f := NewFailoverSource()
+		f.Add(a)
+		f.Add(b)
+		_, err := f.BlockRefByNumber(ctx, 1)
+		require.ErrorContains(t, err, "a down")
+		require.ErrorContains(t, err, "b down")
+
+		a.err = nil
+		_, err = f.BlockRefByNumber(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, a.calls)
+
+		// a recovered, and is preferred again
+		_, err = f.BlockRefByNumber(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 3, a.calls)
+		require.Equal(t, 1, b.calls)
+	})
+
+	t.Run("not found is not a failure", func(t *testing.T) {
+		a := &mockSyncSource{name: "a", err: ethereum.NotFound}
+		b := &mockSyncSource{name: "b", err: ethereum.NotFound}
+		f := NewFailoverSource()
+		f.Add(a)
+		f.Add(b)
+		_, err := f.BlockRefByNumber(ctx, 1)
+		require.ErrorIs(t, err, ethereum.NotFound)
+
+		a.err = nil
+		_, err = f.BlockRefByNumber(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, a.calls, "source must still be preferred")
+		require.Equal(t, 1, b.calls)
+	})
+}
@@ -0,0 +1,190 @@
+package syncnode
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
+)
+
+// maxReportHistory is the number of recent blocks reported by each node that are compared with the other nodes.
+const maxReportHistory = 64
+
+// reportHistory tracks the recent blocks reported by a node.
+type reportHistory struct {
+	latest eth.BlockID
+	hashes map[uint64]common.Hash
+}
+
+func newReportHistory() *reportHistory {
+	return &reportHistory{hashes: make(map[uint64]common.Hash)}
+}
+
+// add records the reported block. A block at or below the latest block replaces the history from its height,
+// since the node reorged or was reset.
+func (h *reportHistory) add(id eth.BlockID) {
+	for n := range h.hashes {
+		if n >= id.Number || n+maxReportHistory <= id.Number {
+			delete(h.hashes, n)
+		}
+	}
+	h.hashes[id.Number] = id.Hash
+	h.latest = id
+}
+
+// conflicts returns true if the latest block of the history conflicts with the block at the same height
+// of the other history.
+func (h *reportHistory) conflicts(other *reportHistory) bool {
+	hash, ok := other.hashes[h.latest.Number]
+	return ok && hash != h.latest.Hash
+}
+
+// nodeGroup reconciles the managed nodes of a chain. Only the reports of the primary node, the first attached node
+// that is healthy, are forwarded to the supervisor. The reports of all nodes are compared,
+// and the chain is degraded while the nodes disagree on the unsafe or derived blocks.
+type nodeGroup struct {
+	log     log.Logger
+	chainID eth.ChainID
+	emitter event.Emitter
+
+	mu       sync.Mutex
+	nodes    []*ManagedNode
+	primary  *ManagedNode
+	unsafe   map[*ManagedNode]*reportHistory
+	derived  map[*ManagedNode]*reportHistory
+	degraded bool
+}
+
+func newNodeGroup(log log.Logger, chainID eth.ChainID, emitter event.Emitter) *nodeGroup {
+	return &nodeGroup{
+		log:     log.New("chain", chainID),
+		chainID: chainID,
+		emitter: emitter,
+		unsafe:  make(map[*ManagedNode]*reportHistory),
+		derived: make(map[*ManagedNode]*reportHistory),
+	}
+}
+
+func (g *nodeGroup) add(node *ManagedNode) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.nodes = append(g.nodes, node)
+	g.unsafe[node] = newReportHistory()
+	g.derived[node] = newReportHistory()
+	g.selectPrimary()
+}
+
+// remove removes a closed node from the group.
+func (g *nodeGroup) remove(node *ManagedNode) {
+	g.mu.Lock()
+	for i, n := range g.nodes {
+		if n == node {
+			g.nodes = append(g.nodes[:i], g.nodes[i+1:]...)
+			break
+		}
+	}
+	delete(g.unsafe, node)
+	delete(g.derived, node)
+	if g.primary == node {
+		g.primary = nil
+	}
+	g.selectPrimary()
+	changed := g.updateDegraded()
+	degraded := g.degraded
+	g.mu.Unlock()
+	g.emitDegraded(changed, degraded)
+}
+
+// selectPrimary keeps the primary node while it is healthy, and otherwise prefers the first healthy node.
+func (g *nodeGroup) selectPrimary() {
+	if g.primary != nil && g.primary.Healthy() {
+		return
+	}
+	for _, node := range g.nodes {
+		if node.Healthy() {
+			if g.primary != nil {
+				g.log.Warn("Primary node is unhealthy, failing over", "from", g.primary.name, "to", node.name)
+			}
+			g.primary = node
+			return
+		}
+	}
+	if g.primary == nil && len(g.nodes) > 0 {
+		g.primary = g.nodes[0]
+	}
+}
+
+// reportUnsafe records an unsafe block reported by the node,
+// and returns true if the node is the primary node, i.e. the report is to be forwarded.
+func (g *nodeGroup) reportUnsafe(node *ManagedNode, id eth.BlockID) bool {
+	return g.report(node, g.unsafe, id)
+}
+
+// reportDerived records a derived block reported by the node,
+// and returns true if the node is the primary node, i.e. the report is to be forwarded.
+func (g *nodeGroup) reportDerived(node *ManagedNode, id eth.BlockID) bool {
+	return g.report(node, g.derived, id)
+}
+
+func (g *nodeGroup) report(node *ManagedNode, reports map[*ManagedNode]*reportHistory, id eth.BlockID) bool {
+	g.mu.Lock()
+	h, ok := reports[node]
+	if ok {
+		h.add(id)
+	}
+	g.selectPrimary()
+	primary := g.primary == node
+	changed := g.updateDegraded()
+	degraded := g.degraded
+	g.mu.Unlock()
+	g.emitDegraded(changed, degraded)
+	return primary
+}
+
+// updateDegraded recomputes whether the nodes disagree, and returns true if that changed.
+func (g *nodeGroup) updateDegraded() bool {
+	degraded := false
+	for _, reports := range []map[*ManagedNode]*reportHistory{g.unsafe, g.derived} {
+		for a, ha := range reports {
+			for b, hb := range reports {
+				if a != b && ha.conflicts(hb) {
+					if !g.degraded {
+						g.log.Error("Managed nodes disagree, chain is degraded",
+							"node", a.name, "block", ha.latest, "other", b.name, "otherHash", hb.hashes[ha.latest.Number])
+					}
+					degraded = true
+				}
+			}
+		}
+	}
+	if degraded == g.degraded {
+		return false
+	}
+	if !degraded {
+		g.log.Info("Managed nodes agree again, chain recovered")
+	}
+	g.degraded = degraded
+	return true
+}
+
+func (g *nodeGroup) emitDegraded(changed bool, degraded bool) {
+	if !changed {
+		return
+	}
+	g.emitter.Emit(superevents.ChainDegradedEvent{ChainID: g.chainID, Degraded: degraded})
+	if !degraded {
+		// resume the cross-safety promotion that was paused
+		g.emitter.Emit(superevents.UpdateCrossSafeRequestEvent{ChainID: g.chainID})
+	}
+}
+
+// Degraded returns true if the nodes of the chain disagree.
+func (g *nodeGroup) Degraded() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.degraded
+}
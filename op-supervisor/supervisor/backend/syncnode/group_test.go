@@ -0,0 +1,109 @@
+package syncnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/backend/superevents"
+)
+
+type captureEmitter struct {
+	events []event.Event
+}
+
+func (c *captureEmitter) Emit(ev event.Event) {
+	c.events = append(c.events, ev)
+}
+
+func newTestGroupNode(t *testing.T, g *nodeGroup, name string) *ManagedNode {
+	node := &ManagedNode{name: name, log: testlog.Logger(t, log.LevelDebug), group: g}
+	node.healthy.Store(true)
+	g.add(node)
+	return node
+}
+
+func TestNodeGroup(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(900)
+	block := func(n uint64, h byte) eth.BlockID {
+		return eth.BlockID{Number: n, Hash: common.Hash{h}}
+	}
+
+	t.Run("primary forwards", func(t *testing.T) {
+		em := &captureEmitter{}
+		g := newNodeGroup(testlog.Logger(t, log.LevelDebug), chainID, em)
+		a := newTestGroupNode(t, g, "a")
+		b := newTestGroupNode(t, g, "b")
+		require.True(t, g.reportUnsafe(a, block(1, 1)))
+		require.False(t, g.reportUnsafe(b, block(1, 1)))
+		require.True(t, g.reportDerived(a, block(1, 1)))
+		require.False(t, g.reportDerived(b, block(1, 1)))
+		require.False(t, g.Degraded())
+		require.Empty(t, em.events)
+	})
+
+	t.Run("fails over to healthy node", func(t *testing.T) {
+		em := &captureEmitter{}
+		g := newNodeGroup(testlog.Logger(t, log.LevelDebug), chainID, em)
+		a := newTestGroupNode(t, g, "a")
+		b := newTestGroupNode(t, g, "b")
+		a.healthy.Store(false)
+		require.True(t, g.reportUnsafe(b, block(1, 1)))
+		require.False(t, g.reportUnsafe(a, block(1, 1)))
+
+		// the new primary is kept, even when the old one recovers
+		a.healthy.Store(true)
+		require.False(t, g.reportUnsafe(a, block(2, 2)))
+		require.True(t, g.reportUnsafe(b, block(2, 2)))
+
+		// the closed primary is replaced
+		g.remove(b)
+		require.True(t, g.reportUnsafe(a, block(3, 3)))
+	})
+
+	t.Run("degraded on disagreement", func(t *testing.T) {
+		em := &captureEmitter{}
+		g := newNodeGroup(testlog.Logger(t, log.LevelDebug), chainID, em)
+		a := newTestGroupNode(t, g, "a")
+		b := newTestGroupNode(t, g, "b")
+		g.reportDerived(a, block(1, 1))
+		g.reportDerived(b, block(1, 2))
+		require.True(t, g.Degraded())
+		require.Equal(t, []event.Event{
+			superevents.ChainDegradedEvent{ChainID: chainID, Degraded: true},
+		}, em.events)
+
+		// further disagreement does not emit again
+		g.reportDerived(a, block(2, 3))
+		require.Len(t, em.events, 1)
+
+		// b reorgs to the block of a, and the chain recovers
+		g.reportDerived(b, block(1, 1))
+		g.reportDerived(b, block(2, 3))
+		require.False(t, g.Degraded())
+		require.Equal(t, []event.Event{
+			superevents.ChainDegradedEvent{ChainID: chainID, Degraded: true},
+			superevents.ChainDegradedEvent{ChainID: chainID, Degraded: false},
+			superevents.UpdateCrossSafeRequestEvent{ChainID: chainID},
+		}, em.events)
+	})
+
+	t.Run("recovers when disagreeing node is removed", func(t *testing.T) {
+		em := &captureEmitter{}
+		g := newNodeGroup(testlog.Logger(t, log.LevelDebug), chainID, em)
+		a := newTestGroupNode(t, g, "a")
+		b := newTestGroupNode(t, g, "b")
+		g.reportUnsafe(a, block(5, 1))
+		g.reportUnsafe(b, block(5, 2))
+		require.True(t, g.Degraded())
+		g.remove(b)
+		require.False(t, g.Degraded())
+		require.Len(t, em.events, 3)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
@@ -38,6 +39,19 @@ type ManagedNode struct {
 	log     log.Logger
 	Node    SyncControl
 	chainID eth.ChainID
+	name    string
+
+	// group reconciles the reports of this node with the other nodes of the chain.
+	// Nil if the node is not part of a group, in which case all its reports are forwarded.
+	group *nodeGroup
+	// healthy is false after a request to the node or its subscription failed,
+	// until a request succeeds or an event is received again.
+	healthy atomic.Bool
+
+	// reportedLock guards the latest unsafe and derived blocks reported by the node.
+	reportedLock    sync.Mutex
+	reportedUnsafe  eth.BlockID
+	reportedDerived eth.BlockID
 
 	backend backend
 
@@ -67,6 +81,7 @@ func NewManagedNode(log log.Logger, id eth.ChainID, node SyncControl, backend ba
 		ctx:     ctx,
 		cancel:  cancel,
 	}
+	m.healthy.Store(true)
 	if !noSubscribe {
 		m.SubscribeToNodeEvents()
 	}
@@ -74,6 +89,26 @@ func NewManagedNode(log log.Logger, id eth.ChainID, node SyncControl, backend ba
 	return m
 }
 
+// Healthy returns false if the last request to the node, or its subscription, failed.
+func (m *ManagedNode) Healthy() bool {
+	return m.healthy.Load()
+}
+
+// ReportedHeads returns the latest unsafe and derived blocks reported by the node.
+// The blocks are zero until the node reported them.
+func (m *ManagedNode) ReportedHeads() (unsafe eth.BlockID, derived eth.BlockID) {
+	m.reportedLock.Lock()
+	defer m.reportedLock.Unlock()
+	return m.reportedUnsafe, m.reportedDerived
+}
+
+// trackHealth records the result of a request to the node.
+func (m *ManagedNode) trackHealth(err error) {
+	if m.healthy.Swap(err == nil) != (err == nil) {
+		m.log.Info("Node health changed", "healthy", err == nil, "err", err)
+	}
+}
+
 func (m *ManagedNode) AttachEmitter(em event.Emitter) {
 	m.emitter = em
 }
@@ -100,7 +135,11 @@ func (m *ManagedNode) OnEvent(ev event.Event) bool {
 			return false
 		}
 		m.resetSignal(x.Err, x.L1Ref)
-	// TODO: watch for reorg events from DB. Send a reset signal to op-node if needed
+	case superevents.DependentReorgEvent:
+		if x.ChainID != m.chainID {
+			return false
+		}
+		m.onDependentReorg(x.Dependent, x.ResetTo)
 	default:
 		return false
 	}
@@ -143,6 +182,7 @@ func (m *ManagedNode) WatchSubscriptionErrors() {
 		select {
 		case err := <-sub.Err():
 			m.log.Error("Subscription error", "err", err)
+			m.trackHealth(err)
 		case <-m.ctx.Done():
 			// we're closing, stop watching the subscription
 		}
@@ -192,6 +232,7 @@ func (m *ManagedNode) onNodeEvent(ev *types.ManagedEvent) {
 		m.log.Warn("Received nil event")
 		return
 	}
+	m.trackHealth(nil)
 	if ev.Reset != nil {
 		m.onResetEvent(*ev.Reset)
 	}
@@ -223,6 +264,7 @@ func (m *ManagedNode) onCrossUnsafeUpdate(seal types.BlockSeal) {
 	defer cancel()
 	id := seal.ID()
 	err := m.Node.UpdateCrossUnsafe(ctx, id)
+	m.trackHealth(err)
 	if err != nil {
 		m.log.Warn("Node failed cross-unsafe updating", "err", err)
 		return
@@ -235,6 +277,7 @@ func (m *ManagedNode) onCrossSafeUpdate(pair types.DerivedBlockSealPair) {
 	defer cancel()
 	pairIDs := pair.IDs()
 	err := m.Node.UpdateCrossSafe(ctx, pairIDs.Derived, pairIDs.DerivedFrom)
+	m.trackHealth(err)
 	if err != nil {
 		m.log.Warn("Node failed cross-safe updating", "err", err)
 		return
@@ -247,6 +290,7 @@ func (m *ManagedNode) onFinalizedL2(seal types.BlockSeal) {
 	defer cancel()
 	id := seal.ID()
 	err := m.Node.UpdateFinalized(ctx, id)
+	m.trackHealth(err)
 	if err != nil {
 		m.log.Warn("Node failed finality updating", "err", err)
 		return
@@ -255,6 +299,12 @@ func (m *ManagedNode) onFinalizedL2(seal types.BlockSeal) {
 
 func (m *ManagedNode) onUnsafeBlock(unsafeRef eth.BlockRef) {
 	m.log.Info("Node has new unsafe block", "unsafeBlock", unsafeRef)
+	m.reportedLock.Lock()
+	m.reportedUnsafe = unsafeRef.ID()
+	m.reportedLock.Unlock()
+	if m.group != nil && !m.group.reportUnsafe(m, unsafeRef.ID()) {
+		return
+	}
 	m.emitter.Emit(superevents.LocalUnsafeReceivedEvent{
 		ChainID:        m.chainID,
 		NewLocalUnsafe: unsafeRef,
@@ -264,6 +314,12 @@ func (m *ManagedNode) onUnsafeBlock(unsafeRef eth.BlockRef) {
 func (m *ManagedNode) onDerivationUpdate(pair types.DerivedBlockRefPair) {
 	m.log.Info("Node derived new block", "derived", pair.Derived,
 		"derivedParent", pair.Derived.ParentID(), "derivedFrom", pair.DerivedFrom)
+	m.reportedLock.Lock()
+	m.reportedDerived = pair.Derived.ID()
+	m.reportedLock.Unlock()
+	if m.group != nil && !m.group.reportDerived(m, pair.Derived.ID()) {
+		return
+	}
 	m.emitter.Emit(superevents.LocalDerivedEvent{
 		ChainID: m.chainID,
 		Derived: pair,
@@ -314,6 +370,7 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 		}
 		log.Debug("Node detected conflict, resetting", "unsafe", u, "safe", s, "finalized", f)
 		err = m.Node.Reset(ctx, u, s, f)
+		m.trackHealth(err)
 		if err != nil {
 			m.log.Warn("Node failed to reset", "err", err)
 		}
@@ -324,6 +381,7 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 		}
 		log.Debug("Node detected future block, resetting", "unsafe", u, "safe", s, "finalized", f)
 		err = m.Node.Reset(ctx, u, s.Derived, f)
+		m.trackHealth(err)
 		if err != nil {
 			m.log.Warn("Node failed to reset", "err", err)
 		}
@@ -332,6 +390,37 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 	}
 }
 
+// onDependentReorg resets the node to the parent of the dependent block,
+// which executes an initiating message that was invalidated by a reorg of another chain.
+func (m *ManagedNode) onDependentReorg(dependent types.BlockSeal, resetTo eth.BlockID) {
+	ctx, cancel := context.WithTimeout(m.ctx, internalTimeout)
+	defer cancel()
+	s, err := m.backend.LocalSafe(ctx, m.chainID)
+	if err != nil {
+		m.log.Warn("Failed to retrieve local-safe", "err", err)
+		return
+	}
+	f, err := m.backend.Finalized(ctx, m.chainID)
+	if err != nil {
+		m.log.Warn("Failed to retrieve finalized", "err", err)
+		return
+	}
+	if f.Number > resetTo.Number {
+		m.log.Error("Cannot reset node to before the finalized block", "dependent", dependent, "resetTo", resetTo, "finalized", f)
+		return
+	}
+	safe := s.Derived
+	if safe.Number > resetTo.Number {
+		safe = resetTo
+	}
+	m.log.Warn("Resetting node, block depends on reorged chain", "dependent", dependent, "unsafe", resetTo, "safe", safe, "finalized", f)
+	err = m.Node.Reset(ctx, resetTo, safe, f)
+	m.trackHealth(err)
+	if err != nil {
+		m.log.Warn("Node failed to reset", "err", err)
+	}
+}
+
 func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
 	m.log.Info("Node completed syncing", "l2", completed.Derived, "l1", completed.DerivedFrom)
 
@@ -349,7 +438,9 @@ func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
 
 	nodeCtx, cancel := context.WithTimeout(m.ctx, nodeTimeout)
 	defer cancel()
-	if err := m.Node.ProvideL1(nodeCtx, nextL1); err != nil {
+	err = m.Node.ProvideL1(nodeCtx, nextL1)
+	m.trackHealth(err)
+	if err != nil {
 		m.log.Warn("Failed to provide next L1 block to node", "err", err)
 		// We will reset the node if we receive a reset-event from it,
 		// which is fired if the provided L1 block was received successfully,
@@ -359,6 +450,9 @@ func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
 }
 
 func (m *ManagedNode) Close() error {
+	if m.group != nil {
+		m.group.remove(m)
+	}
 	m.cancel()
 	m.wg.Wait() // wait for work to complete
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
@@ -89,3 +90,39 @@ func TestEventResponse(t *testing.T) {
 			nodeExhausted >= 1
 	}, 4*time.Second, 250*time.Millisecond)
 }
+
+func TestDependentReorg(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(1)
+	logger := testlog.Logger(t, log.LvlInfo)
+	syncCtrl := &mockSyncControl{}
+
+	ex := event.NewGlobalSynchronous(context.Background())
+	eventSys := event.NewSystem(logger, ex)
+
+	node := NewManagedNode(logger, chainID, syncCtrl, &mockBackend{}, true)
+	eventSys.Register("node", node, event.DefaultRegisterOpts())
+	emitter := eventSys.Register("test", nil, event.DefaultRegisterOpts())
+
+	var resets [][3]eth.BlockID
+	syncCtrl.resetFn = func(ctx context.Context, unsafe, safe, finalized eth.BlockID) error {
+		resets = append(resets, [3]eth.BlockID{unsafe, safe, finalized})
+		return nil
+	}
+
+	resetTo := eth.BlockID{Hash: common.Hash{0xaa}, Number: 5}
+	emitter.Emit(superevents.DependentReorgEvent{
+		ChainID:   eth.ChainIDFromUInt64(2),
+		Dependent: types.BlockSeal{Number: 6},
+		ResetTo:   resetTo,
+	})
+	require.NoError(t, ex.Drain())
+	require.Empty(t, resets, "other chains are ignored")
+
+	emitter.Emit(superevents.DependentReorgEvent{
+		ChainID:   chainID,
+		Dependent: types.BlockSeal{Number: 6},
+		ResetTo:   resetTo,
+	})
+	require.NoError(t, ex.Drain())
+	require.Equal(t, [][3]eth.BlockID{{resetTo, {}, {}}}, resets)
+}
@@ -0,0 +1,131 @@
+package syncnode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/tasks"
+)
+
+const (
+	HeadUnsafe  = "unsafe"
+	HeadDerived = "derived"
+)
+
+// HeadSource is an RPC of a chain, independent of its managed nodes, that follows the canonical chain.
+type HeadSource interface {
+	InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error)
+}
+
+type WatchdogMetrics interface {
+	RecordHeadDivergence(chainID eth.ChainID, node string, head string, diverged bool)
+}
+
+// NodeLister lists the managed nodes of a chain.
+type NodeLister interface {
+	Nodes(chainID eth.ChainID) []*ManagedNode
+}
+
+type divergenceKey struct {
+	chainID eth.ChainID
+	node    string
+	head    string
+}
+
+// HeadWatchdog periodically checks the heads reported by the managed nodes of each chain against an independent
+// source of the chain. A node that reports a block that differs from the block of the source at the same height
+// has forked from the canonical chain, which is reported with an error log and the divergence metric.
+type HeadWatchdog struct {
+	log     log.Logger
+	m       WatchdogMetrics
+	nodes   NodeLister
+	sources map[eth.ChainID]HeadSource
+
+	// diverged tracks the heads that diverged in the last check, to only log changes.
+	mu       sync.Mutex
+	diverged map[divergenceKey]bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	poller *tasks.Poller
+}
+
+func NewHeadWatchdog(log log.Logger, m WatchdogMetrics, nodes NodeLister, sources map[eth.ChainID]HeadSource, interval time.Duration) *HeadWatchdog {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &HeadWatchdog{
+		log:      log.New("service", "head-watchdog"),
+		m:        m,
+		nodes:    nodes,
+		sources:  sources,
+		diverged: make(map[divergenceKey]bool),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	w.poller = tasks.NewPoller(func() { w.Check(w.ctx) }, clock.SystemClock, interval)
+	return w
+}
+
+func (w *HeadWatchdog) Start() {
+	w.log.Info("Starting head watchdog", "chains", len(w.sources))
+	w.poller.Start()
+}
+
+func (w *HeadWatchdog) Close() {
+	w.cancel()
+	w.poller.Stop()
+}
+
+// Check compares the latest heads reported by all managed nodes with the blocks of the independent sources.
+func (w *HeadWatchdog) Check(ctx context.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for chainID, src := range w.sources {
+		for _, node := range w.nodes.Nodes(chainID) {
+			unsafe, derived := node.ReportedHeads()
+			w.checkHead(ctx, chainID, src, node.name, HeadUnsafe, unsafe)
+			w.checkHead(ctx, chainID, src, node.name, HeadDerived, derived)
+		}
+	}
+}
+
+func (w *HeadWatchdog) checkHead(ctx context.Context, chainID eth.ChainID, src HeadSource, node string, head string, id eth.BlockID) {
+	if id == (eth.BlockID{}) {
+		// The node did not report this head yet
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, nodeTimeout)
+	defer cancel()
+	info, err := src.InfoByNumber(ctx, id.Number)
+	if errors.Is(err, ethereum.NotFound) {
+		// The source is behind the node, the head is checked again once the source caught up.
+		w.log.Debug("Head not available from watchdog source yet", "chain", chainID, "node", node, "head", head, "block", id)
+		return
+	} else if err != nil {
+		w.log.Warn("Failed to fetch block from watchdog source", "chain", chainID, "number", id.Number, "err", err)
+		return
+	}
+	key := divergenceKey{chainID: chainID, node: node, head: head}
+	diverged := info.Hash() != id.Hash
+	if diverged && !w.diverged[key] {
+		w.log.Error("Managed node diverged from canonical chain", "chain", chainID, "node", node, "head", head,
+			"reported", id, "canonical", info.Hash())
+	} else if !diverged && w.diverged[key] {
+		w.log.Info("Managed node is back on canonical chain", "chain", chainID, "node", node, "head", head, "reported", id)
+	}
+	w.diverged[key] = diverged
+	w.m.RecordHeadDivergence(chainID, node, head, diverged)
+}
+
+// Diverged returns true if the head of the node diverged from the canonical chain in the last check.
+func (w *HeadWatchdog) Diverged(chainID eth.ChainID, node string, head string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.diverged[divergenceKey{chainID: chainID, node: node, head: head}]
+}
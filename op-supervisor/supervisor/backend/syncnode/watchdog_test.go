@@ -0,0 +1,113 @@
+package syncnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type stubHeadSource struct {
+	blocks map[uint64]common.Hash
+	err    error
+}
+
+func (s *stubHeadSource) InfoByNumber(ctx context.Context, number uint64) (eth.BlockInfo, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	hash, ok := s.blocks[number]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return &testutils.MockBlockInfo{InfoHash: hash, InfoNum: number}, nil
+}
+
+type stubNodeLister map[eth.ChainID][]*ManagedNode
+
+func (s stubNodeLister) Nodes(chainID eth.ChainID) []*ManagedNode {
+	return s[chainID]
+}
+
+type stubWatchdogMetrics struct {
+	recorded map[divergenceKey]bool
+}
+
+func (s *stubWatchdogMetrics) RecordHeadDivergence(chainID eth.ChainID, node string, head string, diverged bool) {
+	s.recorded[divergenceKey{chainID: chainID, node: node, head: head}] = diverged
+}
+
+func TestHeadWatchdog(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(900)
+	canonical := map[uint64]common.Hash{10: {0x0a}, 11: {0x0b}}
+	setup := func(t *testing.T) (*HeadWatchdog, *ManagedNode, *stubHeadSource, *stubWatchdogMetrics) {
+		node := &ManagedNode{name: "node-0"}
+		src := &stubHeadSource{blocks: canonical}
+		m := &stubWatchdogMetrics{recorded: make(map[divergenceKey]bool)}
+		logger := testlog.Logger(t, log.LevelCrit)
+		w := NewHeadWatchdog(logger, m, stubNodeLister{chainID: {node}}, map[eth.ChainID]HeadSource{chainID: src}, time.Second)
+		return w, node, src, m
+	}
+
+	t.Run("Consistent", func(t *testing.T) {
+		w, node, _, m := setup(t)
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0x0b}, Number: 11}
+		node.reportedDerived = eth.BlockID{Hash: common.Hash{0x0a}, Number: 10}
+		w.Check(context.Background())
+		require.False(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+		require.False(t, w.Diverged(chainID, "node-0", HeadDerived))
+		require.Len(t, m.recorded, 2)
+	})
+
+	t.Run("DivergeAndRecover", func(t *testing.T) {
+		w, node, _, m := setup(t)
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0xba, 0xd0}, Number: 11}
+		node.reportedDerived = eth.BlockID{Hash: common.Hash{0x0a}, Number: 10}
+		w.Check(context.Background())
+		require.True(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+		require.False(t, w.Diverged(chainID, "node-0", HeadDerived))
+		require.True(t, m.recorded[divergenceKey{chainID: chainID, node: "node-0", head: HeadUnsafe}])
+
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0x0b}, Number: 11}
+		w.Check(context.Background())
+		require.False(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+		require.False(t, m.recorded[divergenceKey{chainID: chainID, node: "node-0", head: HeadUnsafe}])
+	})
+
+	t.Run("SkipUnreportedHeads", func(t *testing.T) {
+		w, _, _, m := setup(t)
+		w.Check(context.Background())
+		require.Empty(t, m.recorded)
+	})
+
+	t.Run("SkipHeadsAheadOfSource", func(t *testing.T) {
+		w, node, _, m := setup(t)
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0x0c}, Number: 12}
+		w.Check(context.Background())
+		require.False(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+		require.Empty(t, m.recorded)
+	})
+
+	t.Run("KeepStateOnSourceError", func(t *testing.T) {
+		w, node, src, m := setup(t)
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0xba, 0xd0}, Number: 11}
+		w.Check(context.Background())
+		require.True(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+
+		src.err = errors.New("boom")
+		node.reportedUnsafe = eth.BlockID{Hash: common.Hash{0x0b}, Number: 11}
+		w.Check(context.Background())
+		require.True(t, w.Diverged(chainID, "node-0", HeadUnsafe))
+		require.True(t, m.recorded[divergenceKey{chainID: chainID, node: "node-0", head: HeadUnsafe}])
+	})
+}
@@ -24,12 +24,16 @@ type QueryBackend interface {
 	Finalized(ctx context.Context, chainID eth.ChainID) (eth.BlockID, error)
 	FinalizedL1() eth.BlockRef
 	SuperRootAtTimestamp(ctx context.Context, timestamp hexutil.Uint64) (eth.SuperRootResponse, error)
+	SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error)
+	FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error)
 	AllSafeDerivedAt(ctx context.Context, derivedFrom eth.BlockID) (derived map[eth.ChainID]eth.BlockID, err error)
+	MessageStats(ctx context.Context) (types.MessageStats, error)
 }
 
 type Backend interface {
 	AdminBackend
 	QueryBackend
+	ProxyBackend
 }
 
 type QueryFrontend struct {
@@ -76,10 +80,28 @@ func (q *QueryFrontend) SuperRootAtTimestamp(ctx context.Context, timestamp hexu
 	return q.Supervisor.SuperRootAtTimestamp(ctx, timestamp)
 }
 
+// SuperRootProofAtTimestamp returns the proof of inclusion of the output root of the chain
+// in the super root at the timestamp. It enables verifiers that only know the super root,
+// e.g. as posted to L1, to check the output root of a single chain.
+func (q *QueryFrontend) SuperRootProofAtTimestamp(ctx context.Context, chainID eth.ChainID, timestamp hexutil.Uint64) (*eth.SuperRootProof, error) {
+	return q.Supervisor.SuperRootProofAtTimestamp(ctx, chainID, timestamp)
+}
+
+// FinalizedSuperRoot returns the super root of the latest timestamp at which all chains are finalized.
+func (q *QueryFrontend) FinalizedSuperRoot(ctx context.Context) (eth.SuperRootResponse, error) {
+	return q.Supervisor.FinalizedSuperRoot(ctx)
+}
+
 func (q *QueryFrontend) AllSafeDerivedAt(ctx context.Context, derivedFrom eth.BlockID) (derived map[eth.ChainID]eth.BlockID, err error) {
 	return q.Supervisor.AllSafeDerivedAt(ctx, derivedFrom)
 }
 
+// MessageStats returns the statistics of the executing messages of each chain pair,
+// and the most recently detected anomalies of cross-chain messages.
+func (q *QueryFrontend) MessageStats(ctx context.Context) (types.MessageStats, error) {
+	return q.Supervisor.MessageStats(ctx)
+}
+
 type AdminFrontend struct {
 	Supervisor Backend
 }
@@ -0,0 +1,164 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys/bindings"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+// ProxyMinSafety is the minimum safety of the initiating messages of a transaction,
+// for the transaction to be forwarded to the sequencer.
+// Local-unsafe is not sufficient, since messages that are not yet known to the supervisor are reported as local-unsafe.
+const ProxyMinSafety = types.CrossUnsafe
+
+// TxRejectedErrorCode is the JSON-RPC error code of rejected transactions, as defined in EIP-1474.
+const TxRejectedErrorCode = -32003
+
+type TxRejectReason string
+
+const (
+	RejectInvalidTx         TxRejectReason = "invalid-tx"
+	RejectUnknownChain      TxRejectReason = "unknown-chain"
+	RejectInvalidAccessList TxRejectReason = "invalid-access-list"
+	RejectUndeclaredMessage TxRejectReason = "undeclared-message"
+	RejectInvalidMessage    TxRejectReason = "invalid-message"
+	RejectUnsafeMessage     TxRejectReason = "unsafe-message"
+)
+
+// TxRejectedError is returned when a transaction is not forwarded to the sequencer.
+// The details of the rejection are returned as JSON-RPC error data.
+type TxRejectedError struct {
+	Reason TxRejectReason `json:"reason"`
+	// Access is the access-list declaration of the executing message that failed validation, if any.
+	Access *types.Access `json:"access,omitempty"`
+	// Message is the executing message that is not declared in the access-list, if any.
+	Message *types.Message `json:"message,omitempty"`
+	// Safety is the safety level of the executing message that failed validation, if any.
+	Safety types.SafetyLevel `json:"safety,omitempty"`
+	Detail string            `json:"detail"`
+}
+
+func (e *TxRejectedError) Error() string {
+	return fmt.Sprintf("transaction rejected (%s): %s", e.Reason, e.Detail)
+}
+
+func (e *TxRejectedError) ErrorCode() int {
+	return TxRejectedErrorCode
+}
+
+func (e *TxRejectedError) ErrorData() interface{} {
+	return e
+}
+
+type ProxyBackend interface {
+	// CheckAccess checks the safety-level of the initiating message of an access-list declaration.
+	// The declaration is invalid if the checksum does not match the initiating message.
+	CheckAccess(access types.Access) (types.SafetyLevel, error)
+	// RecordInvalidMessage records a transaction of the sender to the chain, which references an invalid message.
+	RecordInvalidMessage(chainID eth.ChainID, sender common.Address)
+}
+
+// ProxyFrontend serves eth_sendRawTransaction to wallets.
+// Transactions are forwarded to the sequencer of the target chain,
+// only if all executing messages declared in the access-list are valid.
+// Transactions that call the CrossL2Inbox or relay a message directly must declare the executed message,
+// as the CrossL2Inbox reverts otherwise.
+type ProxyFrontend struct {
+	Log        log.Logger
+	Supervisor ProxyBackend
+	Sequencers map[eth.ChainID]client.RPC
+}
+
+func (p *ProxyFrontend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	var tx ethTypes.Transaction
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, &TxRejectedError{Reason: RejectInvalidTx, Detail: err.Error()}
+	}
+	chainID := eth.ChainIDFromBig(tx.ChainId())
+	sequencer, ok := p.Sequencers[chainID]
+	if !ok {
+		return common.Hash{}, &TxRejectedError{Reason: RejectUnknownChain, Detail: fmt.Sprintf("no sequencer for chain %v", chainID)}
+	}
+	accesses, err := types.ParseAccessList(tx.AccessList())
+	if err != nil {
+		return common.Hash{}, &TxRejectedError{Reason: RejectInvalidAccessList, Detail: err.Error()}
+	}
+	if err := checkDeclared(&tx, accesses); err != nil {
+		p.Log.Info("Rejected transaction", "tx", tx.Hash(), "chain", chainID, "err", err)
+		return common.Hash{}, err
+	}
+	for _, access := range accesses {
+		if err := p.checkAccess(access); err != nil {
+			p.Log.Info("Rejected transaction", "tx", tx.Hash(), "chain", chainID, "err", err)
+			var rejected *TxRejectedError
+			if errors.As(err, &rejected) && rejected.Reason == RejectInvalidMessage {
+				p.recordInvalidMessage(chainID, &tx)
+			}
+			return common.Hash{}, err
+		}
+	}
+	var result common.Hash
+	if err := sequencer.CallContext(ctx, &result, "eth_sendRawTransaction", data); err != nil {
+		return common.Hash{}, err
+	}
+	p.Log.Debug("Forwarded transaction", "tx", result, "chain", chainID, "messages", len(accesses))
+	return result, nil
+}
+
+// recordInvalidMessage records the sender of the transaction, to detect senders that repeatedly send invalid messages.
+func (p *ProxyFrontend) recordInvalidMessage(chainID eth.ChainID, tx *ethTypes.Transaction) {
+	sender, err := ethTypes.Sender(ethTypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		p.Log.Debug("Not recording invalid message of transaction with invalid signature", "tx", tx.Hash(), "err", err)
+		return
+	}
+	p.Supervisor.RecordInvalidMessage(chainID, sender)
+}
+
+// checkDeclared checks that the message executed by a direct call to the CrossL2Inbox or L2ToL2CrossDomainMessenger
+// is declared in the access-list of the transaction.
+func checkDeclared(tx *ethTypes.Transaction, accesses []types.Access) error {
+	msg, err := bindings.ExecutingMessageOfCall(tx.To(), tx.Data())
+	if err != nil {
+		return &TxRejectedError{Reason: RejectInvalidTx, Detail: err.Error()}
+	}
+	if msg == nil {
+		return nil
+	}
+	access := msg.Access()
+	for _, declared := range accesses {
+		if declared == access {
+			return nil
+		}
+	}
+	return &TxRejectedError{Reason: RejectUndeclaredMessage, Message: msg,
+		Detail: "executing message is not declared in the access-list"}
+}
+
+func (p *ProxyFrontend) checkAccess(access types.Access) error {
+	safety, err := p.Supervisor.CheckAccess(access)
+	if errors.Is(err, types.ErrUnknownChain) {
+		return &TxRejectedError{Reason: RejectUnknownChain, Access: &access, Detail: err.Error()}
+	} else if err != nil {
+		return fmt.Errorf("failed to check access %+v: %w", access, err)
+	}
+	if safety == types.Invalid {
+		return &TxRejectedError{Reason: RejectInvalidMessage, Access: &access, Safety: safety,
+			Detail: "initiating message does not exist"}
+	}
+	if !safety.AtLeastAsSafe(ProxyMinSafety) {
+		return &TxRejectedError{Reason: RejectUnsafeMessage, Access: &access, Safety: safety,
+			Detail: fmt.Sprintf("initiating message does not meet the minimum safety %v", ProxyMinSafety)}
+	}
+	return nil
+}
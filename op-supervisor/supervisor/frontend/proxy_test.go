@@ -0,0 +1,147 @@
+package frontend
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+type stubProxyBackend struct {
+	known   map[types.Access]types.SafetyLevel
+	invalid int
+}
+
+func (b *stubProxyBackend) CheckAccess(access types.Access) (types.SafetyLevel, error) {
+	if safety, ok := b.known[access]; ok {
+		return safety, nil
+	}
+	return types.Invalid, nil
+}
+
+func (b *stubProxyBackend) RecordInvalidMessage(chainID eth.ChainID, sender common.Address) {
+	b.invalid++
+}
+
+type stubSequencer struct {
+	client.RPC
+	sent []hexutil.Bytes
+}
+
+func (s *stubSequencer) CallContext(_ context.Context, result any, method string, args ...any) error {
+	if method != "eth_sendRawTransaction" {
+		return rpc.ErrNoResult
+	}
+	data := args[0].(hexutil.Bytes)
+	s.sent = append(s.sent, data)
+	var tx ethTypes.Transaction
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	*result.(*common.Hash) = tx.Hash()
+	return nil
+}
+
+func (s *stubSequencer) Subscribe(context.Context, string, any, ...any) (ethereum.Subscription, error) {
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+func TestProxySendRawTransaction(t *testing.T) {
+	chainID := eth.ChainIDFromUInt64(900)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethTypes.LatestSignerForChainID(chainID.ToBig())
+
+	sent := &ethTypes.Log{Address: predeploys.L2toL2CrossDomainMessengerAddr, Topics: []common.Hash{{0x01}}, BlockNumber: 10, Index: 1}
+	msg := bindings.MessageFromLog(sent, 1000, eth.ChainIDFromUInt64(901))
+	inboxABI, err := bindings.CrossL2InboxMetaData.GetAbi()
+	require.NoError(t, err)
+	validateCall, err := inboxABI.Pack("validateMessage", bindings.NewIdentifier(msg.Identifier), msg.PayloadHash)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T) (*ProxyFrontend, *stubProxyBackend, *stubSequencer) {
+		backend := &stubProxyBackend{known: map[types.Access]types.SafetyLevel{msg.Access(): types.CrossSafe}}
+		sequencer := &stubSequencer{}
+		return &ProxyFrontend{
+			Log:        testlog.Logger(t, log.LevelInfo),
+			Supervisor: backend,
+			Sequencers: map[eth.ChainID]client.RPC{chainID: sequencer},
+		}, backend, sequencer
+	}
+	send := func(t *testing.T, p *ProxyFrontend, to common.Address, data []byte, accessList ethTypes.AccessList) error {
+		tx, err := ethTypes.SignNewTx(key, signer, &ethTypes.DynamicFeeTx{
+			ChainID:    chainID.ToBig(),
+			Gas:        100_000,
+			GasFeeCap:  big.NewInt(1),
+			To:         &to,
+			Data:       data,
+			AccessList: accessList,
+		})
+		require.NoError(t, err)
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		hash, err := p.SendRawTransaction(context.Background(), raw)
+		if err == nil {
+			require.Equal(t, tx.Hash(), hash)
+		}
+		return err
+	}
+	requireRejected := func(t *testing.T, err error, reason TxRejectReason) {
+		var rejected *TxRejectedError
+		require.ErrorAs(t, err, &rejected)
+		require.Equal(t, reason, rejected.Reason)
+	}
+
+	t.Run("Declared", func(t *testing.T) {
+		p, _, sequencer := setup(t)
+		require.NoError(t, send(t, p, predeploys.CrossL2InboxAddr, validateCall, bindings.ExecutingMessagesAccessList(msg)))
+		require.Len(t, sequencer.sent, 1)
+	})
+	t.Run("NoMessages", func(t *testing.T) {
+		p, _, sequencer := setup(t)
+		require.NoError(t, send(t, p, common.Address{0xaa}, nil, nil))
+		require.Len(t, sequencer.sent, 1)
+	})
+	t.Run("Undeclared", func(t *testing.T) {
+		p, _, sequencer := setup(t)
+		requireRejected(t, send(t, p, predeploys.CrossL2InboxAddr, validateCall, nil), RejectUndeclaredMessage)
+
+		other := msg
+		other.PayloadHash = common.Hash{0xbb}
+		err := send(t, p, predeploys.CrossL2InboxAddr, validateCall, bindings.ExecutingMessagesAccessList(other))
+		requireRejected(t, err, RejectUndeclaredMessage)
+		require.Empty(t, sequencer.sent)
+	})
+	t.Run("InvalidMessage", func(t *testing.T) {
+		p, backend, sequencer := setup(t)
+		other := msg
+		other.PayloadHash = common.Hash{0xbb}
+		err := send(t, p, common.Address{0xaa}, nil, bindings.ExecutingMessagesAccessList(other))
+		requireRejected(t, err, RejectInvalidMessage)
+		require.Equal(t, 1, backend.invalid)
+		require.Empty(t, sequencer.sent)
+	})
+	t.Run("InvalidAccessList", func(t *testing.T) {
+		p, _, sequencer := setup(t)
+		accessList := bindings.ExecutingMessagesAccessList(msg)
+		accessList[0].StorageKeys = accessList[0].StorageKeys[:1]
+		requireRejected(t, send(t, p, common.Address{0xaa}, nil, accessList), RejectInvalidAccessList)
+		require.Empty(t, sequencer.sent)
+	})
+}
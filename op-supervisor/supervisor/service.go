@@ -12,7 +12,9 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/event"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
@@ -45,6 +47,8 @@ type SupervisorService struct {
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
 	rpcServer    *oprpc.Server
+
+	proxySequencers map[eth.ChainID]client.RPC
 }
 
 var _ cliapp.Lifecycle = (*SupervisorService)(nil)
@@ -68,7 +72,7 @@ func (su *SupervisorService) initFromCLIConfig(ctx context.Context, cfg *config.
 	if err := su.initBackend(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to start backend: %w", err)
 	}
-	if err := su.initRPCServer(cfg); err != nil {
+	if err := su.initRPCServer(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
 	}
 	if err := su.initDBSync(ctx, cfg); err != nil {
@@ -92,6 +96,24 @@ func (su *SupervisorService) initBackend(ctx context.Context, cfg *config.Config
 		return nil
 	}
 
+	if cfg.Simulated() {
+		genesisTime := cfg.SimulatedGenesisTime
+		if genesisTime == 0 {
+			genesisTime = uint64(time.Now().Unix())
+		}
+		be, err := backend.NewSimulatedBackend(su.log, clock.SystemClock, backend.SimulatedConfig{
+			Chains:           cfg.SimulatedChains,
+			BlockTime:        cfg.SimulatedBlockTime,
+			MessagesPerBlock: cfg.SimulatedMessagesPerBlock,
+			GenesisTime:      genesisTime,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create simulated backend: %w", err)
+		}
+		su.backend = be
+		return nil
+	}
+
 	be, err := backend.NewSupervisorBackend(ctx, su.log, su.metrics, cfg, ex)
 	if err != nil {
 		return fmt.Errorf("failed to create supervisor backend: %w", err)
@@ -146,7 +168,7 @@ func (su *SupervisorService) initMetricsServer(cfg *config.Config) error {
 	return nil
 }
 
-func (su *SupervisorService) initRPCServer(cfg *config.Config) error {
+func (su *SupervisorService) initRPCServer(ctx context.Context, cfg *config.Config) error {
 	server := oprpc.NewServer(
 		cfg.RPC.ListenAddr,
 		cfg.RPC.ListenPort,
@@ -167,12 +189,48 @@ func (su *SupervisorService) initRPCServer(cfg *config.Config) error {
 		Service:       &frontend.QueryFrontend{Supervisor: su.backend},
 		Authenticated: false,
 	})
+	if len(cfg.ProxySequencers) > 0 {
+		sequencers, err := su.dialProxySequencers(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		su.proxySequencers = sequencers
+		su.log.Info("Transaction proxy enabled", "chains", len(sequencers))
+		server.AddAPI(rpc.API{
+			Namespace:     "eth",
+			Service:       &frontend.ProxyFrontend{Log: su.log, Supervisor: su.backend, Sequencers: sequencers},
+			Authenticated: false,
+		})
+	}
 
 	su.rpcServer = server
 	return nil
 }
 
+func (su *SupervisorService) dialProxySequencers(ctx context.Context, cfg *config.Config) (map[eth.ChainID]client.RPC, error) {
+	addrs, err := config.ParseProxySequencers(cfg.ProxySequencers)
+	if err != nil {
+		return nil, err
+	}
+	sequencers := make(map[eth.ChainID]client.RPC, len(addrs))
+	for chainID, addr := range addrs {
+		cl, err := client.NewRPC(ctx, su.log.New("chain", chainID), addr)
+		if err != nil {
+			for _, dialed := range sequencers {
+				dialed.Close()
+			}
+			return nil, fmt.Errorf("failed to dial sequencer of chain %v: %w", chainID, err)
+		}
+		sequencers[chainID] = cl
+	}
+	return sequencers, nil
+}
+
 func (su *SupervisorService) initDBSync(ctx context.Context, cfg *config.Config) error {
+	if cfg.Simulated() {
+		// The simulated cluster has no databases to sync
+		return nil
+	}
 	syncCfg := sync.Config{
 		DataDir: cfg.Datadir,
 		Logger:  su.log,
@@ -219,6 +277,9 @@ func (su *SupervisorService) Stop(ctx context.Context) error {
 		}
 	}
 	su.log.Info("Stopped RPC Server")
+	for _, cl := range su.proxySequencers {
+		cl.Close()
+	}
 	if su.backend != nil {
 		if err := su.backend.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close supervisor backend: %w", err))
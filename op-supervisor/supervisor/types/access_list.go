@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+// Executing messages are declared in the access-list of a transaction,
+// as storage keys of the CrossL2Inbox predeploy. Each message is encoded as:
+//
+//	lookup:             0x01 ++ 3 zero bytes ++ chainID (8) ++ blockNumber (8) ++ timestamp (8) ++ logIndex (4)
+//	chain ID extension: 0x02 ++ 7 zero bytes ++ upper 24 bytes of the chain ID, only if it does not fit in 8 bytes
+//	checksum:           0x03 ++ last 31 bytes of the message checksum, see ChecksumArgs
+//
+// The CrossL2Inbox only accepts a message if its checksum entry is in the access-list (i.e. the storage slot is warm).
+// The lookup entries allow the initiating message to be found without executing the transaction.
+const (
+	accessListLookupType           byte = 0x01
+	accessListChainIDExtensionType byte = 0x02
+	accessListChecksumType         byte = 0x03
+)
+
+var ErrInvalidAccessList = errors.New("invalid executing message access-list")
+
+// MessageChecksum commits to an executing message,
+// and is the storage key of the CrossL2Inbox that the message must warm up in the access-list.
+type MessageChecksum common.Hash
+
+func (c MessageChecksum) String() string {
+	return common.Hash(c).String()
+}
+
+func (c MessageChecksum) MarshalText() ([]byte, error) {
+	return common.Hash(c).MarshalText()
+}
+
+func (c *MessageChecksum) UnmarshalText(data []byte) error {
+	return (*common.Hash)(c).UnmarshalText(data)
+}
+
+// ChecksumArgs are the inputs of a MessageChecksum.
+// The log hash commits to the origin and payload hash, see PayloadHashToLogHash.
+type ChecksumArgs struct {
+	BlockNumber uint64
+	LogIndex    uint32
+	Timestamp   uint64
+	ChainID     eth.ChainID
+	LogHash     common.Hash
+}
+
+// Checksum computes the checksum, as computed by the CrossL2Inbox calculateChecksum:
+//
+//	idPacked     = 12 zero bytes ++ blockNumber (8) ++ timestamp (8) ++ logIndex (4)
+//	idLogHash    = keccak256(logHash ++ idPacked)
+//	bareChecksum = keccak256(idLogHash ++ chainID (32))
+//	checksum     = 0x03 ++ bareChecksum[1:]
+func (args ChecksumArgs) Checksum() MessageChecksum {
+	var idPacked [32]byte
+	binary.BigEndian.PutUint64(idPacked[12:20], args.BlockNumber)
+	binary.BigEndian.PutUint64(idPacked[20:28], args.Timestamp)
+	binary.BigEndian.PutUint32(idPacked[28:32], args.LogIndex)
+	idLogHash := crypto.Keccak256Hash(args.LogHash[:], idPacked[:])
+	chainID := (*uint256.Int)(&args.ChainID).Bytes32()
+	checksum := crypto.Keccak256Hash(idLogHash[:], chainID[:])
+	checksum[0] = accessListChecksumType
+	return MessageChecksum(checksum)
+}
+
+// Checksum returns the checksum of the message.
+func (m *Message) Checksum() MessageChecksum {
+	return ChecksumArgs{
+		BlockNumber: m.Identifier.BlockNumber,
+		LogIndex:    m.Identifier.LogIndex,
+		Timestamp:   m.Identifier.Timestamp,
+		ChainID:     m.Identifier.ChainID,
+		LogHash:     PayloadHashToLogHash(m.PayloadHash, m.Identifier.Origin),
+	}.Checksum()
+}
+
+// Access returns the access-list declaration of the message.
+func (m *Message) Access() Access {
+	return Access{
+		BlockNumber: m.Identifier.BlockNumber,
+		LogIndex:    m.Identifier.LogIndex,
+		Timestamp:   m.Identifier.Timestamp,
+		ChainID:     m.Identifier.ChainID,
+		Checksum:    m.Checksum(),
+	}
+}
+
+// Access is an executing message as declared in the access-list of a transaction:
+// the location of the initiating message, and the checksum of the full message.
+type Access struct {
+	BlockNumber uint64          `json:"blockNumber"`
+	LogIndex    uint32          `json:"logIndex"`
+	Timestamp   uint64          `json:"timestamp"`
+	ChainID     eth.ChainID     `json:"chainID"`
+	Checksum    MessageChecksum `json:"checksum"`
+}
+
+// ChecksumArgs returns the checksum inputs of the access, given the log hash of the initiating message it refers to.
+func (a *Access) ChecksumArgs(logHash common.Hash) ChecksumArgs {
+	return ChecksumArgs{
+		BlockNumber: a.BlockNumber,
+		LogIndex:    a.LogIndex,
+		Timestamp:   a.Timestamp,
+		ChainID:     a.ChainID,
+		LogHash:     logHash,
+	}
+}
+
+// EncodeAccessList encodes the executing messages as CrossL2Inbox storage keys.
+func EncodeAccessList(messages []Message) []common.Hash {
+	out := make([]common.Hash, 0, len(messages)*2)
+	for _, msg := range messages {
+		access := msg.Access()
+		chainID := (*uint256.Int)(&access.ChainID).Bytes32()
+
+		var lookup common.Hash
+		lookup[0] = accessListLookupType
+		copy(lookup[4:12], chainID[24:32])
+		binary.BigEndian.PutUint64(lookup[12:20], access.BlockNumber)
+		binary.BigEndian.PutUint64(lookup[20:28], access.Timestamp)
+		binary.BigEndian.PutUint32(lookup[28:32], access.LogIndex)
+		out = append(out, lookup)
+
+		if [24]byte(chainID[:24]) != [24]byte{} {
+			var extension common.Hash
+			extension[0] = accessListChainIDExtensionType
+			copy(extension[8:], chainID[:24])
+			out = append(out, extension)
+		}
+
+		out = append(out, common.Hash(access.Checksum))
+	}
+	return out
+}
+
+// ParseAccessList decodes the executing messages declared in the access-list of a transaction.
+// Access-list entries of other addresses are ignored.
+func ParseAccessList(accessList ethTypes.AccessList) ([]Access, error) {
+	var accesses []Access
+	for _, tuple := range accessList {
+		if tuple.Address != predeploys.CrossL2InboxAddr {
+			continue
+		}
+		keys := tuple.StorageKeys
+		for len(keys) > 0 {
+			access, rest, err := parseAccess(keys)
+			if err != nil {
+				return nil, fmt.Errorf("%w: message %d: %w", ErrInvalidAccessList, len(accesses), err)
+			}
+			accesses = append(accesses, access)
+			keys = rest
+		}
+	}
+	return accesses, nil
+}
+
+func parseAccess(keys []common.Hash) (Access, []common.Hash, error) {
+	lookup := keys[0]
+	if lookup[0] != accessListLookupType {
+		return Access{}, nil, fmt.Errorf("unexpected lookup entry type %d", lookup[0])
+	}
+	if [3]byte(lookup[1:4]) != [3]byte{} {
+		return Access{}, nil, errors.New("lookup entry is not zero-padded")
+	}
+	var chainID [32]byte
+	copy(chainID[24:], lookup[4:12])
+	keys = keys[1:]
+	if len(keys) > 0 && keys[0][0] == accessListChainIDExtensionType {
+		extension := keys[0]
+		if [7]byte(extension[1:8]) != [7]byte{} {
+			return Access{}, nil, errors.New("chain ID extension entry is not zero-padded")
+		}
+		copy(chainID[:24], extension[8:])
+		keys = keys[1:]
+	}
+	if len(keys) == 0 {
+		return Access{}, nil, errors.New("missing checksum entry")
+	}
+	if keys[0][0] != accessListChecksumType {
+		return Access{}, nil, fmt.Errorf("unexpected checksum entry type %d", keys[0][0])
+	}
+	return Access{
+		BlockNumber: binary.BigEndian.Uint64(lookup[12:20]),
+		LogIndex:    binary.BigEndian.Uint32(lookup[28:32]),
+		Timestamp:   binary.BigEndian.Uint64(lookup[20:28]),
+		ChainID:     eth.ChainID(*new(uint256.Int).SetBytes32(chainID[:])),
+		Checksum:    MessageChecksum(keys[0]),
+	}, keys[1:], nil
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+)
+
+func TestAccessListRoundTrip(t *testing.T) {
+	messages := []Message{
+		{
+			Identifier: Identifier{
+				Origin:      common.Address{0xaa},
+				BlockNumber: 123,
+				LogIndex:    4,
+				Timestamp:   1000,
+				ChainID:     eth.ChainIDFromUInt64(900),
+			},
+			PayloadHash: common.Hash{0x01},
+		},
+		{
+			Identifier: Identifier{
+				Origin:      common.Address{0xbb},
+				BlockNumber: 1 << 40,
+				LogIndex:    1 << 30,
+				Timestamp:   1 << 50,
+				ChainID:     eth.ChainIDFromUInt64(901),
+			},
+			PayloadHash: common.Hash{0x02},
+		},
+		{
+			Identifier: Identifier{
+				Origin:      common.Address{0xcc},
+				BlockNumber: 7,
+				LogIndex:    0,
+				Timestamp:   2000,
+				ChainID:     eth.ChainIDFromBig(new(big.Int).Lsh(big.NewInt(1), 200)),
+			},
+			PayloadHash: common.Hash{0x03},
+		},
+	}
+	accessList := ethTypes.AccessList{
+		{Address: common.Address{0x42}, StorageKeys: []common.Hash{{0x01}}},
+		{Address: predeploys.CrossL2InboxAddr, StorageKeys: EncodeAccessList(messages)},
+	}
+	require.Len(t, accessList[1].StorageKeys, 7, "only the large chain ID needs an extension entry")
+	parsed, err := ParseAccessList(accessList)
+	require.NoError(t, err)
+	require.Len(t, parsed, len(messages))
+	for i, msg := range messages {
+		require.Equal(t, msg.Access(), parsed[i])
+		require.Equal(t, msg.Checksum(), parsed[i].ChecksumArgs(PayloadHashToLogHash(msg.PayloadHash, msg.Identifier.Origin)).Checksum())
+	}
+}
+
+func TestMessageChecksum(t *testing.T) {
+	// Checksum as computed by CrossL2Inbox.calculateChecksum
+	msg := Message{
+		Identifier: Identifier{
+			Origin:      common.HexToAddress("0x4200000000000000000000000000000000000023"),
+			BlockNumber: 100,
+			LogIndex:    2,
+			Timestamp:   1000,
+			ChainID:     eth.ChainIDFromUInt64(901),
+		},
+		PayloadHash: common.HexToHash("0x5d2c7a9e3c8c9c7b0c4c1f3b0a9b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b"),
+	}
+	var idPacked [32]byte
+	idPacked[19] = 100
+	idPacked[27] = 0xe8
+	idPacked[26] = 0x03
+	idPacked[31] = 2
+	logHash := crypto.Keccak256Hash(msg.Identifier.Origin[:], msg.PayloadHash[:])
+	idLogHash := crypto.Keccak256Hash(logHash[:], idPacked[:])
+	expected := crypto.Keccak256Hash(idLogHash[:], common.BigToHash(big.NewInt(901)).Bytes())
+	expected[0] = 0x03
+	require.Equal(t, MessageChecksum(expected), msg.Checksum())
+}
+
+func TestParseAccessListInvalid(t *testing.T) {
+	valid := func() []common.Hash {
+		return EncodeAccessList([]Message{{Identifier: Identifier{Origin: common.Address{0xaa}, ChainID: eth.ChainIDFromBig(new(big.Int).Lsh(big.NewInt(1), 100))}}})
+	}
+	parse := func(keys []common.Hash) error {
+		_, err := ParseAccessList(ethTypes.AccessList{{Address: predeploys.CrossL2InboxAddr, StorageKeys: keys}})
+		return err
+	}
+
+	require.NoError(t, parse(valid()))
+	require.ErrorIs(t, parse(valid()[:2]), ErrInvalidAccessList)
+	require.ErrorIs(t, parse(append(valid(), valid()[0])), ErrInvalidAccessList)
+
+	keys := valid()
+	keys[0][0] = 0x05
+	require.ErrorIs(t, parse(keys), ErrInvalidAccessList)
+
+	keys = valid()
+	keys[0][2] = 0x01
+	require.ErrorIs(t, parse(keys), ErrInvalidAccessList)
+
+	keys = valid()
+	keys[1][5] = 0x01
+	require.ErrorIs(t, parse(keys), ErrInvalidAccessList)
+
+	keys = valid()
+	keys[2][0] = accessListLookupType
+	require.ErrorIs(t, parse(keys), ErrInvalidAccessList)
+}
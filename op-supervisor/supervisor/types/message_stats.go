@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type MessageAnomalyKind string
+
+const (
+	// MessageSpike is a block with more executing messages of a chain pair than usual.
+	MessageSpike MessageAnomalyKind = "message-spike"
+	// RepeatedInvalidMessages is a sender that repeatedly references initiating messages that do not exist.
+	RepeatedInvalidMessages MessageAnomalyKind = "repeated-invalid-messages"
+)
+
+// ChainPairMessageStats are the statistics of the executing messages on one chain,
+// of the initiating messages of another, or the same, chain.
+type ChainPairMessageStats struct {
+	InitiatingChain eth.ChainID `json:"initiatingChain"`
+	ExecutingChain  eth.ChainID `json:"executingChain"`
+	// Messages is the total number of executing messages.
+	Messages uint64 `json:"messages"`
+	// MaxPerBlock is the highest number of executing messages in a single block.
+	MaxPerBlock uint64 `json:"maxPerBlock"`
+	// AveragePerBlock is the moving average of the number of executing messages per block of the executing chain.
+	AveragePerBlock float64 `json:"averagePerBlock"`
+	// LastBlock is the last block of the executing chain with executing messages of the pair.
+	LastBlock eth.BlockID `json:"lastBlock"`
+}
+
+// MessageAnomaly is unusual cross-chain messaging activity, reported for security monitoring.
+type MessageAnomaly struct {
+	Kind MessageAnomalyKind `json:"kind"`
+	// Time is the unix timestamp in seconds of when the anomaly was detected.
+	Time           uint64      `json:"time"`
+	ExecutingChain eth.ChainID `json:"executingChain"`
+	// InitiatingChain and Block are set for message spikes.
+	InitiatingChain *eth.ChainID `json:"initiatingChain,omitempty"`
+	Block           *eth.BlockID `json:"block,omitempty"`
+	// Sender is set for repeated invalid messages.
+	Sender *common.Address `json:"sender,omitempty"`
+	// Count is the number of messages in the block for spikes,
+	// and the number of invalid messages within the detection window for repeated invalid messages.
+	Count uint64 `json:"count"`
+}
+
+// MessageStats are the statistics of the executing messages of all chain pairs, and the most recent anomalies.
+type MessageStats struct {
+	Pairs     []ChainPairMessageStats `json:"pairs"`
+	Anomalies []MessageAnomaly        `json:"anomalies"`
+}
@@ -26,6 +26,18 @@ interface ICrossL2Inbox {
     /// @notice Thrown when trying to execute a cross chain message on a deposit transaction.
     error NoExecutingDeposits();
 
+    /// @notice Thrown when the checksum of a message is not declared in the access list of the transaction.
+    error NotInAccessList();
+
+    /// @notice Thrown when the block number of an Identifier is greater than 2^64.
+    error BlockNumberTooHigh();
+
+    /// @notice Thrown when the timestamp of an Identifier is greater than 2^64.
+    error TimestampTooHigh();
+
+    /// @notice Thrown when the log index of an Identifier is greater than 2^32.
+    error LogIndexTooHigh();
+
     event ExecutingMessage(bytes32 indexed msgHash, Identifier id);
 
     function version() external view returns (string memory);
@@ -58,4 +70,10 @@ interface ICrossL2Inbox {
     /// @param _id      Identifier of the message.
     /// @param _msgHash Hash of the message payload to call target with.
     function validateMessage(Identifier calldata _id, bytes32 _msgHash) external;
+
+    /// @notice Calculates the checksum of a message, as declared in the access list of the transaction.
+    /// @param _id      Identifier of the message.
+    /// @param _msgHash Hash of the message payload.
+    /// @return checksum_ The checksum of the message.
+    function calculateChecksum(Identifier memory _id, bytes32 _msgHash) external pure returns (bytes32 checksum_);
 }
@@ -21,6 +21,22 @@ error NotEntered();
 /// @notice Thrown when trying to execute a cross chain message on a deposit transaction.
 error NoExecutingDeposits();
 
+/// @notice Thrown when trying to validate a cross chain message with a checksum
+///         that is not declared in the access list of the transaction.
+error NotInAccessList();
+
+/// @notice Thrown when trying to validate a cross chain message with a block number
+///         that is greater than 2^64.
+error BlockNumberTooHigh();
+
+/// @notice Thrown when trying to validate a cross chain message with a timestamp
+///         that is greater than 2^64.
+error TimestampTooHigh();
+
+/// @notice Thrown when trying to validate a cross chain message with a log index
+///         that is greater than 2^32.
+error LogIndexTooHigh();
+
 /// @notice The struct for a pointer to a message payload in a remote (or local) chain.
 struct Identifier {
     address origin;
@@ -64,9 +80,12 @@ contract CrossL2Inbox is ISemver, TransientReentrancyAware {
     ///         transactions.
     address internal constant DEPOSITOR_ACCOUNT = 0xDeaDDEaDDeAdDeAdDEAdDEaddeAddEAdDEAd0001;
 
+    /// @notice The threshold for warm storage slot access cost: a cold SLOAD costs 2100 gas, a warm one 100 gas.
+    uint256 internal constant WARM_READ_THRESHOLD = 1000;
+
     /// @notice Semantic version.
-    /// @custom:semver 1.0.0-beta.12
-    string public constant version = "1.0.0-beta.12";
+    /// @custom:semver 1.0.0-beta.13
+    string public constant version = "1.0.0-beta.13";
 
     /// @notice Emitted when a cross chain message is being executed.
     /// @param msgHash Hash of message payload being executed.
@@ -129,16 +148,54 @@ contract CrossL2Inbox is ISemver, TransientReentrancyAware {
     /// @notice Validates a cross chain message on the destination chain
     ///         and emits an ExecutingMessage event. This function is useful
     ///         for applications that understand the schema of the _message payload and want to
-    ///         process it in a custom way.
+    ///         process it in a custom way. The checksum of the message must be declared
+    ///         in the access list of the transaction, so the message can be checked before execution.
     /// @param _id      Identifier of the message.
     /// @param _msgHash Hash of the message payload to call target with.
     function validateMessage(Identifier calldata _id, bytes32 _msgHash) external {
         // We need to know if this is being called on a depositTx
         if (IL1BlockInterop(Predeploys.L1_BLOCK_ATTRIBUTES).isDeposit()) revert NoExecutingDeposits();
 
+        // The checksum storage slot is only warm if it is declared in the access list
+        bytes32 checksum = calculateChecksum(_id, _msgHash);
+        if (!_isWarm(checksum)) revert NotInAccessList();
+
         emit ExecutingMessage(_msgHash, _id);
     }
 
+    /// @notice Calculates the checksum of a message, as declared in the access list of the transaction.
+    ///         The checksum commits to the full identifier and the message hash:
+    ///         logHash = keccak256(origin ++ msgHash),
+    ///         idLogHash = keccak256(logHash ++ uint96(0) ++ blockNumber ++ timestamp ++ logIndex),
+    ///         and checksum = 0x03 ++ keccak256(idLogHash ++ chainId)[1:].
+    /// @param _id      Identifier of the message.
+    /// @param _msgHash Hash of the message payload.
+    /// @return checksum_ The checksum of the message.
+    function calculateChecksum(Identifier memory _id, bytes32 _msgHash) public pure returns (bytes32 checksum_) {
+        if (_id.blockNumber > type(uint64).max) revert BlockNumberTooHigh();
+        if (_id.timestamp > type(uint64).max) revert TimestampTooHigh();
+        if (_id.logIndex > type(uint32).max) revert LogIndexTooHigh();
+
+        bytes32 logHash = keccak256(abi.encodePacked(_id.origin, _msgHash));
+        bytes32 idPacked =
+            bytes32(abi.encodePacked(bytes12(0), uint64(_id.blockNumber), uint64(_id.timestamp), uint32(_id.logIndex)));
+        bytes32 idLogHash = keccak256(abi.encodePacked(logHash, idPacked));
+        bytes32 bareChecksum = keccak256(abi.encodePacked(idLogHash, _id.chainId));
+
+        checksum_ = (bareChecksum & ~bytes32(uint256(0xff) << 248)) | bytes32(uint256(0x03) << 248);
+    }
+
+    /// @notice Checks if a storage slot is warm, by measuring the gas cost of reading it.
+    /// @param _slot Storage slot to check.
+    /// @return isWarm_ Whether the storage slot is warm.
+    function _isWarm(bytes32 _slot) internal view returns (bool isWarm_) {
+        assembly {
+            let startGas := gas()
+            pop(sload(_slot))
+            isWarm_ := iszero(gt(sub(startGas, gas()), WARM_READ_THRESHOLD))
+        }
+    }
+
     /// @notice Stores the Identifier in transient storage.
     /// @param _id Identifier to store.
     function _storeIdentifier(Identifier calldata _id) internal {
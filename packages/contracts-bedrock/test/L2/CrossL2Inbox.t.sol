@@ -15,7 +15,11 @@ import {
     NotEntered,
     NoExecutingDeposits,
     NotDepositor,
-    InteropStartAlreadySet
+    InteropStartAlreadySet,
+    NotInAccessList,
+    BlockNumberTooHigh,
+    TimestampTooHigh,
+    LogIndexTooHigh
 } from "src/L2/CrossL2Inbox.sol";
 import { IL1BlockInterop } from "interfaces/L2/IL1BlockInterop.sol";
 
@@ -155,6 +159,13 @@ contract CrossL2InboxTest is Test {
             returnData: abi.encode(false)
         });
 
+        // Bound the identifier to the ranges of the checksum
+        _id.blockNumber = bound(_id.blockNumber, 0, type(uint64).max);
+        _id.logIndex = bound(_id.logIndex, 0, type(uint32).max);
+
+        // Warm the checksum slot, as declared in the access list
+        vm.load(address(crossL2Inbox), crossL2Inbox.calculateChecksum(_id, _messageHash));
+
         // Look for the emit ExecutingMessage event
         vm.expectEmit(Predeploys.CROSS_L2_INBOX);
         emit CrossL2Inbox.ExecutingMessage(_messageHash, _id);
@@ -163,6 +174,72 @@ contract CrossL2InboxTest is Test {
         crossL2Inbox.validateMessage(_id, _messageHash);
     }
 
+    /// @dev Tests that the validateMessage function reverts when the checksum is not in the access list.
+    function testFuzz_validateMessage_notInAccessList_reverts(
+        Identifier memory _id,
+        bytes32 _messageHash
+    )
+        external
+        setInteropStart
+    {
+        _id.blockNumber = bound(_id.blockNumber, 0, type(uint64).max);
+        _id.timestamp = bound(_id.timestamp, 0, type(uint64).max);
+        _id.logIndex = bound(_id.logIndex, 0, type(uint32).max);
+
+        // Ensure is not a deposit transaction
+        vm.mockCall({
+            callee: Predeploys.L1_BLOCK_ATTRIBUTES,
+            data: abi.encodeCall(IL1BlockInterop.isDeposit, ()),
+            returnData: abi.encode(false)
+        });
+
+        // Expect a revert with the NotInAccessList selector
+        vm.expectRevert(NotInAccessList.selector);
+
+        // Call the validateMessage function
+        crossL2Inbox.validateMessage(_id, _messageHash);
+    }
+
+    /// @dev Tests that the calculateChecksum function matches the access list encoding of the op-supervisor.
+    function test_calculateChecksum_succeeds() external view {
+        Identifier memory id = Identifier({
+            origin: address(0xaa),
+            blockNumber: 10,
+            logIndex: 2,
+            timestamp: 1000,
+            chainId: 901
+        });
+        bytes32 msgHash = bytes32(uint256(0xbb));
+
+        bytes32 logHash = keccak256(abi.encodePacked(id.origin, msgHash));
+        bytes32 idPacked = bytes32((uint256(10) << 96) | (uint256(1000) << 32) | 2);
+        bytes32 idLogHash = keccak256(abi.encodePacked(logHash, idPacked));
+        bytes32 bareChecksum = keccak256(abi.encodePacked(idLogHash, uint256(901)));
+
+        bytes32 checksum = crossL2Inbox.calculateChecksum(id, msgHash);
+        assertEq(uint8(checksum[0]), 0x03);
+        assertEq(uint256(checksum) << 8, uint256(bareChecksum) << 8);
+    }
+
+    /// @dev Tests that the calculateChecksum function reverts when the identifier is out of range.
+    function test_calculateChecksum_outOfRange_reverts() external {
+        Identifier memory id;
+
+        id.blockNumber = uint256(type(uint64).max) + 1;
+        vm.expectRevert(BlockNumberTooHigh.selector);
+        crossL2Inbox.calculateChecksum(id, bytes32(0));
+
+        id.blockNumber = 0;
+        id.timestamp = uint256(type(uint64).max) + 1;
+        vm.expectRevert(TimestampTooHigh.selector);
+        crossL2Inbox.calculateChecksum(id, bytes32(0));
+
+        id.timestamp = 0;
+        id.logIndex = uint256(type(uint32).max) + 1;
+        vm.expectRevert(LogIndexTooHigh.selector);
+        crossL2Inbox.calculateChecksum(id, bytes32(0));
+    }
+
     function testFuzz_validateMessage_isDeposit_reverts(Identifier calldata _id, bytes32 _messageHash) external {
         // Ensure it is a deposit transaction
         vm.mockCall({